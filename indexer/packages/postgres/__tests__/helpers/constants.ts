@@ -40,6 +40,7 @@ import {
   PerpetualPositionStatus,
   PnlTicksCreateObject,
   PositionSide,
+  SpotMarketCreateObject,
   SubaccountCreateObject,
   TendermintEventCreateObject,
   TimeInForce,
@@ -475,6 +476,28 @@ export const defaultLiquidityTier2: LiquidityTiersCreateObject = {
   basePositionNotional: '1000',
 };
 
+// ============== SpotMarkets ==============
+
+export const defaultSpotMarket: SpotMarketCreateObject = {
+  clobPairId: '1000',
+  baseAssetId: defaultAsset3.id,
+  quoteAssetId: defaultAsset.id,
+  status: PerpetualMarketStatus.ACTIVE,
+  quantumConversionExponent: -8,
+  subticksPerTick: 100,
+  stepBaseQuantums: 10,
+};
+
+export const defaultSpotMarket2: SpotMarketCreateObject = {
+  clobPairId: '1001',
+  baseAssetId: defaultAsset2.id,
+  quoteAssetId: defaultAsset.id,
+  status: PerpetualMarketStatus.ACTIVE,
+  quantumConversionExponent: -6,
+  subticksPerTick: 1000,
+  stepBaseQuantums: 1000000,
+};
+
 // ============== OraclePrices ==============
 
 export const defaultOraclePrice: OraclePriceCreateObject = {
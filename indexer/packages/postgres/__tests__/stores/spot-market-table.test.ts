@@ -0,0 +1,118 @@
+import { PerpetualMarketStatus, SpotMarketFromDatabase } from '../../src/types';
+import * as AssetTable from '../../src/stores/asset-table';
+import * as SpotMarketTable from '../../src/stores/spot-market-table';
+import {
+  clearData,
+  migrate,
+  teardown,
+} from '../../src/helpers/db-helpers';
+import {
+  defaultAsset,
+  defaultAsset2,
+  defaultAsset3,
+  defaultSpotMarket,
+  defaultSpotMarket2,
+} from '../helpers/constants';
+
+describe('SpotMarket store', () => {
+  beforeAll(async () => {
+    await migrate();
+  });
+
+  beforeEach(async () => {
+    await Promise.all([
+      AssetTable.create(defaultAsset),
+      AssetTable.create(defaultAsset2),
+      AssetTable.create(defaultAsset3),
+    ]);
+  });
+
+  afterEach(async () => {
+    await clearData();
+  });
+
+  afterAll(async () => {
+    await teardown();
+  });
+
+  it('Successfully creates a spot market', async () => {
+    await SpotMarketTable.create(defaultSpotMarket);
+  });
+
+  it('Fails to create a spot market with a base asset that does not exist', async () => {
+    await expect(SpotMarketTable.create({
+      ...defaultSpotMarket,
+      baseAssetId: '100',
+    })).rejects.toThrow();
+  });
+
+  it('Successfully finds all spot markets', async () => {
+    await Promise.all([
+      SpotMarketTable.create(defaultSpotMarket),
+      SpotMarketTable.create(defaultSpotMarket2),
+    ]);
+
+    const spotMarkets: SpotMarketFromDatabase[] = await SpotMarketTable.findAll(
+      {},
+      [],
+      { readReplica: true },
+    );
+
+    expect(spotMarkets.length).toEqual(2);
+    expect(spotMarkets[0]).toEqual(expect.objectContaining(defaultSpotMarket));
+    expect(spotMarkets[1]).toEqual(expect.objectContaining(defaultSpotMarket2));
+  });
+
+  it('Successfully finds spot markets by base asset', async () => {
+    await Promise.all([
+      SpotMarketTable.create(defaultSpotMarket),
+      SpotMarketTable.create(defaultSpotMarket2),
+    ]);
+
+    const spotMarkets: SpotMarketFromDatabase[] = await SpotMarketTable.findAll(
+      { baseAssetId: [defaultAsset2.id] },
+      [],
+      { readReplica: true },
+    );
+
+    expect(spotMarkets.length).toEqual(1);
+    expect(spotMarkets[0]).toEqual(expect.objectContaining(defaultSpotMarket2));
+  });
+
+  it('Successfully finds a spot market', async () => {
+    await SpotMarketTable.create(defaultSpotMarket);
+
+    const spotMarket: SpotMarketFromDatabase | undefined = await SpotMarketTable.findById(
+      defaultSpotMarket.clobPairId,
+    );
+
+    expect(spotMarket).toEqual(expect.objectContaining(defaultSpotMarket));
+  });
+
+  it('Unable to find a spot market', async () => {
+    const spotMarket: SpotMarketFromDatabase | undefined = await SpotMarketTable.findById(
+      defaultSpotMarket.clobPairId,
+    );
+    expect(spotMarket).toEqual(undefined);
+  });
+
+  it('Successfully updates a spot market', async () => {
+    await SpotMarketTable.create(defaultSpotMarket);
+
+    const spotMarket: SpotMarketFromDatabase | undefined = await SpotMarketTable.update({
+      clobPairId: defaultSpotMarket.clobPairId,
+      status: PerpetualMarketStatus.PAUSED,
+    });
+
+    expect(spotMarket).toEqual(expect.objectContaining({
+      ...defaultSpotMarket,
+      status: PerpetualMarketStatus.PAUSED,
+    }));
+  });
+
+  it('Successfully upserts a spot market', async () => {
+    const spotMarket: SpotMarketFromDatabase = await SpotMarketTable.upsert(defaultSpotMarket);
+
+    expect(spotMarket).toEqual(expect.objectContaining(defaultSpotMarket));
+  });
+});
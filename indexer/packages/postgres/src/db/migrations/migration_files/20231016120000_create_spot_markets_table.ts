@@ -0,0 +1,28 @@
+import Knex from 'knex';
+
+export async function up(knex: Knex): Promise<void> {
+  return knex
+    .schema
+    .createTable('spot_markets', (table) => {
+      table.bigInteger('clobPairId').primary();
+      table.string('baseAssetId').notNullable().references('id').inTable('assets');
+      table.string('quoteAssetId').notNullable().references('id').inTable('assets');
+      table.enum(
+        'status',
+        [
+          'ACTIVE',
+          'PAUSED',
+          'CANCEL_ONLY',
+          'POST_ONLY',
+          'INITIALIZING',
+        ],
+      ).notNullable();
+      table.integer('quantumConversionExponent').notNullable();
+      table.integer('subticksPerTick').notNullable();
+      table.integer('stepBaseQuantums').notNullable();
+    });
+}
+
+export async function down(knex: Knex): Promise<void> {
+  return knex.schema.dropTableIfExists('spot_markets');
+}
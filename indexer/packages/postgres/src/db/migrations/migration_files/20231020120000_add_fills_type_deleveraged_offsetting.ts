@@ -0,0 +1,19 @@
+import * as Knex from 'knex';
+
+export async function up(knex: Knex): Promise<void> {
+  return knex.schema.raw(`
+    ALTER TABLE "fills"
+    DROP CONSTRAINT "fills_type_check",
+    ADD CONSTRAINT "fills_type_check"
+    CHECK (type IN ('MARKET', 'LIMIT', 'LIQUIDATED', 'LIQUIDATION', 'DELEVERAGED', 'OFFSETTING'))
+  `);
+}
+
+export async function down(knex: Knex): Promise<void> {
+  return knex.schema.raw(`
+    ALTER TABLE "fills"
+    DROP CONSTRAINT "fills_type_check",
+    ADD CONSTRAINT "fills_type_check"
+    CHECK (type IN ('MARKET', 'LIMIT', 'LIQUIDATED', 'LIQUIDATION'))
+  `);
+}
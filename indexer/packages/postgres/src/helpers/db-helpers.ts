@@ -21,6 +21,7 @@ const layer1Tables = [
   'assets',
   'candles',
   'liquidity_tiers',
+  'spot_markets',
   'wallets',
   'compliance_data',
 ];
@@ -0,0 +1,77 @@
+import path from 'path';
+
+import { Model } from 'objection';
+
+import { IntegerPattern } from '../lib/validators';
+import UpsertQueryBuilder from '../query-builders/upsert';
+import { PerpetualMarketStatus } from '../types';
+import BaseModel from './base-model';
+
+export default class SpotMarketModel extends BaseModel {
+  static get tableName() {
+    return 'spot_markets';
+  }
+
+  static get idColumn() {
+    return 'clobPairId';
+  }
+
+  static relationMappings = {
+    baseAsset: {
+      relation: Model.HasOneRelation,
+      modelClass: path.join(__dirname, 'asset-model'),
+      join: {
+        from: 'spot_markets.baseAssetId',
+        to: 'assets.id',
+      },
+    },
+    quoteAsset: {
+      relation: Model.HasOneRelation,
+      modelClass: path.join(__dirname, 'asset-model'),
+      join: {
+        from: 'spot_markets.quoteAssetId',
+        to: 'assets.id',
+      },
+    },
+  };
+
+  static get jsonSchema() {
+    return {
+      type: 'object',
+      required: [
+        'clobPairId',
+        'baseAssetId',
+        'quoteAssetId',
+        'status',
+        'quantumConversionExponent',
+        'subticksPerTick',
+        'stepBaseQuantums',
+      ],
+      properties: {
+        clobPairId: { type: 'string', pattern: IntegerPattern },
+        baseAssetId: { type: 'string' },
+        quoteAssetId: { type: 'string' },
+        status: { type: 'string', enum: [...Object.values(PerpetualMarketStatus)] },
+        quantumConversionExponent: { type: 'integer' },
+        subticksPerTick: { type: 'integer' },
+        stepBaseQuantums: { type: 'integer' },
+      },
+    };
+  }
+
+  clobPairId!: string;
+
+  QueryBuilderType!: UpsertQueryBuilder<this>;
+
+  baseAssetId!: string;
+
+  quoteAssetId!: string;
+
+  status!: PerpetualMarketStatus;
+
+  quantumConversionExponent!: number;
+
+  subticksPerTick!: number;
+
+  stepBaseQuantums!: number;
+}
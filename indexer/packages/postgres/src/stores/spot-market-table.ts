@@ -0,0 +1,119 @@
+import { PartialModelObject, QueryBuilder } from 'objection';
+
+import { DEFAULT_POSTGRES_OPTIONS } from '../constants';
+import { setupBaseQuery, verifyAllRequiredFields } from '../helpers/stores-helpers';
+import Transaction from '../helpers/transaction';
+import SpotMarketModel from '../models/spot-market-model';
+import {
+  Options,
+  Ordering,
+  QueryableField,
+  QueryConfig,
+  SpotMarketColumns,
+  SpotMarketCreateObject,
+  SpotMarketFromDatabase,
+  SpotMarketQueryConfig,
+  SpotMarketUpdateObject,
+} from '../types';
+
+export async function findAll(
+  {
+    limit,
+    clobPairId,
+    baseAssetId,
+  }: SpotMarketQueryConfig,
+  requiredFields: QueryableField[],
+  options: Options = DEFAULT_POSTGRES_OPTIONS,
+): Promise<SpotMarketFromDatabase[]> {
+  verifyAllRequiredFields(
+    {
+      limit,
+      clobPairId,
+      baseAssetId,
+    } as QueryConfig,
+    requiredFields,
+  );
+
+  let baseQuery: QueryBuilder<SpotMarketModel> = setupBaseQuery<SpotMarketModel>(
+    SpotMarketModel,
+    options,
+  );
+
+  if (clobPairId !== undefined) {
+    baseQuery = baseQuery.whereIn(SpotMarketColumns.clobPairId, clobPairId);
+  }
+
+  if (baseAssetId !== undefined) {
+    baseQuery = baseQuery.whereIn(SpotMarketColumns.baseAssetId, baseAssetId);
+  }
+
+  if (options.orderBy !== undefined) {
+    for (const [column, order] of options.orderBy) {
+      baseQuery = baseQuery.orderBy(
+        column,
+        order,
+      );
+    }
+  } else {
+    baseQuery = baseQuery.orderBy(
+      SpotMarketColumns.clobPairId,
+      Ordering.ASC,
+    );
+  }
+
+  if (limit !== undefined) {
+    baseQuery = baseQuery.limit(limit);
+  }
+
+  return baseQuery.returning('*');
+}
+
+export async function create(
+  spotMarketToCreate: SpotMarketCreateObject,
+  options: Options = { txId: undefined },
+): Promise<SpotMarketFromDatabase> {
+  return SpotMarketModel.query(
+    Transaction.get(options.txId),
+  ).insert(spotMarketToCreate).returning('*');
+}
+
+export async function findById(
+  clobPairId: string,
+  options: Options = DEFAULT_POSTGRES_OPTIONS,
+): Promise<SpotMarketFromDatabase | undefined> {
+  const baseQuery: QueryBuilder<SpotMarketModel> = setupBaseQuery<SpotMarketModel>(
+    SpotMarketModel,
+    options,
+  );
+  return baseQuery
+    .findById(clobPairId)
+    .returning('*');
+}
+
+export async function update(
+  {
+    clobPairId,
+    ...fields
+  }: SpotMarketUpdateObject,
+  options: Options = { txId: undefined },
+): Promise<SpotMarketFromDatabase | undefined> {
+  const spotMarket = await SpotMarketModel.query(
+    Transaction.get(options.txId),
+    // TODO fix expression typing so we dont have to use any
+    // eslint-disable-next-line @typescript-eslint/no-explicit-any
+  ).findById(clobPairId);
+  const updatedSpotMarket = await spotMarket.$query().patch(fields as PartialModelObject<SpotMarketModel>).returning('*');
+  // The objection types mistakenly think the query returns an array of spot markets.
+  return updatedSpotMarket as unknown as (SpotMarketFromDatabase | undefined);
+}
+
+export async function upsert(
+  spotMarketToUpsert: SpotMarketCreateObject,
+  options: Options = { txId: undefined },
+): Promise<SpotMarketFromDatabase> {
+  const spotMarkets: SpotMarketModel[] = await SpotMarketModel.query(
+    Transaction.get(options.txId),
+  ).upsert(spotMarketToUpsert).returning('*');
+  // should only ever be one spot market
+  return spotMarkets[0];
+}
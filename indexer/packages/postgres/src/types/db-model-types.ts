@@ -180,6 +180,16 @@ export interface LiquidityTiersFromDatabase {
   basePositionNotional: string;
 }
 
+export interface SpotMarketFromDatabase {
+  clobPairId: string;
+  baseAssetId: string;
+  quoteAssetId: string;
+  status: PerpetualMarketStatus;
+  quantumConversionExponent: number;
+  subticksPerTick: number;
+  stepBaseQuantums: number;
+}
+
 export interface CandleFromDatabase extends IdBasedModelFromDatabase {
   startedAt: IsoString;
   ticker: string;
@@ -23,6 +23,12 @@ export enum FillType {
   LIQUIDATED = 'LIQUIDATED',
   // LIQUIDATION is for the maker side of the fill, never used for orders
   LIQUIDATION = 'LIQUIDATION',
+  // DELEVERAGED is for the side of a deleveraging fill where the subaccount was deleveraged.
+  // The subaccountId associated with this fill is the liquidated subaccount.
+  DELEVERAGED = 'DELEVERAGED',
+  // OFFSETTING is for the side of a deleveraging fill where the subaccount's position was used
+  // to offset the deleveraged position, never used for orders
+  OFFSETTING = 'OFFSETTING',
 }
 
 export interface FillCreateObject {
@@ -19,6 +19,7 @@ export * from './candle-types';
 export * from './pnl-ticks-types';
 export * from './funding-index-updates-types';
 export * from './liquidity-tiers-types';
+export * from './spot-market-types';
 export * from './wallet-types';
 export * from './compliance-data-types';
 export { PositionSide } from './position-types';
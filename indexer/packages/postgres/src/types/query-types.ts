@@ -71,6 +71,7 @@ export enum QueryableField {
   UPDATED_ON_OR_AFTER = 'updatedOnOrAfter',
   PROVIDER = 'provider',
   BLOCKED = 'blocked',
+  BASE_ASSET_ID = 'baseAssetId',
 }
 
 export interface QueryConfig {
@@ -257,6 +258,11 @@ export interface LiquidityTiersQueryConfig extends QueryConfig {
   [QueryableField.ID]?: string[];
 }
 
+export interface SpotMarketQueryConfig extends QueryConfig {
+  [QueryableField.CLOB_PAIR_ID]?: string[];
+  [QueryableField.BASE_ASSET_ID]?: string[];
+}
+
 export interface ComplianceDataQueryConfig extends QueryConfig {
   [QueryableField.ADDRESS]?: string[];
   [QueryableField.UPDATED_BEFORE_OR_AT]?: string;
@@ -0,0 +1,31 @@
+/* ------- SPOT MARKET TYPES ------- */
+
+import { PerpetualMarketStatus } from './perpetual-market-types';
+
+export interface SpotMarketCreateObject {
+  clobPairId: string;
+  baseAssetId: string;
+  quoteAssetId: string;
+  status: PerpetualMarketStatus;
+  quantumConversionExponent: number;
+  subticksPerTick: number;
+  stepBaseQuantums: number;
+}
+
+export interface SpotMarketUpdateObject {
+  clobPairId: string;
+  status?: PerpetualMarketStatus;
+  quantumConversionExponent?: number;
+  subticksPerTick?: number;
+  stepBaseQuantums?: number;
+}
+
+export enum SpotMarketColumns {
+  clobPairId = 'clobPairId',
+  baseAssetId = 'baseAssetId',
+  quoteAssetId = 'quoteAssetId',
+  status = 'status',
+  quantumConversionExponent = 'quantumConversionExponent',
+  subticksPerTick = 'subticksPerTick',
+  stepBaseQuantums = 'stepBaseQuantums',
+}
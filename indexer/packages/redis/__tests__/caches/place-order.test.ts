@@ -193,6 +193,46 @@ describe('placeOrder', () => {
       await expectOrderExpiry(newerRedisOrder);
     });
 
+    it('replaces existing order with equal expiry if the order is a replacement', async () => {
+      await placeOrder(
+        {
+          redisOrder: redisOrderGoodTilBlockTime,
+          client,
+        },
+      );
+
+      await setAsync({
+        key: getOrderDataCacheKey(redisOrderGoodTilBlockTime.order!.orderId!),
+        value: `${getOrderExpiry(redisOrderGoodTilBlockTime.order!)}_43_true`,
+      }, client);
+
+      const replacementRedisOrder: RedisOrder = {
+        ...redisOrderGoodTilBlockTime,
+        order: {
+          ...orderGoodTilBlockTIme,
+          subticks: Long.fromValue(3_200_000, true),
+        },
+        price: '3200.0',
+      };
+
+      const result: PlaceOrderResult = await placeOrder(
+        {
+          redisOrder: replacementRedisOrder,
+          client,
+          isReplacement: true,
+        },
+      );
+
+      expect(result.placed).toEqual(false);
+      expect(result.replaced).toEqual(true);
+      expect(result.oldTotalFilledQuantums).toEqual(43);
+      expect(result.restingOnBook).toEqual(true);
+      expect(result.oldOrder).toEqual(redisOrderGoodTilBlockTime);
+
+      await expectOrderCache(result, replacementRedisOrder, 1);
+      await expectOrderExpiry(replacementRedisOrder);
+    });
+
     it.each([
       ['missing order', { ...redisOrder, order: undefined }],
       ['missing order id', { ...redisOrder, order: { ...order, orderId: undefined } }],
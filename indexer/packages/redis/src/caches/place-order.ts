@@ -29,7 +29,8 @@ const numKeys: number = 4;
  * - if the order exists in the cache, and the placed order has a lower or equal expiry
  *   (good-til-block/seq. number)
  *   - no updates
- * - if the order exists in the cache, and the placed order has a greater expiry
+ * - if the order exists in the cache, and the placed order has a greater expiry, or is an explicit
+ *   replacement (`isReplacement`) of the existing order with an equal expiry
  *   - the encoded `RedisOrder` should be saved to the `ORDERS_CACHE`, replacing the existing order
  *   - {good-til-block/sequence-number}_{totalFilled}_false should be saved to the
  *     `ORDERS_DATA_CACHE`, replacing the existing order, with `totalFilled` being the value for the
@@ -38,15 +39,18 @@ const numKeys: number = 4;
  *     - the score for the order uuid in the `ORDER_EXPIRY_CACHE` should be updated to the new
  *       expiry
  * See the `place_order.lua` script for more context.
- * @param param0 Contains the `RedisOrder` of the order being placed.
+ * @param param0 Contains the `RedisOrder` of the order being placed, and whether the order is an
+ * explicit replacement of an existing order with the same order id.
  * @returns `PlaceOrderResult` for the result of placing the order.
  */
 export async function placeOrder({
   redisOrder,
   client,
+  isReplacement = false,
 }: {
   redisOrder: RedisOrder,
   client: RedisClient,
+  isReplacement?: boolean,
 }): Promise<PlaceOrderResult> {
   validateRedisOrder(redisOrder);
 
@@ -59,6 +63,7 @@ export async function placeOrder({
     orderExpiry: string,
     orderId: string,
     isShortTermOrder: boolean,
+    isReplacementOrder: boolean,
   ) => Promise<PlaceOrderResult> = (
     orderKey,
     orderDataKey,
@@ -68,6 +73,7 @@ export async function placeOrder({
     orderExpiry,
     orderId,
     isShortTermOrder,
+    isReplacementOrder,
   ) => {
     return new Promise((resolve, reject) => {
       const callback: Callback<[number, number, string, string, string]> = (
@@ -109,6 +115,7 @@ export async function placeOrder({
         orderExpiry,
         orderId,
         isShortTermOrder.toString(),
+        isReplacementOrder.toString(),
         callback,
       );
     });
@@ -124,5 +131,6 @@ export async function placeOrder({
     getOrderExpiry(redisOrder.order!).toString(),
     OrderTable.orderIdToUuid(redisOrder.order!.orderId!),
     redisOrder.order!.orderId!.orderFlags === ORDER_FLAG_SHORT_TERM,
+    isReplacement,
   );
 }
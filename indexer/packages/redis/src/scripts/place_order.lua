@@ -20,6 +20,10 @@ local newOrderExpiry = ARGV[2];
 local orderId = ARGV[3];
 -- Whether the order that is being placed is a short-term order. Need to convert from string to bool
 local isShortTermOrder = ARGV[4] == "true";
+-- Whether the order that is being placed is an explicit replacement of an existing order with the
+-- same order id, which may have the same expiry as the order it replaces. Need to convert from
+-- string to bool
+local isReplacement = ARGV[5] == "true";
 
 -- This script returns the following values in an array
 -- 1. Was a new order placed or replaced - 1 if an order is placed, 0 if not
@@ -55,13 +59,15 @@ else
   local oldRestingOnBook = string.sub(oldOrderData, i + 1);
 
   -- if the new order has a lower or equal expiry (good-til-block or sequence number) than the order
-  -- in the cache, return early
-  if tonumber(oldExpiry) >= tonumber(newOrderExpiry) then
+  -- in the cache, return early. Explicit replacements may have an expiry equal to the order in the
+  -- cache, so only return early if the expiry of the replacement is lower.
+  if tonumber(oldExpiry) > tonumber(newOrderExpiry) or
+    (not isReplacement and tonumber(oldExpiry) == tonumber(newOrderExpiry)) then
     return {0, 0, "0", "false", ""}
   end
 
   -- update the order if the new order has a greater expiry (good-til-block or sequence number) than
-  -- the order in the cache, also update the order data with the new expiry and the total filled
+  -- the order in the cache or is an explicit replacement of it, also update the order data with the new expiry and the total filled
   -- of the older order. As the order is replaced, it is no longer resting on the book, so set the
   -- to "false".
   redis.call("set", orderKey, newOrder)
@@ -48,6 +48,15 @@ export interface Asset {
    */
 
   atomicResolution: number;
+  /**
+   * The fraction of the oracle value of a positive balance of this `Asset`
+   * that counts towards the net collateral of a subaccount, in
+   * parts-per-million. An `Asset` with a collateral weight of zero cannot be
+   * used as collateral. Must be zero for `Assets` without a market. Ignored
+   * for USDC, which always counts towards net collateral at full value.
+   */
+
+  collateralWeightPpm: number;
 }
 /** Asset defines a single exchangable asset. */
 
@@ -97,6 +106,15 @@ export interface AssetSDKType {
    */
 
   atomic_resolution: number;
+  /**
+   * The fraction of the oracle value of a positive balance of this `Asset`
+   * that counts towards the net collateral of a subaccount, in
+   * parts-per-million. An `Asset` with a collateral weight of zero cannot be
+   * used as collateral. Must be zero for `Assets` without a market. Ignored
+   * for USDC, which always counts towards net collateral at full value.
+   */
+
+  collateral_weight_ppm: number;
 }
 
 function createBaseAsset(): Asset {
@@ -107,7 +125,8 @@ function createBaseAsset(): Asset {
     denomExponent: 0,
     hasMarket: false,
     marketId: 0,
-    atomicResolution: 0
+    atomicResolution: 0,
+    collateralWeightPpm: 0
   };
 }
 
@@ -141,6 +160,10 @@ export const Asset = {
       writer.uint32(56).sint32(message.atomicResolution);
     }
 
+    if (message.collateralWeightPpm !== 0) {
+      writer.uint32(64).uint32(message.collateralWeightPpm);
+    }
+
     return writer;
   },
 
@@ -181,6 +204,10 @@ export const Asset = {
           message.atomicResolution = reader.sint32();
           break;
 
+        case 8:
+          message.collateralWeightPpm = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -199,6 +226,7 @@ export const Asset = {
     message.hasMarket = object.hasMarket ?? false;
     message.marketId = object.marketId ?? 0;
     message.atomicResolution = object.atomicResolution ?? 0;
+    message.collateralWeightPpm = object.collateralWeightPpm ?? 0;
     return message;
   }
 
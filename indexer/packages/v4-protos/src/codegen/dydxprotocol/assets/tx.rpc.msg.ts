@@ -0,0 +1,24 @@
+import { Rpc } from "../../helpers";
+import * as _m0 from "protobufjs/minimal";
+import { MsgSetAssetCollateralWeight, MsgSetAssetCollateralWeightResponse } from "./tx";
+/** Msg defines the Msg service. */
+
+export interface Msg {
+  /** SetAssetCollateralWeight sets the collateral weight of an existing asset. */
+  setAssetCollateralWeight(request: MsgSetAssetCollateralWeight): Promise<MsgSetAssetCollateralWeightResponse>;
+}
+export class MsgClientImpl implements Msg {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.setAssetCollateralWeight = this.setAssetCollateralWeight.bind(this);
+  }
+
+  setAssetCollateralWeight(request: MsgSetAssetCollateralWeight): Promise<MsgSetAssetCollateralWeightResponse> {
+    const data = MsgSetAssetCollateralWeight.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.assets.Msg", "SetAssetCollateralWeight", data);
+    return promise.then(data => MsgSetAssetCollateralWeightResponse.decode(new _m0.Reader(data)));
+  }
+
+}
\ No newline at end of file
@@ -1 +1,143 @@
-export {}
\ No newline at end of file
+import * as _m0 from "protobufjs/minimal";
+import { DeepPartial } from "../../helpers";
+/**
+ * MsgSetAssetCollateralWeight is a message used by x/gov to set the
+ * collateral weight of an asset.
+ */
+
+export interface MsgSetAssetCollateralWeight {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the asset to update. */
+
+  assetId: number;
+  /** The new collateral weight of the asset, in parts-per-million. */
+
+  collateralWeightPpm: number;
+}
+/**
+ * MsgSetAssetCollateralWeight is a message used by x/gov to set the
+ * collateral weight of an asset.
+ */
+
+export interface MsgSetAssetCollateralWeightSDKType {
+  /** The address that controls the module. */
+  authority: string;
+  /** The id of the asset to update. */
+
+  asset_id: number;
+  /** The new collateral weight of the asset, in parts-per-million. */
+
+  collateral_weight_ppm: number;
+}
+/**
+ * MsgSetAssetCollateralWeightResponse defines the SetAssetCollateralWeight
+ * response type.
+ */
+
+export interface MsgSetAssetCollateralWeightResponse {}
+/**
+ * MsgSetAssetCollateralWeightResponse defines the SetAssetCollateralWeight
+ * response type.
+ */
+
+export interface MsgSetAssetCollateralWeightResponseSDKType {}
+
+function createBaseMsgSetAssetCollateralWeight(): MsgSetAssetCollateralWeight {
+  return {
+    authority: "",
+    assetId: 0,
+    collateralWeightPpm: 0
+  };
+}
+
+export const MsgSetAssetCollateralWeight = {
+  encode(message: MsgSetAssetCollateralWeight, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.assetId !== 0) {
+      writer.uint32(16).uint32(message.assetId);
+    }
+
+    if (message.collateralWeightPpm !== 0) {
+      writer.uint32(24).uint32(message.collateralWeightPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetAssetCollateralWeight {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetAssetCollateralWeight();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.assetId = reader.uint32();
+          break;
+
+        case 3:
+          message.collateralWeightPpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgSetAssetCollateralWeight>): MsgSetAssetCollateralWeight {
+    const message = createBaseMsgSetAssetCollateralWeight();
+    message.authority = object.authority ?? "";
+    message.assetId = object.assetId ?? 0;
+    message.collateralWeightPpm = object.collateralWeightPpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseMsgSetAssetCollateralWeightResponse(): MsgSetAssetCollateralWeightResponse {
+  return {};
+}
+
+export const MsgSetAssetCollateralWeightResponse = {
+  encode(_: MsgSetAssetCollateralWeightResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetAssetCollateralWeightResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetAssetCollateralWeightResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgSetAssetCollateralWeightResponse>): MsgSetAssetCollateralWeightResponse {
+    const message = createBaseMsgSetAssetCollateralWeightResponse();
+    return message;
+  }
+
+};
\ No newline at end of file
@@ -15,6 +15,12 @@ export interface BridgeEvent {
   /** The Ethereum block height of the event. */
 
   ethBlockHeight: Long;
+  /**
+   * The id of the bridge source that emitted the event. Event ids are unique
+   * per source.
+   */
+
+  sourceId: number;
 }
 /** BridgeEvent is a recognized event from the Ethereum blockchain. */
 
@@ -30,6 +36,12 @@ export interface BridgeEventSDKType {
   /** The Ethereum block height of the event. */
 
   eth_block_height: Long;
+  /**
+   * The id of the bridge source that emitted the event. Event ids are unique
+   * per source.
+   */
+
+  source_id: number;
 }
 
 function createBaseBridgeEvent(): BridgeEvent {
@@ -37,7 +49,8 @@ function createBaseBridgeEvent(): BridgeEvent {
     id: 0,
     coin: undefined,
     address: "",
-    ethBlockHeight: Long.UZERO
+    ethBlockHeight: Long.UZERO,
+    sourceId: 0
   };
 }
 
@@ -59,6 +72,10 @@ export const BridgeEvent = {
       writer.uint32(32).uint64(message.ethBlockHeight);
     }
 
+    if (message.sourceId !== 0) {
+      writer.uint32(40).uint32(message.sourceId);
+    }
+
     return writer;
   },
 
@@ -87,6 +104,10 @@ export const BridgeEvent = {
           message.ethBlockHeight = (reader.uint64() as Long);
           break;
 
+        case 5:
+          message.sourceId = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -102,6 +123,7 @@ export const BridgeEvent = {
     message.coin = object.coin !== undefined && object.coin !== null ? Coin.fromPartial(object.coin) : undefined;
     message.address = object.address ?? "";
     message.ethBlockHeight = object.ethBlockHeight !== undefined && object.ethBlockHeight !== null ? Long.fromValue(object.ethBlockHeight) : Long.UZERO;
+    message.sourceId = object.sourceId ?? 0;
     return message;
   }
 
@@ -2,7 +2,7 @@ import * as _m0 from "protobufjs/minimal";
 import { Long, DeepPartial } from "../../helpers";
 /**
  * BridgeEventInfo stores information about the most recently processed bridge
- * event.
+ * event of a bridge source.
  */
 
 export interface BridgeEventInfo {
@@ -14,10 +14,13 @@ export interface BridgeEventInfo {
   /** The Ethereum block height of the most recently processed bridge event. */
 
   ethBlockHeight: Long;
+  /** The id of the bridge source the info belongs to. */
+
+  sourceId: number;
 }
 /**
  * BridgeEventInfo stores information about the most recently processed bridge
- * event.
+ * event of a bridge source.
  */
 
 export interface BridgeEventInfoSDKType {
@@ -29,12 +32,16 @@ export interface BridgeEventInfoSDKType {
   /** The Ethereum block height of the most recently processed bridge event. */
 
   eth_block_height: Long;
+  /** The id of the bridge source the info belongs to. */
+
+  source_id: number;
 }
 
 function createBaseBridgeEventInfo(): BridgeEventInfo {
   return {
     nextId: 0,
-    ethBlockHeight: Long.UZERO
+    ethBlockHeight: Long.UZERO,
+    sourceId: 0
   };
 }
 
@@ -48,6 +55,10 @@ export const BridgeEventInfo = {
       writer.uint32(16).uint64(message.ethBlockHeight);
     }
 
+    if (message.sourceId !== 0) {
+      writer.uint32(24).uint32(message.sourceId);
+    }
+
     return writer;
   },
 
@@ -68,6 +79,10 @@ export const BridgeEventInfo = {
           message.ethBlockHeight = (reader.uint64() as Long);
           break;
 
+        case 3:
+          message.sourceId = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -81,6 +96,7 @@ export const BridgeEventInfo = {
     const message = createBaseBridgeEventInfo();
     message.nextId = object.nextId ?? 0;
     message.ethBlockHeight = object.ethBlockHeight !== undefined && object.ethBlockHeight !== null ? Long.fromValue(object.ethBlockHeight) : Long.UZERO;
+    message.sourceId = object.sourceId ?? 0;
     return message;
   }
 
@@ -16,6 +16,9 @@ export interface GenesisState {
    */
 
   acknowledgedEventInfo?: BridgeEventInfo;
+  /** Acknowledged event info of each additional bridge source. */
+
+  additionalAcknowledgedEventInfos: BridgeEventInfo[];
 }
 /** GenesisState defines the bridge module's genesis state. */
 
@@ -31,6 +34,9 @@ export interface GenesisStateSDKType {
    */
 
   acknowledged_event_info?: BridgeEventInfoSDKType;
+  /** Acknowledged event info of each additional bridge source. */
+
+  additional_acknowledged_event_infos: BridgeEventInfoSDKType[];
 }
 
 function createBaseGenesisState(): GenesisState {
@@ -38,7 +44,8 @@ function createBaseGenesisState(): GenesisState {
     eventParams: undefined,
     proposeParams: undefined,
     safetyParams: undefined,
-    acknowledgedEventInfo: undefined
+    acknowledgedEventInfo: undefined,
+    additionalAcknowledgedEventInfos: []
   };
 }
 
@@ -60,6 +67,10 @@ export const GenesisState = {
       BridgeEventInfo.encode(message.acknowledgedEventInfo, writer.uint32(34).fork()).ldelim();
     }
 
+    for (const v of message.additionalAcknowledgedEventInfos) {
+      BridgeEventInfo.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -88,6 +99,10 @@ export const GenesisState = {
           message.acknowledgedEventInfo = BridgeEventInfo.decode(reader, reader.uint32());
           break;
 
+        case 5:
+          message.additionalAcknowledgedEventInfos.push(BridgeEventInfo.decode(reader, reader.uint32()));
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -103,6 +118,7 @@ export const GenesisState = {
     message.proposeParams = object.proposeParams !== undefined && object.proposeParams !== null ? ProposeParams.fromPartial(object.proposeParams) : undefined;
     message.safetyParams = object.safetyParams !== undefined && object.safetyParams !== null ? SafetyParams.fromPartial(object.safetyParams) : undefined;
     message.acknowledgedEventInfo = object.acknowledgedEventInfo !== undefined && object.acknowledgedEventInfo !== null ? BridgeEventInfo.fromPartial(object.acknowledgedEventInfo) : undefined;
+    message.additionalAcknowledgedEventInfos = object.additionalAcknowledgedEventInfos?.map(e => BridgeEventInfo.fromPartial(e)) || [];
     return message;
   }
 
@@ -3,7 +3,8 @@ import * as _m0 from "protobufjs/minimal";
 import { Long, DeepPartial } from "../../helpers";
 /**
  * EventParams stores parameters about which events to recognize and which
- * tokens to mint.
+ * tokens to mint. The denom, chain ID and contract address configure the
+ * primary bridge source, which has source id 0.
  */
 
 export interface EventParams {
@@ -15,10 +16,17 @@ export interface EventParams {
   /** The address of the Ethereum contract to monitor for logs. */
 
   ethAddress: string;
+  /**
+   * Bridge sources in addition to the primary source. Each source must have a
+   * unique non-zero id, and sources must be sorted by id in ascending order.
+   */
+
+  additionalSources: BridgeSource[];
 }
 /**
  * EventParams stores parameters about which events to recognize and which
- * tokens to mint.
+ * tokens to mint. The denom, chain ID and contract address configure the
+ * primary bridge source, which has source id 0.
  */
 
 export interface EventParamsSDKType {
@@ -29,6 +37,48 @@ export interface EventParamsSDKType {
   eth_chain_id: Long;
   /** The address of the Ethereum contract to monitor for logs. */
 
+  eth_address: string;
+  /**
+   * Bridge sources in addition to the primary source. Each source must have a
+   * unique non-zero id, and sources must be sorted by id in ascending order.
+   */
+
+  additional_sources: BridgeSourceSDKType[];
+}
+/**
+ * BridgeSource stores parameters about a bridge contract on an EVM chain whose
+ * events are recognized, and the token to mint for them.
+ */
+
+export interface BridgeSource {
+  /** The id of the source. Event ids are unique per source. */
+  id: number;
+  /** The denom of the token to mint. */
+
+  denom: string;
+  /** The numerical chain ID of the EVM chain to query. */
+
+  ethChainId: Long;
+  /** The address of the contract to monitor for logs. */
+
+  ethAddress: string;
+}
+/**
+ * BridgeSource stores parameters about a bridge contract on an EVM chain whose
+ * events are recognized, and the token to mint for them.
+ */
+
+export interface BridgeSourceSDKType {
+  /** The id of the source. Event ids are unique per source. */
+  id: number;
+  /** The denom of the token to mint. */
+
+  denom: string;
+  /** The numerical chain ID of the EVM chain to query. */
+
+  eth_chain_id: Long;
+  /** The address of the contract to monitor for logs. */
+
   eth_address: string;
 }
 /** ProposeParams stores parameters for proposing to the module. */
@@ -122,7 +172,8 @@ function createBaseEventParams(): EventParams {
   return {
     denom: "",
     ethChainId: Long.UZERO,
-    ethAddress: ""
+    ethAddress: "",
+    additionalSources: []
   };
 }
 
@@ -140,6 +191,10 @@ export const EventParams = {
       writer.uint32(26).string(message.ethAddress);
     }
 
+    for (const v of message.additionalSources) {
+      BridgeSource.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -164,6 +219,10 @@ export const EventParams = {
           message.ethAddress = reader.string();
           break;
 
+        case 4:
+          message.additionalSources.push(BridgeSource.decode(reader, reader.uint32()));
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -178,6 +237,82 @@ export const EventParams = {
     message.denom = object.denom ?? "";
     message.ethChainId = object.ethChainId !== undefined && object.ethChainId !== null ? Long.fromValue(object.ethChainId) : Long.UZERO;
     message.ethAddress = object.ethAddress ?? "";
+    message.additionalSources = object.additionalSources?.map(e => BridgeSource.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseBridgeSource(): BridgeSource {
+  return {
+    id: 0,
+    denom: "",
+    ethChainId: Long.UZERO,
+    ethAddress: ""
+  };
+}
+
+export const BridgeSource = {
+  encode(message: BridgeSource, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+
+    if (message.denom !== "") {
+      writer.uint32(18).string(message.denom);
+    }
+
+    if (!message.ethChainId.isZero()) {
+      writer.uint32(24).uint64(message.ethChainId);
+    }
+
+    if (message.ethAddress !== "") {
+      writer.uint32(34).string(message.ethAddress);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BridgeSource {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBridgeSource();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.id = reader.uint32();
+          break;
+
+        case 2:
+          message.denom = reader.string();
+          break;
+
+        case 3:
+          message.ethChainId = (reader.uint64() as Long);
+          break;
+
+        case 4:
+          message.ethAddress = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<BridgeSource>): BridgeSource {
+    const message = createBaseBridgeSource();
+    message.id = object.id ?? 0;
+    message.denom = object.denom ?? "";
+    message.ethChainId = object.ethChainId !== undefined && object.ethChainId !== null ? Long.fromValue(object.ethChainId) : Long.UZERO;
+    message.ethAddress = object.ethAddress ?? "";
     return message;
   }
 
@@ -42,18 +42,34 @@ export class LCDQueryClient {
    in-state. */
 
 
-  async acknowledgedEventInfo(_params: QueryAcknowledgedEventInfoRequest = {}): Promise<QueryAcknowledgedEventInfoResponseSDKType> {
+  async acknowledgedEventInfo(params: QueryAcknowledgedEventInfoRequest): Promise<QueryAcknowledgedEventInfoResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.sourceId !== "undefined") {
+      options.params.source_id = params.sourceId;
+    }
+
     const endpoint = `dydxprotocol/v4/bridge/acknowledged_event_info`;
-    return await this.req.get<QueryAcknowledgedEventInfoResponseSDKType>(endpoint);
+    return await this.req.get<QueryAcknowledgedEventInfoResponseSDKType>(endpoint, options);
   }
   /* Queries the RecognizedEventInfo.
    A "recognized" event is one that is finalized on the Ethereum blockchain
    and has been identified by the queried node. It is not yet in-consensus. */
 
 
-  async recognizedEventInfo(_params: QueryRecognizedEventInfoRequest = {}): Promise<QueryRecognizedEventInfoResponseSDKType> {
+  async recognizedEventInfo(params: QueryRecognizedEventInfoRequest): Promise<QueryRecognizedEventInfoResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.sourceId !== "undefined") {
+      options.params.source_id = params.sourceId;
+    }
+
     const endpoint = `dydxprotocol/v4/bridge/recognized_event_info`;
-    return await this.req.get<QueryRecognizedEventInfoResponseSDKType>(endpoint);
+    return await this.req.get<QueryRecognizedEventInfoResponseSDKType>(endpoint, options);
   }
   /* Queries all `MsgCompleteBridge` messages that are delayed (not yet
    executed) and corresponding block heights at which they will execute. */
@@ -19,14 +19,14 @@ export interface Query {
    * in-state.
    */
 
-  acknowledgedEventInfo(request?: QueryAcknowledgedEventInfoRequest): Promise<QueryAcknowledgedEventInfoResponse>;
+  acknowledgedEventInfo(request: QueryAcknowledgedEventInfoRequest): Promise<QueryAcknowledgedEventInfoResponse>;
   /**
    * Queries the RecognizedEventInfo.
    * A "recognized" event is one that is finalized on the Ethereum blockchain
    * and has been identified by the queried node. It is not yet in-consensus.
    */
 
-  recognizedEventInfo(request?: QueryRecognizedEventInfoRequest): Promise<QueryRecognizedEventInfoResponse>;
+  recognizedEventInfo(request: QueryRecognizedEventInfoRequest): Promise<QueryRecognizedEventInfoResponse>;
   /**
    * Queries all `MsgCompleteBridge` messages that are delayed (not yet
    * executed) and corresponding block heights at which they will execute.
@@ -65,13 +65,13 @@ export class QueryClientImpl implements Query {
     return promise.then(data => QuerySafetyParamsResponse.decode(new _m0.Reader(data)));
   }
 
-  acknowledgedEventInfo(request: QueryAcknowledgedEventInfoRequest = {}): Promise<QueryAcknowledgedEventInfoResponse> {
+  acknowledgedEventInfo(request: QueryAcknowledgedEventInfoRequest): Promise<QueryAcknowledgedEventInfoResponse> {
     const data = QueryAcknowledgedEventInfoRequest.encode(request).finish();
     const promise = this.rpc.request("dydxprotocol.bridge.Query", "AcknowledgedEventInfo", data);
     return promise.then(data => QueryAcknowledgedEventInfoResponse.decode(new _m0.Reader(data)));
   }
 
-  recognizedEventInfo(request: QueryRecognizedEventInfoRequest = {}): Promise<QueryRecognizedEventInfoResponse> {
+  recognizedEventInfo(request: QueryRecognizedEventInfoRequest): Promise<QueryRecognizedEventInfoResponse> {
     const data = QueryRecognizedEventInfoRequest.encode(request).finish();
     const promise = this.rpc.request("dydxprotocol.bridge.Query", "RecognizedEventInfo", data);
     return promise.then(data => QueryRecognizedEventInfoResponse.decode(new _m0.Reader(data)));
@@ -100,11 +100,11 @@ export const createRpcQueryExtension = (base: QueryClient) => {
       return queryService.safetyParams(request);
     },
 
-    acknowledgedEventInfo(request?: QueryAcknowledgedEventInfoRequest): Promise<QueryAcknowledgedEventInfoResponse> {
+    acknowledgedEventInfo(request: QueryAcknowledgedEventInfoRequest): Promise<QueryAcknowledgedEventInfoResponse> {
       return queryService.acknowledgedEventInfo(request);
     },
 
-    recognizedEventInfo(request?: QueryRecognizedEventInfoRequest): Promise<QueryRecognizedEventInfoResponse> {
+    recognizedEventInfo(request: QueryRecognizedEventInfoRequest): Promise<QueryRecognizedEventInfoResponse> {
       return queryService.recognizedEventInfo(request);
     },
 
@@ -62,13 +62,19 @@ export interface QuerySafetyParamsResponseSDKType {
  * AcknowledgedEventInfo RPC method.
  */
 
-export interface QueryAcknowledgedEventInfoRequest {}
+export interface QueryAcknowledgedEventInfoRequest {
+  /** The id of the bridge source. Defaults to the primary source. */
+  sourceId: number;
+}
 /**
  * QueryAcknowledgedEventInfoRequest is a request type for the
  * AcknowledgedEventInfo RPC method.
  */
 
-export interface QueryAcknowledgedEventInfoRequestSDKType {}
+export interface QueryAcknowledgedEventInfoRequestSDKType {
+  /** The id of the bridge source. Defaults to the primary source. */
+  source_id: number;
+}
 /**
  * QueryAcknowledgedEventInfoResponse is a response type for the
  * AcknowledgedEventInfo RPC method.
@@ -90,13 +96,19 @@ export interface QueryAcknowledgedEventInfoResponseSDKType {
  * RecognizedEventInfo RPC method.
  */
 
-export interface QueryRecognizedEventInfoRequest {}
+export interface QueryRecognizedEventInfoRequest {
+  /** The id of the bridge source. Defaults to the primary source. */
+  sourceId: number;
+}
 /**
  * QueryRecognizedEventInfoRequest is a request type for the
  * RecognizedEventInfo RPC method.
  */
 
-export interface QueryRecognizedEventInfoRequestSDKType {}
+export interface QueryRecognizedEventInfoRequestSDKType {
+  /** The id of the bridge source. Defaults to the primary source. */
+  source_id: number;
+}
 /**
  * QueryRecognizedEventInfoResponse is a response type for the
  * RecognizedEventInfo RPC method.
@@ -412,11 +424,17 @@ export const QuerySafetyParamsResponse = {
 };
 
 function createBaseQueryAcknowledgedEventInfoRequest(): QueryAcknowledgedEventInfoRequest {
-  return {};
+  return {
+    sourceId: 0
+  };
 }
 
 export const QueryAcknowledgedEventInfoRequest = {
-  encode(_: QueryAcknowledgedEventInfoRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+  encode(message: QueryAcknowledgedEventInfoRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.sourceId !== 0) {
+      writer.uint32(8).uint32(message.sourceId);
+    }
+
     return writer;
   },
 
@@ -429,6 +447,10 @@ export const QueryAcknowledgedEventInfoRequest = {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
+        case 1:
+          message.sourceId = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -438,8 +460,9 @@ export const QueryAcknowledgedEventInfoRequest = {
     return message;
   },
 
-  fromPartial(_: DeepPartial<QueryAcknowledgedEventInfoRequest>): QueryAcknowledgedEventInfoRequest {
+  fromPartial(object: DeepPartial<QueryAcknowledgedEventInfoRequest>): QueryAcknowledgedEventInfoRequest {
     const message = createBaseQueryAcknowledgedEventInfoRequest();
+    message.sourceId = object.sourceId ?? 0;
     return message;
   }
 
@@ -491,11 +514,17 @@ export const QueryAcknowledgedEventInfoResponse = {
 };
 
 function createBaseQueryRecognizedEventInfoRequest(): QueryRecognizedEventInfoRequest {
-  return {};
+  return {
+    sourceId: 0
+  };
 }
 
 export const QueryRecognizedEventInfoRequest = {
-  encode(_: QueryRecognizedEventInfoRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+  encode(message: QueryRecognizedEventInfoRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.sourceId !== 0) {
+      writer.uint32(8).uint32(message.sourceId);
+    }
+
     return writer;
   },
 
@@ -508,6 +537,10 @@ export const QueryRecognizedEventInfoRequest = {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
+        case 1:
+          message.sourceId = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -517,8 +550,9 @@ export const QueryRecognizedEventInfoRequest = {
     return message;
   },
 
-  fromPartial(_: DeepPartial<QueryRecognizedEventInfoRequest>): QueryRecognizedEventInfoRequest {
+  fromPartial(object: DeepPartial<QueryRecognizedEventInfoRequest>): QueryRecognizedEventInfoRequest {
     const message = createBaseQueryRecognizedEventInfoRequest();
+    message.sourceId = object.sourceId ?? 0;
     return message;
   }
 
@@ -10,20 +10,21 @@ export enum ClobPair_Status {
   STATUS_ACTIVE = 1,
 
   /**
-   * STATUS_PAUSED - STATUS_PAUSED behavior is unfinalized.
-   * TODO(DEC-600): update this documentation.
+   * STATUS_PAUSED - STATUS_PAUSED represents a paused clob pair. Clob pairs in this
+   * state do not accept order placements or cancellations, and are
+   * skipped by liquidations, deleveraging and conditional order triggering.
    */
   STATUS_PAUSED = 2,
 
   /**
-   * STATUS_CANCEL_ONLY - STATUS_CANCEL_ONLY behavior is unfinalized.
-   * TODO(DEC-600): update this documentation.
+   * STATUS_CANCEL_ONLY - STATUS_CANCEL_ONLY represents a clob pair which only accepts
+   * order cancellations. No orders may be placed or matched.
    */
   STATUS_CANCEL_ONLY = 3,
 
   /**
-   * STATUS_POST_ONLY - STATUS_POST_ONLY behavior is unfinalized.
-   * TODO(DEC-600): update this documentation.
+   * STATUS_POST_ONLY - STATUS_POST_ONLY represents a clob pair which only accepts
+   * post-only orders and cancellations. No orders may be matched.
    */
   STATUS_POST_ONLY = 4,
 
@@ -45,20 +46,21 @@ export enum ClobPair_StatusSDKType {
   STATUS_ACTIVE = 1,
 
   /**
-   * STATUS_PAUSED - STATUS_PAUSED behavior is unfinalized.
-   * TODO(DEC-600): update this documentation.
+   * STATUS_PAUSED - STATUS_PAUSED represents a paused clob pair. Clob pairs in this
+   * state do not accept order placements or cancellations, and are
+   * skipped by liquidations, deleveraging and conditional order triggering.
    */
   STATUS_PAUSED = 2,
 
   /**
-   * STATUS_CANCEL_ONLY - STATUS_CANCEL_ONLY behavior is unfinalized.
-   * TODO(DEC-600): update this documentation.
+   * STATUS_CANCEL_ONLY - STATUS_CANCEL_ONLY represents a clob pair which only accepts
+   * order cancellations. No orders may be placed or matched.
    */
   STATUS_CANCEL_ONLY = 3,
 
   /**
-   * STATUS_POST_ONLY - STATUS_POST_ONLY behavior is unfinalized.
-   * TODO(DEC-600): update this documentation.
+   * STATUS_POST_ONLY - STATUS_POST_ONLY represents a clob pair which only accepts
+   * post-only orders and cancellations. No orders may be matched.
    */
   STATUS_POST_ONLY = 4,
 
@@ -208,6 +208,16 @@ export enum Order_ConditionType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a distance of
+   * `conditional_order_trailing_percent_ppm` whenever the oracle price moves
+   * up for sells or down for buys. The trigger price never moves away from
+   * the oracle price.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export enum Order_ConditionTypeSDKType {
@@ -230,6 +240,16 @@ export enum Order_ConditionTypeSDKType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a distance of
+   * `conditional_order_trailing_percent_ppm` whenever the oracle price moves
+   * up for sells or down for buys. The trigger price never moves away from
+   * the oracle price.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export function order_ConditionTypeFromJSON(object: any): Order_ConditionType {
@@ -246,6 +266,10 @@ export function order_ConditionTypeFromJSON(object: any): Order_ConditionType {
     case "CONDITION_TYPE_TAKE_PROFIT":
       return Order_ConditionType.CONDITION_TYPE_TAKE_PROFIT;
 
+    case 3:
+    case "CONDITION_TYPE_TRAILING_STOP":
+      return Order_ConditionType.CONDITION_TYPE_TRAILING_STOP;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -263,11 +287,138 @@ export function order_ConditionTypeToJSON(object: Order_ConditionType): string {
     case Order_ConditionType.CONDITION_TYPE_TAKE_PROFIT:
       return "CONDITION_TYPE_TAKE_PROFIT";
 
+    case Order_ConditionType.CONDITION_TYPE_TRAILING_STOP:
+      return "CONDITION_TYPE_TRAILING_STOP";
+
     case Order_ConditionType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
   }
 }
+export enum Order_SelfTradePrevention {
+  /**
+   * SELF_TRADE_PREVENTION_UNSPECIFIED - SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior where
+   * the resting maker order is canceled and matching continues.
+   */
+  SELF_TRADE_PREVENTION_UNSPECIFIED = 0,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_MAKER - SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting maker order and
+   * continues matching the taker order.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_MAKER = 1,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_TAKER - SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+   * taker order and leaves the maker order on the book.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_TAKER = 2,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_BOTH - SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting maker order
+   * and the remaining size of the taker order.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_BOTH = 3,
+
+  /**
+   * SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL - SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting
+   * maker order. If the maker order is smaller than the remaining size of the
+   * taker order, the taker order is decremented by the remaining size of the
+   * maker order and matching continues, but any size left after matching is
+   * canceled instead of being placed on the book. Otherwise the taker order is
+   * canceled as well. Decremented orders never rest on the book, since the
+   * decremented size is not recorded in state.
+   */
+  SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL = 4,
+  UNRECOGNIZED = -1,
+}
+export enum Order_SelfTradePreventionSDKType {
+  /**
+   * SELF_TRADE_PREVENTION_UNSPECIFIED - SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior where
+   * the resting maker order is canceled and matching continues.
+   */
+  SELF_TRADE_PREVENTION_UNSPECIFIED = 0,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_MAKER - SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting maker order and
+   * continues matching the taker order.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_MAKER = 1,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_TAKER - SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+   * taker order and leaves the maker order on the book.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_TAKER = 2,
+
+  /**
+   * SELF_TRADE_PREVENTION_CANCEL_BOTH - SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting maker order
+   * and the remaining size of the taker order.
+   */
+  SELF_TRADE_PREVENTION_CANCEL_BOTH = 3,
+
+  /**
+   * SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL - SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting
+   * maker order. If the maker order is smaller than the remaining size of the
+   * taker order, the taker order is decremented by the remaining size of the
+   * maker order and matching continues, but any size left after matching is
+   * canceled instead of being placed on the book. Otherwise the taker order is
+   * canceled as well. Decremented orders never rest on the book, since the
+   * decremented size is not recorded in state.
+   */
+  SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL = 4,
+  UNRECOGNIZED = -1,
+}
+export function order_SelfTradePreventionFromJSON(object: any): Order_SelfTradePrevention {
+  switch (object) {
+    case 0:
+    case "SELF_TRADE_PREVENTION_UNSPECIFIED":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED;
+
+    case 1:
+    case "SELF_TRADE_PREVENTION_CANCEL_MAKER":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_MAKER;
+
+    case 2:
+    case "SELF_TRADE_PREVENTION_CANCEL_TAKER":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_TAKER;
+
+    case 3:
+    case "SELF_TRADE_PREVENTION_CANCEL_BOTH":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_BOTH;
+
+    case 4:
+    case "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL":
+      return Order_SelfTradePrevention.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Order_SelfTradePrevention.UNRECOGNIZED;
+  }
+}
+export function order_SelfTradePreventionToJSON(object: Order_SelfTradePrevention): string {
+  switch (object) {
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_UNSPECIFIED:
+      return "SELF_TRADE_PREVENTION_UNSPECIFIED";
+
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_MAKER:
+      return "SELF_TRADE_PREVENTION_CANCEL_MAKER";
+
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_TAKER:
+      return "SELF_TRADE_PREVENTION_CANCEL_TAKER";
+
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_CANCEL_BOTH:
+      return "SELF_TRADE_PREVENTION_CANCEL_BOTH";
+
+    case Order_SelfTradePrevention.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL:
+      return "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL";
+
+    case Order_SelfTradePrevention.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 /** OrderId refers to a single order belonging to a Subaccount. */
 
 export interface OrderId {
@@ -603,6 +754,35 @@ export interface Order {
    */
 
   conditionalOrderTriggerSubticks: Long;
+  /**
+   * The self-trade prevention mode applied when this order matches as a taker
+   * against a maker order from the same subaccount.
+   */
+
+  selfTradePrevention: Order_SelfTradePrevention;
+  /**
+   * If true, self-trade prevention is applied to maker orders from any
+   * subaccount owned by the same address as this order's subaccount, instead
+   * of only maker orders from the same subaccount.
+   */
+
+  selfTradePreventionOwnerLevel: boolean;
+  /**
+   * conditional_order_trailing_percent_ppm is the distance, in parts per
+   * million of the oracle price, that the trigger price of a trailing stop
+   * order is kept from the oracle price. Must be nonzero and less than one
+   * million if the condition_type is CONDITION_TYPE_TRAILING_STOP, and must be
+   * 0 otherwise.
+   */
+
+  conditionalOrderTrailingPercentPpm: number;
+  /**
+   * one_cancels_other_order_id is the id of an existing stateful order of the
+   * same subaccount and clob pair that this order is paired with. When either
+   * order of the pair is triggered or filled, the other order is canceled.
+   */
+
+  oneCancelsOtherOrderId?: OrderId;
 }
 /**
  * Order represents a single order belonging to a `Subaccount`
@@ -673,6 +853,35 @@ export interface OrderSDKType {
    */
 
   conditional_order_trigger_subticks: Long;
+  /**
+   * The self-trade prevention mode applied when this order matches as a taker
+   * against a maker order from the same subaccount.
+   */
+
+  self_trade_prevention: Order_SelfTradePreventionSDKType;
+  /**
+   * If true, self-trade prevention is applied to maker orders from any
+   * subaccount owned by the same address as this order's subaccount, instead
+   * of only maker orders from the same subaccount.
+   */
+
+  self_trade_prevention_owner_level: boolean;
+  /**
+   * conditional_order_trailing_percent_ppm is the distance, in parts per
+   * million of the oracle price, that the trigger price of a trailing stop
+   * order is kept from the oracle price. Must be nonzero and less than one
+   * million if the condition_type is CONDITION_TYPE_TRAILING_STOP, and must be
+   * 0 otherwise.
+   */
+
+  conditional_order_trailing_percent_ppm: number;
+  /**
+   * one_cancels_other_order_id is the id of an existing stateful order of the
+   * same subaccount and clob pair that this order is paired with. When either
+   * order of the pair is triggered or filled, the other order is canceled.
+   */
+
+  one_cancels_other_order_id?: OrderIdSDKType;
 }
 /**
  * TransactionOrdering represents a unique location in the block where a
@@ -1100,7 +1309,11 @@ function createBaseOrder(): Order {
     reduceOnly: false,
     clientMetadata: 0,
     conditionType: 0,
-    conditionalOrderTriggerSubticks: Long.UZERO
+    conditionalOrderTriggerSubticks: Long.UZERO,
+    selfTradePrevention: 0,
+    selfTradePreventionOwnerLevel: false,
+    conditionalOrderTrailingPercentPpm: 0,
+    oneCancelsOtherOrderId: undefined
   };
 }
 
@@ -1150,6 +1363,22 @@ export const Order = {
       writer.uint32(88).uint64(message.conditionalOrderTriggerSubticks);
     }
 
+    if (message.selfTradePrevention !== 0) {
+      writer.uint32(96).int32(message.selfTradePrevention);
+    }
+
+    if (message.selfTradePreventionOwnerLevel === true) {
+      writer.uint32(104).bool(message.selfTradePreventionOwnerLevel);
+    }
+
+    if (message.conditionalOrderTrailingPercentPpm !== 0) {
+      writer.uint32(112).uint32(message.conditionalOrderTrailingPercentPpm);
+    }
+
+    if (message.oneCancelsOtherOrderId !== undefined) {
+      OrderId.encode(message.oneCancelsOtherOrderId, writer.uint32(122).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -1206,6 +1435,22 @@ export const Order = {
           message.conditionalOrderTriggerSubticks = (reader.uint64() as Long);
           break;
 
+        case 12:
+          message.selfTradePrevention = (reader.int32() as any);
+          break;
+
+        case 13:
+          message.selfTradePreventionOwnerLevel = reader.bool();
+          break;
+
+        case 14:
+          message.conditionalOrderTrailingPercentPpm = reader.uint32();
+          break;
+
+        case 15:
+          message.oneCancelsOtherOrderId = OrderId.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -1228,6 +1473,10 @@ export const Order = {
     message.clientMetadata = object.clientMetadata ?? 0;
     message.conditionType = object.conditionType ?? 0;
     message.conditionalOrderTriggerSubticks = object.conditionalOrderTriggerSubticks !== undefined && object.conditionalOrderTriggerSubticks !== null ? Long.fromValue(object.conditionalOrderTriggerSubticks) : Long.UZERO;
+    message.selfTradePrevention = object.selfTradePrevention ?? 0;
+    message.selfTradePreventionOwnerLevel = object.selfTradePreventionOwnerLevel ?? false;
+    message.conditionalOrderTrailingPercentPpm = object.conditionalOrderTrailingPercentPpm ?? 0;
+    message.oneCancelsOtherOrderId = object.oneCancelsOtherOrderId !== undefined && object.oneCancelsOtherOrderId !== null ? OrderId.fromPartial(object.oneCancelsOtherOrderId) : undefined;
     return message;
   }
 
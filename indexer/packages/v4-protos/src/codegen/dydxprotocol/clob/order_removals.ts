@@ -197,18 +197,35 @@ export function orderRemoval_RemovalReasonToJSON(object: OrderRemoval_RemovalRea
 export interface OrderRemoval {
   orderId?: OrderId;
   removalReason: OrderRemoval_RemovalReason;
+  /**
+   * The ID of the order on the other side of the self-trade if the removal
+   * reason is REMOVAL_REASON_INVALID_SELF_TRADE. Not set for any other removal
+   * reason, or if the other side of the self-trade is a liquidation order.
+   * Short-Term orders must be placed earlier in the same operations queue.
+   */
+
+  selfTradeOrderId?: OrderId;
 }
 /** OrderRemoval is a request type used for forced removal of stateful orders. */
 
 export interface OrderRemovalSDKType {
   order_id?: OrderIdSDKType;
   removal_reason: OrderRemoval_RemovalReasonSDKType;
+  /**
+   * The ID of the order on the other side of the self-trade if the removal
+   * reason is REMOVAL_REASON_INVALID_SELF_TRADE. Not set for any other removal
+   * reason, or if the other side of the self-trade is a liquidation order.
+   * Short-Term orders must be placed earlier in the same operations queue.
+   */
+
+  self_trade_order_id?: OrderIdSDKType;
 }
 
 function createBaseOrderRemoval(): OrderRemoval {
   return {
     orderId: undefined,
-    removalReason: 0
+    removalReason: 0,
+    selfTradeOrderId: undefined
   };
 }
 
@@ -222,6 +239,10 @@ export const OrderRemoval = {
       writer.uint32(16).int32(message.removalReason);
     }
 
+    if (message.selfTradeOrderId !== undefined) {
+      OrderId.encode(message.selfTradeOrderId, writer.uint32(26).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -242,6 +263,10 @@ export const OrderRemoval = {
           message.removalReason = (reader.int32() as any);
           break;
 
+        case 3:
+          message.selfTradeOrderId = OrderId.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -255,6 +280,7 @@ export const OrderRemoval = {
     const message = createBaseOrderRemoval();
     message.orderId = object.orderId !== undefined && object.orderId !== null ? OrderId.fromPartial(object.orderId) : undefined;
     message.removalReason = object.removalReason ?? 0;
+    message.selfTradeOrderId = object.selfTradeOrderId !== undefined && object.selfTradeOrderId !== null ? OrderId.fromPartial(object.selfTradeOrderId) : undefined;
     return message;
   }
 
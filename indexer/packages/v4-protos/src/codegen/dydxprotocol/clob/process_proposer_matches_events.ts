@@ -12,6 +12,7 @@ import { DeepPartial } from "../../helpers";
  * - Stateful order IDs forcefully removed in the last block.
  * - Conditional order IDs triggered in the last block.
  * - Conditional order IDs placed, but not triggered in the last block.
+ * - Long term order IDs that were replaced in the last block.
  * - The height of the block in which the events occurred.
  */
 
@@ -24,6 +25,7 @@ export interface ProcessProposerMatchesEvents {
   conditionalOrderIdsTriggeredInLastBlock: OrderId[];
   placedConditionalOrderIds: OrderId[];
   blockHeight: number;
+  replacedLongTermOrderIds: OrderId[];
 }
 /**
  * ProcessProposerMatchesEvents is used for communicating which events occurred
@@ -36,6 +38,7 @@ export interface ProcessProposerMatchesEvents {
  * - Stateful order IDs forcefully removed in the last block.
  * - Conditional order IDs triggered in the last block.
  * - Conditional order IDs placed, but not triggered in the last block.
+ * - Long term order IDs that were replaced in the last block.
  * - The height of the block in which the events occurred.
  */
 
@@ -48,6 +51,7 @@ export interface ProcessProposerMatchesEventsSDKType {
   conditional_order_ids_triggered_in_last_block: OrderIdSDKType[];
   placed_conditional_order_ids: OrderIdSDKType[];
   block_height: number;
+  replaced_long_term_order_ids: OrderIdSDKType[];
 }
 
 function createBaseProcessProposerMatchesEvents(): ProcessProposerMatchesEvents {
@@ -59,7 +63,8 @@ function createBaseProcessProposerMatchesEvents(): ProcessProposerMatchesEvents
     removedStatefulOrderIds: [],
     conditionalOrderIdsTriggeredInLastBlock: [],
     placedConditionalOrderIds: [],
-    blockHeight: 0
+    blockHeight: 0,
+    replacedLongTermOrderIds: []
   };
 }
 
@@ -97,6 +102,10 @@ export const ProcessProposerMatchesEvents = {
       writer.uint32(64).uint32(message.blockHeight);
     }
 
+    for (const v of message.replacedLongTermOrderIds) {
+      OrderId.encode(v!, writer.uint32(74).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -141,6 +150,10 @@ export const ProcessProposerMatchesEvents = {
           message.blockHeight = reader.uint32();
           break;
 
+        case 9:
+          message.replacedLongTermOrderIds.push(OrderId.decode(reader, reader.uint32()));
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -160,6 +173,7 @@ export const ProcessProposerMatchesEvents = {
     message.conditionalOrderIdsTriggeredInLastBlock = object.conditionalOrderIdsTriggeredInLastBlock?.map(e => OrderId.fromPartial(e)) || [];
     message.placedConditionalOrderIds = object.placedConditionalOrderIds?.map(e => OrderId.fromPartial(e)) || [];
     message.blockHeight = object.blockHeight ?? 0;
+    message.replacedLongTermOrderIds = object.replacedLongTermOrderIds?.map(e => OrderId.fromPartial(e)) || [];
     return message;
   }
 
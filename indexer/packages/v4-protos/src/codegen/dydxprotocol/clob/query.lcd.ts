@@ -1,6 +1,6 @@
 import { setPaginationParams } from "../../helpers";
 import { LCDClient } from "@osmonauts/lcd";
-import { QueryGetClobPairRequest, QueryClobPairResponseSDKType, QueryAllClobPairRequest, QueryClobPairAllResponseSDKType, QueryEquityTierLimitConfigurationRequest, QueryEquityTierLimitConfigurationResponseSDKType } from "./query";
+import { QueryGetClobPairRequest, QueryClobPairResponseSDKType, QueryAllClobPairRequest, QueryClobPairAllResponseSDKType, QueryEquityTierLimitConfigurationRequest, QueryEquityTierLimitConfigurationResponseSDKType, QueryOrderbookDepthRequest, QueryOrderbookDepthResponseSDKType, QuerySubaccountOpenOrdersRequest, QuerySubaccountOpenOrdersResponseSDKType, QueryOrderFillStateRequest, QueryOrderFillStateResponseSDKType, QueryInsuranceFundBalancesRequest, QueryInsuranceFundBalancesResponseSDKType } from "./query";
 export class LCDQueryClient {
   req: LCDClient;
 
@@ -13,6 +13,10 @@ export class LCDQueryClient {
     this.clobPair = this.clobPair.bind(this);
     this.clobPairAll = this.clobPairAll.bind(this);
     this.equityTierLimitConfiguration = this.equityTierLimitConfiguration.bind(this);
+    this.orderbookDepth = this.orderbookDepth.bind(this);
+    this.subaccountOpenOrders = this.subaccountOpenOrders.bind(this);
+    this.orderFillState = this.orderFillState.bind(this);
+    this.insuranceFundBalances = this.insuranceFundBalances.bind(this);
   }
   /* Queries a ClobPair by id. */
 
@@ -45,5 +49,42 @@ export class LCDQueryClient {
     const endpoint = `dydxprotocol/clob/equity_tier`;
     return await this.req.get<QueryEquityTierLimitConfigurationResponseSDKType>(endpoint);
   }
+  /* Queries the aggregated depth of the in-memory orderbook for a ClobPair. */
+
+
+  async orderbookDepth(params: QueryOrderbookDepthRequest): Promise<QueryOrderbookDepthResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.depth !== "undefined") {
+      options.params.depth = params.depth;
+    }
+
+    const endpoint = `dydxprotocol/clob/orderbook_depth/${params.clobPairId}`;
+    return await this.req.get<QueryOrderbookDepthResponseSDKType>(endpoint, options);
+  }
+  /* Queries the orders of a subaccount resting on the in-memory orderbooks. */
+
+
+  async subaccountOpenOrders(params: QuerySubaccountOpenOrdersRequest): Promise<QuerySubaccountOpenOrdersResponseSDKType> {
+    const endpoint = `dydxprotocol/clob/open_orders/${params.owner}/${params.number}`;
+    return await this.req.get<QuerySubaccountOpenOrdersResponseSDKType>(endpoint);
+  }
+  /* Queries the fill state of an order. */
+
+
+  async orderFillState(params: QueryOrderFillStateRequest): Promise<QueryOrderFillStateResponseSDKType> {
+    const endpoint = `dydxprotocol/clob/order_fill_state/${params.orderId.subaccountId.owner}/${params.orderId.subaccountId.number}/${params.orderId.clientId}`;
+    return await this.req.get<QueryOrderFillStateResponseSDKType>(endpoint);
+  }
+  /* Queries the balance of each insurance fund along with the perpetuals that
+   it backs. */
+
+
+  async insuranceFundBalances(_params: QueryInsuranceFundBalancesRequest = {}): Promise<QueryInsuranceFundBalancesResponseSDKType> {
+    const endpoint = `dydxprotocol/clob/insurance_fund_balances`;
+    return await this.req.get<QueryInsuranceFundBalancesResponseSDKType>(endpoint);
+  }
 
 }
\ No newline at end of file
@@ -1,7 +1,7 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
 import { QueryClient, createProtobufRpcClient } from "@cosmjs/stargate";
-import { QueryGetClobPairRequest, QueryClobPairResponse, QueryAllClobPairRequest, QueryClobPairAllResponse, AreSubaccountsLiquidatableRequest, AreSubaccountsLiquidatableResponse, MevNodeToNodeCalculationRequest, MevNodeToNodeCalculationResponse, QueryEquityTierLimitConfigurationRequest, QueryEquityTierLimitConfigurationResponse } from "./query";
+import { QueryGetClobPairRequest, QueryClobPairResponse, QueryAllClobPairRequest, QueryClobPairAllResponse, AreSubaccountsLiquidatableRequest, AreSubaccountsLiquidatableResponse, MevNodeToNodeCalculationRequest, MevNodeToNodeCalculationResponse, QueryEquityTierLimitConfigurationRequest, QueryEquityTierLimitConfigurationResponse, QueryOrderbookDepthRequest, QueryOrderbookDepthResponse, QuerySubaccountOpenOrdersRequest, QuerySubaccountOpenOrdersResponse, QueryOrderFillStateRequest, QueryOrderFillStateResponse, QueryInsuranceFundBalancesRequest, QueryInsuranceFundBalancesResponse, QuerySimulateLiquidationRequest, QuerySimulateLiquidationResponse, StreamOrderbookUpdatesRequest, StreamOrderbookUpdatesResponse } from "./query";
 /** Query defines the gRPC querier service. */
 
 export interface Query {
@@ -19,6 +19,36 @@ export interface Query {
   /** Queries EquityTierLimitConfiguration. */
 
   equityTierLimitConfiguration(request?: QueryEquityTierLimitConfigurationRequest): Promise<QueryEquityTierLimitConfigurationResponse>;
+  /** Queries the aggregated depth of the in-memory orderbook for a ClobPair. */
+
+  orderbookDepth(request: QueryOrderbookDepthRequest): Promise<QueryOrderbookDepthResponse>;
+  /** Queries the orders of a subaccount resting on the in-memory orderbooks. */
+
+  subaccountOpenOrders(request: QuerySubaccountOpenOrdersRequest): Promise<QuerySubaccountOpenOrdersResponse>;
+  /** Queries the fill state of an order. */
+
+  orderFillState(request: QueryOrderFillStateRequest): Promise<QueryOrderFillStateResponse>;
+  /**
+   * Queries the balance of each insurance fund along with the perpetuals that
+   * it backs.
+   */
+
+  insuranceFundBalances(request?: QueryInsuranceFundBalancesRequest): Promise<QueryInsuranceFundBalancesResponse>;
+  /**
+   * Simulates the margin requirements of a subaccount along with the
+   * liquidation, bankruptcy and fillable prices of its perpetual positions
+   * after hypothetical changes to its positions and to oracle prices. The
+   * simulation never writes to state.
+   */
+
+  simulateLiquidation(request: QuerySimulateLiquidationRequest): Promise<QuerySimulateLiquidationResponse>;
+  /**
+   * Streams orderbook updates for a set of clob pairs. The first response on
+   * the stream is a snapshot of the orderbooks, followed by incremental
+   * updates.
+   */
+
+  streamOrderbookUpdates(request: StreamOrderbookUpdatesRequest): Promise<StreamOrderbookUpdatesResponse>;
 }
 export class QueryClientImpl implements Query {
   private readonly rpc: Rpc;
@@ -30,6 +60,12 @@ export class QueryClientImpl implements Query {
     this.areSubaccountsLiquidatable = this.areSubaccountsLiquidatable.bind(this);
     this.mevNodeToNodeCalculation = this.mevNodeToNodeCalculation.bind(this);
     this.equityTierLimitConfiguration = this.equityTierLimitConfiguration.bind(this);
+    this.orderbookDepth = this.orderbookDepth.bind(this);
+    this.subaccountOpenOrders = this.subaccountOpenOrders.bind(this);
+    this.orderFillState = this.orderFillState.bind(this);
+    this.insuranceFundBalances = this.insuranceFundBalances.bind(this);
+    this.simulateLiquidation = this.simulateLiquidation.bind(this);
+    this.streamOrderbookUpdates = this.streamOrderbookUpdates.bind(this);
   }
 
   clobPair(request: QueryGetClobPairRequest): Promise<QueryClobPairResponse> {
@@ -64,6 +100,42 @@ export class QueryClientImpl implements Query {
     return promise.then(data => QueryEquityTierLimitConfigurationResponse.decode(new _m0.Reader(data)));
   }
 
+  orderbookDepth(request: QueryOrderbookDepthRequest): Promise<QueryOrderbookDepthResponse> {
+    const data = QueryOrderbookDepthRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Query", "OrderbookDepth", data);
+    return promise.then(data => QueryOrderbookDepthResponse.decode(new _m0.Reader(data)));
+  }
+
+  subaccountOpenOrders(request: QuerySubaccountOpenOrdersRequest): Promise<QuerySubaccountOpenOrdersResponse> {
+    const data = QuerySubaccountOpenOrdersRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Query", "SubaccountOpenOrders", data);
+    return promise.then(data => QuerySubaccountOpenOrdersResponse.decode(new _m0.Reader(data)));
+  }
+
+  orderFillState(request: QueryOrderFillStateRequest): Promise<QueryOrderFillStateResponse> {
+    const data = QueryOrderFillStateRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Query", "OrderFillState", data);
+    return promise.then(data => QueryOrderFillStateResponse.decode(new _m0.Reader(data)));
+  }
+
+  insuranceFundBalances(request: QueryInsuranceFundBalancesRequest = {}): Promise<QueryInsuranceFundBalancesResponse> {
+    const data = QueryInsuranceFundBalancesRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Query", "InsuranceFundBalances", data);
+    return promise.then(data => QueryInsuranceFundBalancesResponse.decode(new _m0.Reader(data)));
+  }
+
+  simulateLiquidation(request: QuerySimulateLiquidationRequest): Promise<QuerySimulateLiquidationResponse> {
+    const data = QuerySimulateLiquidationRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Query", "SimulateLiquidation", data);
+    return promise.then(data => QuerySimulateLiquidationResponse.decode(new _m0.Reader(data)));
+  }
+
+  streamOrderbookUpdates(request: StreamOrderbookUpdatesRequest): Promise<StreamOrderbookUpdatesResponse> {
+    const data = StreamOrderbookUpdatesRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Query", "StreamOrderbookUpdates", data);
+    return promise.then(data => StreamOrderbookUpdatesResponse.decode(new _m0.Reader(data)));
+  }
+
 }
 export const createRpcQueryExtension = (base: QueryClient) => {
   const rpc = createProtobufRpcClient(base);
@@ -87,6 +159,30 @@ export const createRpcQueryExtension = (base: QueryClient) => {
 
     equityTierLimitConfiguration(request?: QueryEquityTierLimitConfigurationRequest): Promise<QueryEquityTierLimitConfigurationResponse> {
       return queryService.equityTierLimitConfiguration(request);
+    },
+
+    orderbookDepth(request: QueryOrderbookDepthRequest): Promise<QueryOrderbookDepthResponse> {
+      return queryService.orderbookDepth(request);
+    },
+
+    subaccountOpenOrders(request: QuerySubaccountOpenOrdersRequest): Promise<QuerySubaccountOpenOrdersResponse> {
+      return queryService.subaccountOpenOrders(request);
+    },
+
+    orderFillState(request: QueryOrderFillStateRequest): Promise<QueryOrderFillStateResponse> {
+      return queryService.orderFillState(request);
+    },
+
+    insuranceFundBalances(request?: QueryInsuranceFundBalancesRequest): Promise<QueryInsuranceFundBalancesResponse> {
+      return queryService.insuranceFundBalances(request);
+    },
+
+    simulateLiquidation(request: QuerySimulateLiquidationRequest): Promise<QuerySimulateLiquidationResponse> {
+      return queryService.simulateLiquidation(request);
+    },
+
+    streamOrderbookUpdates(request: StreamOrderbookUpdatesRequest): Promise<StreamOrderbookUpdatesResponse> {
+      return queryService.streamOrderbookUpdates(request);
     }
 
   };
@@ -3,6 +3,8 @@ import { SubaccountId, SubaccountIdSDKType } from "../subaccounts/subaccount";
 import { ValidatorMevMatches, ValidatorMevMatchesSDKType, MevNodeToNodeMetrics, MevNodeToNodeMetricsSDKType } from "./mev";
 import { ClobPair, ClobPairSDKType } from "./clob_pair";
 import { EquityTierLimitConfiguration, EquityTierLimitConfigurationSDKType } from "./equity_tier_limit_config";
+import { Order, OrderSDKType, OrderFillState, OrderFillStateSDKType, TransactionOrdering, TransactionOrderingSDKType, OrderId, OrderIdSDKType, LongTermOrderPlacement, LongTermOrderPlacementSDKType } from "./order";
+import { OffChainUpdateV1, OffChainUpdateV1SDKType } from "../indexer/off_chain_updates/off_chain_updates";
 import * as _m0 from "protobufjs/minimal";
 import { DeepPartial, Long } from "../../helpers";
 /** QueryGetClobPairRequest is request type for the ClobPair method. */
@@ -187,33 +189,1356 @@ export interface QueryEquityTierLimitConfigurationResponse {
 export interface QueryEquityTierLimitConfigurationResponseSDKType {
   equity_tier_limit_config?: EquityTierLimitConfigurationSDKType;
 }
+/** QueryOrderbookDepthRequest is request type for the OrderbookDepth method. */
+
+export interface QueryOrderbookDepthRequest {
+  /** The id of the ClobPair to query. */
+  clobPairId: number;
+  /**
+   * The maximum number of price levels to return for each side of the
+   * orderbook. All price levels are returned if zero.
+   */
+
+  depth: number;
+}
+/** QueryOrderbookDepthRequest is request type for the OrderbookDepth method. */
+
+export interface QueryOrderbookDepthRequestSDKType {
+  /** The id of the ClobPair to query. */
+  clob_pair_id: number;
+  /**
+   * The maximum number of price levels to return for each side of the
+   * orderbook. All price levels are returned if zero.
+   */
+
+  depth: number;
+}
+/**
+ * OrderbookLevel is the aggregated size of all orders resting on a price level
+ * of the orderbook.
+ */
+
+export interface OrderbookLevel {
+  /** The price of the level, in subticks. */
+  subticks: Long;
+  /** The total remaining size of all orders on the level, in base quantums. */
+
+  quantums: Long;
+  /** The number of orders resting on the level. */
+
+  numOrders: number;
+}
+/**
+ * OrderbookLevel is the aggregated size of all orders resting on a price level
+ * of the orderbook.
+ */
+
+export interface OrderbookLevelSDKType {
+  /** The price of the level, in subticks. */
+  subticks: Long;
+  /** The total remaining size of all orders on the level, in base quantums. */
+
+  quantums: Long;
+  /** The number of orders resting on the level. */
+
+  num_orders: number;
+}
+/** QueryOrderbookDepthResponse is response type for the OrderbookDepth method. */
+
+export interface QueryOrderbookDepthResponse {
+  clobPairId: number;
+  /** Bid price levels, sorted from the highest to the lowest price. */
+
+  bids: OrderbookLevel[];
+  /** Ask price levels, sorted from the lowest to the highest price. */
+
+  asks: OrderbookLevel[];
+}
+/** QueryOrderbookDepthResponse is response type for the OrderbookDepth method. */
+
+export interface QueryOrderbookDepthResponseSDKType {
+  clob_pair_id: number;
+  /** Bid price levels, sorted from the highest to the lowest price. */
+
+  bids: OrderbookLevelSDKType[];
+  /** Ask price levels, sorted from the lowest to the highest price. */
+
+  asks: OrderbookLevelSDKType[];
+}
+/**
+ * QuerySubaccountOpenOrdersRequest is request type for the
+ * SubaccountOpenOrders method.
+ */
+
+export interface QuerySubaccountOpenOrdersRequest {
+  owner: string;
+  number: number;
+}
+/**
+ * QuerySubaccountOpenOrdersRequest is request type for the
+ * SubaccountOpenOrders method.
+ */
+
+export interface QuerySubaccountOpenOrdersRequestSDKType {
+  owner: string;
+  number: number;
+}
+/** OpenOrder is an order resting on the orderbook along with its fill state. */
+
+export interface OpenOrder {
+  order?: Order;
+  /** The fill state of the order in state. */
+
+  fillState?: OrderFillState;
+  /**
+   * The block height and transaction index at which the order was placed.
+   * Only set for stateful orders.
+   */
+
+  placementIndex?: TransactionOrdering;
+}
+/** OpenOrder is an order resting on the orderbook along with its fill state. */
+
+export interface OpenOrderSDKType {
+  order?: OrderSDKType;
+  /** The fill state of the order in state. */
+
+  fill_state?: OrderFillStateSDKType;
+  /**
+   * The block height and transaction index at which the order was placed.
+   * Only set for stateful orders.
+   */
+
+  placement_index?: TransactionOrderingSDKType;
+}
+/**
+ * QuerySubaccountOpenOrdersResponse is response type for the
+ * SubaccountOpenOrders method.
+ */
+
+export interface QuerySubaccountOpenOrdersResponse {
+  /** Open orders of the subaccount, sorted by ClobPair id. */
+  openOrders: OpenOrder[];
+}
+/**
+ * QuerySubaccountOpenOrdersResponse is response type for the
+ * SubaccountOpenOrders method.
+ */
+
+export interface QuerySubaccountOpenOrdersResponseSDKType {
+  /** Open orders of the subaccount, sorted by ClobPair id. */
+  open_orders: OpenOrderSDKType[];
+}
+/** QueryOrderFillStateRequest is request type for the OrderFillState method. */
+
+export interface QueryOrderFillStateRequest {
+  orderId?: OrderId;
+}
+/** QueryOrderFillStateRequest is request type for the OrderFillState method. */
+
+export interface QueryOrderFillStateRequestSDKType {
+  order_id?: OrderIdSDKType;
+}
+/** QueryOrderFillStateResponse is response type for the OrderFillState method. */
+
+export interface QueryOrderFillStateResponse {
+  /**
+   * The fill state of the order in state. Empty if the order has not been
+   * filled or its fill state has been pruned.
+   */
+  fillState?: OrderFillState;
+  /** Whether the order is resting on the in-memory orderbook. */
+
+  resting: boolean;
+  /**
+   * The placement of the order in state. Only set for stateful orders which
+   * exist in state.
+   */
+
+  longTermOrderPlacement?: LongTermOrderPlacement;
+}
+/** QueryOrderFillStateResponse is response type for the OrderFillState method. */
+
+export interface QueryOrderFillStateResponseSDKType {
+  /**
+   * The fill state of the order in state. Empty if the order has not been
+   * filled or its fill state has been pruned.
+   */
+  fill_state?: OrderFillStateSDKType;
+  /** Whether the order is resting on the in-memory orderbook. */
+
+  resting: boolean;
+  /**
+   * The placement of the order in state. Only set for stateful orders which
+   * exist in state.
+   */
+
+  long_term_order_placement?: LongTermOrderPlacementSDKType;
+}
+/**
+ * QueryInsuranceFundBalancesRequest is request type for the
+ * InsuranceFundBalances method.
+ */
+
+export interface QueryInsuranceFundBalancesRequest {}
+/**
+ * QueryInsuranceFundBalancesRequest is request type for the
+ * InsuranceFundBalances method.
+ */
+
+export interface QueryInsuranceFundBalancesRequestSDKType {}
+/**
+ * InsuranceFundBalance is the balance of an insurance fund along with the
+ * perpetuals that it backs.
+ */
+
+export interface InsuranceFundBalance {
+  /** The name of the module account of the insurance fund. */
+  name: string;
+  /**
+   * The address of the module account of the insurance fund. An insurance
+   * fund is funded by sending USDC to this address.
+   */
+
+  address: string;
+  /** Ids of the perpetuals backed by the insurance fund, in ascending order. */
+
+  perpetualIds: number[];
+  /** The balance of the insurance fund in quote quantums. */
+
+  balance: Uint8Array;
+}
+/**
+ * InsuranceFundBalance is the balance of an insurance fund along with the
+ * perpetuals that it backs.
+ */
+
+export interface InsuranceFundBalanceSDKType {
+  /** The name of the module account of the insurance fund. */
+  name: string;
+  /**
+   * The address of the module account of the insurance fund. An insurance
+   * fund is funded by sending USDC to this address.
+   */
+
+  address: string;
+  /** Ids of the perpetuals backed by the insurance fund, in ascending order. */
+
+  perpetual_ids: number[];
+  /** The balance of the insurance fund in quote quantums. */
+
+  balance: Uint8Array;
+}
+/**
+ * QueryInsuranceFundBalancesResponse is response type for the
+ * InsuranceFundBalances method.
+ */
+
+export interface QueryInsuranceFundBalancesResponse {
+  /**
+   * The global insurance fund followed by the insurance funds scoped to a
+   * perpetual or liquidity tier.
+   */
+  insuranceFunds: InsuranceFundBalance[];
+}
+/**
+ * QueryInsuranceFundBalancesResponse is response type for the
+ * InsuranceFundBalances method.
+ */
+
+export interface QueryInsuranceFundBalancesResponseSDKType {
+  /**
+   * The global insurance fund followed by the insurance funds scoped to a
+   * perpetual or liquidity tier.
+   */
+  insurance_funds: InsuranceFundBalanceSDKType[];
+}
+/**
+ * QuerySimulateLiquidationRequest is request type for the SimulateLiquidation
+ * method.
+ */
+
+export interface QuerySimulateLiquidationRequest {
+  /** The subaccount to simulate. */
+  subaccountId?: SubaccountId;
+  /**
+   * Hypothetical changes to the perpetual positions of the subaccount. Each
+   * perpetual may be changed at most once.
+   */
+
+  perpetualPositionChanges: SimulatedPerpetualPositionChange[];
+  /**
+   * Hypothetical change to the quote balance of the subaccount in quote
+   * quantums.
+   */
+
+  quoteQuantumsDelta: Long;
+  /**
+   * Hypothetical oracle prices of markets. Each market may be priced at most
+   * once.
+   */
+
+  marketPrices: SimulatedMarketPrice[];
+}
+/**
+ * QuerySimulateLiquidationRequest is request type for the SimulateLiquidation
+ * method.
+ */
+
+export interface QuerySimulateLiquidationRequestSDKType {
+  /** The subaccount to simulate. */
+  subaccount_id?: SubaccountIdSDKType;
+  /**
+   * Hypothetical changes to the perpetual positions of the subaccount. Each
+   * perpetual may be changed at most once.
+   */
+
+  perpetual_position_changes: SimulatedPerpetualPositionChangeSDKType[];
+  /**
+   * Hypothetical change to the quote balance of the subaccount in quote
+   * quantums.
+   */
+
+  quote_quantums_delta: Long;
+  /**
+   * Hypothetical oracle prices of markets. Each market may be priced at most
+   * once.
+   */
+
+  market_prices: SimulatedMarketPriceSDKType[];
+}
+/**
+ * SimulatedPerpetualPositionChange is a hypothetical change to a perpetual
+ * position.
+ */
+
+export interface SimulatedPerpetualPositionChange {
+  /** The id of the perpetual. */
+  perpetualId: number;
+  /**
+   * The change to the size of the position in base quantums. Positive for
+   * buys and negative for sells.
+   */
+
+  quantumsDelta: Long;
+}
+/**
+ * SimulatedPerpetualPositionChange is a hypothetical change to a perpetual
+ * position.
+ */
+
+export interface SimulatedPerpetualPositionChangeSDKType {
+  /** The id of the perpetual. */
+  perpetual_id: number;
+  /**
+   * The change to the size of the position in base quantums. Positive for
+   * buys and negative for sells.
+   */
+
+  quantums_delta: Long;
+}
+/** SimulatedMarketPrice is a hypothetical oracle price of a market. */
+
+export interface SimulatedMarketPrice {
+  /** The id of the market. */
+  marketId: number;
+  /** The price of the market, in the exponent of the market. */
+
+  price: Long;
+}
+/** SimulatedMarketPrice is a hypothetical oracle price of a market. */
+
+export interface SimulatedMarketPriceSDKType {
+  /** The id of the market. */
+  market_id: number;
+  /** The price of the market, in the exponent of the market. */
+
+  price: Long;
+}
+/**
+ * QuerySimulateLiquidationResponse is response type for the
+ * SimulateLiquidation method.
+ */
+
+export interface QuerySimulateLiquidationResponse {
+  /** The net collateral of the subaccount in quote quantums. */
+  netCollateral: Uint8Array;
+  /** The initial margin requirement of the subaccount in quote quantums. */
+
+  initialMarginRequirement: Uint8Array;
+  /** The maintenance margin requirement of the subaccount in quote quantums. */
+
+  maintenanceMarginRequirement: Uint8Array;
+  /**
+   * The net collateral in excess of the initial margin requirement in quote
+   * quantums. Negative if the subaccount is undercollateralized.
+   */
+
+  freeCollateral: Uint8Array;
+  /** Whether the subaccount is liquidatable. */
+
+  isLiquidatable: boolean;
+  /**
+   * The simulated perpetual positions of the subaccount, in ascending order of
+   * perpetual id.
+   */
+
+  perpetualPositions: SimulatedPerpetualPosition[];
+}
+/**
+ * QuerySimulateLiquidationResponse is response type for the
+ * SimulateLiquidation method.
+ */
+
+export interface QuerySimulateLiquidationResponseSDKType {
+  /** The net collateral of the subaccount in quote quantums. */
+  net_collateral: Uint8Array;
+  /** The initial margin requirement of the subaccount in quote quantums. */
+
+  initial_margin_requirement: Uint8Array;
+  /** The maintenance margin requirement of the subaccount in quote quantums. */
+
+  maintenance_margin_requirement: Uint8Array;
+  /**
+   * The net collateral in excess of the initial margin requirement in quote
+   * quantums. Negative if the subaccount is undercollateralized.
+   */
+
+  free_collateral: Uint8Array;
+  /** Whether the subaccount is liquidatable. */
+
+  is_liquidatable: boolean;
+  /**
+   * The simulated perpetual positions of the subaccount, in ascending order of
+   * perpetual id.
+   */
+
+  perpetual_positions: SimulatedPerpetualPositionSDKType[];
+}
+/**
+ * SimulatedPerpetualPosition is a simulated perpetual position along with the
+ * prices at which it would be liquidated and closed. Prices are zero if they
+ * do not exist for the position.
+ */
+
+export interface SimulatedPerpetualPosition {
+  /** The id of the perpetual. */
+  perpetualId: number;
+  /** The size of the position in base quantums. */
+
+  quantums: Uint8Array;
+  /**
+   * The oracle price in subticks at which the subaccount becomes
+   * liquidatable, assuming the prices of all other markets are unchanged.
+   */
+
+  liquidationPriceSubticks: Long;
+  /**
+   * The price in subticks at which closing the position would leave the
+   * subaccount with zero net collateral.
+   */
+
+  bankruptcyPriceSubticks: Long;
+  /**
+   * The worst price in subticks at which a liquidation order closing the
+   * position may be filled at the simulated oracle prices.
+   */
+
+  fillablePriceSubticks: Long;
+  /**
+   * The change in the insurance fund balance in quote quantums if the
+   * position was liquidated at the fillable price. Positive values are the
+   * liquidation fee paid by the subaccount and negative values are paid out
+   * by the insurance fund.
+   */
+
+  insuranceFundDelta: Uint8Array;
+}
+/**
+ * SimulatedPerpetualPosition is a simulated perpetual position along with the
+ * prices at which it would be liquidated and closed. Prices are zero if they
+ * do not exist for the position.
+ */
+
+export interface SimulatedPerpetualPositionSDKType {
+  /** The id of the perpetual. */
+  perpetual_id: number;
+  /** The size of the position in base quantums. */
+
+  quantums: Uint8Array;
+  /**
+   * The oracle price in subticks at which the subaccount becomes
+   * liquidatable, assuming the prices of all other markets are unchanged.
+   */
+
+  liquidation_price_subticks: Long;
+  /**
+   * The price in subticks at which closing the position would leave the
+   * subaccount with zero net collateral.
+   */
+
+  bankruptcy_price_subticks: Long;
+  /**
+   * The worst price in subticks at which a liquidation order closing the
+   * position may be filled at the simulated oracle prices.
+   */
+
+  fillable_price_subticks: Long;
+  /**
+   * The change in the insurance fund balance in quote quantums if the
+   * position was liquidated at the fillable price. Positive values are the
+   * liquidation fee paid by the subaccount and negative values are paid out
+   * by the insurance fund.
+   */
+
+  insurance_fund_delta: Uint8Array;
+}
+/**
+ * StreamOrderbookUpdatesRequest is a request message for the
+ * StreamOrderbookUpdates method.
+ */
+
+export interface StreamOrderbookUpdatesRequest {
+  /** Clob pair ids to stream orderbook updates for. */
+  clobPairId: number[];
+}
+/**
+ * StreamOrderbookUpdatesRequest is a request message for the
+ * StreamOrderbookUpdates method.
+ */
+
+export interface StreamOrderbookUpdatesRequestSDKType {
+  /** Clob pair ids to stream orderbook updates for. */
+  clob_pair_id: number[];
+}
+/**
+ * StreamOrderbookUpdatesResponse is a response message for the
+ * StreamOrderbookUpdates method.
+ */
+
+export interface StreamOrderbookUpdatesResponse {
+  /**
+   * Orderbook updates for the clob pairs. Each update is an order placement,
+   * fill amount update, replacement or removal of an order on the orderbook.
+   */
+  updates: OffChainUpdateV1[];
+  /**
+   * Snapshot indicates if the response is a snapshot of the orderbooks. A
+   * snapshot contains an order placement and fill amount update for every
+   * order resting on the orderbooks, from which both the L3 (per-order) and
+   * L2 (per-price level) orderbooks can be constructed. Clients should reset
+   * their local orderbooks when a snapshot is received.
+   */
+
+  snapshot: boolean;
+  /**
+   * Aggregated price levels of each subscribed orderbook. Only set on the
+   * initial snapshot of a subscription, for clients which only maintain an L2
+   * orderbook.
+   */
+
+  l2Snapshots: OrderbookL2Snapshot[];
+}
+/**
+ * StreamOrderbookUpdatesResponse is a response message for the
+ * StreamOrderbookUpdates method.
+ */
+
+export interface StreamOrderbookUpdatesResponseSDKType {
+  /**
+   * Orderbook updates for the clob pairs. Each update is an order placement,
+   * fill amount update, replacement or removal of an order on the orderbook.
+   */
+  updates: OffChainUpdateV1SDKType[];
+  /**
+   * Snapshot indicates if the response is a snapshot of the orderbooks. A
+   * snapshot contains an order placement and fill amount update for every
+   * order resting on the orderbooks, from which both the L3 (per-order) and
+   * L2 (per-price level) orderbooks can be constructed. Clients should reset
+   * their local orderbooks when a snapshot is received.
+   */
+
+  snapshot: boolean;
+  /**
+   * Aggregated price levels of each subscribed orderbook. Only set on the
+   * initial snapshot of a subscription, for clients which only maintain an L2
+   * orderbook.
+   */
+
+  l2_snapshots: OrderbookL2SnapshotSDKType[];
+}
+/**
+ * OrderbookL2Snapshot is a snapshot of the price levels of an orderbook,
+ * aggregated by price.
+ */
+
+export interface OrderbookL2Snapshot {
+  clobPairId: number;
+  /** Bid price levels, sorted from the highest to the lowest price. */
+
+  bids: OrderbookLevel[];
+  /** Ask price levels, sorted from the lowest to the highest price. */
+
+  asks: OrderbookLevel[];
+}
+/**
+ * OrderbookL2Snapshot is a snapshot of the price levels of an orderbook,
+ * aggregated by price.
+ */
+
+export interface OrderbookL2SnapshotSDKType {
+  clob_pair_id: number;
+  /** Bid price levels, sorted from the highest to the lowest price. */
+
+  bids: OrderbookLevelSDKType[];
+  /** Ask price levels, sorted from the lowest to the highest price. */
+
+  asks: OrderbookLevelSDKType[];
+}
 
 function createBaseQueryGetClobPairRequest(): QueryGetClobPairRequest {
   return {
-    id: 0
+    id: 0
+  };
+}
+
+export const QueryGetClobPairRequest = {
+  encode(message: QueryGetClobPairRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryGetClobPairRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryGetClobPairRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.id = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryGetClobPairRequest>): QueryGetClobPairRequest {
+    const message = createBaseQueryGetClobPairRequest();
+    message.id = object.id ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryClobPairResponse(): QueryClobPairResponse {
+  return {
+    clobPair: undefined
+  };
+}
+
+export const QueryClobPairResponse = {
+  encode(message: QueryClobPairResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clobPair !== undefined) {
+      ClobPair.encode(message.clobPair, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryClobPairResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryClobPairResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.clobPair = ClobPair.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryClobPairResponse>): QueryClobPairResponse {
+    const message = createBaseQueryClobPairResponse();
+    message.clobPair = object.clobPair !== undefined && object.clobPair !== null ? ClobPair.fromPartial(object.clobPair) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryAllClobPairRequest(): QueryAllClobPairRequest {
+  return {
+    pagination: undefined
+  };
+}
+
+export const QueryAllClobPairRequest = {
+  encode(message: QueryAllClobPairRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pagination !== undefined) {
+      PageRequest.encode(message.pagination, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryAllClobPairRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryAllClobPairRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.pagination = PageRequest.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryAllClobPairRequest>): QueryAllClobPairRequest {
+    const message = createBaseQueryAllClobPairRequest();
+    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageRequest.fromPartial(object.pagination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryClobPairAllResponse(): QueryClobPairAllResponse {
+  return {
+    clobPair: [],
+    pagination: undefined
+  };
+}
+
+export const QueryClobPairAllResponse = {
+  encode(message: QueryClobPairAllResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.clobPair) {
+      ClobPair.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.pagination !== undefined) {
+      PageResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryClobPairAllResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryClobPairAllResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.clobPair.push(ClobPair.decode(reader, reader.uint32()));
+          break;
+
+        case 2:
+          message.pagination = PageResponse.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryClobPairAllResponse>): QueryClobPairAllResponse {
+    const message = createBaseQueryClobPairAllResponse();
+    message.clobPair = object.clobPair?.map(e => ClobPair.fromPartial(e)) || [];
+    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageResponse.fromPartial(object.pagination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseAreSubaccountsLiquidatableRequest(): AreSubaccountsLiquidatableRequest {
+  return {
+    subaccountIds: []
+  };
+}
+
+export const AreSubaccountsLiquidatableRequest = {
+  encode(message: AreSubaccountsLiquidatableRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.subaccountIds) {
+      SubaccountId.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AreSubaccountsLiquidatableRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAreSubaccountsLiquidatableRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountIds.push(SubaccountId.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<AreSubaccountsLiquidatableRequest>): AreSubaccountsLiquidatableRequest {
+    const message = createBaseAreSubaccountsLiquidatableRequest();
+    message.subaccountIds = object.subaccountIds?.map(e => SubaccountId.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseAreSubaccountsLiquidatableResponse(): AreSubaccountsLiquidatableResponse {
+  return {
+    results: []
+  };
+}
+
+export const AreSubaccountsLiquidatableResponse = {
+  encode(message: AreSubaccountsLiquidatableResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.results) {
+      AreSubaccountsLiquidatableResponse_Result.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AreSubaccountsLiquidatableResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAreSubaccountsLiquidatableResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.results.push(AreSubaccountsLiquidatableResponse_Result.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<AreSubaccountsLiquidatableResponse>): AreSubaccountsLiquidatableResponse {
+    const message = createBaseAreSubaccountsLiquidatableResponse();
+    message.results = object.results?.map(e => AreSubaccountsLiquidatableResponse_Result.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseAreSubaccountsLiquidatableResponse_Result(): AreSubaccountsLiquidatableResponse_Result {
+  return {
+    subaccountId: undefined,
+    isLiquidatable: false
+  };
+}
+
+export const AreSubaccountsLiquidatableResponse_Result = {
+  encode(message: AreSubaccountsLiquidatableResponse_Result, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.isLiquidatable === true) {
+      writer.uint32(16).bool(message.isLiquidatable);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AreSubaccountsLiquidatableResponse_Result {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAreSubaccountsLiquidatableResponse_Result();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.isLiquidatable = reader.bool();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<AreSubaccountsLiquidatableResponse_Result>): AreSubaccountsLiquidatableResponse_Result {
+    const message = createBaseAreSubaccountsLiquidatableResponse_Result();
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.isLiquidatable = object.isLiquidatable ?? false;
+    return message;
+  }
+
+};
+
+function createBaseMevNodeToNodeCalculationRequest(): MevNodeToNodeCalculationRequest {
+  return {
+    blockProposerMatches: undefined,
+    validatorMevMetrics: undefined
+  };
+}
+
+export const MevNodeToNodeCalculationRequest = {
+  encode(message: MevNodeToNodeCalculationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.blockProposerMatches !== undefined) {
+      ValidatorMevMatches.encode(message.blockProposerMatches, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.validatorMevMetrics !== undefined) {
+      MevNodeToNodeMetrics.encode(message.validatorMevMetrics, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MevNodeToNodeCalculationRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMevNodeToNodeCalculationRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.blockProposerMatches = ValidatorMevMatches.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.validatorMevMetrics = MevNodeToNodeMetrics.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MevNodeToNodeCalculationRequest>): MevNodeToNodeCalculationRequest {
+    const message = createBaseMevNodeToNodeCalculationRequest();
+    message.blockProposerMatches = object.blockProposerMatches !== undefined && object.blockProposerMatches !== null ? ValidatorMevMatches.fromPartial(object.blockProposerMatches) : undefined;
+    message.validatorMevMetrics = object.validatorMevMetrics !== undefined && object.validatorMevMetrics !== null ? MevNodeToNodeMetrics.fromPartial(object.validatorMevMetrics) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseMevNodeToNodeCalculationResponse(): MevNodeToNodeCalculationResponse {
+  return {
+    results: []
+  };
+}
+
+export const MevNodeToNodeCalculationResponse = {
+  encode(message: MevNodeToNodeCalculationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.results) {
+      MevNodeToNodeCalculationResponse_MevAndVolumePerClob.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MevNodeToNodeCalculationResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMevNodeToNodeCalculationResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.results.push(MevNodeToNodeCalculationResponse_MevAndVolumePerClob.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MevNodeToNodeCalculationResponse>): MevNodeToNodeCalculationResponse {
+    const message = createBaseMevNodeToNodeCalculationResponse();
+    message.results = object.results?.map(e => MevNodeToNodeCalculationResponse_MevAndVolumePerClob.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseMevNodeToNodeCalculationResponse_MevAndVolumePerClob(): MevNodeToNodeCalculationResponse_MevAndVolumePerClob {
+  return {
+    clobPairId: 0,
+    mev: 0,
+    volume: Long.UZERO
+  };
+}
+
+export const MevNodeToNodeCalculationResponse_MevAndVolumePerClob = {
+  encode(message: MevNodeToNodeCalculationResponse_MevAndVolumePerClob, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clobPairId !== 0) {
+      writer.uint32(8).uint32(message.clobPairId);
+    }
+
+    if (message.mev !== 0) {
+      writer.uint32(21).float(message.mev);
+    }
+
+    if (!message.volume.isZero()) {
+      writer.uint32(24).uint64(message.volume);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MevNodeToNodeCalculationResponse_MevAndVolumePerClob {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMevNodeToNodeCalculationResponse_MevAndVolumePerClob();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.clobPairId = reader.uint32();
+          break;
+
+        case 2:
+          message.mev = reader.float();
+          break;
+
+        case 3:
+          message.volume = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MevNodeToNodeCalculationResponse_MevAndVolumePerClob>): MevNodeToNodeCalculationResponse_MevAndVolumePerClob {
+    const message = createBaseMevNodeToNodeCalculationResponse_MevAndVolumePerClob();
+    message.clobPairId = object.clobPairId ?? 0;
+    message.mev = object.mev ?? 0;
+    message.volume = object.volume !== undefined && object.volume !== null ? Long.fromValue(object.volume) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryEquityTierLimitConfigurationRequest(): QueryEquityTierLimitConfigurationRequest {
+  return {};
+}
+
+export const QueryEquityTierLimitConfigurationRequest = {
+  encode(_: QueryEquityTierLimitConfigurationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryEquityTierLimitConfigurationRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryEquityTierLimitConfigurationRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<QueryEquityTierLimitConfigurationRequest>): QueryEquityTierLimitConfigurationRequest {
+    const message = createBaseQueryEquityTierLimitConfigurationRequest();
+    return message;
+  }
+
+};
+
+function createBaseQueryEquityTierLimitConfigurationResponse(): QueryEquityTierLimitConfigurationResponse {
+  return {
+    equityTierLimitConfig: undefined
+  };
+}
+
+export const QueryEquityTierLimitConfigurationResponse = {
+  encode(message: QueryEquityTierLimitConfigurationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.equityTierLimitConfig !== undefined) {
+      EquityTierLimitConfiguration.encode(message.equityTierLimitConfig, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryEquityTierLimitConfigurationResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryEquityTierLimitConfigurationResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.equityTierLimitConfig = EquityTierLimitConfiguration.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryEquityTierLimitConfigurationResponse>): QueryEquityTierLimitConfigurationResponse {
+    const message = createBaseQueryEquityTierLimitConfigurationResponse();
+    message.equityTierLimitConfig = object.equityTierLimitConfig !== undefined && object.equityTierLimitConfig !== null ? EquityTierLimitConfiguration.fromPartial(object.equityTierLimitConfig) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryOrderbookDepthRequest(): QueryOrderbookDepthRequest {
+  return {
+    clobPairId: 0,
+    depth: 0
+  };
+}
+
+export const QueryOrderbookDepthRequest = {
+  encode(message: QueryOrderbookDepthRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clobPairId !== 0) {
+      writer.uint32(8).uint32(message.clobPairId);
+    }
+
+    if (message.depth !== 0) {
+      writer.uint32(16).uint32(message.depth);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryOrderbookDepthRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryOrderbookDepthRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.clobPairId = reader.uint32();
+          break;
+
+        case 2:
+          message.depth = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryOrderbookDepthRequest>): QueryOrderbookDepthRequest {
+    const message = createBaseQueryOrderbookDepthRequest();
+    message.clobPairId = object.clobPairId ?? 0;
+    message.depth = object.depth ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseOrderbookLevel(): OrderbookLevel {
+  return {
+    subticks: Long.UZERO,
+    quantums: Long.UZERO,
+    numOrders: 0
+  };
+}
+
+export const OrderbookLevel = {
+  encode(message: OrderbookLevel, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.subticks.isZero()) {
+      writer.uint32(8).uint64(message.subticks);
+    }
+
+    if (!message.quantums.isZero()) {
+      writer.uint32(16).uint64(message.quantums);
+    }
+
+    if (message.numOrders !== 0) {
+      writer.uint32(24).uint32(message.numOrders);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderbookLevel {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrderbookLevel();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subticks = (reader.uint64() as Long);
+          break;
+
+        case 2:
+          message.quantums = (reader.uint64() as Long);
+          break;
+
+        case 3:
+          message.numOrders = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<OrderbookLevel>): OrderbookLevel {
+    const message = createBaseOrderbookLevel();
+    message.subticks = object.subticks !== undefined && object.subticks !== null ? Long.fromValue(object.subticks) : Long.UZERO;
+    message.quantums = object.quantums !== undefined && object.quantums !== null ? Long.fromValue(object.quantums) : Long.UZERO;
+    message.numOrders = object.numOrders ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryOrderbookDepthResponse(): QueryOrderbookDepthResponse {
+  return {
+    clobPairId: 0,
+    bids: [],
+    asks: []
   };
 }
 
-export const QueryGetClobPairRequest = {
-  encode(message: QueryGetClobPairRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.id !== 0) {
-      writer.uint32(8).uint32(message.id);
+export const QueryOrderbookDepthResponse = {
+  encode(message: QueryOrderbookDepthResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clobPairId !== 0) {
+      writer.uint32(8).uint32(message.clobPairId);
+    }
+
+    for (const v of message.bids) {
+      OrderbookLevel.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    for (const v of message.asks) {
+      OrderbookLevel.encode(v!, writer.uint32(26).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryGetClobPairRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryOrderbookDepthResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryGetClobPairRequest();
+    const message = createBaseQueryOrderbookDepthResponse();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.id = reader.uint32();
+          message.clobPairId = reader.uint32();
+          break;
+
+        case 2:
+          message.bids.push(OrderbookLevel.decode(reader, reader.uint32()));
+          break;
+
+        case 3:
+          message.asks.push(OrderbookLevel.decode(reader, reader.uint32()));
           break;
 
         default:
@@ -225,40 +1550,391 @@ export const QueryGetClobPairRequest = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryGetClobPairRequest>): QueryGetClobPairRequest {
-    const message = createBaseQueryGetClobPairRequest();
-    message.id = object.id ?? 0;
+  fromPartial(object: DeepPartial<QueryOrderbookDepthResponse>): QueryOrderbookDepthResponse {
+    const message = createBaseQueryOrderbookDepthResponse();
+    message.clobPairId = object.clobPairId ?? 0;
+    message.bids = object.bids?.map(e => OrderbookLevel.fromPartial(e)) || [];
+    message.asks = object.asks?.map(e => OrderbookLevel.fromPartial(e)) || [];
     return message;
   }
 
 };
 
-function createBaseQueryClobPairResponse(): QueryClobPairResponse {
+function createBaseQuerySubaccountOpenOrdersRequest(): QuerySubaccountOpenOrdersRequest {
   return {
-    clobPair: undefined
+    owner: "",
+    number: 0
   };
 }
 
-export const QueryClobPairResponse = {
-  encode(message: QueryClobPairResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.clobPair !== undefined) {
-      ClobPair.encode(message.clobPair, writer.uint32(10).fork()).ldelim();
+export const QuerySubaccountOpenOrdersRequest = {
+  encode(message: QuerySubaccountOpenOrdersRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountOpenOrdersRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuerySubaccountOpenOrdersRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuerySubaccountOpenOrdersRequest>): QuerySubaccountOpenOrdersRequest {
+    const message = createBaseQuerySubaccountOpenOrdersRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseOpenOrder(): OpenOrder {
+  return {
+    order: undefined,
+    fillState: undefined,
+    placementIndex: undefined
+  };
+}
+
+export const OpenOrder = {
+  encode(message: OpenOrder, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.order !== undefined) {
+      Order.encode(message.order, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.fillState !== undefined) {
+      OrderFillState.encode(message.fillState, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.placementIndex !== undefined) {
+      TransactionOrdering.encode(message.placementIndex, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OpenOrder {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOpenOrder();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.order = Order.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.fillState = OrderFillState.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.placementIndex = TransactionOrdering.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<OpenOrder>): OpenOrder {
+    const message = createBaseOpenOrder();
+    message.order = object.order !== undefined && object.order !== null ? Order.fromPartial(object.order) : undefined;
+    message.fillState = object.fillState !== undefined && object.fillState !== null ? OrderFillState.fromPartial(object.fillState) : undefined;
+    message.placementIndex = object.placementIndex !== undefined && object.placementIndex !== null ? TransactionOrdering.fromPartial(object.placementIndex) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQuerySubaccountOpenOrdersResponse(): QuerySubaccountOpenOrdersResponse {
+  return {
+    openOrders: []
+  };
+}
+
+export const QuerySubaccountOpenOrdersResponse = {
+  encode(message: QuerySubaccountOpenOrdersResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.openOrders) {
+      OpenOrder.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySubaccountOpenOrdersResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuerySubaccountOpenOrdersResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.openOrders.push(OpenOrder.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuerySubaccountOpenOrdersResponse>): QuerySubaccountOpenOrdersResponse {
+    const message = createBaseQuerySubaccountOpenOrdersResponse();
+    message.openOrders = object.openOrders?.map(e => OpenOrder.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryOrderFillStateRequest(): QueryOrderFillStateRequest {
+  return {
+    orderId: undefined
+  };
+}
+
+export const QueryOrderFillStateRequest = {
+  encode(message: QueryOrderFillStateRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.orderId !== undefined) {
+      OrderId.encode(message.orderId, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryOrderFillStateRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryOrderFillStateRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.orderId = OrderId.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryOrderFillStateRequest>): QueryOrderFillStateRequest {
+    const message = createBaseQueryOrderFillStateRequest();
+    message.orderId = object.orderId !== undefined && object.orderId !== null ? OrderId.fromPartial(object.orderId) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryOrderFillStateResponse(): QueryOrderFillStateResponse {
+  return {
+    fillState: undefined,
+    resting: false,
+    longTermOrderPlacement: undefined
+  };
+}
+
+export const QueryOrderFillStateResponse = {
+  encode(message: QueryOrderFillStateResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.fillState !== undefined) {
+      OrderFillState.encode(message.fillState, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.resting === true) {
+      writer.uint32(16).bool(message.resting);
+    }
+
+    if (message.longTermOrderPlacement !== undefined) {
+      LongTermOrderPlacement.encode(message.longTermOrderPlacement, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryOrderFillStateResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryOrderFillStateResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.fillState = OrderFillState.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.resting = reader.bool();
+          break;
+
+        case 3:
+          message.longTermOrderPlacement = LongTermOrderPlacement.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryOrderFillStateResponse>): QueryOrderFillStateResponse {
+    const message = createBaseQueryOrderFillStateResponse();
+    message.fillState = object.fillState !== undefined && object.fillState !== null ? OrderFillState.fromPartial(object.fillState) : undefined;
+    message.resting = object.resting ?? false;
+    message.longTermOrderPlacement = object.longTermOrderPlacement !== undefined && object.longTermOrderPlacement !== null ? LongTermOrderPlacement.fromPartial(object.longTermOrderPlacement) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryInsuranceFundBalancesRequest(): QueryInsuranceFundBalancesRequest {
+  return {};
+}
+
+export const QueryInsuranceFundBalancesRequest = {
+  encode(message: QueryInsuranceFundBalancesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryInsuranceFundBalancesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryInsuranceFundBalancesRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryInsuranceFundBalancesRequest>): QueryInsuranceFundBalancesRequest {
+    const message = createBaseQueryInsuranceFundBalancesRequest();
+    return message;
+  }
+
+};
+
+function createBaseInsuranceFundBalance(): InsuranceFundBalance {
+  return {
+    name: "",
+    address: "",
+    perpetualIds: [],
+    balance: new Uint8Array()
+  };
+}
+
+export const InsuranceFundBalance = {
+  encode(message: InsuranceFundBalance, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+
+    if (message.address !== "") {
+      writer.uint32(18).string(message.address);
+    }
+
+    writer.uint32(26).fork();
+
+    for (const v of message.perpetualIds) {
+      writer.uint32(v);
+    }
+
+    writer.ldelim();
+
+    if (message.balance.length !== 0) {
+      writer.uint32(34).bytes(message.balance);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryClobPairResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InsuranceFundBalance {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryClobPairResponse();
+    const message = createBaseInsuranceFundBalance();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.clobPair = ClobPair.decode(reader, reader.uint32());
+          message.name = reader.string();
+          break;
+
+        case 2:
+          message.address = reader.string();
+          break;
+
+        case 3:
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+
+            while (reader.pos < end2) {
+              message.perpetualIds.push(reader.uint32());
+            }
+          } else {
+            message.perpetualIds.push(reader.uint32());
+          }
+
+          break;
+
+        case 4:
+          message.balance = reader.bytes();
           break;
 
         default:
@@ -270,40 +1946,43 @@ export const QueryClobPairResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryClobPairResponse>): QueryClobPairResponse {
-    const message = createBaseQueryClobPairResponse();
-    message.clobPair = object.clobPair !== undefined && object.clobPair !== null ? ClobPair.fromPartial(object.clobPair) : undefined;
+  fromPartial(object: DeepPartial<InsuranceFundBalance>): InsuranceFundBalance {
+    const message = createBaseInsuranceFundBalance();
+    message.name = object.name ?? "";
+    message.address = object.address ?? "";
+    message.perpetualIds = object.perpetualIds?.map(e => e) || [];
+    message.balance = object.balance ?? new Uint8Array();
     return message;
   }
 
 };
 
-function createBaseQueryAllClobPairRequest(): QueryAllClobPairRequest {
+function createBaseQueryInsuranceFundBalancesResponse(): QueryInsuranceFundBalancesResponse {
   return {
-    pagination: undefined
+    insuranceFunds: []
   };
 }
 
-export const QueryAllClobPairRequest = {
-  encode(message: QueryAllClobPairRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.pagination !== undefined) {
-      PageRequest.encode(message.pagination, writer.uint32(10).fork()).ldelim();
+export const QueryInsuranceFundBalancesResponse = {
+  encode(message: QueryInsuranceFundBalancesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.insuranceFunds) {
+      InsuranceFundBalance.encode(v!, writer.uint32(10).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryAllClobPairRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryInsuranceFundBalancesResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryAllClobPairRequest();
+    const message = createBaseQueryInsuranceFundBalancesResponse();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.pagination = PageRequest.decode(reader, reader.uint32());
+          message.insuranceFunds.push(InsuranceFundBalance.decode(reader, reader.uint32()));
           break;
 
         default:
@@ -315,49 +1994,67 @@ export const QueryAllClobPairRequest = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryAllClobPairRequest>): QueryAllClobPairRequest {
-    const message = createBaseQueryAllClobPairRequest();
-    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageRequest.fromPartial(object.pagination) : undefined;
+  fromPartial(object: DeepPartial<QueryInsuranceFundBalancesResponse>): QueryInsuranceFundBalancesResponse {
+    const message = createBaseQueryInsuranceFundBalancesResponse();
+    message.insuranceFunds = object.insuranceFunds?.map(e => InsuranceFundBalance.fromPartial(e)) || [];
     return message;
   }
 
 };
 
-function createBaseQueryClobPairAllResponse(): QueryClobPairAllResponse {
+function createBaseQuerySimulateLiquidationRequest(): QuerySimulateLiquidationRequest {
   return {
-    clobPair: [],
-    pagination: undefined
+    subaccountId: undefined,
+    perpetualPositionChanges: [],
+    quoteQuantumsDelta: Long.ZERO,
+    marketPrices: []
   };
 }
 
-export const QueryClobPairAllResponse = {
-  encode(message: QueryClobPairAllResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.clobPair) {
-      ClobPair.encode(v!, writer.uint32(10).fork()).ldelim();
+export const QuerySimulateLiquidationRequest = {
+  encode(message: QuerySimulateLiquidationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
     }
 
-    if (message.pagination !== undefined) {
-      PageResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    for (const v of message.perpetualPositionChanges) {
+      SimulatedPerpetualPositionChange.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (!message.quoteQuantumsDelta.isZero()) {
+      writer.uint32(24).sint64(message.quoteQuantumsDelta);
+    }
+
+    for (const v of message.marketPrices) {
+      SimulatedMarketPrice.encode(v!, writer.uint32(34).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryClobPairAllResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySimulateLiquidationRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryClobPairAllResponse();
+    const message = createBaseQuerySimulateLiquidationRequest();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.clobPair.push(ClobPair.decode(reader, reader.uint32()));
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
           break;
 
         case 2:
-          message.pagination = PageResponse.decode(reader, reader.uint32());
+          message.perpetualPositionChanges.push(SimulatedPerpetualPositionChange.decode(reader, reader.uint32()));
+          break;
+
+        case 3:
+          message.quoteQuantumsDelta = (reader.sint64() as Long);
+          break;
+
+        case 4:
+          message.marketPrices.push(SimulatedMarketPrice.decode(reader, reader.uint32()));
           break;
 
         default:
@@ -369,41 +2066,52 @@ export const QueryClobPairAllResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryClobPairAllResponse>): QueryClobPairAllResponse {
-    const message = createBaseQueryClobPairAllResponse();
-    message.clobPair = object.clobPair?.map(e => ClobPair.fromPartial(e)) || [];
-    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageResponse.fromPartial(object.pagination) : undefined;
+  fromPartial(object: DeepPartial<QuerySimulateLiquidationRequest>): QuerySimulateLiquidationRequest {
+    const message = createBaseQuerySimulateLiquidationRequest();
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.perpetualPositionChanges = object.perpetualPositionChanges?.map(e => SimulatedPerpetualPositionChange.fromPartial(e)) || [];
+    message.quoteQuantumsDelta = object.quoteQuantumsDelta !== undefined && object.quoteQuantumsDelta !== null ? Long.fromValue(object.quoteQuantumsDelta) : Long.ZERO;
+    message.marketPrices = object.marketPrices?.map(e => SimulatedMarketPrice.fromPartial(e)) || [];
     return message;
   }
 
 };
 
-function createBaseAreSubaccountsLiquidatableRequest(): AreSubaccountsLiquidatableRequest {
+function createBaseSimulatedPerpetualPositionChange(): SimulatedPerpetualPositionChange {
   return {
-    subaccountIds: []
+    perpetualId: 0,
+    quantumsDelta: Long.ZERO
   };
 }
 
-export const AreSubaccountsLiquidatableRequest = {
-  encode(message: AreSubaccountsLiquidatableRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.subaccountIds) {
-      SubaccountId.encode(v!, writer.uint32(10).fork()).ldelim();
+export const SimulatedPerpetualPositionChange = {
+  encode(message: SimulatedPerpetualPositionChange, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (!message.quantumsDelta.isZero()) {
+      writer.uint32(16).sint64(message.quantumsDelta);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): AreSubaccountsLiquidatableRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SimulatedPerpetualPositionChange {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAreSubaccountsLiquidatableRequest();
+    const message = createBaseSimulatedPerpetualPositionChange();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.subaccountIds.push(SubaccountId.decode(reader, reader.uint32()));
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.quantumsDelta = (reader.sint64() as Long);
           break;
 
         default:
@@ -415,40 +2123,50 @@ export const AreSubaccountsLiquidatableRequest = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<AreSubaccountsLiquidatableRequest>): AreSubaccountsLiquidatableRequest {
-    const message = createBaseAreSubaccountsLiquidatableRequest();
-    message.subaccountIds = object.subaccountIds?.map(e => SubaccountId.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<SimulatedPerpetualPositionChange>): SimulatedPerpetualPositionChange {
+    const message = createBaseSimulatedPerpetualPositionChange();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.quantumsDelta = object.quantumsDelta !== undefined && object.quantumsDelta !== null ? Long.fromValue(object.quantumsDelta) : Long.ZERO;
     return message;
   }
 
 };
 
-function createBaseAreSubaccountsLiquidatableResponse(): AreSubaccountsLiquidatableResponse {
+function createBaseSimulatedMarketPrice(): SimulatedMarketPrice {
   return {
-    results: []
+    marketId: 0,
+    price: Long.UZERO
   };
 }
 
-export const AreSubaccountsLiquidatableResponse = {
-  encode(message: AreSubaccountsLiquidatableResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.results) {
-      AreSubaccountsLiquidatableResponse_Result.encode(v!, writer.uint32(10).fork()).ldelim();
+export const SimulatedMarketPrice = {
+  encode(message: SimulatedMarketPrice, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.marketId !== 0) {
+      writer.uint32(8).uint32(message.marketId);
+    }
+
+    if (!message.price.isZero()) {
+      writer.uint32(16).uint64(message.price);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): AreSubaccountsLiquidatableResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SimulatedMarketPrice {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAreSubaccountsLiquidatableResponse();
+    const message = createBaseSimulatedMarketPrice();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.results.push(AreSubaccountsLiquidatableResponse_Result.decode(reader, reader.uint32()));
+          message.marketId = reader.uint32();
+          break;
+
+        case 2:
+          message.price = (reader.uint64() as Long);
           break;
 
         default:
@@ -460,51 +2178,88 @@ export const AreSubaccountsLiquidatableResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<AreSubaccountsLiquidatableResponse>): AreSubaccountsLiquidatableResponse {
-    const message = createBaseAreSubaccountsLiquidatableResponse();
-    message.results = object.results?.map(e => AreSubaccountsLiquidatableResponse_Result.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<SimulatedMarketPrice>): SimulatedMarketPrice {
+    const message = createBaseSimulatedMarketPrice();
+    message.marketId = object.marketId ?? 0;
+    message.price = object.price !== undefined && object.price !== null ? Long.fromValue(object.price) : Long.UZERO;
     return message;
   }
 
 };
 
-function createBaseAreSubaccountsLiquidatableResponse_Result(): AreSubaccountsLiquidatableResponse_Result {
+function createBaseQuerySimulateLiquidationResponse(): QuerySimulateLiquidationResponse {
   return {
-    subaccountId: undefined,
-    isLiquidatable: false
+    netCollateral: new Uint8Array(),
+    initialMarginRequirement: new Uint8Array(),
+    maintenanceMarginRequirement: new Uint8Array(),
+    freeCollateral: new Uint8Array(),
+    isLiquidatable: false,
+    perpetualPositions: []
   };
 }
 
-export const AreSubaccountsLiquidatableResponse_Result = {
-  encode(message: AreSubaccountsLiquidatableResponse_Result, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.subaccountId !== undefined) {
-      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
+export const QuerySimulateLiquidationResponse = {
+  encode(message: QuerySimulateLiquidationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.netCollateral.length !== 0) {
+      writer.uint32(10).bytes(message.netCollateral);
+    }
+
+    if (message.initialMarginRequirement.length !== 0) {
+      writer.uint32(18).bytes(message.initialMarginRequirement);
+    }
+
+    if (message.maintenanceMarginRequirement.length !== 0) {
+      writer.uint32(26).bytes(message.maintenanceMarginRequirement);
+    }
+
+    if (message.freeCollateral.length !== 0) {
+      writer.uint32(34).bytes(message.freeCollateral);
     }
 
     if (message.isLiquidatable === true) {
-      writer.uint32(16).bool(message.isLiquidatable);
+      writer.uint32(40).bool(message.isLiquidatable);
+    }
+
+    for (const v of message.perpetualPositions) {
+      SimulatedPerpetualPosition.encode(v!, writer.uint32(50).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): AreSubaccountsLiquidatableResponse_Result {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuerySimulateLiquidationResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAreSubaccountsLiquidatableResponse_Result();
+    const message = createBaseQuerySimulateLiquidationResponse();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          message.netCollateral = reader.bytes();
           break;
 
         case 2:
+          message.initialMarginRequirement = reader.bytes();
+          break;
+
+        case 3:
+          message.maintenanceMarginRequirement = reader.bytes();
+          break;
+
+        case 4:
+          message.freeCollateral = reader.bytes();
+          break;
+
+        case 5:
           message.isLiquidatable = reader.bool();
           break;
 
+        case 6:
+          message.perpetualPositions.push(SimulatedPerpetualPosition.decode(reader, reader.uint32()));
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -514,50 +2269,90 @@ export const AreSubaccountsLiquidatableResponse_Result = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<AreSubaccountsLiquidatableResponse_Result>): AreSubaccountsLiquidatableResponse_Result {
-    const message = createBaseAreSubaccountsLiquidatableResponse_Result();
-    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+  fromPartial(object: DeepPartial<QuerySimulateLiquidationResponse>): QuerySimulateLiquidationResponse {
+    const message = createBaseQuerySimulateLiquidationResponse();
+    message.netCollateral = object.netCollateral ?? new Uint8Array();
+    message.initialMarginRequirement = object.initialMarginRequirement ?? new Uint8Array();
+    message.maintenanceMarginRequirement = object.maintenanceMarginRequirement ?? new Uint8Array();
+    message.freeCollateral = object.freeCollateral ?? new Uint8Array();
     message.isLiquidatable = object.isLiquidatable ?? false;
+    message.perpetualPositions = object.perpetualPositions?.map(e => SimulatedPerpetualPosition.fromPartial(e)) || [];
     return message;
   }
 
 };
 
-function createBaseMevNodeToNodeCalculationRequest(): MevNodeToNodeCalculationRequest {
+function createBaseSimulatedPerpetualPosition(): SimulatedPerpetualPosition {
   return {
-    blockProposerMatches: undefined,
-    validatorMevMetrics: undefined
+    perpetualId: 0,
+    quantums: new Uint8Array(),
+    liquidationPriceSubticks: Long.UZERO,
+    bankruptcyPriceSubticks: Long.UZERO,
+    fillablePriceSubticks: Long.UZERO,
+    insuranceFundDelta: new Uint8Array()
   };
 }
 
-export const MevNodeToNodeCalculationRequest = {
-  encode(message: MevNodeToNodeCalculationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.blockProposerMatches !== undefined) {
-      ValidatorMevMatches.encode(message.blockProposerMatches, writer.uint32(10).fork()).ldelim();
+export const SimulatedPerpetualPosition = {
+  encode(message: SimulatedPerpetualPosition, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
     }
 
-    if (message.validatorMevMetrics !== undefined) {
-      MevNodeToNodeMetrics.encode(message.validatorMevMetrics, writer.uint32(18).fork()).ldelim();
+    if (message.quantums.length !== 0) {
+      writer.uint32(18).bytes(message.quantums);
+    }
+
+    if (!message.liquidationPriceSubticks.isZero()) {
+      writer.uint32(24).uint64(message.liquidationPriceSubticks);
+    }
+
+    if (!message.bankruptcyPriceSubticks.isZero()) {
+      writer.uint32(32).uint64(message.bankruptcyPriceSubticks);
+    }
+
+    if (!message.fillablePriceSubticks.isZero()) {
+      writer.uint32(40).uint64(message.fillablePriceSubticks);
+    }
+
+    if (message.insuranceFundDelta.length !== 0) {
+      writer.uint32(50).bytes(message.insuranceFundDelta);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): MevNodeToNodeCalculationRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SimulatedPerpetualPosition {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseMevNodeToNodeCalculationRequest();
+    const message = createBaseSimulatedPerpetualPosition();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.blockProposerMatches = ValidatorMevMatches.decode(reader, reader.uint32());
+          message.perpetualId = reader.uint32();
           break;
 
         case 2:
-          message.validatorMevMetrics = MevNodeToNodeMetrics.decode(reader, reader.uint32());
+          message.quantums = reader.bytes();
+          break;
+
+        case 3:
+          message.liquidationPriceSubticks = (reader.uint64() as Long);
+          break;
+
+        case 4:
+          message.bankruptcyPriceSubticks = (reader.uint64() as Long);
+          break;
+
+        case 5:
+          message.fillablePriceSubticks = (reader.uint64() as Long);
+          break;
+
+        case 6:
+          message.insuranceFundDelta = reader.bytes();
           break;
 
         default:
@@ -569,41 +2364,58 @@ export const MevNodeToNodeCalculationRequest = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<MevNodeToNodeCalculationRequest>): MevNodeToNodeCalculationRequest {
-    const message = createBaseMevNodeToNodeCalculationRequest();
-    message.blockProposerMatches = object.blockProposerMatches !== undefined && object.blockProposerMatches !== null ? ValidatorMevMatches.fromPartial(object.blockProposerMatches) : undefined;
-    message.validatorMevMetrics = object.validatorMevMetrics !== undefined && object.validatorMevMetrics !== null ? MevNodeToNodeMetrics.fromPartial(object.validatorMevMetrics) : undefined;
+  fromPartial(object: DeepPartial<SimulatedPerpetualPosition>): SimulatedPerpetualPosition {
+    const message = createBaseSimulatedPerpetualPosition();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.quantums = object.quantums ?? new Uint8Array();
+    message.liquidationPriceSubticks = object.liquidationPriceSubticks !== undefined && object.liquidationPriceSubticks !== null ? Long.fromValue(object.liquidationPriceSubticks) : Long.UZERO;
+    message.bankruptcyPriceSubticks = object.bankruptcyPriceSubticks !== undefined && object.bankruptcyPriceSubticks !== null ? Long.fromValue(object.bankruptcyPriceSubticks) : Long.UZERO;
+    message.fillablePriceSubticks = object.fillablePriceSubticks !== undefined && object.fillablePriceSubticks !== null ? Long.fromValue(object.fillablePriceSubticks) : Long.UZERO;
+    message.insuranceFundDelta = object.insuranceFundDelta ?? new Uint8Array();
     return message;
   }
 
 };
 
-function createBaseMevNodeToNodeCalculationResponse(): MevNodeToNodeCalculationResponse {
+function createBaseStreamOrderbookUpdatesRequest(): StreamOrderbookUpdatesRequest {
   return {
-    results: []
+    clobPairId: []
   };
 }
 
-export const MevNodeToNodeCalculationResponse = {
-  encode(message: MevNodeToNodeCalculationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.results) {
-      MevNodeToNodeCalculationResponse_MevAndVolumePerClob.encode(v!, writer.uint32(10).fork()).ldelim();
+export const StreamOrderbookUpdatesRequest = {
+  encode(message: StreamOrderbookUpdatesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    writer.uint32(10).fork();
+
+    for (const v of message.clobPairId) {
+      writer.uint32(v);
     }
 
+    writer.ldelim();
+
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): MevNodeToNodeCalculationResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StreamOrderbookUpdatesRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseMevNodeToNodeCalculationResponse();
+    const message = createBaseStreamOrderbookUpdatesRequest();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.results.push(MevNodeToNodeCalculationResponse_MevAndVolumePerClob.decode(reader, reader.uint32()));
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+
+            while (reader.pos < end2) {
+              message.clobPairId.push(reader.uint32());
+            }
+          } else {
+            message.clobPairId.push(reader.uint32());
+          }
+
           break;
 
         default:
@@ -615,58 +2427,58 @@ export const MevNodeToNodeCalculationResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<MevNodeToNodeCalculationResponse>): MevNodeToNodeCalculationResponse {
-    const message = createBaseMevNodeToNodeCalculationResponse();
-    message.results = object.results?.map(e => MevNodeToNodeCalculationResponse_MevAndVolumePerClob.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<StreamOrderbookUpdatesRequest>): StreamOrderbookUpdatesRequest {
+    const message = createBaseStreamOrderbookUpdatesRequest();
+    message.clobPairId = object.clobPairId?.map(e => e) || [];
     return message;
   }
 
 };
 
-function createBaseMevNodeToNodeCalculationResponse_MevAndVolumePerClob(): MevNodeToNodeCalculationResponse_MevAndVolumePerClob {
+function createBaseStreamOrderbookUpdatesResponse(): StreamOrderbookUpdatesResponse {
   return {
-    clobPairId: 0,
-    mev: 0,
-    volume: Long.UZERO
+    updates: [],
+    snapshot: false,
+    l2Snapshots: []
   };
 }
 
-export const MevNodeToNodeCalculationResponse_MevAndVolumePerClob = {
-  encode(message: MevNodeToNodeCalculationResponse_MevAndVolumePerClob, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.clobPairId !== 0) {
-      writer.uint32(8).uint32(message.clobPairId);
+export const StreamOrderbookUpdatesResponse = {
+  encode(message: StreamOrderbookUpdatesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.updates) {
+      OffChainUpdateV1.encode(v!, writer.uint32(10).fork()).ldelim();
     }
 
-    if (message.mev !== 0) {
-      writer.uint32(21).float(message.mev);
+    if (message.snapshot === true) {
+      writer.uint32(16).bool(message.snapshot);
     }
 
-    if (!message.volume.isZero()) {
-      writer.uint32(24).uint64(message.volume);
+    for (const v of message.l2Snapshots) {
+      OrderbookL2Snapshot.encode(v!, writer.uint32(26).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): MevNodeToNodeCalculationResponse_MevAndVolumePerClob {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StreamOrderbookUpdatesResponse {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseMevNodeToNodeCalculationResponse_MevAndVolumePerClob();
+    const message = createBaseStreamOrderbookUpdatesResponse();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.clobPairId = reader.uint32();
+          message.updates.push(OffChainUpdateV1.decode(reader, reader.uint32()));
           break;
 
         case 2:
-          message.mev = reader.float();
+          message.snapshot = reader.bool();
           break;
 
         case 3:
-          message.volume = (reader.uint64() as Long);
+          message.l2Snapshots.push(OrderbookL2Snapshot.decode(reader, reader.uint32()));
           break;
 
         default:
@@ -678,76 +2490,60 @@ export const MevNodeToNodeCalculationResponse_MevAndVolumePerClob = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<MevNodeToNodeCalculationResponse_MevAndVolumePerClob>): MevNodeToNodeCalculationResponse_MevAndVolumePerClob {
-    const message = createBaseMevNodeToNodeCalculationResponse_MevAndVolumePerClob();
-    message.clobPairId = object.clobPairId ?? 0;
-    message.mev = object.mev ?? 0;
-    message.volume = object.volume !== undefined && object.volume !== null ? Long.fromValue(object.volume) : Long.UZERO;
+  fromPartial(object: DeepPartial<StreamOrderbookUpdatesResponse>): StreamOrderbookUpdatesResponse {
+    const message = createBaseStreamOrderbookUpdatesResponse();
+    message.updates = object.updates?.map(e => OffChainUpdateV1.fromPartial(e)) || [];
+    message.snapshot = object.snapshot ?? false;
+    message.l2Snapshots = object.l2Snapshots?.map(e => OrderbookL2Snapshot.fromPartial(e)) || [];
     return message;
   }
 
 };
 
-function createBaseQueryEquityTierLimitConfigurationRequest(): QueryEquityTierLimitConfigurationRequest {
-  return {};
+function createBaseOrderbookL2Snapshot(): OrderbookL2Snapshot {
+  return {
+    clobPairId: 0,
+    bids: [],
+    asks: []
+  };
 }
 
-export const QueryEquityTierLimitConfigurationRequest = {
-  encode(_: QueryEquityTierLimitConfigurationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryEquityTierLimitConfigurationRequest {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryEquityTierLimitConfigurationRequest();
-
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-
-      switch (tag >>> 3) {
-        default:
-          reader.skipType(tag & 7);
-          break;
-      }
+export const OrderbookL2Snapshot = {
+  encode(message: OrderbookL2Snapshot, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clobPairId !== 0) {
+      writer.uint32(8).uint32(message.clobPairId);
     }
 
-    return message;
-  },
-
-  fromPartial(_: DeepPartial<QueryEquityTierLimitConfigurationRequest>): QueryEquityTierLimitConfigurationRequest {
-    const message = createBaseQueryEquityTierLimitConfigurationRequest();
-    return message;
-  }
-
-};
-
-function createBaseQueryEquityTierLimitConfigurationResponse(): QueryEquityTierLimitConfigurationResponse {
-  return {
-    equityTierLimitConfig: undefined
-  };
-}
+    for (const v of message.bids) {
+      OrderbookLevel.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
 
-export const QueryEquityTierLimitConfigurationResponse = {
-  encode(message: QueryEquityTierLimitConfigurationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.equityTierLimitConfig !== undefined) {
-      EquityTierLimitConfiguration.encode(message.equityTierLimitConfig, writer.uint32(10).fork()).ldelim();
+    for (const v of message.asks) {
+      OrderbookLevel.encode(v!, writer.uint32(26).fork()).ldelim();
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryEquityTierLimitConfigurationResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderbookL2Snapshot {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryEquityTierLimitConfigurationResponse();
+    const message = createBaseOrderbookL2Snapshot();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.equityTierLimitConfig = EquityTierLimitConfiguration.decode(reader, reader.uint32());
+          message.clobPairId = reader.uint32();
+          break;
+
+        case 2:
+          message.bids.push(OrderbookLevel.decode(reader, reader.uint32()));
+          break;
+
+        case 3:
+          message.asks.push(OrderbookLevel.decode(reader, reader.uint32()));
           break;
 
         default:
@@ -759,9 +2555,11 @@ export const QueryEquityTierLimitConfigurationResponse = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<QueryEquityTierLimitConfigurationResponse>): QueryEquityTierLimitConfigurationResponse {
-    const message = createBaseQueryEquityTierLimitConfigurationResponse();
-    message.equityTierLimitConfig = object.equityTierLimitConfig !== undefined && object.equityTierLimitConfig !== null ? EquityTierLimitConfiguration.fromPartial(object.equityTierLimitConfig) : undefined;
+  fromPartial(object: DeepPartial<OrderbookL2Snapshot>): OrderbookL2Snapshot {
+    const message = createBaseOrderbookL2Snapshot();
+    message.clobPairId = object.clobPairId ?? 0;
+    message.bids = object.bids?.map(e => OrderbookLevel.fromPartial(e)) || [];
+    message.asks = object.asks?.map(e => OrderbookLevel.fromPartial(e)) || [];
     return message;
   }
 
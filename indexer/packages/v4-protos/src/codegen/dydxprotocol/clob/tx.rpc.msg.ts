@@ -1,6 +1,6 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
-import { MsgProposedOperations, MsgProposedOperationsResponse, MsgPlaceOrder, MsgPlaceOrderResponse, MsgCancelOrder, MsgCancelOrderResponse, MsgCreateClobPair, MsgCreateClobPairResponse, MsgUpdateClobPair, MsgUpdateClobPairResponse, MsgUpdateEquityTierLimitConfiguration, MsgUpdateEquityTierLimitConfigurationResponse, MsgUpdateBlockRateLimitConfiguration, MsgUpdateBlockRateLimitConfigurationResponse, MsgUpdateLiquidationsConfig, MsgUpdateLiquidationsConfigResponse } from "./tx";
+import { MsgProposedOperations, MsgProposedOperationsResponse, MsgPlaceOrder, MsgPlaceOrderResponse, MsgCancelOrder, MsgCancelOrderResponse, MsgBatchPlaceAndCancel, MsgBatchPlaceAndCancelResponse, MsgReplaceOrder, MsgReplaceOrderResponse, MsgCreateClobPair, MsgCreateClobPairResponse, MsgUpdateClobPair, MsgUpdateClobPairResponse, MsgUpdateEquityTierLimitConfiguration, MsgUpdateEquityTierLimitConfigurationResponse, MsgUpdateBlockRateLimitConfiguration, MsgUpdateBlockRateLimitConfigurationResponse, MsgUpdateLiquidationsConfig, MsgUpdateLiquidationsConfigResponse } from "./tx";
 /** Msg defines the Msg service. */
 
 export interface Msg {
@@ -15,6 +15,18 @@ export interface Msg {
   /** CancelOrder allows accounts to cancel existing orders on the orderbook. */
 
   cancelOrder(request: MsgCancelOrder): Promise<MsgCancelOrderResponse>;
+  /**
+   * BatchPlaceAndCancel allows accounts to atomically cancel and place
+   * multiple Short-Term orders for a single subaccount.
+   */
+
+  batchPlaceAndCancel(request: MsgBatchPlaceAndCancel): Promise<MsgBatchPlaceAndCancelResponse>;
+  /**
+   * ReplaceOrder allows accounts to atomically replace an existing order on
+   * the orderbook with a new version of the order.
+   */
+
+  replaceOrder(request: MsgReplaceOrder): Promise<MsgReplaceOrderResponse>;
   /** CreateClobPair creates a new clob pair. */
 
   createClobPair(request: MsgCreateClobPair): Promise<MsgCreateClobPairResponse>;
@@ -50,6 +62,8 @@ export class MsgClientImpl implements Msg {
     this.proposedOperations = this.proposedOperations.bind(this);
     this.placeOrder = this.placeOrder.bind(this);
     this.cancelOrder = this.cancelOrder.bind(this);
+    this.batchPlaceAndCancel = this.batchPlaceAndCancel.bind(this);
+    this.replaceOrder = this.replaceOrder.bind(this);
     this.createClobPair = this.createClobPair.bind(this);
     this.updateClobPair = this.updateClobPair.bind(this);
     this.updateEquityTierLimitConfiguration = this.updateEquityTierLimitConfiguration.bind(this);
@@ -75,6 +89,18 @@ export class MsgClientImpl implements Msg {
     return promise.then(data => MsgCancelOrderResponse.decode(new _m0.Reader(data)));
   }
 
+  batchPlaceAndCancel(request: MsgBatchPlaceAndCancel): Promise<MsgBatchPlaceAndCancelResponse> {
+    const data = MsgBatchPlaceAndCancel.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Msg", "BatchPlaceAndCancel", data);
+    return promise.then(data => MsgBatchPlaceAndCancelResponse.decode(new _m0.Reader(data)));
+  }
+
+  replaceOrder(request: MsgReplaceOrder): Promise<MsgReplaceOrderResponse> {
+    const data = MsgReplaceOrder.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.clob.Msg", "ReplaceOrder", data);
+    return promise.then(data => MsgReplaceOrderResponse.decode(new _m0.Reader(data)));
+  }
+
   createClobPair(request: MsgCreateClobPair): Promise<MsgCreateClobPairResponse> {
     const data = MsgCreateClobPair.encode(request).finish();
     const promise = this.rpc.request("dydxprotocol.clob.Msg", "CreateClobPair", data);
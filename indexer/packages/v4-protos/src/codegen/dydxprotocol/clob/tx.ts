@@ -5,8 +5,9 @@ import { BlockRateLimitConfiguration, BlockRateLimitConfigurationSDKType } from
 import { LiquidationsConfig, LiquidationsConfigSDKType } from "./liquidations_config";
 import { ClobMatch, ClobMatchSDKType } from "./matches";
 import { OrderRemoval, OrderRemovalSDKType } from "./order_removals";
+import { SubaccountId, SubaccountIdSDKType } from "../subaccounts/subaccount";
 import * as _m0 from "protobufjs/minimal";
-import { DeepPartial } from "../../helpers";
+import { DeepPartial, Long } from "../../helpers";
 /** MsgCreateClobPair is a message used by x/gov for creating a new clob pair. */
 
 export interface MsgCreateClobPair {
@@ -125,6 +126,158 @@ export interface MsgCancelOrderResponse {}
 /** MsgCancelOrderResponse is a response type used for canceling orders. */
 
 export interface MsgCancelOrderResponseSDKType {}
+/**
+ * MsgBatchPlaceAndCancel is a request type used for atomically canceling and
+ * placing multiple Short-Term orders for a single subaccount. All
+ * cancellations are processed before all placements, and the batch is
+ * rejected if any cancellation or placement fails stateful validation.
+ */
+
+export interface MsgBatchPlaceAndCancel {
+  /** The subaccount that owns every order in the batch. */
+  subaccountId?: SubaccountId;
+  /** The Short-Term order cancellations to process, in order. */
+
+  cancels: MsgCancelOrder[];
+  /** The Short-Term order placements to process, in order. */
+
+  places: MsgPlaceOrder[];
+}
+/**
+ * MsgBatchPlaceAndCancel is a request type used for atomically canceling and
+ * placing multiple Short-Term orders for a single subaccount. All
+ * cancellations are processed before all placements, and the batch is
+ * rejected if any cancellation or placement fails stateful validation.
+ */
+
+export interface MsgBatchPlaceAndCancelSDKType {
+  /** The subaccount that owns every order in the batch. */
+  subaccount_id?: SubaccountIdSDKType;
+  /** The Short-Term order cancellations to process, in order. */
+
+  cancels: MsgCancelOrderSDKType[];
+  /** The Short-Term order placements to process, in order. */
+
+  places: MsgPlaceOrderSDKType[];
+}
+/**
+ * MsgBatchPlaceAndCancelResponse is a response type used for atomically
+ * canceling and placing multiple Short-Term orders. It contains a result for
+ * each cancellation and placement in the same order as the request.
+ */
+
+export interface MsgBatchPlaceAndCancelResponse {
+  cancelResults: BatchCancelOrderResult[];
+  placeResults: BatchPlaceOrderResult[];
+}
+/**
+ * MsgBatchPlaceAndCancelResponse is a response type used for atomically
+ * canceling and placing multiple Short-Term orders. It contains a result for
+ * each cancellation and placement in the same order as the request.
+ */
+
+export interface MsgBatchPlaceAndCancelResponseSDKType {
+  cancel_results: BatchCancelOrderResultSDKType[];
+  place_results: BatchPlaceOrderResultSDKType[];
+}
+/**
+ * BatchCancelOrderResult is the result of a single cancellation within a
+ * MsgBatchPlaceAndCancel.
+ */
+
+export interface BatchCancelOrderResult {
+  /** The ID of the canceled order. */
+  orderId?: OrderId;
+  /** The error returned by the memclob when canceling the order, if any. */
+
+  error: string;
+}
+/**
+ * BatchCancelOrderResult is the result of a single cancellation within a
+ * MsgBatchPlaceAndCancel.
+ */
+
+export interface BatchCancelOrderResultSDKType {
+  /** The ID of the canceled order. */
+  order_id?: OrderIdSDKType;
+  /** The error returned by the memclob when canceling the order, if any. */
+
+  error: string;
+}
+/**
+ * BatchPlaceOrderResult is the result of a single placement within a
+ * MsgBatchPlaceAndCancel.
+ */
+
+export interface BatchPlaceOrderResult {
+  /** The ID of the placed order. */
+  orderId?: OrderId;
+  /**
+   * The quantums of the order that were optimistically filled when placing
+   * the order.
+   */
+
+  optimisticallyFilledQuantums: Long;
+  /** The status of the order after placement, see `OrderStatus`. */
+
+  status: number;
+  /** The error returned by the memclob when placing the order, if any. */
+
+  error: string;
+}
+/**
+ * BatchPlaceOrderResult is the result of a single placement within a
+ * MsgBatchPlaceAndCancel.
+ */
+
+export interface BatchPlaceOrderResultSDKType {
+  /** The ID of the placed order. */
+  order_id?: OrderIdSDKType;
+  /**
+   * The quantums of the order that were optimistically filled when placing
+   * the order.
+   */
+
+  optimistically_filled_quantums: Long;
+  /** The status of the order after placement, see `OrderStatus`. */
+
+  status: number;
+  /** The error returned by the memclob when placing the order, if any. */
+
+  error: string;
+}
+/**
+ * MsgReplaceOrder is a request type used for atomically replacing an existing
+ * order with a new version of the order. The new order must have the same
+ * order id as the order it replaces, and may only change the price and size of
+ * the order. Short-Term replacement orders must also have a greater
+ * `good_til_block` than the order they replace. If the price is unchanged and
+ * the size is not increased, the order keeps its priority within its price
+ * level.
+ */
+
+export interface MsgReplaceOrder {
+  order?: Order;
+}
+/**
+ * MsgReplaceOrder is a request type used for atomically replacing an existing
+ * order with a new version of the order. The new order must have the same
+ * order id as the order it replaces, and may only change the price and size of
+ * the order. Short-Term replacement orders must also have a greater
+ * `good_til_block` than the order they replace. If the price is unchanged and
+ * the size is not increased, the order keeps its priority within its price
+ * level.
+ */
+
+export interface MsgReplaceOrderSDKType {
+  order?: OrderSDKType;
+}
+/** MsgReplaceOrderResponse is a response type used for replacing orders. */
+
+export interface MsgReplaceOrderResponse {}
+/** MsgReplaceOrderResponse is a response type used for replacing orders. */
+
+export interface MsgReplaceOrderResponseSDKType {}
 /** MsgUpdateClobPair is a request type used for updating a ClobPair in state. */
 
 export interface MsgUpdateClobPair {
@@ -164,6 +317,7 @@ export interface OperationRaw {
   match?: ClobMatch;
   shortTermOrderPlacement?: Uint8Array;
   orderRemoval?: OrderRemoval;
+  shortTermOrderBatchPlacement?: ShortTermOrderBatchPlacement;
 }
 /**
  * OperationRaw represents an operation in the proposed operations.
@@ -174,6 +328,37 @@ export interface OperationRawSDKType {
   match?: ClobMatchSDKType;
   short_term_order_placement?: Uint8Array;
   order_removal?: OrderRemovalSDKType;
+  short_term_order_batch_placement?: ShortTermOrderBatchPlacementSDKType;
+}
+/**
+ * ShortTermOrderBatchPlacement represents a Short-Term order placement that
+ * was included in a signed MsgBatchPlaceAndCancel transaction.
+ */
+
+export interface ShortTermOrderBatchPlacement {
+  /** The bytes of the signed transaction containing the MsgBatchPlaceAndCancel. */
+  txBytes: Uint8Array;
+  /**
+   * The index of the order placement within the `places` of the
+   * MsgBatchPlaceAndCancel.
+   */
+
+  index: number;
+}
+/**
+ * ShortTermOrderBatchPlacement represents a Short-Term order placement that
+ * was included in a signed MsgBatchPlaceAndCancel transaction.
+ */
+
+export interface ShortTermOrderBatchPlacementSDKType {
+  /** The bytes of the signed transaction containing the MsgBatchPlaceAndCancel. */
+  tx_bytes: Uint8Array;
+  /**
+   * The index of the order placement within the `places` of the
+   * MsgBatchPlaceAndCancel.
+   */
+
+  index: number;
 }
 /**
  * MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
@@ -638,6 +823,335 @@ export const MsgCancelOrderResponse = {
 
 };
 
+function createBaseMsgBatchPlaceAndCancel(): MsgBatchPlaceAndCancel {
+  return {
+    subaccountId: undefined,
+    cancels: [],
+    places: []
+  };
+}
+
+export const MsgBatchPlaceAndCancel = {
+  encode(message: MsgBatchPlaceAndCancel, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
+    }
+
+    for (const v of message.cancels) {
+      MsgCancelOrder.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    for (const v of message.places) {
+      MsgPlaceOrder.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgBatchPlaceAndCancel {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgBatchPlaceAndCancel();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.cancels.push(MsgCancelOrder.decode(reader, reader.uint32()));
+          break;
+
+        case 3:
+          message.places.push(MsgPlaceOrder.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgBatchPlaceAndCancel>): MsgBatchPlaceAndCancel {
+    const message = createBaseMsgBatchPlaceAndCancel();
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.cancels = object.cancels?.map(e => MsgCancelOrder.fromPartial(e)) || [];
+    message.places = object.places?.map(e => MsgPlaceOrder.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseMsgBatchPlaceAndCancelResponse(): MsgBatchPlaceAndCancelResponse {
+  return {
+    cancelResults: [],
+    placeResults: []
+  };
+}
+
+export const MsgBatchPlaceAndCancelResponse = {
+  encode(message: MsgBatchPlaceAndCancelResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.cancelResults) {
+      BatchCancelOrderResult.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    for (const v of message.placeResults) {
+      BatchPlaceOrderResult.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgBatchPlaceAndCancelResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgBatchPlaceAndCancelResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.cancelResults.push(BatchCancelOrderResult.decode(reader, reader.uint32()));
+          break;
+
+        case 2:
+          message.placeResults.push(BatchPlaceOrderResult.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgBatchPlaceAndCancelResponse>): MsgBatchPlaceAndCancelResponse {
+    const message = createBaseMsgBatchPlaceAndCancelResponse();
+    message.cancelResults = object.cancelResults?.map(e => BatchCancelOrderResult.fromPartial(e)) || [];
+    message.placeResults = object.placeResults?.map(e => BatchPlaceOrderResult.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseBatchCancelOrderResult(): BatchCancelOrderResult {
+  return {
+    orderId: undefined,
+    error: ""
+  };
+}
+
+export const BatchCancelOrderResult = {
+  encode(message: BatchCancelOrderResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.orderId !== undefined) {
+      OrderId.encode(message.orderId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.error !== "") {
+      writer.uint32(18).string(message.error);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BatchCancelOrderResult {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBatchCancelOrderResult();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.orderId = OrderId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.error = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<BatchCancelOrderResult>): BatchCancelOrderResult {
+    const message = createBaseBatchCancelOrderResult();
+    message.orderId = object.orderId !== undefined && object.orderId !== null ? OrderId.fromPartial(object.orderId) : undefined;
+    message.error = object.error ?? "";
+    return message;
+  }
+
+};
+
+function createBaseBatchPlaceOrderResult(): BatchPlaceOrderResult {
+  return {
+    orderId: undefined,
+    optimisticallyFilledQuantums: Long.UZERO,
+    status: 0,
+    error: ""
+  };
+}
+
+export const BatchPlaceOrderResult = {
+  encode(message: BatchPlaceOrderResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.orderId !== undefined) {
+      OrderId.encode(message.orderId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (!message.optimisticallyFilledQuantums.isZero()) {
+      writer.uint32(16).uint64(message.optimisticallyFilledQuantums);
+    }
+
+    if (message.status !== 0) {
+      writer.uint32(24).uint32(message.status);
+    }
+
+    if (message.error !== "") {
+      writer.uint32(34).string(message.error);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BatchPlaceOrderResult {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBatchPlaceOrderResult();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.orderId = OrderId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.optimisticallyFilledQuantums = (reader.uint64() as Long);
+          break;
+
+        case 3:
+          message.status = reader.uint32();
+          break;
+
+        case 4:
+          message.error = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<BatchPlaceOrderResult>): BatchPlaceOrderResult {
+    const message = createBaseBatchPlaceOrderResult();
+    message.orderId = object.orderId !== undefined && object.orderId !== null ? OrderId.fromPartial(object.orderId) : undefined;
+    message.optimisticallyFilledQuantums = object.optimisticallyFilledQuantums !== undefined && object.optimisticallyFilledQuantums !== null ? Long.fromValue(object.optimisticallyFilledQuantums) : Long.UZERO;
+    message.status = object.status ?? 0;
+    message.error = object.error ?? "";
+    return message;
+  }
+
+};
+
+function createBaseMsgReplaceOrder(): MsgReplaceOrder {
+  return {
+    order: undefined
+  };
+}
+
+export const MsgReplaceOrder = {
+  encode(message: MsgReplaceOrder, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.order !== undefined) {
+      Order.encode(message.order, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgReplaceOrder {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgReplaceOrder();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.order = Order.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgReplaceOrder>): MsgReplaceOrder {
+    const message = createBaseMsgReplaceOrder();
+    message.order = object.order !== undefined && object.order !== null ? Order.fromPartial(object.order) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseMsgReplaceOrderResponse(): MsgReplaceOrderResponse {
+  return {};
+}
+
+export const MsgReplaceOrderResponse = {
+  encode(message: MsgReplaceOrderResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgReplaceOrderResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgReplaceOrderResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgReplaceOrderResponse>): MsgReplaceOrderResponse {
+    const message = createBaseMsgReplaceOrderResponse();
+    return message;
+  }
+
+};
+
 function createBaseMsgUpdateClobPair(): MsgUpdateClobPair {
   return {
     authority: "",
@@ -731,7 +1245,8 @@ function createBaseOperationRaw(): OperationRaw {
   return {
     match: undefined,
     shortTermOrderPlacement: undefined,
-    orderRemoval: undefined
+    orderRemoval: undefined,
+    shortTermOrderBatchPlacement: undefined
   };
 }
 
@@ -749,6 +1264,10 @@ export const OperationRaw = {
       OrderRemoval.encode(message.orderRemoval, writer.uint32(26).fork()).ldelim();
     }
 
+    if (message.shortTermOrderBatchPlacement !== undefined) {
+      ShortTermOrderBatchPlacement.encode(message.shortTermOrderBatchPlacement, writer.uint32(34).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -773,6 +1292,10 @@ export const OperationRaw = {
           message.orderRemoval = OrderRemoval.decode(reader, reader.uint32());
           break;
 
+        case 4:
+          message.shortTermOrderBatchPlacement = ShortTermOrderBatchPlacement.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -787,6 +1310,62 @@ export const OperationRaw = {
     message.match = object.match !== undefined && object.match !== null ? ClobMatch.fromPartial(object.match) : undefined;
     message.shortTermOrderPlacement = object.shortTermOrderPlacement ?? undefined;
     message.orderRemoval = object.orderRemoval !== undefined && object.orderRemoval !== null ? OrderRemoval.fromPartial(object.orderRemoval) : undefined;
+    message.shortTermOrderBatchPlacement = object.shortTermOrderBatchPlacement !== undefined && object.shortTermOrderBatchPlacement !== null ? ShortTermOrderBatchPlacement.fromPartial(object.shortTermOrderBatchPlacement) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseShortTermOrderBatchPlacement(): ShortTermOrderBatchPlacement {
+  return {
+    txBytes: new Uint8Array(),
+    index: 0
+  };
+}
+
+export const ShortTermOrderBatchPlacement = {
+  encode(message: ShortTermOrderBatchPlacement, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.txBytes.length !== 0) {
+      writer.uint32(10).bytes(message.txBytes);
+    }
+
+    if (message.index !== 0) {
+      writer.uint32(16).uint32(message.index);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ShortTermOrderBatchPlacement {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortTermOrderBatchPlacement();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.txBytes = reader.bytes();
+          break;
+
+        case 2:
+          message.index = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<ShortTermOrderBatchPlacement>): ShortTermOrderBatchPlacement {
+    const message = createBaseShortTermOrderBatchPlacement();
+    message.txBytes = object.txBytes ?? new Uint8Array();
+    message.index = object.index ?? 0;
     return message;
   }
 
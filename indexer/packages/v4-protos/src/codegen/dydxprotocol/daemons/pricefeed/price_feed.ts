@@ -5,11 +5,17 @@ import { DeepPartial, toTimestamp, Long, fromTimestamp } from "../../../helpers"
 
 export interface UpdateMarketPricesRequest {
   marketPriceUpdates: MarketPriceUpdate[];
+  /** The health of each exchange queried by the pricefeed daemon. */
+
+  exchangeStatuses: ExchangeStatus[];
 }
 /** UpdateMarketPriceRequest is a request message updating market prices. */
 
 export interface UpdateMarketPricesRequestSDKType {
   market_price_updates: MarketPriceUpdateSDKType[];
+  /** The health of each exchange queried by the pricefeed daemon. */
+
+  exchange_statuses: ExchangeStatusSDKType[];
 }
 /** UpdateMarketPricesResponse is a response message for updating market prices. */
 
@@ -23,6 +29,13 @@ export interface ExchangePrice {
   exchangeId: string;
   price: Long;
   lastUpdateTime?: Date;
+  /**
+   * The trading volume reported by the exchange for the market, or 0 if the
+   * exchange does not report volume. Used to weight the price when the index
+   * price is a volume-weighted median.
+   */
+
+  volume: Long;
 }
 /** ExchangePrice represents a specific exchange's market price */
 
@@ -30,6 +43,13 @@ export interface ExchangePriceSDKType {
   exchange_id: string;
   price: Long;
   last_update_time?: Date;
+  /**
+   * The trading volume reported by the exchange for the market, or 0 if the
+   * exchange does not report volume. Used to weight the price when the index
+   * price is a volume-weighted median.
+   */
+
+  volume: Long;
 }
 /** MarketPriceUpdate represents an update to a single market */
 
@@ -43,10 +63,147 @@ export interface MarketPriceUpdateSDKType {
   market_id: number;
   exchange_prices: ExchangePriceSDKType[];
 }
+/**
+ * ExchangeStatus represents the health of a specific exchange as observed by
+ * the pricefeed daemon.
+ */
+
+export interface ExchangeStatus {
+  exchangeId: string;
+  /** The number of failed price queries since the daemon started. */
+
+  errorCount: Long;
+  /** The time of the last price successfully reported by the exchange, if any. */
+
+  lastSuccessTime?: Date;
+  /** The time of the last failed price query, if any. */
+
+  lastErrorTime?: Date;
+  /** The reason the last failed price query was dropped, e.g. "rate_limit". */
+
+  lastErrorReason: string;
+  /** The error message of the last failed price query. */
+
+  lastError: string;
+}
+/**
+ * ExchangeStatus represents the health of a specific exchange as observed by
+ * the pricefeed daemon.
+ */
+
+export interface ExchangeStatusSDKType {
+  exchange_id: string;
+  /** The number of failed price queries since the daemon started. */
+
+  error_count: Long;
+  /** The time of the last price successfully reported by the exchange, if any. */
+
+  last_success_time?: Date;
+  /** The time of the last failed price query, if any. */
+
+  last_error_time?: Date;
+  /** The reason the last failed price query was dropped, e.g. "rate_limit". */
+
+  last_error_reason: string;
+  /** The error message of the last failed price query. */
+
+  last_error: string;
+}
+/** MarketPricesRequest is a request message for the prices of markets. */
+
+export interface MarketPricesRequest {
+  /** The ids of the markets to return. All markets are returned if empty. */
+  marketIds: number[];
+}
+/** MarketPricesRequest is a request message for the prices of markets. */
+
+export interface MarketPricesRequestSDKType {
+  /** The ids of the markets to return. All markets are returned if empty. */
+  market_ids: number[];
+}
+/**
+ * MarketPricesResponse is a response message containing the prices of
+ * markets.
+ */
+
+export interface MarketPricesResponse {
+  marketPrices: MarketPrices[];
+}
+/**
+ * MarketPricesResponse is a response message containing the prices of
+ * markets.
+ */
+
+export interface MarketPricesResponseSDKType {
+  market_prices: MarketPricesSDKType[];
+}
+/** MarketPrices represents the prices reported for a single market. */
+
+export interface MarketPrices {
+  marketId: number;
+  /** The price last reported by each exchange, regardless of its age. */
+
+  exchangePrices: ExchangePrice[];
+  /**
+   * The most recent index price of the market, or 0 if no valid index price
+   * has been computed.
+   */
+
+  indexPrice: Long;
+  /** The ids of the exchanges whose prices the index price was computed from. */
+
+  indexPriceExchangeIds: string[];
+  /** The time at which the index price was computed. */
+
+  indexPriceTime?: Date;
+}
+/** MarketPrices represents the prices reported for a single market. */
+
+export interface MarketPricesSDKType {
+  market_id: number;
+  /** The price last reported by each exchange, regardless of its age. */
+
+  exchange_prices: ExchangePriceSDKType[];
+  /**
+   * The most recent index price of the market, or 0 if no valid index price
+   * has been computed.
+   */
+
+  index_price: Long;
+  /** The ids of the exchanges whose prices the index price was computed from. */
+
+  index_price_exchange_ids: string[];
+  /** The time at which the index price was computed. */
+
+  index_price_time?: Date;
+}
+/** ExchangeStatusesRequest is a request message for the health of exchanges. */
+
+export interface ExchangeStatusesRequest {}
+/** ExchangeStatusesRequest is a request message for the health of exchanges. */
+
+export interface ExchangeStatusesRequestSDKType {}
+/**
+ * ExchangeStatusesResponse is a response message containing the health of
+ * each exchange.
+ */
+
+export interface ExchangeStatusesResponse {
+  exchangeStatuses: ExchangeStatus[];
+}
+/**
+ * ExchangeStatusesResponse is a response message containing the health of
+ * each exchange.
+ */
+
+export interface ExchangeStatusesResponseSDKType {
+  exchange_statuses: ExchangeStatusSDKType[];
+}
 
 function createBaseUpdateMarketPricesRequest(): UpdateMarketPricesRequest {
   return {
-    marketPriceUpdates: []
+    marketPriceUpdates: [],
+    exchangeStatuses: []
   };
 }
 
@@ -56,6 +213,10 @@ export const UpdateMarketPricesRequest = {
       MarketPriceUpdate.encode(v!, writer.uint32(10).fork()).ldelim();
     }
 
+    for (const v of message.exchangeStatuses) {
+      ExchangeStatus.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -72,6 +233,10 @@ export const UpdateMarketPricesRequest = {
           message.marketPriceUpdates.push(MarketPriceUpdate.decode(reader, reader.uint32()));
           break;
 
+        case 2:
+          message.exchangeStatuses.push(ExchangeStatus.decode(reader, reader.uint32()));
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -84,6 +249,7 @@ export const UpdateMarketPricesRequest = {
   fromPartial(object: DeepPartial<UpdateMarketPricesRequest>): UpdateMarketPricesRequest {
     const message = createBaseUpdateMarketPricesRequest();
     message.marketPriceUpdates = object.marketPriceUpdates?.map(e => MarketPriceUpdate.fromPartial(e)) || [];
+    message.exchangeStatuses = object.exchangeStatuses?.map(e => ExchangeStatus.fromPartial(e)) || [];
     return message;
   }
 
@@ -127,7 +293,8 @@ function createBaseExchangePrice(): ExchangePrice {
   return {
     exchangeId: "",
     price: Long.UZERO,
-    lastUpdateTime: undefined
+    lastUpdateTime: undefined,
+    volume: Long.UZERO
   };
 }
 
@@ -145,6 +312,10 @@ export const ExchangePrice = {
       Timestamp.encode(toTimestamp(message.lastUpdateTime), writer.uint32(26).fork()).ldelim();
     }
 
+    if (!message.volume.isZero()) {
+      writer.uint32(32).uint64(message.volume);
+    }
+
     return writer;
   },
 
@@ -169,6 +340,10 @@ export const ExchangePrice = {
           message.lastUpdateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           break;
 
+        case 4:
+          message.volume = (reader.uint64() as Long);
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -183,6 +358,7 @@ export const ExchangePrice = {
     message.exchangeId = object.exchangeId ?? "";
     message.price = object.price !== undefined && object.price !== null ? Long.fromValue(object.price) : Long.UZERO;
     message.lastUpdateTime = object.lastUpdateTime ?? undefined;
+    message.volume = object.volume !== undefined && object.volume !== null ? Long.fromValue(object.volume) : Long.UZERO;
     return message;
   }
 
@@ -241,4 +417,365 @@ export const MarketPriceUpdate = {
     return message;
   }
 
+};
+
+function createBaseExchangeStatus(): ExchangeStatus {
+  return {
+    exchangeId: "",
+    errorCount: Long.UZERO,
+    lastSuccessTime: undefined,
+    lastErrorTime: undefined,
+    lastErrorReason: "",
+    lastError: ""
+  };
+}
+
+export const ExchangeStatus = {
+  encode(message: ExchangeStatus, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.exchangeId !== "") {
+      writer.uint32(10).string(message.exchangeId);
+    }
+
+    if (!message.errorCount.isZero()) {
+      writer.uint32(16).uint64(message.errorCount);
+    }
+
+    if (message.lastSuccessTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastSuccessTime), writer.uint32(26).fork()).ldelim();
+    }
+
+    if (message.lastErrorTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastErrorTime), writer.uint32(34).fork()).ldelim();
+    }
+
+    if (message.lastErrorReason !== "") {
+      writer.uint32(42).string(message.lastErrorReason);
+    }
+
+    if (message.lastError !== "") {
+      writer.uint32(50).string(message.lastError);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExchangeStatus {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExchangeStatus();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.exchangeId = reader.string();
+          break;
+
+        case 2:
+          message.errorCount = (reader.uint64() as Long);
+          break;
+
+        case 3:
+          message.lastSuccessTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+
+        case 4:
+          message.lastErrorTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+
+        case 5:
+          message.lastErrorReason = reader.string();
+          break;
+
+        case 6:
+          message.lastError = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<ExchangeStatus>): ExchangeStatus {
+    const message = createBaseExchangeStatus();
+    message.exchangeId = object.exchangeId ?? "";
+    message.errorCount = object.errorCount !== undefined && object.errorCount !== null ? Long.fromValue(object.errorCount) : Long.UZERO;
+    message.lastSuccessTime = object.lastSuccessTime ?? undefined;
+    message.lastErrorTime = object.lastErrorTime ?? undefined;
+    message.lastErrorReason = object.lastErrorReason ?? "";
+    message.lastError = object.lastError ?? "";
+    return message;
+  }
+
+};
+
+function createBaseMarketPricesRequest(): MarketPricesRequest {
+  return {
+    marketIds: []
+  };
+}
+
+export const MarketPricesRequest = {
+  encode(message: MarketPricesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    writer.uint32(10).fork();
+
+    for (const v of message.marketIds) {
+      writer.uint32(v);
+    }
+
+    writer.ldelim();
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MarketPricesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMarketPricesRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+
+            while (reader.pos < end2) {
+              message.marketIds.push(reader.uint32());
+            }
+          } else {
+            message.marketIds.push(reader.uint32());
+          }
+
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MarketPricesRequest>): MarketPricesRequest {
+    const message = createBaseMarketPricesRequest();
+    message.marketIds = object.marketIds?.map(e => e) || [];
+    return message;
+  }
+
+};
+
+function createBaseMarketPricesResponse(): MarketPricesResponse {
+  return {
+    marketPrices: []
+  };
+}
+
+export const MarketPricesResponse = {
+  encode(message: MarketPricesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.marketPrices) {
+      MarketPrices.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MarketPricesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMarketPricesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.marketPrices.push(MarketPrices.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MarketPricesResponse>): MarketPricesResponse {
+    const message = createBaseMarketPricesResponse();
+    message.marketPrices = object.marketPrices?.map(e => MarketPrices.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseMarketPrices(): MarketPrices {
+  return {
+    marketId: 0,
+    exchangePrices: [],
+    indexPrice: Long.UZERO,
+    indexPriceExchangeIds: [],
+    indexPriceTime: undefined
+  };
+}
+
+export const MarketPrices = {
+  encode(message: MarketPrices, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.marketId !== 0) {
+      writer.uint32(8).uint32(message.marketId);
+    }
+
+    for (const v of message.exchangePrices) {
+      ExchangePrice.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (!message.indexPrice.isZero()) {
+      writer.uint32(24).uint64(message.indexPrice);
+    }
+
+    for (const v of message.indexPriceExchangeIds) {
+      writer.uint32(34).string(v!);
+    }
+
+    if (message.indexPriceTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.indexPriceTime), writer.uint32(42).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MarketPrices {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMarketPrices();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.marketId = reader.uint32();
+          break;
+
+        case 2:
+          message.exchangePrices.push(ExchangePrice.decode(reader, reader.uint32()));
+          break;
+
+        case 3:
+          message.indexPrice = (reader.uint64() as Long);
+          break;
+
+        case 4:
+          message.indexPriceExchangeIds.push(reader.string());
+          break;
+
+        case 5:
+          message.indexPriceTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MarketPrices>): MarketPrices {
+    const message = createBaseMarketPrices();
+    message.marketId = object.marketId ?? 0;
+    message.exchangePrices = object.exchangePrices?.map(e => ExchangePrice.fromPartial(e)) || [];
+    message.indexPrice = object.indexPrice !== undefined && object.indexPrice !== null ? Long.fromValue(object.indexPrice) : Long.UZERO;
+    message.indexPriceExchangeIds = object.indexPriceExchangeIds?.map(e => e) || [];
+    message.indexPriceTime = object.indexPriceTime ?? undefined;
+    return message;
+  }
+
+};
+
+function createBaseExchangeStatusesRequest(): ExchangeStatusesRequest {
+  return {};
+}
+
+export const ExchangeStatusesRequest = {
+  encode(_: ExchangeStatusesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExchangeStatusesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExchangeStatusesRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<ExchangeStatusesRequest>): ExchangeStatusesRequest {
+    const message = createBaseExchangeStatusesRequest();
+    return message;
+  }
+
+};
+
+function createBaseExchangeStatusesResponse(): ExchangeStatusesResponse {
+  return {
+    exchangeStatuses: []
+  };
+}
+
+export const ExchangeStatusesResponse = {
+  encode(message: ExchangeStatusesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.exchangeStatuses) {
+      ExchangeStatus.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExchangeStatusesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExchangeStatusesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.exchangeStatuses.push(ExchangeStatus.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<ExchangeStatusesResponse>): ExchangeStatusesResponse {
+    const message = createBaseExchangeStatusesResponse();
+    message.exchangeStatuses = object.exchangeStatuses?.map(e => ExchangeStatus.fromPartial(e)) || [];
+    return message;
+  }
+
 };
\ No newline at end of file
@@ -0,0 +1,274 @@
+import { Timestamp } from "../../../google/protobuf/timestamp";
+import { Duration, DurationSDKType } from "../../../google/protobuf/duration";
+import * as _m0 from "protobufjs/minimal";
+import { toTimestamp, fromTimestamp, DeepPartial } from "../../../helpers";
+/**
+ * DaemonHealthRequest is a request message for the health of all registered
+ * daemons.
+ */
+
+export interface DaemonHealthRequest {}
+/**
+ * DaemonHealthRequest is a request message for the health of all registered
+ * daemons.
+ */
+
+export interface DaemonHealthRequestSDKType {}
+/** DaemonHealthResponse is a response message for DaemonHealthRequest. */
+
+export interface DaemonHealthResponse {
+  /** The health of each registered daemon, sorted by service name. */
+  daemonHealth: DaemonHealth[];
+}
+/** DaemonHealthResponse is a response message for DaemonHealthRequest. */
+
+export interface DaemonHealthResponseSDKType {
+  /** The health of each registered daemon, sorted by service name. */
+  daemon_health: DaemonHealthSDKType[];
+}
+/**
+ * DaemonHealth describes the health of a daemon registered with the daemon
+ * server.
+ */
+
+export interface DaemonHealth {
+  /** The name of the daemon service, e.g. "pricefeed-daemon". */
+  service: string;
+  /**
+   * The policy applied when the daemon stops responding. One of "halt", "log"
+   * or "degrade".
+   */
+
+  stalenessPolicy: string;
+  /**
+   * Whether the daemon failed to respond within its maximum acceptable update
+   * delay and has not responded since.
+   */
+
+  stale: boolean;
+  /**
+   * Whether the node is in degraded mode for the daemon. A degraded node does
+   * not propose the data provided by the daemon, but continues to vote.
+   */
+
+  degraded: boolean;
+  /**
+   * The time of the most recent valid response from the daemon. Unset if the
+   * daemon has not yet responded.
+   */
+
+  lastUpdateTime?: Date;
+  /** The maximum delay between valid responses before the daemon is stale. */
+
+  maximumAcceptableUpdateDelay?: Duration;
+}
+/**
+ * DaemonHealth describes the health of a daemon registered with the daemon
+ * server.
+ */
+
+export interface DaemonHealthSDKType {
+  /** The name of the daemon service, e.g. "pricefeed-daemon". */
+  service: string;
+  /**
+   * The policy applied when the daemon stops responding. One of "halt", "log"
+   * or "degrade".
+   */
+
+  staleness_policy: string;
+  /**
+   * Whether the daemon failed to respond within its maximum acceptable update
+   * delay and has not responded since.
+   */
+
+  stale: boolean;
+  /**
+   * Whether the node is in degraded mode for the daemon. A degraded node does
+   * not propose the data provided by the daemon, but continues to vote.
+   */
+
+  degraded: boolean;
+  /**
+   * The time of the most recent valid response from the daemon. Unset if the
+   * daemon has not yet responded.
+   */
+
+  last_update_time?: Date;
+  /** The maximum delay between valid responses before the daemon is stale. */
+
+  maximum_acceptable_update_delay?: DurationSDKType;
+}
+
+function createBaseDaemonHealthRequest(): DaemonHealthRequest {
+  return {};
+}
+
+export const DaemonHealthRequest = {
+  encode(_: DaemonHealthRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DaemonHealthRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDaemonHealthRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<DaemonHealthRequest>): DaemonHealthRequest {
+    const message = createBaseDaemonHealthRequest();
+    return message;
+  }
+
+};
+
+function createBaseDaemonHealthResponse(): DaemonHealthResponse {
+  return {
+    daemonHealth: []
+  };
+}
+
+export const DaemonHealthResponse = {
+  encode(message: DaemonHealthResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.daemonHealth) {
+      DaemonHealth.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DaemonHealthResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDaemonHealthResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.daemonHealth.push(DaemonHealth.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<DaemonHealthResponse>): DaemonHealthResponse {
+    const message = createBaseDaemonHealthResponse();
+    message.daemonHealth = object.daemonHealth?.map(e => DaemonHealth.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseDaemonHealth(): DaemonHealth {
+  return {
+    service: "",
+    stalenessPolicy: "",
+    stale: false,
+    degraded: false,
+    lastUpdateTime: undefined,
+    maximumAcceptableUpdateDelay: undefined
+  };
+}
+
+export const DaemonHealth = {
+  encode(message: DaemonHealth, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.service !== "") {
+      writer.uint32(10).string(message.service);
+    }
+
+    if (message.stalenessPolicy !== "") {
+      writer.uint32(18).string(message.stalenessPolicy);
+    }
+
+    if (message.stale === true) {
+      writer.uint32(24).bool(message.stale);
+    }
+
+    if (message.degraded === true) {
+      writer.uint32(32).bool(message.degraded);
+    }
+
+    if (message.lastUpdateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastUpdateTime), writer.uint32(42).fork()).ldelim();
+    }
+
+    if (message.maximumAcceptableUpdateDelay !== undefined) {
+      Duration.encode(message.maximumAcceptableUpdateDelay, writer.uint32(50).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DaemonHealth {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDaemonHealth();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.service = reader.string();
+          break;
+
+        case 2:
+          message.stalenessPolicy = reader.string();
+          break;
+
+        case 3:
+          message.stale = reader.bool();
+          break;
+
+        case 4:
+          message.degraded = reader.bool();
+          break;
+
+        case 5:
+          message.lastUpdateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+
+        case 6:
+          message.maximumAcceptableUpdateDelay = Duration.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<DaemonHealth>): DaemonHealth {
+    const message = createBaseDaemonHealth();
+    message.service = object.service ?? "";
+    message.stalenessPolicy = object.stalenessPolicy ?? "";
+    message.stale = object.stale ?? false;
+    message.degraded = object.degraded ?? false;
+    message.lastUpdateTime = object.lastUpdateTime ?? undefined;
+    message.maximumAcceptableUpdateDelay = object.maximumAcceptableUpdateDelay !== undefined && object.maximumAcceptableUpdateDelay !== null ? Duration.fromPartial(object.maximumAcceptableUpdateDelay) : undefined;
+    return message;
+  }
+
+};
\ No newline at end of file
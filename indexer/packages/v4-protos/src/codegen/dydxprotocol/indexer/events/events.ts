@@ -116,6 +116,21 @@ export interface FundingUpdateV1 {
    */
 
   fundingIndex: Uint8Array;
+  /**
+   * The premium component (in parts-per-million) of the funding rate, which
+   * includes the default funding of the perpetual. Only set if parent
+   * `FundingEvent` type is `TYPE_FUNDING_RATE_AND_INDEX`.
+   */
+
+  premiumPpm: number;
+  /**
+   * The interest rate component (in parts-per-million) of the funding rate.
+   * The funding rate is the sum of `premium_ppm` and `interest_ppm`, clamped
+   * to the bounds of the perpetual. Only set if parent `FundingEvent` type is
+   * `TYPE_FUNDING_RATE_AND_INDEX`.
+   */
+
+  interestPpm: number;
 }
 /**
  * FundingUpdate is used for funding update events and includes a funding
@@ -137,6 +152,21 @@ export interface FundingUpdateV1SDKType {
    */
 
   funding_index: Uint8Array;
+  /**
+   * The premium component (in parts-per-million) of the funding rate, which
+   * includes the default funding of the perpetual. Only set if parent
+   * `FundingEvent` type is `TYPE_FUNDING_RATE_AND_INDEX`.
+   */
+
+  premium_ppm: number;
+  /**
+   * The interest rate component (in parts-per-million) of the funding rate.
+   * The funding rate is the sum of `premium_ppm` and `interest_ppm`, clamped
+   * to the bounds of the perpetual. Only set if parent `FundingEvent` type is
+   * `TYPE_FUNDING_RATE_AND_INDEX`.
+   */
+
+  interest_ppm: number;
 }
 /**
  * FundingEvent message contains a list of per-market funding values. The
@@ -551,6 +581,8 @@ export interface StatefulOrderEventV1 {
   conditionalOrderPlacement?: StatefulOrderEventV1_ConditionalOrderPlacementV1;
   conditionalOrderTriggered?: StatefulOrderEventV1_ConditionalOrderTriggeredV1;
   longTermOrderPlacement?: StatefulOrderEventV1_LongTermOrderPlacementV1;
+  conditionalOrderTriggerSubticksUpdate?: StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1;
+  longTermOrderReplacement?: StatefulOrderEventV1_LongTermOrderReplacementV1;
 }
 /**
  * StatefulOrderEvent message contains information about a change to a stateful
@@ -565,6 +597,8 @@ export interface StatefulOrderEventV1SDKType {
   conditional_order_placement?: StatefulOrderEventV1_ConditionalOrderPlacementV1SDKType;
   conditional_order_triggered?: StatefulOrderEventV1_ConditionalOrderTriggeredV1SDKType;
   long_term_order_placement?: StatefulOrderEventV1_LongTermOrderPlacementV1SDKType;
+  conditional_order_trigger_subticks_update?: StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1SDKType;
+  long_term_order_replacement?: StatefulOrderEventV1_LongTermOrderReplacementV1SDKType;
 }
 /** A stateful order placement contains an order. */
 
@@ -636,6 +670,42 @@ export interface StatefulOrderEventV1_LongTermOrderPlacementV1 {
 export interface StatefulOrderEventV1_LongTermOrderPlacementV1SDKType {
   order?: IndexerOrderSDKType;
 }
+/**
+ * A conditional order trigger subticks update contains an order id and the
+ * new trigger price of an untriggered trailing stop order. It is emitted
+ * when the trigger price of the order follows the oracle price.
+ */
+
+export interface StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
+  orderId?: IndexerOrderId;
+  conditionalOrderTriggerSubticks: Long;
+}
+/**
+ * A conditional order trigger subticks update contains an order id and the
+ * new trigger price of an untriggered trailing stop order. It is emitted
+ * when the trigger price of the order follows the oracle price.
+ */
+
+export interface StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1SDKType {
+  order_id?: IndexerOrderIdSDKType;
+  conditional_order_trigger_subticks: Long;
+}
+/**
+ * A long term order replacement contains the new version of a long term
+ * order, which has the same order id as the order it replaces.
+ */
+
+export interface StatefulOrderEventV1_LongTermOrderReplacementV1 {
+  order?: IndexerOrder;
+}
+/**
+ * A long term order replacement contains the new version of a long term
+ * order, which has the same order id as the order it replaces.
+ */
+
+export interface StatefulOrderEventV1_LongTermOrderReplacementV1SDKType {
+  order?: IndexerOrderSDKType;
+}
 /**
  * AssetCreateEventV1 message contains all the information about an new Asset on
  * the v4 chain.
@@ -1058,12 +1128,260 @@ export interface UpdatePerpetualEventV1SDKType {
 
   liquidity_tier: number;
 }
+/**
+ * SpotMarketCreateEventV1 message contains all the information about a
+ * new Spot Market on the v4 chain.
+ */
+
+export interface SpotMarketCreateEventV1 {
+  /**
+   * Unique clob pair Id associated with this spot market
+   * Defined in clob.clob_pair
+   */
+  clobPairId: number;
+  /**
+   * Id of the base Asset in the trading pair.
+   * Defined in clob.clob_pair
+   */
+
+  baseAssetId: number;
+  /**
+   * Id of the quote Asset in the trading pair.
+   * Defined in clob.clob_pair
+   */
+
+  quoteAssetId: number;
+  /** Status of the CLOB */
+
+  status: ClobPairStatus;
+  /**
+   * `10^Exponent` gives the number of QuoteQuantums traded per BaseQuantum
+   * per Subtick.
+   * Defined in clob.clob_pair
+   */
+
+  quantumConversionExponent: number;
+  /**
+   * Defines the tick size of the orderbook by defining how many subticks
+   * are in one tick. That is, the subticks of any valid order must be a
+   * multiple of this value. Generally this value should start `>= 100`to
+   * allow room for decreasing it.
+   * Defined in clob.clob_pair
+   */
+
+  subticksPerTick: number;
+  /**
+   * Minimum increment in the size of orders on the CLOB, in base quantums.
+   * Defined in clob.clob_pair
+   */
+
+  stepBaseQuantums: Long;
+}
+/**
+ * SpotMarketCreateEventV1 message contains all the information about a
+ * new Spot Market on the v4 chain.
+ */
+
+export interface SpotMarketCreateEventV1SDKType {
+  /**
+   * Unique clob pair Id associated with this spot market
+   * Defined in clob.clob_pair
+   */
+  clob_pair_id: number;
+  /**
+   * Id of the base Asset in the trading pair.
+   * Defined in clob.clob_pair
+   */
+
+  base_asset_id: number;
+  /**
+   * Id of the quote Asset in the trading pair.
+   * Defined in clob.clob_pair
+   */
+
+  quote_asset_id: number;
+  /** Status of the CLOB */
+
+  status: ClobPairStatusSDKType;
+  /**
+   * `10^Exponent` gives the number of QuoteQuantums traded per BaseQuantum
+   * per Subtick.
+   * Defined in clob.clob_pair
+   */
+
+  quantum_conversion_exponent: number;
+  /**
+   * Defines the tick size of the orderbook by defining how many subticks
+   * are in one tick. That is, the subticks of any valid order must be a
+   * multiple of this value. Generally this value should start `>= 100`to
+   * allow room for decreasing it.
+   * Defined in clob.clob_pair
+   */
+
+  subticks_per_tick: number;
+  /**
+   * Minimum increment in the size of orders on the CLOB, in base quantums.
+   * Defined in clob.clob_pair
+   */
+
+  step_base_quantums: Long;
+}
+/**
+ * DeleveragingEventV1 message contains all the information about a
+ * deleveraging fill on the v4 chain. This includes the liquidated and
+ * offsetting subaccounts, the amount filled and the bankruptcy price of the
+ * liquidated position at which both positions were closed.
+ */
+
+export interface DeleveragingEventV1 {
+  /** The subaccount whose position was deleveraged. */
+  liquidated?: IndexerSubaccountId;
+  /** The subaccount whose position was used to offset the liquidated position. */
+
+  offsetting?: IndexerSubaccountId;
+  /** Id of the perpetual that was deleveraged. */
+
+  perpetualId: number;
+  /** Fill amount in base quantums. */
+
+  fillAmount: Long;
+  /**
+   * Bankruptcy price of the liquidated position in subticks, rounded down.
+   * Negative if the liquidated position was closed at a negative bankruptcy
+   * price. Serialized as a `SerializableInt`, since the bankruptcy price is
+   * not bounded by the range of an int64.
+   */
+
+  subticks: Uint8Array;
+  /**
+   * Total quote quantums paid by the liquidated subaccount at the bankruptcy
+   * price. Negative if the liquidated subaccount received quote quantums.
+   * Serialized as a `SerializableInt`.
+   */
+
+  totalQuoteQuantums: Uint8Array;
+  /**
+   * `true` if the liquidated position is short and was bought back, `false`
+   * otherwise.
+   */
+
+  isBuy: boolean;
+}
+/**
+ * DeleveragingEventV1 message contains all the information about a
+ * deleveraging fill on the v4 chain. This includes the liquidated and
+ * offsetting subaccounts, the amount filled and the bankruptcy price of the
+ * liquidated position at which both positions were closed.
+ */
+
+export interface DeleveragingEventV1SDKType {
+  /** The subaccount whose position was deleveraged. */
+  liquidated?: IndexerSubaccountIdSDKType;
+  /** The subaccount whose position was used to offset the liquidated position. */
+
+  offsetting?: IndexerSubaccountIdSDKType;
+  /** Id of the perpetual that was deleveraged. */
+
+  perpetual_id: number;
+  /** Fill amount in base quantums. */
+
+  fill_amount: Long;
+  /**
+   * Bankruptcy price of the liquidated position in subticks, rounded down.
+   * Negative if the liquidated position was closed at a negative bankruptcy
+   * price. Serialized as a `SerializableInt`, since the bankruptcy price is
+   * not bounded by the range of an int64.
+   */
+
+  subticks: Uint8Array;
+  /**
+   * Total quote quantums paid by the liquidated subaccount at the bankruptcy
+   * price. Negative if the liquidated subaccount received quote quantums.
+   * Serialized as a `SerializableInt`.
+   */
+
+  total_quote_quantums: Uint8Array;
+  /**
+   * `true` if the liquidated position is short and was bought back, `false`
+   * otherwise.
+   */
+
+  is_buy: boolean;
+}
+/**
+ * SpotOrderFillEventV1 message contains all the information from an order
+ * match of a spot clob pair in the dYdX chain. This includes the maker and
+ * taker orders, the amount filled and the fees paid. Spot fills are settled
+ * by transferring the base asset between the asset positions of the maker and
+ * taker subaccounts.
+ */
+
+export interface SpotOrderFillEventV1 {
+  makerOrder?: IndexerOrder;
+  takerOrder?: IndexerOrder;
+  /** Id of the base Asset in the trading pair. */
+
+  baseAssetId: number;
+  /** Id of the quote Asset in the trading pair. */
+
+  quoteAssetId: number;
+  /** Fill amount in base quantums. */
+
+  fillAmount: Long;
+  /** Maker fee in quote quantums. */
+
+  makerFee: Long;
+  /** Taker fee in quote quantums. */
+
+  takerFee: Long;
+  /** Total filled of the maker order in base quantums. */
+
+  totalFilledMaker: Long;
+  /** Total filled of the taker order in base quantums. */
+
+  totalFilledTaker: Long;
+}
+/**
+ * SpotOrderFillEventV1 message contains all the information from an order
+ * match of a spot clob pair in the dYdX chain. This includes the maker and
+ * taker orders, the amount filled and the fees paid. Spot fills are settled
+ * by transferring the base asset between the asset positions of the maker and
+ * taker subaccounts.
+ */
+
+export interface SpotOrderFillEventV1SDKType {
+  maker_order?: IndexerOrderSDKType;
+  taker_order?: IndexerOrderSDKType;
+  /** Id of the base Asset in the trading pair. */
+
+  base_asset_id: number;
+  /** Id of the quote Asset in the trading pair. */
+
+  quote_asset_id: number;
+  /** Fill amount in base quantums. */
+
+  fill_amount: Long;
+  /** Maker fee in quote quantums. */
+
+  maker_fee: Long;
+  /** Taker fee in quote quantums. */
+
+  taker_fee: Long;
+  /** Total filled of the maker order in base quantums. */
+
+  total_filled_maker: Long;
+  /** Total filled of the taker order in base quantums. */
+
+  total_filled_taker: Long;
+}
 
 function createBaseFundingUpdateV1(): FundingUpdateV1 {
   return {
     perpetualId: 0,
     fundingValuePpm: 0,
-    fundingIndex: new Uint8Array()
+    fundingIndex: new Uint8Array(),
+    premiumPpm: 0,
+    interestPpm: 0
   };
 }
 
@@ -1081,6 +1399,14 @@ export const FundingUpdateV1 = {
       writer.uint32(26).bytes(message.fundingIndex);
     }
 
+    if (message.premiumPpm !== 0) {
+      writer.uint32(32).int32(message.premiumPpm);
+    }
+
+    if (message.interestPpm !== 0) {
+      writer.uint32(40).int32(message.interestPpm);
+    }
+
     return writer;
   },
 
@@ -1105,6 +1431,14 @@ export const FundingUpdateV1 = {
           message.fundingIndex = reader.bytes();
           break;
 
+        case 4:
+          message.premiumPpm = reader.int32();
+          break;
+
+        case 5:
+          message.interestPpm = reader.int32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -1119,6 +1453,8 @@ export const FundingUpdateV1 = {
     message.perpetualId = object.perpetualId ?? 0;
     message.fundingValuePpm = object.fundingValuePpm ?? 0;
     message.fundingIndex = object.fundingIndex ?? new Uint8Array();
+    message.premiumPpm = object.premiumPpm ?? 0;
+    message.interestPpm = object.interestPpm ?? 0;
     return message;
   }
 
@@ -1885,7 +2221,9 @@ function createBaseStatefulOrderEventV1(): StatefulOrderEventV1 {
     orderRemoval: undefined,
     conditionalOrderPlacement: undefined,
     conditionalOrderTriggered: undefined,
-    longTermOrderPlacement: undefined
+    longTermOrderPlacement: undefined,
+    conditionalOrderTriggerSubticksUpdate: undefined,
+    longTermOrderReplacement: undefined
   };
 }
 
@@ -1911,6 +2249,14 @@ export const StatefulOrderEventV1 = {
       StatefulOrderEventV1_LongTermOrderPlacementV1.encode(message.longTermOrderPlacement, writer.uint32(58).fork()).ldelim();
     }
 
+    if (message.conditionalOrderTriggerSubticksUpdate !== undefined) {
+      StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.encode(message.conditionalOrderTriggerSubticksUpdate, writer.uint32(66).fork()).ldelim();
+    }
+
+    if (message.longTermOrderReplacement !== undefined) {
+      StatefulOrderEventV1_LongTermOrderReplacementV1.encode(message.longTermOrderReplacement, writer.uint32(74).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -1943,6 +2289,14 @@ export const StatefulOrderEventV1 = {
           message.longTermOrderPlacement = StatefulOrderEventV1_LongTermOrderPlacementV1.decode(reader, reader.uint32());
           break;
 
+        case 8:
+          message.conditionalOrderTriggerSubticksUpdate = StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.decode(reader, reader.uint32());
+          break;
+
+        case 9:
+          message.longTermOrderReplacement = StatefulOrderEventV1_LongTermOrderReplacementV1.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -1959,6 +2313,8 @@ export const StatefulOrderEventV1 = {
     message.conditionalOrderPlacement = object.conditionalOrderPlacement !== undefined && object.conditionalOrderPlacement !== null ? StatefulOrderEventV1_ConditionalOrderPlacementV1.fromPartial(object.conditionalOrderPlacement) : undefined;
     message.conditionalOrderTriggered = object.conditionalOrderTriggered !== undefined && object.conditionalOrderTriggered !== null ? StatefulOrderEventV1_ConditionalOrderTriggeredV1.fromPartial(object.conditionalOrderTriggered) : undefined;
     message.longTermOrderPlacement = object.longTermOrderPlacement !== undefined && object.longTermOrderPlacement !== null ? StatefulOrderEventV1_LongTermOrderPlacementV1.fromPartial(object.longTermOrderPlacement) : undefined;
+    message.conditionalOrderTriggerSubticksUpdate = object.conditionalOrderTriggerSubticksUpdate !== undefined && object.conditionalOrderTriggerSubticksUpdate !== null ? StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.fromPartial(object.conditionalOrderTriggerSubticksUpdate) : undefined;
+    message.longTermOrderReplacement = object.longTermOrderReplacement !== undefined && object.longTermOrderReplacement !== null ? StatefulOrderEventV1_LongTermOrderReplacementV1.fromPartial(object.longTermOrderReplacement) : undefined;
     return message;
   }
 
@@ -2199,68 +2555,168 @@ export const StatefulOrderEventV1_LongTermOrderPlacementV1 = {
 
 };
 
-function createBaseAssetCreateEventV1(): AssetCreateEventV1 {
+function createBaseStatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1(): StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
   return {
-    id: 0,
-    symbol: "",
-    hasMarket: false,
-    marketId: 0,
-    atomicResolution: 0
+    orderId: undefined,
+    conditionalOrderTriggerSubticks: Long.UZERO
   };
 }
 
-export const AssetCreateEventV1 = {
-  encode(message: AssetCreateEventV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.id !== 0) {
-      writer.uint32(8).uint32(message.id);
-    }
-
-    if (message.symbol !== "") {
-      writer.uint32(18).string(message.symbol);
-    }
-
-    if (message.hasMarket === true) {
-      writer.uint32(24).bool(message.hasMarket);
-    }
-
-    if (message.marketId !== 0) {
-      writer.uint32(32).uint32(message.marketId);
+export const StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 = {
+  encode(message: StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.orderId !== undefined) {
+      IndexerOrderId.encode(message.orderId, writer.uint32(10).fork()).ldelim();
     }
 
-    if (message.atomicResolution !== 0) {
-      writer.uint32(40).sint32(message.atomicResolution);
+    if (!message.conditionalOrderTriggerSubticks.isZero()) {
+      writer.uint32(16).uint64(message.conditionalOrderTriggerSubticks);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): AssetCreateEventV1 {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAssetCreateEventV1();
+    const message = createBaseStatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.id = reader.uint32();
+          message.orderId = IndexerOrderId.decode(reader, reader.uint32());
           break;
 
         case 2:
-          message.symbol = reader.string();
-          break;
-
-        case 3:
-          message.hasMarket = reader.bool();
-          break;
-
-        case 4:
-          message.marketId = reader.uint32();
-          break;
-
-        case 5:
-          message.atomicResolution = reader.sint32();
+          message.conditionalOrderTriggerSubticks = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1>): StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
+    const message = createBaseStatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1();
+    message.orderId = object.orderId !== undefined && object.orderId !== null ? IndexerOrderId.fromPartial(object.orderId) : undefined;
+    message.conditionalOrderTriggerSubticks = object.conditionalOrderTriggerSubticks !== undefined && object.conditionalOrderTriggerSubticks !== null ? Long.fromValue(object.conditionalOrderTriggerSubticks) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseStatefulOrderEventV1_LongTermOrderReplacementV1(): StatefulOrderEventV1_LongTermOrderReplacementV1 {
+  return {
+    order: undefined
+  };
+}
+
+export const StatefulOrderEventV1_LongTermOrderReplacementV1 = {
+  encode(message: StatefulOrderEventV1_LongTermOrderReplacementV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.order !== undefined) {
+      IndexerOrder.encode(message.order, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatefulOrderEventV1_LongTermOrderReplacementV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatefulOrderEventV1_LongTermOrderReplacementV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.order = IndexerOrder.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<StatefulOrderEventV1_LongTermOrderReplacementV1>): StatefulOrderEventV1_LongTermOrderReplacementV1 {
+    const message = createBaseStatefulOrderEventV1_LongTermOrderReplacementV1();
+    message.order = object.order !== undefined && object.order !== null ? IndexerOrder.fromPartial(object.order) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseAssetCreateEventV1(): AssetCreateEventV1 {
+  return {
+    id: 0,
+    symbol: "",
+    hasMarket: false,
+    marketId: 0,
+    atomicResolution: 0
+  };
+}
+
+export const AssetCreateEventV1 = {
+  encode(message: AssetCreateEventV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+
+    if (message.symbol !== "") {
+      writer.uint32(18).string(message.symbol);
+    }
+
+    if (message.hasMarket === true) {
+      writer.uint32(24).bool(message.hasMarket);
+    }
+
+    if (message.marketId !== 0) {
+      writer.uint32(32).uint32(message.marketId);
+    }
+
+    if (message.atomicResolution !== 0) {
+      writer.uint32(40).sint32(message.atomicResolution);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AssetCreateEventV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAssetCreateEventV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.id = reader.uint32();
+          break;
+
+        case 2:
+          message.symbol = reader.string();
+          break;
+
+        case 3:
+          message.hasMarket = reader.bool();
+          break;
+
+        case 4:
+          message.marketId = reader.uint32();
+          break;
+
+        case 5:
+          message.atomicResolution = reader.sint32();
           break;
 
         default:
@@ -2672,4 +3128,339 @@ export const UpdatePerpetualEventV1 = {
     return message;
   }
 
+};
+
+function createBaseSpotMarketCreateEventV1(): SpotMarketCreateEventV1 {
+  return {
+    clobPairId: 0,
+    baseAssetId: 0,
+    quoteAssetId: 0,
+    status: 0,
+    quantumConversionExponent: 0,
+    subticksPerTick: 0,
+    stepBaseQuantums: Long.UZERO
+  };
+}
+
+export const SpotMarketCreateEventV1 = {
+  encode(message: SpotMarketCreateEventV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clobPairId !== 0) {
+      writer.uint32(8).uint32(message.clobPairId);
+    }
+
+    if (message.baseAssetId !== 0) {
+      writer.uint32(16).uint32(message.baseAssetId);
+    }
+
+    if (message.quoteAssetId !== 0) {
+      writer.uint32(24).uint32(message.quoteAssetId);
+    }
+
+    if (message.status !== 0) {
+      writer.uint32(32).int32(message.status);
+    }
+
+    if (message.quantumConversionExponent !== 0) {
+      writer.uint32(40).sint32(message.quantumConversionExponent);
+    }
+
+    if (message.subticksPerTick !== 0) {
+      writer.uint32(48).uint32(message.subticksPerTick);
+    }
+
+    if (!message.stepBaseQuantums.isZero()) {
+      writer.uint32(56).uint64(message.stepBaseQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SpotMarketCreateEventV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSpotMarketCreateEventV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.clobPairId = reader.uint32();
+          break;
+
+        case 2:
+          message.baseAssetId = reader.uint32();
+          break;
+
+        case 3:
+          message.quoteAssetId = reader.uint32();
+          break;
+
+        case 4:
+          message.status = (reader.int32() as any);
+          break;
+
+        case 5:
+          message.quantumConversionExponent = reader.sint32();
+          break;
+
+        case 6:
+          message.subticksPerTick = reader.uint32();
+          break;
+
+        case 7:
+          message.stepBaseQuantums = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<SpotMarketCreateEventV1>): SpotMarketCreateEventV1 {
+    const message = createBaseSpotMarketCreateEventV1();
+    message.clobPairId = object.clobPairId ?? 0;
+    message.baseAssetId = object.baseAssetId ?? 0;
+    message.quoteAssetId = object.quoteAssetId ?? 0;
+    message.status = object.status ?? 0;
+    message.quantumConversionExponent = object.quantumConversionExponent ?? 0;
+    message.subticksPerTick = object.subticksPerTick ?? 0;
+    message.stepBaseQuantums = object.stepBaseQuantums !== undefined && object.stepBaseQuantums !== null ? Long.fromValue(object.stepBaseQuantums) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseDeleveragingEventV1(): DeleveragingEventV1 {
+  return {
+    liquidated: undefined,
+    offsetting: undefined,
+    perpetualId: 0,
+    fillAmount: Long.UZERO,
+    subticks: new Uint8Array(),
+    totalQuoteQuantums: new Uint8Array(),
+    isBuy: false
+  };
+}
+
+export const DeleveragingEventV1 = {
+  encode(message: DeleveragingEventV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.liquidated !== undefined) {
+      IndexerSubaccountId.encode(message.liquidated, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.offsetting !== undefined) {
+      IndexerSubaccountId.encode(message.offsetting, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.perpetualId !== 0) {
+      writer.uint32(24).uint32(message.perpetualId);
+    }
+
+    if (!message.fillAmount.isZero()) {
+      writer.uint32(32).uint64(message.fillAmount);
+    }
+
+    if (message.subticks.length !== 0) {
+      writer.uint32(42).bytes(message.subticks);
+    }
+
+    if (message.totalQuoteQuantums.length !== 0) {
+      writer.uint32(50).bytes(message.totalQuoteQuantums);
+    }
+
+    if (message.isBuy === true) {
+      writer.uint32(56).bool(message.isBuy);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleveragingEventV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleveragingEventV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.liquidated = IndexerSubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.offsetting = IndexerSubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 4:
+          message.fillAmount = (reader.uint64() as Long);
+          break;
+
+        case 5:
+          message.subticks = reader.bytes();
+          break;
+
+        case 6:
+          message.totalQuoteQuantums = reader.bytes();
+          break;
+
+        case 7:
+          message.isBuy = reader.bool();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<DeleveragingEventV1>): DeleveragingEventV1 {
+    const message = createBaseDeleveragingEventV1();
+    message.liquidated = object.liquidated !== undefined && object.liquidated !== null ? IndexerSubaccountId.fromPartial(object.liquidated) : undefined;
+    message.offsetting = object.offsetting !== undefined && object.offsetting !== null ? IndexerSubaccountId.fromPartial(object.offsetting) : undefined;
+    message.perpetualId = object.perpetualId ?? 0;
+    message.fillAmount = object.fillAmount !== undefined && object.fillAmount !== null ? Long.fromValue(object.fillAmount) : Long.UZERO;
+    message.subticks = object.subticks ?? new Uint8Array();
+    message.totalQuoteQuantums = object.totalQuoteQuantums ?? new Uint8Array();
+    message.isBuy = object.isBuy ?? false;
+    return message;
+  }
+
+};
+
+function createBaseSpotOrderFillEventV1(): SpotOrderFillEventV1 {
+  return {
+    makerOrder: undefined,
+    takerOrder: undefined,
+    baseAssetId: 0,
+    quoteAssetId: 0,
+    fillAmount: Long.UZERO,
+    makerFee: Long.ZERO,
+    takerFee: Long.ZERO,
+    totalFilledMaker: Long.UZERO,
+    totalFilledTaker: Long.UZERO
+  };
+}
+
+export const SpotOrderFillEventV1 = {
+  encode(message: SpotOrderFillEventV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.makerOrder !== undefined) {
+      IndexerOrder.encode(message.makerOrder, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.takerOrder !== undefined) {
+      IndexerOrder.encode(message.takerOrder, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.baseAssetId !== 0) {
+      writer.uint32(24).uint32(message.baseAssetId);
+    }
+
+    if (message.quoteAssetId !== 0) {
+      writer.uint32(32).uint32(message.quoteAssetId);
+    }
+
+    if (!message.fillAmount.isZero()) {
+      writer.uint32(40).uint64(message.fillAmount);
+    }
+
+    if (!message.makerFee.isZero()) {
+      writer.uint32(48).sint64(message.makerFee);
+    }
+
+    if (!message.takerFee.isZero()) {
+      writer.uint32(56).sint64(message.takerFee);
+    }
+
+    if (!message.totalFilledMaker.isZero()) {
+      writer.uint32(64).uint64(message.totalFilledMaker);
+    }
+
+    if (!message.totalFilledTaker.isZero()) {
+      writer.uint32(72).uint64(message.totalFilledTaker);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SpotOrderFillEventV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSpotOrderFillEventV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.makerOrder = IndexerOrder.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.takerOrder = IndexerOrder.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.baseAssetId = reader.uint32();
+          break;
+
+        case 4:
+          message.quoteAssetId = reader.uint32();
+          break;
+
+        case 5:
+          message.fillAmount = (reader.uint64() as Long);
+          break;
+
+        case 6:
+          message.makerFee = (reader.sint64() as Long);
+          break;
+
+        case 7:
+          message.takerFee = (reader.sint64() as Long);
+          break;
+
+        case 8:
+          message.totalFilledMaker = (reader.uint64() as Long);
+          break;
+
+        case 9:
+          message.totalFilledTaker = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<SpotOrderFillEventV1>): SpotOrderFillEventV1 {
+    const message = createBaseSpotOrderFillEventV1();
+    message.makerOrder = object.makerOrder !== undefined && object.makerOrder !== null ? IndexerOrder.fromPartial(object.makerOrder) : undefined;
+    message.takerOrder = object.takerOrder !== undefined && object.takerOrder !== null ? IndexerOrder.fromPartial(object.takerOrder) : undefined;
+    message.baseAssetId = object.baseAssetId ?? 0;
+    message.quoteAssetId = object.quoteAssetId ?? 0;
+    message.fillAmount = object.fillAmount !== undefined && object.fillAmount !== null ? Long.fromValue(object.fillAmount) : Long.UZERO;
+    message.makerFee = object.makerFee !== undefined && object.makerFee !== null ? Long.fromValue(object.makerFee) : Long.ZERO;
+    message.takerFee = object.takerFee !== undefined && object.takerFee !== null ? Long.fromValue(object.takerFee) : Long.ZERO;
+    message.totalFilledMaker = object.totalFilledMaker !== undefined && object.totalFilledMaker !== null ? Long.fromValue(object.totalFilledMaker) : Long.UZERO;
+    message.totalFilledTaker = object.totalFilledTaker !== undefined && object.totalFilledTaker !== null ? Long.fromValue(object.totalFilledTaker) : Long.UZERO;
+    return message;
+  }
+
 };
\ No newline at end of file
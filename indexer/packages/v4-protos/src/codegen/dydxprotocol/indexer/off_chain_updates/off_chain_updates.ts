@@ -265,6 +265,24 @@ export interface OrderUpdateV1SDKType {
   order_id?: IndexerOrderIdSDKType;
   total_filled_quantums: Long;
 }
+/**
+ * OrderReplace messages contain the new version of an order that replaced an
+ * existing order with the same order id.
+ */
+
+export interface OrderReplaceV1 {
+  order?: IndexerOrder;
+  placementStatus: OrderPlaceV1_OrderPlacementStatus;
+}
+/**
+ * OrderReplace messages contain the new version of an order that replaced an
+ * existing order with the same order id.
+ */
+
+export interface OrderReplaceV1SDKType {
+  order?: IndexerOrderSDKType;
+  placement_status: OrderPlaceV1_OrderPlacementStatusSDKType;
+}
 /**
  * An OffChainUpdate message is the message type which will be sent on Kafka to
  * the Indexer.
@@ -274,6 +292,7 @@ export interface OffChainUpdateV1 {
   orderPlace?: OrderPlaceV1;
   orderRemove?: OrderRemoveV1;
   orderUpdate?: OrderUpdateV1;
+  orderReplace?: OrderReplaceV1;
 }
 /**
  * An OffChainUpdate message is the message type which will be sent on Kafka to
@@ -284,6 +303,7 @@ export interface OffChainUpdateV1SDKType {
   order_place?: OrderPlaceV1SDKType;
   order_remove?: OrderRemoveV1SDKType;
   order_update?: OrderUpdateV1SDKType;
+  order_replace?: OrderReplaceV1SDKType;
 }
 
 function createBaseOrderPlaceV1(): OrderPlaceV1 {
@@ -461,11 +481,67 @@ export const OrderUpdateV1 = {
 
 };
 
+function createBaseOrderReplaceV1(): OrderReplaceV1 {
+  return {
+    order: undefined,
+    placementStatus: 0
+  };
+}
+
+export const OrderReplaceV1 = {
+  encode(message: OrderReplaceV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.order !== undefined) {
+      IndexerOrder.encode(message.order, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.placementStatus !== 0) {
+      writer.uint32(16).int32(message.placementStatus);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderReplaceV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrderReplaceV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.order = IndexerOrder.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.placementStatus = (reader.int32() as any);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<OrderReplaceV1>): OrderReplaceV1 {
+    const message = createBaseOrderReplaceV1();
+    message.order = object.order !== undefined && object.order !== null ? IndexerOrder.fromPartial(object.order) : undefined;
+    message.placementStatus = object.placementStatus ?? 0;
+    return message;
+  }
+
+};
+
 function createBaseOffChainUpdateV1(): OffChainUpdateV1 {
   return {
     orderPlace: undefined,
     orderRemove: undefined,
-    orderUpdate: undefined
+    orderUpdate: undefined,
+    orderReplace: undefined
   };
 }
 
@@ -483,6 +559,10 @@ export const OffChainUpdateV1 = {
       OrderUpdateV1.encode(message.orderUpdate, writer.uint32(26).fork()).ldelim();
     }
 
+    if (message.orderReplace !== undefined) {
+      OrderReplaceV1.encode(message.orderReplace, writer.uint32(34).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -507,6 +587,10 @@ export const OffChainUpdateV1 = {
           message.orderUpdate = OrderUpdateV1.decode(reader, reader.uint32());
           break;
 
+        case 4:
+          message.orderReplace = OrderReplaceV1.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -521,6 +605,7 @@ export const OffChainUpdateV1 = {
     message.orderPlace = object.orderPlace !== undefined && object.orderPlace !== null ? OrderPlaceV1.fromPartial(object.orderPlace) : undefined;
     message.orderRemove = object.orderRemove !== undefined && object.orderRemove !== null ? OrderRemoveV1.fromPartial(object.orderRemove) : undefined;
     message.orderUpdate = object.orderUpdate !== undefined && object.orderUpdate !== null ? OrderUpdateV1.fromPartial(object.orderUpdate) : undefined;
+    message.orderReplace = object.orderReplace !== undefined && object.orderReplace !== null ? OrderReplaceV1.fromPartial(object.orderReplace) : undefined;
     return message;
   }
 
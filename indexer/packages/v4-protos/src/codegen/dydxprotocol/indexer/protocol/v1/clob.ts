@@ -208,6 +208,16 @@ export enum IndexerOrder_ConditionType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a distance of
+   * `conditional_order_trailing_percent_ppm` whenever the oracle price moves
+   * up for sells or down for buys. The trigger price never moves away from
+   * the oracle price.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export enum IndexerOrder_ConditionTypeSDKType {
@@ -230,6 +240,16 @@ export enum IndexerOrder_ConditionTypeSDKType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a distance of
+   * `conditional_order_trailing_percent_ppm` whenever the oracle price moves
+   * up for sells or down for buys. The trigger price never moves away from
+   * the oracle price.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export function indexerOrder_ConditionTypeFromJSON(object: any): IndexerOrder_ConditionType {
@@ -246,6 +266,10 @@ export function indexerOrder_ConditionTypeFromJSON(object: any): IndexerOrder_Co
     case "CONDITION_TYPE_TAKE_PROFIT":
       return IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT;
 
+    case 3:
+    case "CONDITION_TYPE_TRAILING_STOP":
+      return IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -263,6 +287,9 @@ export function indexerOrder_ConditionTypeToJSON(object: IndexerOrder_ConditionT
     case IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT:
       return "CONDITION_TYPE_TAKE_PROFIT";
 
+    case IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP:
+      return "CONDITION_TYPE_TRAILING_STOP";
+
     case IndexerOrder_ConditionType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -554,6 +581,21 @@ export interface IndexerOrder {
    */
 
   conditionalOrderTriggerSubticks: Long;
+  /**
+   * conditional_order_trailing_percent_ppm is the distance, in parts per
+   * million of the oracle price, that the trigger price of a trailing stop
+   * order is kept from the oracle price. Only nonzero for trailing stop
+   * orders.
+   */
+
+  conditionalOrderTrailingPercentPpm: number;
+  /**
+   * one_cancels_other_order_id is the id of the stateful order this order is
+   * paired with. When either order of the pair is triggered or filled, the
+   * other order is canceled.
+   */
+
+  oneCancelsOtherOrderId?: IndexerOrderId;
 }
 /**
  * IndexerOrderV1 represents a single order belonging to a `Subaccount`
@@ -624,6 +666,21 @@ export interface IndexerOrderSDKType {
    */
 
   conditional_order_trigger_subticks: Long;
+  /**
+   * conditional_order_trailing_percent_ppm is the distance, in parts per
+   * million of the oracle price, that the trigger price of a trailing stop
+   * order is kept from the oracle price. Only nonzero for trailing stop
+   * orders.
+   */
+
+  conditional_order_trailing_percent_ppm: number;
+  /**
+   * one_cancels_other_order_id is the id of the stateful order this order is
+   * paired with. When either order of the pair is triggered or filled, the
+   * other order is canceled.
+   */
+
+  one_cancels_other_order_id?: IndexerOrderIdSDKType;
 }
 
 function createBaseIndexerOrderId(): IndexerOrderId {
@@ -713,7 +770,9 @@ function createBaseIndexerOrder(): IndexerOrder {
     reduceOnly: false,
     clientMetadata: 0,
     conditionType: 0,
-    conditionalOrderTriggerSubticks: Long.UZERO
+    conditionalOrderTriggerSubticks: Long.UZERO,
+    conditionalOrderTrailingPercentPpm: 0,
+    oneCancelsOtherOrderId: undefined
   };
 }
 
@@ -763,6 +822,14 @@ export const IndexerOrder = {
       writer.uint32(88).uint64(message.conditionalOrderTriggerSubticks);
     }
 
+    if (message.conditionalOrderTrailingPercentPpm !== 0) {
+      writer.uint32(96).uint32(message.conditionalOrderTrailingPercentPpm);
+    }
+
+    if (message.oneCancelsOtherOrderId !== undefined) {
+      IndexerOrderId.encode(message.oneCancelsOtherOrderId, writer.uint32(106).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -819,6 +886,14 @@ export const IndexerOrder = {
           message.conditionalOrderTriggerSubticks = (reader.uint64() as Long);
           break;
 
+        case 12:
+          message.conditionalOrderTrailingPercentPpm = reader.uint32();
+          break;
+
+        case 13:
+          message.oneCancelsOtherOrderId = IndexerOrderId.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -841,6 +916,8 @@ export const IndexerOrder = {
     message.clientMetadata = object.clientMetadata ?? 0;
     message.conditionType = object.conditionType ?? 0;
     message.conditionalOrderTriggerSubticks = object.conditionalOrderTriggerSubticks !== undefined && object.conditionalOrderTriggerSubticks !== null ? Long.fromValue(object.conditionalOrderTriggerSubticks) : Long.UZERO;
+    message.conditionalOrderTrailingPercentPpm = object.conditionalOrderTrailingPercentPpm ?? 0;
+    message.oneCancelsOtherOrderId = object.oneCancelsOtherOrderId !== undefined && object.oneCancelsOtherOrderId !== null ? IndexerOrderId.fromPartial(object.oneCancelsOtherOrderId) : undefined;
     return message;
   }
 
@@ -75,6 +75,12 @@ export enum OrderRemovalReason {
    * equity tier requirements.
    */
   ORDER_REMOVAL_REASON_EQUITY_TIER = 13,
+
+  /**
+   * ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER - The order was one order of a one-cancels-other pair, and the other order
+   * of the pair was triggered or filled.
+   */
+  ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER = 14,
   UNRECOGNIZED = -1,
 }
 /** OrderRemovalReason is an enum of all the reasons an order was removed. */
@@ -155,6 +161,12 @@ export enum OrderRemovalReasonSDKType {
    * equity tier requirements.
    */
   ORDER_REMOVAL_REASON_EQUITY_TIER = 13,
+
+  /**
+   * ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER - The order was one order of a one-cancels-other pair, and the other order
+   * of the pair was triggered or filled.
+   */
+  ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER = 14,
   UNRECOGNIZED = -1,
 }
 export function orderRemovalReasonFromJSON(object: any): OrderRemovalReason {
@@ -215,6 +227,10 @@ export function orderRemovalReasonFromJSON(object: any): OrderRemovalReason {
     case "ORDER_REMOVAL_REASON_EQUITY_TIER":
       return OrderRemovalReason.ORDER_REMOVAL_REASON_EQUITY_TIER;
 
+    case 14:
+    case "ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -265,6 +281,9 @@ export function orderRemovalReasonToJSON(object: OrderRemovalReason): string {
     case OrderRemovalReason.ORDER_REMOVAL_REASON_EQUITY_TIER:
       return "ORDER_REMOVAL_REASON_EQUITY_TIER";
 
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER:
+      return "ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER";
+
     case OrderRemovalReason.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -4,8 +4,8 @@ import { DeepPartial } from "../../helpers";
 
 export interface Params {
   /**
-   * Funding rate clamp factor in parts-per-million, used for clamping 8-hour
-   * funding rates according to equation: |R| <= funding_rate_clamp_factor *
+   * Funding rate clamp factor in parts-per-million, used for clamping funding
+   * rates according to equation: |R| <= funding_rate_clamp_factor *
    * (initial margin - maintenance margin).
    */
   fundingRateClampFactorPpm: number;
@@ -22,13 +22,27 @@ export interface Params {
    */
 
   minNumVotesPerSample: number;
+  /**
+   * Period (in seconds) over which funding rates are paid. Funding rates are
+   * pro-rated by the funding-tick epoch duration divided by this period, so
+   * this must be a multiple of the funding-tick epoch duration.
+   */
+
+  fundingRatePeriodSeconds: number;
+  /**
+   * Ratio (in parts-per-million) of funding samples to be removed on each end
+   * of the sorted funding samples collected during a funding-tick epoch
+   * before taking their average.
+   */
+
+  removedTailSampleRatioPpm: number;
 }
 /** Params defines the parameters for x/perpetuals module. */
 
 export interface ParamsSDKType {
   /**
-   * Funding rate clamp factor in parts-per-million, used for clamping 8-hour
-   * funding rates according to equation: |R| <= funding_rate_clamp_factor *
+   * Funding rate clamp factor in parts-per-million, used for clamping funding
+   * rates according to equation: |R| <= funding_rate_clamp_factor *
    * (initial margin - maintenance margin).
    */
   funding_rate_clamp_factor_ppm: number;
@@ -45,13 +59,29 @@ export interface ParamsSDKType {
    */
 
   min_num_votes_per_sample: number;
+  /**
+   * Period (in seconds) over which funding rates are paid. Funding rates are
+   * pro-rated by the funding-tick epoch duration divided by this period, so
+   * this must be a multiple of the funding-tick epoch duration.
+   */
+
+  funding_rate_period_seconds: number;
+  /**
+   * Ratio (in parts-per-million) of funding samples to be removed on each end
+   * of the sorted funding samples collected during a funding-tick epoch
+   * before taking their average.
+   */
+
+  removed_tail_sample_ratio_ppm: number;
 }
 
 function createBaseParams(): Params {
   return {
     fundingRateClampFactorPpm: 0,
     premiumVoteClampFactorPpm: 0,
-    minNumVotesPerSample: 0
+    minNumVotesPerSample: 0,
+    fundingRatePeriodSeconds: 0,
+    removedTailSampleRatioPpm: 0
   };
 }
 
@@ -69,6 +99,14 @@ export const Params = {
       writer.uint32(24).uint32(message.minNumVotesPerSample);
     }
 
+    if (message.fundingRatePeriodSeconds !== 0) {
+      writer.uint32(32).uint32(message.fundingRatePeriodSeconds);
+    }
+
+    if (message.removedTailSampleRatioPpm !== 0) {
+      writer.uint32(40).uint32(message.removedTailSampleRatioPpm);
+    }
+
     return writer;
   },
 
@@ -93,6 +131,14 @@ export const Params = {
           message.minNumVotesPerSample = reader.uint32();
           break;
 
+        case 4:
+          message.fundingRatePeriodSeconds = reader.uint32();
+          break;
+
+        case 5:
+          message.removedTailSampleRatioPpm = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -107,6 +153,8 @@ export const Params = {
     message.fundingRateClampFactorPpm = object.fundingRateClampFactorPpm ?? 0;
     message.premiumVoteClampFactorPpm = object.premiumVoteClampFactorPpm ?? 0;
     message.minNumVotesPerSample = object.minNumVotesPerSample ?? 0;
+    message.fundingRatePeriodSeconds = object.fundingRatePeriodSeconds ?? 0;
+    message.removedTailSampleRatioPpm = object.removedTailSampleRatioPpm ?? 0;
     return message;
   }
 
@@ -1,5 +1,79 @@
 import * as _m0 from "protobufjs/minimal";
 import { DeepPartial, Long } from "../../helpers";
+/** InsuranceFundScope determines which insurance fund backs a perpetual. */
+
+export enum InsuranceFundScope {
+  /**
+   * INSURANCE_FUND_SCOPE_GLOBAL - Default value. The perpetual is backed by the global insurance fund
+   * shared by all perpetuals with this scope.
+   */
+  INSURANCE_FUND_SCOPE_GLOBAL = 0,
+
+  /** INSURANCE_FUND_SCOPE_PERPETUAL - The perpetual is backed by an insurance fund of its own. */
+  INSURANCE_FUND_SCOPE_PERPETUAL = 1,
+
+  /**
+   * INSURANCE_FUND_SCOPE_LIQUIDITY_TIER - The perpetual is backed by an insurance fund shared by all perpetuals in
+   * the same liquidity tier with this scope.
+   */
+  INSURANCE_FUND_SCOPE_LIQUIDITY_TIER = 2,
+  UNRECOGNIZED = -1,
+}
+/** InsuranceFundScope determines which insurance fund backs a perpetual. */
+
+export enum InsuranceFundScopeSDKType {
+  /**
+   * INSURANCE_FUND_SCOPE_GLOBAL - Default value. The perpetual is backed by the global insurance fund
+   * shared by all perpetuals with this scope.
+   */
+  INSURANCE_FUND_SCOPE_GLOBAL = 0,
+
+  /** INSURANCE_FUND_SCOPE_PERPETUAL - The perpetual is backed by an insurance fund of its own. */
+  INSURANCE_FUND_SCOPE_PERPETUAL = 1,
+
+  /**
+   * INSURANCE_FUND_SCOPE_LIQUIDITY_TIER - The perpetual is backed by an insurance fund shared by all perpetuals in
+   * the same liquidity tier with this scope.
+   */
+  INSURANCE_FUND_SCOPE_LIQUIDITY_TIER = 2,
+  UNRECOGNIZED = -1,
+}
+export function insuranceFundScopeFromJSON(object: any): InsuranceFundScope {
+  switch (object) {
+    case 0:
+    case "INSURANCE_FUND_SCOPE_GLOBAL":
+      return InsuranceFundScope.INSURANCE_FUND_SCOPE_GLOBAL;
+
+    case 1:
+    case "INSURANCE_FUND_SCOPE_PERPETUAL":
+      return InsuranceFundScope.INSURANCE_FUND_SCOPE_PERPETUAL;
+
+    case 2:
+    case "INSURANCE_FUND_SCOPE_LIQUIDITY_TIER":
+      return InsuranceFundScope.INSURANCE_FUND_SCOPE_LIQUIDITY_TIER;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return InsuranceFundScope.UNRECOGNIZED;
+  }
+}
+export function insuranceFundScopeToJSON(object: InsuranceFundScope): string {
+  switch (object) {
+    case InsuranceFundScope.INSURANCE_FUND_SCOPE_GLOBAL:
+      return "INSURANCE_FUND_SCOPE_GLOBAL";
+
+    case InsuranceFundScope.INSURANCE_FUND_SCOPE_PERPETUAL:
+      return "INSURANCE_FUND_SCOPE_PERPETUAL";
+
+    case InsuranceFundScope.INSURANCE_FUND_SCOPE_LIQUIDITY_TIER:
+      return "INSURANCE_FUND_SCOPE_LIQUIDITY_TIER";
+
+    case InsuranceFundScope.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 /** Perpetual represents a perpetual on the dYdX exchange. */
 
 export interface Perpetual {
@@ -59,6 +133,30 @@ export interface PerpetualParams {
   /** The liquidity_tier that this perpetual is associated with. */
 
   liquidityTier: number;
+  /**
+   * The interest rate component of the funding rate per funding rate period,
+   * which is added to the premium before the funding rate is clamped. In
+   * parts-per-million.
+   */
+
+  interestRatePpm: number;
+  /**
+   * The insurance fund that covers losses from liquidations of this perpetual
+   * and receives its liquidation fees. Changing the scope or the liquidity
+   * tier of a perpetual does not move funds between insurance funds, and is
+   * rejected if it would leave a non-empty scoped insurance fund backing no
+   * perpetual. A scoped insurance fund is funded by sending USDC to its
+   * address, as returned by the InsuranceFundBalances query.
+   */
+
+  insuranceFundScope: InsuranceFundScope;
+  /**
+   * The explicit funding rate bounds of this perpetual, which are applied in
+   * addition to the clamp determined by the liquidity tier. Funding rates are
+   * not explicitly bounded if unset.
+   */
+
+  fundingRateBounds?: FundingRateBounds;
 }
 /**
  * PerpetualParams represents the parameters of a perpetual on the dYdX
@@ -95,6 +193,60 @@ export interface PerpetualParamsSDKType {
   /** The liquidity_tier that this perpetual is associated with. */
 
   liquidity_tier: number;
+  /**
+   * The interest rate component of the funding rate per funding rate period,
+   * which is added to the premium before the funding rate is clamped. In
+   * parts-per-million.
+   */
+
+  interest_rate_ppm: number;
+  /**
+   * The insurance fund that covers losses from liquidations of this perpetual
+   * and receives its liquidation fees. Changing the scope or the liquidity
+   * tier of a perpetual does not move funds between insurance funds, and is
+   * rejected if it would leave a non-empty scoped insurance fund backing no
+   * perpetual. A scoped insurance fund is funded by sending USDC to its
+   * address, as returned by the InsuranceFundBalances query.
+   */
+
+  insurance_fund_scope: InsuranceFundScopeSDKType;
+  /**
+   * The explicit funding rate bounds of this perpetual, which are applied in
+   * addition to the clamp determined by the liquidity tier. Funding rates are
+   * not explicitly bounded if unset.
+   */
+
+  funding_rate_bounds?: FundingRateBoundsSDKType;
+}
+/** FundingRateBounds are the explicit bounds of the funding rate of a perpetual. */
+
+export interface FundingRateBounds {
+  /**
+   * The minimum funding rate of the perpetual per funding rate period. In
+   * parts-per-million.
+   */
+  minFundingRatePpm: number;
+  /**
+   * The maximum funding rate of the perpetual per funding rate period. In
+   * parts-per-million.
+   */
+
+  maxFundingRatePpm: number;
+}
+/** FundingRateBounds are the explicit bounds of the funding rate of a perpetual. */
+
+export interface FundingRateBoundsSDKType {
+  /**
+   * The minimum funding rate of the perpetual per funding rate period. In
+   * parts-per-million.
+   */
+  min_funding_rate_ppm: number;
+  /**
+   * The maximum funding rate of the perpetual per funding rate period. In
+   * parts-per-million.
+   */
+
+  max_funding_rate_ppm: number;
 }
 /** MarketPremiums stores a list of premiums for a single perpetual market. */
 
@@ -174,6 +326,64 @@ export interface PremiumStoreSDKType {
 
   num_premiums: number;
 }
+/**
+ * FundingIndexSnapshot is the funding index of a perpetual after a
+ * `funding-tick` epoch was processed.
+ */
+
+export interface FundingIndexSnapshot {
+  /** The block height at which the `funding-tick` epoch was processed. */
+  blockHeight: number;
+  /**
+   * The funding rate applied during the `funding-tick` epoch, in
+   * parts-per-million.
+   */
+
+  fundingRatePpm: number;
+  /**
+   * The funding index of the perpetual after the `funding-tick` epoch was
+   * processed.
+   */
+
+  fundingIndex: Uint8Array;
+}
+/**
+ * FundingIndexSnapshot is the funding index of a perpetual after a
+ * `funding-tick` epoch was processed.
+ */
+
+export interface FundingIndexSnapshotSDKType {
+  /** The block height at which the `funding-tick` epoch was processed. */
+  block_height: number;
+  /**
+   * The funding rate applied during the `funding-tick` epoch, in
+   * parts-per-million.
+   */
+
+  funding_rate_ppm: number;
+  /**
+   * The funding index of the perpetual after the `funding-tick` epoch was
+   * processed.
+   */
+
+  funding_index: Uint8Array;
+}
+/**
+ * FundingIndexHistory stores the most recent funding index snapshots of a
+ * perpetual, sorted from the oldest to the newest snapshot.
+ */
+
+export interface FundingIndexHistory {
+  snapshots: FundingIndexSnapshot[];
+}
+/**
+ * FundingIndexHistory stores the most recent funding index snapshots of a
+ * perpetual, sorted from the oldest to the newest snapshot.
+ */
+
+export interface FundingIndexHistorySDKType {
+  snapshots: FundingIndexSnapshotSDKType[];
+}
 /** LiquidityTier stores margin information. */
 
 export interface LiquidityTier {
@@ -315,7 +525,10 @@ function createBasePerpetualParams(): PerpetualParams {
     marketId: 0,
     atomicResolution: 0,
     defaultFundingPpm: 0,
-    liquidityTier: 0
+    liquidityTier: 0,
+    interestRatePpm: 0,
+    insuranceFundScope: 0,
+    fundingRateBounds: undefined
   };
 }
 
@@ -345,6 +558,18 @@ export const PerpetualParams = {
       writer.uint32(48).uint32(message.liquidityTier);
     }
 
+    if (message.interestRatePpm !== 0) {
+      writer.uint32(72).sint32(message.interestRatePpm);
+    }
+
+    if (message.insuranceFundScope !== 0) {
+      writer.uint32(80).int32(message.insuranceFundScope);
+    }
+
+    if (message.fundingRateBounds !== undefined) {
+      FundingRateBounds.encode(message.fundingRateBounds, writer.uint32(90).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -381,6 +606,18 @@ export const PerpetualParams = {
           message.liquidityTier = reader.uint32();
           break;
 
+        case 9:
+          message.interestRatePpm = reader.sint32();
+          break;
+
+        case 10:
+          message.insuranceFundScope = (reader.int32() as any);
+          break;
+
+        case 11:
+          message.fundingRateBounds = FundingRateBounds.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -398,6 +635,64 @@ export const PerpetualParams = {
     message.atomicResolution = object.atomicResolution ?? 0;
     message.defaultFundingPpm = object.defaultFundingPpm ?? 0;
     message.liquidityTier = object.liquidityTier ?? 0;
+    message.interestRatePpm = object.interestRatePpm ?? 0;
+    message.insuranceFundScope = object.insuranceFundScope ?? 0;
+    message.fundingRateBounds = object.fundingRateBounds !== undefined && object.fundingRateBounds !== null ? FundingRateBounds.fromPartial(object.fundingRateBounds) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseFundingRateBounds(): FundingRateBounds {
+  return {
+    minFundingRatePpm: 0,
+    maxFundingRatePpm: 0
+  };
+}
+
+export const FundingRateBounds = {
+  encode(message: FundingRateBounds, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.minFundingRatePpm !== 0) {
+      writer.uint32(8).sint32(message.minFundingRatePpm);
+    }
+
+    if (message.maxFundingRatePpm !== 0) {
+      writer.uint32(16).sint32(message.maxFundingRatePpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FundingRateBounds {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFundingRateBounds();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.minFundingRatePpm = reader.sint32();
+          break;
+
+        case 2:
+          message.maxFundingRatePpm = reader.sint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<FundingRateBounds>): FundingRateBounds {
+    const message = createBaseFundingRateBounds();
+    message.minFundingRatePpm = object.minFundingRatePpm ?? 0;
+    message.maxFundingRatePpm = object.maxFundingRatePpm ?? 0;
     return message;
   }
 
@@ -525,6 +820,116 @@ export const PremiumStore = {
 
 };
 
+function createBaseFundingIndexSnapshot(): FundingIndexSnapshot {
+  return {
+    blockHeight: 0,
+    fundingRatePpm: 0,
+    fundingIndex: new Uint8Array()
+  };
+}
+
+export const FundingIndexSnapshot = {
+  encode(message: FundingIndexSnapshot, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.blockHeight !== 0) {
+      writer.uint32(8).uint32(message.blockHeight);
+    }
+
+    if (message.fundingRatePpm !== 0) {
+      writer.uint32(16).int32(message.fundingRatePpm);
+    }
+
+    if (message.fundingIndex.length !== 0) {
+      writer.uint32(26).bytes(message.fundingIndex);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FundingIndexSnapshot {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFundingIndexSnapshot();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.blockHeight = reader.uint32();
+          break;
+
+        case 2:
+          message.fundingRatePpm = reader.int32();
+          break;
+
+        case 3:
+          message.fundingIndex = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<FundingIndexSnapshot>): FundingIndexSnapshot {
+    const message = createBaseFundingIndexSnapshot();
+    message.blockHeight = object.blockHeight ?? 0;
+    message.fundingRatePpm = object.fundingRatePpm ?? 0;
+    message.fundingIndex = object.fundingIndex ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseFundingIndexHistory(): FundingIndexHistory {
+  return {
+    snapshots: []
+  };
+}
+
+export const FundingIndexHistory = {
+  encode(message: FundingIndexHistory, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.snapshots) {
+      FundingIndexSnapshot.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FundingIndexHistory {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFundingIndexHistory();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.snapshots.push(FundingIndexSnapshot.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<FundingIndexHistory>): FundingIndexHistory {
+    const message = createBaseFundingIndexHistory();
+    message.snapshots = object.snapshots?.map(e => FundingIndexSnapshot.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
 function createBaseLiquidityTier(): LiquidityTier {
   return {
     id: 0,
@@ -1,6 +1,6 @@
 import { setPaginationParams } from "../../helpers";
 import { LCDClient } from "@osmonauts/lcd";
-import { QueryPerpetualRequest, QueryPerpetualResponseSDKType, QueryAllPerpetualsRequest, QueryAllPerpetualsResponseSDKType } from "./query";
+import { QueryPerpetualRequest, QueryPerpetualResponseSDKType, QueryAllPerpetualsRequest, QueryAllPerpetualsResponseSDKType, QueryPremiumSamplesRequest, QueryPremiumSamplesResponseSDKType, QueryPremiumVotesRequest, QueryPremiumVotesResponseSDKType, QueryPredictedFundingRatesRequest, QueryPredictedFundingRatesResponseSDKType, QueryFundingIndexHistoryRequest, QueryFundingIndexHistoryResponseSDKType } from "./query";
 export class LCDQueryClient {
   req: LCDClient;
 
@@ -12,6 +12,10 @@ export class LCDQueryClient {
     this.req = requestClient;
     this.perpetual = this.perpetual.bind(this);
     this.allPerpetuals = this.allPerpetuals.bind(this);
+    this.premiumSamples = this.premiumSamples.bind(this);
+    this.premiumVotes = this.premiumVotes.bind(this);
+    this.predictedFundingRates = this.predictedFundingRates.bind(this);
+    this.fundingIndexHistory = this.fundingIndexHistory.bind(this);
   }
   /* Queries a Perpetual by id. */
 
@@ -37,5 +41,44 @@ export class LCDQueryClient {
     const endpoint = `dydxprotocol/perpetuals/perpetual`;
     return await this.req.get<QueryAllPerpetualsResponseSDKType>(endpoint, options);
   }
+  /* Queries the premium samples collected during the current `funding-tick`
+   epoch. */
+
+
+  async premiumSamples(_params: QueryPremiumSamplesRequest = {}): Promise<QueryPremiumSamplesResponseSDKType> {
+    const endpoint = `dydxprotocol/perpetuals/premium_samples`;
+    return await this.req.get<QueryPremiumSamplesResponseSDKType>(endpoint);
+  }
+  /* Queries the premium votes collected during the current `funding-sample`
+   epoch. */
+
+
+  async premiumVotes(_params: QueryPremiumVotesRequest = {}): Promise<QueryPremiumVotesResponseSDKType> {
+    const endpoint = `dydxprotocol/perpetuals/premium_votes`;
+    return await this.req.get<QueryPremiumVotesResponseSDKType>(endpoint);
+  }
+  /* Queries the predicted funding rates of all perpetuals for the current
+   `funding-tick` epoch. */
+
+
+  async predictedFundingRates(_params: QueryPredictedFundingRatesRequest = {}): Promise<QueryPredictedFundingRatesResponseSDKType> {
+    const endpoint = `dydxprotocol/perpetuals/predicted_funding_rates`;
+    return await this.req.get<QueryPredictedFundingRatesResponseSDKType>(endpoint);
+  }
+  /* Queries the most recent funding index snapshots of a perpetual. */
+
+
+  async fundingIndexHistory(params: QueryFundingIndexHistoryRequest): Promise<QueryFundingIndexHistoryResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.limit !== "undefined") {
+      options.params.limit = params.limit;
+    }
+
+    const endpoint = `dydxprotocol/perpetuals/funding_index_history/${params.id}`;
+    return await this.req.get<QueryFundingIndexHistoryResponseSDKType>(endpoint, options);
+  }
 
 }
\ No newline at end of file
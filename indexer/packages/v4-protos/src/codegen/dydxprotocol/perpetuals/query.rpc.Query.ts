@@ -1,7 +1,7 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
 import { QueryClient, createProtobufRpcClient } from "@cosmjs/stargate";
-import { QueryPerpetualRequest, QueryPerpetualResponse, QueryAllPerpetualsRequest, QueryAllPerpetualsResponse } from "./query";
+import { QueryPerpetualRequest, QueryPerpetualResponse, QueryAllPerpetualsRequest, QueryAllPerpetualsResponse, QueryPremiumSamplesRequest, QueryPremiumSamplesResponse, QueryPremiumVotesRequest, QueryPremiumVotesResponse, QueryPredictedFundingRatesRequest, QueryPredictedFundingRatesResponse, QueryFundingIndexHistoryRequest, QueryFundingIndexHistoryResponse } from "./query";
 /** Query defines the gRPC querier service. */
 
 export interface Query {
@@ -10,6 +10,27 @@ export interface Query {
   /** Queries a list of Perpetual items. */
 
   allPerpetuals(request?: QueryAllPerpetualsRequest): Promise<QueryAllPerpetualsResponse>;
+  /**
+   * Queries the premium samples collected during the current `funding-tick`
+   * epoch.
+   */
+
+  premiumSamples(request?: QueryPremiumSamplesRequest): Promise<QueryPremiumSamplesResponse>;
+  /**
+   * Queries the premium votes collected during the current `funding-sample`
+   * epoch.
+   */
+
+  premiumVotes(request?: QueryPremiumVotesRequest): Promise<QueryPremiumVotesResponse>;
+  /**
+   * Queries the predicted funding rates of all perpetuals for the current
+   * `funding-tick` epoch.
+   */
+
+  predictedFundingRates(request?: QueryPredictedFundingRatesRequest): Promise<QueryPredictedFundingRatesResponse>;
+  /** Queries the most recent funding index snapshots of a perpetual. */
+
+  fundingIndexHistory(request: QueryFundingIndexHistoryRequest): Promise<QueryFundingIndexHistoryResponse>;
 }
 export class QueryClientImpl implements Query {
   private readonly rpc: Rpc;
@@ -18,6 +39,10 @@ export class QueryClientImpl implements Query {
     this.rpc = rpc;
     this.perpetual = this.perpetual.bind(this);
     this.allPerpetuals = this.allPerpetuals.bind(this);
+    this.premiumSamples = this.premiumSamples.bind(this);
+    this.premiumVotes = this.premiumVotes.bind(this);
+    this.predictedFundingRates = this.predictedFundingRates.bind(this);
+    this.fundingIndexHistory = this.fundingIndexHistory.bind(this);
   }
 
   perpetual(request: QueryPerpetualRequest): Promise<QueryPerpetualResponse> {
@@ -34,6 +59,30 @@ export class QueryClientImpl implements Query {
     return promise.then(data => QueryAllPerpetualsResponse.decode(new _m0.Reader(data)));
   }
 
+  premiumSamples(request: QueryPremiumSamplesRequest = {}): Promise<QueryPremiumSamplesResponse> {
+    const data = QueryPremiumSamplesRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Query", "PremiumSamples", data);
+    return promise.then(data => QueryPremiumSamplesResponse.decode(new _m0.Reader(data)));
+  }
+
+  premiumVotes(request: QueryPremiumVotesRequest = {}): Promise<QueryPremiumVotesResponse> {
+    const data = QueryPremiumVotesRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Query", "PremiumVotes", data);
+    return promise.then(data => QueryPremiumVotesResponse.decode(new _m0.Reader(data)));
+  }
+
+  predictedFundingRates(request: QueryPredictedFundingRatesRequest = {}): Promise<QueryPredictedFundingRatesResponse> {
+    const data = QueryPredictedFundingRatesRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Query", "PredictedFundingRates", data);
+    return promise.then(data => QueryPredictedFundingRatesResponse.decode(new _m0.Reader(data)));
+  }
+
+  fundingIndexHistory(request: QueryFundingIndexHistoryRequest): Promise<QueryFundingIndexHistoryResponse> {
+    const data = QueryFundingIndexHistoryRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.perpetuals.Query", "FundingIndexHistory", data);
+    return promise.then(data => QueryFundingIndexHistoryResponse.decode(new _m0.Reader(data)));
+  }
+
 }
 export const createRpcQueryExtension = (base: QueryClient) => {
   const rpc = createProtobufRpcClient(base);
@@ -45,6 +94,22 @@ export const createRpcQueryExtension = (base: QueryClient) => {
 
     allPerpetuals(request?: QueryAllPerpetualsRequest): Promise<QueryAllPerpetualsResponse> {
       return queryService.allPerpetuals(request);
+    },
+
+    premiumSamples(request?: QueryPremiumSamplesRequest): Promise<QueryPremiumSamplesResponse> {
+      return queryService.premiumSamples(request);
+    },
+
+    premiumVotes(request?: QueryPremiumVotesRequest): Promise<QueryPremiumVotesResponse> {
+      return queryService.premiumVotes(request);
+    },
+
+    predictedFundingRates(request?: QueryPredictedFundingRatesRequest): Promise<QueryPredictedFundingRatesResponse> {
+      return queryService.predictedFundingRates(request);
+    },
+
+    fundingIndexHistory(request: QueryFundingIndexHistoryRequest): Promise<QueryFundingIndexHistoryResponse> {
+      return queryService.fundingIndexHistory(request);
     }
 
   };
@@ -1,5 +1,5 @@
 import { PageRequest, PageRequestSDKType, PageResponse, PageResponseSDKType } from "../../cosmos/base/query/v1beta1/pagination";
-import { Perpetual, PerpetualSDKType } from "./perpetual";
+import { Perpetual, PerpetualSDKType, PremiumStore, PremiumStoreSDKType, FundingIndexSnapshot, FundingIndexSnapshotSDKType } from "./perpetual";
 import * as _m0 from "protobufjs/minimal";
 import { DeepPartial } from "../../helpers";
 /** Queries a Perpetual by id. */
@@ -46,6 +46,176 @@ export interface QueryAllPerpetualsResponseSDKType {
   perpetual: PerpetualSDKType[];
   pagination?: PageResponseSDKType;
 }
+/**
+ * QueryPremiumSamplesRequest is request type for the PremiumSamples RPC
+ * method.
+ */
+
+export interface QueryPremiumSamplesRequest {}
+/**
+ * QueryPremiumSamplesRequest is request type for the PremiumSamples RPC
+ * method.
+ */
+
+export interface QueryPremiumSamplesRequestSDKType {}
+/**
+ * QueryPremiumSamplesResponse is response type for the PremiumSamples RPC
+ * method.
+ */
+
+export interface QueryPremiumSamplesResponse {
+  premiumSamples?: PremiumStore;
+}
+/**
+ * QueryPremiumSamplesResponse is response type for the PremiumSamples RPC
+ * method.
+ */
+
+export interface QueryPremiumSamplesResponseSDKType {
+  premium_samples?: PremiumStoreSDKType;
+}
+/** QueryPremiumVotesRequest is request type for the PremiumVotes RPC method. */
+
+export interface QueryPremiumVotesRequest {}
+/** QueryPremiumVotesRequest is request type for the PremiumVotes RPC method. */
+
+export interface QueryPremiumVotesRequestSDKType {}
+/** QueryPremiumVotesResponse is response type for the PremiumVotes RPC method. */
+
+export interface QueryPremiumVotesResponse {
+  premiumVotes?: PremiumStore;
+}
+/** QueryPremiumVotesResponse is response type for the PremiumVotes RPC method. */
+
+export interface QueryPremiumVotesResponseSDKType {
+  premium_votes?: PremiumStoreSDKType;
+}
+/**
+ * QueryPredictedFundingRatesRequest is request type for the
+ * PredictedFundingRates RPC method.
+ */
+
+export interface QueryPredictedFundingRatesRequest {}
+/**
+ * QueryPredictedFundingRatesRequest is request type for the
+ * PredictedFundingRates RPC method.
+ */
+
+export interface QueryPredictedFundingRatesRequestSDKType {}
+/**
+ * PredictedFundingRate is the funding rate of a perpetual predicted from the
+ * premium samples collected so far during the current `funding-tick` epoch.
+ * Samples which have not been collected yet are counted as zero.
+ */
+
+export interface PredictedFundingRate {
+  /** The id of the perpetual. */
+  perpetualId: number;
+  /**
+   * The premium component (in parts-per-million) of the funding rate, which
+   * includes the default funding of the perpetual.
+   */
+
+  premiumPpm: number;
+  /** The interest rate component (in parts-per-million) of the funding rate. */
+
+  interestPpm: number;
+  /**
+   * The predicted funding rate (in parts-per-million), which is the sum of
+   * `premium_ppm` and `interest_ppm` clamped to the bounds of the perpetual.
+   */
+
+  fundingRatePpm: number;
+}
+/**
+ * PredictedFundingRate is the funding rate of a perpetual predicted from the
+ * premium samples collected so far during the current `funding-tick` epoch.
+ * Samples which have not been collected yet are counted as zero.
+ */
+
+export interface PredictedFundingRateSDKType {
+  /** The id of the perpetual. */
+  perpetual_id: number;
+  /**
+   * The premium component (in parts-per-million) of the funding rate, which
+   * includes the default funding of the perpetual.
+   */
+
+  premium_ppm: number;
+  /** The interest rate component (in parts-per-million) of the funding rate. */
+
+  interest_ppm: number;
+  /**
+   * The predicted funding rate (in parts-per-million), which is the sum of
+   * `premium_ppm` and `interest_ppm` clamped to the bounds of the perpetual.
+   */
+
+  funding_rate_ppm: number;
+}
+/**
+ * QueryPredictedFundingRatesResponse is response type for the
+ * PredictedFundingRates RPC method.
+ */
+
+export interface QueryPredictedFundingRatesResponse {
+  predictedFundingRates: PredictedFundingRate[];
+}
+/**
+ * QueryPredictedFundingRatesResponse is response type for the
+ * PredictedFundingRates RPC method.
+ */
+
+export interface QueryPredictedFundingRatesResponseSDKType {
+  predicted_funding_rates: PredictedFundingRateSDKType[];
+}
+/**
+ * QueryFundingIndexHistoryRequest is request type for the FundingIndexHistory
+ * RPC method.
+ */
+
+export interface QueryFundingIndexHistoryRequest {
+  /** The id of the perpetual. */
+  id: number;
+  /**
+   * The maximum number of the most recent snapshots to return. All stored
+   * snapshots are returned if zero.
+   */
+
+  limit: number;
+}
+/**
+ * QueryFundingIndexHistoryRequest is request type for the FundingIndexHistory
+ * RPC method.
+ */
+
+export interface QueryFundingIndexHistoryRequestSDKType {
+  /** The id of the perpetual. */
+  id: number;
+  /**
+   * The maximum number of the most recent snapshots to return. All stored
+   * snapshots are returned if zero.
+   */
+
+  limit: number;
+}
+/**
+ * QueryFundingIndexHistoryResponse is response type for the
+ * FundingIndexHistory RPC method.
+ */
+
+export interface QueryFundingIndexHistoryResponse {
+  /** Funding index snapshots, sorted from the oldest to the newest snapshot. */
+  snapshots: FundingIndexSnapshot[];
+}
+/**
+ * QueryFundingIndexHistoryResponse is response type for the
+ * FundingIndexHistory RPC method.
+ */
+
+export interface QueryFundingIndexHistoryResponseSDKType {
+  /** Funding index snapshots, sorted from the oldest to the newest snapshot. */
+  snapshots: FundingIndexSnapshotSDKType[];
+}
 
 function createBaseQueryPerpetualRequest(): QueryPerpetualRequest {
   return {
@@ -235,4 +405,416 @@ export const QueryAllPerpetualsResponse = {
     return message;
   }
 
+};
+
+function createBaseQueryPremiumSamplesRequest(): QueryPremiumSamplesRequest {
+  return {};
+}
+
+export const QueryPremiumSamplesRequest = {
+  encode(_: QueryPremiumSamplesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPremiumSamplesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryPremiumSamplesRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<QueryPremiumSamplesRequest>): QueryPremiumSamplesRequest {
+    const message = createBaseQueryPremiumSamplesRequest();
+    return message;
+  }
+
+};
+
+function createBaseQueryPremiumSamplesResponse(): QueryPremiumSamplesResponse {
+  return {
+    premiumSamples: undefined
+  };
+}
+
+export const QueryPremiumSamplesResponse = {
+  encode(message: QueryPremiumSamplesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.premiumSamples !== undefined) {
+      PremiumStore.encode(message.premiumSamples, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPremiumSamplesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryPremiumSamplesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.premiumSamples = PremiumStore.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryPremiumSamplesResponse>): QueryPremiumSamplesResponse {
+    const message = createBaseQueryPremiumSamplesResponse();
+    message.premiumSamples = object.premiumSamples !== undefined && object.premiumSamples !== null ? PremiumStore.fromPartial(object.premiumSamples) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryPremiumVotesRequest(): QueryPremiumVotesRequest {
+  return {};
+}
+
+export const QueryPremiumVotesRequest = {
+  encode(_: QueryPremiumVotesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPremiumVotesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryPremiumVotesRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<QueryPremiumVotesRequest>): QueryPremiumVotesRequest {
+    const message = createBaseQueryPremiumVotesRequest();
+    return message;
+  }
+
+};
+
+function createBaseQueryPremiumVotesResponse(): QueryPremiumVotesResponse {
+  return {
+    premiumVotes: undefined
+  };
+}
+
+export const QueryPremiumVotesResponse = {
+  encode(message: QueryPremiumVotesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.premiumVotes !== undefined) {
+      PremiumStore.encode(message.premiumVotes, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPremiumVotesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryPremiumVotesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.premiumVotes = PremiumStore.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryPremiumVotesResponse>): QueryPremiumVotesResponse {
+    const message = createBaseQueryPremiumVotesResponse();
+    message.premiumVotes = object.premiumVotes !== undefined && object.premiumVotes !== null ? PremiumStore.fromPartial(object.premiumVotes) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryPredictedFundingRatesRequest(): QueryPredictedFundingRatesRequest {
+  return {};
+}
+
+export const QueryPredictedFundingRatesRequest = {
+  encode(_: QueryPredictedFundingRatesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPredictedFundingRatesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryPredictedFundingRatesRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<QueryPredictedFundingRatesRequest>): QueryPredictedFundingRatesRequest {
+    const message = createBaseQueryPredictedFundingRatesRequest();
+    return message;
+  }
+
+};
+
+function createBasePredictedFundingRate(): PredictedFundingRate {
+  return {
+    perpetualId: 0,
+    premiumPpm: 0,
+    interestPpm: 0,
+    fundingRatePpm: 0
+  };
+}
+
+export const PredictedFundingRate = {
+  encode(message: PredictedFundingRate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.premiumPpm !== 0) {
+      writer.uint32(16).int32(message.premiumPpm);
+    }
+
+    if (message.interestPpm !== 0) {
+      writer.uint32(24).int32(message.interestPpm);
+    }
+
+    if (message.fundingRatePpm !== 0) {
+      writer.uint32(32).int32(message.fundingRatePpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PredictedFundingRate {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePredictedFundingRate();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.premiumPpm = reader.int32();
+          break;
+
+        case 3:
+          message.interestPpm = reader.int32();
+          break;
+
+        case 4:
+          message.fundingRatePpm = reader.int32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<PredictedFundingRate>): PredictedFundingRate {
+    const message = createBasePredictedFundingRate();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.premiumPpm = object.premiumPpm ?? 0;
+    message.interestPpm = object.interestPpm ?? 0;
+    message.fundingRatePpm = object.fundingRatePpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryPredictedFundingRatesResponse(): QueryPredictedFundingRatesResponse {
+  return {
+    predictedFundingRates: []
+  };
+}
+
+export const QueryPredictedFundingRatesResponse = {
+  encode(message: QueryPredictedFundingRatesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.predictedFundingRates) {
+      PredictedFundingRate.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPredictedFundingRatesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryPredictedFundingRatesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.predictedFundingRates.push(PredictedFundingRate.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryPredictedFundingRatesResponse>): QueryPredictedFundingRatesResponse {
+    const message = createBaseQueryPredictedFundingRatesResponse();
+    message.predictedFundingRates = object.predictedFundingRates?.map(e => PredictedFundingRate.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryFundingIndexHistoryRequest(): QueryFundingIndexHistoryRequest {
+  return {
+    id: 0,
+    limit: 0
+  };
+}
+
+export const QueryFundingIndexHistoryRequest = {
+  encode(message: QueryFundingIndexHistoryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+
+    if (message.limit !== 0) {
+      writer.uint32(16).uint32(message.limit);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryFundingIndexHistoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryFundingIndexHistoryRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.id = reader.uint32();
+          break;
+
+        case 2:
+          message.limit = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryFundingIndexHistoryRequest>): QueryFundingIndexHistoryRequest {
+    const message = createBaseQueryFundingIndexHistoryRequest();
+    message.id = object.id ?? 0;
+    message.limit = object.limit ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryFundingIndexHistoryResponse(): QueryFundingIndexHistoryResponse {
+  return {
+    snapshots: []
+  };
+}
+
+export const QueryFundingIndexHistoryResponse = {
+  encode(message: QueryFundingIndexHistoryResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.snapshots) {
+      FundingIndexSnapshot.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryFundingIndexHistoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryFundingIndexHistoryResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.snapshots.push(FundingIndexSnapshot.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryFundingIndexHistoryResponse>): QueryFundingIndexHistoryResponse {
+    const message = createBaseQueryFundingIndexHistoryResponse();
+    message.snapshots = object.snapshots?.map(e => FundingIndexSnapshot.fromPartial(e)) || [];
+    return message;
+  }
+
 };
\ No newline at end of file
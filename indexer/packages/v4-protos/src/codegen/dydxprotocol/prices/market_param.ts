@@ -39,6 +39,20 @@ export interface MarketParam {
    */
 
   exchangeConfigJson: string;
+  /**
+   * The number of most recent blocks whose smoothed prices must all agree with
+   * a proposed price update for the update to be proposed. Defaults to 5 if
+   * zero.
+   */
+
+  smoothedPriceHistoryLength: number;
+  /**
+   * The weight of the index price when updating the exponentially smoothed
+   * price of this market each block. Measured as `1e-6` (parts per million).
+   * Defaults to 300,000 (30%) if zero.
+   */
+
+  priceSmoothingPpm: number;
 }
 /**
  * MarketParam represents the x/prices configuration for markets, including
@@ -79,6 +93,20 @@ export interface MarketParamSDKType {
    */
 
   exchange_config_json: string;
+  /**
+   * The number of most recent blocks whose smoothed prices must all agree with
+   * a proposed price update for the update to be proposed. Defaults to 5 if
+   * zero.
+   */
+
+  smoothed_price_history_length: number;
+  /**
+   * The weight of the index price when updating the exponentially smoothed
+   * price of this market each block. Measured as `1e-6` (parts per million).
+   * Defaults to 300,000 (30%) if zero.
+   */
+
+  price_smoothing_ppm: number;
 }
 
 function createBaseMarketParam(): MarketParam {
@@ -88,7 +116,9 @@ function createBaseMarketParam(): MarketParam {
     exponent: 0,
     minExchanges: 0,
     minPriceChangePpm: 0,
-    exchangeConfigJson: ""
+    exchangeConfigJson: "",
+    smoothedPriceHistoryLength: 0,
+    priceSmoothingPpm: 0
   };
 }
 
@@ -118,6 +148,14 @@ export const MarketParam = {
       writer.uint32(50).string(message.exchangeConfigJson);
     }
 
+    if (message.smoothedPriceHistoryLength !== 0) {
+      writer.uint32(56).uint32(message.smoothedPriceHistoryLength);
+    }
+
+    if (message.priceSmoothingPpm !== 0) {
+      writer.uint32(64).uint32(message.priceSmoothingPpm);
+    }
+
     return writer;
   },
 
@@ -154,6 +192,14 @@ export const MarketParam = {
           message.exchangeConfigJson = reader.string();
           break;
 
+        case 7:
+          message.smoothedPriceHistoryLength = reader.uint32();
+          break;
+
+        case 8:
+          message.priceSmoothingPpm = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -171,6 +217,8 @@ export const MarketParam = {
     message.minExchanges = object.minExchanges ?? 0;
     message.minPriceChangePpm = object.minPriceChangePpm ?? 0;
     message.exchangeConfigJson = object.exchangeConfigJson ?? "";
+    message.smoothedPriceHistoryLength = object.smoothedPriceHistoryLength ?? 0;
+    message.priceSmoothingPpm = object.priceSmoothingPpm ?? 0;
     return message;
   }
 
@@ -1,6 +1,6 @@
 import { setPaginationParams } from "../../helpers";
 import { LCDClient } from "@osmonauts/lcd";
-import { QueryGetSubaccountRequest, QuerySubaccountResponseSDKType, QueryAllSubaccountRequest, QuerySubaccountAllResponseSDKType } from "./query";
+import { QueryGetSubaccountRequest, QuerySubaccountResponseSDKType, QueryAllSubaccountRequest, QuerySubaccountAllResponseSDKType, QueryLiquidationCandidatesRequest, QueryLiquidationCandidatesResponseSDKType, QueryAdlRanksRequest, QueryAdlRanksResponseSDKType } from "./query";
 export class LCDQueryClient {
   req: LCDClient;
 
@@ -12,6 +12,8 @@ export class LCDQueryClient {
     this.req = requestClient;
     this.subaccount = this.subaccount.bind(this);
     this.subaccountAll = this.subaccountAll.bind(this);
+    this.liquidationCandidates = this.liquidationCandidates.bind(this);
+    this.adlRanks = this.adlRanks.bind(this);
   }
   /* Queries a Subaccount by id */
 
@@ -37,5 +39,32 @@ export class LCDQueryClient {
     const endpoint = `dydxprotocol/subaccounts/subaccount`;
     return await this.req.get<QuerySubaccountAllResponseSDKType>(endpoint, options);
   }
+  /* Queries a page of subaccounts with open perpetual positions along with
+   their net collateral and maintenance margin requirement at current oracle
+   prices. */
+
+
+  async liquidationCandidates(params: QueryLiquidationCandidatesRequest = {
+    pagination: undefined
+  }): Promise<QueryLiquidationCandidatesResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.pagination !== "undefined") {
+      setPaginationParams(options, params.pagination);
+    }
+
+    const endpoint = `dydxprotocol/subaccounts/liquidation_candidates`;
+    return await this.req.get<QueryLiquidationCandidatesResponseSDKType>(endpoint, options);
+  }
+  /* Queries the auto-deleveraging rank of each open perpetual position of a
+   subaccount. */
+
+
+  async adlRanks(params: QueryAdlRanksRequest): Promise<QueryAdlRanksResponseSDKType> {
+    const endpoint = `dydxprotocol/subaccounts/adl_ranks/${params.owner}/${params.number}`;
+    return await this.req.get<QueryAdlRanksResponseSDKType>(endpoint);
+  }
 
 }
\ No newline at end of file
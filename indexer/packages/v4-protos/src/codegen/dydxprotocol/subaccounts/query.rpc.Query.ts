@@ -1,7 +1,7 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
 import { QueryClient, createProtobufRpcClient } from "@cosmjs/stargate";
-import { QueryGetSubaccountRequest, QuerySubaccountResponse, QueryAllSubaccountRequest, QuerySubaccountAllResponse } from "./query";
+import { QueryGetSubaccountRequest, QuerySubaccountResponse, QueryAllSubaccountRequest, QuerySubaccountAllResponse, QueryLiquidationCandidatesRequest, QueryLiquidationCandidatesResponse, QueryAdlRanksRequest, QueryAdlRanksResponse } from "./query";
 /** Query defines the gRPC querier service. */
 
 export interface Query {
@@ -10,6 +10,19 @@ export interface Query {
   /** Queries a list of Subaccount items. */
 
   subaccountAll(request?: QueryAllSubaccountRequest): Promise<QuerySubaccountAllResponse>;
+  /**
+   * Queries a page of subaccounts with open perpetual positions along with
+   * their net collateral and maintenance margin requirement at current oracle
+   * prices.
+   */
+
+  liquidationCandidates(request?: QueryLiquidationCandidatesRequest): Promise<QueryLiquidationCandidatesResponse>;
+  /**
+   * Queries the auto-deleveraging rank of each open perpetual position of a
+   * subaccount.
+   */
+
+  adlRanks(request: QueryAdlRanksRequest): Promise<QueryAdlRanksResponse>;
 }
 export class QueryClientImpl implements Query {
   private readonly rpc: Rpc;
@@ -18,6 +31,8 @@ export class QueryClientImpl implements Query {
     this.rpc = rpc;
     this.subaccount = this.subaccount.bind(this);
     this.subaccountAll = this.subaccountAll.bind(this);
+    this.liquidationCandidates = this.liquidationCandidates.bind(this);
+    this.adlRanks = this.adlRanks.bind(this);
   }
 
   subaccount(request: QueryGetSubaccountRequest): Promise<QuerySubaccountResponse> {
@@ -34,6 +49,20 @@ export class QueryClientImpl implements Query {
     return promise.then(data => QuerySubaccountAllResponse.decode(new _m0.Reader(data)));
   }
 
+  liquidationCandidates(request: QueryLiquidationCandidatesRequest = {
+    pagination: undefined
+  }): Promise<QueryLiquidationCandidatesResponse> {
+    const data = QueryLiquidationCandidatesRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "LiquidationCandidates", data);
+    return promise.then(data => QueryLiquidationCandidatesResponse.decode(new _m0.Reader(data)));
+  }
+
+  adlRanks(request: QueryAdlRanksRequest): Promise<QueryAdlRanksResponse> {
+    const data = QueryAdlRanksRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.subaccounts.Query", "AdlRanks", data);
+    return promise.then(data => QueryAdlRanksResponse.decode(new _m0.Reader(data)));
+  }
+
 }
 export const createRpcQueryExtension = (base: QueryClient) => {
   const rpc = createProtobufRpcClient(base);
@@ -45,6 +74,14 @@ export const createRpcQueryExtension = (base: QueryClient) => {
 
     subaccountAll(request?: QueryAllSubaccountRequest): Promise<QuerySubaccountAllResponse> {
       return queryService.subaccountAll(request);
+    },
+
+    liquidationCandidates(request?: QueryLiquidationCandidatesRequest): Promise<QueryLiquidationCandidatesResponse> {
+      return queryService.liquidationCandidates(request);
+    },
+
+    adlRanks(request: QueryAdlRanksRequest): Promise<QueryAdlRanksResponse> {
+      return queryService.adlRanks(request);
     }
 
   };
@@ -1,5 +1,5 @@
 import { PageRequest, PageRequestSDKType, PageResponse, PageResponseSDKType } from "../../cosmos/base/query/v1beta1/pagination";
-import { Subaccount, SubaccountSDKType } from "./subaccount";
+import { Subaccount, SubaccountSDKType, SubaccountId, SubaccountIdSDKType } from "./subaccount";
 import * as _m0 from "protobufjs/minimal";
 import { DeepPartial } from "../../helpers";
 /** QueryGetSubaccountRequest is request type for the Query RPC method. */
@@ -46,6 +46,178 @@ export interface QuerySubaccountAllResponseSDKType {
   subaccount: SubaccountSDKType[];
   pagination?: PageResponseSDKType;
 }
+/**
+ * QueryLiquidationCandidatesRequest is request type for the
+ * LiquidationCandidates RPC method.
+ */
+
+export interface QueryLiquidationCandidatesRequest {
+  /**
+   * Key-based pagination over subaccounts with open perpetual positions. The
+   * page limit defaults to 100 and cannot exceed 1000. Offsets and total
+   * counts are not supported.
+   */
+  pagination?: PageRequest;
+}
+/**
+ * QueryLiquidationCandidatesRequest is request type for the
+ * LiquidationCandidates RPC method.
+ */
+
+export interface QueryLiquidationCandidatesRequestSDKType {
+  /**
+   * Key-based pagination over subaccounts with open perpetual positions. The
+   * page limit defaults to 100 and cannot exceed 1000. Offsets and total
+   * counts are not supported.
+   */
+  pagination?: PageRequestSDKType;
+}
+/**
+ * QueryLiquidationCandidatesResponse is response type for the
+ * LiquidationCandidates RPC method.
+ */
+
+export interface QueryLiquidationCandidatesResponse {
+  /**
+   * Candidates of the page in ascending order of net collateral in excess of
+   * the maintenance margin requirement.
+   */
+  candidates: LiquidationCandidate[];
+  pagination?: PageResponse;
+}
+/**
+ * QueryLiquidationCandidatesResponse is response type for the
+ * LiquidationCandidates RPC method.
+ */
+
+export interface QueryLiquidationCandidatesResponseSDKType {
+  /**
+   * Candidates of the page in ascending order of net collateral in excess of
+   * the maintenance margin requirement.
+   */
+  candidates: LiquidationCandidateSDKType[];
+  pagination?: PageResponseSDKType;
+}
+/**
+ * LiquidationCandidate is a subaccount with at least one open perpetual
+ * position along with its collateralization at current oracle prices.
+ */
+
+export interface LiquidationCandidate {
+  /** The id of the subaccount. */
+  subaccountId?: SubaccountId;
+  /** The net collateral of the subaccount in quote quantums. */
+
+  netCollateral: Uint8Array;
+  /** The maintenance margin requirement of the subaccount in quote quantums. */
+
+  maintenanceMarginRequirement: Uint8Array;
+}
+/**
+ * LiquidationCandidate is a subaccount with at least one open perpetual
+ * position along with its collateralization at current oracle prices.
+ */
+
+export interface LiquidationCandidateSDKType {
+  /** The id of the subaccount. */
+  subaccount_id?: SubaccountIdSDKType;
+  /** The net collateral of the subaccount in quote quantums. */
+
+  net_collateral: Uint8Array;
+  /** The maintenance margin requirement of the subaccount in quote quantums. */
+
+  maintenance_margin_requirement: Uint8Array;
+}
+/** QueryAdlRanksRequest is request type for the AdlRanks RPC method. */
+
+export interface QueryAdlRanksRequest {
+  owner: string;
+  number: number;
+}
+/** QueryAdlRanksRequest is request type for the AdlRanks RPC method. */
+
+export interface QueryAdlRanksRequestSDKType {
+  owner: string;
+  number: number;
+}
+/** QueryAdlRanksResponse is response type for the AdlRanks RPC method. */
+
+export interface QueryAdlRanksResponse {
+  /**
+   * The ranks of the open perpetual positions of the subaccount, in ascending
+   * order of perpetual id.
+   */
+  adlRanks: AdlRank[];
+}
+/** QueryAdlRanksResponse is response type for the AdlRanks RPC method. */
+
+export interface QueryAdlRanksResponseSDKType {
+  /**
+   * The ranks of the open perpetual positions of the subaccount, in ascending
+   * order of perpetual id.
+   */
+  adl_ranks: AdlRankSDKType[];
+}
+/**
+ * AdlRank is the position of an open perpetual position in the
+ * auto-deleveraging queue of its perpetual and side. Positions are ranked by
+ * unrealized PnL multiplied by leverage, and positions with a lower rank are
+ * used first to offset deleveraged positions.
+ */
+
+export interface AdlRank {
+  /** The id of the perpetual. */
+  perpetualId: number;
+  /**
+   * The 1-based rank of the position among all open positions on the same
+   * side of the perpetual. Ranks are capped at 10,000.
+   */
+
+  rank: number;
+  /** The number of open positions on the same side of the perpetual. */
+
+  numPositions: number;
+  /**
+   * The percentage of positions on the same side of the perpetual that rank
+   * at or after this position, in parts per million. A position at the front
+   * of the queue has a percentile of 1,000,000.
+   */
+
+  percentilePpm: number;
+  /** The unrealized PnL of the position in quote quantums. */
+
+  unrealizedPnl: Uint8Array;
+}
+/**
+ * AdlRank is the position of an open perpetual position in the
+ * auto-deleveraging queue of its perpetual and side. Positions are ranked by
+ * unrealized PnL multiplied by leverage, and positions with a lower rank are
+ * used first to offset deleveraged positions.
+ */
+
+export interface AdlRankSDKType {
+  /** The id of the perpetual. */
+  perpetual_id: number;
+  /**
+   * The 1-based rank of the position among all open positions on the same
+   * side of the perpetual. Ranks are capped at 10,000.
+   */
+
+  rank: number;
+  /** The number of open positions on the same side of the perpetual. */
+
+  num_positions: number;
+  /**
+   * The percentage of positions on the same side of the perpetual that rank
+   * at or after this position, in parts per million. A position at the front
+   * of the queue has a percentile of 1,000,000.
+   */
+
+  percentile_ppm: number;
+  /** The unrealized PnL of the position in quote quantums. */
+
+  unrealized_pnl: Uint8Array;
+}
 
 function createBaseQueryGetSubaccountRequest(): QueryGetSubaccountRequest {
   return {
@@ -245,4 +417,354 @@ export const QuerySubaccountAllResponse = {
     return message;
   }
 
+};
+
+function createBaseQueryLiquidationCandidatesRequest(): QueryLiquidationCandidatesRequest {
+  return {
+    pagination: undefined
+  };
+}
+
+export const QueryLiquidationCandidatesRequest = {
+  encode(message: QueryLiquidationCandidatesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pagination !== undefined) {
+      PageRequest.encode(message.pagination, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryLiquidationCandidatesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryLiquidationCandidatesRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.pagination = PageRequest.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryLiquidationCandidatesRequest>): QueryLiquidationCandidatesRequest {
+    const message = createBaseQueryLiquidationCandidatesRequest();
+    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageRequest.fromPartial(object.pagination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryLiquidationCandidatesResponse(): QueryLiquidationCandidatesResponse {
+  return {
+    candidates: [],
+    pagination: undefined
+  };
+}
+
+export const QueryLiquidationCandidatesResponse = {
+  encode(message: QueryLiquidationCandidatesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.candidates) {
+      LiquidationCandidate.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.pagination !== undefined) {
+      PageResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryLiquidationCandidatesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryLiquidationCandidatesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.candidates.push(LiquidationCandidate.decode(reader, reader.uint32()));
+          break;
+
+        case 2:
+          message.pagination = PageResponse.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryLiquidationCandidatesResponse>): QueryLiquidationCandidatesResponse {
+    const message = createBaseQueryLiquidationCandidatesResponse();
+    message.candidates = object.candidates?.map(e => LiquidationCandidate.fromPartial(e)) || [];
+    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageResponse.fromPartial(object.pagination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseLiquidationCandidate(): LiquidationCandidate {
+  return {
+    subaccountId: undefined,
+    netCollateral: new Uint8Array(),
+    maintenanceMarginRequirement: new Uint8Array()
+  };
+}
+
+export const LiquidationCandidate = {
+  encode(message: LiquidationCandidate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subaccountId !== undefined) {
+      SubaccountId.encode(message.subaccountId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.netCollateral.length !== 0) {
+      writer.uint32(18).bytes(message.netCollateral);
+    }
+
+    if (message.maintenanceMarginRequirement.length !== 0) {
+      writer.uint32(26).bytes(message.maintenanceMarginRequirement);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LiquidationCandidate {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLiquidationCandidate();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.subaccountId = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.netCollateral = reader.bytes();
+          break;
+
+        case 3:
+          message.maintenanceMarginRequirement = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<LiquidationCandidate>): LiquidationCandidate {
+    const message = createBaseLiquidationCandidate();
+    message.subaccountId = object.subaccountId !== undefined && object.subaccountId !== null ? SubaccountId.fromPartial(object.subaccountId) : undefined;
+    message.netCollateral = object.netCollateral ?? new Uint8Array();
+    message.maintenanceMarginRequirement = object.maintenanceMarginRequirement ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryAdlRanksRequest(): QueryAdlRanksRequest {
+  return {
+    owner: "",
+    number: 0
+  };
+}
+
+export const QueryAdlRanksRequest = {
+  encode(message: QueryAdlRanksRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryAdlRanksRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryAdlRanksRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryAdlRanksRequest>): QueryAdlRanksRequest {
+    const message = createBaseQueryAdlRanksRequest();
+    message.owner = object.owner ?? "";
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryAdlRanksResponse(): QueryAdlRanksResponse {
+  return {
+    adlRanks: []
+  };
+}
+
+export const QueryAdlRanksResponse = {
+  encode(message: QueryAdlRanksResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.adlRanks) {
+      AdlRank.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryAdlRanksResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryAdlRanksResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.adlRanks.push(AdlRank.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryAdlRanksResponse>): QueryAdlRanksResponse {
+    const message = createBaseQueryAdlRanksResponse();
+    message.adlRanks = object.adlRanks?.map(e => AdlRank.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseAdlRank(): AdlRank {
+  return {
+    perpetualId: 0,
+    rank: 0,
+    numPositions: 0,
+    percentilePpm: 0,
+    unrealizedPnl: new Uint8Array()
+  };
+}
+
+export const AdlRank = {
+  encode(message: AdlRank, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.rank !== 0) {
+      writer.uint32(16).uint32(message.rank);
+    }
+
+    if (message.numPositions !== 0) {
+      writer.uint32(24).uint32(message.numPositions);
+    }
+
+    if (message.percentilePpm !== 0) {
+      writer.uint32(32).uint32(message.percentilePpm);
+    }
+
+    if (message.unrealizedPnl.length !== 0) {
+      writer.uint32(42).bytes(message.unrealizedPnl);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AdlRank {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAdlRank();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.rank = reader.uint32();
+          break;
+
+        case 3:
+          message.numPositions = reader.uint32();
+          break;
+
+        case 4:
+          message.percentilePpm = reader.uint32();
+          break;
+
+        case 5:
+          message.unrealizedPnl = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<AdlRank>): AdlRank {
+    const message = createBaseAdlRank();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.rank = object.rank ?? 0;
+    message.numPositions = object.numPositions ?? 0;
+    message.percentilePpm = object.percentilePpm ?? 0;
+    message.unrealizedPnl = object.unrealizedPnl ?? new Uint8Array();
+    return message;
+  }
+
 };
\ No newline at end of file
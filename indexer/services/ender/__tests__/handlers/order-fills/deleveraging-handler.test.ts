@@ -0,0 +1,288 @@
+import { stats, STATS_FUNCTION_NAME } from '@dydxprotocol-indexer/base';
+import { createKafkaMessage, producer } from '@dydxprotocol-indexer/kafka';
+import {
+  assetRefresher,
+  CandleFromDatabase,
+  CandleTable,
+  dbHelpers,
+  FillTable,
+  FillType,
+  Liquidity,
+  OrderSide,
+  perpetualMarketRefresher,
+  PerpetualPositionCreateObject,
+  PerpetualPositionStatus,
+  PerpetualPositionTable,
+  PositionSide,
+  SubaccountTable,
+  TendermintEventTable,
+  testConstants,
+  testMocks,
+} from '@dydxprotocol-indexer/postgres';
+import { bigIntToBytes, ORDER_FLAG_SHORT_TERM } from '@dydxprotocol-indexer/v4-proto-parser';
+import {
+  DeleveragingEventV1,
+  IndexerSubaccountId,
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+} from '@dydxprotocol-indexer/v4-protos';
+import { KafkaMessage } from 'kafkajs';
+import { DateTime } from 'luxon';
+
+import { updateBlockCache } from '../../../src/caches/block-cache';
+import { clearCandlesMap } from '../../../src/caches/candle-cache';
+import { SUBACCOUNT_ORDER_FILL_EVENT_TYPE } from '../../../src/constants';
+import { DeleveragingHandler } from '../../../src/handlers/order-fills/deleveraging-handler';
+import { createPostgresFunctions } from '../../../src/helpers/postgres/postgres-functions';
+import { onMessage } from '../../../src/lib/on-message';
+import { DydxIndexerSubtypes } from '../../../src/lib/types';
+import {
+  defaultDateTime,
+  defaultDeleveragingEvent,
+  defaultHeight,
+  defaultPreviousHeight,
+  defaultTime,
+  defaultTxHash,
+} from '../../helpers/constants';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+  expectFillInDatabase,
+  expectFillSubaccountKafkaMessageFromLiquidationEvent,
+  expectPerpetualPosition,
+} from '../../helpers/indexer-proto-helpers';
+
+describe('DeleveragingHandler', () => {
+  beforeAll(async () => {
+    await dbHelpers.migrate();
+    await createPostgresFunctions();
+    jest.spyOn(stats, 'increment');
+    jest.spyOn(stats, 'timing');
+    jest.spyOn(stats, 'gauge');
+  });
+
+  beforeEach(async () => {
+    await testMocks.seedData();
+    await perpetualMarketRefresher.updatePerpetualMarkets();
+    await assetRefresher.updateAssets();
+    updateBlockCache(defaultPreviousHeight);
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    jest.clearAllMocks();
+    clearCandlesMap();
+  });
+
+  afterAll(async () => {
+    await dbHelpers.teardown();
+    jest.resetAllMocks();
+  });
+
+  const liquidatedSubaccountId: IndexerSubaccountId = IndexerSubaccountId.fromPartial({
+    owner: testConstants.defaultSubaccount.address,
+    number: testConstants.defaultSubaccount.subaccountNumber,
+  });
+  const offsettingSubaccountId: IndexerSubaccountId = IndexerSubaccountId.fromPartial({
+    owner: testConstants.defaultSubaccount2.address,
+    number: testConstants.defaultSubaccount2.subaccountNumber,
+  });
+  const defaultEvent: DeleveragingEventV1 = {
+    ...defaultDeleveragingEvent,
+    liquidated: liquidatedSubaccountId,
+    offsetting: offsettingSubaccountId,
+  };
+  const liquidatedPerpetualPosition: PerpetualPositionCreateObject = {
+    subaccountId: testConstants.defaultSubaccountId,
+    perpetualId: testConstants.defaultPerpetualMarket.id,
+    side: PositionSide.SHORT,
+    status: PerpetualPositionStatus.OPEN,
+    size: '-10',
+    maxSize: '25',
+    sumOpen: '10',
+    entryPrice: '15000',
+    createdAt: DateTime.utc().toISO(),
+    createdAtHeight: '1',
+    openEventId: testConstants.defaultTendermintEventId,
+    lastEventId: testConstants.defaultTendermintEventId,
+    settledFunding: '200000',
+  };
+  const offsettingPerpetualPosition: PerpetualPositionCreateObject = {
+    ...liquidatedPerpetualPosition,
+    subaccountId: testConstants.defaultSubaccountId2,
+    side: PositionSide.LONG,
+    size: '10',
+  };
+
+  it('returns the correct parallelization ids', () => {
+    const block: IndexerTendermintBlock = createBlock(defaultEvent);
+    const handler: DeleveragingHandler = new DeleveragingHandler(
+      block,
+      block.events[0],
+      0,
+      defaultEvent,
+    );
+
+    const liquidatedUuid: string = SubaccountTable.subaccountIdToUuid(liquidatedSubaccountId);
+    const offsettingUuid: string = SubaccountTable.subaccountIdToUuid(offsettingSubaccountId);
+    expect(handler.getParallelizationIds()).toEqual([
+      `${handler.eventType}_${liquidatedUuid}_${defaultEvent.perpetualId}`,
+      `${handler.eventType}_${offsettingUuid}_${defaultEvent.perpetualId}`,
+      `${SUBACCOUNT_ORDER_FILL_EVENT_TYPE}_${liquidatedUuid}`,
+      `${SUBACCOUNT_ORDER_FILL_EVENT_TYPE}_${offsettingUuid}`,
+    ]);
+  });
+
+  it.each([
+    [
+      'positive',
+      BigInt(1_000_000_000),
+      '100000', // quote currency / base currency = 1e9 * 1e-8 * 1e-6 / 1e-10 = 1e5
+      '0.1', // quote amount is price * fillAmount = 1e5 * 1e-6 = 0.1
+    ],
+    [
+      'negative',
+      BigInt(-1_000_000_000),
+      '-100000',
+      '-0.1',
+    ],
+    [
+      'int64 overflowing',
+      BigInt('10000000000000000000000'),
+      '1000000000000000000',
+      '1000000000000',
+    ],
+  ])('creates fills and updates perpetual positions at a %s bankruptcy price', async (
+    _name: string,
+    subticks: bigint,
+    price: string,
+    quoteAmount: string,
+  ) => {
+    const event: DeleveragingEventV1 = {
+      ...defaultEvent,
+      subticks: bigIntToBytes(subticks),
+    };
+    await Promise.all([
+      PerpetualPositionTable.create(liquidatedPerpetualPosition),
+      PerpetualPositionTable.create(offsettingPerpetualPosition),
+    ]);
+
+    const producerSendMock: jest.SpyInstance = jest.spyOn(producer, 'send');
+    await onMessage(createKafkaMessageFromDeleveragingEvent(event));
+
+    const eventId: Buffer = TendermintEventTable.createEventId(defaultHeight.toString(), 0, 0);
+    const size: string = '0.000001'; // fillAmount in human = 1e4 * 1e-10 = 1e-6
+    await Promise.all([
+      expectFillInDatabase({
+        subaccountId: testConstants.defaultSubaccountId,
+        clientId: '0',
+        liquidity: Liquidity.TAKER,
+        size,
+        price,
+        quoteAmount,
+        eventId,
+        transactionHash: defaultTxHash,
+        createdAt: defaultDateTime.toISO(),
+        createdAtHeight: defaultHeight.toString(),
+        type: FillType.DELEVERAGED,
+        clobPairId: testConstants.defaultPerpetualMarket.clobPairId,
+        side: OrderSide.BUY,
+        orderFlags: ORDER_FLAG_SHORT_TERM.toString(),
+        clientMetadata: null,
+        fee: '0',
+        hasOrderId: false,
+      }),
+      expectFillInDatabase({
+        subaccountId: testConstants.defaultSubaccountId2,
+        clientId: '0',
+        liquidity: Liquidity.MAKER,
+        size,
+        price,
+        quoteAmount,
+        eventId,
+        transactionHash: defaultTxHash,
+        createdAt: defaultDateTime.toISO(),
+        createdAtHeight: defaultHeight.toString(),
+        type: FillType.OFFSETTING,
+        clobPairId: testConstants.defaultPerpetualMarket.clobPairId,
+        side: OrderSide.SELL,
+        orderFlags: ORDER_FLAG_SHORT_TERM.toString(),
+        clientMetadata: null,
+        fee: '0',
+        hasOrderId: false,
+      }),
+    ]);
+
+    const liquidatedPositionId: string = PerpetualPositionTable.uuid(
+      testConstants.defaultSubaccountId,
+      liquidatedPerpetualPosition.openEventId,
+    );
+    const offsettingPositionId: string = PerpetualPositionTable.uuid(
+      testConstants.defaultSubaccountId2,
+      offsettingPerpetualPosition.openEventId,
+    );
+    await Promise.all([
+      expectPerpetualPosition(liquidatedPositionId, { sumClose: size, exitPrice: price }),
+      expectPerpetualPosition(offsettingPositionId, { sumClose: size, exitPrice: price }),
+      expectFillSubaccountKafkaMessageFromLiquidationEvent(
+        producerSendMock,
+        liquidatedSubaccountId,
+        FillTable.uuid(eventId, Liquidity.TAKER),
+        liquidatedPositionId,
+      ),
+      expectFillSubaccountKafkaMessageFromLiquidationEvent(
+        producerSendMock,
+        offsettingSubaccountId,
+        FillTable.uuid(eventId, Liquidity.MAKER),
+        offsettingPositionId,
+      ),
+      // Deleveraging fills do not generate trades, so no candles are created.
+      expectNoCandles(),
+    ]);
+    expectTimingStats();
+  });
+});
+
+function createBlock(
+  deleveragingEvent: DeleveragingEventV1,
+): IndexerTendermintBlock {
+  const event: IndexerTendermintEvent = createIndexerTendermintEvent(
+    DydxIndexerSubtypes.DELEVERAGING,
+    DeleveragingEventV1.encode(deleveragingEvent).finish(),
+    0,
+    0,
+  );
+
+  return createIndexerTendermintBlock(
+    defaultHeight,
+    defaultTime,
+    [event],
+    [defaultTxHash],
+  );
+}
+
+function createKafkaMessageFromDeleveragingEvent(
+  deleveragingEvent: DeleveragingEventV1,
+): KafkaMessage {
+  const block: IndexerTendermintBlock = createBlock(deleveragingEvent);
+  const binaryBlock: Uint8Array = IndexerTendermintBlock.encode(block).finish();
+  return createKafkaMessage(Buffer.from(binaryBlock));
+}
+
+function expectTimingStats() {
+  expectTimingStat('create_fills');
+  expectTimingStat('update_perpetual_positions');
+}
+
+function expectTimingStat(fnName: string) {
+  expect(stats.timing).toHaveBeenCalledWith(
+    `ender.${STATS_FUNCTION_NAME}.timing`,
+    expect.any(Number),
+    { className: 'DeleveragingHandler', eventType: 'DeleveragingEvent', fnName },
+  );
+}
+
+async function expectNoCandles() {
+  const candles: CandleFromDatabase[] = await CandleTable.findAll({}, []);
+  expect(candles.length).toEqual(0);
+}
@@ -0,0 +1,190 @@
+import { stats } from '@dydxprotocol-indexer/base';
+import {
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+  SpotOrderFillEventV1,
+} from '@dydxprotocol-indexer/v4-protos';
+import { redis } from '@dydxprotocol-indexer/redis';
+import {
+  assetRefresher,
+  dbHelpers,
+  FillFromDatabase,
+  FillTable,
+  FillType,
+  Liquidity,
+  OrderFromDatabase,
+  OrderSide,
+  OrderStatus,
+  OrderTable,
+  SpotMarketTable,
+  SubaccountTable,
+  TendermintEventTable,
+  testConstants,
+  testMocks,
+} from '@dydxprotocol-indexer/postgres';
+import { createKafkaMessage } from '@dydxprotocol-indexer/kafka';
+import { KafkaMessage } from 'kafkajs';
+import {
+  STATEFUL_ORDER_ORDER_FILL_EVENT_TYPE,
+  SUBACCOUNT_ORDER_FILL_EVENT_TYPE,
+} from '../../../src/constants';
+import { onMessage } from '../../../src/lib/on-message';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+} from '../../helpers/indexer-proto-helpers';
+import { updateBlockCache } from '../../../src/caches/block-cache';
+import {
+  defaultHeight,
+  defaultPreviousHeight,
+  defaultSpotOrderFillEvent,
+  defaultTime,
+  defaultTxHash,
+} from '../../helpers/constants';
+import { DydxIndexerSubtypes } from '../../../src/lib/types';
+import { SpotOrderHandler } from '../../../src/handlers/order-fills/spot-order-handler';
+import { createPostgresFunctions } from '../../../src/helpers/postgres/postgres-functions';
+import { redisClient } from '../../../src/helpers/redis/redis-controller';
+
+describe('SpotOrderHandler', () => {
+  beforeAll(async () => {
+    await dbHelpers.migrate();
+    await createPostgresFunctions();
+    jest.spyOn(stats, 'increment');
+    jest.spyOn(stats, 'timing');
+    jest.spyOn(stats, 'gauge');
+  });
+
+  beforeEach(async () => {
+    await testMocks.seedData();
+    await SpotMarketTable.create(testConstants.defaultSpotMarket);
+    await assetRefresher.updateAssets();
+    updateBlockCache(defaultPreviousHeight);
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    jest.clearAllMocks();
+    assetRefresher.clear();
+    await redis.deleteAllAsync(redisClient);
+  });
+
+  afterAll(async () => {
+    await dbHelpers.teardown();
+    jest.resetAllMocks();
+  });
+
+  describe('getParallelizationIds', () => {
+    it.each([
+      [Liquidity.MAKER, defaultSpotOrderFillEvent.makerOrder!],
+      [Liquidity.TAKER, defaultSpotOrderFillEvent.takerOrder!],
+    ])('returns the correct parallelization ids for %s', (liquidity, order) => {
+      const block: IndexerTendermintBlock = createBlock(defaultSpotOrderFillEvent);
+      const handler: SpotOrderHandler = new SpotOrderHandler(
+        block,
+        block.events[0],
+        0,
+        {
+          event: defaultSpotOrderFillEvent,
+          liquidity,
+        },
+      );
+
+      const orderUuid: string = OrderTable.orderIdToUuid(order.orderId!);
+      const subaccountUuid: string = SubaccountTable.subaccountIdToUuid(
+        order.orderId!.subaccountId!,
+      );
+      expect(handler.getParallelizationIds()).toEqual([
+        `${handler.eventType}_${subaccountUuid}_${order.orderId!.clobPairId}`,
+        `${SUBACCOUNT_ORDER_FILL_EVENT_TYPE}_${subaccountUuid}`,
+        `${STATEFUL_ORDER_ORDER_FILL_EVENT_TYPE}_${orderUuid}`,
+      ]);
+    });
+  });
+
+  it('creates orders and fills priced in the assets of the spot market', async () => {
+    const block: IndexerTendermintBlock = createBlock(defaultSpotOrderFillEvent);
+    const kafkaMessage: KafkaMessage = createKafkaMessage(
+      Buffer.from(IndexerTendermintBlock.encode(block).finish()),
+    );
+
+    await onMessage(kafkaMessage);
+
+    const eventId: Buffer = TendermintEventTable.createEventId(defaultHeight.toString(), 0, 0);
+    // 10_000 base quantums of an asset with an atomic resolution of -8.
+    const size: string = '0.0001';
+    // 1e9 subticks * 1e-8 (quantum conversion exponent) * 1e-6 (USDC) / 1e-8 (WBTC).
+    const price: string = '1000';
+
+    const makerFill: FillFromDatabase | undefined = await FillTable.findById(
+      FillTable.uuid(eventId, Liquidity.MAKER),
+    );
+    expect(makerFill).toEqual(expect.objectContaining({
+      side: OrderSide.BUY,
+      liquidity: Liquidity.MAKER,
+      type: FillType.LIMIT,
+      clobPairId: testConstants.defaultSpotMarket.clobPairId,
+      size,
+      price,
+      quoteAmount: '0.1',
+    }));
+    const takerFill: FillFromDatabase | undefined = await FillTable.findById(
+      FillTable.uuid(eventId, Liquidity.TAKER),
+    );
+    expect(takerFill).toEqual(expect.objectContaining({
+      side: OrderSide.SELL,
+      liquidity: Liquidity.TAKER,
+      size,
+      price,
+    }));
+
+    const takerOrder: OrderFromDatabase | undefined = await OrderTable.findById(
+      OrderTable.orderIdToUuid(defaultSpotOrderFillEvent.takerOrder!.orderId!),
+    );
+    expect(takerOrder).toEqual(expect.objectContaining({
+      clobPairId: testConstants.defaultSpotMarket.clobPairId,
+      size: '100',
+      totalFilled: size,
+      price,
+      status: OrderStatus.OPEN,
+    }));
+  });
+
+  it('fails when the spot market does not exist', async () => {
+    const block: IndexerTendermintBlock = createBlock({
+      ...defaultSpotOrderFillEvent,
+      makerOrder: {
+        ...defaultSpotOrderFillEvent.makerOrder!,
+        orderId: {
+          ...defaultSpotOrderFillEvent.makerOrder!.orderId!,
+          clobPairId: 2000,
+        },
+      },
+    });
+    const kafkaMessage: KafkaMessage = createKafkaMessage(
+      Buffer.from(IndexerTendermintBlock.encode(block).finish()),
+    );
+
+    await expect(onMessage(kafkaMessage)).rejects.toThrowError(
+      'Unable to find spot market with clobPairId: 2000',
+    );
+  });
+});
+
+function createBlock(
+  spotOrderFillEvent: SpotOrderFillEventV1,
+): IndexerTendermintBlock {
+  const event: IndexerTendermintEvent = createIndexerTendermintEvent(
+    DydxIndexerSubtypes.SPOT_ORDER_FILL,
+    SpotOrderFillEventV1.encode(spotOrderFillEvent).finish(),
+    0,
+    0,
+  );
+
+  return createIndexerTendermintBlock(
+    defaultHeight,
+    defaultTime,
+    [event],
+    [defaultTxHash],
+  );
+}
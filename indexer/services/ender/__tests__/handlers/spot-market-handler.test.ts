@@ -0,0 +1,152 @@
+import { stats, STATS_FUNCTION_NAME } from '@dydxprotocol-indexer/base';
+import {
+  SpotMarketCreateEventV1,
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+  Timestamp,
+} from '@dydxprotocol-indexer/v4-protos';
+import {
+  assetRefresher,
+  dbHelpers,
+  PerpetualMarketStatus,
+  SpotMarketFromDatabase,
+  SpotMarketTable,
+  testMocks,
+} from '@dydxprotocol-indexer/postgres';
+import { KafkaMessage } from 'kafkajs';
+import { createKafkaMessage } from '@dydxprotocol-indexer/kafka';
+import { onMessage } from '../../src/lib/on-message';
+import { DydxIndexerSubtypes } from '../../src/lib/types';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+} from '../helpers/indexer-proto-helpers';
+import { SpotMarketCreationHandler } from '../../src/handlers/spot-market-handler';
+import {
+  defaultSpotMarketCreateEvent,
+  defaultHeight,
+  defaultPreviousHeight,
+  defaultTime,
+  defaultTxHash,
+} from '../helpers/constants';
+import { updateBlockCache } from '../../src/caches/block-cache';
+import { createPostgresFunctions } from '../../src/helpers/postgres/postgres-functions';
+
+describe('spotMarketHandler', () => {
+  beforeAll(async () => {
+    await dbHelpers.migrate();
+    await createPostgresFunctions();
+    jest.spyOn(stats, 'increment');
+    jest.spyOn(stats, 'timing');
+    jest.spyOn(stats, 'gauge');
+  });
+
+  beforeEach(async () => {
+    await testMocks.seedData();
+    await assetRefresher.updateAssets();
+    updateBlockCache(defaultPreviousHeight);
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    jest.clearAllMocks();
+    assetRefresher.clear();
+  });
+
+  afterAll(async () => {
+    await dbHelpers.teardown();
+    jest.resetAllMocks();
+  });
+
+  describe('getParallelizationIds', () => {
+    it('returns the correct parallelization ids', () => {
+      const indexerTendermintEvent: IndexerTendermintEvent = createIndexerTendermintEvent(
+        DydxIndexerSubtypes.SPOT_MARKET,
+        SpotMarketCreateEventV1.encode(defaultSpotMarketCreateEvent).finish(),
+        0,
+        0,
+      );
+      const block: IndexerTendermintBlock = createIndexerTendermintBlock(
+        0,
+        defaultTime,
+        [indexerTendermintEvent],
+        [defaultTxHash],
+      );
+
+      const handler: SpotMarketCreationHandler = new SpotMarketCreationHandler(
+        block,
+        indexerTendermintEvent,
+        0,
+        defaultSpotMarketCreateEvent,
+      );
+
+      expect(handler.getParallelizationIds()).toEqual([]);
+    });
+  });
+
+  it('creates new spot market', async () => {
+    const kafkaMessage: KafkaMessage = createKafkaMessageFromSpotMarketEvent({
+      spotMarketEvent: defaultSpotMarketCreateEvent,
+      transactionIndex: 0,
+      height: defaultHeight,
+      time: defaultTime,
+      txHash: defaultTxHash,
+    });
+
+    await onMessage(kafkaMessage);
+
+    const spotMarkets: SpotMarketFromDatabase[] = await SpotMarketTable.findAll({}, []);
+    expect(spotMarkets.length).toEqual(1);
+    expect(spotMarkets[0]).toEqual(expect.objectContaining({
+      clobPairId: defaultSpotMarketCreateEvent.clobPairId.toString(),
+      baseAssetId: defaultSpotMarketCreateEvent.baseAssetId.toString(),
+      quoteAssetId: defaultSpotMarketCreateEvent.quoteAssetId.toString(),
+      status: PerpetualMarketStatus.ACTIVE,
+      quantumConversionExponent: defaultSpotMarketCreateEvent.quantumConversionExponent,
+      subticksPerTick: defaultSpotMarketCreateEvent.subticksPerTick,
+      stepBaseQuantums: Number(defaultSpotMarketCreateEvent.stepBaseQuantums),
+    }));
+    expect(stats.timing).toHaveBeenCalledWith(
+      `ender.${STATS_FUNCTION_NAME}.timing`,
+      expect.any(Number),
+      {
+        className: 'SpotMarketCreationHandler',
+        eventType: 'SpotMarketCreateEvent',
+        fnName: 'upsert_spot_market',
+      },
+    );
+  });
+});
+
+function createKafkaMessageFromSpotMarketEvent({
+  spotMarketEvent,
+  transactionIndex,
+  height,
+  time,
+  txHash,
+}: {
+  spotMarketEvent: SpotMarketCreateEventV1,
+  transactionIndex: number,
+  height: number,
+  time: Timestamp,
+  txHash: string,
+}) {
+  const events: IndexerTendermintEvent[] = [
+    createIndexerTendermintEvent(
+      DydxIndexerSubtypes.SPOT_MARKET,
+      SpotMarketCreateEventV1.encode(spotMarketEvent).finish(),
+      transactionIndex,
+      0,
+    ),
+  ];
+
+  const block: IndexerTendermintBlock = createIndexerTendermintBlock(
+    height,
+    time,
+    events,
+    [txHash],
+  );
+
+  const binaryBlock: Uint8Array = IndexerTendermintBlock.encode(block).finish();
+  return createKafkaMessage(Buffer.from(binaryBlock));
+}
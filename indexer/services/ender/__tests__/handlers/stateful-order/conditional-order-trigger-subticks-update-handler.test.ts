@@ -0,0 +1,176 @@
+import {
+  dbHelpers,
+  OrderFromDatabase,
+  OrderStatus,
+  OrderTable,
+  perpetualMarketRefresher,
+  protocolTranslations,
+  testConstants,
+  testMocks,
+} from '@dydxprotocol-indexer/postgres';
+import {
+  IndexerOrderId,
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+  StatefulOrderEventV1,
+} from '@dydxprotocol-indexer/v4-protos';
+import { KafkaMessage } from 'kafkajs';
+import { onMessage } from '../../../src/lib/on-message';
+import { DydxIndexerSubtypes } from '../../../src/lib/types';
+import {
+  defaultDateTime,
+  defaultHeight,
+  defaultOrderId,
+  defaultPreviousHeight,
+  defaultTime,
+  defaultTxHash,
+} from '../../helpers/constants';
+import { createKafkaMessageFromStatefulOrderEvent } from '../../helpers/kafka-helpers';
+import { updateBlockCache } from '../../../src/caches/block-cache';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+  expectOrderSubaccountKafkaMessage,
+} from '../../helpers/indexer-proto-helpers';
+import { stats, STATS_FUNCTION_NAME } from '@dydxprotocol-indexer/base';
+import { STATEFUL_ORDER_ORDER_FILL_EVENT_TYPE } from '../../../src/constants';
+import { producer } from '@dydxprotocol-indexer/kafka';
+import { ORDER_FLAG_CONDITIONAL } from '@dydxprotocol-indexer/v4-proto-parser';
+import Long from 'long';
+import {
+  ConditionalOrderTriggerSubticksUpdateHandler,
+} from '../../../src/handlers/stateful-order/conditional-order-trigger-subticks-update-handler';
+import { createPostgresFunctions } from '../../../src/helpers/postgres/postgres-functions';
+
+describe('conditionalOrderTriggerSubticksUpdateHandler', () => {
+  beforeAll(async () => {
+    await dbHelpers.migrate();
+    await createPostgresFunctions();
+    jest.spyOn(stats, 'increment');
+    jest.spyOn(stats, 'timing');
+    jest.spyOn(stats, 'gauge');
+  });
+
+  beforeEach(async () => {
+    await testMocks.seedData();
+    updateBlockCache(defaultPreviousHeight);
+    await perpetualMarketRefresher.updatePerpetualMarkets();
+    producerSendMock = jest.spyOn(producer, 'send');
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    jest.clearAllMocks();
+  });
+
+  afterAll(async () => {
+    await dbHelpers.teardown();
+    jest.resetAllMocks();
+  });
+
+  const conditionalOrderId: IndexerOrderId = {
+    ...defaultOrderId,
+    orderFlags: ORDER_FLAG_CONDITIONAL,
+  };
+  const conditionalOrderTriggerSubticks: Long = Long.fromValue(2000000, true);
+  const defaultStatefulOrderEvent: StatefulOrderEventV1 = {
+    conditionalOrderTriggerSubticksUpdate: {
+      orderId: conditionalOrderId,
+      conditionalOrderTriggerSubticks,
+    },
+  };
+  const orderId: string = OrderTable.orderIdToUuid(conditionalOrderId);
+  let producerSendMock: jest.SpyInstance;
+
+  describe('getParallelizationIds', () => {
+    it('returns the correct parallelization ids', () => {
+      const transactionIndex: number = 0;
+      const eventIndex: number = 0;
+
+      const indexerTendermintEvent: IndexerTendermintEvent = createIndexerTendermintEvent(
+        DydxIndexerSubtypes.STATEFUL_ORDER,
+        StatefulOrderEventV1.encode(defaultStatefulOrderEvent).finish(),
+        transactionIndex,
+        eventIndex,
+      );
+      const block: IndexerTendermintBlock = createIndexerTendermintBlock(
+        0,
+        defaultTime,
+        [indexerTendermintEvent],
+        [defaultTxHash],
+      );
+
+      const handler: ConditionalOrderTriggerSubticksUpdateHandler = new
+      ConditionalOrderTriggerSubticksUpdateHandler(
+        block,
+        indexerTendermintEvent,
+        0,
+        defaultStatefulOrderEvent,
+      );
+
+      expect(handler.getParallelizationIds()).toEqual([
+        `${handler.eventType}_${orderId}`,
+        `${STATEFUL_ORDER_ORDER_FILL_EVENT_TYPE}_${orderId}`,
+      ]);
+    });
+  });
+
+  it('successfully updates the trigger price of an untriggered order', async () => {
+    await OrderTable.create({
+      ...testConstants.defaultOrderGoodTilBlockTime,
+      orderFlags: conditionalOrderId.orderFlags.toString(),
+      status: OrderStatus.UNTRIGGERED,
+      triggerPrice: '1000',
+      clientId: '0',
+    });
+    const kafkaMessage: KafkaMessage = createKafkaMessageFromStatefulOrderEvent(
+      defaultStatefulOrderEvent,
+    );
+
+    await onMessage(kafkaMessage);
+    const order: OrderFromDatabase | undefined = await OrderTable.findById(orderId);
+
+    expect(order).toBeDefined();
+    expect(order).toEqual(expect.objectContaining({
+      status: OrderStatus.UNTRIGGERED,
+      triggerPrice: protocolTranslations.subticksToPrice(
+        conditionalOrderTriggerSubticks.toString(10),
+        testConstants.defaultPerpetualMarket,
+      ),
+      updatedAt: defaultDateTime.toISO(),
+      updatedAtHeight: defaultHeight.toString(),
+    }));
+    expectTimingStats();
+    expectOrderSubaccountKafkaMessage(
+      producerSendMock,
+      conditionalOrderId.subaccountId!,
+      order!,
+    );
+  });
+
+  it('throws error when attempting to update an order that does not exist', async () => {
+    const kafkaMessage: KafkaMessage = createKafkaMessageFromStatefulOrderEvent(
+      defaultStatefulOrderEvent,
+    );
+
+    await expect(onMessage(kafkaMessage)).rejects.toThrowError(
+      new Error(`Unable to update order trigger price with orderId: ${orderId}`),
+    );
+  });
+});
+
+function expectTimingStats() {
+  expectTimingStat('update_trigger_price');
+}
+
+function expectTimingStat(fnName: string) {
+  expect(stats.timing).toHaveBeenCalledWith(
+    `ender.${STATS_FUNCTION_NAME}.timing`,
+    expect.any(Number),
+    {
+      className: 'ConditionalOrderTriggerSubticksUpdateHandler',
+      eventType: 'StatefulOrderEvent',
+      fnName,
+    },
+  );
+}
@@ -0,0 +1,167 @@
+import {
+  dbHelpers,
+  OrderFromDatabase,
+  OrderStatus,
+  OrderTable,
+  perpetualMarketRefresher,
+  testConstants,
+  testMocks,
+} from '@dydxprotocol-indexer/postgres';
+import {
+  IndexerOrder,
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+  OffChainUpdateV1,
+  OrderPlaceV1_OrderPlacementStatus,
+  StatefulOrderEventV1,
+} from '@dydxprotocol-indexer/v4-protos';
+import { KafkaMessage } from 'kafkajs';
+import { onMessage } from '../../../src/lib/on-message';
+import { DydxIndexerSubtypes } from '../../../src/lib/types';
+import {
+  defaultDateTime,
+  defaultHeight,
+  defaultLongTermOrderReplacementEvent,
+  defaultPreviousHeight,
+  defaultTime,
+  defaultTxHash,
+} from '../../helpers/constants';
+import { createKafkaMessageFromStatefulOrderEvent } from '../../helpers/kafka-helpers';
+import { updateBlockCache } from '../../../src/caches/block-cache';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+  expectVulcanKafkaMessage,
+} from '../../helpers/indexer-proto-helpers';
+import { getPrice, getSize } from '../../../src/lib/helper';
+import { stats, STATS_FUNCTION_NAME } from '@dydxprotocol-indexer/base';
+import { STATEFUL_ORDER_ORDER_FILL_EVENT_TYPE } from '../../../src/constants';
+import { producer } from '@dydxprotocol-indexer/kafka';
+import {
+  LongTermOrderReplacementHandler,
+} from '../../../src/handlers/stateful-order/long-term-order-replacement-handler';
+import { createPostgresFunctions } from '../../../src/helpers/postgres/postgres-functions';
+
+describe('longTermOrderReplacementHandler', () => {
+  beforeAll(async () => {
+    await dbHelpers.migrate();
+    await createPostgresFunctions();
+    jest.spyOn(stats, 'increment');
+    jest.spyOn(stats, 'timing');
+    jest.spyOn(stats, 'gauge');
+  });
+
+  beforeEach(async () => {
+    await testMocks.seedData();
+    updateBlockCache(defaultPreviousHeight);
+    await perpetualMarketRefresher.updatePerpetualMarkets();
+    producerSendMock = jest.spyOn(producer, 'send');
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    jest.clearAllMocks();
+  });
+
+  afterAll(async () => {
+    await dbHelpers.teardown();
+    jest.resetAllMocks();
+  });
+
+  const replacementOrder: IndexerOrder = defaultLongTermOrderReplacementEvent
+    .longTermOrderReplacement!.order!;
+  const orderId: string = OrderTable.orderIdToUuid(replacementOrder.orderId!);
+  let producerSendMock: jest.SpyInstance;
+
+  describe('getParallelizationIds', () => {
+    it('returns the correct parallelization ids', () => {
+      const transactionIndex: number = 0;
+      const eventIndex: number = 0;
+
+      const indexerTendermintEvent: IndexerTendermintEvent = createIndexerTendermintEvent(
+        DydxIndexerSubtypes.STATEFUL_ORDER,
+        StatefulOrderEventV1.encode(defaultLongTermOrderReplacementEvent).finish(),
+        transactionIndex,
+        eventIndex,
+      );
+      const block: IndexerTendermintBlock = createIndexerTendermintBlock(
+        0,
+        defaultTime,
+        [indexerTendermintEvent],
+        [defaultTxHash],
+      );
+
+      const handler: LongTermOrderReplacementHandler = new LongTermOrderReplacementHandler(
+        block,
+        indexerTendermintEvent,
+        0,
+        defaultLongTermOrderReplacementEvent,
+      );
+
+      expect(handler.getParallelizationIds()).toEqual([
+        `${handler.eventType}_${orderId}`,
+        `${STATEFUL_ORDER_ORDER_FILL_EVENT_TYPE}_${orderId}`,
+      ]);
+    });
+  });
+
+  it('successfully replaces the price and size of an order and keeps its total filled', async () => {
+    await OrderTable.create({
+      ...testConstants.defaultOrderGoodTilBlockTime,
+      clientId: replacementOrder.orderId!.clientId.toString(),
+      totalFilled: '1',
+      status: OrderStatus.OPEN,
+    });
+    const kafkaMessage: KafkaMessage = createKafkaMessageFromStatefulOrderEvent(
+      defaultLongTermOrderReplacementEvent,
+    );
+
+    await onMessage(kafkaMessage);
+    const order: OrderFromDatabase | undefined = await OrderTable.findById(orderId);
+
+    expect(order).toBeDefined();
+    expect(order).toEqual(expect.objectContaining({
+      size: getSize(replacementOrder, testConstants.defaultPerpetualMarket),
+      price: getPrice(replacementOrder, testConstants.defaultPerpetualMarket),
+      totalFilled: '1',
+      status: OrderStatus.OPEN,
+      updatedAt: defaultDateTime.toISO(),
+      updatedAtHeight: defaultHeight.toString(),
+    }));
+    expectTimingStat('replace_order');
+
+    const expectedOffchainUpdate: OffChainUpdateV1 = {
+      orderReplace: {
+        order: replacementOrder,
+        placementStatus: OrderPlaceV1_OrderPlacementStatus.ORDER_PLACEMENT_STATUS_OPENED,
+      },
+    };
+    expectVulcanKafkaMessage({
+      producerSendMock,
+      orderId: replacementOrder.orderId!,
+      offchainUpdate: expectedOffchainUpdate,
+    });
+  });
+
+  it('throws error when attempting to replace an order that does not exist', async () => {
+    const kafkaMessage: KafkaMessage = createKafkaMessageFromStatefulOrderEvent(
+      defaultLongTermOrderReplacementEvent,
+    );
+
+    await expect(onMessage(kafkaMessage)).rejects.toThrowError(
+      new Error(`Unable to replace order with orderId: ${orderId}`),
+    );
+  });
+});
+
+function expectTimingStat(fnName: string) {
+  expect(stats.timing).toHaveBeenCalledWith(
+    `ender.${STATS_FUNCTION_NAME}.timing`,
+    expect.any(Number),
+    {
+      className: 'LongTermOrderReplacementHandler',
+      eventType: 'StatefulOrderEvent',
+      fnName,
+    },
+  );
+}
@@ -27,6 +27,9 @@ import {
   AssetCreateEventV1,
   PerpetualMarketCreateEventV1,
   ClobPairStatus, LiquidityTierUpsertEventV1, UpdatePerpetualEventV1, UpdateClobPairEventV1,
+  SpotMarketCreateEventV1,
+  SpotOrderFillEventV1,
+  DeleveragingEventV1,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
 import { DateTime } from 'luxon';
@@ -142,6 +145,16 @@ export const defaultUpdateClobPairEvent: UpdateClobPairEventV1 = {
   stepBaseQuantums: Long.fromValue(100, true),
 };
 
+export const defaultSpotMarketCreateEvent: SpotMarketCreateEventV1 = {
+  clobPairId: parseInt(testConstants.defaultSpotMarket.clobPairId, 10),
+  baseAssetId: parseInt(testConstants.defaultAsset3.id, 10),
+  quoteAssetId: parseInt(testConstants.defaultAsset.id, 10),
+  status: ClobPairStatus.CLOB_PAIR_STATUS_ACTIVE,
+  quantumConversionExponent: -8,
+  subticksPerTick: 100,
+  stepBaseQuantums: Long.fromValue(10, true),
+};
+
 export const defaultPreviousHeight: string = '2';
 export const defaultHeight: number = 3;
 export const defaultDateTime: DateTime = DateTime.utc(2022, 6, 1, 12, 1, 1, 2);
@@ -235,6 +248,39 @@ export const defaultLiquidationEvent: OrderFillEventV1 = {
   totalFilledMaker: Long.fromValue(0, true),
   totalFilledTaker: Long.fromValue(0, true),
 };
+export const defaultSpotOrderFillEvent: SpotOrderFillEventV1 = {
+  makerOrder: {
+    ...defaultMakerOrder,
+    orderId: {
+      ...defaultOrderId,
+      clobPairId: parseInt(testConstants.defaultSpotMarket.clobPairId, 10),
+    },
+  },
+  takerOrder: {
+    ...defaultTakerOrder,
+    orderId: {
+      ...defaultOrderId2,
+      clobPairId: parseInt(testConstants.defaultSpotMarket.clobPairId, 10),
+    },
+    reduceOnly: false,
+  },
+  baseAssetId: parseInt(testConstants.defaultAsset3.id, 10),
+  quoteAssetId: parseInt(testConstants.defaultAsset.id, 10),
+  fillAmount: Long.fromValue(10_000, true),
+  makerFee: Long.fromValue(0, false),
+  takerFee: Long.fromValue(0, false),
+  totalFilledMaker: Long.fromValue(10_000, true),
+  totalFilledTaker: Long.fromValue(10_000, true),
+};
+export const defaultDeleveragingEvent: DeleveragingEventV1 = {
+  liquidated: defaultSubaccountId,
+  offsetting: defaultSubaccountId2,
+  perpetualId: parseInt(testConstants.defaultPerpetualMarket.id, 10),
+  fillAmount: Long.fromValue(10_000, true),
+  subticks: bigIntToBytes(BigInt(1_000_000_000)),
+  totalQuoteQuantums: bigIntToBytes(BigInt(100_000)),
+  isBuy: true,
+};
 export const defaultLiquidation: OrderFillEventWithLiquidation = {
   makerOrder: defaultMakerOrder,
   liquidationOrder: defaultLiquidationOrder,
@@ -361,6 +407,15 @@ export const defaultConditionalOrderTriggeredEvent: StatefulOrderEventV1 = {
     },
   },
 };
+export const defaultConditionalOrderTriggerSubticksUpdateEvent: StatefulOrderEventV1 = {
+  conditionalOrderTriggerSubticksUpdate: {
+    orderId: {
+      ...defaultOrderId,
+      orderFlags: ORDER_FLAG_CONDITIONAL,
+    },
+    conditionalOrderTriggerSubticks: Long.fromValue(2000000, true),
+  },
+};
 export const defaultLongTermOrderPlacementEvent: StatefulOrderEventV1 = {
   longTermOrderPlacement: {
     order: {
@@ -373,3 +428,12 @@ export const defaultLongTermOrderPlacementEvent: StatefulOrderEventV1 = {
     },
   },
 };
+export const defaultLongTermOrderReplacementEvent: StatefulOrderEventV1 = {
+  longTermOrderReplacement: {
+    order: {
+      ...defaultLongTermOrderPlacementEvent.longTermOrderPlacement!.order!,
+      quantums: Long.fromValue(5_000_000_000, true),
+      subticks: Long.fromValue(2_000_000_000, true),
+    },
+  },
+};
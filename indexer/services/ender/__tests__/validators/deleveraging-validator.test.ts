@@ -0,0 +1,118 @@
+import { logger, ParseMessageError } from '@dydxprotocol-indexer/base';
+import { bigIntToBytes } from '@dydxprotocol-indexer/v4-proto-parser';
+import {
+  DeleveragingEventV1,
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+} from '@dydxprotocol-indexer/v4-protos';
+import Long from 'long';
+
+import { DydxIndexerSubtypes } from '../../src/lib/types';
+import { DeleveragingValidator } from '../../src/validators/deleveraging-validator';
+import {
+  defaultDeleveragingEvent,
+  defaultHeight,
+  defaultTime,
+  defaultTxHash,
+} from '../helpers/constants';
+import { createIndexerTendermintBlock, createIndexerTendermintEvent } from '../helpers/indexer-proto-helpers';
+import { expectDidntLogError, expectLoggedParseMessageError } from '../helpers/validator-helpers';
+
+describe('deleveraging-validator', () => {
+  beforeEach(() => {
+    jest.spyOn(logger, 'error');
+  });
+
+  afterEach(() => {
+    jest.clearAllMocks();
+  });
+
+  describe('validate', () => {
+    it.each([
+      [
+        'positive bankruptcy price',
+        defaultDeleveragingEvent,
+      ],
+      [
+        'negative bankruptcy price',
+        {
+          ...defaultDeleveragingEvent,
+          subticks: bigIntToBytes(BigInt(-1_000_000_000)),
+          totalQuoteQuantums: bigIntToBytes(BigInt(-100_000)),
+        },
+      ],
+    ])('does not throw error on valid deleveraging with %s', (
+      _message: string,
+      event: DeleveragingEventV1,
+    ) => {
+      const validator: DeleveragingValidator = new DeleveragingValidator(
+        event,
+        createBlock(event),
+      );
+
+      validator.validate();
+      expectDidntLogError();
+    });
+
+    it.each([
+      [
+        'does not contain liquidated',
+        {
+          ...defaultDeleveragingEvent,
+          liquidated: undefined,
+        },
+        'DeleveragingEvent must contain a liquidated subaccountId',
+      ],
+      [
+        'does not contain offsetting',
+        {
+          ...defaultDeleveragingEvent,
+          offsetting: undefined,
+        },
+        'DeleveragingEvent must contain an offsetting subaccountId',
+      ],
+      [
+        'has a fillAmount of 0',
+        {
+          ...defaultDeleveragingEvent,
+          fillAmount: Long.fromValue(0, true),
+        },
+        'DeleveragingEvent must contain a non-zero fillAmount',
+      ],
+    ])('throws error if event %s', (
+      _message: string,
+      event: DeleveragingEventV1,
+      message: string,
+    ) => {
+      const validator: DeleveragingValidator = new DeleveragingValidator(
+        event,
+        createBlock(event),
+      );
+
+      expect(() => validator.validate()).toThrow(new ParseMessageError(message));
+      expectLoggedParseMessageError(
+        DeleveragingValidator.name,
+        message,
+        { event },
+      );
+    });
+  });
+});
+
+function createBlock(
+  deleveragingEvent: DeleveragingEventV1,
+): IndexerTendermintBlock {
+  const event: IndexerTendermintEvent = createIndexerTendermintEvent(
+    DydxIndexerSubtypes.DELEVERAGING,
+    DeleveragingEventV1.encode(deleveragingEvent).finish(),
+    0,
+    0,
+  );
+
+  return createIndexerTendermintBlock(
+    defaultHeight,
+    defaultTime,
+    [event],
+    [defaultTxHash],
+  );
+}
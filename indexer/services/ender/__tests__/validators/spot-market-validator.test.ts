@@ -0,0 +1,89 @@
+import { logger, ParseMessageError } from '@dydxprotocol-indexer/base';
+import { SpotMarketCreateEventV1, IndexerTendermintBlock, IndexerTendermintEvent } from '@dydxprotocol-indexer/v4-protos';
+import { dbHelpers, testMocks } from '@dydxprotocol-indexer/postgres';
+import { DydxIndexerSubtypes } from '../../src/lib/types';
+import {
+  defaultSpotMarketCreateEvent, defaultHeight, defaultTime, defaultTxHash,
+} from '../helpers/constants';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+} from '../helpers/indexer-proto-helpers';
+import { expectDidntLogError } from '../helpers/validator-helpers';
+import { SpotMarketValidator } from '../../src/validators/spot-market-validator';
+import Long from 'long';
+
+describe('spot-market-validator', () => {
+  beforeEach(async () => {
+    await testMocks.seedData();
+    jest.spyOn(logger, 'error');
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    jest.clearAllMocks();
+  });
+
+  describe('validate', () => {
+    it('does not throw error on valid spot market create event', () => {
+      const validator: SpotMarketValidator = new SpotMarketValidator(
+        defaultSpotMarketCreateEvent,
+        createBlock(defaultSpotMarketCreateEvent),
+      );
+
+      validator.validate();
+      expectDidntLogError();
+    });
+
+    it.each([
+      [
+        'throws error on spot market create event with the same base and quote asset',
+        {
+          ...defaultSpotMarketCreateEvent,
+          baseAssetId: defaultSpotMarketCreateEvent.quoteAssetId,
+        } as SpotMarketCreateEventV1,
+        'SpotMarketCreateEvent baseAssetId must be different from quoteAssetId',
+      ],
+      [
+        'throws error on spot market create event missing subticksPerTick',
+        {
+          ...defaultSpotMarketCreateEvent,
+          subticksPerTick: 0,
+        } as SpotMarketCreateEventV1,
+        'SpotMarketCreateEvent subticksPerTick is not populated',
+      ],
+      [
+        'throws error on spot market create event missing stepBaseQuantums',
+        {
+          ...defaultSpotMarketCreateEvent,
+          stepBaseQuantums: Long.fromValue(0, true),
+        } as SpotMarketCreateEventV1,
+        'SpotMarketCreateEvent stepBaseQuantums is not populated',
+      ],
+    ])('%s', (_description: string, event: SpotMarketCreateEventV1, expectedMessage: string) => {
+      const validator: SpotMarketValidator = new SpotMarketValidator(
+        event,
+        createBlock(event),
+      );
+      expect(() => validator.validate()).toThrow(new ParseMessageError(expectedMessage));
+    });
+  });
+});
+
+function createBlock(
+  spotMarketEvent: SpotMarketCreateEventV1,
+): IndexerTendermintBlock {
+  const event: IndexerTendermintEvent = createIndexerTendermintEvent(
+    DydxIndexerSubtypes.SPOT_MARKET,
+    SpotMarketCreateEventV1.encode(spotMarketEvent).finish(),
+    0,
+    0,
+  );
+
+  return createIndexerTendermintBlock(
+    defaultHeight,
+    defaultTime,
+    [event],
+    [defaultTxHash],
+  );
+}
@@ -0,0 +1,119 @@
+import { logger, ParseMessageError } from '@dydxprotocol-indexer/base';
+import {
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+  SpotOrderFillEventV1,
+  IndexerOrder_Side,
+} from '@dydxprotocol-indexer/v4-protos';
+import { DydxIndexerSubtypes } from '../../src/lib/types';
+import { SpotOrderFillValidator } from '../../src/validators/spot-order-fill-validator';
+import {
+  defaultHeight,
+  defaultSpotOrderFillEvent,
+  defaultTime,
+  defaultTxHash,
+} from '../helpers/constants';
+import { createIndexerTendermintBlock, createIndexerTendermintEvent } from '../helpers/indexer-proto-helpers';
+import { expectDidntLogError, expectLoggedParseMessageError } from '../helpers/validator-helpers';
+
+describe('spot-order-fill-validator', () => {
+  beforeEach(() => {
+    jest.spyOn(logger, 'error');
+  });
+
+  afterEach(() => {
+    jest.clearAllMocks();
+  });
+
+  describe('validate', () => {
+    it('does not throw error on valid spot order fill event', () => {
+      const validator: SpotOrderFillValidator = new SpotOrderFillValidator(
+        defaultSpotOrderFillEvent,
+        createBlock(defaultSpotOrderFillEvent),
+      );
+
+      validator.validate();
+      expectDidntLogError();
+    });
+
+    it.each([
+      [
+        'does not contain maker order',
+        {
+          ...defaultSpotOrderFillEvent,
+          makerOrder: undefined,
+        },
+        'SpotOrderFillEvent must contain a maker order',
+      ],
+      [
+        'does not contain taker order',
+        {
+          ...defaultSpotOrderFillEvent,
+          takerOrder: undefined,
+        },
+        'SpotOrderFillEvent must contain a taker order',
+      ],
+      [
+        'does not contain maker orderId',
+        {
+          ...defaultSpotOrderFillEvent,
+          makerOrder: { ...defaultSpotOrderFillEvent.makerOrder!, orderId: undefined },
+        },
+        'SpotOrderFillEvent must contain a makerOrder: Order must contain an orderId',
+      ],
+      [
+        'does not contain a specified taker order side',
+        {
+          ...defaultSpotOrderFillEvent,
+          takerOrder: {
+            ...defaultSpotOrderFillEvent.takerOrder!,
+            side: IndexerOrder_Side.SIDE_UNSPECIFIED,
+          },
+        },
+        'SpotOrderFillEvent must contain a takerOrder:  Order must specify an order side',
+      ],
+    ])('throws error if event %s', (_message: string, event: SpotOrderFillEventV1, message: string) => {
+      const validator: SpotOrderFillValidator = new SpotOrderFillValidator(
+        event,
+        createBlock(event),
+      );
+
+      expect(() => validator.validate()).toThrow(new ParseMessageError(message));
+      expectLoggedParseMessageError(
+        SpotOrderFillValidator.name,
+        message,
+        { event },
+      );
+    });
+  });
+
+  describe('createHandlers', () => {
+    it('creates a handler for each side of the fill', () => {
+      const block: IndexerTendermintBlock = createBlock(defaultSpotOrderFillEvent);
+      const validator: SpotOrderFillValidator = new SpotOrderFillValidator(
+        defaultSpotOrderFillEvent,
+        block,
+      );
+
+      expect(validator.createHandlers(block.events[0], 0)).toHaveLength(2);
+    });
+  });
+});
+
+function createBlock(
+  spotOrderFillEvent: SpotOrderFillEventV1,
+): IndexerTendermintBlock {
+  const event: IndexerTendermintEvent = createIndexerTendermintEvent(
+    DydxIndexerSubtypes.SPOT_ORDER_FILL,
+    SpotOrderFillEventV1.encode(spotOrderFillEvent).finish(),
+    0,
+    0,
+  );
+
+  return createIndexerTendermintBlock(
+    defaultHeight,
+    defaultTime,
+    [event],
+    [defaultTxHash],
+  );
+}
@@ -12,8 +12,10 @@ import { StatefulOrderValidator } from '../../src/validators/stateful-order-vali
 import {
   defaultConditionalOrderPlacementEvent,
   defaultConditionalOrderTriggeredEvent,
+  defaultConditionalOrderTriggerSubticksUpdateEvent,
   defaultHeight,
   defaultLongTermOrderPlacementEvent,
+  defaultLongTermOrderReplacementEvent,
   defaultMakerOrder,
   defaultOrderId,
   defaultStatefulOrderPlacementEvent,
@@ -42,6 +44,11 @@ describe('stateful-order-validator', () => {
       ['conditional order placement', defaultConditionalOrderPlacementEvent],
       ['conditional order triggered', defaultConditionalOrderTriggeredEvent],
       ['long term order placement', defaultLongTermOrderPlacementEvent],
+      [
+        'conditional order trigger subticks update',
+        defaultConditionalOrderTriggerSubticksUpdateEvent,
+      ],
+      ['long term order replacement', defaultLongTermOrderReplacementEvent],
     ])('does not throw error on valid %s', (_message: string, event: StatefulOrderEventV1) => {
       const validator: StatefulOrderValidator = new StatefulOrderValidator(
         event,
@@ -58,7 +65,8 @@ describe('stateful-order-validator', () => {
         'does not contain any event',
         {},
         'One of orderPlace, orderRemoval, conditionalOrderPlacement, ' +
-        'conditionalOrderTriggered, longTermOrderPlacement must be defined in StatefulOrderEvent',
+        'conditionalOrderTriggered, longTermOrderPlacement, conditionalOrderTriggerSubticksUpdate, ' +
+        'longTermOrderReplacement must be defined in StatefulOrderEvent',
       ],
 
       // TODO(IND-334): Remove tests after deprecating StatefulOrderPlacement events
@@ -192,6 +200,46 @@ describe('stateful-order-validator', () => {
         `StatefulOrderEvent long term order must have order flag ${ORDER_FLAG_LONG_TERM}`,
       ],
 
+      // Long term Order Replacement Validations
+      [
+        'long term order replacement does not contain an order',
+        {
+          longTermOrderReplacement: {},
+        },
+        'StatefulOrderEvent long term order replacement must contain an order',
+      ],
+      [
+        'long term order replacement does not contain a defined goodTilBlockTime',
+        {
+          longTermOrderReplacement: {
+            order: {
+              ...defaultMakerOrder,
+              orderId: {
+                ...defaultMakerOrder.orderId!,
+                orderFlags: ORDER_FLAG_LONG_TERM,
+              },
+            },
+          },
+        },
+        'StatefulOrderEvent stateful order: order must have goodTilBlockTime',
+      ],
+      [
+        'long term order replacement does not contain the correct order flag',
+        {
+          longTermOrderReplacement: {
+            order: {
+              ...defaultMakerOrder,
+              orderId: {
+                ...defaultMakerOrder.orderId!,
+                orderFlags: ORDER_FLAG_CONDITIONAL,
+              },
+              goodTilBlockTime: 123,
+            },
+          },
+        },
+        `StatefulOrderEvent long term order replacement must have order flag ${ORDER_FLAG_LONG_TERM}`,
+      ],
+
       // Order Removal Validations
       [
         'Stateful order removal does not contain orderId',
@@ -338,6 +386,45 @@ describe('stateful-order-validator', () => {
         `StatefulOrderEvent conditional order triggered must have order flag ${ORDER_FLAG_CONDITIONAL}`,
       ],
 
+      // Conditional order trigger subticks update Validations
+      [
+        'conditional order trigger subticks update does not contain orderId',
+        {
+          conditionalOrderTriggerSubticksUpdate: {
+            orderId: undefined,
+            conditionalOrderTriggerSubticks: Long.fromValue(2000000, true),
+          },
+        },
+        'StatefulOrderEvent conditional order trigger subticks update must contain an orderId',
+      ],
+      [
+        'conditional order trigger subticks update does not contain the correct order flag',
+        {
+          conditionalOrderTriggerSubticksUpdate: {
+            orderId: {
+              ...defaultOrderId,
+              orderFlags: ORDER_FLAG_SHORT_TERM,
+            },
+            conditionalOrderTriggerSubticks: Long.fromValue(2000000, true),
+          },
+        },
+        'StatefulOrderEvent conditional order trigger subticks update must have order flag ' +
+        `${ORDER_FLAG_CONDITIONAL}`,
+      ],
+      [
+        'conditional order trigger subticks update does not contain a trigger subticks greater than zero',
+        {
+          conditionalOrderTriggerSubticksUpdate: {
+            orderId: {
+              ...defaultOrderId,
+              orderFlags: ORDER_FLAG_CONDITIONAL,
+            },
+            conditionalOrderTriggerSubticks: Long.fromValue(0, true),
+          },
+        },
+        'StatefulOrderEvent conditional order trigger subticks update must have trigger price > 0',
+      ],
+
     ])('throws error if event %s', (
       _message: string,
       event: StatefulOrderEventV1,
@@ -68,7 +68,9 @@ export type OrderFillEventBase = {
   fillType: FillType;
   clobPairId: string;
   side: OrderSide;
-  makerOrder: IndexerOrder,
+  // Price of the fill in subticks, the price of the maker order for order fills and the
+  // bankruptcy price for deleveraging fills, which is not bounded by the range of a Long.
+  subticks: Long | bigint;
   fillAmount: Long;
   liquidity: Liquidity;
   clientMetadata?: string;
@@ -116,7 +118,7 @@ export abstract class AbstractOrderFillHandler<T> extends Handler<T> {
       fillType,
       clobPairId: order.orderId!.clobPairId.toString(),
       side: protocolTranslations.protocolOrderSideToOrderSide(order.side),
-      makerOrder,
+      subticks: makerOrder.subticks,
       fillAmount,
       liquidity,
       clientMetadata: order.clientMetadata.toString(),
@@ -137,7 +139,7 @@ export abstract class AbstractOrderFillHandler<T> extends Handler<T> {
         fillType: FillType.LIQUIDATED,
         clobPairId: order.clobPairId.toString(),
         side: order.isBuy ? OrderSide.BUY : OrderSide.SELL,
-        makerOrder: castedLiquidationFillEventMessage.makerOrder,
+        subticks: castedLiquidationFillEventMessage.makerOrder.subticks,
         fillAmount: castedLiquidationFillEventMessage.fillAmount,
         liquidity,
         fee: castedLiquidationFillEventMessage.takerFee,
@@ -168,8 +170,8 @@ export abstract class AbstractOrderFillHandler<T> extends Handler<T> {
       event.fillAmount.toString(),
       perpetualMarket.atomicResolution,
     );
-    const price: string = getPrice(
-      event.makerOrder,
+    const price: string = protocolTranslations.subticksToPrice(
+      event.subticks.toString(10),
       perpetualMarket,
     );
     const transactionIndex: number = indexerTendermintEventToTransactionIndex(
@@ -259,8 +261,8 @@ export abstract class AbstractOrderFillHandler<T> extends Handler<T> {
       orderFillEventBase.fillAmount.toString(),
       perpetualMarket.atomicResolution,
     );
-    const price: string = getPrice(
-      orderFillEventBase.makerOrder,
+    const price: string = protocolTranslations.subticksToPrice(
+      orderFillEventBase.subticks.toString(10),
       perpetualMarket,
     );
 
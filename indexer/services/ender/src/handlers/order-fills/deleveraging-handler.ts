@@ -0,0 +1,136 @@
+import { logger } from '@dydxprotocol-indexer/base';
+import {
+  FillFromDatabase,
+  FillType,
+  Liquidity,
+  OrderSide,
+  PerpetualMarketFromDatabase,
+  perpetualMarketRefresher,
+  PerpetualPositionFromDatabase,
+  SubaccountTable,
+} from '@dydxprotocol-indexer/postgres';
+import { bytesToBigInt } from '@dydxprotocol-indexer/v4-proto-parser';
+import { DeleveragingEventV1, IndexerSubaccountId } from '@dydxprotocol-indexer/v4-protos';
+import Long from 'long';
+
+import { SUBACCOUNT_ORDER_FILL_EVENT_TYPE } from '../../constants';
+import { convertPerpetualPosition } from '../../helpers/kafka-helper';
+import { ConsolidatedKafkaEvent } from '../../lib/types';
+import { AbstractOrderFillHandler, OrderFillEventBase } from './abstract-order-fill-handler';
+
+/**
+ * Handles a DeleveragingEventV1. The positions of the liquidated and offsetting subaccounts are
+ * both closed at the bankruptcy price of the liquidated position, so a fill is created and the
+ * perpetual position is updated for each subaccount. No trade is generated, as the bankruptcy
+ * price is not a market price.
+ */
+export class DeleveragingHandler extends AbstractOrderFillHandler<DeleveragingEventV1> {
+  eventType: string = 'DeleveragingEvent';
+
+  public getParallelizationIds(): string[] {
+    const liquidatedUuid: string = SubaccountTable.subaccountIdToUuid(this.event.liquidated!);
+    const offsettingUuid: string = SubaccountTable.subaccountIdToUuid(this.event.offsetting!);
+    return [
+      `${this.eventType}_${liquidatedUuid}_${this.event.perpetualId}`,
+      `${this.eventType}_${offsettingUuid}_${this.event.perpetualId}`,
+      // To ensure that SubaccountUpdateEvents, OrderFillEvents and DeleveragingEvents for the same
+      // subaccount are not processed in parallel
+      `${SUBACCOUNT_ORDER_FILL_EVENT_TYPE}_${liquidatedUuid}`,
+      `${SUBACCOUNT_ORDER_FILL_EVENT_TYPE}_${offsettingUuid}`,
+    ];
+  }
+
+  public async internalHandle(): Promise<ConsolidatedKafkaEvent[]> {
+    const perpetualMarket: PerpetualMarketFromDatabase = this.getPerpetualMarket();
+    const liquidatedEventBase: OrderFillEventBase = this.createDeleveragingEventBase(
+      perpetualMarket,
+      Liquidity.TAKER,
+    );
+    const offsettingEventBase: OrderFillEventBase = this.createDeleveragingEventBase(
+      perpetualMarket,
+      Liquidity.MAKER,
+    );
+
+    const [liquidatedFill, offsettingFill]: [
+      FillFromDatabase,
+      FillFromDatabase,
+    ] = await this.runFuncWithTimingStatAndErrorLogging(
+      Promise.all([
+        this.createFillFromEvent(perpetualMarket, liquidatedEventBase),
+        this.createFillFromEvent(perpetualMarket, offsettingEventBase),
+      ]),
+      this.generateTimingStatsOptions('create_fills'),
+    );
+
+    const [liquidatedPosition, offsettingPosition]: [
+      PerpetualPositionFromDatabase,
+      PerpetualPositionFromDatabase,
+    ] = await this.runFuncWithTimingStatAndErrorLogging(
+      Promise.all([
+        this.updatePerpetualPosition(perpetualMarket, liquidatedEventBase),
+        this.updatePerpetualPosition(perpetualMarket, offsettingEventBase),
+      ]),
+      this.generateTimingStatsOptions('update_perpetual_positions'),
+    );
+
+    return [
+      this.generateConsolidatedKafkaEvent(
+        this.event.liquidated!,
+        undefined,
+        convertPerpetualPosition(liquidatedPosition),
+        liquidatedFill,
+        perpetualMarket,
+      ),
+      this.generateConsolidatedKafkaEvent(
+        this.event.offsetting!,
+        undefined,
+        convertPerpetualPosition(offsettingPosition),
+        offsettingFill,
+        perpetualMarket,
+      ),
+    ];
+  }
+
+  protected getPerpetualMarket(): PerpetualMarketFromDatabase {
+    const perpetualMarket: PerpetualMarketFromDatabase | undefined = perpetualMarketRefresher
+      .getPerpetualMarketFromId(this.event.perpetualId.toString());
+    if (perpetualMarket === undefined) {
+      logger.error({
+        at: 'deleveragingHandler#getPerpetualMarket',
+        message: 'Unable to find perpetual market',
+        perpetualId: this.event.perpetualId,
+        event: this.event,
+      });
+      throw new Error(
+        `Unable to find perpetual market with perpetualId: ${this.event.perpetualId}`,
+      );
+    }
+    return perpetualMarket;
+  }
+
+  /**
+   * The liquidated subaccount is the TAKER and the offsetting subaccount is the MAKER of the fill,
+   * the offsetting subaccount trades on the opposite side of the liquidated subaccount.
+   */
+  protected createDeleveragingEventBase(
+    perpetualMarket: PerpetualMarketFromDatabase,
+    liquidity: Liquidity,
+  ): OrderFillEventBase {
+    const isLiquidated: boolean = liquidity === Liquidity.TAKER;
+    const subaccountId: IndexerSubaccountId = isLiquidated
+      ? this.event.liquidated!
+      : this.event.offsetting!;
+    const isBuy: boolean = isLiquidated ? this.event.isBuy : !this.event.isBuy;
+    return {
+      subaccountId: SubaccountTable.subaccountIdToUuid(subaccountId),
+      orderId: undefined,
+      fillType: isLiquidated ? FillType.DELEVERAGED : FillType.OFFSETTING,
+      clobPairId: perpetualMarket.clobPairId,
+      side: isBuy ? OrderSide.BUY : OrderSide.SELL,
+      subticks: bytesToBigInt(this.event.subticks),
+      fillAmount: this.event.fillAmount,
+      liquidity,
+      fee: Long.ZERO,
+    };
+  }
+}
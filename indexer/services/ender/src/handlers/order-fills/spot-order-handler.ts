@@ -0,0 +1,288 @@
+import { logger } from '@dydxprotocol-indexer/base';
+import {
+  AssetFromDatabase,
+  assetRefresher,
+  FillCreateObject,
+  FillFromDatabase,
+  FillTable,
+  FillType,
+  Liquidity,
+  OrderCreateObject,
+  OrderFromDatabase,
+  OrderSide,
+  OrderStatus,
+  OrderTable,
+  OrderType,
+  protocolTranslations,
+  SpotMarketFromDatabase,
+  SpotMarketTable,
+  SubaccountMessageContents,
+  SubaccountTable,
+  TendermintEventTable,
+  TimeInForce,
+} from '@dydxprotocol-indexer/postgres';
+import { CanceledOrdersCache } from '@dydxprotocol-indexer/redis';
+import { isStatefulOrder } from '@dydxprotocol-indexer/v4-proto-parser';
+import {
+  IndexerOrder,
+  IndexerOrder_Side,
+  IndexerOrderId,
+  IndexerSubaccountId,
+} from '@dydxprotocol-indexer/v4-protos';
+import Big from 'big.js';
+import Long from 'long';
+import { DateTime } from 'luxon';
+
+import { STATEFUL_ORDER_ORDER_FILL_EVENT_TYPE, SUBACCOUNT_ORDER_FILL_EVENT_TYPE } from '../../constants';
+import { generateFillSubaccountMessage, generateOrderSubaccountMessage } from '../../helpers/kafka-helper';
+import { redisClient } from '../../helpers/redis/redis-controller';
+import { indexerTendermintEventToTransactionIndex } from '../../lib/helper';
+import { ConsolidatedKafkaEvent, SpotOrderFillEventWithLiquidity } from '../../lib/types';
+import { AbstractOrderFillHandler } from './abstract-order-fill-handler';
+
+/**
+ * Spot market with the assets it trades, used to convert the quantums and subticks of spot orders
+ * to human readable sizes and prices.
+ */
+type SpotMarketWithAssets = {
+  spotMarket: SpotMarketFromDatabase,
+  baseAsset: AssetFromDatabase,
+  quoteAsset: AssetFromDatabase,
+  ticker: string,
+};
+
+/**
+ * Handles one side of a SpotOrderFillEventV1. Spot fills are settled through the asset positions of
+ * the maker and taker subaccounts, which are updated by the SubaccountUpdateEvents emitted along
+ * with the fill, so only the orders and fills are persisted here.
+ */
+export class SpotOrderHandler extends AbstractOrderFillHandler<SpotOrderFillEventWithLiquidity> {
+  eventType: string = 'SpotOrderFillEvent';
+
+  /**
+   * @returns the parallelizationIds for the this.event.liquidity order
+   */
+  public getParallelizationIds(): string[] {
+    const orderId: IndexerOrderId = this.getOrder().orderId!;
+    const orderUuid: string = OrderTable.orderIdToUuid(orderId);
+    const subaccountUuid: string = SubaccountTable.subaccountIdToUuid(orderId.subaccountId!);
+    return [
+      `${this.eventType}_${subaccountUuid}_${orderId.clobPairId}`,
+      // To ensure that SubaccountUpdateEvents and SpotOrderFillEvents for the same subaccount are
+      // not processed in parallel
+      `${SUBACCOUNT_ORDER_FILL_EVENT_TYPE}_${subaccountUuid}`,
+      // To ensure that StatefulOrderEvents and SpotOrderFillEvents for the same order are not
+      // processed in parallel
+      `${STATEFUL_ORDER_ORDER_FILL_EVENT_TYPE}_${orderUuid}`,
+    ];
+  }
+
+  public async internalHandle(): Promise<ConsolidatedKafkaEvent[]> {
+    const kafkaEvents: ConsolidatedKafkaEvent[] = [];
+    const orderProto: IndexerOrder = this.getOrder();
+    const spotMarketWithAssets: SpotMarketWithAssets = await this.getSpotMarketWithAssets(
+      orderProto.orderId!.clobPairId.toString(),
+    );
+
+    const orderUuid: string = OrderTable.orderIdToUuid(orderProto.orderId!);
+    const isOrderCanceled: boolean = await
+    CanceledOrdersCache.isOrderCanceled(orderUuid, redisClient);
+
+    // Must be done in this order, because fills refer to an order
+    const order: OrderFromDatabase = await this.runFuncWithTimingStatAndErrorLogging(
+      this.upsertSpotOrder(spotMarketWithAssets, orderProto, isOrderCanceled),
+      this.generateTimingStatsOptions('upsert_orders'));
+
+    const fill: FillFromDatabase = await this.runFuncWithTimingStatAndErrorLogging(
+      this.createSpotFill(spotMarketWithAssets, orderProto),
+      this.generateTimingStatsOptions('create_fill'));
+
+    const subaccountId: IndexerSubaccountId = orderProto.orderId!.subaccountId!;
+    const message: SubaccountMessageContents = {
+      fills: [
+        generateFillSubaccountMessage(fill, spotMarketWithAssets.ticker),
+      ],
+      orders: [
+        generateOrderSubaccountMessage(order, spotMarketWithAssets.ticker),
+      ],
+    };
+    kafkaEvents.push(
+      this.generateConsolidatedSubaccountKafkaEvent(
+        JSON.stringify(message),
+        subaccountId,
+      ),
+    );
+
+    // Update vulcan with the total filled amount of the order.
+    kafkaEvents.push(
+      this.getOrderUpdateKafkaEvent(
+        orderProto.orderId!,
+        this.getTotalFilled(),
+      ),
+    );
+
+    // If the order is stateful and fully-filled, send an order removal to vulcan. We only do this
+    // for stateful orders as we are guaranteed a stateful order cannot be replaced until the next
+    // block.
+    if (order.status === OrderStatus.FILLED && isStatefulOrder(order.orderFlags)) {
+      kafkaEvents.push(this.getOrderRemoveKafkaEvent(orderProto.orderId!));
+    }
+
+    if (this.event.liquidity === Liquidity.TAKER) {
+      kafkaEvents.push(this.generateTradeKafkaEventFromTakerOrderFill(fill));
+    }
+
+    return kafkaEvents;
+  }
+
+  private getOrder(): IndexerOrder {
+    // event is validated before the handler is created, so both orders must exist
+    return this.event.liquidity === Liquidity.MAKER
+      ? this.event.event.makerOrder!
+      : this.event.event.takerOrder!;
+  }
+
+  private getTotalFilled(): Long {
+    return this.event.liquidity === Liquidity.TAKER
+      ? this.event.event.totalFilledTaker
+      : this.event.event.totalFilledMaker;
+  }
+
+  private getFee(): Long {
+    return this.event.liquidity === Liquidity.TAKER
+      ? this.event.event.takerFee
+      : this.event.event.makerFee;
+  }
+
+  private async getSpotMarketWithAssets(clobPairId: string): Promise<SpotMarketWithAssets> {
+    const spotMarket: SpotMarketFromDatabase | undefined = await SpotMarketTable.findById(
+      clobPairId,
+      { txId: this.txId },
+    );
+    if (spotMarket === undefined) {
+      logger.error({
+        at: 'spotOrderHandler#getSpotMarketWithAssets',
+        message: 'Unable to find spot market',
+        clobPairId,
+        event: this.event,
+      });
+      throw new Error(`Unable to find spot market with clobPairId: ${clobPairId}`);
+    }
+
+    const baseAsset: AssetFromDatabase = assetRefresher.getAssetFromId(spotMarket.baseAssetId);
+    const quoteAsset: AssetFromDatabase = assetRefresher.getAssetFromId(spotMarket.quoteAssetId);
+    return {
+      spotMarket,
+      baseAsset,
+      quoteAsset,
+      ticker: `${baseAsset.symbol}-${quoteAsset.symbol}`,
+    };
+  }
+
+  /**
+   * @returns the human readable price of `subticks`, in quote asset per base asset.
+   */
+  private subticksToSpotPrice(
+    subticks: Long,
+    { spotMarket, baseAsset, quoteAsset }: SpotMarketWithAssets,
+  ): string {
+    return Big(subticks.toString(10))
+      .times(Big(10).pow(spotMarket.quantumConversionExponent))
+      .times(Big(10).pow(quoteAsset.atomicResolution))
+      .div(Big(10).pow(baseAsset.atomicResolution))
+      .toFixed();
+  }
+
+  private upsertSpotOrder(
+    spotMarketWithAssets: SpotMarketWithAssets,
+    order: IndexerOrder,
+    isCanceled: boolean,
+  ): Promise<OrderFromDatabase> {
+    const { baseAsset } = spotMarketWithAssets;
+    const size: string = protocolTranslations.quantumsToHumanFixedString(
+      order.quantums.toString(10),
+      baseAsset.atomicResolution,
+    );
+    const totalFilled: string = protocolTranslations.quantumsToHumanFixedString(
+      this.getTotalFilled().toString(10),
+      baseAsset.atomicResolution,
+    );
+    const timeInForce: TimeInForce = protocolTranslations.protocolOrderTIFToTIF(order.timeInForce);
+    const status: OrderStatus = this.getOrderStatus(
+      isCanceled,
+      size,
+      totalFilled,
+      order.orderId!.orderFlags,
+      timeInForce,
+    );
+
+    const orderToCreate: OrderCreateObject = {
+      subaccountId: SubaccountTable.subaccountIdToUuid(order.orderId!.subaccountId!),
+      clientId: order.orderId!.clientId.toString(),
+      clobPairId: order.orderId!.clobPairId.toString(),
+      side: order.side === IndexerOrder_Side.SIDE_BUY ? OrderSide.BUY : OrderSide.SELL,
+      size,
+      totalFilled,
+      price: this.subticksToSpotPrice(order.subticks, spotMarketWithAssets),
+      type: OrderType.LIMIT,
+      status,
+      timeInForce,
+      reduceOnly: order.reduceOnly,
+      orderFlags: order.orderId!.orderFlags.toString(),
+      goodTilBlock: protocolTranslations.getGoodTilBlock(order)?.toString(),
+      goodTilBlockTime: protocolTranslations.getGoodTilBlockTime(order),
+      clientMetadata: order.clientMetadata.toString(),
+      updatedAt: DateTime.fromJSDate(this.block.time!).toISO(),
+      updatedAtHeight: this.block.height.toString(),
+    };
+
+    return OrderTable.upsert(orderToCreate, { txId: this.txId });
+  }
+
+  private createSpotFill(
+    spotMarketWithAssets: SpotMarketWithAssets,
+    order: IndexerOrder,
+  ): Promise<FillFromDatabase> {
+    const transactionIndex: number = indexerTendermintEventToTransactionIndex(
+      this.indexerTendermintEvent,
+    );
+    const eventId: Buffer = TendermintEventTable.createEventId(
+      this.block.height.toString(),
+      transactionIndex,
+      this.indexerTendermintEvent.eventIndex,
+    );
+    const size: string = protocolTranslations.quantumsToHumanFixedString(
+      this.event.event.fillAmount.toString(),
+      spotMarketWithAssets.baseAsset.atomicResolution,
+    );
+    // Fills are executed at the price of the maker order.
+    const price: string = this.subticksToSpotPrice(
+      this.event.event.makerOrder!.subticks,
+      spotMarketWithAssets,
+    );
+    const fee: string = protocolTranslations.quantumsToHumanFixedString(
+      this.getFee().toString(),
+      spotMarketWithAssets.quoteAsset.atomicResolution,
+    );
+
+    const fillToCreate: FillCreateObject = {
+      subaccountId: SubaccountTable.subaccountIdToUuid(order.orderId!.subaccountId!),
+      side: protocolTranslations.protocolOrderSideToOrderSide(order.side),
+      liquidity: this.event.liquidity,
+      type: FillType.LIMIT,
+      clobPairId: order.orderId!.clobPairId.toString(),
+      orderId: OrderTable.orderIdToUuid(order.orderId!),
+      size,
+      price,
+      quoteAmount: Big(size).times(price).toFixed(),
+      eventId,
+      transactionHash: this.block.txHashes[transactionIndex],
+      createdAt: this.timestamp.toISO(),
+      createdAtHeight: this.block.height.toString(),
+      clientMetadata: order.clientMetadata.toString(),
+      fee,
+    };
+
+    return FillTable.create(fillToCreate, { txId: this.txId });
+  }
+}
@@ -0,0 +1,52 @@
+import {
+  protocolTranslations,
+  SpotMarketCreateObject,
+  SpotMarketFromDatabase,
+  SpotMarketTable,
+} from '@dydxprotocol-indexer/postgres';
+import { SpotMarketCreateEventV1 } from '@dydxprotocol-indexer/v4-protos';
+
+import { ConsolidatedKafkaEvent } from '../lib/types';
+import { Handler } from './handler';
+
+export class SpotMarketCreationHandler extends Handler<SpotMarketCreateEventV1> {
+  eventType: string = 'SpotMarketCreateEvent';
+
+  public getParallelizationIds(): string[] {
+    return [];
+  }
+
+  public async internalHandle(): Promise<ConsolidatedKafkaEvent[]> {
+    await this.runFuncWithTimingStatAndErrorLogging(
+      this.upsertSpotMarket(),
+      this.generateTimingStatsOptions('upsert_spot_market'),
+    );
+    // Spot markets are not yet exposed through the markets websocket channel, which only contains
+    // perpetual markets.
+    return [];
+  }
+
+  private async upsertSpotMarket(): Promise<SpotMarketFromDatabase> {
+    return SpotMarketTable.upsert(
+      this.getSpotMarketCreateObject(this.event),
+      { txId: this.txId },
+    );
+  }
+
+  /**
+   * @description Given a SpotMarketCreateEventV1 event, generate the `SpotMarket` to create.
+   */
+  private getSpotMarketCreateObject(
+    spotMarketCreateEventV1: SpotMarketCreateEventV1,
+  ): SpotMarketCreateObject {
+    return {
+      clobPairId: spotMarketCreateEventV1.clobPairId.toString(),
+      baseAssetId: spotMarketCreateEventV1.baseAssetId.toString(),
+      quoteAssetId: spotMarketCreateEventV1.quoteAssetId.toString(),
+      status: protocolTranslations.clobStatusToMarketStatus(spotMarketCreateEventV1.status),
+      quantumConversionExponent: spotMarketCreateEventV1.quantumConversionExponent,
+      subticksPerTick: spotMarketCreateEventV1.subticksPerTick,
+      stepBaseQuantums: Number(spotMarketCreateEventV1.stepBaseQuantums),
+    };
+  }
+}
@@ -0,0 +1,106 @@
+import { logger } from '@dydxprotocol-indexer/base';
+import {
+  OrderFromDatabase,
+  OrderTable,
+  OrderUpdateObject,
+  PerpetualMarketFromDatabase,
+  perpetualMarketRefresher,
+  protocolTranslations,
+  SubaccountMessageContents,
+} from '@dydxprotocol-indexer/postgres';
+import {
+  IndexerOrderId,
+  StatefulOrderEventV1,
+  StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1,
+} from '@dydxprotocol-indexer/v4-protos';
+import { DateTime } from 'luxon';
+
+import { generateOrderSubaccountMessage } from '../../helpers/kafka-helper';
+import { ConsolidatedKafkaEvent } from '../../lib/types';
+import { AbstractStatefulOrderHandler } from '../abstract-stateful-order-handler';
+
+export class ConditionalOrderTriggerSubticksUpdateHandler extends
+  AbstractStatefulOrderHandler<StatefulOrderEventV1> {
+  eventType: string = 'StatefulOrderEvent';
+
+  public getParallelizationIds(): string[] {
+    const orderId: string = OrderTable.orderIdToUuid(
+      this.event.conditionalOrderTriggerSubticksUpdate!.orderId!,
+    );
+    return this.getParallelizationIdsFromOrderId(orderId);
+  }
+
+  // eslint-disable-next-line @typescript-eslint/require-await
+  public async internalHandle(): Promise<ConsolidatedKafkaEvent[]> {
+    const triggerSubticksUpdate:
+    StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 = this.event
+      .conditionalOrderTriggerSubticksUpdate!;
+    const orderIdProto: IndexerOrderId = triggerSubticksUpdate.orderId!;
+    const clobPairId: string = orderIdProto.clobPairId.toString();
+    const perpetualMarket: PerpetualMarketFromDatabase | undefined = perpetualMarketRefresher
+      .getPerpetualMarketFromClobPairId(clobPairId);
+    if (perpetualMarket === undefined) {
+      logger.error({
+        at: 'conditionalOrderTriggerSubticksUpdateHandler#internalHandle',
+        message: 'Unable to find perpetual market',
+        clobPairId,
+        orderIdProto,
+      });
+      throw new Error(`Unable to find perpetual market with clobPairId: ${clobPairId}`);
+    }
+
+    const conditionalOrder: OrderFromDatabase = await this.runFuncWithTimingStatAndErrorLogging(
+      this.updateTriggerPrice(
+        orderIdProto,
+        protocolTranslations.subticksToPrice(
+          triggerSubticksUpdate.conditionalOrderTriggerSubticks.toString(10),
+          perpetualMarket,
+        ),
+      ),
+      this.generateTimingStatsOptions('update_trigger_price'),
+    );
+
+    // The order is still untriggered and not on the book, so no message is sent to vulcan.
+    // ender needs to send the websocket message indicating the trigger price was updated.
+    const message: SubaccountMessageContents = {
+      orders: [
+        generateOrderSubaccountMessage(conditionalOrder, perpetualMarket.ticker),
+      ],
+    };
+
+    return [
+      this.generateConsolidatedSubaccountKafkaEvent(
+        JSON.stringify(message),
+        orderIdProto.subaccountId!,
+      ),
+    ];
+  }
+
+  private async updateTriggerPrice(
+    orderIdProto: IndexerOrderId,
+    triggerPrice: string,
+  ): Promise<OrderFromDatabase> {
+    const orderId: string = OrderTable.orderIdToUuid(orderIdProto);
+    const orderUpdateObject: OrderUpdateObject = {
+      id: orderId,
+      triggerPrice,
+      updatedAt: DateTime.fromJSDate(this.block.time!).toISO(),
+      updatedAtHeight: this.block.height.toString(),
+    };
+
+    const order: OrderFromDatabase | undefined = await OrderTable.update(
+      orderUpdateObject,
+      { txId: this.txId },
+    );
+    if (order === undefined) {
+      const message: string = `Unable to update order trigger price with orderId: ${orderId}`;
+      logger.error({
+        at: 'conditionalOrderTriggerSubticksUpdateHandler#updateTriggerPrice',
+        message,
+        triggerPrice,
+      });
+      throw new Error(message);
+    }
+    return order;
+  }
+}
@@ -0,0 +1,103 @@
+import { logger } from '@dydxprotocol-indexer/base';
+import {
+  OrderFromDatabase,
+  OrderStatus,
+  OrderTable,
+  OrderUpdateObject,
+  PerpetualMarketFromDatabase,
+  perpetualMarketRefresher,
+} from '@dydxprotocol-indexer/postgres';
+import { getOrderIdHash } from '@dydxprotocol-indexer/v4-proto-parser';
+import {
+  IndexerOrder,
+  OffChainUpdateV1,
+  OrderPlaceV1_OrderPlacementStatus,
+  StatefulOrderEventV1,
+} from '@dydxprotocol-indexer/v4-protos';
+import { DateTime } from 'luxon';
+
+import { getPrice, getSize } from '../../lib/helper';
+import { ConsolidatedKafkaEvent } from '../../lib/types';
+import { AbstractStatefulOrderHandler } from '../abstract-stateful-order-handler';
+
+export class LongTermOrderReplacementHandler extends
+  AbstractStatefulOrderHandler<StatefulOrderEventV1> {
+  eventType: string = 'StatefulOrderEvent';
+
+  public getParallelizationIds(): string[] {
+    const orderId: string = OrderTable.orderIdToUuid(
+      this.event.longTermOrderReplacement!.order!.orderId!,
+    );
+    return this.getParallelizationIdsFromOrderId(orderId);
+  }
+
+  public async internalHandle(): Promise<ConsolidatedKafkaEvent[]> {
+    const order: IndexerOrder = this.event.longTermOrderReplacement!.order!;
+    const clobPairId: string = order.orderId!.clobPairId.toString();
+    const perpetualMarket: PerpetualMarketFromDatabase | undefined = perpetualMarketRefresher
+      .getPerpetualMarketFromClobPairId(clobPairId);
+    if (perpetualMarket === undefined) {
+      logger.error({
+        at: 'longTermOrderReplacementHandler#internalHandle',
+        message: 'Unable to find perpetual market',
+        clobPairId,
+        order,
+      });
+      throw new Error(`Unable to find perpetual market with clobPairId: ${clobPairId}`);
+    }
+
+    await this.runFuncWithTimingStatAndErrorLogging(
+      this.replaceOrder(perpetualMarket, order),
+      this.generateTimingStatsOptions('replace_order'),
+    );
+
+    // vulcan replaces the order in the caches and sends the websocket messages for the order.
+    const offChainUpdate: OffChainUpdateV1 = OffChainUpdateV1.fromPartial({
+      orderReplace: {
+        order,
+        placementStatus: OrderPlaceV1_OrderPlacementStatus.ORDER_PLACEMENT_STATUS_OPENED,
+      },
+    });
+
+    return [
+      this.generateConsolidatedVulcanKafkaEvent(
+        getOrderIdHash(order.orderId!),
+        offChainUpdate,
+      ),
+    ];
+  }
+
+  /**
+   * Updates the price and size of the replaced order. The total filled of the replaced order is
+   * kept, as the fill amount of an order carries over to the order that replaces it.
+   */
+  private async replaceOrder(
+    perpetualMarket: PerpetualMarketFromDatabase,
+    order: IndexerOrder,
+  ): Promise<OrderFromDatabase> {
+    const orderId: string = OrderTable.orderIdToUuid(order.orderId!);
+    const orderUpdateObject: OrderUpdateObject = {
+      id: orderId,
+      size: getSize(order, perpetualMarket),
+      price: getPrice(order, perpetualMarket),
+      status: OrderStatus.OPEN,
+      updatedAt: DateTime.fromJSDate(this.block.time!).toISO(),
+      updatedAtHeight: this.block.height.toString(),
+    };
+
+    const updatedOrder: OrderFromDatabase | undefined = await OrderTable.update(
+      orderUpdateObject,
+      { txId: this.txId },
+    );
+    if (updatedOrder === undefined) {
+      const message: string = `Unable to replace order with orderId: ${orderId}`;
+      logger.error({
+        at: 'longTermOrderReplacementHandler#replaceOrder',
+        message,
+        order,
+      });
+      throw new Error(message);
+    }
+    return updatedOrder;
+  }
+}
@@ -21,6 +21,9 @@ import {
   LiquidityTierUpsertEventV1,
   UpdatePerpetualEventV1,
   UpdateClobPairEventV1,
+  SpotMarketCreateEventV1,
+  SpotOrderFillEventV1,
+  DeleveragingEventV1,
 } from '@dydxprotocol-indexer/v4-protos';
 import Big from 'big.js';
 import { DateTime } from 'luxon';
@@ -171,6 +174,30 @@ export function indexerTendermintEventToEventProtoWithType(
         version,
       };
     }
+    case (DydxIndexerSubtypes.SPOT_MARKET.toString()): {
+      return {
+        type: DydxIndexerSubtypes.SPOT_MARKET,
+        eventProto: SpotMarketCreateEventV1.decode(eventDataBinary),
+        indexerTendermintEvent: event,
+        version,
+      };
+    }
+    case (DydxIndexerSubtypes.SPOT_ORDER_FILL.toString()): {
+      return {
+        type: DydxIndexerSubtypes.SPOT_ORDER_FILL,
+        eventProto: SpotOrderFillEventV1.decode(eventDataBinary),
+        indexerTendermintEvent: event,
+        version,
+      };
+    }
+    case (DydxIndexerSubtypes.DELEVERAGING.toString()): {
+      return {
+        type: DydxIndexerSubtypes.DELEVERAGING,
+        eventProto: DeleveragingEventV1.decode(eventDataBinary),
+        indexerTendermintEvent: event,
+        version,
+      };
+    }
     default: {
       const message: string = `Unable to parse event subtype: ${event.subtype}`;
       logger.error({
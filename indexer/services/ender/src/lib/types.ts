@@ -30,6 +30,9 @@ import {
   LiquidityTierUpsertEventV1,
   UpdatePerpetualEventV1,
   UpdateClobPairEventV1,
+  SpotMarketCreateEventV1,
+  SpotOrderFillEventV1,
+  DeleveragingEventV1,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
 import { DateTime } from 'luxon';
@@ -55,6 +58,9 @@ export enum DydxIndexerSubtypes {
   LIQUIDITY_TIER = 'liquidity_tier',
   UPDATE_PERPETUAL = 'update_perpetual',
   UPDATE_CLOB_PAIR = 'update_clob_pair',
+  SPOT_MARKET = 'spot_market',
+  SPOT_ORDER_FILL = 'spot_order_fill',
+  DELEVERAGING = 'deleveraging',
 }
 
 // Generic interface used for creating the Handler objects
@@ -121,6 +127,21 @@ export type EventProtoWithTypeAndVersion = {
   eventProto: UpdateClobPairEventV1,
   indexerTendermintEvent: IndexerTendermintEvent,
   version: number,
+} | {
+  type: DydxIndexerSubtypes.SPOT_MARKET,
+  eventProto: SpotMarketCreateEventV1,
+  indexerTendermintEvent: IndexerTendermintEvent,
+  version: number,
+} | {
+  type: DydxIndexerSubtypes.SPOT_ORDER_FILL,
+  eventProto: SpotOrderFillEventV1,
+  indexerTendermintEvent: IndexerTendermintEvent,
+  version: number,
+} | {
+  type: DydxIndexerSubtypes.DELEVERAGING,
+  eventProto: DeleveragingEventV1,
+  indexerTendermintEvent: IndexerTendermintEvent,
+  version: number,
 });
 
 // Events grouped into events block events and events for each transactionIndex
@@ -178,6 +199,11 @@ export type OrderFillEventWithLiquidity = {
   liquidity: Liquidity,
 };
 
+export type SpotOrderFillEventWithLiquidity = {
+  event: SpotOrderFillEventV1,
+  liquidity: Liquidity,
+};
+
 export interface PositionWithPnl extends PerpetualPositionFromDatabase {
   realizedPnl?: string,
   unrealizedPnl?: string,
@@ -0,0 +1,44 @@
+import { DeleveragingEventV1, IndexerTendermintEvent } from '@dydxprotocol-indexer/v4-protos';
+
+import { Handler } from '../handlers/handler';
+import { DeleveragingHandler } from '../handlers/order-fills/deleveraging-handler';
+import { Validator } from './validator';
+
+export class DeleveragingValidator extends Validator<DeleveragingEventV1> {
+  public validate(): void {
+    if (this.event.liquidated === undefined) {
+      return this.logAndThrowParseMessageError(
+        'DeleveragingEvent must contain a liquidated subaccountId',
+        { event: this.event },
+      );
+    }
+
+    if (this.event.offsetting === undefined) {
+      return this.logAndThrowParseMessageError(
+        'DeleveragingEvent must contain an offsetting subaccountId',
+        { event: this.event },
+      );
+    }
+
+    if (this.event.fillAmount.isZero()) {
+      return this.logAndThrowParseMessageError(
+        'DeleveragingEvent must contain a non-zero fillAmount',
+        { event: this.event },
+      );
+    }
+  }
+
+  public createHandlers(
+    indexerTendermintEvent: IndexerTendermintEvent,
+    txId: number,
+  ): Handler<DeleveragingEventV1>[] {
+    return [
+      new DeleveragingHandler(
+        this.block,
+        indexerTendermintEvent,
+        txId,
+        this.event,
+      ),
+    ];
+  }
+}
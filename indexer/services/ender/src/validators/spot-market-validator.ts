@@ -0,0 +1,44 @@
+import { IndexerTendermintEvent, SpotMarketCreateEventV1 } from '@dydxprotocol-indexer/v4-protos';
+import Long from 'long';
+
+import { Handler } from '../handlers/handler';
+import { SpotMarketCreationHandler } from '../handlers/spot-market-handler';
+import { Validator } from './validator';
+
+export class SpotMarketValidator extends Validator<SpotMarketCreateEventV1> {
+  public validate(): void {
+    if (this.event.baseAssetId === this.event.quoteAssetId) {
+      return this.logAndThrowParseMessageError(
+        'SpotMarketCreateEvent baseAssetId must be different from quoteAssetId',
+        { event: this.event },
+      );
+    }
+    if (this.event.subticksPerTick === 0) {
+      return this.logAndThrowParseMessageError(
+        'SpotMarketCreateEvent subticksPerTick is not populated',
+        { event: this.event },
+      );
+    }
+
+    if (this.event.stepBaseQuantums.eq(Long.fromValue(0))) {
+      return this.logAndThrowParseMessageError(
+        'SpotMarketCreateEvent stepBaseQuantums is not populated',
+        { event: this.event },
+      );
+    }
+  }
+
+  public createHandlers(
+    indexerTendermintEvent: IndexerTendermintEvent,
+    txId: number,
+  ): Handler<SpotMarketCreateEventV1>[] {
+    const handler: Handler<SpotMarketCreateEventV1> = new SpotMarketCreationHandler(
+      this.block,
+      indexerTendermintEvent,
+      txId,
+      this.event,
+    );
+
+    return [handler];
+  }
+}
@@ -0,0 +1,68 @@
+import { Liquidity } from '@dydxprotocol-indexer/postgres';
+import {
+  IndexerOrder,
+  IndexerTendermintEvent,
+  SpotOrderFillEventV1,
+} from '@dydxprotocol-indexer/v4-protos';
+import _ from 'lodash';
+
+import { Handler } from '../handlers/handler';
+import { SpotOrderHandler } from '../handlers/order-fills/spot-order-handler';
+import { SpotOrderFillEventWithLiquidity } from '../lib/types';
+import { validateOrderAndReturnErrorMessage } from './helpers';
+import { Validator } from './validator';
+
+export class SpotOrderFillValidator extends Validator<SpotOrderFillEventV1> {
+  public validate(): void {
+    if (this.event.makerOrder === undefined) {
+      return this.logAndThrowParseMessageError(
+        'SpotOrderFillEvent must contain a maker order',
+        { event: this.event },
+      );
+    }
+    if (this.event.takerOrder === undefined) {
+      return this.logAndThrowParseMessageError(
+        'SpotOrderFillEvent must contain a taker order',
+        { event: this.event },
+      );
+    }
+
+    this.validateOrder(this.event.makerOrder, Liquidity.MAKER);
+    this.validateOrder(this.event.takerOrder, Liquidity.TAKER);
+  }
+
+  private validateOrder(
+    order: IndexerOrder,
+    liquidity: Liquidity,
+  ): void {
+    const orderName: string = liquidity === Liquidity.MAKER ? 'makerOrder' : 'takerOrder';
+
+    const errorMessage: string | undefined = validateOrderAndReturnErrorMessage(order);
+    if (errorMessage !== undefined) {
+      return this.logAndThrowParseMessageError(
+        `SpotOrderFillEvent must contain a ${orderName}: ${errorMessage}`,
+        { event: this.event },
+      );
+    }
+  }
+
+  public createHandlers(
+    indexerTendermintEvent: IndexerTendermintEvent,
+    txId: number,
+  ): Handler<SpotOrderFillEventWithLiquidity>[] {
+    return _.map(
+      [Liquidity.MAKER, Liquidity.TAKER],
+      (liquidity: Liquidity) => {
+        return new SpotOrderHandler(
+          this.block,
+          indexerTendermintEvent,
+          txId,
+          {
+            event: this.event,
+            liquidity,
+          },
+        );
+      },
+    );
+  }
+}
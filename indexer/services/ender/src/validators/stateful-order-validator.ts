@@ -9,13 +9,19 @@ import {
   StatefulOrderEventV1_ConditionalOrderPlacementV1,
   StatefulOrderEventV1_ConditionalOrderTriggeredV1,
   StatefulOrderEventV1_LongTermOrderPlacementV1,
+  StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1,
+  StatefulOrderEventV1_LongTermOrderReplacementV1,
   IndexerOrder_ConditionType,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
 
 import { Handler, HandlerInitializer } from '../handlers/handler';
 import { ConditionalOrderPlacementHandler } from '../handlers/stateful-order/conditional-order-placement-handler';
+import {
+  ConditionalOrderTriggerSubticksUpdateHandler,
+} from '../handlers/stateful-order/conditional-order-trigger-subticks-update-handler';
 import { ConditionalOrderTriggeredHandler } from '../handlers/stateful-order/conditional-order-triggered-handler';
+import { LongTermOrderReplacementHandler } from '../handlers/stateful-order/long-term-order-replacement-handler';
 import { StatefulOrderPlacementHandler } from '../handlers/stateful-order/stateful-order-placement-handler';
 import { StatefulOrderRemovalHandler } from '../handlers/stateful-order/stateful-order-removal-handler';
 import { validateOrderAndReturnErrorMessage, validateOrderIdAndReturnErrorMessage } from './helpers';
@@ -28,11 +34,14 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       this.event.orderRemoval === undefined &&
       this.event.conditionalOrderPlacement === undefined &&
       this.event.conditionalOrderTriggered === undefined &&
-      this.event.longTermOrderPlacement === undefined
+      this.event.longTermOrderPlacement === undefined &&
+      this.event.conditionalOrderTriggerSubticksUpdate === undefined &&
+      this.event.longTermOrderReplacement === undefined
     ) {
       return this.logAndThrowParseMessageError(
         'One of orderPlace, orderRemoval, conditionalOrderPlacement, conditionalOrderTriggered, ' +
-        'longTermOrderPlacement must be defined in StatefulOrderEvent',
+        'longTermOrderPlacement, conditionalOrderTriggerSubticksUpdate, longTermOrderReplacement ' +
+        'must be defined in StatefulOrderEvent',
         { event: this.event },
       );
     }
@@ -44,8 +53,14 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       this.validateConditionalOrderPlacement(this.event.conditionalOrderPlacement);
     } else if (this.event.conditionalOrderTriggered !== undefined) {
       this.validateConditionalOrderTriggered(this.event.conditionalOrderTriggered);
-    } else { // longTermOrderPlacement
-      this.validateLongTermOrderPlacement(this.event.longTermOrderPlacement!);
+    } else if (this.event.longTermOrderPlacement !== undefined) {
+      this.validateLongTermOrderPlacement(this.event.longTermOrderPlacement);
+    } else if (this.event.conditionalOrderTriggerSubticksUpdate !== undefined) {
+      this.validateConditionalOrderTriggerSubticksUpdate(
+        this.event.conditionalOrderTriggerSubticksUpdate,
+      );
+    } else { // longTermOrderReplacement
+      this.validateLongTermOrderReplacement(this.event.longTermOrderReplacement!);
     }
   }
 
@@ -193,6 +208,64 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
     }
   }
 
+  private validateConditionalOrderTriggerSubticksUpdate(
+    triggerSubticksUpdate: StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1,
+  ): void {
+    if (triggerSubticksUpdate.orderId === undefined) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger subticks update must contain an orderId',
+        { event: this.event },
+      );
+    }
+
+    if (triggerSubticksUpdate.orderId.orderFlags !== ORDER_FLAG_CONDITIONAL) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger subticks update must have order flag ' +
+        `${ORDER_FLAG_CONDITIONAL}`,
+        { event: this.event },
+      );
+    }
+
+    const orderIdErrorMessage: string | undefined = validateOrderIdAndReturnErrorMessage(
+      triggerSubticksUpdate.orderId,
+    );
+    if (orderIdErrorMessage !== undefined) {
+      return this.logAndThrowParseMessageError(
+        `StatefulOrderEvent conditional order trigger subticks update ${orderIdErrorMessage}`,
+        { event: this.event },
+      );
+    }
+
+    if (triggerSubticksUpdate.conditionalOrderTriggerSubticks <= Long.fromValue(0)) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger subticks update must have trigger price > 0',
+        { event: this.event },
+      );
+    }
+  }
+
+  private validateLongTermOrderReplacement(
+    longTermOrderReplacement: StatefulOrderEventV1_LongTermOrderReplacementV1,
+  ): void {
+    const order: IndexerOrder | undefined = longTermOrderReplacement.order;
+    if (order === undefined) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent long term order replacement must contain an order',
+        { event: this.event },
+      );
+    }
+
+    this.validateStatefulOrder(order);
+
+    if (order.orderId!.orderFlags !== ORDER_FLAG_LONG_TERM) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent long term order replacement must have order flag ' +
+        `${ORDER_FLAG_LONG_TERM}`,
+        { event: this.event },
+      );
+    }
+  }
+
   public getHandlerInitializer() : HandlerInitializer | undefined {
     if (this.event.orderPlace !== undefined) {
       return StatefulOrderPlacementHandler;
@@ -204,6 +277,10 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       return ConditionalOrderTriggeredHandler;
     } else if (this.event.longTermOrderPlacement !== undefined) {
       return StatefulOrderPlacementHandler;
+    } else if (this.event.conditionalOrderTriggerSubticksUpdate !== undefined) {
+      return ConditionalOrderTriggerSubticksUpdateHandler;
+    } else if (this.event.longTermOrderReplacement !== undefined) {
+      return LongTermOrderReplacementHandler;
     }
     return undefined;
   }
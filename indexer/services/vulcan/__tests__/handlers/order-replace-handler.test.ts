@@ -0,0 +1,210 @@
+import {
+  logger,
+  stats,
+  wrapBackgroundTask,
+} from '@dydxprotocol-indexer/base';
+import { synchronizeWrapBackgroundTask } from '@dydxprotocol-indexer/dev';
+import {
+  createKafkaMessage,
+  producer,
+} from '@dydxprotocol-indexer/kafka';
+import {
+  dbHelpers,
+  OrderFromDatabase,
+  OrderTable,
+  perpetualMarketRefresher,
+  protocolTranslations,
+  testConstants,
+  testMocks,
+} from '@dydxprotocol-indexer/postgres';
+import {
+  OpenOrdersCache,
+  OrderData,
+  OrderbookLevels,
+  OrderbookLevelsCache,
+  OrdersCache,
+  OrdersDataCache,
+  redis,
+  redisTestConstants,
+  updateOrder,
+} from '@dydxprotocol-indexer/redis';
+import {
+  IndexerOrder,
+  OffChainUpdateV1,
+  OrderPlaceV1_OrderPlacementStatus,
+  RedisOrder,
+} from '@dydxprotocol-indexer/v4-protos';
+import { KafkaMessage } from 'kafkajs';
+import Long from 'long';
+
+import { convertToRedisOrder } from '../../src/handlers/helpers';
+import { redisClient as client } from '../../src/helpers/redis/redis-controller';
+import { onMessage } from '../../src/lib/on-message';
+import { expectOpenOrderIds, handleInitialOrderPlace } from '../helpers/helpers';
+
+jest.mock('@dydxprotocol-indexer/base', () => ({
+  ...jest.requireActual('@dydxprotocol-indexer/base'),
+  wrapBackgroundTask: jest.fn(),
+}));
+
+describe('order-replace-handler', () => {
+  beforeAll(() => {
+    jest.useFakeTimers();
+  });
+
+  afterAll(() => {
+    jest.useRealTimers();
+  });
+
+  describe('handle', () => {
+    const initialOrder: IndexerOrder = redisTestConstants.defaultOrderGoodTilBlockTime;
+    const initialRedisOrder: RedisOrder = redisTestConstants.defaultRedisOrderGoodTilBlockTime;
+    // Long-term orders keep their good-til-block-time when replaced.
+    const replacementOrder: IndexerOrder = {
+      ...initialOrder,
+      quantums: Long.fromValue(500_000, true),
+      subticks: Long.fromValue(1_000_000, true),
+    };
+    const replacementRedisOrder: RedisOrder = convertToRedisOrder(
+      replacementOrder,
+      testConstants.defaultPerpetualMarket,
+    );
+    const replacementMessage: KafkaMessage = createKafkaMessage(
+      Buffer.from(Uint8Array.from(OffChainUpdateV1.encode({
+        orderReplace: {
+          order: replacementOrder,
+          placementStatus: OrderPlaceV1_OrderPlacementStatus.ORDER_PLACEMENT_STATUS_OPENED,
+        },
+      }).finish())),
+    );
+    const dbOrderGoodTilBlockTime: OrderFromDatabase = {
+      ...testConstants.defaultOrderGoodTilBlockTime,
+      id: testConstants.defaultOrderGoodTilBlockTimeId,
+      createdAtHeight: '2',
+    };
+
+    beforeAll(async () => {
+      await dbHelpers.migrate();
+    });
+
+    beforeEach(async () => {
+      await testMocks.seedData();
+      await perpetualMarketRefresher.updatePerpetualMarkets();
+      await OrderTable.create(dbOrderGoodTilBlockTime);
+      jest.spyOn(stats, 'increment');
+      jest.spyOn(OrderbookLevelsCache, 'updatePriceLevel');
+      jest.spyOn(logger, 'error');
+    });
+
+    afterEach(async () => {
+      await redis.deleteAllAsync(client);
+      await dbHelpers.clearData();
+      jest.restoreAllMocks();
+    });
+
+    afterAll(async () => {
+      await dbHelpers.teardown();
+    });
+
+    it('replaces order with the same expiry that is not resting on the book', async () => {
+      synchronizeWrapBackgroundTask(wrapBackgroundTask);
+      const producerSendSpy: jest.SpyInstance = jest.spyOn(producer, 'send').mockReturnThis();
+      await handleInitialOrderPlace({
+        ...redisTestConstants.orderPlace,
+        orderPlace: {
+          order: initialOrder,
+          placementStatus: OrderPlaceV1_OrderPlacementStatus.ORDER_PLACEMENT_STATUS_OPENED,
+        },
+      });
+      jest.runOnlyPendingTimers();
+      jest.clearAllMocks();
+
+      await onMessage(replacementMessage);
+
+      const redisOrder: RedisOrder | null = await OrdersCache.getOrder(
+        replacementRedisOrder.id,
+        client,
+      );
+      expect(redisOrder).toEqual(replacementRedisOrder);
+      expect(OrderbookLevelsCache.updatePriceLevel).not.toHaveBeenCalled();
+
+      jest.runOnlyPendingTimers();
+      // Only the subaccount message is sent.
+      expect(producerSendSpy).toHaveBeenCalledTimes(1);
+      expect(logger.error).not.toHaveBeenCalled();
+      expect(stats.increment).not.toHaveBeenCalledWith(
+        'vulcan.place_order_handler.replaced_order',
+        expect.any(Number),
+      );
+    });
+
+    it('replaces order resting on the book and keeps its total filled quantums', async () => {
+      const oldOrderTotalFilled: number = 10;
+      synchronizeWrapBackgroundTask(wrapBackgroundTask);
+      const producerSendSpy: jest.SpyInstance = jest.spyOn(producer, 'send').mockReturnThis();
+      await handleInitialOrderPlace({
+        ...redisTestConstants.orderPlace,
+        orderPlace: {
+          order: initialOrder,
+          placementStatus: OrderPlaceV1_OrderPlacementStatus.ORDER_PLACEMENT_STATUS_OPENED,
+        },
+      });
+      // Update the order to set it to be resting on the book, and add its remaining size to the
+      // price level of the order.
+      await updateOrder({
+        updatedOrderId: initialOrder.orderId!,
+        newTotalFilledQuantums: oldOrderTotalFilled,
+        client,
+      });
+      await OrderbookLevelsCache.updatePriceLevel({
+        ticker: testConstants.defaultPerpetualMarket.ticker,
+        side: protocolTranslations.protocolOrderSideToOrderSide(initialOrder.side),
+        humanPrice: initialRedisOrder.price,
+        sizeDeltaInQuantums: (Number(initialOrder.quantums) - oldOrderTotalFilled).toString(),
+        client,
+      });
+      await OpenOrdersCache.addOpenOrder(
+        initialRedisOrder.id,
+        testConstants.defaultPerpetualMarket.clobPairId,
+        client,
+      );
+      jest.runOnlyPendingTimers();
+      jest.clearAllMocks();
+
+      await onMessage(replacementMessage);
+
+      const redisOrder: RedisOrder | null = await OrdersCache.getOrder(
+        replacementRedisOrder.id,
+        client,
+      );
+      expect(redisOrder).toEqual(replacementRedisOrder);
+      const orderData: OrderData | null = await OrdersDataCache.getOrderDataWithUUID(
+        replacementRedisOrder.id,
+        client,
+      );
+      expect(orderData).toEqual(expect.objectContaining({
+        totalFilledQuantums: oldOrderTotalFilled.toString(),
+        restingOnBook: false,
+      }));
+
+      // The remaining size of the replaced order is removed from the book until the order update
+      // for the replacement order is received.
+      const orderbook: OrderbookLevels = await OrderbookLevelsCache.getOrderBookLevels(
+        testConstants.defaultPerpetualMarket.ticker,
+        client,
+      );
+      expect(orderbook.bids).toHaveLength(0);
+      expect(orderbook.asks).toHaveLength(0);
+      await expectOpenOrderIds(testConstants.defaultPerpetualMarket.clobPairId, []);
+
+      jest.runOnlyPendingTimers();
+      // Both the subaccount and orderbook messages are sent.
+      expect(producerSendSpy).toHaveBeenCalledTimes(2);
+      expect(logger.error).not.toHaveBeenCalled();
+      expect(stats.increment).not.toHaveBeenCalledWith(
+        'vulcan.place_order_handler.replaced_order',
+        expect.any(Number),
+      );
+    });
+  });
+});
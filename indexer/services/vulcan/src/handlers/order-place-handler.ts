@@ -59,9 +59,10 @@ export class OrderPlaceHandler extends Handler {
       update,
       txHash: this.txHash,
     });
-    this.validateOrderPlace(update.orderPlace!);
-    const order: IndexerOrder = update.orderPlace!.order!;
-    const placementStatus: OrderPlaceV1_OrderPlacementStatus = update.orderPlace!.placementStatus;
+    const orderPlace: OrderPlaceV1 = this.getOrderPlace(update);
+    this.validateOrderPlace(orderPlace);
+    const order: IndexerOrder = orderPlace.order!;
+    const placementStatus: OrderPlaceV1_OrderPlacementStatus = orderPlace.placementStatus;
 
     const perpetualMarket: PerpetualMarketFromDatabase | undefined = perpetualMarketRefresher
       .getPerpetualMarketFromClobPairId(order.orderId!.clobPairId.toString());
@@ -82,6 +83,7 @@ export class OrderPlaceHandler extends Handler {
       placeOrder({
         redisOrder,
         client: redisClient,
+        isReplacement: this.isReplacement(),
       }),
       this.generateTimingStatsOptions('place_order_cache_update'),
     );
@@ -115,12 +117,14 @@ export class OrderPlaceHandler extends Handler {
         redisClient,
       );
       // TODO(IND-172): Replace this with a logger.error call
-      stats.increment(`${config.SERVICE_NAME}.place_order_handler.replaced_order`, 1);
+      if (!this.isReplacement()) {
+        stats.increment(`${config.SERVICE_NAME}.place_order_handler.replaced_order`, 1);
+      }
     }
 
     // TODO(CLOB-597): Remove this logic and log erorrs once best-effort-open is not sent for
     // stateful orders in the protocol
-    if (this.shouldSendSubaccountMessage(update.orderPlace!)) {
+    if (this.shouldSendSubaccountMessage(orderPlace)) {
       // TODO(IND-171): Determine whether we should always be sending a message, even when the cache
       // isn't updated.
       // for stateful and conditional orders, look the order up in the db for the createdAtHeight
@@ -230,6 +234,23 @@ export class OrderPlaceHandler extends Handler {
     return sizeDeltaInQuantums;
   }
 
+  /**
+   * Gets the order placement contained in the off-chain update.
+   * @param update Off-chain update
+   * @returns The `OrderPlaceV1` message of the update.
+   */
+  protected getOrderPlace(update: OffChainUpdateV1): OrderPlaceV1 {
+    return update.orderPlace!;
+  }
+
+  /**
+   * Whether the handled order is an explicit replacement of an existing order with the same order
+   * id, which replaces the existing order in the caches even if it has the same expiry.
+   */
+  protected isReplacement(): boolean {
+    return false;
+  }
+
   protected validateOrderPlace(orderPlace: OrderPlaceV1): void {
     if (orderPlace.order === undefined) {
       this.logAndThrowParseMessageError('Invalid OrderPlace, order is undefined');
@@ -0,0 +1,42 @@
+import { logger } from '@dydxprotocol-indexer/base';
+import {
+  OffChainUpdateV1,
+  OrderPlaceV1,
+} from '@dydxprotocol-indexer/v4-protos';
+
+import { OrderPlaceHandler } from './order-place-handler';
+
+/**
+ * Handler for OrderReplace messages.
+ * An OrderReplace message contains the new version of an order that replaced an existing order with
+ * the same order id, and is handled like an OrderPlace message with the following differences:
+ * - The existing order is replaced in the caches even if the new version has the same expiry, as
+ *   long-term and conditional orders keep their good-til-block-time when replaced
+ *   - the total filled quantums of the existing order are kept
+ * - If the existing order was resting on the book, its remaining size is removed from the price
+ *   level of the existing order, and the order is removed from the set of open orders. The
+ *   remaining size of the new version of the order is added to the book by the OrderUpdate message
+ *   that follows the OrderReplace message.
+ */
+export class OrderReplaceHandler extends OrderPlaceHandler {
+  protected async handle(update: OffChainUpdateV1): Promise<void> {
+    logger.info({
+      at: 'OrderReplaceHandler#handle',
+      message: 'Received OffChainUpdate with OrderReplace.',
+      update,
+      txHash: this.txHash,
+    });
+    return super.handle(update);
+  }
+
+  protected getOrderPlace(update: OffChainUpdateV1): OrderPlaceV1 {
+    return OrderPlaceV1.fromPartial({
+      order: update.orderReplace!.order,
+      placementStatus: update.orderReplace!.placementStatus,
+    });
+  }
+
+  protected isReplacement(): boolean {
+    return true;
+  }
+}
@@ -26,6 +26,15 @@ func ShouldSkipSequenceValidation(msgs []sdk.Msg) (shouldSkipValidation bool) {
 			}
 			// This is a short term order, continue to check the next message.
 			continue
+		case
+			*clobtypes.MsgReplaceOrder:
+			// Stateful order replacements need to use sequence numbers for replay prevention.
+			orderId := typedMsg.GetOrder().OrderId
+			if orderId.IsStatefulOrder() {
+				return false
+			}
+			// This is a short term order replacement, continue to check the next message.
+			continue
 		case
 			*clobtypes.MsgCancelOrder:
 			orderId := typedMsg.GetOrderId()
@@ -34,6 +43,10 @@ func ShouldSkipSequenceValidation(msgs []sdk.Msg) (shouldSkipValidation bool) {
 			}
 			// This is a `GoodTilBlock` message, continue to check the next message.
 			continue
+		case
+			*clobtypes.MsgBatchPlaceAndCancel:
+			// Batches may only contain Short-Term orders, which all use `GoodTilBlock`.
+			continue
 		default:
 			// Early return for messages that require sequence number validation.
 			return false
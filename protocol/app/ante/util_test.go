@@ -28,6 +28,18 @@ func TestSkipSequenceValidation(t *testing.T) {
 			},
 			shouldSkipValidation: true,
 		},
+		"single batch place and cancel message": {
+			msgs: []sdk.Msg{
+				constants.Msg_BatchPlaceAndCancel,
+			},
+			shouldSkipValidation: true,
+		},
+		"single replace order message": {
+			msgs: []sdk.Msg{
+				constants.Msg_ReplaceOrder,
+			},
+			shouldSkipValidation: true,
+		},
 		"single transfer message": {
 			msgs: []sdk.Msg{
 				constants.Msg_Transfer,
@@ -52,6 +64,12 @@ func TestSkipSequenceValidation(t *testing.T) {
 			},
 			shouldSkipValidation: false,
 		},
+		"single long term order replacement": {
+			msgs: []sdk.Msg{
+				constants.Msg_ReplaceOrder_LongTerm,
+			},
+			shouldSkipValidation: false,
+		},
 		"single conditional order": {
 			msgs: []sdk.Msg{
 				constants.Msg_PlaceOrder_Conditional,
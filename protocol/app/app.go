@@ -177,6 +177,10 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
+
+	// gRPC Streaming
+	streaming "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
+	streamingtypes "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
 )
 
 var (
@@ -274,8 +278,9 @@ type App struct {
 	// module configurator
 	configurator module.Configurator
 
-	IndexerEventManager indexer_manager.IndexerEventManager
-	Server              *daemonserver.Server
+	IndexerEventManager  indexer_manager.IndexerEventManager
+	GrpcStreamingManager streamingtypes.GrpcStreamingManager
+	Server               *daemonserver.Server
 
 	// startDaemons encapsulates the logic that starts all daemons and daemon services. This function contains a
 	// closure of all relevant data structures that are shared with various keepers. Daemon services startup is
@@ -358,7 +363,11 @@ func New(
 		rewardsmoduletypes.TransientStoreKey,
 		indexer_manager.TransientStoreKey,
 	)
-	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, clobmoduletypes.MemStoreKey)
+	memKeys := sdk.NewMemoryStoreKeys(
+		capabilitytypes.MemStoreKey,
+		clobmoduletypes.MemStoreKey,
+		satypes.MemStoreKey,
+	)
 
 	app := &App{
 		BaseApp:           bApp,
@@ -526,6 +535,7 @@ func New(
 		tkeys[indexer_manager.TransientStoreKey],
 		indexerFlags.SendOffchainData,
 	)
+	app.GrpcStreamingManager = getGrpcStreamingManagerFromOptions(appFlags, logger)
 	timeProvider := &timelib.TimeProviderImpl{}
 
 	app.EpochsKeeper = *epochsmodulekeeper.NewKeeper(
@@ -578,6 +588,7 @@ func New(
 		if daemonFlags.Liquidation.Enabled {
 			app.Server.ExpectLiquidationsDaemon(
 				daemonservertypes.MaximumAcceptableUpdateDelay(daemonFlags.Liquidation.LoopDelayMs),
+				daemonservertypes.StalenessPolicy(daemonFlags.Liquidation.StalenessPolicy),
 			)
 			go func() {
 				if err := liquidationclient.Start(
@@ -596,8 +607,15 @@ func New(
 
 		// Non-validating full-nodes have no need to run the price daemon.
 		if !appFlags.NonValidatingFullNode && daemonFlags.Price.Enabled {
-			exchangeQueryConfig := constants.StaticExchangeQueryConfig
-			app.Server.ExpectPricefeedDaemon(daemonservertypes.MaximumAcceptableUpdateDelay(daemonFlags.Price.LoopDelayMs))
+			exchangeQueryConfig, exchangeDetails := constants.WithGenericRestExchanges(
+				constants.StaticExchangeQueryConfig,
+				constants.StaticExchangeDetails,
+				constants.NumGenericRestExchanges,
+			)
+			app.Server.ExpectPricefeedDaemon(
+				daemonservertypes.MaximumAcceptableUpdateDelay(daemonFlags.Price.LoopDelayMs),
+				daemonservertypes.StalenessPolicy(daemonFlags.Price.StalenessPolicy),
+			)
 			// Start pricefeed client for sending prices for the pricefeed server to consume. These prices
 			// are retrieved via third-party APIs like Binance and then are encoded in-memory and
 			// periodically sent via gRPC to a shared socket with the server.
@@ -610,7 +628,7 @@ func New(
 				logger,
 				&daemontypes.GrpcClientImpl{},
 				exchangeQueryConfig,
-				constants.StaticExchangeDetails,
+				exchangeDetails,
 				&pricefeedclient.SubTaskRunnerImpl{},
 			)
 		}
@@ -620,7 +638,10 @@ func New(
 		if !appFlags.NonValidatingFullNode && daemonFlags.Bridge.Enabled {
 			// TODO(CORE-582): Re-enable bridge daemon registration once the bridge daemon is fixed in local / CI
 			// environments.
-			// app.Server.ExpectBridgeDaemon(daemonservertypes.MaximumAcceptableUpdateDelay(daemonFlags.Bridge.LoopDelayMs))
+			// app.Server.ExpectBridgeDaemon(
+			// 	daemonservertypes.MaximumAcceptableUpdateDelay(daemonFlags.Bridge.LoopDelayMs),
+			// 	daemonservertypes.StalenessPolicy(daemonFlags.Bridge.StalenessPolicy),
+			// )
 			go func() {
 				if err := bridgeclient.Start(
 					// The client will use `context.Background` so that it can have a different context from
@@ -668,7 +689,7 @@ func New(
 		appCodec,
 		keys[pricesmoduletypes.StoreKey],
 		indexPriceCache,
-		pricesmoduletypes.NewMarketToSmoothedPrices(pricesmoduletypes.SmoothedPriceTrackingBlockHistoryLength),
+		pricesmoduletypes.NewMarketToSmoothedPrices(pricesmoduletypes.MaxSmoothedPriceHistoryLength),
 		timeProvider,
 		app.IndexerEventManager,
 		// set the governance and delaymsg module accounts as the authority for conducting upgrades
@@ -684,6 +705,11 @@ func New(
 		keys[assetsmoduletypes.StoreKey],
 		app.PricesKeeper,
 		app.IndexerEventManager,
+		// set the governance and delaymsg module accounts as the authority for conducting upgrades
+		[]string{
+			authtypes.NewModuleAddress(delaymsgmoduletypes.ModuleName).String(),
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		},
 	)
 	assetsModule := assetsmodule.NewAppModule(appCodec, app.AssetsKeeper)
 
@@ -794,6 +820,7 @@ func New(
 	app.SubaccountsKeeper = *subaccountsmodulekeeper.NewKeeper(
 		appCodec,
 		keys[satypes.StoreKey],
+		memKeys[satypes.MemStoreKey],
 		app.AssetsKeeper,
 		app.BankKeeper,
 		app.PerpetualsKeeper,
@@ -807,7 +834,11 @@ func New(
 	clobFlags := clobflags.GetClobFlagValuesFromOptions(appOpts)
 	logger.Info("Parsed CLOB flags", "Flags", clobFlags)
 
-	memClob := clobmodulememclob.NewMemClobPriceTimePriority(app.IndexerEventManager.Enabled())
+	// Off-chain updates are generated by the memclob if they are consumed by either the indexer or
+	// gRPC streaming subscribers.
+	memClob := clobmodulememclob.NewMemClobPriceTimePriority(
+		app.IndexerEventManager.Enabled() || app.GrpcStreamingManager.Enabled(),
+	)
 
 	app.ClobKeeper = clobmodulekeeper.NewKeeper(
 		appCodec,
@@ -826,9 +857,11 @@ func New(
 		app.BankKeeper,
 		app.FeeTiersKeeper,
 		app.PerpetualsKeeper,
+		app.PricesKeeper,
 		app.StatsKeeper,
 		app.RewardsKeeper,
 		app.IndexerEventManager,
+		app.GrpcStreamingManager,
 		txConfig.TxDecoder(),
 		clobFlags,
 		rate_limit.NewPanicRateLimiter[*clobmoduletypes.MsgPlaceOrder](),
@@ -841,6 +874,7 @@ func New(
 		app.BankKeeper,
 		app.SubaccountsKeeper,
 		liquidatableSubaccountIds,
+		app.Server,
 	)
 	app.PerpetualsKeeper.SetClobKeeper(app.ClobKeeper)
 
@@ -1115,6 +1149,7 @@ func New(
 				app.ClobKeeper,
 				app.PricesKeeper,
 				app.PerpetualsKeeper,
+				app.Server,
 			),
 		)
 	}
@@ -1143,6 +1178,7 @@ func New(
 				app.StakingKeeper,
 				app.PerpetualsKeeper,
 				app.PricesKeeper,
+				app.Server,
 			),
 		)
 	}
@@ -1205,6 +1241,8 @@ func (app *App) hydrateMemStores() {
 	uncachedCtx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
 	// Initialize memstore in clobKeeper with order fill amounts and stateful orders.
 	app.ClobKeeper.InitMemStore(uncachedCtx)
+	// Initialize memstore in subaccountsKeeper with subaccounts that have open perpetual positions.
+	app.SubaccountsKeeper.InitMemStore(uncachedCtx)
 }
 
 // initializeRateLimiters initializes the rate limiters from state if the application is
@@ -1496,3 +1534,19 @@ func getIndexerFromOptions(
 	}
 	return indexerMessageSender, indexerFlags
 }
+
+// getGrpcStreamingManagerFromOptions returns an instance of a streamingtypes.GrpcStreamingManager from the given
+// options. A noop instance is returned if gRPC streaming is not enabled.
+func getGrpcStreamingManagerFromOptions(
+	appFlags flags.Flags,
+	logger log.Logger,
+) (manager streamingtypes.GrpcStreamingManager) {
+	if appFlags.GrpcStreamingEnabled {
+		logger.Info("gRPC streaming is enabled")
+		return streaming.NewGrpcStreamingManager(
+			logger.With(sdklog.ModuleKey, "grpc-streaming"),
+			appFlags.GrpcStreamingBufferSize,
+		)
+	}
+	return streaming.NewNoopGrpcStreamingManager()
+}
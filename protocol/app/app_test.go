@@ -130,6 +130,15 @@ func TestClobKeeperMemStoreHasBeenInitialized(t *testing.T) {
 	require.Panics(t, func() { dydxApp.ClobKeeper.InitMemStore(ctx) })
 }
 
+func TestSubaccountsKeeperMemStoreHasBeenInitialized(t *testing.T) {
+	dydxApp := testapp.DefaultTestApp(nil)
+	ctx := dydxApp.NewUncachedContext(true, tmproto.Header{})
+
+	// The memstore panics if initialized twice so initializing again outside of application
+	// start-up should cause a panic.
+	require.Panics(t, func() { dydxApp.SubaccountsKeeper.InitMemStore(ctx) })
+}
+
 func TestBaseApp(t *testing.T) {
 	dydxApp := testapp.DefaultTestApp(nil)
 	require.NotNil(t, dydxApp.GetBaseApp(), "Expected non-nil BaseApp")
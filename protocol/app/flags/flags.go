@@ -15,6 +15,10 @@ type Flags struct {
 	DdTraceAgentPort      uint16
 	NonValidatingFullNode bool
 
+	// gRPC Streaming
+	GrpcStreamingEnabled    bool
+	GrpcStreamingBufferSize uint32
+
 	// Existing flags
 	GrpcAddress string
 	GrpcEnable  bool
@@ -26,6 +30,10 @@ const (
 	DdTraceAgentPort          = "dd-trace-agent-port"
 	NonValidatingFullNodeFlag = "non-validating-full-node"
 
+	// gRPC Streaming
+	GrpcStreamingEnabled    = "grpc-streaming-enabled"
+	GrpcStreamingBufferSize = "grpc-streaming-buffer-size"
+
 	// Cosmos flags below. These config values can be set as flags or in config.toml.
 	GrpcAddress = "grpc.address"
 	GrpcEnable  = "grpc.enable"
@@ -36,6 +44,9 @@ const (
 	DefaultDdAgentHost           = ""
 	DefaultDdTraceAgentPort      = 8126
 	DefaultNonValidatingFullNode = false
+
+	DefaultGrpcStreamingEnabled    = false
+	DefaultGrpcStreamingBufferSize = 1000
 )
 
 // AddFlagsToCmd adds flags to app initialization.
@@ -59,6 +70,18 @@ func AddFlagsToCmd(cmd *cobra.Command) {
 		DefaultDdTraceAgentPort,
 		"Sets the Datadog Agent port.",
 	)
+	cmd.Flags().Bool(
+		GrpcStreamingEnabled,
+		DefaultGrpcStreamingEnabled,
+		"Whether to enable streaming of orderbook updates over gRPC. "+
+			"This is only supported on non-validating full nodes with the gRPC server enabled.",
+	)
+	cmd.Flags().Uint32(
+		GrpcStreamingBufferSize,
+		DefaultGrpcStreamingBufferSize,
+		"Maximum number of responses buffered for each gRPC streaming subscription. "+
+			"Subscriptions which fall further behind are closed.",
+	)
 }
 
 // Validate checks that the flags are valid.
@@ -67,6 +90,19 @@ func (f *Flags) Validate() error {
 	if !f.NonValidatingFullNode && !f.GrpcEnable {
 		return fmt.Errorf("grpc.enable must be set to true - validating requires gRPC server")
 	}
+
+	// gRPC streaming is only supported on non-validating full nodes with the gRPC server enabled.
+	if f.GrpcStreamingEnabled {
+		if !f.GrpcEnable {
+			return fmt.Errorf("grpc.enable must be set to true - grpc streaming requires gRPC server")
+		}
+		if !f.NonValidatingFullNode {
+			return fmt.Errorf("grpc-streaming-enabled can only be set to true for non-validating full nodes")
+		}
+		if f.GrpcStreamingBufferSize == 0 {
+			return fmt.Errorf("grpc-streaming-buffer-size must be positive")
+		}
+	}
 	return nil
 }
 
@@ -77,9 +113,11 @@ func GetFlagValuesFromOptions(
 ) Flags {
 	// Create default result.
 	result := Flags{
-		NonValidatingFullNode: DefaultNonValidatingFullNode,
-		DdAgentHost:           DefaultDdAgentHost,
-		DdTraceAgentPort:      DefaultDdTraceAgentPort,
+		NonValidatingFullNode:   DefaultNonValidatingFullNode,
+		DdAgentHost:             DefaultDdAgentHost,
+		DdTraceAgentPort:        DefaultDdTraceAgentPort,
+		GrpcStreamingEnabled:    DefaultGrpcStreamingEnabled,
+		GrpcStreamingBufferSize: DefaultGrpcStreamingBufferSize,
 
 		// These are the default values from the Cosmos flags.
 		GrpcAddress: config.DefaultGRPCAddress,
@@ -117,5 +155,17 @@ func GetFlagValuesFromOptions(
 		}
 	}
 
+	if option := appOpts.Get(GrpcStreamingEnabled); option != nil {
+		if v, err := cast.ToBoolE(option); err == nil {
+			result.GrpcStreamingEnabled = v
+		}
+	}
+
+	if option := appOpts.Get(GrpcStreamingBufferSize); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.GrpcStreamingBufferSize = v
+		}
+	}
+
 	return result
 }
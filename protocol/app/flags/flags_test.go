@@ -27,6 +27,12 @@ func TestAddFlagsToCommand(t *testing.T) {
 		},
 		fmt.Sprintf("Has %s flag", flags.DdTraceAgentPort): {
 			flagName: flags.DdTraceAgentPort,
+		},
+		fmt.Sprintf("Has %s flag", flags.GrpcStreamingEnabled): {
+			flagName: flags.GrpcStreamingEnabled,
+		},
+		fmt.Sprintf("Has %s flag", flags.GrpcStreamingBufferSize): {
+			flagName: flags.GrpcStreamingBufferSize,
 		}}
 
 	for name, tc := range tests {
@@ -48,6 +54,7 @@ func TestValidate(t *testing.T) {
 				DdTraceAgentPort:      flags.DefaultDdTraceAgentPort,
 				GrpcAddress:           config.DefaultGRPCAddress,
 				GrpcEnable:            true,
+				GrpcStreamingEnabled:  flags.DefaultGrpcStreamingEnabled,
 			},
 		},
 		"success - full node & gRPC disabled": {
@@ -62,6 +69,41 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("grpc.enable must be set to true - validating requires gRPC server"),
 		},
+		"success - full node & gRPC streaming enabled": {
+			flags: flags.Flags{
+				NonValidatingFullNode:   true,
+				GrpcEnable:              true,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: flags.DefaultGrpcStreamingBufferSize,
+			},
+		},
+		"failure - gRPC streaming enabled with zero buffer size": {
+			flags: flags.Flags{
+				NonValidatingFullNode:   true,
+				GrpcEnable:              true,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: 0,
+			},
+			expectedErr: fmt.Errorf("grpc-streaming-buffer-size must be positive"),
+		},
+		"failure - gRPC disabled & gRPC streaming enabled": {
+			flags: flags.Flags{
+				NonValidatingFullNode: true,
+				GrpcEnable:            false,
+				GrpcStreamingEnabled:  true,
+			},
+			expectedErr: fmt.Errorf("grpc.enable must be set to true - grpc streaming requires gRPC server"),
+		},
+		"failure - validating node & gRPC streaming enabled": {
+			flags: flags.Flags{
+				NonValidatingFullNode: false,
+				GrpcEnable:            true,
+				GrpcStreamingEnabled:  true,
+			},
+			expectedErr: fmt.Errorf(
+				"grpc-streaming-enabled can only be set to true for non-validating full nodes",
+			),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -86,6 +128,8 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 		expectedDdTraceAgentPort          uint16
 		expectedGrpcAddress               string
 		expectedGrpcEnable                bool
+		expectedGrpcStreamingEnable       bool
+		expectedGrpcStreamingBufferSize   uint32
 	}{
 		"Sets to default if unset": {
 			expectedNonValidatingFullNodeFlag: false,
@@ -93,6 +137,8 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 			expectedDdTraceAgentPort:          8126,
 			expectedGrpcAddress:               "localhost:9090",
 			expectedGrpcEnable:                true,
+			expectedGrpcStreamingEnable:       false,
+			expectedGrpcStreamingBufferSize:   1000,
 		},
 		"Sets values from options": {
 			optsMap: map[string]any{
@@ -101,12 +147,16 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				flags.DdTraceAgentPort:          uint16(777),
 				flags.GrpcEnable:                false,
 				flags.GrpcAddress:               "localhost:9091",
+				flags.GrpcStreamingEnabled:      true,
+				flags.GrpcStreamingBufferSize:   uint32(10),
 			},
 			expectedNonValidatingFullNodeFlag: true,
 			expectedDdAgentHost:               "agentHostTest",
 			expectedDdTraceAgentPort:          777,
 			expectedGrpcEnable:                false,
 			expectedGrpcAddress:               "localhost:9091",
+			expectedGrpcStreamingEnable:       true,
+			expectedGrpcStreamingBufferSize:   10,
 		},
 	}
 
@@ -144,6 +194,16 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				tc.expectedGrpcAddress,
 				flags.GrpcAddress,
 			)
+			require.Equal(
+				t,
+				tc.expectedGrpcStreamingEnable,
+				flags.GrpcStreamingEnabled,
+			)
+			require.Equal(
+				t,
+				tc.expectedGrpcStreamingBufferSize,
+				flags.GrpcStreamingBufferSize,
+			)
 		})
 	}
 }
@@ -133,6 +133,10 @@ var (
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse":    {},
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal":       {},
 
+		// assets
+		"/dydxprotocol.assets.MsgSetAssetCollateralWeight":         {},
+		"/dydxprotocol.assets.MsgSetAssetCollateralWeightResponse": {},
+
 		// blocktime
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParams":         {},
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse": {},
@@ -150,6 +154,8 @@ var (
 		"/dydxprotocol.bridge.MsgUpdateSafetyParamsResponse":  {},
 
 		// clob
+		"/dydxprotocol.clob.MsgBatchPlaceAndCancel":                        {},
+		"/dydxprotocol.clob.MsgBatchPlaceAndCancelResponse":                {},
 		"/dydxprotocol.clob.MsgCancelOrder":                                {},
 		"/dydxprotocol.clob.MsgCancelOrderResponse":                        {},
 		"/dydxprotocol.clob.MsgCreateClobPair":                             {},
@@ -158,6 +164,8 @@ var (
 		"/dydxprotocol.clob.MsgPlaceOrderResponse":                         {},
 		"/dydxprotocol.clob.MsgProposedOperations":                         {},
 		"/dydxprotocol.clob.MsgProposedOperationsResponse":                 {},
+		"/dydxprotocol.clob.MsgReplaceOrder":                               {},
+		"/dydxprotocol.clob.MsgReplaceOrderResponse":                       {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration":          {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse":  {},
 		"/dydxprotocol.clob.MsgUpdateClobPair":                             {},
@@ -12,6 +12,7 @@ import (
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assets "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktime "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	bridge "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	clob "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -78,6 +79,10 @@ var (
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse": nil,
 
 		// ------- Custom modules
+		// assets
+		"/dydxprotocol.assets.MsgSetAssetCollateralWeight":         &assets.MsgSetAssetCollateralWeight{},
+		"/dydxprotocol.assets.MsgSetAssetCollateralWeightResponse": nil,
+
 		// blocktime
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParams":         &blocktime.MsgUpdateDowntimeParams{},
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse": nil,
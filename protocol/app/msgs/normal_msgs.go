@@ -121,10 +121,14 @@ var (
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal":       nil,
 
 		// clob
-		"/dydxprotocol.clob.MsgCancelOrder":         &clob.MsgCancelOrder{},
-		"/dydxprotocol.clob.MsgCancelOrderResponse": nil,
-		"/dydxprotocol.clob.MsgPlaceOrder":          &clob.MsgPlaceOrder{},
-		"/dydxprotocol.clob.MsgPlaceOrderResponse":  nil,
+		"/dydxprotocol.clob.MsgBatchPlaceAndCancel":         &clob.MsgBatchPlaceAndCancel{},
+		"/dydxprotocol.clob.MsgBatchPlaceAndCancelResponse": nil,
+		"/dydxprotocol.clob.MsgCancelOrder":                 &clob.MsgCancelOrder{},
+		"/dydxprotocol.clob.MsgCancelOrderResponse":         nil,
+		"/dydxprotocol.clob.MsgPlaceOrder":                  &clob.MsgPlaceOrder{},
+		"/dydxprotocol.clob.MsgPlaceOrderResponse":          nil,
+		"/dydxprotocol.clob.MsgReplaceOrder":                &clob.MsgReplaceOrder{},
+		"/dydxprotocol.clob.MsgReplaceOrderResponse":        nil,
 
 		// perpetuals
 
@@ -108,10 +108,14 @@ func TestNormalMsgs_Key(t *testing.T) {
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
 
 		// clob
+		"/dydxprotocol.clob.MsgBatchPlaceAndCancel",
+		"/dydxprotocol.clob.MsgBatchPlaceAndCancelResponse",
 		"/dydxprotocol.clob.MsgCancelOrder",
 		"/dydxprotocol.clob.MsgCancelOrderResponse",
 		"/dydxprotocol.clob.MsgPlaceOrder",
 		"/dydxprotocol.clob.MsgPlaceOrderResponse",
+		"/dydxprotocol.clob.MsgReplaceOrder",
+		"/dydxprotocol.clob.MsgReplaceOrderResponse",
 
 		// perpetuals
 
@@ -0,0 +1,51 @@
+package prepare
+
+import (
+	"time"
+
+	gometrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	bridgetypes "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+)
+
+// degradedPricesKeeper is used in place of the prices keeper while the node is in degraded mode for the pricefeed
+// daemon. It proposes no market price updates.
+type degradedPricesKeeper struct{}
+
+func (degradedPricesKeeper) GetValidMarketPriceUpdates(ctx sdk.Context) *pricestypes.MsgUpdateMarketPrices {
+	return &pricestypes.MsgUpdateMarketPrices{
+		MarketPriceUpdates: []*pricestypes.MsgUpdateMarketPrices_MarketPrice{},
+	}
+}
+
+// degradedBridgeKeeper is used in place of the bridge keeper while the node is in degraded mode for the bridge
+// daemon. It acknowledges no bridge events.
+type degradedBridgeKeeper struct{}
+
+func (degradedBridgeKeeper) GetAcknowledgeBridges(
+	ctx sdk.Context,
+	blockTimestamp time.Time,
+) *bridgetypes.MsgAcknowledgeBridges {
+	return &bridgetypes.MsgAcknowledgeBridges{
+		Events: []bridgetypes.BridgeEvent{},
+	}
+}
+
+// isDaemonDegraded returns true if the node is in degraded mode for the daemon service, in which case the data
+// provided by the daemon should not be proposed. A metric is recorded for each proposal made while degraded.
+func isDaemonDegraded(daemonHealthChecker daemonservertypes.DaemonHealthChecker, daemonKey string) bool {
+	if !daemonHealthChecker.IsDaemonDegraded(daemonKey) {
+		return false
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, metrics.Degraded, metrics.Count},
+		1,
+		[]gometrics.Label{metrics.GetLabelForStringValue(metrics.Daemon, daemonKey)},
+	)
+	return true
+}
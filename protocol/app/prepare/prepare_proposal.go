@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
 
@@ -48,12 +49,16 @@ type BridgeTxResponse struct {
 //   - "Others" Group: Bytes=25% of max bytes minus "Fixed" Group size. Includes txs in the request.
 //   - "Order" Group: Bytes=75% of max bytes minus "Fixed" Group size. Includes order matches.
 //   - If there are extra available bytes and there are more txs in "Other" group, add more txs from this group.
+//
+// While the node is in degraded mode for the pricefeed or bridge daemon, empty price updates or bridge
+// acknowledgements are proposed instead of the data provided by the daemon.
 func PrepareProposalHandler(
 	txConfig client.TxConfig,
 	bridgeKeeper PrepareBridgeKeeper,
 	clobKeeper PrepareClobKeeper,
 	pricesKeeper PreparePricesKeeper,
 	perpetualKeeper PreparePerpetualsKeeper,
+	daemonHealthChecker daemonservertypes.DaemonHealthChecker,
 ) sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
 		defer telemetry.ModuleMeasureSince(
@@ -72,7 +77,11 @@ func PrepareProposalHandler(
 		}
 
 		// Gather "FixedSize" group messages.
-		pricesTxResp, err := GetUpdateMarketPricesTx(ctx, txConfig, pricesKeeper)
+		var proposalPricesKeeper PreparePricesKeeper = pricesKeeper
+		if isDaemonDegraded(daemonHealthChecker, daemonservertypes.PricefeedDaemonServiceName) {
+			proposalPricesKeeper = degradedPricesKeeper{}
+		}
+		pricesTxResp, err := GetUpdateMarketPricesTx(ctx, txConfig, proposalPricesKeeper)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("GetUpdateMarketPricesTx error: %v", err))
 			recordErrorMetricsWithLabel(metrics.PricesTx)
@@ -98,7 +107,11 @@ func PrepareProposalHandler(
 			return EmptyResponse
 		}
 
-		acknowledgeBridgesTxResp, err := GetAcknowledgeBridgesTx(ctx, txConfig, bridgeKeeper)
+		var proposalBridgeKeeper PrepareBridgeKeeper = bridgeKeeper
+		if isDaemonDegraded(daemonHealthChecker, daemonservertypes.BridgeDaemonServiceName) {
+			proposalBridgeKeeper = degradedBridgeKeeper{}
+		}
+		acknowledgeBridgesTxResp, err := GetAcknowledgeBridgesTx(ctx, txConfig, proposalBridgeKeeper)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("GetAcknowledgeBridgesTx error: %v", err))
 			recordErrorMetricsWithLabel(metrics.AcknowledgeBridgesTx)
@@ -3,11 +3,13 @@ package prepare_test
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/app/prepare"
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/encoding"
@@ -349,6 +351,9 @@ func TestPrepareProposalHandler(t *testing.T) {
 			mockClobKeeper.On("GetOperations", mock.Anything, mock.Anything).
 				Return(tc.clobResp)
 
+			mockDaemonHealthChecker := mocks.DaemonHealthChecker{}
+			mockDaemonHealthChecker.On("IsDaemonDegraded", mock.Anything).Return(false)
+
 			ctx, _, _, _, _, _ := keepertest.PricesKeepers(t)
 
 			handler := prepare.PrepareProposalHandler(
@@ -357,6 +362,7 @@ func TestPrepareProposalHandler(t *testing.T) {
 				&mockClobKeeper,
 				&mockPricesKeeper,
 				&mockPerpKeeper,
+				&mockDaemonHealthChecker,
 			)
 
 			req := abci.RequestPrepareProposal{
@@ -428,6 +434,9 @@ func TestPrepareProposalHandler_OtherTxs(t *testing.T) {
 			mockBridgeKeeper.On("GetAcknowledgeBridges", mock.Anything, mock.Anything).
 				Return(constants.MsgAcknowledgeBridges_Ids0_1_Height0)
 
+			mockDaemonHealthChecker := mocks.DaemonHealthChecker{}
+			mockDaemonHealthChecker.On("IsDaemonDegraded", mock.Anything).Return(false)
+
 			ctx, _, _, _, _, _ := keepertest.PricesKeepers(t)
 
 			handler := prepare.PrepareProposalHandler(
@@ -436,6 +445,7 @@ func TestPrepareProposalHandler_OtherTxs(t *testing.T) {
 				&mockClobKeeper,
 				&mockPricesKeeper,
 				&mockPerpKeeper,
+				&mockDaemonHealthChecker,
 			)
 
 			req := abci.RequestPrepareProposal{
@@ -449,6 +459,101 @@ func TestPrepareProposalHandler_OtherTxs(t *testing.T) {
 	}
 }
 
+func TestPrepareProposalHandler_DegradedDaemons(t *testing.T) {
+	encodingCfg := encoding.GetTestEncodingCfg()
+
+	tests := map[string]struct {
+		degradedDaemons []string
+
+		expectedTxs [][]byte
+	}{
+		"No degraded daemons": {
+			degradedDaemons: []string{},
+			expectedTxs: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,       // order.
+				constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes, // bridge.
+				constants.ValidMsgAddPremiumVotesTxBytes,               // funding.
+				constants.ValidMsgUpdateMarketPricesTxBytes,            // prices.
+			},
+		},
+		"Pricefeed daemon degraded": {
+			degradedDaemons: []string{daemonservertypes.PricefeedDaemonServiceName},
+			expectedTxs: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,       // order.
+				constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes, // bridge.
+				constants.ValidMsgAddPremiumVotesTxBytes,               // funding.
+				constants.EmptyMsgUpdateMarketPricesTxBytes,            // prices.
+			},
+		},
+		"Bridge daemon degraded": {
+			degradedDaemons: []string{daemonservertypes.BridgeDaemonServiceName},
+			expectedTxs: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes, // order.
+				constants.MsgAcknowledgeBridges_NoEvents_TxBytes, // bridge.
+				constants.ValidMsgAddPremiumVotesTxBytes,         // funding.
+				constants.ValidMsgUpdateMarketPricesTxBytes,      // prices.
+			},
+		},
+		"Pricefeed and bridge daemons degraded": {
+			degradedDaemons: []string{
+				daemonservertypes.PricefeedDaemonServiceName,
+				daemonservertypes.BridgeDaemonServiceName,
+			},
+			expectedTxs: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes, // order.
+				constants.MsgAcknowledgeBridges_NoEvents_TxBytes, // bridge.
+				constants.ValidMsgAddPremiumVotesTxBytes,         // funding.
+				constants.EmptyMsgUpdateMarketPricesTxBytes,      // prices.
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockPricesKeeper := mocks.PreparePricesKeeper{}
+			mockPricesKeeper.On("GetValidMarketPriceUpdates", mock.Anything).
+				Return(constants.ValidMsgUpdateMarketPrices)
+
+			mockPerpKeeper := mocks.PreparePerpetualsKeeper{}
+			mockPerpKeeper.On("GetAddPremiumVotes", mock.Anything).
+				Return(constants.ValidMsgAddPremiumVotes)
+
+			mockClobKeeper := mocks.PrepareClobKeeper{}
+			mockClobKeeper.On("GetOperations", mock.Anything, mock.Anything).
+				Return(constants.ValidEmptyMsgProposedOperations)
+
+			mockBridgeKeeper := mocks.PrepareBridgeKeeper{}
+			mockBridgeKeeper.On("GetAcknowledgeBridges", mock.Anything, mock.Anything).
+				Return(constants.MsgAcknowledgeBridges_Ids0_1_Height0)
+
+			mockDaemonHealthChecker := mocks.DaemonHealthChecker{}
+			mockDaemonHealthChecker.On("IsDaemonDegraded", mock.Anything).
+				Return(func(daemonKey string) bool {
+					return slices.Contains(tc.degradedDaemons, daemonKey)
+				})
+
+			ctx, _, _, _, _, _ := keepertest.PricesKeepers(t)
+
+			handler := prepare.PrepareProposalHandler(
+				encodingCfg.TxConfig,
+				&mockBridgeKeeper,
+				&mockClobKeeper,
+				&mockPricesKeeper,
+				&mockPerpKeeper,
+				&mockDaemonHealthChecker,
+			)
+
+			req := abci.RequestPrepareProposal{
+				Txs:        [][]byte{},
+				MaxTxBytes: 100_000, // something large.
+			}
+
+			response := handler(ctx, req)
+			require.Equal(t, tc.expectedTxs, response.Txs)
+		})
+	}
+}
+
 func TestGetUpdateMarketPricesTx(t *testing.T) {
 	tests := map[string]struct {
 		keeperResp *pricestypes.MsgUpdateMarketPrices
@@ -60,11 +60,13 @@ func DecodeAcknowledgeBridgesTx(
 // Validate returns an error if:
 // - msg fails `ValidateBasic`.
 // - bridge events are non empty and bridging is disabled.
-// - first bridge event ID is not the one to be next acknowledged.
-// - last bridge event ID has not been recognized.
+// - a bridge event is of an unknown source or has a denom different from that of its source.
+// - first bridge event ID of any source is not the one to be next acknowledged.
+// - last bridge event ID of any source has not been recognized.
 // - a bridge event's content is not the same as in server state.
 func (abt *AcknowledgeBridgesTx) Validate() error {
-	// `ValidateBasic` validates that bridge event IDs are consecutive.
+	// `ValidateBasic` validates that bridge events are grouped by source and that bridge event IDs
+	// are consecutive within each source.
 	if err := abt.msg.ValidateBasic(); err != nil {
 		telemetry.IncrCounterWithLabels(
 			[]string{
@@ -87,9 +89,38 @@ func (abt *AcknowledgeBridgesTx) Validate() error {
 		return types.ErrBridgingDisabled
 	}
 
+	// Validate that each bridge event is of a configured source and bridges the denom of that source.
+	eventParams := abt.bridgeKeeper.GetEventParams(abt.ctx)
+	for _, event := range abt.msg.Events {
+		if err := eventParams.ValidateBridgeEvent(event); err != nil {
+			return err
+		}
+	}
+
+	// Events are grouped by source. Validate each group of events against its source.
+	for start := 0; start < len(abt.msg.Events); {
+		sourceId := abt.msg.Events[start].SourceId
+		end := start + 1
+		for end < len(abt.msg.Events) && abt.msg.Events[end].SourceId == sourceId {
+			end++
+		}
+		if err := abt.validateSourceEvents(sourceId, abt.msg.Events[start:end]); err != nil {
+			return err
+		}
+		start = end
+	}
+
+	return nil
+}
+
+// validateSourceEvents returns an error if:
+// - first bridge event ID of the source is not the one to be next acknowledged.
+// - last bridge event ID of the source has not been recognized.
+// - a bridge event's content is not the same as in server state.
+func (abt *AcknowledgeBridgesTx) validateSourceEvents(sourceId uint32, events []types.BridgeEvent) error {
 	// Validate that first bridge event ID is the one to be next acknowledged.
-	acknowledgedEventInfo := abt.bridgeKeeper.GetAcknowledgedEventInfo(abt.ctx)
-	if acknowledgedEventInfo.NextId != abt.msg.Events[0].Id {
+	acknowledgedEventInfo := abt.bridgeKeeper.GetAcknowledgedEventInfo(abt.ctx, sourceId)
+	if acknowledgedEventInfo.NextId != events[0].Id {
 		telemetry.IncrCounterWithLabels(
 			[]string{
 				ModuleName,
@@ -104,8 +135,8 @@ func (abt *AcknowledgeBridgesTx) Validate() error {
 	}
 
 	// Validate that last bridge event ID has been recognized.
-	recognizedEventInfo := abt.bridgeKeeper.GetRecognizedEventInfo(abt.ctx)
-	if recognizedEventInfo.NextId <= abt.msg.Events[len(abt.msg.Events)-1].Id {
+	recognizedEventInfo := abt.bridgeKeeper.GetRecognizedEventInfo(abt.ctx, sourceId)
+	if recognizedEventInfo.NextId <= events[len(events)-1].Id {
 		telemetry.IncrCounterWithLabels(
 			[]string{
 				ModuleName,
@@ -120,8 +151,8 @@ func (abt *AcknowledgeBridgesTx) Validate() error {
 	}
 
 	// Validate that bridge events' content is the same as in server state.
-	for _, event := range abt.msg.Events {
-		eventInState, found := abt.bridgeKeeper.GetBridgeEventFromServer(abt.ctx, event.Id)
+	for _, event := range events {
+		eventInState, found := abt.bridgeKeeper.GetBridgeEventFromServer(abt.ctx, sourceId, event.Id)
 		if !found {
 			return types.ErrBridgeEventNotFound
 		}
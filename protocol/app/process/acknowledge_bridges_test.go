@@ -12,6 +12,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/encoding"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testtx "github.com/dydxprotocol/v4-chain/protocol/testutil/tx"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
 )
@@ -82,6 +83,13 @@ func TestDecodeAcknowledgeBridgesTx(t *testing.T) {
 }
 
 func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
+	// Bridge event of a source that is not configured.
+	unknownSourceEvent := constants.BridgeEvent_Source1_Id0_Height2
+	unknownSourceEvent.SourceId = 2
+	// Bridge event of the primary source that bridges the denom of source 1.
+	mismatchedDenomEvent := constants.BridgeEvent_Id0_Height0
+	mismatchedDenomEvent.Coin = constants.BridgeEvent_Source1_Id0_Height2.Coin
+
 	tests := map[string]struct {
 		txBytes []byte // tx bytes.
 
@@ -90,6 +98,9 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 		bridgeEventsInServer  []types.BridgeEvent // events in bridge server that a bridge tx is validated against.
 		acknowledgedEventInfo types.BridgeEventInfo
 		recognizedEventInfo   types.BridgeEventInfo
+		// event infos of bridge source 1.
+		source1AcknowledgedEventInfo types.BridgeEventInfo
+		source1RecognizedEventInfo   types.BridgeEventInfo
 
 		// Expectations.
 		expectedErr error
@@ -168,6 +179,24 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
 			expectedErr:           types.ErrBridgeEventContentMismatch,
 		},
+		"Error: bridge event of unknown source": {
+			txBytes: testtx.MustGetTxBytes(&types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{unknownSourceEvent},
+			}),
+			bridgeEventsInServer:  []types.BridgeEvent{unknownSourceEvent},
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			expectedErr:           types.ErrInvalidBridgeSource,
+		},
+		"Error: bridge event has denom of another source": {
+			txBytes: testtx.MustGetTxBytes(&types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{mismatchedDenomEvent},
+			}),
+			bridgeEventsInServer:  []types.BridgeEvent{mismatchedDenomEvent},
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			expectedErr:           types.ErrInvalidBridgeEventDenom,
+		},
 		"Error: one event and bridging disabled": {
 			txBytes:               constants.MsgAcknowledgeBridges_Id0_Height0_TxBytes,
 			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Id0_Height0.Events,
@@ -201,6 +230,40 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
 			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
 		},
+		"Valid: events of multiple sources": {
+			txBytes:                      constants.MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1_TxBytes,
+			bridgeEventsInServer:         constants.MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1.Events,
+			acknowledgedEventInfo:        constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:          constants.RecognizedEventInfo_Id2_Height0,
+			source1AcknowledgedEventInfo: constants.AcknowledgedEventInfo_Source1_Id0_Height0,
+			source1RecognizedEventInfo:   constants.RecognizedEventInfo_Source1_Id2_Height4,
+		},
+		"Error: second source's bridge event ID not next to be acknowledged": {
+			txBytes:               constants.MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1_TxBytes,
+			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1.Events,
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			source1AcknowledgedEventInfo: types.BridgeEventInfo{
+				NextId:         1,
+				EthBlockHeight: 2,
+				SourceId:       1,
+			},
+			source1RecognizedEventInfo: constants.RecognizedEventInfo_Source1_Id2_Height4,
+			expectedErr:                types.ErrBridgeIdNotNextToAcknowledge,
+		},
+		"Error: second source's bridge event ID not recognized": {
+			txBytes:                      constants.MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1_TxBytes,
+			bridgeEventsInServer:         constants.MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1.Events,
+			acknowledgedEventInfo:        constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:          constants.RecognizedEventInfo_Id2_Height0,
+			source1AcknowledgedEventInfo: constants.AcknowledgedEventInfo_Source1_Id0_Height0,
+			source1RecognizedEventInfo: types.BridgeEventInfo{
+				NextId:         1,
+				EthBlockHeight: 2,
+				SourceId:       1,
+			},
+			expectedErr: types.ErrBridgeIdNotRecognized,
+		},
 	}
 
 	for name, tc := range tests {
@@ -212,10 +275,13 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 				IsDisabled:  tc.bridgingDisabled,
 				DelayBlocks: 7, // dummy value
 			})
-			mockBridgeKeeper.On("GetAcknowledgedEventInfo", ctx).Return(tc.acknowledgedEventInfo)
-			mockBridgeKeeper.On("GetRecognizedEventInfo", ctx).Return(tc.recognizedEventInfo)
+			mockBridgeKeeper.On("GetEventParams", ctx).Return(constants.EventParams_WithSource1)
+			mockBridgeKeeper.On("GetAcknowledgedEventInfo", ctx, types.PrimarySourceId).Return(tc.acknowledgedEventInfo)
+			mockBridgeKeeper.On("GetRecognizedEventInfo", ctx, types.PrimarySourceId).Return(tc.recognizedEventInfo)
+			mockBridgeKeeper.On("GetAcknowledgedEventInfo", ctx, uint32(1)).Return(tc.source1AcknowledgedEventInfo)
+			mockBridgeKeeper.On("GetRecognizedEventInfo", ctx, uint32(1)).Return(tc.source1RecognizedEventInfo)
 			for _, event := range tc.bridgeEventsInServer {
-				mockBridgeKeeper.On("GetBridgeEventFromServer", ctx, event.Id).Return(event, true)
+				mockBridgeKeeper.On("GetBridgeEventFromServer", ctx, event.SourceId, event.Id).Return(event, true)
 			}
 
 			abt, err := process.DecodeAcknowledgeBridgesTx(
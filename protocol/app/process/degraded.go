@@ -0,0 +1,24 @@
+package process
+
+import (
+	gometrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+)
+
+// isDaemonDegraded returns true if the node is in degraded mode for the daemon service, in which case the data
+// provided by the daemon is not available to validate proposals against. A metric is recorded for each proposal
+// processed while degraded.
+func isDaemonDegraded(daemonHealthChecker daemonservertypes.DaemonHealthChecker, daemonKey string) bool {
+	if !daemonHealthChecker.IsDaemonDegraded(daemonKey) {
+		return false
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, metrics.Degraded, metrics.Count},
+		1,
+		[]gometrics.Label{metrics.GetLabelForStringValue(metrics.Daemon, daemonKey)},
+	)
+	return true
+}
@@ -19,6 +19,8 @@ type ProcessPricesKeeper interface {
 		performNonDeterministicValidation bool,
 	) error
 
+	GetMarketPrice(ctx sdk.Context, id uint32) (pricestypes.MarketPrice, error)
+
 	UpdateSmoothedPrices(
 		ctx sdk.Context,
 		linearInterpolateFunc func(v0 uint64, v1 uint64, ppm uint32) (uint64, error),
@@ -61,10 +63,17 @@ type ProcessPerpetualKeeper interface {
 type ProcessBridgeKeeper interface {
 	GetAcknowledgedEventInfo(
 		ctx sdk.Context,
+		sourceId uint32,
 	) (acknowledgedEventInfo bridgetypes.BridgeEventInfo)
 	GetRecognizedEventInfo(
 		ctx sdk.Context,
+		sourceId uint32,
 	) (recognizedEventInfo bridgetypes.BridgeEventInfo)
-	GetBridgeEventFromServer(ctx sdk.Context, id uint32) (event bridgetypes.BridgeEvent, found bool)
+	GetBridgeEventFromServer(
+		ctx sdk.Context,
+		sourceId uint32,
+		id uint32,
+	) (event bridgetypes.BridgeEvent, found bool)
 	GetSafetyParams(ctx sdk.Context) (safetyParams bridgetypes.SafetyParams)
+	GetEventParams(ctx sdk.Context) (eventParams bridgetypes.EventParams)
 }
@@ -1,12 +1,21 @@
 package process
 
 import (
+	"math/big"
 	"reflect"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
+const (
+	// MaxDegradedPriceChangePpm is the max change of a market's price in a single block, relative to the current
+	// price, that is accepted while the proposed prices are not compared against the local index prices.
+	MaxDegradedPriceChangePpm = uint32(50_000)
+)
+
 var (
 	msgUpdateMarketPricesType = reflect.TypeOf(types.MsgUpdateMarketPrices{})
 )
@@ -16,6 +25,11 @@ type UpdateMarketPricesTx struct {
 	ctx          sdk.Context
 	pricesKeeper ProcessPricesKeeper
 	msg          *types.MsgUpdateMarketPrices
+
+	// skipNonDeterministicValidation is set while the node is in degraded mode for the pricefeed daemon, in which
+	// case the proposed prices are not compared against the local index prices, and are only bounded relative to
+	// the current prices.
+	skipNonDeterministicValidation bool
 }
 
 // DecodeAddPremiumVotesTx returns a new `UpdateMarketPricesTx` after validating the following:
@@ -57,19 +71,65 @@ func DecodeUpdateMarketPricesTx(
 
 // Validate returns an error if:
 // - the underlying msg fails `ValidateBasic`
-// - the underlying msg values are not "valid" according to the index price.
+// - the underlying msg values are not "valid" according to the index price. The index price is not considered if
+// non-deterministic validation is skipped.
+// - non-deterministic validation is skipped and a price changes by more than `MaxDegradedPriceChangePpm`.
 func (umpt *UpdateMarketPricesTx) Validate() error {
 	if err := umpt.msg.ValidateBasic(); err != nil {
 		return getValidateBasicError(umpt.msg, err)
 	}
 
-	if err := umpt.pricesKeeper.PerformStatefulPriceUpdateValidation(umpt.ctx, umpt.msg, true); err != nil {
+	if err := umpt.pricesKeeper.PerformStatefulPriceUpdateValidation(
+		umpt.ctx,
+		umpt.msg,
+		!umpt.skipNonDeterministicValidation,
+	); err != nil {
 		return err
 	}
 
+	// Without index prices, bound the change of each price instead so that a degraded node does not accept
+	// arbitrary prices.
+	if umpt.skipNonDeterministicValidation {
+		if err := umpt.validateMaxDegradedPriceChange(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateMaxDegradedPriceChange returns an error if any proposed price differs from the market's current price
+// by more than `MaxDegradedPriceChangePpm`. The check only depends on state, so it is deterministic.
+func (umpt *UpdateMarketPricesTx) validateMaxDegradedPriceChange() error {
+	for _, priceUpdate := range umpt.msg.GetMarketPriceUpdates() {
+		marketPrice, err := umpt.pricesKeeper.GetMarketPrice(umpt.ctx, priceUpdate.MarketId)
+		if err != nil {
+			return err
+		}
+
+		maxChange := lib.BigIntMulPpm(new(big.Int).SetUint64(marketPrice.Price), MaxDegradedPriceChangePpm)
+		change := new(big.Int).SetUint64(lib.AbsDiffUint64(marketPrice.Price, priceUpdate.Price))
+		if change.Cmp(maxChange) > 0 {
+			return errorsmod.Wrapf(
+				types.ErrInvalidMarketPriceUpdateDeterministic,
+				"update price (%d) for market (%d) changes the current price (%d) by more than the max change "+
+					"(%d ppm) accepted while the pricefeed daemon is degraded",
+				priceUpdate.Price,
+				priceUpdate.MarketId,
+				marketPrice.Price,
+				MaxDegradedPriceChangePpm,
+			)
+		}
+	}
 	return nil
 }
 
+// SkipNonDeterministicValidation skips the validation of the proposed prices against the local index prices. The
+// change of each price is bounded by `MaxDegradedPriceChangePpm` instead.
+func (umpt *UpdateMarketPricesTx) SkipNonDeterministicValidation() {
+	umpt.skipNonDeterministicValidation = true
+}
+
 // GetMsg returns the underlying `MsgUpdateMarketPrices`.
 func (umpt *UpdateMarketPricesTx) GetMsg() sdk.Msg {
 	return umpt.msg
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
 
@@ -29,6 +30,11 @@ const ConsensusRound = sdk.ContextKey("consensus_round")
 // outcome depends on the local index price, this validation is dependent on "in-memory state"; therefore,
 // this check is NOT stateless.
 // Note: stakingKeeper and perpetualKeeper are only needed for MEV calculations.
+//
+// While the node is in degraded mode for the pricefeed daemon, proposed prices are not validated against the local
+// index prices, so that the node continues to vote on blocks. Only deterministic checks against the current prices
+// are performed instead. Bridge events are always validated against the bridge events recognized by the node, so
+// a node in degraded mode for the bridge daemon rejects proposals with bridge events it has not recognized.
 func ProcessProposalHandler(
 	txConfig client.TxConfig,
 	bridgeKeeper ProcessBridgeKeeper,
@@ -36,6 +42,7 @@ func ProcessProposalHandler(
 	stakingKeeper ProcessStakingKeeper,
 	perpetualKeeper ProcessPerpetualKeeper,
 	pricesKeeper ProcessPricesKeeper,
+	daemonHealthChecker daemonservertypes.DaemonHealthChecker,
 ) sdk.ProcessProposalHandler {
 	// Keep track of the current block height and consensus round.
 	currentBlockHeight := int64(0)
@@ -75,6 +82,10 @@ func ProcessProposalHandler(
 			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
 		}
 
+		if isDaemonDegraded(daemonHealthChecker, daemonservertypes.PricefeedDaemonServiceName) {
+			txs.UpdateMarketPricesTx.SkipNonDeterministicValidation()
+		}
+
 		err = txs.Validate()
 		if err != nil {
 			error_lib.LogErrorWithOptionalContext(logger, "DecodeProcessProposalTxs.Validate failed", err)
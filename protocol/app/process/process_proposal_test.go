@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"slices"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -8,11 +9,14 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/app/process"
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	testmsgs "github.com/dydxprotocol/v4-chain/protocol/testutil/msgs"
+	testtx "github.com/dydxprotocol/v4-chain/protocol/testutil/tx"
 	bridgetypes "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -272,12 +276,25 @@ func TestProcessProposalHandler_Error(t *testing.T) {
 				IsDisabled:  tc.bridgingDisabled,
 				DelayBlocks: 5, // dummy value, not considered by ProcessProposal.
 			})
-			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything).Return(constants.AcknowledgedEventInfo_Id0_Height0)
-			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything).Return(constants.RecognizedEventInfo_Id2_Height0)
+			mockBridgeKeeper.On("GetEventParams", mock.Anything).Return(constants.EventParams_WithSource1)
+			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything, mock.Anything).Return(
+				constants.AcknowledgedEventInfo_Id0_Height0,
+			)
+			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything, mock.Anything).Return(
+				constants.RecognizedEventInfo_Id2_Height0,
+			)
 			for _, bridgeEvent := range tc.bridgeEventsInServer {
-				mockBridgeKeeper.On("GetBridgeEventFromServer", mock.Anything, bridgeEvent.Id).Return(bridgeEvent, true).Once()
+				mockBridgeKeeper.On(
+					"GetBridgeEventFromServer",
+					mock.Anything,
+					bridgeEvent.SourceId,
+					bridgeEvent.Id,
+				).Return(bridgeEvent, true).Once()
 			}
 
+			mockDaemonHealthChecker := &mocks.DaemonHealthChecker{}
+			mockDaemonHealthChecker.On("IsDaemonDegraded", mock.Anything).Return(false)
+
 			handler := process.ProcessProposalHandler(
 				constants.TestEncodingCfg.TxConfig,
 				mockBridgeKeeper,
@@ -285,6 +302,7 @@ func TestProcessProposalHandler_Error(t *testing.T) {
 				&mocks.ProcessStakingKeeper{},
 				&mocks.ProcessPerpetualKeeper{},
 				pricesKeeper,
+				mockDaemonHealthChecker,
 			)
 			req := abci.RequestProcessProposal{Txs: tc.txsBytes}
 
@@ -301,3 +319,171 @@ func TestProcessProposalHandler_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessProposalHandler_DegradedDaemons(t *testing.T) {
+	acceptResponse := abci.ResponseProcessProposal{
+		Status: abci.ResponseProcessProposal_ACCEPT,
+	}
+	rejectResponse := abci.ResponseProcessProposal{
+		Status: abci.ResponseProcessProposal_REJECT,
+	}
+
+	// Price updates that change the current BTC price by exactly the max change accepted while degraded, and by
+	// more than it.
+	maxChange := constants.FiveBillion / 1_000_000 * uint64(process.MaxDegradedPriceChangePpm)
+	withinBoundsPricesTx := testtx.MustGetTxBytes(&pricestypes.MsgUpdateMarketPrices{
+		MarketPriceUpdates: []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+			pricestypes.NewMarketPriceUpdate(constants.MarketId0, constants.FiveBillion+maxChange),
+		},
+	})
+	outOfBoundsPricesTx := testtx.MustGetTxBytes(&pricestypes.MsgUpdateMarketPrices{
+		MarketPriceUpdates: []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+			pricestypes.NewMarketPriceUpdate(constants.MarketId0, constants.FiveBillion-maxChange-1),
+		},
+	})
+
+	// No index prices or bridge events are available to the node, so the proposed prices and bridge events fail
+	// non-deterministic validation.
+	tests := map[string]struct {
+		txsBytes        [][]byte
+		degradedDaemons []string
+
+		expectedResponse abci.ResponseProcessProposal
+	}{
+		"Reject: no degraded daemons": {
+			txsBytes: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,
+				constants.MsgAcknowledgeBridges_NoEvents_TxBytes,
+				constants.ValidMsgAddPremiumVotesTxBytes,
+				withinBoundsPricesTx,
+			},
+			degradedDaemons:  []string{},
+			expectedResponse: rejectResponse,
+		},
+		"Accept: pricefeed daemon degraded": {
+			txsBytes: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,
+				constants.MsgAcknowledgeBridges_NoEvents_TxBytes,
+				constants.ValidMsgAddPremiumVotesTxBytes,
+				withinBoundsPricesTx,
+			},
+			degradedDaemons:  []string{daemonservertypes.PricefeedDaemonServiceName},
+			expectedResponse: acceptResponse,
+		},
+		"Reject: pricefeed daemon degraded, price change exceeds max degraded price change": {
+			txsBytes: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,
+				constants.MsgAcknowledgeBridges_NoEvents_TxBytes,
+				constants.ValidMsgAddPremiumVotesTxBytes,
+				outOfBoundsPricesTx,
+			},
+			degradedDaemons:  []string{daemonservertypes.PricefeedDaemonServiceName},
+			expectedResponse: rejectResponse,
+		},
+		"Reject: pricefeed daemon degraded, invalid price tx": {
+			txsBytes: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,
+				constants.MsgAcknowledgeBridges_NoEvents_TxBytes,
+				constants.ValidMsgAddPremiumVotesTxBytes,
+				constants.InvalidMsgUpdateMarketPricesStatelessTxBytes,
+			},
+			degradedDaemons:  []string{daemonservertypes.PricefeedDaemonServiceName},
+			expectedResponse: rejectResponse,
+		},
+		"Reject: bridge daemon degraded": {
+			txsBytes: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,
+				constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
+				constants.ValidMsgAddPremiumVotesTxBytes,
+				withinBoundsPricesTx,
+			},
+			degradedDaemons:  []string{daemonservertypes.BridgeDaemonServiceName},
+			expectedResponse: rejectResponse,
+		},
+		"Reject: pricefeed daemon degraded, bridge events not in server": {
+			txsBytes: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,
+				constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
+				constants.ValidMsgAddPremiumVotesTxBytes,
+				withinBoundsPricesTx,
+			},
+			degradedDaemons:  []string{daemonservertypes.PricefeedDaemonServiceName},
+			expectedResponse: rejectResponse,
+		},
+		"Accept: pricefeed and bridge daemons degraded, no bridge events": {
+			txsBytes: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,
+				constants.MsgAcknowledgeBridges_NoEvents_TxBytes,
+				constants.ValidMsgAddPremiumVotesTxBytes,
+				withinBoundsPricesTx,
+			},
+			degradedDaemons: []string{
+				daemonservertypes.PricefeedDaemonServiceName,
+				daemonservertypes.BridgeDaemonServiceName,
+			},
+			expectedResponse: acceptResponse,
+		},
+		"Reject: pricefeed and bridge daemons degraded, bridge events not recognized": {
+			txsBytes: [][]byte{
+				constants.ValidEmptyMsgProposedOperationsTxBytes,
+				constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
+				constants.ValidMsgAddPremiumVotesTxBytes,
+				withinBoundsPricesTx,
+			},
+			degradedDaemons: []string{
+				daemonservertypes.PricefeedDaemonServiceName,
+				daemonservertypes.BridgeDaemonServiceName,
+			},
+			expectedResponse: rejectResponse,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup. Index prices are not updated.
+			ctx, pricesKeeper, _, _, _, mockTimeProvider := keepertest.PricesKeepers(t)
+			mockTimeProvider.On("Now").Return(constants.TimeT)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+
+			mockClobKeeper := &mocks.ProcessClobKeeper{}
+			mockClobKeeper.On("RecordMevMetricsIsEnabled").Return(false)
+
+			mockBridgeKeeper := &mocks.ProcessBridgeKeeper{}
+			mockBridgeKeeper.On("GetSafetyParams", mock.Anything).Return(bridgetypes.SafetyParams{})
+			mockBridgeKeeper.On("GetEventParams", mock.Anything).Return(constants.EventParams_WithSource1)
+			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything, mock.Anything).Return(
+				constants.AcknowledgedEventInfo_Id0_Height0,
+			)
+			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything, mock.Anything).Return(
+				constants.RecognizedEventInfo_Id2_Height0,
+			)
+			mockBridgeKeeper.On("GetBridgeEventFromServer", mock.Anything, mock.Anything, mock.Anything).Return(
+				bridgetypes.BridgeEvent{},
+				false,
+			)
+
+			mockDaemonHealthChecker := &mocks.DaemonHealthChecker{}
+			mockDaemonHealthChecker.On("IsDaemonDegraded", mock.Anything).
+				Return(func(daemonKey string) bool {
+					return slices.Contains(tc.degradedDaemons, daemonKey)
+				})
+
+			handler := process.ProcessProposalHandler(
+				constants.TestEncodingCfg.TxConfig,
+				mockBridgeKeeper,
+				mockClobKeeper,
+				&mocks.ProcessStakingKeeper{},
+				&mocks.ProcessPerpetualKeeper{},
+				pricesKeeper,
+				mockDaemonHealthChecker,
+			)
+			req := abci.RequestProcessProposal{Txs: tc.txsBytes}
+
+			// Run.
+			resp := handler(ctx, req)
+
+			// Validate.
+			require.Equal(t, tc.expectedResponse, resp)
+		})
+	}
+}
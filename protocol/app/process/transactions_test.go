@@ -330,14 +330,20 @@ func TestProcessProposalTxs_Validate_Error(t *testing.T) {
 					DelayBlocks: 5, // dummy value, not considered by Validate.
 				},
 			)
-			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything).Return(
+			mockBridgeKeeper.On("GetEventParams", mock.Anything).Return(constants.EventParams_WithSource1)
+			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything, mock.Anything).Return(
 				constants.AcknowledgedEventInfo_Id0_Height0,
 			)
-			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything).Return(
+			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything, mock.Anything).Return(
 				constants.RecognizedEventInfo_Id2_Height0,
 			)
 			for _, bridgeEvent := range validAcknowledgeBridgesMsg.Events {
-				mockBridgeKeeper.On("GetBridgeEventFromServer", mock.Anything, bridgeEvent.Id).Return(bridgeEvent, true).Once()
+				mockBridgeKeeper.On(
+					"GetBridgeEventFromServer",
+					mock.Anything,
+					bridgeEvent.SourceId,
+					bridgeEvent.Id,
+				).Return(bridgeEvent, true).Once()
 			}
 
 			ppt, err := process.DecodeProcessProposalTxs(
@@ -437,14 +443,20 @@ func TestProcessProposalTxs_Validate_Valid(t *testing.T) {
 					DelayBlocks: 5, // dummy value, not considered by Validate.
 				},
 			)
-			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything).Return(
+			mockBridgeKeeper.On("GetEventParams", mock.Anything).Return(constants.EventParams_WithSource1)
+			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything, mock.Anything).Return(
 				constants.AcknowledgedEventInfo_Id0_Height0,
 			)
-			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything).Return(
+			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything, mock.Anything).Return(
 				constants.RecognizedEventInfo_Id2_Height0,
 			)
 			for _, bridgeEvent := range validAcknowledgeBridgesMsg.Events {
-				mockBridgeKeeper.On("GetBridgeEventFromServer", mock.Anything, bridgeEvent.Id).Return(bridgeEvent, true).Once()
+				mockBridgeKeeper.On(
+					"GetBridgeEventFromServer",
+					mock.Anything,
+					bridgeEvent.SourceId,
+					bridgeEvent.Id,
+				).Return(bridgeEvent, true).Once()
 			}
 
 			ppt, err := process.DecodeProcessProposalTxs(
@@ -18,6 +18,13 @@ func IsDisallowClobOrderMsgInOtherTxs(targetMsg sdk.Msg) bool {
 		order := msg.GetOrder()
 		orderId := order.GetOrderId()
 		return !orderId.IsStatefulOrder() // not stateful -> returns true -> disallow
+	case *clobtypes.MsgReplaceOrder:
+		order := msg.GetOrder()
+		orderId := order.GetOrderId()
+		return !orderId.IsStatefulOrder() // not stateful -> returns true -> disallow
+	case *clobtypes.MsgBatchPlaceAndCancel:
+		// Batches may only contain Short-Term orders -> always disallow
+		return true
 	}
 	return false
 }
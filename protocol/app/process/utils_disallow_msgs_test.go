@@ -25,7 +25,10 @@ func TestIsDisallowClobOrderMsgInOtherTxs(t *testing.T) {
 	for _, msg := range allMsgSamples {
 		result := process.IsDisallowClobOrderMsgInOtherTxs(msg)
 		switch msg.(type) {
-		case *clobtypes.MsgCancelOrder, *clobtypes.MsgPlaceOrder:
+		case *clobtypes.MsgCancelOrder,
+			*clobtypes.MsgPlaceOrder,
+			*clobtypes.MsgBatchPlaceAndCancel,
+			*clobtypes.MsgReplaceOrder:
 			// The sample msgs are short-term orders, so we expect these to be disallowed.
 			require.True(t, result) // true -> disallow
 		default:
@@ -37,6 +40,7 @@ func TestIsDisallowClobOrderMsgInOtherTxs(t *testing.T) {
 	longTermOrders := []sdk.Msg{
 		constants.Msg_PlaceOrder_LongTerm,
 		constants.Msg_CancelOrder_LongTerm,
+		constants.Msg_ReplaceOrder_LongTerm,
 	}
 	for _, msg := range longTermOrders {
 		result := process.IsDisallowClobOrderMsgInOtherTxs(msg)
@@ -116,13 +116,14 @@ var genesisModuleOrder = []string{
 	ibctransfertypes.ModuleName,
 	pricestypes.ModuleName,
 	assetstypes.ModuleName,
+	// The `Perpetuals` genesis depends on the funding-tick epoch of the `Epochs` genesis.
+	epochstypes.ModuleName,
 	perpetualstypes.ModuleName,
 	satypes.ModuleName,
 	clobtypes.ModuleName,
 	sendingtypes.ModuleName,
 	vestmodule.ModuleName,
 	rewardsmodule.ModuleName,
-	epochstypes.ModuleName,
 }
 
 // WithRandomlyGeneratedOperationsSimulationManager uses the default weighted operations of each of
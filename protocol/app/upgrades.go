@@ -2,17 +2,22 @@ package app
 
 import (
 	"fmt"
+
 	"github.com/dydxprotocol/v4-chain/protocol/app/upgrades"
+	v2_0_0 "github.com/dydxprotocol/v4-chain/protocol/app/upgrades/v2.0.0"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 var (
 	// `Upgrades` defines the upgrade handlers and store loaders for the application.
 	// New upgrades should be added to this slice after they are implemented.
-	Upgrades = []upgrades.Upgrade{}
-	Forks    = []upgrades.Fork{}
+	Upgrades = []upgrades.Upgrade{
+		v2_0_0.Upgrade,
+	}
+	Forks = []upgrades.Fork{}
 )
 
 // setupUpgradeHandlers registers the upgrade handlers to perform custom upgrade
@@ -24,11 +29,26 @@ func (app *App) setupUpgradeHandlers() {
 		}
 		app.UpgradeKeeper.SetUpgradeHandler(
 			upgrade.UpgradeName,
-			upgrade.CreateUpgradeHandler(app.ModuleManager, app.configurator),
+			app.createUpgradeHandler(upgrade)(app.ModuleManager, app.configurator),
 		)
 	}
 }
 
+// createUpgradeHandler returns the function that creates the upgrade handler of the upgrade. Upgrade
+// handlers that depend on keepers are created by closures over the keepers of the app.
+func (app *App) createUpgradeHandler(
+	upgrade upgrades.Upgrade,
+) func(*module.Manager, module.Configurator) upgradetypes.UpgradeHandler {
+	switch upgrade.UpgradeName {
+	case v2_0_0.UpgradeName:
+		return func(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+			return v2_0_0.CreateUpgradeHandler(mm, configurator, app.SubaccountsKeeper)
+		}
+	default:
+		return upgrade.CreateUpgradeHandler
+	}
+}
+
 // setUpgradeStoreLoaders sets custom store loaders to customize the rootMultiStore
 // initialization for software upgrades.
 func (app *App) setupUpgradeStoreLoaders() {
@@ -0,0 +1,17 @@
+package v_2_0_0
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/app/upgrades"
+)
+
+const (
+	UpgradeName = "v2.0.0"
+)
+
+var (
+	// Upgrade does not set `CreateUpgradeHandler`, since the upgrade handler depends on keepers
+	// and is created by the app.
+	Upgrade = upgrades.Upgrade{
+		UpgradeName: UpgradeName,
+	}
+)
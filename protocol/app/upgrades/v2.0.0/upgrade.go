@@ -0,0 +1,30 @@
+package v_2_0_0
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// CreateUpgradeHandler returns the upgrade handler for `v2.0.0`, which runs the module migrations.
+// This migrates the `Perpetuals` params to include `FundingRatePeriodSeconds` and
+// `RemovedTailSampleRatioPpm`. It then records the entry notional of perpetual positions opened
+// before entry notionals were tracked.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	subaccountsKeeper satypes.SubaccountsKeeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		if err := subaccountsKeeper.InitializePositionEntryNotionals(ctx); err != nil {
+			return vm, err
+		}
+		return vm, nil
+	}
+}
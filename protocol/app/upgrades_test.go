@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/dydxprotocol/v4-chain/protocol/app"
 	"github.com/dydxprotocol/v4-chain/protocol/app/upgrades"
+	v2_0_0 "github.com/dydxprotocol/v4-chain/protocol/app/upgrades/v2.0.0"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/stretchr/testify/require"
 )
@@ -75,6 +76,12 @@ func TestSetupUpgradeHandlers(t *testing.T) {
 }
 
 func TestDefaultUpgradesAndForks(t *testing.T) {
-	require.Empty(t, app.Upgrades, "Expected empty upgrades list")
+	require.Len(t, app.Upgrades, 1)
+	require.Equal(t, v2_0_0.UpgradeName, app.Upgrades[0].UpgradeName)
 	require.Empty(t, app.Forks, "Expected empty forks list")
 }
+
+func TestSetupUpgradeHandlers_DefaultUpgrades(t *testing.T) {
+	app := testapp.DefaultTestApp(nil)
+	require.True(t, app.UpgradeKeeper.HasHandler(v2_0_0.UpgradeName))
+}
@@ -2,10 +2,12 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
 
+	gometrics "github.com/armon/go-metrics"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	appflags "github.com/dydxprotocol/v4-chain/protocol/app/flags"
@@ -65,13 +67,18 @@ func Start(
 	queryClient := bridgetypes.NewQueryClient(queryConn)
 	serviceClient := api.NewBridgeServiceClient(daemonConn)
 
-	// Initialize an Ethereum client from an RPC endpoint.
-	ethClient, err := ethclient.Dial(flags.Bridge.EthRpcEndpoint)
-	if err != nil {
-		logger.Error("Failed to establish connection to Ethereum node", "error", err)
-		return err
+	// Initialize an Ethereum client from each RPC endpoint. Bridge sources are matched to clients by chain ID.
+	ethRpcEndpoints := append([]string{flags.Bridge.EthRpcEndpoint}, flags.Bridge.AdditionalEthRpcEndpoints...)
+	ethClients := make([]types.EthClient, 0, len(ethRpcEndpoints))
+	for _, ethRpcEndpoint := range ethRpcEndpoints {
+		ethClient, err := ethclient.Dial(ethRpcEndpoint)
+		if err != nil {
+			logger.Error("Failed to establish connection to Ethereum node", "endpoint", ethRpcEndpoint, "error", err)
+			return err
+		}
+		defer ethClient.Close()
+		ethClients = append(ethClients, ethClient)
 	}
-	defer func() { ethClient.Close() }()
 
 	// Run the main task loop at an interval.
 	ticker := time.NewTicker(time.Duration(flags.Bridge.LoopDelayMs) * time.Millisecond)
@@ -79,7 +86,7 @@ func Start(
 		if err := RunBridgeDaemonTaskLoop(
 			ctx,
 			logger,
-			ethClient,
+			ethClients,
 			queryClient,
 			serviceClient,
 		); err != nil {
@@ -93,12 +100,14 @@ func Start(
 
 // RunBridgeDaemonTaskLoop does the following:
 // 1) Fetches configuration information by querying the gRPC server.
-// 2) Fetches Ethereum events from a configured Ethereum client.
+// 2) Fetches Ethereum events of each bridge source from the Ethereum client of the source's chain.
 // 3) Sends newly-recognized bridge events to the gRPC server.
+// A failure to fetch events of one bridge source does not prevent events of other sources from being
+// sent. Errors of all sources are joined and returned.
 func RunBridgeDaemonTaskLoop(
 	ctx context.Context,
 	logger log.Logger,
-	ethClient types.EthClient,
+	ethClients []types.EthClient,
 	queryClient bridgetypes.QueryClient,
 	serviceClient api.BridgeServiceClient,
 ) error {
@@ -111,13 +120,12 @@ func RunBridgeDaemonTaskLoop(
 
 	// Fetch parameters from x/bridge module. Relevant ones to bridge daemon are:
 	// - EventParams
-	//   - ChainId: Ethereum chain ID that bridge contract resides on.
-	//   - EthAddress: Address of the bridge contract to query events from.
+	//   - Bridge sources, each with
+	//     - ChainId: Ethereum chain ID that bridge contract resides on.
+	//     - EthAddress: Address of the bridge contract to query events from.
+	//     - Denom: Denom of the bridged token.
 	// - ProposeParams
 	//   - MaxBridgesPerBlock: Number of bridge events to query for.
-	// - RecognizedEventInfo
-	//   - EthBlockHeight: Ethereum block height from which to start querying events.
-	//   - NextId: Next bridge event ID to query for.
 	eventParams, err := queryClient.EventParams(ctx, &bridgetypes.QueryEventParamsRequest{})
 	if err != nil {
 		return fmt.Errorf("failed to fetch event params: %w", err)
@@ -126,57 +134,97 @@ func RunBridgeDaemonTaskLoop(
 	if err != nil {
 		return fmt.Errorf("failed to fetch propose params: %w", err)
 	}
-	recognizedEventInfo, err := queryClient.RecognizedEventInfo(ctx, &bridgetypes.QueryRecognizedEventInfoRequest{})
-	if err != nil {
-		return fmt.Errorf("failed to fetch recognized event info: %w", err)
-	}
 
-	// Verify Chain ID.
-	chainId, err := ethClient.ChainID(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to fetch chain ID: %w", err)
+	// Map chain IDs to Ethereum clients.
+	var errs []error
+	ethClientsByChainId := make(map[uint64]types.EthClient, len(ethClients))
+	for _, ethClient := range ethClients {
+		chainId, err := ethClient.ChainID(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to fetch chain ID: %w", err))
+			continue
+		}
+		ethClientsByChainId[chainId.Uint64()] = ethClient
 	}
-	if chainId.Uint64() != eventParams.Params.EthChainId {
-		return fmt.Errorf(
-			"expected chain ID %d but node has chain ID %d",
-			eventParams.Params.EthChainId,
-			chainId,
+
+	// Fetch bridge events of each source.
+	newBridgeEvents := make([]bridgetypes.BridgeEvent, 0)
+	for _, source := range eventParams.Params.GetSources() {
+		ethClient, exists := ethClientsByChainId[source.EthChainId]
+		if !exists {
+			errs = append(
+				errs,
+				fmt.Errorf("no Ethereum node with chain ID %d for bridge source %d", source.EthChainId, source.Id),
+			)
+			continue
+		}
+		sourceBridgeEvents, err := getSourceBridgeEvents(
+			ctx,
+			ethClient,
+			queryClient,
+			source,
+			proposeParams.Params.MaxBridgesPerBlock,
 		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("bridge source %d: %w", source.Id, err))
+			continue
+		}
+		newBridgeEvents = append(newBridgeEvents, sourceBridgeEvents...)
+	}
+
+	// Send bridge events to bridge server.
+	if _, err = serviceClient.AddBridgeEvents(ctx, &api.AddBridgeEventsRequest{
+		BridgeEvents: newBridgeEvents,
+	}); err != nil {
+		errs = append(errs, fmt.Errorf("failed to add bridge events: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// getSourceBridgeEvents fetches the logs of bridge events of a bridge source that are not yet recognized
+// and parses them into bridge events.
+func getSourceBridgeEvents(
+	ctx context.Context,
+	ethClient types.EthClient,
+	queryClient bridgetypes.QueryClient,
+	source bridgetypes.BridgeSource,
+	maxBridgesPerBlock uint32,
+) ([]bridgetypes.BridgeEvent, error) {
+	// Fetch recognized event info of the source. Relevant ones to bridge daemon are:
+	// - EthBlockHeight: Ethereum block height from which to start querying events.
+	// - NextId: Next bridge event ID to query for.
+	recognizedEventInfo, err := queryClient.RecognizedEventInfo(
+		ctx,
+		&bridgetypes.QueryRecognizedEventInfoRequest{SourceId: source.Id},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recognized event info: %w", err)
 	}
 
 	// Fetch logs from Ethereum Node.
 	filterQuery := getFilterQuery(
-		eventParams.Params.EthAddress,
+		source.EthAddress,
 		recognizedEventInfo.Info.EthBlockHeight,
 		recognizedEventInfo.Info.NextId,
-		proposeParams.Params.MaxBridgesPerBlock,
+		maxBridgesPerBlock,
 	)
 	logs, err := ethClient.FilterLogs(ctx, filterQuery)
 	if err != nil {
-		return fmt.Errorf("failed to fetch logs: %w", err)
+		return nil, fmt.Errorf("failed to fetch logs: %w", err)
 	}
-	telemetry.IncrCounter(
+	telemetry.IncrCounterWithLabels(
+		[]string{metrics.BridgeDaemon, metrics.NewEthLogs, metrics.Count},
 		float32(len(logs)),
-		metrics.BridgeDaemon,
-		metrics.NewEthLogs,
-		metrics.Count,
+		[]gometrics.Label{metrics.GetLabelForIntValue(metrics.BridgeSourceId, int(source.Id))},
 	)
 
 	// Parse logs into bridge events.
-	newBridgeEvents := make([]bridgetypes.BridgeEvent, len(logs))
+	bridgeEvents := make([]bridgetypes.BridgeEvent, len(logs))
 	for i, log := range logs {
-		newBridgeEvents[i] = libeth.BridgeLogToEvent(log, eventParams.Params.Denom)
+		bridgeEvents[i] = libeth.BridgeLogToEvent(log, source.Id, source.Denom)
 	}
-
-	// Send bridge events to bridge server.
-	if _, err = serviceClient.AddBridgeEvents(ctx, &api.AddBridgeEventsRequest{
-		BridgeEvents: newBridgeEvents,
-	}); err != nil {
-		return fmt.Errorf("failed to add bridge events: %w", err)
-	}
-
-	// Success.
-	return nil
+	return bridgeEvents, nil
 }
 
 // getFilterQuery returns a query to fetch logs of bridge events with following filters:
@@ -8,14 +8,19 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	appflags "github.com/dydxprotocol/v4-chain/protocol/app/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/api"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/client"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/client/types"
 	d_constants "github.com/dydxprotocol/v4-chain/protocol/daemons/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
+	libeth "github.com/dydxprotocol/v4-chain/protocol/lib/eth"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/appoptions"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	bridgetestutil "github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/bridge"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/grpc"
 	bridgetypes "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethcoretypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -121,6 +126,7 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 		"Error getting recognized event info": {
 			eventParams:            constants.EventParams,
 			proposeParams:          constants.ProposeParams,
+			chainId:                constants.EthChainId,
 			recognizedEventInfoErr: errRecognizedEventInfo,
 			expectedError:          errRecognizedEventInfo,
 		},
@@ -137,9 +143,8 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 			recognizedEventInfo: constants.RecognizedEventInfo_Id2_Height0,
 			chainId:             constants.EthChainId + 1,
 			expectedErrorString: fmt.Sprintf(
-				"expected chain ID %d but node has chain ID %d",
+				"no Ethereum node with chain ID %d for bridge source 0",
 				constants.EthChainId,
-				constants.EthChainId+1,
 			),
 		},
 		"Error getting Ethereum logs": {
@@ -196,7 +201,7 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 			err := client.RunBridgeDaemonTaskLoop(
 				grpc.Ctx,
 				&mockLogger,
-				&mockEthClient,
+				[]types.EthClient{&mockEthClient},
 				&mockQueryClient,
 				&mockServiceClient,
 			)
@@ -211,3 +216,106 @@ func TestRunBridgeDaemonTaskLoop(t *testing.T) {
 		})
 	}
 }
+
+func TestRunBridgeDaemonTaskLoop_MultipleSources(t *testing.T) {
+	ctx := grpc.Ctx
+	primaryAddress := ethcommon.HexToAddress("0x1111111111111111111111111111111111111111")
+	source1Address := ethcommon.HexToAddress("0x2222222222222222222222222222222222222222")
+	otherAddress := ethcommon.HexToAddress("0x3333333333333333333333333333333333333333")
+	withAddress := func(log ethcoretypes.Log, address ethcommon.Address) ethcoretypes.Log {
+		log.Address = address
+		return log
+	}
+
+	eventParams := bridgetypes.EventParams{
+		Denom:      "primary-token",
+		EthChainId: 1,
+		EthAddress: primaryAddress.Hex(),
+		AdditionalSources: []bridgetypes.BridgeSource{
+			{Id: 1, Denom: "source1-token", EthChainId: 2, EthAddress: source1Address.Hex()},
+			// No Ethereum node is connected to chain 3.
+			{Id: 2, Denom: "source2-token", EthChainId: 3, EthAddress: otherAddress.Hex()},
+		},
+	}
+	ethClients := []types.EthClient{
+		bridgetestutil.NewFakeEthClient(
+			1,
+			withAddress(constants.EthLog_Event0, primaryAddress),
+			withAddress(constants.EthLog_Event1, primaryAddress),
+			// Logs of other contracts are not recognized.
+			withAddress(constants.EthLog_Event2, otherAddress),
+		),
+		bridgetestutil.NewFakeEthClient(
+			2,
+			// Already recognized.
+			withAddress(constants.EthLog_Event0, source1Address),
+			withAddress(constants.EthLog_Event1, source1Address),
+			withAddress(constants.EthLog_Event2, source1Address),
+			// Beyond `MaxBridgesPerBlock`.
+			withAddress(constants.EthLog_Event3, source1Address),
+		),
+	}
+
+	mockLogger := mocks.Logger{}
+	mockQueryClient := mocks.BridgeQueryClient{}
+	mockServiceClient := mocks.BridgeServiceClient{}
+	mockQueryClient.On("EventParams", ctx, mock.Anything).Return(
+		&bridgetypes.QueryEventParamsResponse{Params: eventParams},
+		nil,
+	)
+	mockQueryClient.On("ProposeParams", ctx, mock.Anything).Return(
+		&bridgetypes.QueryProposeParamsResponse{Params: constants.ProposeParams},
+		nil,
+	)
+	mockQueryClient.On(
+		"RecognizedEventInfo",
+		ctx,
+		&bridgetypes.QueryRecognizedEventInfoRequest{SourceId: 0},
+	).Return(
+		&bridgetypes.QueryRecognizedEventInfoResponse{Info: bridgetypes.BridgeEventInfo{}},
+		nil,
+	)
+	mockQueryClient.On(
+		"RecognizedEventInfo",
+		ctx,
+		&bridgetypes.QueryRecognizedEventInfoRequest{SourceId: 1},
+	).Return(
+		&bridgetypes.QueryRecognizedEventInfoResponse{
+			Info: bridgetypes.BridgeEventInfo{
+				NextId:         1,
+				EthBlockHeight: constants.EthLog_Event0.BlockNumber,
+				SourceId:       1,
+			},
+		},
+		nil,
+	)
+	expectedBridgeEvents := []bridgetypes.BridgeEvent{
+		libeth.BridgeLogToEvent(constants.EthLog_Event0, 0, "primary-token"),
+		libeth.BridgeLogToEvent(constants.EthLog_Event1, 0, "primary-token"),
+		libeth.BridgeLogToEvent(constants.EthLog_Event1, 1, "source1-token"),
+		libeth.BridgeLogToEvent(constants.EthLog_Event2, 1, "source1-token"),
+	}
+	mockServiceClient.On(
+		"AddBridgeEvents",
+		ctx,
+		&api.AddBridgeEventsRequest{BridgeEvents: expectedBridgeEvents},
+	).Return(&api.AddBridgeEventsResponse{}, nil)
+
+	err := client.RunBridgeDaemonTaskLoop(
+		ctx,
+		&mockLogger,
+		ethClients,
+		&mockQueryClient,
+		&mockServiceClient,
+	)
+
+	// Events of sources with a connected Ethereum node are sent even though source 2 fails.
+	require.ErrorContains(t, err, "no Ethereum node with chain ID 3 for bridge source 2")
+	mockServiceClient.AssertExpectations(t)
+	mockQueryClient.AssertNotCalled(
+		t,
+		"RecognizedEventInfo",
+		ctx,
+		&bridgetypes.QueryRecognizedEventInfoRequest{SourceId: 2},
+	)
+}
@@ -11,17 +11,22 @@ const (
 	// Flag names
 	FlagUnixSocketAddress = "unix-socket-address"
 
-	FlagPriceDaemonEnabled     = "price-daemon-enabled"
-	FlagPriceDaemonLoopDelayMs = "price-daemon-loop-delay-ms"
+	FlagPriceDaemonEnabled          = "price-daemon-enabled"
+	FlagPriceDaemonLoopDelayMs      = "price-daemon-loop-delay-ms"
+	FlagPriceDaemonWebsocketEnabled = "price-daemon-websocket-enabled"
+	FlagPriceDaemonStalenessPolicy  = "price-daemon-staleness-policy"
 
-	FlagBridgeDaemonEnabled        = "bridge-daemon-enabled"
-	FlagBridgeDaemonLoopDelayMs    = "bridge-daemon-loop-delay-ms"
-	FlagBridgeDaemonEthRpcEndpoint = "bridge-daemon-eth-rpc-endpoint"
+	FlagBridgeDaemonEnabled                   = "bridge-daemon-enabled"
+	FlagBridgeDaemonLoopDelayMs               = "bridge-daemon-loop-delay-ms"
+	FlagBridgeDaemonEthRpcEndpoint            = "bridge-daemon-eth-rpc-endpoint"
+	FlagBridgeDaemonAdditionalEthRpcEndpoints = "bridge-daemon-additional-eth-rpc-endpoints"
+	FlagBridgeDaemonStalenessPolicy           = "bridge-daemon-staleness-policy"
 
 	FlagLiquidationDaemonEnabled             = "liquidation-daemon-enabled"
 	FlagLiquidationDaemonLoopDelayMs         = "liquidation-daemon-loop-delay-ms"
 	FlagLiquidationDaemonSubaccountPageLimit = "liquidation-daemon-subaccount-page-limit"
 	FlagLiquidationDaemonRequestChunkSize    = "liquidation-daemon-request-chunk-size"
+	FlagLiquidationDaemonStalenessPolicy     = "liquidation-daemon-staleness-policy"
 )
 
 // Shared flags contains configuration flags shared by all daemons.
@@ -38,6 +43,11 @@ type BridgeFlags struct {
 	LoopDelayMs uint32
 	// EthRpcEndpoint is the endpoint for the Ethereum node where bridge data is queried.
 	EthRpcEndpoint string
+	// AdditionalEthRpcEndpoints are the endpoints for the nodes of other EVM chains where bridge data of
+	// additional bridge sources is queried. Bridge sources are matched to endpoints by chain ID.
+	AdditionalEthRpcEndpoints []string
+	// StalenessPolicy configures how the protocol reacts when the bridge daemon stops responding.
+	StalenessPolicy string
 }
 
 // LiquidationFlags contains configuration flags for the Liquidation Daemon.
@@ -46,9 +56,11 @@ type LiquidationFlags struct {
 	Enabled bool
 	// LoopDelayMs configures the update frequency of the liquidation daemon.
 	LoopDelayMs uint32
-	// SubaccountPageLimit configures the pagination limit for fetching subaccounts.
+	// SubaccountPageLimit configures the pagination limit for fetching liquidation candidates.
 	SubaccountPageLimit uint64
 	RequestChunkSize    uint64
+	// StalenessPolicy configures how the protocol reacts when the liquidation daemon stops responding.
+	StalenessPolicy string
 }
 
 // PriceFlags contains configuration flags for the Price Daemon.
@@ -57,6 +69,11 @@ type PriceFlags struct {
 	Enabled bool
 	// LoopDelayMs configures the update frequency of the price daemon.
 	LoopDelayMs uint32
+	// WebsocketEnabled toggles streaming prices over WebSocket subscriptions for exchanges that support it.
+	// Exchanges that do not support streaming are always polled.
+	WebsocketEnabled bool
+	// StalenessPolicy configures how the protocol reacts when the price daemon stops responding.
+	StalenessPolicy string
 }
 
 // DaemonFlags contains the collected configuration flags for all daemons.
@@ -77,19 +94,24 @@ func GetDefaultDaemonFlags() DaemonFlags {
 				SocketAddress: "/tmp/daemons.sock",
 			},
 			Bridge: BridgeFlags{
-				Enabled:        true,
-				LoopDelayMs:    30_000,
-				EthRpcEndpoint: "https://eth-sepolia.g.alchemy.com/v2/demo",
+				Enabled:                   true,
+				LoopDelayMs:               30_000,
+				EthRpcEndpoint:            "https://eth-sepolia.g.alchemy.com/v2/demo",
+				AdditionalEthRpcEndpoints: []string{},
+				StalenessPolicy:           "log",
 			},
 			Liquidation: LiquidationFlags{
 				Enabled:             true,
 				LoopDelayMs:         1_600,
 				SubaccountPageLimit: 1_000,
 				RequestChunkSize:    50,
+				StalenessPolicy:     "log",
 			},
 			Price: PriceFlags{
-				Enabled:     true,
-				LoopDelayMs: 3_000,
+				Enabled:          true,
+				LoopDelayMs:      3_000,
+				WebsocketEnabled: false,
+				StalenessPolicy:  "log",
 			},
 		}
 	}
@@ -129,6 +151,17 @@ func AddDaemonFlagsToCmd(
 		df.Bridge.EthRpcEndpoint,
 		"Ethereum Node Rpc Endpoint",
 	)
+	cmd.Flags().StringSlice(
+		FlagBridgeDaemonAdditionalEthRpcEndpoints,
+		df.Bridge.AdditionalEthRpcEndpoints,
+		"Comma-separated Rpc Endpoints of the nodes of other EVM chains with additional bridge sources",
+	)
+	cmd.Flags().String(
+		FlagBridgeDaemonStalenessPolicy,
+		df.Bridge.StalenessPolicy,
+		"Policy applied when the Bridge Daemon stops responding: 'halt' halts the node, 'log' logs an error and "+
+			"'degrade' stops proposing bridge events while continuing to vote.",
+	)
 
 	// Liquidation Daemon.
 	cmd.Flags().Bool(
@@ -144,13 +177,19 @@ func AddDaemonFlagsToCmd(
 	cmd.Flags().Uint64(
 		FlagLiquidationDaemonSubaccountPageLimit,
 		df.Liquidation.SubaccountPageLimit,
-		"Limit on the number of subaccounts to fetch per query in the Liquidation Daemon task loop.",
+		"Limit on the number of liquidation candidates to fetch per query in the Liquidation Daemon task loop.",
 	)
 	cmd.Flags().Uint64(
 		FlagLiquidationDaemonRequestChunkSize,
 		df.Liquidation.RequestChunkSize,
 		"Limit on the number of subaccounts per collateralization check in the Liquidation Daemon task loop.",
 	)
+	cmd.Flags().String(
+		FlagLiquidationDaemonStalenessPolicy,
+		df.Liquidation.StalenessPolicy,
+		"Policy applied when the Liquidation Daemon stops responding: 'halt' halts the node, 'log' logs an error "+
+			"and 'degrade' stops proposing liquidations while continuing to vote.",
+	)
 
 	// Price Daemon.
 	cmd.Flags().Bool(
@@ -163,6 +202,17 @@ func AddDaemonFlagsToCmd(
 		df.Price.LoopDelayMs,
 		"Delay in milliseconds between sending price updates to the application.",
 	)
+	cmd.Flags().Bool(
+		FlagPriceDaemonWebsocketEnabled,
+		df.Price.WebsocketEnabled,
+		"Stream prices over WebSocket subscriptions for exchanges that support it instead of polling.",
+	)
+	cmd.Flags().String(
+		FlagPriceDaemonStalenessPolicy,
+		df.Price.StalenessPolicy,
+		"Policy applied when the Price Daemon stops responding: 'halt' halts the node, 'log' logs an error and "+
+			"'degrade' stops proposing price updates while continuing to vote.",
+	)
 }
 
 // GetDaemonFlagValuesFromOptions gets all daemon flag values from the `AppOptions` struct.
@@ -195,6 +245,16 @@ func GetDaemonFlagValuesFromOptions(
 			result.Bridge.EthRpcEndpoint = v
 		}
 	}
+	if option := appOpts.Get(FlagBridgeDaemonAdditionalEthRpcEndpoints); option != nil {
+		if v, err := cast.ToStringSliceE(option); err == nil {
+			result.Bridge.AdditionalEthRpcEndpoints = v
+		}
+	}
+	if option := appOpts.Get(FlagBridgeDaemonStalenessPolicy); option != nil {
+		if v, err := cast.ToStringE(option); err == nil {
+			result.Bridge.StalenessPolicy = v
+		}
+	}
 
 	// Liquidation Daemon.
 	if option := appOpts.Get(FlagLiquidationDaemonEnabled); option != nil {
@@ -217,6 +277,11 @@ func GetDaemonFlagValuesFromOptions(
 			result.Liquidation.RequestChunkSize = v
 		}
 	}
+	if option := appOpts.Get(FlagLiquidationDaemonStalenessPolicy); option != nil {
+		if v, err := cast.ToStringE(option); err == nil {
+			result.Liquidation.StalenessPolicy = v
+		}
+	}
 
 	// Price Daemon.
 	if option := appOpts.Get(FlagPriceDaemonEnabled); option != nil {
@@ -229,6 +294,16 @@ func GetDaemonFlagValuesFromOptions(
 			result.Price.LoopDelayMs = v
 		}
 	}
+	if option := appOpts.Get(FlagPriceDaemonWebsocketEnabled); option != nil {
+		if v, err := cast.ToBoolE(option); err == nil {
+			result.Price.WebsocketEnabled = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonStalenessPolicy); option != nil {
+		if v, err := cast.ToStringE(option); err == nil {
+			result.Price.StalenessPolicy = v
+		}
+	}
 
 	return result
 }
@@ -20,13 +20,18 @@ func TestAddDaemonFlagsToCmd(t *testing.T) {
 
 		flags.FlagBridgeDaemonEnabled,
 		flags.FlagBridgeDaemonLoopDelayMs,
+		flags.FlagBridgeDaemonAdditionalEthRpcEndpoints,
+		flags.FlagBridgeDaemonStalenessPolicy,
 
 		flags.FlagLiquidationDaemonEnabled,
 		flags.FlagLiquidationDaemonLoopDelayMs,
 		flags.FlagLiquidationDaemonSubaccountPageLimit,
+		flags.FlagLiquidationDaemonStalenessPolicy,
 
 		flags.FlagPriceDaemonEnabled,
 		flags.FlagPriceDaemonLoopDelayMs,
+		flags.FlagPriceDaemonWebsocketEnabled,
+		flags.FlagPriceDaemonStalenessPolicy,
 	}
 
 	for _, v := range tests {
@@ -45,14 +50,19 @@ func TestGetDaemonFlagValuesFromOptions_Custom(t *testing.T) {
 	optsMap[flags.FlagBridgeDaemonEnabled] = true
 	optsMap[flags.FlagBridgeDaemonLoopDelayMs] = uint32(1111)
 	optsMap[flags.FlagBridgeDaemonEthRpcEndpoint] = "test-eth-rpc-endpoint"
+	optsMap[flags.FlagBridgeDaemonAdditionalEthRpcEndpoints] = []string{"test-eth-rpc-endpoint-2", "test-rpc-3"}
+	optsMap[flags.FlagBridgeDaemonStalenessPolicy] = "halt"
 
 	optsMap[flags.FlagLiquidationDaemonEnabled] = true
 	optsMap[flags.FlagLiquidationDaemonLoopDelayMs] = uint32(2222)
 	optsMap[flags.FlagLiquidationDaemonSubaccountPageLimit] = uint64(3333)
 	optsMap[flags.FlagLiquidationDaemonRequestChunkSize] = uint64(4444)
+	optsMap[flags.FlagLiquidationDaemonStalenessPolicy] = "degrade"
 
 	optsMap[flags.FlagPriceDaemonEnabled] = true
 	optsMap[flags.FlagPriceDaemonLoopDelayMs] = uint32(4444)
+	optsMap[flags.FlagPriceDaemonWebsocketEnabled] = true
+	optsMap[flags.FlagPriceDaemonStalenessPolicy] = "degrade"
 
 	mockOpts := mocks.AppOptions{}
 	mockOpts.On("Get", mock.Anything).
@@ -69,16 +79,21 @@ func TestGetDaemonFlagValuesFromOptions_Custom(t *testing.T) {
 	require.Equal(t, optsMap[flags.FlagBridgeDaemonEnabled], r.Bridge.Enabled)
 	require.Equal(t, optsMap[flags.FlagBridgeDaemonLoopDelayMs], r.Bridge.LoopDelayMs)
 	require.Equal(t, optsMap[flags.FlagBridgeDaemonEthRpcEndpoint], r.Bridge.EthRpcEndpoint)
+	require.Equal(t, optsMap[flags.FlagBridgeDaemonAdditionalEthRpcEndpoints], r.Bridge.AdditionalEthRpcEndpoints)
+	require.Equal(t, optsMap[flags.FlagBridgeDaemonStalenessPolicy], r.Bridge.StalenessPolicy)
 
 	// Liquidation Daemon.
 	require.Equal(t, optsMap[flags.FlagLiquidationDaemonEnabled], r.Liquidation.Enabled)
 	require.Equal(t, optsMap[flags.FlagLiquidationDaemonLoopDelayMs], r.Liquidation.LoopDelayMs)
 	require.Equal(t, optsMap[flags.FlagLiquidationDaemonSubaccountPageLimit], r.Liquidation.SubaccountPageLimit)
 	require.Equal(t, optsMap[flags.FlagLiquidationDaemonRequestChunkSize], r.Liquidation.RequestChunkSize)
+	require.Equal(t, optsMap[flags.FlagLiquidationDaemonStalenessPolicy], r.Liquidation.StalenessPolicy)
 
 	// Price Daemon.
 	require.Equal(t, optsMap[flags.FlagPriceDaemonEnabled], r.Price.Enabled)
 	require.Equal(t, optsMap[flags.FlagPriceDaemonLoopDelayMs], r.Price.LoopDelayMs)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonWebsocketEnabled], r.Price.WebsocketEnabled)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonStalenessPolicy], r.Price.StalenessPolicy)
 }
 
 func TestGetDaemonFlagValuesFromOptions_Defaul(t *testing.T) {
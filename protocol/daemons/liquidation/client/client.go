@@ -20,8 +20,8 @@ import (
 )
 
 // Start begins a job that periodically:
-// 1) Queries a gRPC server for all subaccounts including their open positions.
-// 2) Checks collateralization statuses of subaccounts with at least one open position.
+// 1) Queries a gRPC server for subaccounts with open positions and their margin at current oracle prices.
+// 2) Checks collateralization statuses of the subaccounts below maintenance margin.
 // 3) Sends a list of subaccount ids that potentially need to be liquidated to the application.
 func Start(
 	ctx context.Context,
@@ -97,8 +97,8 @@ func RunLiquidationDaemonTaskLoop(
 		metrics.Latency,
 	)
 
-	// 1. Fetch all subaccounts from query service.
-	subaccounts, err := GetAllSubaccounts(
+	// 1. Fetch liquidation candidates from query service.
+	candidates, err := GetLiquidationCandidates(
 		ctx,
 		subaccountQueryClient,
 		liqFlags.SubaccountPageLimit,
@@ -107,12 +107,12 @@ func RunLiquidationDaemonTaskLoop(
 		return err
 	}
 
-	// 2. Check collateralization statuses of subaccounts with at least one open position.
+	// 2. Check collateralization statuses of candidates below maintenance margin.
 	liquidatableSubaccountIds, err := GetLiquidatableSubaccountIds(
 		ctx,
 		clobQueryClient,
 		liqFlags,
-		subaccounts,
+		candidates,
 	)
 	if err != nil {
 		return err
@@ -131,22 +131,29 @@ func RunLiquidationDaemonTaskLoop(
 	return nil
 }
 
-// GetAllSubaccounts queries a gRPC server and returns a list of subaccounts and
-// their balances and open positions.
-func GetAllSubaccounts(
+// GetLiquidationCandidates queries a gRPC server and returns all subaccounts with at least one open
+// position along with their net collateral and maintenance margin requirement at current oracle prices.
+// Candidates are fetched in pages of at most `limit` candidates.
+func GetLiquidationCandidates(
 	ctx context.Context,
 	client satypes.QueryClient,
 	limit uint64,
 ) (
-	subaccounts []satypes.Subaccount,
+	candidates []satypes.LiquidationCandidate,
 	err error,
 ) {
-	defer telemetry.ModuleMeasureSince(metrics.LiquidationDaemon, time.Now(), metrics.GetAllSubaccounts, metrics.Latency)
-	subaccounts = make([]satypes.Subaccount, 0)
+	defer telemetry.ModuleMeasureSince(
+		metrics.LiquidationDaemon,
+		time.Now(),
+		metrics.GetLiquidationCandidates,
+		metrics.Latency,
+	)
+	candidates = make([]satypes.LiquidationCandidate, 0)
+	limit = lib.Min(limit, satypes.MaxLiquidationCandidatesPageLimit)
 
 	var nextKey []byte
 	for {
-		subaccountsFromKey, next, err := getSubaccountsFromKey(
+		candidatesFromKey, next, err := getLiquidationCandidatesFromKey(
 			ctx,
 			client,
 			limit,
@@ -157,7 +164,7 @@ func GetAllSubaccounts(
 			return nil, err
 		}
 
-		subaccounts = append(subaccounts, subaccountsFromKey...)
+		candidates = append(candidates, candidatesFromKey...)
 		nextKey = next
 
 		if len(nextKey) == 0 {
@@ -167,21 +174,21 @@ func GetAllSubaccounts(
 
 	telemetry.ModuleSetGauge(
 		metrics.LiquidationDaemon,
-		float32(len(subaccounts)),
-		metrics.GetAllSubaccounts,
+		float32(len(candidates)),
+		metrics.LiquidationCandidates,
 		metrics.Count,
 	)
 
-	return subaccounts, nil
+	return candidates, nil
 }
 
-// GetLiquidatableSubaccountIds verifies collateralization statuses of subaccounts with
-// at least one open position and returns a list of unique and potentially liquidatable subaccount ids.
+// GetLiquidatableSubaccountIds verifies collateralization statuses of liquidation candidates below
+// maintenance margin and returns a list of unique and potentially liquidatable subaccount ids.
 func GetLiquidatableSubaccountIds(
 	ctx context.Context,
 	client clobtypes.QueryClient,
 	liqFlags flags.LiquidationFlags,
-	subaccounts []satypes.Subaccount,
+	candidates []satypes.LiquidationCandidate,
 ) (
 	liquidatableSubaccountIds []satypes.SubaccountId,
 	err error,
@@ -193,18 +200,19 @@ func GetLiquidatableSubaccountIds(
 		metrics.Latency,
 	)
 
-	// Filter out subaccounts with no open positions.
+	// Filter out candidates with net collateral at or above maintenance margin.
 	subaccountsToCheck := make([]satypes.SubaccountId, 0)
-	for _, subaccount := range subaccounts {
-		if len(subaccount.PerpetualPositions) > 0 {
-			subaccountsToCheck = append(subaccountsToCheck, *subaccount.Id)
+	for _, candidate := range candidates {
+		if candidate.GetBigDistanceToMaintenanceMargin().Sign() >= 0 {
+			continue
 		}
+		subaccountsToCheck = append(subaccountsToCheck, candidate.SubaccountId)
 	}
 
 	telemetry.ModuleSetGauge(
 		metrics.LiquidationDaemon,
 		float32(len(subaccountsToCheck)),
-		metrics.SubaccountsWithOpenPositions,
+		metrics.LiquidationCandidatesBelowMargin,
 		metrics.Count,
 	)
 
@@ -289,38 +297,38 @@ func SendLiquidatableSubaccountIds(
 	return nil
 }
 
-func getSubaccountsFromKey(
+func getLiquidationCandidatesFromKey(
 	ctx context.Context,
 	client satypes.QueryClient,
 	limit uint64,
 	pageRequestKey []byte,
 ) (
-	subaccounts []satypes.Subaccount,
+	candidates []satypes.LiquidationCandidate,
 	nextKey []byte,
 	err error,
 ) {
 	defer metrics.ModuleMeasureSinceWithLabels(
 		metrics.LiquidationDaemon,
-		[]string{metrics.GetSubaccountsFromKey, metrics.Latency},
+		[]string{metrics.GetLiquidationCandidatesFromKey, metrics.Latency},
 		time.Now(),
 		[]gometrics.Label{
 			metrics.GetLabelForIntValue(metrics.PageLimit, int(limit)),
 		},
 	)
 
-	query := &satypes.QueryAllSubaccountRequest{
+	query := &satypes.QueryLiquidationCandidatesRequest{
 		Pagination: &query.PageRequest{
 			Key:   pageRequestKey,
 			Limit: limit,
 		},
 	}
 
-	response, err := client.SubaccountAll(ctx, query)
+	response, err := client.LiquidationCandidates(ctx, query)
 	if err != nil {
 		return nil, nil, err
 	}
 	if response.Pagination != nil {
 		nextKey = response.Pagination.NextKey
 	}
-	return response.Subaccount, nextKey, nil
+	return response.Candidates, nextKey, nil
 }
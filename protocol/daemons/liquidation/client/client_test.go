@@ -13,6 +13,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/client"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/grpc"
@@ -68,6 +69,27 @@ func TestStart_UnixSocketConnectionFails(t *testing.T) {
 	mockGrpcClient.AssertNumberOfCalls(t, "CloseConnection", 1)
 }
 
+var (
+	// $4,500 of net collateral and $5,000 of maintenance margin.
+	carl_Num0_Below_Maintenance_Margin = satypes.LiquidationCandidate{
+		SubaccountId:                 constants.Carl_Num0,
+		NetCollateral:                dtypes.NewInt(4_500_000_000),
+		MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+	}
+	// $4,900 of net collateral and $5,000 of maintenance margin.
+	dave_Num0_Below_Maintenance_Margin = satypes.LiquidationCandidate{
+		SubaccountId:                 constants.Dave_Num0,
+		NetCollateral:                dtypes.NewInt(4_900_000_000),
+		MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+	}
+	// $100,000 of net collateral and $5,000 of maintenance margin.
+	dave_Num0_Above_Maintenance_Margin = satypes.LiquidationCandidate{
+		SubaccountId:                 constants.Dave_Num0,
+		NetCollateral:                dtypes.NewInt(100_000_000_000),
+		MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+	}
+)
+
 func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 	df := flags.GetDefaultDaemonFlags()
 	tests := map[string]struct {
@@ -80,23 +102,22 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 	}{
 		"Success": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
+				req := &satypes.QueryLiquidationCandidatesRequest{
 					Pagination: &query.PageRequest{
 						Limit: df.Liquidation.SubaccountPageLimit,
 					},
 				}
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_1BTC_Short,
-						constants.Dave_Num0_1BTC_Long_50000USD,
+				response := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{
+						carl_Num0_Below_Maintenance_Margin,
+						dave_Num0_Above_Maintenance_Margin,
 					},
 				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
+				mck.On("LiquidationCandidates", ctx, req).Return(response, nil)
 
 				req2 := &clobtypes.AreSubaccountsLiquidatableRequest{
 					SubaccountIds: []satypes.SubaccountId{
 						constants.Carl_Num0,
-						constants.Dave_Num0,
 					},
 				}
 				response2 := &clobtypes.AreSubaccountsLiquidatableResponse{
@@ -105,10 +126,6 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 							SubaccountId:   constants.Carl_Num0,
 							IsLiquidatable: true,
 						},
-						{
-							SubaccountId:   constants.Dave_Num0,
-							IsLiquidatable: false,
-						},
 					},
 				}
 				mck.On("AreSubaccountsLiquidatable", ctx, req2).Return(response2, nil)
@@ -122,20 +139,91 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				mck.On("LiquidateSubaccounts", ctx, req3).Return(response3, nil)
 			},
 		},
+		"Success - candidates below maintenance margin on later page": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				req := &satypes.QueryLiquidationCandidatesRequest{
+					Pagination: &query.PageRequest{
+						Limit: df.Liquidation.SubaccountPageLimit,
+					},
+				}
+				nextKey := []byte("next key")
+				response := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{
+						dave_Num0_Above_Maintenance_Margin,
+					},
+					Pagination: &query.PageResponse{
+						NextKey: nextKey,
+					},
+				}
+				mck.On("LiquidationCandidates", ctx, req).Return(response, nil)
+				req2 := &satypes.QueryLiquidationCandidatesRequest{
+					Pagination: &query.PageRequest{
+						Key:   nextKey,
+						Limit: df.Liquidation.SubaccountPageLimit,
+					},
+				}
+				response2 := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{
+						carl_Num0_Below_Maintenance_Margin,
+					},
+				}
+				mck.On("LiquidationCandidates", ctx, req2).Return(response2, nil)
+
+				req3 := &clobtypes.AreSubaccountsLiquidatableRequest{
+					SubaccountIds: []satypes.SubaccountId{
+						constants.Carl_Num0,
+					},
+				}
+				response3 := &clobtypes.AreSubaccountsLiquidatableResponse{
+					Results: []clobtypes.AreSubaccountsLiquidatableResponse_Result{
+						{
+							SubaccountId:   constants.Carl_Num0,
+							IsLiquidatable: true,
+						},
+					},
+				}
+				mck.On("AreSubaccountsLiquidatable", ctx, req3).Return(response3, nil)
+
+				req4 := &api.LiquidateSubaccountsRequest{
+					SubaccountIds: []satypes.SubaccountId{
+						constants.Carl_Num0,
+					},
+				}
+				response4 := &api.LiquidateSubaccountsResponse{}
+				mck.On("LiquidateSubaccounts", ctx, req4).Return(response4, nil)
+			},
+		},
 		"Success - no open position": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
+				req := &satypes.QueryLiquidationCandidatesRequest{
+					Pagination: &query.PageRequest{
+						Limit: df.Liquidation.SubaccountPageLimit,
+					},
+				}
+				response := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{},
+				}
+				mck.On("LiquidationCandidates", ctx, req).Return(response, nil)
+				req2 := &api.LiquidateSubaccountsRequest{
+					SubaccountIds: []satypes.SubaccountId{},
+				}
+				response2 := &api.LiquidateSubaccountsResponse{}
+				mck.On("LiquidateSubaccounts", ctx, req2).Return(response2, nil)
+			},
+		},
+		"Success - no candidates below maintenance margin": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				req := &satypes.QueryLiquidationCandidatesRequest{
 					Pagination: &query.PageRequest{
 						Limit: df.Liquidation.SubaccountPageLimit,
 					},
 				}
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_599USD, // no open positions
-						constants.Dave_Num0_599USD, // no open positions
+				response := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{
+						dave_Num0_Above_Maintenance_Margin,
 					},
 				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
+				mck.On("LiquidationCandidates", ctx, req).Return(response, nil)
 				req2 := &api.LiquidateSubaccountsRequest{
 					SubaccountIds: []satypes.SubaccountId{},
 				}
@@ -145,18 +233,18 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 		},
 		"Success - no liquidatable subaccounts": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
+				req := &satypes.QueryLiquidationCandidatesRequest{
 					Pagination: &query.PageRequest{
 						Limit: df.Liquidation.SubaccountPageLimit,
 					},
 				}
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_1BTC_Short,
-						constants.Dave_Num0_1BTC_Long_50000USD,
+				response := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{
+						carl_Num0_Below_Maintenance_Margin,
+						dave_Num0_Below_Maintenance_Margin,
 					},
 				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
+				mck.On("LiquidationCandidates", ctx, req).Return(response, nil)
 
 				req2 := &clobtypes.AreSubaccountsLiquidatableRequest{
 					SubaccountIds: []satypes.SubaccountId{
@@ -184,30 +272,35 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				mck.On("LiquidateSubaccounts", ctx, req3).Return(response3, nil)
 			},
 		},
-		"Panics on error - SubaccountAll": {
+		"Panics on error - LiquidationCandidates": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
+				mck.On("LiquidationCandidates", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
 			},
 			expectedError: errors.New("test error"),
 		},
 		"Panics on error - AreSubaccountsLiquidatable": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(&satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_1BTC_Short,
+				mck.On("LiquidationCandidates", mock.Anything, mock.Anything).Return(
+					&satypes.QueryLiquidationCandidatesResponse{
+						Candidates: []satypes.LiquidationCandidate{
+							carl_Num0_Below_Maintenance_Margin,
+						},
 					},
-				}, nil)
+					nil,
+				)
 				mck.On("AreSubaccountsLiquidatable", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
 			},
 			expectedError: errors.New("test error"),
 		},
 		"Panics on error - LiquidateSubaccounts": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(&satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_1BTC_Short,
+				mck.On("LiquidationCandidates", mock.Anything, mock.Anything).Return(
+					&satypes.QueryLiquidationCandidatesResponse{
+						Candidates: []satypes.LiquidationCandidate{
+							carl_Num0_Below_Maintenance_Margin,
+						},
 					},
-				}, nil,
+					nil,
 				)
 				mck.On("AreSubaccountsLiquidatable", mock.Anything, mock.Anything).Return(
 					&clobtypes.AreSubaccountsLiquidatableResponse{},
@@ -241,79 +334,79 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 	}
 }
 
-func TestGetAllSubaccounts(t *testing.T) {
+func TestGetLiquidationCandidates(t *testing.T) {
 	df := flags.GetDefaultDaemonFlags()
 	tests := map[string]struct {
 		// mocks
 		setupMocks func(ctx context.Context, mck *mocks.QueryClient)
 
 		// expectations
-		expectedSubaccounts []satypes.Subaccount
-		expectedError       error
+		expectedCandidates []satypes.LiquidationCandidate
+		expectedError      error
 	}{
 		"Success": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
+				req := &satypes.QueryLiquidationCandidatesRequest{
 					Pagination: &query.PageRequest{
 						Limit: df.Liquidation.SubaccountPageLimit,
 					},
 				}
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_599USD,
-						constants.Dave_Num0_599USD,
+				response := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{
+						carl_Num0_Below_Maintenance_Margin,
+						dave_Num0_Above_Maintenance_Margin,
 					},
 				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
+				mck.On("LiquidationCandidates", ctx, req).Return(response, nil)
 			},
-			expectedSubaccounts: []satypes.Subaccount{
-				constants.Carl_Num0_599USD,
-				constants.Dave_Num0_599USD,
+			expectedCandidates: []satypes.LiquidationCandidate{
+				carl_Num0_Below_Maintenance_Margin,
+				dave_Num0_Above_Maintenance_Margin,
 			},
 		},
-		"Success Paginated": {
+		"Success - paginated": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
+				req := &satypes.QueryLiquidationCandidatesRequest{
 					Pagination: &query.PageRequest{
 						Limit: df.Liquidation.SubaccountPageLimit,
 					},
 				}
 				nextKey := []byte("next key")
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_599USD,
+				response := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{
+						dave_Num0_Above_Maintenance_Margin,
 					},
 					Pagination: &query.PageResponse{
 						NextKey: nextKey,
 					},
 				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
-				req2 := &satypes.QueryAllSubaccountRequest{
+				mck.On("LiquidationCandidates", ctx, req).Return(response, nil)
+				req2 := &satypes.QueryLiquidationCandidatesRequest{
 					Pagination: &query.PageRequest{
 						Key:   nextKey,
 						Limit: df.Liquidation.SubaccountPageLimit,
 					},
 				}
-				response2 := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Dave_Num0_599USD,
+				response2 := &satypes.QueryLiquidationCandidatesResponse{
+					Candidates: []satypes.LiquidationCandidate{
+						carl_Num0_Below_Maintenance_Margin,
 					},
 				}
-				mck.On("SubaccountAll", ctx, req2).Return(response2, nil)
+				mck.On("LiquidationCandidates", ctx, req2).Return(response2, nil)
 			},
-			expectedSubaccounts: []satypes.Subaccount{
-				constants.Carl_Num0_599USD,
-				constants.Dave_Num0_599USD,
+			expectedCandidates: []satypes.LiquidationCandidate{
+				dave_Num0_Above_Maintenance_Margin,
+				carl_Num0_Below_Maintenance_Margin,
 			},
 		},
 		"Errors are propagated": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
+				req := &satypes.QueryLiquidationCandidatesRequest{
 					Pagination: &query.PageRequest{
 						Limit: df.Liquidation.SubaccountPageLimit,
 					},
 				}
-				mck.On("SubaccountAll", ctx, req).Return(nil, errors.New("test error"))
+				mck.On("LiquidationCandidates", ctx, req).Return(nil, errors.New("test error"))
 			},
 			expectedError: errors.New("test error"),
 		},
@@ -324,11 +417,11 @@ func TestGetAllSubaccounts(t *testing.T) {
 			queryClientMock := &mocks.QueryClient{}
 			tc.setupMocks(grpc.Ctx, queryClientMock)
 
-			actual, err := client.GetAllSubaccounts(grpc.Ctx, queryClientMock, df.Liquidation.SubaccountPageLimit)
+			actual, err := client.GetLiquidationCandidates(grpc.Ctx, queryClientMock, df.Liquidation.SubaccountPageLimit)
 			if err != nil {
 				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
-				require.Equal(t, tc.expectedSubaccounts, actual)
+				require.Equal(t, tc.expectedCandidates, actual)
 			}
 		})
 	}
@@ -35,6 +35,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // UpdateMarketPriceRequest is a request message updating market prices.
 type UpdateMarketPricesRequest struct {
 	MarketPriceUpdates []*MarketPriceUpdate `protobuf:"bytes,1,rep,name=market_price_updates,json=marketPriceUpdates,proto3" json:"market_price_updates,omitempty"`
+	// The health of each exchange queried by the pricefeed daemon.
+	ExchangeStatuses []*ExchangeStatus `protobuf:"bytes,2,rep,name=exchange_statuses,json=exchangeStatuses,proto3" json:"exchange_statuses,omitempty"`
 }
 
 func (m *UpdateMarketPricesRequest) Reset()         { *m = UpdateMarketPricesRequest{} }
@@ -77,6 +79,13 @@ func (m *UpdateMarketPricesRequest) GetMarketPriceUpdates() []*MarketPriceUpdate
 	return nil
 }
 
+func (m *UpdateMarketPricesRequest) GetExchangeStatuses() []*ExchangeStatus {
+	if m != nil {
+		return m.ExchangeStatuses
+	}
+	return nil
+}
+
 // UpdateMarketPricesResponse is a response message for updating market prices.
 type UpdateMarketPricesResponse struct {
 }
@@ -119,6 +128,10 @@ type ExchangePrice struct {
 	ExchangeId     string     `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
 	Price          uint64     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
 	LastUpdateTime *time.Time `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	// The trading volume reported by the exchange for the market, or 0 if the
+	// exchange does not report volume. Used to weight the price when the index
+	// price is a volume-weighted median.
+	Volume uint64 `protobuf:"varint,4,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
 func (m *ExchangePrice) Reset()         { *m = ExchangePrice{} }
@@ -175,6 +188,13 @@ func (m *ExchangePrice) GetLastUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ExchangePrice) GetVolume() uint64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
 // MarketPriceUpdate represents an update to a single market
 type MarketPriceUpdate struct {
 	MarketId       uint32           `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -228,11 +248,365 @@ func (m *MarketPriceUpdate) GetExchangePrices() []*ExchangePrice {
 	return nil
 }
 
+// ExchangeStatus represents the health of a specific exchange as observed by
+// the pricefeed daemon.
+type ExchangeStatus struct {
+	ExchangeId string `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	// The number of failed price queries since the daemon started.
+	ErrorCount uint64 `protobuf:"varint,2,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	// The time of the last price successfully reported by the exchange, if any.
+	LastSuccessTime *time.Time `protobuf:"bytes,3,opt,name=last_success_time,json=lastSuccessTime,proto3,stdtime" json:"last_success_time,omitempty"`
+	// The time of the last failed price query, if any.
+	LastErrorTime *time.Time `protobuf:"bytes,4,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time,omitempty"`
+	// The reason the last failed price query was dropped, e.g. "rate_limit".
+	LastErrorReason string `protobuf:"bytes,5,opt,name=last_error_reason,json=lastErrorReason,proto3" json:"last_error_reason,omitempty"`
+	// The error message of the last failed price query.
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *ExchangeStatus) Reset()         { *m = ExchangeStatus{} }
+func (m *ExchangeStatus) String() string { return proto.CompactTextString(m) }
+func (*ExchangeStatus) ProtoMessage()    {}
+func (*ExchangeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{4}
+}
+func (m *ExchangeStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeStatus.Merge(m, src)
+}
+func (m *ExchangeStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeStatus proto.InternalMessageInfo
+
+func (m *ExchangeStatus) GetExchangeId() string {
+	if m != nil {
+		return m.ExchangeId
+	}
+	return ""
+}
+
+func (m *ExchangeStatus) GetErrorCount() uint64 {
+	if m != nil {
+		return m.ErrorCount
+	}
+	return 0
+}
+
+func (m *ExchangeStatus) GetLastSuccessTime() *time.Time {
+	if m != nil {
+		return m.LastSuccessTime
+	}
+	return nil
+}
+
+func (m *ExchangeStatus) GetLastErrorTime() *time.Time {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return nil
+}
+
+func (m *ExchangeStatus) GetLastErrorReason() string {
+	if m != nil {
+		return m.LastErrorReason
+	}
+	return ""
+}
+
+func (m *ExchangeStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+// MarketPricesRequest is a request message for the prices of markets.
+type MarketPricesRequest struct {
+	// The ids of the markets to return. All markets are returned if empty.
+	MarketIds []uint32 `protobuf:"varint,1,rep,packed,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+}
+
+func (m *MarketPricesRequest) Reset()         { *m = MarketPricesRequest{} }
+func (m *MarketPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketPricesRequest) ProtoMessage()    {}
+func (*MarketPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{5}
+}
+func (m *MarketPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPricesRequest.Merge(m, src)
+}
+func (m *MarketPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketPricesRequest proto.InternalMessageInfo
+
+func (m *MarketPricesRequest) GetMarketIds() []uint32 {
+	if m != nil {
+		return m.MarketIds
+	}
+	return nil
+}
+
+// MarketPricesResponse is a response message containing the prices of
+// markets.
+type MarketPricesResponse struct {
+	MarketPrices []*MarketPrices `protobuf:"bytes,1,rep,name=market_prices,json=marketPrices,proto3" json:"market_prices,omitempty"`
+}
+
+func (m *MarketPricesResponse) Reset()         { *m = MarketPricesResponse{} }
+func (m *MarketPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketPricesResponse) ProtoMessage()    {}
+func (*MarketPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{6}
+}
+func (m *MarketPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPricesResponse.Merge(m, src)
+}
+func (m *MarketPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketPricesResponse proto.InternalMessageInfo
+
+func (m *MarketPricesResponse) GetMarketPrices() []*MarketPrices {
+	if m != nil {
+		return m.MarketPrices
+	}
+	return nil
+}
+
+// MarketPrices represents the prices reported for a single market.
+type MarketPrices struct {
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// The price last reported by each exchange, regardless of its age.
+	ExchangePrices []*ExchangePrice `protobuf:"bytes,2,rep,name=exchange_prices,json=exchangePrices,proto3" json:"exchange_prices,omitempty"`
+	// The most recent index price of the market, or 0 if no valid index price
+	// has been computed.
+	IndexPrice uint64 `protobuf:"varint,3,opt,name=index_price,json=indexPrice,proto3" json:"index_price,omitempty"`
+	// The ids of the exchanges whose prices the index price was computed from.
+	IndexPriceExchangeIds []string `protobuf:"bytes,4,rep,name=index_price_exchange_ids,json=indexPriceExchangeIds,proto3" json:"index_price_exchange_ids,omitempty"`
+	// The time at which the index price was computed.
+	IndexPriceTime *time.Time `protobuf:"bytes,5,opt,name=index_price_time,json=indexPriceTime,proto3,stdtime" json:"index_price_time,omitempty"`
+}
+
+func (m *MarketPrices) Reset()         { *m = MarketPrices{} }
+func (m *MarketPrices) String() string { return proto.CompactTextString(m) }
+func (*MarketPrices) ProtoMessage()    {}
+func (*MarketPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{7}
+}
+func (m *MarketPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPrices.Merge(m, src)
+}
+func (m *MarketPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketPrices proto.InternalMessageInfo
+
+func (m *MarketPrices) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MarketPrices) GetExchangePrices() []*ExchangePrice {
+	if m != nil {
+		return m.ExchangePrices
+	}
+	return nil
+}
+
+func (m *MarketPrices) GetIndexPrice() uint64 {
+	if m != nil {
+		return m.IndexPrice
+	}
+	return 0
+}
+
+func (m *MarketPrices) GetIndexPriceExchangeIds() []string {
+	if m != nil {
+		return m.IndexPriceExchangeIds
+	}
+	return nil
+}
+
+func (m *MarketPrices) GetIndexPriceTime() *time.Time {
+	if m != nil {
+		return m.IndexPriceTime
+	}
+	return nil
+}
+
+// ExchangeStatusesRequest is a request message for the health of exchanges.
+type ExchangeStatusesRequest struct {
+}
+
+func (m *ExchangeStatusesRequest) Reset()         { *m = ExchangeStatusesRequest{} }
+func (m *ExchangeStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeStatusesRequest) ProtoMessage()    {}
+func (*ExchangeStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{8}
+}
+func (m *ExchangeStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeStatusesRequest.Merge(m, src)
+}
+func (m *ExchangeStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeStatusesRequest proto.InternalMessageInfo
+
+// ExchangeStatusesResponse is a response message containing the health of
+// each exchange.
+type ExchangeStatusesResponse struct {
+	ExchangeStatuses []*ExchangeStatus `protobuf:"bytes,1,rep,name=exchange_statuses,json=exchangeStatuses,proto3" json:"exchange_statuses,omitempty"`
+}
+
+func (m *ExchangeStatusesResponse) Reset()         { *m = ExchangeStatusesResponse{} }
+func (m *ExchangeStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*ExchangeStatusesResponse) ProtoMessage()    {}
+func (*ExchangeStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{9}
+}
+func (m *ExchangeStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeStatusesResponse.Merge(m, src)
+}
+func (m *ExchangeStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeStatusesResponse proto.InternalMessageInfo
+
+func (m *ExchangeStatusesResponse) GetExchangeStatuses() []*ExchangeStatus {
+	if m != nil {
+		return m.ExchangeStatuses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UpdateMarketPricesRequest)(nil), "dydxprotocol.daemons.pricefeed.UpdateMarketPricesRequest")
 	proto.RegisterType((*UpdateMarketPricesResponse)(nil), "dydxprotocol.daemons.pricefeed.UpdateMarketPricesResponse")
 	proto.RegisterType((*ExchangePrice)(nil), "dydxprotocol.daemons.pricefeed.ExchangePrice")
 	proto.RegisterType((*MarketPriceUpdate)(nil), "dydxprotocol.daemons.pricefeed.MarketPriceUpdate")
+	proto.RegisterType((*ExchangeStatus)(nil), "dydxprotocol.daemons.pricefeed.ExchangeStatus")
+	proto.RegisterType((*MarketPricesRequest)(nil), "dydxprotocol.daemons.pricefeed.MarketPricesRequest")
+	proto.RegisterType((*MarketPricesResponse)(nil), "dydxprotocol.daemons.pricefeed.MarketPricesResponse")
+	proto.RegisterType((*MarketPrices)(nil), "dydxprotocol.daemons.pricefeed.MarketPrices")
+	proto.RegisterType((*ExchangeStatusesRequest)(nil), "dydxprotocol.daemons.pricefeed.ExchangeStatusesRequest")
+	proto.RegisterType((*ExchangeStatusesResponse)(nil), "dydxprotocol.daemons.pricefeed.ExchangeStatusesResponse")
 }
 
 func init() {
@@ -240,35 +614,55 @@ func init() {
 }
 
 var fileDescriptor_3d8cd2726a0e97cb = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0x2d, 0xa0, 0xf6, 0xa2, 0x96, 0x72, 0xca, 0x60, 0x0c, 0xb2, 0x2d, 0x4f, 0x5e,
-	0x7a, 0x16, 0x81, 0x05, 0x16, 0xa4, 0x4a, 0x20, 0x75, 0x00, 0x21, 0xf3, 0x22, 0xc1, 0x62, 0x5d,
-	0x7c, 0x4f, 0x1d, 0x8b, 0xd8, 0x67, 0x7c, 0xe7, 0xaa, 0x6c, 0x8c, 0x2c, 0x48, 0x9d, 0xf8, 0x00,
-	0x7c, 0x9a, 0x8e, 0x19, 0x99, 0x00, 0x25, 0x5f, 0x04, 0xf9, 0xce, 0x89, 0x12, 0x02, 0x89, 0xc4,
-	0xf6, 0xbc, 0xfd, 0xef, 0xfe, 0xcf, 0xcf, 0x3e, 0x1c, 0xf1, 0x8f, 0xfc, 0xa2, 0xaa, 0x85, 0x12,
-	0xa9, 0x18, 0x47, 0x9c, 0x41, 0x21, 0x4a, 0x19, 0x55, 0x75, 0x9e, 0xc2, 0x19, 0x00, 0x37, 0x51,
-	0xd2, 0x86, 0x54, 0x4f, 0x11, 0x77, 0x59, 0x40, 0x3b, 0x01, 0x5d, 0x08, 0x9c, 0x7e, 0x26, 0x32,
-	0xa1, 0xfb, 0x51, 0x1b, 0x19, 0x95, 0xe3, 0x65, 0x42, 0x64, 0x63, 0x88, 0x74, 0x36, 0x6c, 0xce,
-	0x22, 0x95, 0x17, 0x20, 0x15, 0x2b, 0x2a, 0x33, 0x10, 0x7c, 0x42, 0xf8, 0xf6, 0xeb, 0x8a, 0x33,
-	0x05, 0xcf, 0x58, 0xfd, 0x1e, 0xd4, 0x8b, 0xf6, 0x40, 0x19, 0xc3, 0x87, 0x06, 0xa4, 0x22, 0x29,
-	0xee, 0x17, 0xba, 0x9c, 0x18, 0x3f, 0x8d, 0x9e, 0x94, 0x36, 0xf2, 0x77, 0xc3, 0xde, 0xe0, 0x1e,
-	0xdd, 0xec, 0x89, 0x2e, 0x1d, 0x69, 0xee, 0x88, 0x49, 0xf1, 0x67, 0x49, 0x06, 0x77, 0xb1, 0xf3,
-	0x37, 0x07, 0xb2, 0x12, 0xa5, 0x84, 0xe0, 0x2b, 0xc2, 0x07, 0x4f, 0x2e, 0xd2, 0x11, 0x2b, 0x33,
-	0xd0, 0x2d, 0xe2, 0xe1, 0x1e, 0x74, 0x85, 0x24, 0xe7, 0x36, 0xf2, 0x51, 0xb8, 0x1f, 0xe3, 0x79,
-	0xe9, 0x94, 0x93, 0x3e, 0xbe, 0xae, 0x3d, 0xd8, 0x3b, 0x3e, 0x0a, 0xaf, 0xc5, 0x26, 0x21, 0xcf,
-	0xf1, 0xd1, 0x98, 0x49, 0xd5, 0xed, 0x90, 0xb4, 0x20, 0xec, 0x5d, 0x1f, 0x85, 0xbd, 0x81, 0x43,
-	0x0d, 0x25, 0x3a, 0xa7, 0x44, 0x5f, 0xcd, 0x29, 0x9d, 0xec, 0x5d, 0xfd, 0xf0, 0xd0, 0xe5, 0x4f,
-	0x0f, 0xc5, 0x87, 0xad, 0xda, 0x18, 0x6d, 0xdb, 0xc1, 0x67, 0x84, 0x6f, 0xad, 0x2d, 0x48, 0xee,
-	0xe0, 0xfd, 0x8e, 0x58, 0x67, 0xed, 0x20, 0xde, 0x33, 0x85, 0x53, 0x4e, 0xde, 0xe0, 0x9b, 0x0b,
-	0xe7, 0xda, 0x94, 0xb4, 0x77, 0x34, 0xc9, 0xe3, 0x6d, 0x24, 0x57, 0x08, 0xc4, 0x87, 0xb0, 0x9c,
-	0xca, 0xc1, 0x37, 0x84, 0x8f, 0x74, 0xf8, 0x14, 0x80, 0xbf, 0x84, 0xfa, 0xbc, 0xdd, 0xf7, 0x0b,
-	0xc2, 0x64, 0x9d, 0x2b, 0x79, 0xb8, 0xed, 0xaa, 0x7f, 0xfe, 0x0d, 0xce, 0xa3, 0xff, 0x91, 0x76,
-	0x9f, 0xd1, 0x3a, 0x79, 0x7b, 0x35, 0x75, 0xd1, 0x64, 0xea, 0xa2, 0x5f, 0x53, 0x17, 0x5d, 0xce,
-	0x5c, 0x6b, 0x32, 0x73, 0xad, 0xef, 0x33, 0xd7, 0x7a, 0xf7, 0x38, 0xcb, 0xd5, 0xa8, 0x19, 0xd2,
-	0x54, 0x14, 0xab, 0xcf, 0xe2, 0xfc, 0xc1, 0x71, 0x3a, 0x62, 0x79, 0x19, 0x6d, 0x78, 0x28, 0xac,
-	0xca, 0x87, 0x37, 0x74, 0xff, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x1e, 0xd0, 0x18,
-	0x55, 0x03, 0x00, 0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0xcf, 0xa6, 0x69, 0xd5, 0x4c, 0x9b, 0x36, 0xd9, 0x2f, 0xdf, 0xf7, 0xb9, 0x81, 0x26, 0x91,
+	0x4f, 0x11, 0xa2, 0x8e, 0x48, 0x2b, 0x15, 0xb8, 0x20, 0x15, 0x05, 0xa9, 0x12, 0xa0, 0xe2, 0x02,
+	0x12, 0x70, 0x88, 0x5c, 0x7b, 0x9b, 0x5a, 0x24, 0xde, 0xe0, 0x5d, 0x97, 0x22, 0x1e, 0x00, 0x2e,
+	0x48, 0x7d, 0x06, 0x0e, 0xdc, 0xe0, 0x0d, 0xb8, 0xf7, 0xd8, 0x23, 0x17, 0xfe, 0xa8, 0x7d, 0x11,
+	0xb4, 0xbb, 0xb6, 0xe3, 0xa6, 0xa1, 0xad, 0x11, 0x12, 0x37, 0xef, 0xcc, 0xfc, 0x66, 0x7e, 0xfb,
+	0x9b, 0x99, 0x4d, 0xa0, 0xe9, 0xbc, 0x72, 0xf6, 0x06, 0x3e, 0xe5, 0xd4, 0xa6, 0xbd, 0xa6, 0x63,
+	0x91, 0x3e, 0xf5, 0x58, 0x73, 0xe0, 0xbb, 0x36, 0xd9, 0x26, 0xc4, 0x51, 0x5f, 0x1d, 0xf1, 0x69,
+	0xc8, 0x28, 0x5c, 0x4d, 0x02, 0x8c, 0x10, 0x60, 0xc4, 0x80, 0x4a, 0xb9, 0x4b, 0xbb, 0x54, 0xfa,
+	0x9b, 0xe2, 0x4b, 0xa1, 0x2a, 0xb5, 0x2e, 0xa5, 0xdd, 0x1e, 0x69, 0xca, 0xd3, 0x56, 0xb0, 0xdd,
+	0xe4, 0x6e, 0x9f, 0x30, 0x6e, 0xf5, 0x07, 0x2a, 0x40, 0xff, 0x8a, 0x60, 0xe1, 0xd1, 0xc0, 0xb1,
+	0x38, 0xb9, 0x67, 0xf9, 0xcf, 0x09, 0xdf, 0x10, 0x09, 0x99, 0x49, 0x5e, 0x04, 0x84, 0x71, 0x6c,
+	0x43, 0xb9, 0x2f, 0xcd, 0x1d, 0xc5, 0x27, 0x90, 0x91, 0x4c, 0x43, 0xf5, 0x89, 0xc6, 0x4c, 0xeb,
+	0x9a, 0x71, 0x36, 0x27, 0x23, 0x91, 0x52, 0xd5, 0x30, 0x71, 0x7f, 0xd4, 0xc4, 0xf0, 0x33, 0x28,
+	0x91, 0x3d, 0x7b, 0xc7, 0xf2, 0xba, 0xa4, 0xc3, 0xb8, 0xc5, 0x03, 0x46, 0x98, 0x96, 0x95, 0x15,
+	0x8c, 0xf3, 0x2a, 0xb4, 0x43, 0xe0, 0xa6, 0xc4, 0x99, 0x45, 0x72, 0xe2, 0x4c, 0x98, 0x7e, 0x19,
+	0x2a, 0xe3, 0xae, 0xc7, 0x06, 0xd4, 0x63, 0x44, 0xff, 0x84, 0xa0, 0x10, 0xa5, 0x90, 0x2e, 0x5c,
+	0x83, 0x99, 0x98, 0x8c, 0xeb, 0x68, 0xa8, 0x8e, 0x1a, 0x79, 0x13, 0x22, 0xd3, 0xba, 0x83, 0xcb,
+	0x30, 0x29, 0xcb, 0x6b, 0xd9, 0x3a, 0x6a, 0xe4, 0x4c, 0x75, 0xc0, 0xf7, 0xa1, 0xd8, 0xb3, 0x18,
+	0x0f, 0x05, 0xea, 0x08, 0x95, 0xb5, 0x89, 0x3a, 0x6a, 0xcc, 0xb4, 0x2a, 0x86, 0x6a, 0x81, 0x11,
+	0xb5, 0xc0, 0x78, 0x18, 0xb5, 0x60, 0x6d, 0xfa, 0xe0, 0x5b, 0x0d, 0xed, 0x7f, 0xaf, 0x21, 0x73,
+	0x4e, 0xa0, 0x15, 0x51, 0xe1, 0xc6, 0xff, 0xc1, 0xd4, 0x2e, 0xed, 0x05, 0x7d, 0xa2, 0xe5, 0x64,
+	0x99, 0xf0, 0xa4, 0xbf, 0x45, 0x50, 0x3a, 0xa5, 0x2a, 0xbe, 0x04, 0xf9, 0xb0, 0x4d, 0x21, 0xe5,
+	0x82, 0x39, 0xad, 0x0c, 0xeb, 0x0e, 0x7e, 0x0c, 0xf3, 0xf1, 0x8d, 0x24, 0xd9, 0x48, 0xdc, 0xa5,
+	0x8b, 0x8a, 0x2b, 0x4b, 0x99, 0x73, 0x24, 0x79, 0x64, 0xfa, 0xe7, 0x2c, 0xcc, 0x9d, 0x94, 0xff,
+	0x7c, 0xf1, 0x44, 0x80, 0xef, 0x53, 0xbf, 0x63, 0xd3, 0xc0, 0xe3, 0xa1, 0x84, 0x20, 0x4d, 0xb7,
+	0x85, 0x05, 0x6f, 0x40, 0x49, 0xea, 0xc8, 0x02, 0xdb, 0x26, 0x8c, 0xa5, 0x17, 0x72, 0x5e, 0xc0,
+	0x37, 0x15, 0x5a, 0x2a, 0x79, 0x17, 0xa4, 0xa9, 0xa3, 0xea, 0x72, 0x37, 0x94, 0xf4, 0xa2, 0xf9,
+	0x0a, 0x02, 0xdc, 0x16, 0x58, 0x99, 0xed, 0x0a, 0x94, 0x12, 0xd9, 0x7c, 0x62, 0x31, 0xea, 0x69,
+	0x93, 0xf2, 0x9e, 0xf3, 0x71, 0xa4, 0x29, 0xcd, 0x78, 0x11, 0x60, 0x18, 0xab, 0x4d, 0xc9, 0xa0,
+	0x7c, 0x1c, 0xa4, 0xaf, 0xc0, 0x3f, 0xe3, 0x56, 0x6e, 0x11, 0x20, 0xee, 0xa5, 0x5a, 0xb4, 0x82,
+	0x99, 0x8f, 0x9a, 0xc9, 0x74, 0x17, 0xca, 0xe3, 0x26, 0x19, 0x3f, 0x80, 0x42, 0x72, 0x53, 0xa3,
+	0x15, 0xbd, 0x9a, 0x62, 0x45, 0x99, 0x39, 0x9b, 0xd8, 0x4e, 0xa6, 0x7f, 0xcc, 0xc2, 0x6c, 0xd2,
+	0xfd, 0x57, 0xc6, 0x4c, 0x8c, 0x8c, 0xeb, 0x39, 0x64, 0x4f, 0x25, 0x95, 0xb3, 0x90, 0x33, 0x41,
+	0x9a, 0xd4, 0xc6, 0xae, 0x82, 0x96, 0x08, 0xe8, 0x24, 0x06, 0x90, 0x69, 0xb9, 0xfa, 0x44, 0x23,
+	0x6f, 0xfe, 0x3b, 0x8c, 0x6e, 0xc7, 0xb3, 0xc8, 0xc4, 0xce, 0x26, 0x81, 0x72, 0x34, 0x26, 0xd3,
+	0xec, 0xec, 0x30, 0xad, 0x70, 0xeb, 0x0b, 0xf0, 0x7f, 0x7b, 0xe4, 0xf9, 0x09, 0x9b, 0xaa, 0xbf,
+	0x04, 0xed, 0xb4, 0x2b, 0xec, 0xdc, 0xd8, 0xe7, 0x0f, 0xfd, 0x99, 0xe7, 0xaf, 0xf5, 0x1e, 0x41,
+	0x51, 0x32, 0xbc, 0x43, 0x88, 0xb3, 0x49, 0xfc, 0x5d, 0xa1, 0xd8, 0x3b, 0x04, 0xf8, 0xf4, 0xa3,
+	0x88, 0x6f, 0x9c, 0x57, 0xed, 0x97, 0xbf, 0x13, 0x95, 0x9b, 0xbf, 0x03, 0x0d, 0xdf, 0xe0, 0x4c,
+	0xeb, 0x43, 0x16, 0x16, 0x63, 0x92, 0xeb, 0x1e, 0xf7, 0x29, 0x1b, 0x10, 0x9b, 0xbb, 0xd4, 0x8b,
+	0x18, 0xbf, 0x1e, 0x99, 0xc4, 0xe5, 0x54, 0x63, 0x1d, 0x92, 0x5c, 0x49, 0x07, 0x8a, 0xe8, 0xe1,
+	0x37, 0x08, 0x8a, 0xa3, 0xdd, 0xc3, 0xab, 0xe9, 0x5a, 0x33, 0x64, 0x71, 0x3d, 0x3d, 0x30, 0x62,
+	0xb2, 0xf6, 0xe4, 0xe0, 0xa8, 0x8a, 0x0e, 0x8f, 0xaa, 0xe8, 0xc7, 0x51, 0x15, 0xed, 0x1f, 0x57,
+	0x33, 0x87, 0xc7, 0xd5, 0xcc, 0x97, 0xe3, 0x6a, 0xe6, 0xe9, 0xad, 0xae, 0xcb, 0x77, 0x82, 0x2d,
+	0xc3, 0xa6, 0xfd, 0x93, 0xff, 0x2c, 0x76, 0x57, 0x96, 0xec, 0x1d, 0xcb, 0xf5, 0x9a, 0x67, 0xfc,
+	0xd7, 0xb0, 0x06, 0xee, 0xd6, 0x94, 0xf4, 0x2f, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x54,
+	0xd0, 0xf9, 0x98, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -353,55 +747,185 @@ var _PriceFeedService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "dydxprotocol/daemons/pricefeed/price_feed.proto",
 }
 
-func (m *UpdateMarketPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// PriceFeedIntrospectionServiceClient is the client API for PriceFeedIntrospectionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PriceFeedIntrospectionServiceClient interface {
+	// Returns the price last reported by each exchange for each market, along
+	// with the most recent index price of each market and the exchanges it was
+	// computed from.
+	MarketPrices(ctx context.Context, in *MarketPricesRequest, opts ...grpc.CallOption) (*MarketPricesResponse, error)
+	// Returns the health of each exchange queried by the pricefeed daemon.
+	ExchangeStatuses(ctx context.Context, in *ExchangeStatusesRequest, opts ...grpc.CallOption) (*ExchangeStatusesResponse, error)
 }
 
-func (m *UpdateMarketPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type priceFeedIntrospectionServiceClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *UpdateMarketPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarketPriceUpdates) > 0 {
-		for iNdEx := len(m.MarketPriceUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MarketPriceUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPriceFeed(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func NewPriceFeedIntrospectionServiceClient(cc grpc1.ClientConn) PriceFeedIntrospectionServiceClient {
+	return &priceFeedIntrospectionServiceClient{cc}
+}
+
+func (c *priceFeedIntrospectionServiceClient) MarketPrices(ctx context.Context, in *MarketPricesRequest, opts ...grpc.CallOption) (*MarketPricesResponse, error) {
+	out := new(MarketPricesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.daemons.pricefeed.PriceFeedIntrospectionService/MarketPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateMarketPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *priceFeedIntrospectionServiceClient) ExchangeStatuses(ctx context.Context, in *ExchangeStatusesRequest, opts ...grpc.CallOption) (*ExchangeStatusesResponse, error) {
+	out := new(ExchangeStatusesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.daemons.pricefeed.PriceFeedIntrospectionService/ExchangeStatuses", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UpdateMarketPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+// PriceFeedIntrospectionServiceServer is the server API for PriceFeedIntrospectionService service.
+type PriceFeedIntrospectionServiceServer interface {
+	// Returns the price last reported by each exchange for each market, along
+	// with the most recent index price of each market and the exchanges it was
+	// computed from.
+	MarketPrices(context.Context, *MarketPricesRequest) (*MarketPricesResponse, error)
+	// Returns the health of each exchange queried by the pricefeed daemon.
+	ExchangeStatuses(context.Context, *ExchangeStatusesRequest) (*ExchangeStatusesResponse, error)
+}
+
+// UnimplementedPriceFeedIntrospectionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPriceFeedIntrospectionServiceServer struct {
+}
+
+func (*UnimplementedPriceFeedIntrospectionServiceServer) MarketPrices(ctx context.Context, req *MarketPricesRequest) (*MarketPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketPrices not implemented")
+}
+func (*UnimplementedPriceFeedIntrospectionServiceServer) ExchangeStatuses(ctx context.Context, req *ExchangeStatusesRequest) (*ExchangeStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeStatuses not implemented")
+}
+
+func RegisterPriceFeedIntrospectionServiceServer(s grpc1.Server, srv PriceFeedIntrospectionServiceServer) {
+	s.RegisterService(&_PriceFeedIntrospectionService_serviceDesc, srv)
+}
+
+func _PriceFeedIntrospectionService_MarketPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceFeedIntrospectionServiceServer).MarketPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.daemons.pricefeed.PriceFeedIntrospectionService/MarketPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceFeedIntrospectionServiceServer).MarketPrices(ctx, req.(*MarketPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceFeedIntrospectionService_ExchangeStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceFeedIntrospectionServiceServer).ExchangeStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.daemons.pricefeed.PriceFeedIntrospectionService/ExchangeStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceFeedIntrospectionServiceServer).ExchangeStatuses(ctx, req.(*ExchangeStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PriceFeedIntrospectionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.daemons.pricefeed.PriceFeedIntrospectionService",
+	HandlerType: (*PriceFeedIntrospectionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MarketPrices",
+			Handler:    _PriceFeedIntrospectionService_MarketPrices_Handler,
+		},
+		{
+			MethodName: "ExchangeStatuses",
+			Handler:    _PriceFeedIntrospectionService_ExchangeStatuses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/daemons/pricefeed/price_feed.proto",
+}
+
+func (m *UpdateMarketPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMarketPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateMarketPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeStatuses) > 0 {
+		for iNdEx := len(m.ExchangeStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MarketPriceUpdates) > 0 {
+		for iNdEx := len(m.MarketPriceUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketPriceUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateMarketPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMarketPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -433,6 +957,11 @@ func (m *ExchangePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Volume != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LastUpdateTime != nil {
 		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime):])
 		if err1 != nil {
@@ -500,86 +1029,1150 @@ func (m *MarketPriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPriceFeed(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPriceFeed(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExchangeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *UpdateMarketPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExchangeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MarketPriceUpdates) > 0 {
-		for _, e := range m.MarketPriceUpdates {
-			l = e.Size()
-			n += 1 + l + sovPriceFeed(uint64(l))
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastErrorReason) > 0 {
+		i -= len(m.LastErrorReason)
+		copy(dAtA[i:], m.LastErrorReason)
+		i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.LastErrorReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastErrorTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastErrorTime):])
+		if err2 != nil {
+			return 0, err2
 		}
+		i -= n2
+		i = encodeVarintPriceFeed(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.LastSuccessTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastSuccessTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSuccessTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintPriceFeed(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ErrorCount != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.ErrorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ExchangeId) > 0 {
+		i -= len(m.ExchangeId)
+		copy(dAtA[i:], m.ExchangeId)
+		i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.ExchangeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateMarketPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MarketPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.MarketIds) > 0 {
+		dAtA5 := make([]byte, len(m.MarketIds)*10)
+		var j4 int
+		for _, num := range m.MarketIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintPriceFeed(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExchangePrice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MarketPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExchangeId)
-	if l > 0 {
-		n += 1 + l + sovPriceFeed(uint64(l))
-	}
-	if m.Price != 0 {
-		n += 1 + sovPriceFeed(uint64(m.Price))
-	}
-	if m.LastUpdateTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime)
-		n += 1 + l + sovPriceFeed(uint64(l))
+	if len(m.MarketPrices) > 0 {
+		for iNdEx := len(m.MarketPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MarketPriceUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MarketPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovPriceFeed(uint64(m.MarketId))
+	if m.IndexPriceTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.IndexPriceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.IndexPriceTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintPriceFeed(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IndexPriceExchangeIds) > 0 {
+		for iNdEx := len(m.IndexPriceExchangeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexPriceExchangeIds[iNdEx])
+			copy(dAtA[i:], m.IndexPriceExchangeIds[iNdEx])
+			i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.IndexPriceExchangeIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IndexPrice != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.IndexPrice))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.ExchangePrices) > 0 {
-		for _, e := range m.ExchangePrices {
-			l = e.Size()
-			n += 1 + l + sovPriceFeed(uint64(l))
+		for iNdEx := len(m.ExchangePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeStatuses) > 0 {
+		for iNdEx := len(m.ExchangeStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceFeed(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceFeed(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateMarketPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketPriceUpdates) > 0 {
+		for _, e := range m.MarketPriceUpdates {
+			l = e.Size()
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	if len(m.ExchangeStatuses) > 0 {
+		for _, e := range m.ExchangeStatuses {
+			l = e.Size()
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateMarketPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExchangeId)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovPriceFeed(uint64(m.Price))
+	}
+	if m.LastUpdateTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime)
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovPriceFeed(uint64(m.Volume))
+	}
+	return n
+}
+
+func (m *MarketPriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovPriceFeed(uint64(m.MarketId))
+	}
+	if len(m.ExchangePrices) > 0 {
+		for _, e := range m.ExchangePrices {
+			l = e.Size()
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExchangeStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExchangeId)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	if m.ErrorCount != 0 {
+		n += 1 + sovPriceFeed(uint64(m.ErrorCount))
+	}
+	if m.LastSuccessTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSuccessTime)
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	if m.LastErrorTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastErrorTime)
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	l = len(m.LastErrorReason)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketIds) > 0 {
+		l = 0
+		for _, e := range m.MarketIds {
+			l += sovPriceFeed(uint64(e))
+		}
+		n += 1 + sovPriceFeed(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MarketPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketPrices) > 0 {
+		for _, e := range m.MarketPrices {
+			l = e.Size()
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovPriceFeed(uint64(m.MarketId))
+	}
+	if len(m.ExchangePrices) > 0 {
+		for _, e := range m.ExchangePrices {
+			l = e.Size()
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	if m.IndexPrice != 0 {
+		n += 1 + sovPriceFeed(uint64(m.IndexPrice))
+	}
+	if len(m.IndexPriceExchangeIds) > 0 {
+		for _, s := range m.IndexPriceExchangeIds {
+			l = len(s)
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	if m.IndexPriceTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.IndexPriceTime)
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangeStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeStatuses) > 0 {
+		for _, e := range m.ExchangeStatuses {
+			l = e.Size()
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPriceFeed(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceFeed(x uint64) (n int) {
+	return sovPriceFeed(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateMarketPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMarketPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMarketPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPriceUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketPriceUpdates = append(m.MarketPriceUpdates, &MarketPriceUpdate{})
+			if err := m.MarketPriceUpdates[len(m.MarketPriceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeStatuses = append(m.ExchangeStatuses, &ExchangeStatus{})
+			if err := m.ExchangeStatuses[len(m.ExchangeStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateMarketPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMarketPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMarketPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketPriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketPriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketPriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangePrices = append(m.ExchangePrices, &ExchangePrice{})
+			if err := m.ExchangePrices[len(m.ExchangePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
+			}
+			m.ErrorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSuccessTime == nil {
+				m.LastSuccessTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastSuccessTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastErrorTime == nil {
+				m.LastErrorTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastErrorTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastErrorReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovPriceFeed(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPriceFeed(x uint64) (n int) {
-	return sovPriceFeed(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UpdateMarketPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -602,46 +2195,88 @@ func (m *UpdateMarketPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateMarketPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateMarketPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketPriceUpdates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPriceFeed
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPriceFeed
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.MarketIds = append(m.MarketIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPriceFeed
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPriceFeed
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPriceFeed
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.MarketIds) == 0 {
+					m.MarketIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPriceFeed
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MarketIds = append(m.MarketIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPriceFeed
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPriceFeed
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketPriceUpdates = append(m.MarketPriceUpdates, &MarketPriceUpdate{})
-			if err := m.MarketPriceUpdates[len(m.MarketPriceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPriceFeed(dAtA[iNdEx:])
@@ -663,7 +2298,7 @@ func (m *UpdateMarketPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateMarketPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -686,12 +2321,46 @@ func (m *UpdateMarketPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateMarketPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateMarketPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketPrices = append(m.MarketPrices, &MarketPrices{})
+			if err := m.MarketPrices[len(m.MarketPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPriceFeed(dAtA[iNdEx:])
@@ -713,7 +2382,7 @@ func (m *UpdateMarketPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
+func (m *MarketPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -736,17 +2405,36 @@ func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangePrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangePrices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPriceFeed
@@ -756,29 +2444,31 @@ func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPriceFeed
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPriceFeed
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeId = string(dAtA[iNdEx:postIndex])
+			m.ExchangePrices = append(m.ExchangePrices, &ExchangePrice{})
+			if err := m.ExchangePrices[len(m.ExchangePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
 			}
-			m.Price = 0
+			m.IndexPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPriceFeed
@@ -788,14 +2478,46 @@ func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.IndexPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPriceExchangeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexPriceExchangeIds = append(m.IndexPriceExchangeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPriceTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -822,10 +2544,10 @@ func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastUpdateTime == nil {
-				m.LastUpdateTime = new(time.Time)
+			if m.IndexPriceTime == nil {
+				m.IndexPriceTime = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.IndexPriceTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -850,7 +2572,7 @@ func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketPriceUpdate) Unmarshal(dAtA []byte) error {
+func (m *ExchangeStatusesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -873,34 +2595,65 @@ func (m *MarketPriceUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketPriceUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeStatusesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketPriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPriceFeed
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
 			}
-		case 2:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangePrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -927,8 +2680,8 @@ func (m *MarketPriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangePrices = append(m.ExchangePrices, &ExchangePrice{})
-			if err := m.ExchangePrices[len(m.ExchangePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExchangeStatuses = append(m.ExchangeStatuses, &ExchangeStatus{})
+			if err := m.ExchangeStatuses[len(m.ExchangeStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -71,10 +71,18 @@ func (c *Client) newTickerWithStop(intervalMs int) (*time.Ticker, <-chan bool) {
 	ticker := time.NewTicker(time.Duration(intervalMs) * time.Millisecond)
 	c.tickers = append(c.tickers, ticker)
 
+	return ticker, c.newStop()
+}
+
+// newStop creates a new stop channel for any subtask kicked off by the client that does not run on a ticker. The
+// channel is tracked in order to send the stop signal when the daemon is stopped.
+// Note: this method is not synchronized. It is expected to be called from the client's `StartNewClient` method before
+// `client.CompleteStartup`.
+func (c *Client) newStop() <-chan bool {
 	stop := make(chan bool)
 	c.stops = append(c.stops, stop)
 
-	return ticker, stop
+	return stop
 }
 
 // Stop stops the daemon and all running subtasks. This method is synchronized by the daemonStartup WaitGroup.
@@ -110,7 +118,8 @@ func (c *Client) Stop() {
 //  2. Validate daemon configuration.
 //  3. Initialize synchronized, in-memory shared daemon configuration.
 //  4. Start PriceEncoder and PriceFetcher per exchange. Each price fetcher adds itself to the shared
-//     daemon config.
+//     daemon config. If WebSocket streaming is enabled, exchanges that support streaming are streamed by a
+//     PriceStreamer in place of the PriceFetcher.
 //  5. Start MarketUpdater subtask to periodically update the market configs.
 //  6. Start PriceUpdater to begin broadcasting prices.
 func (c *Client) start(ctx context.Context,
@@ -173,7 +182,7 @@ func (c *Client) start(ctx context.Context,
 		return err
 	}
 
-	// 4. Start PriceEncoder and PriceFetcher per exchange.
+	// 4. Start PriceEncoder and PriceFetcher or PriceStreamer per exchange.
 	timeProvider := &libtime.TimeProviderImpl{}
 	for _exchangeId := range exchangeIdToQueryConfig {
 		// Assign these within the loop to avoid unexpected values being passed to the goroutines.
@@ -202,6 +211,26 @@ func (c *Client) start(ctx context.Context,
 			)
 		}()
 
+		// Stream prices from the exchange if streaming is enabled and supported by the exchange. Otherwise,
+		// periodically poll the exchange for prices.
+		if daemonFlags.Price.WebsocketEnabled && exchangeDetails.SupportsStreaming() {
+			stop := c.newStop()
+			c.runningSubtasksWaitGroup.Add(1)
+			go func() {
+				defer c.runningSubtasksWaitGroup.Done()
+				subTaskRunner.StartPriceStreamer(
+					stop,
+					priceFeedMutableMarketConfigs,
+					*exchangeConfig,
+					exchangeDetails,
+					timeProvider,
+					logger,
+					bCh,
+				)
+			}()
+			continue
+		}
+
 		ticker, stop := c.newTickerWithStop(int(exchangeConfig.IntervalMs))
 		c.runningSubtasksWaitGroup.Add(1)
 		go func() {
@@ -278,7 +278,10 @@ func (s *PriceDaemonIntegrationTestSuite) SetupTest() {
 		&daemontypes.FileHandlerImpl{},
 		s.daemonFlags.Shared.SocketAddress,
 	)
-	s.daemonServer.ExpectPricefeedDaemon(servertypes.MaximumAcceptableUpdateDelay(s.daemonFlags.Price.LoopDelayMs))
+	s.daemonServer.ExpectPricefeedDaemon(
+		servertypes.MaximumAcceptableUpdateDelay(s.daemonFlags.Price.LoopDelayMs),
+		servertypes.StalenessPolicy(s.daemonFlags.Price.StalenessPolicy),
+	)
 	s.exchangePriceCache = pricefeedserver_types.NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
 	s.daemonServer.WithPriceFeedMarketToExchangePrices(s.exchangePriceCache)
 
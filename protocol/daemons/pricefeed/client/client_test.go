@@ -11,6 +11,8 @@ import (
 	daemonserver "github.com/dydxprotocol/v4-chain/protocol/daemons/server"
 	pricefeed_types "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/pricefeed"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/appoptions"
 	grpc_util "github.com/dydxprotocol/v4-chain/protocol/testutil/grpc"
 	pricetypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -46,6 +48,7 @@ type FakeSubTaskRunner struct {
 	UpdaterCallCount       int
 	EncoderCallCount       int
 	FetcherCallCount       int
+	StreamerCallCount      int
 	MarketUpdaterCallCount int
 }
 
@@ -99,6 +102,25 @@ func (f *FakeSubTaskRunner) StartPriceFetcher(
 	f.Done()
 }
 
+// StartPriceStreamer replaces `client.StartPriceStreamer`, marks the embedded waitgroup done and
+// advances `StreamerCallCount` by one. This function will be called from a go-routine and is
+// threadsafe.
+func (f *FakeSubTaskRunner) StartPriceStreamer(
+	stop <-chan bool,
+	configs types.PricefeedMutableMarketConfigs,
+	exchangeQueryConfig types.ExchangeQueryConfig,
+	exchangeDetails types.ExchangeQueryDetails,
+	timeProvider libtime.TimeProvider,
+	logger log.Logger,
+	bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
+) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.StreamerCallCount += 1
+	f.Done()
+}
+
 func (f *FakeSubTaskRunner) StartMarketParamUpdater(
 	ctx context.Context,
 	ticker *time.Ticker,
@@ -286,6 +308,46 @@ func TestStart_InvalidConfig(t *testing.T) {
 	}
 }
 
+func TestStart_WebsocketEnabled(t *testing.T) {
+	mockGrpcClient := grpc_util.GenerateMockGrpcClientWithOptionalGrpcConnectionErrors(nil, nil, true)
+
+	// Only the first exchange supports streaming.
+	streamingExchangeDetails := constants.TestExchangeIdToExchangeQueryDetails[constants.ExchangeId1]
+	streamingExchangeDetails.Websocket = &types.WebsocketDetails{}
+	exchangeIdToExchangeDetails := map[types.ExchangeId]types.ExchangeQueryDetails{
+		constants.ExchangeId1: streamingExchangeDetails,
+		constants.ExchangeId2: constants.TestExchangeIdToExchangeQueryDetails[constants.ExchangeId2],
+	}
+
+	daemonFlags := daemonflags.GetDefaultDaemonFlags()
+	daemonFlags.Price.WebsocketEnabled = true
+
+	faketaskRunner := FakeSubTaskRunner{}
+
+	// Wait for each encoder, fetcher and streamer call to complete.
+	faketaskRunner.WaitGroup.Add(testExchangeQueryConfigLength * 2)
+
+	client := newClient()
+	err := client.start(
+		grpc_util.Ctx,
+		daemonFlags,
+		appflags.GetFlagValuesFromOptions(appoptions.GetDefaultTestAppOptions("", nil)),
+		log.NewNopLogger(),
+		mockGrpcClient,
+		constants.TestExchangeQueryConfigs,
+		exchangeIdToExchangeDetails,
+		&faketaskRunner,
+	)
+	require.NoError(t, err)
+
+	// Verify that the exchange that supports streaming is streamed and the other exchange is polled.
+	faketaskRunner.Wait()
+	require.Equal(t, testExchangeQueryConfigLength, faketaskRunner.EncoderCallCount)
+	require.Equal(t, 1, faketaskRunner.StreamerCallCount)
+	require.Equal(t, 1, faketaskRunner.FetcherCallCount)
+	require.Equal(t, 1, faketaskRunner.UpdaterCallCount)
+}
+
 // TestStop tests that the Stop interface works as expected. It's difficult to ensure that each go-routine
 // is stopped, but this test ensures that the Stop executes successfully with no hangs.
 func TestStop(t *testing.T) {
@@ -721,6 +783,58 @@ func TestPriceUpdater_Mixed(t *testing.T) {
 	}
 }
 
+// TestPriceUpdater_SendsExchangeStatuses tests that `RunPriceUpdaterTaskLoop` sends the status of each exchange,
+// sorted by exchange id, alongside the price updates.
+func TestPriceUpdater_SendsExchangeStatuses(t *testing.T) {
+	etmp, _ := types.NewExchangeToMarketPrices(
+		[]types.ExchangeId{
+			constants.ExchangeId2,
+			constants.ExchangeId1,
+		},
+	)
+	etmp.UpdatePrice(constants.ExchangeId1, constants.Market9_TimeT_Price1)
+	etmp.RecordExchangeError(constants.ExchangeId2, metrics.RateLimit, errors.New("rate limited"))
+
+	mockPriceFeedClient := generateMockQueryClient()
+	mockPriceFeedClient.On("UpdateMarketPrices", grpc_util.Ctx, mock.Anything).
+		Return(nil, nil)
+
+	err := RunPriceUpdaterTaskLoop(
+		grpc_util.Ctx,
+		etmp,
+		mockPriceFeedClient,
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	mockPriceFeedClient.AssertCalled(
+		t,
+		"UpdateMarketPrices",
+		grpc_util.Ctx,
+		mock.MatchedBy(func(i interface{}) bool {
+			statuses := i.(*api.UpdateMarketPricesRequest).ExchangeStatuses
+			require.Len(t, statuses, 2)
+
+			require.Equal(
+				t,
+				&api.ExchangeStatus{
+					ExchangeId:      constants.ExchangeId1,
+					LastSuccessTime: &constants.TimeT,
+				},
+				statuses[0],
+			)
+
+			require.Equal(t, constants.ExchangeId2, statuses[1].ExchangeId)
+			require.Equal(t, uint64(1), statuses[1].ErrorCount)
+			require.Nil(t, statuses[1].LastSuccessTime)
+			require.NotNil(t, statuses[1].LastErrorTime)
+			require.Equal(t, metrics.RateLimit, statuses[1].LastErrorReason)
+			require.Equal(t, "rate limited", statuses[1].LastError)
+			return true
+		}),
+	)
+}
+
 // TestMarketUpdater_Mixed tests the `RunMarketParamUpdaterTaskLoop` function invokes the grpc
 // query to the prices query client and that if the query succeeds, the config is updated.
 func TestMarketUpdater_Mixed(t *testing.T) {
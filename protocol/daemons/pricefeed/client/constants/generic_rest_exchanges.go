@@ -0,0 +1,53 @@
+package constants
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/generic_rest"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+)
+
+const (
+	// NumGenericRestExchanges is the number of generic REST exchanges queried by the price daemon, with ids
+	// `GenericRest1` through `GenericRest<n>`. It is the same for every validator, so that every price daemon
+	// accepts the same exchange config json and queries the same exchanges for each market.
+	NumGenericRestExchanges = 3
+)
+
+// WithGenericRestExchanges returns copies of the exchange query configs and exchange details with
+// `numGenericRestExchanges` generic REST exchanges added, with ids `GenericRest1` through `GenericRest<n>`.
+// Generic REST exchanges query each market individually, and rate limits depend on the exchanges configured
+// for each market, so they use the default query config.
+func WithGenericRestExchanges(
+	exchangeIdToQueryConfig map[types.ExchangeId]*types.ExchangeQueryConfig,
+	exchangeIdToExchangeDetails map[types.ExchangeId]types.ExchangeQueryDetails,
+	numGenericRestExchanges uint32,
+) (
+	map[types.ExchangeId]*types.ExchangeQueryConfig,
+	map[types.ExchangeId]types.ExchangeQueryDetails,
+) {
+	queryConfigs := make(
+		map[types.ExchangeId]*types.ExchangeQueryConfig,
+		len(exchangeIdToQueryConfig)+int(numGenericRestExchanges),
+	)
+	for exchangeId, queryConfig := range exchangeIdToQueryConfig {
+		queryConfigs[exchangeId] = queryConfig
+	}
+	exchangeDetails := make(
+		map[types.ExchangeId]types.ExchangeQueryDetails,
+		len(exchangeIdToExchangeDetails)+int(numGenericRestExchanges),
+	)
+	for exchangeId, details := range exchangeIdToExchangeDetails {
+		exchangeDetails[exchangeId] = details
+	}
+
+	for i := uint32(1); i <= numGenericRestExchanges; i++ {
+		exchangeId := types.GetGenericRestExchangeId(i)
+		queryConfigs[exchangeId] = &types.ExchangeQueryConfig{
+			ExchangeId: exchangeId,
+			IntervalMs: defaultIntervalMs,
+			TimeoutMs:  defaultTimeoutMs,
+			MaxQueries: defaultMaxQueries,
+		}
+		exchangeDetails[exchangeId] = generic_rest.NewGenericRestDetails(exchangeId)
+	}
+	return queryConfigs, exchangeDetails
+}
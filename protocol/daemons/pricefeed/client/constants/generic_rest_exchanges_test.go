@@ -0,0 +1,55 @@
+package constants_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants/exchange_common"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/generic_rest"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithGenericRestExchanges(t *testing.T) {
+	queryConfigs, exchangeDetails := constants.WithGenericRestExchanges(
+		constants.StaticExchangeQueryConfig,
+		constants.StaticExchangeDetails,
+		5,
+	)
+
+	require.Len(t, queryConfigs, len(constants.StaticExchangeQueryConfig)+5)
+	require.Len(t, exchangeDetails, len(constants.StaticExchangeDetails)+5)
+	for _, exchangeId := range []types.ExchangeId{"GenericRest1", "GenericRest3", "GenericRest5"} {
+		require.Equal(
+			t,
+			&types.ExchangeQueryConfig{
+				ExchangeId: exchangeId,
+				IntervalMs: 2_000,
+				TimeoutMs:  3_000,
+				MaxQueries: 3,
+			},
+			queryConfigs[exchangeId],
+		)
+		require.Equal(t, generic_rest.NewGenericRestDetails(exchangeId), exchangeDetails[exchangeId])
+	}
+	require.NotContains(t, queryConfigs, "GenericRest6")
+	require.Equal(
+		t,
+		constants.StaticExchangeQueryConfig[exchange_common.EXCHANGE_ID_BINANCE],
+		queryConfigs[exchange_common.EXCHANGE_ID_BINANCE],
+	)
+
+	// The static maps are not modified.
+	require.NotContains(t, constants.StaticExchangeQueryConfig, "GenericRest1")
+	require.NotContains(t, constants.StaticExchangeDetails, "GenericRest1")
+}
+
+func TestWithGenericRestExchanges_None(t *testing.T) {
+	queryConfigs, exchangeDetails := constants.WithGenericRestExchanges(
+		constants.StaticExchangeQueryConfig,
+		constants.StaticExchangeDetails,
+		0,
+	)
+	require.Equal(t, constants.StaticExchangeQueryConfig, queryConfigs)
+	require.Len(t, exchangeDetails, len(constants.StaticExchangeDetails))
+}
@@ -15,6 +15,7 @@ const (
 	// Module and Submodule names are used to provide consistent key-value pairs for logging across the daemon.
 	PricefeedDaemonModuleName       = "pricefeed-daemon"
 	PriceFetcherSubmoduleName       = "price-fetcher"
+	PriceStreamerSubmoduleName      = "price-streamer"
 	PriceEncoderSubmoduleName       = "price-encoder"
 	PriceUpdaterSubmoduleName       = "price-updater"
 	MarketParamUpdaterSubmoduleName = "market-param-updater"
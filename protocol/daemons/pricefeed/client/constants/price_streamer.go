@@ -0,0 +1,15 @@
+package constants
+
+import "time"
+
+const (
+	// WebsocketInitialReconnectBackoff is the delay before the first attempt to reconnect to an exchange's
+	// WebSocket endpoint after the connection fails. The delay doubles after each consecutive failure.
+	WebsocketInitialReconnectBackoff = 500 * time.Millisecond
+	// WebsocketMaxReconnectBackoff is the maximum delay between attempts to reconnect to an exchange's
+	// WebSocket endpoint.
+	WebsocketMaxReconnectBackoff = 30 * time.Second
+	// WebsocketReadTimeout is the maximum time to wait for a message from an exchange's WebSocket endpoint before
+	// the connection is considered dead and is re-established.
+	WebsocketReadTimeout = 30 * time.Second
+)
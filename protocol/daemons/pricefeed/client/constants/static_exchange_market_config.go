@@ -50,6 +50,7 @@ func GenerateExchangeConfigJson(
 				ExchangeName: id,
 				Ticker:       config.Ticker,
 				Invert:       config.Invert,
+				GenericRest:  config.GenericRest.Copy(),
 			}
 
 			// Convert adjust-by market id to name if specified.
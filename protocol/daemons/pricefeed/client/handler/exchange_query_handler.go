@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/generic_rest"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
@@ -42,7 +43,8 @@ type ExchangeQueryHandler interface {
 
 // Query makes an API call to a specific exchange and returns the transformed response, including both valid prices
 // and any unavailable markets with specific errors.
-// 1) Validate `marketIds` contains at least one id.
+// 1) Validate `marketIds` contains at least one id. Markets on generic REST exchanges are queried with the
+// details described by their generic REST config.
 // 2) Convert the list of `marketIds` to tickers that are specific for a given exchange. Create a mapping of
 // tickers to price exponents and a reverse mapping of ticker back to `MarketId`.
 // 3) Make API call to an exchange and verify the response status code is not an error status code.
@@ -74,6 +76,28 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 		return nil, nil, errors.New("At least one marketId must be queried")
 	}
 
+	// Markets on generic REST exchanges are queried one at a time, with the url and price function described by
+	// the market's generic REST config.
+	if types.IsGenericRestExchange(exchangeQueryDetails.Exchange) {
+		if len(marketIds) != 1 {
+			return nil, nil, fmt.Errorf(
+				"Generic REST exchange %v must query exactly one market, got: %v",
+				exchangeQueryDetails.Exchange,
+				len(marketIds),
+			)
+		}
+		if config, ok := exchangeConfig.MarketToMarketConfig[marketIds[0]]; ok {
+			if config.GenericRest == nil {
+				return nil, nil, fmt.Errorf("No generic REST config for market: %v", marketIds[0])
+			}
+			marketExchangeQueryDetails := generic_rest.NewMarketExchangeQueryDetails(
+				exchangeQueryDetails.Exchange,
+				*config.GenericRest,
+			)
+			exchangeQueryDetails = &marketExchangeQueryDetails
+		}
+	}
+
 	// 2) Convert the list of `marketIds` to tickers that are specific for a given exchange. Create a mapping
 	// of tickers to price exponents and a reverse mapping of ticker back to `MarketId`.
 	tickers := make([]string, 0, len(marketIds))
@@ -154,7 +178,7 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 	}
 
 	// 4) Transform the API response to market prices, while tracking unavailable tickers.
-	prices, unavailableTickers, err := exchangeQueryDetails.PriceFunction(
+	prices, volumes, unavailableTickers, err := exchangeQueryDetails.PriceFunction(
 		response,
 		tickerToPriceExponent,
 		lib.Median[uint64],
@@ -177,6 +201,7 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 		marketPriceTimestamp := &types.MarketPriceTimestamp{
 			MarketId:      marketId,
 			Price:         price,
+			Volume:        volumes[ticker],
 			LastUpdatedAt: now,
 		}
 
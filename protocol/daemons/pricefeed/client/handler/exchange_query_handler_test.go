@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed/exchange_config"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +27,7 @@ const (
 	failStatus400           = 400
 	failStatus500           = 500
 	dummyPrice              = uint64(1)
+	dummyVolume             = uint64(10)
 	noPriceExponentMarketId = 100000
 	FAKEUSD_ID              = 100001
 	unavailableId           = 100002
@@ -72,7 +75,7 @@ func TestQuery(t *testing.T) {
 			response *http.Response,
 			tickerToPriceExponent map[string]int32,
 			resolver pft.Resolver,
-		) (prices map[string]uint64, unavailable map[string]error, err error)
+		) (prices map[string]uint64, volumes map[string]uint64, unavailable map[string]error, err error)
 		marketIds      []types.MarketId
 		requestHandler *mocks.RequestHandler
 
@@ -94,6 +97,7 @@ func TestQuery(t *testing.T) {
 			expectedPrices: []*types.MarketPriceTimestamp{
 				{
 					Price:         dummyPrice,
+					Volume:        dummyVolume,
 					MarketId:      exchange_config.MARKET_BTC_USD,
 					LastUpdatedAt: lastUpdatedAt,
 				},
@@ -114,11 +118,13 @@ func TestQuery(t *testing.T) {
 			expectedPrices: []*types.MarketPriceTimestamp{
 				{
 					Price:         dummyPrice,
+					Volume:        dummyVolume,
 					MarketId:      exchange_config.MARKET_BTC_USD,
 					LastUpdatedAt: lastUpdatedAt,
 				},
 				{
 					Price:         dummyPrice,
+					Volume:        dummyVolume,
 					MarketId:      exchange_config.MARKET_ETH_USD,
 					LastUpdatedAt: lastUpdatedAt,
 				},
@@ -140,11 +146,13 @@ func TestQuery(t *testing.T) {
 			expectedPrices: []*types.MarketPriceTimestamp{
 				{
 					Price:         dummyPrice,
+					Volume:        dummyVolume,
 					MarketId:      exchange_config.MARKET_BTC_USD,
 					LastUpdatedAt: lastUpdatedAt,
 				},
 				{
 					Price:         dummyPrice,
+					Volume:        dummyVolume,
 					MarketId:      exchange_config.MARKET_ETH_USD,
 					LastUpdatedAt: lastUpdatedAt,
 				},
@@ -291,6 +299,115 @@ func TestQuery(t *testing.T) {
 	}
 }
 
+func TestQuery_GenericRest(t *testing.T) {
+	lastUpdatedAt := time.Unix(0, 0)
+	eqh := ExchangeQueryHandlerImpl{generateMockTimeProvider(lastUpdatedAt)}
+
+	genericRestEqd := &types.ExchangeQueryDetails{
+		Exchange: types.GetGenericRestExchangeId(1),
+	}
+	genericRestEmc := &types.MutableExchangeMarketConfig{
+		Id: types.GetGenericRestExchangeId(1),
+		MarketToMarketConfig: map[types.MarketId]types.MarketConfig{
+			exchange_config.MARKET_BTC_USD: {
+				Ticker: "BTC_USDT",
+				GenericRest: &types.GenericRestConfig{
+					Url:           "https://abc.exchange/api/ticker?symbol=$",
+					TickersPath:   "data",
+					AskPricePath:  "ask",
+					BidPricePath:  "bid",
+					LastPricePath: "last",
+					VolumePath:    "volume",
+				},
+			},
+			exchange_config.MARKET_ETH_USD: {
+				Ticker: "ETH_USDT",
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		// parameters
+		marketIds []types.MarketId
+
+		// expectations
+		expectApiRequest bool
+		expectedPrices   []*types.MarketPriceTimestamp
+		expectedError    error
+	}{
+		"Success": {
+			marketIds:        []types.MarketId{exchange_config.MARKET_BTC_USD},
+			expectApiRequest: true,
+			expectedPrices: []*types.MarketPriceTimestamp{
+				{
+					Price:         uint64(2_794_470_000),
+					Volume:        uint64(1_234),
+					MarketId:      exchange_config.MARKET_BTC_USD,
+					LastUpdatedAt: lastUpdatedAt,
+				},
+			},
+		},
+		"Failure - multiple markets queried": {
+			marketIds: []types.MarketId{exchange_config.MARKET_BTC_USD, exchange_config.MARKET_ETH_USD},
+			expectedError: fmt.Errorf(
+				"Generic REST exchange %v must query exactly one market, got: 2",
+				types.GetGenericRestExchangeId(1),
+			),
+		},
+		"Failure - generic REST config not defined for market": {
+			marketIds: []types.MarketId{exchange_config.MARKET_ETH_USD},
+			expectedError: fmt.Errorf(
+				"No generic REST config for market: %v",
+				exchange_config.MARKET_ETH_USD,
+			),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			requestHandler := &mocks.RequestHandler{}
+			requestHandler.On(
+				"Get",
+				context.Background(),
+				"https://abc.exchange/api/ticker?symbol=BTC_USDT",
+			).Return(
+				&http.Response{
+					StatusCode: successStatus,
+					Body: io.NopCloser(strings.NewReader(
+						`{"data":{"ask":"27944.8","bid":"27944.6","last":"27944.7","volume":"1234.5"}}`,
+					)),
+				},
+				nil,
+			)
+
+			prices, unavailableMarkets, err := eqh.Query(
+				context.Background(),
+				genericRestEqd,
+				genericRestEmc,
+				tc.marketIds,
+				requestHandler,
+				testMarketExponentMap,
+			)
+
+			if tc.expectApiRequest {
+				requestHandler.AssertCalled(t, "Get", context.Background(), mock.Anything)
+			} else {
+				requestHandler.AssertNotCalled(t, "Get")
+			}
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, unavailableMarkets)
+			} else {
+				require.NoError(t, err)
+				require.ElementsMatch(t, tc.expectedPrices, prices)
+				require.Empty(t, unavailableMarkets)
+			}
+		})
+	}
+}
+
 func generateMockTimeProvider(time time.Time) *mocks.TimeProvider {
 	mockTimeProvider := &mocks.TimeProvider{}
 	mockTimeProvider.On("Now").Return(time)
@@ -320,52 +437,56 @@ func priceFunc(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
+) (prices map[string]uint64, volumes map[string]uint64, unavailable map[string]error, err error) {
 	prices = make(map[string]uint64, len(tickerToPriceExponent))
+	volumes = make(map[string]uint64, len(tickerToPriceExponent))
 	for ticker := range tickerToPriceExponent {
 		prices[ticker] = dummyPrice
+		volumes[ticker] = dummyVolume
 	}
-	return prices, nil, nil
+	return prices, volumes, nil, nil
 }
 
 func priceFuncWithInvalidResponse(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
+) (prices map[string]uint64, volumes map[string]uint64, unavailable map[string]error, err error) {
 	prices = make(map[string]uint64, len(tickerToPriceExponent))
 	for range tickerToPriceExponent {
 		prices[noMarketTicker] = dummyPrice
 	}
-	return prices, nil, nil
+	return prices, nil, nil, nil
 }
 
 func priceFuncWithValidAndUnavailableTickers(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
+) (prices map[string]uint64, volumes map[string]uint64, unavailable map[string]error, err error) {
 	prices = make(map[string]uint64, len(tickerToPriceExponent))
+	volumes = make(map[string]uint64, len(tickerToPriceExponent))
 	for ticker := range tickerToPriceExponent {
 		if ticker != unavailableTicker {
 			prices[ticker] = dummyPrice
+			volumes[ticker] = dummyVolume
 		}
 	}
-	return prices, map[string]error{unavailableTicker: tickerNotAvailableError}, nil
+	return prices, volumes, map[string]error{unavailableTicker: tickerNotAvailableError}, nil
 }
 
 func priceFuncReturnsInvalidUnavailableTicker(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
-	return nil, map[string]error{noMarketTicker: tickerNotAvailableError}, nil
+) (prices map[string]uint64, volumes map[string]uint64, unavailable map[string]error, err error) {
+	return nil, nil, map[string]error{noMarketTicker: tickerNotAvailableError}, nil
 }
 
 func priceFuncWithErr(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
-	return nil, nil, priceFuncError
+) (prices map[string]uint64, volumes map[string]uint64, unavailable map[string]error, err error) {
+	return nil, nil, nil, priceFuncError
 }
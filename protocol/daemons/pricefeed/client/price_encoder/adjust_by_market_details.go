@@ -1,6 +1,9 @@
 package price_encoder
 
-import "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
 
 // adjustByMarketDetails contains all information required to find and interpret the adjust-by market's price
 // for the purposes of converting an exchange's raw API response price into a market price.
@@ -8,4 +11,5 @@ type adjustByMarketDetails struct {
 	MarketId     types.MarketId
 	Exponent     types.Exponent
 	MinExchanges uint32
+	Aggregation  pricefeedtypes.AggregationConfig
 }
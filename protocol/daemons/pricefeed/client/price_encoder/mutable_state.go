@@ -56,6 +56,7 @@ func (ms *mutableState) GetPriceConversionDetailsForMarket(id types.MarketId) (p
 			MarketId:     *marketConfig.AdjustByMarket,
 			Exponent:     adjustByMarketConfig.Exponent,
 			MinExchanges: adjustByMarketConfig.MinExchanges,
+			Aggregation:  adjustByMarketConfig.Aggregation,
 		}
 	}
 
@@ -13,7 +13,6 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
 	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
-	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/prices"
 	"syscall"
@@ -155,7 +154,7 @@ func (p PriceEncoderImpl) convertPriceUpdate(marketPriceTimestamp *types.MarketP
 		adjustByIndexPrice, numPricesMedianized := p.exchangeToMarketPrices.GetIndexPrice(
 			conversionDetails.AdjustByMarketDetails.MarketId,
 			time.Now().Add(-pricefeedtypes.MaxPriceAge),
-			lib.Median[uint64],
+			conversionDetails.AdjustByMarketDetails.Aggregation,
 		)
 		// If the index price is not valid due to insufficient pricing data, return an error.
 		if numPricesMedianized < int(conversionDetails.AdjustByMarketDetails.MinExchanges) {
@@ -213,6 +212,7 @@ func (p PriceEncoderImpl) convertPriceUpdate(marketPriceTimestamp *types.MarketP
 	return &types.MarketPriceTimestamp{
 		MarketId:      marketPriceTimestamp.MarketId,
 		Price:         price,
+		Volume:        marketPriceTimestamp.Volume,
 		LastUpdatedAt: marketPriceTimestamp.LastUpdatedAt,
 	}, nil
 }
@@ -304,7 +304,8 @@ func recordPriceUpdateExchangeFailure(
 }
 
 // ProcessPriceFetcherResponse consumes the (price, error) response from the price fetcher and either updates the
-// exchangeToMarketPrices cache with a valid price, or appropriately logs and reports metrics for errors.
+// exchangeToMarketPrices cache with a valid price, or appropriately logs and reports metrics for errors. Errors
+// are also recorded in the exchange's status in the exchangeToMarketPrices cache.
 func (p *PriceEncoderImpl) ProcessPriceFetcherResponse(response *price_fetcher.PriceFetcherSubtaskResponse) {
 	// Capture nil response on channel close.
 	if response == nil {
@@ -325,6 +326,7 @@ func (p *PriceEncoderImpl) ProcessPriceFetcherResponse(response *price_fetcher.P
 				response.Err,
 				p.GetExchangeId(),
 			)
+			p.exchangeToMarketPrices.RecordExchangeError(p.GetExchangeId(), metrics.HttpGetTimeout, response.Err)
 		} else if errors.Is(response.Err, constants.RateLimitingError) {
 			// Log an error if there are rate limiting errors in the ingested buffered channel prices.
 			p.logger.Error(
@@ -350,6 +352,7 @@ func (p *PriceEncoderImpl) ProcessPriceFetcherResponse(response *price_fetcher.P
 					metrics.GetLabelForStringValue(metrics.Reason, metrics.RateLimit),
 				},
 			)
+			p.exchangeToMarketPrices.RecordExchangeError(p.GetExchangeId(), metrics.RateLimit, response.Err)
 		} else if ok := errors.As(response.Err, &exchangeSpecificError); ok {
 			// Log info if there are exchange-specific errors in the ingested buffered channel prices.
 			// These responses came back with an acceptable status code, but the response body contents
@@ -360,6 +363,7 @@ func (p *PriceEncoderImpl) ProcessPriceFetcherResponse(response *price_fetcher.P
 				response.Err,
 				p.GetExchangeId(),
 			)
+			p.exchangeToMarketPrices.RecordExchangeError(p.GetExchangeId(), metrics.ExchangeSpecificError, response.Err)
 		} else if price_function.IsGenericExchangeError(response.Err) {
 			// Log info if there are 5xx errors in the ingested buffered channel prices. These responses
 			// may have come back with an acceptable status code, but the response body contents indicate
@@ -370,6 +374,7 @@ func (p *PriceEncoderImpl) ProcessPriceFetcherResponse(response *price_fetcher.P
 				response.Err,
 				p.GetExchangeId(),
 			)
+			p.exchangeToMarketPrices.RecordExchangeError(p.GetExchangeId(), metrics.HttpGet5xx, response.Err)
 		} else if errors.Is(response.Err, syscall.ECONNRESET) {
 			// Log info if there are connections reset by the exchange.
 			recordPriceUpdateExchangeFailure(
@@ -378,6 +383,7 @@ func (p *PriceEncoderImpl) ProcessPriceFetcherResponse(response *price_fetcher.P
 				response.Err,
 				p.GetExchangeId(),
 			)
+			p.exchangeToMarketPrices.RecordExchangeError(p.GetExchangeId(), metrics.HttpGetHangup, response.Err)
 		} else {
 			// Log error if there are errors in the ingested buffered channel prices.
 			p.logger.Error(
@@ -400,6 +406,7 @@ func (p *PriceEncoderImpl) ProcessPriceFetcherResponse(response *price_fetcher.P
 					pricefeedmetrics.GetLabelForExchangeId(p.GetExchangeId()),
 				},
 			)
+			p.exchangeToMarketPrices.RecordExchangeError(p.GetExchangeId(), metrics.Error, response.Err)
 		}
 	}
 }
@@ -110,7 +110,7 @@ type MockExchangeToMarketPrices struct {
 	numPricesMedianized int
 }
 
-func (m *MockExchangeToMarketPrices) GetIndexPrice(types.MarketId, time.Time, pft.Resolver) (uint64, int) {
+func (m *MockExchangeToMarketPrices) GetIndexPrice(types.MarketId, time.Time, pft.AggregationConfig) (uint64, int) {
 	return m.indexPrice, m.numPricesMedianized
 }
 
@@ -367,6 +367,7 @@ func TestProcessPriceFetcherResponse_Error(t *testing.T) {
 		"Unidentified error": {
 			err:                 errors.New("unidentified error"),
 			isUnidentifiedError: true,
+			expectedReason:      metrics.Error,
 		},
 	}
 	for name, tc := range tests {
@@ -406,13 +407,16 @@ func TestProcessPriceFetcherResponse_Error(t *testing.T) {
 				).Return().Once()
 			}
 
+			etmp.On("RecordExchangeError", "Binance", tc.expectedReason, tc.err).Return().Once()
+
 			price_encoder.ProcessPriceFetcherResponse(
 				&price_fetcher.PriceFetcherSubtaskResponse{
 					Err: tc.err,
 				},
 			)
 
-			// Validate correct log method is called. Validate exchangeToMarketPrices is never updated.
+			// Validate correct log method is called and the error is recorded in the exchange status. Validate
+			// exchangeToMarketPrices prices are never updated.
 			mock.AssertExpectationsForObjects(t, logger, etmp)
 		})
 	}
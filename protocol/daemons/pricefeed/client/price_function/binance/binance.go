@@ -15,6 +15,7 @@ type BinanceTicker struct {
 	AskPrice  string `json:"askPrice" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bidPrice" validate:"required,positive-float-string"`
 	LastPrice string `json:"lastPrice" validate:"required,positive-float-string"`
+	Volume    string `json:"volume"`
 }
 
 // Ensure that BinanceTicker implements the Ticker interface at compile time.
@@ -37,18 +38,27 @@ func (t BinanceTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t BinanceTicker) GetVolume() string {
+	return t.Volume
+}
+
 // BinancePriceFunction transforms an API response from Binance into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func BinancePriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into a list of tickers.
 	var binanceTickers []BinanceTicker
 	err = json.NewDecoder(response.Body).Decode(&binanceTickers)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	return price_function.GetMedianPricesFromTickers(
@@ -212,9 +212,9 @@ func TestBinancePriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = binance.BinancePriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = binance.BinancePriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = binance.BinancePriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = binance.BinancePriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -229,3 +229,20 @@ func TestBinancePriceFunction_Mixed(t *testing.T) {
 		})
 	}
 }
+
+func TestBinancePriceFunction_Volume(t *testing.T) {
+	btcTicker := pricefeed.ReadJsonTestFile(t, "btc_ticker_binance.json")
+	ethTicker := pricefeed.ReadJsonTestFile(t, "eth_ticker_binance.json")
+	response := testutil.CreateResponseFromJson(fmt.Sprintf(`[%s,%s]`, btcTicker, ethTicker))
+
+	_, volumes, _, err := binance.BinancePriceFunction(response, BtcAndEthExponentMap, lib.Median[uint64])
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]uint64{
+			BTCUSDC_TICKER: 62_285_784_220,
+			ETHUSDC_TICKER: 298_334_987_800,
+		},
+		volumes,
+	)
+}
@@ -0,0 +1,106 @@
+package binance
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+const (
+	// binanceTickerEventType is the event type of messages pushed by the Binance individual symbol ticker stream.
+	binanceTickerEventType = "24hrTicker"
+	// binanceTickerStreamSuffix is appended to a lowercase symbol to form the name of its ticker stream.
+	binanceTickerStreamSuffix = "@ticker"
+)
+
+// BinanceWebsocketTicker is our representation of ticker information pushed by the Binance individual symbol
+// ticker stream. It implements interface `Ticker` in util.go.
+// https://binance-docs.github.io/apidocs/spot/en/#individual-symbol-ticker-streams
+type BinanceWebsocketTicker struct {
+	EventType string `json:"e"`
+	Pair      string `json:"s" validate:"required"`
+	AskPrice  string `json:"a" validate:"required,positive-float-string"`
+	BidPrice  string `json:"b" validate:"required,positive-float-string"`
+	LastPrice string `json:"c" validate:"required,positive-float-string"`
+	Volume    string `json:"v"`
+}
+
+// Ensure that BinanceWebsocketTicker implements the Ticker interface at compile time.
+var _ price_function.Ticker = (*BinanceWebsocketTicker)(nil)
+
+func (t BinanceWebsocketTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t BinanceWebsocketTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t BinanceWebsocketTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t BinanceWebsocketTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+func (t BinanceWebsocketTicker) GetVolume() string {
+	return t.Volume
+}
+
+// binanceSubscribeRequest is the request sent to Binance to subscribe to a list of streams.
+type binanceSubscribeRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params"`
+	Id     uint32   `json:"id"`
+}
+
+// BinanceWebsocketSubscribeMessages returns the message that subscribes to the ticker stream of each ticker.
+func BinanceWebsocketSubscribeMessages(tickers []string) ([][]byte, error) {
+	streams := make([]string, 0, len(tickers))
+	for _, ticker := range tickers {
+		streams = append(streams, strings.ToLower(ticker)+binanceTickerStreamSuffix)
+	}
+
+	message, err := json.Marshal(binanceSubscribeRequest{
+		Method: "SUBSCRIBE",
+		Params: streams,
+		Id:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{message}, nil
+}
+
+// BinanceWebsocketPriceFunction transforms a message pushed by a Binance ticker stream into a map of tickers to
+// prices that have been shifted by a market specific exponent.
+func BinanceWebsocketPriceFunction(
+	message []byte,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	var binanceTicker BinanceWebsocketTicker
+	err = json.Unmarshal(message, &binanceTicker)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Messages other than ticker updates, such as subscription acknowledgements, do not contain prices.
+	if binanceTicker.EventType != binanceTickerEventType {
+		return map[string]uint64{}, map[string]uint64{}, map[string]error{}, nil
+	}
+
+	return price_function.GetMedianPricesFromStreamedTicker(
+		binanceTicker,
+		tickerToExponent,
+		resolver,
+	)
+}
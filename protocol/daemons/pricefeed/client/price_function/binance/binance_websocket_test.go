@@ -0,0 +1,116 @@
+package binance_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/binance"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinanceWebsocketSubscribeMessages(t *testing.T) {
+	messages, err := binance.BinanceWebsocketSubscribeMessages([]string{BTCUSDC_TICKER, ETHUSDC_TICKER})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[][]byte{[]byte(`{"method":"SUBSCRIBE","params":["btcusdt@ticker","ethusdt@ticker"],"id":1}`)},
+		messages,
+	)
+}
+
+func TestBinanceWebsocketPriceFunction_Mixed(t *testing.T) {
+	const btcTickerMessage = `{"e":"24hrTicker","s":"BTCUSDT","c":"25499.81","b":"25499.80","a":"25499.82"}`
+
+	tests := map[string]struct {
+		// parameters
+		message             string
+		exponentMap         map[string]int32
+		medianFunctionFails bool
+
+		// expectations
+		expectedPriceMap       map[string]uint64
+		expectedUnavailableMap map[string]error
+		expectedError          error
+	}{
+		"Failure - invalid message": {
+			// Invalid due to trailing comma in JSON.
+			message:       `{"e":"24hrTicker",}`,
+			exponentMap:   BtcExponentMap,
+			expectedError: errors.New("invalid character '}' looking for beginning of object key string"),
+		},
+		"Success - subscription acknowledgement contains no prices": {
+			message:                `{"result":null,"id":1}`,
+			exponentMap:            BtcExponentMap,
+			expectedPriceMap:       map[string]uint64{},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Success - ticker not subscribed is ignored": {
+			message:                btcTickerMessage,
+			exponentMap:            EthExponentMap,
+			expectedPriceMap:       map[string]uint64{},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Unavailable - ask price is negative": {
+			message:          `{"e":"24hrTicker","s":"BTCUSDT","c":"25499.81","b":"25499.80","a":"-25499.82"}`,
+			exponentMap:      BtcExponentMap,
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSDC_TICKER: errors.New("Key: 'BinanceWebsocketTicker.AskPrice' Error:Field validation for " +
+					"'AskPrice' failed on the 'positive-float-string' tag"),
+			},
+		},
+		"Failure - medianization error": {
+			message:             btcTickerMessage,
+			exponentMap:         BtcExponentMap,
+			medianFunctionFails: true,
+			expectedPriceMap:    map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSDC_TICKER: testutil.MedianizationError,
+			},
+		},
+		"Success - only the ticker in the message is priced": {
+			message:     btcTickerMessage,
+			exponentMap: BtcAndEthExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDC_TICKER: uint64(2_549_981_000),
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resolver := lib.Median[uint64]
+			if tc.medianFunctionFails {
+				resolver = testutil.MedianErr
+			}
+
+			prices, _, unavailable, err := binance.BinanceWebsocketPriceFunction(
+				[]byte(tc.message),
+				tc.exponentMap,
+				resolver,
+			)
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, unavailable)
+			} else {
+				require.Equal(t, tc.expectedPriceMap, prices)
+				pricefeed.ErrorMapsEqual(t, tc.expectedUnavailableMap, unavailable)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBinanceWebsocketPriceFunction_Volume(t *testing.T) {
+	message := `{"e":"24hrTicker","s":"BTCUSDT","c":"25499.81","b":"25499.80","a":"25499.82","v":"1234.5"}`
+
+	_, volumes, _, err := binance.BinanceWebsocketPriceFunction([]byte(message), BtcExponentMap, lib.Median[uint64])
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{BTCUSDC_TICKER: 1_234_500_000}, volumes)
+}
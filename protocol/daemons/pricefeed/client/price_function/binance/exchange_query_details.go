@@ -11,6 +11,11 @@ var (
 		Url:           "https://data-api.binance.vision/api/v3/ticker/24hr",
 		PriceFunction: BinancePriceFunction,
 		IsMultiMarket: true,
+		Websocket: &types.WebsocketDetails{
+			Url:               "wss://data-stream.binance.vision/ws",
+			SubscribeMessages: BinanceWebsocketSubscribeMessages,
+			PriceFunction:     BinanceWebsocketPriceFunction,
+		},
 	}
 
 	BinanceUSDetails = types.ExchangeQueryDetails{
@@ -18,5 +23,10 @@ var (
 		Url:           "https://api.binance.us/api/v3/ticker/24hr",
 		PriceFunction: BinancePriceFunction,
 		IsMultiMarket: true,
+		Websocket: &types.WebsocketDetails{
+			Url:               "wss://stream.binance.us:9443/ws",
+			SubscribeMessages: BinanceWebsocketSubscribeMessages,
+			PriceFunction:     BinanceWebsocketPriceFunction,
+		},
 	}
 )
@@ -22,3 +22,11 @@ func TestBinanceIsMultiMarket(t *testing.T) {
 func TestBinanceUSIsMultiMarket(t *testing.T) {
 	require.True(t, binance.BinanceUSDetails.IsMultiMarket)
 }
+
+func TestBinanceWebsocketUrl(t *testing.T) {
+	require.Equal(t, "wss://data-stream.binance.vision/ws", binance.BinanceDetails.Websocket.Url)
+}
+
+func TestBinanceUSWebsocketUrl(t *testing.T) {
+	require.Equal(t, "wss://stream.binance.us:9443/ws", binance.BinanceUSDetails.Websocket.Url)
+}
@@ -15,6 +15,7 @@ const (
 	BidPriceIndex  = 1
 	AskPriceIndex  = 3
 	LastPriceIndex = 7
+	VolumeIndex    = 8
 	// We don't need all 11 fields, but 11 fields indicates this is a valid API response. See above link
 	// for documentation on the response format.
 	BitfinexResponseLength = 11
@@ -29,6 +30,7 @@ type BitfinexTicker struct {
 	BidPrice  string `validate:"required,positive-float-string"`
 	AskPrice  string `validate:"required,positive-float-string"`
 	LastPrice string `validate:"required,positive-float-string"`
+	Volume    string
 }
 
 // Ensure that BitfinexTicker implements the Ticker interface at compile time.
@@ -50,18 +52,27 @@ func (t BitfinexTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t BitfinexTicker) GetVolume() string {
+	return t.Volume
+}
+
 // BitfinexPriceFunction transforms an API response from Bitfinex into a map of tickers
 // to prices that have been shifted by a market specific exponent.
 func BitfinexPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into raw format first.
 	var rawResponse [][]interface{}
 	err = json.NewDecoder(response.Body).Decode(&rawResponse)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Convert raw tickers in response into a list of `BitfinexTicker`.
@@ -96,16 +107,22 @@ func BitfinexPriceFunction(
 			invalidRawTickers[pair] = errors.New("invalid last price in response - not a float64")
 			continue
 		}
+		// Get `volume`. Volume is optional, so an invalid volume is treated as unavailable.
+		volume := ""
+		if rawVolume, ok := rawTicker[VolumeIndex].(float64); ok {
+			volume = price_function.ConvertFloat64ToString(rawVolume)
+		}
 		bitfinexTickers = append(bitfinexTickers, BitfinexTicker{
 			Pair:      pair,
 			BidPrice:  price_function.ConvertFloat64ToString(bidPrice),
 			AskPrice:  price_function.ConvertFloat64ToString(askPrice),
 			LastPrice: price_function.ConvertFloat64ToString(lastPrice),
+			Volume:    volume,
 		})
 	}
 
 	// Calculate median price of each ticker in `tickerToExponent`.
-	tickerToPrice, unavailableTickers, err = price_function.GetMedianPricesFromTickers(
+	tickerToPrice, tickerToVolume, unavailableTickers, err = price_function.GetMedianPricesFromTickers(
 		bitfinexTickers,
 		tickerToExponent,
 		resolver,
@@ -118,5 +135,5 @@ func BitfinexPriceFunction(
 		}
 	}
 
-	return tickerToPrice, unavailableTickers, err
+	return tickerToPrice, tickerToVolume, unavailableTickers, err
 }
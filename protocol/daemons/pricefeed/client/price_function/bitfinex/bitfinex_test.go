@@ -250,9 +250,9 @@ func TestBitfinexPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = bitfinex.BitfinexPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = bitfinex.BitfinexPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = bitfinex.BitfinexPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = bitfinex.BitfinexPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
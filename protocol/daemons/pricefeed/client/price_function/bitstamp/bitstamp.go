@@ -15,6 +15,7 @@ type BitstampTicker struct {
 	AskPrice  string `json:"ask" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bid" validate:"required,positive-float-string"`
 	LastPrice string `json:"last" validate:"required,positive-float-string"`
+	Volume    string `json:"volume"`
 }
 
 // Ensure that BitstampTicker implements the Ticker interface at compile time.
@@ -36,18 +37,27 @@ func (t BitstampTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t BitstampTicker) GetVolume() string {
+	return t.Volume
+}
+
 // BitstampPriceFunction transforms an API response from Bitstamp into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func BitstampPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into a list of tickers.
 	var bitstampTickers []BitstampTicker
 	err = json.NewDecoder(response.Body).Decode(&bitstampTickers)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	return price_function.GetMedianPricesFromTickers(
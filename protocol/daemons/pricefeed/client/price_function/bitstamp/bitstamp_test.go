@@ -190,9 +190,9 @@ func TestBitstampPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = bitstamp.BitstampPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = bitstamp.BitstampPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = bitstamp.BitstampPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = bitstamp.BitstampPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -27,6 +27,7 @@ type BybitTicker struct {
 	AskPrice  string `json:"ask1Price" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bid1Price" validate:"required,positive-float-string"`
 	LastPrice string `json:"lastPrice" validate:"required,positive-float-string"`
+	Volume    string `json:"volume24h"`
 }
 
 // Ensure that BybitTicker implements the Ticker interface at compile time.
@@ -48,22 +49,31 @@ func (t BybitTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t BybitTicker) GetVolume() string {
+	return t.Volume
+}
+
 // BybitPriceFunction transforms an API response from Bybit into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func BybitPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into a list of tickers.
 	var bybitResponseBody BybitResponseBody
 	err = json.NewDecoder(response.Body).Decode(&bybitResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if bybitResponseBody.RetCode != 0 {
-		return nil, nil, errors.New("response code is not 0")
+		return nil, nil, nil, errors.New("response code is not 0")
 	}
 
 	return price_function.GetMedianPricesFromTickers(
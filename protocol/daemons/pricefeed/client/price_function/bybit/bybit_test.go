@@ -198,9 +198,9 @@ func TestBybitPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = bybit.BybitPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = bybit.BybitPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = bybit.BybitPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = bybit.BybitPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -168,9 +168,9 @@ func TestCoinbaseProPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = coinbase_pro.CoinbaseProPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = coinbase_pro.CoinbaseProPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = coinbase_pro.CoinbaseProPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = coinbase_pro.CoinbaseProPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -0,0 +1,107 @@
+package coinbase_pro
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+const (
+	// coinbaseProTickerMessageType is the type of messages pushed by the CoinbasePro ticker channel.
+	coinbaseProTickerMessageType = "ticker"
+	// coinbaseProErrorMessageType is the type of messages sent by CoinbasePro when a request fails.
+	coinbaseProErrorMessageType = "error"
+	// coinbaseProTickerChannel is the name of the CoinbasePro channel that pushes ticker updates.
+	coinbaseProTickerChannel = "ticker"
+)
+
+// CoinbaseProWebsocketTicker is our representation of ticker information pushed by the CoinbasePro ticker
+// channel. CoinbaseProWebsocketTicker implements interface `Ticker` in util.go.
+// https://docs.cloud.coinbase.com/exchange/docs/websocket-channels#ticker-channel
+type CoinbaseProWebsocketTicker struct {
+	Type      string `json:"type"`
+	Message   string `json:"message"`
+	Pair      string `json:"product_id" validate:"required"`
+	AskPrice  string `json:"best_ask" validate:"required,positive-float-string"`
+	BidPrice  string `json:"best_bid" validate:"required,positive-float-string"`
+	LastPrice string `json:"price" validate:"required,positive-float-string"`
+	Volume    string `json:"volume_24h"`
+}
+
+// Ensure that CoinbaseProWebsocketTicker implements the Ticker interface at compile time.
+var _ price_function.Ticker = (*CoinbaseProWebsocketTicker)(nil)
+
+func (t CoinbaseProWebsocketTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t CoinbaseProWebsocketTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t CoinbaseProWebsocketTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t CoinbaseProWebsocketTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+func (t CoinbaseProWebsocketTicker) GetVolume() string {
+	return t.Volume
+}
+
+// coinbaseProSubscribeRequest is the request sent to CoinbasePro to subscribe to channels for a list of products.
+type coinbaseProSubscribeRequest struct {
+	Type       string   `json:"type"`
+	ProductIds []string `json:"product_ids"`
+	Channels   []string `json:"channels"`
+}
+
+// CoinbaseProWebsocketSubscribeMessages returns the message that subscribes to the ticker channel of each ticker.
+func CoinbaseProWebsocketSubscribeMessages(tickers []string) ([][]byte, error) {
+	message, err := json.Marshal(coinbaseProSubscribeRequest{
+		Type:       "subscribe",
+		ProductIds: tickers,
+		Channels:   []string{coinbaseProTickerChannel},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{message}, nil
+}
+
+// CoinbaseProWebsocketPriceFunction transforms a message pushed by the CoinbasePro ticker channel into a map of
+// tickers to prices that have been shifted by a market specific exponent.
+func CoinbaseProWebsocketPriceFunction(
+	message []byte,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	var coinbaseProTicker CoinbaseProWebsocketTicker
+	err = json.Unmarshal(message, &coinbaseProTicker)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	switch coinbaseProTicker.Type {
+	case coinbaseProTickerMessageType:
+		return price_function.GetMedianPricesFromStreamedTicker(
+			coinbaseProTicker,
+			tickerToExponent,
+			resolver,
+		)
+	case coinbaseProErrorMessageType:
+		return nil, nil, nil, fmt.Errorf("CoinbasePro websocket error: %v", coinbaseProTicker.Message)
+	default:
+		// Other messages, such as subscription acknowledgements and heartbeats, do not contain prices.
+		return map[string]uint64{}, map[string]uint64{}, map[string]error{}, nil
+	}
+}
@@ -0,0 +1,115 @@
+package coinbase_pro_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/coinbase_pro"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinbaseProWebsocketSubscribeMessages(t *testing.T) {
+	messages, err := coinbase_pro.CoinbaseProWebsocketSubscribeMessages([]string{BTCUSDC_TICKER, ETHUSDC_TICKER})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[][]byte{[]byte(`{"type":"subscribe","product_ids":["BTC-USD","ETH-USD"],"channels":["ticker"]}`)},
+		messages,
+	)
+}
+
+func TestCoinbaseProWebsocketPriceFunction_Mixed(t *testing.T) {
+	const btcTickerMessage = `{"type":"ticker","product_id":"BTC-USD","price":"25499.81",` +
+		`"best_bid":"25499.80","best_ask":"25499.82"}`
+
+	tests := map[string]struct {
+		// parameters
+		message             string
+		exponentMap         map[string]int32
+		medianFunctionFails bool
+
+		// expectations
+		expectedPriceMap       map[string]uint64
+		expectedUnavailableMap map[string]error
+		expectedError          error
+	}{
+		"Failure - invalid message": {
+			// Invalid due to trailing comma in JSON.
+			message:       `{"type":"ticker",}`,
+			exponentMap:   BtcExponentMap,
+			expectedError: errors.New("invalid character '}' looking for beginning of object key string"),
+		},
+		"Failure - error message": {
+			message:       `{"type":"error","message":"Failed to subscribe"}`,
+			exponentMap:   BtcExponentMap,
+			expectedError: errors.New("CoinbasePro websocket error: Failed to subscribe"),
+		},
+		"Success - subscription acknowledgement contains no prices": {
+			message:                `{"type":"subscriptions","channels":[{"name":"ticker","product_ids":["BTC-USD"]}]}`,
+			exponentMap:            BtcExponentMap,
+			expectedPriceMap:       map[string]uint64{},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Success - ticker not subscribed is ignored": {
+			message:                btcTickerMessage,
+			exponentMap:            EthExponentMap,
+			expectedPriceMap:       map[string]uint64{},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Unavailable - bid price is 0": {
+			message: `{"type":"ticker","product_id":"BTC-USD","price":"25499.81",` +
+				`"best_bid":"0","best_ask":"25499.82"}`,
+			exponentMap:      BtcExponentMap,
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSDC_TICKER: errors.New("Key: 'CoinbaseProWebsocketTicker.BidPrice' Error:Field validation for " +
+					"'BidPrice' failed on the 'positive-float-string' tag"),
+			},
+		},
+		"Failure - medianization error": {
+			message:             btcTickerMessage,
+			exponentMap:         BtcExponentMap,
+			medianFunctionFails: true,
+			expectedPriceMap:    map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSDC_TICKER: testutil.MedianizationError,
+			},
+		},
+		"Success - only the ticker in the message is priced": {
+			message:     btcTickerMessage,
+			exponentMap: BtcAndEthExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDC_TICKER: uint64(2_549_981_000),
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resolver := lib.Median[uint64]
+			if tc.medianFunctionFails {
+				resolver = testutil.MedianErr
+			}
+
+			prices, _, unavailable, err := coinbase_pro.CoinbaseProWebsocketPriceFunction(
+				[]byte(tc.message),
+				tc.exponentMap,
+				resolver,
+			)
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, unavailable)
+			} else {
+				require.Equal(t, tc.expectedPriceMap, prices)
+				pricefeed.ErrorMapsEqual(t, tc.expectedUnavailableMap, unavailable)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -10,5 +10,10 @@ var (
 		Exchange:      exchange_common.EXCHANGE_ID_COINBASE_PRO,
 		Url:           "https://api.pro.coinbase.com/products/$/ticker",
 		PriceFunction: CoinbaseProPriceFunction,
+		Websocket: &types.WebsocketDetails{
+			Url:               "wss://ws-feed.exchange.coinbase.com",
+			SubscribeMessages: CoinbaseProWebsocketSubscribeMessages,
+			PriceFunction:     CoinbaseProWebsocketPriceFunction,
+		},
 	}
 )
@@ -29,6 +29,7 @@ type CryptoComTicker struct {
 	AskPrice  string `json:"k" validate:"required,positive-float-string"`
 	BidPrice  string `json:"b" validate:"required,positive-float-string"`
 	LastPrice string `json:"a" validate:"required,positive-float-string"`
+	Volume    string `json:"v"`
 }
 
 // Ensure that CryptoComTicker implements the Ticker interface at compile time.
@@ -50,22 +51,31 @@ func (t CryptoComTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t CryptoComTicker) GetVolume() string {
+	return t.Volume
+}
+
 // CryptoComPriceFunction transforms an API response from CryptoCom into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func CryptoComPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into a list of tickers.
 	var cryptoComResponseBody CryptoComResponseBody
 	err = json.NewDecoder(response.Body).Decode(&cryptoComResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if cryptoComResponseBody.Code != 0 {
-		return nil, nil, errors.New("response code is not 0")
+		return nil, nil, nil, errors.New("response code is not 0")
 	}
 
 	return price_function.GetMedianPricesFromTickers(
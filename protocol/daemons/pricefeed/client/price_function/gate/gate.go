@@ -15,6 +15,7 @@ type GateTicker struct {
 	AskPrice  string `json:"lowest_ask" validate:"required,positive-float-string"`
 	BidPrice  string `json:"highest_bid" validate:"required,positive-float-string"`
 	LastPrice string `json:"last" validate:"required,positive-float-string"`
+	Volume    string `json:"base_volume"`
 }
 
 // Ensure that GateTicker implements the Ticker interface at compile time.
@@ -36,18 +37,27 @@ func (t GateTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t GateTicker) GetVolume() string {
+	return t.Volume
+}
+
 // GatePriceFunction transforms an API response from Gate into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func GatePriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into a list of tickers.
 	var gateTickers []GateTicker
 	err = json.NewDecoder(response.Body).Decode(&gateTickers)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	return price_function.GetMedianPricesFromTickers(
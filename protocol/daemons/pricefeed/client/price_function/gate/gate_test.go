@@ -195,9 +195,9 @@ func TestGatePriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = gate.GatePriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = gate.GatePriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = gate.GatePriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = gate.GatePriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
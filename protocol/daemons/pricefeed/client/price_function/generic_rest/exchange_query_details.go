@@ -0,0 +1,27 @@
+package generic_rest
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+)
+
+// NewGenericRestDetails returns the details of the generic REST exchange `exchange`. Generic REST exchanges have
+// no url or price function of their own. Each market on a generic REST exchange is queried individually with the
+// details returned by `NewMarketExchangeQueryDetails`.
+func NewGenericRestDetails(exchange types.ExchangeId) types.ExchangeQueryDetails {
+	return types.ExchangeQueryDetails{
+		Exchange: exchange,
+	}
+}
+
+// NewMarketExchangeQueryDetails returns the details used to query a single market on the generic REST exchange
+// `exchange`, as described by the market's generic REST config.
+func NewMarketExchangeQueryDetails(
+	exchange types.ExchangeId,
+	config types.GenericRestConfig,
+) types.ExchangeQueryDetails {
+	return types.ExchangeQueryDetails{
+		Exchange:      exchange,
+		Url:           config.Url,
+		PriceFunction: NewGenericRestPriceFunction(config),
+	}
+}
@@ -0,0 +1,206 @@
+package generic_rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+// GenericRestTicker is our representation of ticker information extracted from a generic REST exchange response
+// with the JSON paths of a `GenericRestConfig`. The pair is always the market's ticker.
+// GenericRestTicker implements interface `Ticker` in util.go.
+type GenericRestTicker struct {
+	Pair      string `validate:"required"`
+	AskPrice  string `validate:"required,positive-float-string"`
+	BidPrice  string `validate:"required,positive-float-string"`
+	LastPrice string `validate:"required,positive-float-string"`
+	Volume    string
+}
+
+// Ensure that GenericRestTicker implements the Ticker interface at compile time.
+var _ price_function.Ticker = (*GenericRestTicker)(nil)
+
+func (t GenericRestTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t GenericRestTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t GenericRestTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t GenericRestTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+func (t GenericRestTicker) GetVolume() string {
+	return t.Volume
+}
+
+// NewGenericRestPriceFunction returns a price function that transforms an API response from a generic REST
+// exchange into a map of tickers to prices that have been shifted by a market specific exponent, using the
+// JSON paths of `config` to locate each ticker's prices and volume.
+func NewGenericRestPriceFunction(config types.GenericRestConfig) func(
+	response *http.Response,
+	tickerToExponent map[string]int32,
+	resolver pricefeedtypes.Resolver,
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	return func(
+		response *http.Response,
+		tickerToExponent map[string]int32,
+		resolver pricefeedtypes.Resolver,
+	) (
+		tickerToPrice map[string]uint64,
+		tickerToVolume map[string]uint64,
+		unavailableTickers map[string]error,
+		err error,
+	) {
+		// Unmarshal response body. Numbers are decoded as `json.Number` so that prices do not lose precision.
+		var responseBody interface{}
+		decoder := json.NewDecoder(response.Body)
+		decoder.UseNumber()
+		if err = decoder.Decode(&responseBody); err != nil {
+			return nil, nil, nil, err
+		}
+
+		tickersValue, err := getValueAtPath(responseBody, config.TickersPath)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		var tickers []GenericRestTicker
+		if config.IsMultiMarket {
+			tickers, err = getTickersFromMultiMarketValue(tickersValue, tickerToExponent, config)
+		} else {
+			tickers, err = getTickersFromSingleMarketValue(tickersValue, tickerToExponent, config)
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return price_function.GetMedianPricesFromTickers(
+			tickers,
+			tickerToExponent,
+			resolver,
+		)
+	}
+}
+
+// getTickersFromSingleMarketValue extracts the only requested ticker from a response that contains a single
+// ticker.
+func getTickersFromSingleMarketValue(
+	tickerValue interface{},
+	tickerToExponent map[string]int32,
+	config types.GenericRestConfig,
+) ([]GenericRestTicker, error) {
+	ticker, _, err := price_function.GetOnlyTickerAndExponent(tickerToExponent, types.GenericRestExchangeIdPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return []GenericRestTicker{newGenericRestTicker(ticker, tickerValue, config)}, nil
+}
+
+// getTickersFromMultiMarketValue extracts all requested tickers from a list of tickers. Each ticker in the list
+// is identified by the pair at `config.PairPath`. Tickers that were not requested are ignored.
+func getTickersFromMultiMarketValue(
+	tickersValue interface{},
+	tickerToExponent map[string]int32,
+	config types.GenericRestConfig,
+) ([]GenericRestTicker, error) {
+	tickerValues, ok := tickersValue.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("value at tickersPath '%v' is not a list", config.TickersPath)
+	}
+
+	pairToTicker := make(map[string]string, len(tickerToExponent))
+	for ticker := range tickerToExponent {
+		pairToTicker[config.GetPair(ticker)] = ticker
+	}
+
+	tickers := make([]GenericRestTicker, 0, len(tickerToExponent))
+	for _, tickerValue := range tickerValues {
+		pair := getStringAtPath(tickerValue, config.PairPath)
+		if ticker, exists := pairToTicker[pair]; exists {
+			tickers = append(tickers, newGenericRestTicker(ticker, tickerValue, config))
+		}
+	}
+	return tickers, nil
+}
+
+// newGenericRestTicker extracts the prices and volume of `ticker` from `tickerValue`. Prices that cannot be found
+// are left empty and fail validation of the ticker.
+func newGenericRestTicker(
+	ticker string,
+	tickerValue interface{},
+	config types.GenericRestConfig,
+) GenericRestTicker {
+	genericRestTicker := GenericRestTicker{
+		Pair:      ticker,
+		AskPrice:  getStringAtPath(tickerValue, config.AskPricePath),
+		BidPrice:  getStringAtPath(tickerValue, config.BidPricePath),
+		LastPrice: getStringAtPath(tickerValue, config.LastPricePath),
+	}
+	if config.VolumePath != "" {
+		genericRestTicker.Volume = getStringAtPath(tickerValue, config.VolumePath)
+	}
+	return genericRestTicker
+}
+
+// getStringAtPath returns the string or number at `path` within `value` as a string. An empty string is returned
+// if the path cannot be resolved or if the value at the path is neither a string nor a number.
+func getStringAtPath(value interface{}, path string) string {
+	valueAtPath, err := getValueAtPath(value, path)
+	if err != nil {
+		return ""
+	}
+	switch v := valueAtPath.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+// getValueAtPath returns the value at `path` within `value`, where `path` is a sequence of object keys and array
+// indices separated by `.`. An empty path resolves to `value` itself.
+func getValueAtPath(value interface{}, path string) (interface{}, error) {
+	if path == "" {
+		return value, nil
+	}
+
+	for _, key := range strings.Split(path, types.GenericRestPathSeparator) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			child, exists := v[key]
+			if !exists {
+				return nil, fmt.Errorf("key '%v' of path '%v' not found", key, path)
+			}
+			value = child
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, fmt.Errorf("index '%v' of path '%v' is not valid", key, path)
+			}
+			value = v[index]
+		default:
+			return nil, fmt.Errorf("key '%v' of path '%v' cannot be resolved in a non-container value", key, path)
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,241 @@
+package generic_rest_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/generic_rest"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	"github.com/stretchr/testify/require"
+)
+
+// Test tickers for the generic REST exchange.
+const (
+	BTCUSDT_TICKER = "BTC_USDT"
+	ETHUSDT_TICKER = "ETH_USDT"
+)
+
+// Test exponent maps.
+var (
+	BtcExponentMap = map[string]int32{
+		BTCUSDT_TICKER: constants.BtcUsdExponent,
+	}
+	BtcAndEthExponentMap = map[string]int32{
+		BTCUSDT_TICKER: constants.BtcUsdExponent,
+		ETHUSDT_TICKER: constants.EthUsdExponent,
+	}
+)
+
+// Test configs.
+var (
+	stringPricesConfig = types.GenericRestConfig{
+		Url:           "https://abc.exchange/api/ticker?symbol=$",
+		TickersPath:   "data",
+		AskPricePath:  "ask",
+		BidPricePath:  "bid",
+		LastPricePath: "last",
+		VolumePath:    "stats.vol24h",
+	}
+	numberPricesConfig = types.GenericRestConfig{
+		Url:           "https://abc.exchange/api/tickers/$",
+		TickersPath:   "result.0",
+		AskPricePath:  "quote.0",
+		BidPricePath:  "quote.1",
+		LastPricePath: "last",
+	}
+	multiMarketConfig = types.GenericRestConfig{
+		Url:           "https://abc.exchange/api/tickers",
+		IsMultiMarket: true,
+		TickersPath:   "result.list",
+		PairPath:      "symbol",
+		TickerFormat:  "t$",
+		AskPricePath:  "quote.0",
+		BidPricePath:  "quote.1",
+		LastPricePath: "last",
+	}
+)
+
+func TestGenericRestPriceFunction_Mixed(t *testing.T) {
+	tests := map[string]struct {
+		// parameters
+		config              types.GenericRestConfig
+		responseJsonString  string
+		exponentMap         map[string]int32
+		medianFunctionFails bool
+
+		// expectations
+		expectedPriceMap       map[string]uint64
+		expectedVolumeMap      map[string]uint64
+		expectedUnavailableMap map[string]error
+		expectedError          error
+	}{
+		"Failure - invalid response": {
+			config:             stringPricesConfig,
+			responseJsonString: `{"data":{"ask":"1780.25",}}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("invalid character '}' looking for beginning of object key string"),
+		},
+		"Failure - tickers path not found": {
+			config:             stringPricesConfig,
+			responseJsonString: `{"result":{}}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("key 'data' of path 'data' not found"),
+		},
+		"Failure - multiple tickers requested": {
+			config:             stringPricesConfig,
+			responseJsonString: `{"data":{"ask":"1780.25","bid":"1780.24","last":"1780.29"}}`,
+			exponentMap:        BtcAndEthExponentMap,
+			expectedError: errors.New("Invalid market price exponent map for GenericRest price function " +
+				"of length: 2, expected length 1"),
+		},
+		"Failure - ticker path index out of range": {
+			config:             numberPricesConfig,
+			responseJsonString: `{"result":[]}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("index '0' of path 'result.0' is not valid"),
+		},
+		"Failure - multi-market tickers path is not a list": {
+			config:             multiMarketConfig,
+			responseJsonString: `{"result":{"list":{}}}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("value at tickersPath 'result.list' is not a list"),
+		},
+		"Unavailable - price not found": {
+			config:             stringPricesConfig,
+			responseJsonString: `{"data":{"ask":"27944.8","last":"27944.7"}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   map[string]uint64{},
+			expectedVolumeMap:  map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSDT_TICKER: errors.New("Key: 'GenericRestTicker.BidPrice' Error:Field validation for " +
+					"'BidPrice' failed on the 'required' tag"),
+			},
+		},
+		"Unavailable - price is negative": {
+			config:             stringPricesConfig,
+			responseJsonString: `{"data":{"ask":"27944.8","bid":"-27944.6","last":"27944.7"}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   map[string]uint64{},
+			expectedVolumeMap:  map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSDT_TICKER: errors.New("Key: 'GenericRestTicker.BidPrice' Error:Field validation for " +
+					"'BidPrice' failed on the 'positive-float-string' tag"),
+			},
+		},
+		"Unavailable - ticker not in multi-market response": {
+			config:             multiMarketConfig,
+			responseJsonString: `{"result":{"list":[{"symbol":"tETH_USDT","quote":[1780.25,1780.24],"last":1780.29}]}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   map[string]uint64{},
+			expectedVolumeMap:  map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSDT_TICKER: errors.New("no listing found for ticker BTC_USDT"),
+			},
+		},
+		"Failure - medianization error": {
+			config:              stringPricesConfig,
+			responseJsonString:  `{"data":{"ask":"27944.8","bid":"27944.6","last":"27944.7"}}`,
+			exponentMap:         BtcExponentMap,
+			medianFunctionFails: true,
+			expectedPriceMap:    map[string]uint64{},
+			expectedVolumeMap:   map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSDT_TICKER: testutil.MedianizationError,
+			},
+		},
+		"Success - string prices and volume": {
+			config:             stringPricesConfig,
+			responseJsonString: `{"data":{"ask":"27944.8","bid":"27944.6","last":"27944.7","stats":{"vol24h":"1234.5"}}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDT_TICKER: uint64(2_794_470_000),
+			},
+			expectedVolumeMap: map[string]uint64{
+				BTCUSDT_TICKER: uint64(1_234_500_000),
+			},
+		},
+		"Success - without volume": {
+			config:             stringPricesConfig,
+			responseJsonString: `{"data":{"ask":"27944.8","bid":"27944.6","last":"27944.7"}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDT_TICKER: uint64(2_794_470_000),
+			},
+			expectedVolumeMap: map[string]uint64{
+				BTCUSDT_TICKER: uint64(0),
+			},
+		},
+		"Success - number prices and array paths": {
+			config:             numberPricesConfig,
+			responseJsonString: `{"result":[{"quote":[27944.8,27944.6],"last":27944.7}]}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDT_TICKER: uint64(2_794_470_000),
+			},
+			expectedVolumeMap: map[string]uint64{
+				BTCUSDT_TICKER: uint64(0),
+			},
+		},
+		"Success - multi-market with number prices and ticker format": {
+			config: multiMarketConfig,
+			responseJsonString: `{"result":{"list":[` +
+				`{"symbol":"tBTC_USDT","quote":[27944.8,27944.6],"last":27944.7},` +
+				`{"symbol":"tETH_USDT","quote":[1780.25,1780.24],"last":1780.29},` +
+				`{"symbol":"tSOL_USDT","quote":[20.1,20.0],"last":20.05}` +
+				`]}}`,
+			exponentMap: BtcAndEthExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDT_TICKER: uint64(2_794_470_000),
+				ETHUSDT_TICKER: uint64(1_780_250_000),
+			},
+			expectedVolumeMap: map[string]uint64{
+				BTCUSDT_TICKER: uint64(0),
+				ETHUSDT_TICKER: uint64(0),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			response := testutil.CreateResponseFromJson(tc.responseJsonString)
+
+			var prices, volumes map[string]uint64
+			var unavailable map[string]error
+			var err error
+			priceFunction := generic_rest.NewGenericRestPriceFunction(tc.config)
+			if tc.medianFunctionFails {
+				prices, volumes, unavailable, err = priceFunction(response, tc.exponentMap, testutil.MedianErr)
+			} else {
+				prices, volumes, unavailable, err = priceFunction(response, tc.exponentMap, lib.Median[uint64])
+			}
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, volumes)
+				require.Nil(t, unavailable)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedPriceMap, prices)
+				require.Equal(t, tc.expectedVolumeMap, volumes)
+				pricefeed.ErrorMapsEqual(t, tc.expectedUnavailableMap, unavailable)
+			}
+		})
+	}
+}
+
+func TestNewMarketExchangeQueryDetails(t *testing.T) {
+	details := generic_rest.NewMarketExchangeQueryDetails(
+		types.GetGenericRestExchangeId(2),
+		stringPricesConfig,
+	)
+	require.Equal(t, "GenericRest2", details.Exchange)
+	require.Equal(t, stringPricesConfig.Url, details.Url)
+	require.NotNil(t, details.PriceFunction)
+	require.False(t, details.IsMultiMarket)
+	require.False(t, details.SupportsStreaming())
+}
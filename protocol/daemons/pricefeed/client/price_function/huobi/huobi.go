@@ -22,6 +22,7 @@ type HuobiTicker struct {
 	AskPrice  float64 `json:"ask" validate:"required,gt=0"`
 	BidPrice  float64 `json:"bid" validate:"required,gt=0"`
 	LastPrice float64 `json:"close" validate:"required,gt=0"`
+	Volume    float64 `json:"amount"`
 }
 
 // Ensure that HuobiTicker implements the Ticker interface at compile time.
@@ -43,22 +44,31 @@ func (t HuobiTicker) GetLastPrice() string {
 	return price_function.ConvertFloat64ToString(t.LastPrice)
 }
 
+func (t HuobiTicker) GetVolume() string {
+	return price_function.ConvertFloat64ToString(t.Volume)
+}
+
 // HuobiPriceFunction transforms an API response from Huobi into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func HuobiPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body.
 	var huobiResponseBody HuobiResponseBody
 	err = json.NewDecoder(response.Body).Decode(&huobiResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if huobiResponseBody.Status != "ok" {
-		return nil, nil, errors.New(`huobi response status is not "ok"`)
+		return nil, nil, nil, errors.New(`huobi response status is not "ok"`)
 	}
 
 	return price_function.GetMedianPricesFromTickers(
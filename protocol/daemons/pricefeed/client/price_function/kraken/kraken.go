@@ -20,6 +20,8 @@ type KrakenTickerResult struct {
 	AskPriceStats   []string `json:"a" validate:"len=3,dive,positive-float-string"`
 	BidPriceStats   []string `json:"b" validate:"len=3,dive,positive-float-string"`
 	ClosePriceStats []string `json:"c" validate:"len=2,dive,positive-float-string"`
+	// VolumeStats holds today's volume and the volume of the last 24 hours.
+	VolumeStats []string `json:"v"`
 }
 
 // Ensure that KrakenTickerResult implements the TickerResult interface at compile time.
@@ -46,6 +48,13 @@ func (ktr KrakenTickerResult) GetLastPrice() string {
 	return ktr.ClosePriceStats[0]
 }
 
+func (ktr KrakenTickerResult) GetVolume() string {
+	if len(ktr.VolumeStats) < 2 {
+		return ""
+	}
+	return ktr.VolumeStats[1]
+}
+
 type KrakenResponseBody struct {
 	// As of this time, the Kraken API response is all-or-nothing - either valid ticker data, or one or more errors,
 	// but not both. We enforce this expectation by defining mutual exclusivity in the validation tags of the Errors
@@ -60,10 +69,15 @@ func KrakenPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	var responseBody KrakenResponseBody
 	if err := json.NewDecoder(response.Body).Decode(&responseBody); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	// The Kraken API will return an empty list of errors with an API result containing valid tickers. However, it's
 	// easier for us to validate that there were no errors if this field is set to nil whenever it's empty.
@@ -76,7 +90,7 @@ func KrakenPriceFunction(
 		apiCallError := fmt.Errorf(
 			"kraken API call error: %w", errors.New(strings.Join(responseBody.Errors, ", ")),
 		)
-		return nil, nil, apiCallError
+		return nil, nil, nil, apiCallError
 	}
 
 	tickers := make([]KrakenTickerResult, 0, len(responseBody.Tickers))
@@ -105,9 +105,9 @@ func TestKrakenPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = kraken.KrakenPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = kraken.KrakenPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = kraken.KrakenPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = kraken.KrakenPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -122,3 +122,19 @@ func TestKrakenPriceFunction_Mixed(t *testing.T) {
 		})
 	}
 }
+
+func TestKrakenPriceFunction_Volume(t *testing.T) {
+	response := testutil.CreateResponseFromJson(pricefeed.ReadJsonTestFile(t, "kraken_2_ticker_response.json"))
+
+	// The volume of the last 24 hours is used.
+	_, volumes, _, err := kraken.KrakenPriceFunction(response, BtcAndEthExponentMap, lib.Median[uint64])
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]uint64{
+			ETHUSDC_TICKER: 31_422_362_505,
+			BTCUSDC_TICKER: 5_239_618_376,
+		},
+		volumes,
+	)
+}
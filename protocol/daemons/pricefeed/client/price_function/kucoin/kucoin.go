@@ -27,6 +27,7 @@ type KucoinTicker struct {
 	AskPrice  string `json:"sell" validate:"required,positive-float-string"`
 	BidPrice  string `json:"buy" validate:"required,positive-float-string"`
 	LastPrice string `json:"last" validate:"required,positive-float-string"`
+	Volume    string `json:"vol"`
 }
 
 // Ensure that KucoinTicker implements the Ticker interface at compile time.
@@ -48,22 +49,31 @@ func (t KucoinTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t KucoinTicker) GetVolume() string {
+	return t.Volume
+}
+
 // KucoinPriceFunction transforms an API response from Kucoin into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func KucoinPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body.
 	var kucoinResponseBody KucoinResponseBody
 	err = json.NewDecoder(response.Body).Decode(&kucoinResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if kucoinResponseBody.Code != "200000" {
-		return nil, nil, errors.New(`kucoin response code is not "200000"`)
+		return nil, nil, nil, errors.New(`kucoin response code is not "200000"`)
 	}
 
 	return price_function.GetMedianPricesFromTickers(
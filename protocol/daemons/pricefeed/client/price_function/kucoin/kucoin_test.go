@@ -219,9 +219,9 @@ func TestKucoinPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = kucoin.KucoinPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = kucoin.KucoinPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = kucoin.KucoinPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = kucoin.KucoinPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
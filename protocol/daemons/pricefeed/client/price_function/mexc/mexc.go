@@ -22,6 +22,7 @@ type MexcTicker struct {
 	AskPrice  string `json:"ask" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bid" validate:"required,positive-float-string"`
 	LastPrice string `json:"last" validate:"required,positive-float-string"`
+	Volume    string `json:"volume"`
 }
 
 // Ensure that MexcTicker implements the Ticker interface at compile time.
@@ -43,22 +44,31 @@ func (t MexcTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t MexcTicker) GetVolume() string {
+	return t.Volume
+}
+
 // MexcPriceFunction transforms an API response from Mexc into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func MexcPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body.
 	var mexcResponseBody MexcResponseBody
 	err = json.NewDecoder(response.Body).Decode(&mexcResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if mexcResponseBody.Code != 200 {
-		return nil, nil, errors.New(`mexc response code is not 200`)
+		return nil, nil, nil, errors.New(`mexc response code is not 200`)
 	}
 
 	return price_function.GetMedianPricesFromTickers(
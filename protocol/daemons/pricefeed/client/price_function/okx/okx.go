@@ -22,6 +22,7 @@ type OkxTicker struct {
 	AskPrice  string `json:"askPx" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bidPx" validate:"required,positive-float-string"`
 	LastPrice string `json:"last" validate:"required,positive-float-string"`
+	Volume    string `json:"vol24h"`
 }
 
 // Ensure that OkxTicker implements the Ticker interface at compile time.
@@ -43,22 +44,31 @@ func (t OkxTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t OkxTicker) GetVolume() string {
+	return t.Volume
+}
+
 // OkxPriceFunction transforms an API response from Okx into a map of tickers
 // to prices that have been shifted by a market specific exponent.
 func OkxPriceFunction(
 	response *http.Response,
 	marketPriceExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body.
 	var okxResponseBody OkxResponseBody
 	err = json.NewDecoder(response.Body).Decode(&okxResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if okxResponseBody.Code != "0" {
-		return nil, nil, errors.New(`okx response code is not "0"`)
+		return nil, nil, nil, errors.New(`okx response code is not "0"`)
 	}
 
 	return price_function.GetMedianPricesFromTickers(
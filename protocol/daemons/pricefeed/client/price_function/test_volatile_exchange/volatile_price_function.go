@@ -36,6 +36,11 @@ func (t VolatileExchangeTicker) GetLastPrice() string {
 	return t.Price
 }
 
+// GetVolume returns an empty volume since the test exchange does not simulate trading volume.
+func (t VolatileExchangeTicker) GetVolume() string {
+	return ""
+}
+
 // VolatileExchangePriceFunction generates a time-based price value. The value follows a cosine wave
 // function, but that includes jumps from the lowest value to the highest value (and vice versa)
 // once per period. The general formula is written below.
@@ -49,7 +54,12 @@ func VolatileExchangePriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Calculate the phase, how far in the period we are.
 	// The phase is a value that goes from 0 to 1 over the timespan of (1 day / frequency).
 	phase := math.Mod(
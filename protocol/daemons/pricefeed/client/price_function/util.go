@@ -3,6 +3,7 @@ package price_function
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"regexp"
 	"strconv"
@@ -12,6 +13,11 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
+const (
+	// VolumeDecimals is the number of decimals of precision kept when converting exchange volumes to uint64.
+	VolumeDecimals = 6
+)
+
 var (
 	apiResponseValidator *validator.Validate
 
@@ -165,31 +171,60 @@ func reverseShiftFloatWithPow10(value *big.Float, pow10 *big.Float, exponent int
 }
 
 // Ticker encodes a ticker response returned by an exchange API. It contains accessors for the ticker's
-// ask price, bid price, and last price, which are medianized to compute an exchange price.
+// ask price, bid price, and last price, which are medianized to compute an exchange price, and for the
+// ticker's trading volume, which is used to weight the exchange price in volume-weighted index prices.
 type Ticker interface {
 	GetPair() string
 	GetAskPrice() string
 	GetBidPrice() string
 	GetLastPrice() string
+	// GetVolume returns the trading volume of the ticker as reported by the exchange, or an empty string
+	// if the exchange does not report volume. Volume is not validated.
+	GetVolume() string
+}
+
+// ConvertVolumeToUint64 converts a volume string reported by an exchange into a uint64 with `VolumeDecimals`
+// decimals of precision, so that volumes below 1 still carry weight. Any further fractional part is
+// truncated. Volumes are only used as relative weights, so the scale is irrelevant as long as it is the same
+// for all exchanges. Volumes that are missing, malformed or negative are converted to 0, and volumes that
+// overflow a uint64 are capped at the max uint64.
+func ConvertVolumeToUint64(volume string) uint64 {
+	bigVolume, ok := new(big.Rat).SetString(volume)
+	if !ok || bigVolume.Sign() <= 0 {
+		return 0
+	}
+	scaledVolume := new(big.Int).Mul(bigVolume.Num(), lib.BigPow10(VolumeDecimals))
+	scaledVolume.Quo(scaledVolume, bigVolume.Denom())
+	if !scaledVolume.IsUint64() {
+		return math.MaxUint64
+	}
+	return scaledVolume.Uint64()
 }
 
 // GetMedianPricesFromTickers processes through a list of `tickers` and calculates a median price (from
 // `LastPrice`, `AskPrice`, and `BidPrice`) for each ticker in `tickerToExponent` and marks a ticker
 // as unavailable if it's not present in `tickers` or its ticker's validation or calculation fails.
+// The volume of each ticker with a price is returned in `tickerToVolume`.
 func GetMedianPricesFromTickers[T Ticker](
 	tickers []T,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Create API response validator, if not already.
 	if apiResponseValidator == nil {
 		apiResponseValidator, err = GetApiResponseValidator()
 		if err != nil {
-			return nil, nil, fmt.Errorf("Error creating API response validator (%w)", err)
+			return nil, nil, nil, fmt.Errorf("Error creating API response validator (%w)", err)
 		}
 	}
 
 	tickerToPrice = make(map[string]uint64, len(tickerToExponent))
+	tickerToVolume = make(map[string]uint64, len(tickerToExponent))
 	unavailableTickers = make(map[string]error)
 
 	// Iterate through every ticker in response and calculate median prices for requested
@@ -226,6 +261,7 @@ func GetMedianPricesFromTickers[T Ticker](
 				continue
 			} else {
 				tickerToPrice[tickerPair] = medianPrice
+				tickerToVolume[tickerPair] = ConvertVolumeToUint64(ticker.GetVolume())
 			}
 		}
 	}
@@ -240,7 +276,33 @@ func GetMedianPricesFromTickers[T Ticker](
 		}
 	}
 
-	return tickerToPrice, unavailableTickers, nil
+	return tickerToPrice, tickerToVolume, unavailableTickers, nil
+}
+
+// GetMedianPricesFromStreamedTicker calculates the median price of a single ticker received over an exchange's
+// WebSocket subscription. Unlike `GetMedianPricesFromTickers`, tickers in `tickerToExponent` that are not present
+// in the message are not marked as unavailable, since each streamed message only updates a subset of tickers.
+// Tickers that are not present in `tickerToExponent` are ignored.
+func GetMedianPricesFromStreamedTicker[T Ticker](
+	ticker T,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	exponent, exists := tickerToExponent[ticker.GetPair()]
+	if !exists {
+		return map[string]uint64{}, map[string]uint64{}, map[string]error{}, nil
+	}
+
+	return GetMedianPricesFromTickers(
+		[]T{ticker},
+		map[string]int32{ticker.GetPair(): exponent},
+		resolver,
+	)
 }
 
 // ConvertFloat64ToString converts a `float64` to `string`.
@@ -3,6 +3,7 @@ package price_function
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"testing"
 
@@ -431,3 +432,52 @@ func bigSliceToFloatSlice(bigFloat []*big.Float) []float64 {
 
 	return floatSlice
 }
+
+func TestConvertVolumeToUint64(t *testing.T) {
+	tests := map[string]struct {
+		// parameters
+		volume string
+
+		// expectations
+		expectedVolume uint64
+	}{
+		"Fractional volume is scaled": {
+			volume:         "62285.78422000",
+			expectedVolume: 62_285_784_220,
+		},
+		"Volume less than one is scaled": {
+			volume:         "0.5",
+			expectedVolume: 500_000,
+		},
+		"Precision beyond volume decimals is truncated": {
+			volume:         "0.0000019",
+			expectedVolume: 1,
+		},
+		"Volume in scientific notation": {
+			volume:         "1.5e3",
+			expectedVolume: 1_500_000_000,
+		},
+		"Empty volume": {
+			volume:         "",
+			expectedVolume: 0,
+		},
+		"Malformed volume": {
+			volume:         "not a number",
+			expectedVolume: 0,
+		},
+		"Negative volume": {
+			volume:         "-100",
+			expectedVolume: 0,
+		},
+		"Volume overflowing uint64 is capped": {
+			volume:         "1e30",
+			expectedVolume: math.MaxUint64,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedVolume, ConvertVolumeToUint64(tc.volume))
+		})
+	}
+}
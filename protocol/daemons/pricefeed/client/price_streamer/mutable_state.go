@@ -0,0 +1,82 @@
+package price_streamer
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+)
+
+// mutableState stores the mutable state of the price streamer.
+// These parameters are updated periodically by a go routine that polls the pricefeed server
+// for the current exchange and market configs. All access is synchronized by a mutex.
+type mutableState struct {
+	sync.Mutex
+	// Access to all following fields is protected.
+	// mutableExchangeConfig contains a copy of the current MutableExchangeMarketConfig for the exchange.
+	mutableExchangeConfig *types.MutableExchangeMarketConfig
+	// marketExponents maps market ids to exponents for all markets supported by the exchange.
+	marketExponents map[types.MarketId]types.Exponent
+	// version is incremented on every update so that the price streamer can detect that its current
+	// subscription is out of date.
+	version uint64
+}
+
+// Update updates all fields in the mutableState atomically. This method expects validation to occur
+// in the PriceStreamer. This method is synchronized.
+func (ms *mutableState) Update(
+	config *types.MutableExchangeMarketConfig,
+	marketExponents map[types.MarketId]types.Exponent,
+) {
+	ms.Lock()
+	defer ms.Unlock()
+
+	ms.mutableExchangeConfig = config
+	ms.marketExponents = marketExponents
+	ms.version++
+}
+
+// GetVersion returns the number of updates applied to the mutableState. This method is synchronized.
+func (ms *mutableState) GetVersion() uint64 {
+	ms.Lock()
+	defer ms.Unlock()
+
+	return ms.version
+}
+
+// getStreamDefinition returns a snapshot of the current price streamer mutable state, expressed in terms
+// of the exchange-specific tickers the price streamer subscribes to.
+func (ms *mutableState) getStreamDefinition() *streamDefinition {
+	ms.Lock()
+	defer ms.Unlock()
+
+	marketIds := ms.mutableExchangeConfig.GetMarketIds()
+	definition := &streamDefinition{
+		tickers:          make([]string, 0, len(marketIds)),
+		tickerToExponent: make(map[string]int32, len(marketIds)),
+		tickerToMarketId: make(map[string]types.MarketId, len(marketIds)),
+		version:          ms.version,
+	}
+	for _, marketId := range marketIds {
+		ticker := ms.mutableExchangeConfig.MarketToMarketConfig[marketId].Ticker
+		definition.tickers = append(definition.tickers, ticker)
+		definition.tickerToExponent[ticker] = ms.marketExponents[marketId]
+		definition.tickerToMarketId[ticker] = marketId
+	}
+	sort.Strings(definition.tickers)
+
+	return definition
+}
+
+// streamDefinition is a snapshot of the price streamer's mutable state that is used for the lifetime of
+// a single WebSocket connection.
+type streamDefinition struct {
+	// tickers are the exchange-specific tickers to subscribe to, in sorted order.
+	tickers []string
+	// tickerToExponent maps each ticker to the price exponent of its market.
+	tickerToExponent map[string]int32
+	// tickerToMarketId maps each ticker back to its market id.
+	tickerToMarketId map[string]types.MarketId
+	// version is the version of the mutable state this definition was created from.
+	version uint64
+}
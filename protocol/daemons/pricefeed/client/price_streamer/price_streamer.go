@@ -0,0 +1,391 @@
+package price_streamer
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	gometrics "github.com/armon/go-metrics"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/gorilla/websocket"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_fetcher"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
+)
+
+// streamResult describes why a single WebSocket connection was closed by the price streamer.
+type streamResult int
+
+const (
+	// streamStopped indicates that the price streamer was stopped.
+	streamStopped streamResult = iota
+	// streamResubscribe indicates that the exchange config was updated and the price streamer should
+	// immediately reconnect with the new set of tickers.
+	streamResubscribe
+	// streamFailed indicates that the connection failed and the price streamer should reconnect after a delay.
+	streamFailed
+)
+
+// PriceStreamer streams prices from an exchange over a persistent WebSocket subscription based on the
+// `exchangeDetails` specifications and then encodes the prices or any associated errors. It is an alternative
+// to the `PriceFetcher` for exchanges that support streaming, and shares the same buffered channel interface
+// with the exchange's price encoder.
+type PriceStreamer struct {
+	exchangeQueryConfig types.ExchangeQueryConfig
+	exchangeDetails     types.ExchangeQueryDetails
+	timeProvider        libtime.TimeProvider
+	logger              log.Logger
+	bCh                 chan<- *price_fetcher.PriceFetcherSubtaskResponse
+
+	// initialReconnectBackoff and maxReconnectBackoff bound the exponential backoff between reconnection attempts.
+	initialReconnectBackoff time.Duration
+	maxReconnectBackoff     time.Duration
+	// readTimeout is the maximum time to wait for a message before the connection is considered dead.
+	readTimeout time.Duration
+
+	// configUpdated is signalled whenever the exchange config is updated so that the price streamer can
+	// resubscribe with the new set of tickers.
+	configUpdated chan struct{}
+
+	// mutableState contains all mutable state on the price streamer, with access and update protected by a mutex.
+	mutableState *mutableState
+}
+
+// NewPriceStreamer creates a new PriceStreamer struct. It manages a WebSocket subscription to an exchange
+// and encodes the streamed prices or related errors into the shared buffered channel `bCh`.
+func NewPriceStreamer(
+	exchangeQueryConfig types.ExchangeQueryConfig,
+	exchangeDetails types.ExchangeQueryDetails,
+	mutableExchangeConfig *types.MutableExchangeMarketConfig,
+	mutableMarketConfigs []*types.MutableMarketConfig,
+	timeProvider libtime.TimeProvider,
+	logger log.Logger,
+	bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
+) (
+	*PriceStreamer,
+	error,
+) {
+	if !exchangeDetails.SupportsStreaming() {
+		return nil, fmt.Errorf("exchange %v does not support streaming", exchangeQueryConfig.ExchangeId)
+	}
+
+	// Configure price streamer logger to have streamer-specific metadata.
+	psLogger := logger.With(
+		constants.SubmoduleLogKey,
+		constants.PriceStreamerSubmoduleName,
+		constants.ExchangeIdLogKey,
+		exchangeQueryConfig.ExchangeId,
+	)
+
+	ps := &PriceStreamer{
+		exchangeQueryConfig:     exchangeQueryConfig,
+		exchangeDetails:         exchangeDetails,
+		timeProvider:            timeProvider,
+		logger:                  psLogger,
+		bCh:                     bCh,
+		initialReconnectBackoff: constants.WebsocketInitialReconnectBackoff,
+		maxReconnectBackoff:     constants.WebsocketMaxReconnectBackoff,
+		readTimeout:             constants.WebsocketReadTimeout,
+		configUpdated:           make(chan struct{}, 1),
+		mutableState:            &mutableState{},
+	}
+
+	// This will instantiate the price streamer's mutable state.
+	err := ps.UpdateMutableExchangeConfig(mutableExchangeConfig, mutableMarketConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+// GetExchangeId returns the exchange id for the exchange streamed by the price streamer.
+// This method is added to support the ExchangeConfigUpdater interface.
+func (ps *PriceStreamer) GetExchangeId() types.ExchangeId {
+	return ps.exchangeQueryConfig.ExchangeId
+}
+
+// UpdateMutableExchangeConfig updates the price streamer with the most current copy of the exchange config, as
+// well as all markets supported by the exchange. The price streamer resubscribes with the new config.
+// This method is added to support the ExchangeConfigUpdater interface.
+func (ps *PriceStreamer) UpdateMutableExchangeConfig(
+	newConfig *types.MutableExchangeMarketConfig,
+	newMarketConfigs []*types.MutableMarketConfig,
+) error {
+	// 1. Validate new config.
+	if newConfig.Id != ps.exchangeQueryConfig.ExchangeId {
+		return fmt.Errorf("PriceStreamer.UpdateMutableExchangeConfig: exchange id mismatch")
+	}
+
+	if err := newConfig.Validate(newMarketConfigs); err != nil {
+		return fmt.Errorf("PriceStreamer.UpdateMutableExchangeConfig: invalid exchange config update: %w", err)
+	}
+
+	// 2. Compute market exponents.
+	marketExponents := make(map[types.MarketId]types.Exponent, len(newMarketConfigs))
+	for _, marketConfig := range newMarketConfigs {
+		marketExponents[marketConfig.Id] = marketConfig.Exponent
+	}
+
+	// 3. Perform update and notify the streaming loop without blocking. The channel has a buffer of 1, so
+	// if a notification is already pending, the streaming loop will pick up this update as well.
+	ps.mutableState.Update(newConfig, marketExponents)
+	select {
+	case ps.configUpdated <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run streams prices from the exchange until `stop` is closed. Whenever the connection fails, Run reconnects
+// with exponential backoff, and whenever the exchange config is updated, Run immediately resubscribes with the
+// new set of tickers. Run closes the buffered channel on return to signal to the price encoder that no more
+// prices will be written.
+func (ps *PriceStreamer) Run(stop <-chan bool) {
+	defer close(ps.bCh)
+
+	backoff := ps.initialReconnectBackoff
+	for {
+		result, receivedPrices, err := ps.stream(stop)
+		switch result {
+		case streamStopped:
+			return
+		case streamResubscribe:
+			backoff = ps.initialReconnectBackoff
+			continue
+		}
+
+		// The connection failed. Report the error to the price encoder and reconnect after a delay. The
+		// delay is reset if the connection was healthy enough to deliver prices before failing.
+		ps.writeToBufferedChannel(nil, err)
+		if receivedPrices {
+			backoff = ps.initialReconnectBackoff
+		}
+
+		ps.logger.Info(
+			"price_streamer: connection failed, reconnecting",
+			constants.ErrorLogKey,
+			err,
+			"backoff",
+			backoff,
+		)
+		telemetry.IncrCounterWithLabels(
+			[]string{
+				metrics.PricefeedDaemon,
+				metrics.PriceStreamerReconnect,
+				metrics.Count,
+			},
+			1,
+			[]gometrics.Label{pricefeedmetrics.GetLabelForExchangeId(ps.GetExchangeId())},
+		)
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(backoff):
+		}
+		backoff = lib.Min(2*backoff, ps.maxReconnectBackoff)
+	}
+}
+
+// stream connects to the exchange, subscribes to the tickers of all markets currently supported by the exchange
+// and processes messages until the price streamer is stopped, the exchange config is updated, or the connection
+// fails. If the exchange currently supports no markets, stream waits for a config update without connecting.
+// Returns why the stream ended, whether any prices were received, and the error that caused the connection
+// to fail, if any.
+func (ps *PriceStreamer) stream(
+	stop <-chan bool,
+) (
+	result streamResult,
+	receivedPrices bool,
+	err error,
+) {
+	definition := ps.mutableState.getStreamDefinition()
+
+	// messages and readErrs are never written to if no connection is made.
+	messages := make(chan []byte)
+	readErrs := make(chan error, 1)
+	if len(definition.tickers) > 0 {
+		conn, err := ps.connect(definition.tickers)
+		if err != nil {
+			return streamFailed, false, err
+		}
+
+		done := make(chan struct{})
+		defer func() {
+			close(done)
+			conn.Close()
+		}()
+		go ps.readMessages(conn, messages, readErrs, done)
+	}
+
+	for {
+		select {
+		case <-stop:
+			return streamStopped, receivedPrices, nil
+		case <-ps.configUpdated:
+			// Notifications may predate the creation of this definition, in which case the subscription is current.
+			if ps.mutableState.GetVersion() != definition.version {
+				return streamResubscribe, receivedPrices, nil
+			}
+		case err := <-readErrs:
+			return streamFailed, receivedPrices, err
+		case message := <-messages:
+			if ps.processMessage(message, definition) {
+				receivedPrices = true
+			}
+		}
+	}
+}
+
+// connect dials the exchange's WebSocket endpoint and sends the messages that subscribe to price updates
+// for `tickers`.
+func (ps *PriceStreamer) connect(tickers []string) (*websocket.Conn, error) {
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: time.Duration(ps.exchangeQueryConfig.TimeoutMs) * time.Millisecond,
+	}
+	conn, _, err := dialer.Dial(ps.exchangeDetails.Websocket.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	subscribeMessages, err := ps.exchangeDetails.Websocket.SubscribeMessages(tickers)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	for _, message := range subscribeMessages {
+		if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	ps.logger.Info("price_streamer: subscribed to exchange", "tickers", tickers)
+	telemetry.IncrCounterWithLabels(
+		[]string{
+			metrics.PricefeedDaemon,
+			metrics.PriceStreamerConnect,
+			metrics.Count,
+		},
+		1,
+		[]gometrics.Label{pricefeedmetrics.GetLabelForExchangeId(ps.GetExchangeId())},
+	)
+	return conn, nil
+}
+
+// readMessages reads messages from `conn` and forwards them to `messages` until reading fails, in which
+// case the error is written to `readErrs`, or until `done` is closed.
+func (ps *PriceStreamer) readMessages(
+	conn *websocket.Conn,
+	messages chan<- []byte,
+	readErrs chan<- error,
+	done <-chan struct{},
+) {
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(ps.readTimeout)); err != nil {
+			readErrs <- err
+			return
+		}
+
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			readErrs <- err
+			return
+		}
+
+		select {
+		case messages <- message:
+		case <-done:
+			return
+		}
+	}
+}
+
+// processMessage transforms a single message from the exchange into market prices and writes the prices, or any
+// associated errors, to the buffered channel. Returns true if the message contained at least one price.
+func (ps *PriceStreamer) processMessage(message []byte, definition *streamDefinition) bool {
+	exchangeId := ps.GetExchangeId()
+
+	prices, volumes, _, err := ps.exchangeDetails.Websocket.PriceFunction(
+		message,
+		definition.tickerToExponent,
+		lib.Median[uint64],
+	)
+	if err != nil {
+		ps.writeToBufferedChannel(nil, price_function.NewExchangeError(exchangeId, err.Error()))
+		return false
+	}
+
+	now := ps.timeProvider.Now()
+	for ticker, price := range prices {
+		marketId, ok := definition.tickerToMarketId[ticker]
+		if !ok {
+			ps.writeToBufferedChannel(
+				nil,
+				fmt.Errorf("Severe unexpected error: no market id for ticker: %v", ticker),
+			)
+			continue
+		}
+
+		// No price should validly be zero. A price of zero points to an error in the API queried.
+		if price == uint64(0) {
+			ps.writeToBufferedChannel(
+				nil,
+				fmt.Errorf(
+					"Invalid price of 0 for exchange: '%v' and market: %v",
+					exchangeId,
+					marketId,
+				),
+			)
+			continue
+		}
+
+		ps.logger.Debug(
+			"price_streamer: Adding new price for market.",
+			constants.PriceLogKey,
+			price,
+			constants.MarketIdLogKey,
+			marketId,
+			"LastUpdatedAt",
+			now,
+		)
+
+		ps.writeToBufferedChannel(
+			&types.MarketPriceTimestamp{
+				MarketId:      marketId,
+				Price:         price,
+				Volume:        volumes[ticker],
+				LastUpdatedAt: now,
+			},
+			nil,
+		)
+	}
+	return len(prices) > 0
+}
+
+// writeToBufferedChannel writes the (price, error) generated during streaming to the price streamer's
+// buffered channel, which outputs the result to the price encoder.
+func (ps *PriceStreamer) writeToBufferedChannel(
+	price *types.MarketPriceTimestamp,
+	err error,
+) {
+	// Sanity check that the channel is not full already.
+	if len(ps.bCh) == constants.FixedBufferSize {
+		// Log if writing to buffered channel failed.
+		ps.logger.Error("Pricefeed daemon's shared buffer is full.")
+	}
+
+	ps.bCh <- &price_fetcher.PriceFetcherSubtaskResponse{
+		Err:   err,
+		Price: price,
+	}
+}
@@ -0,0 +1,381 @@
+package price_streamer
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_fetcher"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+)
+
+const (
+	// testTimeout bounds how long tests wait for the price streamer or the fake exchange to make progress.
+	testTimeout = 5 * time.Second
+	// testVolume is the volume reported with every price pushed by the fake exchange.
+	testVolume = uint64(10)
+)
+
+var (
+	streamTime = time.Unix(1_000, 0)
+)
+
+// fakeExchange is a local WebSocket server that records the subscription sent on each connection and exposes
+// each connection to the test so that it can push messages or disconnect the price streamer.
+type fakeExchange struct {
+	*httptest.Server
+	subscriptions chan string
+	connections   chan *websocket.Conn
+}
+
+func newFakeExchange(t *testing.T) *fakeExchange {
+	exchange := &fakeExchange{
+		subscriptions: make(chan string, 10),
+		connections:   make(chan *websocket.Conn, 10),
+	}
+	upgrader := websocket.Upgrader{}
+	exchange.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, subscription, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		exchange.subscriptions <- string(subscription)
+		exchange.connections <- conn
+
+		// Keep the connection open until either side closes it.
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(exchange.Close)
+	return exchange
+}
+
+// nextConnection waits for the price streamer to connect and returns the connection and its subscription.
+func (e *fakeExchange) nextConnection(t *testing.T) (*websocket.Conn, string) {
+	select {
+	case subscription := <-e.subscriptions:
+		return <-e.connections, subscription
+	case <-time.After(testTimeout):
+		require.FailNow(t, "timed out waiting for price streamer to connect")
+		return nil, ""
+	}
+}
+
+// testWebsocketDetails returns WebsocketDetails for an exchange that expects a comma-separated list of tickers
+// as its subscription and pushes prices as messages of the form "<ticker>=<price>". Every price is reported
+// with a volume of `testVolume`.
+func testWebsocketDetails(url string) *types.WebsocketDetails {
+	return &types.WebsocketDetails{
+		Url: url,
+		SubscribeMessages: func(tickers []string) ([][]byte, error) {
+			return [][]byte{[]byte(strings.Join(tickers, ","))}, nil
+		},
+		PriceFunction: func(
+			message []byte,
+			tickerToExponent map[string]int32,
+			resolver pricefeedtypes.Resolver,
+		) (map[string]uint64, map[string]uint64, map[string]error, error) {
+			ticker, priceString, found := strings.Cut(string(message), "=")
+			if !found {
+				return nil, nil, nil, errors.New("invalid message")
+			}
+			if _, ok := tickerToExponent[ticker]; !ok {
+				return map[string]uint64{}, map[string]uint64{}, map[string]error{}, nil
+			}
+			price, err := strconv.ParseUint(priceString, 10, 64)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			return map[string]uint64{ticker: price}, map[string]uint64{ticker: testVolume}, map[string]error{}, nil
+		},
+	}
+}
+
+// newTestPriceStreamer returns a price streamer connected to `exchange` with short reconnection delays.
+func newTestPriceStreamer(
+	t *testing.T,
+	exchange *fakeExchange,
+	mutableExchangeConfig types.MutableExchangeMarketConfig,
+	mutableMarketConfigs []*types.MutableMarketConfig,
+) (*PriceStreamer, chan *price_fetcher.PriceFetcherSubtaskResponse) {
+	timeProvider := &mocks.TimeProvider{}
+	timeProvider.On("Now").Return(streamTime)
+
+	bCh := make(chan *price_fetcher.PriceFetcherSubtaskResponse, 10)
+	ps, err := NewPriceStreamer(
+		constants.Exchange1_1MaxQueries_QueryConfig,
+		types.ExchangeQueryDetails{
+			Exchange:  constants.ExchangeId1,
+			Websocket: testWebsocketDetails("ws" + strings.TrimPrefix(exchange.URL, "http")),
+		},
+		&mutableExchangeConfig,
+		mutableMarketConfigs,
+		timeProvider,
+		log.NewNopLogger(),
+		bCh,
+	)
+	require.NoError(t, err)
+
+	ps.initialReconnectBackoff = time.Millisecond
+	ps.maxReconnectBackoff = 10 * time.Millisecond
+	return ps, bCh
+}
+
+// nextResponse waits for the price streamer to write a response to the buffered channel.
+func nextResponse(
+	t *testing.T,
+	bCh <-chan *price_fetcher.PriceFetcherSubtaskResponse,
+) *price_fetcher.PriceFetcherSubtaskResponse {
+	select {
+	case response, ok := <-bCh:
+		require.True(t, ok, "buffered channel closed unexpectedly")
+		return response
+	case <-time.After(testTimeout):
+		require.FailNow(t, "timed out waiting for price streamer response")
+		return nil
+	}
+}
+
+// requireStopped stops the price streamer and verifies that it closes the buffered channel.
+func requireStopped(t *testing.T, stop chan bool, bCh <-chan *price_fetcher.PriceFetcherSubtaskResponse) {
+	close(stop)
+	for {
+		select {
+		case _, ok := <-bCh:
+			if !ok {
+				return
+			}
+		case <-time.After(testTimeout):
+			require.FailNow(t, "timed out waiting for price streamer to stop")
+		}
+	}
+}
+
+func TestNewPriceStreamer_StreamingNotSupported(t *testing.T) {
+	_, err := NewPriceStreamer(
+		constants.Exchange1_1MaxQueries_QueryConfig,
+		constants.SingleMarketExchangeQueryDetails,
+		&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_1Markets,
+		&mocks.TimeProvider{},
+		log.NewNopLogger(),
+		make(chan *price_fetcher.PriceFetcherSubtaskResponse),
+	)
+	require.ErrorContains(t, err, "does not support streaming")
+}
+
+func TestUpdateMutableExchangeConfig_Invalid(t *testing.T) {
+	exchange := newFakeExchange(t)
+	ps, _ := newTestPriceStreamer(
+		t,
+		exchange,
+		constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_1Markets,
+	)
+
+	wrongExchangeConfig := constants.Exchange1_1Markets_MutableExchangeMarketConfig.Copy()
+	wrongExchangeConfig.Id = constants.ExchangeId2
+	err := ps.UpdateMutableExchangeConfig(wrongExchangeConfig, constants.MutableMarketConfigs_1Markets)
+	require.ErrorContains(t, err, "exchange id mismatch")
+
+	err = ps.UpdateMutableExchangeConfig(
+		&constants.Exchange1_2Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_1Markets,
+	)
+	require.ErrorContains(t, err, "invalid exchange config update")
+}
+
+func TestRun_StreamsPrices(t *testing.T) {
+	exchange := newFakeExchange(t)
+	ps, bCh := newTestPriceStreamer(
+		t,
+		exchange,
+		constants.Exchange1_2Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_2Markets,
+	)
+	stop := make(chan bool)
+	go ps.Run(stop)
+
+	conn, subscription := exchange.nextConnection(t)
+	require.Equal(t, "BTC-USD,ETH-USD", subscription)
+
+	for _, message := range []string{
+		"BTC-USD=100",
+		"UNSUBSCRIBED-USD=5", // Ignored.
+		"ETH-USD=0",
+		"invalid",
+		"ETH-USD=200",
+	} {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+	}
+
+	require.Equal(
+		t,
+		&price_fetcher.PriceFetcherSubtaskResponse{
+			Price: &types.MarketPriceTimestamp{
+				MarketId:      constants.MarketId7,
+				Price:         100,
+				Volume:        testVolume,
+				LastUpdatedAt: streamTime,
+			},
+		},
+		nextResponse(t, bCh),
+	)
+
+	response := nextResponse(t, bCh)
+	require.Nil(t, response.Price)
+	require.ErrorContains(t, response.Err, "Invalid price of 0")
+
+	response = nextResponse(t, bCh)
+	require.Nil(t, response.Price)
+	var exchangeError price_function.ExchangeError
+	require.ErrorAs(t, response.Err, &exchangeError)
+	require.Equal(t, constants.ExchangeId1, exchangeError.GetExchangeId())
+
+	require.Equal(
+		t,
+		&price_fetcher.PriceFetcherSubtaskResponse{
+			Price: &types.MarketPriceTimestamp{
+				MarketId:      constants.MarketId8,
+				Price:         200,
+				Volume:        testVolume,
+				LastUpdatedAt: streamTime,
+			},
+		},
+		nextResponse(t, bCh),
+	)
+
+	requireStopped(t, stop, bCh)
+}
+
+func TestRun_ReconnectsAfterDisconnect(t *testing.T) {
+	exchange := newFakeExchange(t)
+	ps, bCh := newTestPriceStreamer(
+		t,
+		exchange,
+		constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_1Markets,
+	)
+	stop := make(chan bool)
+	go ps.Run(stop)
+
+	conn, subscription := exchange.nextConnection(t)
+	require.Equal(t, "BTC-USD", subscription)
+
+	// Disconnect the price streamer. The failure is reported to the price encoder.
+	require.NoError(t, conn.Close())
+	response := nextResponse(t, bCh)
+	require.Nil(t, response.Price)
+	require.Error(t, response.Err)
+
+	// The price streamer reconnects, resubscribes and continues to stream prices.
+	conn, subscription = exchange.nextConnection(t)
+	require.Equal(t, "BTC-USD", subscription)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("BTC-USD=100")))
+	require.Equal(t, uint64(100), nextResponse(t, bCh).Price.Price)
+
+	requireStopped(t, stop, bCh)
+}
+
+func TestRun_ReconnectsAfterFailedConnection(t *testing.T) {
+	exchange := newFakeExchange(t)
+	ps, bCh := newTestPriceStreamer(
+		t,
+		exchange,
+		constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_1Markets,
+	)
+	// Point the price streamer at an unreachable endpoint.
+	websocketDetails := *ps.exchangeDetails.Websocket
+	websocketDetails.Url = "ws://127.0.0.1:0"
+	ps.exchangeDetails.Websocket = &websocketDetails
+
+	stop := make(chan bool)
+	go ps.Run(stop)
+
+	// Each failed attempt is reported to the price encoder.
+	for i := 0; i < 3; i++ {
+		response := nextResponse(t, bCh)
+		require.Nil(t, response.Price)
+		require.Error(t, response.Err)
+	}
+
+	requireStopped(t, stop, bCh)
+}
+
+func TestRun_ResubscribesOnConfigUpdate(t *testing.T) {
+	exchange := newFakeExchange(t)
+	ps, bCh := newTestPriceStreamer(
+		t,
+		exchange,
+		constants.Exchange1_NoMarkets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_0Markets,
+	)
+	stop := make(chan bool)
+	go ps.Run(stop)
+
+	// The price streamer does not connect until the exchange supports at least one market.
+	select {
+	case subscription := <-exchange.subscriptions:
+		require.FailNow(t, "unexpected subscription", subscription)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(
+		t,
+		ps.UpdateMutableExchangeConfig(
+			&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+			constants.MutableMarketConfigs_1Markets,
+		),
+	)
+	_, subscription := exchange.nextConnection(t)
+	require.Equal(t, "BTC-USD", subscription)
+
+	require.NoError(
+		t,
+		ps.UpdateMutableExchangeConfig(
+			&constants.Exchange1_2Markets_MutableExchangeMarketConfig,
+			constants.MutableMarketConfigs_2Markets,
+		),
+	)
+	conn, subscription := exchange.nextConnection(t)
+	require.Equal(t, "BTC-USD,ETH-USD", subscription)
+
+	// Prices for the new market are streamed, and resubscribing does not report an error.
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("ETH-USD=200")))
+	require.Equal(
+		t,
+		&price_fetcher.PriceFetcherSubtaskResponse{
+			Price: &types.MarketPriceTimestamp{
+				MarketId:      constants.MarketId8,
+				Price:         200,
+				Volume:        testVolume,
+				LastUpdatedAt: streamTime,
+			},
+		},
+		nextResponse(t, bCh),
+	)
+
+	requireStopped(t, stop, bCh)
+}
@@ -5,9 +5,12 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_encoder"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_fetcher"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_streamer"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
 	pricetypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"net"
 	"net/http"
+	"sort"
 	"time"
 
 	gometrics "github.com/armon/go-metrics"
@@ -18,12 +21,26 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
 )
 
 var (
 	HttpClient = http.Client{
 		Transport: &http.Transport{MaxConnsPerHost: constants.MaxConnectionsPerExchange},
 	}
+
+	// GenericRestHttpClient is used to query generic REST exchanges, whose urls are set through governance. It
+	// refuses to connect to addresses that are not public, including those of hostnames that resolve to loopback
+	// or private addresses, and does not follow redirects, which could otherwise lead to such addresses.
+	GenericRestHttpClient = http.Client{
+		Transport: &http.Transport{
+			MaxConnsPerHost: constants.MaxConnectionsPerExchange,
+			DialContext:     (&net.Dialer{Control: types.CheckPublicAddress}).DialContext,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
 )
 
 // SubTaskRunnerImpl is the struct that implements the `SubTaskRunner` interface.
@@ -59,6 +76,15 @@ type SubTaskRunner interface {
 		logger log.Logger,
 		bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
 	)
+	StartPriceStreamer(
+		stop <-chan bool,
+		configs types.PricefeedMutableMarketConfigs,
+		exchangeQueryConfig types.ExchangeQueryConfig,
+		exchangeDetails types.ExchangeQueryDetails,
+		timeProvider libtime.TimeProvider,
+		logger log.Logger,
+		bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
+	)
 	StartMarketParamUpdater(
 		ctx context.Context,
 		ticker *time.Ticker,
@@ -190,8 +216,12 @@ func (s *SubTaskRunnerImpl) StartPriceFetcher(
 	// itself to the config's list of exchange config updaters here.
 	configs.AddPriceFetcher(priceFetcher)
 
+	httpClient := &HttpClient
+	if types.IsGenericRestExchange(exchangeQueryConfig.ExchangeId) {
+		httpClient = &GenericRestHttpClient
+	}
 	requestHandler := daemontypes.NewRequestHandlerImpl(
-		&HttpClient,
+		httpClient,
 	)
 	// Begin loop to periodically start goroutines to query market prices.
 	for {
@@ -210,6 +240,53 @@ func (s *SubTaskRunnerImpl) StartPriceFetcher(
 	}
 }
 
+// StartPriceStreamer maintains a persistent WebSocket subscription to a specific exchange and sends each
+// streamed market price, transformed to a `MarketPriceTimestamp`, to the buffered channel read by the exchange's
+// price encoder. The price streamer reconnects with exponential backoff whenever the connection fails, and
+// resubscribes whenever the markets supported by the exchange change.
+// NOTE: the subtask response shared channel has a buffer size and the price streamer will block if the buffer
+// is full.
+func (s *SubTaskRunnerImpl) StartPriceStreamer(
+	stop <-chan bool,
+	configs types.PricefeedMutableMarketConfigs,
+	exchangeQueryConfig types.ExchangeQueryConfig,
+	exchangeDetails types.ExchangeQueryDetails,
+	timeProvider libtime.TimeProvider,
+	logger log.Logger,
+	bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
+) {
+	exchangeMarketConfig, err := configs.GetExchangeMarketConfigCopy(exchangeQueryConfig.ExchangeId)
+	if err != nil {
+		panic(err)
+	}
+
+	marketConfigs, err := configs.GetMarketConfigCopies(exchangeMarketConfig.GetMarketIds())
+	if err != nil {
+		panic(err)
+	}
+
+	priceStreamer, err := price_streamer.NewPriceStreamer(
+		exchangeQueryConfig,
+		exchangeDetails,
+		exchangeMarketConfig,
+		marketConfigs,
+		timeProvider,
+		logger,
+		bCh,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// The price streamer takes the place of the price fetcher for this exchange, and receives exchange config
+	// updates in the same way.
+	configs.AddPriceFetcher(priceStreamer)
+
+	// Stream prices until the daemon is stopped. The price streamer closes the shared channel on return to
+	// signal to the encoder that it is done.
+	priceStreamer.Run(stop)
+}
+
 // StartMarketParamUpdater periodically starts a goroutine to update the market parameters that control which
 // markets the daemon queries and how they are queried and computed from each exchange.
 func (s *SubTaskRunnerImpl) StartMarketParamUpdater(
@@ -255,6 +332,7 @@ func RunPriceUpdaterTaskLoop(
 	logger = logger.With(constants.SubmoduleLogKey, constants.PriceUpdaterSubmoduleName)
 	priceUpdates := exchangeToMarketPrices.GetAllPrices()
 	request := transformPriceUpdates(priceUpdates)
+	request.ExchangeStatuses = transformExchangeStatuses(exchangeToMarketPrices.GetAllExchangeStatuses())
 
 	// Measure latency to send prices over gRPC.
 	// Note: intentionally skipping latency for `GetAllPrices`.
@@ -424,6 +502,7 @@ func transformPriceUpdates(
 				ExchangeId:     exchangeId,
 				Price:          marketPriceTimestamp.Price,
 				LastUpdateTime: &priceUpdateTime,
+				Volume:         marketPriceTimestamp.Volume,
 			}
 			marketPriceUpdate.ExchangePrices = append(marketPriceUpdate.ExchangePrices, exchangePrice)
 		}
@@ -441,3 +520,32 @@ func transformPriceUpdates(
 	}
 	return request
 }
+
+// transformExchangeStatuses transforms a map of exchangeIds to `ExchangeStatus` into a list of
+// `api.ExchangeStatus` sorted by exchangeId, to be sent alongside price updates for introspection.
+func transformExchangeStatuses(
+	statuses map[types.ExchangeId]types.ExchangeStatus,
+) []*api.ExchangeStatus {
+	exchangeStatuses := make([]*api.ExchangeStatus, 0, len(statuses))
+	for exchangeId, status := range statuses {
+		exchangeStatus := &api.ExchangeStatus{
+			ExchangeId:      exchangeId,
+			ErrorCount:      status.ErrorCount,
+			LastErrorReason: status.LastErrorReason,
+			LastError:       status.LastError,
+		}
+		if !status.LastSuccessTime.IsZero() {
+			lastSuccessTime := status.LastSuccessTime
+			exchangeStatus.LastSuccessTime = &lastSuccessTime
+		}
+		if !status.LastErrorTime.IsZero() {
+			lastErrorTime := status.LastErrorTime
+			exchangeStatus.LastErrorTime = &lastErrorTime
+		}
+		exchangeStatuses = append(exchangeStatuses, exchangeStatus)
+	}
+	sort.Slice(exchangeStatuses, func(i, j int) bool {
+		return exchangeStatuses[i].ExchangeId < exchangeStatuses[j].ExchangeId
+	})
+	return exchangeStatuses
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 )
 
 // ExchangeConfigJson demarshals the exchange configuration json for a particular market.
@@ -14,6 +16,9 @@ import (
 // because the id is expected to be known at the time the object is in use.
 type ExchangeConfigJson struct {
 	Exchanges []ExchangeMarketConfigJson `json:"exchanges"`
+	// Aggregation configures how the prices reported by each exchange are aggregated into the market's
+	// index price. If omitted, the index price is the median of all exchange prices.
+	Aggregation *types.AggregationConfig `json:"aggregation,omitempty"`
 }
 
 // Validate validates the exchange configuration json, checking that required fields are defined
@@ -32,5 +37,17 @@ func (ecj *ExchangeConfigJson) Validate(
 			return fmt.Errorf("invalid exchange: %w", err)
 		}
 	}
+
+	if err := ecj.GetAggregation().Validate(); err != nil {
+		return fmt.Errorf("invalid aggregation: %w", err)
+	}
 	return nil
 }
+
+// GetAggregation returns the aggregation config of the market, or the zero value if it is omitted.
+func (ecj *ExchangeConfigJson) GetAggregation() types.AggregationConfig {
+	if ecj.Aggregation == nil {
+		return types.AggregationConfig{}
+	}
+	return *ecj.Aggregation
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"fmt"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -22,6 +23,20 @@ func TestExchangeConfigJsonValidate_Mixed(t *testing.T) {
 				},
 			},
 		},
+		"Valid - with aggregation": {
+			exchangeConfigJson: types.ExchangeConfigJson{
+				Exchanges: []types.ExchangeMarketConfigJson{
+					{
+						ExchangeName: "binance",
+						Ticker:       "BTC-USDT",
+					},
+				},
+				Aggregation: &pricefeedtypes.AggregationConfig{
+					Method:          pricefeedtypes.AggregationMethodVolumeWeightedMedian,
+					MaxDeviationPpm: 50_000,
+				},
+			},
+		},
 		"Invalid - no exchanges": {
 			exchangeConfigJson: types.ExchangeConfigJson{},
 			expectedErr:        fmt.Errorf("exchanges cannot be empty"),
@@ -37,6 +52,20 @@ func TestExchangeConfigJsonValidate_Mixed(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("invalid exchange: exchange name 'not-a-real-exchange' is not valid"),
 		},
+		"Invalid - invalid aggregation": {
+			exchangeConfigJson: types.ExchangeConfigJson{
+				Exchanges: []types.ExchangeMarketConfigJson{
+					{
+						ExchangeName: "binance",
+						Ticker:       "BTC-USDT",
+					},
+				},
+				Aggregation: &pricefeedtypes.AggregationConfig{
+					Method: "mode", // invalid
+				},
+			},
+			expectedErr: fmt.Errorf("invalid aggregation: aggregation method 'mode' is not valid"),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
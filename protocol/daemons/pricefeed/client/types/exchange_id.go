@@ -1,5 +1,26 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+)
+
 // ExchangeId is the unique id for an `Exchange` in the `Prices` module.
 // The id will be matched against each exchange's `exchangeName` in the `MarketParam`'s `exchange_config_json`.
 type ExchangeId = string
+
+// GenericRestExchangeIdPrefix prefixes the ids of the exchanges that are queried with the generic REST connector.
+// These exchanges have no dedicated price function. Instead, each market configured on them describes how to
+// query and parse its price with a `GenericRestConfig`.
+const GenericRestExchangeIdPrefix = "GenericRest"
+
+// GetGenericRestExchangeId returns the id of the generic REST exchange with the given index, e.g. `GenericRest1`.
+// The number of generic REST exchanges queried by the price daemon is `NumGenericRestExchanges`.
+func GetGenericRestExchangeId(index uint32) ExchangeId {
+	return fmt.Sprintf("%v%d", GenericRestExchangeIdPrefix, index)
+}
+
+// IsGenericRestExchange returns true if the exchange is queried with the generic REST connector.
+func IsGenericRestExchange(exchangeId ExchangeId) bool {
+	return strings.HasPrefix(exchangeId, GenericRestExchangeIdPrefix)
+}
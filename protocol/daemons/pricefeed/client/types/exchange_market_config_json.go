@@ -12,6 +12,9 @@ type ExchangeMarketConfigJson struct {
 	Ticker         string `json:"ticker"`
 	AdjustByMarket string `json:"adjustByMarket,omitempty"`
 	Invert         bool   `json:"invert,omitempty"`
+	// GenericRest describes how to query and parse the market's price on a generic REST exchange. It is
+	// required for generic REST exchanges and not supported by any other exchange.
+	GenericRest *GenericRestConfig `json:"genericRest,omitempty"`
 }
 
 // Validate validates the exchange market configuration json. It returns an error if the
@@ -41,5 +44,15 @@ func (emcj *ExchangeMarketConfigJson) Validate(
 			return fmt.Errorf("adjustment market '%v' is not valid", emcj.AdjustByMarket)
 		}
 	}
+	if IsGenericRestExchange(emcj.ExchangeName) {
+		if emcj.GenericRest == nil {
+			return fmt.Errorf("genericRest cannot be empty for generic REST exchange '%v'", emcj.ExchangeName)
+		}
+		if err := emcj.GenericRest.Validate(); err != nil {
+			return fmt.Errorf("invalid genericRest: %w", err)
+		}
+	} else if emcj.GenericRest != nil {
+		return fmt.Errorf("genericRest is not supported by exchange '%v'", emcj.ExchangeName)
+	}
 	return nil
 }
@@ -52,11 +52,41 @@ func TestExchangeMarketConfigJsonValidate_Mixed(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("adjustment market 'XYZ-USD' is not valid"),
 		},
+		"Valid - generic REST exchange": {
+			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
+				ExchangeName: "GenericRest1",
+				Ticker:       "BTC-USDT",
+				GenericRest:  validGenericRestConfig(),
+			},
+		},
+		"Invalid - generic REST exchange without generic REST config": {
+			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
+				ExchangeName: "GenericRest1",
+				Ticker:       "BTC-USDT",
+			},
+			expectedErr: fmt.Errorf("genericRest cannot be empty for generic REST exchange 'GenericRest1'"),
+		},
+		"Invalid - generic REST config invalid": {
+			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
+				ExchangeName: "GenericRest1",
+				Ticker:       "BTC-USDT",
+				GenericRest:  &types.GenericRestConfig{},
+			},
+			expectedErr: fmt.Errorf("invalid genericRest: url cannot be empty"),
+		},
+		"Invalid - generic REST config on other exchange": {
+			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
+				ExchangeName: "binance",
+				Ticker:       "BTC-USDT",
+				GenericRest:  validGenericRestConfig(),
+			},
+			expectedErr: fmt.Errorf("genericRest is not supported by exchange 'binance'"),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			err := tc.exchangeMarketConfigJson.Validate(
-				[]types.ExchangeId{"binance"},
+				[]types.ExchangeId{"binance", "GenericRest1"},
 				map[string]types.MarketId{
 					"ABC-USD": 3,
 				},
@@ -11,16 +11,48 @@ type ExchangeQueryDetails struct {
 	Exchange ExchangeId
 	// Url is the url to query the exchange.
 	Url string
-	// PriceFunction computes a map of tickers to prices from an exchange's response
+	// PriceFunction computes a map of tickers to prices, and a map of tickers to the trading volumes reported
+	// by the exchange, from an exchange's response
 	PriceFunction func(
 		response *http.Response,
 		tickerToPriceExponent map[string]int32,
 		resolver types.Resolver,
 	) (
 		tickerToPrice map[string]uint64,
+		tickerToVolume map[string]uint64,
 		unavailableTickers map[string]error,
 		err error,
 	)
 	// IsMultiMarket indicates whether the url query response contains multiple tickers.
 	IsMultiMarket bool
+	// Websocket, if set, describes how to stream prices from the exchange over a persistent WebSocket
+	// subscription. This is an alternative to polling `Url` and is only used if streaming is enabled.
+	Websocket *WebsocketDetails
+}
+
+// SupportsStreaming returns true if prices can be streamed from the exchange over a WebSocket subscription.
+func (eqd ExchangeQueryDetails) SupportsStreaming() bool {
+	return eqd.Websocket != nil
+}
+
+// WebsocketDetails represents the information needed to stream prices from a specific exchange.
+type WebsocketDetails struct {
+	// Url is the url of the exchange's WebSocket endpoint.
+	Url string
+	// SubscribeMessages returns the messages to send to the exchange after connecting in order to subscribe to
+	// price updates for the given tickers.
+	SubscribeMessages func(tickers []string) (messages [][]byte, err error)
+	// PriceFunction computes a map of tickers to prices from a single message received from the exchange. Only
+	// tickers present in the message are returned, along with their trading volumes. Messages that contain no
+	// price data, such as subscription acknowledgements, return empty maps.
+	PriceFunction func(
+		message []byte,
+		tickerToPriceExponent map[string]int32,
+		resolver types.Resolver,
+	) (
+		tickerToPrice map[string]uint64,
+		tickerToVolume map[string]uint64,
+		unavailableTickers map[string]error,
+		err error,
+	)
 }
@@ -0,0 +1,18 @@
+package types
+
+import "time"
+
+// ExchangeStatus maintains the health of an exchange as observed by the pricefeed daemon. Times are zero
+// if the exchange has not yet reported a price or failed a query.
+type ExchangeStatus struct {
+	// ErrorCount is the number of failed price queries since the daemon started.
+	ErrorCount uint64
+	// LastSuccessTime is the time of the most recent price reported by the exchange.
+	LastSuccessTime time.Time
+	// LastErrorTime is the time of the most recent failed price query.
+	LastErrorTime time.Time
+	// LastErrorReason is the reason the most recent failed price query was dropped, e.g. "rate_limit".
+	LastErrorReason string
+	// LastError is the error message of the most recent failed price query.
+	LastError string
+}
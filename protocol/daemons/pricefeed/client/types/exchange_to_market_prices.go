@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -19,19 +20,35 @@ type ExchangeToMarketPrices interface {
 		marketPriceTimestamp *MarketPriceTimestamp,
 	)
 	GetAllPrices() map[ExchangeId][]MarketPriceTimestamp
+	RecordExchangeError(
+		exchangeId ExchangeId,
+		reason string,
+		err error,
+	)
+	GetAllExchangeStatuses() map[ExchangeId]ExchangeStatus
 	GetIndexPrice(
 		marketId MarketId,
 		cutoffTime time.Time,
-		resolver types.Resolver,
+		aggregation types.AggregationConfig,
 	) (
-		medianPrice uint64,
-		numPricesMedianized int,
+		indexPrice uint64,
+		numPricesAggregated int,
 	)
 }
 
 type ExchangeToMarketPricesImpl struct {
 	// {k: exchangeId, v: market prices, read-write lock}
 	ExchangeMarketPrices map[ExchangeId]*MarketToPrice
+
+	// previousIndexPricesLock protects previousIndexPrices.
+	previousIndexPricesLock sync.Mutex
+	// {k: marketId, v: the most recently computed index price}. Used for outlier rejection.
+	previousIndexPrices map[MarketId]uint64
+
+	// exchangeStatusesLock protects exchangeStatuses.
+	exchangeStatusesLock sync.Mutex
+	// {k: exchangeId, v: the health of the exchange}. Reported to the daemon server for introspection.
+	exchangeStatuses map[ExchangeId]*ExchangeStatus
 }
 
 // Enforce conformity of ExchangeToMarketPricesImpl to ExchangeToMarketPrices interface at compile time.
@@ -48,6 +65,8 @@ func NewExchangeToMarketPrices(exchangeIds []ExchangeId) (ExchangeToMarketPrices
 
 	exchangeToMarketPrices := &ExchangeToMarketPricesImpl{
 		ExchangeMarketPrices: make(map[ExchangeId]*MarketToPrice, len(exchangeIds)),
+		previousIndexPrices:  make(map[MarketId]uint64),
+		exchangeStatuses:     make(map[ExchangeId]*ExchangeStatus, len(exchangeIds)),
 	}
 
 	for _, exchangeId := range exchangeIds {
@@ -57,13 +76,15 @@ func NewExchangeToMarketPrices(exchangeIds []ExchangeId) (ExchangeToMarketPrices
 		}
 
 		exchangeToMarketPrices.ExchangeMarketPrices[exchangeId] = NewMarketToPrice()
+		exchangeToMarketPrices.exchangeStatuses[exchangeId] = &ExchangeStatus{}
 	}
 
 	return exchangeToMarketPrices, nil
 }
 
 // UpdatePrice updates a price for a market for an exchange. Prices are only updated if the
-// timestamp on the updates are greater than the timestamp on existing prices. NOTE:
+// timestamp on the updates are greater than the timestamp on existing prices. The update is also
+// recorded as the exchange's last success in its `ExchangeStatus`. NOTE:
 // `UpdatePrice` will only ever read from `ExchangeMarketPrices` and calls a
 // goroutine-safe method on the fetched `MarketToPrice`.
 // Note: if an invalid `exchangeId` is being written to the `UpdatePrice` it is possible the
@@ -82,6 +103,48 @@ func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) UpdatePrice(
 	)
 
 	exchangeToMarketPrices.ExchangeMarketPrices[exchangeId].UpdatePrice(marketPriceTimestamp)
+
+	exchangeToMarketPrices.exchangeStatusesLock.Lock()
+	defer exchangeToMarketPrices.exchangeStatusesLock.Unlock()
+	status := exchangeToMarketPrices.exchangeStatuses[exchangeId]
+	if marketPriceTimestamp.LastUpdatedAt.After(status.LastSuccessTime) {
+		status.LastSuccessTime = marketPriceTimestamp.LastUpdatedAt
+	}
+}
+
+// RecordExchangeError records a failed price query for an exchange in its `ExchangeStatus`. `reason`
+// classifies the failure, e.g. as a timeout or rate limit, and matches the reason reported in metrics.
+// Like `UpdatePrice`, `RecordExchangeError` will panic if `exchangeId` is invalid.
+func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) RecordExchangeError(
+	exchangeId ExchangeId,
+	reason string,
+	err error,
+) {
+	exchangeToMarketPrices.exchangeStatusesLock.Lock()
+	defer exchangeToMarketPrices.exchangeStatusesLock.Unlock()
+
+	status, ok := exchangeToMarketPrices.exchangeStatuses[exchangeId]
+	if !ok {
+		panic(fmt.Errorf("exchangeId: '%v' is not valid", exchangeId))
+	}
+	status.ErrorCount++
+	status.LastErrorTime = time.Now()
+	status.LastErrorReason = reason
+	if err != nil {
+		status.LastError = err.Error()
+	}
+}
+
+// GetAllExchangeStatuses returns a map of exchangeIds to a copy of the `ExchangeStatus` of the exchange.
+func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) GetAllExchangeStatuses() map[ExchangeId]ExchangeStatus {
+	exchangeToMarketPrices.exchangeStatusesLock.Lock()
+	defer exchangeToMarketPrices.exchangeStatusesLock.Unlock()
+
+	exchangeStatuses := make(map[ExchangeId]ExchangeStatus, len(exchangeToMarketPrices.exchangeStatuses))
+	for exchangeId, status := range exchangeToMarketPrices.exchangeStatuses {
+		exchangeStatuses[exchangeId] = *status
+	}
+	return exchangeStatuses
 }
 
 // GetAllPrices returns a map of exchangeIds to a list of all `MarketPriceTimestamps` for the exchange.
@@ -108,18 +171,20 @@ func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) GetAllPrices() map[Exc
 }
 
 // GetIndexPrice returns the index price for a given marketId, disallowing prices that are older than cutoffTime.
-// If no valid prices are found, an error is returned.
+// Prices are aggregated according to `aggregation`, and outliers are rejected against the index price most
+// recently computed for the market, or the median of the valid prices if there is none. If no valid prices are
+// found, (0, 0) is returned.
 func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) GetIndexPrice(
 	marketId MarketId,
 	cutoffTime time.Time,
-	resolver types.Resolver,
+	aggregation types.AggregationConfig,
 ) (
-	medianPrice uint64,
-	numPricesMedianized int,
+	indexPrice uint64,
+	numPricesAggregated int,
 ) {
-	prices := make([]uint64, 0, len(exchangeToMarketPrices.ExchangeMarketPrices))
+	prices := make([]types.PriceVolume, 0, len(exchangeToMarketPrices.ExchangeMarketPrices))
 	for _, mtp := range exchangeToMarketPrices.ExchangeMarketPrices {
-		price, ok := mtp.GetValidPriceForMarket(marketId, cutoffTime)
+		price, ok := mtp.GetValidPriceVolumeForMarket(marketId, cutoffTime)
 		if ok {
 			prices = append(prices, price)
 		}
@@ -128,10 +193,17 @@ func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) GetIndexPrice(
 	if len(prices) == 0 {
 		return 0, 0
 	}
-	median, err := resolver(prices)
 
+	exchangeToMarketPrices.previousIndexPricesLock.Lock()
+	defer exchangeToMarketPrices.previousIndexPricesLock.Unlock()
+
+	indexPrice, aggregatedPrices, err := aggregation.Aggregate(
+		prices,
+		exchangeToMarketPrices.previousIndexPrices[marketId],
+	)
 	if err != nil {
 		return 0, 0
 	}
-	return median, len(prices)
+	exchangeToMarketPrices.previousIndexPrices[marketId] = indexPrice
+	return indexPrice, len(aggregatedPrices)
 }
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
-	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/client"
 	"testing"
 	"time"
@@ -312,8 +312,11 @@ func TestGetIndexPrice_Mixed(t *testing.T) {
 			}
 
 			// Execute.
-			resolver := lib.Median[uint64]
-			medianPrice, numPricesMedianized := etmp.GetIndexPrice(tc.market, tc.cutoffTime, resolver)
+			medianPrice, numPricesMedianized := etmp.GetIndexPrice(
+				tc.market,
+				tc.cutoffTime,
+				pricefeedtypes.AggregationConfig{},
+			)
 
 			// Assert.
 			require.Equal(t, tc.expectedMedianPrice, medianPrice)
@@ -322,6 +325,95 @@ func TestGetIndexPrice_Mixed(t *testing.T) {
 	}
 }
 
+func TestGetIndexPrice_Aggregation(t *testing.T) {
+	testExchanges := []types.ExchangeId{constants.ExchangeId1, constants.ExchangeId2, constants.ExchangeId3}
+	etmp := getNewExchangeToMarketPricesAndCheckForError(t, testExchanges, nil)
+	for i, exchangeId := range testExchanges {
+		etmp.UpdatePrice(exchangeId, &types.MarketPriceTimestamp{
+			MarketId:      constants.MarketId9,
+			Price:         []uint64{1_000, 1_010, 2_000}[i],
+			Volume:        []uint64{1, 1, 10}[i],
+			LastUpdatedAt: constants.TimeT,
+		})
+	}
+
+	// The volume-weighted median is dominated by the high-volume exchange.
+	indexPrice, numPrices := etmp.GetIndexPrice(
+		constants.MarketId9,
+		constants.TimeTMinus1,
+		pricefeedtypes.AggregationConfig{Method: pricefeedtypes.AggregationMethodVolumeWeightedMedian},
+	)
+	require.Equal(t, uint64(2_000), indexPrice)
+	require.Equal(t, 3, numPrices)
+
+	// The median becomes the previous index price of the market.
+	indexPrice, numPrices = etmp.GetIndexPrice(
+		constants.MarketId9,
+		constants.TimeTMinus1,
+		pricefeedtypes.AggregationConfig{},
+	)
+	require.Equal(t, uint64(1_010), indexPrice)
+	require.Equal(t, 3, numPrices)
+
+	// Prices deviating more than 5% from the previous index price are rejected before volume weighting.
+	indexPrice, numPrices = etmp.GetIndexPrice(
+		constants.MarketId9,
+		constants.TimeTMinus1,
+		pricefeedtypes.AggregationConfig{
+			Method:          pricefeedtypes.AggregationMethodVolumeWeightedMedian,
+			MaxDeviationPpm: 50_000,
+		},
+	)
+	require.Equal(t, uint64(1_000), indexPrice)
+	require.Equal(t, 2, numPrices)
+}
+
+func TestExchangeStatuses_Mixed(t *testing.T) {
+	exchangeToMarketPrices := getNewExchangeToMarketPricesAndCheckForError(
+		t,
+		constants.Exchange1Exchange2Array,
+		nil,
+	)
+
+	// Statuses are empty on initialization.
+	require.Equal(
+		t,
+		map[types.ExchangeId]types.ExchangeStatus{
+			constants.ExchangeId1: {},
+			constants.ExchangeId2: {},
+		},
+		exchangeToMarketPrices.GetAllExchangeStatuses(),
+	)
+
+	// Successful updates record the latest price update time as the last success.
+	exchangeToMarketPrices.UpdatePrice(constants.ExchangeId1, constants.Market9_TimeTPlusThreshold_Price1)
+	exchangeToMarketPrices.UpdatePrice(constants.ExchangeId1, constants.Market9_TimeT_Price1)
+
+	// Errors are counted, and the most recent error is recorded.
+	before := time.Now()
+	exchangeToMarketPrices.RecordExchangeError(constants.ExchangeId2, "http_get_timeout", errors.New("timeout"))
+	exchangeToMarketPrices.RecordExchangeError(constants.ExchangeId2, "rate_limit", errors.New("rate limited"))
+
+	statuses := exchangeToMarketPrices.GetAllExchangeStatuses()
+	require.Equal(
+		t,
+		types.ExchangeStatus{LastSuccessTime: constants.TimeTPlusThreshold},
+		statuses[constants.ExchangeId1],
+	)
+
+	status := statuses[constants.ExchangeId2]
+	require.Equal(t, uint64(2), status.ErrorCount)
+	require.True(t, status.LastSuccessTime.IsZero())
+	require.False(t, status.LastErrorTime.Before(before))
+	require.Equal(t, "rate_limit", status.LastErrorReason)
+	require.Equal(t, "rate limited", status.LastError)
+
+	// Recording an error for an invalid exchange panics.
+	require.Panics(t, func() {
+		exchangeToMarketPrices.RecordExchangeError(constants.ExchangeId3, "rate_limit", errors.New("rate limited"))
+	})
+}
+
 func updatePriceAndCheckForPanic(
 	t *testing.T,
 	exchangeToMarketPrices types.ExchangeToMarketPrices,
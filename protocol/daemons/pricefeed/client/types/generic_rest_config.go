@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+const (
+	// GenericRestTickerPlaceholder is replaced with the market's ticker in the url and ticker format of a
+	// `GenericRestConfig`.
+	GenericRestTickerPlaceholder = "$"
+	// GenericRestPathSeparator separates the object keys and array indices of a path into a JSON response.
+	GenericRestPathSeparator = "."
+)
+
+// GenericRestConfig describes how to query and parse the price of a market from an exchange that does not have
+// a dedicated price function. It is specified under the "genericRest" key of the market's entry for a generic
+// REST exchange in the market's exchange config json, which allows new exchanges to be added with no code change.
+//
+// Paths into the JSON response are object keys and array indices separated by `.`, e.g. "data.tickers" or
+// "result.0.ask". Values at the price and volume paths may be either JSON strings or JSON numbers.
+//
+// The url must be an https url of a public host, since the config is set through governance and queried by
+// every validator's price daemon. Hostnames are additionally checked against their resolved addresses when
+// connecting, see `CheckPublicAddress`.
+type GenericRestConfig struct {
+	// Url is the url template used to query the exchange. Every `$` in the url is replaced with the ticker.
+	Url string `json:"url"`
+	// IsMultiMarket indicates whether the response contains a list of tickers for multiple markets, in which case
+	// the market's ticker is located in the list by its pair. Otherwise, the response contains a single ticker.
+	IsMultiMarket bool `json:"isMultiMarket,omitempty"`
+	// TickersPath is the path of the list of tickers if the response is multi-market, or of the single ticker
+	// otherwise. If empty, the root of the response is used.
+	TickersPath string `json:"tickersPath,omitempty"`
+	// PairPath is the path of the pair within each ticker of a multi-market response. Required if the response
+	// is multi-market.
+	PairPath string `json:"pairPath,omitempty"`
+	// TickerFormat is the format of the pair of the market's ticker in a multi-market response. Every `$` in
+	// the format is replaced with the ticker. If empty, the pair is expected to equal the ticker.
+	TickerFormat string `json:"tickerFormat,omitempty"`
+	// AskPricePath is the path of the ask price within the ticker.
+	AskPricePath string `json:"askPricePath"`
+	// BidPricePath is the path of the bid price within the ticker.
+	BidPricePath string `json:"bidPricePath"`
+	// LastPricePath is the path of the last price within the ticker.
+	LastPricePath string `json:"lastPricePath"`
+	// VolumePath is the optional path of the trading volume within the ticker.
+	VolumePath string `json:"volumePath,omitempty"`
+}
+
+// Validate returns an error if the generic REST config is invalid.
+func (grc *GenericRestConfig) Validate() error {
+	if grc.Url == "" {
+		return fmt.Errorf("url cannot be empty")
+	}
+	parsedUrl, err := url.ParseRequestURI(grc.Url)
+	if err != nil || parsedUrl.Scheme != "https" || parsedUrl.Hostname() == "" {
+		return fmt.Errorf("url '%v' is not a valid https url", grc.Url)
+	}
+	if !isPublicHost(parsedUrl.Hostname()) {
+		return fmt.Errorf("url '%v' must not point to a loopback or private host", grc.Url)
+	}
+
+	if grc.IsMultiMarket {
+		if grc.PairPath == "" {
+			return fmt.Errorf("pairPath cannot be empty for a multi-market response")
+		}
+		if grc.TickerFormat != "" && !strings.Contains(grc.TickerFormat, GenericRestTickerPlaceholder) {
+			return fmt.Errorf(
+				"tickerFormat '%v' must contain the ticker placeholder '%v'",
+				grc.TickerFormat,
+				GenericRestTickerPlaceholder,
+			)
+		}
+	} else if grc.PairPath != "" || grc.TickerFormat != "" {
+		return fmt.Errorf("pairPath and tickerFormat are only supported for a multi-market response")
+	}
+
+	requiredPaths := []struct {
+		name string
+		path string
+	}{
+		{"askPricePath", grc.AskPricePath},
+		{"bidPricePath", grc.BidPricePath},
+		{"lastPricePath", grc.LastPricePath},
+	}
+	for _, requiredPath := range requiredPaths {
+		if requiredPath.path == "" {
+			return fmt.Errorf("%v cannot be empty", requiredPath.name)
+		}
+	}
+
+	paths := []struct {
+		name string
+		path string
+	}{
+		{"tickersPath", grc.TickersPath},
+		{"pairPath", grc.PairPath},
+		{"askPricePath", grc.AskPricePath},
+		{"bidPricePath", grc.BidPricePath},
+		{"lastPricePath", grc.LastPricePath},
+		{"volumePath", grc.VolumePath},
+	}
+	for _, path := range paths {
+		if path.path == "" {
+			continue
+		}
+		for _, key := range strings.Split(path.path, GenericRestPathSeparator) {
+			if key == "" {
+				return fmt.Errorf("%v '%v' contains an empty key", path.name, path.path)
+			}
+		}
+	}
+	return nil
+}
+
+// GetPair returns the pair of `ticker` as it appears in a multi-market response.
+func (grc *GenericRestConfig) GetPair(ticker string) string {
+	if grc.TickerFormat == "" {
+		return ticker
+	}
+	return strings.ReplaceAll(grc.TickerFormat, GenericRestTickerPlaceholder, ticker)
+}
+
+// isPublicHost returns false if `host` is localhost or an IP address that is not public. Hostnames are not
+// resolved, since the addresses they resolve to may change. These are checked on every connection instead.
+func isPublicHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return true
+	}
+	return isPublicIp(ip)
+}
+
+// isPublicIp returns false if `ip` is loopback, private, link-local or unspecified.
+func isPublicIp(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsUnspecified()
+}
+
+// CheckPublicAddress is a `net.Dialer` control function that refuses to connect to an address that is not
+// public. It is called with the resolved address of every connection, so it also rejects hostnames of generic
+// REST exchanges that resolve to loopback or private addresses.
+func CheckPublicAddress(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIp(ip) {
+		return fmt.Errorf("connecting to non-public address %v over %v is not allowed", address, network)
+	}
+	return nil
+}
+
+// Copy returns a copy of the GenericRestConfig.
+func (grc *GenericRestConfig) Copy() *GenericRestConfig {
+	if grc == nil {
+		return nil
+	}
+	config := *grc
+	return &config
+}
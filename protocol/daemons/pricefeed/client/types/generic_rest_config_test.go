@@ -0,0 +1,250 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func validGenericRestConfig() *types.GenericRestConfig {
+	return &types.GenericRestConfig{
+		Url:           "https://abc.exchange/api/ticker?symbol=$",
+		TickersPath:   "data",
+		AskPricePath:  "ask",
+		BidPricePath:  "bid",
+		LastPricePath: "last",
+		VolumePath:    "stats.volume",
+	}
+}
+
+func validMultiMarketGenericRestConfig() *types.GenericRestConfig {
+	return &types.GenericRestConfig{
+		Url:           "https://abc.exchange/api/tickers",
+		IsMultiMarket: true,
+		TickersPath:   "result.list",
+		PairPath:      "symbol",
+		TickerFormat:  "t$",
+		AskPricePath:  "ask",
+		BidPricePath:  "bid",
+		LastPricePath: "last",
+	}
+}
+
+func TestGenericRestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		config      func() *types.GenericRestConfig
+		expectedErr error
+	}{
+		"Valid: single market": {
+			config: validGenericRestConfig,
+		},
+		"Valid: multi-market": {
+			config: validMultiMarketGenericRestConfig,
+		},
+		"Valid: multi-market without ticker format": {
+			config: func() *types.GenericRestConfig {
+				config := validMultiMarketGenericRestConfig()
+				config.TickerFormat = ""
+				return config
+			},
+		},
+		"Valid: public ip with port": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "https://8.8.8.8:8443/ticker?symbol=$"
+				return config
+			},
+		},
+		"Invalid: no url": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = ""
+				return config
+			},
+			expectedErr: fmt.Errorf("url cannot be empty"),
+		},
+		"Invalid: url not https": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "http://abc.exchange/ticker"
+				return config
+			},
+			expectedErr: fmt.Errorf("url 'http://abc.exchange/ticker' is not a valid https url"),
+		},
+		"Invalid: url not absolute": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "abc.exchange/ticker"
+				return config
+			},
+			expectedErr: fmt.Errorf("url 'abc.exchange/ticker' is not a valid https url"),
+		},
+		"Invalid: localhost": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "https://localhost:8080/ticker"
+				return config
+			},
+			expectedErr: fmt.Errorf("url 'https://localhost:8080/ticker' must not point to a loopback or private host"),
+		},
+		"Invalid: loopback ip": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "https://127.0.0.1/ticker"
+				return config
+			},
+			expectedErr: fmt.Errorf("url 'https://127.0.0.1/ticker' must not point to a loopback or private host"),
+		},
+		"Invalid: loopback ipv6": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "https://[::1]/ticker"
+				return config
+			},
+			expectedErr: fmt.Errorf("url 'https://[::1]/ticker' must not point to a loopback or private host"),
+		},
+		"Invalid: private ip": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "https://10.0.0.5/ticker"
+				return config
+			},
+			expectedErr: fmt.Errorf("url 'https://10.0.0.5/ticker' must not point to a loopback or private host"),
+		},
+		"Invalid: link-local ip": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "https://169.254.169.254/latest/meta-data"
+				return config
+			},
+			expectedErr: fmt.Errorf(
+				"url 'https://169.254.169.254/latest/meta-data' must not point to a loopback or private host",
+			),
+		},
+		"Invalid: unspecified ip": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.Url = "https://0.0.0.0/ticker"
+				return config
+			},
+			expectedErr: fmt.Errorf("url 'https://0.0.0.0/ticker' must not point to a loopback or private host"),
+		},
+		"Invalid: multi-market without pair path": {
+			config: func() *types.GenericRestConfig {
+				config := validMultiMarketGenericRestConfig()
+				config.PairPath = ""
+				return config
+			},
+			expectedErr: fmt.Errorf("pairPath cannot be empty for a multi-market response"),
+		},
+		"Invalid: ticker format without placeholder": {
+			config: func() *types.GenericRestConfig {
+				config := validMultiMarketGenericRestConfig()
+				config.TickerFormat = "BTCUSD"
+				return config
+			},
+			expectedErr: fmt.Errorf("tickerFormat 'BTCUSD' must contain the ticker placeholder '$'"),
+		},
+		"Invalid: single market with pair path": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.PairPath = "symbol"
+				return config
+			},
+			expectedErr: fmt.Errorf("pairPath and tickerFormat are only supported for a multi-market response"),
+		},
+		"Invalid: no ask price path": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.AskPricePath = ""
+				return config
+			},
+			expectedErr: fmt.Errorf("askPricePath cannot be empty"),
+		},
+		"Invalid: no bid price path": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.BidPricePath = ""
+				return config
+			},
+			expectedErr: fmt.Errorf("bidPricePath cannot be empty"),
+		},
+		"Invalid: no last price path": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.LastPricePath = ""
+				return config
+			},
+			expectedErr: fmt.Errorf("lastPricePath cannot be empty"),
+		},
+		"Invalid: path with empty key": {
+			config: func() *types.GenericRestConfig {
+				config := validGenericRestConfig()
+				config.VolumePath = "stats..volume"
+				return config
+			},
+			expectedErr: fmt.Errorf("volumePath 'stats..volume' contains an empty key"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config().Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestGenericRestConfigGetPair(t *testing.T) {
+	require.Equal(t, "BTCUSD", validGenericRestConfig().GetPair("BTCUSD"))
+	require.Equal(t, "tBTCUSD", validMultiMarketGenericRestConfig().GetPair("BTCUSD"))
+}
+
+func TestCheckPublicAddress(t *testing.T) {
+	tests := map[string]struct {
+		address     string
+		expectedErr error
+	}{
+		"Public ipv4": {
+			address: "8.8.8.8:443",
+		},
+		"Public ipv6": {
+			address: "[2001:4860:4860::8888]:443",
+		},
+		"Loopback": {
+			address:     "127.0.0.1:443",
+			expectedErr: fmt.Errorf("connecting to non-public address 127.0.0.1:443 over tcp4 is not allowed"),
+		},
+		"Private": {
+			address:     "192.168.1.1:443",
+			expectedErr: fmt.Errorf("connecting to non-public address 192.168.1.1:443 over tcp4 is not allowed"),
+		},
+		"Link-local": {
+			address:     "169.254.169.254:80",
+			expectedErr: fmt.Errorf("connecting to non-public address 169.254.169.254:80 over tcp4 is not allowed"),
+		},
+		"Ipv4-mapped loopback": {
+			address:     "[::ffff:127.0.0.1]:443",
+			expectedErr: fmt.Errorf("connecting to non-public address [::ffff:127.0.0.1]:443 over tcp4 is not allowed"),
+		},
+		"Missing port": {
+			address:     "8.8.8.8",
+			expectedErr: fmt.Errorf("address 8.8.8.8: missing port in address"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := types.CheckPublicAddress("tcp4", tc.address, nil)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			}
+		})
+	}
+}
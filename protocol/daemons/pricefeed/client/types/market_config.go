@@ -39,6 +39,10 @@ type MarketConfig struct {
 	//
 	// BTC-USD = 1 / USD-BTC
 	Invert bool
+
+	// GenericRest describes how to query and parse the price of the market on a generic REST exchange. It is
+	// nil for all other exchanges.
+	GenericRest *GenericRestConfig
 }
 
 // Equal returns true if the two MarketConfigs are equal.
@@ -47,7 +51,10 @@ func (mc *MarketConfig) Equal(other MarketConfig) bool {
 		mc.Invert == other.Invert &&
 		((mc.AdjustByMarket == nil && other.AdjustByMarket == nil) ||
 			(mc.AdjustByMarket != nil && other.AdjustByMarket != nil &&
-				*mc.AdjustByMarket == *other.AdjustByMarket))
+				*mc.AdjustByMarket == *other.AdjustByMarket)) &&
+		((mc.GenericRest == nil && other.GenericRest == nil) ||
+			(mc.GenericRest != nil && other.GenericRest != nil &&
+				*mc.GenericRest == *other.GenericRest))
 }
 
 // Copy returns a deep copy of the MarketConfig.
@@ -61,5 +68,6 @@ func (mc *MarketConfig) Copy() MarketConfig {
 		Ticker:         mc.Ticker,
 		AdjustByMarket: adjustByMarket,
 		Invert:         mc.Invert,
+		GenericRest:    mc.GenericRest.Copy(),
 	}
 }
@@ -80,6 +80,38 @@ func TestEqual_Mixed(t *testing.T) {
 			},
 			expectedEqual: false,
 		},
+		"Equal: generic REST config defined": {
+			A: types.MarketConfig{
+				Ticker:      "ABC-USD",
+				GenericRest: &types.GenericRestConfig{Url: "https://abc.exchange/ticker?symbol=$"},
+			},
+			B: types.MarketConfig{
+				Ticker:      "ABC-USD",
+				GenericRest: &types.GenericRestConfig{Url: "https://abc.exchange/ticker?symbol=$"},
+			},
+			expectedEqual: true,
+		},
+		"Not equal: generic REST configs differ": {
+			A: types.MarketConfig{
+				Ticker:      "ABC-USD",
+				GenericRest: &types.GenericRestConfig{Url: "https://abc.exchange/ticker?symbol=$"},
+			},
+			B: types.MarketConfig{
+				Ticker:      "ABC-USD",
+				GenericRest: &types.GenericRestConfig{Url: "https://def.exchange/ticker?symbol=$"},
+			},
+			expectedEqual: false,
+		},
+		"Not equal: generic REST configs nil/non-nil": {
+			A: types.MarketConfig{
+				Ticker:      "ABC-USD",
+				GenericRest: &types.GenericRestConfig{Url: "https://abc.exchange/ticker?symbol=$"},
+			},
+			B: types.MarketConfig{
+				Ticker: "ABC-USD",
+			},
+			expectedEqual: false,
+		},
 		"Not equal: adjustBy markets nil/non-nil": {
 			A: types.MarketConfig{
 				Ticker:         "ABC-USD",
@@ -118,11 +150,20 @@ func TestMarketConfig_Copy(t *testing.T) {
 				Invert: true,
 			},
 		},
+		"Copy: generic REST config defined": {
+			config: types.MarketConfig{
+				Ticker:      "ABC-USD",
+				GenericRest: &types.GenericRestConfig{Url: "https://abc.exchange/ticker?symbol=$"},
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			copied := tc.config.Copy()
 			require.True(t, tc.config.Equal(copied))
+			if tc.config.GenericRest != nil {
+				require.NotSame(t, tc.config.GenericRest, copied.GenericRest)
+			}
 		})
 	}
 }
@@ -2,9 +2,11 @@ package types
 
 import "time"
 
-// MarketPriceTimestamp maintains a `MarketId`, `Price` and `LastUpdatedAt`.
+// MarketPriceTimestamp maintains a `MarketId`, `Price`, `Volume` and `LastUpdatedAt`.
+// `Volume` is the trading volume reported by the exchange for the market, or 0 if unavailable.
 type MarketPriceTimestamp struct {
 	MarketId      uint32
 	Price         uint64
+	Volume        uint64
 	LastUpdatedAt time.Time
 }
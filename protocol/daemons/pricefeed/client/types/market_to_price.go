@@ -39,7 +39,11 @@ func (mtp *MarketToPrice) UpdatePrice(
 		priceTimestamp = types.NewPriceTimestamp()
 		mtp.MarketToPriceTimestamp[marketId] = priceTimestamp
 	}
-	isUpdated := priceTimestamp.UpdatePrice(marketPriceTimestamp.Price, &marketPriceTimestamp.LastUpdatedAt)
+	isUpdated := priceTimestamp.UpdatePriceWithVolume(
+		marketPriceTimestamp.Price,
+		marketPriceTimestamp.Volume,
+		&marketPriceTimestamp.LastUpdatedAt,
+	)
 
 	validity := metrics.Valid
 	if !isUpdated {
@@ -61,6 +65,7 @@ func (mtp *MarketToPrice) GetAllPrices() []MarketPriceTimestamp {
 			MarketId:      marketId,
 			LastUpdatedAt: priceTimestamp.LastUpdateTime,
 			Price:         priceTimestamp.Price,
+			Volume:        priceTimestamp.Volume,
 		}
 		marketPricesForExchange = append(marketPricesForExchange, mpt)
 	}
@@ -79,3 +84,19 @@ func (mtp *MarketToPrice) GetValidPriceForMarket(marketId MarketId, cutoffTime t
 
 	return price.GetValidPrice(cutoffTime)
 }
+
+// GetValidPriceVolumeForMarket returns the most recent valid price for a market for an exchange, along with the
+// trading volume reported with it.
+func (mtp *MarketToPrice) GetValidPriceVolumeForMarket(
+	marketId MarketId,
+	cutoffTime time.Time,
+) (types.PriceVolume, bool) {
+	mtp.Lock()
+	defer mtp.Unlock()
+	price, exists := mtp.MarketToPriceTimestamp[marketId]
+	if !exists {
+		return types.PriceVolume{}, false
+	}
+
+	return price.GetValidPriceVolume(cutoffTime)
+}
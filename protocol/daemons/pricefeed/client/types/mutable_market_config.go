@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
 
 // MutableMarketConfig stores the metadata that is common to a market across exchanges.
 type MutableMarketConfig struct {
@@ -8,6 +12,7 @@ type MutableMarketConfig struct {
 	Pair         string
 	Exponent     Exponent
 	MinExchanges uint32
+	Aggregation  types.AggregationConfig
 }
 
 // Copy returns a copy of the MutableMarketConfig.
@@ -17,6 +22,7 @@ func (mmc *MutableMarketConfig) Copy() *MutableMarketConfig {
 		Pair:         mmc.Pair,
 		Exponent:     mmc.Exponent,
 		MinExchanges: mmc.MinExchanges,
+		Aggregation:  mmc.Aggregation,
 	}
 }
 
@@ -27,6 +33,9 @@ func (mmc *MutableMarketConfig) Validate() error {
 	if mmc.MinExchanges == 0 {
 		return fmt.Errorf("min exchanges cannot be 0")
 	}
+	if err := mmc.Aggregation.Validate(); err != nil {
+		return fmt.Errorf("invalid aggregation: %w", err)
+	}
 
 	return nil
 }
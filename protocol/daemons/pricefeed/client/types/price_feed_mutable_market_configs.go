@@ -269,8 +269,9 @@ func (pfmmc *PricefeedMutableMarketConfigsImpl) ValidateAndTransformParams(marke
 				return nil, nil, nil, err
 			}
 			marketConfig := MarketConfig{
-				Ticker: exchangeConfig.Ticker,
-				Invert: exchangeConfig.Invert,
+				Ticker:      exchangeConfig.Ticker,
+				Invert:      exchangeConfig.Invert,
+				GenericRest: exchangeConfig.GenericRest.Copy(),
 			}
 
 			// Populate the adjustByMarket only if it is specified in the config.
@@ -297,6 +298,7 @@ func (pfmmc *PricefeedMutableMarketConfigsImpl) ValidateAndTransformParams(marke
 			Pair:         marketParam.Pair,
 			Exponent:     marketParam.Exponent,
 			MinExchanges: marketParam.MinExchanges,
+			Aggregation:  exchangeConfigJson.GetAggregation(),
 		}
 	}
 	return mutableExchangeConfigs, mutableMarketConfigs, marketParamErrors, nil
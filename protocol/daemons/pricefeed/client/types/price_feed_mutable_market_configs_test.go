@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	prices_types "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -362,6 +363,61 @@ func TestValidateAndTransformParams_Mixed(t *testing.T) {
 				},
 			},
 		},
+		"Valid: market with aggregation config": {
+			marketParams: []prices_types.MarketParam{
+				{
+					Id:                1,
+					Exponent:          -2,
+					Pair:              "BTC-USD",
+					MinExchanges:      1,
+					MinPriceChangePpm: 1,
+					ExchangeConfigJson: fmt.Sprintf(
+						`{"exchanges":[%s],"aggregation":{"method":"volumeWeightedMedian","maxDeviationPpm":50000}}`,
+						exchangeConfigBinanceBtc,
+					),
+				},
+			},
+			expectedMutableMarketConfigs: map[types.MarketId]*types.MutableMarketConfig{
+				1: {
+					Id:           1,
+					Exponent:     -2,
+					Pair:         "BTC-USD",
+					MinExchanges: 1,
+					Aggregation: pricefeedtypes.AggregationConfig{
+						Method:          pricefeedtypes.AggregationMethodVolumeWeightedMedian,
+						MaxDeviationPpm: 50_000,
+					},
+				},
+			},
+			expectedMutableExchangeConfigs: map[types.ExchangeId]*types.MutableExchangeMarketConfig{
+				exchangeIdCoinbase: {
+					Id:                   exchangeIdCoinbase,
+					MarketToMarketConfig: map[types.MarketId]types.MarketConfig{},
+				},
+				exchangeIdBinance: {
+					Id: exchangeIdBinance,
+					MarketToMarketConfig: map[types.MarketId]types.MarketConfig{
+						1: {
+							Ticker: "BTCUSDT",
+						},
+					},
+				},
+			},
+		},
+		"Invalid: invalid exchangeConfigJson (aggregation invalid)": {
+			marketParams: []prices_types.MarketParam{
+				validMarketParamWithExchangeConfig(
+					fmt.Sprintf(`{"exchanges":[%s],"aggregation":{"method":"mode"}}`, exchangeConfigBinanceBtc),
+				),
+			},
+			expectedMarketParamErrors: map[types.MarketId]error{
+				1: errors.New(
+					"invalid exchange config json for market param 1: invalid aggregation: aggregation method " +
+						"'mode' is not valid"),
+			},
+			expectedMutableMarketConfigs:   testEmptyMarketConfigs,
+			expectedMutableExchangeConfigs: testEmptyExchangeMarketConfigs,
+		},
 		"Mixed: 1 invalid (invalid exchange config: missing adjust-by market), 1 valid": {
 			marketParams: []prices_types.MarketParam{
 				{
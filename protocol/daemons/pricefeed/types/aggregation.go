@@ -0,0 +1,225 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// AggregationMethod identifies how the prices reported by each exchange for a market are aggregated into a
+// single index price.
+type AggregationMethod string
+
+const (
+	// AggregationMethodMedian takes the median of all exchange prices.
+	AggregationMethodMedian AggregationMethod = "median"
+	// AggregationMethodVolumeWeightedMedian takes the median of all exchange prices, weighting each price by
+	// the trading volume reported by its exchange.
+	AggregationMethodVolumeWeightedMedian AggregationMethod = "volumeWeightedMedian"
+	// AggregationMethodTrimmedMean takes the mean of all exchange prices after removing a fraction of the
+	// highest and lowest prices.
+	AggregationMethodTrimmedMean AggregationMethod = "trimmedMean"
+)
+
+const (
+	// MaxTrimPpm is the maximum fraction of prices, in parts-per-million, that may be trimmed from each end of
+	// the sorted prices. At least one price always remains after trimming.
+	MaxTrimPpm = uint32(499_999)
+)
+
+// PriceVolume is a single exchange's price for a market, along with the exchange's trading volume for the market.
+type PriceVolume struct {
+	Price  uint64
+	Volume uint64
+	// ExchangeId identifies the exchange that reported the price, if known. It does not affect aggregation.
+	ExchangeId string
+}
+
+// AggregationConfig configures how the index price of a market is aggregated from the prices reported by each
+// exchange. It is specified per market under the "aggregation" key of the market's exchange config json. The
+// zero value aggregates prices with a plain median and does not reject outliers.
+type AggregationConfig struct {
+	// Method is the aggregation method. Defaults to the median if empty.
+	Method AggregationMethod `json:"method,omitempty"`
+	// TrimPpm is the fraction of prices, in parts-per-million, removed from each end of the sorted prices before
+	// the mean is taken. Only supported by the trimmed mean.
+	TrimPpm uint32 `json:"trimPpm,omitempty"`
+	// MaxDeviationPpm, if non-zero, rejects exchange prices that deviate from the previous index price, or from
+	// the median of all exchange prices if there is no previous index price, by more than this fraction, in
+	// parts-per-million, before prices are aggregated.
+	MaxDeviationPpm uint32 `json:"maxDeviationPpm,omitempty"`
+}
+
+// AggregationConfigFromExchangeConfigJson parses and validates the aggregation config of a market from the
+// market's exchange config json. Markets without an aggregation config use the zero value.
+func AggregationConfigFromExchangeConfigJson(exchangeConfigJson string) (AggregationConfig, error) {
+	var config struct {
+		Aggregation AggregationConfig `json:"aggregation"`
+	}
+	if err := json.Unmarshal([]byte(exchangeConfigJson), &config); err != nil {
+		return AggregationConfig{}, err
+	}
+	if err := config.Aggregation.Validate(); err != nil {
+		return AggregationConfig{}, err
+	}
+	return config.Aggregation, nil
+}
+
+// Validate returns an error if the aggregation config is invalid.
+func (ac AggregationConfig) Validate() error {
+	switch ac.Method {
+	case "", AggregationMethodMedian, AggregationMethodVolumeWeightedMedian:
+		if ac.TrimPpm != 0 {
+			return fmt.Errorf("trimPpm is not supported by aggregation method '%v'", ac.Method)
+		}
+	case AggregationMethodTrimmedMean:
+		if ac.TrimPpm > MaxTrimPpm {
+			return fmt.Errorf("trimPpm must be less than or equal to %d", MaxTrimPpm)
+		}
+	default:
+		return fmt.Errorf("aggregation method '%v' is not valid", ac.Method)
+	}
+	return nil
+}
+
+// Aggregate aggregates `prices` into a single index price and returns the index price along with the prices it
+// was aggregated from.
+//
+// If outlier rejection is configured, prices that deviate from `previousIndexPrice` by more than
+// `MaxDeviationPpm` are rejected first. If there is no previous index price (`previousIndexPrice` is zero), or
+// every price would be rejected because the market moved as a whole, prices are instead anchored to the median
+// of `prices`. If every price would still be rejected, which is only possible if the prices are split into two
+// distant groups, no prices are rejected.
+func (ac AggregationConfig) Aggregate(
+	prices []PriceVolume,
+	previousIndexPrice uint64,
+) (
+	indexPrice uint64,
+	aggregatedPrices []PriceVolume,
+	err error,
+) {
+	if len(prices) == 0 {
+		return 0, nil, errors.New("input cannot be empty")
+	}
+
+	prices, err = ac.rejectOutliers(prices, previousIndexPrice)
+	if err != nil {
+		return 0, nil, err
+	}
+	switch ac.Method {
+	case AggregationMethodVolumeWeightedMedian:
+		indexPrice, err = volumeWeightedMedian(prices)
+	case AggregationMethodTrimmedMean:
+		indexPrice, err = trimmedMean(prices, ac.TrimPpm)
+	default:
+		indexPrice, err = lib.Median(getPrices(prices))
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	return indexPrice, prices, nil
+}
+
+// rejectOutliers returns the prices that deviate from `previousIndexPrice` by no more than `MaxDeviationPpm`,
+// falling back to the median of `prices` if there is no previous index price or every price would be rejected.
+// All prices are returned if outlier rejection is disabled or every price would be rejected by both anchors.
+func (ac AggregationConfig) rejectOutliers(prices []PriceVolume, previousIndexPrice uint64) ([]PriceVolume, error) {
+	if ac.MaxDeviationPpm == 0 {
+		return prices, nil
+	}
+
+	if previousIndexPrice != 0 {
+		if accepted := ac.getPricesWithinMaxDeviation(prices, previousIndexPrice); len(accepted) > 0 {
+			return accepted, nil
+		}
+	}
+
+	median, err := lib.Median(getPrices(prices))
+	if err != nil {
+		return nil, err
+	}
+
+	if accepted := ac.getPricesWithinMaxDeviation(prices, median); len(accepted) > 0 {
+		return accepted, nil
+	}
+	return prices, nil
+}
+
+// getPricesWithinMaxDeviation returns the prices that deviate from `anchor` by no more than `MaxDeviationPpm`.
+func (ac AggregationConfig) getPricesWithinMaxDeviation(prices []PriceVolume, anchor uint64) []PriceVolume {
+	bigAnchor := new(big.Int).SetUint64(anchor)
+	maxDeviation := new(big.Int).Mul(bigAnchor, big.NewInt(int64(ac.MaxDeviationPpm)))
+	accepted := make([]PriceVolume, 0, len(prices))
+	for _, price := range prices {
+		deviation := new(big.Int).Sub(new(big.Int).SetUint64(price.Price), bigAnchor)
+		deviation.Abs(deviation).Mul(deviation, lib.BigIntOneMillion())
+		if deviation.Cmp(maxDeviation) <= 0 {
+			accepted = append(accepted, price)
+		}
+	}
+	return accepted
+}
+
+// volumeWeightedMedian returns the lowest price at which the cumulative volume of all lower or equal prices is
+// at least half of the total volume. If no exchange reported any volume, the plain median is returned.
+func volumeWeightedMedian(prices []PriceVolume) (uint64, error) {
+	sorted := sortByPrice(prices)
+
+	totalVolume := new(big.Int)
+	for _, price := range sorted {
+		totalVolume.Add(totalVolume, new(big.Int).SetUint64(price.Volume))
+	}
+	if totalVolume.Sign() == 0 {
+		return lib.Median(getPrices(prices))
+	}
+
+	cumulativeVolume := new(big.Int)
+	for _, price := range sorted {
+		cumulativeVolume.Add(cumulativeVolume, new(big.Int).SetUint64(price.Volume))
+		if new(big.Int).Lsh(cumulativeVolume, 1).Cmp(totalVolume) >= 0 {
+			return price.Price, nil
+		}
+	}
+
+	// Unreachable since the cumulative volume of all prices equals the total volume.
+	return sorted[len(sorted)-1].Price, nil
+}
+
+// trimmedMean removes `trimPpm` of the prices from each end of the sorted prices and returns the mean of the
+// remaining prices, rounded down.
+func trimmedMean(prices []PriceVolume, trimPpm uint32) (uint64, error) {
+	sorted := sortByPrice(prices)
+
+	numTrimmed := int(uint64(len(sorted)) * uint64(trimPpm) / uint64(lib.OneMillion))
+	remaining := sorted[numTrimmed : len(sorted)-numTrimmed]
+	if len(remaining) == 0 {
+		return 0, errors.New("no prices remain after trimming")
+	}
+
+	sum := new(big.Int)
+	for _, price := range remaining {
+		sum.Add(sum, new(big.Int).SetUint64(price.Price))
+	}
+	return sum.Quo(sum, big.NewInt(int64(len(remaining)))).Uint64(), nil
+}
+
+// sortByPrice returns a copy of `prices` sorted in ascending order of price.
+func sortByPrice(prices []PriceVolume) []PriceVolume {
+	sorted := make([]PriceVolume, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Price < sorted[j].Price })
+	return sorted
+}
+
+// getPrices returns the prices of `prices` without volumes.
+func getPrices(prices []PriceVolume) []uint64 {
+	result := make([]uint64, 0, len(prices))
+	for _, price := range prices {
+		result = append(result, price.Price)
+	}
+	return result
+}
@@ -0,0 +1,290 @@
+package types_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregationConfigFromExchangeConfigJson(t *testing.T) {
+	tests := map[string]struct {
+		exchangeConfigJson string
+		expectedConfig     types.AggregationConfig
+		expectedErr        string
+	}{
+		"No aggregation": {
+			exchangeConfigJson: `{"exchanges":[{"exchangeName":"Binance","ticker":"BTCUSDT"}]}`,
+			expectedConfig:     types.AggregationConfig{},
+		},
+		"Volume-weighted median with outlier rejection": {
+			exchangeConfigJson: `{"aggregation":{"method":"volumeWeightedMedian","maxDeviationPpm":50000}}`,
+			expectedConfig: types.AggregationConfig{
+				Method:          types.AggregationMethodVolumeWeightedMedian,
+				MaxDeviationPpm: 50_000,
+			},
+		},
+		"Trimmed mean": {
+			exchangeConfigJson: `{"aggregation":{"method":"trimmedMean","trimPpm":200000}}`,
+			expectedConfig: types.AggregationConfig{
+				Method:  types.AggregationMethodTrimmedMean,
+				TrimPpm: 200_000,
+			},
+		},
+		"Invalid json": {
+			exchangeConfigJson: `{"aggregation":`,
+			expectedErr:        "unexpected end of JSON input",
+		},
+		"Invalid aggregation": {
+			exchangeConfigJson: `{"aggregation":{"method":"mode"}}`,
+			expectedErr:        "aggregation method 'mode' is not valid",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config, err := types.AggregationConfigFromExchangeConfigJson(tc.exchangeConfigJson)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedConfig, config)
+			}
+		})
+	}
+}
+
+func TestAggregationConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		config      types.AggregationConfig
+		expectedErr error
+	}{
+		"Valid: default": {
+			config: types.AggregationConfig{},
+		},
+		"Valid: median with outlier rejection": {
+			config: types.AggregationConfig{
+				Method:          types.AggregationMethodMedian,
+				MaxDeviationPpm: 100_000,
+			},
+		},
+		"Valid: volume-weighted median": {
+			config: types.AggregationConfig{Method: types.AggregationMethodVolumeWeightedMedian},
+		},
+		"Valid: trimmed mean with max trim": {
+			config: types.AggregationConfig{
+				Method:  types.AggregationMethodTrimmedMean,
+				TrimPpm: types.MaxTrimPpm,
+			},
+		},
+		"Invalid: unknown method": {
+			config:      types.AggregationConfig{Method: "mode"},
+			expectedErr: errors.New("aggregation method 'mode' is not valid"),
+		},
+		"Invalid: trim with median": {
+			config:      types.AggregationConfig{TrimPpm: 100_000},
+			expectedErr: errors.New("trimPpm is not supported by aggregation method ''"),
+		},
+		"Invalid: trim too large": {
+			config: types.AggregationConfig{
+				Method:  types.AggregationMethodTrimmedMean,
+				TrimPpm: types.MaxTrimPpm + 1,
+			},
+			expectedErr: errors.New("trimPpm must be less than or equal to 499999"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAggregationConfig_Aggregate(t *testing.T) {
+	tests := map[string]struct {
+		config             types.AggregationConfig
+		prices             []types.PriceVolume
+		previousIndexPrice uint64
+
+		expectedIndexPrice uint64
+		expectedNumPrices  int
+		expectedErr        error
+	}{
+		"Empty prices": {
+			config:      types.AggregationConfig{},
+			expectedErr: errors.New("input cannot be empty"),
+		},
+		"Median: odd number of prices": {
+			config:             types.AggregationConfig{},
+			prices:             []types.PriceVolume{{Price: 3}, {Price: 1}, {Price: 2}},
+			expectedIndexPrice: 2,
+			expectedNumPrices:  3,
+		},
+		"Median: even number of prices": {
+			config:             types.AggregationConfig{Method: types.AggregationMethodMedian},
+			prices:             []types.PriceVolume{{Price: 4}, {Price: 1}, {Price: 2}, {Price: 3}},
+			expectedIndexPrice: 3,
+			expectedNumPrices:  4,
+		},
+		"Volume-weighted median: weighted towards high volume price": {
+			config: types.AggregationConfig{Method: types.AggregationMethodVolumeWeightedMedian},
+			prices: []types.PriceVolume{
+				{Price: 100, Volume: 10},
+				{Price: 300, Volume: 30},
+				{Price: 200, Volume: 10},
+			},
+			expectedIndexPrice: 300,
+			expectedNumPrices:  3,
+		},
+		"Volume-weighted median: lower price at exactly half of volume": {
+			config: types.AggregationConfig{Method: types.AggregationMethodVolumeWeightedMedian},
+			prices: []types.PriceVolume{
+				{Price: 200, Volume: 10},
+				{Price: 100, Volume: 10},
+			},
+			expectedIndexPrice: 100,
+			expectedNumPrices:  2,
+		},
+		"Volume-weighted median: zero volume prices have no weight": {
+			config: types.AggregationConfig{Method: types.AggregationMethodVolumeWeightedMedian},
+			prices: []types.PriceVolume{
+				{Price: 100, Volume: 0},
+				{Price: 200, Volume: 1},
+				{Price: 300, Volume: 0},
+			},
+			expectedIndexPrice: 200,
+			expectedNumPrices:  3,
+		},
+		"Volume-weighted median: falls back to median without volume": {
+			config:             types.AggregationConfig{Method: types.AggregationMethodVolumeWeightedMedian},
+			prices:             []types.PriceVolume{{Price: 100}, {Price: 200}, {Price: 400}},
+			expectedIndexPrice: 200,
+			expectedNumPrices:  3,
+		},
+		"Volume-weighted median: volumes do not overflow": {
+			config: types.AggregationConfig{Method: types.AggregationMethodVolumeWeightedMedian},
+			prices: []types.PriceVolume{
+				{Price: 100, Volume: math.MaxUint64},
+				{Price: 200, Volume: math.MaxUint64},
+				{Price: 300, Volume: math.MaxUint64},
+			},
+			expectedIndexPrice: 200,
+			expectedNumPrices:  3,
+		},
+		"Trimmed mean: no trim": {
+			config:             types.AggregationConfig{Method: types.AggregationMethodTrimmedMean},
+			prices:             []types.PriceVolume{{Price: 100}, {Price: 200}, {Price: 400}},
+			expectedIndexPrice: 233,
+			expectedNumPrices:  3,
+		},
+		"Trimmed mean: trims highest and lowest prices": {
+			config: types.AggregationConfig{
+				Method:  types.AggregationMethodTrimmedMean,
+				TrimPpm: 200_000, // 20%
+			},
+			prices: []types.PriceVolume{
+				{Price: 1_000},
+				{Price: 100},
+				{Price: 110},
+				{Price: 1},
+				{Price: 120},
+			},
+			expectedIndexPrice: 110,
+			expectedNumPrices:  5,
+		},
+		"Trimmed mean: rounds down the number of trimmed prices": {
+			config: types.AggregationConfig{
+				Method:  types.AggregationMethodTrimmedMean,
+				TrimPpm: 300_000, // 30%
+			},
+			prices:             []types.PriceVolume{{Price: 100}, {Price: 200}, {Price: 400}},
+			expectedIndexPrice: 233,
+			expectedNumPrices:  3,
+		},
+		"Trimmed mean: sum does not overflow": {
+			config: types.AggregationConfig{Method: types.AggregationMethodTrimmedMean},
+			prices: []types.PriceVolume{
+				{Price: math.MaxUint64},
+				{Price: math.MaxUint64},
+			},
+			expectedIndexPrice: math.MaxUint64,
+			expectedNumPrices:  2,
+		},
+		"Outlier rejection: rejects prices deviating from the previous index price": {
+			config: types.AggregationConfig{MaxDeviationPpm: 100_000}, // 10%
+			prices: []types.PriceVolume{
+				{Price: 1_000},
+				{Price: 1_100},
+				{Price: 1_101},
+				{Price: 899},
+			},
+			previousIndexPrice: 1_000,
+			expectedIndexPrice: 1_050,
+			expectedNumPrices:  2,
+		},
+		"Outlier rejection: anchored to the median if the whole market moved from the previous index price": {
+			config:             types.AggregationConfig{MaxDeviationPpm: 100_000},
+			prices:             []types.PriceVolume{{Price: 2_000}, {Price: 2_100}, {Price: 2_200}},
+			previousIndexPrice: 1_000,
+			expectedIndexPrice: 2_100,
+			expectedNumPrices:  3,
+		},
+		"Outlier rejection: rejects prices deviating from the median without previous index price": {
+			config: types.AggregationConfig{
+				Method:          types.AggregationMethodTrimmedMean,
+				MaxDeviationPpm: 100_000, // 10%
+			},
+			prices: []types.PriceVolume{
+				{Price: 1_000},
+				{Price: 1_300},
+				{Price: 1_060},
+				{Price: 800},
+				{Price: 1_050},
+			},
+			// The median is 1_050, so 800 and 1_300 deviate by more than 10% and are rejected.
+			expectedIndexPrice: 1_036,
+			expectedNumPrices:  3,
+		},
+		"Outlier rejection: all prices used if all prices are outliers": {
+			config:             types.AggregationConfig{MaxDeviationPpm: 100_000},
+			prices:             []types.PriceVolume{{Price: 1_000}, {Price: 3_000}},
+			previousIndexPrice: 5_000,
+			expectedIndexPrice: 2_000,
+			expectedNumPrices:  2,
+		},
+		"Outlier rejection: applied before volume weighting": {
+			config: types.AggregationConfig{
+				Method:          types.AggregationMethodVolumeWeightedMedian,
+				MaxDeviationPpm: 100_000,
+			},
+			prices: []types.PriceVolume{
+				{Price: 1_000, Volume: 1},
+				{Price: 1_050, Volume: 2},
+				{Price: 5_000, Volume: 100},
+			},
+			expectedIndexPrice: 1_050,
+			expectedNumPrices:  2,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			indexPrice, aggregatedPrices, err := tc.config.Aggregate(tc.prices, tc.previousIndexPrice)
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedIndexPrice, indexPrice)
+				require.Len(t, aggregatedPrices, tc.expectedNumPrices)
+			}
+		})
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"time"
 )
 
-// PriceTimestamp maintains a price and its last update timestamp.
+// PriceTimestamp maintains a price, the trading volume reported along with it, and its last update timestamp.
 type PriceTimestamp struct {
 	LastUpdateTime time.Time
 	Price          uint64
+	Volume         uint64
 }
 
 // NewPriceTimestamp creates a new PriceTimestamp.
@@ -18,9 +19,16 @@ func NewPriceTimestamp() *PriceTimestamp {
 // UpdatePrice updates the price if the given update has a greater timestamp. Returns true if
 // updating succeeds. Otherwise, returns false.
 func (pt *PriceTimestamp) UpdatePrice(price uint64, newUpdateTime *time.Time) bool {
+	return pt.UpdatePriceWithVolume(price, 0, newUpdateTime)
+}
+
+// UpdatePriceWithVolume updates the price and volume if the given update has a greater timestamp.
+// Returns true if updating succeeds. Otherwise, returns false.
+func (pt *PriceTimestamp) UpdatePriceWithVolume(price uint64, volume uint64, newUpdateTime *time.Time) bool {
 	if newUpdateTime.After(pt.LastUpdateTime) {
 		pt.LastUpdateTime = *newUpdateTime
 		pt.Price = price
+		pt.Volume = volume
 
 		return true
 	}
@@ -36,3 +44,12 @@ func (pt *PriceTimestamp) GetValidPrice(cutoffTime time.Time) (uint64, bool) {
 	}
 	return pt.Price, true
 }
+
+// GetValidPriceVolume returns (price and volume, true) if the last update time is greater than or
+// equal to the given cutoff time. Otherwise returns (empty PriceVolume, false).
+func (pt *PriceTimestamp) GetValidPriceVolume(cutoffTime time.Time) (PriceVolume, bool) {
+	if pt.LastUpdateTime.Before(cutoffTime) {
+		return PriceVolume{}, false
+	}
+	return PriceVolume{Price: pt.Price, Volume: pt.Volume}, true
+}
@@ -98,3 +98,41 @@ func TestGetValidPrice_InvalidLastUpdateTimeFail(t *testing.T) {
 	require.False(t, ok)
 	require.Equal(t, uint64(0), r)
 }
+
+func TestUpdatePriceWithVolume_GreaterUpdateTimeSuccess(t *testing.T) {
+	pt := types.NewPriceTimestamp()
+
+	// Last update @ timeT
+	ok := pt.UpdatePriceWithVolume(constants.Price1, 100, &constants.TimeT)
+	require.True(t, ok)
+
+	// Stale update @ timeT does not overwrite the volume.
+	ok = pt.UpdatePriceWithVolume(constants.Price2, 200, &constants.TimeT)
+	require.False(t, ok)
+	require.Equal(t, uint64(100), pt.Volume)
+
+	// New update @ timeT + threshold
+	ok = pt.UpdatePriceWithVolume(constants.Price2, 200, &constants.TimeTPlusThreshold)
+	require.True(t, ok)
+
+	require.Equal(t, constants.TimeTPlusThreshold, pt.LastUpdateTime)
+	require.Equal(t, constants.Price2, pt.Price)
+	require.Equal(t, uint64(200), pt.Volume)
+}
+
+func TestGetValidPriceVolume(t *testing.T) {
+	pt := types.NewPriceTimestamp()
+
+	// Last update @ timeT
+	ok := pt.UpdatePriceWithVolume(constants.Price1, 100, &constants.TimeT)
+	require.True(t, ok)
+
+	r, ok := pt.GetValidPriceVolume(constants.TimeT)
+	require.True(t, ok)
+	require.Equal(t, types.PriceVolume{Price: constants.Price1, Volume: 100}, r)
+
+	// Updates @ timeT are no longer valid at this cutoff time.
+	r, ok = pt.GetValidPriceVolume(constants.TimeTPlus1)
+	require.False(t, ok)
+	require.Equal(t, types.PriceVolume{}, r)
+}
@@ -0,0 +1,985 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dydxprotocol/daemons/server/health.proto
+
+package api
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DaemonHealthRequest is a request message for the health of all registered
+// daemons.
+type DaemonHealthRequest struct {
+}
+
+func (m *DaemonHealthRequest) Reset()         { *m = DaemonHealthRequest{} }
+func (m *DaemonHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*DaemonHealthRequest) ProtoMessage()    {}
+func (*DaemonHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0ef1b39f7707618, []int{0}
+}
+func (m *DaemonHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaemonHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaemonHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaemonHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaemonHealthRequest.Merge(m, src)
+}
+func (m *DaemonHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaemonHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaemonHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaemonHealthRequest proto.InternalMessageInfo
+
+// DaemonHealthResponse is a response message for DaemonHealthRequest.
+type DaemonHealthResponse struct {
+	// The health of each registered daemon, sorted by service name.
+	DaemonHealth []*DaemonHealth `protobuf:"bytes,1,rep,name=daemon_health,json=daemonHealth,proto3" json:"daemon_health,omitempty"`
+}
+
+func (m *DaemonHealthResponse) Reset()         { *m = DaemonHealthResponse{} }
+func (m *DaemonHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*DaemonHealthResponse) ProtoMessage()    {}
+func (*DaemonHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0ef1b39f7707618, []int{1}
+}
+func (m *DaemonHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaemonHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaemonHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaemonHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaemonHealthResponse.Merge(m, src)
+}
+func (m *DaemonHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaemonHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaemonHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaemonHealthResponse proto.InternalMessageInfo
+
+func (m *DaemonHealthResponse) GetDaemonHealth() []*DaemonHealth {
+	if m != nil {
+		return m.DaemonHealth
+	}
+	return nil
+}
+
+// DaemonHealth describes the health of a daemon registered with the daemon
+// server.
+type DaemonHealth struct {
+	// The name of the daemon service, e.g. "pricefeed-daemon".
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// The policy applied when the daemon stops responding. One of "halt", "log"
+	// or "degrade".
+	StalenessPolicy string `protobuf:"bytes,2,opt,name=staleness_policy,json=stalenessPolicy,proto3" json:"staleness_policy,omitempty"`
+	// Whether the daemon failed to respond within its maximum acceptable update
+	// delay and has not responded since.
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Whether the node is in degraded mode for the daemon. A degraded node does
+	// not propose the data provided by the daemon, but continues to vote.
+	Degraded bool `protobuf:"varint,4,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	// The time of the most recent valid response from the daemon. Unset if the
+	// daemon has not yet responded.
+	LastUpdateTime *time.Time `protobuf:"bytes,5,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	// The maximum delay between valid responses before the daemon is stale.
+	MaximumAcceptableUpdateDelay time.Duration `protobuf:"bytes,6,opt,name=maximum_acceptable_update_delay,json=maximumAcceptableUpdateDelay,proto3,stdduration" json:"maximum_acceptable_update_delay"`
+}
+
+func (m *DaemonHealth) Reset()         { *m = DaemonHealth{} }
+func (m *DaemonHealth) String() string { return proto.CompactTextString(m) }
+func (*DaemonHealth) ProtoMessage()    {}
+func (*DaemonHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0ef1b39f7707618, []int{2}
+}
+func (m *DaemonHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaemonHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaemonHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaemonHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaemonHealth.Merge(m, src)
+}
+func (m *DaemonHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaemonHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaemonHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaemonHealth proto.InternalMessageInfo
+
+func (m *DaemonHealth) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *DaemonHealth) GetStalenessPolicy() string {
+	if m != nil {
+		return m.StalenessPolicy
+	}
+	return ""
+}
+
+func (m *DaemonHealth) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *DaemonHealth) GetDegraded() bool {
+	if m != nil {
+		return m.Degraded
+	}
+	return false
+}
+
+func (m *DaemonHealth) GetLastUpdateTime() *time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return nil
+}
+
+func (m *DaemonHealth) GetMaximumAcceptableUpdateDelay() time.Duration {
+	if m != nil {
+		return m.MaximumAcceptableUpdateDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DaemonHealthRequest)(nil), "dydxprotocol.daemons.server.DaemonHealthRequest")
+	proto.RegisterType((*DaemonHealthResponse)(nil), "dydxprotocol.daemons.server.DaemonHealthResponse")
+	proto.RegisterType((*DaemonHealth)(nil), "dydxprotocol.daemons.server.DaemonHealth")
+}
+
+func init() {
+	proto.RegisterFile("dydxprotocol/daemons/server/health.proto", fileDescriptor_b0ef1b39f7707618)
+}
+
+var fileDescriptor_b0ef1b39f7707618 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x8f, 0xd3, 0x30,
+	0x14, 0xaf, 0xef, 0x1f, 0xc5, 0x77, 0xc0, 0x29, 0x14, 0x29, 0x04, 0x94, 0x56, 0x9d, 0x72, 0x03,
+	0x36, 0x14, 0x46, 0x16, 0xaa, 0x0e, 0x88, 0x01, 0xa1, 0xf2, 0x67, 0x60, 0x89, 0xdc, 0xf8, 0x5d,
+	0x6a, 0xe4, 0xc4, 0x21, 0x76, 0x4e, 0xd7, 0xaf, 0xc0, 0x74, 0x23, 0x5f, 0x86, 0xfd, 0xc6, 0x1b,
+	0x99, 0x00, 0xb5, 0x5f, 0x04, 0xc5, 0x4e, 0xab, 0xb6, 0x08, 0xc4, 0x6d, 0x7e, 0xef, 0xf7, 0xc7,
+	0x4f, 0xbf, 0x67, 0xe3, 0x88, 0xcf, 0xf8, 0x79, 0x51, 0x2a, 0xa3, 0x12, 0x25, 0x29, 0x67, 0x90,
+	0xa9, 0x5c, 0x53, 0x0d, 0xe5, 0x19, 0x94, 0x74, 0x0a, 0x4c, 0x9a, 0x29, 0xb1, 0xb0, 0xf7, 0x60,
+	0x9d, 0x49, 0x1a, 0x26, 0x71, 0xcc, 0xa0, 0x93, 0xaa, 0x54, 0x59, 0x90, 0xd6, 0x27, 0x27, 0x09,
+	0xc2, 0x54, 0xa9, 0x54, 0x02, 0xb5, 0xd5, 0xa4, 0x3a, 0xa5, 0xbc, 0x2a, 0x99, 0x11, 0x2a, 0x6f,
+	0xf0, 0xee, 0x36, 0x6e, 0x44, 0x06, 0xda, 0xb0, 0xac, 0x70, 0x84, 0xfe, 0x3d, 0x7c, 0x77, 0x64,
+	0x2f, 0x7a, 0x69, 0x27, 0x19, 0xc3, 0xe7, 0x0a, 0xb4, 0xe9, 0x9f, 0xe2, 0xce, 0x66, 0x5b, 0x17,
+	0x2a, 0xd7, 0xe0, 0xbd, 0xc6, 0xb7, 0xdc, 0x5c, 0xb1, 0x9b, 0xdc, 0x47, 0xbd, 0xdd, 0xe8, 0x70,
+	0x70, 0x42, 0xfe, 0x31, 0x3a, 0xd9, 0x70, 0x3a, 0xe2, 0x6b, 0x55, 0xff, 0xdb, 0x0e, 0x3e, 0x5a,
+	0x87, 0x3d, 0x1f, 0xdf, 0xa8, 0x55, 0x22, 0x01, 0x1f, 0xf5, 0x50, 0x74, 0x73, 0xbc, 0x2c, 0xbd,
+	0x13, 0x7c, 0xac, 0x0d, 0x93, 0x90, 0x83, 0xd6, 0x71, 0xa1, 0xa4, 0x48, 0x66, 0xfe, 0x8e, 0xa5,
+	0xdc, 0x59, 0xf5, 0xdf, 0xd8, 0xb6, 0xd7, 0xc1, 0xfb, 0xb6, 0xe5, 0xef, 0xf6, 0x50, 0xd4, 0x1e,
+	0xbb, 0xc2, 0x0b, 0x70, 0x9b, 0x43, 0x5a, 0x32, 0x0e, 0xdc, 0xdf, 0xb3, 0xc0, 0xaa, 0xf6, 0x5e,
+	0xe1, 0x63, 0xc9, 0xb4, 0x89, 0xab, 0x82, 0x33, 0x03, 0x71, 0x9d, 0x92, 0xbf, 0xdf, 0x43, 0xd1,
+	0xe1, 0x20, 0x20, 0x2e, 0x42, 0xb2, 0x8c, 0x90, 0xbc, 0x5b, 0x46, 0x38, 0xdc, 0xbb, 0xf8, 0xd9,
+	0x45, 0xe3, 0xdb, 0xb5, 0xf2, 0xbd, 0x15, 0xd6, 0x90, 0xf7, 0x09, 0x77, 0x33, 0x76, 0x2e, 0xb2,
+	0x2a, 0x8b, 0x59, 0x92, 0x40, 0x61, 0xd8, 0x44, 0xc2, 0xd2, 0x99, 0x83, 0x64, 0x33, 0xff, 0xc0,
+	0x5a, 0xdf, 0xff, 0xc3, 0x7a, 0xd4, 0x6c, 0x6f, 0xd8, 0xbe, 0xfc, 0xd1, 0x6d, 0x7d, 0xad, 0xdd,
+	0x1f, 0x36, 0x5e, 0x2f, 0x56, 0x56, 0xee, 0xaa, 0x51, 0x6d, 0x34, 0xf8, 0x82, 0x36, 0xf7, 0xf7,
+	0xb6, 0x09, 0x4b, 0x6f, 0xc5, 0xfa, 0xf8, 0xff, 0x17, 0xe4, 0x5e, 0x40, 0xf0, 0xe4, 0x1a, 0x0a,
+	0xf7, 0x38, 0x86, 0x1f, 0x2e, 0xe7, 0x21, 0xba, 0x9a, 0x87, 0xe8, 0xd7, 0x3c, 0x44, 0x17, 0x8b,
+	0xb0, 0x75, 0xb5, 0x08, 0x5b, 0xdf, 0x17, 0x61, 0xeb, 0xe3, 0xf3, 0x54, 0x98, 0x69, 0x35, 0x21,
+	0x89, 0xca, 0xe8, 0xc6, 0x77, 0x38, 0x7b, 0xf6, 0x28, 0x99, 0x32, 0x91, 0xd3, 0xbf, 0x7d, 0x10,
+	0x56, 0x88, 0xc9, 0x81, 0x05, 0x9f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x32, 0x13, 0xc5, 0x18,
+	0x4a, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DaemonHealthServiceClient is the client API for DaemonHealthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DaemonHealthServiceClient interface {
+	// Returns the health of all daemons registered with the daemon server.
+	DaemonHealth(ctx context.Context, in *DaemonHealthRequest, opts ...grpc.CallOption) (*DaemonHealthResponse, error)
+}
+
+type daemonHealthServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewDaemonHealthServiceClient(cc grpc1.ClientConn) DaemonHealthServiceClient {
+	return &daemonHealthServiceClient{cc}
+}
+
+func (c *daemonHealthServiceClient) DaemonHealth(ctx context.Context, in *DaemonHealthRequest, opts ...grpc.CallOption) (*DaemonHealthResponse, error) {
+	out := new(DaemonHealthResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.daemons.server.DaemonHealthService/DaemonHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DaemonHealthServiceServer is the server API for DaemonHealthService service.
+type DaemonHealthServiceServer interface {
+	// Returns the health of all daemons registered with the daemon server.
+	DaemonHealth(context.Context, *DaemonHealthRequest) (*DaemonHealthResponse, error)
+}
+
+// UnimplementedDaemonHealthServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDaemonHealthServiceServer struct {
+}
+
+func (*UnimplementedDaemonHealthServiceServer) DaemonHealth(ctx context.Context, req *DaemonHealthRequest) (*DaemonHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DaemonHealth not implemented")
+}
+
+func RegisterDaemonHealthServiceServer(s grpc1.Server, srv DaemonHealthServiceServer) {
+	s.RegisterService(&_DaemonHealthService_serviceDesc, srv)
+}
+
+func _DaemonHealthService_DaemonHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaemonHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonHealthServiceServer).DaemonHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.daemons.server.DaemonHealthService/DaemonHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonHealthServiceServer).DaemonHealth(ctx, req.(*DaemonHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DaemonHealthService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.daemons.server.DaemonHealthService",
+	HandlerType: (*DaemonHealthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DaemonHealth",
+			Handler:    _DaemonHealthService_DaemonHealth_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/daemons/server/health.proto",
+}
+
+func (m *DaemonHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaemonHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaemonHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DaemonHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaemonHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaemonHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DaemonHealth) > 0 {
+		for iNdEx := len(m.DaemonHealth) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaemonHealth[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHealth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaemonHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaemonHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaemonHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaximumAcceptableUpdateDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaximumAcceptableUpdateDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHealth(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.LastUpdateTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintHealth(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Degraded {
+		i--
+		if m.Degraded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StalenessPolicy) > 0 {
+		i -= len(m.StalenessPolicy)
+		copy(dAtA[i:], m.StalenessPolicy)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.StalenessPolicy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHealth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHealth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DaemonHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DaemonHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DaemonHealth) > 0 {
+		for _, e := range m.DaemonHealth {
+			l = e.Size()
+			n += 1 + l + sovHealth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DaemonHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	l = len(m.StalenessPolicy)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if m.Degraded {
+		n += 2
+	}
+	if m.LastUpdateTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime)
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaximumAcceptableUpdateDelay)
+	n += 1 + l + sovHealth(uint64(l))
+	return n
+}
+
+func sovHealth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHealth(x uint64) (n int) {
+	return sovHealth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DaemonHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaemonHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaemonHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaemonHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaemonHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaemonHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaemonHealth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaemonHealth = append(m.DaemonHealth, &DaemonHealth{})
+			if err := m.DaemonHealth[len(m.DaemonHealth)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaemonHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaemonHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaemonHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalenessPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StalenessPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Degraded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Degraded = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumAcceptableUpdateDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaximumAcceptableUpdateDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHealth(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHealth
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHealth
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHealth
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHealth        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHealth          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHealth = fmt.Errorf("proto: unexpected end of group")
+)
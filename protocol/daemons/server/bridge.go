@@ -25,10 +25,13 @@ func (server *Server) WithBridgeEventManager(
 
 // ExpectBridgeDaemon registers the bridge daemon with the server. This is required
 // in order to ensure that the daemon service is called at least once during every
-// maximumAcceptableUpdateDelay duration. It will cause the protocol to panic if the daemon does not
+// maximumAcceptableUpdateDelay duration. The staleness policy is applied if the daemon does not
 // respond within maximumAcceptableUpdateDelay duration.
-func (server *Server) ExpectBridgeDaemon(maximumAcceptableUpdateDelay time.Duration) {
-	server.registerDaemon(types.BridgeDaemonServiceName, maximumAcceptableUpdateDelay)
+func (server *Server) ExpectBridgeDaemon(
+	maximumAcceptableUpdateDelay time.Duration,
+	stalenessPolicy types.StalenessPolicy,
+) {
+	server.registerDaemon(types.BridgeDaemonServiceName, maximumAcceptableUpdateDelay, stalenessPolicy)
 }
 
 // AddBridgeEvents stores any bridge events recognized by the daemon
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/server/api"
+)
+
+// DaemonHealth returns the health of all daemons registered with the daemon server, including whether each daemon
+// is stale and whether the node is in degraded mode for the daemon.
+func (s *Server) DaemonHealth(
+	ctx context.Context,
+	req *api.DaemonHealthRequest,
+) (*api.DaemonHealthResponse, error) {
+	daemonHealth := s.updateMonitor.GetDaemonHealth()
+	response := &api.DaemonHealthResponse{
+		DaemonHealth: make([]*api.DaemonHealth, 0, len(daemonHealth)),
+	}
+	for _, health := range daemonHealth {
+		h := &api.DaemonHealth{
+			Service:                      health.Service,
+			StalenessPolicy:              string(health.StalenessPolicy),
+			Stale:                        health.Stale,
+			Degraded:                     health.Degraded,
+			MaximumAcceptableUpdateDelay: health.MaximumAcceptableUpdateDelay,
+		}
+		if !health.LastUpdateTime.IsZero() {
+			lastUpdateTime := health.LastUpdateTime
+			h.LastUpdateTime = &lastUpdateTime
+		}
+		response.DaemonHealth = append(response.DaemonHealth, h)
+	}
+	return response, nil
+}
@@ -0,0 +1,54 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/server"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/server/api"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/grpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDaemonHealth(t *testing.T) {
+	grpcServer := &mocks.GrpcServer{}
+	grpcServer.On("Stop").Return().Once()
+	s := server.NewServer(
+		log.NewNopLogger(),
+		grpcServer,
+		&mocks.FileHandler{},
+		grpc.SocketPath,
+	)
+	defer s.Stop()
+
+	// No daemons are registered.
+	response, err := s.DaemonHealth(context.TODO(), &api.DaemonHealthRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.DaemonHealth)
+
+	s.ExpectPricefeedDaemon(5*time.Second, types.StalenessPolicyDegrade)
+	s.ExpectLiquidationsDaemon(10*time.Second, types.StalenessPolicyHalt)
+
+	response, err = s.DaemonHealth(context.TODO(), &api.DaemonHealthRequest{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*api.DaemonHealth{
+			{
+				Service:                      types.LiquidationsDaemonServiceName,
+				StalenessPolicy:              string(types.StalenessPolicyHalt),
+				MaximumAcceptableUpdateDelay: 10 * time.Second,
+			},
+			{
+				Service:                      types.PricefeedDaemonServiceName,
+				StalenessPolicy:              string(types.StalenessPolicyDegrade),
+				MaximumAcceptableUpdateDelay: 5 * time.Second,
+			},
+		},
+		response.DaemonHealth,
+	)
+}
@@ -28,10 +28,13 @@ func (server *Server) WithLiquidatableSubaccountIds(
 
 // ExpectLiquidationsDaemon registers the liquidations daemon with the server. This is required
 // in order to ensure that the daemon service is called at least once during every
-// maximumAcceptableUpdateDelay duration. It will cause the protocol to panic if the daemon does not
+// maximumAcceptableUpdateDelay duration. The staleness policy is applied if the daemon does not
 // respond within maximumAcceptableUpdateDelay duration.
-func (server *Server) ExpectLiquidationsDaemon(maximumAcceptableUpdateDelay time.Duration) {
-	server.registerDaemon(types.LiquidationsDaemonServiceName, maximumAcceptableUpdateDelay)
+func (server *Server) ExpectLiquidationsDaemon(
+	maximumAcceptableUpdateDelay time.Duration,
+	stalenessPolicy types.StalenessPolicy,
+) {
+	server.registerDaemon(types.LiquidationsDaemonServiceName, maximumAcceptableUpdateDelay, stalenessPolicy)
 }
 
 // LiquidateSubaccounts stores the list of potentially liquidatable subaccount ids
@@ -8,8 +8,8 @@ import (
 
 // ExpectMetricsDaemon registers the periodic metrics daemon with the server. This is required
 // in order to ensure that the daemon service is called at least once during every
-// maximumAcceptableUpdateDelay duration. It will cause the protocol to panic if the daemon does not
-// respond within maximumAcceptableUpdateDelay duration.
+// maximumAcceptableUpdateDelay duration. The metrics daemon is purely used for observability, so an error is
+// logged if the daemon does not respond within maximumAcceptableUpdateDelay duration.
 func (server *Server) ExpectMetricsDaemon(maximumAcceptableUpdateDelay time.Duration) {
-	server.registerDaemon(types.MetricsDaemonServiceName, maximumAcceptableUpdateDelay)
+	server.registerDaemon(types.MetricsDaemonServiceName, maximumAcceptableUpdateDelay, types.StalenessPolicyLog)
 }
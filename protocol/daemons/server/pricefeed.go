@@ -19,6 +19,8 @@ import (
 // PriceFeedServer defines the fields required for price updates.
 type PriceFeedServer struct {
 	marketToExchange *pricefeedtypes.MarketToExchangePrices
+	// exchangeStatuses stores the exchange statuses reported by the daemon for introspection.
+	exchangeStatuses *pricefeedtypes.ExchangeStatuses
 }
 
 // WithPriceFeedMarketToExchangePrices sets the `MarketToExchangePrices` field.
@@ -33,10 +35,13 @@ func (server *Server) WithPriceFeedMarketToExchangePrices(
 
 // ExpectPricefeedDaemon registers the pricefeed daemon with the server. This is required
 // in order to ensure that the daemon service is called at least once during every
-// maximumAcceptableUpdateDelay duration. It will cause the protocol to panic if the daemon does not
+// maximumAcceptableUpdateDelay duration. The staleness policy is applied if the daemon does not
 // respond within maximumAcceptableUpdateDelay duration.
-func (server *Server) ExpectPricefeedDaemon(maximumAcceptableUpdateDelay time.Duration) {
-	server.registerDaemon(servertypes.PricefeedDaemonServiceName, maximumAcceptableUpdateDelay)
+func (server *Server) ExpectPricefeedDaemon(
+	maximumAcceptableUpdateDelay time.Duration,
+	stalenessPolicy servertypes.StalenessPolicy,
+) {
+	server.registerDaemon(servertypes.PricefeedDaemonServiceName, maximumAcceptableUpdateDelay, stalenessPolicy)
 }
 
 // UpdateMarketPrices updates prices from exchanges for each market provided.
@@ -74,6 +79,7 @@ func (s *Server) UpdateMarketPrices(
 	}
 
 	s.marketToExchange.UpdatePrices(req.MarketPriceUpdates)
+	s.exchangeStatuses.UpdateStatuses(req.ExchangeStatuses)
 	return &api.UpdateMarketPricesResponse{}, nil
 }
 
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarketPrices returns the most recent price reported by each exchange for the requested markets, along with the
+// most recently computed index price of each market and the exchanges whose prices were aggregated into it.
+// All markets are returned if no market ids are requested.
+func (s *Server) MarketPrices(
+	ctx context.Context,
+	req *api.MarketPricesRequest,
+) (*api.MarketPricesResponse, error) {
+	if s.marketToExchange == nil {
+		return nil, status.Error(codes.Unavailable, "MarketToExchange not initialized")
+	}
+
+	return &api.MarketPricesResponse{
+		MarketPrices: s.marketToExchange.GetMarketPrices(req.MarketIds),
+	}, nil
+}
+
+// ExchangeStatuses returns the most recent status reported by the pricefeed daemon for each exchange, including
+// the number of failed queries and the time of the last successful query.
+func (s *Server) ExchangeStatuses(
+	ctx context.Context,
+	req *api.ExchangeStatusesRequest,
+) (*api.ExchangeStatusesResponse, error) {
+	if s.exchangeStatuses == nil {
+		return nil, status.Error(codes.Unavailable, "ExchangeStatuses not initialized")
+	}
+
+	return &api.ExchangeStatusesResponse{
+		ExchangeStatuses: s.exchangeStatuses.GetAllStatuses(),
+	}, nil
+}
@@ -0,0 +1,88 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
+	pricefeed_types "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/server"
+	pricefeedserver_types "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/pricefeed"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMarketPrices_NotInitialized(t *testing.T) {
+	s := createServerWithMocks(t, &mocks.GrpcServer{}, &mocks.FileHandler{})
+
+	_, err := s.MarketPrices(context.TODO(), &api.MarketPricesRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.EqualError(t, err, status.Error(codes.Unavailable, "MarketToExchange not initialized").Error())
+}
+
+func TestExchangeStatuses_NotInitialized(t *testing.T) {
+	s := &server.Server{}
+
+	_, err := s.ExchangeStatuses(context.TODO(), &api.ExchangeStatusesRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.EqualError(t, err, status.Error(codes.Unavailable, "ExchangeStatuses not initialized").Error())
+}
+
+func TestMarketPricesAndExchangeStatuses(t *testing.T) {
+	s := createServerWithMocks(
+		t,
+		&mocks.GrpcServer{},
+		&mocks.FileHandler{},
+	).WithPriceFeedMarketToExchangePrices(
+		pricefeedserver_types.NewMarketToExchangePrices(pricefeed_types.MaxPriceAge),
+	)
+
+	// Nothing is returned before the daemon reports prices.
+	marketPrices, err := s.MarketPrices(context.TODO(), &api.MarketPricesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, marketPrices.MarketPrices)
+	exchangeStatuses, err := s.ExchangeStatuses(context.TODO(), &api.ExchangeStatusesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, exchangeStatuses.ExchangeStatuses)
+
+	status := &api.ExchangeStatus{
+		ExchangeId:      constants.ExchangeId2,
+		ErrorCount:      3,
+		LastSuccessTime: &constants.TimeT,
+		LastErrorTime:   &constants.TimeTPlusThreshold,
+		LastErrorReason: "http_get_5xx",
+		LastError:       "Internal Server Error",
+	}
+	sendAndCheckPriceUpdate(
+		t,
+		s,
+		&api.UpdateMarketPricesRequest{
+			MarketPriceUpdates: constants.AtTimeTPriceUpdate,
+			ExchangeStatuses:   []*api.ExchangeStatus{status},
+		},
+		nil,
+	)
+
+	marketPrices, err = s.MarketPrices(
+		context.TODO(),
+		&api.MarketPricesRequest{MarketIds: []uint32{constants.MarketId9}},
+	)
+	require.NoError(t, err)
+	require.Len(t, marketPrices.MarketPrices, 1)
+	require.Equal(t, constants.MarketId9, marketPrices.MarketPrices[0].MarketId)
+	require.Equal(
+		t,
+		[]*api.ExchangePrice{
+			constants.Exchange1_Price1_TimeT,
+			constants.Exchange2_Price2_TimeT,
+		},
+		marketPrices.MarketPrices[0].ExchangePrices,
+	)
+
+	exchangeStatuses, err = s.ExchangeStatuses(context.TODO(), &api.ExchangeStatusesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*api.ExchangeStatus{status}, exchangeStatuses.ExchangeStatuses)
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/constants"
 	liquidationapi "github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	pricefeedapi "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
+	serverapi "github.com/dydxprotocol/v4-chain/protocol/daemons/server/api"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/pricefeed"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"net"
@@ -48,6 +50,9 @@ func NewServer(
 		fileHandler:   fileHandler,
 		socketAddress: socketAddress,
 		updateMonitor: types.NewUpdateFrequencyMonitor(types.DaemonStartupGracePeriod, logger),
+		PriceFeedServer: PriceFeedServer{
+			exchangeStatuses: pricefeedtypes.NewExchangeStatuses(),
+		},
 	}
 }
 
@@ -67,8 +72,13 @@ func (server *Server) DisableUpdateMonitoringForTesting() {
 func (server *Server) registerDaemon(
 	daemonKey string,
 	maximumAcceptableUpdateDelay time.Duration,
+	stalenessPolicy types.StalenessPolicy,
 ) {
-	if err := server.updateMonitor.RegisterDaemonService(daemonKey, maximumAcceptableUpdateDelay); err != nil {
+	if err := server.updateMonitor.RegisterDaemonServiceWithPolicy(
+		daemonKey,
+		maximumAcceptableUpdateDelay,
+		stalenessPolicy,
+	); err != nil {
 		server.logger.Error(
 			"Failed to register daemon service with update monitor",
 			"error",
@@ -77,14 +87,23 @@ func (server *Server) registerDaemon(
 			daemonKey,
 			"maximumAcceptableUpdateDelay",
 			maximumAcceptableUpdateDelay,
+			"stalenessPolicy",
+			stalenessPolicy,
 		)
 		panic(err)
 	}
 }
 
+// IsDaemonDegraded returns true if the node is in degraded mode for the daemon service, i.e. the daemon was
+// registered with the degrade staleness policy and has not responded within its maximumAcceptableUpdateDelay.
+// While degraded, the node should not propose the data provided by the daemon.
+func (server *Server) IsDaemonDegraded(daemonKey string) bool {
+	return server.updateMonitor.IsDegraded(daemonKey)
+}
+
 // reportResponse reports a response from a daemon service with the update monitor. This is used to
 // ensure that the daemon continues to operate. If the update monitor does not see a response from a
-// registered daemon within the maximumAcceptableUpdateDelay, it will cause the protocol to panic.
+// registered daemon within the maximumAcceptableUpdateDelay, it will apply the daemon's staleness policy.
 func (server *Server) reportResponse(
 	daemonKey string,
 ) error {
@@ -134,9 +153,15 @@ func (server *Server) Start() {
 	// Register Server to ingest gRPC requests from price feed daemon and update market prices.
 	pricefeedapi.RegisterPriceFeedServiceServer(server.gsrv, server)
 
+	// Register Server to serve gRPC requests from operators inspecting the prices reported by the price feed daemon.
+	pricefeedapi.RegisterPriceFeedIntrospectionServiceServer(server.gsrv, server)
+
 	// Register Server to ingest gRPC requests from liquidation daemon.
 	liquidationapi.RegisterLiquidationServiceServer(server.gsrv, server)
 
+	// Register Server to serve gRPC requests from operators inspecting the health of the daemons.
+	serverapi.RegisterDaemonHealthServiceServer(server.gsrv, server)
+
 	if err := server.gsrv.Serve(ln); err != nil {
 		server.logger.Error("daemon gRPC server stopped with an error", "error", err)
 		panic(err)
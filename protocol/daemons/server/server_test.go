@@ -6,6 +6,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	pricefeedconstants "github.com/dydxprotocol/v4-chain/protocol/daemons/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/server"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/grpc"
 	"github.com/stretchr/testify/mock"
@@ -171,7 +172,7 @@ func TestRegisterDaemon_DoesNotPanic(t *testing.T) {
 	defer server.Stop()
 
 	require.NotPanics(t, func() {
-		server.ExpectPricefeedDaemon(5 * time.Second)
+		server.ExpectPricefeedDaemon(5*time.Second, types.StalenessPolicyLog)
 	})
 }
 
@@ -188,7 +189,7 @@ func TestRegisterDaemon_DoubleRegistrationPanics(t *testing.T) {
 
 	// First registration should not panic.
 	require.NotPanics(t, func() {
-		server.ExpectPricefeedDaemon(5 * time.Second)
+		server.ExpectPricefeedDaemon(5*time.Second, types.StalenessPolicyLog)
 	})
 
 	// Second registration should panic.
@@ -196,11 +197,51 @@ func TestRegisterDaemon_DoubleRegistrationPanics(t *testing.T) {
 		t,
 		"service pricefeed-daemon already registered",
 		func() {
-			server.ExpectPricefeedDaemon(5 * time.Second)
+			server.ExpectPricefeedDaemon(5*time.Second, types.StalenessPolicyLog)
 		},
 	)
 }
 
+func TestRegisterDaemon_InvalidStalenessPolicyPanics(t *testing.T) {
+	grpcServer := &mocks.GrpcServer{}
+	grpcServer.On("Stop").Return().Once()
+	server := server.NewServer(
+		log.NewNopLogger(),
+		grpcServer,
+		&mocks.FileHandler{},
+		grpc.SocketPath,
+	)
+	defer server.Stop()
+
+	require.PanicsWithError(
+		t,
+		`registration failure for service pricefeed-daemon: invalid staleness policy "continue", `+
+			`must be one of "halt", "log" or "degrade"`,
+		func() {
+			server.ExpectPricefeedDaemon(5*time.Second, "continue")
+		},
+	)
+}
+
+func TestIsDaemonDegraded(t *testing.T) {
+	grpcServer := &mocks.GrpcServer{}
+	grpcServer.On("Stop").Return().Once()
+	server := server.NewServer(
+		log.NewNopLogger(),
+		grpcServer,
+		&mocks.FileHandler{},
+		grpc.SocketPath,
+	)
+	defer server.Stop()
+
+	server.ExpectPricefeedDaemon(5*time.Second, types.StalenessPolicyDegrade)
+
+	// The daemon is within its startup grace period, so the node is not degraded.
+	require.False(t, server.IsDaemonDegraded(types.PricefeedDaemonServiceName))
+	// Unregistered daemons never cause the node to be degraded.
+	require.False(t, server.IsDaemonDegraded(types.BridgeDaemonServiceName))
+}
+
 func createServerWithMocks(
 	t testing.TB,
 	mockGrpcServer *mocks.GrpcServer,
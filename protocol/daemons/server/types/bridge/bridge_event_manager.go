@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	gometrics "github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
@@ -13,20 +14,23 @@ import (
 
 type EventId = uint32
 
-// BridgeEventManager maintains a map of "Recognized" Bridge Events.
+type SourceId = uint32
+
+// BridgeEventManager maintains a map of "Recognized" Bridge Events per bridge source.
 // That is, events that have been finalized on Ethereum but are
 // not yet in consensus on the V4 chain. Methods are goroutine safe.
 type BridgeEventManager struct {
 	// Exclusive mutex taken when reading or writing
 	sync.Mutex
 
-	// Bridge events by ID
-	events map[EventId]BridgeEventWithTime
+	// Bridge events by source ID and event ID
+	events map[SourceId]map[EventId]BridgeEventWithTime
 
-	// Stores:
+	// Stores per source:
 	// - The next unused key in the bridges map (`NextId`)
 	// - The block height of the last recognized event (`EthBlockHeight`)
-	recognizedEventInfo types.BridgeEventInfo
+	// Sources that have not recognized any event are absent.
+	recognizedEventInfos map[SourceId]types.BridgeEventInfo
 
 	// Time provider than can mocked out if necessary
 	timeProvider libtime.TimeProvider
@@ -44,18 +48,16 @@ func NewBridgeEventManager(
 	timeProvider libtime.TimeProvider,
 ) *BridgeEventManager {
 	return &BridgeEventManager{
-		events: make(map[uint32]BridgeEventWithTime),
-		recognizedEventInfo: types.BridgeEventInfo{
-			NextId:         0,
-			EthBlockHeight: 0,
-		},
-		timeProvider: timeProvider,
+		events:               make(map[SourceId]map[EventId]BridgeEventWithTime),
+		recognizedEventInfos: make(map[SourceId]types.BridgeEventInfo),
+		timeProvider:         timeProvider,
 	}
 }
 
 // AddBridgeEvents adds bridge events to the manager (with timestamps).
-// Added events must have contiguous and in-order IDs.
-// Any events with ID less than the `recognizedEventInfo.NextId` are ignored.
+// Added events must be grouped by source in increasing order of source ID and
+// have contiguous and in-order IDs within each source.
+// Any events with ID less than the `NextId` of its source's recognized event info are ignored.
 func (b *BridgeEventManager) AddBridgeEvents(
 	events []types.BridgeEvent,
 ) error {
@@ -67,54 +69,78 @@ func (b *BridgeEventManager) AddBridgeEvents(
 		return nil
 	}
 
-	// Validate events are contiguous and in-order.
+	// Validate events are grouped by source and contiguous and in-order within each source.
 	for i, event := range events {
-		if event.Id != events[0].Id+uint32(i) {
+		if i == 0 {
+			continue
+		}
+		prev := events[i-1]
+		if prev.SourceId > event.SourceId {
+			telemetry.IncrCounter(1, metrics.BridgeServer, metrics.AddBridgeEvents, metrics.EventIdNotSequential)
+			return fmt.Errorf("AddBridgeEvents: Events must be grouped by source in increasing order of source ID")
+		}
+		if prev.SourceId == event.SourceId && prev.Id+1 != event.Id {
 			telemetry.IncrCounter(1, metrics.BridgeServer, metrics.AddBridgeEvents, metrics.EventIdNotSequential)
 			return fmt.Errorf("AddBridgeEvents: Events must be contiguous and in-order")
 		}
 	}
 
 	now := b.timeProvider.Now()
+	updatedSourceIds := make([]SourceId, 0, 1)
 	for _, event := range events {
+		recognizedEventInfo := b.getRecognizedEventInfo(event.SourceId)
 		// Ignore stale events which may be the result of a race condition.
-		if event.Id < b.recognizedEventInfo.NextId {
+		if event.Id < recognizedEventInfo.NextId {
 			telemetry.IncrCounter(1, metrics.BridgeServer, metrics.AddBridgeEvents, metrics.EventIdAlreadyRecognized)
 			continue
 		}
 
 		// Update BridgeEventManager with the new event.
-		b.events[event.Id] = BridgeEventWithTime{
+		sourceEvents, exists := b.events[event.SourceId]
+		if !exists {
+			sourceEvents = make(map[EventId]BridgeEventWithTime)
+			b.events[event.SourceId] = sourceEvents
+		}
+		sourceEvents[event.Id] = BridgeEventWithTime{
 			event:     event,
 			timestamp: now,
 		}
-		// Update recognized event info of BridgeEventManager.
-		b.recognizedEventInfo = types.BridgeEventInfo{
+		// Update recognized event info of the source.
+		b.recognizedEventInfos[event.SourceId] = types.BridgeEventInfo{
 			NextId:         event.Id + 1,
 			EthBlockHeight: event.EthBlockHeight,
+			SourceId:       event.SourceId,
+		}
+		if len(updatedSourceIds) == 0 || updatedSourceIds[len(updatedSourceIds)-1] != event.SourceId {
+			updatedSourceIds = append(updatedSourceIds, event.SourceId)
 		}
 	}
 
-	// Emit metrics on updated recognized event info.
-	telemetry.SetGauge(
-		float32(b.recognizedEventInfo.NextId),
-		metrics.BridgeServer,
-		metrics.RecognizedEventInfo,
-		metrics.NextId,
-	)
-	telemetry.SetGauge(
-		float32(b.recognizedEventInfo.EthBlockHeight),
-		metrics.BridgeServer,
-		metrics.RecognizedEventInfo,
-		metrics.EthBlockHeight,
-	)
+	// Emit metrics on updated recognized event infos.
+	for _, sourceId := range updatedSourceIds {
+		recognizedEventInfo := b.recognizedEventInfos[sourceId]
+		sourceIdLabel := []gometrics.Label{
+			metrics.GetLabelForIntValue(metrics.BridgeSourceId, int(sourceId)),
+		}
+		telemetry.SetGaugeWithLabels(
+			[]string{metrics.BridgeServer, metrics.RecognizedEventInfo, metrics.NextId},
+			float32(recognizedEventInfo.NextId),
+			sourceIdLabel,
+		)
+		telemetry.SetGaugeWithLabels(
+			[]string{metrics.BridgeServer, metrics.RecognizedEventInfo, metrics.EthBlockHeight},
+			float32(recognizedEventInfo.EthBlockHeight),
+			sourceIdLabel,
+		)
+	}
 
 	return nil
 }
 
-// GetBridgeEventById returns a bridge event by ID.
+// GetBridgeEventById returns a bridge event of a source by ID.
 // Found is false if the manager does not have the event.
 func (b *BridgeEventManager) GetBridgeEventById(
+	sourceId uint32,
 	id uint32,
 ) (
 	event types.BridgeEvent,
@@ -125,7 +151,7 @@ func (b *BridgeEventManager) GetBridgeEventById(
 	defer b.Unlock()
 
 	// Find the event.
-	eventWithTime, found := b.events[id]
+	eventWithTime, found := b.events[sourceId][id]
 	if !found {
 		return event, timestamp, found // default values
 	}
@@ -133,30 +159,44 @@ func (b *BridgeEventManager) GetBridgeEventById(
 	return eventWithTime.event, eventWithTime.timestamp, true
 }
 
-// GetRecognizedEventInfo returns `recognizedEventInfo`.
-func (b *BridgeEventManager) GetRecognizedEventInfo() types.BridgeEventInfo {
+// GetRecognizedEventInfo returns the recognized event info of a source.
+func (b *BridgeEventManager) GetRecognizedEventInfo(sourceId uint32) types.BridgeEventInfo {
 	b.Lock()
 	defer b.Unlock()
 
-	return b.recognizedEventInfo
+	return b.getRecognizedEventInfo(sourceId)
+}
+
+// getRecognizedEventInfo returns the recognized event info of a source. The lock must be held by the caller.
+func (b *BridgeEventManager) getRecognizedEventInfo(sourceId uint32) types.BridgeEventInfo {
+	recognizedEventInfo, exists := b.recognizedEventInfos[sourceId]
+	if !exists {
+		return types.BridgeEventInfo{
+			NextId:         0,
+			EthBlockHeight: 0,
+			SourceId:       sourceId,
+		}
+	}
+	return recognizedEventInfo
 }
 
-// SetRecognizedEventInfo sets `recognizedEventInfo`. An error is returned
-// and no update occurs if `NextId` or `EthBlockHeight` is lesser than its
-// existing value.
+// SetRecognizedEventInfo sets the recognized event info of source `eventInfo.SourceId`.
+// An error is returned and no update occurs if `NextId` or `EthBlockHeight` is lesser
+// than its existing value.
 func (b *BridgeEventManager) SetRecognizedEventInfo(
 	eventInfo types.BridgeEventInfo,
 ) error {
 	b.Lock()
 	defer b.Unlock()
 
-	if eventInfo.NextId < b.recognizedEventInfo.NextId {
+	recognizedEventInfo := b.getRecognizedEventInfo(eventInfo.SourceId)
+	if eventInfo.NextId < recognizedEventInfo.NextId {
 		return fmt.Errorf("NextId cannot be set to a lower value")
-	} else if eventInfo.EthBlockHeight < b.recognizedEventInfo.EthBlockHeight {
+	} else if eventInfo.EthBlockHeight < recognizedEventInfo.EthBlockHeight {
 		return fmt.Errorf("EthBlockHeight cannot be set to a lower value")
 	}
 
-	b.recognizedEventInfo = eventInfo
+	b.recognizedEventInfos[eventInfo.SourceId] = eventInfo
 	return nil
 }
 
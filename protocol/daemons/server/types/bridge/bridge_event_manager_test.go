@@ -28,14 +28,14 @@ func setupEventManager() *bdtypes.BridgeEventManager {
 func TestNewBridgeEventManager(t *testing.T) {
 	bem := setupEventManager()
 
-	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 }
 
 func TestBridgeEventManager_SetRecognizedEventInfo(t *testing.T) {
 	bem := setupEventManager()
 
 	// Check default value.
-	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 
 	// Increase `NextId` by 1.
 	eventInfo := types.BridgeEventInfo{
@@ -43,7 +43,7 @@ func TestBridgeEventManager_SetRecognizedEventInfo(t *testing.T) {
 		EthBlockHeight: 0,
 	}
 	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 
 	// Increase `NextId` by more than 1.
 	eventInfo = types.BridgeEventInfo{
@@ -51,11 +51,11 @@ func TestBridgeEventManager_SetRecognizedEventInfo(t *testing.T) {
 		EthBlockHeight: 0,
 	}
 	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 
 	// Keep `NextId` the same
 	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 
 	// Cannot decrease `NextId`.
 	eventInfo = types.BridgeEventInfo{
@@ -70,7 +70,7 @@ func TestBridgeEventManager_SetRecognizedEventInfo(t *testing.T) {
 		EthBlockHeight: 1,
 	}
 	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 
 	// Increase `EthBlockHeight` by more than 1.
 	eventInfo = types.BridgeEventInfo{
@@ -78,7 +78,7 @@ func TestBridgeEventManager_SetRecognizedEventInfo(t *testing.T) {
 		EthBlockHeight: 4,
 	}
 	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 
 	// Cannot decrease `EthBlockHeight`.
 	eventInfo = types.BridgeEventInfo{
@@ -93,7 +93,7 @@ func TestBridgeEventManager_SetRecognizedEventInfo(t *testing.T) {
 		EthBlockHeight: 5,
 	}
 	require.NoError(t, bem.SetRecognizedEventInfo(eventInfo))
-	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo())
+	require.EqualValues(t, eventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 }
 
 func TestBridgeEventManager_AddBridgeEvents(t *testing.T) {
@@ -167,6 +167,17 @@ func TestBridgeEventManager_AddBridgeEvents(t *testing.T) {
 			},
 			errorMsg: "contiguous",
 		},
+		"Error Sources Not Sorted": {
+			initialREI: types.BridgeEventInfo{
+				NextId:         0,
+				EthBlockHeight: 0,
+			},
+			events: []types.BridgeEvent{
+				constants.BridgeEvent_Source1_Id0_Height2,
+				constants.BridgeEvent_Id0_Height0,
+			},
+			errorMsg: "grouped by source",
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -185,9 +196,9 @@ func TestBridgeEventManager_AddBridgeEvents(t *testing.T) {
 			}
 
 			// ensure result is correct
-			require.EqualValues(t, tc.expectedREI, bem.GetRecognizedEventInfo())
+			require.EqualValues(t, tc.expectedREI, bem.GetRecognizedEventInfo(types.PrimarySourceId))
 			for _, event := range tc.events {
-				_, _, found := bem.GetBridgeEventById(event.Id)
+				_, _, found := bem.GetBridgeEventById(event.SourceId, event.Id)
 				if event.Id >= tc.initialREI.NextId {
 					require.True(t, found)
 				} else {
@@ -201,7 +212,7 @@ func TestBridgeEventManager_AddBridgeEvents(t *testing.T) {
 func TestBridgeEventManager_GetBridgeEventById_Empty(t *testing.T) {
 	bem := setupEventManager()
 
-	_, _, found := bem.GetBridgeEventById(0)
+	_, _, found := bem.GetBridgeEventById(types.PrimarySourceId, 0)
 	require.Equal(t, false, found)
 }
 
@@ -213,8 +224,55 @@ func TestBridgeEventManager_GetBridgeEventById_Success(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	result, timestamp, found := bem.GetBridgeEventById(constants.BridgeEvent_Id0_Height0.Id)
+	result, timestamp, found := bem.GetBridgeEventById(
+		constants.BridgeEvent_Id0_Height0.SourceId,
+		constants.BridgeEvent_Id0_Height0.Id,
+	)
 	require.True(t, found)
 	require.Equal(t, constants.BridgeEvent_Id0_Height0, result)
 	require.Equal(t, constants.TimeT, timestamp)
 }
+
+func TestBridgeEventManager_MultipleSources(t *testing.T) {
+	bem := setupEventManager()
+
+	// Recognized event info of each source is independent.
+	require.NoError(t, bem.SetRecognizedEventInfo(constants.AcknowledgedEventInfo_Source1_Id0_Height0))
+	require.EqualValues(t, DefaultBridgeEventInfo, bem.GetRecognizedEventInfo(types.PrimarySourceId))
+	require.EqualValues(
+		t,
+		constants.AcknowledgedEventInfo_Source1_Id0_Height0,
+		bem.GetRecognizedEventInfo(constants.BridgeSource_1.Id),
+	)
+
+	// Event ids are contiguous per source.
+	err := bem.AddBridgeEvents([]types.BridgeEvent{
+		constants.BridgeEvent_Id0_Height0,
+		constants.BridgeEvent_Source1_Id0_Height2,
+		constants.BridgeEvent_Source1_Id1_Height4,
+	})
+	require.NoError(t, err)
+
+	require.EqualValues(
+		t,
+		types.BridgeEventInfo{
+			NextId:         1,
+			EthBlockHeight: constants.BridgeEvent_Id0_Height0.EthBlockHeight,
+		},
+		bem.GetRecognizedEventInfo(types.PrimarySourceId),
+	)
+	require.EqualValues(
+		t,
+		constants.RecognizedEventInfo_Source1_Id2_Height4,
+		bem.GetRecognizedEventInfo(constants.BridgeSource_1.Id),
+	)
+
+	result, _, found := bem.GetBridgeEventById(constants.BridgeSource_1.Id, 0)
+	require.True(t, found)
+	require.Equal(t, constants.BridgeEvent_Source1_Id0_Height2, result)
+	result, _, found = bem.GetBridgeEventById(types.PrimarySourceId, 0)
+	require.True(t, found)
+	require.Equal(t, constants.BridgeEvent_Id0_Height0, result)
+	_, _, found = bem.GetBridgeEventById(types.PrimarySourceId, 1)
+	require.False(t, found)
+}
@@ -0,0 +1,7 @@
+package types
+
+// DaemonHealthChecker reports whether the node is in degraded mode for a daemon service. While degraded, the node
+// continues to vote on blocks but does not propose the data provided by the daemon.
+type DaemonHealthChecker interface {
+	IsDaemonDegraded(daemonKey string) bool
+}
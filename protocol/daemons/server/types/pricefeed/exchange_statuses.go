@@ -0,0 +1,51 @@
+package types
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
+)
+
+// ExchangeStatuses maintains the most recent status of each exchange queried by the pricefeed daemon, as reported
+// by the daemon alongside its price updates. Methods are goroutine safe.
+type ExchangeStatuses struct {
+	sync.Mutex                                      // lock
+	exchangeToStatus map[string]*api.ExchangeStatus // {k: exchange id, v: exchange status}
+}
+
+// NewExchangeStatuses creates a new ExchangeStatuses.
+func NewExchangeStatuses() *ExchangeStatuses {
+	return &ExchangeStatuses{
+		exchangeToStatus: make(map[string]*api.ExchangeStatus),
+	}
+}
+
+// UpdateStatuses replaces the status of each exchange in `statuses`. The statuses of exchanges not in `statuses`
+// are left unchanged.
+func (es *ExchangeStatuses) UpdateStatuses(statuses []*api.ExchangeStatus) {
+	es.Lock()
+	defer es.Unlock()
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+		statusCopy := *status
+		es.exchangeToStatus[status.ExchangeId] = &statusCopy
+	}
+}
+
+// GetAllStatuses returns the most recent status of each exchange, sorted by exchange id.
+func (es *ExchangeStatuses) GetAllStatuses() []*api.ExchangeStatus {
+	es.Lock()
+	defer es.Unlock()
+	statuses := make([]*api.ExchangeStatus, 0, len(es.exchangeToStatus))
+	for _, status := range es.exchangeToStatus {
+		statusCopy := *status
+		statuses = append(statuses, &statusCopy)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ExchangeId < statuses[j].ExchangeId
+	})
+	return statuses
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExchangeStatuses_IsEmpty(t *testing.T) {
+	es := NewExchangeStatuses()
+
+	require.Empty(t, es.exchangeToStatus)
+	require.Empty(t, es.GetAllStatuses())
+}
+
+func TestExchangeStatuses_UpdateStatuses(t *testing.T) {
+	es := NewExchangeStatuses()
+
+	es.UpdateStatuses(
+		[]*api.ExchangeStatus{
+			{
+				ExchangeId:      constants.ExchangeId2,
+				ErrorCount:      1,
+				LastErrorTime:   &constants.TimeT,
+				LastErrorReason: "rate_limit",
+				LastError:       "rate limited",
+			},
+			{
+				ExchangeId:      constants.ExchangeId1,
+				LastSuccessTime: &constants.TimeT,
+			},
+		},
+	)
+
+	// Statuses replace the previous status of the exchange, and other exchanges are unchanged.
+	es.UpdateStatuses(
+		[]*api.ExchangeStatus{
+			{
+				ExchangeId:      constants.ExchangeId1,
+				ErrorCount:      2,
+				LastSuccessTime: &constants.TimeTPlusThreshold,
+			},
+		},
+	)
+
+	require.Equal(
+		t,
+		[]*api.ExchangeStatus{
+			{
+				ExchangeId:      constants.ExchangeId1,
+				ErrorCount:      2,
+				LastSuccessTime: &constants.TimeTPlusThreshold,
+			},
+			{
+				ExchangeId:      constants.ExchangeId2,
+				ErrorCount:      1,
+				LastErrorTime:   &constants.TimeT,
+				LastErrorReason: "rate_limit",
+				LastError:       "rate limited",
+			},
+		},
+		es.GetAllStatuses(),
+	)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"sort"
 	"time"
 
 	gometrics "github.com/armon/go-metrics"
@@ -40,7 +41,11 @@ func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 			etp.exchangeToPriceTimestamp[exchangeId] = priceTimestamp
 		}
 
-		isUpdated := priceTimestamp.UpdatePrice(exchangePrice.Price, exchangePrice.LastUpdateTime)
+		isUpdated := priceTimestamp.UpdatePriceWithVolume(
+			exchangePrice.Price,
+			exchangePrice.Volume,
+			exchangePrice.LastUpdateTime,
+		)
 
 		validity := metrics.Valid
 		if exists && !isUpdated {
@@ -59,17 +64,18 @@ func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 	}
 }
 
-// GetValidPrices returns a list of "valid" prices. Prices are considered
-// "valid" iff the last update time is greater than or equal to the given cutoff time.
+// GetValidPrices returns a list of "valid" prices, along with the volume and exchange id reported with each price.
+// Prices are considered "valid" iff the last update time is greater than or equal to the given cutoff time.
 func (etp *ExchangeToPrice) GetValidPrices(
 	cutoffTime time.Time,
-) []uint64 {
-	validExchangePricesForMarket := make([]uint64, 0, len(etp.exchangeToPriceTimestamp))
+) []types.PriceVolume {
+	validExchangePricesForMarket := make([]types.PriceVolume, 0, len(etp.exchangeToPriceTimestamp))
 	for exchangeId, priceTimestamp := range etp.exchangeToPriceTimestamp {
 		validity := metrics.Valid
 
 		// PriceTimestamp returns price if the last update time is valid.
-		if price, ok := priceTimestamp.GetValidPrice(cutoffTime); ok {
+		if price, ok := priceTimestamp.GetValidPriceVolume(cutoffTime); ok {
+			price.ExchangeId = exchangeId
 			validExchangePricesForMarket = append(validExchangePricesForMarket, price)
 		} else {
 			// Price is invalid.
@@ -93,3 +99,22 @@ func (etp *ExchangeToPrice) GetValidPrices(
 	}
 	return validExchangePricesForMarket
 }
+
+// GetAllPrices returns the most recent price reported by each exchange, regardless of its age, sorted by
+// exchange id.
+func (etp *ExchangeToPrice) GetAllPrices() []*api.ExchangePrice {
+	exchangePrices := make([]*api.ExchangePrice, 0, len(etp.exchangeToPriceTimestamp))
+	for exchangeId, priceTimestamp := range etp.exchangeToPriceTimestamp {
+		lastUpdateTime := priceTimestamp.LastUpdateTime
+		exchangePrices = append(exchangePrices, &api.ExchangePrice{
+			ExchangeId:     exchangeId,
+			Price:          priceTimestamp.Price,
+			LastUpdateTime: &lastUpdateTime,
+			Volume:         priceTimestamp.Volume,
+		})
+	}
+	sort.Slice(exchangePrices, func(i, j int) bool {
+		return exchangePrices[i].ExchangeId < exchangePrices[j].ExchangeId
+	})
+	return exchangePrices
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -115,7 +116,28 @@ func TestGetValidPrices(t *testing.T) {
 
 	r := etp.GetValidPrices(constants.TimeT)
 	require.Len(t, r, 1)
-	require.Equal(t, constants.Price1, r[0])
+	require.Equal(t, types.PriceVolume{Price: constants.Price1, ExchangeId: constants.ExchangeId1}, r[0])
+}
+
+func TestGetValidPrices_WithVolume(t *testing.T) {
+	etp := NewExchangeToPrice(0)
+
+	etp.UpdatePrices(
+		[]*api.ExchangePrice{
+			{
+				ExchangeId:     constants.ExchangeId1,
+				Price:          constants.Price1,
+				LastUpdateTime: &constants.TimeT,
+				Volume:         100,
+			},
+		})
+
+	r := etp.GetValidPrices(constants.TimeT)
+	require.Equal(
+		t,
+		[]types.PriceVolume{{Price: constants.Price1, Volume: 100, ExchangeId: constants.ExchangeId1}},
+		r,
+	)
 }
 
 func TestGetValidPrices_Empty(t *testing.T) {
@@ -152,6 +174,29 @@ func TestGetValidPrices_ValidAndOldPrices(t *testing.T) {
 	r := etp.GetValidPrices(constants.TimeTPlus1)
 	require.Len(t, r, 2)
 
-	expected := []uint64{constants.Price3, constants.Price4}
+	expected := []types.PriceVolume{
+		{Price: constants.Price3, ExchangeId: constants.ExchangeId2},
+		{Price: constants.Price4, ExchangeId: constants.ExchangeId3},
+	}
 	assert.ElementsMatch(t, expected, r)
 }
+
+func TestGetAllPrices(t *testing.T) {
+	etp := NewExchangeToPrice(0)
+
+	etp.UpdatePrices(
+		[]*api.ExchangePrice{
+			constants.Exchange2_Price2_TimeT,
+			constants.Exchange1_Price3_BeforeTimeT,
+		})
+
+	// Prices are returned regardless of age, sorted by exchange id.
+	require.Equal(
+		t,
+		[]*api.ExchangePrice{
+			constants.Exchange1_Price3_BeforeTimeT,
+			constants.Exchange2_Price2_TimeT,
+		},
+		etp.GetAllPrices(),
+	)
+}
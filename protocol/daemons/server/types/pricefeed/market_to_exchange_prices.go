@@ -1,6 +1,7 @@
 package types
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -8,7 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
-	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
@@ -16,10 +17,15 @@ import (
 // MarketToExchangePrices maintains price info for multiple markets. Each
 // market can support prices from multiple exchange sources. Specifically,
 // MarketToExchangePrices supports methods to update prices and to retrieve
-// median prices. Methods are goroutine safe.
+// index prices. Methods are goroutine safe.
 type MarketToExchangePrices struct {
 	sync.Mutex                                         // lock
 	marketToExchangePrices map[uint32]*ExchangeToPrice // {k: market id, v: exchange prices}
+	// marketToIndexPrice stores the most recent index price of each market, which is reported for introspection.
+	marketToIndexPrice map[uint32]*indexPrice
+	// marketToAggregationConfig caches the aggregation config parsed from the exchange config json of each
+	// market, so that the json is only parsed again when the market's exchange config json changes.
+	marketToAggregationConfig map[uint32]*aggregationConfig
 	// maxPriceAge is the maximum age of a price before it is considered too stale to be used.
 	// Prices older than this age will not be used to calculate the median price.
 	maxPriceAge time.Duration
@@ -28,11 +34,27 @@ type MarketToExchangePrices struct {
 // NewMarketToExchangePrices creates a new MarketToExchangePrices.
 func NewMarketToExchangePrices(maxPriceAge time.Duration) *MarketToExchangePrices {
 	return &MarketToExchangePrices{
-		marketToExchangePrices: make(map[uint32]*ExchangeToPrice),
-		maxPriceAge:            maxPriceAge,
+		marketToExchangePrices:    make(map[uint32]*ExchangeToPrice),
+		marketToIndexPrice:        make(map[uint32]*indexPrice),
+		marketToAggregationConfig: make(map[uint32]*aggregationConfig),
+		maxPriceAge:               maxPriceAge,
 	}
 }
 
+// aggregationConfig is the aggregation config of a market, along with the exchange config json it was parsed from.
+type aggregationConfig struct {
+	exchangeConfigJson string
+	config             pricefeedtypes.AggregationConfig
+}
+
+// indexPrice is an index price computed for a market, along with the exchanges whose prices were aggregated
+// into it and the read time it was computed for.
+type indexPrice struct {
+	price       uint64
+	exchangeIds []string
+	readTime    time.Time
+}
+
 // UpdatePrices updates market prices given a list of price updates. Prices are
 // only updated if the timestamp on the updates are greater than the timestamp
 // on existing prices.
@@ -51,14 +73,16 @@ func (mte *MarketToExchangePrices) UpdatePrices(
 	}
 }
 
-// GetValidMedianPrices returns median prices for multiple markets.
+// GetValidMedianPrices returns index prices for multiple markets.
 // Specifically, it returns a map where the key is the market ID and the value
-// is the median price for the market. It only returns "valid" prices where
-// a price is valid iff
+// is the index price for the market. Exchange prices are aggregated into the index
+// price according to the aggregation config in the market's exchange config json,
+// which defaults to the median. It only returns "valid" prices where a price is
+// valid iff
 // 1) the last update time is within a predefined threshold away from the given
 // read time.
-// 2) the number of prices that meet 1) are greater than the minimum number of
-// exchanges specified in the given input.
+// 2) the number of prices that meet 1) and are not rejected as outliers are greater
+// than the minimum number of exchanges specified in the given input.
 func (mte *MarketToExchangePrices) GetValidMedianPrices(
 	marketParams []types.MarketParam,
 	readTime time.Time,
@@ -103,8 +127,14 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 
 		// The number of valid prices must be >= min number of exchanges.
 		if len(validPrices) >= int(marketParam.MinExchanges) {
-			// Calculate the median. Returns an error if the input is empty.
-			median, err := lib.Median(validPrices)
+			// Calculate the index price. Returns an error if the input is empty.
+			// Outliers are rejected against the most recent index price of the market, if any.
+			var previousIndexPrice uint64
+			if previous, ok := mte.marketToIndexPrice[marketId]; ok {
+				previousIndexPrice = previous.price
+			}
+			aggregation := mte.getAggregationConfig(marketParam)
+			price, aggregatedPrices, err := aggregation.Aggregate(validPrices, previousIndexPrice)
 			if err != nil {
 				telemetry.IncrCounterWithLabels(
 					[]string{
@@ -119,9 +149,88 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 				)
 				continue
 			}
-			marketIdToMedianPrice[marketId] = median
+
+			// The number of prices remaining after outlier rejection must also be >= min number of exchanges.
+			if len(aggregatedPrices) < int(marketParam.MinExchanges) {
+				continue
+			}
+
+			exchangeIds := make([]string, 0, len(aggregatedPrices))
+			for _, aggregatedPrice := range aggregatedPrices {
+				exchangeIds = append(exchangeIds, aggregatedPrice.ExchangeId)
+			}
+			sort.Strings(exchangeIds)
+			mte.marketToIndexPrice[marketId] = &indexPrice{
+				price:       price,
+				exchangeIds: exchangeIds,
+				readTime:    readTime,
+			}
+			marketIdToMedianPrice[marketId] = price
 		}
 	}
 
 	return marketIdToMedianPrice
 }
+
+// getAggregationConfig returns the aggregation config of a market, parsing the market's exchange config json only
+// if it changed since the config was last parsed. Markets with an invalid aggregation config fall back to the
+// median. Such configs are rejected by `MarketParam.Validate`, so this only guards against unexpected configs.
+// The caller must hold the lock.
+func (mte *MarketToExchangePrices) getAggregationConfig(
+	marketParam types.MarketParam,
+) pricefeedtypes.AggregationConfig {
+	cached, ok := mte.marketToAggregationConfig[marketParam.Id]
+	if ok && cached.exchangeConfigJson == marketParam.ExchangeConfigJson {
+		return cached.config
+	}
+
+	config, err := pricefeedtypes.AggregationConfigFromExchangeConfigJson(marketParam.ExchangeConfigJson)
+	if err != nil {
+		config = pricefeedtypes.AggregationConfig{}
+	}
+	mte.marketToAggregationConfig[marketParam.Id] = &aggregationConfig{
+		exchangeConfigJson: marketParam.ExchangeConfigJson,
+		config:             config,
+	}
+	return config
+}
+
+// GetMarketPrices returns the most recent price reported by each exchange for the given markets, along with the
+// most recently computed index price of each market and the exchanges whose prices were aggregated into it. All
+// markets with exchange prices are returned if `marketIds` is empty. Markets are sorted by market id, and
+// markets without exchange prices are omitted.
+func (mte *MarketToExchangePrices) GetMarketPrices(marketIds []uint32) []*api.MarketPrices {
+	mte.Lock()
+	defer mte.Unlock()
+
+	if len(marketIds) == 0 {
+		marketIds = make([]uint32, 0, len(mte.marketToExchangePrices))
+		for marketId := range mte.marketToExchangePrices {
+			marketIds = append(marketIds, marketId)
+		}
+	}
+
+	marketPrices := make([]*api.MarketPrices, 0, len(marketIds))
+	for _, marketId := range marketIds {
+		exchangeToPrice, ok := mte.marketToExchangePrices[marketId]
+		if !ok {
+			continue
+		}
+
+		prices := &api.MarketPrices{
+			MarketId:       marketId,
+			ExchangePrices: exchangeToPrice.GetAllPrices(),
+		}
+		if index, ok := mte.marketToIndexPrice[marketId]; ok {
+			readTime := index.readTime
+			prices.IndexPrice = index.price
+			prices.IndexPriceExchangeIds = append([]string{}, index.exchangeIds...)
+			prices.IndexPriceTime = &readTime
+		}
+		marketPrices = append(marketPrices, prices)
+	}
+	sort.Slice(marketPrices, func(i, j int) bool {
+		return marketPrices[i].MarketId < marketPrices[j].MarketId
+	})
+	return marketPrices
+}
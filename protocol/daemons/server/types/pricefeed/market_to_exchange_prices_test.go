@@ -193,3 +193,134 @@ func TestGetValidMedianPrices_MultiMarketSuccess(t *testing.T) {
 	// Market7 only has 1 valid price due to update time constraint,
 	// but the min exchanges required is 2. Therefore, no median price.
 }
+
+func TestGetValidMedianPrices_Aggregation(t *testing.T) {
+	mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+	updatePrices := func(prices []uint64, volumes []uint64) {
+		exchangePrices := make([]*api.ExchangePrice, 0, len(prices))
+		for i, price := range prices {
+			exchangePrices = append(exchangePrices, &api.ExchangePrice{
+				ExchangeId:     []string{constants.ExchangeId1, constants.ExchangeId2, constants.ExchangeId3}[i],
+				Price:          price,
+				Volume:         volumes[i],
+				LastUpdateTime: &constants.TimeT,
+			})
+		}
+		mte.UpdatePrices([]*api.MarketPriceUpdate{{MarketId: constants.MarketId9, ExchangePrices: exchangePrices}})
+	}
+	marketParam := func(exchangeConfigJson string, minExchanges uint32) []types.MarketParam {
+		return []types.MarketParam{
+			{
+				Id:                 constants.MarketId9,
+				MinExchanges:       minExchanges,
+				ExchangeConfigJson: exchangeConfigJson,
+			},
+		}
+	}
+
+	updatePrices([]uint64{1_000, 1_010, 2_000}, []uint64{1, 1, 10})
+
+	// The median is used if no aggregation is configured.
+	r := mte.GetValidMedianPrices(marketParam(`{"exchanges":[]}`, 3), constants.TimeT)
+	require.Equal(t, map[uint32]uint64{constants.MarketId9: 1_010}, r)
+
+	// The volume-weighted median is dominated by the high-volume exchange.
+	r = mte.GetValidMedianPrices(
+		marketParam(`{"aggregation":{"method":"volumeWeightedMedian"}}`, 3),
+		constants.TimeT,
+	)
+	require.Equal(t, map[uint32]uint64{constants.MarketId9: 2_000}, r)
+
+	// Prices deviating more than 5% from the median of all prices are rejected.
+	r = mte.GetValidMedianPrices(marketParam(`{"aggregation":{"maxDeviationPpm":50000}}`, 2), constants.TimeT)
+	require.Equal(t, map[uint32]uint64{constants.MarketId9: 1_005}, r)
+
+	// No price is returned if too few prices remain after outlier rejection.
+	r = mte.GetValidMedianPrices(marketParam(`{"aggregation":{"maxDeviationPpm":50000}}`, 3), constants.TimeT)
+	require.Empty(t, r)
+
+	// Trimmed mean removes the highest and lowest price.
+	r = mte.GetValidMedianPrices(
+		marketParam(`{"aggregation":{"method":"trimmedMean","trimPpm":333334}}`, 3),
+		constants.TimeT,
+	)
+	require.Equal(t, map[uint32]uint64{constants.MarketId9: 1_010}, r)
+
+	// Invalid aggregation configs fall back to the median.
+	r = mte.GetValidMedianPrices(marketParam(`{"aggregation":{"method":"mode"}}`, 3), constants.TimeT)
+	require.Equal(t, map[uint32]uint64{constants.MarketId9: 1_010}, r)
+}
+
+func TestGetMarketPrices(t *testing.T) {
+	mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+	require.Empty(t, mte.GetMarketPrices(nil))
+
+	mte.UpdatePrices(
+		[]*api.MarketPriceUpdate{
+			{
+				MarketId: constants.MarketId9,
+				ExchangePrices: []*api.ExchangePrice{
+					constants.Exchange3_Price3_TimeT,
+					constants.Exchange1_Price1_TimeT,
+					constants.Exchange2_Price1_BeforeTimeT,
+				},
+			},
+			{
+				MarketId: constants.MarketId8,
+				ExchangePrices: []*api.ExchangePrice{
+					constants.Exchange1_Price1_TimeT,
+				},
+			},
+		})
+
+	market8Prices := &api.MarketPrices{
+		MarketId: constants.MarketId8,
+		ExchangePrices: []*api.ExchangePrice{
+			constants.Exchange1_Price1_TimeT,
+		},
+	}
+
+	// No index price has been computed yet.
+	require.Equal(
+		t,
+		[]*api.MarketPrices{
+			market8Prices,
+			{
+				MarketId: constants.MarketId9,
+				ExchangePrices: []*api.ExchangePrice{
+					constants.Exchange1_Price1_TimeT,
+					constants.Exchange2_Price1_BeforeTimeT,
+					constants.Exchange3_Price3_TimeT,
+				},
+			},
+		},
+		mte.GetMarketPrices(nil),
+	)
+
+	// The index price records the exchanges whose prices were valid at the read time. Markets are sorted by id,
+	// and markets without prices are omitted.
+	readTime := constants.TimeT.Add(time.Second)
+	r := mte.GetValidMedianPrices(
+		[]types.MarketParam{{Id: constants.MarketId9, MinExchanges: 2}},
+		readTime,
+	)
+	require.Equal(t, map[uint32]uint64{constants.MarketId9: 2002}, r) // Median of 1001, 3003
+	require.Equal(
+		t,
+		[]*api.MarketPrices{
+			market8Prices,
+			{
+				MarketId: constants.MarketId9,
+				ExchangePrices: []*api.ExchangePrice{
+					constants.Exchange1_Price1_TimeT,
+					constants.Exchange2_Price1_BeforeTimeT,
+					constants.Exchange3_Price3_TimeT,
+				},
+				IndexPrice:            2002,
+				IndexPriceExchangeIds: []string{constants.ExchangeId1, constants.ExchangeId3},
+				IndexPriceTime:        &readTime,
+			},
+		},
+		mte.GetMarketPrices([]uint32{constants.MarketId9, constants.MarketId8, constants.MarketId7}),
+	)
+}
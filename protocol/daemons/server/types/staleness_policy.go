@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// StalenessPolicy determines how the protocol reacts when a daemon service fails to respond within its maximum
+// acceptable update delay.
+type StalenessPolicy string
+
+const (
+	// StalenessPolicyHalt halts the protocol when the daemon service is not responding.
+	StalenessPolicyHalt StalenessPolicy = "halt"
+	// StalenessPolicyLog logs an error when the daemon service is not responding and otherwise continues as normal.
+	StalenessPolicyLog StalenessPolicy = "log"
+	// StalenessPolicyDegrade logs an error and places the node in degraded mode for the daemon service while it is
+	// not responding. In degraded mode the node stops proposing the data provided by the daemon, but continues to
+	// vote on blocks proposed by other validators.
+	StalenessPolicyDegrade StalenessPolicy = "degrade"
+)
+
+// Validate returns an error if the staleness policy is not one of the supported policies.
+func (p StalenessPolicy) Validate() error {
+	switch p {
+	case StalenessPolicyHalt, StalenessPolicyLog, StalenessPolicyDegrade:
+		return nil
+	default:
+		return fmt.Errorf(
+			"invalid staleness policy %q, must be one of %q, %q or %q",
+			string(p),
+			StalenessPolicyHalt,
+			StalenessPolicyLog,
+			StalenessPolicyDegrade,
+		)
+	}
+}
+
+// LogDegradedServiceNotResponding returns a function that logs an error indicating that the specified daemon service
+// is not responding and that the node has entered degraded mode for the service.
+func LogDegradedServiceNotResponding(service string, logger log.Logger) func() {
+	return func() {
+		logger.Error(
+			"daemon not responding, entering degraded mode",
+			"service",
+			service,
+		)
+	}
+}
+
+// callbackForStalenessPolicy returns the callback the update monitor executes when a daemon service registered with
+// the given staleness policy is not responding.
+func callbackForStalenessPolicy(service string, policy StalenessPolicy, logger log.Logger) func() {
+	switch policy {
+	case StalenessPolicyHalt:
+		return PanicServiceNotResponding(service)
+	case StalenessPolicyDegrade:
+		return LogDegradedServiceNotResponding(service, logger)
+	default:
+		return LogErrorServiceNotResponding(service, logger)
+	}
+}
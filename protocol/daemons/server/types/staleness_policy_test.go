@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStalenessPolicy_Validate(t *testing.T) {
+	tests := map[string]struct {
+		policy      types.StalenessPolicy
+		expectedErr string
+	}{
+		"halt": {
+			policy: types.StalenessPolicyHalt,
+		},
+		"log": {
+			policy: types.StalenessPolicyLog,
+		},
+		"degrade": {
+			policy: types.StalenessPolicyDegrade,
+		},
+		"empty": {
+			policy:      "",
+			expectedErr: `invalid staleness policy ""`,
+		},
+		"unknown": {
+			policy:      "continue",
+			expectedErr: `invalid staleness policy "continue"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestLogDegradedServiceNotResponding(t *testing.T) {
+	logger := &mocks.Logger{}
+	logger.On("Error", "daemon not responding, entering degraded mode", "service", "test-service").Return()
+	logFunc := types.LogDegradedServiceNotResponding("test-service", logger)
+	logFunc()
+
+	// Assert: the logger was called with the expected arguments.
+	mock.AssertExpectationsForObjects(t, logger)
+}
@@ -2,7 +2,11 @@ package types
 
 import (
 	"fmt"
+	gometrics "github.com/armon/go-metrics"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"sort"
 	"sync"
 	"time"
 )
@@ -10,15 +14,42 @@ import (
 type updateMetadata struct {
 	timer           *time.Timer
 	updateFrequency time.Duration
+	// policy is the staleness policy the service was registered with. It is empty for services registered with a
+	// custom callback.
+	policy StalenessPolicy
+	// lastUpdateTime is the time of the most recent valid response from the service. It is zero if the service has
+	// not yet responded.
+	lastUpdateTime time.Time
+	// stale indicates whether the service failed to respond within its maximum acceptable update delay and has not
+	// responded since.
+	stale bool
+}
+
+// DaemonHealth describes the health of a daemon service registered with the update monitor.
+type DaemonHealth struct {
+	// Service is the name of the daemon service.
+	Service string
+	// StalenessPolicy is the policy applied when the service is not responding.
+	StalenessPolicy StalenessPolicy
+	// Stale indicates whether the service is currently not responding.
+	Stale bool
+	// Degraded indicates whether the node is in degraded mode for the service.
+	Degraded bool
+	// LastUpdateTime is the time of the most recent valid response from the service, or zero if the service has
+	// not yet responded.
+	LastUpdateTime time.Time
+	// MaximumAcceptableUpdateDelay is the maximum delay between valid responses before the service is stale.
+	MaximumAcceptableUpdateDelay time.Duration
 }
 
 // UpdateMonitor monitors the update frequency of daemon services. If a daemon service does not respond within
-// the maximum acceptable update delay set when the daemon is registered, the monitor will log an error and halt the
-// protocol. This was judged to be the best solution for network performance because it prevents any validator from
-// participating in the network at all if a daemon service is not responding.
+// the maximum acceptable update delay set when the daemon is registered, the monitor marks the service as stale and
+// applies the staleness policy the service was registered with: the protocol is either halted, an error is logged,
+// or the node enters degraded mode for the service, in which it stops proposing the data provided by the daemon but
+// continues to vote. A stale service recovers as soon as it sends a valid response.
 type UpdateMonitor struct {
 	// serviceToUpdateMetadata maps daemon service names to their update metadata.
-	serviceToUpdateMetadata map[string]updateMetadata
+	serviceToUpdateMetadata map[string]*updateMetadata
 	// stopped indicates whether the monitor has been stopped. Additional daemon services cannot be registered
 	// after the monitor has been stopped.
 	stopped bool
@@ -36,7 +67,7 @@ type UpdateMonitor struct {
 // NewUpdateFrequencyMonitor creates a new update frequency monitor.
 func NewUpdateFrequencyMonitor(daemonStartupGracePeriod time.Duration, logger log.Logger) *UpdateMonitor {
 	return &UpdateMonitor{
-		serviceToUpdateMetadata:  make(map[string]updateMetadata),
+		serviceToUpdateMetadata:  make(map[string]*updateMetadata),
 		logger:                   logger,
 		daemonStartupGracePeriod: daemonStartupGracePeriod,
 	}
@@ -57,6 +88,37 @@ func (ufm *UpdateMonitor) RegisterDaemonServiceWithCallback(
 	service string,
 	maximumAcceptableUpdateDelay time.Duration,
 	callback func(),
+) error {
+	return ufm.registerDaemonService(service, maximumAcceptableUpdateDelay, "", callback)
+}
+
+// RegisterDaemonServiceWithPolicy registers a new daemon service with the update frequency monitor. If the daemon
+// service fails to respond within the maximum acceptable update delay, the monitor will apply the staleness policy.
+// This method is synchronized. The method returns an error if the staleness policy is invalid, the daemon service
+// was already registered or the monitor has already been stopped.
+func (ufm *UpdateMonitor) RegisterDaemonServiceWithPolicy(
+	service string,
+	maximumAcceptableUpdateDelay time.Duration,
+	policy StalenessPolicy,
+) error {
+	if err := policy.Validate(); err != nil {
+		return fmt.Errorf("registration failure for service %v: %w", service, err)
+	}
+	return ufm.registerDaemonService(
+		service,
+		maximumAcceptableUpdateDelay,
+		policy,
+		callbackForStalenessPolicy(service, policy, ufm.logger),
+	)
+}
+
+// registerDaemonService registers a new daemon service with the update frequency monitor. When the service fails to
+// respond within the maximum acceptable update delay, it is marked stale before the callback is executed.
+func (ufm *UpdateMonitor) registerDaemonService(
+	service string,
+	maximumAcceptableUpdateDelay time.Duration,
+	policy StalenessPolicy,
+	callback func(),
 ) error {
 	ufm.lock.Lock()
 	defer ufm.lock.Unlock()
@@ -85,13 +147,42 @@ func (ufm *UpdateMonitor) RegisterDaemonServiceWithCallback(
 		return fmt.Errorf("service %v already registered", service)
 	}
 
-	ufm.serviceToUpdateMetadata[service] = updateMetadata{
-		timer:           time.AfterFunc(ufm.daemonStartupGracePeriod+maximumAcceptableUpdateDelay, callback),
+	ufm.serviceToUpdateMetadata[service] = &updateMetadata{
+		timer: time.AfterFunc(
+			ufm.daemonStartupGracePeriod+maximumAcceptableUpdateDelay,
+			func() {
+				ufm.markStale(service)
+				callback()
+			},
+		),
 		updateFrequency: maximumAcceptableUpdateDelay,
+		policy:          policy,
 	}
 	return nil
 }
 
+// markStale marks the daemon service as stale. This method is synchronized.
+func (ufm *UpdateMonitor) markStale(service string) {
+	ufm.lock.Lock()
+	defer ufm.lock.Unlock()
+
+	metadata, ok := ufm.serviceToUpdateMetadata[service]
+	if !ok {
+		return
+	}
+	metadata.stale = true
+
+	telemetry.IncrCounterWithLabels(
+		[]string{
+			metrics.DaemonServer,
+			metrics.MissingResponse,
+		},
+		1,
+		getDaemonLabels(service, metadata.policy),
+	)
+	emitDaemonHealthMetrics(service, metadata)
+}
+
 // PanicServiceNotResponding returns a function that panics with a message indicating that the specified daemon
 // service is not responding. This is ideal for creating a callback function when registering a daemon service.
 func PanicServiceNotResponding(service string) func() {
@@ -112,19 +203,15 @@ func LogErrorServiceNotResponding(service string, logger log.Logger) func() {
 	}
 }
 
-// RegisterDaemonService registers a new daemon service with the update frequency monitor. If the daemon service
-// fails to respond within the maximum acceptable update delay, the monitor will log an error.
-// This method is synchronized. The method an error if the daemon service was already registered or the monitor has
-// already been stopped.
+// RegisterDaemonService registers a new daemon service with the update frequency monitor using the log staleness
+// policy. If the daemon service fails to respond within the maximum acceptable update delay, the monitor will log an
+// error. This method is synchronized. The method an error if the daemon service was already registered or the monitor
+// has already been stopped.
 func (ufm *UpdateMonitor) RegisterDaemonService(
 	service string,
 	maximumAcceptableUpdateDelay time.Duration,
 ) error {
-	return ufm.RegisterDaemonServiceWithCallback(
-		service,
-		maximumAcceptableUpdateDelay,
-		LogErrorServiceNotResponding(service, ufm.logger),
-	)
+	return ufm.RegisterDaemonServiceWithPolicy(service, maximumAcceptableUpdateDelay, StalenessPolicyLog)
 }
 
 // Stop stops the update frequency monitor. This method is synchronized.
@@ -165,5 +252,92 @@ func (ufm *UpdateMonitor) RegisterValidResponse(service string) error {
 	}
 
 	metadata.timer.Reset(metadata.updateFrequency)
+	metadata.lastUpdateTime = time.Now()
+	if metadata.stale {
+		metadata.stale = false
+		ufm.logger.Info("daemon recovered", "service", service)
+		emitDaemonHealthMetrics(service, metadata)
+	}
 	return nil
 }
+
+// IsDegraded returns true if the node is in degraded mode for the daemon service, i.e. the service was registered
+// with the degrade staleness policy and is currently not responding. This method is synchronized.
+func (ufm *UpdateMonitor) IsDegraded(service string) bool {
+	ufm.lock.Lock()
+	defer ufm.lock.Unlock()
+
+	if ufm.disabled {
+		return false
+	}
+
+	metadata, ok := ufm.serviceToUpdateMetadata[service]
+	if !ok {
+		return false
+	}
+	return metadata.isDegraded()
+}
+
+// GetDaemonHealth returns the health of all registered daemon services, sorted by service name. This method is
+// synchronized.
+func (ufm *UpdateMonitor) GetDaemonHealth() []DaemonHealth {
+	ufm.lock.Lock()
+	defer ufm.lock.Unlock()
+
+	health := make([]DaemonHealth, 0, len(ufm.serviceToUpdateMetadata))
+	for service, metadata := range ufm.serviceToUpdateMetadata {
+		health = append(health, DaemonHealth{
+			Service:                      service,
+			StalenessPolicy:              metadata.policy,
+			Stale:                        metadata.stale,
+			Degraded:                     metadata.isDegraded(),
+			LastUpdateTime:               metadata.lastUpdateTime,
+			MaximumAcceptableUpdateDelay: metadata.updateFrequency,
+		})
+	}
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].Service < health[j].Service
+	})
+	return health
+}
+
+// isDegraded returns true if the service is stale and was registered with the degrade staleness policy.
+func (metadata *updateMetadata) isDegraded() bool {
+	return metadata.stale && metadata.policy == StalenessPolicyDegrade
+}
+
+// emitDaemonHealthMetrics emits gauges indicating whether the daemon service is stale and whether the node is in
+// degraded mode for the service.
+func emitDaemonHealthMetrics(service string, metadata *updateMetadata) {
+	labels := getDaemonLabels(service, metadata.policy)
+	telemetry.SetGaugeWithLabels(
+		[]string{
+			metrics.DaemonServer,
+			metrics.Stale,
+		},
+		boolToGaugeValue(metadata.stale),
+		labels,
+	)
+	telemetry.SetGaugeWithLabels(
+		[]string{
+			metrics.DaemonServer,
+			metrics.Degraded,
+		},
+		boolToGaugeValue(metadata.isDegraded()),
+		labels,
+	)
+}
+
+func getDaemonLabels(service string, policy StalenessPolicy) []gometrics.Label {
+	return []gometrics.Label{
+		metrics.GetLabelForStringValue(metrics.Daemon, service),
+		metrics.GetLabelForStringValue(metrics.StalenessPolicy, string(policy)),
+	}
+}
+
+func boolToGaugeValue(b bool) float32 {
+	if b {
+		return 1
+	}
+	return 0
+}
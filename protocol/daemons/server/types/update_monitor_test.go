@@ -187,3 +187,90 @@ func TestLogErrorServiceNotResponding(t *testing.T) {
 	// Assert: the logger was called with the expected arguments.
 	mock.AssertExpectationsForObjects(t, logger)
 }
+
+func TestRegisterDaemonServiceWithPolicy_InvalidPolicy(t *testing.T) {
+	ufm, logger := createTestMonitor()
+	err := ufm.RegisterDaemonServiceWithPolicy("test-service", 50*time.Millisecond, "invalid")
+	require.ErrorContains(t, err, `invalid staleness policy "invalid"`)
+
+	// Sanity check: no calls to the logger should have been made.
+	mock.AssertExpectationsForObjects(t, logger)
+}
+
+func TestRegisterDaemonServiceWithPolicy_DegradeAndRecover(t *testing.T) {
+	ufm, logger := createTestMonitor()
+	logger.On("Error", "daemon not responding, entering degraded mode", "service", "test-service").Once().Return()
+	logger.On("Info", "daemon recovered", "service", "test-service").Once().Return()
+	err := ufm.RegisterDaemonServiceWithPolicy("test-service", 50*time.Millisecond, types.StalenessPolicyDegrade)
+	require.NoError(t, err)
+	require.False(t, ufm.IsDegraded("test-service"))
+
+	// The service becomes stale and the node enters degraded mode.
+	time.Sleep(75 * time.Millisecond)
+	require.True(t, ufm.IsDegraded("test-service"))
+	health := ufm.GetDaemonHealth()
+	require.Len(t, health, 1)
+	require.True(t, health[0].Stale)
+	require.True(t, health[0].Degraded)
+	require.True(t, health[0].LastUpdateTime.IsZero())
+
+	// A valid response recovers the service.
+	require.NoError(t, ufm.RegisterValidResponse("test-service"))
+	require.False(t, ufm.IsDegraded("test-service"))
+	health = ufm.GetDaemonHealth()
+	require.False(t, health[0].Stale)
+	require.False(t, health[0].Degraded)
+	require.False(t, health[0].LastUpdateTime.IsZero())
+
+	ufm.Stop()
+	mock.AssertExpectationsForObjects(t, logger)
+}
+
+func TestIsDegraded_LogPolicyIsNeverDegraded(t *testing.T) {
+	ufm, logger := createTestMonitor()
+	logger.On("Error", "daemon not responding", "service", "test-service").Once().Return()
+	err := ufm.RegisterDaemonServiceWithPolicy("test-service", 1*time.Millisecond, types.StalenessPolicyLog)
+	require.NoError(t, err)
+	time.Sleep(25 * time.Millisecond)
+
+	// The service is stale, but the log policy never places the node in degraded mode.
+	require.False(t, ufm.IsDegraded("test-service"))
+	health := ufm.GetDaemonHealth()
+	require.Len(t, health, 1)
+	require.True(t, health[0].Stale)
+	require.False(t, health[0].Degraded)
+
+	ufm.Stop()
+	mock.AssertExpectationsForObjects(t, logger)
+}
+
+func TestIsDegraded_UnregisteredService(t *testing.T) {
+	ufm, _ := createTestMonitor()
+	require.False(t, ufm.IsDegraded("test-service"))
+}
+
+func TestGetDaemonHealth(t *testing.T) {
+	ufm, _ := createTestMonitor()
+	require.Empty(t, ufm.GetDaemonHealth())
+
+	require.NoError(t, ufm.RegisterDaemonServiceWithPolicy("b-service", 1*time.Minute, types.StalenessPolicyHalt))
+	require.NoError(t, ufm.RegisterDaemonServiceWithPolicy("a-service", 2*time.Minute, types.StalenessPolicyDegrade))
+
+	require.Equal(
+		t,
+		[]types.DaemonHealth{
+			{
+				Service:                      "a-service",
+				StalenessPolicy:              types.StalenessPolicyDegrade,
+				MaximumAcceptableUpdateDelay: 2 * time.Minute,
+			},
+			{
+				Service:                      "b-service",
+				StalenessPolicy:              types.StalenessPolicyHalt,
+				MaximumAcceptableUpdateDelay: 1 * time.Minute,
+			},
+		},
+		ufm.GetDaemonHealth(),
+	)
+	ufm.Stop()
+}
@@ -16,6 +16,9 @@ const (
 	SubtypeLiquidityTier    = "liquidity_tier"
 	SubtypeUpdatePerpetual  = "update_perpetual"
 	SubtypeUpdateClobPair   = "update_clob_pair"
+	SubtypeSpotMarket       = "spot_market"
+	SubtypeDeleveraging     = "deleveraging"
+	SubtypeSpotOrderFill    = "spot_order_fill"
 )
 
 const (
@@ -31,6 +34,9 @@ const (
 	LiquidityTierEventVersion    uint32 = 1
 	UpdatePerpetualEventVersion  uint32 = 1
 	UpdateClobPairEventVersion   uint32 = 1
+	SpotMarketEventVersion       uint32 = 1
+	DeleveragingEventVersion     uint32 = 1
+	SpotOrderFillEventVersion    uint32 = 1
 )
 
 var OnChainEventSubtypes = []string{
@@ -44,4 +50,7 @@ var OnChainEventSubtypes = []string{
 	SubtypePerpetualMarket,
 	SubtypeLiquidityTier,
 	SubtypeUpdatePerpetual,
+	SubtypeSpotMarket,
+	SubtypeDeleveraging,
+	SubtypeSpotOrderFill,
 }
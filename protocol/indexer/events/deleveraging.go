@@ -0,0 +1,33 @@
+package events
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// NewDeleveragingEvent creates a DeleveragingEvent representing a deleveraging fill where the
+// position of the liquidated subaccount is offset by the position of the offsetting subaccount at
+// the bankruptcy price of the liquidated position. The bankruptcy price and total quote quantums
+// are signed, since the bankruptcy price of a position may be negative, and are not bounded to an int64.
+func NewDeleveragingEvent(
+	liquidatedSubaccountId satypes.SubaccountId,
+	offsettingSubaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	fillAmount satypes.BaseQuantums,
+	subticks *big.Int,
+	totalQuoteQuantums *big.Int,
+	isBuy bool,
+) *DeleveragingEventV1 {
+	return &DeleveragingEventV1{
+		Liquidated:         v1.SubaccountIdToIndexerSubaccountId(liquidatedSubaccountId),
+		Offsetting:         v1.SubaccountIdToIndexerSubaccountId(offsettingSubaccountId),
+		PerpetualId:        perpetualId,
+		FillAmount:         fillAmount.ToUint64(),
+		Subticks:           dtypes.NewIntFromBigInt(subticks),
+		TotalQuoteQuantums: dtypes.NewIntFromBigInt(totalQuoteQuantums),
+		IsBuy:              isBuy,
+	}
+}
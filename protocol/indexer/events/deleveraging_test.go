@@ -0,0 +1,83 @@
+package events_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeleveragingEvent_Success(t *testing.T) {
+	deleveragingEvent := events.NewDeleveragingEvent(
+		constants.Carl_Num0,
+		constants.Dave_Num0,
+		0,
+		satypes.BaseQuantums(100_000_000),
+		big.NewInt(5_049_900_000),
+		big.NewInt(50_499_000_000),
+		true,
+	)
+	expectedDeleveragingEventProto := &events.DeleveragingEventV1{
+		Liquidated:         v1.SubaccountIdToIndexerSubaccountId(constants.Carl_Num0),
+		Offsetting:         v1.SubaccountIdToIndexerSubaccountId(constants.Dave_Num0),
+		PerpetualId:        0,
+		FillAmount:         100_000_000,
+		Subticks:           dtypes.NewInt(5_049_900_000),
+		TotalQuoteQuantums: dtypes.NewInt(50_499_000_000),
+		IsBuy:              true,
+	}
+	require.Equal(t, expectedDeleveragingEventProto, deleveragingEvent)
+}
+
+func TestNewDeleveragingEvent_NegativeBankruptcyPrice(t *testing.T) {
+	deleveragingEvent := events.NewDeleveragingEvent(
+		constants.Carl_Num0,
+		constants.Dave_Num0,
+		0,
+		satypes.BaseQuantums(100_000_000),
+		big.NewInt(-5_049_900_000),
+		big.NewInt(-50_499_000_000),
+		false,
+	)
+	expectedDeleveragingEventProto := &events.DeleveragingEventV1{
+		Liquidated:         v1.SubaccountIdToIndexerSubaccountId(constants.Carl_Num0),
+		Offsetting:         v1.SubaccountIdToIndexerSubaccountId(constants.Dave_Num0),
+		PerpetualId:        0,
+		FillAmount:         100_000_000,
+		Subticks:           dtypes.NewInt(-5_049_900_000),
+		TotalQuoteQuantums: dtypes.NewInt(-50_499_000_000),
+		IsBuy:              false,
+	}
+	require.Equal(t, expectedDeleveragingEventProto, deleveragingEvent)
+}
+
+func TestNewDeleveragingEvent_BankruptcyPriceOverflowsInt64(t *testing.T) {
+	subticks := new(big.Int).Lsh(big.NewInt(1), 70)
+	totalQuoteQuantums := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 80))
+	deleveragingEvent := events.NewDeleveragingEvent(
+		constants.Carl_Num0,
+		constants.Dave_Num0,
+		0,
+		satypes.BaseQuantums(100_000_000),
+		subticks,
+		totalQuoteQuantums,
+		false,
+	)
+	expectedDeleveragingEventProto := &events.DeleveragingEventV1{
+		Liquidated:         v1.SubaccountIdToIndexerSubaccountId(constants.Carl_Num0),
+		Offsetting:         v1.SubaccountIdToIndexerSubaccountId(constants.Dave_Num0),
+		PerpetualId:        0,
+		FillAmount:         100_000_000,
+		Subticks:           dtypes.NewIntFromBigInt(subticks),
+		TotalQuoteQuantums: dtypes.NewIntFromBigInt(totalQuoteQuantums),
+		IsBuy:              false,
+	}
+	require.Equal(t, expectedDeleveragingEventProto, deleveragingEvent)
+	require.Equal(t, subticks, deleveragingEvent.Subticks.BigInt())
+	require.Equal(t, totalQuoteQuantums, deleveragingEvent.TotalQuoteQuantums.BigInt())
+}
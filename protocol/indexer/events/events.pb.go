@@ -9,8 +9,8 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
-	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
-	shared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	types "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1/types"
+	types1 "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -77,6 +77,15 @@ type FundingUpdateV1 struct {
 	// funding index is required if and only if parent `FundingEvent` type is
 	// `TYPE_FUNDING_RATE_AND_INDEX`.
 	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
+	// The premium component (in parts-per-million) of the funding rate, which
+	// includes the default funding of the perpetual. Only set if parent
+	// `FundingEvent` type is `TYPE_FUNDING_RATE_AND_INDEX`.
+	PremiumPpm int32 `protobuf:"varint,4,opt,name=premium_ppm,json=premiumPpm,proto3" json:"premium_ppm,omitempty"`
+	// The interest rate component (in parts-per-million) of the funding rate.
+	// The funding rate is the sum of `premium_ppm` and `interest_ppm`, clamped
+	// to the bounds of the perpetual. Only set if parent `FundingEvent` type is
+	// `TYPE_FUNDING_RATE_AND_INDEX`.
+	InterestPpm int32 `protobuf:"varint,5,opt,name=interest_ppm,json=interestPpm,proto3" json:"interest_ppm,omitempty"`
 }
 
 func (m *FundingUpdateV1) Reset()         { *m = FundingUpdateV1{} }
@@ -126,6 +135,20 @@ func (m *FundingUpdateV1) GetFundingValuePpm() int32 {
 	return 0
 }
 
+func (m *FundingUpdateV1) GetPremiumPpm() int32 {
+	if m != nil {
+		return m.PremiumPpm
+	}
+	return 0
+}
+
+func (m *FundingUpdateV1) GetInterestPpm() int32 {
+	if m != nil {
+		return m.InterestPpm
+	}
+	return 0
+}
+
 // FundingEvent message contains a list of per-market funding values. The
 // funding values in the list is of the same type and the types are: which can
 // have one of the following types:
@@ -564,7 +587,7 @@ type isSourceOfFunds_Source interface {
 }
 
 type SourceOfFunds_SubaccountId struct {
-	SubaccountId *v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3,oneof" json:"subaccount_id,omitempty"`
+	SubaccountId *types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3,oneof" json:"subaccount_id,omitempty"`
 }
 type SourceOfFunds_Address struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof" json:"address,omitempty"`
@@ -580,7 +603,7 @@ func (m *SourceOfFunds) GetSource() isSourceOfFunds_Source {
 	return nil
 }
 
-func (m *SourceOfFunds) GetSubaccountId() *v1.IndexerSubaccountId {
+func (m *SourceOfFunds) GetSubaccountId() *types.IndexerSubaccountId {
 	if x, ok := m.GetSource().(*SourceOfFunds_SubaccountId); ok {
 		return x.SubaccountId
 	}
@@ -607,8 +630,8 @@ func (*SourceOfFunds) XXX_OneofWrappers() []interface{} {
 // When a subaccount is involved, a SubaccountUpdateEvent message will
 // be produced with the updated asset positions.
 type TransferEventV1 struct {
-	SenderSubaccountId    *v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=sender_subaccount_id,json=senderSubaccountId,proto3" json:"sender_subaccount_id,omitempty"`
-	RecipientSubaccountId *v1.IndexerSubaccountId `protobuf:"bytes,2,opt,name=recipient_subaccount_id,json=recipientSubaccountId,proto3" json:"recipient_subaccount_id,omitempty"`
+	SenderSubaccountId    *types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=sender_subaccount_id,json=senderSubaccountId,proto3" json:"sender_subaccount_id,omitempty"`
+	RecipientSubaccountId *types.IndexerSubaccountId `protobuf:"bytes,2,opt,name=recipient_subaccount_id,json=recipientSubaccountId,proto3" json:"recipient_subaccount_id,omitempty"`
 	// Id of the asset transfered.
 	AssetId uint32 `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 	// The amount of asset in quantums to transfer.
@@ -656,14 +679,14 @@ func (m *TransferEventV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferEventV1 proto.InternalMessageInfo
 
-func (m *TransferEventV1) GetSenderSubaccountId() *v1.IndexerSubaccountId {
+func (m *TransferEventV1) GetSenderSubaccountId() *types.IndexerSubaccountId {
 	if m != nil {
 		return m.SenderSubaccountId
 	}
 	return nil
 }
 
-func (m *TransferEventV1) GetRecipientSubaccountId() *v1.IndexerSubaccountId {
+func (m *TransferEventV1) GetRecipientSubaccountId() *types.IndexerSubaccountId {
 	if m != nil {
 		return m.RecipientSubaccountId
 	}
@@ -702,7 +725,7 @@ func (m *TransferEventV1) GetRecipient() *SourceOfFunds {
 // the V4 chain. This includes the maker/taker orders that matched and the
 // amount filled.
 type OrderFillEventV1 struct {
-	MakerOrder v1.IndexerOrder `protobuf:"bytes,1,opt,name=maker_order,json=makerOrder,proto3" json:"maker_order"`
+	MakerOrder types.IndexerOrder `protobuf:"bytes,1,opt,name=maker_order,json=makerOrder,proto3" json:"maker_order"`
 	// The type of order fill this event represents.
 	//
 	// Types that are valid to be assigned to TakerOrder:
@@ -763,7 +786,7 @@ type isOrderFillEventV1_TakerOrder interface {
 }
 
 type OrderFillEventV1_Order struct {
-	Order *v1.IndexerOrder `protobuf:"bytes,2,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	Order *types.IndexerOrder `protobuf:"bytes,2,opt,name=order,proto3,oneof" json:"order,omitempty"`
 }
 type OrderFillEventV1_LiquidationOrder struct {
 	LiquidationOrder *LiquidationOrderV1 `protobuf:"bytes,4,opt,name=liquidation_order,json=liquidationOrder,proto3,oneof" json:"liquidation_order,omitempty"`
@@ -779,14 +802,14 @@ func (m *OrderFillEventV1) GetTakerOrder() isOrderFillEventV1_TakerOrder {
 	return nil
 }
 
-func (m *OrderFillEventV1) GetMakerOrder() v1.IndexerOrder {
+func (m *OrderFillEventV1) GetMakerOrder() types.IndexerOrder {
 	if m != nil {
 		return m.MakerOrder
 	}
-	return v1.IndexerOrder{}
+	return types.IndexerOrder{}
 }
 
-func (m *OrderFillEventV1) GetOrder() *v1.IndexerOrder {
+func (m *OrderFillEventV1) GetOrder() *types.IndexerOrder {
 	if x, ok := m.GetTakerOrder().(*OrderFillEventV1_Order); ok {
 		return x.Order
 	}
@@ -847,7 +870,7 @@ func (*OrderFillEventV1) XXX_OneofWrappers() []interface{} {
 // liquidation order fill event.
 type LiquidationOrderV1 struct {
 	// ID of the subaccount that was liquidated.
-	Liquidated v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
+	Liquidated types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
 	// The ID of the clob pair involved in the liquidation.
 	ClobPairId uint32 `protobuf:"varint,2,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
 	// The ID of the perpetual involved in the liquidation.
@@ -898,11 +921,11 @@ func (m *LiquidationOrderV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidationOrderV1 proto.InternalMessageInfo
 
-func (m *LiquidationOrderV1) GetLiquidated() v1.IndexerSubaccountId {
+func (m *LiquidationOrderV1) GetLiquidated() types.IndexerSubaccountId {
 	if m != nil {
 		return m.Liquidated
 	}
-	return v1.IndexerSubaccountId{}
+	return types.IndexerSubaccountId{}
 }
 
 func (m *LiquidationOrderV1) GetClobPairId() uint32 {
@@ -947,11 +970,11 @@ func (m *LiquidationOrderV1) GetSubticks() uint64 {
 // at the end of a block which is why multiple asset/perpetual position
 // updates may exist.
 type SubaccountUpdateEventV1 struct {
-	SubaccountId *v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	SubaccountId *types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 	// updated_perpetual_positions will each be for unique perpetuals.
-	UpdatedPerpetualPositions []*v1.IndexerPerpetualPosition `protobuf:"bytes,3,rep,name=updated_perpetual_positions,json=updatedPerpetualPositions,proto3" json:"updated_perpetual_positions,omitempty"`
+	UpdatedPerpetualPositions []*types.IndexerPerpetualPosition `protobuf:"bytes,3,rep,name=updated_perpetual_positions,json=updatedPerpetualPositions,proto3" json:"updated_perpetual_positions,omitempty"`
 	// updated_asset_positions will each be for unique assets.
-	UpdatedAssetPositions []*v1.IndexerAssetPosition `protobuf:"bytes,4,rep,name=updated_asset_positions,json=updatedAssetPositions,proto3" json:"updated_asset_positions,omitempty"`
+	UpdatedAssetPositions []*types.IndexerAssetPosition `protobuf:"bytes,4,rep,name=updated_asset_positions,json=updatedAssetPositions,proto3" json:"updated_asset_positions,omitempty"`
 }
 
 func (m *SubaccountUpdateEventV1) Reset()         { *m = SubaccountUpdateEventV1{} }
@@ -987,21 +1010,21 @@ func (m *SubaccountUpdateEventV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SubaccountUpdateEventV1 proto.InternalMessageInfo
 
-func (m *SubaccountUpdateEventV1) GetSubaccountId() *v1.IndexerSubaccountId {
+func (m *SubaccountUpdateEventV1) GetSubaccountId() *types.IndexerSubaccountId {
 	if m != nil {
 		return m.SubaccountId
 	}
 	return nil
 }
 
-func (m *SubaccountUpdateEventV1) GetUpdatedPerpetualPositions() []*v1.IndexerPerpetualPosition {
+func (m *SubaccountUpdateEventV1) GetUpdatedPerpetualPositions() []*types.IndexerPerpetualPosition {
 	if m != nil {
 		return m.UpdatedPerpetualPositions
 	}
 	return nil
 }
 
-func (m *SubaccountUpdateEventV1) GetUpdatedAssetPositions() []*v1.IndexerAssetPosition {
+func (m *SubaccountUpdateEventV1) GetUpdatedAssetPositions() []*types.IndexerAssetPosition {
 	if m != nil {
 		return m.UpdatedAssetPositions
 	}
@@ -1022,6 +1045,8 @@ type StatefulOrderEventV1 struct {
 	//	*StatefulOrderEventV1_ConditionalOrderPlacement
 	//	*StatefulOrderEventV1_ConditionalOrderTriggered
 	//	*StatefulOrderEventV1_LongTermOrderPlacement
+	//	*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate
+	//	*StatefulOrderEventV1_LongTermOrderReplacement
 	Event isStatefulOrderEventV1_Event `protobuf_oneof:"event"`
 }
 
@@ -1079,12 +1104,20 @@ type StatefulOrderEventV1_ConditionalOrderTriggered struct {
 type StatefulOrderEventV1_LongTermOrderPlacement struct {
 	LongTermOrderPlacement *StatefulOrderEventV1_LongTermOrderPlacementV1 `protobuf:"bytes,7,opt,name=long_term_order_placement,json=longTermOrderPlacement,proto3,oneof" json:"long_term_order_placement,omitempty"`
 }
+type StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate struct {
+	ConditionalOrderTriggerSubticksUpdate *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 `protobuf:"bytes,8,opt,name=conditional_order_trigger_subticks_update,json=conditionalOrderTriggerSubticksUpdate,proto3,oneof" json:"conditional_order_trigger_subticks_update,omitempty"`
+}
+type StatefulOrderEventV1_LongTermOrderReplacement struct {
+	LongTermOrderReplacement *StatefulOrderEventV1_LongTermOrderReplacementV1 `protobuf:"bytes,9,opt,name=long_term_order_replacement,json=longTermOrderReplacement,proto3,oneof" json:"long_term_order_replacement,omitempty"`
+}
 
-func (*StatefulOrderEventV1_OrderPlace) isStatefulOrderEventV1_Event()                {}
-func (*StatefulOrderEventV1_OrderRemoval) isStatefulOrderEventV1_Event()              {}
-func (*StatefulOrderEventV1_ConditionalOrderPlacement) isStatefulOrderEventV1_Event() {}
-func (*StatefulOrderEventV1_ConditionalOrderTriggered) isStatefulOrderEventV1_Event() {}
-func (*StatefulOrderEventV1_LongTermOrderPlacement) isStatefulOrderEventV1_Event()    {}
+func (*StatefulOrderEventV1_OrderPlace) isStatefulOrderEventV1_Event()                            {}
+func (*StatefulOrderEventV1_OrderRemoval) isStatefulOrderEventV1_Event()                          {}
+func (*StatefulOrderEventV1_ConditionalOrderPlacement) isStatefulOrderEventV1_Event()             {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggered) isStatefulOrderEventV1_Event()             {}
+func (*StatefulOrderEventV1_LongTermOrderPlacement) isStatefulOrderEventV1_Event()                {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate) isStatefulOrderEventV1_Event() {}
+func (*StatefulOrderEventV1_LongTermOrderReplacement) isStatefulOrderEventV1_Event()              {}
 
 func (m *StatefulOrderEventV1) GetEvent() isStatefulOrderEventV1_Event {
 	if m != nil {
@@ -1128,6 +1161,20 @@ func (m *StatefulOrderEventV1) GetLongTermOrderPlacement() *StatefulOrderEventV1
 	return nil
 }
 
+func (m *StatefulOrderEventV1) GetConditionalOrderTriggerSubticksUpdate() *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 {
+	if x, ok := m.GetEvent().(*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate); ok {
+		return x.ConditionalOrderTriggerSubticksUpdate
+	}
+	return nil
+}
+
+func (m *StatefulOrderEventV1) GetLongTermOrderReplacement() *StatefulOrderEventV1_LongTermOrderReplacementV1 {
+	if x, ok := m.GetEvent().(*StatefulOrderEventV1_LongTermOrderReplacement); ok {
+		return x.LongTermOrderReplacement
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*StatefulOrderEventV1) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1136,12 +1183,14 @@ func (*StatefulOrderEventV1) XXX_OneofWrappers() []interface{} {
 		(*StatefulOrderEventV1_ConditionalOrderPlacement)(nil),
 		(*StatefulOrderEventV1_ConditionalOrderTriggered)(nil),
 		(*StatefulOrderEventV1_LongTermOrderPlacement)(nil),
+		(*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate)(nil),
+		(*StatefulOrderEventV1_LongTermOrderReplacement)(nil),
 	}
 }
 
 // A stateful order placement contains an order.
 type StatefulOrderEventV1_StatefulOrderPlacementV1 struct {
-	Order *v1.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order *types.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Reset() {
@@ -1181,7 +1230,7 @@ func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StatefulOrderEventV1_StatefulOrderPlacementV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) GetOrder() *v1.IndexerOrder {
+func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) GetOrder() *types.IndexerOrder {
 	if m != nil {
 		return m.Order
 	}
@@ -1191,8 +1240,8 @@ func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) GetOrder() *v1.IndexerOr
 // A stateful order removal contains the id of an order that was already
 // placed and is now removed and the reason for the removal.
 type StatefulOrderEventV1_StatefulOrderRemovalV1 struct {
-	RemovedOrderId *v1.IndexerOrderId        `protobuf:"bytes,1,opt,name=removed_order_id,json=removedOrderId,proto3" json:"removed_order_id,omitempty"`
-	Reason         shared.OrderRemovalReason `protobuf:"varint,2,opt,name=reason,proto3,enum=dydxprotocol.indexer.shared.OrderRemovalReason" json:"reason,omitempty"`
+	RemovedOrderId *types.IndexerOrderId     `protobuf:"bytes,1,opt,name=removed_order_id,json=removedOrderId,proto3" json:"removed_order_id,omitempty"`
+	Reason         types1.OrderRemovalReason `protobuf:"varint,2,opt,name=reason,proto3,enum=dydxprotocol.indexer.shared.OrderRemovalReason" json:"reason,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Reset() {
@@ -1232,24 +1281,24 @@ func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StatefulOrderEventV1_StatefulOrderRemovalV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) GetRemovedOrderId() *v1.IndexerOrderId {
+func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) GetRemovedOrderId() *types.IndexerOrderId {
 	if m != nil {
 		return m.RemovedOrderId
 	}
 	return nil
 }
 
-func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) GetReason() shared.OrderRemovalReason {
+func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) GetReason() types1.OrderRemovalReason {
 	if m != nil {
 		return m.Reason
 	}
-	return shared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED
+	return types1.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED
 }
 
 // A conditional order placement contains an order. The order is newly-placed
 // and untriggered when this event is emitted.
 type StatefulOrderEventV1_ConditionalOrderPlacementV1 struct {
-	Order *v1.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order *types.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) Reset() {
@@ -1289,7 +1338,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) XXX_DiscardUnknown()
 
 var xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderPlacementV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) GetOrder() *v1.IndexerOrder {
+func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) GetOrder() *types.IndexerOrder {
 	if m != nil {
 		return m.Order
 	}
@@ -1299,7 +1348,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) GetOrder() *v1.Indexe
 // A conditional order trigger event contains an order id and is emitted when
 // an order is triggered.
 type StatefulOrderEventV1_ConditionalOrderTriggeredV1 struct {
-	TriggeredOrderId *v1.IndexerOrderId `protobuf:"bytes,1,opt,name=triggered_order_id,json=triggeredOrderId,proto3" json:"triggered_order_id,omitempty"`
+	TriggeredOrderId *types.IndexerOrderId `protobuf:"bytes,1,opt,name=triggered_order_id,json=triggeredOrderId,proto3" json:"triggered_order_id,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) Reset() {
@@ -1339,7 +1388,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) XXX_DiscardUnknown()
 
 var xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggeredV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) GetTriggeredOrderId() *v1.IndexerOrderId {
+func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) GetTriggeredOrderId() *types.IndexerOrderId {
 	if m != nil {
 		return m.TriggeredOrderId
 	}
@@ -1348,7 +1397,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) GetTriggeredOrderId()
 
 // A long term order placement contains an order.
 type StatefulOrderEventV1_LongTermOrderPlacementV1 struct {
-	Order *v1.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order *types.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Reset() {
@@ -1388,7 +1437,116 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StatefulOrderEventV1_LongTermOrderPlacementV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) GetOrder() *v1.IndexerOrder {
+func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) GetOrder() *types.IndexerOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+// A conditional order trigger subticks update contains an order id and the
+// new trigger price of an untriggered trailing stop order. It is emitted
+// when the trigger price of the order follows the oracle price.
+type StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 struct {
+	OrderId                         *types.IndexerOrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ConditionalOrderTriggerSubticks uint64                `protobuf:"varint,2,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Reset() {
+	*m = StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1{}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) ProtoMessage() {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{12, 5}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.Merge(m, src)
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1 proto.InternalMessageInfo
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) GetOrderId() *types.IndexerOrderId {
+	if m != nil {
+		return m.OrderId
+	}
+	return nil
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) GetConditionalOrderTriggerSubticks() uint64 {
+	if m != nil {
+		return m.ConditionalOrderTriggerSubticks
+	}
+	return 0
+}
+
+// A long term order replacement contains the new version of a long term
+// order, which has the same order id as the order it replaces.
+type StatefulOrderEventV1_LongTermOrderReplacementV1 struct {
+	Order *types.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Reset() {
+	*m = StatefulOrderEventV1_LongTermOrderReplacementV1{}
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StatefulOrderEventV1_LongTermOrderReplacementV1) ProtoMessage() {}
+func (*StatefulOrderEventV1_LongTermOrderReplacementV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{12, 6}
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatefulOrderEventV1_LongTermOrderReplacementV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatefulOrderEventV1_LongTermOrderReplacementV1.Merge(m, src)
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatefulOrderEventV1_LongTermOrderReplacementV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatefulOrderEventV1_LongTermOrderReplacementV1 proto.InternalMessageInfo
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) GetOrder() *types.IndexerOrder {
 	if m != nil {
 		return m.Order
 	}
@@ -1501,7 +1659,7 @@ type PerpetualMarketCreateEventV1 struct {
 	// Defined in perpetuals.perpetual
 	MarketId uint32 `protobuf:"varint,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// Status of the CLOB
-	Status v1.ClobPairStatus `protobuf:"varint,5,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
+	Status types.ClobPairStatus `protobuf:"varint,5,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
 	// `10^Exponent` gives the number of QuoteQuantums traded per BaseQuantum
 	// per Subtick.
 	// Defined in clob.clob_pair
@@ -1587,11 +1745,11 @@ func (m *PerpetualMarketCreateEventV1) GetMarketId() uint32 {
 	return 0
 }
 
-func (m *PerpetualMarketCreateEventV1) GetStatus() v1.ClobPairStatus {
+func (m *PerpetualMarketCreateEventV1) GetStatus() types.ClobPairStatus {
 	if m != nil {
 		return m.Status
 	}
-	return v1.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
+	return types.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
 }
 
 func (m *PerpetualMarketCreateEventV1) GetQuantumConversionExponent() int32 {
@@ -1723,7 +1881,7 @@ type UpdateClobPairEventV1 struct {
 	// Defined in clob.clob_pair
 	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
 	// Status of the CLOB
-	Status v1.ClobPairStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
+	Status types.ClobPairStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
 	// `10^Exponent` gives the number of QuoteQuantums traded per BaseQuantum
 	// per Subtick.
 	// Defined in clob.clob_pair
@@ -1779,11 +1937,11 @@ func (m *UpdateClobPairEventV1) GetClobPairId() uint32 {
 	return 0
 }
 
-func (m *UpdateClobPairEventV1) GetStatus() v1.ClobPairStatus {
+func (m *UpdateClobPairEventV1) GetStatus() types.ClobPairStatus {
 	if m != nil {
 		return m.Status
 	}
-	return v1.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
+	return types.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
 }
 
 func (m *UpdateClobPairEventV1) GetQuantumConversionExponent() int32 {
@@ -1898,375 +2056,514 @@ func (m *UpdatePerpetualEventV1) GetLiquidityTier() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("dydxprotocol.indexer.events.FundingEventV1_Type", FundingEventV1_Type_name, FundingEventV1_Type_value)
-	proto.RegisterType((*FundingUpdateV1)(nil), "dydxprotocol.indexer.events.FundingUpdateV1")
-	proto.RegisterType((*FundingEventV1)(nil), "dydxprotocol.indexer.events.FundingEventV1")
-	proto.RegisterType((*MarketEventV1)(nil), "dydxprotocol.indexer.events.MarketEventV1")
-	proto.RegisterType((*MarketPriceUpdateEventV1)(nil), "dydxprotocol.indexer.events.MarketPriceUpdateEventV1")
-	proto.RegisterType((*MarketBaseEventV1)(nil), "dydxprotocol.indexer.events.MarketBaseEventV1")
-	proto.RegisterType((*MarketCreateEventV1)(nil), "dydxprotocol.indexer.events.MarketCreateEventV1")
-	proto.RegisterType((*MarketModifyEventV1)(nil), "dydxprotocol.indexer.events.MarketModifyEventV1")
-	proto.RegisterType((*SourceOfFunds)(nil), "dydxprotocol.indexer.events.SourceOfFunds")
-	proto.RegisterType((*TransferEventV1)(nil), "dydxprotocol.indexer.events.TransferEventV1")
-	proto.RegisterType((*OrderFillEventV1)(nil), "dydxprotocol.indexer.events.OrderFillEventV1")
-	proto.RegisterType((*LiquidationOrderV1)(nil), "dydxprotocol.indexer.events.LiquidationOrderV1")
-	proto.RegisterType((*SubaccountUpdateEventV1)(nil), "dydxprotocol.indexer.events.SubaccountUpdateEventV1")
-	proto.RegisterType((*StatefulOrderEventV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1")
-	proto.RegisterType((*StatefulOrderEventV1_StatefulOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.StatefulOrderPlacementV1")
-	proto.RegisterType((*StatefulOrderEventV1_StatefulOrderRemovalV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.StatefulOrderRemovalV1")
-	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderPlacementV1")
-	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggeredV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggeredV1")
-	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderPlacementV1")
-	proto.RegisterType((*AssetCreateEventV1)(nil), "dydxprotocol.indexer.events.AssetCreateEventV1")
-	proto.RegisterType((*PerpetualMarketCreateEventV1)(nil), "dydxprotocol.indexer.events.PerpetualMarketCreateEventV1")
-	proto.RegisterType((*LiquidityTierUpsertEventV1)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV1")
-	proto.RegisterType((*UpdateClobPairEventV1)(nil), "dydxprotocol.indexer.events.UpdateClobPairEventV1")
-	proto.RegisterType((*UpdatePerpetualEventV1)(nil), "dydxprotocol.indexer.events.UpdatePerpetualEventV1")
+// SpotMarketCreateEventV1 message contains all the information about a
+// new Spot Market on the v4 chain.
+type SpotMarketCreateEventV1 struct {
+	// Unique clob pair Id associated with this spot market
+	// Defined in clob.clob_pair
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Id of the base Asset in the trading pair.
+	// Defined in clob.clob_pair
+	BaseAssetId uint32 `protobuf:"varint,2,opt,name=base_asset_id,json=baseAssetId,proto3" json:"base_asset_id,omitempty"`
+	// Id of the quote Asset in the trading pair.
+	// Defined in clob.clob_pair
+	QuoteAssetId uint32 `protobuf:"varint,3,opt,name=quote_asset_id,json=quoteAssetId,proto3" json:"quote_asset_id,omitempty"`
+	// Status of the CLOB
+	Status types.ClobPairStatus `protobuf:"varint,4,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
+	// `10^Exponent` gives the number of QuoteQuantums traded per BaseQuantum
+	// per Subtick.
+	// Defined in clob.clob_pair
+	QuantumConversionExponent int32 `protobuf:"zigzag32,5,opt,name=quantum_conversion_exponent,json=quantumConversionExponent,proto3" json:"quantum_conversion_exponent,omitempty"`
+	// Defines the tick size of the orderbook by defining how many subticks
+	// are in one tick. That is, the subticks of any valid order must be a
+	// multiple of this value. Generally this value should start `>= 100`to
+	// allow room for decreasing it.
+	// Defined in clob.clob_pair
+	SubticksPerTick uint32 `protobuf:"varint,6,opt,name=subticks_per_tick,json=subticksPerTick,proto3" json:"subticks_per_tick,omitempty"`
+	// Minimum increment in the size of orders on the CLOB, in base quantums.
+	// Defined in clob.clob_pair
+	StepBaseQuantums uint64 `protobuf:"varint,7,opt,name=step_base_quantums,json=stepBaseQuantums,proto3" json:"step_base_quantums,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dydxprotocol/indexer/events/events.proto", fileDescriptor_6331dfb59c6fd2bb)
+func (m *SpotMarketCreateEventV1) Reset()         { *m = SpotMarketCreateEventV1{} }
+func (m *SpotMarketCreateEventV1) String() string { return proto.CompactTextString(m) }
+func (*SpotMarketCreateEventV1) ProtoMessage()    {}
+func (*SpotMarketCreateEventV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{18}
 }
-
-var fileDescriptor_6331dfb59c6fd2bb = []byte{
-	// 1914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x77, 0xcf, 0xb4, 0xc7, 0xf6, 0xb3, 0xc7, 0x99, 0xa9, 0x38, 0xce, 0xd8, 0x06, 0xc7, 0xb4,
-	0x84, 0x64, 0x65, 0x77, 0xc7, 0x71, 0x08, 0x68, 0xc5, 0x01, 0xe1, 0xb1, 0xc7, 0xeb, 0x89, 0x6c,
-	0x67, 0x68, 0x8f, 0xb3, 0xbb, 0x01, 0x6d, 0x53, 0xd3, 0x5d, 0x1e, 0x97, 0xdc, 0x5f, 0xdb, 0xd5,
-	0x6d, 0xe2, 0x48, 0x9c, 0xe1, 0x06, 0x12, 0x67, 0x0e, 0x1c, 0xb8, 0x20, 0x71, 0x40, 0xe2, 0xba,
-	0x27, 0x2e, 0x7b, 0x63, 0xc5, 0x05, 0xc4, 0x21, 0x42, 0xc9, 0x81, 0x7f, 0x03, 0xd5, 0x47, 0xf7,
-	0xcc, 0x78, 0x3e, 0x32, 0x49, 0xcc, 0xc9, 0xd3, 0xef, 0xd5, 0xfb, 0xbd, 0xcf, 0x7a, 0xf5, 0x9e,
-	0x61, 0xd3, 0xb9, 0x72, 0x9e, 0x87, 0x51, 0x10, 0x07, 0x76, 0xe0, 0x6e, 0x51, 0xdf, 0x21, 0xcf,
-	0x49, 0xb4, 0x45, 0x2e, 0x89, 0x1f, 0x33, 0xf5, 0xa7, 0x2a, 0xd8, 0x68, 0xad, 0xf7, 0x64, 0x55,
-	0x9d, 0xac, 0xca, 0x23, 0xab, 0x2b, 0x76, 0xc0, 0xbc, 0x80, 0x59, 0x82, 0xbf, 0x25, 0x3f, 0xa4,
-	0xdc, 0xea, 0x52, 0x27, 0xe8, 0x04, 0x92, 0xce, 0x7f, 0x29, 0xea, 0x83, 0xa1, 0x7a, 0xd9, 0x39,
-	0x8e, 0x88, 0xb3, 0x15, 0x11, 0x2f, 0xb8, 0xc4, 0xae, 0x15, 0x11, 0xcc, 0x02, 0x5f, 0x49, 0x7c,
-	0x30, 0x54, 0x22, 0x23, 0x5c, 0x6e, 0x6f, 0xd9, 0x6e, 0xd0, 0x56, 0x87, 0xb7, 0xdf, 0x78, 0x98,
-	0x25, 0x6d, 0x6c, 0xdb, 0x41, 0xe2, 0xc7, 0x52, 0xc4, 0xf8, 0xbb, 0x06, 0xb7, 0xf6, 0x13, 0xdf,
-	0xa1, 0x7e, 0xe7, 0x34, 0x74, 0x70, 0x4c, 0x9e, 0x6e, 0xa3, 0xef, 0xc0, 0x42, 0x48, 0xa2, 0x90,
-	0xc4, 0x09, 0x76, 0x2d, 0xea, 0x54, 0xb4, 0x0d, 0x6d, 0xb3, 0x68, 0xce, 0x67, 0xb4, 0x86, 0x83,
-	0xee, 0x43, 0xf9, 0x4c, 0x4a, 0x59, 0x97, 0xd8, 0x4d, 0x88, 0x15, 0x86, 0x5e, 0x25, 0xb7, 0xa1,
-	0x6d, 0x4e, 0x9b, 0xb7, 0x14, 0xe3, 0x29, 0xa7, 0x37, 0x43, 0x0f, 0x79, 0x50, 0x4c, 0xcf, 0x0a,
-	0x93, 0x2a, 0xf9, 0x0d, 0x6d, 0x73, 0xa1, 0x76, 0xf0, 0xf5, 0xcb, 0x7b, 0x53, 0xff, 0x7e, 0x79,
-	0xef, 0xc7, 0x1d, 0x1a, 0x9f, 0x27, 0xed, 0xaa, 0x1d, 0x78, 0x5b, 0x7d, 0xf6, 0x5f, 0x3e, 0xfa,
-	0xc8, 0x3e, 0xc7, 0xd4, 0xef, 0x3a, 0xe0, 0xc4, 0x57, 0x21, 0x61, 0xd5, 0x13, 0x12, 0x51, 0xec,
-	0xd2, 0x17, 0xb8, 0xed, 0x92, 0x86, 0x1f, 0x9b, 0x0b, 0x0a, 0xbe, 0xc1, 0xd1, 0x8d, 0xdf, 0xe5,
-	0x60, 0x51, 0x79, 0x54, 0xe7, 0x69, 0x7a, 0xba, 0x8d, 0x0e, 0x61, 0x26, 0x11, 0xce, 0xb1, 0x8a,
-	0xb6, 0x91, 0xdf, 0x9c, 0x7f, 0xf8, 0x61, 0x75, 0x4c, 0x5a, 0xab, 0xd7, 0xe2, 0x51, 0xd3, 0xb9,
-	0xa5, 0x66, 0x0a, 0x81, 0xf6, 0x40, 0xe7, 0x76, 0x08, 0x77, 0x17, 0x1f, 0x3e, 0x98, 0x04, 0x4a,
-	0x19, 0x52, 0x6d, 0x5d, 0x85, 0xc4, 0x14, 0xd2, 0x86, 0x07, 0x3a, 0xff, 0x42, 0x4b, 0x50, 0x6a,
-	0x7d, 0xde, 0xac, 0x5b, 0xa7, 0xc7, 0x27, 0xcd, 0xfa, 0x6e, 0x63, 0xbf, 0x51, 0xdf, 0x2b, 0x4d,
-	0xa1, 0xbb, 0x70, 0x5b, 0x50, 0x9b, 0x66, 0xfd, 0xa8, 0x71, 0x7a, 0x64, 0x9d, 0xec, 0x1c, 0x35,
-	0x0f, 0xeb, 0x25, 0x0d, 0xdd, 0x83, 0x35, 0xc1, 0xd8, 0x3f, 0x3d, 0xde, 0x6b, 0x1c, 0x7f, 0x62,
-	0x99, 0x3b, 0xad, 0xba, 0xb5, 0x73, 0xbc, 0x67, 0x35, 0x8e, 0xf7, 0xea, 0x9f, 0x95, 0x72, 0xe8,
-	0x0e, 0x94, 0xfb, 0x24, 0x9f, 0x3e, 0x69, 0xd5, 0x4b, 0x79, 0xe3, 0x6f, 0x39, 0x28, 0x1e, 0xe1,
-	0xe8, 0x82, 0xc4, 0x69, 0x50, 0xd6, 0x60, 0xce, 0x13, 0x84, 0x6e, 0x8a, 0x67, 0x25, 0xa1, 0xe1,
-	0xa0, 0x67, 0xb0, 0x10, 0x46, 0xd4, 0x26, 0x96, 0x74, 0x5a, 0xf8, 0x3a, 0xff, 0xf0, 0xfb, 0x63,
-	0x7d, 0x95, 0xf0, 0x4d, 0x2e, 0x26, 0x43, 0xa7, 0x34, 0x1d, 0x4c, 0x99, 0xf3, 0x61, 0x97, 0x8a,
-	0x3e, 0x85, 0xa2, 0x52, 0x6c, 0x47, 0x84, 0x83, 0xe7, 0x05, 0xf8, 0x83, 0x09, 0xc0, 0x77, 0x85,
-	0x40, 0x17, 0x77, 0xc1, 0xeb, 0x21, 0xf7, 0x00, 0x7b, 0x81, 0x43, 0xcf, 0xae, 0x2a, 0xfa, 0xc4,
-	0xc0, 0x47, 0x42, 0x60, 0x00, 0x58, 0x92, 0x6b, 0x33, 0x30, 0x2d, 0x4e, 0x1b, 0x8f, 0xa1, 0x32,
-	0xca, 0x4b, 0x54, 0x85, 0xdb, 0x32, 0x64, 0xbf, 0xa0, 0xf1, 0xb9, 0x45, 0x9e, 0x87, 0x81, 0x4f,
-	0xfc, 0x58, 0x44, 0x56, 0x37, 0xcb, 0x82, 0xf5, 0x29, 0x8d, 0xcf, 0xeb, 0x8a, 0x61, 0x7c, 0x06,
-	0x65, 0x89, 0x55, 0xc3, 0x2c, 0x03, 0x41, 0xa0, 0x87, 0x98, 0x46, 0x42, 0x6a, 0xce, 0x14, 0xbf,
-	0xd1, 0x16, 0x2c, 0x79, 0xd4, 0xb7, 0x24, 0xb8, 0x7d, 0x8e, 0xfd, 0x4e, 0xf7, 0xba, 0x15, 0xcd,
-	0xb2, 0x47, 0x7d, 0x61, 0xcd, 0xae, 0xe0, 0x34, 0x43, 0xcf, 0x48, 0xe0, 0xf6, 0x90, 0x70, 0xa1,
-	0x1a, 0xe8, 0x6d, 0xcc, 0x88, 0xc0, 0x9e, 0x7f, 0x58, 0x9d, 0x20, 0x2a, 0x3d, 0x96, 0x99, 0x42,
-	0x16, 0xad, 0xc2, 0x6c, 0xe6, 0x19, 0xd7, 0x5f, 0x36, 0xb3, 0x6f, 0xe3, 0xf3, 0x54, 0x6d, 0x5f,
-	0x30, 0x6f, 0x42, 0xad, 0xf1, 0x67, 0x0d, 0x8a, 0x27, 0x41, 0x12, 0xd9, 0xe4, 0xc9, 0x19, 0xbf,
-	0x52, 0x0c, 0xfd, 0x0c, 0x8a, 0xdd, 0x5e, 0x96, 0x56, 0xf0, 0xc8, 0x0a, 0xcd, 0x08, 0x97, 0xdb,
-	0xd5, 0x86, 0xa4, 0x9d, 0x64, 0xd2, 0x0d, 0x87, 0x27, 0x9c, 0xf5, 0x7c, 0xa3, 0x47, 0x30, 0x83,
-	0x1d, 0x27, 0x22, 0x8c, 0x09, 0x2f, 0xe7, 0x6a, 0x95, 0x7f, 0xfc, 0xf5, 0xa3, 0x25, 0xd5, 0xe0,
-	0x77, 0x24, 0xe7, 0x24, 0x8e, 0xa8, 0xdf, 0x39, 0x98, 0x32, 0xd3, 0xa3, 0xb5, 0x59, 0x28, 0x30,
-	0x61, 0xa4, 0xf1, 0xa7, 0x3c, 0xdc, 0x6a, 0x45, 0xd8, 0x67, 0x67, 0x24, 0x4a, 0xe3, 0xd0, 0x81,
-	0x25, 0x46, 0x7c, 0x87, 0x44, 0xd6, 0xcd, 0x19, 0x6e, 0x22, 0x09, 0xd9, 0x4b, 0x43, 0x1e, 0xdc,
-	0x8d, 0x88, 0x4d, 0x43, 0x4a, 0xfc, 0xf8, 0x9a, 0xae, 0xdc, 0xfb, 0xe8, 0xba, 0x93, 0xa1, 0xf6,
-	0xa9, 0x5b, 0x81, 0x59, 0xcc, 0x98, 0x6c, 0x23, 0x79, 0x51, 0x92, 0x33, 0xe2, 0xbb, 0xe1, 0xa0,
-	0x65, 0x28, 0x60, 0x8f, 0x1f, 0x13, 0x37, 0x51, 0x37, 0xd5, 0x17, 0xaa, 0x41, 0x41, 0xda, 0x5d,
-	0x99, 0x16, 0x06, 0xdd, 0x1f, 0x5b, 0x14, 0x7d, 0x89, 0x37, 0x95, 0x24, 0x3a, 0x80, 0xb9, 0xcc,
-	0x9e, 0x4a, 0xe1, 0xad, 0x61, 0xba, 0xc2, 0xc6, 0x3f, 0xf3, 0x50, 0x7a, 0x12, 0x39, 0x24, 0xda,
-	0xa7, 0xae, 0x9b, 0x66, 0xeb, 0x14, 0xe6, 0x3d, 0x7c, 0x41, 0x22, 0x2b, 0xe0, 0x9c, 0xf1, 0xc5,
-	0x3b, 0x24, 0x70, 0x02, 0x4f, 0x3d, 0x1c, 0x20, 0x80, 0x04, 0x05, 0xed, 0xc3, 0xb4, 0x04, 0xcc,
-	0xbd, 0x0b, 0xe0, 0xc1, 0x94, 0x29, 0xc5, 0xd1, 0x17, 0x50, 0x76, 0xe9, 0x97, 0x09, 0x75, 0x70,
-	0x4c, 0x03, 0x5f, 0x19, 0x29, 0xdb, 0xdd, 0xd6, 0xd8, 0x28, 0x1c, 0x76, 0xa5, 0x04, 0xa4, 0xe8,
-	0x76, 0x25, 0xf7, 0x1a, 0x15, 0xdd, 0x83, 0xf9, 0x33, 0xea, 0xba, 0x96, 0x4a, 0x5f, 0x5e, 0xa4,
-	0x0f, 0x38, 0x69, 0x47, 0xa6, 0x50, 0xbc, 0x1e, 0x3c, 0x3e, 0x67, 0x84, 0x88, 0x2c, 0x22, 0xfe,
-	0x7a, 0x5c, 0x90, 0x68, 0x9f, 0x10, 0xce, 0x8c, 0x33, 0x66, 0x41, 0x32, 0xe3, 0x94, 0xf9, 0x21,
-	0xa0, 0x38, 0x88, 0xb1, 0x6b, 0x71, 0x34, 0xe2, 0x58, 0x42, 0xaa, 0x32, 0x23, 0x34, 0x94, 0x04,
-	0x67, 0x5f, 0x30, 0x8e, 0x38, 0x7d, 0xe0, 0xb4, 0x80, 0xa9, 0xcc, 0x0e, 0x9c, 0x6e, 0x71, 0x7a,
-	0xad, 0x08, 0xf3, 0x71, 0x37, 0x6b, 0xc6, 0xaf, 0x73, 0x80, 0x06, 0x1d, 0x46, 0x3f, 0x05, 0x48,
-	0x1d, 0x26, 0xef, 0x77, 0xff, 0xd2, 0x0c, 0x77, 0xe1, 0xd0, 0x06, 0x2c, 0xf0, 0x89, 0xcc, 0xe2,
-	0xad, 0x3b, 0xbd, 0x72, 0x45, 0x13, 0x38, 0xad, 0x89, 0x69, 0xd4, 0x70, 0x06, 0xc6, 0xab, 0xfc,
-	0xe0, 0x78, 0xf5, 0x6d, 0x00, 0xe9, 0x35, 0xa3, 0x2f, 0x88, 0xba, 0x3c, 0x73, 0x82, 0x72, 0x42,
-	0x5f, 0x10, 0x74, 0x07, 0x0a, 0x94, 0x59, 0xed, 0xe4, 0x4a, 0x44, 0x7e, 0xd6, 0x9c, 0xa6, 0xac,
-	0x96, 0x5c, 0xf1, 0xe6, 0xcc, 0x92, 0x76, 0x4c, 0xed, 0x0b, 0x26, 0xa2, 0xae, 0x9b, 0xd9, 0xb7,
-	0xf1, 0xdf, 0x1c, 0xdc, 0xed, 0x5a, 0xde, 0xff, 0x72, 0x3d, 0xbb, 0xc9, 0x5e, 0x7a, 0xad, 0x93,
-	0xbe, 0x80, 0x35, 0x39, 0x42, 0x38, 0x56, 0xd7, 0xe9, 0x30, 0x60, 0x94, 0x27, 0x84, 0x55, 0xf2,
-	0x62, 0x1c, 0xfb, 0xe1, 0xc4, 0x9a, 0x9a, 0x29, 0x46, 0x53, 0x41, 0x98, 0x2b, 0x0a, 0x7e, 0x80,
-	0xc3, 0x90, 0x0f, 0x77, 0x53, 0xdd, 0xb2, 0x43, 0x75, 0xf5, 0xea, 0x42, 0xef, 0x0f, 0x26, 0xd6,
-	0xbb, 0xc3, 0xe5, 0x33, 0x9d, 0x77, 0x14, 0x6c, 0x1f, 0x95, 0x3d, 0xd6, 0x67, 0x73, 0xa5, 0xbc,
-	0xf1, 0x07, 0x80, 0xa5, 0x93, 0x18, 0xc7, 0xe4, 0x2c, 0x71, 0x45, 0xc5, 0xa5, 0x61, 0xf6, 0x60,
-	0x5e, 0x94, 0xa5, 0x15, 0xba, 0xd8, 0x4e, 0xdf, 0xc3, 0xc7, 0xe3, 0x7b, 0xd6, 0x10, 0x9c, 0x7e,
-	0x62, 0x93, 0x63, 0x79, 0xe9, 0xd8, 0x02, 0x41, 0x46, 0x43, 0x01, 0x14, 0xa5, 0x3a, 0xb5, 0x57,
-	0xa8, 0xf6, 0x70, 0xf0, 0x9e, 0x0a, 0x4d, 0x89, 0x26, 0xa7, 0xa4, 0xa0, 0x87, 0x82, 0x7e, 0xa3,
-	0xc1, 0x9a, 0x1d, 0xf8, 0x8e, 0x88, 0x06, 0x76, 0xad, 0x1e, 0x67, 0xb9, 0x81, 0xaa, 0xd7, 0x1f,
-	0xbd, 0xbd, 0xfe, 0xdd, 0x2e, 0xe8, 0x10, 0x9f, 0x57, 0xec, 0x51, 0xec, 0x11, 0x16, 0xc5, 0x11,
-	0xed, 0x74, 0x48, 0x44, 0x1c, 0xf5, 0x6c, 0xdc, 0x80, 0x45, 0xad, 0x14, 0x72, 0xb8, 0x45, 0x19,
-	0x1b, 0xfd, 0x4a, 0x83, 0x15, 0x37, 0xf0, 0x3b, 0x56, 0x4c, 0x22, 0x6f, 0x20, 0x42, 0x33, 0xef,
-	0x5a, 0x12, 0x87, 0x81, 0xdf, 0x69, 0x91, 0xc8, 0x1b, 0x12, 0x9e, 0x65, 0x77, 0x28, 0x6f, 0xf5,
-	0xe7, 0x50, 0x19, 0x55, 0x48, 0x68, 0x2f, 0x7d, 0xa5, 0xde, 0xe9, 0xd9, 0x53, 0x6f, 0xd4, 0xea,
-	0x57, 0x1a, 0x2c, 0x0f, 0x2f, 0x1d, 0xf4, 0x0c, 0x4a, 0xa2, 0x2a, 0x89, 0xa3, 0x62, 0x90, 0x35,
-	0x9d, 0x07, 0x6f, 0xa7, 0xab, 0xe1, 0x98, 0x8b, 0x0a, 0x49, 0x7d, 0xa3, 0x4f, 0xa0, 0x20, 0x37,
-	0x68, 0xb5, 0xa0, 0x8d, 0x78, 0x0f, 0xe5, 0xd2, 0x5d, 0xed, 0x35, 0xcc, 0x14, 0x62, 0xa6, 0x12,
-	0x5f, 0xb5, 0x61, 0x6d, 0x4c, 0xe5, 0xdd, 0x50, 0x90, 0x7e, 0x39, 0xa8, 0xa4, 0xa7, 0x98, 0xd0,
-	0x17, 0x80, 0xb2, 0x72, 0x7d, 0xff, 0x50, 0x95, 0x32, 0x2c, 0x45, 0xe1, 0x55, 0x30, 0xaa, 0x76,
-	0x6e, 0xc6, 0xc1, 0x6c, 0x77, 0x92, 0xdd, 0xf1, 0xb1, 0x3e, 0x9b, 0x2f, 0xe9, 0xc6, 0x1f, 0x35,
-	0x40, 0xa2, 0x79, 0xf6, 0x6f, 0x28, 0x8b, 0x90, 0xcb, 0x76, 0xd1, 0x1c, 0x15, 0xf3, 0x23, 0xbb,
-	0xf2, 0xda, 0x81, 0x2b, 0xa7, 0x70, 0x53, 0x7d, 0xf1, 0xe7, 0xf1, 0x1c, 0x33, 0x4b, 0xee, 0x68,
-	0xe2, 0xfd, 0x9c, 0x35, 0xe7, 0xce, 0x31, 0x93, 0xeb, 0x43, 0xff, 0x66, 0xab, 0x5f, 0xdb, 0x6c,
-	0x3f, 0x80, 0x32, 0x8e, 0x03, 0x8f, 0xda, 0x56, 0x44, 0x58, 0xe0, 0x26, 0x3c, 0xf0, 0xa2, 0x35,
-	0x95, 0xcd, 0x92, 0x64, 0x98, 0x19, 0xdd, 0xf8, 0x2a, 0x0f, 0xdf, 0xca, 0x1e, 0x96, 0x61, 0x3b,
-	0xd5, 0x75, 0x8b, 0xdf, 0xfc, 0xfa, 0x2f, 0x43, 0x81, 0xbf, 0xc8, 0x24, 0x12, 0x76, 0xcf, 0x99,
-	0xea, 0x6b, 0xbc, 0xd1, 0x07, 0x50, 0x60, 0x31, 0x8e, 0x13, 0x26, 0x2c, 0x5d, 0x9c, 0x24, 0xf5,
-	0xbb, 0x4a, 0xe5, 0x89, 0x90, 0x33, 0x95, 0x3c, 0xfa, 0x11, 0xac, 0x7d, 0x99, 0x60, 0x3f, 0x4e,
-	0x3c, 0xcb, 0x0e, 0xfc, 0x4b, 0x12, 0x31, 0x3e, 0x3f, 0x66, 0x3b, 0x5d, 0x41, 0x04, 0x62, 0x45,
-	0x1d, 0xd9, 0xcd, 0x4e, 0xa4, 0x5b, 0xeb, 0xf0, 0xf0, 0xcd, 0x0c, 0x0f, 0x1f, 0xba, 0x0f, 0xe5,
-	0x74, 0x00, 0xe1, 0xaf, 0xbf, 0xc5, 0x7f, 0x89, 0xd9, 0xad, 0x68, 0xde, 0x4a, 0x19, 0x4d, 0x12,
-	0xb5, 0xa8, 0x7d, 0xc1, 0x07, 0x3d, 0x16, 0x93, 0xd0, 0xe2, 0xfb, 0x9e, 0xa5, 0xf4, 0xb3, 0xca,
-	0x9c, 0x1c, 0xf4, 0x38, 0x87, 0x6f, 0x85, 0x3f, 0x51, 0x74, 0xf4, 0x5d, 0x58, 0x94, 0x33, 0x17,
-	0x8d, 0xaf, 0xac, 0x98, 0x92, 0xa8, 0x02, 0x02, 0xb6, 0x98, 0x51, 0x5b, 0x94, 0x44, 0xc6, 0x4b,
-	0x0d, 0x56, 0x0f, 0x7b, 0x29, 0xa7, 0x21, 0x23, 0x51, 0x3c, 0x2a, 0x7b, 0x08, 0x74, 0x1f, 0x7b,
-	0x44, 0x55, 0x9b, 0xf8, 0xcd, 0xed, 0xa2, 0x3e, 0x8d, 0x29, 0x76, 0x79, 0xbd, 0x75, 0xf8, 0x22,
-	0x1e, 0x7a, 0x6a, 0x66, 0x2b, 0x29, 0xce, 0x91, 0x60, 0x34, 0x43, 0x0f, 0x7d, 0x0c, 0x15, 0x0f,
-	0x53, 0x3f, 0x26, 0x3e, 0xf6, 0x6d, 0x62, 0x9d, 0x45, 0xd8, 0x16, 0x03, 0x3a, 0x97, 0x91, 0x49,
-	0x5d, 0xee, 0xe1, 0xef, 0x2b, 0x36, 0x97, 0x7c, 0x04, 0xcb, 0xc2, 0xf5, 0x74, 0x46, 0xb1, 0xfc,
-	0x40, 0xf6, 0x04, 0x91, 0x72, 0xdd, 0x5c, 0xe2, 0xdc, 0x74, 0xd6, 0x38, 0x56, 0x3c, 0xe3, 0xf7,
-	0x39, 0xb8, 0x23, 0x87, 0xb9, 0x34, 0xdf, 0xa9, 0x6f, 0xd7, 0x2b, 0x51, 0x1b, 0xa8, 0xc4, 0x6e,
-	0x51, 0xe5, 0xfe, 0xbf, 0x45, 0x95, 0x7f, 0x53, 0x51, 0x0d, 0xad, 0x13, 0xfd, 0x6d, 0xea, 0x64,
-	0x7a, 0x78, 0x9d, 0x18, 0x7f, 0xd1, 0x60, 0x59, 0xc6, 0x27, 0xbb, 0xc6, 0x63, 0x9a, 0x8d, 0xba,
-	0x98, 0xb9, 0xd1, 0x17, 0x33, 0x3f, 0x49, 0x37, 0xd1, 0x47, 0x5c, 0x87, 0xc1, 0xa2, 0x9d, 0x1e,
-	0x52, 0xb4, 0x35, 0xf3, 0xeb, 0x57, 0xeb, 0xda, 0x37, 0xaf, 0xd6, 0xb5, 0xff, 0xbc, 0x5a, 0xd7,
-	0x7e, 0xfb, 0x7a, 0x7d, 0xea, 0x9b, 0xd7, 0xeb, 0x53, 0xff, 0x7a, 0xbd, 0x3e, 0xf5, 0xec, 0xe3,
-	0xc9, 0xff, 0x55, 0xda, 0xff, 0x3f, 0xed, 0x76, 0x41, 0x30, 0xbe, 0xf7, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xc3, 0xa2, 0xc7, 0xf2, 0xf9, 0x16, 0x00, 0x00,
+func (m *SpotMarketCreateEventV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *FundingUpdateV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpotMarketCreateEventV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotMarketCreateEventV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *FundingUpdateV1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpotMarketCreateEventV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotMarketCreateEventV1.Merge(m, src)
+}
+func (m *SpotMarketCreateEventV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotMarketCreateEventV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotMarketCreateEventV1.DiscardUnknown(m)
 }
 
-func (m *FundingUpdateV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.FundingIndex.Size()
-		i -= size
-		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.FundingValuePpm != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FundingValuePpm))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PerpetualId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PerpetualId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_SpotMarketCreateEventV1 proto.InternalMessageInfo
+
+func (m *SpotMarketCreateEventV1) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *FundingEventV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpotMarketCreateEventV1) GetBaseAssetId() uint32 {
+	if m != nil {
+		return m.BaseAssetId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FundingEventV1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpotMarketCreateEventV1) GetQuoteAssetId() uint32 {
+	if m != nil {
+		return m.QuoteAssetId
+	}
+	return 0
 }
 
-func (m *FundingEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Type != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *SpotMarketCreateEventV1) GetStatus() types.ClobPairStatus {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return types.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
 }
 
-func (m *MarketEventV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpotMarketCreateEventV1) GetQuantumConversionExponent() int32 {
+	if m != nil {
+		return m.QuantumConversionExponent
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MarketEventV1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpotMarketCreateEventV1) GetSubticksPerTick() uint32 {
+	if m != nil {
+		return m.SubticksPerTick
+	}
+	return 0
 }
 
-func (m *MarketEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Event != nil {
-		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *SpotMarketCreateEventV1) GetStepBaseQuantums() uint64 {
+	if m != nil {
+		return m.StepBaseQuantums
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+// DeleveragingEventV1 message contains all the information about a
+// deleveraging fill on the v4 chain. This includes the liquidated and
+// offsetting subaccounts, the amount filled and the bankruptcy price of the
+// liquidated position at which both positions were closed.
+type DeleveragingEventV1 struct {
+	// The subaccount whose position was deleveraged.
+	Liquidated types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
+	// The subaccount whose position was used to offset the liquidated position.
+	Offsetting types.IndexerSubaccountId `protobuf:"bytes,2,opt,name=offsetting,proto3" json:"offsetting"`
+	// Id of the perpetual that was deleveraged.
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// Fill amount in base quantums.
+	FillAmount uint64 `protobuf:"varint,4,opt,name=fill_amount,json=fillAmount,proto3" json:"fill_amount,omitempty"`
+	// Bankruptcy price of the liquidated position in subticks, rounded down.
+	// Negative if the liquidated position was closed at a negative bankruptcy
+	// price. Serialized as a `SerializableInt`, since the bankruptcy price is
+	// not bounded by the range of an int64.
+	Subticks github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,5,opt,name=subticks,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"subticks"`
+	// Total quote quantums paid by the liquidated subaccount at the bankruptcy
+	// price. Negative if the liquidated subaccount received quote quantums.
+	// Serialized as a `SerializableInt`.
+	TotalQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,6,opt,name=total_quote_quantums,json=totalQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"total_quote_quantums"`
+	// `true` if the liquidated position is short and was bought back, `false`
+	// otherwise.
+	IsBuy bool `protobuf:"varint,7,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+}
+
+func (m *DeleveragingEventV1) Reset()         { *m = DeleveragingEventV1{} }
+func (m *DeleveragingEventV1) String() string { return proto.CompactTextString(m) }
+func (*DeleveragingEventV1) ProtoMessage()    {}
+func (*DeleveragingEventV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{19}
+}
+func (m *DeleveragingEventV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleveragingEventV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleveragingEventV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleveragingEventV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleveragingEventV1.Merge(m, src)
+}
+func (m *DeleveragingEventV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleveragingEventV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleveragingEventV1.DiscardUnknown(m)
 }
 
-func (m *MarketEventV1_PriceUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DeleveragingEventV1 proto.InternalMessageInfo
+
+func (m *DeleveragingEventV1) GetLiquidated() types.IndexerSubaccountId {
+	if m != nil {
+		return m.Liquidated
+	}
+	return types.IndexerSubaccountId{}
 }
 
-func (m *MarketEventV1_PriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PriceUpdate != nil {
-		{
-			size, err := m.PriceUpdate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *DeleveragingEventV1) GetOffsetting() types.IndexerSubaccountId {
+	if m != nil {
+		return m.Offsetting
 	}
-	return len(dAtA) - i, nil
+	return types.IndexerSubaccountId{}
 }
-func (m *MarketEventV1_MarketCreate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *DeleveragingEventV1) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
 }
 
-func (m *MarketEventV1_MarketCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MarketCreate != nil {
-		{
-			size, err := m.MarketCreate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *DeleveragingEventV1) GetFillAmount() uint64 {
+	if m != nil {
+		return m.FillAmount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *MarketEventV1_MarketModify) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *DeleveragingEventV1) GetIsBuy() bool {
+	if m != nil {
+		return m.IsBuy
+	}
+	return false
 }
 
-func (m *MarketEventV1_MarketModify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MarketModify != nil {
-		{
-			size, err := m.MarketModify.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+// SpotOrderFillEventV1 message contains all the information from an order
+// match of a spot clob pair in the dYdX chain. This includes the maker and
+// taker orders, the amount filled and the fees paid. Spot fills are settled
+// by transferring the base asset between the asset positions of the maker and
+// taker subaccounts.
+type SpotOrderFillEventV1 struct {
+	MakerOrder types.IndexerOrder `protobuf:"bytes,1,opt,name=maker_order,json=makerOrder,proto3" json:"maker_order"`
+	TakerOrder types.IndexerOrder `protobuf:"bytes,2,opt,name=taker_order,json=takerOrder,proto3" json:"taker_order"`
+	// Id of the base Asset in the trading pair.
+	BaseAssetId uint32 `protobuf:"varint,3,opt,name=base_asset_id,json=baseAssetId,proto3" json:"base_asset_id,omitempty"`
+	// Id of the quote Asset in the trading pair.
+	QuoteAssetId uint32 `protobuf:"varint,4,opt,name=quote_asset_id,json=quoteAssetId,proto3" json:"quote_asset_id,omitempty"`
+	// Fill amount in base quantums.
+	FillAmount uint64 `protobuf:"varint,5,opt,name=fill_amount,json=fillAmount,proto3" json:"fill_amount,omitempty"`
+	// Maker fee in quote quantums.
+	MakerFee int64 `protobuf:"zigzag64,6,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+	// Taker fee in quote quantums.
+	TakerFee int64 `protobuf:"zigzag64,7,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	// Total filled of the maker order in base quantums.
+	TotalFilledMaker uint64 `protobuf:"varint,8,opt,name=total_filled_maker,json=totalFilledMaker,proto3" json:"total_filled_maker,omitempty"`
+	// Total filled of the taker order in base quantums.
+	TotalFilledTaker uint64 `protobuf:"varint,9,opt,name=total_filled_taker,json=totalFilledTaker,proto3" json:"total_filled_taker,omitempty"`
+}
+
+func (m *SpotOrderFillEventV1) Reset()         { *m = SpotOrderFillEventV1{} }
+func (m *SpotOrderFillEventV1) String() string { return proto.CompactTextString(m) }
+func (*SpotOrderFillEventV1) ProtoMessage()    {}
+func (*SpotOrderFillEventV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{20}
+}
+func (m *SpotOrderFillEventV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotOrderFillEventV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotOrderFillEventV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *MarketPriceUpdateEventV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpotOrderFillEventV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotOrderFillEventV1.Merge(m, src)
+}
+func (m *SpotOrderFillEventV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotOrderFillEventV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotOrderFillEventV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotOrderFillEventV1 proto.InternalMessageInfo
+
+func (m *SpotOrderFillEventV1) GetMakerOrder() types.IndexerOrder {
+	if m != nil {
+		return m.MakerOrder
 	}
-	return dAtA[:n], nil
+	return types.IndexerOrder{}
 }
 
-func (m *MarketPriceUpdateEventV1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpotOrderFillEventV1) GetTakerOrder() types.IndexerOrder {
+	if m != nil {
+		return m.TakerOrder
+	}
+	return types.IndexerOrder{}
 }
 
-func (m *MarketPriceUpdateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PriceWithExponent != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PriceWithExponent))
-		i--
-		dAtA[i] = 0x8
+func (m *SpotOrderFillEventV1) GetBaseAssetId() uint32 {
+	if m != nil {
+		return m.BaseAssetId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MarketBaseEventV1) Marshal() (dAtA []byte, err error) {
+func (m *SpotOrderFillEventV1) GetQuoteAssetId() uint32 {
+	if m != nil {
+		return m.QuoteAssetId
+	}
+	return 0
+}
+
+func (m *SpotOrderFillEventV1) GetFillAmount() uint64 {
+	if m != nil {
+		return m.FillAmount
+	}
+	return 0
+}
+
+func (m *SpotOrderFillEventV1) GetMakerFee() int64 {
+	if m != nil {
+		return m.MakerFee
+	}
+	return 0
+}
+
+func (m *SpotOrderFillEventV1) GetTakerFee() int64 {
+	if m != nil {
+		return m.TakerFee
+	}
+	return 0
+}
+
+func (m *SpotOrderFillEventV1) GetTotalFilledMaker() uint64 {
+	if m != nil {
+		return m.TotalFilledMaker
+	}
+	return 0
+}
+
+func (m *SpotOrderFillEventV1) GetTotalFilledTaker() uint64 {
+	if m != nil {
+		return m.TotalFilledTaker
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dydxprotocol.indexer.events.FundingEventV1_Type", FundingEventV1_Type_name, FundingEventV1_Type_value)
+	proto.RegisterType((*FundingUpdateV1)(nil), "dydxprotocol.indexer.events.FundingUpdateV1")
+	proto.RegisterType((*FundingEventV1)(nil), "dydxprotocol.indexer.events.FundingEventV1")
+	proto.RegisterType((*MarketEventV1)(nil), "dydxprotocol.indexer.events.MarketEventV1")
+	proto.RegisterType((*MarketPriceUpdateEventV1)(nil), "dydxprotocol.indexer.events.MarketPriceUpdateEventV1")
+	proto.RegisterType((*MarketBaseEventV1)(nil), "dydxprotocol.indexer.events.MarketBaseEventV1")
+	proto.RegisterType((*MarketCreateEventV1)(nil), "dydxprotocol.indexer.events.MarketCreateEventV1")
+	proto.RegisterType((*MarketModifyEventV1)(nil), "dydxprotocol.indexer.events.MarketModifyEventV1")
+	proto.RegisterType((*SourceOfFunds)(nil), "dydxprotocol.indexer.events.SourceOfFunds")
+	proto.RegisterType((*TransferEventV1)(nil), "dydxprotocol.indexer.events.TransferEventV1")
+	proto.RegisterType((*OrderFillEventV1)(nil), "dydxprotocol.indexer.events.OrderFillEventV1")
+	proto.RegisterType((*LiquidationOrderV1)(nil), "dydxprotocol.indexer.events.LiquidationOrderV1")
+	proto.RegisterType((*SubaccountUpdateEventV1)(nil), "dydxprotocol.indexer.events.SubaccountUpdateEventV1")
+	proto.RegisterType((*StatefulOrderEventV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1")
+	proto.RegisterType((*StatefulOrderEventV1_StatefulOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.StatefulOrderPlacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_StatefulOrderRemovalV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.StatefulOrderRemovalV1")
+	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderPlacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggeredV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggeredV1")
+	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderPlacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggerSubticksUpdateV1")
+	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderReplacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderReplacementV1")
+	proto.RegisterType((*AssetCreateEventV1)(nil), "dydxprotocol.indexer.events.AssetCreateEventV1")
+	proto.RegisterType((*PerpetualMarketCreateEventV1)(nil), "dydxprotocol.indexer.events.PerpetualMarketCreateEventV1")
+	proto.RegisterType((*LiquidityTierUpsertEventV1)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV1")
+	proto.RegisterType((*UpdateClobPairEventV1)(nil), "dydxprotocol.indexer.events.UpdateClobPairEventV1")
+	proto.RegisterType((*UpdatePerpetualEventV1)(nil), "dydxprotocol.indexer.events.UpdatePerpetualEventV1")
+	proto.RegisterType((*SpotMarketCreateEventV1)(nil), "dydxprotocol.indexer.events.SpotMarketCreateEventV1")
+	proto.RegisterType((*DeleveragingEventV1)(nil), "dydxprotocol.indexer.events.DeleveragingEventV1")
+	proto.RegisterType((*SpotOrderFillEventV1)(nil), "dydxprotocol.indexer.events.SpotOrderFillEventV1")
+}
+
+func init() {
+	proto.RegisterFile("dydxprotocol/indexer/events/events.proto", fileDescriptor_6331dfb59c6fd2bb)
+}
+
+var fileDescriptor_6331dfb59c6fd2bb = []byte{
+	// 2243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xf7, 0xcc, 0xf4, 0x7c, 0xf8, 0x8d, 0xc7, 0x19, 0x57, 0x1c, 0x67, 0x6c, 0x83, 0x93, 0x1d,
+	0xb1, 0x22, 0xec, 0xc7, 0x38, 0x09, 0x01, 0xad, 0x38, 0x20, 0xfc, 0xb9, 0x9e, 0xac, 0xed, 0xcc,
+	0xb6, 0xed, 0xec, 0x26, 0x8b, 0xb6, 0x69, 0x77, 0x97, 0xc7, 0x25, 0xf7, 0x57, 0xba, 0x6a, 0x4c,
+	0x6c, 0x89, 0x33, 0x1c, 0x40, 0x20, 0xed, 0x99, 0x23, 0x17, 0x24, 0x0e, 0x48, 0x70, 0xdc, 0xd3,
+	0x0a, 0x69, 0x8f, 0x2b, 0x2e, 0x20, 0x0e, 0x11, 0x4a, 0x0e, 0x88, 0xff, 0x02, 0xd5, 0x47, 0x77,
+	0xcf, 0xb7, 0xc7, 0xf1, 0x84, 0x93, 0xa7, 0xdf, 0xab, 0xf7, 0x7b, 0x1f, 0xf5, 0xde, 0xab, 0xaa,
+	0x67, 0xb8, 0x63, 0x9f, 0xd9, 0xcf, 0x83, 0xd0, 0x67, 0xbe, 0xe5, 0x3b, 0xcb, 0xc4, 0xb3, 0xf1,
+	0x73, 0x1c, 0x2e, 0xe3, 0x53, 0xec, 0x31, 0xaa, 0xfe, 0xd4, 0x04, 0x1b, 0x2d, 0xb6, 0xaf, 0xac,
+	0xa9, 0x95, 0x35, 0xb9, 0x64, 0x61, 0xde, 0xf2, 0xa9, 0xeb, 0x53, 0x43, 0xf0, 0x97, 0xe5, 0x87,
+	0x94, 0x5b, 0x98, 0x6d, 0xfa, 0x4d, 0x5f, 0xd2, 0xf9, 0x2f, 0x45, 0xbd, 0xdb, 0x57, 0x2f, 0x3d,
+	0x36, 0x43, 0x6c, 0x2f, 0x87, 0xd8, 0xf5, 0x4f, 0x4d, 0xc7, 0x08, 0xb1, 0x49, 0x7d, 0x4f, 0x49,
+	0xbc, 0xdb, 0x57, 0x22, 0x26, 0x9c, 0xde, 0x5b, 0xb6, 0x1c, 0xff, 0x50, 0x2d, 0xbe, 0x77, 0xe1,
+	0x62, 0xda, 0x3a, 0x34, 0x2d, 0xcb, 0x6f, 0x79, 0x4c, 0x8a, 0x54, 0xbf, 0x48, 0xc3, 0xb5, 0xcd,
+	0x96, 0x67, 0x13, 0xaf, 0x79, 0x10, 0xd8, 0x26, 0xc3, 0x8f, 0xef, 0xa1, 0xb7, 0x60, 0x2a, 0xc0,
+	0x61, 0x80, 0x59, 0xcb, 0x74, 0x0c, 0x62, 0x57, 0x52, 0xb7, 0x53, 0x77, 0x4a, 0x7a, 0x31, 0xa6,
+	0xd5, 0x6d, 0xf4, 0x0e, 0xcc, 0x1c, 0x49, 0x29, 0xe3, 0xd4, 0x74, 0x5a, 0xd8, 0x08, 0x02, 0xb7,
+	0x92, 0xbe, 0x9d, 0xba, 0x93, 0xd5, 0xaf, 0x29, 0xc6, 0x63, 0x4e, 0x6f, 0x04, 0x2e, 0x72, 0xa1,
+	0x14, 0xad, 0x15, 0x26, 0x55, 0x32, 0xb7, 0x53, 0x77, 0xa6, 0x56, 0xb7, 0xbe, 0x7e, 0x71, 0x6b,
+	0xe2, 0x5f, 0x2f, 0x6e, 0xfd, 0xa4, 0x49, 0xd8, 0x71, 0xeb, 0xb0, 0x66, 0xf9, 0xee, 0x72, 0x87,
+	0xfd, 0xa7, 0x0f, 0xde, 0xb7, 0x8e, 0x4d, 0xe2, 0x25, 0x0e, 0xd8, 0xec, 0x2c, 0xc0, 0xb4, 0xb6,
+	0x87, 0x43, 0x62, 0x3a, 0xe4, 0xdc, 0x3c, 0x74, 0x70, 0xdd, 0x63, 0xfa, 0x94, 0x82, 0xaf, 0x73,
+	0x74, 0x74, 0x0b, 0x8a, 0x41, 0x88, 0x5d, 0xd2, 0x72, 0x85, 0x51, 0x9a, 0x30, 0x0a, 0x14, 0x89,
+	0xdb, 0xf3, 0x16, 0x4c, 0x11, 0x8f, 0xe1, 0x10, 0x53, 0x26, 0x56, 0x64, 0xc5, 0x8a, 0x62, 0x44,
+	0x6b, 0x04, 0x2e, 0x8f, 0xca, 0xb4, 0x8a, 0xca, 0x06, 0xdf, 0xea, 0xc7, 0xf7, 0xd0, 0x36, 0xe4,
+	0x5b, 0x22, 0x40, 0xb4, 0x92, 0xba, 0x9d, 0xb9, 0x53, 0xbc, 0xff, 0x5e, 0x6d, 0x48, 0x6a, 0xd4,
+	0xba, 0x62, 0xba, 0xaa, 0x71, 0x6f, 0xf5, 0x08, 0x02, 0xad, 0x83, 0xc6, 0x7d, 0x11, 0x21, 0x9b,
+	0xbe, 0x7f, 0x77, 0x14, 0x28, 0x65, 0x48, 0x6d, 0xff, 0x2c, 0xc0, 0xba, 0x90, 0xae, 0xba, 0xa0,
+	0xf1, 0x2f, 0x34, 0x0b, 0xe5, 0xfd, 0x27, 0x8d, 0x0d, 0xe3, 0x60, 0x77, 0xaf, 0xb1, 0xb1, 0x56,
+	0xdf, 0xac, 0x6f, 0xac, 0x97, 0x27, 0xd0, 0x4d, 0xb8, 0x2e, 0xa8, 0x0d, 0x7d, 0x63, 0xa7, 0x7e,
+	0xb0, 0x63, 0xec, 0xad, 0xec, 0x34, 0xb6, 0x37, 0xca, 0x29, 0x74, 0x0b, 0x16, 0x05, 0x63, 0xf3,
+	0x60, 0x77, 0xbd, 0xbe, 0xfb, 0xa1, 0xa1, 0xaf, 0xec, 0x6f, 0x18, 0x2b, 0xbb, 0xeb, 0x46, 0x7d,
+	0x77, 0x7d, 0xe3, 0xd3, 0x72, 0x1a, 0xdd, 0x80, 0x99, 0x0e, 0xc9, 0xc7, 0x8f, 0xf6, 0x37, 0xca,
+	0x99, 0xea, 0x57, 0x69, 0x28, 0xed, 0x98, 0xe1, 0x09, 0x66, 0x51, 0x50, 0x16, 0x61, 0xd2, 0x15,
+	0x84, 0x24, 0x4d, 0x0a, 0x92, 0x50, 0xb7, 0xd1, 0x53, 0x98, 0x0a, 0x42, 0x62, 0x61, 0x43, 0x3a,
+	0x2d, 0x7c, 0x2d, 0xde, 0xff, 0xc1, 0x50, 0x5f, 0x25, 0x7c, 0x83, 0x8b, 0xc9, 0xd0, 0x29, 0x4d,
+	0x5b, 0x13, 0x7a, 0x31, 0x48, 0xa8, 0xe8, 0x13, 0x28, 0x29, 0xc5, 0x56, 0x88, 0x39, 0x78, 0x46,
+	0x80, 0xdf, 0x1d, 0x01, 0x7c, 0x2d, 0xc4, 0x1d, 0xb8, 0x53, 0x6e, 0x1b, 0xb9, 0x0d, 0xd8, 0xf5,
+	0x6d, 0x72, 0x74, 0x56, 0xd1, 0x46, 0x06, 0xde, 0x11, 0x02, 0x3d, 0xc0, 0x92, 0xbc, 0x9a, 0x87,
+	0xac, 0x58, 0x5d, 0x7d, 0x08, 0x95, 0x41, 0x5e, 0xa2, 0x1a, 0x5c, 0x97, 0x21, 0xfb, 0x39, 0x61,
+	0xc7, 0x06, 0x7e, 0x1e, 0xf8, 0x1e, 0xf6, 0x98, 0x88, 0xac, 0xa6, 0xcf, 0x08, 0xd6, 0x27, 0x84,
+	0x1d, 0x6f, 0x28, 0x46, 0xf5, 0x53, 0x98, 0x91, 0x58, 0xab, 0x26, 0x8d, 0x41, 0x10, 0x68, 0x81,
+	0x49, 0x42, 0x21, 0x35, 0xa9, 0x8b, 0xdf, 0x68, 0x19, 0x66, 0x5d, 0xe2, 0x19, 0x12, 0xdc, 0x3a,
+	0x36, 0xbd, 0x66, 0x52, 0xb2, 0x25, 0x7d, 0xc6, 0x25, 0x9e, 0xb0, 0x66, 0x4d, 0x70, 0x78, 0x05,
+	0xb4, 0xe0, 0x7a, 0x9f, 0x70, 0xa1, 0x55, 0xd0, 0x0e, 0x4d, 0x8a, 0x05, 0x76, 0xf1, 0x7e, 0x6d,
+	0x84, 0xa8, 0xb4, 0x59, 0xa6, 0x0b, 0x59, 0xb4, 0x00, 0x85, 0xd8, 0x33, 0xae, 0x7f, 0x46, 0x8f,
+	0xbf, 0xab, 0x4f, 0x22, 0xb5, 0x1d, 0xc1, 0x1c, 0x87, 0xda, 0xea, 0x9f, 0x52, 0x50, 0xda, 0xf3,
+	0x5b, 0xa1, 0x85, 0x1f, 0x1d, 0xf1, 0x92, 0xa2, 0xe8, 0xa7, 0x50, 0x4a, 0xfa, 0x61, 0x94, 0xc1,
+	0x03, 0x33, 0x34, 0x26, 0x9c, 0xde, 0xab, 0xd5, 0x25, 0x6d, 0x2f, 0x96, 0xae, 0xdb, 0x7c, 0xc3,
+	0x69, 0xdb, 0x37, 0x7a, 0x00, 0x79, 0xd3, 0xb6, 0x43, 0x4c, 0xa9, 0xf0, 0x72, 0x72, 0xb5, 0xf2,
+	0xf7, 0xbf, 0xbc, 0x3f, 0xab, 0x0e, 0x89, 0x15, 0xc9, 0xd9, 0x63, 0x21, 0xf1, 0x9a, 0x5b, 0x13,
+	0x7a, 0xb4, 0x74, 0xb5, 0x00, 0x39, 0x2a, 0x8c, 0xac, 0xfe, 0x31, 0x03, 0xd7, 0xf6, 0x43, 0xd3,
+	0xa3, 0x47, 0x38, 0x8c, 0xe2, 0xd0, 0x84, 0x59, 0x8a, 0x3d, 0x1b, 0x87, 0xc6, 0xf8, 0x0c, 0xd7,
+	0x91, 0x84, 0x6c, 0xa7, 0x21, 0x17, 0x6e, 0x86, 0xd8, 0x22, 0x01, 0xc1, 0x1e, 0xeb, 0xd2, 0x95,
+	0xbe, 0x8a, 0xae, 0x1b, 0x31, 0x6a, 0x87, 0xba, 0x79, 0x28, 0x98, 0x94, 0xca, 0x36, 0x92, 0x11,
+	0x29, 0x99, 0x17, 0xdf, 0x75, 0x1b, 0xcd, 0x41, 0xce, 0x74, 0xf9, 0x32, 0x51, 0x89, 0x9a, 0xae,
+	0xbe, 0xd0, 0x2a, 0xe4, 0xa4, 0xdd, 0xa2, 0x7f, 0x17, 0xef, 0xbf, 0x33, 0x34, 0x29, 0x3a, 0x36,
+	0x5e, 0x57, 0x92, 0x68, 0x0b, 0x26, 0x63, 0x7b, 0x2a, 0xb9, 0x4b, 0xc3, 0x24, 0xc2, 0xd5, 0x7f,
+	0x64, 0xa0, 0xfc, 0x28, 0xb4, 0x71, 0xb8, 0x49, 0x1c, 0x27, 0xda, 0xad, 0x03, 0x28, 0xba, 0xe6,
+	0x09, 0x0e, 0x0d, 0x9f, 0x73, 0x86, 0x27, 0x6f, 0x9f, 0xc0, 0x09, 0x3c, 0x75, 0x70, 0x80, 0x00,
+	0x12, 0x14, 0xb4, 0x09, 0x59, 0x09, 0x98, 0x7e, 0x1d, 0xc0, 0xad, 0x09, 0x5d, 0x8a, 0xa3, 0xcf,
+	0x61, 0xc6, 0x21, 0xcf, 0x5a, 0xc4, 0x36, 0x19, 0xf1, 0x3d, 0x65, 0xa4, 0x6c, 0x77, 0xcb, 0x43,
+	0xa3, 0xb0, 0x9d, 0x48, 0x09, 0x48, 0xd1, 0xed, 0xca, 0x4e, 0x17, 0x95, 0x1f, 0xc4, 0x47, 0xc4,
+	0x71, 0x0c, 0xb5, 0x7d, 0x19, 0xb1, 0x7d, 0xc0, 0x49, 0x2b, 0x72, 0x0b, 0xc5, 0xe9, 0xc1, 0xe3,
+	0x73, 0x84, 0xb1, 0xd8, 0x45, 0xc4, 0x4f, 0x8f, 0x13, 0x1c, 0x6e, 0x62, 0xcc, 0x99, 0x2c, 0x66,
+	0xe6, 0x24, 0x93, 0x45, 0xcc, 0xf7, 0x00, 0x31, 0x9f, 0x99, 0x8e, 0xc1, 0xd1, 0xb0, 0x6d, 0x08,
+	0xa9, 0x4a, 0x5e, 0x68, 0x28, 0x0b, 0xce, 0xa6, 0x60, 0xec, 0x70, 0x7a, 0xcf, 0x6a, 0x01, 0x53,
+	0x29, 0xf4, 0xac, 0xde, 0xe7, 0xf4, 0xd5, 0x12, 0x14, 0x59, 0xb2, 0x6b, 0xd5, 0x5f, 0xa5, 0x01,
+	0xf5, 0x3a, 0x8c, 0x3e, 0x03, 0x88, 0x1c, 0xc6, 0x57, 0xab, 0xbf, 0x68, 0x87, 0x13, 0x38, 0x74,
+	0x1b, 0xa6, 0xf8, 0xad, 0xce, 0xe0, 0xad, 0x3b, 0x2a, 0xb9, 0x92, 0x0e, 0x9c, 0xd6, 0x30, 0x49,
+	0x58, 0xb7, 0x7b, 0xae, 0x68, 0x99, 0xde, 0x2b, 0xda, 0xb7, 0x01, 0xa4, 0xd7, 0x94, 0x9c, 0x63,
+	0x55, 0x3c, 0x93, 0x82, 0xb2, 0x47, 0xce, 0x31, 0xba, 0x01, 0x39, 0x42, 0x8d, 0xc3, 0xd6, 0x99,
+	0x88, 0x7c, 0x41, 0xcf, 0x12, 0xba, 0xda, 0x3a, 0xe3, 0xcd, 0x99, 0xb6, 0x0e, 0x19, 0xb1, 0x4e,
+	0xa8, 0x88, 0xba, 0xa6, 0xc7, 0xdf, 0xd5, 0xff, 0xa4, 0xe1, 0x66, 0x62, 0x79, 0xe7, 0xc9, 0xf5,
+	0x74, 0x9c, 0xbd, 0xb4, 0xab, 0x93, 0x9e, 0xc3, 0xa2, 0xbc, 0x42, 0xd8, 0x46, 0xe2, 0x74, 0xe0,
+	0x53, 0xc2, 0x37, 0x84, 0x56, 0x32, 0xe2, 0x3a, 0xf6, 0xa3, 0x91, 0x35, 0x35, 0x22, 0x8c, 0x86,
+	0x82, 0xd0, 0xe7, 0x15, 0x7c, 0x0f, 0x87, 0x22, 0x0f, 0x6e, 0x46, 0xba, 0x65, 0x87, 0x4a, 0xf4,
+	0x6a, 0x42, 0xef, 0x0f, 0x47, 0xd6, 0xbb, 0xc2, 0xe5, 0x63, 0x9d, 0x37, 0x14, 0x6c, 0x07, 0x95,
+	0x3e, 0xd4, 0x0a, 0xe9, 0x72, 0xa6, 0xfa, 0xc5, 0x35, 0x98, 0xdd, 0x63, 0x26, 0xc3, 0x47, 0x2d,
+	0x47, 0x64, 0x5c, 0x14, 0x66, 0x17, 0x8a, 0x22, 0x2d, 0x8d, 0xc0, 0x31, 0xad, 0xe8, 0x3c, 0x7c,
+	0x38, 0xbc, 0x67, 0xf5, 0xc1, 0xe9, 0x24, 0x36, 0x38, 0x96, 0x1b, 0x5d, 0x5b, 0xc0, 0x8f, 0x69,
+	0xc8, 0x87, 0x92, 0x54, 0xa7, 0xde, 0x26, 0xaa, 0x3d, 0x6c, 0x5d, 0x51, 0xa1, 0x2e, 0xd1, 0xe4,
+	0x2d, 0xc9, 0x6f, 0xa3, 0xa0, 0xdf, 0xa6, 0x60, 0xd1, 0xf2, 0x3d, 0x5b, 0x44, 0xc3, 0x74, 0x8c,
+	0x36, 0x67, 0xb9, 0x81, 0xaa, 0xd7, 0xef, 0x5c, 0x5e, 0xff, 0x5a, 0x02, 0xda, 0xc7, 0xe7, 0x79,
+	0x6b, 0x10, 0x7b, 0x80, 0x45, 0x2c, 0x24, 0xcd, 0x26, 0x0e, 0xb1, 0x5d, 0xc9, 0x8d, 0xcb, 0xa2,
+	0xfd, 0x08, 0xb2, 0xbf, 0x45, 0x31, 0x1b, 0xfd, 0x32, 0x05, 0xf3, 0x8e, 0xef, 0x35, 0x0d, 0x86,
+	0x43, 0xb7, 0x27, 0x42, 0xf9, 0xd7, 0x4d, 0x89, 0x6d, 0xdf, 0x6b, 0xee, 0xe3, 0xd0, 0xed, 0x13,
+	0x9e, 0x39, 0xa7, 0x2f, 0x0f, 0xfd, 0x35, 0x05, 0xdf, 0x1b, 0x18, 0x1b, 0x23, 0xea, 0x1b, 0xd1,
+	0xfd, 0xbf, 0x20, 0x2c, 0x7b, 0x32, 0xb6, 0x48, 0xed, 0x29, 0xfc, 0xe8, 0x8d, 0xb5, 0x35, 0xa1,
+	0xbf, 0x6d, 0x8d, 0xb2, 0x14, 0xfd, 0x26, 0x05, 0x8b, 0xdd, 0x11, 0x0c, 0x71, 0x12, 0xc3, 0x49,
+	0x61, 0xe9, 0xf6, 0x15, 0x63, 0xa8, 0x27, 0x88, 0xc2, 0xb8, 0x8a, 0x33, 0x80, 0xbb, 0xf0, 0x33,
+	0xa8, 0x0c, 0x2a, 0x48, 0xb4, 0x1e, 0x9d, 0xf6, 0xaf, 0x75, 0x7d, 0x50, 0x67, 0xfd, 0xc2, 0x97,
+	0x29, 0x98, 0xeb, 0x5f, 0x82, 0xe8, 0x29, 0x94, 0x45, 0x75, 0x63, 0x5b, 0x45, 0x22, 0x6e, 0xde,
+	0x77, 0x2f, 0xa7, 0xab, 0x6e, 0xeb, 0xd3, 0x0a, 0x49, 0x7d, 0xa3, 0x0f, 0x21, 0x27, 0xa7, 0x19,
+	0xea, 0xa1, 0x3b, 0xe0, 0x5e, 0x21, 0x07, 0x20, 0xb5, 0x76, 0xc3, 0x74, 0x21, 0xa6, 0x2b, 0xf1,
+	0x05, 0x0b, 0x16, 0x87, 0x54, 0xf0, 0x98, 0x82, 0xf4, 0x8b, 0x5e, 0x25, 0x6d, 0x45, 0x89, 0x3e,
+	0x07, 0x14, 0x97, 0xfd, 0xd5, 0x43, 0x55, 0x8e, 0xb1, 0x14, 0x85, 0x67, 0xc1, 0xa0, 0x1a, 0x1c,
+	0x93, 0x83, 0x5f, 0xa5, 0xe0, 0xbb, 0x23, 0x16, 0x13, 0xfa, 0x08, 0x0a, 0x57, 0xf6, 0x31, 0xef,
+	0xcb, 0x1f, 0xe8, 0x23, 0xa8, 0x5e, 0xdc, 0x27, 0x44, 0x8e, 0x68, 0xfa, 0xad, 0x0b, 0x6a, 0x78,
+	0xe1, 0x10, 0x16, 0x06, 0xd7, 0xd9, 0x78, 0x22, 0x15, 0xbf, 0xd6, 0xe5, 0x79, 0xfc, 0x50, 0x2b,
+	0x64, 0xca, 0x5a, 0xf5, 0x0f, 0x29, 0x40, 0xe2, 0xb8, 0xee, 0x7c, 0x13, 0x4f, 0x43, 0x3a, 0x9e,
+	0x7e, 0xa4, 0x89, 0x78, 0xb1, 0xd0, 0x33, 0xf7, 0xd0, 0x77, 0xe4, 0xbb, 0x4f, 0x57, 0x5f, 0xfc,
+	0x42, 0x76, 0x6c, 0x52, 0x43, 0x4e, 0x05, 0xc4, 0x8d, 0xad, 0xa0, 0x4f, 0x1e, 0x9b, 0x54, 0x3e,
+	0x58, 0x3b, 0x67, 0x29, 0x5a, 0xd7, 0x2c, 0xe5, 0x5d, 0x98, 0x31, 0x99, 0xef, 0x12, 0xcb, 0x08,
+	0x31, 0xf5, 0x9d, 0x16, 0x0f, 0x8f, 0x38, 0x0c, 0x67, 0xf4, 0xb2, 0x64, 0xe8, 0x31, 0xbd, 0xfa,
+	0x65, 0x06, 0xbe, 0x15, 0x5f, 0x65, 0xfa, 0xbd, 0xe2, 0xbb, 0x2d, 0xbe, 0xf8, 0xbe, 0x39, 0x07,
+	0x39, 0x1e, 0x7c, 0x1c, 0x0a, 0xbb, 0x27, 0x75, 0xf5, 0x35, 0xdc, 0xe8, 0x2d, 0xc8, 0x51, 0x66,
+	0xb2, 0x16, 0xad, 0x64, 0x87, 0x8d, 0xb9, 0xda, 0xf7, 0x62, 0x4d, 0xa9, 0xdc, 0x13, 0x72, 0xba,
+	0x92, 0x47, 0x3f, 0x86, 0xc5, 0x67, 0x2d, 0xd3, 0x63, 0x2d, 0xd7, 0xb0, 0x7c, 0xef, 0x14, 0x87,
+	0x94, 0xbf, 0x58, 0xe2, 0x29, 0x42, 0x4e, 0x04, 0x62, 0x5e, 0x2d, 0x59, 0x8b, 0x57, 0x44, 0x73,
+	0x92, 0xfe, 0xe1, 0xcb, 0xf7, 0x0f, 0x1f, 0x9f, 0x6d, 0xc6, 0x47, 0x57, 0xc0, 0xf3, 0x94, 0x58,
+	0x27, 0xe2, 0xf0, 0x2a, 0xe9, 0xd7, 0x22, 0x46, 0x03, 0x87, 0xfb, 0xc4, 0x3a, 0xe1, 0x4f, 0x0b,
+	0xca, 0x70, 0x60, 0xf0, 0x09, 0x83, 0xa1, 0xf4, 0x53, 0x71, 0x7e, 0x68, 0x7a, 0x99, 0x73, 0xf8,
+	0x1c, 0xe2, 0x63, 0x45, 0x47, 0x6f, 0xc3, 0xb4, 0xbc, 0xe5, 0x13, 0x76, 0x66, 0x30, 0x82, 0xc3,
+	0x0a, 0x08, 0xd8, 0x52, 0x4c, 0xdd, 0x27, 0x38, 0xac, 0xbe, 0x48, 0xc1, 0xc2, 0x76, 0x3b, 0xe5,
+	0x20, 0xa0, 0x38, 0x64, 0x83, 0x76, 0x0f, 0x81, 0xe6, 0x99, 0x2e, 0x56, 0xd9, 0x26, 0x7e, 0x73,
+	0xbb, 0x88, 0x47, 0x18, 0x31, 0x1d, 0x9e, 0x6f, 0x4d, 0x3e, 0xfa, 0x09, 0x5c, 0xf5, 0x4a, 0x28,
+	0x2b, 0xce, 0x8e, 0x60, 0xf0, 0x89, 0xe8, 0x07, 0x50, 0x71, 0x4d, 0xe2, 0x31, 0xec, 0x99, 0x9e,
+	0x85, 0x8d, 0xa3, 0xd0, 0xb4, 0xc4, 0x93, 0x30, 0x9a, 0x9f, 0x96, 0xf4, 0xb9, 0x36, 0xfe, 0xa6,
+	0x62, 0x73, 0xc9, 0x07, 0x30, 0x27, 0x5c, 0x8f, 0x6e, 0xc5, 0x86, 0xe7, 0xcb, 0xca, 0x15, 0x5b,
+	0xae, 0xe9, 0xb3, 0x9c, 0x1b, 0xdd, 0x6e, 0x77, 0x15, 0xaf, 0xfa, 0xfb, 0x34, 0xdc, 0x90, 0x8d,
+	0x26, 0xda, 0xef, 0xc8, 0xb7, 0xee, 0x4c, 0x4c, 0xf5, 0x64, 0x62, 0x92, 0x54, 0xe9, 0x37, 0x9b,
+	0x54, 0x99, 0x8b, 0x92, 0xaa, 0x6f, 0x9e, 0x68, 0x97, 0xc9, 0x93, 0x6c, 0xff, 0x3c, 0xa9, 0xfe,
+	0x39, 0x05, 0x73, 0x32, 0x3e, 0x71, 0x19, 0x0f, 0x69, 0x36, 0xaa, 0x30, 0xd3, 0x83, 0x0b, 0x33,
+	0x33, 0x4a, 0x37, 0xd1, 0x06, 0x94, 0x43, 0x6f, 0xd2, 0x66, 0xfb, 0x25, 0xed, 0x7f, 0xf9, 0xe3,
+	0x30, 0xf0, 0x59, 0xbf, 0x7e, 0x73, 0xf1, 0xae, 0x56, 0xa1, 0x24, 0x42, 0x13, 0x4f, 0x81, 0x64,
+	0x0b, 0x2a, 0x72, 0xe2, 0x8a, 0x9a, 0x04, 0x7d, 0x07, 0xa6, 0x9f, 0xb5, 0x7c, 0xd6, 0xb6, 0x48,
+	0xfa, 0x35, 0x25, 0xa8, 0xd1, 0xaa, 0x24, 0x3f, 0xb4, 0x37, 0x9b, 0x1f, 0xd9, 0xd7, 0xca, 0x8f,
+	0xdc, 0x65, 0xf2, 0x23, 0x3f, 0x20, 0x3f, 0x7e, 0xad, 0xc1, 0xf5, 0x75, 0xec, 0xe0, 0x53, 0x1c,
+	0x9a, 0xcd, 0xb6, 0xff, 0x51, 0xbc, 0xd1, 0xa1, 0xc4, 0x67, 0x00, 0xfe, 0xd1, 0x11, 0xc5, 0x8c,
+	0x11, 0xaf, 0x59, 0x49, 0x8f, 0x01, 0x3c, 0x81, 0x1b, 0x65, 0x9e, 0xd1, 0x35, 0x4e, 0xd2, 0x7a,
+	0xc6, 0x49, 0x76, 0xdb, 0xe8, 0x22, 0x3b, 0xe6, 0x7f, 0x31, 0xc5, 0xc8, 0xe8, 0x1c, 0x66, 0xe5,
+	0x58, 0x45, 0xe6, 0x62, 0xbc, 0x57, 0xb9, 0x31, 0x6b, 0x94, 0x23, 0xab, 0x8f, 0xb9, 0x92, 0xf8,
+	0xfc, 0x48, 0x66, 0x36, 0xf9, 0xb6, 0x99, 0x4d, 0xf5, 0x6f, 0x19, 0x98, 0xe5, 0xa5, 0xf7, 0xff,
+	0x1a, 0x40, 0x1e, 0x74, 0x4c, 0xc8, 0x2a, 0xe9, 0xab, 0xc0, 0xb2, 0x04, 0xb6, 0xa7, 0x07, 0x64,
+	0x46, 0xe9, 0x01, 0x5a, 0x9f, 0x1e, 0xd0, 0x95, 0x2a, 0xd9, 0xe1, 0x93, 0xc7, 0xdc, 0xb0, 0xc9,
+	0x63, 0x7e, 0xa4, 0xc9, 0x63, 0xe1, 0x52, 0x93, 0xc7, 0xc9, 0x01, 0x93, 0x47, 0xfd, 0xeb, 0x97,
+	0x4b, 0xa9, 0x6f, 0x5e, 0x2e, 0xa5, 0xfe, 0xfd, 0x72, 0x29, 0xf5, 0xbb, 0x57, 0x4b, 0x13, 0xdf,
+	0xbc, 0x5a, 0x9a, 0xf8, 0xe7, 0xab, 0xa5, 0x89, 0xa7, 0x1f, 0x8c, 0x9e, 0x4e, 0x9d, 0xff, 0xcc,
+	0x3e, 0xcc, 0x09, 0xc6, 0xf7, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x08, 0xdd, 0xce, 0xf2,
+	0x1e, 0x00, 0x00,
+}
+
+func (m *FundingUpdateV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2276,32 +2573,50 @@ func (m *MarketBaseEventV1) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketBaseEventV1) MarshalTo(dAtA []byte) (int, error) {
+func (m *FundingUpdateV1) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketBaseEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FundingUpdateV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinPriceChangePpm != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MinPriceChangePpm))
+	if m.InterestPpm != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InterestPpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PremiumPpm != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PremiumPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.FundingIndex.Size()
+		i -= size
+		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FundingValuePpm != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FundingValuePpm))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Pair)))
+	if m.PerpetualId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PerpetualId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MarketCreateEventV1) Marshal() (dAtA []byte, err error) {
+func (m *FundingEventV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2311,25 +2626,230 @@ func (m *MarketCreateEventV1) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketCreateEventV1) MarshalTo(dAtA []byte) (int, error) {
+func (m *FundingEventV1) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketCreateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FundingEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Exponent != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64((uint32(m.Exponent)<<1)^uint32((m.Exponent>>31))))
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Base != nil {
-		{
-			size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketEventV1_PriceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketEventV1_PriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PriceUpdate != nil {
+		{
+			size, err := m.PriceUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MarketEventV1_MarketCreate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketEventV1_MarketCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MarketCreate != nil {
+		{
+			size, err := m.MarketCreate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MarketEventV1_MarketModify) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketEventV1_MarketModify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MarketModify != nil {
+		{
+			size, err := m.MarketModify.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MarketPriceUpdateEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPriceUpdateEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPriceUpdateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriceWithExponent != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PriceWithExponent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketBaseEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketBaseEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketBaseEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinPriceChangePpm != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MinPriceChangePpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketCreateEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketCreateEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketCreateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64((uint32(m.Exponent)<<1)^uint32((m.Exponent>>31))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Base != nil {
+		{
+			size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -2896,6 +3416,48 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacement) MarshalToSizedBuffer(dAtA
 	}
 	return len(dAtA) - i, nil
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConditionalOrderTriggerSubticksUpdate != nil {
+		{
+			size, err := m.ConditionalOrderTriggerSubticksUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LongTermOrderReplacement != nil {
+		{
+			size, err := m.LongTermOrderReplacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3076,7 +3638,7 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func (m *AssetCreateEventV1) Marshal() (dAtA []byte, err error) {
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3086,29 +3648,104 @@ func (m *AssetCreateEventV1) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssetCreateEventV1) MarshalTo(dAtA []byte) (int, error) {
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetCreateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AtomicResolution != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64((uint32(m.AtomicResolution)<<1)^uint32((m.AtomicResolution>>31))))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+	if m.ConditionalOrderTriggerSubticks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 	}
-	if m.HasMarket {
-		i--
-		if m.HasMarket {
+	if m.OrderId != nil {
+		{
+			size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetCreateEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetCreateEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetCreateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AtomicResolution != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64((uint32(m.AtomicResolution)<<1)^uint32((m.AtomicResolution>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HasMarket {
+		i--
+		if m.HasMarket {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -3354,148 +3991,373 @@ func (m *UpdatePerpetualEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *FundingUpdateV1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PerpetualId != 0 {
-		n += 1 + sovEvents(uint64(m.PerpetualId))
-	}
-	if m.FundingValuePpm != 0 {
-		n += 1 + sovEvents(uint64(m.FundingValuePpm))
+func (m *SpotMarketCreateEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.FundingIndex.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FundingEventV1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
-	}
-	if m.Type != 0 {
-		n += 1 + sovEvents(uint64(m.Type))
-	}
-	return n
+func (m *SpotMarketCreateEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketEventV1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SpotMarketCreateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	if m.Event != nil {
-		n += m.Event.Size()
+	if m.StepBaseQuantums != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StepBaseQuantums))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *MarketEventV1_PriceUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SubticksPerTick != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SubticksPerTick))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.PriceUpdate != nil {
-		l = m.PriceUpdate.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.QuantumConversionExponent != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64((uint32(m.QuantumConversionExponent)<<1)^uint32((m.QuantumConversionExponent>>31))))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-func (m *MarketEventV1_MarketCreate) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.MarketCreate != nil {
-		l = m.MarketCreate.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.QuoteAssetId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QuoteAssetId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *MarketEventV1_MarketModify) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BaseAssetId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BaseAssetId))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.MarketModify != nil {
-		l = m.MarketModify.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ClobPairId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MarketPriceUpdateEventV1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PriceWithExponent != 0 {
-		n += 1 + sovEvents(uint64(m.PriceWithExponent))
+
+func (m *DeleveragingEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MarketBaseEventV1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MinPriceChangePpm != 0 {
-		n += 1 + sovEvents(uint64(m.MinPriceChangePpm))
-	}
-	return n
+func (m *DeleveragingEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketCreateEventV1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleveragingEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Base != nil {
-		l = m.Base.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Exponent != 0 {
-		n += 1 + sozEvents(uint64(m.Exponent))
+	{
+		size := m.TotalQuoteQuantums.Size()
+		i -= size
+		if _, err := m.TotalQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MarketModifyEventV1) Size() (n int) {
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Subticks.Size()
+		i -= size
+		if _, err := m.Subticks.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FillAmount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FillAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Offsetting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Liquidated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotOrderFillEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotOrderFillEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotOrderFillEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalFilledTaker != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalFilledTaker))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TotalFilledMaker != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalFilledMaker))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TakerFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64((uint64(m.TakerFee)<<1)^uint64((m.TakerFee>>63))))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MakerFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64((uint64(m.MakerFee)<<1)^uint64((m.MakerFee>>63))))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FillAmount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FillAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QuoteAssetId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QuoteAssetId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseAssetId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BaseAssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.TakerOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MakerOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FundingUpdateV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovEvents(uint64(m.PerpetualId))
+	}
+	if m.FundingValuePpm != 0 {
+		n += 1 + sovEvents(uint64(m.FundingValuePpm))
+	}
+	l = m.FundingIndex.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PremiumPpm != 0 {
+		n += 1 + sovEvents(uint64(m.PremiumPpm))
+	}
+	if m.InterestPpm != 0 {
+		n += 1 + sovEvents(uint64(m.InterestPpm))
+	}
+	return n
+}
+
+func (m *FundingEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *MarketEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *MarketEventV1_PriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceUpdate != nil {
+		l = m.PriceUpdate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *MarketEventV1_MarketCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketCreate != nil {
+		l = m.MarketCreate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *MarketEventV1_MarketModify) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketModify != nil {
+		l = m.MarketModify.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *MarketPriceUpdateEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceWithExponent != 0 {
+		n += 1 + sovEvents(uint64(m.PriceWithExponent))
+	}
+	return n
+}
+
+func (m *MarketBaseEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MinPriceChangePpm != 0 {
+		n += 1 + sovEvents(uint64(m.MinPriceChangePpm))
+	}
+	return n
+}
+
+func (m *MarketCreateEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Base != nil {
+		l = m.Base.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sozEvents(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *MarketModifyEventV1) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3749,13 +4611,37 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacement) Size() (n int) {
 	}
 	return n
 }
-func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Size() (n int) {
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Order != nil {
+	if m.ConditionalOrderTriggerSubticksUpdate != nil {
+		l = m.ConditionalOrderTriggerSubticksUpdate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LongTermOrderReplacement != nil {
+		l = m.LongTermOrderReplacement.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
 		l = m.Order.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -3817,6 +4703,35 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Size() (n int) {
 	return n
 }
 
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != nil {
+		l = m.OrderId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ConditionalOrderTriggerSubticks != 0 {
+		n += 1 + sovEvents(uint64(m.ConditionalOrderTriggerSubticks))
+	}
+	return n
+}
+
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *AssetCreateEventV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3928,41 +4843,737 @@ func (m *UpdateClobPairEventV1) Size() (n int) {
 	if m.StepBaseQuantums != 0 {
 		n += 1 + sovEvents(uint64(m.StepBaseQuantums))
 	}
-	return n
-}
+	return n
+}
+
+func (m *UpdatePerpetualEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	if m.AtomicResolution != 0 {
+		n += 1 + sozEvents(uint64(m.AtomicResolution))
+	}
+	if m.LiquidityTier != 0 {
+		n += 1 + sovEvents(uint64(m.LiquidityTier))
+	}
+	return n
+}
+
+func (m *SpotMarketCreateEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovEvents(uint64(m.ClobPairId))
+	}
+	if m.BaseAssetId != 0 {
+		n += 1 + sovEvents(uint64(m.BaseAssetId))
+	}
+	if m.QuoteAssetId != 0 {
+		n += 1 + sovEvents(uint64(m.QuoteAssetId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.QuantumConversionExponent != 0 {
+		n += 1 + sozEvents(uint64(m.QuantumConversionExponent))
+	}
+	if m.SubticksPerTick != 0 {
+		n += 1 + sovEvents(uint64(m.SubticksPerTick))
+	}
+	if m.StepBaseQuantums != 0 {
+		n += 1 + sovEvents(uint64(m.StepBaseQuantums))
+	}
+	return n
+}
+
+func (m *DeleveragingEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Liquidated.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Offsetting.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PerpetualId != 0 {
+		n += 1 + sovEvents(uint64(m.PerpetualId))
+	}
+	if m.FillAmount != 0 {
+		n += 1 + sovEvents(uint64(m.FillAmount))
+	}
+	l = m.Subticks.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.TotalQuoteQuantums.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.IsBuy {
+		n += 2
+	}
+	return n
+}
+
+func (m *SpotOrderFillEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerOrder.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.TakerOrder.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BaseAssetId != 0 {
+		n += 1 + sovEvents(uint64(m.BaseAssetId))
+	}
+	if m.QuoteAssetId != 0 {
+		n += 1 + sovEvents(uint64(m.QuoteAssetId))
+	}
+	if m.FillAmount != 0 {
+		n += 1 + sovEvents(uint64(m.FillAmount))
+	}
+	if m.MakerFee != 0 {
+		n += 1 + sozEvents(uint64(m.MakerFee))
+	}
+	if m.TakerFee != 0 {
+		n += 1 + sozEvents(uint64(m.TakerFee))
+	}
+	if m.TotalFilledMaker != 0 {
+		n += 1 + sovEvents(uint64(m.TotalFilledMaker))
+	}
+	if m.TotalFilledTaker != 0 {
+		n += 1 + sovEvents(uint64(m.TotalFilledTaker))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingUpdateV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingUpdateV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingValuePpm", wireType)
+			}
+			m.FundingValuePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingValuePpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingIndex", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumPpm", wireType)
+			}
+			m.PremiumPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PremiumPpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestPpm", wireType)
+			}
+			m.InterestPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestPpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, FundingUpdateV1{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FundingEventV1_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MarketPriceUpdateEventV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &MarketEventV1_PriceUpdate{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketCreate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MarketCreateEventV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &MarketEventV1_MarketCreate{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketModify", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MarketModifyEventV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &MarketEventV1_MarketModify{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketPriceUpdateEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketPriceUpdateEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketPriceUpdateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceWithExponent", wireType)
+			}
+			m.PriceWithExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceWithExponent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketBaseEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketBaseEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketBaseEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceChangePpm", wireType)
+			}
+			m.MinPriceChangePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPriceChangePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *UpdatePerpetualEventV1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	if m.AtomicResolution != 0 {
-		n += 1 + sozEvents(uint64(m.AtomicResolution))
-	}
-	if m.LiquidityTier != 0 {
-		n += 1 + sovEvents(uint64(m.LiquidityTier))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
+func (m *MarketCreateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3985,17 +5596,17 @@ func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingUpdateV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketCreateEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingUpdateV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			m.PerpetualId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4005,16 +5616,33 @@ func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PerpetualId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Base == nil {
+				m.Base = &MarketBaseEventV1{}
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingValuePpm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 			}
-			m.FundingValuePpm = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4024,16 +5652,68 @@ func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FundingValuePpm |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Exponent = v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketModifyEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketModifyEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketModifyEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4043,22 +5723,25 @@ func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Base == nil {
+				m.Base = &MarketBaseEventV1{}
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4083,7 +5766,7 @@ func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingEventV1) Unmarshal(dAtA []byte) error {
+func (m *SourceOfFunds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4106,15 +5789,15 @@ func (m *FundingEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceOfFunds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceOfFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4141,16 +5824,17 @@ func (m *FundingEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, FundingUpdateV1{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types.IndexerSubaccountId{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Source = &SourceOfFunds_SubaccountId{v}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4160,11 +5844,24 @@ func (m *FundingEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= FundingEventV1_Type(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = &SourceOfFunds_Address{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4186,7 +5883,7 @@ func (m *FundingEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketEventV1) Unmarshal(dAtA []byte) error {
+func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4209,17 +5906,17 @@ func (m *MarketEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderSubaccountId", wireType)
 			}
-			m.MarketId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4229,14 +5926,31 @@ func (m *MarketEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SenderSubaccountId == nil {
+				m.SenderSubaccountId = &types.IndexerSubaccountId{}
+			}
+			if err := m.SenderSubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientSubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4263,15 +5977,54 @@ func (m *MarketEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MarketPriceUpdateEventV1{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RecipientSubaccountId == nil {
+				m.RecipientSubaccountId = &types.IndexerSubaccountId{}
+			}
+			if err := m.RecipientSubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &MarketEventV1_PriceUpdate{v}
-			iNdEx = postIndex
-		case 3:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4298,15 +6051,16 @@ func (m *MarketEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MarketCreateEventV1{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Sender == nil {
+				m.Sender = &SourceOfFunds{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &MarketEventV1_MarketCreate{v}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketModify", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4333,11 +6087,12 @@ func (m *MarketEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MarketModifyEventV1{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Recipient == nil {
+				m.Recipient = &SourceOfFunds{}
+			}
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &MarketEventV1_MarketModify{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4360,7 +6115,7 @@ func (m *MarketEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketPriceUpdateEventV1) Unmarshal(dAtA []byte) error {
+func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4383,17 +6138,17 @@ func (m *MarketPriceUpdateEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketPriceUpdateEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderFillEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketPriceUpdateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderFillEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceWithExponent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrder", wireType)
 			}
-			m.PriceWithExponent = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4403,66 +6158,30 @@ func (m *MarketPriceUpdateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceWithExponent |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketBaseEventV1) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MakerOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketBaseEventV1: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketBaseEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4472,29 +6191,32 @@ func (m *MarketBaseEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pair = string(dAtA[iNdEx:postIndex])
+			v := &types.IndexerOrder{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.TakerOrder = &OrderFillEventV1_Order{v}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceChangePpm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
 			}
-			m.MinPriceChangePpm = 0
+			m.FillAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4504,64 +6226,14 @@ func (m *MarketBaseEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinPriceChangePpm |= uint32(b&0x7F) << shift
+				m.FillAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketCreateEventV1) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketCreateEventV1: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4588,18 +6260,17 @@ func (m *MarketCreateEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Base == nil {
-				m.Base = &MarketBaseEventV1{}
-			}
-			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &LiquidationOrderV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.TakerOrder = &OrderFillEventV1_LiquidationOrder{v}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
 			}
-			var v int32
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4609,68 +6280,18 @@ func (m *MarketCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.Exponent = v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketModifyEventV1) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketModifyEventV1: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketModifyEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.MakerFee = int64(v)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
-			var msglen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4680,28 +6301,51 @@ func (m *MarketModifyEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.TakerFee = int64(v)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFilledMaker", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TotalFilledMaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalFilledMaker |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Base == nil {
-				m.Base = &MarketBaseEventV1{}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFilledTaker", wireType)
 			}
-			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TotalFilledTaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalFilledTaker |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4723,7 +6367,7 @@ func (m *MarketModifyEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceOfFunds) Unmarshal(dAtA []byte) error {
+func (m *LiquidationOrderV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4746,15 +6390,15 @@ func (m *SourceOfFunds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceOfFunds: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationOrderV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceOfFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationOrderV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4781,17 +6425,15 @@ func (m *SourceOfFunds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v1.IndexerSubaccountId{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liquidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Source = &SourceOfFunds_SubaccountId{v}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
 			}
-			var stringLen uint64
+			m.ClobPairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4801,24 +6443,88 @@ func (m *SourceOfFunds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClobPairId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subticks", wireType)
+			}
+			m.Subticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Subticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Source = &SourceOfFunds_Address{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4840,7 +6546,7 @@ func (m *SourceOfFunds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
+func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4863,51 +6569,15 @@ func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubaccountUpdateEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubaccountUpdateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderSubaccountId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SenderSubaccountId == nil {
-				m.SenderSubaccountId = &v1.IndexerSubaccountId{}
-			}
-			if err := m.SenderSubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientSubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4934,54 +6604,16 @@ func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecipientSubaccountId == nil {
-				m.RecipientSubaccountId = &v1.IndexerSubaccountId{}
+			if m.SubaccountId == nil {
+				m.SubaccountId = &types.IndexerSubaccountId{}
 			}
-			if err := m.RecipientSubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
-			}
-			m.AssetId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AssetId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedPerpetualPositions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5008,16 +6640,14 @@ func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sender == nil {
-				m.Sender = &SourceOfFunds{}
-			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UpdatedPerpetualPositions = append(m.UpdatedPerpetualPositions, &types.IndexerPerpetualPosition{})
+			if err := m.UpdatedPerpetualPositions[len(m.UpdatedPerpetualPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAssetPositions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5042,12 +6672,10 @@ func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Recipient == nil {
-				m.Recipient = &SourceOfFunds{}
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UpdatedAssetPositions = append(m.UpdatedAssetPositions, &types.IndexerAssetPosition{})
+			if err := m.UpdatedAssetPositions[len(m.UpdatedAssetPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5072,7 +6700,7 @@ func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
+func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5095,15 +6723,15 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderFillEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatefulOrderEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderFillEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatefulOrderEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPlace", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5130,13 +6758,15 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &StatefulOrderEventV1_StatefulOrderPlacementV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Event = &StatefulOrderEventV1_OrderPlace{v}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderRemoval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5163,17 +6793,17 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v1.IndexerOrder{}
+			v := &StatefulOrderEventV1_StatefulOrderRemovalV1{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.TakerOrder = &OrderFillEventV1_Order{v}
+			m.Event = &StatefulOrderEventV1_OrderRemoval{v}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderPlacement", wireType)
 			}
-			m.FillAmount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5183,14 +6813,30 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FillAmount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StatefulOrderEventV1_ConditionalOrderPlacementV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_ConditionalOrderPlacement{v}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggered", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5217,17 +6863,17 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LiquidationOrderV1{}
+			v := &StatefulOrderEventV1_ConditionalOrderTriggeredV1{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.TakerOrder = &OrderFillEventV1_LiquidationOrder{v}
+			m.Event = &StatefulOrderEventV1_ConditionalOrderTriggered{v}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongTermOrderPlacement", wireType)
 			}
-			var v uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5237,39 +6883,32 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-			m.MakerFee = int64(v)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-			m.TakerFee = int64(v)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFilledMaker", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TotalFilledMaker = 0
+			v := &StatefulOrderEventV1_LongTermOrderPlacementV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_LongTermOrderPlacement{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggerSubticksUpdate", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5279,16 +6918,32 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalFilledMaker |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFilledTaker", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.TotalFilledTaker = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongTermOrderReplacement", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5298,11 +6953,27 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalFilledTaker |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StatefulOrderEventV1_LongTermOrderReplacementV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_LongTermOrderReplacement{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5324,7 +6995,7 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidationOrderV1) Unmarshal(dAtA []byte) error {
+func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5347,15 +7018,15 @@ func (m *LiquidationOrderV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidationOrderV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatefulOrderPlacementV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidationOrderV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatefulOrderPlacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5382,106 +7053,13 @@ func (m *LiquidationOrderV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Liquidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
-			}
-			m.ClobPairId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClobPairId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
-			}
-			m.PerpetualId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PerpetualId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
-			}
-			m.TotalSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsBuy = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subticks", wireType)
+			if m.Order == nil {
+				m.Order = &types.IndexerOrder{}
 			}
-			m.Subticks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Subticks |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5503,7 +7081,7 @@ func (m *LiquidationOrderV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
+func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5526,15 +7104,15 @@ func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountUpdateEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatefulOrderRemovalV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountUpdateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatefulOrderRemovalV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedOrderId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5561,18 +7139,18 @@ func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubaccountId == nil {
-				m.SubaccountId = &v1.IndexerSubaccountId{}
+			if m.RemovedOrderId == nil {
+				m.RemovedOrderId = &types.IndexerOrderId{}
 			}
-			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedPerpetualPositions", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5582,29 +7160,64 @@ func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Reason |= types1.OrderRemovalReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedPerpetualPositions = append(m.UpdatedPerpetualPositions, &v1.IndexerPerpetualPosition{})
-			if err := m.UpdatedPerpetualPositions[len(m.UpdatedPerpetualPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrderPlacementV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrderPlacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAssetPositions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5631,8 +7244,10 @@ func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAssetPositions = append(m.UpdatedAssetPositions, &v1.IndexerAssetPosition{})
-			if err := m.UpdatedAssetPositions[len(m.UpdatedAssetPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Order == nil {
+				m.Order = &types.IndexerOrder{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5657,7 +7272,7 @@ func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
+func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5680,15 +7295,15 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatefulOrderEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConditionalOrderTriggeredV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatefulOrderEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConditionalOrderTriggeredV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderPlace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredOrderId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5715,15 +7330,66 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &StatefulOrderEventV1_StatefulOrderPlacementV1{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TriggeredOrderId == nil {
+				m.TriggeredOrderId = &types.IndexerOrderId{}
+			}
+			if err := m.TriggeredOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &StatefulOrderEventV1_OrderPlace{v}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LongTermOrderPlacementV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LongTermOrderPlacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderRemoval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5750,15 +7416,66 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &StatefulOrderEventV1_StatefulOrderRemovalV1{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Order == nil {
+				m.Order = &types.IndexerOrder{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &StatefulOrderEventV1_OrderRemoval{v}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrderTriggerSubticksUpdateV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrderTriggerSubticksUpdateV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderPlacement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5785,17 +7502,18 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &StatefulOrderEventV1_ConditionalOrderPlacementV1{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OrderId == nil {
+				m.OrderId = &types.IndexerOrderId{}
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &StatefulOrderEventV1_ConditionalOrderPlacement{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggered", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggerSubticks", wireType)
 			}
-			var msglen int
+			m.ConditionalOrderTriggerSubticks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5805,30 +7523,64 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConditionalOrderTriggerSubticks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &StatefulOrderEventV1_ConditionalOrderTriggeredV1{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Event = &StatefulOrderEventV1_ConditionalOrderTriggered{v}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LongTermOrderReplacementV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LongTermOrderReplacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LongTermOrderPlacement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5855,11 +7607,12 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &StatefulOrderEventV1_LongTermOrderPlacementV1{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Order == nil {
+				m.Order = &types.IndexerOrder{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &StatefulOrderEventV1_LongTermOrderPlacement{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5882,7 +7635,7 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Unmarshal(dAtA []byte) error {
+func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5905,17 +7658,36 @@ func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatefulOrderPlacementV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetCreateEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatefulOrderPlacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5925,28 +7697,84 @@ func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &v1.IndexerOrder{}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMarket", wireType)
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.HasMarket = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.AtomicResolution = v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5968,7 +7796,7 @@ func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Unmarshal(dAtA []byte) error {
+func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5991,17 +7819,146 @@ func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatefulOrderRemovalV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerpetualMarketCreateEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatefulOrderRemovalV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerpetualMarketCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.ClobPairStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumConversionExponent", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.QuantumConversionExponent = v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6011,33 +7968,56 @@ func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.AtomicResolution = v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubticksPerTick", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SubticksPerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubticksPerTick |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.RemovedOrderId == nil {
-				m.RemovedOrderId = &v1.IndexerOrderId{}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepBaseQuantums", wireType)
 			}
-			if err := m.RemovedOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StepBaseQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepBaseQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
 			}
-			m.Reason = 0
+			m.LiquidityTier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6047,7 +8027,7 @@ func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= shared.OrderRemovalReason(b&0x7F) << shift
+				m.LiquidityTier |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6073,7 +8053,7 @@ func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) Unmarshal(dAtA []byte) error {
+func (m *LiquidityTierUpsertEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6096,17 +8076,36 @@ func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConditionalOrderPlacementV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityTierUpsertEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConditionalOrderPlacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityTierUpsertEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6116,83 +8115,29 @@ func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) Unmarshal(dAtA []byte
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &v1.IndexerOrder{}
-			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConditionalOrderTriggeredV1: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConditionalOrderTriggeredV1: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredOrderId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
 			}
-			var msglen int
+			m.InitialMarginPpm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6202,28 +8147,49 @@ func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) Unmarshal(dAtA []byte
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InitialMarginPpm |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFractionPpm", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaintenanceFractionPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceFractionPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.TriggeredOrderId == nil {
-				m.TriggeredOrderId = &v1.IndexerOrderId{}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePositionNotional", wireType)
 			}
-			if err := m.TriggeredOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BasePositionNotional = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasePositionNotional |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6245,7 +8211,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Unmarshal(dAtA []byte) error {
+func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6268,17 +8234,55 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LongTermOrderPlacementV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateClobPairEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LongTermOrderPlacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateClobPairEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.ClobPairStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumConversionExponent", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6288,28 +8292,51 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.QuantumConversionExponent = v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubticksPerTick", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SubticksPerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubticksPerTick |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Order == nil {
-				m.Order = &v1.IndexerOrder{}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepBaseQuantums", wireType)
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StepBaseQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepBaseQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6331,7 +8358,7 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
+func (m *UpdatePerpetualEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6354,10 +8381,10 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetCreateEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePerpetualEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePerpetualEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6381,7 +8408,7 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6409,13 +8436,13 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Ticker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMarket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var v int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6425,17 +8452,16 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasMarket = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
 			}
-			m.MarketId = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6445,16 +8471,18 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.AtomicResolution = v
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
 			}
-			var v int32
+			m.LiquidityTier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6464,13 +8492,11 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.LiquidityTier |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.AtomicResolution = v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6492,7 +8518,7 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
+func (m *SpotMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6515,32 +8541,13 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualMarketCreateEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotMarketCreateEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualMarketCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotMarketCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
 			}
@@ -6559,11 +8566,11 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetId", wireType)
 			}
-			var stringLen uint64
+			m.BaseAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6573,29 +8580,16 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BaseAssetId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetId", wireType)
 			}
-			m.MarketId = 0
+			m.QuoteAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6605,12 +8599,12 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				m.QuoteAssetId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -6624,12 +8618,12 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= v1.ClobPairStatus(b&0x7F) << shift
+				m.Status |= types.ClobPairStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field QuantumConversionExponent", wireType)
 			}
@@ -6650,28 +8644,7 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 			}
 			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
 			m.QuantumConversionExponent = v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.AtomicResolution = v
-		case 8:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SubticksPerTick", wireType)
 			}
@@ -6690,7 +8663,7 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 9:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StepBaseQuantums", wireType)
 			}
@@ -6709,25 +8682,6 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
-			}
-			m.LiquidityTier = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LiquidityTier |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6749,7 +8703,7 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityTierUpsertEventV1) Unmarshal(dAtA []byte) error {
+func (m *DeleveragingEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6772,17 +8726,102 @@ func (m *LiquidityTierUpsertEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityTierUpsertEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleveragingEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityTierUpsertEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleveragingEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsetting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Offsetting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
 			}
-			m.Id = 0
+			m.FillAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6792,16 +8831,16 @@ func (m *LiquidityTierUpsertEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.FillAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subticks", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6811,29 +8850,30 @@ func (m *LiquidityTierUpsertEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Subticks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalQuoteQuantums", wireType)
 			}
-			m.InitialMarginPpm = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6843,35 +8883,30 @@ func (m *LiquidityTierUpsertEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialMarginPpm |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFractionPpm", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.MaintenanceFractionPpm = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaintenanceFractionPpm |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
 			}
-			m.BasePositionNotional = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6881,11 +8916,12 @@ func (m *LiquidityTierUpsertEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BasePositionNotional |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsBuy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6907,7 +8943,7 @@ func (m *LiquidityTierUpsertEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
+func (m *SpotOrderFillEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6930,17 +8966,17 @@ func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateClobPairEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotOrderFillEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateClobPairEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotOrderFillEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrder", wireType)
 			}
-			m.ClobPairId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6950,16 +8986,30 @@ func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClobPairId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerOrder", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6969,16 +9019,30 @@ func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= v1.ClobPairStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuantumConversionExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetId", wireType)
 			}
-			var v int32
+			m.BaseAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6988,18 +9052,16 @@ func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.BaseAssetId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.QuantumConversionExponent = v
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubticksPerTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetId", wireType)
 			}
-			m.SubticksPerTick = 0
+			m.QuoteAssetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7009,16 +9071,16 @@ func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubticksPerTick |= uint32(b&0x7F) << shift
+				m.QuoteAssetId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepBaseQuantums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
 			}
-			m.StepBaseQuantums = 0
+			m.FillAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7028,85 +9090,16 @@ func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StepBaseQuantums |= uint64(b&0x7F) << shift
+				m.FillAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdatePerpetualEventV1) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePerpetualEventV1: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePerpetualEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7116,29 +9109,18 @@ func (m *UpdatePerpetualEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.MakerFee = int64(v)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
-			m.MarketId = 0
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7148,16 +9130,18 @@ func (m *UpdatePerpetualEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.TakerFee = int64(v)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFilledMaker", wireType)
 			}
-			var v int32
+			m.TotalFilledMaker = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7167,18 +9151,16 @@ func (m *UpdatePerpetualEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.TotalFilledMaker |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.AtomicResolution = v
-		case 5:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFilledTaker", wireType)
 			}
-			m.LiquidityTier = 0
+			m.TotalFilledTaker = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7188,7 +9170,7 @@ func (m *UpdatePerpetualEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LiquidityTier |= uint32(b&0x7F) << shift
+				m.TotalFilledTaker |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
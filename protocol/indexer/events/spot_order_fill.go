@@ -0,0 +1,35 @@
+package events
+
+import (
+	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// NewSpotOrderFillEvent creates a new SpotOrderFillEvent proto message given the maker and taker orders
+// of a spot clob pair along with the fill and fee amounts. Note: This function does no validation of the
+// input maker/taker orders or the fill amount and assumes all such validation has been done before
+// constructing the event.
+func NewSpotOrderFillEvent(
+	makerOrder clobtypes.Order,
+	takerOrder clobtypes.Order,
+	baseAssetId uint32,
+	quoteAssetId uint32,
+	fillAmount satypes.BaseQuantums,
+	makerFee int64,
+	takerFee int64,
+	totalFilledMaker satypes.BaseQuantums,
+	totalFilledTaker satypes.BaseQuantums,
+) *SpotOrderFillEventV1 {
+	return &SpotOrderFillEventV1{
+		MakerOrder:       v1.OrderToIndexerOrder(makerOrder),
+		TakerOrder:       v1.OrderToIndexerOrder(takerOrder),
+		BaseAssetId:      baseAssetId,
+		QuoteAssetId:     quoteAssetId,
+		FillAmount:       fillAmount.ToUint64(),
+		MakerFee:         makerFee,
+		TakerFee:         takerFee,
+		TotalFilledMaker: totalFilledMaker.ToUint64(),
+		TotalFilledTaker: totalFilledTaker.ToUint64(),
+	}
+}
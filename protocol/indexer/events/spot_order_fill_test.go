@@ -0,0 +1,35 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpotOrderFillEvent_Success(t *testing.T) {
+	spotOrderFillEvent := events.NewSpotOrderFillEvent(
+		makerOrder,
+		takerOrder,
+		1,
+		0,
+		fillAmount,
+		makerFee,
+		takerFee,
+		fillAmount,
+		fillAmount,
+	)
+
+	expectedSpotOrderFillEventProto := &events.SpotOrderFillEventV1{
+		MakerOrder:       indexerMakerOrder,
+		TakerOrder:       indexerTakerOrder,
+		BaseAssetId:      1,
+		QuoteAssetId:     0,
+		FillAmount:       fillAmount.ToUint64(),
+		MakerFee:         makerFee,
+		TakerFee:         takerFee,
+		TotalFilledMaker: fillAmount.ToUint64(),
+		TotalFilledTaker: fillAmount.ToUint64(),
+	}
+	require.Equal(t, expectedSpotOrderFillEventProto, spotOrderFillEvent)
+}
@@ -2,7 +2,7 @@ package events
 
 import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
-	"github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 )
 
@@ -20,9 +20,23 @@ func NewLongTermOrderPlacementEvent(
 	}
 }
 
+func NewLongTermOrderReplacementEvent(
+	order clobtypes.Order,
+) *StatefulOrderEventV1 {
+	indexerOrder := v1.OrderToIndexerOrder(order)
+	orderReplace := StatefulOrderEventV1_LongTermOrderReplacementV1{
+		Order: &indexerOrder,
+	}
+	return &StatefulOrderEventV1{
+		Event: &StatefulOrderEventV1_LongTermOrderReplacement{
+			LongTermOrderReplacement: &orderReplace,
+		},
+	}
+}
+
 func NewStatefulOrderRemovalEvent(
 	removedOrderId clobtypes.OrderId,
-	reason shared.OrderRemovalReason,
+	reason sharedtypes.OrderRemovalReason,
 ) *StatefulOrderEventV1 {
 	orderId := v1.OrderIdToIndexerOrderId(removedOrderId)
 	orderRemoval := StatefulOrderEventV1_StatefulOrderRemovalV1{
@@ -63,3 +77,19 @@ func NewConditionalOrderTriggeredEvent(
 		},
 	}
 }
+
+func NewConditionalOrderTriggerSubticksUpdateEvent(
+	orderId clobtypes.OrderId,
+	conditionalOrderTriggerSubticks uint64,
+) *StatefulOrderEventV1 {
+	indexerOrderId := v1.OrderIdToIndexerOrderId(orderId)
+	triggerSubticksUpdate := StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1{
+		OrderId:                         &indexerOrderId,
+		ConditionalOrderTriggerSubticks: conditionalOrderTriggerSubticks,
+	}
+	return &StatefulOrderEventV1{
+		Event: &StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate{
+			ConditionalOrderTriggerSubticksUpdate: &triggerSubticksUpdate,
+		},
+	}
+}
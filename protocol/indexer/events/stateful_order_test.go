@@ -5,7 +5,7 @@ import (
 
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
-	"github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +15,7 @@ var (
 	indexerOrder   = v1.OrderToIndexerOrder(order)
 	orderId        = constants.OrderId_Alice_Num0_ClientId0_Clob0
 	indexerOrderId = v1.OrderIdToIndexerOrderId(orderId)
-	reason         = shared.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED
+	reason         = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED
 )
 
 func TestLongTermOrderPlacementEvent_Success(t *testing.T) {
@@ -30,6 +30,18 @@ func TestLongTermOrderPlacementEvent_Success(t *testing.T) {
 	require.Equal(t, expectedStatefulOrderEventProto, longTermOrderPlacementEvent)
 }
 
+func TestLongTermOrderReplacementEvent_Success(t *testing.T) {
+	longTermOrderReplacementEvent := events.NewLongTermOrderReplacementEvent(order)
+	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
+		Event: &events.StatefulOrderEventV1_LongTermOrderReplacement{
+			LongTermOrderReplacement: &events.StatefulOrderEventV1_LongTermOrderReplacementV1{
+				Order: &indexerOrder,
+			},
+		},
+	}
+	require.Equal(t, expectedStatefulOrderEventProto, longTermOrderReplacementEvent)
+}
+
 func TestStatefulOrderRemovalEvent_Success(t *testing.T) {
 	statefulOrderRemovalEvent := events.NewStatefulOrderRemovalEvent(orderId, reason)
 	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
@@ -66,3 +78,16 @@ func TestConditionalOrderTriggeredEvent_Success(t *testing.T) {
 	}
 	require.Equal(t, expectedStatefulOrderEventProto, conditionalOrderTriggeredEvent)
 }
+
+func TestConditionalOrderTriggerSubticksUpdateEvent_Success(t *testing.T) {
+	triggerSubticksUpdateEvent := events.NewConditionalOrderTriggerSubticksUpdateEvent(orderId, 100)
+	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
+		Event: &events.StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdate{
+			ConditionalOrderTriggerSubticksUpdate: &events.StatefulOrderEventV1_ConditionalOrderTriggerSubticksUpdateV1{
+				OrderId:                         &indexerOrderId,
+				ConditionalOrderTriggerSubticks: 100,
+			},
+		},
+	}
+	require.Equal(t, expectedStatefulOrderEventProto, triggerSubticksUpdateEvent)
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/common"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
 	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -54,6 +56,42 @@ func CreateOrderPlaceMessage(
 	return msgsender.Message{Key: orderIdHash, Value: update}, true
 }
 
+// MustCreateOrderReplaceMessage invokes CreateOrderReplaceMessage and panics if creation was unsuccessful.
+func MustCreateOrderReplaceMessage(
+	logger log.Logger,
+	order clobtypes.Order,
+) msgsender.Message {
+	msg, ok := CreateOrderReplaceMessage(logger, order)
+	if !ok {
+		panic(fmt.Errorf("Unable to create replace order message for order %+v", order))
+	}
+	return msg
+}
+
+// CreateOrderReplaceMessage creates an off-chain update message for an order replacing an existing
+// order with the same order id.
+func CreateOrderReplaceMessage(
+	logger log.Logger,
+	order clobtypes.Order,
+) (message msgsender.Message, success bool) {
+	errMessage := "Error creating off-chain update message for replacing order."
+	errDetails := fmt.Sprintf("Order: %+v", order)
+
+	orderIdHash, err := GetOrderIdHash(order.OrderId)
+	if err != nil {
+		logger.Error(fmt.Sprintf("%s %s Err: %+v %s\n", errMessage, hashErrMsg, err, errDetails))
+		return msgsender.Message{}, false
+	}
+
+	update, err := newOrderReplaceMessage(order)
+	if err != nil {
+		logger.Error(fmt.Sprintf("%s %s Err: %+v %s\n", errMessage, createErrMsg, err, errDetails))
+		return msgsender.Message{}, false
+	}
+
+	return msgsender.Message{Key: orderIdHash, Value: update}, true
+}
+
 // MustCreateOrderUpdateMessage invokes CreateOrderUpdateMessage and panics if creation was unsuccessful.
 func MustCreateOrderUpdateMessage(
 	logger log.Logger,
@@ -96,8 +134,8 @@ func CreateOrderUpdateMessage(
 func MustCreateOrderRemoveMessageWithReason(
 	logger log.Logger,
 	orderId clobtypes.OrderId,
-	reason shared.OrderRemovalReason,
-	removalStatus OrderRemoveV1_OrderRemovalStatus,
+	reason sharedtypes.OrderRemovalReason,
+	removalStatus ocutypes.OrderRemoveV1_OrderRemovalStatus,
 ) msgsender.Message {
 	msg, ok := CreateOrderRemoveMessageWithReason(logger, orderId, reason, removalStatus)
 	if !ok {
@@ -111,8 +149,8 @@ func MustCreateOrderRemoveMessageWithReason(
 func CreateOrderRemoveMessageWithReason(
 	logger log.Logger,
 	orderId clobtypes.OrderId,
-	reason shared.OrderRemovalReason,
-	removalStatus OrderRemoveV1_OrderRemovalStatus,
+	reason sharedtypes.OrderRemovalReason,
+	removalStatus ocutypes.OrderRemoveV1_OrderRemovalStatus,
 ) (message msgsender.Message, success bool) {
 	errMessage := "Error creating off-chain update message for removing order."
 	errDetails := fmt.Sprintf(
@@ -142,7 +180,7 @@ func MustCreateOrderRemoveMessage(logger log.Logger,
 	orderId clobtypes.OrderId,
 	orderStatus clobtypes.OrderStatus,
 	orderError error,
-	removalStatus OrderRemoveV1_OrderRemovalStatus,
+	removalStatus ocutypes.OrderRemoveV1_OrderRemovalStatus,
 ) msgsender.Message {
 	msg, ok := CreateOrderRemoveMessage(logger, orderId, orderStatus, orderError, removalStatus)
 	if !ok {
@@ -158,7 +196,7 @@ func CreateOrderRemoveMessage(
 	orderId clobtypes.OrderId,
 	orderStatus clobtypes.OrderStatus,
 	orderError error,
-	removalStatus OrderRemoveV1_OrderRemovalStatus,
+	removalStatus ocutypes.OrderRemoveV1_OrderRemovalStatus,
 ) (message msgsender.Message, success bool) {
 	errDetails := fmt.Sprintf(
 		"OrderId: %+v, Removal status %d",
@@ -191,10 +229,10 @@ func CreateOrderRemoveMessageWithDefaultReason(
 	orderId clobtypes.OrderId,
 	orderStatus clobtypes.OrderStatus,
 	orderError error,
-	removalStatus OrderRemoveV1_OrderRemovalStatus,
-	defaultRemovalReason shared.OrderRemovalReason,
+	removalStatus ocutypes.OrderRemoveV1_OrderRemovalStatus,
+	defaultRemovalReason sharedtypes.OrderRemovalReason,
 ) (message msgsender.Message, success bool) {
-	if defaultRemovalReason == shared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED {
+	if defaultRemovalReason == sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED {
 		panic(
 			fmt.Errorf(
 				"Invalid parameter: " +
@@ -230,12 +268,30 @@ func newOrderPlaceMessage(
 	order clobtypes.Order,
 ) ([]byte, error) {
 	indexerOrder := v1.OrderToIndexerOrder(order)
-	update := OffChainUpdateV1{
-		UpdateMessage: &OffChainUpdateV1_OrderPlace{
-			&OrderPlaceV1{
+	update := ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderPlace{
+			&ocutypes.OrderPlaceV1{
+				Order: &indexerOrder,
+				// Protocol will always send best effort opened messages to indexer.
+				PlacementStatus: ocutypes.OrderPlaceV1_ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
+			},
+		},
+	}
+	return marshalOffchainUpdate(update, &common.MarshalerImpl{})
+}
+
+// newOrderReplaceMessage returns an `OffChainUpdate` struct populated with an `OrderReplace` struct
+// as the `UpdateMessage` parameter, encoded as a byte slice.
+func newOrderReplaceMessage(
+	order clobtypes.Order,
+) ([]byte, error) {
+	indexerOrder := v1.OrderToIndexerOrder(order)
+	update := ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderReplace{
+			&ocutypes.OrderReplaceV1{
 				Order: &indexerOrder,
 				// Protocol will always send best effort opened messages to indexer.
-				PlacementStatus: OrderPlaceV1_ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
+				PlacementStatus: ocutypes.OrderPlaceV1_ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
 			},
 		},
 	}
@@ -247,13 +303,13 @@ func newOrderPlaceMessage(
 // The `OrderRemove` struct is instantiated with the given orderId, reason and status parameters.
 func newOrderRemoveMessage(
 	orderId clobtypes.OrderId,
-	reason shared.OrderRemovalReason,
-	status OrderRemoveV1_OrderRemovalStatus,
+	reason sharedtypes.OrderRemovalReason,
+	status ocutypes.OrderRemoveV1_OrderRemovalStatus,
 ) ([]byte, error) {
 	indexerOrderId := v1.OrderIdToIndexerOrderId(orderId)
-	update := OffChainUpdateV1{
-		UpdateMessage: &OffChainUpdateV1_OrderRemove{
-			&OrderRemoveV1{
+	update := ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderRemove{
+			&ocutypes.OrderRemoveV1{
 				RemovedOrderId: &indexerOrderId,
 				Reason:         reason,
 				RemovalStatus:  status,
@@ -271,9 +327,9 @@ func newOrderUpdateMessage(
 	totalFilled satypes.BaseQuantums,
 ) ([]byte, error) {
 	indexerOrderId := v1.OrderIdToIndexerOrderId(orderId)
-	update := OffChainUpdateV1{
-		UpdateMessage: &OffChainUpdateV1_OrderUpdate{
-			&OrderUpdateV1{
+	update := ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderUpdate{
+			&ocutypes.OrderUpdateV1{
 				OrderId:             &indexerOrderId,
 				TotalFilledQuantums: totalFilled.ToUint64(),
 			},
@@ -283,7 +339,7 @@ func newOrderUpdateMessage(
 }
 
 func marshalOffchainUpdate(
-	offChainUpdate OffChainUpdateV1,
+	offChainUpdate ocutypes.OffChainUpdateV1,
 	marshaler common.Marshaler,
 ) ([]byte, error) {
 	updateBytes, err := marshaler.Marshal(&offChainUpdate)
@@ -9,8 +9,9 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
 	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
-	"github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -26,37 +27,45 @@ var (
 	totalFilledAmount              = satypes.BaseQuantums(5)
 	orderStatus                    = clobtypes.Undercollateralized
 	orderError               error = nil
-	reason                         = shared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED
-	status                         = OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED
-	defaultRemovalReason           = shared.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR
-	offchainUpdateOrderPlace       = OffChainUpdateV1{
-		UpdateMessage: &OffChainUpdateV1_OrderPlace{
-			&OrderPlaceV1{
+	reason                         = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED
+	status                         = ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED
+	defaultRemovalReason           = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR
+	offchainUpdateOrderPlace       = ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderPlace{
+			&ocutypes.OrderPlaceV1{
 				Order:           &indexerOrder,
-				PlacementStatus: OrderPlaceV1_ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
+				PlacementStatus: ocutypes.OrderPlaceV1_ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
 			},
 		},
 	}
-	offchainUpdateOrderUpdate = OffChainUpdateV1{
-		UpdateMessage: &OffChainUpdateV1_OrderUpdate{
-			&OrderUpdateV1{
+	offchainUpdateOrderReplace = ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderReplace{
+			&ocutypes.OrderReplaceV1{
+				Order:           &indexerOrder,
+				PlacementStatus: ocutypes.OrderPlaceV1_ORDER_PLACEMENT_STATUS_BEST_EFFORT_OPENED,
+			},
+		},
+	}
+	offchainUpdateOrderUpdate = ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderUpdate{
+			&ocutypes.OrderUpdateV1{
 				OrderId:             &indexerOrder.OrderId,
 				TotalFilledQuantums: totalFilledAmount.ToUint64(),
 			},
 		},
 	}
-	offchainUpdateOrderRemove = OffChainUpdateV1{
-		UpdateMessage: &OffChainUpdateV1_OrderRemove{
-			&OrderRemoveV1{
+	offchainUpdateOrderRemove = ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderRemove{
+			&ocutypes.OrderRemoveV1{
 				RemovedOrderId: &indexerOrder.OrderId,
 				Reason:         reason,
 				RemovalStatus:  status,
 			},
 		},
 	}
-	offchainUpdateOrderRemoveWithDefaultRemovalReason = OffChainUpdateV1{
-		UpdateMessage: &OffChainUpdateV1_OrderRemove{
-			&OrderRemoveV1{
+	offchainUpdateOrderRemoveWithDefaultRemovalReason = ocutypes.OffChainUpdateV1{
+		UpdateMessage: &ocutypes.OffChainUpdateV1_OrderRemove{
+			&ocutypes.OrderRemoveV1{
 				RemovedOrderId: &indexerOrder.OrderId,
 				Reason:         defaultRemovalReason,
 				RemovalStatus:  status,
@@ -81,6 +90,22 @@ func TestCreateOrderPlaceMessage(t *testing.T) {
 	require.Equal(t, expectedMessage, actualMessage)
 }
 
+func TestCreateOrderReplaceMessage(t *testing.T) {
+	actualMessage, success := CreateOrderReplaceMessage(
+		noopLogger,
+		order,
+	)
+	require.True(t, success)
+
+	updateBytes, err := proto.Marshal(&offchainUpdateOrderReplace)
+	require.NoError(t, err)
+	expectedMessage := msgsender.Message{
+		Key:   orderIdHash,
+		Value: updateBytes,
+	}
+	require.Equal(t, expectedMessage, actualMessage)
+}
+
 func TestCreateOrderUpdateMessage(t *testing.T) {
 	actualMessage, success := CreateOrderUpdateMessage(noopLogger, order.OrderId, totalFilledAmount)
 	require.True(t, success)
@@ -170,7 +195,7 @@ func TestCreateOrderRemoveMessageWithDefaultReason_InvalidDefault(t *testing.T)
 				clobtypes.Success,
 				orderError,
 				status,
-				shared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED,
+				sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED,
 			)
 		},
 	)
@@ -203,7 +228,7 @@ func TestNewOrderPlaceMessage(t *testing.T) {
 		err,
 		"Encoding OffchainUpdateV1 proto into bytes should not result in an error.",
 	)
-	actualUpdate := &OffChainUpdateV1{}
+	actualUpdate := &ocutypes.OffChainUpdateV1{}
 	err = proto.Unmarshal(actualUpdateBytes, actualUpdate)
 	require.NoError(
 		t,
@@ -218,6 +243,30 @@ func TestNewOrderPlaceMessage(t *testing.T) {
 	)
 }
 
+func TestNewOrderReplaceMessage(t *testing.T) {
+	actualUpdateBytes, err := newOrderReplaceMessage(
+		order,
+	)
+	require.NoError(
+		t,
+		err,
+		"Encoding OffchainUpdateV1 proto into bytes should not result in an error.",
+	)
+	actualUpdate := &ocutypes.OffChainUpdateV1{}
+	err = proto.Unmarshal(actualUpdateBytes, actualUpdate)
+	require.NoError(
+		t,
+		err,
+		"Decoding OffchainUpdateV1 proto bytes should not result in an error.",
+	)
+	require.Equal(
+		t,
+		offchainUpdateOrderReplace,
+		*actualUpdate,
+		"Decoded OffchainUpdateV1 value should be equal to the expected OffchainUpdate proto message",
+	)
+}
+
 func TestNewOrderUpdateMessage(t *testing.T) {
 	actualUpdateBytes, err := newOrderUpdateMessage(order.OrderId, totalFilledAmount)
 	require.NoError(
@@ -225,7 +274,7 @@ func TestNewOrderUpdateMessage(t *testing.T) {
 		err,
 		"Encoding OffchainUpdateV1 proto into bytes should not result in an error.",
 	)
-	actualUpdate := &OffChainUpdateV1{}
+	actualUpdate := &ocutypes.OffChainUpdateV1{}
 	err = proto.Unmarshal(actualUpdateBytes, actualUpdate)
 	require.NoError(
 		t,
@@ -247,7 +296,7 @@ func TestNewOrderRemoveMessage(t *testing.T) {
 		err,
 		"Encoding OffchainUpdateV1 proto into bytes should not result in an error.",
 	)
-	actualUpdate := &OffChainUpdateV1{}
+	actualUpdate := &ocutypes.OffChainUpdateV1{}
 	err = proto.Unmarshal(actualUpdateBytes, actualUpdate)
 	require.NoError(
 		t,
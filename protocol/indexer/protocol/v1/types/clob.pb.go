@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: dydxprotocol/indexer/protocol/v1/clob.proto
 
-package v1
+package types
 
 import (
 	encoding_binary "encoding/binary"
@@ -170,18 +170,27 @@ const (
 	// order will trigger when the oracle price moves at or below the trigger
 	// price for buys and at or above the trigger price for sells.
 	IndexerOrder_CONDITION_TYPE_TAKE_PROFIT IndexerOrder_ConditionType = 2
+	// CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+	// trailing stop order triggers like a stop order, but its trigger price
+	// follows the oracle price at a distance of
+	// `conditional_order_trailing_percent_ppm` whenever the oracle price moves
+	// up for sells or down for buys. The trigger price never moves away from
+	// the oracle price.
+	IndexerOrder_CONDITION_TYPE_TRAILING_STOP IndexerOrder_ConditionType = 3
 )
 
 var IndexerOrder_ConditionType_name = map[int32]string{
 	0: "CONDITION_TYPE_UNSPECIFIED",
 	1: "CONDITION_TYPE_STOP_LOSS",
 	2: "CONDITION_TYPE_TAKE_PROFIT",
+	3: "CONDITION_TYPE_TRAILING_STOP",
 }
 
 var IndexerOrder_ConditionType_value = map[string]int32{
-	"CONDITION_TYPE_UNSPECIFIED": 0,
-	"CONDITION_TYPE_STOP_LOSS":   1,
-	"CONDITION_TYPE_TAKE_PROFIT": 2,
+	"CONDITION_TYPE_UNSPECIFIED":   0,
+	"CONDITION_TYPE_STOP_LOSS":     1,
+	"CONDITION_TYPE_TAKE_PROFIT":   2,
+	"CONDITION_TYPE_TRAILING_STOP": 3,
 }
 
 func (x IndexerOrder_ConditionType) String() string {
@@ -322,6 +331,15 @@ type IndexerOrder struct {
 	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
 	// orderId.ClobPairId`).
 	ConditionalOrderTriggerSubticks uint64 `protobuf:"varint,11,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+	// conditional_order_trailing_percent_ppm is the distance, in parts per
+	// million of the oracle price, that the trigger price of a trailing stop
+	// order is kept from the oracle price. Only nonzero for trailing stop
+	// orders.
+	ConditionalOrderTrailingPercentPpm uint32 `protobuf:"varint,12,opt,name=conditional_order_trailing_percent_ppm,json=conditionalOrderTrailingPercentPpm,proto3" json:"conditional_order_trailing_percent_ppm,omitempty"`
+	// one_cancels_other_order_id is the id of the stateful order this order is
+	// paired with. When either order of the pair is triggered or filled, the
+	// other order is canceled.
+	OneCancelsOtherOrderId *IndexerOrderId `protobuf:"bytes,13,opt,name=one_cancels_other_order_id,json=oneCancelsOtherOrderId,proto3" json:"one_cancels_other_order_id,omitempty"`
 }
 
 func (m *IndexerOrder) Reset()         { *m = IndexerOrder{} }
@@ -457,6 +475,20 @@ func (m *IndexerOrder) GetConditionalOrderTriggerSubticks() uint64 {
 	return 0
 }
 
+func (m *IndexerOrder) GetConditionalOrderTrailingPercentPpm() uint32 {
+	if m != nil {
+		return m.ConditionalOrderTrailingPercentPpm
+	}
+	return 0
+}
+
+func (m *IndexerOrder) GetOneCancelsOtherOrderId() *IndexerOrderId {
+	if m != nil {
+		return m.OneCancelsOtherOrderId
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*IndexerOrder) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -479,58 +511,63 @@ func init() {
 }
 
 var fileDescriptor_fac8923e70f7ca3c = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x73, 0xdb, 0x54,
-	0x14, 0xb5, 0x1c, 0xb7, 0x71, 0xae, 0x3f, 0x10, 0x8f, 0x32, 0x15, 0x49, 0xeb, 0x18, 0x2f, 0x20,
-	0x03, 0x83, 0x4d, 0x5a, 0x58, 0xc0, 0xc0, 0xc2, 0x56, 0xe4, 0xf6, 0x4d, 0x54, 0x49, 0x48, 0x0a,
-	0x4c, 0xba, 0xe0, 0x21, 0x4b, 0x2f, 0xee, 0x9b, 0xca, 0x7a, 0x41, 0x96, 0x32, 0xf5, 0x8e, 0x9f,
-	0xc0, 0xcf, 0xea, 0xb2, 0xb0, 0x60, 0x58, 0x31, 0x4c, 0xf2, 0x47, 0x98, 0x27, 0x09, 0x55, 0x8e,
-	0x3b, 0x94, 0xec, 0x74, 0xcf, 0x3d, 0xf7, 0xcc, 0x3d, 0xe7, 0xca, 0x32, 0x7c, 0x1a, 0xac, 0x82,
-	0x17, 0xe7, 0x31, 0x4f, 0xb8, 0xcf, 0xc3, 0x11, 0x8b, 0x02, 0xfa, 0x82, 0xc6, 0xa3, 0x12, 0xb8,
-	0x38, 0x1c, 0xf9, 0x21, 0x9f, 0x0d, 0x33, 0x00, 0xf5, 0xab, 0xe4, 0x61, 0x41, 0x1e, 0x96, 0xc0,
-	0xc5, 0xe1, 0xee, 0xe1, 0x5b, 0xe5, 0x96, 0xe9, 0xcc, 0xf3, 0x7d, 0x9e, 0x46, 0x49, 0x3e, 0xb8,
-	0x7b, 0x67, 0xce, 0xe7, 0x3c, 0x7b, 0x1c, 0x89, 0xa7, 0x1c, 0x1d, 0xfc, 0x2e, 0x41, 0x17, 0xe7,
-	0xe3, 0x66, 0x1c, 0xd0, 0x18, 0x07, 0xe8, 0x27, 0xe8, 0xbc, 0x1e, 0x26, 0x2c, 0x50, 0xa4, 0xbe,
-	0x74, 0xd0, 0x7a, 0xf0, 0xe5, 0xf0, 0x6d, 0x5b, 0x0d, 0x0b, 0x21, 0xa7, 0x9c, 0xc6, 0xc1, 0xa4,
-	0xf1, 0xf2, 0xaf, 0xfd, 0x9a, 0xdd, 0x5e, 0x56, 0x30, 0xb4, 0x07, 0x3b, 0x7e, 0xc8, 0x68, 0xae,
-	0x5e, 0xef, 0x4b, 0x07, 0xdb, 0x76, 0x33, 0x07, 0x70, 0x80, 0xf6, 0xa1, 0xc5, 0xc5, 0x26, 0xe4,
-	0x2c, 0xf4, 0xe6, 0x4b, 0x65, 0xab, 0x2f, 0x1d, 0x74, 0x6c, 0xc8, 0xa0, 0xa9, 0x40, 0x50, 0x1f,
-	0xda, 0x22, 0x2b, 0x72, 0xee, 0xb1, 0x58, 0x08, 0x34, 0x72, 0x86, 0xc0, 0x2c, 0x8f, 0xc5, 0x38,
-	0x18, 0xfc, 0xb1, 0x0d, 0xed, 0xaa, 0x29, 0xf4, 0x1d, 0x34, 0x73, 0xcd, 0xd2, 0xcd, 0xe7, 0xff,
-	0xdb, 0x4d, 0x11, 0x4b, 0x61, 0x64, 0x9b, 0x17, 0x29, 0x3d, 0x82, 0xc6, 0x92, 0x05, 0x34, 0x5b,
-	0xbf, 0xfb, 0xe0, 0xe1, 0xcd, 0xe4, 0x86, 0x0e, 0x0b, 0xa8, 0x9d, 0x09, 0xa0, 0x5d, 0x68, 0xfe,
-	0x9c, 0x7a, 0x51, 0x92, 0x2e, 0x72, 0xb3, 0x0d, 0xbb, 0xac, 0x45, 0x6f, 0x99, 0xce, 0x12, 0xe6,
-	0x3f, 0x5f, 0x66, 0x36, 0x1b, 0x76, 0x59, 0xa3, 0x8f, 0xa0, 0x3b, 0xe7, 0x3c, 0x20, 0x09, 0x0b,
-	0xc9, 0x2c, 0xe4, 0xfe, 0x73, 0xe5, 0x96, 0x08, 0xe2, 0x71, 0xcd, 0x6e, 0x0b, 0xdc, 0x65, 0xe1,
-	0x44, 0xa0, 0x68, 0x04, 0xef, 0xad, 0xf3, 0x48, 0xc2, 0x16, 0x54, 0xb9, 0x2d, 0x62, 0x7f, 0x5c,
-	0xb3, 0xe5, 0x2a, 0xd9, 0x65, 0x0b, 0x8a, 0x7e, 0x84, 0x8e, 0x60, 0x10, 0x16, 0x91, 0x33, 0x1e,
-	0xfb, 0x54, 0xd9, 0xce, 0x2c, 0x7e, 0x7d, 0x43, 0x8b, 0x42, 0x0b, 0x47, 0x53, 0xa1, 0x60, 0xb7,
-	0x92, 0xd7, 0x85, 0x38, 0x70, 0x4c, 0x83, 0xd4, 0xa7, 0x84, 0x47, 0xe1, 0x4a, 0x69, 0xf6, 0xa5,
-	0x83, 0xa6, 0x0d, 0x39, 0x64, 0x46, 0xe1, 0x0a, 0x7d, 0x0c, 0xef, 0x14, 0xaf, 0xc7, 0x82, 0x26,
-	0x5e, 0xe0, 0x25, 0x9e, 0xb2, 0x93, 0xdd, 0xb8, 0x9b, 0xc3, 0x4f, 0x0a, 0x14, 0xf9, 0xd0, 0xf5,
-	0x79, 0x14, 0xb0, 0x84, 0xf1, 0x88, 0x24, 0xab, 0x73, 0xaa, 0x40, 0xb6, 0xea, 0x37, 0x37, 0x5c,
-	0x55, 0xfd, 0x57, 0xc4, 0x5d, 0x9d, 0x53, 0xbb, 0xe3, 0x57, 0x4b, 0x74, 0x0c, 0x83, 0x12, 0xf0,
-	0x42, 0x92, 0xbf, 0x47, 0x49, 0xcc, 0xe6, 0x73, 0x1a, 0x93, 0xf2, 0x3a, 0xad, 0xec, 0x3a, 0xfb,
-	0x15, 0x66, 0x26, 0xed, 0xe6, 0x3c, 0xa7, 0xa0, 0x0d, 0xbe, 0x82, 0x86, 0x38, 0x3d, 0xba, 0x03,
-	0xb2, 0x83, 0x8f, 0x34, 0x72, 0x62, 0x38, 0x96, 0xa6, 0xe2, 0x29, 0xd6, 0x8e, 0xe4, 0x1a, 0x6a,
-	0x43, 0x33, 0x43, 0x27, 0x27, 0xa7, 0xb2, 0x84, 0x3a, 0xb0, 0x93, 0x55, 0x8e, 0xa6, 0xeb, 0x72,
-	0x7d, 0xf0, 0x8b, 0x04, 0xad, 0x4a, 0xa6, 0xe8, 0x3e, 0x7c, 0xe0, 0xe2, 0x27, 0x1a, 0xc1, 0x06,
-	0x99, 0x9a, 0xb6, 0x7a, 0x5d, 0xeb, 0x7d, 0x78, 0x77, 0xbd, 0x8d, 0x4d, 0x55, 0x96, 0xd0, 0x1e,
-	0xdc, 0x5d, 0x87, 0x2d, 0xd3, 0x71, 0x89, 0x69, 0xe8, 0xa7, 0x72, 0x1d, 0xf5, 0x60, 0x77, 0xbd,
-	0x39, 0xc5, 0xba, 0x4e, 0x4c, 0x9b, 0x1c, 0x63, 0x5d, 0x97, 0xb7, 0x06, 0x0b, 0xe8, 0xac, 0x45,
-	0x25, 0x06, 0x54, 0xd3, 0x38, 0xc2, 0x2e, 0x36, 0x0d, 0xe2, 0x9e, 0x5a, 0xd7, 0x97, 0xb8, 0x07,
-	0xca, 0xb5, 0xbe, 0xe3, 0x9a, 0x16, 0xd1, 0x4d, 0xc7, 0x91, 0xa5, 0x37, 0x4c, 0xbb, 0xe3, 0x63,
-	0x8d, 0x58, 0xb6, 0x39, 0xc5, 0xae, 0x5c, 0x9f, 0xc8, 0x95, 0x37, 0x9c, 0x47, 0x94, 0x9f, 0x7d,
-	0xf2, 0x9b, 0x04, 0x5d, 0xb5, 0xf8, 0x9d, 0x3b, 0x89, 0x97, 0xa4, 0xe2, 0x6b, 0x70, 0x4f, 0xd5,
-	0xcd, 0x09, 0xb1, 0xc6, 0xd8, 0x26, 0x8e, 0x3b, 0x76, 0x4f, 0x9c, 0x6b, 0x4b, 0xec, 0xc1, 0xdd,
-	0x0d, 0xc6, 0x58, 0x75, 0xf1, 0xf7, 0x5a, 0x9e, 0xc7, 0x46, 0xd3, 0x1a, 0x9f, 0x38, 0xda, 0x91,
-	0x5c, 0x7f, 0xa3, 0xb6, 0x3a, 0x36, 0x54, 0x4d, 0xcf, 0x13, 0xdb, 0xca, 0x2c, 0x6c, 0x8c, 0x97,
-	0x89, 0x36, 0xd0, 0x87, 0x70, 0x7f, 0xa3, 0x8f, 0x0d, 0xec, 0xe2, 0xb1, 0x8e, 0x9f, 0x62, 0xe3,
-	0x91, 0x7c, 0x6b, 0xf2, 0xc3, 0xcb, 0xcb, 0x9e, 0xf4, 0xea, 0xb2, 0x27, 0xfd, 0x7d, 0xd9, 0x93,
-	0x7e, 0xbd, 0xea, 0xd5, 0x5e, 0x5d, 0xf5, 0x6a, 0x7f, 0x5e, 0xf5, 0x6a, 0x4f, 0xbf, 0x9d, 0xb3,
-	0xe4, 0x59, 0x3a, 0x1b, 0xfa, 0x7c, 0x31, 0x5a, 0xfb, 0xde, 0x5f, 0x7c, 0xf1, 0x99, 0xff, 0xcc,
-	0x63, 0xd1, 0xe8, 0xbf, 0xfe, 0x01, 0x66, 0xb7, 0xb3, 0xe2, 0xe1, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x19, 0x52, 0x63, 0x4b, 0x7b, 0x06, 0x00, 0x00,
+	// 891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x72, 0xdb, 0x54,
+	0x14, 0xb6, 0x12, 0xb7, 0x71, 0x8e, 0x7f, 0x10, 0x97, 0x42, 0x85, 0xd3, 0x3a, 0xc6, 0x8b, 0x92,
+	0x81, 0xc1, 0x26, 0x2d, 0x2c, 0x60, 0xd8, 0xd8, 0x8a, 0x9d, 0xde, 0x89, 0x6a, 0x09, 0x49, 0x61,
+	0x26, 0x9d, 0x81, 0x8b, 0x2c, 0xdd, 0x38, 0x77, 0x2a, 0xeb, 0x1a, 0x59, 0xce, 0xd4, 0x3b, 0xde,
+	0x00, 0xde, 0x87, 0x17, 0xe8, 0xb2, 0xb0, 0x62, 0xc5, 0x30, 0xc9, 0x8b, 0x30, 0xf7, 0x4a, 0x55,
+	0x6d, 0x27, 0x43, 0x08, 0x3b, 0x9d, 0xef, 0x7c, 0xe7, 0x9b, 0xf3, 0x9d, 0x73, 0x24, 0xc1, 0xa7,
+	0xc1, 0x22, 0x78, 0x39, 0x8d, 0x79, 0xc2, 0x7d, 0x1e, 0x76, 0x58, 0x14, 0xd0, 0x97, 0x34, 0xee,
+	0xe4, 0xc0, 0xf9, 0x7e, 0xc7, 0x0f, 0xf9, 0xa8, 0x2d, 0x01, 0xd4, 0x5c, 0x26, 0xb7, 0x33, 0x72,
+	0x3b, 0x07, 0xce, 0xf7, 0xeb, 0xfb, 0x37, 0xca, 0xcd, 0xe6, 0x23, 0xcf, 0xf7, 0xf9, 0x3c, 0x4a,
+	0xd2, 0xc2, 0xfa, 0xbd, 0x31, 0x1f, 0x73, 0xf9, 0xd8, 0x11, 0x4f, 0x29, 0xda, 0xfa, 0x43, 0x81,
+	0x1a, 0x4e, 0xcb, 0xcd, 0x38, 0xa0, 0x31, 0x0e, 0xd0, 0x8f, 0x50, 0x7d, 0x5b, 0x4c, 0x58, 0xa0,
+	0x29, 0x4d, 0x65, 0xaf, 0xfc, 0xf8, 0xcb, 0xf6, 0x4d, 0x5d, 0xb5, 0x33, 0x21, 0x27, 0xaf, 0xc6,
+	0x41, 0xaf, 0xf8, 0xea, 0xaf, 0xdd, 0x82, 0x5d, 0x99, 0x2d, 0x61, 0x68, 0x07, 0xb6, 0xfd, 0x90,
+	0xd1, 0x54, 0x7d, 0xa3, 0xa9, 0xec, 0x6d, 0xd9, 0xa5, 0x14, 0xc0, 0x01, 0xda, 0x85, 0x32, 0x17,
+	0x9d, 0x90, 0xd3, 0xd0, 0x1b, 0xcf, 0xb4, 0xcd, 0xa6, 0xb2, 0x57, 0xb5, 0x41, 0x42, 0x03, 0x81,
+	0xa0, 0x26, 0x54, 0xc4, 0xac, 0xc8, 0xd4, 0x63, 0xb1, 0x10, 0x28, 0xa6, 0x0c, 0x81, 0x59, 0x1e,
+	0x8b, 0x71, 0xd0, 0xfa, 0x6d, 0x1b, 0x2a, 0xcb, 0xa6, 0xd0, 0xb7, 0x50, 0x4a, 0x35, 0x73, 0x37,
+	0x9f, 0xff, 0x67, 0x37, 0xd9, 0x58, 0x32, 0x23, 0x5b, 0x3c, 0x9b, 0xd2, 0x21, 0x14, 0x67, 0x2c,
+	0xa0, 0xb2, 0xfd, 0xda, 0xe3, 0x27, 0xb7, 0x93, 0x6b, 0x3b, 0x2c, 0xa0, 0xb6, 0x14, 0x40, 0x75,
+	0x28, 0xfd, 0x34, 0xf7, 0xa2, 0x64, 0x3e, 0x49, 0xcd, 0x16, 0xed, 0x3c, 0x16, 0xb9, 0xd9, 0x7c,
+	0x94, 0x30, 0xff, 0xc5, 0x4c, 0xda, 0x2c, 0xda, 0x79, 0x8c, 0x1e, 0x41, 0x6d, 0xcc, 0x79, 0x40,
+	0x12, 0x16, 0x92, 0x51, 0xc8, 0xfd, 0x17, 0xda, 0x1d, 0x31, 0x88, 0xa7, 0x05, 0xbb, 0x22, 0x70,
+	0x97, 0x85, 0x3d, 0x81, 0xa2, 0x0e, 0xbc, 0xb7, 0xca, 0x23, 0x09, 0x9b, 0x50, 0xed, 0xae, 0x18,
+	0xfb, 0xd3, 0x82, 0xad, 0x2e, 0x93, 0x5d, 0x36, 0xa1, 0xe8, 0x07, 0xa8, 0x0a, 0x06, 0x61, 0x11,
+	0x39, 0xe5, 0xb1, 0x4f, 0xb5, 0x2d, 0x69, 0xf1, 0xeb, 0x5b, 0x5a, 0x14, 0x5a, 0x38, 0x1a, 0x08,
+	0x05, 0xbb, 0x9c, 0xbc, 0x0d, 0xc4, 0x82, 0x63, 0x1a, 0xcc, 0x7d, 0x4a, 0x78, 0x14, 0x2e, 0xb4,
+	0x52, 0x53, 0xd9, 0x2b, 0xd9, 0x90, 0x42, 0x66, 0x14, 0x2e, 0xd0, 0xc7, 0xf0, 0x4e, 0x76, 0x1e,
+	0x13, 0x9a, 0x78, 0x81, 0x97, 0x78, 0xda, 0xb6, 0xdc, 0x71, 0x2d, 0x85, 0x9f, 0x65, 0x28, 0xf2,
+	0xa1, 0xe6, 0xf3, 0x28, 0x60, 0x09, 0xe3, 0x11, 0x49, 0x16, 0x53, 0xaa, 0x81, 0x6c, 0xf5, 0x9b,
+	0x5b, 0xb6, 0xaa, 0xbf, 0x11, 0x71, 0x17, 0x53, 0x6a, 0x57, 0xfd, 0xe5, 0x10, 0x1d, 0x41, 0x2b,
+	0x07, 0xbc, 0x90, 0xa4, 0x77, 0x94, 0xc4, 0x6c, 0x3c, 0xa6, 0x31, 0xc9, 0xb7, 0x53, 0x96, 0xdb,
+	0xd9, 0x5d, 0x62, 0x4a, 0x69, 0x37, 0xe5, 0x39, 0x6f, 0x96, 0x66, 0xc3, 0xa3, 0xeb, 0xc4, 0x3c,
+	0x16, 0xb2, 0x68, 0x4c, 0xa6, 0x34, 0xf6, 0x85, 0xed, 0xe9, 0x74, 0xa2, 0x55, 0xa4, 0xe3, 0xd6,
+	0x55, 0xc1, 0x94, 0x6b, 0xa5, 0x54, 0x6b, 0x3a, 0x41, 0x21, 0xd4, 0x79, 0x44, 0x89, 0xef, 0x45,
+	0x3e, 0x0d, 0x67, 0x84, 0x27, 0x67, 0x34, 0x26, 0xf9, 0xb9, 0x57, 0xff, 0xdf, 0xb9, 0xdb, 0x1f,
+	0xf0, 0x88, 0xea, 0xa9, 0xa4, 0x99, 0x9c, 0xe5, 0x78, 0xeb, 0x2b, 0x28, 0x8a, 0xe3, 0x45, 0xf7,
+	0x40, 0x75, 0xf0, 0x41, 0x9f, 0x1c, 0x0f, 0x1d, 0xab, 0xaf, 0xe3, 0x01, 0xee, 0x1f, 0xa8, 0x05,
+	0x54, 0x81, 0x92, 0x44, 0x7b, 0xc7, 0x27, 0xaa, 0x82, 0xaa, 0xb0, 0x2d, 0x23, 0xa7, 0x6f, 0x18,
+	0xea, 0x46, 0xeb, 0x67, 0x05, 0xca, 0x4b, 0x57, 0x81, 0x1e, 0xc2, 0x87, 0x2e, 0x7e, 0xd6, 0x27,
+	0x78, 0x48, 0x06, 0xa6, 0xad, 0xaf, 0x6b, 0xbd, 0x0f, 0xef, 0xae, 0xa6, 0xb1, 0xa9, 0xab, 0x0a,
+	0xda, 0x81, 0xfb, 0xab, 0xb0, 0x65, 0x3a, 0x2e, 0x31, 0x87, 0xc6, 0x89, 0xba, 0x81, 0x1a, 0x50,
+	0x5f, 0x4d, 0x0e, 0xb0, 0x61, 0x10, 0xd3, 0x26, 0x47, 0xd8, 0x30, 0xd4, 0xcd, 0xd6, 0x2f, 0x0a,
+	0x54, 0x57, 0xb6, 0x2d, 0x2a, 0x74, 0x73, 0x78, 0x80, 0x5d, 0x6c, 0x0e, 0x89, 0x7b, 0x62, 0xad,
+	0x77, 0xf1, 0x00, 0xb4, 0xb5, 0xbc, 0xe3, 0x9a, 0x16, 0x31, 0x4c, 0xc7, 0x51, 0x95, 0x6b, 0xaa,
+	0xdd, 0xee, 0x51, 0x9f, 0x58, 0xb6, 0x39, 0xc0, 0xae, 0xba, 0x81, 0x9a, 0xf0, 0x60, 0x3d, 0x6f,
+	0x77, 0xb1, 0x81, 0x87, 0x87, 0x52, 0x46, 0xdd, 0xec, 0xa9, 0x4b, 0xaf, 0x31, 0x8f, 0x28, 0x3f,
+	0xfd, 0xe4, 0x77, 0x05, 0x6a, 0x7a, 0xf6, 0x31, 0x73, 0x12, 0x2f, 0x99, 0xcf, 0xa4, 0x8c, 0x61,
+	0xf6, 0x88, 0xd5, 0xc5, 0x36, 0x71, 0xdc, 0xae, 0x7b, 0xec, 0xac, 0xb5, 0xb9, 0x03, 0xf7, 0xaf,
+	0x30, 0xba, 0xba, 0x8b, 0xbf, 0xeb, 0xab, 0xca, 0xb5, 0x49, 0xab, 0x7b, 0xec, 0xf4, 0x0f, 0xb2,
+	0x16, 0xd7, 0x93, 0x7a, 0x77, 0xa8, 0xf7, 0x8d, 0x74, 0xa8, 0x9b, 0xd2, 0xe4, 0x95, 0xf2, 0x7c,
+	0xe8, 0x45, 0xf4, 0x11, 0x3c, 0xbc, 0x92, 0xc7, 0x43, 0xec, 0xe2, 0xae, 0x81, 0x9f, 0xe3, 0xe1,
+	0xa1, 0x7a, 0xa7, 0xf7, 0xfd, 0xab, 0x8b, 0x86, 0xf2, 0xfa, 0xa2, 0xa1, 0xfc, 0x7d, 0xd1, 0x50,
+	0x7e, 0xbd, 0x6c, 0x14, 0x5e, 0x5f, 0x36, 0x0a, 0x7f, 0x5e, 0x36, 0x0a, 0xcf, 0xf5, 0x31, 0x4b,
+	0xce, 0xe6, 0xa3, 0xb6, 0xcf, 0x27, 0x9d, 0x95, 0x9f, 0xda, 0xf9, 0x17, 0x9f, 0xf9, 0x67, 0x1e,
+	0x8b, 0x3a, 0xff, 0xfa, 0x9b, 0x13, 0x6f, 0xfd, 0x6c, 0x74, 0x57, 0x42, 0x4f, 0xfe, 0x09, 0x00,
+	0x00, 0xff, 0xff, 0x07, 0xba, 0xf9, 0x7d, 0x66, 0x07, 0x00, 0x00,
 }
 
 func (m *IndexerOrderId) Marshal() (dAtA []byte, err error) {
@@ -602,6 +639,23 @@ func (m *IndexerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OneCancelsOtherOrderId != nil {
+		{
+			size, err := m.OneCancelsOtherOrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClob(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ConditionalOrderTrailingPercentPpm != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.ConditionalOrderTrailingPercentPpm))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		i = encodeVarintClob(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
@@ -760,6 +814,13 @@ func (m *IndexerOrder) Size() (n int) {
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		n += 1 + sovClob(uint64(m.ConditionalOrderTriggerSubticks))
 	}
+	if m.ConditionalOrderTrailingPercentPpm != 0 {
+		n += 1 + sovClob(uint64(m.ConditionalOrderTrailingPercentPpm))
+	}
+	if m.OneCancelsOtherOrderId != nil {
+		l = m.OneCancelsOtherOrderId.Size()
+		n += 1 + l + sovClob(uint64(l))
+	}
 	return n
 }
 
@@ -1165,6 +1226,61 @@ func (m *IndexerOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTrailingPercentPpm", wireType)
+			}
+			m.ConditionalOrderTrailingPercentPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderTrailingPercentPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneCancelsOtherOrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OneCancelsOtherOrderId == nil {
+				m.OneCancelsOtherOrderId = &IndexerOrderId{}
+			}
+			if err := m.OneCancelsOtherOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClob(dAtA[iNdEx:])
@@ -50,7 +50,8 @@ func PadOrTruncateAddress(address []byte) []byte {
 }
 
 /*
-BridgeLogToEvent converts an Ethereum log from Bridge contract to a BridgeEvent.
+BridgeLogToEvent converts an Ethereum log from Bridge contract of a bridge source to a BridgeEvent
+of the source's denom.
 Note: The format of a dYdX address is [prefix][separator][address][checksum], where `prefix` is `dydx`,
 `separator` is `1`, `address` is the actual address portion, and `checksum` occupies last 6 characters.
 An address in Ethereum logs is in hexadecimal format and in Cosmos bech32 format. For example, a
@@ -58,6 +59,7 @@ An address in Ethereum logs is in hexadecimal format and in Cosmos bech32 format
 */
 func BridgeLogToEvent(
 	log ethcoretypes.Log,
+	sourceId uint32,
 	denom string,
 ) bridgetypes.BridgeEvent {
 	// Unpack the topics.
@@ -80,5 +82,6 @@ func BridgeLogToEvent(
 		Coin:           sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)),
 		Address:        sdk.MustBech32ifyAddressBytes(config.Bech32PrefixAccAddr, address),
 		EthBlockHeight: log.BlockNumber,
+		SourceId:       sourceId,
 	}
 }
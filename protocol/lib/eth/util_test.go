@@ -110,8 +110,9 @@ func TestPadOrTruncateAddress(t *testing.T) {
 
 func TestBridgeLogToEvent(t *testing.T) {
 	tests := map[string]struct {
-		inputLog   ethcoretypes.Log
-		inputDenom string
+		inputLog      ethcoretypes.Log
+		inputSourceId uint32
+		inputDenom    string
 
 		expectedEvent bridgetypes.BridgeEvent
 	}{
@@ -171,9 +172,10 @@ func TestBridgeLogToEvent(t *testing.T) {
 				EthBlockHeight: 4139348,
 			},
 		},
-		"Success: event ID 4": {
-			inputLog:   constants.EthLog_Event4,
-			inputDenom: "adv4tnt",
+		"Success: event ID 4 - additional source": {
+			inputLog:      constants.EthLog_Event4,
+			inputSourceId: 3,
+			inputDenom:    "adv4tnt",
 			expectedEvent: bridgetypes.BridgeEvent{
 				Id: 4,
 				Coin: sdk.NewCoin(
@@ -183,13 +185,14 @@ func TestBridgeLogToEvent(t *testing.T) {
 				// address shorter than 20 bytes is padded with zeros.
 				Address:        "dydx1zg6pydqqqqqqqqqqqqqqqqqqqqqqqqqqm0r5ra",
 				EthBlockHeight: 4139349,
+				SourceId:       3,
 			},
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			event := libeth.BridgeLogToEvent(tc.inputLog, tc.inputDenom)
+			event := libeth.BridgeLogToEvent(tc.inputLog, tc.inputSourceId, tc.inputDenom)
 			require.Equal(t, tc.expectedEvent, event)
 		})
 	}
@@ -90,6 +90,7 @@ const (
 	// CLOB.
 	AddPerpetualFillAmount                       = "add_perpetual_fill_amount"
 	BaseQuantums                                 = "base_quantums"
+	BatchPlaceAndCancel                          = "batch_place_and_cancel"
 	BestAskClobPair                              = "best_ask_clob_pair"
 	BestBidClobPair                              = "best_bid_clob_pair"
 	Buy                                          = "buy"
@@ -137,6 +138,7 @@ const (
 	NumUniqueSubaccountsLiquidated               = "num_unique_subaccounts_liquidated"
 	NumUniqueSubaccountsOffsettingDeleveraged    = "num_unique_subaccounts_offsetting_deleveraged"
 	OffsettingSubaccountPerpetualPosition        = "offsetting_subaccount_perpetual_position"
+	OneCancelsOther                              = "one_cancels_other"
 	OperationsQueueLength                        = "operations_queue_length"
 	OrderConflictsWithClobPairStatus             = "order_conflicts_with_clob_pair_status"
 	OrderFlag                                    = "order_flag"
@@ -158,11 +160,16 @@ const (
 	ReduceOnly                                   = "reduce_only"
 	RemovalReason                                = "removal_reason"
 	RemoveAndClearOperationsQueue                = "remove_and_clear_operations_queue"
+	ReplaceOrder                                 = "replace_order"
+	ReplaceLongTermOrdersFromLastBlock           = "replace_long_term_orders_from_last_block"
+	ReplaceStatefulOrder                         = "replace_stateful_order"
 	ReplayOperations                             = "replay_operations"
 	SortLiquidationOrders                        = "sort_liquidation_orders"
+	SendBatchPlaceAndCancelOffchainUpdates       = "send_batch_place_and_cancel_offchain_updates"
 	SendCancelOrderOffchainUpdates               = "send_cancel_order_offchain_updates"
 	SendPlaceOrderOffchainUpdates                = "send_place_order_offchain_updates"
 	SendPlacePerpetualLiquidationOffchainUpdates = "send_perpetual_liquidation_offchain_updates"
+	SendReplaceOrderOffchainUpdates              = "send_replace_order_offchain_updates"
 	SendPrepareCheckStateOffchainUpdates         = "send_prepare_check_state_offchain_updates"
 	SendProcessProposerMatchesOffchainUpdates    = "send_process_proposer_matches_offchain_updates"
 	SendProposedOperationsOffchainUpdates        = "send_proposed_operations_offchain_updates"
@@ -182,6 +189,7 @@ const (
 	TimeInForce                                  = "time_in_force"
 	TotalOrdersClobPair                          = "total_orders_in_clob"
 	TotalQuoteQuantums                           = "total_quote_quantums"
+	TrailingStopTriggerUpdated                   = "trailing_stop_trigger_updated"
 	Unfilled                                     = "unfilled"
 	UnfilledLiquidationOrders                    = "unfilled_liquidation_orders"
 	UnknownPlaceOrders                           = "unknown_place_orders"
@@ -203,6 +211,8 @@ const (
 	DaemonServer    = "daemon_server"
 	ValidResponse   = "valid_response"
 	MissingResponse = "missing_response"
+	Stale           = "stale"
+	Degraded        = "degraded"
 
 	// Epochs.
 	EpochInfoName = "epoch_name"
@@ -291,17 +301,19 @@ const (
 
 	// Liquidation Daemon.
 	CheckCollateralizationForSubaccounts = "check_collateralization_for_subaccounts"
-	GetAllSubaccounts                    = "get_all_subaccounts"
 	GetLiquidatableSubaccountIds         = "get_liquidatable_subaccount_ids"
-	GetSubaccountsFromKey                = "get_subaccounts_from_key"
+	GetLiquidationCandidates             = "get_liquidation_candidates"
+	GetLiquidationCandidatesFromKey      = "get_liquidation_candidates_from_key"
 	LiquidatableSubaccountIds            = "liquidatable_subaccount_ids"
+	LiquidationCandidates                = "liquidation_candidates"
+	LiquidationCandidatesBelowMargin     = "liquidation_candidates_below_margin"
 	LiquidationDaemon                    = "liquidation_daemon"
 	PageLimit                            = "page_limit"
 	SendLiquidatableSubaccountIds        = "send_liquidatable_subaccount_ids"
-	SubaccountsWithOpenPositions         = "subaccounts_with_open_positions"
 
 	// Liquidation.
 	ConstructLiquidationOrder             = "construct_liquidation_order"
+	InsuranceFund                         = "insurance_fund"
 	InsuranceFundBalance                  = "insurance_fund_balance"
 	InsuranceFundDelta                    = "insurance_fund_delta"
 	Liquidations                          = "liquidations"
@@ -319,14 +331,15 @@ const (
 	LiquidationMatchNegativeTNC           = "liquidation_match_negative_tnc"
 
 	// Deleveraging.
+	AdlRankingSize                 = "adl_ranking_size"
 	CannotDeleverageSubaccount     = "cannot_deleverage_subaccount"
 	DeleverageSubaccount           = "deleverage_subaccount"
 	Deleveraging                   = "deleveraging"
 	DeltaQuoteQuantums             = "delta_quote_quantums"
+	GetAdlRanking                  = "get_adl_ranking"
 	NumSubaccountsIterated         = "num_subaccounts_iterated"
 	NotEnoughPositionToFullyOffset = "not_enough_position_to_fully_offset"
 	NonOverlappingBankruptcyPrices = "non_overlapping_bankruptcy_prices"
-	NoOpenPositionOnOppositeSide   = "no_open_position_on_opposite_side"
 
 	// Pricefeed Daemon.
 	Exchange                                = "exchange"
@@ -345,6 +358,8 @@ const (
 	PriceFetcherQueryExchange               = "price_fetcher_query_exchange"
 	PriceFetcherSubtaskLoop                 = "price_fetcher_subtask_loop"
 	PriceFetcherSubtaskLoopAndSetCtxTimeout = "price_fetcher_subtask_loop_and_set_ctx_timeout"
+	PriceStreamerConnect                    = "price_streamer_connect"
+	PriceStreamerReconnect                  = "price_streamer_reconnect"
 	PriceUpdateCount                        = "price_update_count"
 	PriceUpdaterSendPrices                  = "price_updater_send_prices"
 	PriceUpdaterTaskLoop                    = "price_updater_task_loop"
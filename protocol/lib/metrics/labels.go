@@ -8,5 +8,9 @@ const (
 
 	Callback = "callback"
 
-	Daemon = "daemon"
+	Daemon          = "daemon"
+	StalenessPolicy = "staleness_policy"
+
+	// Bridge labels.
+	BridgeSourceId = "bridge_source_id"
 )
@@ -42,13 +42,13 @@ func (_m *BridgeKeeper) CompleteBridge(ctx types.Context, bridges bridgetypes.Br
 	return r0
 }
 
-// GetAcknowledgedEventInfo provides a mock function with given fields: ctx
-func (_m *BridgeKeeper) GetAcknowledgedEventInfo(ctx types.Context) bridgetypes.BridgeEventInfo {
-	ret := _m.Called(ctx)
+// GetAcknowledgedEventInfo provides a mock function with given fields: ctx, sourceId
+func (_m *BridgeKeeper) GetAcknowledgedEventInfo(ctx types.Context, sourceId uint32) bridgetypes.BridgeEventInfo {
+	ret := _m.Called(ctx, sourceId)
 
 	var r0 bridgetypes.BridgeEventInfo
-	if rf, ok := ret.Get(0).(func(types.Context) bridgetypes.BridgeEventInfo); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) bridgetypes.BridgeEventInfo); ok {
+		r0 = rf(ctx, sourceId)
 	} else {
 		r0 = ret.Get(0).(bridgetypes.BridgeEventInfo)
 	}
@@ -84,13 +84,13 @@ func (_m *BridgeKeeper) GetProposeParams(ctx types.Context) bridgetypes.ProposeP
 	return r0
 }
 
-// GetRecognizedEventInfo provides a mock function with given fields: ctx
-func (_m *BridgeKeeper) GetRecognizedEventInfo(ctx types.Context) bridgetypes.BridgeEventInfo {
-	ret := _m.Called(ctx)
+// GetRecognizedEventInfo provides a mock function with given fields: ctx, sourceId
+func (_m *BridgeKeeper) GetRecognizedEventInfo(ctx types.Context, sourceId uint32) bridgetypes.BridgeEventInfo {
+	ret := _m.Called(ctx, sourceId)
 
 	var r0 bridgetypes.BridgeEventInfo
-	if rf, ok := ret.Get(0).(func(types.Context) bridgetypes.BridgeEventInfo); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) bridgetypes.BridgeEventInfo); ok {
+		r0 = rf(ctx, sourceId)
 	} else {
 		r0 = ret.Get(0).(bridgetypes.BridgeEventInfo)
 	}
@@ -47,6 +47,29 @@ func (_m *ClobKeeper) AddOrderToOrderbookCollatCheck(ctx types.Context, clobPair
 	return r0, r1
 }
 
+// BatchPlaceAndCancelShortTermOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) BatchPlaceAndCancelShortTermOrders(ctx types.Context, msg *clobtypes.MsgBatchPlaceAndCancel) (*clobtypes.MsgBatchPlaceAndCancelResponse, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 *clobtypes.MsgBatchPlaceAndCancelResponse
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchPlaceAndCancel) *clobtypes.MsgBatchPlaceAndCancelResponse); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.MsgBatchPlaceAndCancelResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgBatchPlaceAndCancel) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CancelShortTermOrder provides a mock function with given fields: ctx, msg
 func (_m *ClobKeeper) CancelShortTermOrder(ctx types.Context, msg *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, msg)
@@ -214,13 +237,13 @@ func (_m *ClobKeeper) GetIndexerEventManager() indexer_manager.IndexerEventManag
 	return r0
 }
 
-// GetInsuranceFundBalance provides a mock function with given fields: ctx
-func (_m *ClobKeeper) GetInsuranceFundBalance(ctx types.Context) *big.Int {
-	ret := _m.Called(ctx)
+// GetInsuranceFundBalance provides a mock function with given fields: ctx, perpetualId
+func (_m *ClobKeeper) GetInsuranceFundBalance(ctx types.Context, perpetualId uint32) *big.Int {
+	ret := _m.Called(ctx, perpetualId)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func(types.Context) *big.Int); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) *big.Int); ok {
+		r0 = rf(ctx, perpetualId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -753,6 +776,20 @@ func (_m *ClobKeeper) PruneStateFillAmountsForShortTermOrders(ctx types.Context)
 	_m.Called(ctx)
 }
 
+// RateLimitBatchPlaceAndCancel provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) RateLimitBatchPlaceAndCancel(ctx types.Context, msg *clobtypes.MsgBatchPlaceAndCancel) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchPlaceAndCancel) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RateLimitCancelOrder provides a mock function with given fields: ctx, order
 func (_m *ClobKeeper) RateLimitCancelOrder(ctx types.Context, order *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, order)
@@ -807,6 +844,48 @@ func (_m *ClobKeeper) RemoveOrderFillAmount(ctx types.Context, orderId clobtypes
 	_m.Called(ctx, orderId)
 }
 
+// ReplaceShortTermOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) ReplaceShortTermOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 subaccountstypes.BaseQuantums
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) subaccountstypes.BaseQuantums); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Get(0).(subaccountstypes.BaseQuantums)
+	}
+
+	var r1 clobtypes.OrderStatus
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgReplaceOrder) clobtypes.OrderStatus); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Get(1).(clobtypes.OrderStatus)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r2 = rf(ctx, msg)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReplaceStatefulOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) ReplaceStatefulOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLongTermOrderPlacement provides a mock function with given fields: ctx, order, blockHeight
 func (_m *ClobKeeper) SetLongTermOrderPlacement(ctx types.Context, order clobtypes.Order, blockHeight uint32) {
 	_m.Called(ctx, order, blockHeight)
@@ -16,6 +16,22 @@ type ExchangeToMarketPrices struct {
 	mock.Mock
 }
 
+// GetAllExchangeStatuses provides a mock function with given fields:
+func (_m *ExchangeToMarketPrices) GetAllExchangeStatuses() map[string]types.ExchangeStatus {
+	ret := _m.Called()
+
+	var r0 map[string]types.ExchangeStatus
+	if rf, ok := ret.Get(0).(func() map[string]types.ExchangeStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]types.ExchangeStatus)
+		}
+	}
+
+	return r0
+}
+
 // GetAllPrices provides a mock function with given fields:
 func (_m *ExchangeToMarketPrices) GetAllPrices() map[string][]types.MarketPriceTimestamp {
 	ret := _m.Called()
@@ -32,20 +48,20 @@ func (_m *ExchangeToMarketPrices) GetAllPrices() map[string][]types.MarketPriceT
 	return r0
 }
 
-// GetIndexPrice provides a mock function with given fields: marketId, cutoffTime, resolver
-func (_m *ExchangeToMarketPrices) GetIndexPrice(marketId uint32, cutoffTime time.Time, resolver pricefeedtypes.Resolver) (uint64, int) {
-	ret := _m.Called(marketId, cutoffTime, resolver)
+// GetIndexPrice provides a mock function with given fields: marketId, cutoffTime, aggregation
+func (_m *ExchangeToMarketPrices) GetIndexPrice(marketId uint32, cutoffTime time.Time, aggregation pricefeedtypes.AggregationConfig) (uint64, int) {
+	ret := _m.Called(marketId, cutoffTime, aggregation)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(uint32, time.Time, pricefeedtypes.Resolver) uint64); ok {
-		r0 = rf(marketId, cutoffTime, resolver)
+	if rf, ok := ret.Get(0).(func(uint32, time.Time, pricefeedtypes.AggregationConfig) uint64); ok {
+		r0 = rf(marketId, cutoffTime, aggregation)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 int
-	if rf, ok := ret.Get(1).(func(uint32, time.Time, pricefeedtypes.Resolver) int); ok {
-		r1 = rf(marketId, cutoffTime, resolver)
+	if rf, ok := ret.Get(1).(func(uint32, time.Time, pricefeedtypes.AggregationConfig) int); ok {
+		r1 = rf(marketId, cutoffTime, aggregation)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
@@ -53,6 +69,11 @@ func (_m *ExchangeToMarketPrices) GetIndexPrice(marketId uint32, cutoffTime time
 	return r0, r1
 }
 
+// RecordExchangeError provides a mock function with given fields: exchangeId, reason, err
+func (_m *ExchangeToMarketPrices) RecordExchangeError(exchangeId string, reason string, err error) {
+	_m.Called(exchangeId, reason, err)
+}
+
 // UpdatePrice provides a mock function with given fields: exchangeId, marketPriceTimestamp
 func (_m *ExchangeToMarketPrices) UpdatePrice(exchangeId string, marketPriceTimestamp *types.MarketPriceTimestamp) {
 	_m.Called(exchangeId, marketPriceTimestamp)
@@ -127,6 +127,38 @@ func (_m *MemClob) GetMidPrice(ctx types.Context, clobPairId clobtypes.ClobPairI
 	return r0, r1
 }
 
+// GetOffchainUpdatesForOrderbookSnapshot provides a mock function with given fields: ctx, clobPairId
+func (_m *MemClob) GetOffchainUpdatesForOrderbookSnapshot(ctx types.Context, clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+	ret := _m.Called(ctx, clobPairId)
+
+	var r0 *clobtypes.OffchainUpdates
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId) *clobtypes.OffchainUpdates); ok {
+		r0 = rf(ctx, clobPairId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	return r0
+}
+
+// GetOpenOrders provides a mock function with given fields: ctx, clobPairId
+func (_m *MemClob) GetOpenOrders(ctx types.Context, clobPairId clobtypes.ClobPairId) []clobtypes.Order {
+	ret := _m.Called(ctx, clobPairId)
+
+	var r0 []clobtypes.Order
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId) []clobtypes.Order); ok {
+		r0 = rf(ctx, clobPairId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.Order)
+		}
+	}
+
+	return r0
+}
+
 // GetOperationsRaw provides a mock function with given fields: ctx
 func (_m *MemClob) GetOperationsRaw(ctx types.Context) []clobtypes.OperationRaw {
 	ret := _m.Called(ctx)
@@ -224,6 +256,31 @@ func (_m *MemClob) GetOrderRemainingAmount(ctx types.Context, order clobtypes.Or
 	return r0, r1
 }
 
+// GetOrderbookDepth provides a mock function with given fields: ctx, clobPairId, maxLevels
+func (_m *MemClob) GetOrderbookDepth(ctx types.Context, clobPairId clobtypes.ClobPairId, maxLevels uint32) ([]clobtypes.OrderbookLevel, []clobtypes.OrderbookLevel) {
+	ret := _m.Called(ctx, clobPairId, maxLevels)
+
+	var r0 []clobtypes.OrderbookLevel
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId, uint32) []clobtypes.OrderbookLevel); ok {
+		r0 = rf(ctx, clobPairId, maxLevels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderbookLevel)
+		}
+	}
+
+	var r1 []clobtypes.OrderbookLevel
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.ClobPairId, uint32) []clobtypes.OrderbookLevel); ok {
+		r1 = rf(ctx, clobPairId, maxLevels)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]clobtypes.OrderbookLevel)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetPricePremium provides a mock function with given fields: ctx, clobPair, params
 func (_m *MemClob) GetPricePremium(ctx types.Context, clobPair clobtypes.ClobPair, params perpetualstypes.GetPricePremiumParams) (int32, error) {
 	ret := _m.Called(ctx, clobPair, params)
@@ -368,6 +425,43 @@ func (_m *MemClob) RemoveOrderIfFilled(ctx types.Context, orderId clobtypes.Orde
 	_m.Called(ctx, orderId)
 }
 
+// ReplaceOrder provides a mock function with given fields: ctx, order
+func (_m *MemClob) ReplaceOrder(ctx types.Context, order clobtypes.Order) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, *clobtypes.OffchainUpdates, error) {
+	ret := _m.Called(ctx, order)
+
+	var r0 subaccountstypes.BaseQuantums
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.Order) subaccountstypes.BaseQuantums); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Get(0).(subaccountstypes.BaseQuantums)
+	}
+
+	var r1 clobtypes.OrderStatus
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.Order) clobtypes.OrderStatus); ok {
+		r1 = rf(ctx, order)
+	} else {
+		r1 = ret.Get(1).(clobtypes.OrderStatus)
+	}
+
+	var r2 *clobtypes.OffchainUpdates
+	if rf, ok := ret.Get(2).(func(types.Context, clobtypes.Order) *clobtypes.OffchainUpdates); ok {
+		r2 = rf(ctx, order)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(types.Context, clobtypes.Order) error); ok {
+		r3 = rf(ctx, order)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // ReplayOperations provides a mock function with given fields: ctx, localOperations, shortTermOrderTxBytes, existingOffchainUpdates
 func (_m *MemClob) ReplayOperations(ctx types.Context, localOperations []clobtypes.InternalOperation, shortTermOrderTxBytes map[clobtypes.OrderHash][]byte, existingOffchainUpdates *clobtypes.OffchainUpdates) *clobtypes.OffchainUpdates {
 	ret := _m.Called(ctx, localOperations, shortTermOrderTxBytes, existingOffchainUpdates)
@@ -47,20 +47,20 @@ func (_m *MemClobKeeper) AddOrderToOrderbookCollatCheck(ctx types.Context, clobP
 	return r0, r1
 }
 
-// CanDeleverageSubaccount provides a mock function with given fields: ctx, subaccountId
-func (_m *MemClobKeeper) CanDeleverageSubaccount(ctx types.Context, subaccountId subaccountstypes.SubaccountId) (bool, error) {
-	ret := _m.Called(ctx, subaccountId)
+// CanDeleverageSubaccount provides a mock function with given fields: ctx, subaccountId, perpetualId
+func (_m *MemClobKeeper) CanDeleverageSubaccount(ctx types.Context, subaccountId subaccountstypes.SubaccountId, perpetualId uint32) (bool, error) {
+	ret := _m.Called(ctx, subaccountId, perpetualId)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId) bool); ok {
-		r0 = rf(ctx, subaccountId)
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, uint32) bool); ok {
+		r0 = rf(ctx, subaccountId, perpetualId)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId) error); ok {
-		r1 = rf(ctx, subaccountId)
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId, uint32) error); ok {
+		r1 = rf(ctx, subaccountId, perpetualId)
 	} else {
 		r1 = ret.Error(1)
 	}
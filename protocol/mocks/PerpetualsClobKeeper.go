@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	big "math/big"
+
 	types "github.com/cosmos/cosmos-sdk/types"
 	perpetualstypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	mock "github.com/stretchr/testify/mock"
@@ -13,6 +15,22 @@ type PerpetualsClobKeeper struct {
 	mock.Mock
 }
 
+// GetInsuranceFundBalance provides a mock function with given fields: ctx, perpetualId
+func (_m *PerpetualsClobKeeper) GetInsuranceFundBalance(ctx types.Context, perpetualId uint32) *big.Int {
+	ret := _m.Called(ctx, perpetualId)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) *big.Int); ok {
+		r0 = rf(ctx, perpetualId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // GetPricePremiumForPerpetual provides a mock function with given fields: ctx, perpetualId, params
 func (_m *PerpetualsClobKeeper) GetPricePremiumForPerpetual(ctx types.Context, perpetualId uint32, params perpetualstypes.GetPricePremiumParams) (int32, error) {
 	ret := _m.Called(ctx, perpetualId, params)
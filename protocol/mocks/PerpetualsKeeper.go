@@ -30,20 +30,20 @@ func (_m *PerpetualsKeeper) AddPremiumVotes(ctx types.Context, votes []perpetual
 	return r0
 }
 
-// CreatePerpetual provides a mock function with given fields: ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier
-func (_m *PerpetualsKeeper) CreatePerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, atomicResolution int32, defaultFundingPpm int32, liquidityTier uint32) (perpetualstypes.Perpetual, error) {
-	ret := _m.Called(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier)
+// CreatePerpetual provides a mock function with given fields: ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier, fundingRateBounds, interestRatePpm, insuranceFundScope
+func (_m *PerpetualsKeeper) CreatePerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, atomicResolution int32, defaultFundingPpm int32, liquidityTier uint32, fundingRateBounds *perpetualstypes.FundingRateBounds, interestRatePpm int32, insuranceFundScope perpetualstypes.InsuranceFundScope) (perpetualstypes.Perpetual, error) {
+	ret := _m.Called(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier, fundingRateBounds, interestRatePpm, insuranceFundScope)
 
 	var r0 perpetualstypes.Perpetual
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, int32, uint32) perpetualstypes.Perpetual); ok {
-		r0 = rf(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, int32, uint32, *perpetualstypes.FundingRateBounds, int32, perpetualstypes.InsuranceFundScope) perpetualstypes.Perpetual); ok {
+		r0 = rf(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier, fundingRateBounds, interestRatePpm, insuranceFundScope)
 	} else {
 		r0 = ret.Get(0).(perpetualstypes.Perpetual)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, int32, uint32) error); ok {
-		r1 = rf(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, int32, uint32, *perpetualstypes.FundingRateBounds, int32, perpetualstypes.InsuranceFundScope) error); ok {
+		r1 = rf(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier, fundingRateBounds, interestRatePpm, insuranceFundScope)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -192,20 +192,20 @@ func (_m *PerpetualsKeeper) MaybeProcessNewFundingTickEpoch(ctx types.Context) {
 	_m.Called(ctx)
 }
 
-// ModifyPerpetual provides a mock function with given fields: ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier
-func (_m *PerpetualsKeeper) ModifyPerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, defaultFundingPpm int32, liquidityTier uint32) (perpetualstypes.Perpetual, error) {
-	ret := _m.Called(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+// ModifyPerpetual provides a mock function with given fields: ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, fundingRateBounds, interestRatePpm, insuranceFundScope
+func (_m *PerpetualsKeeper) ModifyPerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, defaultFundingPpm int32, liquidityTier uint32, fundingRateBounds *perpetualstypes.FundingRateBounds, interestRatePpm int32, insuranceFundScope perpetualstypes.InsuranceFundScope) (perpetualstypes.Perpetual, error) {
+	ret := _m.Called(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, fundingRateBounds, interestRatePpm, insuranceFundScope)
 
 	var r0 perpetualstypes.Perpetual
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, uint32) perpetualstypes.Perpetual); ok {
-		r0 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, uint32, *perpetualstypes.FundingRateBounds, int32, perpetualstypes.InsuranceFundScope) perpetualstypes.Perpetual); ok {
+		r0 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, fundingRateBounds, interestRatePpm, insuranceFundScope)
 	} else {
 		r0 = ret.Get(0).(perpetualstypes.Perpetual)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, uint32) error); ok {
-		r1 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, uint32, *perpetualstypes.FundingRateBounds, int32, perpetualstypes.InsuranceFundScope) error); ok {
+		r1 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, fundingRateBounds, interestRatePpm, insuranceFundScope)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -262,6 +262,20 @@ func (_m *PerpetualsKeeper) SetParams(ctx types.Context, params perpetualstypes.
 	return r0
 }
 
+// ValidateParamsWithEpochs provides a mock function with given fields: ctx, params
+func (_m *PerpetualsKeeper) ValidateParamsWithEpochs(ctx types.Context, params perpetualstypes.Params) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, perpetualstypes.Params) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewPerpetualsKeeper interface {
 	mock.TestingT
 	Cleanup(func())
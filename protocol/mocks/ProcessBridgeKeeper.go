@@ -14,13 +14,13 @@ type ProcessBridgeKeeper struct {
 	mock.Mock
 }
 
-// GetAcknowledgedEventInfo provides a mock function with given fields: ctx
-func (_m *ProcessBridgeKeeper) GetAcknowledgedEventInfo(ctx types.Context) bridgetypes.BridgeEventInfo {
-	ret := _m.Called(ctx)
+// GetAcknowledgedEventInfo provides a mock function with given fields: ctx, sourceId
+func (_m *ProcessBridgeKeeper) GetAcknowledgedEventInfo(ctx types.Context, sourceId uint32) bridgetypes.BridgeEventInfo {
+	ret := _m.Called(ctx, sourceId)
 
 	var r0 bridgetypes.BridgeEventInfo
-	if rf, ok := ret.Get(0).(func(types.Context) bridgetypes.BridgeEventInfo); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) bridgetypes.BridgeEventInfo); ok {
+		r0 = rf(ctx, sourceId)
 	} else {
 		r0 = ret.Get(0).(bridgetypes.BridgeEventInfo)
 	}
@@ -28,20 +28,20 @@ func (_m *ProcessBridgeKeeper) GetAcknowledgedEventInfo(ctx types.Context) bridg
 	return r0
 }
 
-// GetBridgeEventFromServer provides a mock function with given fields: ctx, id
-func (_m *ProcessBridgeKeeper) GetBridgeEventFromServer(ctx types.Context, id uint32) (bridgetypes.BridgeEvent, bool) {
-	ret := _m.Called(ctx, id)
+// GetBridgeEventFromServer provides a mock function with given fields: ctx, sourceId, id
+func (_m *ProcessBridgeKeeper) GetBridgeEventFromServer(ctx types.Context, sourceId uint32, id uint32) (bridgetypes.BridgeEvent, bool) {
+	ret := _m.Called(ctx, sourceId, id)
 
 	var r0 bridgetypes.BridgeEvent
-	if rf, ok := ret.Get(0).(func(types.Context, uint32) bridgetypes.BridgeEvent); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint32) bridgetypes.BridgeEvent); ok {
+		r0 = rf(ctx, sourceId, id)
 	} else {
 		r0 = ret.Get(0).(bridgetypes.BridgeEvent)
 	}
 
 	var r1 bool
-	if rf, ok := ret.Get(1).(func(types.Context, uint32) bool); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, uint32) bool); ok {
+		r1 = rf(ctx, sourceId, id)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
@@ -49,13 +49,27 @@ func (_m *ProcessBridgeKeeper) GetBridgeEventFromServer(ctx types.Context, id ui
 	return r0, r1
 }
 
-// GetRecognizedEventInfo provides a mock function with given fields: ctx
-func (_m *ProcessBridgeKeeper) GetRecognizedEventInfo(ctx types.Context) bridgetypes.BridgeEventInfo {
+// GetEventParams provides a mock function with given fields: ctx
+func (_m *ProcessBridgeKeeper) GetEventParams(ctx types.Context) bridgetypes.EventParams {
 	ret := _m.Called(ctx)
 
-	var r0 bridgetypes.BridgeEventInfo
-	if rf, ok := ret.Get(0).(func(types.Context) bridgetypes.BridgeEventInfo); ok {
+	var r0 bridgetypes.EventParams
+	if rf, ok := ret.Get(0).(func(types.Context) bridgetypes.EventParams); ok {
 		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bridgetypes.EventParams)
+	}
+
+	return r0
+}
+
+// GetRecognizedEventInfo provides a mock function with given fields: ctx, sourceId
+func (_m *ProcessBridgeKeeper) GetRecognizedEventInfo(ctx types.Context, sourceId uint32) bridgetypes.BridgeEventInfo {
+	ret := _m.Called(ctx, sourceId)
+
+	var r0 bridgetypes.BridgeEventInfo
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) bridgetypes.BridgeEventInfo); ok {
+		r0 = rf(ctx, sourceId)
 	} else {
 		r0 = ret.Get(0).(bridgetypes.BridgeEventInfo)
 	}
@@ -56,6 +56,36 @@ func (_m *QueryClient) AddBridgeEvents(ctx context.Context, in *api.AddBridgeEve
 	return r0, r1
 }
 
+// AdlRanks provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AdlRanks(ctx context.Context, in *subaccountstypes.QueryAdlRanksRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryAdlRanksResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *subaccountstypes.QueryAdlRanksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryAdlRanksRequest, ...grpc.CallOption) *subaccountstypes.QueryAdlRanksResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryAdlRanksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryAdlRanksRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AllMarketParams provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) AllMarketParams(ctx context.Context, in *types.QueryAllMarketParamsRequest, opts ...grpc.CallOption) (*types.QueryAllMarketParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -266,6 +296,36 @@ func (_m *QueryClient) LiquidateSubaccounts(ctx context.Context, in *liquidation
 	return r0, r1
 }
 
+// LiquidationCandidates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) LiquidationCandidates(ctx context.Context, in *subaccountstypes.QueryLiquidationCandidatesRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryLiquidationCandidatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *subaccountstypes.QueryLiquidationCandidatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryLiquidationCandidatesRequest, ...grpc.CallOption) *subaccountstypes.QueryLiquidationCandidatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryLiquidationCandidatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryLiquidationCandidatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarketParam provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) MarketParam(ctx context.Context, in *types.QueryMarketParamRequest, opts ...grpc.CallOption) (*types.QueryMarketParamResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -356,6 +416,96 @@ func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtyp
 	return r0, r1
 }
 
+// OrderFillState provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) OrderFillState(ctx context.Context, in *clobtypes.QueryOrderFillStateRequest, opts ...grpc.CallOption) (*clobtypes.QueryOrderFillStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryOrderFillStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryOrderFillStateRequest, ...grpc.CallOption) *clobtypes.QueryOrderFillStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryOrderFillStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryOrderFillStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderbookDepth provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) OrderbookDepth(ctx context.Context, in *clobtypes.QueryOrderbookDepthRequest, opts ...grpc.CallOption) (*clobtypes.QueryOrderbookDepthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryOrderbookDepthResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryOrderbookDepthRequest, ...grpc.CallOption) *clobtypes.QueryOrderbookDepthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryOrderbookDepthResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryOrderbookDepthRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamOrderbookUpdates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) StreamOrderbookUpdates(ctx context.Context, in *clobtypes.StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (clobtypes.Query_StreamOrderbookUpdatesClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 clobtypes.Query_StreamOrderbookUpdatesClient
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.StreamOrderbookUpdatesRequest, ...grpc.CallOption) clobtypes.Query_StreamOrderbookUpdatesClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clobtypes.Query_StreamOrderbookUpdatesClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.StreamOrderbookUpdatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Subaccount provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Subaccount(ctx context.Context, in *subaccountstypes.QueryGetSubaccountRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -416,6 +566,36 @@ func (_m *QueryClient) SubaccountAll(ctx context.Context, in *subaccountstypes.Q
 	return r0, r1
 }
 
+// SubaccountOpenOrders provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountOpenOrders(ctx context.Context, in *clobtypes.QuerySubaccountOpenOrdersRequest, opts ...grpc.CallOption) (*clobtypes.QuerySubaccountOpenOrdersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QuerySubaccountOpenOrdersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QuerySubaccountOpenOrdersRequest, ...grpc.CallOption) *clobtypes.QuerySubaccountOpenOrdersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QuerySubaccountOpenOrdersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QuerySubaccountOpenOrdersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMarketPrices provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) UpdateMarketPrices(ctx context.Context, in *pricefeedapi.UpdateMarketPricesRequest, opts ...grpc.CallOption) (*pricefeedapi.UpdateMarketPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
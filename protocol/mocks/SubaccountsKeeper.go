@@ -154,6 +154,20 @@ func (_m *SubaccountsKeeper) GetSubaccount(ctx types.Context, id subaccountstype
 	return r0
 }
 
+// InitializePositionEntryNotionals provides a mock function with given fields: ctx
+func (_m *SubaccountsKeeper) InitializePositionEntryNotionals(ctx types.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetSubaccount provides a mock function with given fields: ctx, subaccount
 func (_m *SubaccountsKeeper) SetSubaccount(ctx types.Context, subaccount subaccountstypes.Subaccount) {
 	_m.Called(ctx, subaccount)
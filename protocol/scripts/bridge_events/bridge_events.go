@@ -35,7 +35,8 @@ Usage:
 		-totalsupply <total_supply> \
 		-rpc <rpc_node_url> \
 		-address <bridge_contract_address> \
-		-toblock <last_block_inclusive>
+		-toblock <last_block_inclusive> \
+		-sourceid <bridge_source_id>
 */
 func main() {
 	ctx := context.Background()
@@ -45,12 +46,14 @@ func main() {
 	// Get flags.
 	var denom, totalSupply, rpcNode, bridgeAddress string
 	var toBlock int64
+	var sourceId uint
 	var verbose bool
 	flag.StringVar(&denom, "denom", "adv4tnt", "token denom")
 	flag.StringVar(&totalSupply, "totalsupply", "1000000000000000000000000000", "token's total supply (base 10)")
 	flag.StringVar(&rpcNode, "rpc", "https://eth-sepolia.g.alchemy.com/v2/demo", "rpc node url")
 	flag.StringVar(&bridgeAddress, "address", "0xcca9D5f0a3c58b6f02BD0985fC7F9420EA24C1f0", "bridge address")
 	flag.Int64Var(&toBlock, "toblock", 100_000_000, "last block (inclusive)")
+	flag.UintVar(&sourceId, "sourceid", uint(bridgetypes.PrimarySourceId), "bridge source id")
 	flag.BoolVar(&verbose, "verbose", false, "print additional JSON")
 	flag.Parse()
 
@@ -61,6 +64,7 @@ func main() {
 	fmt.Println("rpc:", rpcNode)
 	fmt.Println("address:", bridgeAddress)
 	fmt.Println("toblock:", toBlock)
+	fmt.Println("sourceid:", sourceId)
 	fmt.Println()
 
 	// ------------ INPUT VALIDATION ------------
@@ -69,6 +73,8 @@ func main() {
 	if !ok {
 		log.Fatal("invalid total supply")
 	}
+	// Validate `-sourceid`.
+	bridgeSourceId := lib.MustConvertIntegerToUint32(sourceId)
 
 	// ------------ LOGIC ------------
 
@@ -103,13 +109,14 @@ func main() {
 	aei := bridgetypes.BridgeEventInfo{
 		NextId:         0,
 		EthBlockHeight: 0,
+		SourceId:       bridgeSourceId,
 	}
 	balances := make(map[string]*big.Int)
 	totalAmountBridged := big.NewInt(0)
 
 	// Iterate over each event and populate the above fields
 	for _, log := range logs {
-		event := libeth.BridgeLogToEvent(log, denom)
+		event := libeth.BridgeLogToEvent(log, bridgeSourceId, denom)
 		aei.NextId = lib.Max(aei.NextId, event.Id)
 		aei.EthBlockHeight = lib.Max(aei.EthBlockHeight, log.BlockNumber)
 
@@ -137,16 +144,30 @@ func main() {
 	bridgeModAccBalance := totalSupplyBigInt.Sub(totalSupplyBigInt, totalAmountBridged)
 	fmt.Printf("Remaining bridge module account balance: %s\n", bridgeModAccBalance.String())
 
-	// Print x/bridge event params.
-	eventParams := bridgetypes.EventParams{
-		Denom:      denom,
-		EthChainId: chainId.Uint64(),
-		EthAddress: bridgeAddress,
-	}
-	fmt.Printf("\"bridge.event_params\": %s\n", mustJson(eventParams))
+	if bridgeSourceId == bridgetypes.PrimarySourceId {
+		// Print x/bridge event params.
+		eventParams := bridgetypes.EventParams{
+			Denom:      denom,
+			EthChainId: chainId.Uint64(),
+			EthAddress: bridgeAddress,
+		}
+		fmt.Printf("\"bridge.event_params\": %s\n", mustJson(eventParams))
+
+		// Print x/bridge acknowledged event info.
+		fmt.Printf("\"bridge.acknowledged_event_info\": %s\n", mustJson(aei))
+	} else {
+		// Print x/bridge additional source.
+		source := bridgetypes.BridgeSource{
+			Id:         bridgeSourceId,
+			Denom:      denom,
+			EthChainId: chainId.Uint64(),
+			EthAddress: bridgeAddress,
+		}
+		fmt.Printf("\"bridge.event_params.additional_sources[]\": %s\n", mustJson(source))
 
-	// Print x/bridge acknowledged event info.
-	fmt.Printf("\"bridge.acknowledged_event_info\": %s\n", mustJson(aei))
+		// Print x/bridge acknowledged event info of the additional source.
+		fmt.Printf("\"bridge.additional_acknowledged_event_infos[]\": %s\n", mustJson(aei))
+	}
 
 	// Print x/bank balances sorted by address.
 	bankBalances := make([]banktypes.Balance, 0)
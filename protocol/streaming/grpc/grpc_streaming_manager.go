@@ -0,0 +1,323 @@
+package grpc
+
+import (
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// Ensure the `GrpcStreamingManager` interface is implemented at compile time.
+var _ types.GrpcStreamingManager = (*GrpcStreamingManagerImpl)(nil)
+
+// GrpcStreamingManagerImpl is an implementation for managing gRPC streaming subscriptions.
+// Subscriptions are added and removed from gRPC goroutines, while snapshots and updates are
+// enqueued from the main application goroutine, so all subscription state is guarded by a mutex.
+// Responses are sent to each subscriber from a dedicated goroutine so that a slow subscriber
+// never blocks the main application goroutine.
+type GrpcStreamingManagerImpl struct {
+	sync.Mutex
+
+	logger log.Logger
+
+	// orderbookSubscriptions maps subscription IDs to their respective orderbook subscriptions.
+	orderbookSubscriptions map[uint32]*OrderbookSubscription
+	nextSubscriptionId     uint32
+
+	// Maximum number of responses buffered for each subscription. Subscriptions which fall
+	// further behind are removed.
+	bufferSize uint32
+}
+
+// OrderbookSubscription represents an active subscription to the orderbook updates stream.
+type OrderbookSubscription struct {
+	// Whether an orderbook snapshot has been sent to the subscriber. Incremental updates are
+	// only sent once the subscription has been initialized with a snapshot.
+	initialized bool
+
+	// Clob pair ids to subscribe to.
+	clobPairIds map[uint32]struct{}
+
+	// Stream to send responses to.
+	srv clobtypes.Query_StreamOrderbookUpdatesServer
+
+	// Buffered channel of responses waiting to be sent to the stream by the sender goroutine.
+	responses chan *clobtypes.StreamOrderbookUpdatesResponse
+
+	// Closed when the subscription is removed, which stops the sender goroutine.
+	done chan struct{}
+}
+
+// clobPairUpdate is an off-chain update along with the ID of the clob pair it affects.
+type clobPairUpdate struct {
+	clobPairId uint32
+	update     ocutypes.OffChainUpdateV1
+}
+
+func NewGrpcStreamingManager(
+	logger log.Logger,
+	bufferSize uint32,
+) *GrpcStreamingManagerImpl {
+	return &GrpcStreamingManagerImpl{
+		logger:                 logger,
+		orderbookSubscriptions: make(map[uint32]*OrderbookSubscription),
+		bufferSize:             bufferSize,
+	}
+}
+
+func (sm *GrpcStreamingManagerImpl) Enabled() bool {
+	return true
+}
+
+// Subscribe registers a new subscription to orderbook updates for the clob pairs in the request
+// and starts the goroutine which sends responses to the stream. It returns the ID of the
+// subscription and a channel which is closed once the subscription has been removed, either
+// by `Unsubscribe` or because the subscriber could not keep up with the updates. The
+// subscription will not receive any updates until it has been initialized with an orderbook
+// snapshot in `InitializeNewStreams`.
+func (sm *GrpcStreamingManagerImpl) Subscribe(
+	req clobtypes.StreamOrderbookUpdatesRequest,
+	srv clobtypes.Query_StreamOrderbookUpdatesServer,
+) (
+	subscriptionId uint32,
+	done <-chan struct{},
+	err error,
+) {
+	if len(req.GetClobPairId()) == 0 {
+		return 0, nil, errorsmod.Wrap(
+			clobtypes.ErrInvalidGrpcStreamingRequest,
+			"at least one clob pair id must be provided",
+		)
+	}
+
+	clobPairIds := make(map[uint32]struct{}, len(req.GetClobPairId()))
+	for _, clobPairId := range req.GetClobPairId() {
+		clobPairIds[clobPairId] = struct{}{}
+	}
+	subscription := &OrderbookSubscription{
+		clobPairIds: clobPairIds,
+		srv:         srv,
+		responses:   make(chan *clobtypes.StreamOrderbookUpdatesResponse, sm.bufferSize),
+		done:        make(chan struct{}),
+	}
+
+	sm.Lock()
+	defer sm.Unlock()
+
+	subscriptionId = sm.nextSubscriptionId
+	sm.orderbookSubscriptions[subscriptionId] = subscription
+	sm.nextSubscriptionId++
+
+	sm.logger.Info(
+		"New orderbook updates subscription",
+		"subscriptionId", subscriptionId,
+		"clobPairIds", req.GetClobPairId(),
+	)
+
+	go sm.sendResponses(subscriptionId, subscription)
+	return subscriptionId, subscription.done, nil
+}
+
+// Unsubscribe removes the subscription with the provided ID, if it exists.
+func (sm *GrpcStreamingManagerImpl) Unsubscribe(
+	subscriptionId uint32,
+) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	sm.removeSubscription(subscriptionId)
+}
+
+// InitializeNewStreams sends an orderbook snapshot to every subscription which has not yet been
+// initialized. The snapshot contains the L3 orderbook updates returned by `getOrderbookSnapshot`
+// and the L2 price levels returned by `getOrderbookL2Snapshot`, both of which are fetched at most
+// once per clob pair. This method must be called from the main application goroutine since the
+// snapshots are read from the memclob.
+func (sm *GrpcStreamingManagerImpl) InitializeNewStreams(
+	getOrderbookSnapshot func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates,
+	getOrderbookL2Snapshot func(clobPairId clobtypes.ClobPairId) clobtypes.OrderbookL2Snapshot,
+) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	snapshots := make(map[uint32][]clobPairUpdate)
+	l2Snapshots := make(map[uint32]clobtypes.OrderbookL2Snapshot)
+	for _, subscriptionId := range lib.GetSortedKeys[lib.Sortable[uint32]](sm.orderbookSubscriptions) {
+		subscription := sm.orderbookSubscriptions[subscriptionId]
+		if subscription.initialized {
+			continue
+		}
+
+		updates := make([]ocutypes.OffChainUpdateV1, 0)
+		subscriptionL2Snapshots := make([]clobtypes.OrderbookL2Snapshot, 0, len(subscription.clobPairIds))
+		for _, clobPairId := range lib.GetSortedKeys[lib.Sortable[uint32]](subscription.clobPairIds) {
+			snapshot, exists := snapshots[clobPairId]
+			if !exists {
+				snapshot = sm.getClobPairUpdates(getOrderbookSnapshot(clobtypes.ClobPairId(clobPairId)))
+				snapshots[clobPairId] = snapshot
+			}
+			for _, clobPairUpdate := range snapshot {
+				updates = append(updates, clobPairUpdate.update)
+			}
+
+			l2Snapshot, exists := l2Snapshots[clobPairId]
+			if !exists {
+				l2Snapshot = getOrderbookL2Snapshot(clobtypes.ClobPairId(clobPairId))
+				l2Snapshots[clobPairId] = l2Snapshot
+			}
+			subscriptionL2Snapshots = append(subscriptionL2Snapshots, l2Snapshot)
+		}
+
+		if sm.enqueueResponse(
+			subscriptionId,
+			subscription,
+			&clobtypes.StreamOrderbookUpdatesResponse{
+				Updates:     updates,
+				Snapshot:    true,
+				L2Snapshots: subscriptionL2Snapshots,
+			},
+		) {
+			subscription.initialized = true
+		}
+	}
+}
+
+// SendOrderbookUpdates sends the provided off-chain updates to every initialized subscription
+// which is subscribed to at least one of the clob pairs affected by the updates. The updates are
+// only decoded if there is at least one subscription.
+func (sm *GrpcStreamingManagerImpl) SendOrderbookUpdates(
+	offchainUpdates *clobtypes.OffchainUpdates,
+	snapshot bool,
+) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	if len(sm.orderbookSubscriptions) == 0 {
+		return
+	}
+
+	clobPairUpdates := sm.getClobPairUpdates(offchainUpdates)
+	if len(clobPairUpdates) == 0 {
+		return
+	}
+
+	for _, subscriptionId := range lib.GetSortedKeys[lib.Sortable[uint32]](sm.orderbookSubscriptions) {
+		subscription := sm.orderbookSubscriptions[subscriptionId]
+		if !subscription.initialized {
+			continue
+		}
+
+		updates := make([]ocutypes.OffChainUpdateV1, 0)
+		for _, clobPairUpdate := range clobPairUpdates {
+			if _, exists := subscription.clobPairIds[clobPairUpdate.clobPairId]; exists {
+				updates = append(updates, clobPairUpdate.update)
+			}
+		}
+
+		if len(updates) > 0 {
+			sm.enqueueResponse(
+				subscriptionId,
+				subscription,
+				&clobtypes.StreamOrderbookUpdatesResponse{
+					Updates:  updates,
+					Snapshot: snapshot,
+				},
+			)
+		}
+	}
+}
+
+// enqueueResponse adds the response to the buffer of the subscription without blocking and returns
+// true if the response was buffered. Subscriptions whose buffer is full are removed, since skipping
+// a response would leave the subscriber with an inconsistent orderbook. Note that the caller must
+// hold the lock.
+func (sm *GrpcStreamingManagerImpl) enqueueResponse(
+	subscriptionId uint32,
+	subscription *OrderbookSubscription,
+	response *clobtypes.StreamOrderbookUpdatesResponse,
+) bool {
+	select {
+	case subscription.responses <- response:
+		return true
+	default:
+		sm.logger.Error(
+			"Orderbook updates subscription buffer is full, removing subscription",
+			"subscriptionId", subscriptionId,
+			"bufferSize", sm.bufferSize,
+		)
+		sm.removeSubscription(subscriptionId)
+		return false
+	}
+}
+
+// sendResponses sends the buffered responses of the subscription to its stream until the
+// subscription is removed. The subscription is removed if a response fails to send.
+func (sm *GrpcStreamingManagerImpl) sendResponses(
+	subscriptionId uint32,
+	subscription *OrderbookSubscription,
+) {
+	for {
+		select {
+		case <-subscription.done:
+			return
+		case response := <-subscription.responses:
+			if err := subscription.srv.Send(response); err != nil {
+				sm.logger.Error(
+					"Failed to send orderbook updates, removing subscription",
+					"subscriptionId", subscriptionId,
+					"error", err,
+				)
+				sm.Unsubscribe(subscriptionId)
+				return
+			}
+		}
+	}
+}
+
+// removeSubscription removes the subscription with the provided ID, if it exists, and stops its
+// sender goroutine. Note that the caller must hold the lock.
+func (sm *GrpcStreamingManagerImpl) removeSubscription(
+	subscriptionId uint32,
+) {
+	subscription, exists := sm.orderbookSubscriptions[subscriptionId]
+	if !exists {
+		return
+	}
+	close(subscription.done)
+	delete(sm.orderbookSubscriptions, subscriptionId)
+}
+
+// getClobPairUpdates decodes the off-chain update messages, preserving their order. Messages which
+// cannot be decoded are logged and skipped.
+func (sm *GrpcStreamingManagerImpl) getClobPairUpdates(
+	offchainUpdates *clobtypes.OffchainUpdates,
+) []clobPairUpdate {
+	if offchainUpdates == nil {
+		return nil
+	}
+
+	clobPairUpdates := make([]clobPairUpdate, 0, len(offchainUpdates.Messages))
+	for _, message := range offchainUpdates.Messages {
+		var update ocutypes.OffChainUpdateV1
+		if err := update.Unmarshal(message.Message.Value); err != nil {
+			sm.logger.Error(
+				"Failed to unmarshal off-chain update",
+				"orderId", message.OrderId,
+				"error", err,
+			)
+			continue
+		}
+		clobPairUpdates = append(
+			clobPairUpdates,
+			clobPairUpdate{
+				clobPairId: message.OrderId.ClobPairId,
+				update:     update,
+			},
+		)
+	}
+	return clobPairUpdates
+}
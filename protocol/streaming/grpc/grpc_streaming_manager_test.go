@@ -0,0 +1,320 @@
+package grpc_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	streaming "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const bufferSize = 100
+
+// fakeStreamServer records all responses sent on the stream and optionally fails to send or
+// blocks until unblocked. Responses are sent from the sender goroutine of the subscription, so
+// all state is guarded by a mutex.
+type fakeStreamServer struct {
+	grpc.ServerStream
+
+	sync.Mutex
+	responses []*clobtypes.StreamOrderbookUpdatesResponse
+	sendErr   error
+	blocked   chan struct{}
+}
+
+func (f *fakeStreamServer) Send(response *clobtypes.StreamOrderbookUpdatesResponse) error {
+	if f.blocked != nil {
+		<-f.blocked
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	f.responses = append(f.responses, response)
+	return nil
+}
+
+func (f *fakeStreamServer) setSendErr(err error) {
+	f.Lock()
+	defer f.Unlock()
+	f.sendErr = err
+}
+
+func (f *fakeStreamServer) getResponses() []*clobtypes.StreamOrderbookUpdatesResponse {
+	f.Lock()
+	defer f.Unlock()
+	return append([]*clobtypes.StreamOrderbookUpdatesResponse{}, f.responses...)
+}
+
+// requireResponsesEventually waits until the stream has received the expected number of responses.
+func requireResponsesEventually(t *testing.T, srv *fakeStreamServer, numResponses int) {
+	require.Eventually(
+		t,
+		func() bool { return len(srv.getResponses()) == numResponses },
+		time.Second,
+		time.Millisecond,
+	)
+}
+
+// requireClosedEventually waits until the channel is closed.
+func requireClosedEventually(t *testing.T, done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "subscription was not removed")
+	}
+}
+
+func emptyL2Snapshot(clobPairId clobtypes.ClobPairId) clobtypes.OrderbookL2Snapshot {
+	return clobtypes.OrderbookL2Snapshot{ClobPairId: clobPairId.ToUint32()}
+}
+
+func createPlaceUpdates(t *testing.T, orders ...clobtypes.Order) (
+	offchainUpdates *clobtypes.OffchainUpdates,
+	updates []ocutypes.OffChainUpdateV1,
+) {
+	offchainUpdates = clobtypes.NewOffchainUpdates()
+	updates = make([]ocutypes.OffChainUpdateV1, 0, len(orders))
+	for _, order := range orders {
+		message, success := off_chain_updates.CreateOrderPlaceMessage(log.NewNopLogger(), order)
+		require.True(t, success)
+		offchainUpdates.AddPlaceMessage(order.OrderId, message)
+
+		var update ocutypes.OffChainUpdateV1
+		require.NoError(t, update.Unmarshal(message.Value))
+		updates = append(updates, update)
+	}
+	return offchainUpdates, updates
+}
+
+func TestSubscribe_NoClobPairIds(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), bufferSize)
+
+	_, _, err := manager.Subscribe(clobtypes.StreamOrderbookUpdatesRequest{}, &fakeStreamServer{})
+	require.ErrorIs(t, err, clobtypes.ErrInvalidGrpcStreamingRequest)
+}
+
+func TestUnsubscribe_ClosesSubscription(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), bufferSize)
+
+	subscriptionId, done, err := manager.Subscribe(
+		clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{0}},
+		&fakeStreamServer{},
+	)
+	require.NoError(t, err)
+
+	manager.Unsubscribe(subscriptionId)
+	requireClosedEventually(t, done)
+
+	// Unsubscribing again is a no-op.
+	manager.Unsubscribe(subscriptionId)
+}
+
+func TestInitializeNewStreams(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), bufferSize)
+	clob0Updates, expectedClob0Updates := createPlaceUpdates(
+		t,
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+	)
+	clob1Updates, expectedClob1Updates := createPlaceUpdates(
+		t,
+		constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+	)
+	snapshots := map[clobtypes.ClobPairId]*clobtypes.OffchainUpdates{
+		0: clob0Updates,
+		1: clob1Updates,
+	}
+	l2Snapshots := map[clobtypes.ClobPairId]clobtypes.OrderbookL2Snapshot{
+		0: {
+			ClobPairId: 0,
+			Bids:       []clobtypes.OrderbookLevel{{Subticks: 10, Quantums: 5, NumOrders: 1}},
+		},
+		1: {
+			ClobPairId: 1,
+			Asks:       []clobtypes.OrderbookLevel{{Subticks: 15, Quantums: 10, NumOrders: 1}},
+		},
+	}
+	numSnapshotCalls := 0
+	getOrderbookSnapshot := func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+		numSnapshotCalls++
+		return snapshots[clobPairId]
+	}
+	numL2SnapshotCalls := 0
+	getOrderbookL2Snapshot := func(clobPairId clobtypes.ClobPairId) clobtypes.OrderbookL2Snapshot {
+		numL2SnapshotCalls++
+		return l2Snapshots[clobPairId]
+	}
+
+	srv0 := &fakeStreamServer{}
+	_, _, err := manager.Subscribe(clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{1, 0, 1}}, srv0)
+	require.NoError(t, err)
+	srv1 := &fakeStreamServer{}
+	_, _, err = manager.Subscribe(clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{1}}, srv1)
+	require.NoError(t, err)
+
+	// Updates are not sent to subscriptions before they have been initialized.
+	manager.SendOrderbookUpdates(clob0Updates, false)
+
+	// Each subscription receives a single snapshot, and snapshots are only generated once per clob pair.
+	manager.InitializeNewStreams(getOrderbookSnapshot, getOrderbookL2Snapshot)
+	require.Equal(t, 2, numSnapshotCalls)
+	require.Equal(t, 2, numL2SnapshotCalls)
+	requireResponsesEventually(t, srv0, 1)
+	require.Equal(
+		t,
+		[]*clobtypes.StreamOrderbookUpdatesResponse{
+			{
+				Updates:     append(expectedClob0Updates, expectedClob1Updates...),
+				Snapshot:    true,
+				L2Snapshots: []clobtypes.OrderbookL2Snapshot{l2Snapshots[0], l2Snapshots[1]},
+			},
+		},
+		srv0.getResponses(),
+	)
+	requireResponsesEventually(t, srv1, 1)
+	require.Equal(
+		t,
+		[]*clobtypes.StreamOrderbookUpdatesResponse{
+			{
+				Updates:     expectedClob1Updates,
+				Snapshot:    true,
+				L2Snapshots: []clobtypes.OrderbookL2Snapshot{l2Snapshots[1]},
+			},
+		},
+		srv1.getResponses(),
+	)
+
+	// Initialized subscriptions do not receive another snapshot.
+	manager.InitializeNewStreams(getOrderbookSnapshot, getOrderbookL2Snapshot)
+	require.Equal(t, 2, numSnapshotCalls)
+	require.Equal(t, 2, numL2SnapshotCalls)
+	require.Never(
+		t,
+		func() bool { return len(srv0.getResponses()) > 1 || len(srv1.getResponses()) > 1 },
+		50*time.Millisecond,
+		time.Millisecond,
+	)
+}
+
+func TestSendOrderbookUpdates(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), bufferSize)
+	offchainUpdates, expectedUpdates := createPlaceUpdates(
+		t,
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+		constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+		constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+	)
+	getOrderbookSnapshot := func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+		return clobtypes.NewOffchainUpdates()
+	}
+
+	srv0 := &fakeStreamServer{}
+	_, _, err := manager.Subscribe(clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{0}}, srv0)
+	require.NoError(t, err)
+	srv1 := &fakeStreamServer{}
+	_, _, err = manager.Subscribe(clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{0, 1}}, srv1)
+	require.NoError(t, err)
+	srv2 := &fakeStreamServer{}
+	_, _, err = manager.Subscribe(clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{2}}, srv2)
+	require.NoError(t, err)
+	manager.InitializeNewStreams(getOrderbookSnapshot, emptyL2Snapshot)
+
+	manager.SendOrderbookUpdates(offchainUpdates, false)
+
+	// Subscriptions only receive updates for their clob pairs, in the order they were generated.
+	requireResponsesEventually(t, srv0, 2)
+	require.Equal(
+		t,
+		&clobtypes.StreamOrderbookUpdatesResponse{
+			Updates: []ocutypes.OffChainUpdateV1{expectedUpdates[0], expectedUpdates[2]},
+		},
+		srv0.getResponses()[1],
+	)
+	requireResponsesEventually(t, srv1, 2)
+	require.Equal(
+		t,
+		&clobtypes.StreamOrderbookUpdatesResponse{
+			Updates: expectedUpdates,
+		},
+		srv1.getResponses()[1],
+	)
+
+	// Subscriptions without any relevant updates are not sent a response.
+	requireResponsesEventually(t, srv2, 1)
+}
+
+func TestSendOrderbookUpdates_SendFailureRemovesSubscription(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), bufferSize)
+	offchainUpdates, _ := createPlaceUpdates(
+		t,
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+	)
+	getOrderbookSnapshot := func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+		return clobtypes.NewOffchainUpdates()
+	}
+
+	srv := &fakeStreamServer{}
+	_, done, err := manager.Subscribe(clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{0}}, srv)
+	require.NoError(t, err)
+	manager.InitializeNewStreams(getOrderbookSnapshot, emptyL2Snapshot)
+	requireResponsesEventually(t, srv, 1)
+
+	// The subscription is removed after failing to send.
+	srv.setSendErr(errors.New("stream closed"))
+	manager.SendOrderbookUpdates(offchainUpdates, false)
+	requireClosedEventually(t, done)
+
+	// No further sends are attempted once the subscription has been removed.
+	srv.setSendErr(nil)
+	manager.SendOrderbookUpdates(offchainUpdates, false)
+	require.Never(
+		t,
+		func() bool { return len(srv.getResponses()) > 1 },
+		50*time.Millisecond,
+		time.Millisecond,
+	)
+}
+
+func TestSendOrderbookUpdates_FullBufferRemovesSubscription(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), 1)
+	offchainUpdates, _ := createPlaceUpdates(
+		t,
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+	)
+	getOrderbookSnapshot := func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+		return clobtypes.NewOffchainUpdates()
+	}
+
+	// The stream blocks on every send, so the sender goroutine is stuck on the snapshot.
+	srv := &fakeStreamServer{blocked: make(chan struct{})}
+	defer close(srv.blocked)
+	_, done, err := manager.Subscribe(clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{0}}, srv)
+	require.NoError(t, err)
+	manager.InitializeNewStreams(getOrderbookSnapshot, emptyL2Snapshot)
+
+	// Sending updates does not block on the slow subscriber, which is removed once its buffer is full.
+	manager.SendOrderbookUpdates(offchainUpdates, false)
+	manager.SendOrderbookUpdates(offchainUpdates, false)
+	requireClosedEventually(t, done)
+}
+
+func TestNoopGrpcStreamingManager(t *testing.T) {
+	manager := streaming.NewNoopGrpcStreamingManager()
+	require.False(t, manager.Enabled())
+
+	_, _, err := manager.Subscribe(
+		clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{0}},
+		&fakeStreamServer{},
+	)
+	require.ErrorIs(t, err, clobtypes.ErrGrpcStreamingManagerNotEnabled)
+}
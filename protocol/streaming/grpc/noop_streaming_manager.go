@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// Ensure the `GrpcStreamingManager` interface is implemented at compile time.
+var _ types.GrpcStreamingManager = (*NoopGrpcStreamingManager)(nil)
+
+// NoopGrpcStreamingManager is a `GrpcStreamingManager` used when gRPC streaming is disabled.
+type NoopGrpcStreamingManager struct{}
+
+func NewNoopGrpcStreamingManager() *NoopGrpcStreamingManager {
+	return &NoopGrpcStreamingManager{}
+}
+
+func (sm *NoopGrpcStreamingManager) Enabled() bool {
+	return false
+}
+
+func (sm *NoopGrpcStreamingManager) Subscribe(
+	req clobtypes.StreamOrderbookUpdatesRequest,
+	srv clobtypes.Query_StreamOrderbookUpdatesServer,
+) (
+	subscriptionId uint32,
+	done <-chan struct{},
+	err error,
+) {
+	return 0, nil, clobtypes.ErrGrpcStreamingManagerNotEnabled
+}
+
+func (sm *NoopGrpcStreamingManager) Unsubscribe(
+	subscriptionId uint32,
+) {
+}
+
+func (sm *NoopGrpcStreamingManager) InitializeNewStreams(
+	getOrderbookSnapshot func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates,
+	getOrderbookL2Snapshot func(clobPairId clobtypes.ClobPairId) clobtypes.OrderbookL2Snapshot,
+) {
+}
+
+func (sm *NoopGrpcStreamingManager) SendOrderbookUpdates(
+	offchainUpdates *clobtypes.OffchainUpdates,
+	snapshot bool,
+) {
+}
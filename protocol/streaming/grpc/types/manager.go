@@ -0,0 +1,25 @@
+package types
+
+import (
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+type GrpcStreamingManager interface {
+	Enabled() bool
+
+	// L3 gRPC stream subscription methods.
+	Subscribe(
+		req clobtypes.StreamOrderbookUpdatesRequest,
+		srv clobtypes.Query_StreamOrderbookUpdatesServer,
+	) (
+		subscriptionId uint32,
+		done <-chan struct{},
+		err error,
+	)
+	Unsubscribe(subscriptionId uint32)
+	InitializeNewStreams(
+		getOrderbookSnapshot func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates,
+		getOrderbookL2Snapshot func(clobPairId clobtypes.ClobPairId) clobtypes.OrderbookL2Snapshot,
+	)
+	SendOrderbookUpdates(offchainUpdates *clobtypes.OffchainUpdates, snapshot bool)
+}
@@ -152,6 +152,8 @@ function edit_genesis() {
 	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.funding_rate_clamp_factor_ppm' -v '6000000' # 600 % (same as 75% on hourly rate)
 	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.premium_vote_clamp_factor_ppm' -v '60000000' # 6000 % (some multiples of funding rate clamp factor)
 	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.min_num_votes_per_sample' -v '15' # half of expected number of votes
+	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.funding_rate_period_seconds' -v '28800' # 8 hours
+	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.removed_tail_sample_ratio_ppm' -v '0' # samples are already medians of premium votes
 
 	# Perpetuals.
 	dasel put -t json -f "$GENESIS" '.app_state.perpetuals.perpetuals' -v "[]"
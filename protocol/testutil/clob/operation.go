@@ -159,6 +159,25 @@ func NewShortTermOrderPlacementOperationRaw(order types.Order) types.OperationRa
 	}
 }
 
+// NewShortTermOrderBatchPlacementOperationRaw returns a new raw operation for placing the order at
+// the provided index of a batch.
+func NewShortTermOrderBatchPlacementOperationRaw(
+	msg *types.MsgBatchPlaceAndCancel,
+	index uint32,
+) types.OperationRaw {
+	// Create new tx that wraps the msg
+	bytes := buildTxAndGetBytesFromMsg(msg)
+
+	return types.OperationRaw{
+		Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+			ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+				TxBytes: bytes,
+				Index:   index,
+			},
+		},
+	}
+}
+
 // NewMatchOperationRaw returns a new raw operation for matching maker orders against a matchable order.
 func NewMatchOperationRaw(
 	takerMatchableOrder types.MatchableOrder,
@@ -244,3 +263,20 @@ func NewOrderRemovalOperationRaw(
 		},
 	}
 }
+
+// NewSelfTradeOrderRemovalOperationRaw returns a new raw order removal operation for an order which
+// self-traded with the order with ID `selfTradeOrderId`.
+func NewSelfTradeOrderRemovalOperationRaw(
+	orderId types.OrderId,
+	selfTradeOrderId *types.OrderId,
+) types.OperationRaw {
+	return types.OperationRaw{
+		Operation: &types.OperationRaw_OrderRemoval{
+			OrderRemoval: &types.OrderRemoval{
+				OrderId:          orderId,
+				RemovalReason:    types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+				SelfTradeOrderId: selfTradeOrderId,
+			},
+		},
+	}
+}
@@ -41,6 +41,19 @@ var (
 		AtomicResolution: int32(-8),
 	}
 
+	// BtcUsd_90PercentCollateralWeight is BTC that counts towards the net collateral of
+	// a subaccount at 90% of its oracle value.
+	BtcUsd_90PercentCollateralWeight = &asstypes.Asset{
+		Id:                  1,
+		Symbol:              "BTC",
+		Denom:               "btc-denom",
+		DenomExponent:       int32(-8),
+		HasMarket:           true,
+		MarketId:            uint32(0),
+		AtomicResolution:    int32(-8),
+		CollateralWeightPpm: 900_000,
+	}
+
 	Usdc = &asstypes.Asset{
 		Id:               0,
 		Symbol:           "USDC",
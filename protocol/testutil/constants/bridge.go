@@ -29,6 +29,11 @@ func init() {
 
 	_ = TestTxBuilder.SetMsgs(MsgAcknowledgeBridges_Ids0_55_Height0)
 	MsgAcknowledgeBridges_Ids0_55_Height0_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
+
+	_ = TestTxBuilder.SetMsgs(MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1)
+	MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(
+		TestTxBuilder.GetTx(),
+	)
 }
 
 var (
@@ -37,6 +42,10 @@ var (
 		Denom:  "adv4tnt",
 		Amount: sdkmath.NewIntFromUint64(888),
 	}
+	source1Coin = sdk.Coin{
+		Denom:  "source1-token",
+		Amount: sdkmath.NewIntFromUint64(777),
+	}
 
 	// Public
 	GovModuleAccAddressString      = authtypes.NewModuleAddress(govtypes.ModuleName).String()
@@ -72,6 +81,20 @@ var (
 		Coin:           coin,
 		EthBlockHeight: 15,
 	}
+	BridgeEvent_Source1_Id0_Height2 = types.BridgeEvent{
+		Id:             0,
+		Address:        AliceAccAddress.String(),
+		Coin:           source1Coin,
+		EthBlockHeight: 2,
+		SourceId:       1,
+	}
+	BridgeEvent_Source1_Id1_Height4 = types.BridgeEvent{
+		Id:             1,
+		Address:        CarlAccAddress.String(),
+		Coin:           source1Coin,
+		EthBlockHeight: 4,
+		SourceId:       1,
+	}
 
 	// Acknowledge Bridges Tx.
 	MsgAcknowledgeBridges_NoEvents = &types.MsgAcknowledgeBridges{
@@ -116,6 +139,15 @@ var (
 	}
 	MsgAcknowledgeBridges_Ids0_55_Height0_TxBytes []byte
 
+	MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1 = &types.MsgAcknowledgeBridges{
+		Events: []types.BridgeEvent{
+			BridgeEvent_Id0_Height0,
+			BridgeEvent_Source1_Id0_Height2,
+			BridgeEvent_Source1_Id1_Height4,
+		},
+	}
+	MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1_TxBytes []byte
+
 	// Event Info.
 	AcknowledgedEventInfo_Id0_Height0 = types.BridgeEventInfo{
 		NextId:         0,
@@ -125,6 +157,16 @@ var (
 		NextId:         2,
 		EthBlockHeight: 0,
 	}
+	AcknowledgedEventInfo_Source1_Id0_Height0 = types.BridgeEventInfo{
+		NextId:         0,
+		EthBlockHeight: 0,
+		SourceId:       1,
+	}
+	RecognizedEventInfo_Source1_Id2_Height4 = types.BridgeEventInfo{
+		NextId:         2,
+		EthBlockHeight: 4,
+		SourceId:       1,
+	}
 
 	// Eth Chain ID.
 	EthChainId = 11155111
@@ -231,6 +273,20 @@ var (
 		EthChainId: uint64(EthChainId),
 		EthAddress: AliceAccAddress.String(),
 	}
+	// Additional bridge source.
+	BridgeSource_1 = types.BridgeSource{
+		Id:         1,
+		Denom:      source1Coin.Denom,
+		EthChainId: 421614,
+		EthAddress: BobAccAddress.String(),
+	}
+	// Event Params with an additional bridge source.
+	EventParams_WithSource1 = types.EventParams{
+		Denom:             coin.Denom,
+		EthChainId:        uint64(EthChainId),
+		EthAddress:        AliceAccAddress.String(),
+		AdditionalSources: []types.BridgeSource{BridgeSource_1},
+	}
 	// Propose Params.
 	ProposeParams = types.ProposeParams{
 		MaxBridgesPerBlock:           2,
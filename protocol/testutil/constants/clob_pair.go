@@ -9,7 +9,7 @@ var (
 		Id: 1000,
 		Metadata: &clobtypes.ClobPair_SpotClobMetadata{
 			SpotClobMetadata: &clobtypes.SpotClobMetadata{
-				BaseAssetId:  0,
+				BaseAssetId:  1,
 				QuoteAssetId: 0,
 			},
 		},
@@ -58,8 +58,8 @@ var (
 		Id: 100,
 		Metadata: &clobtypes.ClobPair_SpotClobMetadata{
 			SpotClobMetadata: &clobtypes.SpotClobMetadata{
-				BaseAssetId:  0,
-				QuoteAssetId: 1,
+				BaseAssetId:  1,
+				QuoteAssetId: 0,
 			},
 		},
 		StepBaseQuantums:          1000,
@@ -115,4 +115,28 @@ var (
 		QuantumConversionExponent: -8,
 		Status:                    clobtypes.ClobPair_STATUS_PAUSED,
 	}
+	ClobPair_Btc_CancelOnly = clobtypes.ClobPair{
+		Id: 0,
+		Metadata: &clobtypes.ClobPair_PerpetualClobMetadata{
+			PerpetualClobMetadata: &clobtypes.PerpetualClobMetadata{
+				PerpetualId: 0,
+			},
+		},
+		StepBaseQuantums:          5,
+		SubticksPerTick:           5,
+		QuantumConversionExponent: -8,
+		Status:                    clobtypes.ClobPair_STATUS_CANCEL_ONLY,
+	}
+	ClobPair_Btc_PostOnly = clobtypes.ClobPair{
+		Id: 0,
+		Metadata: &clobtypes.ClobPair_PerpetualClobMetadata{
+			PerpetualClobMetadata: &clobtypes.PerpetualClobMetadata{
+				PerpetualId: 0,
+			},
+		},
+		StepBaseQuantums:          5,
+		SubticksPerTick:           5,
+		QuantumConversionExponent: -8,
+		Status:                    clobtypes.ClobPair_STATUS_POST_ONLY,
+	}
 )
@@ -879,15 +879,20 @@ const GenesisState = `{
       ],
       "params": {
         "funding_rate_clamp_factor_ppm": 6000000,
+        "funding_rate_period_seconds": 28800,
         "min_num_votes_per_sample": 15,
-        "premium_vote_clamp_factor_ppm": 60000000
+        "premium_vote_clamp_factor_ppm": 60000000,
+        "removed_tail_sample_ratio_ppm": 0
       },
       "perpetuals": [
         {
           "params": {
             "atomic_resolution": -10,
             "default_funding_ppm": 0,
+            "funding_rate_bounds": null,
             "id": 0,
+            "insurance_fund_scope": "INSURANCE_FUND_SCOPE_GLOBAL",
+            "interest_rate_ppm": 0,
             "liquidity_tier": 0,
             "market_id": 0,
             "ticker": "BTC-USD"
@@ -897,7 +902,10 @@ const GenesisState = `{
           "params": {
             "atomic_resolution": -9,
             "default_funding_ppm": 0,
+            "funding_rate_bounds": null,
             "id": 1,
+            "insurance_fund_scope": "INSURANCE_FUND_SCOPE_GLOBAL",
+            "interest_rate_ppm": 0,
             "liquidity_tier": 0,
             "market_id": 1,
             "ticker": "ETH-USD"
@@ -913,7 +921,9 @@ const GenesisState = `{
           "id": 0,
           "min_exchanges": 1,
           "min_price_change_ppm": 1000,
-          "pair": "BTC-USD"
+          "pair": "BTC-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ETHUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ETHUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tETHUSD\"},{\"exchangeName\":\"Bitstamp\",\"ticker\":\"ETH/USD\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"ETHUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ETH-USD\"},{\"exchangeName\":\"CryptoCom\",\"ticker\":\"ETH_USD\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XETHZUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ETH-USDT\"}]}",
@@ -921,7 +931,9 @@ const GenesisState = `{
           "id": 1,
           "min_exchanges": 1,
           "min_price_change_ppm": 1000,
-          "pair": "ETH-USD"
+          "pair": "ETH-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"LINKUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"LINKUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"LINK-USD\"},{\"exchangeName\":\"CryptoCom\",\"ticker\":\"LINK_USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"linkusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"LINKUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"LINK-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"LINK-USDT\"}]}",
@@ -929,7 +941,9 @@ const GenesisState = `{
           "id": 2,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "LINK-USD"
+          "pair": "LINK-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"MATICUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"MATICUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"MATIC-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"MATIC_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"maticusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"MATIC-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"MATIC-USDT\"}]}",
@@ -937,7 +951,9 @@ const GenesisState = `{
           "id": 3,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "MATIC-USD"
+          "pair": "MATIC-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"CRVUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"CRVUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"CRVUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"CRV-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"CRV_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"crvusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"CRVUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"CRV-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"CRV-USDT\"}]}",
@@ -945,7 +961,9 @@ const GenesisState = `{
           "id": 4,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "CRV-USD"
+          "pair": "CRV-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"SOLUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"SOLUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tSOLUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"SOL-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"solusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"SOLUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"SOL-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"SOL-USDT\"}]}",
@@ -953,7 +971,9 @@ const GenesisState = `{
           "id": 5,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "SOL-USD"
+          "pair": "SOL-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ADAUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ADAUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tADAUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ADA-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"ADA_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"adausdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"ADAUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ADA-USDT\"}]}",
@@ -961,7 +981,9 @@ const GenesisState = `{
           "id": 6,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ADA-USD"
+          "pair": "ADA-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"AVAXUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"AVAXUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tAVAX:USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"AVAX_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"avaxusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"AVAX-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"AVAX-USDT\"}]}",
@@ -969,7 +991,9 @@ const GenesisState = `{
           "id": 7,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "AVAX-USD"
+          "pair": "AVAX-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"FILUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"FILUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"FIL-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"filusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"FILUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"FIL-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"FIL-USDT\"}]}",
@@ -977,7 +1001,9 @@ const GenesisState = `{
           "id": 8,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "FIL-USD"
+          "pair": "FIL-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"AAVEUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"AAVEUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"AAVE-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"aaveusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"AAVEUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"AAVE-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"AAVE-USDT\"}]}",
@@ -985,7 +1011,9 @@ const GenesisState = `{
           "id": 9,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "AAVE-USD"
+          "pair": "AAVE-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"LTCUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"LTCUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"LTCUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"LTC-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"ltcusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XLTCZUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"LTC-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"LTC-USDT\"}]}",
@@ -993,7 +1021,9 @@ const GenesisState = `{
           "id": 10,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "LTC-USD"
+          "pair": "LTC-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"DOGEUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"DOGEUSD\\\"\"},{\"exchangeName\":\"Gate\",\"ticker\":\"DOGE_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"dogeusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"DOGE-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"DOGE-USDT\"}]}",
@@ -1001,7 +1031,9 @@ const GenesisState = `{
           "id": 11,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "DOGE-USD"
+          "pair": "DOGE-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ICPUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ICPUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ICP-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"ICP_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"icpusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ICP-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ICP-USDT\"}]}",
@@ -1009,7 +1041,9 @@ const GenesisState = `{
           "id": 12,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ICP-USD"
+          "pair": "ICP-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ATOMUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ATOMUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"ATOMUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ATOM-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"atomusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"ATOMUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ATOM-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ATOM-USDT\"}]}",
@@ -1017,7 +1051,9 @@ const GenesisState = `{
           "id": 13,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ATOM-USD"
+          "pair": "ATOM-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"DOTUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"DOTUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tDOTUSD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"DOT_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"dotusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"DOTUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"DOT-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"DOT-USDT\"}]}",
@@ -1025,7 +1061,9 @@ const GenesisState = `{
           "id": 14,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "DOT-USD"
+          "pair": "DOT-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"XTZUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"XTZUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tXTZUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"XTZ-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"XTZ_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"xtzusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XTZUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"XTZ-USDT\"}]}",
@@ -1033,7 +1071,9 @@ const GenesisState = `{
           "id": 15,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "XTZ-USD"
+          "pair": "XTZ-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"UNIUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"UNIUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"UNIUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"UNI-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"UNI_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"uniusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"UNIUSD\"},{\"exchangeName\":\"Mexc\",\"ticker\":\"UNI_USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"UNI-USDT\"}]}",
@@ -1041,7 +1081,9 @@ const GenesisState = `{
           "id": 16,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "UNI-USD"
+          "pair": "UNI-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"BCHUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"BCHUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"BCH-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"BCH_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"bchusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"BCHUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"BCH-USDT\"}]}",
@@ -1049,7 +1091,9 @@ const GenesisState = `{
           "id": 17,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "BCH-USD"
+          "pair": "BCH-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"EOSUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"EOSUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tEOSUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"EOS-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"eosusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"EOSUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"EOS-USDT\"}]}",
@@ -1057,7 +1101,9 @@ const GenesisState = `{
           "id": 18,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "EOS-USD"
+          "pair": "EOS-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"EOSUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"EOSUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tEOSUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"EOS-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"eosusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"EOSUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"EOS-USDT\"}]}",
@@ -1065,7 +1111,9 @@ const GenesisState = `{
           "id": 19,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "TRX-USD"
+          "pair": "TRX-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ALGOUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ALGOUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ALGO-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"algousdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"ALGOUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ALGO-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ALGO-USDT\"}]}",
@@ -1073,7 +1121,9 @@ const GenesisState = `{
           "id": 20,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ALGO-USD"
+          "pair": "ALGO-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"NEARUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"NEARUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"NEARUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"NEAR-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"NEAR_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"nearusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"NEAR-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"NEAR-USDT\"}]}",
@@ -1081,7 +1131,9 @@ const GenesisState = `{
           "id": 21,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "NEAR-USD"
+          "pair": "NEAR-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"SNXUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"SNXUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tSNXUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"SNX-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"snxusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"SNXUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"SNX-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"SNX-USDT\"}]}",
@@ -1089,7 +1141,9 @@ const GenesisState = `{
           "id": 22,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "SNX-USD"
+          "pair": "SNX-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"MKRUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"MKRUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tMKRUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"MKR-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"MKR_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"mkrusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"MKR-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"MKR-USDT\"}]}",
@@ -1097,7 +1151,9 @@ const GenesisState = `{
           "id": 23,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "MKR-USD"
+          "pair": "MKR-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"SUSHIUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"SUSHIUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tSUSHI:USD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"SUSHI-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"SUSHI_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"sushiusdt\"},{\"exchangeName\":\"Okx\",\"ticker\":\"SUSHI-USDT\"}]}",
@@ -1105,7 +1161,9 @@ const GenesisState = `{
           "id": 24,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "SUSHI-USD"
+          "pair": "SUSHI-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"XLMUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"XLMUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tXLMUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"XLM-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"XLM_USDT\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XXLMZUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"XLM-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"XLM-USDT\"}]}",
@@ -1113,7 +1171,9 @@ const GenesisState = `{
           "id": 25,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "XLM-USD"
+          "pair": "XLM-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"XMRUSDT\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tXMRUSD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"XMR_USDT\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XXMRZUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"XMR-USDT\"},{\"exchangeName\":\"Mexc\",\"ticker\":\"XMR_USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"XMR-USDT\"}]}",
@@ -1121,7 +1181,9 @@ const GenesisState = `{
           "id": 26,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "XMR-USD"
+          "pair": "XMR-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ETCUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ETCUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ETC-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"ETC_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"etcusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XETCZUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ETC-USDT\"}]}",
@@ -1129,7 +1191,9 @@ const GenesisState = `{
           "id": 27,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ETC-USD"
+          "pair": "ETC-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"1INCHUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"1INCHUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"1INCH-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"1INCH_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"1inchusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"1INCH-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"1INCH-USDT\"}]}",
@@ -1137,7 +1201,9 @@ const GenesisState = `{
           "id": 28,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "1INCH-USD"
+          "pair": "1INCH-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"COMPUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"COMPUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"COMPUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"COMP-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"compusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"COMPUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"COMP-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"COMP-USDT\"}]}",
@@ -1145,7 +1211,9 @@ const GenesisState = `{
           "id": 29,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "COMP-USD"
+          "pair": "COMP-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ZECUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ZECUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tZECUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ZEC-USD\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XZECZUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ZEC-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ZEC-USDT\"}]}",
@@ -1153,7 +1221,9 @@ const GenesisState = `{
           "id": 30,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ZEC-USD"
+          "pair": "ZEC-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ZRXUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ZRXUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tZRXUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ZRX-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"zrxusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"ZRXUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ZRX-USDT\"}]}",
@@ -1161,7 +1231,9 @@ const GenesisState = `{
           "id": 31,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ZRX-USD"
+          "pair": "ZRX-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"YFIUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"YFIUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tYFIUSD\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"YFIUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"YFI-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"yfiusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"YFIUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"YFI-USDT\"}]}",
@@ -1169,7 +1241,9 @@ const GenesisState = `{
           "id": 32,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "YFI-USD"
+          "pair": "YFI-USD",
+          "price_smoothing_ppm": 0,
+          "smoothed_price_history_length": 0
         }
       ],
       "market_prices": [
@@ -60,6 +60,24 @@ var (
 	Msg_PlaceOrder_Conditional = &clobtypes.MsgPlaceOrder{
 		Order: ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
 	}
+	Msg_BatchPlaceAndCancel = &clobtypes.MsgBatchPlaceAndCancel{
+		SubaccountId: Alice_Num0,
+		Cancels: []clobtypes.MsgCancelOrder{
+			{
+				OrderId:      clobtypes.OrderId{SubaccountId: Alice_Num0, ClientId: 1},
+				GoodTilOneof: &clobtypes.MsgCancelOrder_GoodTilBlock{GoodTilBlock: 10},
+			},
+		},
+		Places: []clobtypes.MsgPlaceOrder{
+			{Order: Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15},
+		},
+	}
+	Msg_ReplaceOrder = &clobtypes.MsgReplaceOrder{
+		Order: Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20,
+	}
+	Msg_ReplaceOrder_LongTerm = &clobtypes.MsgReplaceOrder{
+		Order: LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+	}
 	Msg_Transfer = &sendingtypes.MsgCreateTransfer{
 		Transfer: &sendingtypes.Transfer{
 			Sender:    Carl_Num0,
@@ -1095,4 +1095,46 @@ var (
 		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 22},
 		TimeInForce:  clobtypes.Order_TIME_IN_FORCE_POST_ONLY,
 	}
+
+	// Self-trade prevention orders.
+	Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelTaker = clobtypes.Order{
+		OrderId:             clobtypes.OrderId{SubaccountId: Alice_Num1, ClientId: 14, ClobPairId: 1},
+		Side:                clobtypes.Order_SIDE_BUY,
+		Quantums:            35,
+		Subticks:            math.MaxUint64,
+		GoodTilOneof:        &clobtypes.Order_GoodTilBlock{GoodTilBlock: 30},
+		SelfTradePrevention: clobtypes.Order_SELF_TRADE_PREVENTION_CANCEL_TAKER,
+	}
+	Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelBoth = clobtypes.Order{
+		OrderId:             clobtypes.OrderId{SubaccountId: Alice_Num1, ClientId: 14, ClobPairId: 1},
+		Side:                clobtypes.Order_SIDE_BUY,
+		Quantums:            35,
+		Subticks:            math.MaxUint64,
+		GoodTilOneof:        &clobtypes.Order_GoodTilBlock{GoodTilBlock: 30},
+		SelfTradePrevention: clobtypes.Order_SELF_TRADE_PREVENTION_CANCEL_BOTH,
+	}
+	Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_DecrementAndCancel = clobtypes.Order{
+		OrderId:             clobtypes.OrderId{SubaccountId: Alice_Num1, ClientId: 14, ClobPairId: 1},
+		Side:                clobtypes.Order_SIDE_BUY,
+		Quantums:            35,
+		Subticks:            math.MaxUint64,
+		GoodTilOneof:        &clobtypes.Order_GoodTilBlock{GoodTilBlock: 30},
+		SelfTradePrevention: clobtypes.Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+	}
+	Order_Alice_Num1_Id14_Clob1_Buy5_PriceMax_GTB30_STP_DecrementAndCancel = clobtypes.Order{
+		OrderId:             clobtypes.OrderId{SubaccountId: Alice_Num1, ClientId: 14, ClobPairId: 1},
+		Side:                clobtypes.Order_SIDE_BUY,
+		Quantums:            5,
+		Subticks:            math.MaxUint64,
+		GoodTilOneof:        &clobtypes.Order_GoodTilBlock{GoodTilBlock: 30},
+		SelfTradePrevention: clobtypes.Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+	}
+	Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel = clobtypes.Order{
+		OrderId:                       clobtypes.OrderId{SubaccountId: Alice_Num0, ClientId: 11, ClobPairId: 1},
+		Side:                          clobtypes.Order_SIDE_BUY,
+		Quantums:                      35,
+		Subticks:                      math.MaxUint64,
+		GoodTilOneof:                  &clobtypes.Order_GoodTilBlock{GoodTilBlock: 30},
+		SelfTradePreventionOwnerLevel: true,
+	}
 )
@@ -104,11 +104,15 @@ var LiquidityTiers = []perptypes.LiquidityTier{
 const TestFundingRateClampFactorPpm = 6_000_000
 const TestPremiumVoteClampFactorPpm = 60_000_000
 const TestMinNumVotesPerSample = 15
+const TestFundingRatePeriodSeconds = 28_800
+const TestRemovedTailSampleRatioPpm = 0
 
 var PerpetualsGenesisParams = perptypes.Params{
 	FundingRateClampFactorPpm: TestFundingRateClampFactorPpm,
 	PremiumVoteClampFactorPpm: TestPremiumVoteClampFactorPpm,
 	MinNumVotesPerSample:      TestMinNumVotesPerSample,
+	FundingRatePeriodSeconds:  TestFundingRatePeriodSeconds,
+	RemovedTailSampleRatioPpm: TestRemovedTailSampleRatioPpm,
 }
 
 var Perpetuals_GenesisState_ParamsOnly = perptypes.GenesisState{
@@ -183,6 +187,45 @@ var (
 		},
 		FundingIndex: dtypes.ZeroInt(),
 	}
+	BtcUsd_0_001Percent_Interest_10AtomicResolution = perptypes.Perpetual{
+		Params: perptypes.PerpetualParams{
+			Id:                0,
+			Ticker:            "BTC-USD 0.001 percent interest",
+			MarketId:          uint32(0),
+			AtomicResolution:  int32(-10),
+			DefaultFundingPpm: int32(0),
+			LiquidityTier:     uint32(1),
+			InterestRatePpm:   int32(1000), // 0.001%
+		},
+		FundingIndex: dtypes.ZeroInt(),
+	}
+	BtcUsd_0_001Percent_FundingRateBounds_10AtomicResolution = perptypes.Perpetual{
+		Params: perptypes.PerpetualParams{
+			Id:                0,
+			Ticker:            "BTC-USD 0.001 percent funding rate bounds",
+			MarketId:          uint32(0),
+			AtomicResolution:  int32(-10),
+			DefaultFundingPpm: int32(0),
+			LiquidityTier:     uint32(1),
+			FundingRateBounds: &perptypes.FundingRateBounds{
+				MinFundingRatePpm: int32(-1000), // -0.001%
+				MaxFundingRatePpm: int32(1000),  // 0.001%
+			},
+		},
+		FundingIndex: dtypes.ZeroInt(),
+	}
+	BtcUsd_ZeroFundingRateBounds_10AtomicResolution = perptypes.Perpetual{
+		Params: perptypes.PerpetualParams{
+			Id:                0,
+			Ticker:            "BTC-USD zero funding rate bounds",
+			MarketId:          uint32(0),
+			AtomicResolution:  int32(-10),
+			DefaultFundingPpm: int32(0),
+			LiquidityTier:     uint32(1),
+			FundingRateBounds: &perptypes.FundingRateBounds{},
+		},
+		FundingIndex: dtypes.ZeroInt(),
+	}
 	BtcUsd_SmallMarginRequirement = perptypes.Perpetual{
 		Params: perptypes.PerpetualParams{
 			Id:                0,
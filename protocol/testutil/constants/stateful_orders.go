@@ -1172,6 +1172,32 @@ var (
 		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
 		ReduceOnly:   true,
 	}
+	LongTermOrder_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000_GTBT10_RO = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Carl_Num0,
+			ClientId:     2,
+			OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+			ClobPairId:   0,
+		},
+		Side:         clobtypes.Order_SIDE_BUY,
+		Quantums:     50_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ReduceOnly:   true,
+	}
+	LongTermOrder_Dave_Num0_Id1_Clob0_Sell05BTC_Price50000_GTBT10_RO = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Dave_Num0,
+			ClientId:     1,
+			OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+			ClobPairId:   0,
+		},
+		Side:         clobtypes.Order_SIDE_SELL,
+		Quantums:     50_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ReduceOnly:   true,
+	}
 
 	// Long-Term Fill Or Kill Orders.
 	LongTermOrder_Carl_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_FOK = clobtypes.Order{
@@ -1202,4 +1228,108 @@ var (
 		GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
 		TimeInForce:  clobtypes.Order_TIME_IN_FORCE_IOC,
 	}
+
+	// Trailing stop orders.
+	ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_10Pct = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                               clobtypes.Order_SIDE_SELL,
+		Quantums:                           5,
+		Subticks:                           10,
+		GoodTilOneof:                       &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                      clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks:    20,
+		ConditionalOrderTrailingPercentPpm: 100_000,
+	}
+	ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price20_GTBT15_TrailingStop20_10Pct = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     1,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                               clobtypes.Order_SIDE_BUY,
+		Quantums:                           5,
+		Subticks:                           20,
+		GoodTilOneof:                       &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                      clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks:    20,
+		ConditionalOrderTrailingPercentPpm: 100_000,
+	}
+	ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TS_50010_1BIP = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                               clobtypes.Order_SIDE_BUY,
+		Quantums:                           100_000_000,
+		Subticks:                           50_000_000_000,
+		GoodTilOneof:                       &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                      clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks:    50_010_000_000,
+		ConditionalOrderTrailingPercentPpm: 100,
+	}
+	ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49990_1BIP = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Bob_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                               clobtypes.Order_SIDE_SELL,
+		Quantums:                           100_000_000,
+		Subticks:                           50_000_000_000,
+		GoodTilOneof:                       &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                      clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks:    49_990_000_000,
+		ConditionalOrderTrailingPercentPpm: 100,
+	}
+
+	// One-cancels-other orders.
+	ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO_Id0 = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Bob_Num0,
+			ClientId:     1,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        100_000_000,
+		Subticks:                        50_000_000_000,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TAKE_PROFIT,
+		ConditionalOrderTriggerSubticks: 50_001_000_000,
+		OneCancelsOtherOrderId: &clobtypes.OrderId{
+			SubaccountId: Bob_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+	}
+	ConditionalOrder_Dave_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49999_OCO_LongTermId0 = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Dave_Num0,
+			ClientId:     1,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        100_000_000,
+		Subticks:                        50_000_000_000,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_STOP_LOSS,
+		ConditionalOrderTriggerSubticks: 49_999_000_000,
+		OneCancelsOtherOrderId: &clobtypes.OrderId{
+			SubaccountId: Dave_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+			ClobPairId:   0,
+		},
+	}
 )
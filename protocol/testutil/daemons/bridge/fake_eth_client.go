@@ -0,0 +1,87 @@
+package bridge
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/client/types"
+	eth "github.com/ethereum/go-ethereum"
+	ethcoretypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var _ types.EthClient = (*FakeEthClient)(nil)
+
+// FakeEthClient is an in-memory `EthClient` for an EVM chain that holds a fixed set of logs. `FilterLogs`
+// applies the block range, address and topic filters of a query the way an Ethereum node does. Block tags
+// such as "finalized" are treated as the latest block.
+type FakeEthClient struct {
+	sync.Mutex
+
+	chainId uint64
+	logs    []ethcoretypes.Log
+
+	// filterQueries records the queries passed to `FilterLogs`.
+	filterQueries []eth.FilterQuery
+}
+
+// NewFakeEthClient returns a new `FakeEthClient` for the chain with the given chain ID and logs.
+func NewFakeEthClient(chainId uint64, logs ...ethcoretypes.Log) *FakeEthClient {
+	return &FakeEthClient{
+		chainId: chainId,
+		logs:    logs,
+	}
+}
+
+// ChainID returns the chain ID of the fake chain.
+func (c *FakeEthClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return new(big.Int).SetUint64(c.chainId), nil
+}
+
+// FilterLogs returns the logs of the fake chain that match the query, in order.
+func (c *FakeEthClient) FilterLogs(ctx context.Context, q eth.FilterQuery) ([]ethcoretypes.Log, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.filterQueries = append(c.filterQueries, q)
+	logs := make([]ethcoretypes.Log, 0)
+	for _, log := range c.logs {
+		if matchesFilterQuery(log, q) {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+// GetFilterQueries returns the queries passed to `FilterLogs` so far.
+func (c *FakeEthClient) GetFilterQueries() []eth.FilterQuery {
+	c.Lock()
+	defer c.Unlock()
+
+	return slices.Clone(c.filterQueries)
+}
+
+// matchesFilterQuery returns whether a log satisfies the block range, address and topic filters of a query.
+func matchesFilterQuery(log ethcoretypes.Log, q eth.FilterQuery) bool {
+	// Negative block numbers are block tags, which match any block.
+	if q.FromBlock != nil && q.FromBlock.Sign() >= 0 && log.BlockNumber < q.FromBlock.Uint64() {
+		return false
+	}
+	if q.ToBlock != nil && q.ToBlock.Sign() >= 0 && log.BlockNumber > q.ToBlock.Uint64() {
+		return false
+	}
+	if len(q.Addresses) > 0 && !slices.Contains(q.Addresses, log.Address) {
+		return false
+	}
+	// Each position matches any of its topics. An empty position matches any topic.
+	for i, topics := range q.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) || !slices.Contains(topics, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
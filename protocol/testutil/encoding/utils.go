@@ -76,6 +76,8 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 		&clobtypes.MsgProposedOperations{},
 		&clobtypes.MsgPlaceOrder{},
 		&clobtypes.MsgCancelOrder{},
+		&clobtypes.MsgBatchPlaceAndCancel{},
+		&clobtypes.MsgReplaceOrder{},
 
 		// Perpetuals.
 		&perpetualtypes.MsgAddPremiumVotes{},
@@ -14,10 +14,13 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	delaymsgmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
 	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
 )
 
@@ -32,6 +35,7 @@ func CreateUsdcAsset(ctx sdk.Context, assetsKeeper *keeper.Keeper) error {
 		constants.Usdc.HasMarket,
 		constants.Usdc.MarketId,
 		constants.Usdc.AtomicResolution,
+		constants.Usdc.CollateralWeightPpm,
 	)
 	return err
 }
@@ -89,6 +93,10 @@ func createAssetsKeeper(
 		storeKey,
 		pk,
 		mockIndexerEventsManager,
+		[]string{
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			authtypes.NewModuleAddress(delaymsgmoduletypes.ModuleName).String(),
+		},
 	)
 
 	return k, storeKey
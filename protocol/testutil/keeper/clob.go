@@ -15,6 +15,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/rate_limit"
 
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	streaming "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 
 	db "github.com/cometbft/cometbft-db"
@@ -143,6 +144,7 @@ func NewClobKeepersTestContextWithUninitializedMemStore(
 			bankKeeper,
 			ks.FeeTiersKeeper,
 			ks.PerpetualsKeeper,
+			ks.PricesKeeper,
 			ks.StatsKeeper,
 			ks.RewardsKeeper,
 			ks.SubaccountsKeeper,
@@ -179,6 +181,7 @@ func createClobKeeper(
 	bankKeeper types.BankKeeper,
 	feeTiersKeeper types.FeeTiersKeeper,
 	perpKeeper *perpkeeper.Keeper,
+	pricesKeeper *priceskeeper.Keeper,
 	statsKeeper *statskeeper.Keeper,
 	rewardsKeeper types.RewardsKeeper,
 	saKeeper *subkeeper.Keeper,
@@ -209,9 +212,11 @@ func createClobKeeper(
 		bankKeeper,
 		feeTiersKeeper,
 		perpKeeper,
+		pricesKeeper,
 		statsKeeper,
 		rewardsKeeper,
 		indexerEventManager,
+		streaming.NewNoopGrpcStreamingManager(),
 		constants.TestEncodingCfg.TxConfig.TxDecoder(),
 		flags.GetDefaultClobFlags(),
 		rate_limit.NewNoOpRateLimiter[*types.MsgPlaceOrder](),
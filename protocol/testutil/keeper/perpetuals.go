@@ -63,6 +63,7 @@ func PerpetualsKeepersWithClobHelpers(
 		stateStore storetypes.CommitMultiStore,
 		transientStoreKey storetypes.StoreKey,
 	) []GenesisInitializer {
+		pc.Cdc = cdc
 		// Define necessary keepers here for unit tests
 		pc.PricesKeeper, _, pc.IndexPriceCache, _, pc.MockTimeProvider = createPricesKeeper(
 			stateStore,
@@ -241,6 +242,9 @@ func CreateNPerpetuals(
 			int32(i),             // AtomicResolution
 			defaultFundingPpm,    // DefaultFundingPpm
 			allLiquidityTiers[i%len(allLiquidityTiers)].Id, // LiquidityTier
+			nil, // FundingRateBounds
+			0,   // InterestRatePpm
+			types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
 		)
 		if err != nil {
 			return items, err
@@ -290,6 +294,9 @@ func CreateTestPricesAndPerpetualMarkets(
 			perp.Params.AtomicResolution,
 			perp.Params.DefaultFundingPpm,
 			perp.Params.LiquidityTier,
+			perp.Params.FundingRateBounds,
+			perp.Params.InterestRatePpm,
+			perp.Params.InsuranceFundScope,
 		)
 		require.NoError(t, err)
 	}
@@ -72,7 +72,7 @@ func createPricesKeeper(
 
 	indexPriceCache := pricefeedserver_types.NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
 
-	marketToSmoothedPrices := types.NewMarketToSmoothedPrices(types.SmoothedPriceTrackingBlockHistoryLength)
+	marketToSmoothedPrices := types.NewMarketToSmoothedPrices(types.MaxSmoothedPriceHistoryLength)
 
 	mockTimeProvider := &mocks.TimeProvider{}
 
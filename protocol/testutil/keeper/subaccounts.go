@@ -88,8 +88,10 @@ func createSubaccountsKeeper(
 	msgSenderEnabled bool,
 ) (*keeper.Keeper, storetypes.StoreKey) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, db)
 
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(msgSenderEnabled)
@@ -98,6 +100,7 @@ func createSubaccountsKeeper(
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
+		memKey,
 		ak,
 		bk,
 		pk,
@@ -108,6 +108,7 @@ func (f *FakeMemClobKeeper) CancelShortTermOrder(
 func (f *FakeMemClobKeeper) CanDeleverageSubaccount(
 	ctx sdk.Context,
 	msg satypes.SubaccountId,
+	perpetualId uint32,
 ) (
 	bool,
 	error,
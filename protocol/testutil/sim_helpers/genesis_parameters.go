@@ -169,6 +169,24 @@ var (
 		Valid:      80,
 	}
 
+	MinFundingRatePeriodSeconds = GenesisParameters[int]{
+		Reasonable: 3_600, // 1 hour
+		Valid:      1,
+	}
+	MaxFundingRatePeriodSeconds = GenesisParameters[int]{
+		Reasonable: 28_800,  // 8 hours
+		Valid:      604_800, // 1 week
+	}
+
+	MinRemovedTailSampleRatioPpm = GenesisParameters[int]{
+		Reasonable: 0,
+		Valid:      0,
+	}
+	MaxRemovedTailSampleRatioPpm = GenesisParameters[int]{
+		Reasonable: 50_000,  // 5%
+		Valid:      499_999, // 49.9999%
+	}
+
 	MinAtomicResolution = GenesisParameters[int]{
 		Reasonable: -10,
 		Valid:      -10,
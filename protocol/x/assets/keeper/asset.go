@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
 func (k Keeper) CreateAsset(
@@ -24,6 +25,7 @@ func (k Keeper) CreateAsset(
 	hasMarket bool,
 	marketId uint32,
 	atomicResolution int32,
+	collateralWeightPpm uint32,
 ) (types.Asset, error) {
 	if prevAsset, exists := k.GetAsset(ctx, assetId); exists {
 		return types.Asset{}, errorsmod.Wrapf(
@@ -67,13 +69,19 @@ func (k Keeper) CreateAsset(
 
 	// Create the asset
 	asset := types.Asset{
-		Id:               assetId,
-		Symbol:           symbol,
-		Denom:            denom,
-		DenomExponent:    denomExponent,
-		HasMarket:        hasMarket,
-		MarketId:         marketId,
-		AtomicResolution: atomicResolution,
+		Id:                  assetId,
+		Symbol:              symbol,
+		Denom:               denom,
+		DenomExponent:       denomExponent,
+		HasMarket:           hasMarket,
+		MarketId:            marketId,
+		AtomicResolution:    atomicResolution,
+		CollateralWeightPpm: collateralWeightPpm,
+	}
+
+	// Validate collateral weight
+	if err := asset.ValidateCollateralWeight(); err != nil {
+		return asset, err
 	}
 
 	// Validate market
@@ -131,6 +139,45 @@ func (k Keeper) ModifyAsset(
 	asset.HasMarket = hasMarket
 	asset.MarketId = marketId
 
+	// Validate collateral weight
+	if err := asset.ValidateCollateralWeight(); err != nil {
+		return asset, err
+	}
+
+	// Store the modified asset
+	k.setAsset(ctx, asset)
+
+	return asset, nil
+}
+
+// SetAssetCollateralWeight sets the collateral weight of an existing asset.
+// The collateral weight of USDC cannot be modified.
+func (k Keeper) SetAssetCollateralWeight(
+	ctx sdk.Context,
+	id uint32,
+	collateralWeightPpm uint32,
+) (types.Asset, error) {
+	// Get asset
+	asset, exists := k.GetAsset(ctx, id)
+	if !exists {
+		return asset, errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
+	}
+
+	if id == types.AssetUsdc.Id {
+		return asset, errorsmod.Wrap(
+			types.ErrInvalidCollateralWeight,
+			"collateral weight of USDC cannot be modified",
+		)
+	}
+
+	// Modify asset
+	asset.CollateralWeightPpm = collateralWeightPpm
+
+	// Validate collateral weight
+	if err := asset.ValidateCollateralWeight(); err != nil {
+		return asset, err
+	}
+
 	// Store the modified asset
 	k.setAsset(ctx, asset)
 
@@ -182,8 +229,33 @@ func (k Keeper) GetAllAssets(
 	return list
 }
 
+// GetAssetAndMarketPrice returns the asset and the current market price of the asset's market.
+// Returns an error if the asset does not exist or does not have a market.
+func (k Keeper) GetAssetAndMarketPrice(
+	ctx sdk.Context,
+	id uint32,
+) (types.Asset, pricestypes.MarketPrice, error) {
+	asset, exists := k.GetAsset(ctx, id)
+	if !exists {
+		return asset, pricestypes.MarketPrice{}, errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
+	}
+
+	if !asset.HasMarket {
+		return asset, pricestypes.MarketPrice{}, errorsmod.Wrap(types.ErrAssetHasNoMarket, lib.UintToString(id))
+	}
+
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+	if err != nil {
+		return asset, marketPrice, err
+	}
+
+	return asset, marketPrice, nil
+}
+
 // GetNetCollateral returns the net collateral that a given position (quantums)
 // for a given assetId contributes to an account.
+// A positive balance of a non-USDC asset contributes its oracle value, scaled down by the
+// asset's collateral weight and rounded down.
 func (k Keeper) GetNetCollateral(
 	ctx sdk.Context,
 	id uint32,
@@ -197,7 +269,7 @@ func (k Keeper) GetNetCollateral(
 	}
 
 	// Get asset
-	_, exists := k.GetAsset(ctx, id)
+	asset, exists := k.GetAsset(ctx, id)
 	if !exists {
 		return big.NewInt(0), errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
 	}
@@ -208,9 +280,25 @@ func (k Keeper) GetNetCollateral(
 	}
 
 	// Balance is positive.
-	// TODO(DEC-581): add multi-collateral support.
+	// Assets without a collateral weight (e.g. those only acquired by trading on spot markets)
+	// are fully funded, but do not contribute any collateral to the account.
 	if bigQuantums.Sign() == 1 {
-		return big.NewInt(0), types.ErrNotImplementedMulticollateral
+		if asset.CollateralWeightPpm == 0 {
+			return big.NewInt(0), nil
+		}
+
+		marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+		if err != nil {
+			return big.NewInt(0), err
+		}
+
+		bigQuoteQuantums := lib.BaseToQuoteQuantums(
+			bigQuantums,
+			asset.AtomicResolution,
+			marketPrice.Price,
+			marketPrice.Exponent,
+		)
+		return lib.BigIntMulPpm(bigQuoteQuantums, asset.CollateralWeightPpm), nil
 	}
 
 	// Balance is negative.
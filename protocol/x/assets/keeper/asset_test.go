@@ -51,6 +51,7 @@ func createNAssets(
 			hasMarket,                   // HasMarket
 			marketId,                    // MarketId
 			int32(i),                    // AtomicResolution
+			0,                           // CollateralWeightPpm
 		)
 		if err != nil {
 			return items, err
@@ -75,6 +76,7 @@ func TestCreateAsset_MarketNotFound(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		0,
 	)
 	require.EqualError(t, err, errorsmod.Wrap(pricestypes.ErrMarketPriceDoesNotExist, "999").Error())
 
@@ -95,6 +97,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		0,
 	)
 	require.ErrorIs(t, err, types.ErrUsdcMustBeAssetZero)
 
@@ -111,6 +114,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		0,
 	)
 	require.ErrorIs(t, err, types.ErrUsdcMustBeAssetZero)
 
@@ -127,6 +131,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		0,
 	)
 	require.ErrorIs(t, err, types.ErrUnexpectedUsdcDenomExponent)
 
@@ -147,6 +152,7 @@ func TestCreateAsset_MarketIdInvalid(t *testing.T) {
 		false,
 		uint32(1),
 		int32(-1),
+		0,
 	)
 	require.EqualError(t, err, errorsmod.Wrap(types.ErrInvalidMarketId, "Market ID: 1").Error())
 
@@ -168,6 +174,7 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,       // hasMarket
 		0,           // marketId
 		10,          // atomicResolution
+		0,           // collateralWeightPpm
 	)
 	require.NoError(t, err)
 
@@ -181,6 +188,7 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,       // hasMarket
 		0,           // marketId
 		10,          // atomicResolution
+		0,           // collateralWeightPpm
 	)
 	require.EqualError(t, err, errorsmod.Wrap(types.ErrAssetDenomAlreadyExists, "btc-denom").Error())
 
@@ -194,10 +202,48 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,            // hasMarket
 		0,                // marketId
 		10,               // atomicResolution
+		0,                // collateralWeightPpm
 	)
 	require.ErrorIs(t, err, types.ErrAssetIdAlreadyExists)
 }
 
+func TestCreateAsset_InvalidCollateralWeight(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	keepertest.CreateNMarkets(t, ctx, pricesKeeper, 1)
+
+	// Throws error when creating asset with a collateral weight above 100%.
+	_, err := keeper.CreateAsset(
+		ctx,
+		firstValidAssetId,
+		"foo-symbol", // symbol
+		"foo-denom",  // denom
+		-6,           // denomExponent
+		true,
+		uint32(0),
+		int32(-1),
+		1_000_001,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCollateralWeight)
+
+	// Throws error when creating asset without a market but with a collateral weight.
+	_, err = keeper.CreateAsset(
+		ctx,
+		firstValidAssetId,
+		"foo-symbol", // symbol
+		"foo-denom",  // denom
+		-6,           // denomExponent
+		false,
+		uint32(0),
+		int32(-1),
+		900_000,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCollateralWeight)
+
+	// Does not create the asset.
+	_, exists := keeper.GetAsset(ctx, firstValidAssetId)
+	require.False(t, exists)
+}
+
 func TestModifyAsset_Success(t *testing.T) {
 	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
 	items, err := createNAssets(t, ctx, keeper, pricesKeeper, 10)
@@ -282,6 +328,84 @@ func TestModifyAsset_MarketNotFound(t *testing.T) {
 	require.EqualError(t, err, errorsmod.Wrap(pricestypes.ErrMarketPriceDoesNotExist, "999").Error())
 }
 
+func TestModifyAsset_CollateralAssetWithoutMarket(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 1)
+	require.NoError(t, err)
+	_, err = keeper.SetAssetCollateralWeight(ctx, firstValidAssetId, 900_000)
+	require.NoError(t, err)
+
+	// Expect error when removing the market of an asset that is used as collateral.
+	_, err = keeper.ModifyAsset(
+		ctx,
+		firstValidAssetId,
+		false,
+		uint32(0),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCollateralWeight)
+}
+
+func TestSetAssetCollateralWeight(t *testing.T) {
+	tests := map[string]struct {
+		assetId             uint32
+		collateralWeightPpm uint32
+		expectedErr         error
+	}{
+		"Success": {
+			assetId:             firstValidAssetId,
+			collateralWeightPpm: 900_000,
+		},
+		"Success: 100% collateral weight": {
+			assetId:             firstValidAssetId,
+			collateralWeightPpm: 1_000_000,
+		},
+		"Success: zero collateral weight": {
+			assetId:             firstValidAssetId,
+			collateralWeightPpm: 0,
+		},
+		"Failure: asset does not exist": {
+			assetId:             uint32(999),
+			collateralWeightPpm: 900_000,
+			expectedErr:         types.ErrAssetDoesNotExist,
+		},
+		"Failure: USDC": {
+			assetId:             types.AssetUsdc.Id,
+			collateralWeightPpm: 900_000,
+			expectedErr:         types.ErrInvalidCollateralWeight,
+		},
+		"Failure: asset without a market": {
+			assetId:             firstValidAssetId + 1,
+			collateralWeightPpm: 900_000,
+			expectedErr:         types.ErrInvalidCollateralWeight,
+		},
+		"Failure: collateral weight exceeds 100%": {
+			assetId:             firstValidAssetId,
+			collateralWeightPpm: 1_000_001,
+			expectedErr:         types.ErrInvalidCollateralWeight,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, keeper))
+			_, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
+			require.NoError(t, err)
+			initialAsset, _ := keeper.GetAsset(ctx, tc.assetId)
+
+			asset, err := keeper.SetAssetCollateralWeight(ctx, tc.assetId, tc.collateralWeightPpm)
+			storedAsset, _ := keeper.GetAsset(ctx, tc.assetId)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, initialAsset, storedAsset)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.collateralWeightPpm, asset.CollateralWeightPpm)
+				require.Equal(t, asset, storedAsset)
+			}
+		})
+	}
+}
+
 func TestGetAsset_Success(t *testing.T) {
 	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
 	items, err := createNAssets(t, ctx, keeper, pricesKeeper, 10)
@@ -318,6 +442,28 @@ func TestGetAllAssets_Success(t *testing.T) {
 	)
 }
 
+func TestGetAssetAndMarketPrice(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	items, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
+	require.NoError(t, err)
+
+	// Asset with a market.
+	asset, marketPrice, err := keeper.GetAssetAndMarketPrice(ctx, items[0].Id)
+	require.NoError(t, err)
+	require.Equal(t, items[0], asset)
+	expectedMarketPrice, err := pricesKeeper.GetMarketPrice(ctx, items[0].MarketId)
+	require.NoError(t, err)
+	require.Equal(t, expectedMarketPrice, marketPrice)
+
+	// Asset without a market.
+	_, _, err = keeper.GetAssetAndMarketPrice(ctx, items[1].Id)
+	require.ErrorIs(t, err, types.ErrAssetHasNoMarket)
+
+	// Asset does not exist.
+	_, _, err = keeper.GetAssetAndMarketPrice(ctx, 100)
+	require.ErrorIs(t, err, types.ErrAssetDoesNotExist)
+}
+
 func TestGetNetCollateral(t *testing.T) {
 	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
 	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
@@ -331,12 +477,25 @@ func TestGetNetCollateral(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, new(big.Int).SetInt64(100), netCollateral)
 
-	_, err = keeper.GetNetCollateral(
+	netCollateral, err = keeper.GetNetCollateral(
 		ctx,
 		uint32(1),
 		new(big.Int).SetInt64(100),
 	)
-	require.EqualError(t, types.ErrNotImplementedMulticollateral, err.Error())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int), netCollateral)
+
+	// A positive balance of an asset with a collateral weight contributes its weighted oracle value.
+	// Asset 1 has an atomic resolution of 0 and its market has a price of $1,000.
+	_, err = keeper.SetAssetCollateralWeight(ctx, uint32(1), 900_000)
+	require.NoError(t, err)
+	netCollateral, err = keeper.GetNetCollateral(
+		ctx,
+		uint32(1),
+		new(big.Int).SetInt64(100),
+	)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(90_000_000_000), netCollateral)
 
 	_, err = keeper.GetNetCollateral(
 		ctx,
@@ -467,6 +626,7 @@ func TestConvertAssetToCoin_Success(t *testing.T) {
 				false,
 				0,
 				tc.atomicResolution,
+				0,
 			)
 			require.NoError(t, err)
 
@@ -522,6 +682,7 @@ func TestConvertAssetToCoin_Failure(t *testing.T) {
 		false,
 		0,
 		-6,
+		0,
 	)
 	require.NoError(t, err)
 
@@ -542,6 +703,7 @@ func TestConvertAssetToCoin_Failure(t *testing.T) {
 		false,
 		0,
 		-50, /* invalid asset atomic resolution */
+		0,
 	)
 	require.NoError(t, err)
 	_, _, err = keeper.ConvertAssetToCoin(ctx, 2, big.NewInt(100))
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 )
 
@@ -20,6 +21,7 @@ type (
 		storeKey            storetypes.StoreKey
 		pricesKeeper        types.PricesKeeper
 		indexerEventManager indexer_manager.IndexerEventManager
+		authorities         map[string]struct{}
 	}
 )
 
@@ -28,12 +30,14 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	pricesKeeper types.PricesKeeper,
 	indexerEventManager indexer_manager.IndexerEventManager,
+	authorities []string,
 ) *Keeper {
 	return &Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
 		pricesKeeper:        pricesKeeper,
 		indexerEventManager: indexerEventManager,
+		authorities:         lib.UniqueSliceToSet(authorities),
 	}
 }
 
@@ -41,6 +45,12 @@ func (k Keeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
 	return k.indexerEventManager
 }
 
+// HasAuthority returns whether the given address is an authority of the module.
+func (k Keeper) HasAuthority(authority string) bool {
+	_, ok := k.authorities[authority]
+	return ok
+}
+
 func (k Keeper) InitializeForGenesis(ctx sdk.Context) {
 }
 
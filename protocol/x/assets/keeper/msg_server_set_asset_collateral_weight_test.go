@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerSetAssetCollateralWeight(t *testing.T) {
+	tests := map[string]struct {
+		msg         *types.MsgSetAssetCollateralWeight
+		expectedErr string
+	}{
+		"Success": {
+			msg: &types.MsgSetAssetCollateralWeight{
+				Authority:           authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				AssetId:             firstValidAssetId,
+				CollateralWeightPpm: 900_000,
+			},
+		},
+		"Failure: asset does not exist": {
+			msg: &types.MsgSetAssetCollateralWeight{
+				Authority:           authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				AssetId:             firstValidAssetId + 10,
+				CollateralWeightPpm: 900_000,
+			},
+			expectedErr: "Asset does not exist",
+		},
+		"Failure: asset without a market": {
+			msg: &types.MsgSetAssetCollateralWeight{
+				Authority:           authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				AssetId:             firstValidAssetId + 1,
+				CollateralWeightPpm: 900_000,
+			},
+			expectedErr: "has no market and must have a CollateralWeightPpm of zero",
+		},
+		"Failure: invalid authority": {
+			msg: &types.MsgSetAssetCollateralWeight{
+				Authority:           constants.BobAccAddress.String(),
+				AssetId:             firstValidAssetId,
+				CollateralWeightPpm: 900_000,
+			},
+			expectedErr: "invalid authority",
+		},
+		"Failure: empty authority": {
+			msg: &types.MsgSetAssetCollateralWeight{
+				Authority:           "",
+				AssetId:             firstValidAssetId,
+				CollateralWeightPpm: 900_000,
+			},
+			expectedErr: "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, assetsKeeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+			_, err := createNAssets(t, ctx, assetsKeeper, pricesKeeper, 2)
+			require.NoError(t, err)
+
+			msgServer := keeper.NewMsgServerImpl(*assetsKeeper)
+			wrappedCtx := sdk.WrapSDKContext(ctx)
+
+			_, err = msgServer.SetAssetCollateralWeight(wrappedCtx, tc.msg)
+			asset, _ := assetsKeeper.GetAsset(ctx, tc.msg.AssetId)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				// Verify that the collateral weight is unchanged.
+				require.Equal(t, uint32(0), asset.CollateralWeightPpm)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.CollateralWeightPpm, asset.CollateralWeightPpm)
+			}
+		})
+	}
+}
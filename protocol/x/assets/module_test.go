@@ -105,7 +105,7 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	expected := `{"assets":[{"id":0,"symbol":"USDC","denom":`
 	expected += `"ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5",`
 	expected += `"denom_exponent":-6,"has_market":false,`
-	expected += `"market_id":0,"atomic_resolution":-6}]}`
+	expected += `"market_id":0,"atomic_resolution":-6,"collateral_weight_ppm":0}]}`
 	require.Equal(t, expected, string(json))
 }
 
@@ -249,7 +249,7 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected := `{"assets":[{"id":0,"symbol":"USDC","denom":`
 	expected += `"ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5",`
 	expected += `"denom_exponent":-6,"has_market":false,`
-	expected += `"market_id":0,"atomic_resolution":-6}]}`
+	expected += `"market_id":0,"atomic_resolution":-6,"collateral_weight_ppm":0}]}`
 	require.Equal(t, expected, string(genesisJson))
 }
 
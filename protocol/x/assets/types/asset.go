@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// ValidateCollateralWeight returns an error if the collateral weight of the asset exceeds 100%,
+// or if an asset without a market has a non-zero collateral weight.
+func (a *Asset) ValidateCollateralWeight() error {
+	if a.CollateralWeightPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidCollateralWeight,
+			"CollateralWeightPpm %d exceeds maximum value of 1e6",
+			a.CollateralWeightPpm,
+		)
+	}
+
+	if !a.HasMarket && a.CollateralWeightPpm > 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidCollateralWeight,
+			"asset %d has no market and must have a CollateralWeightPpm of zero",
+			a.Id,
+		)
+	}
+
+	return nil
+}
+
+// IsCollateral returns true if a positive balance of the asset counts towards the
+// net collateral of a subaccount.
+func (a *Asset) IsCollateral() bool {
+	return a.Id == AssetUsdc.Id || a.CollateralWeightPpm > 0
+}
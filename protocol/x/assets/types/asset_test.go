@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsset_ValidateCollateralWeight(t *testing.T) {
+	tests := map[string]struct {
+		asset       types.Asset
+		expectedErr string
+	}{
+		"Valid: USDC": {
+			asset: types.AssetUsdc,
+		},
+		"Valid: zero collateral weight": {
+			asset: types.Asset{
+				Id:        1,
+				HasMarket: true,
+			},
+		},
+		"Valid: 100% collateral weight": {
+			asset: types.Asset{
+				Id:                  1,
+				HasMarket:           true,
+				CollateralWeightPpm: 1_000_000,
+			},
+		},
+		"Invalid: collateral weight exceeds 100%": {
+			asset: types.Asset{
+				Id:                  1,
+				HasMarket:           true,
+				CollateralWeightPpm: 1_000_001,
+			},
+			expectedErr: "CollateralWeightPpm 1000001 exceeds maximum value of 1e6",
+		},
+		"Invalid: non-zero collateral weight without a market": {
+			asset: types.Asset{
+				Id:                  1,
+				HasMarket:           false,
+				CollateralWeightPpm: 500_000,
+			},
+			expectedErr: "asset 1 has no market and must have a CollateralWeightPpm of zero",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.asset.ValidateCollateralWeight()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidCollateralWeight)
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAsset_IsCollateral(t *testing.T) {
+	tests := map[string]struct {
+		asset    types.Asset
+		expected bool
+	}{
+		"USDC is always collateral": {
+			asset:    types.AssetUsdc,
+			expected: true,
+		},
+		"Asset with a zero collateral weight is not collateral": {
+			asset: types.Asset{
+				Id:        1,
+				HasMarket: true,
+			},
+			expected: false,
+		},
+		"Asset with a positive collateral weight is collateral": {
+			asset: types.Asset{
+				Id:                  1,
+				HasMarket:           true,
+				CollateralWeightPpm: 1,
+			},
+			expected: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.asset.IsCollateral())
+		})
+	}
+}
@@ -18,8 +18,11 @@ var (
 	ErrInvalidDenomExponent         = errorsmod.Register(ModuleName, 11, "Invalid denom exponent")
 	ErrAssetAlreadyExists           = errorsmod.Register(ModuleName, 12, "Asset already exists")
 	ErrUnexpectedUsdcDenomExponent  = errorsmod.Register(ModuleName, 13, "USDC denom exponent is unexpected")
+	ErrAssetHasNoMarket             = errorsmod.Register(ModuleName, 14, "Asset does not have a market")
+	ErrInvalidCollateralWeight      = errorsmod.Register(ModuleName, 15, "Invalid collateral weight")
+	ErrInvalidAuthority             = errorsmod.Register(ModuleName, 16, "Authority is invalid")
+	ErrAssetNotCollateral           = errorsmod.Register(ModuleName, 17, "Asset cannot be used as collateral")
 
 	// Errors for Not Implemented
-	ErrNotImplementedMulticollateral = errorsmod.Register(ModuleName, 401, "Not Implemented: Multi-Collateral")
-	ErrNotImplementedMargin          = errorsmod.Register(ModuleName, 402, "Not Implemented: Margin-Trading of Assets")
+	ErrNotImplementedMargin = errorsmod.Register(ModuleName, 402, "Not Implemented: Margin-Trading of Assets")
 )
@@ -43,6 +43,7 @@ func (gs GenesisState) Validate() error {
 	// Provided assets should not contain duplicated asset ids, and denoms.
 	// Asset ids should be sequential.
 	// MarketId should be 0 if HasMarket is false.
+	// Collateral weights should be valid.
 	assetIdSet := make(map[uint32]struct{})
 	denomSet := make(map[string]struct{})
 	expectedId := uint32(0)
@@ -60,6 +61,9 @@ func (gs GenesisState) Validate() error {
 		if !asset.HasMarket && asset.MarketId > 0 {
 			return ErrInvalidMarketId
 		}
+		if err := asset.ValidateCollateralWeight(); err != nil {
+			return err
+		}
 		assetIdSet[asset.Id] = struct{}{}
 		denomSet[asset.Denom] = struct{}{}
 		expectedId = expectedId + 1
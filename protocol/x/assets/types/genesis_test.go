@@ -35,6 +35,15 @@ func TestGenesisState_Validate(t *testing.T) {
 						MarketId:         0,
 						AtomicResolution: int32(-6),
 					},
+					{
+						Id:                  2,
+						Symbol:              "ETH",
+						Denom:               "eth-denom",
+						HasMarket:           true,
+						MarketId:            1,
+						AtomicResolution:    int32(-9),
+						CollateralWeightPpm: 900_000,
+					},
 				},
 			},
 		},
@@ -165,6 +174,39 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedErr: types.ErrInvalidMarketId,
 		},
+		"CollateralWeightPpm exceeds 1e6": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					types.AssetUsdc,
+					{
+						Id:                  1,
+						Symbol:              "BTC",
+						Denom:               "btc-denom",
+						HasMarket:           true,
+						MarketId:            0,
+						AtomicResolution:    int32(-6),
+						CollateralWeightPpm: 1_000_001,
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidCollateralWeight,
+		},
+		"CollateralWeightPpm non-zero when HasMarket is false": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					types.AssetUsdc,
+					{
+						Id:                  1,
+						Symbol:              "USDT",
+						Denom:               "usdt-denom",
+						HasMarket:           false,
+						AtomicResolution:    int32(-6),
+						CollateralWeightPpm: 900_000,
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidCollateralWeight,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+var _ sdk.Msg = &MsgSetAssetCollateralWeight{}
+
+func (msg *MsgSetAssetCollateralWeight) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetAssetCollateralWeight) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+
+	if msg.CollateralWeightPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidCollateralWeight,
+			"CollateralWeightPpm %d exceeds maximum value of 1e6",
+			msg.CollateralWeightPpm,
+		)
+	}
+
+	return nil
+}
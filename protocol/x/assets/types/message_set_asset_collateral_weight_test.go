@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetAssetCollateralWeight_GetSigners(t *testing.T) {
+	msg := types.MsgSetAssetCollateralWeight{
+		Authority: constants.BobAccAddress.String(),
+	}
+	require.Equal(t, []sdk.AccAddress{constants.BobAccAddress}, msg.GetSigners())
+}
+
+func TestMsgSetAssetCollateralWeight_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetAssetCollateralWeight
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgSetAssetCollateralWeight{
+				Authority:           constants.AliceAccAddress.String(),
+				AssetId:             1,
+				CollateralWeightPpm: 900_000,
+			},
+		},
+		"Success: zero collateral weight": {
+			msg: types.MsgSetAssetCollateralWeight{
+				Authority: constants.AliceAccAddress.String(),
+				AssetId:   1,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetAssetCollateralWeight{
+				Authority: "",
+			},
+			expectedErr: "Authority is invalid",
+		},
+		"Failure: Collateral weight is greater than 100%": {
+			msg: types.MsgSetAssetCollateralWeight{
+				Authority:           constants.AliceAccAddress.String(),
+				AssetId:             1,
+				CollateralWeightPpm: 1_000_001,
+			},
+			expectedErr: "CollateralWeightPpm 1000001 exceeds maximum value of 1e6",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -6,10 +6,16 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,19 +29,140 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgSetAssetCollateralWeight is a message used by x/gov to set the
+// collateral weight of an asset.
+type MsgSetAssetCollateralWeight struct {
+	// The address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the asset to update.
+	AssetId uint32 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The new collateral weight of the asset, in parts-per-million.
+	CollateralWeightPpm uint32 `protobuf:"varint,3,opt,name=collateral_weight_ppm,json=collateralWeightPpm,proto3" json:"collateral_weight_ppm,omitempty"`
+}
+
+func (m *MsgSetAssetCollateralWeight) Reset()         { *m = MsgSetAssetCollateralWeight{} }
+func (m *MsgSetAssetCollateralWeight) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetCollateralWeight) ProtoMessage()    {}
+func (*MsgSetAssetCollateralWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b715ccf58d5be126, []int{0}
+}
+func (m *MsgSetAssetCollateralWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetCollateralWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetCollateralWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetCollateralWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetCollateralWeight.Merge(m, src)
+}
+func (m *MsgSetAssetCollateralWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetCollateralWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetCollateralWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAssetCollateralWeight proto.InternalMessageInfo
+
+func (m *MsgSetAssetCollateralWeight) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetAssetCollateralWeight) GetAssetId() uint32 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
+}
+
+func (m *MsgSetAssetCollateralWeight) GetCollateralWeightPpm() uint32 {
+	if m != nil {
+		return m.CollateralWeightPpm
+	}
+	return 0
+}
+
+// MsgSetAssetCollateralWeightResponse defines the SetAssetCollateralWeight
+// response type.
+type MsgSetAssetCollateralWeightResponse struct {
+}
+
+func (m *MsgSetAssetCollateralWeightResponse) Reset()         { *m = MsgSetAssetCollateralWeightResponse{} }
+func (m *MsgSetAssetCollateralWeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetCollateralWeightResponse) ProtoMessage()    {}
+func (*MsgSetAssetCollateralWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b715ccf58d5be126, []int{1}
+}
+func (m *MsgSetAssetCollateralWeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetCollateralWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetCollateralWeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetCollateralWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetCollateralWeightResponse.Merge(m, src)
+}
+func (m *MsgSetAssetCollateralWeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetCollateralWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetCollateralWeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAssetCollateralWeightResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetAssetCollateralWeight)(nil), "dydxprotocol.assets.MsgSetAssetCollateralWeight")
+	proto.RegisterType((*MsgSetAssetCollateralWeightResponse)(nil), "dydxprotocol.assets.MsgSetAssetCollateralWeightResponse")
+}
+
 func init() { proto.RegisterFile("dydxprotocol/assets/tx.proto", fileDescriptor_b715ccf58d5be126) }
 
 var fileDescriptor_b715ccf58d5be126 = []byte{
-	// 130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0x29, 0xd6,
-	0x2f, 0xa9, 0xd0, 0x03, 0x0b, 0x09, 0x09, 0x23, 0xcb, 0xea, 0x41, 0x64, 0x8d, 0x58, 0xb9, 0x98,
-	0x7d, 0x8b, 0xd3, 0x9d, 0x82, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
-	0x22, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xc5, 0xf8, 0x32, 0x13,
-	0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x7d, 0xb8, 0x48, 0x05, 0xdc, 0xca, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0x84, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x82, 0x5a, 0xd9, 0x5c, 0x96, 0x00,
-	0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x4b, 0x3a, 0x41,
+	0x1c, 0x76, 0xfe, 0xc2, 0xbf, 0x1c, 0xa8, 0xc3, 0x5a, 0xb4, 0x5a, 0x2c, 0x62, 0x04, 0x12, 0xb8,
+	0x53, 0x16, 0x21, 0xdd, 0xb4, 0x53, 0x07, 0x21, 0xd6, 0x43, 0xd0, 0x65, 0x59, 0x77, 0x87, 0xd9,
+	0x81, 0x5d, 0x67, 0xd8, 0xdf, 0x68, 0x7a, 0xed, 0xd0, 0x31, 0xfa, 0x28, 0x1d, 0xfc, 0x10, 0x1d,
+	0xa5, 0x53, 0xc7, 0xd0, 0x43, 0x5f, 0x23, 0x9c, 0x2d, 0xad, 0x28, 0xa1, 0xd3, 0xf0, 0xbc, 0xcc,
+	0xf3, 0x7b, 0xe6, 0x05, 0xef, 0x04, 0xc3, 0x60, 0x20, 0x13, 0xa1, 0x84, 0x2f, 0x22, 0xe2, 0x01,
+	0x50, 0x05, 0x44, 0x0d, 0x6c, 0x4d, 0x19, 0xf9, 0xcf, 0xaa, 0x9d, 0xaa, 0xc5, 0x82, 0x2f, 0x20,
+	0x16, 0xe0, 0x6a, 0x9e, 0xa4, 0x20, 0xf5, 0x17, 0xb7, 0x52, 0x44, 0x62, 0x60, 0xa4, 0x7f, 0x38,
+	0x5b, 0x52, 0xa1, 0x3c, 0x42, 0x78, 0xbb, 0x05, 0xac, 0x4d, 0x55, 0x63, 0x16, 0x72, 0x26, 0xa2,
+	0xc8, 0x53, 0x34, 0xf1, 0xa2, 0x4b, 0xca, 0x59, 0xa8, 0x8c, 0x13, 0x9c, 0xf3, 0x7a, 0x2a, 0x14,
+	0x09, 0x57, 0x43, 0x13, 0x95, 0x50, 0x25, 0xd7, 0x34, 0x9f, 0x46, 0xd5, 0x8d, 0xf7, 0xf4, 0x46,
+	0x10, 0x24, 0x14, 0xa0, 0xad, 0x12, 0xde, 0x65, 0xce, 0xc2, 0x6a, 0x14, 0xf0, 0xaa, 0x6e, 0xe5,
+	0xf2, 0xc0, 0xfc, 0x57, 0x42, 0x95, 0x35, 0x67, 0x45, 0xe3, 0xf3, 0xc0, 0xa8, 0xe1, 0x4d, 0x7f,
+	0x3e, 0xc6, 0xbd, 0xd6, 0x73, 0x5c, 0x29, 0x63, 0x33, 0xab, 0x7d, 0x79, 0xff, 0x5b, 0x87, 0x0b,
+	0x19, 0x9f, 0xae, 0xdf, 0xbc, 0x3e, 0xec, 0x2f, 0xe2, 0xcb, 0x7b, 0x78, 0x77, 0x49, 0x6b, 0x87,
+	0x82, 0x14, 0x5d, 0xa0, 0xb5, 0x3b, 0x84, 0xb3, 0x2d, 0x60, 0xc6, 0x2d, 0xc2, 0xe6, 0xaf, 0x47,
+	0x3c, 0xb0, 0x7f, 0xb8, 0x4c, 0x7b, 0x49, 0x7c, 0xb1, 0xfe, 0xd7, 0x1d, 0x1f, 0x85, 0x9a, 0xce,
+	0xe3, 0xc4, 0x42, 0xe3, 0x89, 0x85, 0x5e, 0x26, 0x16, 0xba, 0x9f, 0x5a, 0x99, 0xf1, 0xd4, 0xca,
+	0x3c, 0x4f, 0xad, 0xcc, 0x55, 0x9d, 0x71, 0x15, 0xf6, 0x3a, 0xb6, 0x2f, 0x62, 0xf2, 0xe5, 0xe9,
+	0xfb, 0xc7, 0x55, 0x3f, 0xf4, 0x78, 0x97, 0xcc, 0x99, 0xc1, 0xfc, 0x3b, 0x0c, 0x25, 0x85, 0xce,
+	0x7f, 0x2d, 0x1c, 0xbd, 0x05, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xd6, 0x68, 0x29, 0x32, 0x02, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -50,6 +177,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// SetAssetCollateralWeight sets the collateral weight of an existing asset.
+	SetAssetCollateralWeight(ctx context.Context, in *MsgSetAssetCollateralWeight, opts ...grpc.CallOption) (*MsgSetAssetCollateralWeightResponse, error)
 }
 
 type msgClient struct {
@@ -60,22 +189,423 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) SetAssetCollateralWeight(ctx context.Context, in *MsgSetAssetCollateralWeight, opts ...grpc.CallOption) (*MsgSetAssetCollateralWeightResponse, error) {
+	out := new(MsgSetAssetCollateralWeightResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.assets.Msg/SetAssetCollateralWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// SetAssetCollateralWeight sets the collateral weight of an existing asset.
+	SetAssetCollateralWeight(context.Context, *MsgSetAssetCollateralWeight) (*MsgSetAssetCollateralWeightResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) SetAssetCollateralWeight(ctx context.Context, req *MsgSetAssetCollateralWeight) (*MsgSetAssetCollateralWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetCollateralWeight not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_SetAssetCollateralWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAssetCollateralWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAssetCollateralWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.assets.Msg/SetAssetCollateralWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAssetCollateralWeight(ctx, req.(*MsgSetAssetCollateralWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.assets.Msg",
 	HandlerType: (*MsgServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "dydxprotocol/assets/tx.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAssetCollateralWeight",
+			Handler:    _Msg_SetAssetCollateralWeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/assets/tx.proto",
+}
+
+func (m *MsgSetAssetCollateralWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetCollateralWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetCollateralWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CollateralWeightPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CollateralWeightPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetCollateralWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetCollateralWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetCollateralWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
 }
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAssetCollateralWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovTx(uint64(m.AssetId))
+	}
+	if m.CollateralWeightPpm != 0 {
+		n += 1 + sovTx(uint64(m.CollateralWeightPpm))
+	}
+	return n
+}
+
+func (m *MsgSetAssetCollateralWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAssetCollateralWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetCollateralWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetCollateralWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralWeightPpm", wireType)
+			}
+			m.CollateralWeightPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CollateralWeightPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetCollateralWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetCollateralWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetCollateralWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -138,6 +138,7 @@ func TestBridge_Success(t *testing.T) {
 				testapp.UpdateGenesisDocWithAppStateForModule(
 					&genesis,
 					func(genesisState *bridgetypes.GenesisState) {
+						genesisState.EventParams = constants.EventParams
 						genesisState.ProposeParams = tc.proposeParams
 						genesisState.SafetyParams = tc.safetyParams
 					},
@@ -305,6 +306,7 @@ func TestBridge_REJECT(t *testing.T) {
 				testapp.UpdateGenesisDocWithAppStateForModule(
 					&genesis,
 					func(genesisState *bridgetypes.GenesisState) {
+						genesisState.EventParams = constants.EventParams
 						genesisState.SafetyParams = bridgetypes.SafetyParams{
 							IsDisabled:  tc.bridgingDisabled,
 							DelayBlocks: 5,
@@ -371,11 +373,11 @@ func TestBridge_AcknowledgedEventIdGreaterThanRecognizedEventId(t *testing.T) {
 	ctx := tApp.InitChain()
 
 	// Verify that AcknowledgedEventInfo.NextId is 2.
-	aei := tApp.App.BridgeKeeper.GetAcknowledgedEventInfo(ctx)
+	aei := tApp.App.BridgeKeeper.GetAcknowledgedEventInfo(ctx, bridgetypes.PrimarySourceId)
 	require.Equal(t, uint32(2), aei.NextId)
 
 	// Verify that RecognizedEventInfo.NextId is still 0.
-	rei := tApp.App.BridgeKeeper.GetRecognizedEventInfo(ctx)
+	rei := tApp.App.BridgeKeeper.GetRecognizedEventInfo(ctx, bridgetypes.PrimarySourceId)
 	require.Equal(t, uint32(0), rei.NextId)
 
 	// Verify that bridge query `RecognizedEventInfo` returns whichever of AcknowledgedEventInfo and
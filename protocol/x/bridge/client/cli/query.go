@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
@@ -102,14 +103,25 @@ func CmdQuerySafetyParams() *cobra.Command {
 
 func CmdQueryAcknowledgedEventInfo() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get-acknowledged-event-info",
-		Short: "get the AcknowledgedEventInfo",
+		Use:   "get-acknowledged-event-info [source-id]",
+		Short: "get the AcknowledgedEventInfo of a bridge source (primary source if not specified)",
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
+
+			sourceId := types.PrimarySourceId
+			if len(args) > 0 {
+				if sourceId, err = cast.ToUint32E(args[0]); err != nil {
+					return err
+				}
+			}
+
 			res, err := queryClient.AcknowledgedEventInfo(
 				context.Background(),
-				&types.QueryAcknowledgedEventInfoRequest{},
+				&types.QueryAcknowledgedEventInfoRequest{
+					SourceId: sourceId,
+				},
 			)
 			if err != nil {
 				return err
@@ -125,14 +137,25 @@ func CmdQueryAcknowledgedEventInfo() *cobra.Command {
 
 func CmdQueryRecognizedEventInfo() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get-recognized-event-info",
-		Short: "get the RecognizedEventInfo",
+		Use:   "get-recognized-event-info [source-id]",
+		Short: "get the RecognizedEventInfo of a bridge source (primary source if not specified)",
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
+
+			sourceId := types.PrimarySourceId
+			if len(args) > 0 {
+				if sourceId, err = cast.ToUint32E(args[0]); err != nil {
+					return err
+				}
+			}
+
 			res, err := queryClient.RecognizedEventInfo(
 				context.Background(),
-				&types.QueryRecognizedEventInfoRequest{},
+				&types.QueryRecognizedEventInfoRequest{
+					SourceId: sourceId,
+				},
 			)
 			if err != nil {
 				return err
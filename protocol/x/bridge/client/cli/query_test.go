@@ -84,6 +84,10 @@ func TestQueryAcknowledgedEventInfo(t *testing.T) {
 	var resp types.QueryAcknowledgedEventInfoResponse
 	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
 	require.Equal(t, types.DefaultGenesis().AcknowledgedEventInfo, resp.Info)
+
+	// Bridge source 1 is not configured in default genesis.
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryAcknowledgedEventInfo(), []string{"1"})
+	require.ErrorContains(t, err, "bridge source 1 not found")
 }
 
 func TestQueryDelayedCompleteBridgeMessages(t *testing.T) {
@@ -22,14 +22,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetAcknowledgedEventInfo(ctx, genState.AcknowledgedEventInfo); err != nil {
 		panic(err)
 	}
+	for _, info := range genState.AdditionalAcknowledgedEventInfos {
+		if err := k.SetAcknowledgedEventInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bridge module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	eventParams := k.GetEventParams(ctx)
+	var additionalAcknowledgedEventInfos []types.BridgeEventInfo
+	for _, source := range eventParams.AdditionalSources {
+		additionalAcknowledgedEventInfos = append(
+			additionalAcknowledgedEventInfos,
+			k.GetAcknowledgedEventInfo(ctx, source.Id),
+		)
+	}
 	return &types.GenesisState{
-		EventParams:           k.GetEventParams(ctx),
-		ProposeParams:         k.GetProposeParams(ctx),
-		SafetyParams:          k.GetSafetyParams(ctx),
-		AcknowledgedEventInfo: k.GetAcknowledgedEventInfo(ctx),
+		EventParams:                      eventParams,
+		ProposeParams:                    k.GetProposeParams(ctx),
+		SafetyParams:                     k.GetSafetyParams(ctx),
+		AcknowledgedEventInfo:            k.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId),
+		AdditionalAcknowledgedEventInfos: additionalAcknowledgedEventInfos,
 	}
 }
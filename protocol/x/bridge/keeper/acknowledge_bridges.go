@@ -14,7 +14,7 @@ import (
 )
 
 // GetAcknowledgeBridges returns a `MsgAcknowledgeBridges` for recognized but not-yet-acknowledged
-// bridge events, up to a maximum number of `ProposeParams.MaxBridgesPerBlock`.
+// bridge events of all bridge sources, up to a maximum number of `ProposeParams.MaxBridgesPerBlock`.
 func (k Keeper) GetAcknowledgeBridges(
 	ctx sdk.Context,
 	blockTimestamp time.Time,
@@ -47,26 +47,32 @@ func (k Keeper) GetAcknowledgeBridges(
 		metrics.GetAcknowledgeBridges,
 		metrics.Latency,
 	)
-	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx)
 	recognizedCutoffTime := wallClock.Add(-proposeParams.ProposeDelayDuration)
 	events := make([]types.BridgeEvent, 0)
-	for i := uint32(0); i < proposeParams.MaxBridgesPerBlock; i++ {
-		// 1. Try to retrieve recognized event with id `NextId + i` from BridgeEventManager.
-		eventToAcknowledge, eventRecognizedAt, found := k.bridgeEventManager.GetBridgeEventById(
-			acknowledgedEventInfo.NextId + i)
-		// Stop looking for events with higher IDs if event with current ID is not found.
-		// This assumes that recognized events are assigned IDs that increment by 1 each time.
-		if !found {
-			break
-		}
+	// Sources are visited in order so that events are grouped by source. `MaxBridgesPerBlock`
+	// bounds the number of events across all sources.
+	for _, source := range k.GetEventParams(ctx).GetSources() {
+		acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx, source.Id)
+		for i := uint32(0); uint32(len(events)) < proposeParams.MaxBridgesPerBlock; i++ {
+			// 1. Try to retrieve recognized event with id `NextId + i` from BridgeEventManager.
+			eventToAcknowledge, eventRecognizedAt, found := k.bridgeEventManager.GetBridgeEventById(
+				source.Id,
+				acknowledgedEventInfo.NextId+i,
+			)
+			// Stop looking for events with higher IDs if event with current ID is not found.
+			// This assumes that recognized events are assigned IDs that increment by 1 each time.
+			if !found {
+				break
+			}
 
-		// 2. Append the new event if it is recognized before the cutoff time.
-		if eventRecognizedAt.Before(recognizedCutoffTime) {
-			events = append(events, eventToAcknowledge)
-		} else {
-			// Stop looking for events with higher IDs if event with current ID is not old enough.
-			// This assumes that events with lower IDs are recognized before events with higher IDs.
-			break
+			// 2. Append the new event if it is recognized before the cutoff time.
+			if eventRecognizedAt.Before(recognizedCutoffTime) {
+				events = append(events, eventToAcknowledge)
+			} else {
+				// Stop looking for events with higher IDs if event with current ID is not old enough.
+				// This assumes that events with lower IDs are recognized before events with higher IDs.
+				break
+			}
 		}
 	}
 
@@ -77,8 +83,9 @@ func (k Keeper) GetAcknowledgeBridges(
 
 // AcknowledgeBridges acknowledges a list of bridge events and returns an error if any of following
 // - bridging is disabled.
+// - any bridge event is of an unknown source or has a denom different from that of its source.
 // - fails to delay a `MsgCompleteBridge` for any bridge event.
-// - fails to update `AcknowledgedEventInfo` in state.
+// - fails to update `AcknowledgedEventInfo` of any bridge source in state.
 func (k Keeper) AcknowledgeBridges(
 	ctx sdk.Context,
 	bridgeEvents []types.BridgeEvent,
@@ -92,6 +99,14 @@ func (k Keeper) AcknowledgeBridges(
 		return types.ErrBridgingDisabled
 	}
 
+	// Validate the source and denom of all bridge events before delaying any `MsgCompleteBridge`.
+	eventParams := k.GetEventParams(ctx)
+	for _, bridgeEvent := range bridgeEvents {
+		if err := eventParams.ValidateBridgeEvent(bridgeEvent); err != nil {
+			return err
+		}
+	}
+
 	// Measure latency if there are bridge events to acknowledge.
 	defer telemetry.ModuleMeasureSince(
 		types.ModuleName,
@@ -119,15 +134,20 @@ func (k Keeper) AcknowledgeBridges(
 		}
 	}
 
-	// Update `AcknowledgedEventInfo` in state.
-	// - `NextId` is set to ID of last acknowledged bridge event + 1
-	// - `EthBlockHeight`is set to block height of last acknowledged bridge event
-	lastBridgeEvent := bridgeEvents[len(bridgeEvents)-1]
-	if err = k.SetAcknowledgedEventInfo(ctx, types.BridgeEventInfo{
-		NextId:         lastBridgeEvent.GetId() + 1,
-		EthBlockHeight: lastBridgeEvent.GetEthBlockHeight(),
-	}); err != nil {
-		return err
+	// Update `AcknowledgedEventInfo` of each bridge source in state. Events are grouped by source.
+	// - `NextId` is set to ID of last acknowledged bridge event of the source + 1
+	// - `EthBlockHeight`is set to block height of last acknowledged bridge event of the source
+	for i, bridgeEvent := range bridgeEvents {
+		if i+1 < len(bridgeEvents) && bridgeEvents[i+1].GetSourceId() == bridgeEvent.GetSourceId() {
+			continue
+		}
+		if err = k.SetAcknowledgedEventInfo(ctx, types.BridgeEventInfo{
+			NextId:         bridgeEvent.GetId() + 1,
+			EthBlockHeight: bridgeEvent.GetEthBlockHeight(),
+			SourceId:       bridgeEvent.GetSourceId(),
+		}); err != nil {
+			return err
+		}
 	}
 
 	return nil
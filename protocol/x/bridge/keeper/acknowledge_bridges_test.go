@@ -13,12 +13,21 @@ import (
 )
 
 func TestAcknowledgeBridges(t *testing.T) {
+	// Bridge event of a source that is not configured.
+	unknownSourceEvent := constants.BridgeEvent_Source1_Id0_Height2
+	unknownSourceEvent.SourceId = 2
+	// Bridge event of the primary source that bridges the denom of source 1.
+	mismatchedDenomEvent := constants.BridgeEvent_Id1_Height0
+	mismatchedDenomEvent.Coin = constants.BridgeEvent_Source1_Id0_Height2.Coin
+
 	tests := map[string]struct {
 		/* --- Setup --- */
 		// Bridge events to acknowledge.
 		bridgeEvents []types.BridgeEvent
 		// Whether bridging is disabled.
 		bridgingDisabled bool
+		// Whether any bridge event is invalid, in which case no message should be delayed.
+		invalidEvent bool
 		// Error responses of mock delayMsgKeeper.
 		delayMsgErrors []error
 
@@ -72,13 +81,32 @@ func TestAcknowledgeBridges(t *testing.T) {
 			delayMsgErrors: []error{nil, errors.New("failed to delay message 1")},
 			expectedError:  "failed to delay message 1",
 		},
+		"Error: event of unknown source": {
+			bridgeEvents: []types.BridgeEvent{
+				unknownSourceEvent,
+			},
+			delayMsgErrors: []error{nil},
+			invalidEvent:   true,
+			expectedError:  types.ErrInvalidBridgeSource.Error(),
+		},
+		"Error: second event has denom of another source": {
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				mismatchedDenomEvent,
+			},
+			delayMsgErrors: []error{nil, nil},
+			invalidEvent:   true,
+			expectedError:  types.ErrInvalidBridgeEventDenom.Error(),
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Initialize context, keeper, and mockDelayMsgKeeper.
 			ctx, bridgeKeeper, _, _, _, _, mockDelayMsgKeeper := keepertest.BridgeKeepers(t)
-			err := bridgeKeeper.UpdateSafetyParams(ctx, types.SafetyParams{
+			err := bridgeKeeper.UpdateEventParams(ctx, constants.EventParams_WithSource1)
+			require.NoError(t, err)
+			err = bridgeKeeper.UpdateSafetyParams(ctx, types.SafetyParams{
 				IsDisabled:  tc.bridgingDisabled,
 				DelayBlocks: bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
 			})
@@ -91,7 +119,7 @@ func TestAcknowledgeBridges(t *testing.T) {
 					mock.Anything,
 				).Return(uint32(i), tc.delayMsgErrors[i]).Once()
 			}
-			initialAei := bridgeKeeper.GetAcknowledgedEventInfo(ctx)
+			initialAei := bridgeKeeper.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId)
 
 			// Invoke AcknowledgeBridges.
 			err = bridgeKeeper.AcknowledgeBridges(ctx, tc.bridgeEvents)
@@ -101,9 +129,9 @@ func TestAcknowledgeBridges(t *testing.T) {
 				require.ErrorContains(t, err, tc.expectedError)
 
 				// Verify that AcknowledgedEventInfo was not updated.
-				require.Equal(t, initialAei, bridgeKeeper.GetAcknowledgedEventInfo(ctx))
+				require.Equal(t, initialAei, bridgeKeeper.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId))
 
-				if tc.bridgingDisabled {
+				if tc.bridgingDisabled || tc.invalidEvent {
 					// Verify that no messages were delayed.
 					mockDelayMsgKeeper.AssertNotCalled(t, "DelayMessageByBlocks")
 				}
@@ -112,7 +140,7 @@ func TestAcknowledgeBridges(t *testing.T) {
 				require.NoError(t, err)
 
 				// Verify that AcknowledgedEventInfo is updated in state.
-				aei := bridgeKeeper.GetAcknowledgedEventInfo(ctx)
+				aei := bridgeKeeper.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId)
 				require.Equal(t, tc.expectedAEI, aei)
 
 				// Assert mock expectations.
@@ -122,6 +150,39 @@ func TestAcknowledgeBridges(t *testing.T) {
 	}
 }
 
+func TestAcknowledgeBridges_MultipleSources(t *testing.T) {
+	ctx, bridgeKeeper, _, _, _, _, mockDelayMsgKeeper := keepertest.BridgeKeepers(t)
+	require.NoError(t, bridgeKeeper.UpdateEventParams(ctx, constants.EventParams_WithSource1))
+	mockDelayMsgKeeper.On(
+		"DelayMessageByBlocks",
+		ctx,
+		mock.Anything,
+		mock.Anything,
+	).Return(uint32(0), nil).Times(3)
+
+	err := bridgeKeeper.AcknowledgeBridges(
+		ctx,
+		constants.MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1.Events,
+	)
+	require.NoError(t, err)
+
+	// Verify that AcknowledgedEventInfo of each source is updated in state.
+	require.Equal(
+		t,
+		types.BridgeEventInfo{
+			NextId:         1,
+			EthBlockHeight: constants.BridgeEvent_Id0_Height0.EthBlockHeight,
+		},
+		bridgeKeeper.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId),
+	)
+	require.Equal(
+		t,
+		constants.RecognizedEventInfo_Source1_Id2_Height4,
+		bridgeKeeper.GetAcknowledgedEventInfo(ctx, constants.BridgeSource_1.Id),
+	)
+	mockDelayMsgKeeper.AssertExpectations(t)
+}
+
 func TestGetAcknowledgeBridges(t *testing.T) {
 	timeNow := time.Now()
 
@@ -133,6 +194,7 @@ func TestGetAcknowledgeBridges(t *testing.T) {
 		bridgeEventsToAdd     []types.BridgeEvent
 		acknowledgedEventInfo types.BridgeEventInfo
 		bridgingDisabled      bool
+		additionalSources     []types.BridgeSource
 
 		// Expectations.
 		expectedMsg *types.MsgAcknowledgeBridges
@@ -276,6 +338,70 @@ func TestGetAcknowledgeBridges(t *testing.T) {
 				Events: []types.BridgeEvent{},
 			},
 		},
+		"Events of multiple sources are proposed in order of source": {
+			blockTimestamp: timeNow,
+			eventTimestamp: timeNow.Add(-time.Second * 2),
+			proposeParams: types.ProposeParams{
+				SkipRatePpm:                  0,           // do not skip based on pseudo-randomness.
+				SkipIfBlockDelayedByDuration: time.Second, // do not skip based on time.
+				MaxBridgesPerBlock:           3,           // propose up to 3 events per block.
+				ProposeDelayDuration:         time.Second, // propose events recognized at least one second ago.
+			},
+			bridgeEventsToAdd: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Source1_Id0_Height2,
+				constants.BridgeEvent_Source1_Id1_Height4,
+			},
+			additionalSources: []types.BridgeSource{constants.BridgeSource_1},
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+					constants.BridgeEvent_Source1_Id0_Height2,
+					constants.BridgeEvent_Source1_Id1_Height4,
+				},
+			},
+		},
+		"MaxBridgesPerBlock applies across sources": {
+			blockTimestamp: timeNow,
+			eventTimestamp: timeNow.Add(-time.Second * 2),
+			proposeParams: types.ProposeParams{
+				SkipRatePpm:                  0,           // do not skip based on pseudo-randomness.
+				SkipIfBlockDelayedByDuration: time.Second, // do not skip based on time.
+				MaxBridgesPerBlock:           2,           // propose up to 2 events per block.
+				ProposeDelayDuration:         time.Second, // propose events recognized at least one second ago.
+			},
+			bridgeEventsToAdd: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Source1_Id0_Height2,
+				constants.BridgeEvent_Source1_Id1_Height4, // this event should not be proposed.
+			},
+			additionalSources: []types.BridgeSource{constants.BridgeSource_1},
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+					constants.BridgeEvent_Source1_Id0_Height2,
+				},
+			},
+		},
+		"Events of unconfigured sources are not proposed": {
+			blockTimestamp: timeNow,
+			eventTimestamp: timeNow.Add(-time.Second * 2),
+			proposeParams: types.ProposeParams{
+				SkipRatePpm:                  0,           // do not skip based on pseudo-randomness.
+				SkipIfBlockDelayedByDuration: time.Second, // do not skip based on time.
+				MaxBridgesPerBlock:           3,           // propose up to 3 events per block.
+				ProposeDelayDuration:         time.Second, // propose events recognized at least one second ago.
+			},
+			bridgeEventsToAdd: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Source1_Id0_Height2, // this event should not be proposed.
+			},
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+				},
+			},
+		},
 		"No event is proposed when bridging is disabled": {
 			blockTimestamp: timeNow,
 			eventTimestamp: timeNow.Add(-time.Second * 2),
@@ -309,6 +435,11 @@ func TestGetAcknowledgeBridges(t *testing.T) {
 			require.NoError(t, err)
 			err = bridgeKeeper.UpdateProposeParams(ctx, tc.proposeParams)
 			require.NoError(t, err)
+			if len(tc.additionalSources) > 0 {
+				eventParams := bridgeKeeper.GetEventParams(ctx)
+				eventParams.AdditionalSources = tc.additionalSources
+				require.NoError(t, bridgeKeeper.UpdateEventParams(ctx, eventParams))
+			}
 			err = bridgeKeeper.UpdateSafetyParams(ctx, types.SafetyParams{
 				IsDisabled:  tc.bridgingDisabled,
 				DelayBlocks: bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
@@ -5,9 +5,13 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 )
 
-// `GetBridgeEventFromServer` returns the bridge event with the given id from the server. `found` is false
-// if the event is not found.
-func (k Keeper) GetBridgeEventFromServer(ctx sdk.Context, id uint32) (event types.BridgeEvent, found bool) {
-	event, _, found = k.bridgeEventManager.GetBridgeEventById(id)
+// `GetBridgeEventFromServer` returns the bridge event with the given source id and id from the server.
+// `found` is false if the event is not found.
+func (k Keeper) GetBridgeEventFromServer(
+	ctx sdk.Context,
+	sourceId uint32,
+	id uint32,
+) (event types.BridgeEvent, found bool) {
+	event, _, found = k.bridgeEventManager.GetBridgeEventById(sourceId, id)
 	return event, found
 }
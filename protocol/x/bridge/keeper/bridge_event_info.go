@@ -1,24 +1,39 @@
 package keeper
 
 import (
+	gometrics "github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 )
 
-// GetAcknowledgedEventInfo returns `AcknowledgedEventInfo` from state.
+// acknowledgedEventInfoKey returns the store key of `AcknowledgedEventInfo` of a bridge source.
+// The primary source uses the legacy key so that its existing state is retained.
+func acknowledgedEventInfoKey(sourceId uint32) []byte {
+	if sourceId == types.PrimarySourceId {
+		return []byte(types.AcknowledgedEventInfoKey)
+	}
+	return append([]byte(types.AdditionalAcknowledgedEventInfoKeyPrefix), lib.Uint32ToKey(sourceId)...)
+}
+
+// GetAcknowledgedEventInfo returns `AcknowledgedEventInfo` of a bridge source from state.
 func (k Keeper) GetAcknowledgedEventInfo(
 	ctx sdk.Context,
+	sourceId uint32,
 ) (acknowledgedEventInfo types.BridgeEventInfo) {
 	store := ctx.KVStore(k.storeKey)
-	var rawBytes []byte = store.Get([]byte(types.AcknowledgedEventInfoKey))
+	var rawBytes []byte = store.Get(acknowledgedEventInfoKey(sourceId))
 
 	k.cdc.MustUnmarshal(rawBytes, &acknowledgedEventInfo)
+	// Sources that have never acknowledged an event have no info in state.
+	acknowledgedEventInfo.SourceId = sourceId
 	return acknowledgedEventInfo
 }
 
-// SetAcknowledgedEventInfo sets `AcknowledgedEventInfo` in state.
+// SetAcknowledgedEventInfo sets `AcknowledgedEventInfo` of bridge source
+// `acknowledgedEventInfo.SourceId` in state.
 func (k Keeper) SetAcknowledgedEventInfo(
 	ctx sdk.Context,
 	acknowledgedEventInfo types.BridgeEventInfo,
@@ -29,31 +44,33 @@ func (k Keeper) SetAcknowledgedEventInfo(
 
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&acknowledgedEventInfo)
-	store.Set([]byte(types.AcknowledgedEventInfoKey), b)
+	store.Set(acknowledgedEventInfoKey(acknowledgedEventInfo.SourceId), b)
 
 	// Emit metrics on acknowledged event info.
-	telemetry.SetGauge(
+	sourceIdLabel := []gometrics.Label{
+		metrics.GetLabelForIntValue(metrics.BridgeSourceId, int(acknowledgedEventInfo.SourceId)),
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, metrics.AcknowledgedEventInfo, metrics.NextId},
 		float32(acknowledgedEventInfo.NextId),
-		types.ModuleName,
-		metrics.AcknowledgedEventInfo,
-		metrics.NextId,
+		sourceIdLabel,
 	)
-	telemetry.SetGauge(
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, metrics.AcknowledgedEventInfo, metrics.EthBlockHeight},
 		float32(acknowledgedEventInfo.EthBlockHeight),
-		types.ModuleName,
-		metrics.AcknowledgedEventInfo,
-		metrics.EthBlockHeight,
+		sourceIdLabel,
 	)
 
 	return nil
 }
 
-// GetRecognizedEventInfo returns `RecognizedEventInfo` from `BridgeEventManager`.
+// GetRecognizedEventInfo returns `RecognizedEventInfo` of a bridge source from `BridgeEventManager`.
 // This has the next event id that has not yet been recognized by this node’s daemon.
 // This also has the height of the highest Ethereum block at which a bridge event
 // was recognized. These values are not in-consensus.
 func (k Keeper) GetRecognizedEventInfo(
 	ctx sdk.Context,
+	sourceId uint32,
 ) (recognizedEventInfo types.BridgeEventInfo) {
-	return k.bridgeEventManager.GetRecognizedEventInfo()
+	return k.bridgeEventManager.GetRecognizedEventInfo(sourceId)
 }
@@ -19,7 +19,17 @@ func TestGetAcknowledgedEventInfo(t *testing.T) {
 			NextId:         0,
 			EthBlockHeight: 0,
 		},
-		k.GetAcknowledgedEventInfo(ctx),
+		k.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId),
+	)
+	// Sources that have not acknowledged any event default to an empty info.
+	require.Equal(
+		t,
+		types.BridgeEventInfo{
+			NextId:         0,
+			EthBlockHeight: 0,
+			SourceId:       5,
+		},
+		k.GetAcknowledgedEventInfo(ctx, 5),
 	)
 }
 
@@ -39,8 +49,19 @@ func TestSetAcknowledgedEventInfo(t *testing.T) {
 
 	err := k.SetAcknowledgedEventInfo(ctx, info1)
 	require.NoError(t, err)
-	require.Equal(t, info1, k.GetAcknowledgedEventInfo(ctx))
+	require.Equal(t, info1, k.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId))
 	err = k.SetAcknowledgedEventInfo(ctx, info2)
 	require.NoError(t, err)
-	require.Equal(t, info2, k.GetAcknowledgedEventInfo(ctx))
+	require.Equal(t, info2, k.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId))
+
+	// Event info of another source is stored separately.
+	info3 := types.BridgeEventInfo{
+		NextId:         7,
+		EthBlockHeight: 333,
+		SourceId:       1,
+	}
+	err = k.SetAcknowledgedEventInfo(ctx, info3)
+	require.NoError(t, err)
+	require.Equal(t, info3, k.GetAcknowledgedEventInfo(ctx, 1))
+	require.Equal(t, info2, k.GetAcknowledgedEventInfo(ctx, types.PrimarySourceId))
 }
@@ -14,8 +14,9 @@ func TestGetBridgeEventFromServer(t *testing.T) {
 	tests := map[string]struct {
 		// Bridge event to add to server.
 		bridgeEvent types.BridgeEvent
-		// Bridge event ID to query.
-		bridgeEventId uint32
+		// Bridge source ID and bridge event ID to query.
+		bridgeSourceId uint32
+		bridgeEventId  uint32
 
 		// Expected response.
 		expectedEvent types.BridgeEvent
@@ -32,6 +33,19 @@ func TestGetBridgeEventFromServer(t *testing.T) {
 			bridgeEventId: 1,
 			expectedFound: false,
 		},
+		"Event of another source found": {
+			bridgeEvent:    constants.BridgeEvent_Source1_Id0_Height2,
+			bridgeSourceId: 1,
+			bridgeEventId:  0,
+			expectedEvent:  constants.BridgeEvent_Source1_Id0_Height2,
+			expectedFound:  true,
+		},
+		"Event of another source not found": {
+			bridgeEvent:    constants.BridgeEvent_Id0_Height0,
+			bridgeSourceId: 1,
+			bridgeEventId:  0,
+			expectedFound:  false,
+		},
 	}
 
 	for name, tc := range tests {
@@ -43,7 +57,7 @@ func TestGetBridgeEventFromServer(t *testing.T) {
 			require.NoError(t, err)
 
 			// Complete bridge.
-			event, found := bridgeKeeper.GetBridgeEventFromServer(ctx, tc.bridgeEventId)
+			event, found := bridgeKeeper.GetBridgeEventFromServer(ctx, tc.bridgeSourceId, tc.bridgeEventId)
 
 			// Assert expectations.
 			require.Equal(t, tc.expectedEvent, event)
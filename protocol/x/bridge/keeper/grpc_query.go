@@ -68,7 +68,8 @@ func (k Keeper) SafetyParams(
 	}, nil
 }
 
-// AcknowledgedEventInfo processes a query request/response for `AcknowledgedEventInfo` from state.
+// AcknowledgedEventInfo processes a query request/response for `AcknowledgedEventInfo` of a bridge
+// source from state.
 func (k Keeper) AcknowledgedEventInfo(
 	c context.Context,
 	req *types.QueryAcknowledgedEventInfoRequest,
@@ -81,13 +82,16 @@ func (k Keeper) AcknowledgedEventInfo(
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx)
+	if err := k.validateSourceId(ctx, req.SourceId); err != nil {
+		return nil, err
+	}
+	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx, req.SourceId)
 	return &types.QueryAcknowledgedEventInfoResponse{
 		Info: acknowledgedEventInfo,
 	}, nil
 }
 
-// RecognizedEventInfo processes a query request/response for the following
+// RecognizedEventInfo processes a query request/response for the following of a bridge source
 // that has a greater `NextId`:
 // - the `AcknowledgedEventInfo` from state
 // - the `RecognizedEventInfo` from memory
@@ -105,8 +109,11 @@ func (k Keeper) RecognizedEventInfo(
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx)
-	recognizedEventInfo := k.bridgeEventManager.GetRecognizedEventInfo()
+	if err := k.validateSourceId(ctx, req.SourceId); err != nil {
+		return nil, err
+	}
+	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx, req.SourceId)
+	recognizedEventInfo := k.bridgeEventManager.GetRecognizedEventInfo(req.SourceId)
 
 	// If `AcknowledgedEventInfo` from state has a greater `NextId`, use that in response.
 	// This implies that the EventInfo that has a greater `NextId` also has a equal-or-higher
@@ -119,6 +126,15 @@ func (k Keeper) RecognizedEventInfo(
 	}, nil
 }
 
+// validateSourceId returns a `NotFound` error if no bridge source with the given id is configured.
+func (k Keeper) validateSourceId(ctx sdk.Context, sourceId uint32) error {
+	eventParams := k.GetEventParams(ctx)
+	if _, exists := eventParams.GetSource(sourceId); !exists {
+		return status.Errorf(codes.NotFound, "bridge source %d not found", sourceId)
+	}
+	return nil
+}
+
 func (k Keeper) DelayedCompleteBridgeMessages(
 	c context.Context,
 	req *types.QueryDelayedCompleteBridgeMessagesRequest,
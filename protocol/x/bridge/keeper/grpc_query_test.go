@@ -145,6 +145,11 @@ func TestAcknowledgedEventInfo(t *testing.T) {
 			res: nil,
 			err: status.Error(codes.InvalidArgument, "invalid request"),
 		},
+		"Source not found": {
+			req: &types.QueryAcknowledgedEventInfoRequest{SourceId: 1},
+			res: nil,
+			err: status.Error(codes.NotFound, "bridge source 1 not found"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			res, err := k.AcknowledgedEventInfo(ctx, tc.req)
@@ -183,6 +188,11 @@ func TestRecognizedEventInfo(t *testing.T) {
 			res: nil,
 			err: status.Error(codes.InvalidArgument, "invalid request"),
 		},
+		"Source not found": {
+			req: &types.QueryRecognizedEventInfoRequest{SourceId: 1},
+			res: nil,
+			err: status.Error(codes.NotFound, "bridge source 1 not found"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			res, err := k.RecognizedEventInfo(ctx, tc.req)
@@ -226,6 +236,8 @@ func TestDelayedCompleteBridgeMessages(t *testing.T) {
 			ctx := tApp.InitChain()
 			k := tApp.App.BridgeKeeper
 			delayMsgKeeper := tApp.App.DelayMsgKeeper
+			// Configure the denom of bridged coins as that of the bridge events.
+			require.NoError(t, k.UpdateEventParams(ctx, constants.EventParams))
 
 			// Acknowledge bridge events, for each of which there should be a delayed `MsgCompleteBridge`.
 			err := k.AcknowledgeBridges(ctx, tc.events)
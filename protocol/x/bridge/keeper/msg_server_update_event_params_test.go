@@ -39,6 +39,21 @@ func TestMsgServerUpdateEventParams(t *testing.T) {
 			},
 			expectedErr: "invalid denom",
 		},
+		"Failure: additional sources out of order": {
+			testMsg: types.MsgUpdateEventParams{
+				Authority: constants.GovModuleAccAddressString,
+				Params: types.EventParams{
+					Denom:      "denom",
+					EthChainId: 1,
+					EthAddress: "ethAddress",
+					AdditionalSources: []types.BridgeSource{
+						{Id: 2, Denom: "denom-b", EthChainId: 3, EthAddress: "ethAddress-b"},
+						{Id: 1, Denom: "denom-a", EthChainId: 2, EthAddress: "ethAddress-a"},
+					},
+				},
+			},
+			expectedErr: types.ErrBridgeSourceIdsNotSorted.Error(),
+		},
 		"Failure: invalid authority": {
 			testMsg: types.MsgUpdateEventParams{
 				Authority: "12345",
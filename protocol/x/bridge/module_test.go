@@ -21,6 +21,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge"
 	bridge_keeper "github.com/dydxprotocol/v4-chain/protocol/x/bridge/keeper"
+	bridgetypes "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/stretchr/testify/mock"
@@ -114,10 +115,11 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	require.Equal(
 		t,
 		`{"event_params":{"denom":"bridge-token","eth_chain_id":"11155111",`+
-			`"eth_address":"0xEf01c3A30eB57c91c40C52E996d29c202ae72193"},"propose_params":`+
+			`"eth_address":"0xEf01c3A30eB57c91c40C52E996d29c202ae72193","additional_sources":[]},"propose_params":`+
 			`{"max_bridges_per_block":10,"propose_delay_duration":"60s","skip_rate_ppm":800000,`+
 			`"skip_if_block_delayed_by_duration":"5s"},"safety_params":{"is_disabled":false,`+
-			`"delay_blocks":86400},"acknowledged_event_info":{"next_id":0,"eth_block_height":"0"}}`,
+			`"delay_blocks":86400},"acknowledged_event_info":{"next_id":0,"eth_block_height":"0","source_id":0},`+
+			`"additional_acknowledged_event_infos":[]}`,
 		string(json),
 	)
 }
@@ -293,14 +295,15 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	require.Equal(t, uint64(77), keeper.GetEventParams(ctx).EthChainId)
 	require.Equal(t, time.Second*60, keeper.GetProposeParams(ctx).ProposeDelayDuration)
 	require.Equal(t, uint32(86400), keeper.GetSafetyParams(ctx).DelayBlocks)
-	require.Equal(t, uint32(0), keeper.GetAcknowledgedEventInfo(ctx).NextId)
+	require.Equal(t, uint32(0), keeper.GetAcknowledgedEventInfo(ctx, bridgetypes.PrimarySourceId).NextId)
 
 	genesisJson := am.ExportGenesis(ctx, cdc)
 	expected := `{"event_params":{"denom":"bridge-token","eth_chain_id":"77",`
-	expected += `"eth_address":"0xEf01c3A30eB57c91c40C52E996d29c202ae72193"},"propose_params":{`
+	expected += `"eth_address":"0xEf01c3A30eB57c91c40C52E996d29c202ae72193","additional_sources":[]},"propose_params":{`
 	expected += `"max_bridges_per_block":10,"propose_delay_duration":"60s","skip_rate_ppm":800000,`
 	expected += `"skip_if_block_delayed_by_duration":"5s"},"safety_params":{"is_disabled":false,"delay_blocks":86400},`
-	expected += `"acknowledged_event_info":{"next_id":0,"eth_block_height":"0"}}`
+	expected += `"acknowledged_event_info":{"next_id":0,"eth_block_height":"0","source_id":0},`
+	expected += `"additional_acknowledged_event_infos":[]}`
 	require.Equal(t, expected, string(genesisJson))
 }
 
@@ -35,6 +35,9 @@ type BridgeEvent struct {
 	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	// The Ethereum block height of the event.
 	EthBlockHeight uint64 `protobuf:"varint,4,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	// The id of the bridge source that emitted the event. Event ids are unique
+	// per source.
+	SourceId uint32 `protobuf:"varint,5,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 }
 
 func (m *BridgeEvent) Reset()         { *m = BridgeEvent{} }
@@ -98,6 +101,13 @@ func (m *BridgeEvent) GetEthBlockHeight() uint64 {
 	return 0
 }
 
+func (m *BridgeEvent) GetSourceId() uint32 {
+	if m != nil {
+		return m.SourceId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BridgeEvent)(nil), "dydxprotocol.bridge.BridgeEvent")
 }
@@ -107,27 +117,28 @@ func init() {
 }
 
 var fileDescriptor_d8b4b572ecddaf6f = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbd, 0x4e, 0xeb, 0x30,
-	0x18, 0x86, 0xe3, 0x9e, 0xe8, 0x20, 0x52, 0x51, 0xa1, 0xd0, 0x21, 0xed, 0x60, 0x22, 0x06, 0x94,
-	0xa5, 0xb1, 0xda, 0x32, 0xb0, 0x12, 0x84, 0xc4, 0x1c, 0x36, 0x96, 0x28, 0xfe, 0x91, 0x6d, 0xd1,
-	0xc6, 0x55, 0x6c, 0xaa, 0xf6, 0x2e, 0xb8, 0x14, 0x06, 0x2e, 0xa2, 0x63, 0xc5, 0xc4, 0x84, 0x50,
-	0x7b, 0x23, 0x28, 0x76, 0x8a, 0x60, 0xb2, 0xbf, 0xf7, 0x79, 0xfc, 0x5a, 0xfa, 0x82, 0x4b, 0xba,
-	0xa6, 0xab, 0x45, 0xad, 0x8c, 0x22, 0x6a, 0x86, 0x70, 0x2d, 0x29, 0x67, 0xed, 0x51, 0xb0, 0x25,
-	0xab, 0x4c, 0x6a, 0x61, 0x78, 0xf6, 0xdb, 0x4b, 0x9d, 0x30, 0xec, 0x73, 0xc5, 0x95, 0x0d, 0x51,
-	0x73, 0x73, 0xea, 0x70, 0x40, 0x94, 0x9e, 0x2b, 0x5d, 0x38, 0xe0, 0x86, 0x16, 0x41, 0x37, 0x21,
-	0x5c, 0x6a, 0x86, 0x96, 0x63, 0xcc, 0x4c, 0x39, 0x46, 0x44, 0xc9, 0xca, 0xf1, 0x8b, 0x57, 0x10,
-	0x74, 0x33, 0xdb, 0x7d, 0xd7, 0xfc, 0x1d, 0xf6, 0x82, 0x8e, 0xa4, 0x11, 0x88, 0x41, 0x72, 0x92,
-	0x77, 0x24, 0x0d, 0xa7, 0x81, 0xdf, 0xd8, 0x51, 0x27, 0x06, 0x49, 0x77, 0x32, 0x48, 0xdb, 0xf2,
-	0xa6, 0x2e, 0x6d, 0xeb, 0xd2, 0x5b, 0x25, 0xab, 0xcc, 0xdf, 0x7c, 0x9e, 0x7b, 0xb9, 0x95, 0xc3,
-	0x49, 0x70, 0x54, 0x52, 0x5a, 0x33, 0xad, 0xa3, 0x7f, 0x31, 0x48, 0x8e, 0xb3, 0xe8, 0xfd, 0x6d,
-	0xd4, 0x6f, 0x9f, 0xde, 0x38, 0xf2, 0x60, 0x6a, 0x59, 0xf1, 0xfc, 0x20, 0x86, 0x49, 0x70, 0xca,
-	0x8c, 0x28, 0xf0, 0x4c, 0x91, 0xa7, 0x42, 0x30, 0xc9, 0x85, 0x89, 0xfc, 0x18, 0x24, 0x7e, 0xde,
-	0x63, 0x46, 0x64, 0x4d, 0x7c, 0x6f, 0xd3, 0x2c, 0xdf, 0xec, 0x20, 0xd8, 0xee, 0x20, 0xf8, 0xda,
-	0x41, 0xf0, 0xb2, 0x87, 0xde, 0x76, 0x0f, 0xbd, 0x8f, 0x3d, 0xf4, 0x1e, 0xaf, 0xb9, 0x34, 0xe2,
-	0x19, 0xa7, 0x44, 0xcd, 0xd1, 0x9f, 0x2d, 0x2f, 0xaf, 0x46, 0x44, 0x94, 0xb2, 0x42, 0x3f, 0xc9,
-	0xea, 0xb0, 0x79, 0xb3, 0x5e, 0x30, 0x8d, 0xff, 0x5b, 0x30, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0xcc, 0x75, 0xa1, 0x2a, 0x9d, 0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4a, 0xfb, 0x30,
+	0x1c, 0xc7, 0x9b, 0xfd, 0xfb, 0x57, 0x97, 0xe1, 0x90, 0xba, 0x43, 0x37, 0x21, 0x16, 0x0f, 0xd2,
+	0xcb, 0x1a, 0xb6, 0x79, 0xf0, 0x6a, 0x45, 0xd0, 0x6b, 0xbd, 0x79, 0x29, 0x6d, 0x12, 0xda, 0xe0,
+	0xd6, 0x8c, 0x26, 0x1b, 0xdb, 0x5b, 0xf8, 0x30, 0x3e, 0xc4, 0x2e, 0xc2, 0xf0, 0xe4, 0x49, 0x64,
+	0x7d, 0x11, 0x69, 0xd2, 0x89, 0x9e, 0xda, 0xdf, 0xf7, 0xf3, 0xc9, 0x37, 0x81, 0x1f, 0xbc, 0xa4,
+	0x6b, 0xba, 0x9a, 0x97, 0x42, 0x09, 0x22, 0xa6, 0x38, 0x2d, 0x39, 0xcd, 0x58, 0xf3, 0x89, 0xd9,
+	0x92, 0x15, 0x2a, 0xd0, 0xd0, 0x39, 0xfd, 0xed, 0x05, 0x46, 0x18, 0xf4, 0x32, 0x91, 0x09, 0x1d,
+	0xe2, 0xfa, 0xcf, 0xa8, 0x83, 0x3e, 0x11, 0x72, 0x26, 0x64, 0x6c, 0x80, 0x19, 0x1a, 0x84, 0xcc,
+	0x84, 0xd3, 0x44, 0x32, 0xbc, 0x1c, 0xa5, 0x4c, 0x25, 0x23, 0x4c, 0x04, 0x2f, 0x0c, 0xbf, 0x78,
+	0x03, 0xb0, 0x13, 0xea, 0xee, 0xbb, 0xfa, 0x6e, 0xa7, 0x0b, 0x5b, 0x9c, 0xba, 0xc0, 0x03, 0xfe,
+	0x71, 0xd4, 0xe2, 0xd4, 0x99, 0x40, 0xbb, 0xb6, 0xdd, 0x96, 0x07, 0xfc, 0xce, 0xb8, 0x1f, 0x34,
+	0xe5, 0x75, 0x5d, 0xd0, 0xd4, 0x05, 0xb7, 0x82, 0x17, 0xa1, 0xbd, 0xf9, 0x3c, 0xb7, 0x22, 0x2d,
+	0x3b, 0x63, 0x78, 0x98, 0x50, 0x5a, 0x32, 0x29, 0xdd, 0x7f, 0x1e, 0xf0, 0xdb, 0xa1, 0xfb, 0xfe,
+	0x3a, 0xec, 0x35, 0x47, 0x6f, 0x0c, 0x79, 0x54, 0x25, 0x2f, 0xb2, 0x68, 0x2f, 0x3a, 0x3e, 0x3c,
+	0x61, 0x2a, 0x8f, 0xd3, 0xa9, 0x20, 0xcf, 0x71, 0xce, 0x78, 0x96, 0x2b, 0xd7, 0xf6, 0x80, 0x6f,
+	0x47, 0x5d, 0xa6, 0xf2, 0xb0, 0x8e, 0xef, 0x75, 0xea, 0x9c, 0xc1, 0xb6, 0x14, 0x8b, 0x92, 0xb0,
+	0x98, 0x53, 0xf7, 0xbf, 0x7e, 0xe9, 0x91, 0x09, 0x1e, 0x68, 0x18, 0x6d, 0x76, 0x08, 0x6c, 0x77,
+	0x08, 0x7c, 0xed, 0x10, 0x78, 0xa9, 0x90, 0xb5, 0xad, 0x90, 0xf5, 0x51, 0x21, 0xeb, 0xe9, 0x3a,
+	0xe3, 0x2a, 0x5f, 0xa4, 0x01, 0x11, 0x33, 0xfc, 0x67, 0x05, 0xcb, 0xab, 0x21, 0xc9, 0x13, 0x5e,
+	0xe0, 0x9f, 0x64, 0xb5, 0x5f, 0x8b, 0x5a, 0xcf, 0x99, 0x4c, 0x0f, 0x34, 0x98, 0x7c, 0x07, 0x00,
+	0x00, 0xff, 0xff, 0x6f, 0x58, 0xf6, 0xde, 0xba, 0x01, 0x00, 0x00,
 }
 
 func (m *BridgeEvent) Marshal() (dAtA []byte, err error) {
@@ -150,6 +161,11 @@ func (m *BridgeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SourceId != 0 {
+		i = encodeVarintBridgeEvent(dAtA, i, uint64(m.SourceId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.EthBlockHeight != 0 {
 		i = encodeVarintBridgeEvent(dAtA, i, uint64(m.EthBlockHeight))
 		i--
@@ -209,6 +225,9 @@ func (m *BridgeEvent) Size() (n int) {
 	if m.EthBlockHeight != 0 {
 		n += 1 + sovBridgeEvent(uint64(m.EthBlockHeight))
 	}
+	if m.SourceId != 0 {
+		n += 1 + sovBridgeEvent(uint64(m.SourceId))
+	}
 	return n
 }
 
@@ -350,6 +369,25 @@ func (m *BridgeEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			m.SourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridgeEvent(dAtA[iNdEx:])
@@ -23,13 +23,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // BridgeEventInfo stores information about the most recently processed bridge
-// event.
+// event of a bridge source.
 type BridgeEventInfo struct {
 	// The next event id (the last processed id plus one) of the logs from the
 	// Ethereum contract.
 	NextId uint32 `protobuf:"varint,1,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
 	// The Ethereum block height of the most recently processed bridge event.
 	EthBlockHeight uint64 `protobuf:"varint,2,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	// The id of the bridge source the info belongs to.
+	SourceId uint32 `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 }
 
 func (m *BridgeEventInfo) Reset()         { *m = BridgeEventInfo{} }
@@ -79,6 +81,13 @@ func (m *BridgeEventInfo) GetEthBlockHeight() uint64 {
 	return 0
 }
 
+func (m *BridgeEventInfo) GetSourceId() uint32 {
+	if m != nil {
+		return m.SourceId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BridgeEventInfo)(nil), "dydxprotocol.bridge.BridgeEventInfo")
 }
@@ -88,20 +97,22 @@ func init() {
 }
 
 var fileDescriptor_ca20c815789f7707 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
+	// 228 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0x4c, 0xa9,
 	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0x85,
 	0x52, 0xf1, 0xa9, 0x65, 0xa9, 0x79, 0x25, 0xf1, 0x99, 0x79, 0x69, 0xf9, 0x7a, 0x60, 0x15, 0x42,
-	0xc2, 0xc8, 0x8a, 0xf5, 0x20, 0xaa, 0x94, 0x42, 0xb8, 0xf8, 0x9d, 0xc0, 0x2c, 0x57, 0x90, 0x72,
+	0xc2, 0xc8, 0x8a, 0xf5, 0x20, 0xaa, 0x94, 0x0a, 0xb9, 0xf8, 0x9d, 0xc0, 0x2c, 0x57, 0x90, 0x72,
 	0xcf, 0xbc, 0xb4, 0x7c, 0x21, 0x71, 0x2e, 0xf6, 0xbc, 0xd4, 0x8a, 0x92, 0xf8, 0xcc, 0x14, 0x09,
 	0x46, 0x05, 0x46, 0x0d, 0xde, 0x20, 0x36, 0x10, 0xd7, 0x33, 0x45, 0x48, 0x83, 0x4b, 0x20, 0xb5,
 	0x24, 0x23, 0x3e, 0x29, 0x27, 0x3f, 0x39, 0x3b, 0x3e, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82,
-	0x49, 0x81, 0x51, 0x83, 0x25, 0x88, 0x2f, 0xb5, 0x24, 0xc3, 0x09, 0x24, 0xec, 0x01, 0x16, 0x75,
-	0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x14, 0xc7, 0x97, 0x99, 0xe8, 0x26, 0x67, 0x24,
-	0x66, 0xe6, 0xe9, 0xc3, 0x45, 0x2a, 0x60, 0x1e, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x4b, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf4, 0xfc, 0xef, 0xf4, 0x00, 0x00, 0x00,
+	0x49, 0x81, 0x51, 0x83, 0x25, 0x88, 0x2f, 0xb5, 0x24, 0xc3, 0x09, 0x24, 0xec, 0x01, 0x16, 0x15,
+	0x92, 0xe6, 0xe2, 0x2c, 0xce, 0x2f, 0x2d, 0x4a, 0x4e, 0x05, 0x19, 0xc2, 0x0c, 0x36, 0x84, 0x03,
+	0x22, 0xe0, 0x99, 0xe2, 0x14, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
+	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
+	0x16, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x28, 0x3e, 0x2b, 0x33,
+	0xd1, 0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x8b, 0x54, 0xc0, 0x7c, 0x5b, 0x52, 0x59, 0x90,
+	0x5a, 0x9c, 0xc4, 0x06, 0x96, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x32, 0x4e, 0x08, 0x2f,
+	0x11, 0x01, 0x00, 0x00,
 }
 
 func (m *BridgeEventInfo) Marshal() (dAtA []byte, err error) {
@@ -124,6 +135,11 @@ func (m *BridgeEventInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SourceId != 0 {
+		i = encodeVarintBridgeEventInfo(dAtA, i, uint64(m.SourceId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.EthBlockHeight != 0 {
 		i = encodeVarintBridgeEventInfo(dAtA, i, uint64(m.EthBlockHeight))
 		i--
@@ -160,6 +176,9 @@ func (m *BridgeEventInfo) Size() (n int) {
 	if m.EthBlockHeight != 0 {
 		n += 1 + sovBridgeEventInfo(uint64(m.EthBlockHeight))
 	}
+	if m.SourceId != 0 {
+		n += 1 + sovBridgeEventInfo(uint64(m.SourceId))
+	}
 	return n
 }
 
@@ -236,6 +255,25 @@ func (m *BridgeEventInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			m.SourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeEventInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridgeEventInfo(dAtA[iNdEx:])
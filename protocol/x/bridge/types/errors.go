@@ -41,6 +41,21 @@ var (
 		7,
 		"Invalid Ethereum address",
 	)
+	ErrInvalidBridgeSource = errorsmod.Register(
+		ModuleName,
+		8,
+		"Invalid bridge source",
+	)
+	ErrBridgeSourceIdsNotSorted = errorsmod.Register(
+		ModuleName,
+		9,
+		"Bridge event source IDs are not sorted",
+	)
+	ErrInvalidBridgeEventDenom = errorsmod.Register(
+		ModuleName,
+		10,
+		"Bridge event denom does not match denom of its source",
+	)
 
 	ErrNegativeDuration = errorsmod.Register(
 		ModuleName,
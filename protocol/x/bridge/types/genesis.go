@@ -2,6 +2,8 @@ package types
 
 import (
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 // DefaultGenesis returns the default bridge genesis state.
@@ -44,6 +46,43 @@ func (gs GenesisState) Validate() error {
 	if err := gs.AcknowledgedEventInfo.Validate(); err != nil {
 		return err
 	}
+	if gs.AcknowledgedEventInfo.SourceId != PrimarySourceId {
+		return errorsmod.Wrapf(
+			ErrInvalidBridgeSource,
+			"acknowledged event info source id must be %d, got %d",
+			PrimarySourceId,
+			gs.AcknowledgedEventInfo.SourceId,
+		)
+	}
+
+	seenIds := make(map[uint32]struct{}, len(gs.AdditionalAcknowledgedEventInfos))
+	for _, info := range gs.AdditionalAcknowledgedEventInfos {
+		if err := info.Validate(); err != nil {
+			return err
+		}
+		if info.SourceId == PrimarySourceId {
+			return errorsmod.Wrapf(
+				ErrInvalidBridgeSource,
+				"additional acknowledged event info source id cannot be %d",
+				PrimarySourceId,
+			)
+		}
+		if _, exists := seenIds[info.SourceId]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidBridgeSource,
+				"duplicate additional acknowledged event info for source %d",
+				info.SourceId,
+			)
+		}
+		seenIds[info.SourceId] = struct{}{}
+		if _, exists := gs.EventParams.GetSource(info.SourceId); !exists {
+			return errorsmod.Wrapf(
+				ErrInvalidBridgeSource,
+				"acknowledged event info for source %d which is not configured",
+				info.SourceId,
+			)
+		}
+	}
 
 	return nil
 }
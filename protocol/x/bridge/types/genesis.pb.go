@@ -33,6 +33,8 @@ type GenesisState struct {
 	// - the next event ID to be added to consensus.
 	// - Ethereum block height of the most recently acknowledged bridge event.
 	AcknowledgedEventInfo BridgeEventInfo `protobuf:"bytes,4,opt,name=acknowledged_event_info,json=acknowledgedEventInfo,proto3" json:"acknowledged_event_info"`
+	// Acknowledged event info of each additional bridge source.
+	AdditionalAcknowledgedEventInfos []BridgeEventInfo `protobuf:"bytes,5,rep,name=additional_acknowledged_event_infos,json=additionalAcknowledgedEventInfos,proto3" json:"additional_acknowledged_event_infos"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +98,13 @@ func (m *GenesisState) GetAcknowledgedEventInfo() BridgeEventInfo {
 	return BridgeEventInfo{}
 }
 
+func (m *GenesisState) GetAdditionalAcknowledgedEventInfos() []BridgeEventInfo {
+	if m != nil {
+		return m.AdditionalAcknowledgedEventInfos
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.bridge.GenesisState")
 }
@@ -103,27 +112,29 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/bridge/genesis.proto", fileDescriptor_d57e751403447d26) }
 
 var fileDescriptor_d57e751403447d26 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x8b, 0x0b, 0x09, 0x23, 0x2b, 0xd1, 0x83,
-	0x28, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x0b, 0xea, 0x83, 0x58, 0x10, 0xa5, 0x52, 0xda,
-	0xd8, 0x4c, 0x83, 0x50, 0xf1, 0xa9, 0x65, 0xa9, 0x79, 0x25, 0xf1, 0x99, 0x79, 0x69, 0x30, 0xc5,
-	0x0a, 0xd8, 0x14, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x6d, 0x56, 0xba, 0xc3, 0xc4, 0xc5, 0xe3,
-	0x0e, 0x71, 0x4b, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x27, 0x17, 0x0f, 0xc4, 0x18, 0x88, 0x32,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x05, 0x3d, 0x2c, 0x2e, 0xd4, 0x73, 0x05, 0x29, 0x0c,
-	0x00, 0xab, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x88, 0x3b, 0x15, 0x21, 0x24, 0xe4, 0xcf,
-	0xc5, 0x57, 0x50, 0x94, 0x5f, 0x90, 0x5f, 0x9c, 0x0a, 0x33, 0x8c, 0x09, 0x6c, 0x98, 0x12, 0x56,
-	0xc3, 0x02, 0x20, 0x4a, 0x51, 0x8c, 0xe3, 0x2d, 0x40, 0x16, 0x14, 0xf2, 0xe1, 0xe2, 0x2d, 0x4e,
-	0x4c, 0x4b, 0x2d, 0xa9, 0x84, 0x99, 0xc7, 0x0c, 0x36, 0x4f, 0x11, 0xab, 0x79, 0xc1, 0x60, 0x95,
-	0x28, 0xc6, 0xf1, 0x14, 0x23, 0x89, 0x09, 0x25, 0x71, 0x89, 0x27, 0x26, 0x67, 0xe7, 0xe5, 0x97,
-	0xe7, 0xa4, 0xa6, 0xa4, 0xa7, 0xa6, 0x20, 0x85, 0x9e, 0x04, 0x0b, 0xd8, 0x5c, 0x15, 0xac, 0xe6,
-	0x3a, 0x81, 0x29, 0xb0, 0xd7, 0x3d, 0xf3, 0xd2, 0xf2, 0xa1, 0x46, 0x8b, 0x22, 0x1b, 0x85, 0x90,
-	0x0c, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x94, 0x58, 0x2a, 0x33, 0xd1, 0x4d, 0xce, 0x48,
-	0xcc, 0xcc, 0xd3, 0x87, 0x8b, 0x54, 0xc0, 0x62, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0x2c, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xce, 0xa8, 0x6a, 0x58, 0x02, 0x00, 0x00,
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x4f, 0xf2, 0x30,
+	0x18, 0xc7, 0xb7, 0x17, 0x5e, 0x0f, 0x05, 0x3c, 0x4c, 0x8d, 0x84, 0xc3, 0x1c, 0xe8, 0xc1, 0xc4,
+	0xb8, 0x25, 0xea, 0xc1, 0xab, 0x24, 0xc6, 0x90, 0x98, 0x48, 0xe0, 0xe6, 0x85, 0x74, 0x6b, 0x19,
+	0x8d, 0xd0, 0x36, 0x6b, 0x45, 0xf6, 0x2d, 0xfc, 0x58, 0x1c, 0xb9, 0xe9, 0xc9, 0x18, 0xf8, 0x22,
+	0x86, 0x96, 0xc1, 0x96, 0xd4, 0x83, 0xa7, 0x2e, 0xff, 0xfe, 0xf2, 0xfb, 0x3f, 0x6b, 0x1e, 0xd0,
+	0x44, 0x29, 0x9a, 0xf1, 0x84, 0x49, 0x16, 0xb1, 0x71, 0x10, 0x26, 0x04, 0xc5, 0x38, 0x88, 0x31,
+	0xc5, 0x82, 0x08, 0x5f, 0xe5, 0xce, 0x41, 0x1e, 0xf1, 0x35, 0xd2, 0x38, 0x8c, 0x59, 0xcc, 0x54,
+	0x18, 0xac, 0xbf, 0x34, 0xda, 0xb8, 0x30, 0xd9, 0xf4, 0x31, 0xc0, 0x53, 0x4c, 0xe5, 0x80, 0xd0,
+	0x61, 0x06, 0x7b, 0x26, 0x98, 0xc3, 0x04, 0x4e, 0x36, 0xcd, 0xad, 0x8f, 0x12, 0xa8, 0x3e, 0xe8,
+	0x59, 0xfa, 0x12, 0x4a, 0xec, 0x74, 0x40, 0x55, 0x6b, 0x34, 0x56, 0xb7, 0x3d, 0xfb, 0xbc, 0x72,
+	0xe5, 0xf9, 0x86, 0x09, 0xfd, 0xfb, 0x35, 0xd8, 0x55, 0x5c, 0xbb, 0x3c, 0xff, 0x3a, 0xb1, 0x7a,
+	0x15, 0xbc, 0x8b, 0x9c, 0x27, 0xb0, 0xcf, 0x13, 0xc6, 0x99, 0xc0, 0x99, 0xec, 0x9f, 0x92, 0xb5,
+	0x8c, 0xb2, 0xae, 0x46, 0x0b, 0xba, 0x1a, 0xcf, 0x87, 0xce, 0x23, 0xa8, 0x09, 0x38, 0xc4, 0x32,
+	0xcd, 0x7c, 0x25, 0xe5, 0x6b, 0x1a, 0x7d, 0x7d, 0x45, 0x16, 0x74, 0x55, 0x91, 0xcb, 0x9c, 0x10,
+	0x1c, 0xc3, 0xe8, 0x85, 0xb2, 0xb7, 0x31, 0x46, 0x31, 0x46, 0xb9, 0xd7, 0xab, 0x97, 0x95, 0xf7,
+	0xcc, 0xe8, 0x6d, 0xab, 0x43, 0xfd, 0x7a, 0x87, 0x0e, 0xd9, 0x46, 0x7d, 0x94, 0x57, 0x6d, 0x2f,
+	0x9d, 0x14, 0x9c, 0x42, 0x84, 0x88, 0x24, 0x8c, 0xc2, 0xf1, 0xe0, 0x97, 0x3a, 0x51, 0xff, 0xef,
+	0x95, 0xfe, 0xd8, 0xe7, 0xed, 0xb4, 0x77, 0xa6, 0x66, 0xd1, 0xee, 0xcd, 0x97, 0xae, 0xbd, 0x58,
+	0xba, 0xf6, 0xf7, 0xd2, 0xb5, 0xdf, 0x57, 0xae, 0xb5, 0x58, 0xb9, 0xd6, 0xe7, 0xca, 0xb5, 0x9e,
+	0x6f, 0x63, 0x22, 0x47, 0xaf, 0xa1, 0x1f, 0xb1, 0x49, 0x50, 0x58, 0x90, 0xe9, 0xcd, 0x65, 0x34,
+	0x82, 0x84, 0x06, 0xdb, 0x64, 0x96, 0x2d, 0x8d, 0x4c, 0x39, 0x16, 0xe1, 0x9e, 0xba, 0xb8, 0xfe,
+	0x09, 0x00, 0x00, 0xff, 0xff, 0x81, 0x43, 0x0c, 0xa4, 0xd3, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -146,6 +157,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalAcknowledgedEventInfos) > 0 {
+		for iNdEx := len(m.AdditionalAcknowledgedEventInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalAcknowledgedEventInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.AcknowledgedEventInfo.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -214,6 +239,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.AcknowledgedEventInfo.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AdditionalAcknowledgedEventInfos) > 0 {
+		for _, e := range m.AdditionalAcknowledgedEventInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -384,6 +415,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalAcknowledgedEventInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalAcknowledgedEventInfos = append(m.AdditionalAcknowledgedEventInfos, BridgeEventInfo{})
+			if err := m.AdditionalAcknowledgedEventInfos[len(m.AdditionalAcknowledgedEventInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
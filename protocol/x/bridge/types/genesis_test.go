@@ -39,6 +39,75 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			err: types.ErrNegativeDuration.Error(),
 		},
+		"valid additional acknowledged event info": {
+			genState: &types.GenesisState{
+				EventParams: types.EventParams{
+					Denom:      "test-coin",
+					EthChainId: 2,
+					EthAddress: "test",
+					AdditionalSources: []types.BridgeSource{
+						{Id: 1, Denom: "test-coin-a", EthChainId: 3, EthAddress: "test-a"},
+					},
+				},
+				AdditionalAcknowledgedEventInfos: []types.BridgeEventInfo{
+					{NextId: 5, EthBlockHeight: 10, SourceId: 1},
+				},
+			},
+		},
+		"invalid AcknowledgedEventInfo source id": {
+			genState: &types.GenesisState{
+				EventParams: types.EventParams{
+					Denom:      "test-coin",
+					EthChainId: 2,
+					EthAddress: "test",
+				},
+				AcknowledgedEventInfo: types.BridgeEventInfo{SourceId: 1},
+			},
+			err: "acknowledged event info source id must be 0",
+		},
+		"additional acknowledged event info for primary source": {
+			genState: &types.GenesisState{
+				EventParams: types.EventParams{
+					Denom:      "test-coin",
+					EthChainId: 2,
+					EthAddress: "test",
+				},
+				AdditionalAcknowledgedEventInfos: []types.BridgeEventInfo{
+					{NextId: 5, EthBlockHeight: 10, SourceId: 0},
+				},
+			},
+			err: "additional acknowledged event info source id cannot be 0",
+		},
+		"duplicate additional acknowledged event info": {
+			genState: &types.GenesisState{
+				EventParams: types.EventParams{
+					Denom:      "test-coin",
+					EthChainId: 2,
+					EthAddress: "test",
+					AdditionalSources: []types.BridgeSource{
+						{Id: 1, Denom: "test-coin-a", EthChainId: 3, EthAddress: "test-a"},
+					},
+				},
+				AdditionalAcknowledgedEventInfos: []types.BridgeEventInfo{
+					{NextId: 5, EthBlockHeight: 10, SourceId: 1},
+					{NextId: 6, EthBlockHeight: 11, SourceId: 1},
+				},
+			},
+			err: "duplicate additional acknowledged event info for source 1",
+		},
+		"additional acknowledged event info for unconfigured source": {
+			genState: &types.GenesisState{
+				EventParams: types.EventParams{
+					Denom:      "test-coin",
+					EthChainId: 2,
+					EthAddress: "test",
+				},
+				AdditionalAcknowledgedEventInfos: []types.BridgeEventInfo{
+					{NextId: 5, EthBlockHeight: 10, SourceId: 2},
+				},
+			},
+			err: "source 2 which is not configured",
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
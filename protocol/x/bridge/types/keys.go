@@ -14,6 +14,10 @@ const (
 	// AcknowledgedEventInfoKey defines the key for the AcknowledgedEventInfo
 	AcknowledgedEventInfoKey = "AckEventInfo"
 
+	// AdditionalAcknowledgedEventInfoKeyPrefix is the prefix to retrieve the AcknowledgedEventInfo
+	// of an additional bridge source. The primary source is stored under AcknowledgedEventInfoKey.
+	AdditionalAcknowledgedEventInfoKeyPrefix = "AckEventInfo:"
+
 	// EventParamsKey defines the key for the EventParams
 	EventParamsKey = "EventParams"
 
@@ -14,6 +14,7 @@ func TestModuleKeys(t *testing.T) {
 
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "AckEventInfo", types.AcknowledgedEventInfoKey)
+	require.Equal(t, "AckEventInfo:", types.AdditionalAcknowledgedEventInfoKeyPrefix)
 	require.Equal(t, "EventParams", types.EventParamsKey)
 	require.Equal(t, "ProposeParams", types.ProposeParamsKey)
 	require.Equal(t, "SafetyParams", types.SafetyParamsKey)
@@ -10,9 +10,17 @@ func (msg *MsgAcknowledgeBridges) GetSigners() []sdk.AccAddress {
 }
 
 func (msg *MsgAcknowledgeBridges) ValidateBasic() error {
-	// Validates that bridge event IDs are consecutive.
+	// Validates that bridge events are grouped by source in increasing order of source ID and that
+	// bridge event IDs are consecutive within each source.
 	for i, event := range msg.Events {
-		if i > 0 && msg.Events[i-1].Id != event.Id-1 {
+		if i == 0 {
+			continue
+		}
+		prev := msg.Events[i-1]
+		if prev.SourceId > event.SourceId {
+			return ErrBridgeSourceIdsNotSorted
+		}
+		if prev.SourceId == event.SourceId && prev.Id != event.Id-1 {
 			return ErrBridgeIdsNotConsecutive
 		}
 	}
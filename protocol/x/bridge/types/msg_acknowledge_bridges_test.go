@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAcknowledgeBridges_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         *types.MsgAcknowledgeBridges
+		expectedErr error
+	}{
+		"Valid: no events": {
+			msg: constants.MsgAcknowledgeBridges_NoEvents,
+		},
+		"Valid: consecutive events": {
+			msg: constants.MsgAcknowledgeBridges_Ids0_1_Height0,
+		},
+		"Valid: consecutive events across sources": {
+			msg: constants.MsgAcknowledgeBridges_Id0_Source0_Ids0_1_Source1,
+		},
+		"Invalid: non-consecutive events": {
+			msg:         constants.MsgAcknowledgeBridges_Ids0_55_Height0,
+			expectedErr: types.ErrBridgeIdsNotConsecutive,
+		},
+		"Invalid: non-consecutive events within a source": {
+			msg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+					{Id: 1, SourceId: 1},
+					{Id: 3, SourceId: 1},
+				},
+			},
+			expectedErr: types.ErrBridgeIdsNotConsecutive,
+		},
+		"Invalid: sources not sorted": {
+			msg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Source1_Id0_Height2,
+					constants.BridgeEvent_Id0_Height0,
+				},
+			},
+			expectedErr: types.ErrBridgeSourceIdsNotSorted,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -6,11 +6,100 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 )
 
+// PrimarySourceId is the id of the bridge source configured by the top-level fields of `EventParams`.
+const PrimarySourceId uint32 = 0
+
 func (m *EventParams) Validate() error {
 	// TODO(CORE-601): More properly validate Ethereum address.
 	if m.EthAddress == "" {
 		return errorsmod.Wrap(ErrInvalidEthAddress, "Ethereum contract address cannot be empty")
 	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+
+	// Additional sources must be sorted by id in strictly ascending order, so that sources and the bridge events
+	// of each source are always processed in the same order.
+	prevId := PrimarySourceId
+	for _, source := range m.AdditionalSources {
+		if source.Id == PrimarySourceId {
+			return errorsmod.Wrapf(
+				ErrInvalidBridgeSource,
+				"additional source id cannot be %d, which is reserved for the primary source",
+				PrimarySourceId,
+			)
+		}
+		if source.Id == prevId {
+			return errorsmod.Wrapf(ErrInvalidBridgeSource, "duplicate source id %d", source.Id)
+		}
+		if source.Id < prevId {
+			return errorsmod.Wrapf(
+				ErrBridgeSourceIdsNotSorted,
+				"additional source id %d is less than previous source id %d",
+				source.Id,
+				prevId,
+			)
+		}
+		prevId = source.Id
+		if err := source.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSources returns all bridge sources sorted by id, starting with the primary source followed by any
+// additional sources. Additional sources of valid params are configured in ascending order of id.
+func (m *EventParams) GetSources() []BridgeSource {
+	sources := make([]BridgeSource, 0, 1+len(m.AdditionalSources))
+	sources = append(sources, BridgeSource{
+		Id:         PrimarySourceId,
+		Denom:      m.Denom,
+		EthChainId: m.EthChainId,
+		EthAddress: m.EthAddress,
+	})
+	return append(sources, m.AdditionalSources...)
+}
+
+// GetSource returns the bridge source with the given id and whether it exists.
+func (m *EventParams) GetSource(sourceId uint32) (BridgeSource, bool) {
+	for _, source := range m.GetSources() {
+		if source.Id == sourceId {
+			return source, true
+		}
+	}
+	return BridgeSource{}, false
+}
+
+// ValidateBridgeEvent returns an error if the source of the given bridge event is not configured or if
+// the denom of the bridged coin is not the denom of the source.
+func (m *EventParams) ValidateBridgeEvent(event BridgeEvent) error {
+	source, exists := m.GetSource(event.SourceId)
+	if !exists {
+		return errorsmod.Wrapf(ErrInvalidBridgeSource, "source id %d is not configured", event.SourceId)
+	}
+	if event.Coin.Denom != source.Denom {
+		return errorsmod.Wrapf(
+			ErrInvalidBridgeEventDenom,
+			"bridge event %d of source %d has denom %s, expected %s",
+			event.Id,
+			event.SourceId,
+			event.Coin.Denom,
+			source.Denom,
+		)
+	}
+	return nil
+}
+
+func (m *BridgeSource) Validate() error {
+	// TODO(CORE-601): More properly validate Ethereum address.
+	if m.EthAddress == "" {
+		return errorsmod.Wrapf(
+			ErrInvalidEthAddress,
+			"Ethereum contract address of source %d cannot be empty",
+			m.Id,
+		)
+	}
 	return sdk.ValidateDenom(m.Denom)
 }
 
@@ -28,7 +28,8 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // EventParams stores parameters about which events to recognize and which
-// tokens to mint.
+// tokens to mint. The denom, chain ID and contract address configure the
+// primary bridge source, which has source id 0.
 type EventParams struct {
 	// The denom of the token to mint.
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -36,6 +37,9 @@ type EventParams struct {
 	EthChainId uint64 `protobuf:"varint,2,opt,name=eth_chain_id,json=ethChainId,proto3" json:"eth_chain_id,omitempty"`
 	// The address of the Ethereum contract to monitor for logs.
 	EthAddress string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	// Bridge sources in addition to the primary source. Each source must have a
+	// unique non-zero id, and sources must be sorted by id in ascending order.
+	AdditionalSources []BridgeSource `protobuf:"bytes,4,rep,name=additional_sources,json=additionalSources,proto3" json:"additional_sources"`
 }
 
 func (m *EventParams) Reset()         { *m = EventParams{} }
@@ -92,6 +96,87 @@ func (m *EventParams) GetEthAddress() string {
 	return ""
 }
 
+func (m *EventParams) GetAdditionalSources() []BridgeSource {
+	if m != nil {
+		return m.AdditionalSources
+	}
+	return nil
+}
+
+// BridgeSource stores parameters about a bridge contract on an EVM chain whose
+// events are recognized, and the token to mint for them.
+type BridgeSource struct {
+	// The id of the source. Event ids are unique per source.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The denom of the token to mint.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The numerical chain ID of the EVM chain to query.
+	EthChainId uint64 `protobuf:"varint,3,opt,name=eth_chain_id,json=ethChainId,proto3" json:"eth_chain_id,omitempty"`
+	// The address of the contract to monitor for logs.
+	EthAddress string `protobuf:"bytes,4,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+}
+
+func (m *BridgeSource) Reset()         { *m = BridgeSource{} }
+func (m *BridgeSource) String() string { return proto.CompactTextString(m) }
+func (*BridgeSource) ProtoMessage()    {}
+func (*BridgeSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29afb5e8a05168cd, []int{1}
+}
+func (m *BridgeSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeSource.Merge(m, src)
+}
+func (m *BridgeSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeSource proto.InternalMessageInfo
+
+func (m *BridgeSource) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BridgeSource) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BridgeSource) GetEthChainId() uint64 {
+	if m != nil {
+		return m.EthChainId
+	}
+	return 0
+}
+
+func (m *BridgeSource) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
 // ProposeParams stores parameters for proposing to the module.
 type ProposeParams struct {
 	// The maximum number of bridge events to propose per block.
@@ -116,7 +201,7 @@ func (m *ProposeParams) Reset()         { *m = ProposeParams{} }
 func (m *ProposeParams) String() string { return proto.CompactTextString(m) }
 func (*ProposeParams) ProtoMessage()    {}
 func (*ProposeParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29afb5e8a05168cd, []int{1}
+	return fileDescriptor_29afb5e8a05168cd, []int{2}
 }
 func (m *ProposeParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +271,7 @@ func (m *SafetyParams) Reset()         { *m = SafetyParams{} }
 func (m *SafetyParams) String() string { return proto.CompactTextString(m) }
 func (*SafetyParams) ProtoMessage()    {}
 func (*SafetyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29afb5e8a05168cd, []int{2}
+	return fileDescriptor_29afb5e8a05168cd, []int{3}
 }
 func (m *SafetyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,6 +316,7 @@ func (m *SafetyParams) GetDelayBlocks() uint32 {
 
 func init() {
 	proto.RegisterType((*EventParams)(nil), "dydxprotocol.bridge.EventParams")
+	proto.RegisterType((*BridgeSource)(nil), "dydxprotocol.bridge.BridgeSource")
 	proto.RegisterType((*ProposeParams)(nil), "dydxprotocol.bridge.ProposeParams")
 	proto.RegisterType((*SafetyParams)(nil), "dydxprotocol.bridge.SafetyParams")
 }
@@ -238,35 +324,40 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/bridge/params.proto", fileDescriptor_29afb5e8a05168cd) }
 
 var fileDescriptor_29afb5e8a05168cd = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x92, 0xd3, 0x3c,
-	0x14, 0x8d, 0xf7, 0xdb, 0x8f, 0x59, 0xe4, 0xb8, 0x11, 0x81, 0x09, 0x3b, 0x8c, 0x93, 0x4d, 0xb5,
-	0x0d, 0xf6, 0xf0, 0x53, 0xd0, 0x62, 0x42, 0xb1, 0x5d, 0x46, 0x54, 0xd0, 0x68, 0xe4, 0xe8, 0xda,
-	0xd1, 0xac, 0x1d, 0x69, 0x24, 0x65, 0x27, 0x79, 0x0b, 0x4a, 0xde, 0x82, 0xd7, 0xd8, 0x72, 0x4b,
-	0x2a, 0x60, 0x92, 0x17, 0x61, 0x7c, 0xe5, 0xec, 0x2c, 0x1d, 0x9d, 0x75, 0xce, 0xf1, 0xb9, 0xe7,
-	0x5c, 0x89, 0x4c, 0xe5, 0x4e, 0x6e, 0x8d, 0xd5, 0x5e, 0x2f, 0x75, 0x93, 0x97, 0x56, 0xc9, 0x1a,
-	0x72, 0x23, 0xac, 0x68, 0x5d, 0x86, 0x30, 0x7d, 0xf2, 0x50, 0x91, 0x05, 0xc5, 0xf9, 0xa8, 0xd6,
-	0xb5, 0x46, 0x30, 0xef, 0xbe, 0x82, 0xf4, 0x3c, 0xad, 0xb5, 0xae, 0x1b, 0xc8, 0xf1, 0x54, 0x6e,
-	0xaa, 0x5c, 0x6e, 0xac, 0xf0, 0x4a, 0xaf, 0x03, 0x3f, 0xab, 0x48, 0xfc, 0xf1, 0x06, 0xd6, 0x7e,
-	0x81, 0xfe, 0x74, 0x44, 0xfe, 0x97, 0xb0, 0xd6, 0xed, 0x38, 0x9a, 0x46, 0x97, 0x8f, 0x59, 0x38,
-	0xd0, 0x29, 0x19, 0x82, 0x5f, 0xf1, 0xe5, 0x4a, 0xa8, 0x35, 0x57, 0x72, 0x7c, 0x32, 0x8d, 0x2e,
-	0x4f, 0x19, 0x01, 0xbf, 0xfa, 0xd0, 0x41, 0x57, 0x92, 0x4e, 0x48, 0xdc, 0x29, 0x84, 0x94, 0x16,
-	0x9c, 0x1b, 0xff, 0x87, 0x7f, 0x77, 0x82, 0xf7, 0x01, 0x99, 0x7d, 0x3f, 0x21, 0xc9, 0xc2, 0x6a,
-	0xa3, 0x1d, 0xf4, 0xa3, 0x5e, 0x91, 0xa7, 0xad, 0xd8, 0xf2, 0x90, 0xde, 0x71, 0x03, 0x96, 0x97,
-	0x8d, 0x5e, 0x5e, 0xe3, 0xe8, 0x84, 0xd1, 0x56, 0x6c, 0x8b, 0xc0, 0x2d, 0xc0, 0x16, 0x1d, 0x43,
-	0x3f, 0x93, 0x67, 0x26, 0x78, 0x70, 0x09, 0x8d, 0xd8, 0xf1, 0x63, 0x19, 0x4c, 0x14, 0xbf, 0x7e,
-	0x9e, 0x85, 0xb6, 0xd9, 0xb1, 0x6d, 0x36, 0xef, 0x05, 0xc5, 0xd9, 0xed, 0xcf, 0xc9, 0xe0, 0xdb,
-	0xaf, 0x49, 0xc4, 0x46, 0xbd, 0xc5, 0xbc, 0x73, 0x38, 0xf2, 0x74, 0x46, 0x12, 0x77, 0xad, 0x0c,
-	0xb7, 0xc2, 0x03, 0x37, 0xa6, 0xc5, 0x0a, 0x09, 0x8b, 0x3b, 0x90, 0x09, 0x0f, 0x0b, 0xd3, 0xd2,
-	0x86, 0x5c, 0xa0, 0x46, 0x55, 0x21, 0x69, 0x08, 0x01, 0x92, 0x97, 0x0f, 0x92, 0x9c, 0xfe, 0x7b,
-	0x92, 0x17, 0x9d, 0xdb, 0x55, 0x85, 0xdd, 0xe6, 0xc1, 0xaa, 0xb8, 0x4f, 0x34, 0x63, 0x64, 0xf8,
-	0x49, 0x54, 0xe0, 0x77, 0xfd, 0xbe, 0x26, 0x24, 0x56, 0x8e, 0x4b, 0xe5, 0x44, 0xd9, 0x80, 0xc4,
-	0x2d, 0x9d, 0x31, 0xa2, 0xdc, 0xbc, 0x47, 0xe8, 0x05, 0x19, 0x86, 0xad, 0x60, 0x38, 0x87, 0x3b,
-	0x49, 0x58, 0x8c, 0x18, 0xce, 0x70, 0x05, 0xbb, 0xdd, 0xa7, 0xd1, 0xdd, 0x3e, 0x8d, 0x7e, 0xef,
-	0xd3, 0xe8, 0xeb, 0x21, 0x1d, 0xdc, 0x1d, 0xd2, 0xc1, 0x8f, 0x43, 0x3a, 0xf8, 0xf2, 0xae, 0x56,
-	0x7e, 0xb5, 0x29, 0xb3, 0xa5, 0x6e, 0xf3, 0xbf, 0xde, 0xdf, 0xcd, 0xdb, 0x97, 0x78, 0xef, 0xf9,
-	0x3d, 0xb2, 0x3d, 0xbe, 0x49, 0xbf, 0x33, 0xe0, 0xca, 0x47, 0x48, 0xbc, 0xf9, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x2b, 0x28, 0x4e, 0x34, 0xb7, 0x02, 0x00, 0x00,
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x8e, 0xd3, 0x30,
+	0x18, 0x6c, 0xda, 0x82, 0x16, 0xa7, 0x45, 0xc2, 0x14, 0x54, 0x56, 0x28, 0xfd, 0x39, 0xf5, 0x42,
+	0x22, 0x16, 0x0e, 0x5c, 0x09, 0xe5, 0xb0, 0xb7, 0xca, 0x2b, 0x21, 0xc1, 0xc5, 0x72, 0x62, 0x37,
+	0xb5, 0x36, 0xa9, 0x2d, 0xdb, 0x5d, 0xda, 0xb7, 0xe0, 0xc8, 0x5b, 0xf0, 0x06, 0x9c, 0xf7, 0xb8,
+	0x47, 0x4e, 0x80, 0xda, 0x17, 0x41, 0xb6, 0xd3, 0xdd, 0x82, 0x10, 0xec, 0x29, 0xf1, 0x7c, 0xe3,
+	0xf9, 0x66, 0xbe, 0x4f, 0x06, 0x43, 0xba, 0xa1, 0x6b, 0xa9, 0x84, 0x11, 0xb9, 0x28, 0x93, 0x4c,
+	0x71, 0x5a, 0xb0, 0x44, 0x12, 0x45, 0x2a, 0x1d, 0x3b, 0x18, 0x3e, 0x3c, 0x64, 0xc4, 0x9e, 0x71,
+	0xdc, 0x2b, 0x44, 0x21, 0x1c, 0x98, 0xd8, 0x3f, 0x4f, 0x3d, 0x8e, 0x0a, 0x21, 0x8a, 0x92, 0x25,
+	0xee, 0x94, 0xad, 0xe6, 0x09, 0x5d, 0x29, 0x62, 0xb8, 0x58, 0xfa, 0xfa, 0xf8, 0x6b, 0x00, 0xc2,
+	0xb7, 0x17, 0x6c, 0x69, 0x66, 0xae, 0x01, 0xec, 0x81, 0x3b, 0x94, 0x2d, 0x45, 0xd5, 0x0f, 0x86,
+	0xc1, 0xe4, 0x1e, 0xf2, 0x07, 0x38, 0x04, 0x1d, 0x66, 0x16, 0x38, 0x5f, 0x10, 0xbe, 0xc4, 0x9c,
+	0xf6, 0x9b, 0xc3, 0x60, 0xd2, 0x46, 0x80, 0x99, 0xc5, 0x1b, 0x0b, 0x9d, 0x52, 0x38, 0x00, 0xa1,
+	0x65, 0x10, 0x4a, 0x15, 0xd3, 0xba, 0xdf, 0x72, 0xb7, 0x2d, 0xe1, 0xb5, 0x47, 0xe0, 0x3b, 0x00,
+	0x09, 0xa5, 0xdc, 0xb6, 0x26, 0x25, 0xd6, 0x62, 0xa5, 0x72, 0xa6, 0xfb, 0xed, 0x61, 0x6b, 0x12,
+	0x9e, 0x8c, 0xe2, 0xbf, 0x04, 0x8a, 0x53, 0xf7, 0x39, 0x73, 0xcc, 0xb4, 0x7d, 0xf9, 0x7d, 0xd0,
+	0x40, 0x0f, 0x6e, 0x24, 0x3c, 0xae, 0xc7, 0x1f, 0x41, 0xe7, 0x90, 0x08, 0xef, 0x83, 0x26, 0xa7,
+	0xce, 0x7d, 0x17, 0x35, 0x39, 0xbd, 0x09, 0xd4, 0xfc, 0x57, 0xa0, 0xd6, 0xff, 0x02, 0xb5, 0xff,
+	0x0c, 0x34, 0xfe, 0xd2, 0x04, 0xdd, 0x99, 0x12, 0x52, 0x68, 0x56, 0xcf, 0xee, 0x39, 0x78, 0x54,
+	0x91, 0x35, 0xf6, 0xf6, 0x35, 0x96, 0x4c, 0xe1, 0xac, 0x14, 0xf9, 0x79, 0xed, 0x06, 0x56, 0x64,
+	0xed, 0xad, 0xea, 0x19, 0x53, 0xa9, 0xad, 0xc0, 0xf7, 0xe0, 0xb1, 0xf4, 0x1a, 0x98, 0xb2, 0x92,
+	0x6c, 0xf0, 0x7e, 0x3d, 0xce, 0x6e, 0x78, 0xf2, 0x24, 0xf6, 0xfb, 0x8b, 0xf7, 0xfb, 0x8b, 0xa7,
+	0x35, 0x21, 0x3d, 0xb2, 0x13, 0xf9, 0xfc, 0x63, 0x10, 0xa0, 0x5e, 0x2d, 0x31, 0xb5, 0x0a, 0xfb,
+	0x3a, 0x1c, 0x83, 0xae, 0x3e, 0xe7, 0x12, 0x2b, 0x62, 0x18, 0x96, 0xb2, 0x72, 0x19, 0xbb, 0x28,
+	0xb4, 0x20, 0x22, 0x86, 0xcd, 0x64, 0x05, 0x4b, 0x30, 0x72, 0x1c, 0x3e, 0xf7, 0x4e, 0xbd, 0x09,
+	0x46, 0x71, 0x76, 0xe0, 0xa4, 0x7d, 0x7b, 0x27, 0x4f, 0xad, 0xda, 0xe9, 0xdc, 0x65, 0x9b, 0x7a,
+	0xa9, 0xf4, 0xda, 0xd1, 0x18, 0x81, 0xce, 0x19, 0x99, 0x33, 0xb3, 0xa9, 0xe7, 0x35, 0x00, 0x21,
+	0xd7, 0x98, 0x72, 0x4d, 0xb2, 0x92, 0xf9, 0x9d, 0x1d, 0x21, 0xc0, 0xf5, 0xb4, 0x46, 0xe0, 0x08,
+	0x74, 0xfc, 0x54, 0x9c, 0x39, 0xed, 0x66, 0xd2, 0x45, 0xa1, 0xc3, 0x5c, 0x0f, 0x9d, 0xa2, 0xcb,
+	0x6d, 0x14, 0x5c, 0x6d, 0xa3, 0xe0, 0xe7, 0x36, 0x0a, 0x3e, 0xed, 0xa2, 0xc6, 0xd5, 0x2e, 0x6a,
+	0x7c, 0xdb, 0x45, 0x8d, 0x0f, 0xaf, 0x0a, 0x6e, 0x16, 0xab, 0x2c, 0xce, 0x45, 0x95, 0xfc, 0xf6,
+	0xa2, 0x2e, 0x5e, 0x3e, 0x73, 0x7b, 0x4f, 0xae, 0x91, 0xf5, 0xfe, 0x95, 0x99, 0x8d, 0x64, 0x3a,
+	0xbb, 0xeb, 0x0a, 0x2f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xa2, 0x50, 0x05, 0x89, 0x03,
+	0x00, 0x00,
 }
 
 func (m *EventParams) Marshal() (dAtA []byte, err error) {
@@ -289,6 +380,20 @@ func (m *EventParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalSources) > 0 {
+		for iNdEx := len(m.AdditionalSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.EthAddress) > 0 {
 		i -= len(m.EthAddress)
 		copy(dAtA[i:], m.EthAddress)
@@ -311,6 +416,53 @@ func (m *EventParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BridgeSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EthChainId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EthChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProposeParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -426,6 +578,35 @@ func (m *EventParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.AdditionalSources) > 0 {
+		for _, e := range m.AdditionalSources {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovParams(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.EthChainId != 0 {
+		n += 1 + sovParams(uint64(m.EthChainId))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -581,6 +762,192 @@ func (m *EventParams) Unmarshal(dAtA []byte) error {
 			}
 			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalSources = append(m.AdditionalSources, BridgeSource{})
+			if err := m.AdditionalSources[len(m.AdditionalSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			}
+			m.EthChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
@@ -33,6 +35,87 @@ func TestEventParams_Validate(t *testing.T) {
 			},
 			err: "invalid denom",
 		},
+		"Valid: additional sources": {
+			params: &types.EventParams{
+				Denom:      "denom",
+				EthChainId: 1,
+				EthAddress: "test",
+				AdditionalSources: []types.BridgeSource{
+					{Id: 1, Denom: "denom-a", EthChainId: 2, EthAddress: "test-a"},
+					{Id: 7, Denom: "denom-b", EthChainId: 1, EthAddress: "test-b"},
+				},
+			},
+		},
+		"Invalid: additional source uses primary source id": {
+			params: &types.EventParams{
+				Denom:      "denom",
+				EthChainId: 1,
+				EthAddress: "test",
+				AdditionalSources: []types.BridgeSource{
+					{Id: 0, Denom: "denom-a", EthChainId: 2, EthAddress: "test-a"},
+				},
+			},
+			err: "reserved for the primary source",
+		},
+		"Invalid: duplicate additional source id": {
+			params: &types.EventParams{
+				Denom:      "denom",
+				EthChainId: 1,
+				EthAddress: "test",
+				AdditionalSources: []types.BridgeSource{
+					{Id: 1, Denom: "denom-a", EthChainId: 2, EthAddress: "test-a"},
+					{Id: 1, Denom: "denom-b", EthChainId: 3, EthAddress: "test-b"},
+				},
+			},
+			err: "duplicate source id 1",
+		},
+		"Invalid: additional sources out of order": {
+			params: &types.EventParams{
+				Denom:      "denom",
+				EthChainId: 1,
+				EthAddress: "test",
+				AdditionalSources: []types.BridgeSource{
+					{Id: 7, Denom: "denom-b", EthChainId: 1, EthAddress: "test-b"},
+					{Id: 1, Denom: "denom-a", EthChainId: 2, EthAddress: "test-a"},
+				},
+			},
+			err: types.ErrBridgeSourceIdsNotSorted.Error(),
+		},
+		"Invalid: duplicate additional source id out of order": {
+			params: &types.EventParams{
+				Denom:      "denom",
+				EthChainId: 1,
+				EthAddress: "test",
+				AdditionalSources: []types.BridgeSource{
+					{Id: 1, Denom: "denom-a", EthChainId: 2, EthAddress: "test-a"},
+					{Id: 7, Denom: "denom-b", EthChainId: 1, EthAddress: "test-b"},
+					{Id: 1, Denom: "denom-c", EthChainId: 3, EthAddress: "test-c"},
+				},
+			},
+			err: types.ErrBridgeSourceIdsNotSorted.Error(),
+		},
+		"Invalid: additional source Eth Address": {
+			params: &types.EventParams{
+				Denom:      "denom",
+				EthChainId: 1,
+				EthAddress: "test",
+				AdditionalSources: []types.BridgeSource{
+					{Id: 1, Denom: "denom-a", EthChainId: 2, EthAddress: ""},
+				},
+			},
+			err: types.ErrInvalidEthAddress.Error(),
+		},
+		"Invalid: additional source denom": {
+			params: &types.EventParams{
+				Denom:      "denom",
+				EthChainId: 1,
+				EthAddress: "test",
+				AdditionalSources: []types.BridgeSource{
+					{Id: 1, Denom: "7coin", EthChainId: 2, EthAddress: "test-a"},
+				},
+			},
+			err: "invalid denom",
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -46,6 +129,76 @@ func TestEventParams_Validate(t *testing.T) {
 	}
 }
 
+func TestEventParams_GetSources(t *testing.T) {
+	params := types.EventParams{
+		Denom:      "denom",
+		EthChainId: 1,
+		EthAddress: "test",
+		AdditionalSources: []types.BridgeSource{
+			{Id: 3, Denom: "denom-a", EthChainId: 2, EthAddress: "test-a"},
+		},
+	}
+	require.Equal(
+		t,
+		[]types.BridgeSource{
+			{Id: types.PrimarySourceId, Denom: "denom", EthChainId: 1, EthAddress: "test"},
+			{Id: 3, Denom: "denom-a", EthChainId: 2, EthAddress: "test-a"},
+		},
+		params.GetSources(),
+	)
+
+	source, exists := params.GetSource(3)
+	require.True(t, exists)
+	require.Equal(t, "denom-a", source.Denom)
+
+	_, exists = params.GetSource(1)
+	require.False(t, exists)
+}
+
+func TestEventParams_ValidateBridgeEvent(t *testing.T) {
+	params := types.EventParams{
+		Denom:      "denom",
+		EthChainId: 1,
+		EthAddress: "test",
+		AdditionalSources: []types.BridgeSource{
+			{Id: 3, Denom: "denom-a", EthChainId: 2, EthAddress: "test-a"},
+		},
+	}
+	tests := map[string]struct {
+		event types.BridgeEvent
+		err   error
+	}{
+		"Valid: primary source": {
+			event: types.BridgeEvent{Coin: sdk.NewCoin("denom", sdkmath.NewInt(1))},
+		},
+		"Valid: additional source": {
+			event: types.BridgeEvent{Coin: sdk.NewCoin("denom-a", sdkmath.NewInt(1)), SourceId: 3},
+		},
+		"Invalid: unknown source": {
+			event: types.BridgeEvent{Coin: sdk.NewCoin("denom-a", sdkmath.NewInt(1)), SourceId: 1},
+			err:   types.ErrInvalidBridgeSource,
+		},
+		"Invalid: denom of another source": {
+			event: types.BridgeEvent{Coin: sdk.NewCoin("denom-a", sdkmath.NewInt(1))},
+			err:   types.ErrInvalidBridgeEventDenom,
+		},
+		"Invalid: unknown denom": {
+			event: types.BridgeEvent{Coin: sdk.NewCoin("denom-b", sdkmath.NewInt(1)), SourceId: 3},
+			err:   types.ErrInvalidBridgeEventDenom,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := params.ValidateBridgeEvent(tc.event)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposeParams_Validate(t *testing.T) {
 	tests := map[string]struct {
 		params *types.ProposeParams
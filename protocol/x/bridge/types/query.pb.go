@@ -279,6 +279,8 @@ func (m *QuerySafetyParamsResponse) GetParams() SafetyParams {
 // QueryAcknowledgedEventInfoRequest is a request type for the
 // AcknowledgedEventInfo RPC method.
 type QueryAcknowledgedEventInfoRequest struct {
+	// The id of the bridge source. Defaults to the primary source.
+	SourceId uint32 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 }
 
 func (m *QueryAcknowledgedEventInfoRequest) Reset()         { *m = QueryAcknowledgedEventInfoRequest{} }
@@ -314,6 +316,13 @@ func (m *QueryAcknowledgedEventInfoRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAcknowledgedEventInfoRequest proto.InternalMessageInfo
 
+func (m *QueryAcknowledgedEventInfoRequest) GetSourceId() uint32 {
+	if m != nil {
+		return m.SourceId
+	}
+	return 0
+}
+
 // QueryAcknowledgedEventInfoResponse is a response type for the
 // AcknowledgedEventInfo RPC method.
 type QueryAcknowledgedEventInfoResponse struct {
@@ -363,6 +372,8 @@ func (m *QueryAcknowledgedEventInfoResponse) GetInfo() BridgeEventInfo {
 // QueryRecognizedEventInfoRequest is a request type for the
 // RecognizedEventInfo RPC method.
 type QueryRecognizedEventInfoRequest struct {
+	// The id of the bridge source. Defaults to the primary source.
+	SourceId uint32 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 }
 
 func (m *QueryRecognizedEventInfoRequest) Reset()         { *m = QueryRecognizedEventInfoRequest{} }
@@ -398,6 +409,13 @@ func (m *QueryRecognizedEventInfoRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryRecognizedEventInfoRequest proto.InternalMessageInfo
 
+func (m *QueryRecognizedEventInfoRequest) GetSourceId() uint32 {
+	if m != nil {
+		return m.SourceId
+	}
+	return 0
+}
+
 // QueryRecognizedEventInfoResponse is a response type for the
 // RecognizedEventInfo RPC method.
 type QueryRecognizedEventInfoResponse struct {
@@ -618,54 +636,55 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/bridge/query.proto", fileDescriptor_b4ca11b6b8f7f939) }
 
 var fileDescriptor_b4ca11b6b8f7f939 = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xc1, 0x53, 0xd3, 0x40,
-	0x14, 0xc6, 0xbb, 0x88, 0x80, 0xaf, 0x70, 0x59, 0x74, 0x2c, 0x11, 0x4b, 0x1b, 0x11, 0xab, 0xd2,
-	0x64, 0x40, 0x10, 0xc6, 0x03, 0x20, 0x8a, 0xa3, 0x07, 0x66, 0xb0, 0xdc, 0x18, 0xc7, 0x4e, 0x9a,
-	0x2c, 0x69, 0x86, 0x36, 0x1b, 0x92, 0x80, 0xd4, 0x9b, 0xde, 0xbc, 0x39, 0xe3, 0xc5, 0x83, 0x07,
-	0xff, 0x0d, 0x8f, 0xde, 0xf0, 0xc6, 0x8c, 0x17, 0x4f, 0x8e, 0xd3, 0xfa, 0x87, 0x38, 0xdd, 0x6c,
-	0x3a, 0x49, 0xdd, 0x64, 0x8a, 0xe3, 0x29, 0xb0, 0xfb, 0xbe, 0xf7, 0xfd, 0xde, 0x86, 0xfd, 0x08,
-	0xcc, 0x18, 0x2d, 0xe3, 0xc4, 0x71, 0xa9, 0x4f, 0x75, 0xda, 0x50, 0x6b, 0xae, 0x65, 0x98, 0x44,
-	0x3d, 0x3c, 0x22, 0x6e, 0x4b, 0x61, 0xab, 0x78, 0x32, 0x5a, 0xa0, 0x04, 0x05, 0xd2, 0x65, 0x93,
-	0x9a, 0x94, 0x2d, 0xaa, 0xdd, 0x9f, 0x82, 0x52, 0x69, 0xda, 0xa4, 0xd4, 0x6c, 0x10, 0x55, 0x73,
-	0x2c, 0x55, 0xb3, 0x6d, 0xea, 0x6b, 0xbe, 0x45, 0x6d, 0x8f, 0xef, 0xde, 0x15, 0x39, 0x05, 0x8f,
-	0x2a, 0x39, 0x26, 0xb6, 0x5f, 0xb5, 0xec, 0xfd, 0xb0, 0x55, 0x41, 0x54, 0xec, 0x68, 0xae, 0xd6,
-	0x0c, 0xdb, 0x4d, 0x8b, 0x2a, 0xfc, 0x93, 0x60, 0x57, 0x9e, 0x82, 0xab, 0xcf, 0xbb, 0x43, 0x6c,
-	0x75, 0x1b, 0xef, 0x30, 0x5d, 0x85, 0x1c, 0x1e, 0x11, 0xcf, 0x97, 0xf7, 0x20, 0xf7, 0xf7, 0x96,
-	0xe7, 0x50, 0xdb, 0x23, 0x78, 0x0d, 0x46, 0x02, 0x93, 0x1c, 0x2a, 0xa0, 0x52, 0x76, 0xb1, 0xa0,
-	0x08, 0xa6, 0x57, 0x22, 0xca, 0xcd, 0xe1, 0xd3, 0x9f, 0x33, 0x99, 0x0a, 0x57, 0xc9, 0xd7, 0x60,
-	0x8a, 0xf5, 0xde, 0x71, 0xa9, 0x43, 0x3d, 0x12, 0x37, 0x7e, 0x09, 0x92, 0x68, 0x93, 0x5b, 0x6f,
-	0xf4, 0x59, 0xcb, 0x42, 0xeb, 0x98, 0xb6, 0xcf, 0x5c, 0xe2, 0x83, 0xed, 0x6a, 0xfb, 0xc4, 0x6f,
-	0xc5, 0xbd, 0x5f, 0x70, 0xb0, 0xf8, 0x1e, 0xb7, 0x5e, 0xef, 0xb3, 0x2e, 0x0a, 0xad, 0xa3, 0xd2,
-	0x3e, 0xe7, 0x1b, 0x50, 0x64, 0xdd, 0x1f, 0xea, 0x07, 0x36, 0x7d, 0xd5, 0x20, 0x86, 0x49, 0x0c,
-	0x76, 0x48, 0xcf, 0xec, 0x7d, 0x1a, 0x22, 0x18, 0x20, 0xa7, 0x15, 0xf5, 0xde, 0xc0, 0x70, 0xf7,
-	0xcf, 0x80, 0x93, 0xcc, 0x0a, 0x49, 0x36, 0xd9, 0xa3, 0xa7, 0xe5, 0x30, 0x4c, 0x27, 0x17, 0x61,
-	0x86, 0xb9, 0x54, 0x88, 0x4e, 0x4d, 0xdb, 0x7a, 0x2d, 0x00, 0xa9, 0x41, 0x21, 0xb9, 0xe4, 0x3f,
-	0x61, 0x6c, 0xc1, 0x6d, 0xe6, 0xf1, 0x98, 0x34, 0xb4, 0x16, 0x31, 0x1e, 0xd1, 0xa6, 0xd3, 0x20,
-	0x3e, 0x09, 0x24, 0xdb, 0xc4, 0xf3, 0x34, 0x93, 0x84, 0x2f, 0x07, 0xe7, 0x60, 0x54, 0x33, 0x0c,
-	0x97, 0x78, 0xc1, 0x0b, 0xb8, 0x54, 0x09, 0x7f, 0x95, 0xdf, 0x20, 0xb8, 0x33, 0x48, 0x1f, 0x4e,
-	0xbd, 0x0b, 0x63, 0x4d, 0xbe, 0x96, 0x43, 0x85, 0x0b, 0xa5, 0xec, 0xe2, 0x82, 0x90, 0x3c, 0xad,
-	0x1b, 0x1f, 0xa3, 0xd7, 0x48, 0x7e, 0x87, 0x60, 0x3a, 0x4d, 0x80, 0x9f, 0xc0, 0x28, 0x2f, 0xe6,
-	0xc7, 0x35, 0x27, 0x34, 0xdd, 0xf6, 0xcc, 0xb8, 0x9e, 0x3b, 0x85, 0x62, 0x5c, 0x84, 0xf1, 0x5a,
-	0x83, 0xea, 0x07, 0xd5, 0x3a, 0xb1, 0xcc, 0xba, 0x9f, 0x1b, 0x2a, 0xa0, 0xd2, 0x44, 0x25, 0xcb,
-	0xd6, 0x9e, 0xb2, 0xa5, 0xc5, 0x6f, 0x63, 0x70, 0x91, 0x9d, 0x07, 0xfe, 0x88, 0x20, 0x1b, 0xb9,
-	0x87, 0x78, 0x5e, 0xe8, 0x99, 0x90, 0x01, 0x52, 0x79, 0xc0, 0xea, 0xe0, 0x5c, 0xe5, 0xf9, 0xb7,
-	0xdf, 0x7f, 0x7f, 0x18, 0x9a, 0xc3, 0xb3, 0x6a, 0x2c, 0x74, 0x8e, 0x97, 0xc2, 0xdc, 0x09, 0xf2,
-	0x2b, 0xb8, 0x0d, 0xf8, 0x33, 0x82, 0x89, 0xd8, 0x3d, 0xc5, 0x4a, 0xb2, 0x9d, 0x28, 0x29, 0x24,
-	0x75, 0xe0, 0x7a, 0x0e, 0xa8, 0x30, 0xc0, 0x12, 0x9e, 0x4b, 0x02, 0x74, 0x02, 0x59, 0x88, 0xf8,
-	0x09, 0xc1, 0x78, 0xf4, 0x3e, 0xe3, 0x94, 0x03, 0x11, 0xc4, 0x89, 0xa4, 0x0c, 0x5a, 0xce, 0xf9,
-	0xca, 0x8c, 0xef, 0x16, 0xbe, 0x99, 0xc4, 0xe7, 0x31, 0x55, 0x88, 0xf7, 0x15, 0xc1, 0x15, 0x61,
-	0x4c, 0xe0, 0xfb, 0xc9, 0xc6, 0x69, 0xe1, 0x23, 0xad, 0x9c, 0x5b, 0xc7, 0xc9, 0x57, 0x18, 0xf9,
-	0x02, 0x56, 0x93, 0xc8, 0xb5, 0x88, 0x3c, 0xf2, 0x7f, 0x0c, 0x7f, 0x41, 0x30, 0x29, 0x48, 0x18,
-	0xbc, 0x94, 0x4c, 0x92, 0x9c, 0x59, 0xd2, 0xf2, 0x39, 0x55, 0x9c, 0x7e, 0x99, 0xd1, 0xab, 0xb8,
-	0x9c, 0x44, 0xef, 0xf6, 0xc4, 0x51, 0xf6, 0x36, 0x82, 0xeb, 0xa9, 0x89, 0x83, 0xd7, 0x92, 0x79,
-	0x06, 0x89, 0x3c, 0x69, 0xfd, 0x9f, 0xf5, 0x7c, 0xb2, 0x0d, 0x36, 0xd9, 0x03, 0xbc, 0x9a, 0x34,
-	0x99, 0x11, 0xb4, 0xa9, 0xea, 0xbc, 0x4f, 0x95, 0x7f, 0x6a, 0x84, 0xb9, 0xb6, 0x59, 0x39, 0x6d,
-	0xe7, 0xd1, 0x59, 0x3b, 0x8f, 0x7e, 0xb5, 0xf3, 0xe8, 0x7d, 0x27, 0x9f, 0x39, 0xeb, 0xe4, 0x33,
-	0x3f, 0x3a, 0xf9, 0xcc, 0xde, 0xaa, 0x69, 0xf9, 0xf5, 0xa3, 0x9a, 0xa2, 0xd3, 0x66, 0x7f, 0xf7,
-	0xb2, 0x5e, 0xd7, 0x2c, 0x5b, 0xed, 0xad, 0x9c, 0xf4, 0xbe, 0x3c, 0x5a, 0x0e, 0xf1, 0x6a, 0x23,
-	0x6c, 0xe3, 0xde, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xca, 0x65, 0x64, 0x56, 0x09, 0x00,
-	0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xc7, 0x3b, 0xbc, 0xbc, 0xfc, 0x78, 0x0a, 0x97, 0xe1, 0x7d, 0x63, 0x59, 0xb0, 0x94, 0x0d,
+	0x22, 0x2a, 0xdd, 0x0d, 0x08, 0x42, 0x3c, 0x00, 0xa2, 0x18, 0x39, 0x90, 0x60, 0xb9, 0x11, 0xe3,
+	0x66, 0xbb, 0x3b, 0x6c, 0x37, 0xb4, 0x3b, 0xcb, 0xee, 0x16, 0xa9, 0x37, 0xbd, 0x79, 0x33, 0xf1,
+	0xe2, 0xc1, 0x83, 0xff, 0x86, 0x47, 0x6f, 0x78, 0x23, 0xf1, 0xe2, 0xc9, 0x98, 0xe2, 0x1f, 0x62,
+	0x3a, 0x33, 0xdb, 0x6c, 0xeb, 0x6c, 0x53, 0x8c, 0xa7, 0x6d, 0x9f, 0x79, 0xbe, 0xcf, 0xf7, 0x33,
+	0x33, 0xdd, 0x6f, 0x61, 0xc6, 0x6e, 0xd8, 0x67, 0x7e, 0x40, 0x23, 0x6a, 0xd1, 0xaa, 0x5e, 0x0e,
+	0x5c, 0xdb, 0x21, 0xfa, 0x49, 0x9d, 0x04, 0x0d, 0x8d, 0x55, 0xf1, 0x44, 0xb2, 0x41, 0xe3, 0x0d,
+	0xca, 0x7f, 0x0e, 0x75, 0x28, 0x2b, 0xea, 0xad, 0x4f, 0xbc, 0x55, 0x99, 0x76, 0x28, 0x75, 0xaa,
+	0x44, 0x37, 0x7d, 0x57, 0x37, 0x3d, 0x8f, 0x46, 0x66, 0xe4, 0x52, 0x2f, 0x14, 0xab, 0x77, 0x64,
+	0x4e, 0xfc, 0x61, 0x90, 0x53, 0xe2, 0x45, 0x86, 0xeb, 0x1d, 0xc5, 0xa3, 0x0a, 0xb2, 0x66, 0xdf,
+	0x0c, 0xcc, 0x5a, 0x3c, 0x6e, 0x5a, 0xd6, 0x11, 0x9d, 0xf1, 0x55, 0x75, 0x12, 0xae, 0x3d, 0x6d,
+	0x6d, 0x62, 0xa7, 0x35, 0x78, 0x9f, 0xe9, 0x4a, 0xe4, 0xa4, 0x4e, 0xc2, 0x48, 0x3d, 0x84, 0xdc,
+	0xef, 0x4b, 0xa1, 0x4f, 0xbd, 0x90, 0xe0, 0x0d, 0x18, 0xe2, 0x26, 0x39, 0x54, 0x40, 0x0b, 0xd9,
+	0xe5, 0x82, 0x26, 0xd9, 0xbd, 0x96, 0x50, 0x6e, 0x0f, 0x9e, 0x7f, 0x9f, 0xc9, 0x94, 0x84, 0x4a,
+	0x9d, 0x82, 0x49, 0x36, 0x7b, 0x3f, 0xa0, 0x3e, 0x0d, 0x49, 0xa7, 0xf1, 0x73, 0x50, 0x64, 0x8b,
+	0xc2, 0x7a, 0xab, 0xcb, 0x5a, 0x95, 0x5a, 0x77, 0x68, 0xbb, 0xcc, 0x15, 0xb1, 0xb1, 0x03, 0xf3,
+	0x88, 0x44, 0x8d, 0x4e, 0xef, 0x67, 0x30, 0x29, 0x59, 0x13, 0xd6, 0x9b, 0x5d, 0xd6, 0xb3, 0x52,
+	0xeb, 0xa4, 0xb4, 0xcb, 0x79, 0x0b, 0x66, 0xd9, 0xf4, 0x07, 0xd6, 0xb1, 0x47, 0x5f, 0x54, 0x89,
+	0xed, 0x10, 0x9b, 0x1d, 0xd2, 0xae, 0x77, 0x44, 0x05, 0x02, 0x9e, 0x82, 0xd1, 0x90, 0xd6, 0x03,
+	0x8b, 0x18, 0xae, 0xcd, 0x8c, 0xc6, 0x4b, 0x23, 0xbc, 0xb0, 0x6b, 0xab, 0x36, 0xa8, 0xbd, 0x26,
+	0xb4, 0xaf, 0x67, 0xb0, 0xf5, 0x1b, 0x11, 0x98, 0x73, 0x52, 0xcc, 0x6d, 0xf6, 0x68, 0x6b, 0x05,
+	0x29, 0xd3, 0xa9, 0x1b, 0x30, 0xc3, 0x5c, 0x4a, 0xc4, 0xa2, 0x8e, 0xe7, 0xbe, 0xbc, 0x2a, 0x65,
+	0x19, 0x0a, 0xe9, 0xfa, 0xbf, 0xc4, 0xb8, 0x03, 0xb7, 0x98, 0xc7, 0x23, 0x52, 0x35, 0x1b, 0xc4,
+	0x7e, 0x48, 0x6b, 0x7e, 0x95, 0x44, 0x84, 0x4b, 0xf6, 0x48, 0x18, 0x9a, 0x0e, 0x89, 0xaf, 0x15,
+	0xe7, 0x60, 0xd8, 0xb4, 0xed, 0x80, 0x84, 0xfc, 0xea, 0x46, 0x4b, 0xf1, 0x57, 0xf5, 0x15, 0x82,
+	0xdb, 0xfd, 0xcc, 0x11, 0xd4, 0x07, 0x30, 0x52, 0x13, 0xb5, 0x1c, 0x2a, 0xfc, 0xb3, 0x90, 0x5d,
+	0x5e, 0x92, 0x92, 0xf7, 0x9a, 0x26, 0xb6, 0xd1, 0x1e, 0xa4, 0xbe, 0x41, 0x30, 0xdd, 0x4b, 0x80,
+	0x1f, 0xc3, 0xb0, 0x68, 0x16, 0xc7, 0x35, 0x2f, 0x35, 0xdd, 0x0b, 0x9d, 0x4e, 0xbd, 0x70, 0x8a,
+	0xc5, 0x78, 0x16, 0xc6, 0xca, 0x55, 0x6a, 0x1d, 0x1b, 0x15, 0xe2, 0x3a, 0x95, 0x28, 0x37, 0xc0,
+	0xee, 0x2d, 0xcb, 0x6a, 0x4f, 0x58, 0x69, 0xf9, 0xcb, 0x08, 0xfc, 0xcb, 0xce, 0x03, 0xbf, 0x47,
+	0x90, 0x4d, 0xbc, 0xc1, 0x78, 0x51, 0xea, 0x99, 0x92, 0x1e, 0x4a, 0xb1, 0xcf, 0x6e, 0x7e, 0xae,
+	0xea, 0xe2, 0xeb, 0xaf, 0x3f, 0xdf, 0x0d, 0xcc, 0xe3, 0x39, 0xbd, 0x23, 0xae, 0x4e, 0x57, 0xe2,
+	0xc4, 0xe2, 0xc9, 0xc7, 0xdf, 0x23, 0xfc, 0x11, 0xc1, 0x78, 0xc7, 0x1b, 0x8e, 0xb5, 0x74, 0x3b,
+	0x59, 0xc6, 0x28, 0x7a, 0xdf, 0xfd, 0x02, 0x50, 0x63, 0x80, 0x0b, 0x78, 0x3e, 0x0d, 0xd0, 0xe7,
+	0xb2, 0x18, 0xf1, 0x03, 0x82, 0xb1, 0x64, 0x12, 0xe0, 0x1e, 0x07, 0x22, 0x09, 0x22, 0x45, 0xeb,
+	0xb7, 0x5d, 0xf0, 0x15, 0x19, 0xdf, 0x4d, 0x7c, 0x23, 0x8d, 0x2f, 0x64, 0xaa, 0x18, 0xef, 0x33,
+	0x82, 0xff, 0xa5, 0x19, 0x82, 0xef, 0xa5, 0x1b, 0xf7, 0x8a, 0x2d, 0x65, 0xed, 0xca, 0x3a, 0x41,
+	0xbe, 0xc6, 0xc8, 0x97, 0xb0, 0x9e, 0x46, 0x6e, 0x26, 0xe4, 0x89, 0x7f, 0x40, 0xfc, 0x09, 0xc1,
+	0x84, 0x24, 0x61, 0xf0, 0x4a, 0x3a, 0x49, 0x7a, 0xa0, 0x29, 0xab, 0x57, 0x54, 0x09, 0xfa, 0x55,
+	0x46, 0xaf, 0xe3, 0x62, 0x1a, 0x7d, 0xd0, 0x16, 0x27, 0xd9, 0x9b, 0x08, 0xae, 0xf7, 0x4c, 0x1c,
+	0xbc, 0x91, 0xce, 0xd3, 0x4f, 0xe4, 0x29, 0x9b, 0x7f, 0xac, 0x17, 0x3b, 0xdb, 0x62, 0x3b, 0xbb,
+	0x8f, 0xd7, 0xd3, 0x76, 0x66, 0xf3, 0x31, 0x86, 0x25, 0xe6, 0x18, 0xbc, 0x6e, 0xc4, 0xb9, 0xb6,
+	0x5d, 0x3a, 0x6f, 0xe6, 0xd1, 0x45, 0x33, 0x8f, 0x7e, 0x34, 0xf3, 0xe8, 0xed, 0x65, 0x3e, 0x73,
+	0x71, 0x99, 0xcf, 0x7c, 0xbb, 0xcc, 0x67, 0x0e, 0xd7, 0x1d, 0x37, 0xaa, 0xd4, 0xcb, 0x9a, 0x45,
+	0x6b, 0xdd, 0xd3, 0x8b, 0x56, 0xc5, 0x74, 0x3d, 0xbd, 0x5d, 0x39, 0x8b, 0xed, 0xa2, 0x86, 0x4f,
+	0xc2, 0xf2, 0x10, 0x5b, 0xb8, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x47, 0x58, 0xf6, 0xfa, 0x90,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1138,6 +1157,11 @@ func (m *QueryAcknowledgedEventInfoRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.SourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1194,6 +1218,11 @@ func (m *QueryRecognizedEventInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.SourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1412,6 +1441,9 @@ func (m *QueryAcknowledgedEventInfoRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.SourceId != 0 {
+		n += 1 + sovQuery(uint64(m.SourceId))
+	}
 	return n
 }
 
@@ -1432,6 +1464,9 @@ func (m *QueryRecognizedEventInfoRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.SourceId != 0 {
+		n += 1 + sovQuery(uint64(m.SourceId))
+	}
 	return n
 }
 
@@ -1922,6 +1957,25 @@ func (m *QueryAcknowledgedEventInfoRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryAcknowledgedEventInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			m.SourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2055,6 +2109,25 @@ func (m *QueryRecognizedEventInfoRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryRecognizedEventInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			m.SourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6,9 +6,9 @@ import (
 
 type BridgeKeeper interface {
 	// Bridge Events
-	GetAcknowledgedEventInfo(ctx sdk.Context) BridgeEventInfo
+	GetAcknowledgedEventInfo(ctx sdk.Context, sourceId uint32) BridgeEventInfo
 
-	GetRecognizedEventInfo(ctx sdk.Context) BridgeEventInfo
+	GetRecognizedEventInfo(ctx sdk.Context, sourceId uint32) BridgeEventInfo
 
 	AcknowledgeBridges(ctx sdk.Context, bridges []BridgeEvent) error
 
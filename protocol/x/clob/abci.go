@@ -7,10 +7,11 @@ import (
 	gometrics "github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
 	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/liquidations"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
@@ -70,10 +71,12 @@ func EndBlocker(
 		)
 	}
 
-	// Prune expired untriggered conditional orders from the in-memory UntriggeredConditionalOrders struct.
+	// Prune expired, cancelled and removed untriggered conditional orders from the in-memory
+	// UntriggeredConditionalOrders struct.
 	keeper.PruneUntriggeredConditionalOrders(
 		expiredStatefulOrderIds,
 		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
 	)
 
 	// Update the memstore with expired order ids.
@@ -93,10 +96,16 @@ func EndBlocker(
 	)
 
 	// Poll out all triggered conditional orders from `UntriggeredConditionalOrders` and update state.
-	triggeredConditionalOrderIds := keeper.MaybeTriggerConditionalOrders(ctx)
+	triggeredConditionalOrderIds, removedOneCancelsOtherOrderIds := keeper.MaybeTriggerConditionalOrders(ctx)
 	// Update the memstore with conditional order ids triggered in the last block.
 	// These triggered conditional orders will be placed in the `PrepareCheckState``.
 	processProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock = triggeredConditionalOrderIds
+	// Update the memstore with one-cancels-other orders removed by triggered conditional orders.
+	// These removed orders will be purged from the memclob in `PrepareCheckState`.
+	processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
+		removedOneCancelsOtherOrderIds...,
+	)
 
 	// Write the ProcessProposerMatchcesEvents with all the EndBlocker updates to state.
 	keeper.MustSetProcessProposerMatchesEvents(
@@ -108,11 +117,15 @@ func EndBlocker(
 	keeper.PruneRateLimits(ctx)
 
 	// Emit relevant metrics at the end of every block.
-	telemetry.SetGaugeWithLabels(
-		[]string{metrics.InsuranceFundBalance},
-		metrics.GetMetricValueFromBigInt(keeper.GetInsuranceFundBalance(ctx)),
-		[]gometrics.Label{},
-	)
+	for _, insuranceFundBalance := range keeper.GetAllInsuranceFundBalances(ctx) {
+		telemetry.SetGaugeWithLabels(
+			[]string{metrics.InsuranceFundBalance},
+			metrics.GetMetricValueFromBigInt(insuranceFundBalance.Balance.BigInt()),
+			[]gometrics.Label{
+				metrics.GetLabelForStringValue(metrics.InsuranceFund, insuranceFundBalance.Name),
+			},
+		)
+	}
 }
 
 // PrepareCheckState executes all ABCI PrepareCheckState logic respective to the clob module.
@@ -120,6 +133,7 @@ func PrepareCheckState(
 	ctx sdk.Context,
 	keeper *keeper.Keeper,
 	liquidatableSubaccountIds *liquidationtypes.LiquidatableSubaccountIds,
+	daemonHealthChecker daemonservertypes.DaemonHealthChecker,
 ) {
 	// Get the events generated from processing the matches in the latest block.
 	processProposerMatchesEvents := keeper.GetProcessProposerMatchesEvents(ctx)
@@ -178,14 +192,34 @@ func PrepareCheckState(
 		metrics.Count,
 	)
 
-	// 4. Place all conditional orders triggered in EndBlocker of last block on the memclob.
+	// 4. Replace all stateful orders replaced in the last block on the memclob.
+	startReplaceLongTermOrders := time.Now()
+	offchainUpdates = keeper.ReplaceStatefulOrdersFromLastBlock(
+		ctx,
+		processProposerMatchesEvents.ReplacedLongTermOrderIds,
+		offchainUpdates,
+	)
+	telemetry.MeasureSince(
+		startReplaceLongTermOrders,
+		types.ModuleName,
+		metrics.ReplaceLongTermOrdersFromLastBlock,
+		metrics.Latency,
+	)
+	telemetry.SetGauge(
+		float32(len(processProposerMatchesEvents.ReplacedLongTermOrderIds)),
+		types.ModuleName,
+		metrics.ReplaceLongTermOrdersFromLastBlock,
+		metrics.Count,
+	)
+
+	// 5. Place all conditional orders triggered in EndBlocker of last block on the memclob.
 	offchainUpdates = keeper.PlaceConditionalOrdersTriggeredInLastBlock(
 		ctx,
 		processProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock,
 		offchainUpdates,
 	)
 
-	// 5. Replay the local validator’s operations onto the book.
+	// 6. Replay the local validator’s operations onto the book.
 	replayUpdates := keeper.MemClob.ReplayOperations(
 		ctx,
 		localValidatorOperationsQueue,
@@ -198,15 +232,28 @@ func PrepareCheckState(
 		offchainUpdates = replayUpdates
 	}
 
-	// 6. Get all potentially liquidatable subaccount IDs and attempt to liquidate them.
-	subaccountIds := liquidatableSubaccountIds.GetSubaccountIds()
-	if err := keeper.LiquidateSubaccountsAgainstOrderbook(ctx, subaccountIds); err != nil {
-		panic(err)
+	// 7. Get all potentially liquidatable subaccount IDs and attempt to liquidate them. The subaccount IDs
+	// reported by the liquidations daemon are ignored while the node is in degraded mode for the daemon, so
+	// that no liquidations are proposed based on stale data.
+	if daemonHealthChecker.IsDaemonDegraded(daemonservertypes.LiquidationsDaemonServiceName) {
+		telemetry.IncrCounter(1, types.ModuleName, metrics.LiquidationDaemon, metrics.Degraded, metrics.Count)
+	} else {
+		subaccountIds := liquidatableSubaccountIds.GetSubaccountIds()
+		if err := keeper.LiquidateSubaccountsAgainstOrderbook(ctx, subaccountIds); err != nil {
+			panic(err)
+		}
 	}
 
 	// Send all off-chain Indexer events
 	keeper.SendOffchainMessages(offchainUpdates, nil, metrics.SendPrepareCheckStateOffchainUpdates)
 
+	// Send orderbook snapshots to gRPC streams which subscribed since the last block. This happens
+	// after all off-chain updates for this block have been sent so that the snapshots are consistent
+	// with the incremental updates that follow.
+	if keeper.GetGrpcStreamingManager().Enabled() {
+		keeper.InitializeNewGrpcStreams(ctx)
+	}
+
 	newLocalValidatorOperationsQueue, _ := keeper.MemClob.GetOperationsToReplay(ctx)
 	keeper.Logger(ctx).Debug(
 		"Local operations queue after PrepareCheckState",
@@ -218,4 +265,10 @@ func PrepareCheckState(
 
 	// Set per-orderbook gauges.
 	keeper.MemClob.SetMemclobGauges(ctx)
+
+	// Update the snapshot of the orderbooks served by queries, which cannot read the memclob directly.
+	// Copying the orderbooks is skipped on nodes which do not serve orderbook queries.
+	if keeper.Flags.OrderbookQueriesEnabled {
+		keeper.UpdateOrderbookSnapshot(ctx)
+	}
 }
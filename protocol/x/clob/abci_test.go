@@ -14,7 +14,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	prices "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -26,6 +26,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
 	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/liquidations"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
@@ -687,6 +688,9 @@ func TestEndBlocker_Success(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1101,6 +1105,7 @@ func TestPrepareCheckState_WithProcessProposerMatchesEventsWithBadBlockHeight(t
 			ks.Ctx.WithBlockHeight(int64(blockHeight+1)),
 			ks.ClobKeeper,
 			liquidationtypes.NewLiquidatableSubaccountIds(),
+			&mocks.DaemonHealthChecker{},
 		)
 	})
 }
@@ -1128,6 +1133,7 @@ func TestCommitBlocker_WithProcessProposerMatchesEventsWithBadBlockHeight(t *tes
 			ks.Ctx.WithBlockHeight(int64(blockHeight+1)),
 			ks.ClobKeeper,
 			liquidationtypes.NewLiquidatableSubaccountIds(),
+			&mocks.DaemonHealthChecker{},
 		)
 	})
 }
@@ -1241,7 +1247,8 @@ func TestPrepareCheckState(t *testing.T) {
 		placedOperations []types.Operation
 
 		// Parameters.
-		liquidatableSubaccounts []satypes.SubaccountId
+		liquidatableSubaccounts      []satypes.SubaccountId
+		isLiquidationsDaemonDegraded bool
 
 		// Expectations.
 		expectedOperationsQueue []types.InternalOperation
@@ -1264,6 +1271,25 @@ func TestPrepareCheckState(t *testing.T) {
 			expectedBids:            []memclob.OrderWithRemainingSize{},
 			expectedAsks:            []memclob.OrderWithRemainingSize{},
 		},
+		"Liquidatable subaccounts are ignored while the liquidations daemon is degraded": {
+			perpetuals:                []*perptypes.Perpetual{},
+			subaccounts:               []satypes.Subaccount{},
+			clobs:                     []types.ClobPair{},
+			preExistingStatefulOrders: []types.Order{},
+			processProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: 4,
+			},
+			placedOperations: []types.Operation{},
+
+			liquidatableSubaccounts: []satypes.SubaccountId{
+				constants.Carl_Num0,
+			},
+			isLiquidationsDaemonDegraded: true,
+
+			expectedOperationsQueue: []types.InternalOperation{},
+			expectedBids:            []memclob.OrderWithRemainingSize{},
+			expectedAsks:            []memclob.OrderWithRemainingSize{},
+		},
 		`Regression: Local validator replays two matches of exactly the same size for the same OrderId to the memclob.
 		 ReplayOperations should not panic as the MatchOperations should have unique taker OrderHashes therefore the
 			nonce for the second match should not already exist.`: {
@@ -1370,6 +1396,9 @@ func TestPrepareCheckState(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1479,11 +1508,18 @@ func TestPrepareCheckState(t *testing.T) {
 			liquidatableSubaccountIds := liquidationtypes.NewLiquidatableSubaccountIds()
 			liquidatableSubaccountIds.UpdateSubaccountIds(tc.liquidatableSubaccounts)
 
+			daemonHealthChecker := &mocks.DaemonHealthChecker{}
+			daemonHealthChecker.On(
+				"IsDaemonDegraded",
+				daemonservertypes.LiquidationsDaemonServiceName,
+			).Return(tc.isLiquidationsDaemonDegraded)
+
 			// Run the test.
 			clob.PrepareCheckState(
 				ctx,
 				ks.ClobKeeper,
 				liquidatableSubaccountIds,
+				daemonHealthChecker,
 			)
 
 			// Verify test expectations.
@@ -1491,6 +1527,7 @@ func TestPrepareCheckState(t *testing.T) {
 			operationsQueue, _ := memClob.GetOperationsToReplay(ctx)
 
 			require.Equal(t, tc.expectedOperationsQueue, operationsQueue)
+			daemonHealthChecker.AssertExpectations(t)
 
 			memclob.AssertMemclobHasOrders(
 				t,
@@ -13,20 +13,25 @@ import (
 
 // ClobDecorator is an AnteDecorator which is responsible for:
 //   - adding short term order placements and cancelations to the in-memory orderbook (`CheckTx` only).
+//   - adding batches of short term order placements and cancelations to the in-memory orderbook (`CheckTx` only).
+//   - replacing short term orders on the in-memory orderbook (`CheckTx` only).
+//   - validating stateful order replacements (`CheckTx` and `RecheckTx` only).
 //   - adding stateful order placements and cancelations to state (`CheckTx` and `RecheckTx` only).
 //
 // This AnteDecorator also enforces that any Transaction which contains a `MsgPlaceOrder`,
-// or a `MsgCancelOrder`, must consist only of a single message.
+// `MsgCancelOrder`, `MsgBatchPlaceAndCancel` or `MsgReplaceOrder`, must consist only of a single message.
 //
 // This AnteDecorator is a no-op if:
-//   - No messages in the transaction are `MsgPlaceOrder` or `MsgCancelOrder`.
+//   - No messages in the transaction are `MsgPlaceOrder`, `MsgCancelOrder`, `MsgBatchPlaceAndCancel` or
+//     `MsgReplaceOrder`.
 //   - This AnteDecorator is called during `DeliverTx`.
 //
 // This AnteDecorator returns an error if:
-//   - The transaction contains multiple messages, and one of them is a `MsgPlaceOrder`
-//     or `MsgCancelOrder` message.
-//   - The underlying `PlaceStatefulOrder`, `PlaceShortTermOrder`, `CancelStatefulOrder`, or `CancelShortTermOrder`
-//     methods on the keeper return errors.
+//   - The transaction contains multiple messages, and one of them is a `MsgPlaceOrder`,
+//     `MsgCancelOrder`, `MsgBatchPlaceAndCancel` or `MsgReplaceOrder` message.
+//   - The underlying `PlaceStatefulOrder`, `PlaceShortTermOrder`, `CancelStatefulOrder`, `CancelShortTermOrder`,
+//     `BatchPlaceAndCancelShortTermOrders`, `ReplaceStatefulOrder` or `ReplaceShortTermOrder` methods on the
+//     keeper return errors.
 type ClobDecorator struct {
 	clobKeeper types.ClobKeeper
 }
@@ -139,6 +144,84 @@ func (cd ClobDecorator) AnteHandle(
 				lib.TxMode(ctx),
 			)
 		}
+
+	case *types.MsgBatchPlaceAndCancel:
+		// No need to process batches of short term orders on `ReCheckTx`.
+		if ctx.IsReCheckTx() {
+			return next(ctx, tx, simulate)
+		}
+
+		var resp *types.MsgBatchPlaceAndCancelResponse
+		// Note that `msg.ValidateBasic` is called before all AnteHandlers.
+		// This guarantees that `MsgBatchPlaceAndCancel` has undergone stateless validation.
+		// The result of each cancellation and placement is emitted as an event by the keeper, and is
+		// therefore returned in the CheckTx response.
+		resp, err = cd.clobKeeper.BatchPlaceAndCancelShortTermOrders(ctx, msg)
+		cd.clobKeeper.Logger(ctx).Debug("Received new batch of short term order cancelations and placements",
+			"tx",
+			log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			"msg",
+			msg,
+			"results",
+			resp,
+			"err",
+			err,
+			"block",
+			ctx.BlockHeight(),
+			"txMode",
+			lib.TxMode(ctx),
+		)
+
+	case *types.MsgReplaceOrder:
+		if msg.Order.OrderId.IsStatefulOrder() {
+			err = cd.clobKeeper.ReplaceStatefulOrder(ctx, msg)
+			cd.clobKeeper.Logger(ctx).Debug("Received new stateful order replacement",
+				"tx",
+				log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+				"orderHash",
+				log.NewLazySprintf("%X", msg.Order.GetOrderHash()),
+				"msg",
+				msg,
+				"err",
+				err,
+				"block",
+				ctx.BlockHeight(),
+				"txMode",
+				lib.TxMode(ctx),
+			)
+		} else {
+			// No need to process short term order replacements on `ReCheckTx`.
+			if ctx.IsReCheckTx() {
+				return next(ctx, tx, simulate)
+			}
+
+			var orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums
+			var status types.OrderStatus
+			// Note that `msg.ValidateBasic` is called before all AnteHandlers.
+			// This guarantees that `MsgReplaceOrder` has undergone stateless validation.
+			orderSizeOptimisticallyFilledFromMatchingQuantums, status, err = cd.clobKeeper.ReplaceShortTermOrder(
+				ctx,
+				msg,
+			)
+			cd.clobKeeper.Logger(ctx).Debug("Received new short term order replacement",
+				"tx",
+				log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+				"orderHash",
+				log.NewLazySprintf("%X", msg.Order.GetOrderHash()),
+				"msg",
+				msg,
+				"status",
+				status,
+				"orderSizeOptimisticallyFilledFromMatchingQuantums",
+				orderSizeOptimisticallyFilledFromMatchingQuantums,
+				"err",
+				err,
+				"block",
+				ctx.BlockHeight(),
+				"txMode",
+				lib.TxMode(ctx),
+			)
+		}
 	}
 	if err != nil {
 		return ctx, err
@@ -148,15 +231,15 @@ func (cd ClobDecorator) AnteHandle(
 }
 
 // IsSingleClobMsgTx returns `true` if the supplied `tx` consist of a single clob message
-// (`MsgPlaceOrder` or `MsgCancelOrder`). If `msgs` consist of multiple clob messages,
-// or a mix of on-chain and clob messages, an error is returned.
+// (`MsgPlaceOrder`, `MsgCancelOrder`, `MsgBatchPlaceAndCancel` or `MsgReplaceOrder`). If `msgs` consist of
+// multiple clob messages, or a mix of on-chain and clob messages, an error is returned.
 func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	msgs := tx.GetMsgs()
 	var hasMessage = false
 
 	for _, msg := range msgs {
 		switch msg.(type) {
-		case *types.MsgCancelOrder, *types.MsgPlaceOrder:
+		case *types.MsgCancelOrder, *types.MsgPlaceOrder, *types.MsgBatchPlaceAndCancel, *types.MsgReplaceOrder:
 			hasMessage = true
 		}
 
@@ -173,7 +256,8 @@ func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	if numMsgs > 1 {
 		return false, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"a transaction containing MsgCancelOrder or MsgPlaceOrder may not contain more than one message",
+			"a transaction containing MsgCancelOrder, MsgPlaceOrder, MsgBatchPlaceAndCancel or MsgReplaceOrder may not "+
+				"contain more than one message",
 		)
 	}
 
@@ -181,8 +265,9 @@ func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 }
 
 // IsShortTermClobMsgTx returns `true` if the supplied `tx` consist of a single clob message
-// (`MsgPlaceOrder` or `MsgCancelOrder`) which references a Short-Term Order. If `msgs` consist of multiple
-// clob messages, or a mix of on-chain and clob messages, an error is returned.
+// (`MsgPlaceOrder`, `MsgCancelOrder`, `MsgBatchPlaceAndCancel` or `MsgReplaceOrder`) which references a
+// Short-Term Order. If `msgs` consist of multiple clob messages, or a mix of on-chain and clob messages, an error
+// is returned.
 func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	msgs := tx.GetMsgs()
 
@@ -202,6 +287,17 @@ func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 					isShortTermOrder = true
 				}
 			}
+		case *types.MsgBatchPlaceAndCancel:
+			{
+				// Batches may only contain Short-Term orders.
+				isShortTermOrder = true
+			}
+		case *types.MsgReplaceOrder:
+			{
+				if msg.Order.OrderId.IsShortTermOrder() {
+					isShortTermOrder = true
+				}
+			}
 		}
 
 		if isShortTermOrder {
@@ -217,7 +313,8 @@ func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	if numMsgs > 1 {
 		return false, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"a transaction containing MsgCancelOrder or MsgPlaceOrder may not contain more than one message",
+			"a transaction containing MsgCancelOrder, MsgPlaceOrder, MsgBatchPlaceAndCancel or MsgReplaceOrder may not "+
+				"contain more than one message",
 		)
 	}
 
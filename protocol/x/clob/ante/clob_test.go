@@ -271,6 +271,26 @@ func TestIsClobTransaction(t *testing.T) {
 			expectedResult: true,
 			expectedErr:    nil,
 		},
+		"Returns true for a `BatchPlaceAndCancel` message": {
+			msgs:           []sdk.Msg{constants.Msg_BatchPlaceAndCancel},
+			expectedResult: true,
+			expectedErr:    nil,
+		},
+		"Returns false and error for mix of `MsgSend` and `BatchPlaceAndCancel` messages": {
+			msgs:           []sdk.Msg{constants.Msg_Send, constants.Msg_BatchPlaceAndCancel},
+			expectedResult: false,
+			expectedErr:    sdkerrors.ErrInvalidRequest,
+		},
+		"Returns true for a `ReplaceOrder` message": {
+			msgs:           []sdk.Msg{constants.Msg_ReplaceOrder},
+			expectedResult: true,
+			expectedErr:    nil,
+		},
+		"Returns false and error for mix of `MsgSend` and `ReplaceOrder` messages": {
+			msgs:           []sdk.Msg{constants.Msg_Send, constants.Msg_ReplaceOrder},
+			expectedResult: false,
+			expectedErr:    sdkerrors.ErrInvalidRequest,
+		},
 	}
 
 	// Run tests.
@@ -346,6 +366,21 @@ func TestIsShortTermClobTransaction(t *testing.T) {
 			expectedResult: true,
 			expectedErr:    nil,
 		},
+		"Returns true for a `BatchPlaceAndCancel` message": {
+			msgs:           []sdk.Msg{constants.Msg_BatchPlaceAndCancel},
+			expectedResult: true,
+			expectedErr:    nil,
+		},
+		"Returns true for a Short-Term `ReplaceOrder` message": {
+			msgs:           []sdk.Msg{constants.Msg_ReplaceOrder},
+			expectedResult: true,
+			expectedErr:    nil,
+		},
+		"Returns false for a Stateful `ReplaceOrder` message": {
+			msgs:           []sdk.Msg{constants.Msg_ReplaceOrder_LongTerm},
+			expectedResult: false,
+			expectedErr:    nil,
+		},
 		"Returns false for a Stateful `PlaceOrder` message": {
 			msgs:           []sdk.Msg{constants.Msg_PlaceOrder_LongTerm},
 			expectedResult: false,
@@ -536,3 +571,141 @@ func TestClobDecorator_MsgCancelOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestClobDecorator_MsgBatchPlaceAndCancel(t *testing.T) {
+	tests := map[string]TestCase{
+		"Successfully places and cancels a batch of short term orders": {
+			msgs: []sdk.Msg{constants.Msg_BatchPlaceAndCancel},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("BatchPlaceAndCancelShortTermOrders",
+					ctx,
+					constants.Msg_BatchPlaceAndCancel,
+				).Return(
+					&clobtypes.MsgBatchPlaceAndCancelResponse{},
+					nil,
+				)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             nil,
+		},
+		"BatchPlaceAndCancelShortTermOrders is not called on keeper during deliver": {
+			msgs:                    []sdk.Msg{constants.Msg_BatchPlaceAndCancel},
+			useWithIsCheckTxContext: false,
+			expectedErr:             nil,
+		},
+		"BatchPlaceAndCancelShortTermOrders is not called on keeper during simulate": {
+			msgs:                    []sdk.Msg{constants.Msg_BatchPlaceAndCancel},
+			useWithIsCheckTxContext: false,
+			isSimulate:              true,
+			expectedErr:             nil,
+		},
+		"BatchPlaceAndCancelShortTermOrders is not called on keeper during re-check": {
+			msgs:                      []sdk.Msg{constants.Msg_BatchPlaceAndCancel},
+			useWithIsCheckTxContext:   false,
+			useWithIsRecheckTxContext: true,
+			isSimulate:                false,
+			expectedErr:               nil,
+		},
+		"Fails if BatchPlaceAndCancelShortTermOrders returns an error": {
+			msgs: []sdk.Msg{constants.Msg_BatchPlaceAndCancel},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("BatchPlaceAndCancelShortTermOrders",
+					ctx,
+					constants.Msg_BatchPlaceAndCancel,
+				).Return(
+					nil,
+					clobtypes.ErrHeightExceedsGoodTilBlock,
+				)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             clobtypes.ErrHeightExceedsGoodTilBlock,
+		},
+		"Fails if there are multiple off-chain messages": {
+			msgs:                    []sdk.Msg{constants.Msg_BatchPlaceAndCancel, constants.Msg_PlaceOrder},
+			useWithIsCheckTxContext: true,
+			expectedErr:             sdkerrors.ErrInvalidRequest,
+		},
+		"Fails if there are a mix of off-chain and on-chain messages": {
+			msgs:                    []sdk.Msg{constants.Msg_BatchPlaceAndCancel, constants.Msg_Send},
+			useWithIsCheckTxContext: true,
+			expectedErr:             sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runTestCase(t, tc)
+		})
+	}
+}
+
+func TestClobDecorator_MsgReplaceOrder(t *testing.T) {
+	tests := map[string]TestCase{
+		"Successfully replaces a short term order": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("ReplaceShortTermOrder",
+					ctx,
+					constants.Msg_ReplaceOrder,
+				).Return(
+					satypes.BaseQuantums(0),
+					clobtypes.Success,
+					nil,
+				)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             nil,
+		},
+		"Successfully replaces a long term order": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder_LongTerm},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("ReplaceStatefulOrder",
+					ctx,
+					constants.Msg_ReplaceOrder_LongTerm,
+				).Return(nil)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             nil,
+		},
+		"ReplaceShortTermOrder is not called on keeper during re-check": {
+			msgs:                      []sdk.Msg{constants.Msg_ReplaceOrder},
+			useWithIsCheckTxContext:   false,
+			useWithIsRecheckTxContext: true,
+			isSimulate:                false,
+			expectedErr:               nil,
+		},
+		"ReplaceStatefulOrder is not called on keeper during deliver": {
+			msgs:                    []sdk.Msg{constants.Msg_ReplaceOrder_LongTerm},
+			useWithIsCheckTxContext: false,
+			expectedErr:             nil,
+		},
+		"Fails if ReplaceShortTermOrder returns an error": {
+			msgs: []sdk.Msg{constants.Msg_ReplaceOrder},
+			setupMocks: func(ctx sdk.Context, mck *mocks.ClobKeeper) {
+				mck.On("ReplaceShortTermOrder",
+					ctx,
+					constants.Msg_ReplaceOrder,
+				).Return(
+					satypes.BaseQuantums(0),
+					clobtypes.Success,
+					clobtypes.ErrOrderToReplaceDoesNotExist,
+				)
+			},
+			useWithIsCheckTxContext: true,
+			expectedErr:             clobtypes.ErrOrderToReplaceDoesNotExist,
+		},
+		"Fails if there are multiple off-chain messages": {
+			msgs:                    []sdk.Msg{constants.Msg_ReplaceOrder, constants.Msg_PlaceOrder},
+			useWithIsCheckTxContext: true,
+			expectedErr:             sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runTestCase(t, tc)
+		})
+	}
+}
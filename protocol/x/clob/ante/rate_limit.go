@@ -7,15 +7,19 @@ import (
 
 var _ sdktypes.AnteDecorator = (*ClobRateLimitDecorator)(nil)
 
-// ClobRateLimitDecorator is an AnteDecorator which is responsible for rate limiting MsgCancelOrder and MsgPlaceOrder
-// requests.
+// ClobRateLimitDecorator is an AnteDecorator which is responsible for rate limiting MsgCancelOrder, MsgPlaceOrder,
+// MsgBatchPlaceAndCancel and MsgReplaceOrder requests.
 //
 // This AnteDecorator is a no-op if:
-//   - No messages in the transaction are `MsgCancelOrder` or `MsgPlaceOrder`.
+//   - No messages in the transaction are `MsgCancelOrder`, `MsgPlaceOrder`, `MsgBatchPlaceAndCancel` or
+//     `MsgReplaceOrder`.
 //
 // This AnteDecorator returns an error if:
 //   - The rate limit is exceeded for any `MsgCancelOrder` messages.
 //   - The rate limit is exceeded for any `MsgPlaceOrder` messages.
+//   - The rate limit is exceeded for any cancellation or placement within `MsgBatchPlaceAndCancel` messages.
+//   - The rate limit is exceeded for any `MsgReplaceOrder` messages. Order replacements are rate limited as
+//     order placements.
 //
 // TODO(CLOB-721): Rate limit short term order cancellations.
 type ClobRateLimitDecorator struct {
@@ -43,6 +47,14 @@ func (r ClobRateLimitDecorator) AnteHandle(
 			if err = r.clobKeeper.RateLimitPlaceOrder(ctx, msg); err != nil {
 				return ctx, err
 			}
+		case *types.MsgBatchPlaceAndCancel:
+			if err = r.clobKeeper.RateLimitBatchPlaceAndCancel(ctx, msg); err != nil {
+				return ctx, err
+			}
+		case *types.MsgReplaceOrder:
+			if err = r.clobKeeper.RateLimitPlaceOrder(ctx, types.NewMsgPlaceOrder(msg.Order)); err != nil {
+				return ctx, err
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
@@ -10,6 +10,24 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 )
 
+const (
+	// FlagDepth is the flag used to specify the maximum number of price levels to return for each side
+	// of the orderbook. Defaults to returning all price levels.
+	FlagDepth = "depth"
+
+	// FlagPositionChanges is the flag used to specify hypothetical changes to perpetual positions as
+	// comma-separated `perpetual_id:quantums_delta` pairs.
+	FlagPositionChanges = "position-changes"
+
+	// FlagQuoteQuantumsDelta is the flag used to specify a hypothetical change to the quote balance
+	// of a subaccount in quote quantums.
+	FlagQuoteQuantumsDelta = "quote-quantums-delta"
+
+	// FlagMarketPrices is the flag used to specify hypothetical oracle prices as comma-separated
+	// `market_id:price` pairs.
+	FlagMarketPrices = "market-prices"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group clob queries under a subcommand.
@@ -23,6 +41,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListClobPair())
 	cmd.AddCommand(CmdShowClobPair())
+	cmd.AddCommand(CmdQueryOrderbookDepth())
+	cmd.AddCommand(CmdQuerySubaccountOpenOrders())
+	cmd.AddCommand(CmdQueryOrderFillState())
+	cmd.AddCommand(CmdQueryInsuranceFundBalances())
+	cmd.AddCommand(CmdQuerySimulateLiquidation())
 
 	return cmd
 }
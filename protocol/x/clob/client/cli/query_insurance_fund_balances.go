@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryInsuranceFundBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund-balances",
+		Short: "shows the balance of each insurance fund",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InsuranceFundBalances(
+				context.Background(),
+				&types.QueryInsuranceFundBalancesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
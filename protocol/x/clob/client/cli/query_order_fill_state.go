@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryOrderFillState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-fill-state [owner] [number] [client_id] [order_flags] [clob_pair_id]",
+		Short: "shows the fill state of an order",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argClientId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argOrderFlags, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argClobPairId, err := cast.ToUint32E(args[4])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOrderFillStateRequest{
+				OrderId: types.OrderId{
+					SubaccountId: satypes.SubaccountId{
+						Owner:  argOwner,
+						Number: argNumber,
+					},
+					ClientId:   argClientId,
+					OrderFlags: argOrderFlags,
+					ClobPairId: argClobPairId,
+				},
+			}
+
+			res, err := queryClient.OrderFillState(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
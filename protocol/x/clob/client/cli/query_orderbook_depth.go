@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryOrderbookDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orderbook-depth [clob_pair_id]",
+		Short: "shows the aggregated price levels of the orderbook for a clob pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argClobPairId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argDepth, err := cmd.Flags().GetUint32(FlagDepth)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOrderbookDepthRequest{
+				ClobPairId: argClobPairId,
+				Depth:      argDepth,
+			}
+
+			res, err := queryClient.OrderbookDepth(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(
+		FlagDepth,
+		0,
+		"Maximum number of price levels to return for each side of the orderbook. Defaults to all levels.",
+	)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQuerySimulateLiquidation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-liquidation [owner] [number]",
+		Short: "simulates the margin requirements and liquidation prices of a subaccount",
+		Long: "Simulates the margin requirements of a subaccount along with the liquidation, bankruptcy and " +
+			"fillable prices of its perpetual positions after hypothetical changes to its positions and to " +
+			"oracle prices. The simulation never writes to state.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argPositionChanges, err := cmd.Flags().GetStringSlice(FlagPositionChanges)
+			if err != nil {
+				return err
+			}
+			positionChanges := make([]types.SimulatedPerpetualPositionChange, 0, len(argPositionChanges))
+			for _, argPositionChange := range argPositionChanges {
+				argPerpetualId, argQuantumsDelta, err := splitPair(argPositionChange)
+				if err != nil {
+					return err
+				}
+				perpetualId, err := cast.ToUint32E(argPerpetualId)
+				if err != nil {
+					return err
+				}
+				quantumsDelta, err := cast.ToInt64E(argQuantumsDelta)
+				if err != nil {
+					return err
+				}
+				positionChanges = append(positionChanges, types.SimulatedPerpetualPositionChange{
+					PerpetualId:   perpetualId,
+					QuantumsDelta: quantumsDelta,
+				})
+			}
+
+			argQuoteQuantumsDelta, err := cmd.Flags().GetInt64(FlagQuoteQuantumsDelta)
+			if err != nil {
+				return err
+			}
+
+			argMarketPrices, err := cmd.Flags().GetStringSlice(FlagMarketPrices)
+			if err != nil {
+				return err
+			}
+			marketPrices := make([]types.SimulatedMarketPrice, 0, len(argMarketPrices))
+			for _, argMarketPrice := range argMarketPrices {
+				argMarketId, argPrice, err := splitPair(argMarketPrice)
+				if err != nil {
+					return err
+				}
+				marketId, err := cast.ToUint32E(argMarketId)
+				if err != nil {
+					return err
+				}
+				price, err := cast.ToUint64E(argPrice)
+				if err != nil {
+					return err
+				}
+				marketPrices = append(marketPrices, types.SimulatedMarketPrice{
+					MarketId: marketId,
+					Price:    price,
+				})
+			}
+
+			params := &types.QuerySimulateLiquidationRequest{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  argOwner,
+					Number: argNumber,
+				},
+				PerpetualPositionChanges: positionChanges,
+				QuoteQuantumsDelta:       argQuoteQuantumsDelta,
+				MarketPrices:             marketPrices,
+			}
+
+			res, err := queryClient.SimulateLiquidation(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(
+		FlagPositionChanges,
+		[]string{},
+		"Comma-separated perpetual_id:quantums_delta pairs of hypothetical changes to perpetual positions.",
+	)
+	cmd.Flags().Int64(
+		FlagQuoteQuantumsDelta,
+		0,
+		"Hypothetical change to the quote balance of the subaccount in quote quantums.",
+	)
+	cmd.Flags().StringSlice(
+		FlagMarketPrices,
+		[]string{},
+		"Comma-separated market_id:price pairs of hypothetical oracle prices, in the exponent of each market.",
+	)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// splitPair splits a `key:value` pair.
+func splitPair(pair string) (key string, value string, err error) {
+	key, value, found := strings.Cut(pair, ":")
+	if !found {
+		return "", "", fmt.Errorf("expected a key:value pair, got %q", pair)
+	}
+	return key, value, nil
+}
@@ -483,6 +483,126 @@ func TestConditionalOrder(t *testing.T) {
 				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001.OrderId: true,
 			},
 		},
+		"TrailingStop/Sell conditional order follows price increase and is triggered by price decrease": {
+			subaccounts: []satypes.Subaccount{
+				constants.Bob_Num0_100_000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49990_1BIP,
+			},
+			// Trigger price follows the oracle price up to 50,003 * (1 - 0.0001) = 49,997.9997.
+			priceUpdateForFirstBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 5_000_300_000),
+				},
+			},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 4_999_700_000),
+				},
+			},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49990_1BIP.OrderId: true,
+			},
+			expectedTriggered: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49990_1BIP.OrderId: true,
+			},
+		},
+		"TrailingStop/Buy conditional order follows price decrease and is triggered by price increase": {
+			subaccounts: []satypes.Subaccount{
+				constants.Alice_Num0_100_000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TS_50010_1BIP,
+			},
+			// Trigger price follows the oracle price down to 49,997 * (1 + 0.0001) = 50,001.9997.
+			priceUpdateForFirstBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 4_999_700_000),
+				},
+			},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 5_000_300_000),
+				},
+			},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TS_50010_1BIP.OrderId: true,
+			},
+			expectedTriggered: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TS_50010_1BIP.OrderId: true,
+			},
+		},
+		"TrailingStop/Sell conditional order is not triggered by price decrease without price increase": {
+			subaccounts: []satypes.Subaccount{
+				constants.Bob_Num0_100_000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49990_1BIP,
+			},
+			priceUpdateForFirstBlock: &prices.MsgUpdateMarketPrices{},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 4_999_700_000),
+				},
+			},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49990_1BIP.OrderId: true,
+			},
+			expectedTriggered: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49990_1BIP.OrderId: false,
+			},
+		},
+		"OneCancelsOther/Triggering a conditional order removes the other order": {
+			subaccounts: []satypes.Subaccount{
+				constants.Bob_Num0_100_000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_SL_49999,
+				constants.ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO_Id0,
+			},
+			priceUpdateForFirstBlock: &prices.MsgUpdateMarketPrices{},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 5_000_300_000),
+				},
+			},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_SL_49999.OrderId:         false,
+				constants.ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO_Id0.OrderId: true,
+			},
+			expectedTriggered: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO_Id0.OrderId: true,
+			},
+		},
+		"OneCancelsOther/Filling a long-term order removes the other conditional order": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_100000USD,
+				constants.Dave_Num0_500000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.LongTermOrder_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10,
+				constants.ConditionalOrder_Dave_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49999_OCO_LongTermId0,
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10,
+			},
+			priceUpdateForFirstBlock:  &prices.MsgUpdateMarketPrices{},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.LongTermOrder_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10.OrderId:                             false,
+				constants.ConditionalOrder_Dave_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49999_OCO_LongTermId0.OrderId: false,
+			},
+			expectedTriggered: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Dave_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49999_OCO_LongTermId0.OrderId: false,
+			},
+			expectedOrderFillAmount: map[clobtypes.OrderId]uint64{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10.OrderId: 100_000_000,
+			},
+		},
 	}
 
 	for name, tc := range tests {
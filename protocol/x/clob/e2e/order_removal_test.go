@@ -425,42 +425,40 @@ func TestOrderRemoval_Invalid(t *testing.T) {
 		// 	},
 		// 	expectedErr: "Order passes collateralization check",
 		// },
-		// Re-enable when reduce-only orders are re-enabled.
-		// "invalid proposal: valid reduce-only order cannot be removed": {
-		// 	subaccounts: []satypes.Subaccount{
-		// 		constants.Carl_Num0_1BTC_Short,
-		// 	},
-		// 	orders: []clobtypes.Order{
-		// 		constants.LongTermOrder_Carl_Num0_Id2_Clob0_Buy10_Price35_GTB20_RO,
-		// 	},
-		// 	msgProposedOperations: &clobtypes.MsgProposedOperations{
-		// 		OperationsQueue: []clobtypes.OperationRaw{
-		// 			clobtestutils.NewOrderRemovalOperationRaw(
-		// 				constants.LongTermOrder_Carl_Num0_Id2_Clob0_Buy10_Price35_GTB20_RO.OrderId,
-		// 				clobtypes.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
-		// 			),
-		// 		},
-		// 	},
-		// 	expectedErr: "Order fill must increase position size or change side",
-		// },
-		// "invalid proposal: non reduce-only order may not be removed with reduce-only reason": {
-		// 	subaccounts: []satypes.Subaccount{
-		// 		constants.Carl_Num0_1BTC_Short,
-		// 	},
-		// 	orders: []clobtypes.Order{
-		// 		constants.LongTermOrder_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10,
-		// 	},
-		// 	msgProposedOperations: &clobtypes.MsgProposedOperations{
-		// 		OperationsQueue: []clobtypes.OperationRaw{
-		// 			clobtestutils.NewOrderRemovalOperationRaw(
-		// 				constants.LongTermOrder_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10.OrderId,
-		// 				clobtypes.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
-		// 			),
-		// 		},
-		// 	},
-		// 	expectedErrType: clobtypes.ErrInvalidOrderRemoval,
-		// 	expectedErr:     "Order must be reduce only",
-		// },
+		"invalid proposal: valid reduce-only order cannot be removed": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short,
+			},
+			orders: []clobtypes.Order{
+				constants.LongTermOrder_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000_GTBT10_RO,
+			},
+			msgProposedOperations: &clobtypes.MsgProposedOperations{
+				OperationsQueue: []clobtypes.OperationRaw{
+					clobtestutils.NewOrderRemovalOperationRaw(
+						constants.LongTermOrder_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000_GTBT10_RO.OrderId,
+						clobtypes.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
+					),
+				},
+			},
+			expectedErr: "Order fill must increase position size",
+		},
+		"invalid proposal: non reduce-only order may not be removed with reduce-only reason": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short,
+			},
+			orders: []clobtypes.Order{
+				constants.LongTermOrder_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10,
+			},
+			msgProposedOperations: &clobtypes.MsgProposedOperations{
+				OperationsQueue: []clobtypes.OperationRaw{
+					clobtestutils.NewOrderRemovalOperationRaw(
+						constants.LongTermOrder_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10.OrderId,
+						clobtypes.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
+					),
+				},
+			},
+			expectedErr: "Order must be reduce only",
+		},
 		"invalid proposal: conditional fok order cannot be removed when untriggered": {
 			subaccounts: []satypes.Subaccount{
 				constants.Carl_Num0_10000USD,
@@ -794,6 +792,17 @@ func TestOrderRemoval(t *testing.T) {
 			expectedFirstOrderRemoved:  false,
 			expectedSecondOrderRemoved: true, // taker order fully filled
 		},
+		"reduce-only order that would increase position size is removed": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_10000USD,
+				constants.Dave_Num0_10000USD,
+			},
+			firstOrder:  constants.LongTermOrder_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTBT10,
+			secondOrder: constants.LongTermOrder_Dave_Num0_Id1_Clob0_Sell05BTC_Price50000_GTBT10_RO,
+
+			expectedFirstOrderRemoved:  false,
+			expectedSecondOrderRemoved: true, // Dave has no position to reduce.
+		},
 		"under-collateralized taker during matching is removed": {
 			subaccounts: []satypes.Subaccount{
 				constants.Carl_Num0_10000USD,
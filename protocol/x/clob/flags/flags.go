@@ -17,6 +17,8 @@ type ClobFlags struct {
 	MevTelemetryEnabled    bool
 	MevTelemetryHost       string
 	MevTelemetryIdentifier string
+
+	OrderbookQueriesEnabled bool
 }
 
 // List of CLI flags.
@@ -30,6 +32,9 @@ const (
 	MevTelemetryEnabled    = "mev-telemetry-enabled"
 	MevTelemetryHost       = "mev-telemetry-host"
 	MevTelemetryIdentifier = "mev-telemetry-identifier"
+
+	// Orderbook queries.
+	OrderbookQueriesEnabled = "orderbook-queries-enabled"
 )
 
 // Default values.
@@ -41,6 +46,8 @@ const (
 	DefaultMevTelemetryEnabled    = false
 	DefaultMevTelemetryHost       = ""
 	DefaultMevTelemetryIdentifier = ""
+
+	DefaultOrderbookQueriesEnabled = false
 )
 
 // AddFlagsToCmd adds flags to app initialization.
@@ -86,6 +93,12 @@ func AddClobFlagsToCmd(cmd *cobra.Command) {
 		DefaultMevTelemetryIdentifier,
 		"Sets the identifier to use for MEV Telemetry collection agent.",
 	)
+	cmd.Flags().Bool(
+		OrderbookQueriesEnabled,
+		DefaultOrderbookQueriesEnabled,
+		"Whether to serve queries of the in-memory orderbooks. If true, a snapshot of the orderbooks "+
+			"is taken in every PrepareCheckState.",
+	)
 }
 
 func GetDefaultClobFlags() ClobFlags {
@@ -96,6 +109,7 @@ func GetDefaultClobFlags() ClobFlags {
 		MevTelemetryEnabled:                 DefaultMevTelemetryEnabled,
 		MevTelemetryHost:                    DefaultMevTelemetryHost,
 		MevTelemetryIdentifier:              DefaultMevTelemetryIdentifier,
+		OrderbookQueriesEnabled:             DefaultOrderbookQueriesEnabled,
 	}
 }
 
@@ -144,5 +158,11 @@ func GetClobFlagValuesFromOptions(
 		}
 	}
 
+	if option := appOpts.Get(OrderbookQueriesEnabled); option != nil {
+		if v, err := cast.ToBoolE(option); err == nil {
+			result.OrderbookQueriesEnabled = v
+		}
+	}
+
 	return result
 }
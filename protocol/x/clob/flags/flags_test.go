@@ -29,6 +29,9 @@ func TestAddFlagsToCommand(t *testing.T) {
 		},
 		fmt.Sprintf("Has %s flag", flags.MevTelemetryIdentifier): {
 			flagName: flags.MevTelemetryIdentifier,
+		},
+		fmt.Sprintf("Has %s flag", flags.OrderbookQueriesEnabled): {
+			flagName: flags.OrderbookQueriesEnabled,
 		}}
 
 	for name, tc := range tests {
@@ -49,6 +52,7 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 		expectedMaxDeleveragingSubaccountsToIterate uint32
 		expectedMevTelemetryHost                    string
 		expectedMevTelemetryIdentifier              string
+		expectedOrderbookQueriesEnabled             bool
 	}{
 		"Sets to default if unset": {
 			expectedMaxLiquidationAttemptsPerBlock:      flags.DefaultMaxLiquidationAttemptsPerBlock,
@@ -56,6 +60,7 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 			expectedMaxDeleveragingSubaccountsToIterate: flags.DefaultMaxDeleveragingSubaccountsToIterate,
 			expectedMevTelemetryHost:                    flags.DefaultMevTelemetryHost,
 			expectedMevTelemetryIdentifier:              flags.DefaultMevTelemetryIdentifier,
+			expectedOrderbookQueriesEnabled:             flags.DefaultOrderbookQueriesEnabled,
 		},
 		"Sets values from options": {
 			optsMap: map[string]any{
@@ -64,12 +69,14 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				flags.MaxDeleveragingSubaccountsToIterate: uint32(100),
 				flags.MevTelemetryHost:                    "https://localhost:13137",
 				flags.MevTelemetryIdentifier:              "node-agent-01",
+				flags.OrderbookQueriesEnabled:             true,
 			},
 			expectedMaxLiquidationAttemptsPerBlock:      uint32(50),
 			expectedMaxDeleveragingAttemptsPerBlock:     uint32(25),
 			expectedMaxDeleveragingSubaccountsToIterate: uint32(100),
 			expectedMevTelemetryHost:                    "https://localhost:13137",
 			expectedMevTelemetryIdentifier:              "node-agent-01",
+			expectedOrderbookQueriesEnabled:             true,
 		},
 	}
 
@@ -107,6 +114,11 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				tc.expectedMaxDeleveragingSubaccountsToIterate,
 				flags.MaxDeleveragingSubaccountsToIterate,
 			)
+			require.Equal(
+				t,
+				tc.expectedOrderbookQueriesEnabled,
+				flags.OrderbookQueriesEnabled,
+			)
 		})
 	}
 }
@@ -204,10 +204,10 @@ func TestGenesis(t *testing.T) {
 					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
 				},
 			},
-			expectedErr:     "Asset orders are not implemented",
+			expectedErr:     "ClobPair is not a perpetual CLOB pair",
 			expectedErrType: types.ErrInvalidClobPairParameter,
 		},
-		"Genesis state is invalid when there is a spot metadata on a CLOB pair": {
+		"Genesis state is invalid when a spot CLOB pair is not quoted in USDC": {
 			genesis: types.GenesisState{
 				ClobPairs: []types.ClobPair{
 					{
@@ -244,7 +244,7 @@ func TestGenesis(t *testing.T) {
 					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
 				},
 			},
-			expectedErr:     "Asset orders are not implemented",
+			expectedErr:     "invalid ClobPair parameter: spot QuoteAssetId must be 0 (USDC). Got 1",
 			expectedErrType: types.ErrInvalidClobPairParameter,
 		},
 		"Genesis state is invalid when spread to maintenance margin ratio ppm is 0": {
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// BatchPlaceAndCancelShortTermOrders cancels and places the Short-Term orders in the provided
+// `MsgBatchPlaceAndCancel`. All cancellations are processed before all placements, and each is
+// processed in the order it appears in the batch. This method is meant to be used in the CheckTx
+// flow. It uses the next block height.
+//
+// Every cancellation and placement is validated against state and the memclob before any of them
+// are processed, and an error is returned without modifying the memclob if any of them fail. Once
+// the memclob is modified the batch is always processed in full: an error returned by the memclob
+// for a single cancellation or placement (e.g. a post-only order that would cross) is reported in
+// the result of that cancellation or placement instead of aborting the batch, so that the memclob
+// never holds a partially processed batch whose off-chain updates were not sent. The batch is
+// processed on a branched context whose state changes are committed together, and the result of
+// each cancellation and placement is emitted as an event so it is returned in the CheckTx response.
+func (k Keeper) BatchPlaceAndCancelShortTermOrders(
+	ctx sdk.Context,
+	msg *types.MsgBatchPlaceAndCancel,
+) (
+	resp *types.MsgBatchPlaceAndCancelResponse,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+	// Note that we add `+1` here to account for the fact that `ctx.BlockHeight()` is technically the
+	// previously mined block, not the next block that will be proposed. This is due to the fact that
+	// this function is only ever called during `CheckTx`.
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.BatchPlaceAndCancel, metrics.Latency)
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(err, types.ModuleName, metrics.BatchPlaceAndCancel, metrics.CheckTx)
+	}()
+
+	// Validate every cancellation and placement up front so that the batch is rejected as a whole
+	// if any of them are invalid.
+	for i := range msg.Cancels {
+		cancel := &msg.Cancels[i]
+		if err := k.PerformOrderCancellationStatefulValidation(ctx, cancel, nextBlockHeight); err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid cancel at index %d", i)
+		}
+		// The memclob rejects a cancellation if a cancellation of the order with an equal or greater
+		// `GoodTilBlock` already exists.
+		if goodTilBlock, exists := k.MemClob.GetCancelOrder(ctx, cancel.OrderId); exists &&
+			goodTilBlock >= cancel.GetGoodTilBlock() {
+			return nil, errorsmod.Wrapf(types.ErrMemClobCancelAlreadyExists, "invalid cancel at index %d", i)
+		}
+	}
+	for i := range msg.Places {
+		order := msg.Places[i].GetOrder()
+		if err := k.PerformStatefulOrderValidation(ctx, &order, nextBlockHeight, true); err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid place at index %d", i)
+		}
+	}
+
+	// Process the batch on a branched context so that its state changes are committed together.
+	batchCtx, writeCache := ctx.CacheContext()
+	offchainUpdates := types.NewOffchainUpdates()
+
+	resp = &types.MsgBatchPlaceAndCancelResponse{
+		CancelResults: make([]types.BatchCancelOrderResult, 0, len(msg.Cancels)),
+		PlaceResults:  make([]types.BatchPlaceOrderResult, 0, len(msg.Places)),
+	}
+
+	// Process all cancellations before any placements so that the placements are not matched
+	// against orders that are canceled in the same batch.
+	for i := range msg.Cancels {
+		cancel := &msg.Cancels[i]
+		result := types.BatchCancelOrderResult{
+			OrderId: cancel.OrderId,
+		}
+		cancelOffchainUpdates, err := k.MemClob.CancelOrder(batchCtx, cancel)
+		if cancelOffchainUpdates != nil {
+			offchainUpdates.Append(cancelOffchainUpdates)
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		resp.CancelResults = append(resp.CancelResults, result)
+	}
+
+	for i := range msg.Places {
+		place := &msg.Places[i]
+		result := types.BatchPlaceOrderResult{
+			OrderId: place.Order.OrderId,
+		}
+
+		// Record the index of the placement within the batch so that the memclob can propose the
+		// order placement with the batch's TX bytes.
+		placeCtx := types.WithShortTermOrderBatchIndex(batchCtx, uint32(i))
+		orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, placeOffchainUpdates, err :=
+			k.placeBatchOrder(placeCtx, place)
+		if placeOffchainUpdates != nil {
+			offchainUpdates.Append(placeOffchainUpdates)
+		}
+		result.OptimisticallyFilledQuantums = orderSizeOptimisticallyFilledFromMatchingQuantums.ToUint64()
+		result.Status = uint32(orderStatus)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		resp.PlaceResults = append(resp.PlaceResults, result)
+	}
+
+	writeCache()
+	k.sendOffchainMessagesWithTxHash(
+		offchainUpdates,
+		tmhash.Sum(ctx.TxBytes()),
+		metrics.SendBatchPlaceAndCancelOffchainUpdates,
+	)
+
+	for i, result := range resp.CancelResults {
+		ctx.EventManager().EmitEvent(types.NewBatchCancelOrderResultEvent(i, result))
+	}
+	for i, result := range resp.PlaceResults {
+		ctx.EventManager().EmitEvent(types.NewBatchPlaceOrderResultEvent(i, result))
+	}
+
+	return resp, nil
+}
+
+// placeBatchOrder places a statefully validated Short-Term order of a `MsgBatchPlaceAndCancel` on
+// the memclob and returns the result along with the off-chain updates generated by placing it.
+// The off-chain updates are not sent so that they can be sent once the whole batch is processed.
+func (k Keeper) placeBatchOrder(
+	ctx sdk.Context,
+	msg *types.MsgPlaceOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	order := msg.GetOrder()
+	orderLabels := order.GetOrderLabels()
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.PlaceOrder, metrics.Latency)
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.PlaceOrder, metrics.Count},
+			1,
+			orderLabels,
+		)
+		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, metrics.PlaceOrder, metrics.Rejected},
+				1,
+				orderLabels,
+			)
+		}
+	}()
+
+	// Validate that adding the order wouldn't exceed subaccount equity tier limits, including the
+	// orders placed earlier in the batch.
+	if err := k.ValidateSubaccountEquityTierLimitForNewOrder(ctx, order); err != nil {
+		return 0, 0, nil, err
+	}
+
+	return k.MemClob.PlaceOrder(ctx, order)
+}
+
+// maybeWithShortTermOrderBatchIndex returns a context recording the index of the provided
+// Short-Term order within the `MsgBatchPlaceAndCancel` contained in the context's TX bytes.
+// If the TX bytes do not contain a `MsgBatchPlaceAndCancel` that places the order, the
+// context is returned unmodified.
+func (k Keeper) maybeWithShortTermOrderBatchIndex(
+	ctx sdk.Context,
+	order types.Order,
+) sdk.Context {
+	tx, err := k.txDecoder(ctx.TxBytes())
+	if err != nil {
+		return ctx
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return ctx
+	}
+
+	msg, ok := msgs[0].(*types.MsgBatchPlaceAndCancel)
+	if !ok {
+		return ctx
+	}
+
+	if index, found := msg.GetPlaceOrderIndex(order); found {
+		return types.WithShortTermOrderBatchIndex(ctx, index)
+	}
+	return ctx
+}
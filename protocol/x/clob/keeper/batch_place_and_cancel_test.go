@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchPlaceAndCancelShortTermOrders(t *testing.T) {
+	tests := map[string]struct {
+		// State.
+		subaccount      satypes.Subaccount
+		existingOrders  []types.Order
+		existingCancels []types.MsgCancelOrder
+
+		// Parameters.
+		cancels []types.MsgCancelOrder
+		places  []types.MsgPlaceOrder
+
+		// Expectations.
+		expectedErr      error
+		expectedResp     *types.MsgBatchPlaceAndCancelResponse
+		expectedOrders   []types.Order
+		unexpectedOrders []types.Order
+	}{
+		"Cancels existing orders and places new orders": {
+			subaccount: constants.Carl_Num0_100000USD,
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			cancels: []types.MsgCancelOrder{
+				*types.NewMsgCancelOrderShortTerm(
+					constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId,
+					10,
+				),
+			},
+			places: []types.MsgPlaceOrder{
+				*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000),
+				*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id3_Clob0_Buy025BTC_Price49500),
+			},
+			expectedResp: &types.MsgBatchPlaceAndCancelResponse{
+				CancelResults: []types.BatchCancelOrderResult{
+					{OrderId: constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId},
+				},
+				PlaceResults: []types.BatchPlaceOrderResult{
+					{
+						OrderId: constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000.OrderId,
+						Status:  uint32(types.Success),
+					},
+					{
+						OrderId: constants.Order_Carl_Num0_Id3_Clob0_Buy025BTC_Price49500.OrderId,
+						Status:  uint32(types.Success),
+					},
+				},
+			},
+			expectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000,
+				constants.Order_Carl_Num0_Id3_Clob0_Buy025BTC_Price49500,
+			},
+			unexpectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+		},
+		"Returns the status of each placement": {
+			subaccount: constants.Carl_Num0_10000USD,
+			places: []types.MsgPlaceOrder{
+				*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id3_Clob0_Buy025BTC_Price49500),
+				*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id0_Clob0_Sell1BTC_Price500000_GTB10),
+			},
+			expectedResp: &types.MsgBatchPlaceAndCancelResponse{
+				CancelResults: []types.BatchCancelOrderResult{},
+				PlaceResults: []types.BatchPlaceOrderResult{
+					{
+						OrderId: constants.Order_Carl_Num0_Id3_Clob0_Buy025BTC_Price49500.OrderId,
+						Status:  uint32(types.Success),
+					},
+					{
+						OrderId: constants.Order_Carl_Num0_Id0_Clob0_Sell1BTC_Price500000_GTB10.OrderId,
+						Status:  uint32(types.Undercollateralized),
+					},
+				},
+			},
+			expectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id3_Clob0_Buy025BTC_Price49500,
+			},
+			unexpectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Sell1BTC_Price500000_GTB10,
+			},
+		},
+		"Rejects the batch if a cancellation fails stateful validation": {
+			subaccount: constants.Carl_Num0_100000USD,
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			cancels: []types.MsgCancelOrder{
+				*types.NewMsgCancelOrderShortTerm(
+					constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId,
+					10,
+				),
+				*types.NewMsgCancelOrderShortTerm(
+					constants.Order_Carl_Num0_Id1_Clob0_Buy1BTC_Price49999.OrderId,
+					100,
+				),
+			},
+			places: []types.MsgPlaceOrder{
+				*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000),
+			},
+			expectedErr: types.ErrGoodTilBlockExceedsShortBlockWindow,
+			expectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			unexpectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000,
+			},
+		},
+		"Rejects the batch without modifying the memclob if a cancellation already exists": {
+			subaccount: constants.Carl_Num0_100000USD,
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			existingCancels: []types.MsgCancelOrder{
+				*types.NewMsgCancelOrderShortTerm(
+					constants.Order_Carl_Num0_Id1_Clob0_Buy1BTC_Price49999.OrderId,
+					10,
+				),
+			},
+			cancels: []types.MsgCancelOrder{
+				*types.NewMsgCancelOrderShortTerm(
+					constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId,
+					10,
+				),
+				*types.NewMsgCancelOrderShortTerm(
+					constants.Order_Carl_Num0_Id1_Clob0_Buy1BTC_Price49999.OrderId,
+					10,
+				),
+			},
+			places: []types.MsgPlaceOrder{
+				*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000),
+			},
+			expectedErr: types.ErrMemClobCancelAlreadyExists,
+			expectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			unexpectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000,
+			},
+		},
+		"Rejects the batch if a placement fails stateful validation": {
+			subaccount: constants.Carl_Num0_100000USD,
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			cancels: []types.MsgCancelOrder{
+				*types.NewMsgCancelOrderShortTerm(
+					constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId,
+					10,
+				),
+			},
+			places: []types.MsgPlaceOrder{
+				*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000),
+				*types.NewMsgPlaceOrder(types.Order{
+					OrderId:      types.OrderId{SubaccountId: constants.Carl_Num0, ClientId: 3, ClobPairId: 99},
+					Side:         types.Order_SIDE_BUY,
+					Quantums:     100_000_000,
+					Subticks:     50_000_000_000,
+					GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 10},
+				}),
+			},
+			expectedErr: types.ErrInvalidClob,
+			expectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			unexpectedOrders: []types.Order{
+				constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup keeper state.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockBankKeeper := &mocks.BankKeeper{}
+			mockBankKeeper.On(
+				"SendCoinsFromModuleToModule",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+			).Return(nil)
+
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, mockBankKeeper, indexer_manager.NewIndexerEventManagerNoop())
+			ctx := ks.Ctx.WithIsCheckTx(true)
+
+			// Create the default markets.
+			keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+
+			// Create liquidity tiers.
+			keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+
+			require.NoError(t, ks.FeeTiersKeeper.SetPerpetualFeeParams(ctx, constants.PerpetualFeeParamsNoFee))
+
+			// Set up USDC asset in assets module.
+			err := keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper)
+			require.NoError(t, err)
+
+			perpetual := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+			_, err = ks.PerpetualsKeeper.CreatePerpetual(
+				ctx,
+				perpetual.Params.Id,
+				perpetual.Params.Ticker,
+				perpetual.Params.MarketId,
+				perpetual.Params.AtomicResolution,
+				perpetual.Params.DefaultFundingPpm,
+				perpetual.Params.LiquidityTier,
+				perpetual.Params.FundingRateBounds,
+				perpetual.Params.InterestRatePpm,
+				perpetual.Params.InsuranceFundScope,
+			)
+			require.NoError(t, err)
+
+			ks.SubaccountsKeeper.SetSubaccount(ctx, tc.subaccount)
+
+			clobPair := constants.ClobPair_Btc
+			_, err = ks.ClobKeeper.CreatePerpetualClobPair(
+				ctx,
+				clobPair.Id,
+				clobtest.MustPerpetualId(clobPair),
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				clobPair.Status,
+			)
+			require.NoError(t, err)
+
+			for _, order := range tc.existingOrders {
+				_, _, err := ks.ClobKeeper.PlaceShortTermOrder(ctx, &types.MsgPlaceOrder{Order: order})
+				require.NoError(t, err)
+			}
+			for _, cancel := range tc.existingCancels {
+				require.NoError(t, ks.ClobKeeper.CancelShortTermOrder(ctx, &cancel))
+			}
+
+			// Run the test.
+			resp, err := ks.ClobKeeper.BatchPlaceAndCancelShortTermOrders(
+				ctx,
+				types.NewMsgBatchPlaceAndCancel(constants.Carl_Num0, tc.cancels, tc.places),
+			)
+
+			// Verify test expectations.
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, resp)
+				for _, event := range ctx.EventManager().Events() {
+					require.NotEqual(t, types.EventTypeBatchCancelOrderResult, event.Type)
+					require.NotEqual(t, types.EventTypeBatchPlaceOrderResult, event.Type)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResp, resp)
+
+				// The result of each cancellation and placement is emitted as an event.
+				events := ctx.EventManager().Events()
+				for i, result := range tc.expectedResp.CancelResults {
+					require.Contains(t, events, types.NewBatchCancelOrderResultEvent(i, result))
+				}
+				for i, result := range tc.expectedResp.PlaceResults {
+					require.Contains(t, events, types.NewBatchPlaceOrderResultEvent(i, result))
+				}
+			}
+
+			for _, order := range tc.expectedOrders {
+				memclobOrder, found := memClob.GetOrder(ctx, order.OrderId)
+				require.True(t, found)
+				require.Equal(t, order, memclobOrder)
+			}
+			for _, order := range tc.unexpectedOrders {
+				_, found := memClob.GetOrder(ctx, order.OrderId)
+				require.False(t, found)
+			}
+		})
+	}
+}
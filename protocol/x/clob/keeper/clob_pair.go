@@ -11,6 +11,7 @@ import (
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -110,10 +111,78 @@ func (k Keeper) CreatePerpetualClobPair(
 	return clobPair, nil
 }
 
+// CreateSpotClobPair creates a new spot CLOB pair in the store.
+// Additionally, it creates an order book matching the ID of the newly created CLOB pair.
+//
+// An error will occur if any of the fields fail validation (see validateClobPair for details),
+// or if the `baseAssetId` or `quoteAssetId` cannot be found.
+// In the event of an error, the store will not be updated nor will a matching order book be created.
+//
+// Returns the newly created CLOB pair and an error if one occurs.
+func (k Keeper) CreateSpotClobPair(
+	ctx sdk.Context,
+	clobPairId uint32,
+	baseAssetId uint32,
+	quoteAssetId uint32,
+	stepSizeBaseQuantums satypes.BaseQuantums,
+	quantumConversionExponent int32,
+	subticksPerTick uint32,
+	status types.ClobPair_Status,
+) (types.ClobPair, error) {
+	// If the desired CLOB pair ID is already in use, return an error.
+	if clobPair, exists := k.GetClobPair(ctx, types.ClobPairId(clobPairId)); exists {
+		return types.ClobPair{}, errorsmod.Wrapf(
+			types.ErrClobPairAlreadyExists,
+			"id=%v, existing clob pair=%v",
+			clobPairId,
+			clobPair,
+		)
+	}
+
+	clobPair := types.ClobPair{
+		Metadata: &types.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &types.SpotClobMetadata{
+				BaseAssetId:  baseAssetId,
+				QuoteAssetId: quoteAssetId,
+			},
+		},
+		Id:                        clobPairId,
+		StepBaseQuantums:          stepSizeBaseQuantums.ToUint64(),
+		QuantumConversionExponent: quantumConversionExponent,
+		SubticksPerTick:           subticksPerTick,
+		Status:                    status,
+	}
+	if err := k.validateClobPair(ctx, &clobPair); err != nil {
+		return clobPair, err
+	}
+
+	k.createClobPair(ctx, clobPair)
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeSpotMarket,
+		indexerevents.SpotMarketEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewSpotMarketCreateEvent(
+				clobPairId,
+				baseAssetId,
+				quoteAssetId,
+				status,
+				quantumConversionExponent,
+				subticksPerTick,
+				stepSizeBaseQuantums.ToUint64(),
+			),
+		),
+	)
+
+	return clobPair, nil
+}
+
 // validateClobPair validates a CLOB pair's fields are suitable for CLOB pair creation.
 //
 // Stateful Validation:
-//   - Must be a perpetual CLOB pair with a perpetualId matching a perpetual in the store.
+//   - A perpetual CLOB pair must have a perpetualId matching a perpetual in the store.
+//   - A spot CLOB pair must have a base and quote asset matching assets in the store,
+//     and the base asset must have a market.
 //
 // Stateless Validation
 //   - `clobPair.Validate()` returns no error.
@@ -122,8 +191,7 @@ func (k Keeper) validateClobPair(ctx sdk.Context, clobPair *types.ClobPair) erro
 		return err
 	}
 
-	// TODO(DEC-1535): update this validation when we implement "spot"/"asset" clob pairs.
-	switch clobPair.Metadata.(type) {
+	switch metadata := clobPair.Metadata.(type) {
 	case *types.ClobPair_PerpetualClobMetadata:
 		perpetualId, err := clobPair.GetPerpetualId()
 		if err != nil {
@@ -141,11 +209,29 @@ func (k Keeper) validateClobPair(ctx sdk.Context, clobPair *types.ClobPair) erro
 				clobPair,
 			)
 		}
+	case *types.ClobPair_SpotClobMetadata:
+		spotClobMetadata := metadata.SpotClobMetadata
+		// Validate the quote asset referenced by the CLOB pair exists.
+		if _, exists := k.assetsKeeper.GetAsset(ctx, spotClobMetadata.QuoteAssetId); !exists {
+			return errorsmod.Wrapf(
+				assettypes.ErrAssetDoesNotExist,
+				"CLOB pair (%+v) has invalid quote asset.",
+				clobPair,
+			)
+		}
+		// Validate the base asset referenced by the CLOB pair exists and has a market
+		// that can be used as the oracle price.
+		if _, _, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, spotClobMetadata.BaseAssetId); err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"CLOB pair (%+v) has invalid base asset.",
+				clobPair,
+			)
+		}
 	default:
 		return errorsmod.Wrapf(
 			types.ErrInvalidClobPairParameter,
-			// TODO(DEC-1535): update this error message when we implement "spot"/"asset" clob pairs.
-			"CLOB pair (%+v) is not a perpetual CLOB.",
+			"CLOB pair (%+v) is not a perpetual or spot CLOB.",
 			clobPair,
 		)
 	}
@@ -323,6 +409,26 @@ func (k Keeper) validateOrderAgainstClobPairStatus(
 	}
 
 	switch clobPair.Status {
+	case types.ClobPair_STATUS_PAUSED, types.ClobPair_STATUS_CANCEL_ONLY:
+		// Reject all orders. Paused clob pairs are frozen entirely, and cancel-only clob pairs
+		// only allow existing orders to be cancelled.
+		return errorsmod.Wrapf(
+			types.ErrOrderConflictsWithClobPairStatus,
+			"Order %+v cannot be placed for clob pair with status %+v",
+			order,
+			clobPair.Status,
+		)
+	case types.ClobPair_STATUS_POST_ONLY:
+		// Reject non-post-only orders. Post-only orders can never take liquidity, which guarantees
+		// no matches occur while the clob pair is in this status.
+		if order.TimeInForce != types.Order_TIME_IN_FORCE_POST_ONLY {
+			return errorsmod.Wrapf(
+				types.ErrOrderConflictsWithClobPairStatus,
+				"Order %+v must be post-only for clob pair with status %+v",
+				order,
+				clobPair.Status,
+			)
+		}
 	case types.ClobPair_STATUS_INITIALIZING:
 		// Reject stateful orders. Short-term orders expire within the ShortBlockWindow, ensuring
 		// stale short term order expiration. Stateful orders are rejected to prevent long-term orders
@@ -378,6 +484,38 @@ func (k Keeper) validateOrderAgainstClobPairStatus(
 	return nil
 }
 
+// validateOrderCancellationAgainstClobPairStatus returns an error if cancelling an order on the provided
+// clob pair would conflict with the clob pair's current status.
+func (k Keeper) validateOrderCancellationAgainstClobPairStatus(
+	ctx sdk.Context,
+	msgCancelOrder *types.MsgCancelOrder,
+	clobPair types.ClobPair,
+) error {
+	if !types.IsSupportedClobPairStatus(clobPair.Status) {
+		// Validation should only be called against ClobPairs in state, implying we have a ClobPair with
+		// an unsupported status in state.
+		panic(
+			fmt.Sprintf(
+				"validateOrderCancellationAgainstClobPairStatus: clob pair status %v is not supported",
+				clobPair.Status,
+			),
+		)
+	}
+
+	switch clobPair.Status {
+	case types.ClobPair_STATUS_PAUSED:
+		// Reject all cancellations. Paused clob pairs are frozen entirely.
+		return errorsmod.Wrapf(
+			types.ErrOrderConflictsWithClobPairStatus,
+			"Order cancellation %+v is invalid for clob pair with status %+v",
+			msgCancelOrder,
+			clobPair.Status,
+		)
+	}
+
+	return nil
+}
+
 // mustGetClobPair fetches a ClobPair from state given its id.
 // This function panics if the ClobPair is not found.
 func (k Keeper) mustGetClobPair(
@@ -425,27 +563,43 @@ func (k Keeper) UpdateClobPair(
 		)
 	}
 
-	// Note, only perpetual clob pairs are currently supported. Neither the old nor the
-	// new clob pair should be spot.
-	perpetualId, err := clobPair.GetPerpetualId()
-	if err != nil {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			err.Error(),
-		)
-	}
-	oldPerpetualId, err := oldClobPair.GetPerpetualId()
-	if err != nil {
+	if clobPair.IsSpotClobPair() != oldClobPair.IsSpotClobPair() {
 		return errorsmod.Wrap(
 			types.ErrInvalidClobPairUpdate,
-			err.Error(),
+			"UpdateClobPair: cannot update ClobPair metadata type",
 		)
 	}
-	if perpetualId != oldPerpetualId {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			"UpdateClobPair: cannot update ClobPair perpetual id",
-		)
+	if clobPair.IsSpotClobPair() {
+		spotClobMetadata := clobPair.MustGetSpotClobMetadata()
+		oldSpotClobMetadata := oldClobPair.MustGetSpotClobMetadata()
+		if spotClobMetadata.BaseAssetId != oldSpotClobMetadata.BaseAssetId ||
+			spotClobMetadata.QuoteAssetId != oldSpotClobMetadata.QuoteAssetId {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				"UpdateClobPair: cannot update ClobPair base or quote asset id",
+			)
+		}
+	} else {
+		perpetualId, err := clobPair.GetPerpetualId()
+		if err != nil {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				err.Error(),
+			)
+		}
+		oldPerpetualId, err := oldClobPair.GetPerpetualId()
+		if err != nil {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				err.Error(),
+			)
+		}
+		if perpetualId != oldPerpetualId {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				"UpdateClobPair: cannot update ClobPair perpetual id",
+			)
+		}
 	}
 	if clobPair.StepBaseQuantums != oldClobPair.StepBaseQuantums {
 		return errorsmod.Wrapf(
@@ -580,15 +734,39 @@ func (k Keeper) validateInternalOperationAgainstClobPairStatus(
 
 	// Branch validation logic for supported statuses requiring validation.
 	switch clobPair.Status {
-	case types.ClobPair_STATUS_INITIALIZING:
-		// All operations are invalid for initializing clob pairs.
+	case types.ClobPair_STATUS_PAUSED, types.ClobPair_STATUS_INITIALIZING:
+		// All operations are invalid for paused and initializing clob pairs.
 		return errorsmod.Wrapf(
 			types.ErrOperationConflictsWithClobPairStatus,
 			"Operation %s invalid for ClobPair with id %d with status %s",
 			internalOperation.GetInternalOperationTextString(),
 			clobPairId,
-			types.ClobPair_STATUS_INITIALIZING,
+			clobPair.Status,
 		)
+	case types.ClobPair_STATUS_CANCEL_ONLY:
+		// Only order removals are valid for cancel-only clob pairs.
+		if _, ok := internalOperation.Operation.(*types.InternalOperation_OrderRemoval); !ok {
+			return errorsmod.Wrapf(
+				types.ErrOperationConflictsWithClobPairStatus,
+				"Operation %s invalid for ClobPair with id %d with status %s",
+				internalOperation.GetInternalOperationTextString(),
+				clobPairId,
+				clobPair.Status,
+			)
+		}
+	case types.ClobPair_STATUS_POST_ONLY:
+		// Matches of any kind, including liquidations and deleveraging, are invalid for post-only
+		// clob pairs. Short-term order placements are validated against the clob pair status
+		// during stateful order validation.
+		if _, ok := internalOperation.Operation.(*types.InternalOperation_Match); ok {
+			return errorsmod.Wrapf(
+				types.ErrOperationConflictsWithClobPairStatus,
+				"Operation %s invalid for ClobPair with id %d with status %s",
+				internalOperation.GetInternalOperationTextString(),
+				clobPairId,
+				clobPair.Status,
+			)
+		}
 	}
 
 	return nil
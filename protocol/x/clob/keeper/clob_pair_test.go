@@ -241,9 +241,9 @@ func TestCreatePerpetualClobPair(t *testing.T) {
 		},
 		"CLOB pair status is not supported": {
 			clobPair: *clobtest.GenerateClobPair(
-				clobtest.WithStatus(types.ClobPair_STATUS_PAUSED),
+				clobtest.WithStatus(types.ClobPair_Status(100)),
 			),
-			expectedErr: "has unsupported status STATUS_PAUSED",
+			expectedErr: "has unsupported status 100",
 		},
 	}
 	for name, tc := range tests {
@@ -318,6 +318,131 @@ func TestCreatePerpetualClobPair(t *testing.T) {
 	}
 }
 
+func TestCreateSpotClobPair(t *testing.T) {
+	tests := map[string]struct {
+		// CLOB pair.
+		clobPair types.ClobPair
+
+		// Whether the base asset exists in state.
+		baseAssetExists bool
+
+		// Expectations.
+		expectedErr string
+	}{
+		"CLOB pair is valid": {
+			clobPair:        constants.ClobPair_Spot_Btc,
+			baseAssetExists: true,
+		},
+		"CLOB pair is invalid when the base asset does not exist": {
+			clobPair:        constants.ClobPair_Spot_Btc,
+			baseAssetExists: false,
+			expectedErr:     "has invalid base asset.",
+		},
+		"CLOB pair is invalid when the base asset equals the quote asset": {
+			clobPair: *clobtest.GenerateClobPair(
+				clobtest.WithId(constants.ClobPair_Spot_Btc.Id),
+				clobtest.WithSpotMetadata(
+					&types.ClobPair_SpotClobMetadata{
+						SpotClobMetadata: &types.SpotClobMetadata{
+							BaseAssetId:  constants.Usdc.Id,
+							QuoteAssetId: constants.Usdc.Id,
+						},
+					},
+				),
+			),
+			baseAssetExists: true,
+			expectedErr:     "spot BaseAssetId must not equal QuoteAssetId",
+		},
+		"CLOB pair is invalid when the quote asset is not USDC": {
+			clobPair: *clobtest.GenerateClobPair(
+				clobtest.WithId(constants.ClobPair_Spot_Btc.Id),
+				clobtest.WithSpotMetadata(
+					&types.ClobPair_SpotClobMetadata{
+						SpotClobMetadata: &types.SpotClobMetadata{
+							BaseAssetId:  constants.Usdc.Id,
+							QuoteAssetId: constants.BtcUsd.Id,
+						},
+					},
+				),
+			),
+			baseAssetExists: true,
+			expectedErr:     "spot QuoteAssetId must be 0 (USDC)",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Boilerplate setup.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+
+			prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+			if tc.baseAssetExists {
+				_, err := ks.AssetsKeeper.CreateAsset(
+					ks.Ctx,
+					constants.BtcUsd.Id,
+					constants.BtcUsd.Symbol,
+					constants.BtcUsd.Denom,
+					constants.BtcUsd.DenomExponent,
+					constants.BtcUsd.HasMarket,
+					constants.BtcUsd.MarketId,
+					constants.BtcUsd.AtomicResolution,
+					constants.BtcUsd.CollateralWeightPpm,
+				)
+				require.NoError(t, err)
+			}
+
+			spotClobMetadata := tc.clobPair.GetSpotClobMetadata()
+			if tc.expectedErr == "" {
+				mockIndexerEventManager.On("AddTxnEvent",
+					ks.Ctx,
+					indexerevents.SubtypeSpotMarket,
+					indexerevents.SpotMarketEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewSpotMarketCreateEvent(
+							tc.clobPair.Id,
+							spotClobMetadata.BaseAssetId,
+							spotClobMetadata.QuoteAssetId,
+							tc.clobPair.Status,
+							tc.clobPair.QuantumConversionExponent,
+							tc.clobPair.SubticksPerTick,
+							tc.clobPair.StepBaseQuantums,
+						),
+					),
+				).Once().Return()
+			}
+
+			// Perform the method under test.
+			createdClobPair, actualErr := ks.ClobKeeper.CreateSpotClobPair(
+				ks.Ctx,
+				tc.clobPair.Id,
+				spotClobMetadata.BaseAssetId,
+				spotClobMetadata.QuoteAssetId,
+				satypes.BaseQuantums(tc.clobPair.StepBaseQuantums),
+				tc.clobPair.QuantumConversionExponent,
+				tc.clobPair.SubticksPerTick,
+				tc.clobPair.Status,
+			)
+			storedClobPair, found := ks.ClobKeeper.GetClobPair(ks.Ctx, types.ClobPairId(tc.clobPair.Id))
+			mockIndexerEventManager.AssertExpectations(t)
+
+			if tc.expectedErr == "" {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.clobPair, createdClobPair)
+				require.True(t, found)
+				require.Equal(t, tc.clobPair, storedClobPair)
+
+				// Spot CLOB pairs are not associated with any perpetual.
+				require.Empty(t, ks.ClobKeeper.PerpetualIdToClobPairId)
+			} else {
+				require.ErrorContains(t, actualErr, tc.expectedErr)
+				require.False(t, found)
+			}
+		})
+	}
+}
+
 func TestCreateMultipleClobPairs(t *testing.T) {
 	type CreationExpectation struct {
 		// CLOB pair.
@@ -654,6 +779,19 @@ func TestUpdateClobPair(t *testing.T) {
 			},
 			status: types.ClobPair_STATUS_ACTIVE,
 		},
+		"Errors when changing the metadata type": {
+			setup: func(t *testing.T, ks keepertest.ClobKeepersTestContext, mockIndexerEventManager *mocks.IndexerEventManager) {
+				// Write a spot clob pair with the same id directly to state.
+				clobPair := constants.ClobPair_Spot_Btc
+				clobPair.Id = constants.ClobPair_Btc.Id
+				registry := codectypes.NewInterfaceRegistry()
+				cdc := codec.NewProtoCodec(registry)
+				store := prefix.NewStore(ks.Ctx.KVStore(ks.StoreKey), []byte(types.ClobPairKeyPrefix))
+				store.Set(lib.Uint32ToKey(clobPair.Id), cdc.MustMarshal(&clobPair))
+			},
+			status:      types.ClobPair_STATUS_ACTIVE,
+			expectedErr: "UpdateClobPair: cannot update ClobPair metadata type",
+		},
 		"Errors with missing clob pair": {
 			setup: func(t *testing.T, ks keepertest.ClobKeepersTestContext, mockIndexerEventManager *mocks.IndexerEventManager) {
 			},
@@ -955,14 +1093,49 @@ func TestClobPairValidate(t *testing.T) {
 		expectedErr string
 	}{
 		{
-			desc: "Invalid Metadata (SpotClobMetadata)",
+			desc: "Missing SpotClobMetadata",
 			clobPair: types.ClobPair{
 				Metadata:         &types.ClobPair_SpotClobMetadata{},
 				StepBaseQuantums: 1,
 				SubticksPerTick:  1,
 				Status:           types.ClobPair_STATUS_ACTIVE,
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "is missing spot metadata",
+		},
+		{
+			desc: "Spot QuoteAssetId is not USDC",
+			clobPair: types.ClobPair{
+				Metadata: &types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  0,
+						QuoteAssetId: 1,
+					},
+				},
+				StepBaseQuantums: 1,
+				SubticksPerTick:  1,
+				Status:           types.ClobPair_STATUS_ACTIVE,
+			},
+			expectedErr: "spot QuoteAssetId must be 0 (USDC)",
+		},
+		{
+			desc: "Spot BaseAssetId equals QuoteAssetId",
+			clobPair: types.ClobPair{
+				Metadata: &types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  0,
+						QuoteAssetId: 0,
+					},
+				},
+				StepBaseQuantums: 1,
+				SubticksPerTick:  1,
+				Status:           types.ClobPair_STATUS_ACTIVE,
+			},
+			expectedErr: "spot BaseAssetId must not equal QuoteAssetId",
+		},
+		{
+			desc:        "Valid spot ClobPair",
+			clobPair:    constants.ClobPair_Spot_Btc,
+			expectedErr: "",
 		},
 		{
 			desc: "Unsupported Status",
@@ -970,7 +1143,7 @@ func TestClobPairValidate(t *testing.T) {
 				Metadata:         &types.ClobPair_PerpetualClobMetadata{},
 				StepBaseQuantums: 1,
 				SubticksPerTick:  1,
-				Status:           types.ClobPair_STATUS_PAUSED,
+				Status:           types.ClobPair_STATUS_UNSPECIFIED,
 			},
 			expectedErr: "has unsupported status",
 		},
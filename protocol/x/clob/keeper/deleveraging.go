@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
@@ -23,7 +24,7 @@ import (
 // MaybeDeleverageSubaccount is the main entry point to deleverage a subaccount. It attempts to find positions
 // on the opposite side of deltaQuantums and use them to offset the liquidated subaccount's position at
 // the bankruptcy price of the liquidated position.
-// Note that the full position size will get deleveraged.
+// Note that only the position size returned by `GetDeleveragingPositionSizeDelta` will get deleveraged.
 func (k Keeper) MaybeDeleverageSubaccount(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -34,7 +35,22 @@ func (k Keeper) MaybeDeleverageSubaccount(
 ) {
 	lib.AssertCheckTxMode(ctx)
 
-	canPerformDeleveraging, err := k.CanDeleverageSubaccount(ctx, subaccountId)
+	// Early return to skip deleveraging if the clob pair for the perpetual is not active. Deleveraging
+	// is only allowed on active clob pairs.
+	isActive, err := k.IsPerpetualClobPairActive(ctx, perpetualId)
+	if err != nil {
+		return new(big.Int), err
+	}
+	if !isActive {
+		k.Logger(ctx).Debug(
+			"Skipping deleveraging since the clob pair for the perpetual is not active",
+			"subaccount", subaccountId,
+			"perpetualId", perpetualId,
+		)
+		return new(big.Int), nil
+	}
+
+	canPerformDeleveraging, err := k.CanDeleverageSubaccount(ctx, subaccountId, perpetualId)
 	if err != nil {
 		return new(big.Int), err
 	}
@@ -50,10 +66,8 @@ func (k Keeper) MaybeDeleverageSubaccount(
 		return new(big.Int), nil
 	}
 
-	// Deleverage the entire position for the given perpetual id.
 	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
-	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
-	if !exists {
+	if _, exists := subaccount.GetPerpetualPositionForId(perpetualId); !exists {
 		// Early return to skip deleveraging if the subaccount does not have an open position for the perpetual.
 		// This could happen if the subaccount's position was closed by other liquidation matches.
 		k.Logger(ctx).Debug(
@@ -64,7 +78,11 @@ func (k Keeper) MaybeDeleverageSubaccount(
 		return new(big.Int), nil
 	}
 
-	deltaQuantums := new(big.Int).Neg(position.GetBigQuantums())
+	// Deleverage only as much of the position as is needed for the insurance fund to cover the rest.
+	deltaQuantums, err := k.GetDeleveragingPositionSizeDelta(ctx, subaccountId, perpetualId)
+	if err != nil {
+		return new(big.Int), err
+	}
 	quantumsDeleveraged, err = k.MemClob.DeleverageSubaccount(ctx, subaccountId, perpetualId, deltaQuantums)
 
 	labels := []gometrics.Label{
@@ -112,10 +130,96 @@ func (k Keeper) MaybeDeleverageSubaccount(
 	return quantumsDeleveraged, err
 }
 
-// GetInsuranceFundBalance returns the current balance of the insurance fund (in quote quantums).
+// GetDeleveragingPositionSizeDelta returns the number of base quantums to deleverage from the
+// perpetual position of the subaccount. Deleveraging at the bankruptcy price reduces the negative
+// total net collateral (TNC) of the subaccount in proportion to the maintenance margin requirement
+// that is closed, so only enough of the position is deleveraged for the insurance fund to be able
+// to cover the shortfall of the rest of the subaccount:
+// `abs(deltaQuantums) = abs(PS) * (abs(TNC) - IFB) * TMMR / (abs(TNC) * PMMR)`, where
+// - PS is the size of the position.
+// - IFB is the balance of the insurance fund that backs the perpetual.
+// - TMMR is the total maintenance margin requirement of the subaccount.
+// - PMMR is the maintenance margin requirement of the position.
+//
+// The result is rounded up to the step size of the clob pair and clamped to the size of the position.
+// Note that the maintenance margin requirement of a position decreases at least proportionally as the
+// position is reduced, so the estimate errs on the side of deleveraging too much. The full position
+// is deleveraged if the insurance fund can cover the entire shortfall.
+func (k Keeper) GetDeleveragingPositionSizeDelta(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+) (
+	deltaQuantums *big.Int,
+	err error,
+) {
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoOpenPositionForPerpetual,
+			"Subaccount %+v does not have an open position for perpetual %+v",
+			subaccountId,
+			perpetualId,
+		)
+	}
+	psBig := position.GetBigQuantums()
+	absPsBig := new(big.Int).Abs(psBig)
+
+	tncBig, _, tmmrBig, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{SubaccountId: subaccountId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, pmmrBig, err := k.perpetualsKeeper.GetMarginRequirements(ctx, perpetualId, psBig)
+	if err != nil {
+		return nil, err
+	}
+
+	// The shortfall is the negative TNC of the subaccount that the insurance fund can't cover.
+	absTncBig := new(big.Int).Neg(tncBig)
+	shortfallBig := new(big.Int).Sub(absTncBig, k.GetInsuranceFundBalance(ctx, perpetualId))
+	stepBaseQuantums := new(big.Int).SetUint64(k.mustGetClobPairForPerpetualId(ctx, perpetualId).StepBaseQuantums)
+
+	// Deleverage the full position to avoid any rounding errors.
+	if tncBig.Sign() >= 0 ||
+		shortfallBig.Sign() <= 0 ||
+		pmmrBig.Sign() <= 0 ||
+		absPsBig.Cmp(stepBaseQuantums) <= 0 {
+		return new(big.Int).Neg(psBig), nil
+	}
+
+	absDeltaQuantums := lib.BigRatRound(
+		new(big.Rat).SetFrac(
+			new(big.Int).Mul(new(big.Int).Mul(absPsBig, shortfallBig), tmmrBig),
+			new(big.Int).Mul(absTncBig, pmmrBig),
+		),
+		true,
+	)
+
+	// Round up to the nearest step size and clamp to the size of the position.
+	absDeltaQuantums = lib.BigIntClamp(
+		lib.BigIntRoundToMultiple(absDeltaQuantums, stepBaseQuantums, true),
+		stepBaseQuantums,
+		absPsBig,
+	)
+
+	// Negate the position size if it's a long position to get the size delta.
+	if position.GetIsLong() {
+		return absDeltaQuantums.Neg(absDeltaQuantums), nil
+	}
+	return absDeltaQuantums, nil
+}
+
+// GetInsuranceFundBalance returns the current balance of the insurance fund that backs the perpetual
+// (in quote quantums).
 // This calls the Bank Keeper’s GetBalance() function for the Module Address of the insurance fund.
 func (k Keeper) GetInsuranceFundBalance(
 	ctx sdk.Context,
+	perpetualId uint32,
 ) (
 	balance *big.Int,
 ) {
@@ -123,9 +227,13 @@ func (k Keeper) GetInsuranceFundBalance(
 	if !exists {
 		panic("GetInsuranceFundBalance: Usdc asset not found in state")
 	}
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		panic(err)
+	}
 	insuranceFundBalance := k.bankKeeper.GetBalance(
 		ctx,
-		authtypes.NewModuleAddress(types.InsuranceFundName),
+		perpetual.Params.GetInsuranceFundModuleAddress(),
 		usdcAsset.Denom,
 	)
 
@@ -133,13 +241,56 @@ func (k Keeper) GetInsuranceFundBalance(
 	return insuranceFundBalance.Amount.BigInt()
 }
 
+// GetAllInsuranceFundBalances returns the balance of every insurance fund along with the perpetuals
+// that it backs. The global insurance fund is always returned first, followed by the insurance funds
+// scoped to a perpetual or a liquidity tier in ascending order of the first perpetual that they back.
+func (k Keeper) GetAllInsuranceFundBalances(
+	ctx sdk.Context,
+) (
+	insuranceFundBalances []types.InsuranceFundBalance,
+) {
+	usdcAsset, exists := k.assetsKeeper.GetAsset(ctx, assettypes.AssetUsdc.Id)
+	if !exists {
+		panic("GetAllInsuranceFundBalances: Usdc asset not found in state")
+	}
+
+	insuranceFundBalances = []types.InsuranceFundBalance{
+		{
+			Name:         types.InsuranceFundName,
+			PerpetualIds: []uint32{},
+		},
+	}
+	nameToIndex := map[string]int{types.InsuranceFundName: 0}
+	for _, perpetual := range k.perpetualsKeeper.GetAllPerpetuals(ctx) {
+		name := perpetual.Params.GetInsuranceFundName()
+		i, exists := nameToIndex[name]
+		if !exists {
+			i = len(insuranceFundBalances)
+			nameToIndex[name] = i
+			insuranceFundBalances = append(insuranceFundBalances, types.InsuranceFundBalance{Name: name})
+		}
+		insuranceFundBalances[i].PerpetualIds = append(insuranceFundBalances[i].PerpetualIds, perpetual.Params.Id)
+	}
+
+	for i, insuranceFundBalance := range insuranceFundBalances {
+		address := authtypes.NewModuleAddress(insuranceFundBalance.Name)
+		balance := k.bankKeeper.GetBalance(ctx, address, usdcAsset.Denom)
+		insuranceFundBalances[i].Address = address.String()
+		insuranceFundBalances[i].Balance = dtypes.NewIntFromBigInt(balance.Amount.BigInt())
+	}
+	return insuranceFundBalances
+}
+
 // CanDeleverageSubaccount returns true if a subaccount can be deleveraged.
 // Specifically, this function returns true if both of the following are true:
 // - The subaccount's total net collateral is negative.
+// - The balance of the insurance fund that backs the perpetual is less than the subaccount's negative
+// total net collateral, such that the insurance fund can't cover the losses of the subaccount.
 // This function returns an error if `GetNetCollateralAndMarginRequirements` returns an error.
 func (k Keeper) CanDeleverageSubaccount(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
 ) (bool, error) {
 	bigNetCollateral,
 		_,
@@ -157,17 +308,25 @@ func (k Keeper) CanDeleverageSubaccount(
 		return false, nil
 	}
 
-	// The subaccount's total net collateral is negative, so deleveraging can be performed.
+	// Deleveraging cannot be performed if the insurance fund can cover the subaccount's losses.
+	if k.GetInsuranceFundBalance(ctx, perpetualId).Cmp(new(big.Int).Neg(bigNetCollateral)) >= 0 {
+		return false, nil
+	}
+
+	// The subaccount's total net collateral is negative and exceeds the insurance fund balance, so
+	// deleveraging can be performed.
 	return true, nil
 }
 
-// IsValidInsuranceFundDelta returns true if the insurance fund has enough funds to cover the insurance
-// fund delta. Specifically, this function returns true if either of the following are true:
+// IsValidInsuranceFundDelta returns true if the insurance fund that backs the perpetual has enough
+// funds to cover the insurance fund delta. Specifically, this function returns true if either of the
+// following are true:
 // - The `insuranceFundDelta` is non-negative.
 // - The insurance fund balance + `insuranceFundDelta` is greater-than-or-equal-to 0.
 func (k Keeper) IsValidInsuranceFundDelta(
 	ctx sdk.Context,
 	insuranceFundDelta *big.Int,
+	perpetualId uint32,
 ) bool {
 	// Non-negative insurance fund deltas are valid.
 	if insuranceFundDelta.Sign() >= 0 {
@@ -176,12 +335,14 @@ func (k Keeper) IsValidInsuranceFundDelta(
 
 	// The insurance fund delta is valid if the insurance fund balance is non-negative after adding
 	// the delta.
-	currentInsuranceFundBalance := k.GetInsuranceFundBalance(ctx)
+	currentInsuranceFundBalance := k.GetInsuranceFundBalance(ctx, perpetualId)
 	return new(big.Int).Add(currentInsuranceFundBalance, insuranceFundDelta).Sign() >= 0
 }
 
-// OffsetSubaccountPerpetualPosition iterates over all subaccounts and use those with positions
-// on the opposite side to offset the liquidated subaccount's position by `deltaQuantumsTotal`.
+// OffsetSubaccountPerpetualPosition uses positions on the opposite side of the liquidated subaccount's
+// position to offset it by `deltaQuantumsTotal`. At most `MaxDeleveragingSubaccountsToIterate` offsetting
+// positions are used in auto-deleveraging (ADL) order, such that the most profitable and most leveraged
+// positions are used first.
 //
 // This function returns the fills that were processed and the remaining amount to offset.
 // Note that each deleveraging fill is being processed _optimistically_, and the state transitions are
@@ -204,81 +365,85 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 
 	numSubaccountsIterated := uint32(0)
 	numSubaccountsWithNonOverlappingBankruptcyPrices := uint32(0)
-	numSubaccountsWithNoOpenPositionOnOppositeSide := uint32(0)
 	deltaQuantumsRemaining = new(big.Int).Set(deltaQuantumsTotal)
 	fills = make([]types.MatchPerpetualDeleveraging_Fill, 0)
 
-	k.subaccountsKeeper.ForEachSubaccountRandomStart(
+	// Offsetting positions are on the same side as `deltaQuantumsTotal`, which is the opposite side of
+	// the liquidated subaccount's position.
+	offsettingPositions, err := k.subaccountsKeeper.GetAdlRanking(
 		ctx,
-		func(offsettingSubaccount satypes.Subaccount) (finished bool) {
-			// Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
-			if numSubaccountsIterated >= k.Flags.MaxDeleveragingSubaccountsToIterate {
-				return true
-			}
-
-			numSubaccountsIterated++
-			offsettingPosition, _ := offsettingSubaccount.GetPerpetualPositionForId(perpetualId)
-			bigOffsettingPositionQuantums := offsettingPosition.GetBigQuantums()
-
-			// Skip subaccounts that do not have a position in the opposite direction as the liquidated subaccount.
-			if deltaQuantumsRemaining.Sign() != bigOffsettingPositionQuantums.Sign() {
-				numSubaccountsWithNoOpenPositionOnOppositeSide++
-				return false
-			}
-
-			// TODO(DEC-1495): Determine max amount to offset per offsetting subaccount.
-			var deltaQuantums *big.Int
-			if deltaQuantumsRemaining.CmpAbs(bigOffsettingPositionQuantums) > 0 {
-				deltaQuantums = new(big.Int).Set(bigOffsettingPositionQuantums)
-			} else {
-				deltaQuantums = new(big.Int).Set(deltaQuantumsRemaining)
-			}
-
-			// Try to process the deleveraging operation for both subaccounts.
-			if err := k.ProcessDeleveraging(
-				ctx,
-				liquidatedSubaccountId,
-				*offsettingSubaccount.Id,
-				perpetualId,
-				deltaQuantums,
-			); err == nil {
-				// Update the remaining liquidatable quantums.
-				deltaQuantumsRemaining = new(big.Int).Sub(
-					deltaQuantumsRemaining,
-					deltaQuantums,
-				)
-				fills = append(fills, types.MatchPerpetualDeleveraging_Fill{
-					OffsettingSubaccountId: *offsettingSubaccount.Id,
-					FillAmount:             new(big.Int).Abs(deltaQuantums).Uint64(),
-				})
-			} else if errors.Is(err, types.ErrInvalidPerpetualPositionSizeDelta) {
-				panic(
-					fmt.Sprintf(
-						"Invalid perpetual position size delta when processing deleveraging. error: %v",
-						err,
-					),
-				)
-			} else {
-				// If an error is returned, it's likely because the subaccounts' bankruptcy prices do not overlap.
-				// TODO(CLOB-75): Support deleveraging subaccounts with non overlapping bankruptcy prices.
-				liquidatedSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, liquidatedSubaccountId)
-				offsettingSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, *offsettingSubaccount.Id)
-				k.Logger(ctx).Debug(
-					"Encountered error when processing deleveraging",
-					"error", err,
-					"blockHeight", ctx.BlockHeight(),
-					"checkTx", ctx.IsCheckTx(),
-					"perpetualId", perpetualId,
-					"deltaQuantums", deltaQuantums,
-					"liquidatedSubaccount", log.NewLazySprintf("%+v", liquidatedSubaccount),
-					"offsettingSubaccount", log.NewLazySprintf("%+v", offsettingSubaccount),
-				)
-				numSubaccountsWithNonOverlappingBankruptcyPrices++
-			}
-			return deltaQuantumsRemaining.Sign() == 0
-		},
-		k.GetPseudoRand(ctx),
+		perpetualId,
+		deltaQuantumsTotal.Sign() > 0,
+		k.Flags.MaxDeleveragingSubaccountsToIterate,
 	)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"Failed to get ADL ranking when offsetting perpetual position",
+			"error", err,
+			"perpetualId", perpetualId,
+			"liquidatedSubaccountId", liquidatedSubaccountId,
+		)
+		return fills, deltaQuantumsRemaining
+	}
+
+	for _, offsettingPosition := range offsettingPositions {
+		if deltaQuantumsRemaining.Sign() == 0 {
+			break
+		}
+
+		numSubaccountsIterated++
+		bigOffsettingPositionQuantums := offsettingPosition.Quantums
+
+		// TODO(DEC-1495): Determine max amount to offset per offsetting subaccount.
+		var deltaQuantums *big.Int
+		if deltaQuantumsRemaining.CmpAbs(bigOffsettingPositionQuantums) > 0 {
+			deltaQuantums = new(big.Int).Set(bigOffsettingPositionQuantums)
+		} else {
+			deltaQuantums = new(big.Int).Set(deltaQuantumsRemaining)
+		}
+
+		// Try to process the deleveraging operation for both subaccounts.
+		if _, err := k.ProcessDeleveraging(
+			ctx,
+			liquidatedSubaccountId,
+			offsettingPosition.SubaccountId,
+			perpetualId,
+			deltaQuantums,
+		); err == nil {
+			// Update the remaining liquidatable quantums.
+			deltaQuantumsRemaining = new(big.Int).Sub(
+				deltaQuantumsRemaining,
+				deltaQuantums,
+			)
+			fills = append(fills, types.MatchPerpetualDeleveraging_Fill{
+				OffsettingSubaccountId: offsettingPosition.SubaccountId,
+				FillAmount:             new(big.Int).Abs(deltaQuantums).Uint64(),
+			})
+		} else if errors.Is(err, types.ErrInvalidPerpetualPositionSizeDelta) {
+			panic(
+				fmt.Sprintf(
+					"Invalid perpetual position size delta when processing deleveraging. error: %v",
+					err,
+				),
+			)
+		} else {
+			// If an error is returned, it's likely because the subaccounts' bankruptcy prices do not overlap.
+			// TODO(CLOB-75): Support deleveraging subaccounts with non overlapping bankruptcy prices.
+			liquidatedSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, liquidatedSubaccountId)
+			offsettingSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, offsettingPosition.SubaccountId)
+			k.Logger(ctx).Debug(
+				"Encountered error when processing deleveraging",
+				"error", err,
+				"blockHeight", ctx.BlockHeight(),
+				"checkTx", ctx.IsCheckTx(),
+				"perpetualId", perpetualId,
+				"deltaQuantums", deltaQuantums,
+				"liquidatedSubaccount", log.NewLazySprintf("%+v", liquidatedSubaccount),
+				"offsettingSubaccount", log.NewLazySprintf("%+v", offsettingSubaccount),
+			)
+			numSubaccountsWithNonOverlappingBankruptcyPrices++
+		}
+	}
 
 	labels := []gometrics.Label{
 		metrics.GetLabelForIntValue(metrics.PerpetualId, int(perpetualId)),
@@ -299,21 +464,74 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 	)
 	telemetry.SetGaugeWithLabels(
 		[]string{
-			types.ModuleName, metrics.Deleveraging, metrics.NoOpenPositionOnOppositeSide, metrics.Count,
+			types.ModuleName, metrics.Deleveraging, metrics.AdlRankingSize, metrics.Count,
 		},
-		float32(numSubaccountsWithNoOpenPositionOnOppositeSide),
+		float32(len(offsettingPositions)),
 		labels,
 	)
 	return fills, deltaQuantumsRemaining
 }
 
+// ValidateDeleveragingFillsAdlOrder returns an error if any of the offsetting subaccounts of `fills` does
+// not have an open position on the given side of the perpetual, or if the offsetting positions are not in
+// auto-deleveraging (ADL) order, which requires their ADL scores at current oracle prices to be
+// non-increasing. Scores are computed from state before any of the fills is processed. Positions in the
+// ADL ranking may be skipped, since deleveraging against them can fail due to non-overlapping bankruptcy
+// prices.
+func (k Keeper) ValidateDeleveragingFillsAdlOrder(
+	ctx sdk.Context,
+	perpetualId uint32,
+	isLong bool,
+	fills []types.MatchPerpetualDeleveraging_Fill,
+) error {
+	offsettingSubaccountIds := make(map[satypes.SubaccountId]bool, len(fills))
+	var previousScore *big.Rat
+	for i, fill := range fills {
+		if offsettingSubaccountIds[fill.OffsettingSubaccountId] {
+			return errorsmod.Wrapf(
+				types.ErrDeleveragingFillNotInAdlOrder,
+				"Offsetting subaccount %+v is used more than once",
+				fill.OffsettingSubaccountId,
+			)
+		}
+		offsettingSubaccountIds[fill.OffsettingSubaccountId] = true
+
+		adlPosition, exists, err := k.subaccountsKeeper.GetAdlPosition(ctx, fill.OffsettingSubaccountId, perpetualId)
+		if err != nil {
+			return err
+		}
+		if !exists || (adlPosition.Quantums.Sign() > 0) != isLong {
+			return errorsmod.Wrapf(
+				types.ErrDeleveragingFillNotInAdlOrder,
+				"Offsetting subaccount %+v does not have an open position on the offsetting side of perpetual %d",
+				fill.OffsettingSubaccountId,
+				perpetualId,
+			)
+		}
+
+		score := adlPosition.GetAdlScore()
+		if i > 0 && satypes.CompareAdlScores(score, previousScore) > 0 {
+			return errorsmod.Wrapf(
+				types.ErrDeleveragingFillNotInAdlOrder,
+				"Offsetting subaccount %+v with ADL score %v is used after a position with ADL score %v",
+				fill.OffsettingSubaccountId,
+				score,
+				previousScore,
+			)
+		}
+		previousScore = score
+	}
+	return nil
+}
+
 // ProcessDeleveraging processes a deleveraging operation by closing both the liquidated subaccount's
 // position and the offsetting subaccount's position at the bankruptcy price of the _liquidated_ position.
 // This function takes a `deltaQuantums` argument, which is the delta with respect to the liquidated subaccount's
 // position, to allow for partial deleveraging. This function emits a cometbft event if the deleveraging match
 // is successfully written to state.
 //
-// This function returns an error if:
+// This function returns the change in the liquidated subaccount's quote balance, which is the bankruptcy
+// price of `deltaQuantums` in quote quantums. This function returns an error if:
 // - `deltaQuantums` is not valid with respect to either of the subaccounts.
 // - `GetBankruptcyPriceInQuoteQuantums` returns an error.
 // - subaccount updates cannot be applied when the bankruptcy prices of both subaccounts don't overlap.
@@ -324,6 +542,7 @@ func (k Keeper) ProcessDeleveraging(
 	perpetualId uint32,
 	deltaQuantums *big.Int,
 ) (
+	deltaQuoteQuantums *big.Int,
 	err error,
 ) {
 	// Get the liquidated subaccount.
@@ -344,7 +563,7 @@ func (k Keeper) ProcessDeleveraging(
 		liquidatedPositionQuantums.CmpAbs(deltaQuantums) == -1 ||
 		offsettingPositionQuantums.Sign()*deltaQuantums.Sign() != 1 ||
 		offsettingPositionQuantums.CmpAbs(deltaQuantums) == -1 {
-		return errorsmod.Wrapf(
+		return nil, errorsmod.Wrapf(
 			types.ErrInvalidPerpetualPositionSizeDelta,
 			"ProcessDeleveraging: liquidated = (%+v), offsetting = (%+v), perpetual id = (%d), deltaQuantums = (%+v)",
 			liquidatedSubaccount,
@@ -363,7 +582,7 @@ func (k Keeper) ProcessDeleveraging(
 		deltaQuantums,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	deleveragedSubaccountQuoteBalanceDelta := bankruptcyPriceQuoteQuantums
@@ -409,12 +628,12 @@ func (k Keeper) ProcessDeleveraging(
 	// Apply the update.
 	success, successPerUpdate, err := k.subaccountsKeeper.UpdateSubaccounts(ctx, updates)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// If not successful, return error indicating why.
 	if updateErr := satypes.GetErrorFromUpdateResults(success, successPerUpdate, updates); updateErr != nil {
-		return updateErr
+		return nil, updateErr
 	}
 
 	// Stat quantums deleveraged in quote quantums.
@@ -453,5 +672,5 @@ func (k Keeper) ProcessDeleveraging(
 		),
 	)
 
-	return nil
+	return deleveragedSubaccountQuoteBalanceDelta, nil
 }
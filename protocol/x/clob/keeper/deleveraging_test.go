@@ -26,10 +26,36 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// createInsuranceFundTestPerpetual creates the BTC perpetual backed by an insurance fund with the given scope.
+func createInsuranceFundTestPerpetual(
+	t *testing.T,
+	ks keepertest.ClobKeepersTestContext,
+	insuranceFundScope perptypes.InsuranceFundScope,
+) perptypes.Perpetual {
+	keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+
+	perpetual, err := ks.PerpetualsKeeper.CreatePerpetual(
+		ks.Ctx,
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.Id,
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.Ticker,
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.MarketId,
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.AtomicResolution,
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.DefaultFundingPpm,
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.LiquidityTier,
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.FundingRateBounds,
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.InterestRatePpm,
+		insuranceFundScope,
+	)
+	require.NoError(t, err)
+	return perpetual
+}
+
 func TestGetInsuranceFundBalance(t *testing.T) {
 	tests := map[string]struct {
 		// Setup
 		assets               []assettypes.Asset
+		insuranceFundScope   perptypes.InsuranceFundScope
 		insuranceFundBalance *big.Int
 
 		// Expectations.
@@ -63,6 +89,22 @@ func TestGetInsuranceFundBalance(t *testing.T) {
 				new(big.Int).SetUint64(math.MaxUint64),
 			),
 		},
+		"can get balance of insurance fund scoped to the perpetual": {
+			assets: []assettypes.Asset{
+				*constants.Usdc,
+			},
+			insuranceFundScope:           perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+			insuranceFundBalance:         big.NewInt(100),
+			expectedInsuranceFundBalance: big.NewInt(100),
+		},
+		"can get balance of insurance fund scoped to the liquidity tier": {
+			assets: []assettypes.Asset{
+				*constants.Usdc,
+			},
+			insuranceFundScope:           perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+			insuranceFundBalance:         big.NewInt(100),
+			expectedInsuranceFundBalance: big.NewInt(100),
+		},
 		"panics when asset not found in state": {
 			assets:        []assettypes.Asset{},
 			expectedError: errors.New("GetInsuranceFundBalance: Usdc asset not found in state"),
@@ -86,15 +128,18 @@ func TestGetInsuranceFundBalance(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
 
+			perpetual := createInsuranceFundTestPerpetual(t, ks, tc.insuranceFundScope)
+
 			if tc.insuranceFundBalance != nil {
 				bankMock.On(
 					"GetBalance",
 					mock.Anything,
-					authtypes.NewModuleAddress(types.InsuranceFundName),
+					perpetual.Params.GetInsuranceFundModuleAddress(),
 					constants.Usdc.Denom,
 				).Return(
 					sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewIntFromBigInt(tc.insuranceFundBalance)),
@@ -106,20 +151,87 @@ func TestGetInsuranceFundBalance(t *testing.T) {
 					t,
 					tc.expectedError.Error(),
 					func() {
-						ks.ClobKeeper.GetInsuranceFundBalance(ks.Ctx)
+						ks.ClobKeeper.GetInsuranceFundBalance(ks.Ctx, perpetual.Params.Id)
 					},
 				)
 			} else {
 				require.Equal(
 					t,
 					tc.expectedInsuranceFundBalance,
-					ks.ClobKeeper.GetInsuranceFundBalance(ks.Ctx),
+					ks.ClobKeeper.GetInsuranceFundBalance(ks.Ctx, perpetual.Params.Id),
 				)
 			}
 		})
 	}
 }
 
+func TestGetAllInsuranceFundBalances(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	bankMock := &mocks.BankKeeper{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, bankMock, &mocks.IndexerEventManager{})
+	require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+	keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+
+	// BTC is backed by its own insurance fund while ETH and SOL share the insurance fund of
+	// liquidity tier 3.
+	for _, perpetual := range []perptypes.Perpetual{
+		constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+		constants.EthUsd_20PercentInitial_10PercentMaintenance,
+		constants.SolUsd_20PercentInitial_10PercentMaintenance,
+	} {
+		insuranceFundScope := perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER
+		if perpetual.Params.Id == constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.Id {
+			insuranceFundScope = perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL
+		}
+		_, err := ks.PerpetualsKeeper.CreatePerpetual(
+			ks.Ctx,
+			perpetual.Params.Id,
+			perpetual.Params.Ticker,
+			perpetual.Params.MarketId,
+			perpetual.Params.AtomicResolution,
+			perpetual.Params.DefaultFundingPpm,
+			perpetual.Params.LiquidityTier,
+			perpetual.Params.FundingRateBounds,
+			perpetual.Params.InterestRatePpm,
+			insuranceFundScope,
+		)
+		require.NoError(t, err)
+	}
+
+	expectedInsuranceFundBalances := []types.InsuranceFundBalance{
+		{
+			Name:         types.InsuranceFundName,
+			PerpetualIds: []uint32{},
+			Balance:      dtypes.NewInt(1_000_000),
+		},
+		{
+			Name:         "insurance_fund:perpetual:0",
+			PerpetualIds: []uint32{0},
+			Balance:      dtypes.NewInt(2_000_000),
+		},
+		{
+			Name:         "insurance_fund:liquidity_tier:3",
+			PerpetualIds: []uint32{1, 2},
+			Balance:      dtypes.NewInt(3_000_000),
+		},
+	}
+	for i, insuranceFundBalance := range expectedInsuranceFundBalances {
+		address := authtypes.NewModuleAddress(insuranceFundBalance.Name)
+		expectedInsuranceFundBalances[i].Address = address.String()
+		bankMock.On(
+			"GetBalance",
+			mock.Anything,
+			address,
+			constants.Usdc.Denom,
+		).Return(
+			sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewIntFromBigInt(insuranceFundBalance.Balance.BigInt())),
+		)
+	}
+
+	require.Equal(t, expectedInsuranceFundBalances, ks.ClobKeeper.GetAllInsuranceFundBalances(ks.Ctx))
+}
+
 func TestIsValidInsuranceFundDelta(t *testing.T) {
 	tests := map[string]struct {
 		// Setup
@@ -189,6 +301,12 @@ func TestIsValidInsuranceFundDelta(t *testing.T) {
 			err := keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper)
 			require.NoError(t, err)
 
+			perpetual := createInsuranceFundTestPerpetual(
+				t,
+				ks,
+				perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			)
+
 			bankMock.On(
 				"GetBalance",
 				mock.Anything,
@@ -203,6 +321,7 @@ func TestIsValidInsuranceFundDelta(t *testing.T) {
 				ks.ClobKeeper.IsValidInsuranceFundDelta(
 					ks.Ctx,
 					tc.insuranceFundDelta,
+					perpetual.Params.Id,
 				),
 			)
 		})
@@ -213,6 +332,7 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 	tests := map[string]struct {
 		// Setup
 		liquidationConfig             types.LiquidationsConfig
+		insuranceFundScope            perptypes.InsuranceFundScope
 		insuranceFundBalance          *big.Int
 		subaccount                    satypes.Subaccount
 		marketIdToOraclePriceOverride map[uint32]uint64
@@ -240,9 +360,30 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 
 			expectedCanDeleverageSubaccount: false,
 		},
-		`Can deleverage when subaccount has negative TNC`: {
+		`Cannot deleverage when insurance fund can cover negative TNC`: {
+			liquidationConfig:    constants.LiquidationsConfig_No_Limit,
+			insuranceFundBalance: big.NewInt(1_000_000), // $1
+			subaccount:           constants.Carl_Num0_1BTC_Short_54999USD,
+			marketIdToOraclePriceOverride: map[uint32]uint64{
+				constants.BtcUsd.MarketId: 5_500_000_000, // $55,000 / BTC
+			},
+
+			expectedCanDeleverageSubaccount: false,
+		},
+		`Can deleverage when subaccount has negative TNC that insurance fund cannot cover`: {
+			liquidationConfig:    constants.LiquidationsConfig_No_Limit,
+			insuranceFundBalance: big.NewInt(999_999), // $0.999999
+			subaccount:           constants.Carl_Num0_1BTC_Short_54999USD,
+			marketIdToOraclePriceOverride: map[uint32]uint64{
+				constants.BtcUsd.MarketId: 5_500_000_000, // $55,000 / BTC
+			},
+
+			expectedCanDeleverageSubaccount: true,
+		},
+		`Can deleverage when insurance fund scoped to the perpetual cannot cover negative TNC`: {
 			liquidationConfig:    constants.LiquidationsConfig_No_Limit,
-			insuranceFundBalance: big.NewInt(10_000_000_000), // $10,000
+			insuranceFundScope:   perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+			insuranceFundBalance: big.NewInt(0),
 			subaccount:           constants.Carl_Num0_1BTC_Short_54999USD,
 			marketIdToOraclePriceOverride: map[uint32]uint64{
 				constants.BtcUsd.MarketId: 5_500_000_000, // $55,000 / BTC
@@ -266,13 +407,19 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 			err = ks.ClobKeeper.InitializeLiquidationsConfig(ks.Ctx, tc.liquidationConfig)
 			require.NoError(t, err)
 
+			// The global insurance fund can cover the losses of the subaccount, which only matters
+			// if it backs the perpetual.
+			globalInsuranceFundBalance := big.NewInt(10_000_000_000) // $10,000
+			if tc.insuranceFundScope == perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL {
+				globalInsuranceFundBalance = tc.insuranceFundBalance
+			}
 			bankMock.On(
 				"GetBalance",
 				mock.Anything,
 				authtypes.NewModuleAddress(types.InsuranceFundName),
 				constants.Usdc.Denom,
 			).Return(
-				sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewIntFromBigInt(tc.insuranceFundBalance)),
+				sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewIntFromBigInt(globalInsuranceFundBalance)),
 			)
 
 			// Create test markets.
@@ -295,20 +442,31 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			perpetuals := []perptypes.Perpetual{
-				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
-			}
-			for _, perpetual := range perpetuals {
-				_, err = ks.PerpetualsKeeper.CreatePerpetual(
-					ks.Ctx,
-					perpetual.Params.Id,
-					perpetual.Params.Ticker,
-					perpetual.Params.MarketId,
-					perpetual.Params.AtomicResolution,
-					perpetual.Params.DefaultFundingPpm,
-					perpetual.Params.LiquidityTier,
+			perpetual := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			perpetual.Params.InsuranceFundScope = tc.insuranceFundScope
+			_, err = ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				perpetual.Params.Id,
+				perpetual.Params.Ticker,
+				perpetual.Params.MarketId,
+				perpetual.Params.AtomicResolution,
+				perpetual.Params.DefaultFundingPpm,
+				perpetual.Params.LiquidityTier,
+				perpetual.Params.FundingRateBounds,
+				perpetual.Params.InterestRatePpm,
+				perpetual.Params.InsuranceFundScope,
+			)
+			require.NoError(t, err)
+
+			if tc.insuranceFundScope != perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL {
+				bankMock.On(
+					"GetBalance",
+					mock.Anything,
+					perpetual.Params.GetInsuranceFundModuleAddress(),
+					constants.Usdc.Denom,
+				).Return(
+					sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewIntFromBigInt(tc.insuranceFundBalance)),
 				)
-				require.NoError(t, err)
 			}
 
 			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.subaccount)
@@ -316,6 +474,7 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 			canDeleverageSubaccount, err := ks.ClobKeeper.CanDeleverageSubaccount(
 				ks.Ctx,
 				*tc.subaccount.Id,
+				perpetual.Params.Id,
 			)
 			require.NoError(t, err)
 			require.Equal(
@@ -327,6 +486,209 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 	}
 }
 
+func TestMaybeDeleverageSubaccount_ClobPairNotActive(t *testing.T) {
+	tests := map[string]struct {
+		clobPair types.ClobPair
+	}{
+		"Skips deleveraging for a paused clob pair": {
+			clobPair: constants.ClobPair_Btc_Paused,
+		},
+		"Skips deleveraging for a cancel-only clob pair": {
+			clobPair: constants.ClobPair_Btc_CancelOnly,
+		},
+		"Skips deleveraging for a post-only clob pair": {
+			clobPair: constants.ClobPair_Btc_PostOnly,
+		},
+		"Skips deleveraging for an initializing clob pair": {
+			clobPair: constants.ClobPair_Btc_Init,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			mockIndexerEventManager.On("AddTxnEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return()
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+			ctx := ks.Ctx.WithIsCheckTx(true)
+
+			createInsuranceFundTestPerpetual(t, ks, perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper))
+
+			_, err := ks.ClobKeeper.CreatePerpetualClobPair(
+				ctx,
+				tc.clobPair.Id,
+				tc.clobPair.MustGetPerpetualId(),
+				satypes.BaseQuantums(tc.clobPair.StepBaseQuantums),
+				tc.clobPair.QuantumConversionExponent,
+				tc.clobPair.SubticksPerTick,
+				tc.clobPair.Status,
+			)
+			require.NoError(t, err)
+
+			subaccounts := []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_49999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			}
+			for _, subaccount := range subaccounts {
+				ks.SubaccountsKeeper.SetSubaccount(ctx, subaccount)
+			}
+
+			quantumsDeleveraged, err := ks.ClobKeeper.MaybeDeleverageSubaccount(ctx, constants.Carl_Num0, 0)
+			require.NoError(t, err)
+			require.Zero(t, quantumsDeleveraged.Sign())
+
+			// Neither subaccount is modified and no deleveraging operations are proposed.
+			for _, subaccount := range subaccounts {
+				require.Equal(t, subaccount, ks.SubaccountsKeeper.GetSubaccount(ctx, *subaccount.Id))
+			}
+			require.Empty(t, ks.ClobKeeper.GetOperations(ctx).GetOperationsQueue())
+		})
+	}
+}
+
+func TestGetDeleveragingPositionSizeDelta(t *testing.T) {
+	// $1 of negative TNC at $50,000 / BTC.
+	dave_Num0_1BTC_Long_Negative_TNC := satypes.Subaccount{
+		Id:             &constants.Dave_Num0,
+		AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(-50_001_000_000)),
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			{
+				PerpetualId:  0,
+				Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+				FundingIndex: dtypes.NewInt(0),
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		insuranceFundBalance *big.Int
+		subaccount           satypes.Subaccount
+		oraclePrice          uint64
+
+		// Expectations.
+		expectedDeltaQuantums *big.Int
+		expectedErr           error
+	}{
+		`Full position is deleveraged when the insurance fund is empty`: {
+			insuranceFundBalance: big.NewInt(0),
+			subaccount:           constants.Carl_Num0_1BTC_Short_54999USD,
+			oraclePrice:          5_500_000_000, // $55,000 / BTC, TNC = -$1
+
+			expectedDeltaQuantums: big.NewInt(100_000_000),
+		},
+		`Full position is deleveraged when the insurance fund can cover the shortfall`: {
+			insuranceFundBalance: big.NewInt(1_000_000), // $1
+			subaccount:           constants.Carl_Num0_1BTC_Short_54999USD,
+			oraclePrice:          5_500_000_000, // $55,000 / BTC, TNC = -$1
+
+			expectedDeltaQuantums: big.NewInt(100_000_000),
+		},
+		`Short position is partially deleveraged when the insurance fund can cover part of the shortfall`: {
+			insuranceFundBalance: big.NewInt(250_000), // $0.25
+			subaccount:           constants.Carl_Num0_1BTC_Short_54999USD,
+			oraclePrice:          5_500_000_000, // $55,000 / BTC, TNC = -$1
+
+			// The remaining $0.25 of negative TNC of 0.25 BTC can be covered by the insurance fund.
+			expectedDeltaQuantums: big.NewInt(75_000_000),
+		},
+		`Long position is partially deleveraged when the insurance fund can cover part of the shortfall`: {
+			insuranceFundBalance: big.NewInt(500_000), // $0.50
+			subaccount:           dave_Num0_1BTC_Long_Negative_TNC,
+			oraclePrice:          5_000_000_000, // $50,000 / BTC, TNC = -$1
+
+			expectedDeltaQuantums: big.NewInt(-50_000_000),
+		},
+		`Deleveraged position size is at least the step size`: {
+			insuranceFundBalance: big.NewInt(999_999), // $0.999999
+			subaccount:           constants.Carl_Num0_1BTC_Short_54999USD,
+			oraclePrice:          5_500_000_000, // $55,000 / BTC, TNC = -$1
+
+			expectedDeltaQuantums: big.NewInt(100),
+		},
+		`Fails when subaccount does not have an open position for the perpetual`: {
+			insuranceFundBalance: big.NewInt(0),
+			subaccount:           constants.Carl_Num0_599USD,
+			oraclePrice:          5_000_000_000,
+
+			expectedErr: types.ErrNoOpenPositionForPerpetual,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			bankMock := &mocks.BankKeeper{}
+			bankMock.On(
+				"GetBalance",
+				mock.Anything,
+				authtypes.NewModuleAddress(types.InsuranceFundName),
+				constants.Usdc.Denom,
+			).Return(
+				sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewIntFromBigInt(tc.insuranceFundBalance)),
+			)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			mockIndexerEventManager.On("Enabled").Return(false)
+			mockIndexerEventManager.On("AddTxnEvent",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+			).Return()
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, bankMock, mockIndexerEventManager)
+
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+			require.NoError(
+				t,
+				ks.PricesKeeper.UpdateMarketPrices(ks.Ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+					pricestypes.NewMarketPriceUpdate(constants.BtcUsd.MarketId, tc.oraclePrice),
+				}),
+			)
+
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.FundingRateBounds,
+				p.Params.InterestRatePpm,
+				p.Params.InsuranceFundScope,
+			)
+			require.NoError(t, err)
+
+			clobPair := constants.ClobPair_Btc
+			_, err = ks.ClobKeeper.CreatePerpetualClobPair(
+				ks.Ctx,
+				clobPair.Id,
+				clobPair.MustGetPerpetualId(),
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				clobPair.Status,
+			)
+			require.NoError(t, err)
+
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.subaccount)
+
+			deltaQuantums, err := ks.ClobKeeper.GetDeleveragingPositionSizeDelta(ks.Ctx, *tc.subaccount.Id, 0)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				require.Zero(t, tc.expectedDeltaQuantums.Cmp(deltaQuantums))
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
@@ -634,6 +996,9 @@ func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1026,6 +1391,9 @@ func TestProcessDeleveraging(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1033,7 +1401,7 @@ func TestProcessDeleveraging(t *testing.T) {
 			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.liquidatedSubaccount)
 			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.offsettingSubaccount)
 
-			err = ks.ClobKeeper.ProcessDeleveraging(
+			deltaQuoteQuantums, err := ks.ClobKeeper.ProcessDeleveraging(
 				ks.Ctx,
 				*tc.liquidatedSubaccount.GetId(),
 				*tc.offsettingSubaccount.GetId(),
@@ -1049,6 +1417,14 @@ func TestProcessDeleveraging(t *testing.T) {
 					tc.expectedLiquidatedSubaccount,
 					actualLiquidated,
 				)
+				// The returned quote quantums are the change in the liquidated subaccount's quote balance.
+				require.Zero(
+					t,
+					new(big.Int).Sub(
+						actualLiquidated.GetUsdcPosition(),
+						tc.liquidatedSubaccount.GetUsdcPosition(),
+					).Cmp(deltaQuoteQuantums),
+				)
 
 				actualOffsetting := ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, *tc.offsettingSubaccount.GetId())
 				require.Equal(
@@ -1159,6 +1535,9 @@ func TestProcessDeleveraging_Rounding(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1166,7 +1545,7 @@ func TestProcessDeleveraging_Rounding(t *testing.T) {
 			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.liquidatedSubaccount)
 			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.offsettingSubaccount)
 
-			err = ks.ClobKeeper.ProcessDeleveraging(
+			_, err = ks.ClobKeeper.ProcessDeleveraging(
 				ks.Ctx,
 				*tc.liquidatedSubaccount.GetId(),
 				*tc.offsettingSubaccount.GetId(),
@@ -1181,3 +1560,187 @@ func TestProcessDeleveraging_Rounding(t *testing.T) {
 		})
 	}
 }
+
+// setupAdlOrderTest creates two 0.5 BTC long positions entered at $50,000, and sets the price of BTC
+// to $51,000. Dave_Num1 has less net collateral than Dave_Num0 and therefore higher leverage, which
+// puts it first in the ADL ranking of long positions.
+func setupAdlOrderTest(t *testing.T) keepertest.ClobKeepersTestContext {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+
+	p := constants.BtcUsd_100PercentMarginRequirement
+	_, err := ks.PerpetualsKeeper.CreatePerpetual(
+		ks.Ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.FundingRateBounds,
+		p.Params.InterestRatePpm,
+		p.Params.InsuranceFundScope,
+	)
+	require.NoError(t, err)
+
+	for _, subaccount := range []satypes.Subaccount{
+		{
+			Id:             &constants.Dave_Num0,
+			AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)),
+		},
+		{
+			Id:             &constants.Dave_Num1,
+			AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(30_000_000_000)),
+		},
+	} {
+		ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+		success, _, err := ks.SubaccountsKeeper.UpdateSubaccounts(
+			ks.Ctx,
+			[]satypes.Update{
+				{
+					SubaccountId: *subaccount.Id,
+					AssetUpdates: keepertest.CreateUsdcAssetUpdate(big.NewInt(-25_000_000_000)),
+					PerpetualUpdates: []satypes.PerpetualUpdate{
+						{
+							PerpetualId:      0,
+							BigQuantumsDelta: big.NewInt(50_000_000), // 0.5 BTC
+						},
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.True(t, success)
+	}
+	ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, constants.Carl_Num0_1BTC_Short_54999USD)
+
+	require.NoError(
+		t,
+		ks.PricesKeeper.UpdateMarketPrices(ks.Ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+			pricestypes.NewMarketPriceUpdate(0, 5_100_000_000), // $51,000 / BTC.
+		}),
+	)
+	ks.BlockTimeKeeper.SetPreviousBlockInfo(ks.Ctx, &blocktimetypes.BlockInfo{
+		Timestamp: time.Unix(5, 0),
+	})
+	return ks
+}
+
+func TestOffsetSubaccountPerpetualPosition_AdlOrder(t *testing.T) {
+	ks := setupAdlOrderTest(t)
+
+	fills, deltaQuantumsRemaining := ks.ClobKeeper.OffsetSubaccountPerpetualPosition(
+		ks.Ctx,
+		constants.Carl_Num0,
+		0,
+		big.NewInt(50_000_000), // 0.5 BTC
+	)
+	require.Equal(
+		t,
+		[]types.MatchPerpetualDeleveraging_Fill{
+			{
+				OffsettingSubaccountId: constants.Dave_Num1,
+				FillAmount:             50_000_000,
+			},
+		},
+		fills,
+	)
+	require.Zero(t, deltaQuantumsRemaining.Sign())
+
+	// Dave_Num0 is not used to offset the position since it ranks after Dave_Num1.
+	daveNum0 := ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, constants.Dave_Num0)
+	require.Len(t, daveNum0.PerpetualPositions, 1)
+	daveNum1 := ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, constants.Dave_Num1)
+	require.Empty(t, daveNum1.PerpetualPositions)
+}
+
+func TestOffsetSubaccountPerpetualPosition_MaxDeleveragingSubaccountsToIterate(t *testing.T) {
+	ks := setupAdlOrderTest(t)
+	ks.ClobKeeper.Flags.MaxDeleveragingSubaccountsToIterate = 1
+
+	fills, deltaQuantumsRemaining := ks.ClobKeeper.OffsetSubaccountPerpetualPosition(
+		ks.Ctx,
+		constants.Carl_Num0,
+		0,
+		big.NewInt(100_000_000), // 1 BTC
+	)
+
+	// Only the first position in the ADL ranking is used.
+	require.Equal(
+		t,
+		[]types.MatchPerpetualDeleveraging_Fill{
+			{
+				OffsettingSubaccountId: constants.Dave_Num1,
+				FillAmount:             50_000_000,
+			},
+		},
+		fills,
+	)
+	require.Equal(t, big.NewInt(50_000_000), deltaQuantumsRemaining)
+}
+
+func TestValidateDeleveragingFillsAdlOrder(t *testing.T) {
+	tests := map[string]struct {
+		isLong                 bool
+		offsettingSubaccountId []satypes.SubaccountId
+
+		expectedErr error
+	}{
+		"Fills in ADL order": {
+			isLong:                 true,
+			offsettingSubaccountId: []satypes.SubaccountId{constants.Dave_Num1, constants.Dave_Num0},
+		},
+		"Fills may skip positions in the ADL ranking": {
+			isLong:                 true,
+			offsettingSubaccountId: []satypes.SubaccountId{constants.Dave_Num0},
+		},
+		"No fills": {
+			isLong: true,
+		},
+		"Fills not in ADL order": {
+			isLong:                 true,
+			offsettingSubaccountId: []satypes.SubaccountId{constants.Dave_Num0, constants.Dave_Num1},
+			expectedErr:            types.ErrDeleveragingFillNotInAdlOrder,
+		},
+		"Fills with duplicate offsetting subaccount": {
+			isLong:                 true,
+			offsettingSubaccountId: []satypes.SubaccountId{constants.Dave_Num1, constants.Dave_Num1},
+			expectedErr:            types.ErrDeleveragingFillNotInAdlOrder,
+		},
+		"Offsetting subaccount with position on the wrong side": {
+			isLong:                 true,
+			offsettingSubaccountId: []satypes.SubaccountId{constants.Carl_Num0},
+			expectedErr:            types.ErrDeleveragingFillNotInAdlOrder,
+		},
+		"Offsetting subaccount without an open position": {
+			isLong:                 false,
+			offsettingSubaccountId: []satypes.SubaccountId{constants.Alice_Num0},
+			expectedErr:            types.ErrDeleveragingFillNotInAdlOrder,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ks := setupAdlOrderTest(t)
+
+			fills := make([]types.MatchPerpetualDeleveraging_Fill, 0, len(tc.offsettingSubaccountId))
+			for _, subaccountId := range tc.offsettingSubaccountId {
+				fills = append(fills, types.MatchPerpetualDeleveraging_Fill{
+					OffsettingSubaccountId: subaccountId,
+					FillAmount:             10_000_000,
+				})
+			}
+
+			err := ks.ClobKeeper.ValidateDeleveragingFillsAdlOrder(ks.Ctx, 0, tc.isLong, fills)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
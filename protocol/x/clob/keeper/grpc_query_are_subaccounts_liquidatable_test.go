@@ -141,6 +141,9 @@ func TestAreSubaccountsLiquidatable(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
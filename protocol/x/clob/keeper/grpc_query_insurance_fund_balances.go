@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+)
+
+func (k Keeper) InsuranceFundBalances(
+	c context.Context,
+	req *types.QueryInsuranceFundBalancesRequest,
+) (*types.QueryInsuranceFundBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryInsuranceFundBalancesResponse{
+		InsuranceFunds: k.GetAllInsuranceFundBalances(ctx),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	testApp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsuranceFundBalances(
+	t *testing.T,
+) {
+	tApp := testApp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	expected := types.QueryInsuranceFundBalancesResponse{
+		InsuranceFunds: tApp.App.ClobKeeper.GetAllInsuranceFundBalances(ctx),
+	}
+
+	request := types.QueryInsuranceFundBalancesRequest{}
+	abciResponse := tApp.App.Query(abci.RequestQuery{
+		Path: "/dydxprotocol.clob.Query/InsuranceFundBalances",
+		Data: tApp.App.AppCodec().MustMarshal(&request),
+	})
+	require.True(t, abciResponse.IsOK())
+
+	var actual types.QueryInsuranceFundBalancesResponse
+	tApp.App.AppCodec().MustUnmarshal(abciResponse.Value, &actual)
+
+	// All genesis perpetuals are backed by the global insurance fund.
+	require.Len(t, actual.InsuranceFunds, 1)
+	require.Equal(t, types.InsuranceFundName, actual.InsuranceFunds[0].Name)
+	require.Equal(t, authtypes.NewModuleAddress(types.InsuranceFundName).String(), actual.InsuranceFunds[0].Address)
+	require.Equal(t, expected.InsuranceFunds[0].PerpetualIds, actual.InsuranceFunds[0].PerpetualIds)
+	require.Zero(t, expected.InsuranceFunds[0].Balance.Cmp(actual.InsuranceFunds[0].Balance))
+}
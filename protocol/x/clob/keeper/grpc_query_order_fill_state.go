@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderFillState returns the fill state of an order, whether it is resting on the in-memory orderbook
+// as of the last snapshot taken in `PrepareCheckState` and, for stateful orders, its placement in state.
+func (k Keeper) OrderFillState(
+	c context.Context,
+	req *types.QueryOrderFillStateRequest,
+) (*types.QueryOrderFillStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.OrderId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	snapshot, err := k.getOrderbookSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, resting := snapshot.restingOrderIds[req.OrderId]
+	response := &types.QueryOrderFillStateResponse{
+		FillState: k.getOrderFillState(ctx, req.OrderId),
+		Resting:   resting,
+	}
+	if req.OrderId.IsStatefulOrder() {
+		if placement, found := k.GetLongTermOrderPlacement(ctx, req.OrderId); found {
+			response.LongTermOrderPlacement = &placement
+		}
+	}
+	return response, nil
+}
+
+// getOrderFillState returns the `OrderFillState` of an order from state, or an empty `OrderFillState`
+// if the order has no fill amount in state.
+func (k Keeper) getOrderFillState(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) types.OrderFillState {
+	exists, fillAmount, prunableBlockHeight := k.GetOrderFillAmount(ctx, orderId)
+	if !exists {
+		return types.OrderFillState{}
+	}
+	return types.OrderFillState{
+		FillAmount:          fillAmount.ToUint64(),
+		PrunableBlockHeight: prunableBlockHeight,
+	}
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestOrderFillState(t *testing.T) {
+	shortTermOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	longTermOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+
+	for _, tc := range []struct {
+		desc string
+
+		// State.
+		restingOrders []types.Order
+
+		request  *types.QueryOrderFillStateRequest
+		response *types.QueryOrderFillStateResponse
+		err      error
+	}{
+		{
+			desc: "Returns an empty fill state for an unknown order",
+			request: &types.QueryOrderFillStateRequest{
+				OrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+			},
+			response: &types.QueryOrderFillStateResponse{},
+		},
+		{
+			desc:          "Returns the fill state of a resting short-term order",
+			restingOrders: []types.Order{shortTermOrder},
+			request: &types.QueryOrderFillStateRequest{
+				OrderId: shortTermOrder.OrderId,
+			},
+			response: &types.QueryOrderFillStateResponse{
+				FillState: types.OrderFillState{
+					FillAmount:          2,
+					PrunableBlockHeight: 20,
+				},
+				Resting: true,
+			},
+		},
+		{
+			desc: "Returns the fill state and placement of a stateful order",
+			request: &types.QueryOrderFillStateRequest{
+				OrderId: longTermOrder.OrderId,
+			},
+			response: &types.QueryOrderFillStateResponse{
+				FillState: types.OrderFillState{
+					FillAmount:          3,
+					PrunableBlockHeight: 30,
+				},
+				LongTermOrderPlacement: &types.LongTermOrderPlacement{
+					Order: longTermOrder,
+					PlacementIndex: types.TransactionOrdering{
+						BlockHeight:      5,
+						TransactionIndex: 0,
+					},
+				},
+			},
+		},
+		{
+			desc: "Invalid order id",
+			request: &types.QueryOrderFillStateRequest{
+				OrderId: types.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					OrderFlags:   1,
+				},
+			},
+			err: status.Error(codes.InvalidArgument, types.ErrInvalidOrderFlag.Error()),
+		},
+		{
+			desc: "Invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("CreateOrderbook", mock.Anything, mock.Anything).Return()
+			memClob.On("GetOpenOrders", mock.Anything, types.ClobPairId(0)).Return(tc.restingOrders)
+			memClob.On("GetOrderbookDepth", mock.Anything, types.ClobPairId(0), uint32(0)).Return(
+				[]types.OrderbookLevel{},
+				[]types.OrderbookLevel{},
+			)
+
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+			ks.ClobKeeper.Flags.OrderbookQueriesEnabled = true
+			keepertest.CreateNClobPair(
+				t,
+				ks.ClobKeeper,
+				ks.PerpetualsKeeper,
+				ks.PricesKeeper,
+				ks.Ctx,
+				1,
+				mockIndexerEventManager,
+			)
+			ks.ClobKeeper.UpdateOrderbookSnapshot(ks.Ctx.WithIsCheckTx(true))
+			ks.ClobKeeper.SetOrderFillAmount(ks.Ctx, shortTermOrder.OrderId, 2, 20)
+			ks.ClobKeeper.SetOrderFillAmount(ks.Ctx, longTermOrder.OrderId, 3, 30)
+			ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, longTermOrder, 5)
+
+			response, err := ks.ClobKeeper.OrderFillState(sdk.WrapSDKContext(ks.Ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderbookDepth returns the price levels of the in-memory orderbook for a ClobPair, aggregated by price,
+// as of the last snapshot taken in `PrepareCheckState`. Note that the in-memory orderbook is local to each
+// node and may differ between nodes.
+func (k Keeper) OrderbookDepth(
+	c context.Context,
+	req *types.QueryOrderbookDepthRequest,
+) (*types.QueryOrderbookDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	clobPairId := types.ClobPairId(req.ClobPairId)
+	if _, found := k.GetClobPair(ctx, clobPairId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	snapshot, err := k.getOrderbookSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	bids, asks := snapshot.getOrderbookDepth(clobPairId, req.Depth)
+	return &types.QueryOrderbookDepthResponse{
+		ClobPairId: req.ClobPairId,
+		Bids:       bids,
+		Asks:       asks,
+	}, nil
+}
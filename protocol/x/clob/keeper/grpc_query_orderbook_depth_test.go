@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestOrderbookDepth(t *testing.T) {
+	bids := []types.OrderbookLevel{
+		{Subticks: 10, Quantums: 25, NumOrders: 2},
+		{Subticks: 5, Quantums: 10, NumOrders: 1},
+	}
+	asks := []types.OrderbookLevel{
+		{Subticks: 15, Quantums: 20, NumOrders: 1},
+	}
+
+	for _, tc := range []struct {
+		desc                    string
+		orderbookQueriesEnabled bool
+		request                 *types.QueryOrderbookDepthRequest
+		response                *types.QueryOrderbookDepthResponse
+		err                     error
+	}{
+		{
+			desc:                    "Returns the orderbook depth",
+			orderbookQueriesEnabled: true,
+			request: &types.QueryOrderbookDepthRequest{
+				ClobPairId: 0,
+				Depth:      2,
+			},
+			response: &types.QueryOrderbookDepthResponse{
+				ClobPairId: 0,
+				Bids:       bids,
+				Asks:       asks,
+			},
+		},
+		{
+			desc:                    "Returns at most the requested number of levels",
+			orderbookQueriesEnabled: true,
+			request: &types.QueryOrderbookDepthRequest{
+				ClobPairId: 0,
+				Depth:      1,
+			},
+			response: &types.QueryOrderbookDepthResponse{
+				ClobPairId: 0,
+				Bids:       bids[:1],
+				Asks:       asks,
+			},
+		},
+		{
+			desc:                    "ClobPair not found",
+			orderbookQueriesEnabled: true,
+			request: &types.QueryOrderbookDepthRequest{
+				ClobPairId: 100,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:                    "Invalid request",
+			orderbookQueriesEnabled: true,
+			err:                     status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		{
+			desc: "Orderbook queries are not enabled",
+			request: &types.QueryOrderbookDepthRequest{
+				ClobPairId: 0,
+			},
+			err: status.Error(codes.Unavailable, "orderbook queries are not enabled"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("CreateOrderbook", mock.Anything, mock.Anything).Return()
+			memClob.On("GetOrderbookDepth", mock.Anything, types.ClobPairId(0), uint32(0)).Return(bids, asks)
+			memClob.On("GetOpenOrders", mock.Anything, types.ClobPairId(0)).Return([]types.Order{})
+
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+			ks.ClobKeeper.Flags.OrderbookQueriesEnabled = tc.orderbookQueriesEnabled
+			keepertest.CreateNClobPair(
+				t,
+				ks.ClobKeeper,
+				ks.PerpetualsKeeper,
+				ks.PricesKeeper,
+				ks.Ctx,
+				1,
+				mockIndexerEventManager,
+			)
+			ks.ClobKeeper.UpdateOrderbookSnapshot(ks.Ctx.WithIsCheckTx(true))
+
+			response, err := ks.ClobKeeper.OrderbookDepth(sdk.WrapSDKContext(ks.Ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateLiquidation returns the margin requirements of a subaccount along with the liquidation,
+// bankruptcy and fillable prices of its perpetual positions after hypothetical changes to its
+// positions and to oracle prices. The simulation never writes to state.
+func (k Keeper) SimulateLiquidation(
+	c context.Context,
+	req *types.QuerySimulateLiquidationRequest,
+) (*types.QuerySimulateLiquidationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateSimulateLiquidationRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response, err := k.SimulateSubaccountLiquidation(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return response, nil
+}
+
+// validateSimulateLiquidationRequest returns an error if the subaccount id of the request is invalid,
+// a perpetual or market is referenced more than once, or a simulated price is zero.
+func validateSimulateLiquidationRequest(req *types.QuerySimulateLiquidationRequest) error {
+	if err := req.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	perpetualIds := make(map[uint32]struct{}, len(req.PerpetualPositionChanges))
+	for _, change := range req.PerpetualPositionChanges {
+		if _, exists := perpetualIds[change.PerpetualId]; exists {
+			return fmt.Errorf("duplicate position change for perpetual %d", change.PerpetualId)
+		}
+		perpetualIds[change.PerpetualId] = struct{}{}
+	}
+
+	marketIds := make(map[uint32]struct{}, len(req.MarketPrices))
+	for _, marketPrice := range req.MarketPrices {
+		if _, exists := marketIds[marketPrice.MarketId]; exists {
+			return fmt.Errorf("duplicate price for market %d", marketPrice.MarketId)
+		}
+		if marketPrice.Price == 0 {
+			return fmt.Errorf("price for market %d must be positive", marketPrice.MarketId)
+		}
+		marketIds[marketPrice.MarketId] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSimulateLiquidation(t *testing.T) {
+	// $10,000 of net collateral and no open perpetual positions.
+	alice_Num0_10000USD := satypes.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&constants.Usdc_Asset_10_000,
+		},
+	}
+	// $10,000 of net collateral and $5,000 of maintenance margin.
+	bob_Num0_1BTC_Short_10000USD_Collateral := satypes.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(60_000_000_000)),
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			{
+				PerpetualId:  0,
+				Quantums:     dtypes.NewInt(-100_000_000), // -1 BTC
+				FundingIndex: dtypes.NewInt(0),
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		request *types.QuerySimulateLiquidationRequest
+
+		response *types.QuerySimulateLiquidationResponse
+		err      error
+	}{
+		"Subaccount without perpetual positions": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Alice_Num0,
+			},
+			response: &types.QuerySimulateLiquidationResponse{
+				NetCollateral:                dtypes.NewInt(10_000_000_000),
+				InitialMarginRequirement:     dtypes.NewInt(0),
+				MaintenanceMarginRequirement: dtypes.NewInt(0),
+				FreeCollateral:               dtypes.NewInt(10_000_000_000),
+				PerpetualPositions:           []types.SimulatedPerpetualPosition{},
+			},
+		},
+		"Opening a long position": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Alice_Num0,
+				PerpetualPositionChanges: []types.SimulatedPerpetualPositionChange{
+					{
+						PerpetualId:   0,
+						QuantumsDelta: 100_000_000, // 1 BTC
+					},
+				},
+				QuoteQuantumsDelta: -50_000_000_000,
+			},
+			response: &types.QuerySimulateLiquidationResponse{
+				NetCollateral:                dtypes.NewInt(10_000_000_000),
+				InitialMarginRequirement:     dtypes.NewInt(10_000_000_000),
+				MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+				FreeCollateral:               dtypes.NewInt(0),
+				PerpetualPositions: []types.SimulatedPerpetualPosition{
+					{
+						PerpetualId:              0,
+						Quantums:                 dtypes.NewInt(100_000_000),
+						LiquidationPriceSubticks: 44_444_444_445, // $44,444.44
+						BankruptcyPriceSubticks:  40_000_000_000, // $40,000
+						FillablePriceSubticks:    50_000_000_000, // $50,000
+						InsuranceFundDelta:       dtypes.NewInt(250_000_000),
+					},
+				},
+			},
+		},
+		"Opening a long position with a simulated oracle price below the liquidation price": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Alice_Num0,
+				PerpetualPositionChanges: []types.SimulatedPerpetualPositionChange{
+					{
+						PerpetualId:   0,
+						QuantumsDelta: 100_000_000, // 1 BTC
+					},
+				},
+				QuoteQuantumsDelta: -50_000_000_000,
+				MarketPrices: []types.SimulatedMarketPrice{
+					{
+						MarketId: 0,
+						Price:    4_400_000_000, // $44,000
+					},
+				},
+			},
+			response: &types.QuerySimulateLiquidationResponse{
+				NetCollateral:                dtypes.NewInt(4_000_000_000),
+				InitialMarginRequirement:     dtypes.NewInt(8_800_000_000),
+				MaintenanceMarginRequirement: dtypes.NewInt(4_400_000_000),
+				FreeCollateral:               dtypes.NewInt(-4_800_000_000),
+				IsLiquidatable:               true,
+				PerpetualPositions: []types.SimulatedPerpetualPosition{
+					{
+						PerpetualId:              0,
+						Quantums:                 dtypes.NewInt(100_000_000),
+						LiquidationPriceSubticks: 44_444_444_445, // $44,444.44
+						BankruptcyPriceSubticks:  40_000_000_000, // $40,000
+						FillablePriceSubticks:    43_960_000_000, // $43,960
+						InsuranceFundDelta:       dtypes.NewInt(219_800_000),
+					},
+				},
+			},
+		},
+		"Existing short position": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Bob_Num0,
+			},
+			response: &types.QuerySimulateLiquidationResponse{
+				NetCollateral:                dtypes.NewInt(10_000_000_000),
+				InitialMarginRequirement:     dtypes.NewInt(10_000_000_000),
+				MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+				FreeCollateral:               dtypes.NewInt(0),
+				PerpetualPositions: []types.SimulatedPerpetualPosition{
+					{
+						PerpetualId:              0,
+						Quantums:                 dtypes.NewInt(-100_000_000),
+						LiquidationPriceSubticks: 54_545_454_545, // $54,545.45
+						BankruptcyPriceSubticks:  60_000_000_000, // $60,000
+						FillablePriceSubticks:    50_000_000_000, // $50,000
+						InsuranceFundDelta:       dtypes.NewInt(250_000_000),
+					},
+				},
+			},
+		},
+		"Closing a position": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Bob_Num0,
+				PerpetualPositionChanges: []types.SimulatedPerpetualPositionChange{
+					{
+						PerpetualId:   0,
+						QuantumsDelta: 100_000_000, // 1 BTC
+					},
+				},
+				QuoteQuantumsDelta: -50_000_000_000,
+			},
+			response: &types.QuerySimulateLiquidationResponse{
+				NetCollateral:                dtypes.NewInt(10_000_000_000),
+				InitialMarginRequirement:     dtypes.NewInt(0),
+				MaintenanceMarginRequirement: dtypes.NewInt(0),
+				FreeCollateral:               dtypes.NewInt(10_000_000_000),
+				PerpetualPositions:           []types.SimulatedPerpetualPosition{},
+			},
+		},
+		"Nil request": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Duplicate perpetual": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Alice_Num0,
+				PerpetualPositionChanges: []types.SimulatedPerpetualPositionChange{
+					{PerpetualId: 0, QuantumsDelta: 1},
+					{PerpetualId: 0, QuantumsDelta: 1},
+				},
+			},
+			err: status.Error(codes.InvalidArgument, "duplicate position change for perpetual 0"),
+		},
+		"Duplicate market": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Alice_Num0,
+				MarketPrices: []types.SimulatedMarketPrice{
+					{MarketId: 0, Price: 1},
+					{MarketId: 0, Price: 1},
+				},
+			},
+			err: status.Error(codes.InvalidArgument, "duplicate price for market 0"),
+		},
+		"Zero price": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Alice_Num0,
+				MarketPrices: []types.SimulatedMarketPrice{
+					{MarketId: 0, Price: 0},
+				},
+			},
+			err: status.Error(codes.InvalidArgument, "price for market 0 must be positive"),
+		},
+		"Perpetual does not exist": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Alice_Num0,
+				PerpetualPositionChanges: []types.SimulatedPerpetualPositionChange{
+					{PerpetualId: 1, QuantumsDelta: 1},
+				},
+			},
+			err: perptypes.ErrPerpetualDoesNotExist,
+		},
+		"Market does not exist": {
+			request: &types.QuerySimulateLiquidationRequest{
+				SubaccountId: constants.Alice_Num0,
+				MarketPrices: []types.SimulatedMarketPrice{
+					{MarketId: 1_000, Price: 1},
+				},
+			},
+			err: pricestypes.ErrMarketPriceDoesNotExist,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			mockIndexerEventManager.On("AddTxnEvent",
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			).Return()
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+
+			createInsuranceFundTestPerpetual(t, ks, perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+			keepertest.CreateTestClobPairs(t, ks.Ctx, ks.ClobKeeper, []types.ClobPair{constants.ClobPair_Btc})
+			require.NoError(t, ks.ClobKeeper.InitializeLiquidationsConfig(ks.Ctx, types.LiquidationsConfig_Default))
+
+			for _, subaccount := range []satypes.Subaccount{
+				alice_Num0_10000USD,
+				bob_Num0_1BTC_Short_10000USD_Collateral,
+			} {
+				ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+			}
+			subaccounts := ks.SubaccountsKeeper.GetAllSubaccount(ks.Ctx)
+			marketPrices := ks.PricesKeeper.GetAllMarketPrices(ks.Ctx)
+
+			response, err := ks.ClobKeeper.SimulateLiquidation(sdk.WrapSDKContext(ks.Ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Zero(t, tc.response.NetCollateral.Cmp(response.NetCollateral))
+				require.Zero(t, tc.response.InitialMarginRequirement.Cmp(response.InitialMarginRequirement))
+				require.Zero(t, tc.response.MaintenanceMarginRequirement.Cmp(response.MaintenanceMarginRequirement))
+				require.Zero(t, tc.response.FreeCollateral.Cmp(response.FreeCollateral))
+				require.Equal(t, tc.response.IsLiquidatable, response.IsLiquidatable)
+				require.Len(t, response.PerpetualPositions, len(tc.response.PerpetualPositions))
+				for i, expectedPosition := range tc.response.PerpetualPositions {
+					position := response.PerpetualPositions[i]
+					require.Equal(t, expectedPosition.PerpetualId, position.PerpetualId)
+					require.Zero(t, expectedPosition.Quantums.Cmp(position.Quantums))
+					require.Equal(t, expectedPosition.LiquidationPriceSubticks, position.LiquidationPriceSubticks)
+					require.Equal(t, expectedPosition.BankruptcyPriceSubticks, position.BankruptcyPriceSubticks)
+					require.Equal(t, expectedPosition.FillablePriceSubticks, position.FillablePriceSubticks)
+					require.Zero(t, expectedPosition.InsuranceFundDelta.Cmp(position.InsuranceFundDelta))
+				}
+			}
+
+			// The simulation never writes to state.
+			require.Equal(t, subaccounts, ks.SubaccountsKeeper.GetAllSubaccount(ks.Ctx))
+			require.Equal(t, marketPrices, ks.PricesKeeper.GetAllMarketPrices(ks.Ctx))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubaccountOpenOrders returns the orders of a subaccount resting on the in-memory orderbooks as of the
+// last snapshot taken in `PrepareCheckState`, along with their fill state and, for stateful orders, their
+// placement in state. Orders are sorted by ClobPair id and then by order id.
+func (k Keeper) SubaccountOpenOrders(
+	c context.Context,
+	req *types.QuerySubaccountOpenOrdersRequest,
+) (*types.QuerySubaccountOpenOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	subaccountId := satypes.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	snapshot, err := k.getOrderbookSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orders := snapshot.subaccountOrders[subaccountId]
+	openOrders := make([]types.OpenOrder, 0, len(orders))
+	for _, order := range orders {
+		openOrder := types.OpenOrder{
+			Order:     order,
+			FillState: k.getOrderFillState(ctx, order.OrderId),
+		}
+		if order.OrderId.IsStatefulOrder() {
+			if placement, found := k.GetLongTermOrderPlacement(ctx, order.OrderId); found {
+				openOrder.PlacementIndex = &placement.PlacementIndex
+			}
+		}
+		openOrders = append(openOrders, openOrder)
+	}
+
+	return &types.QuerySubaccountOpenOrdersResponse{
+		OpenOrders: openOrders,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestSubaccountOpenOrders(t *testing.T) {
+	shortTermBuy := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	shortTermSell := constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15
+	longTermBuy := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+
+	for _, tc := range []struct {
+		desc string
+
+		// State.
+		restingOrders []types.Order
+
+		request  *types.QuerySubaccountOpenOrdersRequest
+		response *types.QuerySubaccountOpenOrdersResponse
+		err      error
+	}{
+		{
+			desc:          "Returns no orders",
+			restingOrders: []types.Order{},
+			request: &types.QuerySubaccountOpenOrdersRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+			response: &types.QuerySubaccountOpenOrdersResponse{
+				OpenOrders: []types.OpenOrder{},
+			},
+		},
+		{
+			desc:          "Returns sorted orders with their fill state and placement",
+			restingOrders: []types.Order{shortTermSell, longTermBuy, shortTermBuy},
+			request: &types.QuerySubaccountOpenOrdersRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+			response: &types.QuerySubaccountOpenOrdersResponse{
+				OpenOrders: []types.OpenOrder{
+					{
+						Order: shortTermBuy,
+						FillState: types.OrderFillState{
+							FillAmount:          2,
+							PrunableBlockHeight: 20,
+						},
+					},
+					{
+						Order: longTermBuy,
+						PlacementIndex: &types.TransactionOrdering{
+							BlockHeight:      5,
+							TransactionIndex: 0,
+						},
+					},
+					{
+						Order: shortTermSell,
+					},
+				},
+			},
+		},
+		{
+			desc:          "Does not return orders of other subaccounts",
+			restingOrders: []types.Order{constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32},
+			request: &types.QuerySubaccountOpenOrdersRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+			response: &types.QuerySubaccountOpenOrdersResponse{
+				OpenOrders: []types.OpenOrder{},
+			},
+		},
+		{
+			desc: "Invalid subaccount owner",
+			request: &types.QuerySubaccountOpenOrdersRequest{
+				Owner: "invalid",
+			},
+			err: status.Error(codes.InvalidArgument, satypes.ErrInvalidSubaccountIdOwner.Error()),
+		},
+		{
+			desc: "Invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("CreateOrderbook", mock.Anything, mock.Anything).Return()
+			memClob.On("GetOpenOrders", mock.Anything, types.ClobPairId(0)).Return(tc.restingOrders)
+			memClob.On("GetOrderbookDepth", mock.Anything, types.ClobPairId(0), uint32(0)).Return(
+				[]types.OrderbookLevel{},
+				[]types.OrderbookLevel{},
+			)
+
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+			ks.ClobKeeper.Flags.OrderbookQueriesEnabled = true
+			keepertest.CreateNClobPair(
+				t,
+				ks.ClobKeeper,
+				ks.PerpetualsKeeper,
+				ks.PricesKeeper,
+				ks.Ctx,
+				1,
+				mockIndexerEventManager,
+			)
+			ks.ClobKeeper.SetOrderFillAmount(ks.Ctx, shortTermBuy.OrderId, 2, 20)
+			ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, longTermBuy, 5)
+			ks.ClobKeeper.UpdateOrderbookSnapshot(ks.Ctx.WithIsCheckTx(true))
+
+			response, err := ks.ClobKeeper.SubaccountOpenOrders(sdk.WrapSDKContext(ks.Ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamOrderbookUpdates subscribes the stream to orderbook updates for the requested clob pairs. The
+// subscription is initialized with a snapshot of the orderbooks in the next `PrepareCheckState`, after
+// which incremental updates are sent as they are generated. This method blocks until the client closes
+// the stream or the subscription is removed because the client could not keep up with the updates.
+//
+// Note that streaming methods are registered directly with the gRPC server and are not routed through
+// ABCI queries, so no `sdk.Context` is available here.
+func (k Keeper) StreamOrderbookUpdates(
+	req *types.StreamOrderbookUpdatesRequest,
+	stream types.Query_StreamOrderbookUpdatesServer,
+) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	subscriptionId, done, err := k.GetGrpcStreamingManager().Subscribe(*req, stream)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer k.GetGrpcStreamingManager().Unsubscribe(subscriptionId)
+
+	// Keep the stream open until the client disconnects or the subscription is removed.
+	select {
+	case <-stream.Context().Done():
+		return nil
+	case <-done:
+		return status.Error(codes.ResourceExhausted, "orderbook updates subscription was closed")
+	}
+}
+
+// InitializeNewGrpcStreams sends L3 and L2 orderbook snapshots to all gRPC streaming subscriptions which
+// have not yet been initialized. This is called in `PrepareCheckState` once the memclob has been updated.
+func (k Keeper) InitializeNewGrpcStreams(ctx sdk.Context) {
+	lib.AssertCheckTxMode(ctx)
+
+	k.GetGrpcStreamingManager().InitializeNewStreams(
+		func(clobPairId types.ClobPairId) *types.OffchainUpdates {
+			return k.MemClob.GetOffchainUpdatesForOrderbookSnapshot(ctx, clobPairId)
+		},
+		func(clobPairId types.ClobPairId) types.OrderbookL2Snapshot {
+			bids, asks := k.MemClob.GetOrderbookDepth(ctx, clobPairId, 0)
+			return types.OrderbookL2Snapshot{
+				ClobPairId: clobPairId.ToUint32(),
+				Bids:       bids,
+				Asks:       asks,
+			}
+		},
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	streamingtypes "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/rate_limit"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -39,12 +40,17 @@ type (
 		blockTimeKeeper     types.BlockTimeKeeper
 		feeTiersKeeper      types.FeeTiersKeeper
 		perpetualsKeeper    types.PerpetualsKeeper
+		pricesKeeper        types.PricesKeeper
 		statsKeeper         types.StatsKeeper
 		rewardsKeeper       types.RewardsKeeper
 		indexerEventManager indexer_manager.IndexerEventManager
+		streamingManager    streamingtypes.GrpcStreamingManager
 
 		memStoreInitialized *atomic.Bool
 
+		// Snapshot of the in-memory orderbooks served by queries, see `UpdateOrderbookSnapshot`.
+		latestOrderbookSnapshot *atomic.Pointer[orderbookSnapshot]
+
 		Flags flags.ClobFlags
 
 		mevTelemetryConfig MevTelemetryConfig
@@ -75,9 +81,11 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	feeTiersKeeper types.FeeTiersKeeper,
 	perpetualsKeeper types.PerpetualsKeeper,
+	pricesKeeper types.PricesKeeper,
 	statsKeeper types.StatsKeeper,
 	rewardsKeeper types.RewardsKeeper,
 	indexerEventManager indexer_manager.IndexerEventManager,
+	grpcStreamingManager streamingtypes.GrpcStreamingManager,
 	txDecoder sdk.TxDecoder,
 	clobFlags flags.ClobFlags,
 	placeOrderRateLimiter rate_limit.RateLimiter[*types.MsgPlaceOrder],
@@ -98,10 +106,13 @@ func NewKeeper(
 		bankKeeper:                   bankKeeper,
 		feeTiersKeeper:               feeTiersKeeper,
 		perpetualsKeeper:             perpetualsKeeper,
+		pricesKeeper:                 pricesKeeper,
 		statsKeeper:                  statsKeeper,
 		rewardsKeeper:                rewardsKeeper,
 		indexerEventManager:          indexerEventManager,
+		streamingManager:             grpcStreamingManager,
 		memStoreInitialized:          &atomic.Bool{},
+		latestOrderbookSnapshot:      &atomic.Pointer[orderbookSnapshot]{},
 		txDecoder:                    txDecoder,
 		mevTelemetryConfig: MevTelemetryConfig{
 			Enabled:    clobFlags.MevTelemetryEnabled,
@@ -129,6 +140,16 @@ func (k Keeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
 	return k.indexerEventManager
 }
 
+func (k Keeper) GetGrpcStreamingManager() streamingtypes.GrpcStreamingManager {
+	return k.streamingManager
+}
+
+// GenerateOffchainUpdates returns true if off-chain update messages should be generated, which is the
+// case if they will be consumed by either the indexer or gRPC streaming subscribers.
+func (k Keeper) GenerateOffchainUpdates() bool {
+	return k.GetIndexerEventManager().Enabled() || k.GetGrpcStreamingManager().Enabled()
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With(
 		sdklog.ModuleKey, "x/clob",
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// SimulateSubaccountLiquidation returns the collateralization of the subaccount along with the
+// liquidation, bankruptcy and fillable prices of each of its perpetual positions after applying the
+// hypothetical position changes and oracle prices of `req`. The simulation runs on a branched
+// context whose writes are discarded, so state is never modified.
+func (k Keeper) SimulateSubaccountLiquidation(
+	ctx sdk.Context,
+	req *types.QuerySimulateLiquidationRequest,
+) (
+	response *types.QuerySimulateLiquidationResponse,
+	err error,
+) {
+	// Branch the context such that the simulated prices and positions are never written to state.
+	simulationCtx, _ := ctx.CacheContext()
+
+	marketPriceUpdates := make([]*pricestypes.MsgUpdateMarketPrices_MarketPrice, 0, len(req.MarketPrices))
+	for _, marketPrice := range req.MarketPrices {
+		marketPriceUpdates = append(
+			marketPriceUpdates,
+			pricestypes.NewMarketPriceUpdate(marketPrice.MarketId, marketPrice.Price),
+		)
+	}
+	if err := k.pricesKeeper.SetSimulatedMarketPrices(simulationCtx, marketPriceUpdates); err != nil {
+		return nil, err
+	}
+
+	update := satypes.Update{SubaccountId: req.SubaccountId}
+	for _, change := range req.PerpetualPositionChanges {
+		if _, err := k.perpetualsKeeper.GetPerpetual(simulationCtx, change.PerpetualId); err != nil {
+			return nil, err
+		}
+		// Skip empty changes, which would otherwise open positions of size zero.
+		if change.QuantumsDelta == 0 {
+			continue
+		}
+		update.PerpetualUpdates = append(update.PerpetualUpdates, satypes.PerpetualUpdate{
+			PerpetualId:      change.PerpetualId,
+			BigQuantumsDelta: big.NewInt(change.QuantumsDelta),
+		})
+	}
+	if req.QuoteQuantumsDelta != 0 {
+		update.AssetUpdates = []satypes.AssetUpdate{
+			{
+				AssetId:          assettypes.AssetUsdc.Id,
+				BigQuantumsDelta: big.NewInt(req.QuoteQuantumsDelta),
+			},
+		}
+	}
+	if err := k.subaccountsKeeper.ApplySimulatedSubaccountUpdate(simulationCtx, update); err != nil {
+		return nil, err
+	}
+
+	bigNetCollateral,
+		bigInitialMargin,
+		bigMaintenanceMargin,
+		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		simulationCtx,
+		satypes.Update{SubaccountId: req.SubaccountId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	isLiquidatable, err := k.IsLiquidatable(simulationCtx, req.SubaccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(simulationCtx, req.SubaccountId)
+	positions := make([]types.SimulatedPerpetualPosition, 0, len(subaccount.PerpetualPositions))
+	for _, position := range subaccount.PerpetualPositions {
+		simulatedPosition, err := k.simulatePerpetualPosition(
+			simulationCtx,
+			req.SubaccountId,
+			position,
+			bigNetCollateral,
+			bigMaintenanceMargin,
+		)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, simulatedPosition)
+	}
+
+	return &types.QuerySimulateLiquidationResponse{
+		NetCollateral:                dtypes.NewIntFromBigInt(bigNetCollateral),
+		InitialMarginRequirement:     dtypes.NewIntFromBigInt(bigInitialMargin),
+		MaintenanceMarginRequirement: dtypes.NewIntFromBigInt(bigMaintenanceMargin),
+		FreeCollateral:               dtypes.NewIntFromBigInt(new(big.Int).Sub(bigNetCollateral, bigInitialMargin)),
+		IsLiquidatable:               isLiquidatable,
+		PerpetualPositions:           positions,
+	}, nil
+}
+
+// simulatePerpetualPosition returns the liquidation, bankruptcy and fillable prices of the perpetual
+// position of the subaccount, along with the insurance fund delta of liquidating the entire position
+// at its fillable price. `tncBig` and `tmmrBig` are the total net collateral and total maintenance
+// margin requirement of the subaccount.
+func (k Keeper) simulatePerpetualPosition(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	position *satypes.PerpetualPosition,
+	tncBig *big.Int,
+	tmmrBig *big.Int,
+) (
+	simulatedPosition types.SimulatedPerpetualPosition,
+	err error,
+) {
+	perpetualId := position.PerpetualId
+	psBig := position.GetBigQuantums()
+	isLong := position.GetIsLong()
+
+	simulatedPosition = types.SimulatedPerpetualPosition{
+		PerpetualId:        perpetualId,
+		Quantums:           dtypes.NewIntFromBigInt(psBig),
+		InsuranceFundDelta: dtypes.ZeroInt(),
+	}
+
+	// A subaccount without a maintenance margin requirement can never be liquidated. Prices are also
+	// quoted in the subticks of the clob pair of the perpetual, so they cannot be determined for
+	// perpetuals without a clob pair.
+	if tmmrBig.Sign() == 0 {
+		return simulatedPosition, nil
+	}
+	clobPairId, err := k.GetClobPairIdForPerpetual(ctx, perpetualId)
+	if err != nil {
+		return simulatedPosition, nil
+	}
+	clobPair, found := k.GetClobPair(ctx, clobPairId)
+	if !found {
+		return simulatedPosition, nil
+	}
+
+	liquidationPrice, err := k.getLiquidationPrice(ctx, perpetualId, psBig, tncBig, tmmrBig)
+	if err != nil {
+		return simulatedPosition, err
+	}
+	if liquidationPrice != nil && liquidationPrice.Sign() > 0 {
+		simulatedPosition.LiquidationPriceSubticks = k.ConvertFillablePriceToSubticks(
+			ctx,
+			liquidationPrice,
+			isLong,
+			clobPair,
+		).ToUint64()
+	}
+
+	// The bankruptcy and fillable prices are those of closing the entire position.
+	deltaQuantums := new(big.Int).Neg(psBig)
+
+	bankruptcyPriceQuoteQuantums, err := k.GetBankruptcyPriceInQuoteQuantums(
+		ctx,
+		subaccountId,
+		perpetualId,
+		deltaQuantums,
+	)
+	if err != nil {
+		return simulatedPosition, err
+	}
+	// The bankruptcy price does not exist if the subaccount would remain solvent at any price.
+	bankruptcyPrice := new(big.Rat).SetFrac(bankruptcyPriceQuoteQuantums, psBig)
+	if bankruptcyPrice.Sign() > 0 {
+		simulatedPosition.BankruptcyPriceSubticks = k.ConvertFillablePriceToSubticks(
+			ctx,
+			bankruptcyPrice,
+			isLong,
+			clobPair,
+		).ToUint64()
+	}
+
+	fillablePrice, err := k.GetFillablePrice(ctx, subaccountId, perpetualId, deltaQuantums)
+	if err != nil {
+		return simulatedPosition, err
+	}
+	fillablePriceSubticks := k.ConvertFillablePriceToSubticks(ctx, fillablePrice, isLong, clobPair)
+	simulatedPosition.FillablePriceSubticks = fillablePriceSubticks.ToUint64()
+
+	insuranceFundDelta, err := k.GetLiquidationInsuranceFundDelta(
+		ctx,
+		subaccountId,
+		perpetualId,
+		!isLong,
+		new(big.Int).Abs(psBig).Uint64(),
+		fillablePriceSubticks,
+	)
+	if err != nil {
+		return simulatedPosition, err
+	}
+	simulatedPosition.InsuranceFundDelta = dtypes.NewIntFromBigInt(insuranceFundDelta)
+
+	return simulatedPosition, nil
+}
+
+// getLiquidationPrice returns the oracle price of the perpetual in quote quantums per base quantum
+// at which a subaccount with a position of size `psBig`, total net collateral `tncBig` and total
+// maintenance margin requirement `tmmrBig` becomes liquidatable. It assumes that the net notional
+// and maintenance margin requirement of the position scale linearly with the oracle price and that
+// the prices of all other markets are unchanged. Returns nil if no such price exists.
+func (k Keeper) getLiquidationPrice(
+	ctx sdk.Context,
+	perpetualId uint32,
+	psBig *big.Int,
+	tncBig *big.Int,
+	tmmrBig *big.Int,
+) (
+	liquidationPrice *big.Rat,
+	err error,
+) {
+	// The equation for calculating the liquidation price is the following:
+	// `(PNNV / PS) * (1 - (TNC - TMMR) / (PNNV - PMMR))`.
+	// This follows from scaling the oracle price by `r`, after which the subaccount is liquidatable
+	// once `TNC + (r - 1) * PNNV < TMMR + (r - 1) * PMMR`.
+	pnnvBig, err := k.perpetualsKeeper.GetNetNotional(ctx, perpetualId, psBig)
+	if err != nil {
+		return nil, err
+	}
+
+	_, pmmrBig, err := k.perpetualsKeeper.GetMarginRequirements(ctx, perpetualId, psBig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Changes to the oracle price do not affect the distance to maintenance margin if the net
+	// notional and maintenance margin requirement of the position are equal.
+	pnnvSubPmmrBig := new(big.Int).Sub(pnnvBig, pmmrBig)
+	if pnnvSubPmmrBig.Sign() == 0 {
+		return nil, nil
+	}
+
+	ratioRat := new(big.Rat).Sub(
+		lib.BigRat1(),
+		new(big.Rat).SetFrac(new(big.Int).Sub(tncBig, tmmrBig), pnnvSubPmmrBig),
+	)
+	return ratioRat.Mul(ratioRat, new(big.Rat).SetFrac(pnnvBig, psBig)), nil
+}
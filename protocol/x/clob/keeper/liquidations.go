@@ -15,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -71,6 +72,12 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 				continue
 			}
 
+			// Subaccount might only have positions in perpetuals whose clob pairs do not currently
+			// allow liquidations, for example if the clob pair is paused. If so, continue.
+			if errors.Is(err, types.ErrNoPerpetualPositionsToLiquidate) {
+				continue
+			}
+
 			// Return unexpected errors.
 			return err
 		}
@@ -104,7 +111,8 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 		if err != nil {
 			// Subaccount might not always be liquidatable if previous liquidation orders
 			// improves the net collateral of this subaccount.
-			if errors.Is(err, types.ErrSubaccountNotLiquidatable) {
+			if errors.Is(err, types.ErrSubaccountNotLiquidatable) ||
+				errors.Is(err, types.ErrNoPerpetualPositionsToLiquidate) {
 				continue
 			}
 
@@ -735,6 +743,8 @@ func (k Keeper) GetLiquidationInsuranceFundDelta(
 // GetPerpetualPositionToLiquidate determines which position to liquidate on the
 // passed-in subaccount (after accounting for the `update`). It will return the perpetual id that
 // will be used for liquidating the perpetual position.
+// Positions in perpetuals whose clob pair is not active are skipped, since liquidations are only
+// allowed on active clob pairs.
 // This function returns an error if the subaccount has no perpetual positions to liquidate.
 func (k Keeper) GetPerpetualPositionToLiquidate(
 	ctx sdk.Context,
@@ -755,7 +765,15 @@ func (k Keeper) GetPerpetualPositionToLiquidate(
 			// Note that this could run in O(n^2) time. This is fine for now because we have less than a hundred
 			// perpetuals and only liquidate once per subaccount per block. This means that the position with smallest
 			// id will be liquidated first.
-			if !subaccountLiquidationInfo.HasPerpetualBeenLiquidatedForSubaccount(position.PerpetualId) {
+			if subaccountLiquidationInfo.HasPerpetualBeenLiquidatedForSubaccount(position.PerpetualId) {
+				continue
+			}
+
+			isActive, err := k.IsPerpetualClobPairActive(ctx, position.PerpetualId)
+			if err != nil {
+				return 0, err
+			}
+			if isActive {
 				return position.PerpetualId, nil
 			}
 		}
@@ -1118,7 +1136,7 @@ func (k Keeper) validateMatchedLiquidation(
 
 	// Validate that processing the liquidation fill does not leave insufficient funds
 	// in the insurance fund (such that the liquidation couldn't have possibly continued).
-	if !k.IsValidInsuranceFundDelta(ctx, insuranceFundDelta) {
+	if !k.IsValidInsuranceFundDelta(ctx, insuranceFundDelta, perpetualId) {
 		k.Logger(ctx).Debug("ProcessMatches: insurance fund has insufficient balance to process the liquidation.")
 		return nil, errorsmod.Wrapf(
 			types.ErrInsuranceFundHasInsufficientFunds,
@@ -1215,13 +1233,25 @@ func (k Keeper) validateLiquidationAgainstSubaccountBlockLimits(
 
 // SortLiquidationOrders deterministically sorts the liquidation orders in place.
 // Orders are first ordered by their absolute percentage difference from the oracle price in descending order,
-// followed by the their size in quote quantums in descending order, and finally by order hashes.
+// followed by the collateral haircut of the liquidated subaccount in descending order, then by their size
+// in quote quantums in descending order, and finally by order hashes. Subaccounts with a larger haircut
+// rely more on non-USDC collateral whose value moves with its oracle price, so they are liquidated first.
 func (k Keeper) SortLiquidationOrders(
 	ctx sdk.Context,
 	liquidationOrders []types.LiquidationOrder,
 ) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.SortLiquidationOrders)
 
+	// Compute the collateral haircut of each liquidated subaccount once, since it requires reading the
+	// subaccount and the market prices of its assets from state.
+	subaccountIdToCollateralHaircut := make(map[satypes.SubaccountId]*big.Int, len(liquidationOrders))
+	for _, liquidationOrder := range liquidationOrders {
+		subaccountId := liquidationOrder.GetSubaccountId()
+		if _, exists := subaccountIdToCollateralHaircut[subaccountId]; !exists {
+			subaccountIdToCollateralHaircut[subaccountId] = k.getCollateralHaircutQuoteQuantums(ctx, subaccountId)
+		}
+	}
+
 	sort.Slice(liquidationOrders, func(i, j int) bool {
 		x, y := liquidationOrders[i], liquidationOrders[j]
 
@@ -1232,6 +1262,13 @@ func (k Keeper) SortLiquidationOrders(
 			return xAbsPercentageDiffFromOraclePrice.Cmp(yAbsPercentageDiffFromOraclePrice) == 1
 		}
 
+		// Then sort by the collateral haircut of the liquidated subaccount in descending order.
+		xCollateralHaircut := subaccountIdToCollateralHaircut[x.GetSubaccountId()]
+		yCollateralHaircut := subaccountIdToCollateralHaircut[y.GetSubaccountId()]
+		if xCollateralHaircut.Cmp(yCollateralHaircut) != 0 {
+			return xCollateralHaircut.Cmp(yCollateralHaircut) == 1
+		}
+
 		// Then sort by order quote quantums in descending order.
 		xQuoteQuantums := k.getQuoteQuantumsForLiquidationOrder(ctx, x)
 		yQuoteQuantums := k.getQuoteQuantumsForLiquidationOrder(ctx, y)
@@ -1276,3 +1313,37 @@ func (k Keeper) getQuoteQuantumsForLiquidationOrder(
 	}
 	return quoteQuantums
 }
+
+// getCollateralHaircutQuoteQuantums returns the oracle value in quote quantums of the non-USDC asset
+// positions of the subaccount that is not counted towards its net collateral because of the collateral
+// weights of the assets. Assets without a market do not contribute to the haircut.
+func (k Keeper) getCollateralHaircutQuoteQuantums(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) *big.Int {
+	bigHaircut := big.NewInt(0)
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	for _, assetPosition := range subaccount.AssetPositions {
+		bigQuantums := assetPosition.GetBigQuantums()
+		if assetPosition.AssetId == assettypes.AssetUsdc.Id || bigQuantums.Sign() <= 0 {
+			continue
+		}
+
+		asset, marketPrice, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, assetPosition.AssetId)
+		if err != nil {
+			continue
+		}
+
+		bigQuoteQuantums := lib.BaseToQuoteQuantums(
+			bigQuantums,
+			asset.AtomicResolution,
+			marketPrice.Price,
+			marketPrice.Exponent,
+		)
+		bigHaircut.Add(
+			bigHaircut,
+			bigQuoteQuantums.Sub(bigQuoteQuantums, lib.BigIntMulPpm(bigQuoteQuantums, asset.CollateralWeightPpm)),
+		)
+	}
+	return bigHaircut
+}
@@ -20,6 +20,7 @@ import (
 	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -294,6 +295,9 @@ func TestPlacePerpetualLiquidation(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1038,6 +1042,9 @@ func TestPlacePerpetualLiquidation_PreexistingLiquidation(t *testing.T) {
 					perpetual.Params.AtomicResolution,
 					perpetual.Params.DefaultFundingPpm,
 					perpetual.Params.LiquidityTier,
+					perpetual.Params.FundingRateBounds,
+					perpetual.Params.InterestRatePpm,
+					perpetual.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1887,7 +1894,7 @@ func TestPlacePerpetualLiquidation_Deleveraging(t *testing.T) {
 				mock.Anything,
 				mock.Anything,
 				mock.Anything,
-			).Return(sdk.NewCoin("USDC", sdkmath.NewIntFromUint64(tc.insuranceFundBalance))).Twice()
+			).Return(sdk.NewCoin("USDC", sdkmath.NewIntFromUint64(tc.insuranceFundBalance)))
 
 			mockIndexerEventManager := &mocks.IndexerEventManager{}
 			mockIndexerEventManager.On("Enabled").Return(false)
@@ -1919,6 +1926,9 @@ func TestPlacePerpetualLiquidation_Deleveraging(t *testing.T) {
 					perpetual.Params.AtomicResolution,
 					perpetual.Params.DefaultFundingPpm,
 					perpetual.Params.LiquidityTier,
+					perpetual.Params.FundingRateBounds,
+					perpetual.Params.InterestRatePpm,
+					perpetual.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -2107,6 +2117,7 @@ func TestPlacePerpetualLiquidation_SendOffchainMessages(t *testing.T) {
 func TestIsLiquidatable(t *testing.T) {
 	tests := map[string]struct {
 		// State.
+		assets     []*assettypes.Asset
 		perpetuals []perptypes.Perpetual
 
 		// Subaccount state.
@@ -2176,6 +2187,87 @@ func TestIsLiquidatable(t *testing.T) {
 			),
 			expectedIsLiquidatable: true,
 		},
+		"Subaccount at maintenance margin requirements with weighted collateral is not liquidatable": {
+			assets: []*assettypes.Asset{
+				constants.Usdc,
+				constants.BtcUsd_90PercentCollateralWeight,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+
+			perpetualPositions: []*satypes.PerpetualPosition{
+				{
+					PerpetualId: uint32(0),
+					Quantums:    dtypes.NewInt(10_000_000), // 0.1 BTC, $5,000 notional.
+				},
+			},
+			assetPositions: []*satypes.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(constants.QuoteBalance_OneDollar * -4_950),
+				},
+				{
+					AssetId:  constants.BtcUsd_90PercentCollateralWeight.Id,
+					Quantums: dtypes.NewInt(1_000_000), // 0.01 BTC, $500 notional, $450 collateral.
+				},
+			},
+			expectedIsLiquidatable: false,
+		},
+		"Subaccount below maintenance margin requirements with weighted collateral is liquidatable": {
+			assets: []*assettypes.Asset{
+				constants.Usdc,
+				constants.BtcUsd_90PercentCollateralWeight,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+
+			perpetualPositions: []*satypes.PerpetualPosition{
+				{
+					PerpetualId: uint32(0),
+					Quantums:    dtypes.NewInt(10_000_000), // 0.1 BTC, $5,000 notional.
+				},
+			},
+			assetPositions: []*satypes.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(constants.QuoteBalance_OneDollar * -4_951),
+				},
+				{
+					AssetId:  constants.BtcUsd_90PercentCollateralWeight.Id,
+					Quantums: dtypes.NewInt(1_000_000), // 0.01 BTC, $500 notional, $450 collateral.
+				},
+			},
+			expectedIsLiquidatable: true,
+		},
+		"Subaccount holding an asset with no collateral weight is liquidatable": {
+			assets: []*assettypes.Asset{
+				constants.Usdc,
+				constants.BtcUsd,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+
+			perpetualPositions: []*satypes.PerpetualPosition{
+				{
+					PerpetualId: uint32(0),
+					Quantums:    dtypes.NewInt(10_000_000), // 0.1 BTC, $5,000 notional.
+				},
+			},
+			assetPositions: []*satypes.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(constants.QuoteBalance_OneDollar * -4_950),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(1_000_000), // 0.01 BTC, $500 notional, no collateral.
+				},
+			},
+			expectedIsLiquidatable: true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -2187,6 +2279,22 @@ func TestIsLiquidatable(t *testing.T) {
 			// Create the default markets.
 			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
 
+			// Create all assets.
+			for _, a := range tc.assets {
+				_, err := ks.AssetsKeeper.CreateAsset(
+					ks.Ctx,
+					a.Id,
+					a.Symbol,
+					a.Denom,
+					a.DenomExponent,
+					a.HasMarket,
+					a.MarketId,
+					a.AtomicResolution,
+					a.CollateralWeightPpm,
+				)
+				require.NoError(t, err)
+			}
+
 			// Create liquidity tiers.
 			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
 
@@ -2200,6 +2308,9 @@ func TestIsLiquidatable(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -2619,6 +2730,9 @@ func TestGetBankruptcyPriceInQuoteQuantums(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -3149,6 +3263,9 @@ func TestGetFillablePrice(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -3573,6 +3690,9 @@ func TestGetLiquidationInsuranceFundDelta(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -4228,6 +4348,27 @@ func TestGetPerpetualPositionToLiquidate(t *testing.T) {
 			expectedClobPair: constants.ClobPair_Btc,
 			expectedQuantums: new(big.Int).SetInt64(5_000_000), // 0.05 BTC
 		},
+		`Position in a perpetual whose CLOB pair is paused is skipped`: {
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneTenthBTCLong,
+				&constants.PerpetualPosition_OneTenthEthLong,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			},
+			liquidationConfig: constants.LiquidationsConfig_No_Limit,
+
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_Paused,
+				constants.ClobPair_Eth,
+			},
+
+			expectedClobPair: constants.ClobPair_Eth,
+			expectedQuantums: new(big.Int).Neg(
+				constants.PerpetualPosition_OneTenthEthLong.GetBigQuantums(),
+			),
+		},
 		`Full position size of max uint64 of perpetual and CLOB pair are returned when subaccount
 		has one long perpetual position at max position size`: {
 			perpetualPositions: []*satypes.PerpetualPosition{
@@ -4297,6 +4438,9 @@ func TestGetPerpetualPositionToLiquidate(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -4545,6 +4689,9 @@ func TestMaybeGetLiquidationOrder(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -4868,6 +5015,9 @@ func TestGetMaxAndMinPositionNotionalLiquidatable(t *testing.T) {
 				constants.BtcUsd_100PercentMarginRequirement.Params.AtomicResolution,
 				constants.BtcUsd_100PercentMarginRequirement.Params.DefaultFundingPpm,
 				constants.BtcUsd_100PercentMarginRequirement.Params.LiquidityTier,
+				constants.BtcUsd_100PercentMarginRequirement.Params.FundingRateBounds,
+				constants.BtcUsd_100PercentMarginRequirement.Params.InterestRatePpm,
+				constants.BtcUsd_100PercentMarginRequirement.Params.InsuranceFundScope,
 			)
 			require.NoError(t, err)
 
@@ -4924,6 +5074,10 @@ func TestGetMaxAndMinPositionNotionalLiquidatable(t *testing.T) {
 
 func TestSortLiquidationOrders(t *testing.T) {
 	tests := map[string]struct {
+		// State.
+		assets      []*assettypes.Asset
+		subaccounts []satypes.Subaccount
+
 		orders   []types.LiquidationOrder
 		expected []types.LiquidationOrder
 	}{
@@ -4987,6 +5141,31 @@ func TestSortLiquidationOrders(t *testing.T) {
 				constants.LiquidationOrder_Dave_Num1_Clob0_Sell01BTC_Price50000,
 			},
 		},
+		"Sorts liquidations by collateral haircut before order size in quote quantums": {
+			assets: []*assettypes.Asset{
+				constants.Usdc,
+				constants.BtcUsd_90PercentCollateralWeight,
+			},
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Dave_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.BtcUsd_90PercentCollateralWeight.Id,
+							Quantums: dtypes.NewInt(1_000_000), // 0.01 BTC, $500 notional, $50 haircut.
+						},
+					},
+				},
+			},
+			orders: []types.LiquidationOrder{
+				constants.LiquidationOrder_Carl_Num0_Clob0_Buy1BTC_Price50000,
+				constants.LiquidationOrder_Dave_Num1_Clob0_Sell01BTC_Price50000,
+			},
+			expected: []types.LiquidationOrder{
+				constants.LiquidationOrder_Dave_Num1_Clob0_Sell01BTC_Price50000,
+				constants.LiquidationOrder_Carl_Num0_Clob0_Buy1BTC_Price50000,
+			},
+		},
 		"Sorts liquidations by order hash": {
 			orders: []types.LiquidationOrder{
 				constants.LiquidationOrder_Dave_Num0_Clob0_Sell1BTC_Price50000,
@@ -5009,6 +5188,27 @@ func TestSortLiquidationOrders(t *testing.T) {
 			// Create the default markets.
 			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
 
+			// Create all assets.
+			for _, a := range tc.assets {
+				_, err := ks.AssetsKeeper.CreateAsset(
+					ks.Ctx,
+					a.Id,
+					a.Symbol,
+					a.Denom,
+					a.DenomExponent,
+					a.HasMarket,
+					a.MarketId,
+					a.AtomicResolution,
+					a.CollateralWeightPpm,
+				)
+				require.NoError(t, err)
+			}
+
+			// Create all subaccounts.
+			for _, subaccount := range tc.subaccounts {
+				ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+			}
+
 			// Create liquidity tiers.
 			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
 
@@ -5021,6 +5221,9 @@ func TestSortLiquidationOrders(t *testing.T) {
 				constants.BtcUsd_100PercentMarginRequirement.Params.AtomicResolution,
 				constants.BtcUsd_100PercentMarginRequirement.Params.DefaultFundingPpm,
 				constants.BtcUsd_100PercentMarginRequirement.Params.LiquidityTier,
+				constants.BtcUsd_100PercentMarginRequirement.Params.FundingRateBounds,
+				constants.BtcUsd_100PercentMarginRequirement.Params.InterestRatePpm,
+				constants.BtcUsd_100PercentMarginRequirement.Params.InsuranceFundScope,
 			)
 			require.NoError(t, err)
 
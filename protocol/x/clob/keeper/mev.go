@@ -414,9 +414,14 @@ func (k Keeper) InitializeCumulativePnLs(
 		}
 
 		// Get a mapping from perpetual Id to current perpetual funding index.
-		perpetual, err := perpetualKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
-		if err != nil {
-			panic(perptypes.ErrPerpetualDoesNotExist)
+		// Spot clob pairs have no perpetual and therefore no funding.
+		perpetualFundingIndex := big.NewInt(0)
+		if !clobPair.IsSpotClobPair() {
+			perpetual, err := perpetualKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
+			if err != nil {
+				panic(perptypes.ErrPerpetualDoesNotExist)
+			}
+			perpetualFundingIndex = perpetual.FundingIndex.BigInt()
 		}
 
 		for _, cumulativePnL := range []map[types.ClobPairId]*CumulativePnL{
@@ -430,7 +435,7 @@ func (k Keeper) InitializeCumulativePnLs(
 				VolumeQuoteQuantums:         big.NewInt(0),
 				ClobPair:                    clobPair,
 				MidPriceSubticks:            midPriceSubticks,
-				PerpetualFundingIndex:       perpetual.FundingIndex.BigInt(),
+				PerpetualFundingIndex:       new(big.Int).Set(perpetualFundingIndex),
 			}
 		}
 	}
@@ -465,9 +470,20 @@ func (k Keeper) GetMEVDataFromOperations(
 			if err != nil {
 				return nil, err
 			}
-			msgPlaceOrder := tx.GetMsgs()[0].(*types.MsgPlaceOrder)
+			// Short-Term order replacements are proposed as placements of the new version of the order.
+			msgPlaceOrder, _ := types.GetMsgPlaceOrder(tx.GetMsgs()[0])
 			order := msgPlaceOrder.Order
 			placedShortTermOrders[order.GetOrderId()] = order
+		case *types.OperationRaw_ShortTermOrderBatchPlacement:
+			// Decode the short-term order placed within the batch for subsequent lookups.
+			batchPlacement := typedOperation.ShortTermOrderBatchPlacement
+			tx, err := k.txDecoder(batchPlacement.TxBytes)
+			if err != nil {
+				return nil, err
+			}
+			msgBatch := tx.GetMsgs()[0].(*types.MsgBatchPlaceAndCancel)
+			order := msgBatch.Places[batchPlacement.Index].Order
+			placedShortTermOrders[order.GetOrderId()] = order
 		case *types.OperationRaw_Match:
 			switch match := typedOperation.Match.Match.(type) {
 			case *types.ClobMatch_MatchOrders:
@@ -671,9 +687,20 @@ func (k Keeper) CalculateSubaccountPnLForMatches(
 			if err != nil {
 				return err
 			}
-			msgPlaceOrder := tx.GetMsgs()[0].(*types.MsgPlaceOrder)
+			// Short-Term order replacements are proposed as placements of the new version of the order.
+			msgPlaceOrder, _ := types.GetMsgPlaceOrder(tx.GetMsgs()[0])
 			order := msgPlaceOrder.Order
 			placedShortTermOrders[order.GetOrderId()] = order
+		case *types.OperationRaw_ShortTermOrderBatchPlacement:
+			// Collect all the short-term orders placed within batches for subsequent lookups.
+			batchPlacement := typedOperation.ShortTermOrderBatchPlacement
+			tx, err := k.txDecoder(batchPlacement.TxBytes)
+			if err != nil {
+				return err
+			}
+			msgBatch := tx.GetMsgs()[0].(*types.MsgBatchPlaceAndCancel)
+			order := msgBatch.Places[batchPlacement.Index].Order
+			placedShortTermOrders[order.GetOrderId()] = order
 		case *types.OperationRaw_Match:
 			switch match := typedOperation.Match.Match.(type) {
 			case *types.ClobMatch_MatchOrders:
@@ -753,6 +780,11 @@ func (k Keeper) AddSettlementForPositionDelta(
 	clobPairToPnLs map[types.ClobPairId]*CumulativePnL,
 ) (err error) {
 	for _, cumulativePnL := range clobPairToPnLs {
+		// Spot clob pairs have no perpetual and therefore no funding settlement.
+		if cumulativePnL.ClobPair.IsSpotClobPair() {
+			continue
+		}
+
 		perpetualId := cumulativePnL.ClobPair.MustGetPerpetualId()
 		for subaccountId, deltaQuantums := range cumulativePnL.SubaccountPositionSizeDelta {
 			// Get the subaccount and its perpetual positions.
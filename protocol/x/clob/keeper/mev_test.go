@@ -879,6 +879,9 @@ func TestRecordMevMetrics(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1220,6 +1223,9 @@ func TestGetMidPrices(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// BatchPlaceAndCancel is the entry point for `MsgBatchPlaceAndCancel` messages executed in `runMsgs` during
+// `DeliverTx`. Batches only contain Short-Term orders which are processed in the ante handler during `CheckTx`,
+// and transactions containing them are not allowed in blocks, so this handler always returns an error.
+func (k msgServer) BatchPlaceAndCancel(
+	goCtx context.Context,
+	msg *types.MsgBatchPlaceAndCancel,
+) (*types.MsgBatchPlaceAndCancelResponse, error) {
+	return nil, errorsmod.Wrap(
+		types.ErrInvalidBatchPlaceAndCancel,
+		"MsgBatchPlaceAndCancel may only be processed during CheckTx",
+	)
+}
@@ -129,6 +129,9 @@ func TestPlaceOrder_Error(t *testing.T) {
 				perpetual.Params.AtomicResolution,
 				perpetual.Params.DefaultFundingPpm,
 				perpetual.Params.LiquidityTier,
+				perpetual.Params.FundingRateBounds,
+				perpetual.Params.InterestRatePpm,
+				perpetual.Params.InsuranceFundScope,
 			)
 			require.NoError(t, err)
 
@@ -274,6 +277,9 @@ func TestPlaceOrder_Success(t *testing.T) {
 				perpetual.Params.AtomicResolution,
 				perpetual.Params.DefaultFundingPpm,
 				perpetual.Params.LiquidityTier,
+				perpetual.Params.FundingRateBounds,
+				perpetual.Params.InterestRatePpm,
+				perpetual.Params.InsuranceFundScope,
 			)
 			require.NoError(t, err)
 
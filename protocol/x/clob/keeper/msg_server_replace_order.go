@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	errorlib "github.com/dydxprotocol/v4-chain/protocol/lib/error"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// ReplaceOrder is the entry point for stateful `MsgReplaceOrder` messages executed in `runMsgs` during `DeliverTx`.
+// Short-Term order replacements are processed in the ante handler during `CheckTx`, and transactions containing
+// them are not allowed in blocks, so this handler returns an error for Short-Term orders.
+func (k msgServer) ReplaceOrder(goCtx context.Context, msg *types.MsgReplaceOrder) (
+	resp *types.MsgReplaceOrderResponse,
+	err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.ReplaceOrder,
+			metrics.DeliverTx,
+			msg.Order.GetOrderLabels()...,
+		)
+		if err != nil {
+			if errors.Is(err, types.ErrStatefulOrderCollateralizationCheckFailed) {
+				telemetry.IncrCounterWithLabels(
+					[]string{
+						types.ModuleName,
+						metrics.ReplaceOrder,
+						metrics.CollateralizationCheckFailed,
+					},
+					1,
+					msg.Order.GetOrderLabels(),
+				)
+				k.Keeper.Logger(ctx).Info(
+					err.Error(),
+					metrics.BlockHeight, ctx.BlockHeight(),
+					metrics.Handler, "ReplaceOrder",
+					metrics.Callback, metrics.DeliverTx,
+					metrics.Msg, msg,
+				)
+				return
+			}
+			errorlib.LogDeliverTxError(k.Keeper.Logger(ctx), err, ctx.BlockHeight(), "ReplaceOrder", msg)
+		}
+	}()
+
+	// 1. Ensure the order is a Long-Term order.
+	order := msg.GetOrder()
+	if !order.IsLongTermOrder() {
+		return nil, errorsmod.Wrap(
+			types.ErrInvalidReplacementOrder,
+			"Short-Term order replacements may only be processed during CheckTx",
+		)
+	}
+
+	// 2. Return an error if an associated cancellation or removal already exists in the current block.
+	processProposerMatchesEvents := k.Keeper.GetProcessProposerMatchesEvents(ctx)
+	cancelledOrderIds := lib.UniqueSliceToSet(processProposerMatchesEvents.PlacedStatefulCancellationOrderIds)
+	if _, found := cancelledOrderIds[order.GetOrderId()]; found {
+		return nil, errorsmod.Wrapf(
+			types.ErrStatefulOrderPreviouslyCancelled,
+			"ReplaceOrder: order (%+v)",
+			order,
+		)
+	}
+	removedOrderIds := lib.UniqueSliceToSet(processProposerMatchesEvents.RemovedStatefulOrderIds)
+	if _, found := removedOrderIds[order.GetOrderId()]; found {
+		return nil, errorsmod.Wrapf(
+			types.ErrStatefulOrderPreviouslyRemoved,
+			"ReplaceOrder: order (%+v)",
+			order,
+		)
+	}
+
+	// 3. Replace the order on the ClobKeeper which is responsible for:
+	//   - replacement and stateful order validation.
+	//   - collateralization check.
+	//   - writing the replacement order to state and the memstore.
+	if err := k.Keeper.ReplaceStatefulOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// 4. Emit the order replacement indexer event.
+	k.Keeper.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewLongTermOrderReplacementEvent(
+				order,
+			),
+		),
+	)
+
+	// 5. Add the replaced stateful order to `ProcessProposerMatchesEvents` for use in `PrepareCheckState`.
+	processProposerMatchesEvents.ReplacedLongTermOrderIds = append(
+		processProposerMatchesEvents.ReplacedLongTermOrderIds,
+		order.OrderId,
+	)
+	k.Keeper.MustSetProcessProposerMatchesEvents(
+		ctx,
+		processProposerMatchesEvents,
+	)
+
+	return &types.MsgReplaceOrderResponse{}, nil
+}
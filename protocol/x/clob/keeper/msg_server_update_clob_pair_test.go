@@ -102,6 +102,138 @@ func TestMsgServerUpdateClobPair(t *testing.T) {
 			},
 			expectedErr: types.ErrInvalidClobPairStatusTransition,
 		},
+		"Success: transition from active to paused": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_PAUSED),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_ACTIVE,
+				types.ClobPair_STATUS_PAUSED,
+				true,
+			),
+			expectedResp: &types.MsgUpdateClobPairResponse{},
+		},
+		"Success: transition from active to cancel-only": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_CANCEL_ONLY),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_ACTIVE,
+				types.ClobPair_STATUS_CANCEL_ONLY,
+				true,
+			),
+			expectedResp: &types.MsgUpdateClobPairResponse{},
+		},
+		"Success: transition from active to post-only": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_POST_ONLY),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_ACTIVE,
+				types.ClobPair_STATUS_POST_ONLY,
+				true,
+			),
+			expectedResp: &types.MsgUpdateClobPairResponse{},
+		},
+		"Success: transition from paused to active": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_ACTIVE),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_PAUSED,
+				types.ClobPair_STATUS_ACTIVE,
+				true,
+			),
+			expectedResp: &types.MsgUpdateClobPairResponse{},
+		},
+		"Success: transition from paused to cancel-only": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_CANCEL_ONLY),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_PAUSED,
+				types.ClobPair_STATUS_CANCEL_ONLY,
+				true,
+			),
+			expectedResp: &types.MsgUpdateClobPairResponse{},
+		},
+		"Success: transition from cancel-only to post-only": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_POST_ONLY),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_CANCEL_ONLY,
+				types.ClobPair_STATUS_POST_ONLY,
+				true,
+			),
+			expectedResp: &types.MsgUpdateClobPairResponse{},
+		},
+		"Success: transition from post-only to active": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_ACTIVE),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_POST_ONLY,
+				types.ClobPair_STATUS_ACTIVE,
+				true,
+			),
+			expectedResp: &types.MsgUpdateClobPairResponse{},
+		},
+		"Error: unsupported status transition from paused to initializing": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_INITIALIZING),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_PAUSED,
+				types.ClobPair_STATUS_INITIALIZING,
+				false,
+			),
+			expectedErr: types.ErrInvalidClobPairStatusTransition,
+		},
+		"Error: unsupported status transition from cancel-only to initializing": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_INITIALIZING),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_CANCEL_ONLY,
+				types.ClobPair_STATUS_INITIALIZING,
+				false,
+			),
+			expectedErr: types.ErrInvalidClobPairStatusTransition,
+		},
+		"Error: unsupported status transition from initializing to paused": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_PAUSED),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_INITIALIZING,
+				types.ClobPair_STATUS_PAUSED,
+				false,
+			),
+			expectedErr: types.ErrInvalidClobPairStatusTransition,
+		},
+		"Error: unsupported status transition from initializing to post-only": {
+			msg: &types.MsgUpdateClobPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPair:  clobPairWithStatus(types.ClobPair_STATUS_POST_ONLY),
+			},
+			setup: setupClobPairStatusTransition(
+				types.ClobPair_STATUS_INITIALIZING,
+				types.ClobPair_STATUS_POST_ONLY,
+				false,
+			),
+			expectedErr: types.ErrInvalidClobPairStatusTransition,
+		},
 		"Panic: clob pair not found": {
 			msg: &types.MsgUpdateClobPair{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -289,3 +421,44 @@ func TestMsgServerUpdateClobPair(t *testing.T) {
 		})
 	}
 }
+
+// clobPairWithStatus returns the BTC clob pair with the given status.
+func clobPairWithStatus(status types.ClobPair_Status) types.ClobPair {
+	clobPair := constants.ClobPair_Btc
+	clobPair.Status = status
+	return clobPair
+}
+
+// setupClobPairStatusTransition returns a test setup that writes the BTC clob pair to state with status
+// `from`, and expects an update event for status `to` if the transition is expected to succeed.
+func setupClobPairStatusTransition(
+	from types.ClobPair_Status,
+	to types.ClobPair_Status,
+	expectSuccess bool,
+) func(ks keepertest.ClobKeepersTestContext, mockIndexerEventManager *mocks.IndexerEventManager) {
+	return func(ks keepertest.ClobKeepersTestContext, mockIndexerEventManager *mocks.IndexerEventManager) {
+		registry := codectypes.NewInterfaceRegistry()
+		cdc := codec.NewProtoCodec(registry)
+		store := prefix.NewStore(ks.Ctx.KVStore(ks.StoreKey), []byte(types.ClobPairKeyPrefix))
+		clobPair := clobPairWithStatus(from)
+		b := cdc.MustMarshal(&clobPair)
+		store.Set(lib.Uint32ToKey(clobPair.Id), b)
+
+		if expectSuccess {
+			mockIndexerEventManager.On("AddTxnEvent",
+				ks.Ctx,
+				indexerevents.SubtypeUpdateClobPair,
+				indexerevents.UpdateClobPairEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewUpdateClobPairEvent(
+						clobPair.GetClobPairId(),
+						to,
+						clobPair.QuantumConversionExponent,
+						types.SubticksPerTick(clobPair.GetSubticksPerTick()),
+						satypes.BaseQuantums(clobPair.GetStepBaseQuantums()),
+					),
+				),
+			).Once().Return()
+		}
+	}
+}
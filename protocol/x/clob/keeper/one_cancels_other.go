@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// SetOneCancelsOtherOrderIds pairs two stateful orders as one-cancels-other orders in state.
+// The pair is stored in both directions so that it can be looked up from either order.
+func (k Keeper) SetOneCancelsOtherOrderIds(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	otherOrderId types.OrderId,
+) {
+	orderId.MustBeStatefulOrder()
+	otherOrderId.MustBeStatefulOrder()
+
+	store := k.getOneCancelsOtherStore(ctx)
+	store.Set(orderId.ToStateKey(), k.cdc.MustMarshal(&otherOrderId))
+	store.Set(otherOrderId.ToStateKey(), k.cdc.MustMarshal(&orderId))
+}
+
+// GetOneCancelsOtherOrderId returns the order ID of the stateful order paired with `orderId`
+// as a one-cancels-other order. Returns false if `orderId` is not paired with another order.
+func (k Keeper) GetOneCancelsOtherOrderId(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) (otherOrderId types.OrderId, found bool) {
+	store := k.getOneCancelsOtherStore(ctx)
+
+	b := store.Get(orderId.ToStateKey())
+	if b == nil {
+		return otherOrderId, false
+	}
+
+	k.cdc.MustUnmarshal(b, &otherOrderId)
+	return otherOrderId, true
+}
+
+// DeleteOneCancelsOtherOrderIds removes the one-cancels-other pair containing `orderId` from state.
+// This function is a no-op if `orderId` is not paired with another order.
+func (k Keeper) DeleteOneCancelsOtherOrderIds(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) {
+	otherOrderId, found := k.GetOneCancelsOtherOrderId(ctx, orderId)
+	if !found {
+		return
+	}
+
+	store := k.getOneCancelsOtherStore(ctx)
+	store.Delete(orderId.ToStateKey())
+	store.Delete(otherOrderId.ToStateKey())
+}
+
+// MaybeRemoveOneCancelsOtherOrder removes the stateful order paired with `orderId` from state if one
+// exists, and emits an indexer event for the removal. This is called when `orderId` is triggered or
+// filled. Returns the order ID of the removed order and whether an order was removed.
+// Note that the removed order must additionally be removed from the memclob and, if it is an
+// untriggered conditional order, from `UntriggeredConditionalOrders`.
+func (k Keeper) MaybeRemoveOneCancelsOtherOrder(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) (otherOrderId types.OrderId, removed bool) {
+	otherOrderId, found := k.GetOneCancelsOtherOrderId(ctx, orderId)
+	if !found {
+		return otherOrderId, false
+	}
+
+	// The pair is removed from state along with the other order's placement.
+	k.MustRemoveStatefulOrder(ctx, otherOrderId)
+
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				otherOrderId,
+				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER,
+			),
+		),
+	)
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, metrics.OneCancelsOther, metrics.StatefulOrderRemoved, metrics.Count},
+		1,
+		otherOrderId.GetOrderIdLabels(),
+	)
+
+	return otherOrderId, true
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSetDeleteOneCancelsOtherOrderIds(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	orderId := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId
+	otherOrderId := constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId
+
+	// Neither order is paired initially.
+	_, found := ks.ClobKeeper.GetOneCancelsOtherOrderId(ks.Ctx, orderId)
+	require.False(t, found)
+	_, found = ks.ClobKeeper.GetOneCancelsOtherOrderId(ks.Ctx, otherOrderId)
+	require.False(t, found)
+
+	// The pair can be looked up from either order.
+	ks.ClobKeeper.SetOneCancelsOtherOrderIds(ks.Ctx, orderId, otherOrderId)
+	gotOrderId, found := ks.ClobKeeper.GetOneCancelsOtherOrderId(ks.Ctx, orderId)
+	require.True(t, found)
+	require.Equal(t, otherOrderId, gotOrderId)
+	gotOrderId, found = ks.ClobKeeper.GetOneCancelsOtherOrderId(ks.Ctx, otherOrderId)
+	require.True(t, found)
+	require.Equal(t, orderId, gotOrderId)
+
+	// Deleting the pair from either order removes both directions.
+	ks.ClobKeeper.DeleteOneCancelsOtherOrderIds(ks.Ctx, otherOrderId)
+	_, found = ks.ClobKeeper.GetOneCancelsOtherOrderId(ks.Ctx, orderId)
+	require.False(t, found)
+	_, found = ks.ClobKeeper.GetOneCancelsOtherOrderId(ks.Ctx, otherOrderId)
+	require.False(t, found)
+
+	// Deleting a non-existent pair is a no-op.
+	ks.ClobKeeper.DeleteOneCancelsOtherOrderIds(ks.Ctx, orderId)
+}
+
+func TestMaybeRemoveOneCancelsOtherOrder(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+	order := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+	otherOrder := constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10
+
+	// An order that is not paired does not remove anything.
+	_, removed := ks.ClobKeeper.MaybeRemoveOneCancelsOtherOrder(ks.Ctx, order.OrderId)
+	require.False(t, removed)
+
+	ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(ks.Ctx, order.MustGetUnixGoodTilBlockTime(), order.OrderId)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, order, uint32(1))
+	ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(
+		ks.Ctx,
+		otherOrder.MustGetUnixGoodTilBlockTime(),
+		otherOrder.OrderId,
+	)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, otherOrder, uint32(1))
+	ks.ClobKeeper.SetOneCancelsOtherOrderIds(ks.Ctx, order.OrderId, otherOrder.OrderId)
+
+	indexerEventManager.On("AddTxnEvent",
+		ks.Ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				otherOrder.OrderId,
+				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_ONE_CANCELS_OTHER,
+			),
+		),
+	).Once().Return()
+
+	removedOrderId, removed := ks.ClobKeeper.MaybeRemoveOneCancelsOtherOrder(ks.Ctx, order.OrderId)
+	require.True(t, removed)
+	require.Equal(t, otherOrder.OrderId, removedOrderId)
+	indexerEventManager.AssertExpectations(t)
+
+	// The other order and the pair are removed from state, and the order itself is untouched.
+	_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, otherOrder.OrderId)
+	require.False(t, found)
+	_, found = ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, order.OrderId)
+	require.True(t, found)
+	_, found = ks.ClobKeeper.GetOneCancelsOtherOrderId(ks.Ctx, order.OrderId)
+	require.False(t, found)
+}
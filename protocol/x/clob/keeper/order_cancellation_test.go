@@ -167,8 +167,10 @@ func TestPerformOrderCancellationStatefulValidation(t *testing.T) {
 
 	tests := map[string]struct {
 		setupDeliverTxState func(ctx sdk.Context, k *keeper.Keeper)
-		msgCancelOrder      *types.MsgCancelOrder
-		expectedErr         string
+		// Status of the clob pair, defaults to active if unset.
+		clobPairStatus types.ClobPair_Status
+		msgCancelOrder *types.MsgCancelOrder
+		expectedErr    string
 	}{
 		"short-term cancellation succeeds with a GoodTilBlock of blockHeight": {
 			msgCancelOrder: &types.MsgCancelOrder{
@@ -193,6 +195,43 @@ func TestPerformOrderCancellationStatefulValidation(t *testing.T) {
 			},
 			expectedErr: types.ErrHeightExceedsGoodTilBlock.Error(),
 		},
+		"short-term cancellation fails for a paused clob pair": {
+			clobPairStatus: types.ClobPair_STATUS_PAUSED,
+			msgCancelOrder: &types.MsgCancelOrder{
+				OrderId: types.OrderId{
+					ClientId:     0,
+					SubaccountId: constants.Alice_Num0,
+					OrderFlags:   types.OrderIdFlags_ShortTerm,
+					ClobPairId:   uint32(0),
+				},
+				GoodTilOneof: &types.MsgCancelOrder_GoodTilBlock{GoodTilBlock: blockHeight},
+			},
+			expectedErr: "is invalid for clob pair with status",
+		},
+		"short-term cancellation succeeds for a cancel-only clob pair": {
+			clobPairStatus: types.ClobPair_STATUS_CANCEL_ONLY,
+			msgCancelOrder: &types.MsgCancelOrder{
+				OrderId: types.OrderId{
+					ClientId:     0,
+					SubaccountId: constants.Alice_Num0,
+					OrderFlags:   types.OrderIdFlags_ShortTerm,
+					ClobPairId:   uint32(0),
+				},
+				GoodTilOneof: &types.MsgCancelOrder_GoodTilBlock{GoodTilBlock: blockHeight},
+			},
+		},
+		"short-term cancellation succeeds for a post-only clob pair": {
+			clobPairStatus: types.ClobPair_STATUS_POST_ONLY,
+			msgCancelOrder: &types.MsgCancelOrder{
+				OrderId: types.OrderId{
+					ClientId:     0,
+					SubaccountId: constants.Alice_Num0,
+					OrderFlags:   types.OrderIdFlags_ShortTerm,
+					ClobPairId:   uint32(0),
+				},
+				GoodTilOneof: &types.MsgCancelOrder_GoodTilBlock{GoodTilBlock: blockHeight},
+			},
+		},
 		"short-term cancellation fails with a GoodTilBlock exceeds ShortBlockWindow from block height": {
 			msgCancelOrder: &types.MsgCancelOrder{
 				OrderId: types.OrderId{
@@ -405,6 +444,10 @@ func TestPerformOrderCancellationStatefulValidation(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
+			clobPairStatus := types.ClobPair_STATUS_ACTIVE
+			if tc.clobPairStatus != types.ClobPair_STATUS_UNSPECIFIED {
+				clobPairStatus = tc.clobPairStatus
+			}
 			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() cmt.GenesisDoc {
 				genesis := testapp.DefaultGenesis()
 				testapp.UpdateGenesisDocWithAppStateForModule(&genesis, func(state *types.GenesisState) {
@@ -415,7 +458,7 @@ func TestPerformOrderCancellationStatefulValidation(t *testing.T) {
 									PerpetualId: 0,
 								},
 							},
-							Status:           types.ClobPair_STATUS_ACTIVE,
+							Status:           clobPairStatus,
 							StepBaseQuantums: 12,
 							SubticksPerTick:  39,
 						},
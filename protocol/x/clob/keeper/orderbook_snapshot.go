@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// orderbookSnapshot is an immutable copy of the in-memory orderbooks which is used to serve queries.
+// The memclob may only be accessed while holding the ABCI lock, while gRPC queries are served
+// concurrently without it, so queries read the latest snapshot instead of the memclob.
+type orderbookSnapshot struct {
+	// Price levels of each orderbook, sorted from the best to the worst price.
+	bids map[types.ClobPairId][]types.OrderbookLevel
+	asks map[types.ClobPairId][]types.OrderbookLevel
+
+	// Orders resting on the orderbooks for each subaccount, sorted by ClobPair id and then by order id.
+	subaccountOrders map[satypes.SubaccountId][]types.Order
+
+	// Ids of all orders resting on the orderbooks.
+	restingOrderIds map[types.OrderId]struct{}
+}
+
+// UpdateOrderbookSnapshot replaces the snapshot of the in-memory orderbooks served by queries with the
+// current state of the memclob. This is called at the end of `PrepareCheckState`, while the ABCI lock
+// is held, so queries observe the orderbooks as of the last committed block. Since this copies every
+// resting order, it is only called if orderbook queries are enabled with the `orderbook-queries-enabled`
+// flag.
+func (k Keeper) UpdateOrderbookSnapshot(ctx sdk.Context) {
+	lib.AssertCheckTxMode(ctx)
+
+	snapshot := &orderbookSnapshot{
+		bids:             make(map[types.ClobPairId][]types.OrderbookLevel),
+		asks:             make(map[types.ClobPairId][]types.OrderbookLevel),
+		subaccountOrders: make(map[satypes.SubaccountId][]types.Order),
+		restingOrderIds:  make(map[types.OrderId]struct{}),
+	}
+	for _, clobPair := range k.GetAllClobPairs(ctx) {
+		clobPairId := clobPair.GetClobPairId()
+		snapshot.bids[clobPairId], snapshot.asks[clobPairId] = k.MemClob.GetOrderbookDepth(ctx, clobPairId, 0)
+
+		orders := k.MemClob.GetOpenOrders(ctx, clobPairId)
+		orderIds := make([]types.OrderId, 0, len(orders))
+		ordersById := make(map[types.OrderId]types.Order, len(orders))
+		for _, order := range orders {
+			orderIds = append(orderIds, order.OrderId)
+			ordersById[order.OrderId] = order
+			snapshot.restingOrderIds[order.OrderId] = struct{}{}
+		}
+
+		// Orders of each subaccount are sorted by order id within each ClobPair, and ClobPairs are
+		// iterated in ascending order.
+		sort.Sort(types.SortedOrders(orderIds))
+		for _, orderId := range orderIds {
+			snapshot.subaccountOrders[orderId.SubaccountId] = append(
+				snapshot.subaccountOrders[orderId.SubaccountId],
+				ordersById[orderId],
+			)
+		}
+	}
+
+	k.latestOrderbookSnapshot.Store(snapshot)
+}
+
+// getOrderbookSnapshot returns the latest snapshot of the in-memory orderbooks. An empty snapshot is
+// returned if no snapshot has been taken yet, and an `Unavailable` error is returned if orderbook
+// queries are not enabled.
+func (k Keeper) getOrderbookSnapshot() (*orderbookSnapshot, error) {
+	if !k.Flags.OrderbookQueriesEnabled {
+		return nil, status.Error(codes.Unavailable, "orderbook queries are not enabled")
+	}
+	if snapshot := k.latestOrderbookSnapshot.Load(); snapshot != nil {
+		return snapshot, nil
+	}
+	return &orderbookSnapshot{}, nil
+}
+
+// getOrderbookDepth returns at most `maxLevels` price levels of each side of the orderbook from the
+// snapshot, or all levels if `maxLevels` is zero.
+func (s *orderbookSnapshot) getOrderbookDepth(
+	clobPairId types.ClobPairId,
+	maxLevels uint32,
+) (
+	bids []types.OrderbookLevel,
+	asks []types.OrderbookLevel,
+) {
+	truncate := func(levels []types.OrderbookLevel) []types.OrderbookLevel {
+		if maxLevels != 0 && uint32(len(levels)) > maxLevels {
+			levels = levels[:maxLevels]
+		}
+		return append([]types.OrderbookLevel{}, levels...)
+	}
+	return truncate(s.bids[clobPairId]), truncate(s.asks[clobPairId])
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"sync"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOrderbookQueries_ConcurrentWithOrderPlacement queries the in-memory orderbooks from several
+// goroutines while orders are placed on the memclob, as gRPC queries are served concurrently with
+// `CheckTx` and `PrepareCheckState`. This test is only meaningful when run with the race detector.
+func TestOrderbookQueries_ConcurrentWithOrderPlacement(t *testing.T) {
+	numOrders := 50
+	numQueriers := 4
+
+	// Setup keeper state.
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockBankKeeper := &mocks.BankKeeper{}
+	mockBankKeeper.On(
+		"SendCoinsFromModuleToModule",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, mockBankKeeper, indexer_manager.NewIndexerEventManagerNoop())
+	ks.ClobKeeper.Flags.OrderbookQueriesEnabled = true
+	ctx := ks.Ctx.WithIsCheckTx(true)
+	keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+	require.NoError(t, ks.FeeTiersKeeper.SetPerpetualFeeParams(ctx, constants.PerpetualFeeParamsNoFee))
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper))
+
+	perpetual := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+	_, err := ks.PerpetualsKeeper.CreatePerpetual(
+		ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.AtomicResolution,
+		perpetual.Params.DefaultFundingPpm,
+		perpetual.Params.LiquidityTier,
+		perpetual.Params.FundingRateBounds,
+		perpetual.Params.InterestRatePpm,
+		perpetual.Params.InsuranceFundScope,
+	)
+	require.NoError(t, err)
+	ks.SubaccountsKeeper.SetSubaccount(ctx, constants.Carl_Num0_100000USD)
+
+	clobPair := constants.ClobPair_Btc
+	_, err = ks.ClobKeeper.CreatePerpetualClobPair(
+		ctx,
+		clobPair.Id,
+		clobtest.MustPerpetualId(clobPair),
+		satypes.BaseQuantums(clobPair.StepBaseQuantums),
+		clobPair.QuantumConversionExponent,
+		clobPair.SubticksPerTick,
+		clobPair.Status,
+	)
+	require.NoError(t, err)
+
+	// Commit the state so that queries read an immutable version of it, like gRPC queries do, while
+	// orders are placed on a branch of the latest state.
+	commitMultiStore, ok := ctx.MultiStore().(storetypes.CommitMultiStore)
+	require.True(t, ok)
+	version := commitMultiStore.Commit().Version
+	checkCtx, _ := ctx.CacheContext()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < numQueriers; i++ {
+		queryStore, err := commitMultiStore.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+		queryCtx := sdk.WrapSDKContext(ctx.WithMultiStore(queryStore))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				_, err := ks.ClobKeeper.OrderbookDepth(
+					queryCtx,
+					&types.QueryOrderbookDepthRequest{ClobPairId: clobPair.Id},
+				)
+				require.NoError(t, err)
+				_, err = ks.ClobKeeper.SubaccountOpenOrders(
+					queryCtx,
+					&types.QuerySubaccountOpenOrdersRequest{
+						Owner:  constants.Carl_Num0.Owner,
+						Number: constants.Carl_Num0.Number,
+					},
+				)
+				require.NoError(t, err)
+				_, err = ks.ClobKeeper.OrderFillState(
+					queryCtx,
+					&types.QueryOrderFillStateRequest{
+						OrderId: constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId,
+					},
+				)
+				require.NoError(t, err)
+			}
+		}()
+	}
+
+	for i := 0; i < numOrders; i++ {
+		order := constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10
+		order.OrderId.ClientId = uint32(i)
+		order.Quantums = 100_000
+		_, _, err := ks.ClobKeeper.PlaceShortTermOrder(checkCtx, types.NewMsgPlaceOrder(order))
+		require.NoError(t, err)
+		ks.ClobKeeper.UpdateOrderbookSnapshot(checkCtx)
+	}
+	close(done)
+	wg.Wait()
+
+	// Queries observe all orders once the snapshot has been updated.
+	response, err := ks.ClobKeeper.SubaccountOpenOrders(
+		sdk.WrapSDKContext(checkCtx),
+		&types.QuerySubaccountOpenOrdersRequest{
+			Owner:  constants.Carl_Num0.Owner,
+			Number: constants.Carl_Num0.Number,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, response.OpenOrders, numOrders)
+	depth, err := ks.ClobKeeper.OrderbookDepth(
+		sdk.WrapSDKContext(checkCtx),
+		&types.QueryOrderbookDepthRequest{ClobPairId: clobPair.Id},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]types.OrderbookLevel{
+			{
+				Subticks:  constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.Subticks,
+				Quantums:  uint64(numOrders) * 100_000,
+				NumOrders: uint32(numOrders),
+			},
+		},
+		depth.Bids,
+	)
+}
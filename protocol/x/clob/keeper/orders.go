@@ -14,7 +14,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -276,6 +277,9 @@ func (k Keeper) PlaceStatefulOrder(
 			order.MustGetUnixGoodTilBlockTime(),
 			order.GetOrderId(),
 		)
+		if order.IsOneCancelsOtherOrder() {
+			k.SetOneCancelsOtherOrderIds(ctx, order.OrderId, *order.OneCancelsOtherOrderId)
+		}
 	} else {
 		// Write the stateful order to a transient store. PerformStatefulOrderValidation will ensure that the order does
 		// not exist which will prevent MustAddUncommittedStatefulOrderPlacement from panicking.
@@ -288,7 +292,8 @@ func (k Keeper) PlaceStatefulOrder(
 	return nil
 }
 
-// ReplayPlaceOrder returns the result of calling `PlaceOrder` on the memclob.
+// ReplayPlaceOrder returns the result of calling `PlaceOrder` on the memclob, or `ReplaceOrder` if the
+// order is a Short-Term order that was placed with a `MsgReplaceOrder`.
 // This method does not forward events directly to indexer, but instead returns
 // them in the form of `OffchainUpdates`. This method is meant to be used in the
 // `ReplayOperations` flow, where we replay Short-Term and newly-played stateful
@@ -318,6 +323,18 @@ func (k Keeper) ReplayPlaceOrder(
 		return 0, 0, nil, err
 	}
 
+	// If the Short-Term order was placed as part of a batch, record its index within the batch so the
+	// memclob proposes the order placement with the batch's TX bytes.
+	if order.IsShortTermOrder() {
+		ctx = k.maybeWithShortTermOrderBatchIndex(ctx, order)
+	}
+
+	// If the order was placed with a `MsgReplaceOrder`, replace the order on the memclob so that
+	// the order retains its queue priority where possible.
+	if k.isShortTermOrderReplacement(ctx, order) {
+		return k.MemClob.ReplaceOrder(ctx, msg.Order)
+	}
+
 	// Place the order on the memclob and return the result.
 	orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err = k.MemClob.PlaceOrder(
 		ctx,
@@ -384,7 +401,7 @@ func (k Keeper) placeOrder(
 	// Send off-chain updates generated from placing the order. `SendOffchainData` enqueues the
 	// the messages to be sent in a channel and should be non-blocking.
 	// Off-chain update messages should be only be returned if the `IndexerMessageSender`
-	// is enabled (`msgSender.Enabled()` returns true).
+	// is enabled (`msgSender.Enabled()` returns true) or gRPC streaming is enabled.
 	k.sendOffchainMessagesWithTxHash(
 		offchainUpdates,
 		tmhash.Sum(ctx.TxBytes()),
@@ -480,7 +497,7 @@ func (k Keeper) PlaceStatefulOrdersFromLastBlock(
 			// Note: Currently, the error returned from placing the order determines whether an order
 			// removal message is sent to the Indexer. This may change later on to be a check on whether
 			// the order has an existing nonce.
-			if k.indexerEventManager.Enabled() && off_chain_updates.ShouldSendOrderRemovalOnReplay(err) {
+			if k.GenerateOffchainUpdates() && off_chain_updates.ShouldSendOrderRemovalOnReplay(err) {
 				// If the stateful order is dropped while adding it to the book, return an off-chain order remove
 				// message for the order. It's possible that this validator already knows about this order, in which
 				// case an `ErrInvalidReplacement` error would be returned here.
@@ -494,13 +511,13 @@ func (k Keeper) PlaceStatefulOrdersFromLastBlock(
 					order.OrderId,
 					orderStatus,
 					err,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
 				); success {
 					existingOffchainUpdates.AddRemoveMessage(order.OrderId, message)
 				}
 			}
-		} else if k.indexerEventManager.Enabled() {
+		} else if k.GenerateOffchainUpdates() {
 			existingOffchainUpdates.Append(placeOrderOffchainUpdates)
 		}
 	}
@@ -563,12 +580,22 @@ func (k Keeper) PlaceConditionalOrdersTriggeredInLastBlock(
 //     previous block.
 //   - Short term Order Cancellation GTB must be greater than or equal to blockHeight
 //   - Short term Order Cancellation GTB is less than or equal to ShortBlockWindow block hight in the future.
+//
+// This validation also ensures that the order cancellation is valid for the ClobPair's status.
 func (k Keeper) PerformOrderCancellationStatefulValidation(
 	ctx sdk.Context,
 	msgCancelOrder *types.MsgCancelOrder,
 	blockHeight uint32,
 ) error {
 	orderIdToCancel := msgCancelOrder.GetOrderId()
+
+	// Validates the order cancellation against the ClobPair's status.
+	if clobPair, found := k.GetClobPair(ctx, types.ClobPairId(orderIdToCancel.GetClobPairId())); found {
+		if err := k.validateOrderCancellationAgainstClobPairStatus(ctx, msgCancelOrder, clobPair); err != nil {
+			return err
+		}
+	}
+
 	if orderIdToCancel.IsStatefulOrder() {
 		previousBlockInfo := k.blockTimeKeeper.GetPreviousBlockInfo(ctx)
 
@@ -866,6 +893,14 @@ func (k Keeper) PerformStatefulOrderValidation(
 			)
 		}
 
+		// If this is a new one-cancels-other order, validate that the other order exists and is not
+		// already paired with another order.
+		if !isPreexistingStatefulOrder && order.IsOneCancelsOtherOrder() {
+			if err := k.validateOneCancelsOtherOrder(ctx, *order.OneCancelsOtherOrderId); err != nil {
+				return err
+			}
+		}
+
 		if order.IsConditionalOrder() {
 			if order.ConditionalOrderTriggerSubticks%uint64(clobPair.SubticksPerTick) != 0 {
 				return errorsmod.Wrapf(
@@ -881,6 +916,37 @@ func (k Keeper) PerformStatefulOrderValidation(
 	return nil
 }
 
+// validateOneCancelsOtherOrder validates that the stateful order with `otherOrderId` can be paired with
+// a newly-placed order as a one-cancels-other order. The other order must exist in state, or in uncommitted
+// state during `CheckTx`, and must not already be paired with another order.
+func (k Keeper) validateOneCancelsOtherOrder(
+	ctx sdk.Context,
+	otherOrderId types.OrderId,
+) error {
+	_, found := k.GetLongTermOrderPlacement(ctx, otherOrderId)
+	if !found && !lib.IsDeliverTxMode(ctx) {
+		_, found = k.GetUncommittedStatefulOrderPlacement(ctx, otherOrderId)
+	}
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOneCancelsOtherOrder,
+			"paired order (%+v) does not exist",
+			otherOrderId,
+		)
+	}
+
+	if existingOrderId, paired := k.GetOneCancelsOtherOrderId(ctx, otherOrderId); paired {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOneCancelsOtherOrder,
+			"paired order (%+v) is already paired with order (%+v)",
+			otherOrderId,
+			existingOrderId,
+		)
+	}
+
+	return nil
+}
+
 // MustValidateReduceOnlyOrder makes sure the given reduce-only
 // order is valid with respect to the current position size.
 // Specifically, this function validates:
@@ -968,9 +1034,15 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 
 	pendingUpdates := types.NewPendingUpdates()
 
-	// Retrieve the associated `PerpetualId` for the `ClobPair`.
 	oraclePriceSubticksRat := k.GetOraclePriceSubticksRat(ctx, clobPair)
-	perpetualId := clobPair.MustGetPerpetualId()
+
+	// Retrieve the associated `PerpetualId` for the `ClobPair`, unless it is a spot `ClobPair`
+	// in which case fills are settled in the base asset.
+	spotClobMetadata := clobPair.GetSpotClobMetadata()
+	var perpetualId uint32
+	if spotClobMetadata == nil {
+		perpetualId = clobPair.MustGetPerpetualId()
+	}
 
 	// TODO(DEC-1713): Complete as many calculations from getPessimisticCollateralCheckPrice as possible here
 	// so we aren't recalculating the same thing within the loop.
@@ -1004,8 +1076,8 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 				k.Logger(ctx).Error(
 					fmt.Sprintf(
 						"Integer overflow: oracle price (subticks) exceeded uint64 max. "+
-							"perpetual ID = (%d), oracle price = (%+v), is buy = (%t)",
-						perpetualId,
+							"clob pair ID = (%d), oracle price = (%+v), is buy = (%t)",
+						clobPairId,
 						oraclePriceSubticksRat,
 						openOrder.IsBuy,
 					),
@@ -1014,8 +1086,8 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 				panic(
 					errorsmod.Wrapf(
 						err,
-						"perpetual id = (%d), oracle price = (%+v), is buy = (%t)",
-						perpetualId,
+						"clob pair id = (%d), oracle price = (%+v), is buy = (%t)",
+						clobPairId,
 						oraclePriceSubticksRat,
 						openOrder.IsBuy,
 					),
@@ -1035,14 +1107,25 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 
 			bigFillAmount := openOrder.RemainingQuantums.ToBigInt()
 			addPerpetualFillAmountStart := time.Now()
-			pendingUpdates.AddPerpetualFill(
-				subaccountId,
-				perpetualId,
-				openOrder.IsBuy,
-				makerFeePpm,
-				bigFillAmount,
-				bigFillQuoteQuantums,
-			)
+			if spotClobMetadata != nil {
+				pendingUpdates.AddSpotFill(
+					subaccountId,
+					spotClobMetadata.BaseAssetId,
+					openOrder.IsBuy,
+					makerFeePpm,
+					bigFillAmount,
+					bigFillQuoteQuantums,
+				)
+			} else {
+				pendingUpdates.AddPerpetualFill(
+					subaccountId,
+					perpetualId,
+					openOrder.IsBuy,
+					makerFeePpm,
+					bigFillAmount,
+					bigFillQuoteQuantums,
+				)
+			}
 			telemetry.ModuleMeasureSince(
 				types.ModuleName,
 				addPerpetualFillAmountStart,
@@ -1083,7 +1166,12 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 }
 
 // GetOraclePriceSubticksRat returns the oracle price in subticks for the given `ClobPair`.
+// For spot `ClobPair`s, the oracle price of the base asset's market is used.
 func (k Keeper) GetOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPair) *big.Rat {
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		return k.getSpotOraclePriceSubticksRat(ctx, clobPair, spotClobMetadata.BaseAssetId)
+	}
+
 	// Retrieve the associated `PerpetualId` for the `ClobPair`.
 	perpetualId := clobPair.MustGetPerpetualId()
 
@@ -1115,6 +1203,39 @@ func (k Keeper) GetOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPa
 	return oraclePriceSubticksRat
 }
 
+// getSpotOraclePriceSubticksRat returns the oracle price in subticks for the given spot `ClobPair`.
+func (k Keeper) getSpotOraclePriceSubticksRat(
+	ctx sdk.Context,
+	clobPair types.ClobPair,
+	baseAssetId uint32,
+) *big.Rat {
+	// Use the base asset to retrieve the `Market` so we can determine the oracle price.
+	baseAsset, marketPrice, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, baseAssetId)
+	// If an error is returned, this implies stateful order validation was not performed properly, therefore panic.
+	if err != nil {
+		panic(errorsmod.Wrapf(err, "base asset ID = (%d)", baseAssetId))
+	}
+
+	oraclePriceSubticksRat := types.PriceToSubticks(
+		marketPrice,
+		clobPair,
+		baseAsset.AtomicResolution,
+		lib.QuoteCurrencyAtomicResolution,
+	)
+	if oraclePriceSubticksRat.Cmp(big.NewRat(0, 1)) == 0 {
+		panic(
+			errorsmod.Wrapf(
+				types.ErrZeroPriceForOracle,
+				"clob pair ID = (%d), base asset ID = (%d), market ID = (%d)",
+				clobPair.Id,
+				baseAssetId,
+				marketPrice.Id,
+			),
+		)
+	}
+	return oraclePriceSubticksRat
+}
+
 // GetStatePosition returns the current size of a subaccount's position for the specified `clobPairId`.
 func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.SubaccountId, clobPairId types.ClobPairId,
 ) (
@@ -1126,13 +1247,21 @@ func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.Subaccoun
 		panic(fmt.Sprintf("GetStatePosition: CLOB pair %d not found", clobPairId))
 	}
 
+	// For spot CLOB pairs, the position is the subaccount's balance of the base asset.
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+		position, _ := subaccount.GetAssetPositionForId(spotClobMetadata.BaseAssetId)
+		return position.GetBigQuantums()
+	}
+
 	// Get the perpetual ID for this CLOB pair, and panic if it is not a perpetual CLOB.
 	perpetualId, err := clobPair.GetPerpetualId()
 	if err != nil {
 		panic(
-			errorsmod.Wrap(
+			errorsmod.Wrapf(
 				err,
-				"GetStatePosition: Reduce-only orders for assets not implemented",
+				"GetStatePosition: CLOB pair %d has no perpetual or spot metadata",
+				clobPairId,
 			),
 		)
 	}
@@ -1198,7 +1327,7 @@ func (k Keeper) InitStatefulOrders(
 		// Send off-chain updates generated from placing the order. `SendOffchainData` enqueues the
 		// the messages to be sent in a channel and should be non-blocking.
 		// Off-chain update messages should be only be returned if the `IndexerMessageSender`
-		// is enabled (`msgSender.Enabled()` returns true).
+		// is enabled (`msgSender.Enabled()` returns true) or gRPC streaming is enabled.
 		k.SendOffchainMessages(offchainUpdates, nil, metrics.SendPlaceOrderOffchainUpdates)
 
 		if orderSizeOptimisticallyFilledFromMatchingQuantums > 0 {
@@ -1260,7 +1389,8 @@ func (k Keeper) sendOffchainMessagesWithTxHash(
 
 // SendOffchainMessages sends all the `Message` in the offchainUpdates passed in along with
 // any additional headers passed in. No headers will be added if a `nil` or empty list of additional
-// headers is passed in.
+// headers is passed in. The messages are also sent to gRPC streaming subscribers if gRPC streaming
+// is enabled.
 func (k Keeper) SendOffchainMessages(
 	offchainUpdates *types.OffchainUpdates,
 	additionalHeaders []msgsender.MessageHeader,
@@ -1278,6 +1408,11 @@ func (k Keeper) SendOffchainMessages(
 		}
 		k.GetIndexerEventManager().SendOffchainData(update)
 	}
+
+	// Forward the updates to gRPC streaming subscribers.
+	if k.GetGrpcStreamingManager().Enabled() {
+		k.GetGrpcStreamingManager().SendOrderbookUpdates(offchainUpdates, false)
+	}
 }
 
 // getPessimisticCollateralCheckPrice returns the price in subticks we should use for collateralization checks.
@@ -1319,9 +1454,7 @@ func getPessimisticCollateralCheckPrice(
 // getFillQuoteQuantums returns the total fillAmount price in quote quantums based on the maker subticks.
 // This value is always positive.
 //
-// Returns an error if:
-// - The Order is not for a `PerpetualClob`.
-// - The underlying `Price` does not exist.
+// Note that this is the same for perpetual and spot CLOB pairs, since both are quoted in USDC.
 func getFillQuoteQuantums(
 	clobPair types.ClobPair,
 	makerSubticks types.Subticks,
@@ -1334,10 +1467,6 @@ func getFillQuoteQuantums(
 		metrics.Latency,
 	)
 
-	if perpetualClobMetadata := clobPair.GetPerpetualClobMetadata(); perpetualClobMetadata == nil {
-		return nil, types.ErrAssetOrdersNotImplemented
-	}
-
 	quantumConversionExponent := clobPair.QuantumConversionExponent
 
 	quoteQuantums := types.FillAmountToQuoteQuantums(
@@ -682,6 +682,9 @@ func TestPlaceShortTermOrder(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -890,6 +893,9 @@ func TestAddPreexistingStatefulOrder(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -1671,6 +1677,106 @@ func TestPerformStatefulOrderValidation(t *testing.T) {
 				TimeInForce:  types.Order_TIME_IN_FORCE_POST_ONLY,
 			},
 		},
+		"Fails with short-term post-only order and ClobPair_Status of PAUSED": {
+			clobPairs: []types.ClobPair{
+				{
+					Metadata: &types.ClobPair_PerpetualClobMetadata{
+						PerpetualClobMetadata: &types.PerpetualClobMetadata{
+							PerpetualId: 0,
+						},
+					},
+					Status:           types.ClobPair_STATUS_PAUSED,
+					StepBaseQuantums: 10,
+					SubticksPerTick:  10,
+				},
+			},
+			order: types.Order{
+				OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 1, ClobPairId: 0},
+				Side:         types.Order_SIDE_BUY,
+				Quantums:     20,
+				Subticks:     10,
+				GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 15},
+				TimeInForce:  types.Order_TIME_IN_FORCE_POST_ONLY,
+			},
+			expectedErr: "cannot be placed for clob pair with status",
+		},
+		"Fails with long-term order and ClobPair_Status of PAUSED": {
+			clobPairs: []types.ClobPair{
+				{
+					Metadata: &types.ClobPair_PerpetualClobMetadata{
+						PerpetualClobMetadata: &types.PerpetualClobMetadata{
+							PerpetualId: 0,
+						},
+					},
+					Status:           types.ClobPair_STATUS_PAUSED,
+					StepBaseQuantums: 10,
+					SubticksPerTick:  10,
+				},
+			},
+			order:       constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+			expectedErr: "cannot be placed for clob pair with status",
+		},
+		"Fails with short-term post-only order and ClobPair_Status of CANCEL_ONLY": {
+			clobPairs: []types.ClobPair{
+				{
+					Metadata: &types.ClobPair_PerpetualClobMetadata{
+						PerpetualClobMetadata: &types.PerpetualClobMetadata{
+							PerpetualId: 0,
+						},
+					},
+					Status:           types.ClobPair_STATUS_CANCEL_ONLY,
+					StepBaseQuantums: 10,
+					SubticksPerTick:  10,
+				},
+			},
+			order: types.Order{
+				OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 1, ClobPairId: 0},
+				Side:         types.Order_SIDE_BUY,
+				Quantums:     20,
+				Subticks:     10,
+				GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 15},
+				TimeInForce:  types.Order_TIME_IN_FORCE_POST_ONLY,
+			},
+			expectedErr: "cannot be placed for clob pair with status",
+		},
+		"Fails with short-term non-post-only order and ClobPair_Status of POST_ONLY": {
+			clobPairs: []types.ClobPair{
+				{
+					Metadata: &types.ClobPair_PerpetualClobMetadata{
+						PerpetualClobMetadata: &types.PerpetualClobMetadata{
+							PerpetualId: 0,
+						},
+					},
+					Status:           types.ClobPair_STATUS_POST_ONLY,
+					StepBaseQuantums: 10,
+					SubticksPerTick:  10,
+				},
+			},
+			order:       constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+			expectedErr: "must be post-only for clob pair with status",
+		},
+		"Succeeds with short-term post-only order and ClobPair_Status of POST_ONLY": {
+			clobPairs: []types.ClobPair{
+				{
+					Metadata: &types.ClobPair_PerpetualClobMetadata{
+						PerpetualClobMetadata: &types.PerpetualClobMetadata{
+							PerpetualId: 0,
+						},
+					},
+					Status:           types.ClobPair_STATUS_POST_ONLY,
+					StepBaseQuantums: 10,
+					SubticksPerTick:  10,
+				},
+			},
+			order: types.Order{
+				OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 1, ClobPairId: 0},
+				Side:         types.Order_SIDE_BUY,
+				Quantums:     20,
+				Subticks:     10,
+				GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 15},
+				TimeInForce:  types.Order_TIME_IN_FORCE_POST_ONLY,
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -1852,47 +1958,98 @@ func TestGetStatePosition_PanicsOnInvalidClob(t *testing.T) {
 	)
 }
 
-// TODO(DEC-1535): Uncomment this test when we can create spot CLOB pairs.
-// func TestGetStatePosition_PanicsOnAssetClob(t *testing.T) {
-// 	// Setup keeper state.
-// 	MemClob := memclob.NewMemClobPriceTimePriority(false)
-// 	ctx,
-// 		clobKeeper,
-// 		pricesKeeper,
-// 		_,
-// 		perpetualsKeeper,
-// 		_,
-// 		_,
-// 		_ := keepertest.ClobKeepers(t, MemClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
-// 	prices.InitGenesis(ctx, *pricesKeeper, constants.Prices_DefaultGenesisState)
-// 	perpetuals.InitGenesis(ctx, *perpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
-
-// 	// Create CLOB pair.
-// 	clobPair := constants.ClobPair_Asset
-// 	clobKeeper.CreatePerpetualClobPair(
-// 		ctx,
-// 		clobPair.Metadata.(*types.ClobPair_PerpetualClobMetadata),
-// 		satypes.BaseQuantums(clobPair.StepBaseQuantums),
-// 		satypes.BaseQuantums(clobPair.MinOrderBaseQuantums),
-// 		clobPair.QuantumConversionExponent,
-// 		clobPair.SubticksPerTick,
-// 		clobPair.Status,
-// 		clobPair.MakerFeePpm,
-// 		clobPair.TakerFeePpm,
-// 	)
-
-// 	// Run the test and verify expectations.
-// 	require.PanicsWithError(
-// 		t,
-// 		errorsmod.Wrap(
-// 			types.ErrAssetOrdersNotImplemented,
-// 			"GetStatePosition: Reduce-only orders for assets not implemented",
-// 		).Error(),
-// 		func() {
-// 			clobKeeper.GetStatePosition(ctx, constants.Alice_Num0, clobPair.Id)
-// 		},
-// 	)
-// }
+func TestGetStatePosition_SpotClob(t *testing.T) {
+	tests := map[string]struct {
+		// Subaccount state.
+		assetPositions []*satypes.AssetPosition
+
+		// Expectations.
+		expectedPositionSize *big.Int
+	}{
+		`Can fetch the base asset balance of a subaccount`: {
+			assetPositions: []*satypes.AssetPosition{
+				&constants.Usdc_Asset_500_000,
+				&constants.Long_Asset_1BTC,
+			},
+
+			expectedPositionSize: constants.Long_Asset_1BTC.GetBigQuantums(),
+		},
+		`Fetching a subaccount without a base asset balance returns 0`: {
+			assetPositions: []*satypes.AssetPosition{
+				&constants.Usdc_Asset_500_000,
+			},
+
+			expectedPositionSize: big.NewInt(0),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup keeper state.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			indexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+			prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+			_, err := ks.AssetsKeeper.CreateAsset(
+				ks.Ctx,
+				constants.BtcUsd.Id,
+				constants.BtcUsd.Symbol,
+				constants.BtcUsd.Denom,
+				constants.BtcUsd.DenomExponent,
+				constants.BtcUsd.HasMarket,
+				constants.BtcUsd.MarketId,
+				constants.BtcUsd.AtomicResolution,
+				constants.BtcUsd.CollateralWeightPpm,
+			)
+			require.NoError(t, err)
+
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, satypes.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: tc.assetPositions,
+			})
+
+			// Create the spot CLOB pair.
+			clobPair := constants.ClobPair_Spot_Btc
+			spotClobMetadata := clobPair.GetSpotClobMetadata()
+			indexerEventManager.On("AddTxnEvent",
+				ks.Ctx,
+				indexerevents.SubtypeSpotMarket,
+				indexerevents.SpotMarketEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewSpotMarketCreateEvent(
+						clobPair.Id,
+						spotClobMetadata.BaseAssetId,
+						spotClobMetadata.QuoteAssetId,
+						clobPair.Status,
+						clobPair.QuantumConversionExponent,
+						clobPair.SubticksPerTick,
+						clobPair.StepBaseQuantums,
+					),
+				),
+			).Once().Return()
+			_, err = ks.ClobKeeper.CreateSpotClobPair(
+				ks.Ctx,
+				clobPair.Id,
+				spotClobMetadata.BaseAssetId,
+				spotClobMetadata.QuoteAssetId,
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				clobPair.Status,
+			)
+			require.NoError(t, err)
+
+			// Run the test and verify expectations.
+			positionSizeBig := ks.ClobKeeper.GetStatePosition(
+				ks.Ctx,
+				constants.Alice_Num0,
+				types.ClobPairId(clobPair.Id),
+			)
+
+			require.Equal(t, tc.expectedPositionSize, positionSizeBig)
+		})
+	}
+}
 
 func TestInitStatefulOrders(t *testing.T) {
 	tests := map[string]struct {
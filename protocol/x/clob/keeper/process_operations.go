@@ -54,7 +54,7 @@ func (k Keeper) ProcessProposerOperations(
 	// Collect the list of order ids filled and set the field in the `ProcessProposerMatchesEvents` object.
 	processProposerMatchesEvents := k.GenerateProcessProposerMatchesEvents(ctx, operations)
 
-	// Remove fully filled orders from state.
+	// Remove the other order of filled one-cancels-other orders and fully filled orders from state.
 	for _, orderId := range processProposerMatchesEvents.OrderIdsFilledInLastBlock {
 		if orderId.IsShortTermOrder() {
 			continue
@@ -62,6 +62,15 @@ func (k Keeper) ProcessProposerOperations(
 
 		orderPlacement, placementExists := k.GetLongTermOrderPlacement(ctx, orderId)
 		if placementExists {
+			// If the order is paired with another order as a one-cancels-other order, remove the other order.
+			// Note this must happen before the order is removed from state below, which also removes the pair.
+			if otherOrderId, removed := k.MaybeRemoveOneCancelsOtherOrder(ctx, orderId); removed {
+				processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+					processProposerMatchesEvents.RemovedStatefulOrderIds,
+					otherOrderId,
+				)
+			}
+
 			fillAmountExists, orderStateFillAmount, _ := k.GetOrderFillAmount(ctx, orderId)
 			if !fillAmountExists {
 				panic("ProcessProposerOperations: Order fill amount does not exist in state")
@@ -121,6 +130,9 @@ func (k Keeper) ProcessInternalOperations(
 	// Collect all the short-term orders placed for subsequent lookups.
 	// All short term orders in this map have passed validation.
 	placedShortTermOrders := make(map[types.OrderId]types.Order, 0)
+	// Collect all the stateful orders removed by previous order removals for subsequent lookups of
+	// the other order of a self-trade.
+	removedStatefulOrders := make(map[types.OrderId]types.Order, 0)
 
 	// Write the matches to state if all stateful validation passes.
 	for _, operation := range operations {
@@ -152,7 +164,12 @@ func (k Keeper) ProcessInternalOperations(
 		case *types.InternalOperation_OrderRemoval:
 			orderRemoval := castedOperation.OrderRemoval
 
-			if err := k.PersistOrderRemovalToState(ctx, *orderRemoval); err != nil {
+			if err := k.PersistOrderRemovalToState(
+				ctx,
+				*orderRemoval,
+				placedShortTermOrders,
+				removedStatefulOrders,
+			); err != nil {
 				return errorsmod.Wrapf(
 					types.ErrInvalidOrderRemoval,
 					"Order Removal (%+v) invalid. Error: %+v",
@@ -248,10 +265,14 @@ func (k Keeper) statUnverifiedOrderRemoval(
 }
 
 // PersistOrderRemovalToState takes in an OrderRemoval, statefully validates it according to
-// RemovalReason, and writes the removal to state.
+// RemovalReason, and writes the removal to state. The maps of short-term orders placed and
+// stateful orders removed earlier in the operations queue are required to fetch the other order
+// of a self-trade, and the removed order is added to `removedStatefulOrders`.
 func (k Keeper) PersistOrderRemovalToState(
 	ctx sdk.Context,
 	orderRemoval types.OrderRemoval,
+	placedShortTermOrders map[types.OrderId]types.Order,
+	removedStatefulOrders map[types.OrderId]types.Order,
 ) error {
 	orderIdToRemove := orderRemoval.GetOrderId()
 	orderIdToRemove.MustBeStatefulOrder()
@@ -314,8 +335,15 @@ func (k Keeper) PersistOrderRemovalToState(
 			)
 		}
 	case types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE:
-		// TODO (CLOB-877)
-		k.statUnverifiedOrderRemoval(ctx, orderRemoval, orderToRemove)
+		if err := k.validateSelfTradeOrderRemoval(
+			ctx,
+			orderRemoval,
+			orderToRemove,
+			placedShortTermOrders,
+			removedStatefulOrders,
+		); err != nil {
+			return err
+		}
 	case types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED:
 		// TODO (CLOB-877)
 		k.statUnverifiedOrderRemoval(ctx, orderRemoval, orderToRemove)
@@ -367,35 +395,29 @@ func (k Keeper) PersistOrderRemovalToState(
 				orderToRemove.GetBaseQuantums(),
 			)
 		}
-	// TODO - uncomment when reduce only orders are enabled. Order Removals of this type will fail ValidateBasic.
-	// case types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY:
-	// 	if !orderToRemove.IsReduceOnly() {
-	// 		return errorsmod.Wrapf(
-	// 			types.ErrInvalidOrderRemoval,
-	// 			"Order Removal (%+v) invalid. Order must be reduce only.",
-	// 			orderRemoval,
-	// 		)
-	// 	}
-
-	// 	// The reduce-only order must increase or change the side of the position to trigger removal.
-	// 	currentPositionSize := k.GetStatePosition(
-	// 		ctx,
-	// 		orderIdToRemove.SubaccountId,
-	// 		orderToRemove.GetClobPairId(),
-	// 	)
-	// 	orderQuantumsToFill := orderToRemove.GetBigQuantums()
-
-	// 	orderFillWouldIncreasePositionSize := orderQuantumsToFill.Sign() == currentPositionSize.Sign()
-
-	// 	newPositionSize := new(big.Int).Add(currentPositionSize, orderQuantumsToFill)
-	// 	orderChangedSide := currentPositionSize.Sign()*newPositionSize.Sign() == -1
-	// 	if !orderFillWouldIncreasePositionSize && !orderChangedSide {
-	// 		return errorsmod.Wrapf(
-	// 			types.ErrInvalidOrderRemoval,
-	// 			"Order Removal (%+v) invalid. Order fill must increase position size or change side.",
-	// 			orderRemoval,
-	// 		)
-	// 	}
+	case types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY:
+		if !orderToRemove.IsReduceOnly() {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. Order must be reduce only.",
+				orderRemoval,
+			)
+		}
+
+		// The reduce-only order must no longer be on the opposite side of the current position,
+		// meaning that any fill would increase the position size or the position is closed.
+		currentPositionSize := k.GetStatePosition(
+			ctx,
+			orderIdToRemove.SubaccountId,
+			orderToRemove.GetClobPairId(),
+		)
+		if orderToRemove.GetBigQuantums().Sign()*currentPositionSize.Sign() == -1 {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. Order fill must increase position size.",
+				orderRemoval,
+			)
+		}
 	default:
 		return errorsmod.Wrapf(
 			types.ErrInvalidOrderRemovalReason,
@@ -405,6 +427,7 @@ func (k Keeper) PersistOrderRemovalToState(
 
 	// Remove the stateful order from state.
 	k.MustRemoveStatefulOrder(ctx, orderIdToRemove)
+	removedStatefulOrders[orderIdToRemove] = orderToRemove
 
 	// Emit an on-chain indexer event for Stateful Order Removal.
 	k.GetIndexerEventManager().AddTxnEvent(
@@ -432,6 +455,84 @@ func (k Keeper) PersistOrderRemovalToState(
 	return nil
 }
 
+// validateSelfTradeOrderRemoval validates that the order removed for an invalid self-trade crossed
+// an order of the same subaccount, or of the same owner if either order has owner-level self-trade
+// prevention enabled. If the other order is not set, the order must have crossed a liquidation order
+// of its own subaccount, which must therefore be liquidatable.
+func (k Keeper) validateSelfTradeOrderRemoval(
+	ctx sdk.Context,
+	orderRemoval types.OrderRemoval,
+	orderToRemove types.Order,
+	placedShortTermOrders map[types.OrderId]types.Order,
+	removedStatefulOrders map[types.OrderId]types.Order,
+) error {
+	if orderRemoval.SelfTradeOrderId == nil {
+		isLiquidatable, err := k.IsLiquidatable(ctx, orderToRemove.OrderId.SubaccountId)
+		if err != nil {
+			return err
+		}
+		if !isLiquidatable {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. Subaccount of order is not liquidatable.",
+				orderRemoval,
+			)
+		}
+		return nil
+	}
+
+	// The other order may have been removed earlier in the operations queue, for example the maker
+	// order of a taker order using the cancel-both self-trade prevention mode.
+	selfTradeOrderId := *orderRemoval.SelfTradeOrderId
+	selfTradeOrder, removed := removedStatefulOrders[selfTradeOrderId]
+	if !removed {
+		var err error
+		selfTradeOrder, err = k.FetchOrderFromOrderId(ctx, selfTradeOrderId, placedShortTermOrders)
+		if err != nil {
+			return err
+		}
+	}
+
+	if selfTradeOrder.GetClobPairId() != orderToRemove.GetClobPairId() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderRemoval,
+			"Order Removal (%+v) invalid. Self-trade orders are on different clob pairs.",
+			orderRemoval,
+		)
+	}
+
+	if selfTradeOrder.Side == orderToRemove.Side {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderRemoval,
+			"Order Removal (%+v) invalid. Self-trade orders are on the same side.",
+			orderRemoval,
+		)
+	}
+
+	buyOrder, sellOrder := orderToRemove, selfTradeOrder
+	if sellOrder.IsBuy() {
+		buyOrder, sellOrder = sellOrder, buyOrder
+	}
+	if buyOrder.GetOrderSubticks() < sellOrder.GetOrderSubticks() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderRemoval,
+			"Order Removal (%+v) invalid. Self-trade orders do not cross.",
+			orderRemoval,
+		)
+	}
+
+	if !orderToRemove.IsSelfTrade(selfTradeOrder.OrderId.SubaccountId) &&
+		!selfTradeOrder.IsSelfTrade(orderToRemove.OrderId.SubaccountId) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderRemoval,
+			"Order Removal (%+v) invalid. Orders are not from the same subaccount or owner.",
+			orderRemoval,
+		)
+	}
+
+	return nil
+}
+
 // PersistMatchOrdersToState writes a MatchOrders object to state and emits an onchain
 // indexer event for the match.
 func (k Keeper) PersistMatchOrdersToState(
@@ -504,6 +605,31 @@ func (k Keeper) PersistMatchOrdersToState(
 				),
 			)
 		}
+		// Fills of spot clob pairs are sent as separate events since they are settled through asset
+		// positions rather than perpetual positions.
+		clobPair := k.mustGetClobPair(ctx, takerOrder.GetClobPairId())
+		if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+			k.GetIndexerEventManager().AddTxnEvent(
+				ctx,
+				indexerevents.SubtypeSpotOrderFill,
+				indexerevents.SpotOrderFillEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewSpotOrderFillEvent(
+						matchWithOrders.MakerOrder.MustGetOrder(),
+						matchWithOrders.TakerOrder.MustGetOrder(),
+						spotClobMetadata.BaseAssetId,
+						spotClobMetadata.QuoteAssetId,
+						matchWithOrders.FillAmount,
+						matchWithOrders.MakerFee,
+						matchWithOrders.TakerFee,
+						totalFilledMaker,
+						totalFilledTaker,
+					),
+				),
+			)
+			continue
+		}
+
 		k.GetIndexerEventManager().AddTxnEvent(
 			ctx,
 			indexerevents.SubtypeOrderFill,
@@ -627,20 +753,28 @@ func (k Keeper) PersistMatchLiquidationToState(
 	return nil
 }
 
-// PersistMatchDeleveragingToState writes a MatchPerpetualDeleveraging object to state.
+// PersistMatchDeleveragingToState writes a MatchPerpetualDeleveraging object to state and emits an
+// indexer event with the bankruptcy price of each deleveraging fill.
 // This function returns an error if:
 // - CanDeleverageSubaccount returns false, indicating the subaccount failed deleveraging validation.
 // - OffsetSubaccountPerpetualPosition returns an error.
+// - The perpetual does not have a clob pair.
 // - The generated fills do not match the fills in the Operations object.
+// - The fills do not use offsetting positions in auto-deleveraging order.
 // TODO(CLOB-654) Verify deleveraging is triggered by unmatched liquidation orders and for the correct amount.
 func (k Keeper) PersistMatchDeleveragingToState(
 	ctx sdk.Context,
 	matchDeleveraging *types.MatchPerpetualDeleveraging,
 ) error {
 	liquidatedSubaccountId := matchDeleveraging.GetLiquidated()
+	perpetualId := matchDeleveraging.GetPerpetualId()
 
 	// Validate that the provided subaccount can be deleveraged.
-	if canDeleverageSubaccount, err := k.CanDeleverageSubaccount(ctx, liquidatedSubaccountId); err != nil {
+	if canDeleverageSubaccount, err := k.CanDeleverageSubaccount(
+		ctx,
+		liquidatedSubaccountId,
+		perpetualId,
+	); err != nil {
 		panic(
 			fmt.Sprintf(
 				"PersistMatchDeleveragingToState: Failed to determine if subaccount can be deleveraged. "+
@@ -658,8 +792,6 @@ func (k Keeper) PersistMatchDeleveragingToState(
 		)
 	}
 
-	perpetualId := matchDeleveraging.GetPerpetualId()
-
 	liquidatedSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, liquidatedSubaccountId)
 	position, exists := liquidatedSubaccount.GetPerpetualPositionForId(perpetualId)
 	if !exists {
@@ -672,19 +804,37 @@ func (k Keeper) PersistMatchDeleveragingToState(
 	}
 	deltaQuantumsIsNegative := position.GetIsLong()
 
+	clobPairId, err := k.GetClobPairIdForPerpetual(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+	clobPair := k.mustGetClobPair(ctx, clobPairId)
+
+	// Validate that the offsetting positions are used in auto-deleveraging order. Offsetting positions
+	// are on the opposite side of the liquidated subaccount's position.
+	if err := k.ValidateDeleveragingFillsAdlOrder(
+		ctx,
+		perpetualId,
+		!position.GetIsLong(),
+		matchDeleveraging.GetFills(),
+	); err != nil {
+		return err
+	}
+
 	for _, fill := range matchDeleveraging.GetFills() {
 		deltaQuantums := new(big.Int).SetUint64(fill.FillAmount)
 		if deltaQuantumsIsNegative {
 			deltaQuantums.Neg(deltaQuantums)
 		}
 
-		if err := k.ProcessDeleveraging(
+		deltaQuoteQuantums, err := k.ProcessDeleveraging(
 			ctx,
 			liquidatedSubaccountId,
 			fill.OffsettingSubaccountId,
 			perpetualId,
 			deltaQuantums,
-		); err != nil {
+		)
+		if err != nil {
 			return errorsmod.Wrapf(
 				types.ErrInvalidDeleveragingFill,
 				"Failed to process deleveraging fill: %+v. liquidatedSubaccountId: %+v, "+
@@ -697,6 +847,37 @@ func (k Keeper) PersistMatchDeleveragingToState(
 			)
 		}
 
+		// The liquidated subaccount pays the bankruptcy price when buying and receives it when selling.
+		isBuy := !deltaQuantumsIsNegative
+		totalQuoteQuantums := new(big.Int).Set(deltaQuoteQuantums)
+		if isBuy {
+			totalQuoteQuantums.Neg(totalQuoteQuantums)
+		}
+		bankruptcySubticks := lib.BigRatRound(
+			types.GetAveragePriceSubticks(
+				totalQuoteQuantums,
+				new(big.Int).SetUint64(fill.FillAmount),
+				clobPair.QuantumConversionExponent,
+			),
+			false,
+		)
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeDeleveraging,
+			indexerevents.DeleveragingEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewDeleveragingEvent(
+					liquidatedSubaccountId,
+					fill.OffsettingSubaccountId,
+					perpetualId,
+					satypes.BaseQuantums(fill.FillAmount),
+					bankruptcySubticks,
+					totalQuoteQuantums,
+					isBuy,
+				),
+			),
+		)
+
 		if quoteQuantums, err := k.perpetualsKeeper.GetNetNotional(
 			ctx,
 			perpetualId,
@@ -765,6 +946,7 @@ func (k Keeper) GenerateProcessProposerMatchesEvents(
 	// ConditionalOrderIdsTriggeredInLastBlock to be populated in EndBlocker.
 	// ExpiredOrderId to be populated in the EndBlocker.
 	// PlacedStatefulCancellation to be populated in MsgHandler for MsgCancelOrder.
+	// ReplacedLongTermOrderIds to be populated in MsgHandler for MsgReplaceOrder.
 	return types.ProcessProposerMatchesEvents{
 		PlacedLongTermOrderIds:                  []types.OrderId{},
 		ExpiredStatefulOrderIds:                 []types.OrderId{},
@@ -773,6 +955,7 @@ func (k Keeper) GenerateProcessProposerMatchesEvents(
 		RemovedStatefulOrderIds:                 removedOrderIds,
 		PlacedConditionalOrderIds:               []types.OrderId{},
 		ConditionalOrderIdsTriggeredInLastBlock: []types.OrderId{},
+		ReplacedLongTermOrderIds:                []types.OrderId{},
 		BlockHeight:                             lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
 	}
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	asstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -40,6 +42,7 @@ type MatchWithOrdersForTesting struct {
 
 type processProposerOperationsTestCase struct {
 	// State
+	assets                        []*asstypes.Asset
 	perpetuals                    []*perptypes.Perpetual
 	perpetualFeeParams            *feetierstypes.PerpetualFeeParams
 	clobPairs                     []types.ClobPair
@@ -61,9 +64,12 @@ type processProposerOperationsTestCase struct {
 	// the operations field above.
 	expectedProcessProposerMatchesEvents types.ProcessProposerMatchesEvents
 	expectedMatches                      []*MatchWithOrdersForTesting
+	expectedDeleveragingEvents           []*indexerevents.DeleveragingEventV1
+	expectedSpotOrderFillEvents          []*indexerevents.SpotOrderFillEventV1
 	expectedFillAmounts                  map[types.OrderId]satypes.BaseQuantums
 	expectedQuoteBalances                map[satypes.SubaccountId]int64
 	expectedPerpetualPositions           map[satypes.SubaccountId][]*satypes.PerpetualPosition
+	expectedAssetPositions               map[satypes.SubaccountId][]*satypes.AssetPosition
 	expectedSubaccountLiquidationInfo    map[satypes.SubaccountId]types.SubaccountLiquidationInfo
 	expectedError                        error
 	expectedPanics                       string
@@ -905,6 +911,18 @@ func TestProcessProposerOperations(t *testing.T) {
 					TotalFilledTaker: 25_000_000,
 				},
 			},
+			// Carl's remaining $37,500 is paid to Dave for 0.75 BTC at the bankruptcy price of $50,000.
+			expectedDeleveragingEvents: []*indexerevents.DeleveragingEventV1{
+				indexerevents.NewDeleveragingEvent(
+					constants.Carl_Num0,
+					constants.Dave_Num0,
+					0,
+					75_000_000,
+					big.NewInt(50_000_000_000),
+					big.NewInt(37_500_000_000),
+					true,
+				),
+			},
 			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
 				OrderIdsFilledInLastBlock: []types.OrderId{
 					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11.GetOrderId(),
@@ -932,12 +950,13 @@ func TestProcessProposerOperations(t *testing.T) {
 			preExistingStatefulOrders: []types.Order{
 				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
 				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
 				constants.LongTermOrder_Bob_Num0_Id0_Clob0_Sell10_Price10_GTBT10_PO,
 			},
 			rawOperations: []types.OperationRaw{
-				clobtest.NewOrderRemovalOperationRaw(
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
 					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
-					types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+					&constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId,
 				),
 				clobtest.NewOrderRemovalOperationRaw(
 					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Sell10_Price10_GTBT10_PO.OrderId,
@@ -953,6 +972,67 @@ func TestProcessProposerOperations(t *testing.T) {
 				},
 			},
 		},
+		"Fails with self-trade order removal for orders of different subaccounts": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+				constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+					&constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10.OrderId,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with self-trade order removal for orders on the same side": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+					&constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with self-trade order removal without a self-trade order for a non-liquidatable subaccount": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+					nil,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
 		"Fails when attempting to match order with invalid order side": {
 			perpetuals: []*perptypes.Perpetual{
 				&constants.BtcUsd_100PercentMarginRequirement,
@@ -1347,8 +1427,10 @@ func TestProcessProposerOperations(t *testing.T) {
 				registry := codectypes.NewInterfaceRegistry()
 				cdc := codec.NewProtoCodec(registry)
 				store := prefix.NewStore(ks.Ctx.KVStore(ks.StoreKey), []byte(types.ClobPairKeyPrefix))
-				b := cdc.MustMarshal(&constants.ClobPair_Btc_Paused)
-				store.Set(lib.Uint32ToKey(constants.ClobPair_Btc_Paused.Id), b)
+				clobPair := constants.ClobPair_Btc
+				clobPair.Status = types.ClobPair_STATUS_UNSPECIFIED
+				b := cdc.MustMarshal(&clobPair)
+				store.Set(lib.Uint32ToKey(clobPair.Id), b)
 			},
 			expectedPanics: "validateInternalOperationAgainstClobPairStatus: ClobPair's status is not supported",
 		},
@@ -1407,6 +1489,345 @@ func TestProcessProposerOperations(t *testing.T) {
 			},
 			expectedError: types.ErrOperationConflictsWithClobPairStatus,
 		},
+		"Fails with clob match for market in paused mode": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_Paused,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRaw(
+					&constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell50_Price10_GTBT15,
+					[]types.MakerFill{
+						{
+							FillAmount:   5,
+							MakerOrderId: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
+		"Fails with order removal for market in paused mode": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_Paused,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER,
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
+		"Fails with clob match for market in cancel-only mode": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_CancelOnly,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRaw(
+					&constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell50_Price10_GTBT15,
+					[]types.MakerFill{
+						{
+							FillAmount:   5,
+							MakerOrderId: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
+		"Fails with short term order placement for market in cancel-only mode": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_CancelOnly,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11,
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
+		"Succeeds with order removal for market in cancel-only mode": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_CancelOnly,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id0_Clob0_Sell10_Price10_GTBT10_PO,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Sell10_Price10_GTBT10_PO.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER,
+				),
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+				RemovedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Sell10_Price10_GTBT10_PO.OrderId,
+				},
+			},
+		},
+		"Fails with clob match for market in post-only mode": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_PostOnly,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRaw(
+					&constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell50_Price10_GTBT15,
+					[]types.MakerFill{
+						{
+							FillAmount:   5,
+							MakerOrderId: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
+		"Fails with deleveraging match for market in post-only mode": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_PostOnly,
+			},
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_50499USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromPerpetualDeleveragingLiquidation(
+					types.MatchPerpetualDeleveraging{
+						Liquidated:  constants.Carl_Num0,
+						PerpetualId: 0,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             100_000_000,
+							},
+						},
+					},
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
+		"Succeeds with singular match on a spot clob pair": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			perpetuals:         []*perptypes.Perpetual{},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Spot_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_100_000,
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_100_000,
+						{
+							AssetId:  constants.BtcUsd.Id,
+							Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			preExistingStatefulOrders: []types.Order{},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 1000},
+						Side:         types.Order_SIDE_BUY,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+				),
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 1000},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+				),
+				clobtest.NewMatchOperationRaw(
+					&types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 1000},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+					[]types.MakerFill{
+						{
+							FillAmount:   100_000_000,
+							MakerOrderId: types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 1000},
+						},
+					},
+				),
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				OrderIdsFilledInLastBlock: []types.OrderId{
+					{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 1000},
+					{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 1000},
+				},
+				BlockHeight: blockHeight,
+			},
+			expectedSpotOrderFillEvents: []*indexerevents.SpotOrderFillEventV1{
+				indexerevents.NewSpotOrderFillEvent(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 1000},
+						Side:         types.Order_SIDE_BUY,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 1000},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+					constants.BtcUsd.Id,
+					constants.Usdc.Id,
+					100_000_000,
+					10_000,
+					25_000,
+					100_000_000,
+					100_000_000,
+				),
+			},
+			expectedFillAmounts: map[types.OrderId]satypes.BaseQuantums{
+				{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 1000}: 100_000_000,
+				{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 1000}:   100_000_000,
+			},
+			// Expected balances are initial balance + balance change due to order - fees
+			expectedQuoteBalances: map[satypes.SubaccountId]int64{
+				constants.Alice_Num0: constants.Usdc_Asset_100_000.GetBigQuantums().Int64() - 50_000_000 - 10_000,
+				constants.Bob_Num0:   constants.Usdc_Asset_100_000.GetBigQuantums().Int64() + 50_000_000 - 25_000,
+			},
+			// The base asset is transferred from the seller to the buyer, and no perpetual positions are opened.
+			expectedAssetPositions: map[satypes.SubaccountId][]*satypes.AssetPosition{
+				constants.Alice_Num0: {
+					{
+						AssetId:  constants.Usdc.Id,
+						Quantums: dtypes.NewInt(100_000_000_000 - 50_000_000 - 10_000),
+					},
+					{
+						AssetId:  constants.BtcUsd.Id,
+						Quantums: dtypes.NewInt(100_000_000),
+					},
+				},
+				constants.Bob_Num0: {
+					{
+						AssetId:  constants.Usdc.Id,
+						Quantums: dtypes.NewInt(100_000_000_000 + 50_000_000 - 25_000),
+					},
+				},
+			},
+			expectedPerpetualPositions: map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+				constants.Alice_Num0: {},
+				constants.Bob_Num0:   {},
+			},
+		},
+		"Fails when a spot match would leave the seller with a negative base asset balance": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			perpetuals:         []*perptypes.Perpetual{},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Spot_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_100_000,
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_100_000,
+					},
+				},
+			},
+			preExistingStatefulOrders: []types.Order{},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 1000},
+						Side:         types.Order_SIDE_BUY,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+				),
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 1000},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+				),
+				clobtest.NewMatchOperationRaw(
+					&types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 1000},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     100_000_000, // 1 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+					[]types.MakerFill{
+						{
+							FillAmount:   100_000_000,
+							MakerOrderId: types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 1000},
+						},
+					},
+				),
+			},
+			expectedError: satypes.ErrFailedToUpdateSubaccounts,
+		},
 	}
 
 	for name, tc := range tests {
@@ -1435,6 +1856,7 @@ func TestGenerateProcessProposerMatchesEvents(t *testing.T) {
 				RemovedStatefulOrderIds:                 []types.OrderId{},
 				PlacedConditionalOrderIds:               []types.OrderId{},
 				ConditionalOrderIdsTriggeredInLastBlock: []types.OrderId{},
+				ReplacedLongTermOrderIds:                []types.OrderId{},
 				BlockHeight:                             blockHeight,
 			},
 		},
@@ -1467,6 +1889,7 @@ func TestGenerateProcessProposerMatchesEvents(t *testing.T) {
 				RemovedStatefulOrderIds:                 []types.OrderId{},
 				PlacedConditionalOrderIds:               []types.OrderId{},
 				ConditionalOrderIdsTriggeredInLastBlock: []types.OrderId{},
+				ReplacedLongTermOrderIds:                []types.OrderId{},
 				BlockHeight:                             blockHeight,
 			},
 		},
@@ -1495,6 +1918,7 @@ func TestGenerateProcessProposerMatchesEvents(t *testing.T) {
 				RemovedStatefulOrderIds:                 []types.OrderId{},
 				PlacedConditionalOrderIds:               []types.OrderId{},
 				ConditionalOrderIdsTriggeredInLastBlock: []types.OrderId{},
+				ReplacedLongTermOrderIds:                []types.OrderId{},
 				BlockHeight:                             blockHeight,
 			},
 		},
@@ -1527,6 +1951,7 @@ func TestGenerateProcessProposerMatchesEvents(t *testing.T) {
 				RemovedStatefulOrderIds:                 []types.OrderId{},
 				PlacedConditionalOrderIds:               []types.OrderId{},
 				ConditionalOrderIdsTriggeredInLastBlock: []types.OrderId{},
+				ReplacedLongTermOrderIds:                []types.OrderId{},
 				BlockHeight:                             blockHeight,
 			},
 		},
@@ -1544,6 +1969,7 @@ func TestGenerateProcessProposerMatchesEvents(t *testing.T) {
 				RemovedStatefulOrderIds:                 []types.OrderId{},
 				PlacedConditionalOrderIds:               []types.OrderId{},
 				ConditionalOrderIdsTriggeredInLastBlock: []types.OrderId{},
+				ReplacedLongTermOrderIds:                []types.OrderId{},
 				BlockHeight:                             blockHeight,
 			},
 		},
@@ -1569,6 +1995,7 @@ func TestGenerateProcessProposerMatchesEvents(t *testing.T) {
 				},
 				PlacedConditionalOrderIds:               []types.OrderId{},
 				ConditionalOrderIdsTriggeredInLastBlock: []types.OrderId{},
+				ReplacedLongTermOrderIds:                []types.OrderId{},
 				BlockHeight:                             blockHeight,
 			},
 		},
@@ -1630,12 +2057,15 @@ func setupProcessProposerOperationsTestCase(
 	ctx = ks.Ctx.WithIsCheckTx(false)
 
 	// Assert Indexer messages
-	if tc.expectedError == nil && tc.expectedPanics == "" && len(tc.expectedMatches) > 0 {
+	if tc.expectedError == nil && tc.expectedPanics == "" &&
+		(len(tc.expectedMatches) > 0 || len(tc.expectedSpotOrderFillEvents) > 0) {
 		setupNewMockEventManager(
 			t,
 			ctx,
 			mockIndexerEventManager,
 			tc.expectedMatches,
+			tc.expectedDeleveragingEvents,
+			tc.expectedSpotOrderFillEvents,
 			tc.rawOperations,
 		)
 	} else {
@@ -1661,6 +2091,22 @@ func setupProcessProposerOperationsTestCase(
 	err := keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper)
 	require.NoError(t, err)
 
+	// Create all non-USDC assets.
+	for _, a := range tc.assets {
+		_, err := ks.AssetsKeeper.CreateAsset(
+			ctx,
+			a.Id,
+			a.Symbol,
+			a.Denom,
+			a.DenomExponent,
+			a.HasMarket,
+			a.MarketId,
+			a.AtomicResolution,
+			a.CollateralWeightPpm,
+		)
+		require.NoError(t, err)
+	}
+
 	// Create all perpetuals.
 	for _, p := range tc.perpetuals {
 		_, err := ks.PerpetualsKeeper.CreatePerpetual(
@@ -1671,6 +2117,9 @@ func setupProcessProposerOperationsTestCase(
 			p.Params.AtomicResolution,
 			p.Params.DefaultFundingPpm,
 			p.Params.LiquidityTier,
+			p.Params.FundingRateBounds,
+			p.Params.InterestRatePpm,
+			p.Params.InsuranceFundScope,
 		)
 		require.NoError(t, err)
 	}
@@ -1682,6 +2131,21 @@ func setupProcessProposerOperationsTestCase(
 
 	// Create all CLOBs.
 	for i, clobPair := range tc.clobPairs {
+		if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+			_, err = ks.ClobKeeper.CreateSpotClobPair(
+				ctx,
+				clobPair.Id,
+				spotClobMetadata.BaseAssetId,
+				spotClobMetadata.QuoteAssetId,
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				clobPair.Status,
+			)
+			require.NoError(t, err)
+			continue
+		}
+
 		perpetualId := clobtest.MustPerpetualId(clobPair)
 		// PerpetualMarketCreateEvents are emitted when initializing the genesis state, so we need to mock
 		// the indexer event manager to expect these events.
@@ -1831,6 +2295,11 @@ func runProcessProposerOperationsTestCase(
 	// Verify subaccount state.
 	assertSubaccountState(t, ctx, ks.SubaccountsKeeper, tc.expectedQuoteBalances, tc.expectedPerpetualPositions)
 
+	for subaccountId, assetPositions := range tc.expectedAssetPositions {
+		subaccount := ks.SubaccountsKeeper.GetSubaccount(ctx, subaccountId)
+		require.Equal(t, assetPositions, subaccount.AssetPositions)
+	}
+
 	for orderId, fillAmount := range tc.expectedFillAmounts {
 		_, actualFillAmount, _ := ks.ClobKeeper.GetOrderFillAmount(ctx, orderId)
 		require.Equal(t, fillAmount, actualFillAmount)
@@ -1847,9 +2316,11 @@ func setupNewMockEventManager(
 	ctx sdk.Context,
 	mockIndexerEventManager *mocks.IndexerEventManager,
 	matches []*MatchWithOrdersForTesting,
+	deleveragingEvents []*indexerevents.DeleveragingEventV1,
+	spotOrderFillEvents []*indexerevents.SpotOrderFillEventV1,
 	rawOperations []types.OperationRaw,
 ) {
-	if len(matches) > 0 {
+	if len(matches) > 0 || len(spotOrderFillEvents) > 0 {
 		mockIndexerEventManager.On("Enabled").Return(true)
 	}
 
@@ -1896,6 +2367,24 @@ func setupNewMockEventManager(
 		}
 	}
 
+	for _, deleveragingEvent := range deleveragingEvents {
+		mockIndexerEventManager.On("AddTxnEvent",
+			mock.Anything,
+			indexerevents.SubtypeDeleveraging,
+			indexerevents.DeleveragingEventVersion,
+			indexer_manager.GetBytes(deleveragingEvent),
+		).Once().Return()
+	}
+
+	for _, spotOrderFillEvent := range spotOrderFillEvents {
+		mockIndexerEventManager.On("AddTxnEvent",
+			mock.Anything,
+			indexerevents.SubtypeSpotOrderFill,
+			indexerevents.SpotOrderFillEventVersion,
+			indexer_manager.GetBytes(spotOrderFillEvent),
+		).Once().Return()
+	}
+
 	for _, operation := range rawOperations {
 		if removal, ok := operation.Operation.(*types.OperationRaw_OrderRemoval); ok {
 			mockIndexerEventManager.On("AddTxnEvent",
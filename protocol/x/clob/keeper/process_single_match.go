@@ -25,7 +25,7 @@ import (
 // If additional validation of the provided orders or match fails, an error is returned.
 // The following validation occurs in this method:
 //   - Order is for a valid ClobPair.
-//   - Order is for a valid Perpetual.
+//   - Order is for a valid Perpetual, or is not a liquidation order if the ClobPair is a spot ClobPair.
 //   - Validate the `fillAmount` of a match is divisible by the `ClobPair`'s `StepBaseQuantums`.
 //   - Validate the new total fill amount of an order does not exceed the total quantums of the order given
 //     the fill amounts present in the provided `matchOrders` and in state.
@@ -127,10 +127,23 @@ func (k Keeper) ProcessSingleMatch(
 		)
 	}
 
-	// Retrieve the associated perpetual id for the `ClobPair`.
-	perpetualId, err := clobPair.GetPerpetualId()
-	if err != nil {
-		return false, takerUpdateResult, makerUpdateResult, nil, err
+	// Retrieve the associated perpetual id for the `ClobPair`. Spot `ClobPair`s have no associated
+	// perpetual and cannot be used to liquidate subaccounts.
+	var perpetualId uint32
+	if clobPair.IsSpotClobPair() {
+		if takerMatchableOrder.IsLiquidation() {
+			return false, takerUpdateResult, makerUpdateResult, nil, errorsmod.Wrapf(
+				types.ErrNotPerpetualClobPair,
+				"ProcessSingleMatch: liquidation order %+v is for spot ClobPair %d",
+				takerMatchableOrder,
+				clobPair.Id,
+			)
+		}
+	} else {
+		perpetualId, err = clobPair.GetPerpetualId()
+		if err != nil {
+			return false, takerUpdateResult, makerUpdateResult, nil, err
+		}
 	}
 
 	// Calculate taker and maker fee ppms.
@@ -218,7 +231,7 @@ func (k Keeper) ProcessSingleMatch(
 	takerUpdateResult, makerUpdateResult, err = k.persistMatchedOrders(
 		ctx,
 		matchWithOrders,
-		perpetualId,
+		clobPair,
 		takerFeePpm,
 		makerFeePpm,
 		bigFillQuoteQuantums,
@@ -296,14 +309,15 @@ func (k Keeper) ProcessSingleMatch(
 
 // persistMatchedOrders persists a matched order to the subaccount state,
 // by updating the quoteBalance and perpetual position size of the
-// affected subaccounts.
+// affected subaccounts. For spot CLOB pairs, the base asset position is
+// updated instead of the perpetual position.
 // This method also transfers fees to the fee collector module, and
 // transfers insurance fund payments to the insurance fund.
 // This method mutates matchWithOrders by setting the fee fields.
 func (k Keeper) persistMatchedOrders(
 	ctx sdk.Context,
 	matchWithOrders *types.MatchWithOrders,
-	perpetualId uint32,
+	clobPair types.ClobPair,
 	takerFeePpm int32,
 	makerFeePpm int32,
 	bigFillQuoteQuantums *big.Int,
@@ -361,40 +375,57 @@ func (k Keeper) persistMatchedOrders(
 		bigTakerQuoteBalanceDelta.Sub(bigTakerQuoteBalanceDelta, insuranceFundDelta)
 	}
 
-	// Create the subaccount update.
-	updates := []satypes.Update{
-		// Taker update
-		{
-			AssetUpdates: []satypes.AssetUpdate{
-				{
-					AssetId:          assettypes.AssetUsdc.Id,
-					BigQuantumsDelta: bigTakerQuoteBalanceDelta,
-				},
+	// Create the subaccount updates.
+	takerUpdate := satypes.Update{
+		AssetUpdates: []satypes.AssetUpdate{
+			{
+				AssetId:          assettypes.AssetUsdc.Id,
+				BigQuantumsDelta: bigTakerQuoteBalanceDelta,
 			},
-			PerpetualUpdates: []satypes.PerpetualUpdate{
-				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: bigTakerPerpetualQuantumsDelta,
-				},
+		},
+		SubaccountId: matchWithOrders.TakerOrder.GetSubaccountId(),
+	}
+	makerUpdate := satypes.Update{
+		AssetUpdates: []satypes.AssetUpdate{
+			{
+				AssetId:          assettypes.AssetUsdc.Id,
+				BigQuantumsDelta: bigMakerQuoteBalanceDelta,
 			},
-			SubaccountId: matchWithOrders.TakerOrder.GetSubaccountId(),
 		},
-		// Maker update
-		{
-			AssetUpdates: []satypes.AssetUpdate{
-				{
-					AssetId:          assettypes.AssetUsdc.Id,
-					BigQuantumsDelta: bigMakerQuoteBalanceDelta,
-				},
+		SubaccountId: matchWithOrders.MakerOrder.GetSubaccountId(),
+	}
+
+	spotClobMetadata := clobPair.GetSpotClobMetadata()
+	var perpetualId uint32
+	if spotClobMetadata != nil {
+		// Spot fills are settled by transferring the base asset between the subaccounts.
+		takerUpdate.AssetUpdates = append(takerUpdate.AssetUpdates, satypes.AssetUpdate{
+			AssetId:          spotClobMetadata.BaseAssetId,
+			BigQuantumsDelta: bigTakerPerpetualQuantumsDelta,
+		})
+		makerUpdate.AssetUpdates = append(makerUpdate.AssetUpdates, satypes.AssetUpdate{
+			AssetId:          spotClobMetadata.BaseAssetId,
+			BigQuantumsDelta: bigMakerPerpetualQuantumsDelta,
+		})
+	} else {
+		perpetualId = clobPair.MustGetPerpetualId()
+		takerUpdate.PerpetualUpdates = []satypes.PerpetualUpdate{
+			{
+				PerpetualId:      perpetualId,
+				BigQuantumsDelta: bigTakerPerpetualQuantumsDelta,
 			},
-			PerpetualUpdates: []satypes.PerpetualUpdate{
-				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: bigMakerPerpetualQuantumsDelta,
-				},
+		}
+		makerUpdate.PerpetualUpdates = []satypes.PerpetualUpdate{
+			{
+				PerpetualId:      perpetualId,
+				BigQuantumsDelta: bigMakerPerpetualQuantumsDelta,
 			},
-			SubaccountId: matchWithOrders.MakerOrder.GetSubaccountId(),
-		},
+		}
+	}
+
+	updates := []satypes.Update{
+		takerUpdate,
+		makerUpdate,
 	}
 
 	// Apply the update.
@@ -430,7 +461,7 @@ func (k Keeper) persistMatchedOrders(
 		)
 	}
 
-	if err := k.subaccountsKeeper.TransferInsuranceFundPayments(ctx, insuranceFundDelta); err != nil {
+	if err := k.subaccountsKeeper.TransferInsuranceFundPayments(ctx, insuranceFundDelta, perpetualId); err != nil {
 		return takerUpdateResult, makerUpdateResult, err
 	}
 
@@ -470,22 +501,38 @@ func (k Keeper) persistMatchedOrders(
 	)
 
 	// Emit an event indicating a match occurred.
-	ctx.EventManager().EmitEvent(
-		types.NewCreateMatchEvent(
-			matchWithOrders.TakerOrder.GetSubaccountId(),
-			matchWithOrders.MakerOrder.GetSubaccountId(),
-			bigTakerFeeQuoteQuantums,
-			bigMakerFeeQuoteQuantums,
-			bigTakerQuoteBalanceDelta,
-			bigMakerQuoteBalanceDelta,
-			bigTakerPerpetualQuantumsDelta,
-			bigMakerPerpetualQuantumsDelta,
-			insuranceFundDelta,
-			isTakerLiquidation,
-			false,
-			perpetualId,
-		),
-	)
+	if spotClobMetadata != nil {
+		ctx.EventManager().EmitEvent(
+			types.NewCreateSpotMatchEvent(
+				matchWithOrders.TakerOrder.GetSubaccountId(),
+				matchWithOrders.MakerOrder.GetSubaccountId(),
+				bigTakerFeeQuoteQuantums,
+				bigMakerFeeQuoteQuantums,
+				bigTakerQuoteBalanceDelta,
+				bigMakerQuoteBalanceDelta,
+				bigTakerPerpetualQuantumsDelta,
+				bigMakerPerpetualQuantumsDelta,
+				spotClobMetadata.BaseAssetId,
+			),
+		)
+	} else {
+		ctx.EventManager().EmitEvent(
+			types.NewCreateMatchEvent(
+				matchWithOrders.TakerOrder.GetSubaccountId(),
+				matchWithOrders.MakerOrder.GetSubaccountId(),
+				bigTakerFeeQuoteQuantums,
+				bigMakerFeeQuoteQuantums,
+				bigTakerQuoteBalanceDelta,
+				bigMakerQuoteBalanceDelta,
+				bigTakerPerpetualQuantumsDelta,
+				bigMakerPerpetualQuantumsDelta,
+				insuranceFundDelta,
+				isTakerLiquidation,
+				false,
+				perpetualId,
+			),
+		)
+	}
 
 	return takerUpdateResult, makerUpdateResult, nil
 }
@@ -534,7 +581,7 @@ func (k Keeper) setOrderFillAmountsAndPruning(
 		pruneableBlockHeight,
 	)
 
-	if k.GetIndexerEventManager().Enabled() {
+	if k.GenerateOffchainUpdates() {
 		if _, exists := k.MemClob.GetOrder(ctx, order.OrderId); exists {
 			// Generate an off-chain update message updating the total filled amount of order.
 			if message, success := off_chain_updates.CreateOrderUpdateMessage(
@@ -63,6 +63,31 @@ func (k *Keeper) RateLimitPlaceOrder(ctx sdk.Context, msg *types.MsgPlaceOrder)
 	return k.placeOrderRateLimiter.RateLimit(ctx, msg)
 }
 
+// RateLimitBatchPlaceAndCancel rate limits each cancellation and placement in the batch as if it were
+// sent as a separate `MsgCancelOrder` or `MsgPlaceOrder`, so that every order in the batch counts
+// against the existing block rate limit configuration.
+// The rate limiting is only performed during `CheckTx` and `ReCheckTx`.
+func (k *Keeper) RateLimitBatchPlaceAndCancel(ctx sdk.Context, msg *types.MsgBatchPlaceAndCancel) error {
+	// Only rate limit during `CheckTx` and `ReCheckTx`.
+	if lib.IsDeliverTxMode(ctx) {
+		return nil
+	}
+
+	for i := range msg.Cancels {
+		if err := k.RateLimitCancelOrder(ctx, &msg.Cancels[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := range msg.Places {
+		if err := k.RateLimitPlaceOrder(ctx, &msg.Places[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (k *Keeper) PruneRateLimits(ctx sdk.Context) {
 	k.placeOrderRateLimiter.PruneRateLimits(ctx)
 	k.cancelOrderRateLimiter.PruneRateLimits(ctx)
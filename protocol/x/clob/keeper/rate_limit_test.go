@@ -41,3 +41,26 @@ func TestRateLimitCancelOrderIsNoopOutsideOfCheckTxAndReCheckTx(t *testing.T) {
 	// We don't expect any checks from occurring.
 	require.Nil(t, tApp.App.ClobKeeper.RateLimitCancelOrder(deliverTxCtx, msg))
 }
+
+func TestRateLimitBatchPlaceAndCancelIsNoopOutsideOfCheckTxAndReCheckTx(t *testing.T) {
+	tApp := testApp.NewTestAppBuilder(t).Build()
+	checkTxCtx := tApp.AdvanceToBlock(21, testApp.AdvanceToBlockOptions{})
+	deliverTxCtx := checkTxCtx.WithIsCheckTx(false).WithIsReCheckTx(false)
+	msg := clobtypes.NewMsgBatchPlaceAndCancel(
+		constants.Alice_Num0,
+		[]clobtypes.MsgCancelOrder{
+			*clobtypes.NewMsgCancelOrderShortTerm(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price5_GTB20.OrderId, 20),
+		},
+		nil,
+	)
+
+	// We expect an error and that the GTB is out of bounds.
+	require.Error(
+		t,
+		tApp.App.ClobKeeper.RateLimitBatchPlaceAndCancel(checkTxCtx, msg),
+		"GoodTilBlock 20 is less than the current blockHeight 22",
+	)
+
+	// We don't expect any checks from occurring.
+	require.Nil(t, tApp.App.ClobKeeper.RateLimitBatchPlaceAndCancel(deliverTxCtx, msg))
+}
@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// ReplaceShortTermOrder replaces the Short-Term order resting on the orderbook that has the same order ID as
+// the order in the provided `MsgReplaceOrder`, and performs matching if the replacement order causes an
+// overlap. This function will return the result of calling `ReplaceOrder` on the keeper's memclob. This
+// method is meant to be used in the CheckTx flow. It uses the next block height.
+//
+// An error will be returned if any of the following conditions are true:
+//   - The order to replace does not exist on the orderbook.
+//   - The order is not a valid replacement of the order to replace.
+//   - Standard stateful validation fails.
+//   - The order would be fully filled by the filled amount of the order to replace.
+//   - The memclob itself returns an error.
+//
+// This method will panic if the provided order is not a Short-Term order.
+func (k Keeper) ReplaceShortTermOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	err error,
+) {
+	msg.Order.OrderId.MustBeShortTermOrder()
+
+	lib.AssertCheckTxMode(ctx)
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+
+	order := msg.GetOrder()
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.ReplaceOrder, metrics.Latency)
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.ReplaceOrder,
+			metrics.CheckTx,
+			order.GetOrderLabels()...,
+		)
+	}()
+
+	existingOrder, found := k.MemClob.GetOrder(ctx, order.OrderId)
+	if !found {
+		return 0, 0, errorsmod.Wrapf(
+			types.ErrOrderToReplaceDoesNotExist,
+			"ReplaceShortTermOrder: order (%+v)",
+			order,
+		)
+	}
+
+	if err := order.ValidateIsReplacementOf(&existingOrder); err != nil {
+		return 0, 0, err
+	}
+
+	// Perform stateful validation.
+	if err := k.PerformStatefulOrderValidation(ctx, &order, nextBlockHeight, true); err != nil {
+		return 0, 0, err
+	}
+
+	// Ensure the order would not be fully filled by the filled amount of the order it replaces, since
+	// a resting maker order with no remaining amount can't be matched.
+	if exists, fillAmount, _ := k.GetOrderFillAmount(ctx, order.OrderId); exists &&
+		fillAmount >= order.GetBaseQuantums() {
+		return 0, 0, errorsmod.Wrapf(
+			types.ErrInvalidReplacementOrder,
+			"ReplaceShortTermOrder: order (%+v) size is less than or equal to its filled amount (%d)",
+			order,
+			fillAmount,
+		)
+	}
+
+	// Replace the order on the memclob and return the result.
+	orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err := k.MemClob.ReplaceOrder(
+		ctx,
+		order,
+	)
+
+	// Send off-chain updates generated from replacing the order.
+	k.sendOffchainMessagesWithTxHash(
+		offchainUpdates,
+		tmhash.Sum(ctx.TxBytes()),
+		metrics.SendReplaceOrderOffchainUpdates,
+	)
+
+	return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, err
+}
+
+// ReplaceStatefulOrder performs replacement order validation and a collateralization check on the
+// Long-Term order in the provided `MsgReplaceOrder`, and writes the order to state in place of the
+// existing order with the same order ID. The order will not be placed on the orderbook.
+//
+// An error will be returned if any of the following conditions are true:
+//   - The order to replace does not exist in state.
+//   - The order is not a valid replacement of the order to replace.
+//   - Standard stateful validation fails.
+//   - The order would be fully filled by the filled amount of the order to replace.
+//   - Collateralization check fails.
+//
+// Note that state is only written to during `DeliverTx`. The existing placement index of the order is
+// retained if the replacement order retains the queue priority of the order it replaces.
+//
+// This method will panic if the provided order is not a Stateful order.
+func (k Keeper) ReplaceStatefulOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (err error) {
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.ReplaceStatefulOrder,
+			metrics.GetCallbackMetricFromCtx(ctx),
+		)
+	}()
+
+	// 1. Ensure the order is not a Short-Term order.
+	order := msg.Order
+	order.OrderId.MustBeStatefulOrder()
+
+	// 2. Ensure the order to replace exists in state and the order is a valid replacement for it.
+	existingOrderPlacement, found := k.GetLongTermOrderPlacement(ctx, order.OrderId)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrOrderToReplaceDoesNotExist,
+			"ReplaceStatefulOrder: order (%+v)",
+			order,
+		)
+	}
+	existingOrder := existingOrderPlacement.GetOrder()
+	if err := order.ValidateIsReplacementOf(&existingOrder); err != nil {
+		return err
+	}
+
+	// 3. Perform stateful validation on the order.
+	if err := k.PerformStatefulOrderValidation(
+		ctx,
+		&order,
+		// Note that the blockHeight is not used during stateful order validation.
+		0,
+		true,
+	); err != nil {
+		return err
+	}
+
+	// 4. Ensure the order would not be fully filled by the filled amount of the order it replaces.
+	if exists, fillAmount, _ := k.GetOrderFillAmount(ctx, order.OrderId); exists &&
+		fillAmount >= order.GetBaseQuantums() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidReplacementOrder,
+			"ReplaceStatefulOrder: order (%+v) size is less than or equal to its filled amount (%d)",
+			order,
+			fillAmount,
+		)
+	}
+
+	// 5. Perform a collateralization check for the full size of the order to mitigate spam.
+	_, successPerSubaccountUpdate := k.AddOrderToOrderbookCollatCheck(
+		ctx,
+		order.GetClobPairId(),
+		map[satypes.SubaccountId][]types.PendingOpenOrder{
+			order.OrderId.SubaccountId: {
+				{
+					RemainingQuantums: order.GetBaseQuantums(),
+					IsBuy:             order.IsBuy(),
+					Subticks:          order.GetOrderSubticks(),
+					ClobPairId:        order.GetClobPairId(),
+				},
+			},
+		},
+	)
+
+	if !successPerSubaccountUpdate[order.OrderId.SubaccountId].IsSuccess() {
+		return errorsmod.Wrapf(
+			types.ErrStatefulOrderCollateralizationCheckFailed,
+			"ReplaceStatefulOrder: order (%+v), result (%s)",
+			order,
+			successPerSubaccountUpdate[order.OrderId.SubaccountId].String(),
+		)
+	}
+
+	// 6. If we are in `deliverTx` then we write the replacement order to committed state.
+	if lib.IsDeliverTxMode(ctx) {
+		k.MustReplaceLongTermOrderPlacement(
+			ctx,
+			order,
+			lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+			order.CanRetainQueuePriority(&existingOrder),
+		)
+	}
+
+	return nil
+}
+
+// ReplaceStatefulOrdersFromLastBlock validates and replaces the Long-Term orders replaced in the last block
+// on the memclob. This is called in `PrepareCheckState` after stateful orders placed in the last block have
+// been placed on the memclob. Orders which no longer exist in state, or which have already been replaced on
+// the memclob, are skipped.
+func (k Keeper) ReplaceStatefulOrdersFromLastBlock(
+	ctx sdk.Context,
+	replacedStatefulOrderIds []types.OrderId,
+	existingOffchainUpdates *types.OffchainUpdates,
+) (
+	offchainUpdates *types.OffchainUpdates,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	for _, orderId := range replacedStatefulOrderIds {
+		orderId.MustBeStatefulOrder()
+
+		orderPlacement, exists := k.GetLongTermOrderPlacement(ctx, orderId)
+		if !exists {
+			// Order does not exist in state and therefore should not be placed. This likely
+			// indicates that the order was cancelled or removed.
+			continue
+		}
+
+		order := orderPlacement.GetOrder()
+		if existingOrder, found := k.MemClob.GetOrder(ctx, orderId); found &&
+			existingOrder.GetOrderHash() == order.GetOrderHash() {
+			continue
+		}
+
+		// Validate and replace the order.
+		var orderStatus types.OrderStatus
+		var replaceOrderOffchainUpdates *types.OffchainUpdates
+		err := k.PerformStatefulOrderValidation(ctx, &order, 0, true)
+		if err == nil {
+			_, orderStatus, replaceOrderOffchainUpdates, err = k.MemClob.ReplaceOrder(ctx, order)
+		}
+
+		if err != nil {
+			k.Logger(ctx).Debug(
+				fmt.Sprintf(
+					"ReplaceStatefulOrdersFromLastBlock: ReplaceOrder() returned an error %+v for order %+v",
+					err,
+					order,
+				),
+			)
+
+			if k.GenerateOffchainUpdates() && off_chain_updates.ShouldSendOrderRemovalOnReplay(err) {
+				if message, success := off_chain_updates.CreateOrderRemoveMessageWithDefaultReason(
+					k.Logger(ctx),
+					order.OrderId,
+					orderStatus,
+					err,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
+				); success {
+					existingOffchainUpdates.AddRemoveMessage(order.OrderId, message)
+				}
+			}
+		} else if k.GenerateOffchainUpdates() {
+			existingOffchainUpdates.Append(replaceOrderOffchainUpdates)
+		}
+	}
+
+	// Clear replace messages as BEST_EFFORT_OPEN messages should not be
+	// sent for stateful order replacements.
+	existingOffchainUpdates.CondenseMessagesForReplay()
+
+	return existingOffchainUpdates
+}
+
+// isShortTermOrderReplacement returns true if the context's TX bytes contain a `MsgReplaceOrder`
+// that replaces the provided Short-Term order.
+func (k Keeper) isShortTermOrderReplacement(
+	ctx sdk.Context,
+	order types.Order,
+) bool {
+	if !order.IsShortTermOrder() {
+		return false
+	}
+
+	tx, err := k.txDecoder(ctx.TxBytes())
+	if err != nil {
+		return false
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return false
+	}
+
+	msg, ok := msgs[0].(*types.MsgReplaceOrder)
+	return ok && msg.Order.GetOrderHash() == order.GetOrderHash()
+}
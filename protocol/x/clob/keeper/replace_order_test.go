@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceShortTermOrder(t *testing.T) {
+	replacementOrder := types.Order{
+		OrderId:      constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId,
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     50_000_000,
+		Subticks:     49_500_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 11},
+	}
+
+	tests := map[string]struct {
+		// State.
+		existingOrders     []types.Order
+		existingFillAmount satypes.BaseQuantums
+
+		// Parameters.
+		order types.Order
+
+		// Expectations.
+		expectedErr    error
+		expectedStatus types.OrderStatus
+		expectedOrder  types.Order
+	}{
+		"Replaces an existing order": {
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			order:          replacementOrder,
+			expectedStatus: types.Success,
+			expectedOrder:  replacementOrder,
+		},
+		"Fails if the order to replace does not exist": {
+			order:       replacementOrder,
+			expectedErr: types.ErrOrderToReplaceDoesNotExist,
+		},
+		"Fails if the replacement does not increase the GoodTilBlock": {
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			order: types.Order{
+				OrderId:      constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId,
+				Side:         types.Order_SIDE_BUY,
+				Quantums:     50_000_000,
+				Subticks:     49_500_000_000,
+				GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 10},
+			},
+			expectedErr:   types.ErrInvalidReplacementOrder,
+			expectedOrder: constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+		},
+		"Fails if the replacement changes the side of the order": {
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			order: types.Order{
+				OrderId:      constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10.OrderId,
+				Side:         types.Order_SIDE_SELL,
+				Quantums:     50_000_000,
+				Subticks:     49_500_000_000,
+				GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 11},
+			},
+			expectedErr:   types.ErrInvalidReplacementOrder,
+			expectedOrder: constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+		},
+		"Fails if the replacement size is equal to the filled amount of the order": {
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			existingFillAmount: 50_000_000,
+			order:              replacementOrder,
+			expectedErr:        types.ErrInvalidReplacementOrder,
+			expectedOrder:      constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+		},
+		"Fails if the replacement size is less than the filled amount of the order": {
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			existingFillAmount: 60_000_000,
+			order:              replacementOrder,
+			expectedErr:        types.ErrInvalidReplacementOrder,
+			expectedOrder:      constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+		},
+		"Replaces an existing partially filled order": {
+			existingOrders: []types.Order{
+				constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price49500_GTB10,
+			},
+			existingFillAmount: 40_000_000,
+			order:              replacementOrder,
+			expectedStatus:     types.Success,
+			expectedOrder:      replacementOrder,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup keeper state.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockBankKeeper := &mocks.BankKeeper{}
+			mockBankKeeper.On(
+				"SendCoinsFromModuleToModule",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+			).Return(nil)
+
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, mockBankKeeper, indexer_manager.NewIndexerEventManagerNoop())
+			ctx := ks.Ctx.WithIsCheckTx(true)
+
+			// Create the default markets.
+			keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+
+			// Create liquidity tiers.
+			keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+
+			require.NoError(t, ks.FeeTiersKeeper.SetPerpetualFeeParams(ctx, constants.PerpetualFeeParamsNoFee))
+
+			// Set up USDC asset in assets module.
+			err := keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper)
+			require.NoError(t, err)
+
+			perpetual := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+			_, err = ks.PerpetualsKeeper.CreatePerpetual(
+				ctx,
+				perpetual.Params.Id,
+				perpetual.Params.Ticker,
+				perpetual.Params.MarketId,
+				perpetual.Params.AtomicResolution,
+				perpetual.Params.DefaultFundingPpm,
+				perpetual.Params.LiquidityTier,
+				perpetual.Params.FundingRateBounds,
+				perpetual.Params.InterestRatePpm,
+				perpetual.Params.InsuranceFundScope,
+			)
+			require.NoError(t, err)
+
+			ks.SubaccountsKeeper.SetSubaccount(ctx, constants.Carl_Num0_100000USD)
+
+			clobPair := constants.ClobPair_Btc
+			_, err = ks.ClobKeeper.CreatePerpetualClobPair(
+				ctx,
+				clobPair.Id,
+				clobtest.MustPerpetualId(clobPair),
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				clobPair.Status,
+			)
+			require.NoError(t, err)
+
+			for _, order := range tc.existingOrders {
+				_, _, err := ks.ClobKeeper.PlaceShortTermOrder(ctx, &types.MsgPlaceOrder{Order: order})
+				require.NoError(t, err)
+			}
+			if tc.existingFillAmount > 0 {
+				ks.ClobKeeper.SetOrderFillAmount(ctx, tc.order.OrderId, tc.existingFillAmount, 10)
+			}
+
+			// Run the test.
+			_, status, err := ks.ClobKeeper.ReplaceShortTermOrder(ctx, types.NewMsgReplaceOrder(tc.order))
+
+			// Verify test expectations.
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedStatus, status)
+			}
+
+			memclobOrder, found := memClob.GetOrder(ctx, tc.order.OrderId)
+			if len(tc.existingOrders) == 0 {
+				require.False(t, found)
+			} else {
+				require.True(t, found)
+				require.Equal(t, tc.expectedOrder, memclobOrder)
+			}
+		})
+	}
+}
@@ -81,6 +81,45 @@ func (k Keeper) SetLongTermOrderPlacement(
 	}
 }
 
+// MustReplaceLongTermOrderPlacement replaces the stateful order in state that has the same order ID as
+// `order`. If `retainPriority` is true the placement index of the replaced order is kept, otherwise the
+// order is placed at `blockHeight` with the next unused transaction index for this block, as if it were
+// newly placed. Note that replacement orders have the same expiry as the orders they replace, so the
+// stateful order expiry state is unchanged.
+// This function will panic if no stateful order exists in state with the order ID of `order`.
+func (k Keeper) MustReplaceLongTermOrderPlacement(
+	ctx sdk.Context,
+	order types.Order,
+	blockHeight uint32,
+	retainPriority bool,
+) {
+	existingOrderPlacement, found := k.GetLongTermOrderPlacement(ctx, order.OrderId)
+	if !found {
+		panic(
+			fmt.Sprintf(
+				"MustReplaceLongTermOrderPlacement: order does not exist in state. Order: %+v",
+				order,
+			),
+		)
+	}
+
+	if !retainPriority {
+		k.SetLongTermOrderPlacement(ctx, order, blockHeight)
+		return
+	}
+
+	longTermOrderPlacement := types.LongTermOrderPlacement{
+		Order:          order,
+		PlacementIndex: existingOrderPlacement.PlacementIndex,
+	}
+	longTermOrderPlacementBytes := k.cdc.MustMarshal(&longTermOrderPlacement)
+
+	store, memStore := k.fetchStateStoresForOrder(ctx, order.OrderId)
+	orderKey := order.OrderId.ToStateKey()
+	store.Set(orderKey, longTermOrderPlacementBytes)
+	memStore.Set(orderKey, longTermOrderPlacementBytes)
+}
+
 // GetTriggeredConditionalOrderPlacement gets an triggered conditional order placement from the memstore.
 // Returns false if no triggered conditional order exists in memstore with `orderId`.
 func (k Keeper) GetTriggeredConditionalOrderPlacement(
@@ -137,7 +176,8 @@ func (k Keeper) GetLongTermOrderPlacement(
 }
 
 // DeleteLongTermOrderPlacement deletes a long term order and the placement information from state
-// and decrements the stateful order count if the `orderId` exists.
+// and decrements the stateful order count if the `orderId` exists. If the order is paired with another
+// order as a one-cancels-other order, the pair is deleted from state as well.
 // This function is a no-op if no stateful order exists in state with `orderId`.
 func (k Keeper) DeleteLongTermOrderPlacement(
 	ctx sdk.Context,
@@ -169,6 +209,9 @@ func (k Keeper) DeleteLongTermOrderPlacement(
 	// Delete the `StatefulOrderPlacement` from memstore.
 	memStore.Delete(orderKey)
 
+	// Delete the one-cancels-other pair, if any, from state.
+	k.DeleteOneCancelsOtherOrderIds(ctx, orderId)
+
 	// Set the count.
 	k.SetStatefulOrderCount(ctx, orderId.SubaccountId, count)
 
@@ -283,6 +326,35 @@ func (k Keeper) MustTriggerConditionalOrder(
 	)
 }
 
+// MustSetUntriggeredConditionalOrderTriggerSubticks updates the trigger subticks of an untriggered
+// conditional order placement in state and the memstore. The placement index of the order is unchanged.
+// Function will panic if the order does not exist in Untriggered state.
+func (k Keeper) MustSetUntriggeredConditionalOrderTriggerSubticks(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	triggerSubticks types.Subticks,
+) {
+	// If this is not a conditional order, panic.
+	orderId.MustBeConditionalOrder()
+
+	longTermOrderPlacement, exists := k.GetUntriggeredConditionalOrderPlacement(ctx, orderId)
+	if !exists {
+		panic(
+			fmt.Sprintf(
+				"MustSetUntriggeredConditionalOrderTriggerSubticks: conditional order Id does not exist in "+
+					"Untriggered state: %+v",
+				orderId,
+			),
+		)
+	}
+	longTermOrderPlacement.Order.ConditionalOrderTriggerSubticks = triggerSubticks.ToUint64()
+
+	longTermOrderPlacementBytes := k.cdc.MustMarshal(&longTermOrderPlacement)
+	orderKey := orderId.ToStateKey()
+	k.GetUntriggeredConditionalOrderPlacementStore(ctx).Set(orderKey, longTermOrderPlacementBytes)
+	k.GetUntriggeredConditionalOrderPlacementMemStore(ctx).Set(orderKey, longTermOrderPlacementBytes)
+}
+
 // MustAddOrderToStatefulOrdersTimeSlice adds a new `OrderId` to an existing time slice, or creates a new time slice
 // containing the `OrderId` and writes it to state. It first sorts all order IDs before writing them
 // to state to avoid non-determinism issues.
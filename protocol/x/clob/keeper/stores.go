@@ -112,6 +112,15 @@ func (k Keeper) getStatefulOrdersTimeSliceStore(ctx sdk.Context) prefix.Store {
 	)
 }
 
+// getOneCancelsOtherStore fetches a state store used for creating,
+// reading, updating, and deleting one-cancels-other order pairs from state.
+func (k Keeper) getOneCancelsOtherStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.OneCancelsOtherKeyPrefix),
+	)
+}
+
 // getTransientStore fetches a transient store used for reading and
 // updating the transient store.
 func (k Keeper) getTransientStore(ctx sdk.Context) sdk.KVStore {
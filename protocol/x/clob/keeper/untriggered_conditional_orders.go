@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -21,12 +23,12 @@ import (
 // optimal runtime a an AVL-tree backed priority queue would work.
 // TODO(CLOB-717) Change list to use priority queue.
 type UntriggeredConditionalOrders struct {
-	// All untriggered take profit buy orders and stop loss sell orders sorted by time priority.
+	// All untriggered take profit buy orders and stop loss and trailing stop sell orders sorted by time priority.
 	// These orders will be triggered when the oracle price goes lower than or equal to the trigger price.
 	// This array functions like a max heap.
 	OrdersToTriggerWhenOraclePriceLTETriggerPrice []types.Order
 
-	// All untriggered take profit sell orders and stop loss buy orders sorted by time priority.
+	// All untriggered take profit sell orders and stop loss and trailing stop buy orders sorted by time priority.
 	// These orders will be triggered when the oracle price goes greater than or equal to the trigger price.
 	// This array functions like a min heap.
 	OrdersToTriggerWhenOraclePriceGTETriggerPrice []types.Order
@@ -105,7 +107,8 @@ func (untriggeredOrders *UntriggeredConditionalOrders) AddUntriggeredConditional
 		}
 	}
 
-	if order.IsStopLossOrder() {
+	// Trailing stop orders trigger in the same direction as stop loss orders.
+	if order.IsStopLossOrder() || order.IsTrailingStopOrder() {
 		if order.IsBuy() {
 			untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice = append(
 				untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
@@ -120,13 +123,14 @@ func (untriggeredOrders *UntriggeredConditionalOrders) AddUntriggeredConditional
 	}
 }
 
-// PruneUntriggeredConditionalOrders takes in lists of expired and cancelled stateful order ids and removes
-// all respective orders from the in-memory `UntriggeredConditionalOrders` data structure. This data structure
-// stores untriggered orders in a map of ClobPairId -> []Order, so we first group orders by ClobPairId and then
-// call `UntriggeredConditionalOrders.RemoveExpiredUntriggeredConditionalOrders` on each ClobPairId.
+// PruneUntriggeredConditionalOrders takes in lists of expired, cancelled and removed stateful order ids and
+// removes all respective orders from the in-memory `UntriggeredConditionalOrders` data structure. This data
+// structure stores untriggered orders in a map of ClobPairId -> []Order, so we first group orders by ClobPairId
+// and then call `UntriggeredConditionalOrders.RemoveExpiredUntriggeredConditionalOrders` on each ClobPairId.
 func (k Keeper) PruneUntriggeredConditionalOrders(
 	expiredStatefulOrderIds []types.OrderId,
 	cancelledStatefulOrderIds []types.OrderId,
+	removedStatefulOrderIds []types.OrderId,
 ) {
 	// Merge lists of order ids.
 	orderIdsToPrune := lib.UniqueSliceToSet(expiredStatefulOrderIds)
@@ -141,6 +145,9 @@ func (k Keeper) PruneUntriggeredConditionalOrders(
 		}
 		orderIdsToPrune[orderId] = struct{}{}
 	}
+	for _, orderId := range removedStatefulOrderIds {
+		orderIdsToPrune[orderId] = struct{}{}
+	}
 
 	prunableUntriggeredConditionalOrderIdsByClobPair := make(map[types.ClobPairId][]types.OrderId)
 	for orderId := range orderIdsToPrune {
@@ -216,6 +223,35 @@ func (untriggeredOrders *UntriggeredConditionalOrders) RemoveUntriggeredConditio
 	untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice = newOrdersToTriggerWhenOraclePriceGTETriggerPrice
 }
 
+// UpdateTrailingStopTriggerSubticks updates the trigger subticks of all trailing stop orders in the
+// `UntriggeredConditionalOrders` struct given a new oracle price for a clobPairId, moving the trigger
+// price of each order towards the oracle price as described in `Order.GetTrailingStopTriggerSubticks`.
+// It returns the list of orders whose trigger subticks were updated. This is only called in EndBlocker.
+func (untriggeredOrders *UntriggeredConditionalOrders) UpdateTrailingStopTriggerSubticks(
+	oraclePriceSubticksRat *big.Rat,
+	subticksPerTick types.SubticksPerTick,
+) []types.Order {
+	updatedOrders := make([]types.Order, 0)
+	for _, orders := range [][]types.Order{
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+	} {
+		for i := range orders {
+			order := &orders[i]
+			if !order.IsTrailingStopOrder() {
+				continue
+			}
+
+			triggerSubticks, updated := order.GetTrailingStopTriggerSubticks(oraclePriceSubticksRat, subticksPerTick)
+			if updated {
+				order.ConditionalOrderTriggerSubticks = triggerSubticks.ToUint64()
+				updatedOrders = append(updatedOrders, *order)
+			}
+		}
+	}
+	return updatedOrders
+}
+
 // PollTriggeredConditionalOrders removes all triggered conditional orders from the
 // `UntriggeredConditionalOrders` struct given a new oracle price for a clobPairId. It returns
 // a list of order ids that were triggered. This is only called in EndBlocker. We round up to the nearest
@@ -262,14 +298,23 @@ func (untriggeredOrders *UntriggeredConditionalOrders) PollTriggeredConditionalO
 }
 
 // MaybeTriggerConditionalOrders queries the prices module for price updates and triggers
-// any conditional orders in `UntriggeredConditionalOrders` that can be triggered. For each triggered
+// any conditional orders in `UntriggeredConditionalOrders` that can be triggered. Before polling
+// out triggered orders, the trigger prices of trailing stop orders are updated to follow the oracle
+// price, and the updated trigger prices are written to Untriggered state. For each triggered
 // order, it takes the stateful order placement stored in Untriggered state and moves it to Triggered state.
+// If the triggered order is paired with another order as a one-cancels-other order, the other order
+// is removed from state.
 // A conditional order trigger event is emitted for each triggered order.
 // Function returns a sorted list of conditional order ids that were triggered, intended to be written
-// to `ProcessProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock`.
+// to `ProcessProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock`, and a list of one-cancels-other
+// order ids that were removed, intended to be appended to `ProcessProposerMatchesEvents.RemovedStatefulOrderIds`.
 // This function is called in EndBlocker.
-func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditionalOrderIds []types.OrderId) {
-	triggeredConditionalOrderIds = make([]types.OrderId, 0)
+func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (
+	triggeredConditionalOrderIds []types.OrderId,
+	removedOneCancelsOtherOrderIds []types.OrderId,
+) {
+	polledConditionalOrderIds := make([]types.OrderId, 0)
+	updatedTrailingStopOrders := make([]types.Order, 0)
 	// Sort the keys for the untriggered conditional orders struct. We need to trigger
 	// the conditional orders in an ordered way to have deterministic state writes.
 	sortedKeys := lib.GetSortedKeys[types.SortedClobPairId](k.UntriggeredConditionalOrders)
@@ -287,18 +332,66 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditi
 				),
 			)
 		}
+		// Conditional orders are not triggered while the clob pair is not active, since triggered
+		// orders would be rejected by the clob pair's status. They remain untriggered until the
+		// clob pair is active again.
+		if clobPair.Status != types.ClobPair_STATUS_ACTIVE {
+			continue
+		}
 		currentOraclePriceSubticksRat := k.GetOraclePriceSubticksRat(ctx, clobPair)
+		updatedTrailingStopOrders = append(
+			updatedTrailingStopOrders,
+			untriggeredConditionalOrders.UpdateTrailingStopTriggerSubticks(
+				currentOraclePriceSubticksRat,
+				types.SubticksPerTick(clobPair.SubticksPerTick),
+			)...,
+		)
 		triggeredOrderIds := untriggeredConditionalOrders.PollTriggeredConditionalOrders(
 			currentOraclePriceSubticksRat,
 		)
-		triggeredConditionalOrderIds = append(triggeredConditionalOrderIds, triggeredOrderIds...)
+		polledConditionalOrderIds = append(polledConditionalOrderIds, triggeredOrderIds...)
 		// Set the modified untriggeredConditionalOrders back on the keeper field.
 		k.UntriggeredConditionalOrders[clobPairId] = untriggeredConditionalOrders
 	}
 
+	// State write - update the trigger subticks of trailing stop orders in Untriggered state.
+	// Emit an event for each updated trailing stop order.
+	for _, order := range updatedTrailingStopOrders {
+		k.MustSetUntriggeredConditionalOrderTriggerSubticks(
+			ctx,
+			order.OrderId,
+			types.Subticks(order.ConditionalOrderTriggerSubticks),
+		)
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewConditionalOrderTriggerSubticksUpdateEvent(
+					order.OrderId,
+					order.ConditionalOrderTriggerSubticks,
+				),
+			),
+		)
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.TrailingStopTriggerUpdated, metrics.Count},
+			1,
+			order.GetOrderLabels(),
+		)
+	}
+
 	// State write - move the conditional order placement in state from untriggered to triggered state.
 	// Emit an event for each triggered conditional order.
-	for _, triggeredConditionalOrderId := range triggeredConditionalOrderIds {
+	triggeredConditionalOrderIds = make([]types.OrderId, 0, len(polledConditionalOrderIds))
+	removedOneCancelsOtherOrderIds = make([]types.OrderId, 0)
+	removedOrderIdsSet := make(map[types.OrderId]struct{})
+	for _, triggeredConditionalOrderId := range polledConditionalOrderIds {
+		// Skip orders that were removed because the other order of their one-cancels-other pair
+		// was triggered earlier in this block.
+		if _, removed := removedOrderIdsSet[triggeredConditionalOrderId]; removed {
+			continue
+		}
+
 		k.MustTriggerConditionalOrder(
 			ctx,
 			triggeredConditionalOrderId,
@@ -313,8 +406,18 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditi
 				),
 			),
 		)
+		triggeredConditionalOrderIds = append(triggeredConditionalOrderIds, triggeredConditionalOrderId)
+
+		if otherOrderId, removed := k.MaybeRemoveOneCancelsOtherOrder(ctx, triggeredConditionalOrderId); removed {
+			removedOneCancelsOtherOrderIds = append(removedOneCancelsOtherOrderIds, otherOrderId)
+			removedOrderIdsSet[otherOrderId] = struct{}{}
+		}
 	}
-	return triggeredConditionalOrderIds
+
+	// Remove the one-cancels-other orders which are still untriggered from `UntriggeredConditionalOrders`.
+	k.PruneUntriggeredConditionalOrders(nil, nil, removedOneCancelsOtherOrderIds)
+
+	return triggeredConditionalOrderIds, removedOneCancelsOtherOrderIds
 }
 
 // CountUntriggeredSubaccountStatefulOrders will count all untriggered stateful conditional orders for a given
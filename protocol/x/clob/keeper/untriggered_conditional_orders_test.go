@@ -6,9 +6,16 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals"
+	"github.com/dydxprotocol/v4-chain/protocol/x/prices"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -66,6 +73,28 @@ func TestAddUntriggeredConditionalOrder(t *testing.T) {
 			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{},
 			expectedNumberOfMatches:                               1,
 		},
+		"Can add a trailing stop sell to the LTE array": {
+			conditionalOrdersToAdd: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_10Pct,
+			},
+
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_10Pct,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{},
+			expectedNumberOfMatches:                               1,
+		},
+		"Can add a trailing stop buy to the GTE array": {
+			conditionalOrdersToAdd: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price20_GTBT15_TrailingStop20_10Pct,
+			},
+
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price20_GTBT15_TrailingStop20_10Pct,
+			},
+			expectedNumberOfMatches: 1,
+		},
 		"Can add multiple conditional orders to both heaps": {
 			conditionalOrdersToAdd: []types.Order{
 				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20,
@@ -236,6 +265,70 @@ func TestRemoveUntriggeredConditionalOrders(t *testing.T) {
 	}
 }
 
+func TestUpdateTrailingStopTriggerSubticks(t *testing.T) {
+	trailingStopSell := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_10Pct
+	trailingStopBuy := constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price20_GTBT15_TrailingStop20_10Pct
+	stopLossSell := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20
+
+	tests := map[string]struct {
+		// Setup.
+		conditionalOrdersToAdd []types.Order
+		currentSubticks        *big.Rat
+
+		// Expectations.
+		expectedUpdatedTriggerSubticks map[types.OrderId]uint64
+	}{
+		"Trailing stop sell follows oracle price up": {
+			conditionalOrdersToAdd: []types.Order{trailingStopSell, stopLossSell},
+			currentSubticks:        big.NewRat(30, 1),
+			expectedUpdatedTriggerSubticks: map[types.OrderId]uint64{
+				trailingStopSell.OrderId: 27,
+			},
+		},
+		"Trailing stop buy follows oracle price down": {
+			conditionalOrdersToAdd: []types.Order{trailingStopBuy, stopLossSell},
+			currentSubticks:        big.NewRat(10, 1),
+			expectedUpdatedTriggerSubticks: map[types.OrderId]uint64{
+				trailingStopBuy.OrderId: 11,
+			},
+		},
+		"Trailing stops are not updated when oracle price moves towards trigger price": {
+			conditionalOrdersToAdd:         []types.Order{trailingStopSell},
+			currentSubticks:                big.NewRat(21, 1),
+			expectedUpdatedTriggerSubticks: map[types.OrderId]uint64{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			untriggeredConditionalOrders := keeper.NewUntriggeredConditionalOrders()
+			for _, order := range tc.conditionalOrdersToAdd {
+				untriggeredConditionalOrders.AddUntriggeredConditionalOrder(order)
+			}
+
+			updatedOrders := untriggeredConditionalOrders.UpdateTrailingStopTriggerSubticks(tc.currentSubticks, 1)
+			require.Len(t, updatedOrders, len(tc.expectedUpdatedTriggerSubticks))
+			for _, order := range updatedOrders {
+				require.Equal(t, tc.expectedUpdatedTriggerSubticks[order.OrderId], order.ConditionalOrderTriggerSubticks)
+			}
+
+			// Verify the untriggered orders were updated in place.
+			for _, orders := range [][]types.Order{
+				untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+				untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+			} {
+				for _, order := range orders {
+					if triggerSubticks, ok := tc.expectedUpdatedTriggerSubticks[order.OrderId]; ok {
+						require.Equal(t, triggerSubticks, order.ConditionalOrderTriggerSubticks)
+					} else {
+						require.Equal(t, uint64(20), order.ConditionalOrderTriggerSubticks)
+					}
+				}
+			}
+		})
+	}
+}
+
 func TestPollTriggeredConditionalOrders(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
@@ -454,3 +547,67 @@ func TestPollTriggeredConditionalOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestMaybeTriggerConditionalOrders_ClobPairStatus(t *testing.T) {
+	tests := map[string]struct {
+		status            types.ClobPair_Status
+		expectedTriggered bool
+	}{
+		"Triggers conditional orders for an active clob pair": {
+			status:            types.ClobPair_STATUS_ACTIVE,
+			expectedTriggered: true,
+		},
+		"Does not trigger conditional orders for a paused clob pair": {
+			status: types.ClobPair_STATUS_PAUSED,
+		},
+		"Does not trigger conditional orders for a cancel-only clob pair": {
+			status: types.ClobPair_STATUS_CANCEL_ONLY,
+		},
+		"Does not trigger conditional orders for a post-only clob pair": {
+			status: types.ClobPair_STATUS_POST_ONLY,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("CreateOrderbook", mock.Anything, mock.Anything).Return()
+			indexerEventManager := &mocks.IndexerEventManager{}
+			indexerEventManager.On("AddTxnEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return()
+
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+			prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+			perpetuals.InitGenesis(ks.Ctx, *ks.PerpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
+
+			clobPair := constants.ClobPair_Btc
+			_, err := ks.ClobKeeper.CreatePerpetualClobPair(
+				ks.Ctx,
+				clobPair.Id,
+				clobtest.MustPerpetualId(clobPair),
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				tc.status,
+			)
+			require.NoError(t, err)
+
+			// The stop loss buy order triggers at any oracle price of at least 20 subticks.
+			order := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+			ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, order, 0)
+			ks.ClobKeeper.HydrateUntriggeredConditionalOrders(ks.Ctx)
+
+			triggeredOrderIds, _ := ks.ClobKeeper.MaybeTriggerConditionalOrders(ks.Ctx)
+			if tc.expectedTriggered {
+				require.Equal(t, []types.OrderId{order.OrderId}, triggeredOrderIds)
+			} else {
+				require.Empty(t, triggeredOrderIds)
+				// The order remains untriggered until the clob pair is active again.
+				require.Len(t, ks.ClobKeeper.UntriggeredConditionalOrders[clobPair.GetClobPairId()].
+					OrdersToTriggerWhenOraclePriceGTETriggerPrice, 1)
+			}
+			require.Equal(t, tc.expectedTriggered, ks.ClobKeeper.IsConditionalOrderTriggered(ks.Ctx, order.OrderId))
+		})
+	}
+}
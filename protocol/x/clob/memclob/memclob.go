@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"runtime/debug"
+	"slices"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,7 +15,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
 	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -125,8 +128,8 @@ func (m *MemClobPriceTimePriority) CancelOrder(
 		if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 			m.clobKeeper.Logger(ctx),
 			orderIdToCancel,
-			indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
-			off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+			sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+			ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 		); success {
 			offchainUpdates.AddRemoveMessage(orderIdToCancel, message)
 		}
@@ -199,6 +202,83 @@ func (m *MemClobPriceTimePriority) GetOrderFilledAmount(
 	return orderStateFilledAmount
 }
 
+// GetOffchainUpdatesForOrderbookSnapshot returns the off-chain updates needed to construct a snapshot of
+// the orderbook with the provided `clobPairId`. For every order resting on the orderbook, an order place
+// message and an order update message containing the order's total filled amount are generated. Bids
+// are returned from the best to the worst price level followed by asks from the best to the worst price
+// level, where orders within each level are returned in time priority order. An empty set of off-chain
+// updates is returned if the orderbook does not exist.
+func (m *MemClobPriceTimePriority) GetOffchainUpdatesForOrderbookSnapshot(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (offchainUpdates *types.OffchainUpdates) {
+	offchainUpdates = types.NewOffchainUpdates()
+
+	orderbook, exists := m.openOrders.orderbooksMap[clobPairId]
+	if !exists {
+		return offchainUpdates
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		side := orderbook.GetSide(isBuy)
+		subticks := lib.GetSortedKeys[lib.Sortable[types.Subticks]](side)
+		if isBuy {
+			slices.Reverse(subticks)
+		}
+
+		for _, levelSubticks := range subticks {
+			for levelOrder := side[levelSubticks].LevelOrders.Front; levelOrder != nil; levelOrder = levelOrder.Next {
+				order := levelOrder.Value.Order
+				if message, success := off_chain_updates.CreateOrderPlaceMessage(
+					m.clobKeeper.Logger(ctx),
+					order,
+				); success {
+					offchainUpdates.AddPlaceMessage(order.OrderId, message)
+				}
+				if message, success := off_chain_updates.CreateOrderUpdateMessage(
+					m.clobKeeper.Logger(ctx),
+					order.OrderId,
+					m.GetOrderFilledAmount(ctx, order.OrderId),
+				); success {
+					offchainUpdates.AddUpdateMessage(order.OrderId, message)
+				}
+			}
+		}
+	}
+
+	return offchainUpdates
+}
+
+// GetOpenOrders returns all orders resting on the orderbook for the given clob pair. Bids are returned
+// before asks, and each side is sorted by price-time priority. No orders are returned if the orderbook
+// does not exist.
+func (m *MemClobPriceTimePriority) GetOpenOrders(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (openOrders []types.Order) {
+	openOrders = make([]types.Order, 0)
+
+	orderbook, exists := m.openOrders.orderbooksMap[clobPairId]
+	if !exists {
+		return openOrders
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		side := orderbook.GetSide(isBuy)
+		subticks := lib.GetSortedKeys[lib.Sortable[types.Subticks]](side)
+		if isBuy {
+			slices.Reverse(subticks)
+		}
+
+		for _, levelSubticks := range subticks {
+			for levelOrder := side[levelSubticks].LevelOrders.Front; levelOrder != nil; levelOrder = levelOrder.Next {
+				openOrders = append(openOrders, levelOrder.Value.Order)
+			}
+		}
+	}
+	return openOrders
+}
+
 // GetSubaccountOrders gets all of a subaccount's order on a specific CLOB and side.
 // This function will panic if `side` is invalid or if the orderbook does not exist.
 func (m *MemClobPriceTimePriority) GetSubaccountOrders(
@@ -331,16 +411,14 @@ func (m *MemClobPriceTimePriority) mustUpdateMemclobStateWithMatches(
 	if !takerOrder.IsLiquidation() {
 		taker := takerOrder.MustGetOrder()
 
-		// Add the taker order placement to the operations queue.
+		// Add the taker order placement to the operations queue. Note a Short-Term taker order placement is
+		// already in the operations queue if the taker order self-traded with a stateful maker order.
 		if taker.IsStatefulOrder() {
 			m.operationsToPropose.MustAddStatefulOrderPlacementToOperationsQueue(
 				taker,
 			)
-		} else {
-			m.operationsToPropose.MustAddShortTermOrderTxBytes(
-				taker,
-				ctx.TxBytes(),
-			)
+		} else if !m.operationsToPropose.IsOrderPlacementInOperationsQueue(taker) {
+			m.mustAddShortTermOrderTxBytes(ctx, taker)
 			m.operationsToPropose.MustAddShortTermOrderPlacementToOperationsQueue(
 				taker,
 			)
@@ -406,6 +484,93 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	orderStatus types.OrderStatus,
 	offchainUpdates *types.OffchainUpdates,
 	err error,
+) {
+	return m.placeOrder(ctx, order, false)
+}
+
+// ReplaceOrder replaces the order with the same order ID on the orderbook with the provided order.
+// If the existing order is resting on the book and the replacement order can retain its queue priority
+// (see `Order.CanRetainQueuePriority`), the existing order is updated in place. Otherwise, the existing
+// order is removed and the replacement order is placed as described in `PlaceOrder`.
+//
+// This function assumes the replacement order has already been validated against the order it replaces
+// (see `Order.ValidateIsReplacementOf`). A single off-chain replace message is emitted for the order
+// rather than an order removal followed by an order placement.
+func (m *MemClobPriceTimePriority) ReplaceOrder(
+	ctx sdk.Context,
+	order types.Order,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	existingOrder, found := m.openOrders.getOrder(ctx, order.OrderId)
+	if !found || !order.CanRetainQueuePriority(&existingOrder) {
+		return m.placeOrder(ctx, order, true)
+	}
+
+	offchainUpdates = types.NewOffchainUpdates()
+
+	// Validate the order and return an error if any validation fails.
+	if err := m.validateNewOrder(ctx, order, true); err != nil {
+		return 0, 0, offchainUpdates, err
+	}
+
+	// The replacement order has the same price and no greater size than the resting order, therefore
+	// it cannot cross the book and does not require an additional collateralization check.
+	m.openOrders.mustReplaceOrderInPlace(ctx, order)
+
+	// If this is a Short-Term order, propose the replacement order with the TX bytes of the replacement.
+	// Note the TX bytes of the existing order are retained if it's in the operations queue.
+	if order.IsShortTermOrder() {
+		if !m.operationsToPropose.IsOrderPlacementInOperationsQueue(existingOrder) {
+			m.operationsToPropose.RemoveShortTermOrderTxBytes(existingOrder)
+		}
+		if !m.operationsToPropose.IsOrderPlacementInOperationsQueue(order) {
+			m.mustAddShortTermOrderTxBytes(ctx, order)
+		}
+	}
+
+	if m.generateOffchainUpdates {
+		if message, success := off_chain_updates.CreateOrderReplaceMessage(
+			m.clobKeeper.Logger(ctx),
+			order,
+		); success {
+			offchainUpdates.AddReplaceMessage(order.OrderId, message)
+		}
+		if message, success := off_chain_updates.CreateOrderUpdateMessage(
+			m.clobKeeper.Logger(ctx),
+			order.OrderId,
+			m.GetOrderFilledAmount(ctx, order.OrderId),
+		); success {
+			offchainUpdates.AddUpdateMessage(order.OrderId, message)
+		}
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, metrics.ReplaceOrder, metrics.AddedToOrderBook},
+		1,
+		order.GetOrderLabels(),
+	)
+
+	return 0, types.Success, offchainUpdates, nil
+}
+
+// placeOrder contains the shared logic of `PlaceOrder` and `ReplaceOrder`. If `isReplacement` is true,
+// the order is placed as an explicit replacement of the order with the same order ID, and a single
+// off-chain replace message is emitted for the order instead of an order removal and placement.
+func (m *MemClobPriceTimePriority) placeOrder(
+	ctx sdk.Context,
+	order types.Order,
+	isReplacement bool,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
 ) {
 	lib.AssertCheckTxMode(ctx)
 
@@ -436,11 +601,29 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	offchainUpdates = types.NewOffchainUpdates()
 
 	// Validate the order and return an error if any validation fails.
-	if err := m.validateNewOrder(ctx, order); err != nil {
+	if err := m.validateNewOrder(ctx, order, isReplacement); err != nil {
+		// Stateful reduce-only orders which would increase the position size cannot be placed on the book,
+		// so add an Order Removal to the operations queue to remove the order from state.
+		if errors.Is(err, types.ErrReduceOnlyWouldIncreasePositionSize) &&
+			order.IsStatefulOrder() &&
+			!m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
+			m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
+				order.OrderId,
+				types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
+			)
+		}
 		return 0, 0, offchainUpdates, err
 	}
 
-	if m.generateOffchainUpdates {
+	if m.generateOffchainUpdates && isReplacement {
+		// If this is an explicit replacement order, send a single replace message for the order.
+		if message, success := off_chain_updates.CreateOrderReplaceMessage(
+			m.clobKeeper.Logger(ctx),
+			order,
+		); success {
+			offchainUpdates.AddReplaceMessage(order.OrderId, message)
+		}
+	} else if m.generateOffchainUpdates {
 		// If this is a replacement order, then ensure we send the appropriate removal message.
 		if !order.IsLiquidation() {
 			orderId := order.OrderId
@@ -448,8 +631,8 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 					m.clobKeeper.Logger(ctx),
 					orderId,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 				); success {
 					offchainUpdates.AddRemoveMessage(orderId, message)
 				}
@@ -501,7 +684,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				order.OrderId,
 				takerOrderStatus.OrderStatus,
 				err,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(order.OrderId, message)
 			}
@@ -523,7 +706,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				order.OrderId,
 				takerOrderStatus.OrderStatus,
 				nil,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(order.OrderId, message)
 			}
@@ -538,6 +721,26 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				)
 			}
 		}
+		// If stateful taker order was canceled by its self-trade prevention mode while matching, add
+		// Order Removal to operations queue to remove the order from state.
+		if takerOrderStatus.OrderStatus == types.SelfTradeCanceled && order.IsStatefulOrder() {
+			if !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
+				m.operationsToPropose.MustAddSelfTradeOrderRemovalToOperationsQueue(
+					order.OrderId,
+					takerOrderStatus.SelfTradeMakerOrder,
+				)
+			}
+		}
+		// If stateful reduce-only taker order closed the position while matching, add Order Removal
+		// to operations queue to remove the remaining size of the order from state.
+		if takerOrderStatus.OrderStatus == types.ReduceOnlyResized && order.IsStatefulOrder() {
+			if !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
+				m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
+					order.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
+				)
+			}
+		}
 		return orderSizeOptimisticallyFilledFromMatchingQuantums, takerOrderStatus.OrderStatus, offchainUpdates, nil
 	}
 
@@ -570,7 +773,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				order.OrderId,
 				orderStatus,
 				nil,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(order.OrderId, message)
 			}
@@ -605,7 +808,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				order.OrderId,
 				addOrderOrderStatus,
 				nil,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(order.OrderId, message)
 			}
@@ -625,10 +828,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	// operations to propose.
 	if order.IsShortTermOrder() &&
 		!m.operationsToPropose.IsOrderPlacementInOperationsQueue(order) {
-		m.operationsToPropose.MustAddShortTermOrderTxBytes(
-			order,
-			ctx.TxBytes(),
-		)
+		m.mustAddShortTermOrderTxBytes(ctx, order)
 	}
 
 	// Add the order to the orderbook and all other bookkeeping data structures.
@@ -756,14 +956,28 @@ func (m *MemClobPriceTimePriority) matchOrder(
 		}
 	}
 
+	// If a stateful taker order was canceled by its self-trade prevention mode, its Order Removal references the
+	// maker order it self-traded with. Add the placement of the maker order to the operations queue before the
+	// maker order is possibly removed below.
+	if takerOrderStatus.OrderStatus == types.SelfTradeCanceled && order.MustGetOrder().IsStatefulOrder() {
+		m.maybeAddSelfTradeOrderPlacementToOperationsQueue(ctx, *takerOrderStatus.SelfTradeMakerOrder)
+	}
+
 	// For each maker order that should be removed, remove it from the orderbook and emit off-chain
 	// updates for the indexer.
 	for _, makerOrderWithRemovalReason := range makerOrdersToRemove {
+		// Invalid reduce-only maker orders are removed after the new matches have been added to the
+		// operations queue, since their removal is only valid against the post-match position sizes.
+		if makerOrderWithRemovalReason.RemovalReason == types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY {
+			continue
+		}
+
 		// TODO(DEC-847): Update logic to properly remove long-term orders.
 		makerOrderId := makerOrderWithRemovalReason.Order.OrderId
+		isReplacedOrder := !order.IsLiquidation() && makerOrderId == order.MustGetOrder().OrderId
 		// TODO(CLOB-669): Move logic outside of `memclob.go` by returning a slice of removed orders.
 		// If the order is a replacement order, a message was already added above the place message.
-		if m.generateOffchainUpdates && (order.IsLiquidation() || makerOrderId != order.MustGetOrder().OrderId) {
+		if m.generateOffchainUpdates && !isReplacedOrder {
 			// If the taker order and the removed maker order are from the same subaccount, set
 			// the reason to SELF_TRADE error, otherwise set the reason to be UNDERCOLLATERALIZED.
 			// TODO(DEC-1409): Update this to support order replacements on indexer.
@@ -774,18 +988,34 @@ func (m *MemClobPriceTimePriority) matchOrder(
 				branchedContext.Logger(),
 				makerOrderId,
 				reason,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(makerOrderId, message)
 			}
 		}
 
 		m.mustRemoveOrder(branchedContext, makerOrderId)
-		if makerOrderId.IsStatefulOrder() && !m.operationsToPropose.IsOrderRemovalInOperationsQueue(makerOrderId) {
-			m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
-				makerOrderId,
-				makerOrderWithRemovalReason.RemovalReason,
-			)
+		// Stateful orders which are replaced remain in state as the replacement order, so they must not
+		// be removed from state.
+		if makerOrderId.IsStatefulOrder() &&
+			!isReplacedOrder &&
+			!m.operationsToPropose.IsOrderRemovalInOperationsQueue(makerOrderId) {
+			if makerOrderWithRemovalReason.RemovalReason == types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE {
+				// The Order Removal references the taker order the maker order self-traded with, or no order
+				// if the taker order is a liquidation order.
+				var selfTradeOrder *types.Order
+				if !order.IsLiquidation() {
+					takerOrder := order.MustGetOrder()
+					m.maybeAddSelfTradeOrderPlacementToOperationsQueue(ctx, takerOrder)
+					selfTradeOrder = &takerOrder
+				}
+				m.operationsToPropose.MustAddSelfTradeOrderRemovalToOperationsQueue(makerOrderId, selfTradeOrder)
+			} else {
+				m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
+					makerOrderId,
+					makerOrderWithRemovalReason.RemovalReason,
+				)
+			}
 		}
 	}
 
@@ -831,6 +1061,9 @@ func (m *MemClobPriceTimePriority) matchOrder(
 		writeCache()
 	}
 
+	// Remove any reduce-only maker orders which were skipped during matching and are still invalid.
+	offchainUpdates.Append(m.maybeRemoveInvalidReduceOnlyMakerOrders(ctx, makerOrdersToRemove))
+
 	return takerOrderStatus, offchainUpdates, makerOrdersToRemove, matchingErr
 }
 
@@ -1089,8 +1322,8 @@ func (m *MemClobPriceTimePriority) GenerateOffchainUpdatesForReplayPlaceOrder(
 				orderId,
 				orderStatus,
 				err,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
-				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
 			); success {
 				existingOffchainUpdates.AddRemoveMessage(orderId, message)
 			}
@@ -1239,8 +1472,8 @@ func (m *MemClobPriceTimePriority) PurgeInvalidMemclobState(
 				if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 					m.clobKeeper.Logger(ctx),
 					statefulOrderId,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
 				); success {
 					existingOffchainUpdates.AddRemoveMessage(statefulOrderId, message)
 				}
@@ -1258,8 +1491,8 @@ func (m *MemClobPriceTimePriority) PurgeInvalidMemclobState(
 				if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 					m.clobKeeper.Logger(ctx),
 					shortTermOrderId,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
 				); success {
 					existingOffchainUpdates.AddRemoveMessage(shortTermOrderId, message)
 				}
@@ -1310,6 +1543,7 @@ func (m *MemClobPriceTimePriority) PurgeInvalidMemclobState(
 func (m *MemClobPriceTimePriority) validateNewOrder(
 	ctx sdk.Context,
 	order types.Order,
+	isReplacement bool,
 ) (
 	err error,
 ) {
@@ -1343,12 +1577,25 @@ func (m *MemClobPriceTimePriority) validateNewOrder(
 	// then we must validate that the new order's `GoodTilBlock` is greater-in-value than the old order.
 	// If greater, then it can be placed (replacing the old order if it was resting on the book).
 	// If equal-or-lesser, then it is dropped.
-	if restingOrderExists && existingRestingOrder.MustCmpReplacementOrder(&order) >= 0 {
-		return types.ErrInvalidReplacement
-	}
+	// Explicit replacement orders have already been validated against the order they replace, so they
+	// are only dropped if they are identical to the existing order.
+	if isReplacement {
+		orderHash := order.GetOrderHash()
+		if restingOrderExists && existingRestingOrder.GetOrderHash() == orderHash {
+			return types.ErrInvalidReplacement
+		}
+
+		if matchedOrderExists && existingMatchedOrder.GetOrderHash() == orderHash {
+			return types.ErrInvalidReplacement
+		}
+	} else {
+		if restingOrderExists && existingRestingOrder.MustCmpReplacementOrder(&order) >= 0 {
+			return types.ErrInvalidReplacement
+		}
 
-	if matchedOrderExists && existingMatchedOrder.MustCmpReplacementOrder(&order) >= 0 {
-		return types.ErrInvalidReplacement
+		if matchedOrderExists && existingMatchedOrder.MustCmpReplacementOrder(&order) >= 0 {
+			return types.ErrInvalidReplacement
+		}
 	}
 
 	// If the order is a reduce-only order, we should ensure it does not increase the subaccount's
@@ -1546,6 +1793,8 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 	var takerOrderHash types.OrderHash
 	var takerOrderHashWasSet bool
 	var bigTotalMatchedAmount *big.Int = big.NewInt(0)
+	var takerSelfTradeDecrementedSize satypes.BaseQuantums
+	var takerSelfTradeDecrementingMakerOrder *types.Order
 
 	// Begin attempting to match orders. The below loop performs the following high-level operations, in order:
 	// - Find the next best maker order if it exists. If not, stop matching.
@@ -1595,17 +1844,59 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 			continue
 		}
 
-		// If the matched maker order does not have same order ID and is from the same subaccount
-		// as the taker order, then we cannot match the orders. Cancel the maker order and continue matching.
-		// TODO(DEC-1562): determine if we should handle self-trades differently.
-		if makerSubaccountId == takerSubaccountId {
-			makerOrdersToRemove = append(
-				makerOrdersToRemove,
-				OrderWithRemovalReason{
-					Order:         makerOrder.Order,
-					RemovalReason: types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
-				},
-			)
+		// If the matched maker order does not have same order ID and is a self-trade with the taker order,
+		// then we cannot match the orders. Resolve the self-trade using the self-trade prevention mode of
+		// the taker order. Liquidation orders always cancel the maker order and continue matching.
+		isSelfTrade := makerSubaccountId == takerSubaccountId
+		selfTradePrevention := types.Order_SELF_TRADE_PREVENTION_UNSPECIFIED
+		if !takerIsLiquidation {
+			takerOrder := newTakerOrder.MustGetOrder()
+			isSelfTrade = takerOrder.IsSelfTrade(makerSubaccountId)
+			selfTradePrevention = takerOrder.SelfTradePrevention
+		}
+		if isSelfTrade {
+			var cancelMaker, cancelTaker bool
+			switch selfTradePrevention {
+			case types.Order_SELF_TRADE_PREVENTION_CANCEL_TAKER:
+				cancelTaker = true
+			case types.Order_SELF_TRADE_PREVENTION_CANCEL_BOTH:
+				cancelMaker = true
+				cancelTaker = true
+			case types.Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL:
+				// Cancel the maker order. If the maker order is smaller than the taker order, decrement the
+				// taker order by the maker order's remaining size, otherwise cancel the taker order as well.
+				// Note the maker order is canceled rather than decremented, since a decremented size of a
+				// resting order is not recorded in state.
+				makerRemainingSize, makerHasRemainingSize := m.GetOrderRemainingAmount(ctx, makerOrder.Order)
+				if !makerHasRemainingSize {
+					panic(fmt.Sprintf("mustPerformTakerOrderMatching: maker order has no remaining amount %v", makerOrder.Order))
+				}
+				cancelMaker = true
+				cancelTaker = makerRemainingSize >= takerRemainingSize
+				if !cancelTaker {
+					takerRemainingSize -= makerRemainingSize
+					takerSelfTradeDecrementedSize += makerRemainingSize
+					takerSelfTradeDecrementingMakerOrder = &makerOrder.Order
+				}
+			default:
+				cancelMaker = true
+			}
+
+			if cancelMaker {
+				makerOrdersToRemove = append(
+					makerOrdersToRemove,
+					OrderWithRemovalReason{
+						Order:         makerOrder.Order,
+						RemovalReason: types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+					},
+				)
+			}
+
+			if cancelTaker {
+				takerOrderStatus.OrderStatus = types.SelfTradeCanceled
+				takerOrderStatus.SelfTradeMakerOrder = &makerOrder.Order
+				break
+			}
 			continue
 		}
 
@@ -1638,9 +1929,9 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 			// If the match size is zero, that indicates the maker order was a reduce-only order that
 			// would have increased the maker's position size and we need to find the next best maker
 			// order. This can happen if the maker has previous matches within this matching loop
-			// that changed their position side, meaning all their resting reduce-only orders are invalid.
+			// that closed or changed the side of their position, meaning all their resting reduce-only
+			// orders are invalid. The order is removed once matching has finished.
 			if resizedMatchAmount == 0 {
-				// TODO(DEC-1415): Revert this reduce-only bug patch.
 				makerOrdersToRemove = append(
 					makerOrdersToRemove,
 					OrderWithRemovalReason{
@@ -1794,7 +2085,6 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 		if newTakerOrder.IsReduceOnly() && takerRemainingSize > 0 {
 			takerStatePositionSize := m.clobKeeper.GetStatePosition(ctx, takerSubaccountId, clobPairId)
 			if takerStatePositionSize.Sign() == 0 {
-				// TODO(DEC-847): Update logic to properly remove stateful taker reduce-only orders.
 				takerOrderStatus.OrderStatus = types.ReduceOnlyResized
 				break
 			}
@@ -1806,9 +2096,17 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 		}
 	}
 
+	// The decremented size of a taker order cannot be reflected in the size of a resting order, so
+	// any remaining size of a taker order decremented by self-trade prevention is canceled.
+	if takerSelfTradeDecrementedSize > 0 && takerRemainingSize > 0 && takerOrderStatus.OrderStatus.IsSuccess() {
+		takerOrderStatus.OrderStatus = types.SelfTradeCanceled
+		takerOrderStatus.SelfTradeMakerOrder = takerSelfTradeDecrementingMakerOrder
+	}
+
 	// Update the remaining size of the taker order now that matching has ended.
 	takerOrderStatus.RemainingQuantums = takerRemainingSize
-	takerOrderStatus.OrderOptimisticallyFilledQuantums = takerRemainingSizeBeforeMatching - takerRemainingSize
+	takerOrderStatus.OrderOptimisticallyFilledQuantums = takerRemainingSizeBeforeMatching - takerRemainingSize -
+		takerSelfTradeDecrementedSize
 
 	return newMakerFills,
 		matchedOrderHashToOrder,
@@ -1884,6 +2182,38 @@ func (m *MemClobPriceTimePriority) SetMemclobGauges(
 	)
 }
 
+// mustAddShortTermOrderTxBytes adds the TX bytes of the provided Short-Term order to the operations
+// to propose. If the order is being placed as part of a `MsgBatchPlaceAndCancel`, the index of the
+// order placement within the batch is recorded as well.
+func (m *MemClobPriceTimePriority) mustAddShortTermOrderTxBytes(
+	ctx sdk.Context,
+	order types.Order,
+) {
+	if batchIndex, isBatch := types.GetShortTermOrderBatchIndex(ctx); isBatch {
+		m.operationsToPropose.MustAddShortTermOrderBatchTxBytes(order, ctx.TxBytes(), batchIndex)
+		return
+	}
+	m.operationsToPropose.MustAddShortTermOrderTxBytes(order, ctx.TxBytes())
+}
+
+// maybeAddSelfTradeOrderPlacementToOperationsQueue adds the placement of a Short-Term order which self-traded
+// with a stateful order to the operations queue if it's not already in the operations queue, such that the
+// Order Removal of the stateful order can reference it. Stateful orders are not added since they are in state.
+func (m *MemClobPriceTimePriority) maybeAddSelfTradeOrderPlacementToOperationsQueue(
+	ctx sdk.Context,
+	order types.Order,
+) {
+	if order.IsStatefulOrder() || m.operationsToPropose.IsOrderPlacementInOperationsQueue(order) {
+		return
+	}
+
+	// Resting Short-Term orders already have TX bytes, while new Short-Term taker orders do not.
+	if _, exists := m.operationsToPropose.ShortTermOrderHashToTxBytes[order.GetOrderHash()]; !exists {
+		m.mustAddShortTermOrderTxBytes(ctx, order)
+	}
+	m.operationsToPropose.MustAddShortTermOrderPlacementToOperationsQueue(order)
+}
+
 // mustRemoveOrder completely removes an order from all data structures for tracking
 // open orders in the memclob. If the order does not exist, this method will panic.
 // NOTE: `mustRemoveOrder` does _not_ remove cancels.
@@ -2016,7 +2346,8 @@ func (m *MemClobPriceTimePriority) RemoveOrderIfFilled(
 }
 
 // maybeCancelReduceOnlyOrders cancels all open reduce-only orders on the CLOB pair if the new fill would change the
-// position side of the subaccount.
+// position side of the subaccount. If the fill instead shrinks the position, each open reduce-only order whose
+// remaining size exceeds the new position size is resized to the new position size.
 func (m *MemClobPriceTimePriority) maybeCancelReduceOnlyOrders(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -2031,47 +2362,146 @@ func (m *MemClobPriceTimePriority) maybeCancelReduceOnlyOrders(
 	// matching occurred.
 	previousPositionSize := new(big.Int).Sub(newPositionSize, totalBigMatchedQuantums)
 
-	// If the subaccount's position size has changed sign, remove all open reduce-only orders.
-	if newPositionSize.Sign() != previousPositionSize.Sign() {
-		orderbook := m.openOrders.orderbooksMap[clobPairId]
+	positionChangedSide := newPositionSize.Sign() != previousPositionSize.Sign()
+	positionShrunk := newPositionSize.CmpAbs(previousPositionSize) == -1
+	if !positionChangedSide && !positionShrunk {
+		return offchainUpdates
+	}
 
-		if openReduceOnlyOrders, exists := orderbook.SubaccountOpenReduceOnlyOrders[subaccountId]; exists {
-			// Copy the list of open reduce-only orders.
-			openReduceOnlyOrdersCopy := make([]types.OrderId, 0, len(openReduceOnlyOrders))
-			for orderId := range openReduceOnlyOrders {
-				openReduceOnlyOrdersCopy = append(openReduceOnlyOrdersCopy, orderId)
-			}
+	orderbook := m.openOrders.orderbooksMap[clobPairId]
+	openReduceOnlyOrders, exists := orderbook.SubaccountOpenReduceOnlyOrders[subaccountId]
+	if !exists {
+		return offchainUpdates
+	}
 
-			// Sort open reduce-only orders by ClientId so that order removal is deterministic. ClientId
-			// can be used here since all orders are from the same subaccount, and there should be no
-			// duplicates. Determinism is necessary as these removals are part of `DeliverTx`
-			// which updates state.
-			types.MustSortAndHaveNoDuplicates(openReduceOnlyOrdersCopy)
-
-			// Remove each open reduce-only order from the memclob.
-			for _, orderId := range openReduceOnlyOrdersCopy {
-				// TODO(DEC-847): Update logic to properly remove stateful orders.
-				m.mustRemoveOrder(ctx, orderId)
-				if orderId.IsStatefulOrder() && !m.operationsToPropose.IsOrderRemovalInOperationsQueue(orderId) {
-					m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
-						orderId,
-						types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
-					)
-				}
-				if m.generateOffchainUpdates {
-					if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
-						m.clobKeeper.Logger(ctx),
-						orderId,
-						indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE,
-						off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
-					); success {
-						offchainUpdates.AddRemoveMessage(orderId, message)
-					}
-				}
-			}
+	// Copy the list of open reduce-only orders.
+	openReduceOnlyOrdersCopy := make([]types.OrderId, 0, len(openReduceOnlyOrders))
+	for orderId := range openReduceOnlyOrders {
+		openReduceOnlyOrdersCopy = append(openReduceOnlyOrdersCopy, orderId)
+	}
+
+	// Sort open reduce-only orders by ClientId so that order removal is deterministic. ClientId
+	// can be used here since all orders are from the same subaccount, and there should be no
+	// duplicates. Determinism is necessary as these removals are part of `DeliverTx`
+	// which updates state.
+	types.MustSortAndHaveNoDuplicates(openReduceOnlyOrdersCopy)
+
+	bigAbsNewPositionSize := new(big.Int).Abs(newPositionSize)
+	for _, orderId := range openReduceOnlyOrdersCopy {
+		// If the subaccount's position size has changed sign, remove all open reduce-only orders.
+		if positionChangedSide {
+			offchainUpdates.Append(m.mustRemoveInvalidReduceOnlyOrder(ctx, orderId))
+			continue
+		}
+
+		// Otherwise resize the orders whose remaining size exceeds the new position size.
+		order, found := m.openOrders.getOrder(ctx, orderId)
+		if !found {
+			continue
+		}
+		remainingAmount, _ := m.GetOrderRemainingAmount(ctx, order)
+		if remainingAmount.ToBigInt().Cmp(bigAbsNewPositionSize) <= 0 {
+			continue
+		}
+		offchainUpdates.Append(
+			m.resizeReduceOnlyOrder(ctx, order, satypes.BaseQuantums(bigAbsNewPositionSize.Uint64())),
+		)
+	}
+
+	return offchainUpdates
+}
+
+// maybeRemoveInvalidReduceOnlyMakerOrders removes the reduce-only maker orders that were skipped during
+// matching if they are still resting on the book and are no longer on the opposite side of the maker's
+// current position. Note that these orders may have already been removed by `maybeCancelReduceOnlyOrders`.
+func (m *MemClobPriceTimePriority) maybeRemoveInvalidReduceOnlyMakerOrders(
+	ctx sdk.Context,
+	makerOrdersToRemove []OrderWithRemovalReason,
+) (offchainUpdates *types.OffchainUpdates) {
+	offchainUpdates = types.NewOffchainUpdates()
+	for _, makerOrderWithRemovalReason := range makerOrdersToRemove {
+		if makerOrderWithRemovalReason.RemovalReason != types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY {
+			continue
 		}
+
+		order := makerOrderWithRemovalReason.Order
+		if _, found := m.openOrders.getOrder(ctx, order.OrderId); !found {
+			continue
+		}
+
+		// The order is still valid if the matches which invalidated it were discarded.
+		positionSize := m.clobKeeper.GetStatePosition(ctx, order.OrderId.SubaccountId, order.GetClobPairId())
+		if order.GetBigQuantums().Sign()*positionSize.Sign() == -1 {
+			continue
+		}
+
+		offchainUpdates.Append(m.mustRemoveInvalidReduceOnlyOrder(ctx, order.OrderId))
 	}
+	return offchainUpdates
+}
 
+// resizeReduceOnlyOrder resizes a resting reduce-only order whose remaining size exceeds the size of its
+// subaccount's position so that its remaining size equals the position size.
+//
+// The order itself is left untouched in the memclob and in state. Every match against a reduce-only order
+// is already capped at the current position size by `resizeReduceOnlyMatchIfNecessary`, so the resized
+// size is derived from state at match time and is the same on every node, including nodes that restarted
+// or replayed operations. Only the indexer is notified of the new size, via an order replace message for
+// the resized order followed by an order update message with its total filled amount.
+func (m *MemClobPriceTimePriority) resizeReduceOnlyOrder(
+	ctx sdk.Context,
+	order types.Order,
+	newRemainingAmount satypes.BaseQuantums,
+) (offchainUpdates *types.OffchainUpdates) {
+	offchainUpdates = types.NewOffchainUpdates()
+	if !m.generateOffchainUpdates {
+		return offchainUpdates
+	}
+
+	totalFilledAmount := m.GetOrderFilledAmount(ctx, order.OrderId)
+	resizedOrder := order
+	resizedOrder.Quantums = (totalFilledAmount + newRemainingAmount).ToUint64()
+	if message, success := off_chain_updates.CreateOrderReplaceMessage(
+		m.clobKeeper.Logger(ctx),
+		resizedOrder,
+	); success {
+		offchainUpdates.AddReplaceMessage(order.OrderId, message)
+	}
+	if message, success := off_chain_updates.CreateOrderUpdateMessage(
+		m.clobKeeper.Logger(ctx),
+		order.OrderId,
+		totalFilledAmount,
+	); success {
+		offchainUpdates.AddUpdateMessage(order.OrderId, message)
+	}
+	return offchainUpdates
+}
+
+// mustRemoveInvalidReduceOnlyOrder removes a reduce-only order which would increase or change the side
+// of its subaccount's position from the memclob. If the order is stateful, an Order Removal is added to
+// the operations queue to remove the order from state.
+func (m *MemClobPriceTimePriority) mustRemoveInvalidReduceOnlyOrder(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) (offchainUpdates *types.OffchainUpdates) {
+	offchainUpdates = types.NewOffchainUpdates()
+	m.mustRemoveOrder(ctx, orderId)
+	if orderId.IsStatefulOrder() && !m.operationsToPropose.IsOrderRemovalInOperationsQueue(orderId) {
+		m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
+			orderId,
+			types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
+		)
+	}
+	if m.generateOffchainUpdates {
+		if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
+			m.clobKeeper.Logger(ctx),
+			orderId,
+			sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE,
+			ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+		); success {
+			offchainUpdates.AddRemoveMessage(orderId, message)
+		}
+	}
 	return offchainUpdates
 }
 
@@ -2100,6 +2530,67 @@ func (m *MemClobPriceTimePriority) GetMidPrice(
 	return subticks, exists
 }
 
+// GetOrderbookDepth returns the price levels of the orderbook for the given clob pair, aggregated by
+// price. Bids are sorted from the highest to the lowest price and asks from the lowest to the highest
+// price. At most `maxLevels` levels are returned for each side, or all levels if `maxLevels` is zero.
+// The size of each level is the total remaining size of the orders on the level, excluding any
+// fully-filled orders. Empty levels are returned if the orderbook does not exist.
+func (m *MemClobPriceTimePriority) GetOrderbookDepth(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	maxLevels uint32,
+) (
+	bids []types.OrderbookLevel,
+	asks []types.OrderbookLevel,
+) {
+	orderbook, exists := m.openOrders.orderbooksMap[clobPairId]
+	if !exists {
+		return []types.OrderbookLevel{}, []types.OrderbookLevel{}
+	}
+
+	return m.getOrderbookSideDepth(ctx, orderbook, true, maxLevels),
+		m.getOrderbookSideDepth(ctx, orderbook, false, maxLevels)
+}
+
+// getOrderbookSideDepth returns the aggregated price levels of one side of the orderbook, sorted from
+// the best to the worst price. Levels without any remaining size are skipped.
+func (m *MemClobPriceTimePriority) getOrderbookSideDepth(
+	ctx sdk.Context,
+	orderbook *types.Orderbook,
+	isBuy bool,
+	maxLevels uint32,
+) []types.OrderbookLevel {
+	side := orderbook.GetSide(isBuy)
+	subticks := lib.GetSortedKeys[lib.Sortable[types.Subticks]](side)
+	if isBuy {
+		slices.Reverse(subticks)
+	}
+
+	levels := make([]types.OrderbookLevel, 0)
+	for _, levelSubticks := range subticks {
+		if maxLevels != 0 && uint32(len(levels)) >= maxLevels {
+			break
+		}
+
+		level := types.OrderbookLevel{
+			Subticks: levelSubticks.ToUint64(),
+		}
+		for levelOrder := side[levelSubticks].LevelOrders.Front; levelOrder != nil; levelOrder = levelOrder.Next {
+			remainingAmount, hasRemainingAmount := m.GetOrderRemainingAmount(ctx, levelOrder.Value.Order)
+			if !hasRemainingAmount {
+				continue
+			}
+			level.Quantums += remainingAmount.ToUint64()
+			level.NumOrders++
+		}
+
+		if level.NumOrders > 0 {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
 // getImpactPriceSubticks returns the impact ask or bid price (in subticks), given the clob pair
 // and orderbook. The bid (or ask) impact price is the average price a trader
 // would receive if they sold (or bought) from the order book using `impactNotionalAmount`.
@@ -0,0 +1,120 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOffchainUpdatesForOrderbookSnapshot(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	tests := map[string]struct {
+		// State.
+		placedMatchableOrders []types.MatchableOrder
+		orderFillAmounts      map[types.OrderId]satypes.BaseQuantums
+
+		// Parameters.
+		clobPairId types.ClobPairId
+
+		// Expectations.
+		expectedOrders []types.Order
+	}{
+		"Returns no updates if the orderbook does not exist": {
+			placedMatchableOrders: []types.MatchableOrder{},
+
+			clobPairId: 0,
+
+			expectedOrders: []types.Order{},
+		},
+		"Does not return updates for orders on other orderbooks": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+			},
+
+			clobPairId: 1,
+
+			expectedOrders: []types.Order{
+				constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+			},
+		},
+		"Returns bids and then asks, both sorted by price-time priority": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+				&constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+				&constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+				&constants.Order_Alice_Num0_Id7_Clob0_Sell25_Price15_GTB20,
+				&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+			},
+
+			clobPairId: 0,
+
+			expectedOrders: []types.Order{
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+				constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+				constants.Order_Alice_Num0_Id7_Clob0_Sell25_Price15_GTB20,
+				constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+			},
+		},
+		"Returns the filled amount of partially filled orders": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				&constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+			},
+			orderFillAmounts: map[types.OrderId]satypes.BaseQuantums{
+				constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20.OrderId: 10,
+			},
+
+			clobPairId: 0,
+
+			expectedOrders: []types.Order{
+				constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup the memclob state.
+			memclob, memClobKeeper := setUpMemclobAndOrderbook(
+				t,
+				ctx,
+				tc.placedMatchableOrders,
+				constants.GetStatePosition_ZeroPositionSize,
+				[]types.MatchableOrder{},
+			)
+			for orderId, fillAmount := range tc.orderFillAmounts {
+				memClobKeeper.SetOrderFillAmount(ctx, orderId, fillAmount)
+			}
+
+			// Build the expected off-chain updates.
+			expectedOffchainUpdates := types.NewOffchainUpdates()
+			for _, order := range tc.expectedOrders {
+				placeMessage, success := off_chain_updates.CreateOrderPlaceMessage(ctx.Logger(), order)
+				require.True(t, success)
+				expectedOffchainUpdates.AddPlaceMessage(order.OrderId, placeMessage)
+
+				updateMessage, success := off_chain_updates.CreateOrderUpdateMessage(
+					ctx.Logger(),
+					order.OrderId,
+					tc.orderFillAmounts[order.OrderId],
+				)
+				require.True(t, success)
+				expectedOffchainUpdates.AddUpdateMessage(order.OrderId, updateMessage)
+			}
+
+			// Run the test case and verify expectations.
+			offchainUpdates := memclob.GetOffchainUpdatesForOrderbookSnapshot(ctx, tc.clobPairId)
+			require.Equal(t, expectedOffchainUpdates, offchainUpdates)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOpenOrders(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	tests := map[string]struct {
+		// State.
+		placedMatchableOrders []types.MatchableOrder
+
+		// Parameters.
+		clobPairId types.ClobPairId
+
+		// Expectations.
+		expectedOrders []types.Order
+	}{
+		"Returns no orders if the orderbook does not exist": {
+			placedMatchableOrders: []types.MatchableOrder{},
+
+			clobPairId: 1,
+
+			expectedOrders: []types.Order{},
+		},
+		"Returns bids and then asks sorted by price-time priority": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				&constants.Order_Alice_Num0_Id7_Clob0_Sell25_Price15_GTB20,
+				&constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+				&constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+			},
+
+			clobPairId: 0,
+
+			expectedOrders: []types.Order{
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+				constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				constants.Order_Alice_Num0_Id7_Clob0_Sell25_Price15_GTB20,
+				constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup the memclob state.
+			memclob, _ := setUpMemclobAndOrderbook(
+				t,
+				ctx,
+				tc.placedMatchableOrders,
+				constants.GetStatePosition_ZeroPositionSize,
+				[]types.MatchableOrder{},
+			)
+
+			// Run the test case and verify expectations.
+			require.Equal(t, tc.expectedOrders, memclob.GetOpenOrders(ctx, tc.clobPairId))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderbookDepth(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	placedMatchableOrders := []types.MatchableOrder{
+		&constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+		&constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+		&constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+		&constants.Order_Alice_Num0_Id7_Clob0_Sell25_Price15_GTB20,
+		&constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+	}
+	tests := map[string]struct {
+		// State.
+		placedMatchableOrders []types.MatchableOrder
+		orderFillAmounts      map[types.OrderId]satypes.BaseQuantums
+
+		// Parameters.
+		clobPairId types.ClobPairId
+		maxLevels  uint32
+
+		// Expectations.
+		expectedBids []types.OrderbookLevel
+		expectedAsks []types.OrderbookLevel
+	}{
+		"Returns no levels if the orderbook does not exist": {
+			placedMatchableOrders: []types.MatchableOrder{},
+
+			clobPairId: 0,
+
+			expectedBids: []types.OrderbookLevel{},
+			expectedAsks: []types.OrderbookLevel{},
+		},
+		"Returns all levels sorted from the best to the worst price": {
+			placedMatchableOrders: placedMatchableOrders,
+
+			clobPairId: 0,
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 20, NumOrders: 1},
+				{Subticks: 5, Quantums: 25, NumOrders: 1},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 35, NumOrders: 2},
+				{Subticks: 35, Quantums: 20, NumOrders: 1},
+			},
+		},
+		"Returns at most the max number of levels for each side": {
+			placedMatchableOrders: placedMatchableOrders,
+
+			clobPairId: 0,
+			maxLevels:  1,
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 20, NumOrders: 1},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 35, NumOrders: 2},
+			},
+		},
+		"Returns the remaining size of partially filled orders and skips fully filled orders": {
+			placedMatchableOrders: placedMatchableOrders,
+			orderFillAmounts: map[types.OrderId]satypes.BaseQuantums{
+				constants.Order_Alice_Num0_Id7_Clob0_Sell25_Price15_GTB20.OrderId: 10,
+				constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32.OrderId:  20,
+			},
+
+			clobPairId: 0,
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 20, NumOrders: 1},
+				{Subticks: 5, Quantums: 25, NumOrders: 1},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 25, NumOrders: 2},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup the memclob state.
+			memclob, memClobKeeper := setUpMemclobAndOrderbook(
+				t,
+				ctx,
+				tc.placedMatchableOrders,
+				constants.GetStatePosition_ZeroPositionSize,
+				[]types.MatchableOrder{},
+			)
+			for orderId, fillAmount := range tc.orderFillAmounts {
+				memClobKeeper.SetOrderFillAmount(ctx, orderId, fillAmount)
+			}
+
+			// Run the test case and verify expectations.
+			bids, asks := memclob.GetOrderbookDepth(ctx, tc.clobPairId, tc.maxLevels)
+			require.Equal(t, tc.expectedBids, bids)
+			require.Equal(t, tc.expectedAsks, asks)
+		})
+	}
+}
@@ -443,6 +443,43 @@ func (m *memclobOpenOrders) mustAddOrderToOrderbook(
 	}
 }
 
+// mustReplaceOrderInPlace replaces the order resting on the book that has the same order ID as
+// `newOrder` with `newOrder`, retaining the position of the existing order within its price level.
+// This function will assume that all order validation has already been done. It panics if the existing
+// order does not exist, or if `newOrder` does not have the same price and side as the existing order.
+func (m *memclobOpenOrders) mustReplaceOrderInPlace(
+	ctx sdk.Context,
+	newOrder types.Order,
+) {
+	levelOrder, exists := m.orderIdToLevelOrder[newOrder.OrderId]
+	if !exists {
+		panic(fmt.Sprintf("mustReplaceOrderInPlace: order does not exist %v", newOrder.OrderId))
+	}
+
+	existingOrder := levelOrder.Value.Order
+	if existingOrder.Subticks != newOrder.Subticks || existingOrder.Side != newOrder.Side {
+		panic(
+			fmt.Sprintf(
+				"mustReplaceOrderInPlace: order (%+v) does not have the same price and side as order (%+v)",
+				newOrder,
+				existingOrder,
+			),
+		)
+	}
+
+	// If this is a Short-Term order, move the order to the set of orders expiring at its new `GoodTilBlock`.
+	if existingOrder.IsShortTermOrder() {
+		goodTilBlock := existingOrder.GetGoodTilBlock()
+		delete(m.blockExpirationsForOrders[goodTilBlock], existingOrder.OrderId)
+		if len(m.blockExpirationsForOrders[goodTilBlock]) == 0 {
+			delete(m.blockExpirationsForOrders, goodTilBlock)
+		}
+		m.mustAddShortTermOrderToBlockExpirationsForOrders(ctx, newOrder)
+	}
+
+	levelOrder.Value.Order = newOrder
+}
+
 // mustRemoveOrder completely removes an order from all data structures for tracking
 // open orders in the memclob. If the order does not exist, this method will panic.
 // NOTE: `mustRemoveOrder` does _not_ remove cancels.
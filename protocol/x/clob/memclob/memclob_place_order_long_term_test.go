@@ -650,9 +650,9 @@ func TestPlaceOrder_LongTerm(t *testing.T) {
 			expectedOrderStatus: types.Success,
 			expectedOperations:  []types.Operation{},
 			expectedInternalOperations: []types.InternalOperation{
-				types.NewOrderRemovalInternalOperation(
+				types.NewSelfTradeOrderRemovalInternalOperation(
 					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
-					types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+					&constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
 				),
 			},
 			expectedRemainingBids: []OrderWithRemainingSize{},
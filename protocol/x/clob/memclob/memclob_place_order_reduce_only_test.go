@@ -406,7 +406,7 @@ func TestPlaceOrder_ReduceOnly(t *testing.T) {
 			},
 		},
 		`Can place a regular order that matches and does not close the position, and the taker's resting
-						reduce-only orders on that CLOB remain open`: {
+						reduce-only orders larger than the remaining position are resized and remain open`: {
 			placedMatchableOrders: []types.MatchableOrder{
 				&constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO,
 				&constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO,
@@ -860,129 +860,128 @@ func TestPlaceOrder_ReduceOnly(t *testing.T) {
 				constants.Order_Alice_Num1_Id6_Clob0_Buy10_Price5_GTB20_RO.OrderId,
 			},
 		},
-		// TODO(DEC-1415): Uncomment reduce-only tests after the patch is removed.
-		// `Can place a taker order that matches with a maker order that sets the maker's position size
-		// 	        to zero, and all following matched reduce-only orders from the same maker are canceled`: {
-		// 	placedMatchableOrders: []types.MatchableOrder{
-		// 		&constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
-		// 		&constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO,
-		// 		&constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO,
-		// 		&constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
-		// 	},
-		// 	collateralizationCheckFailures: map[int]map[satypes.SubaccountId]satypes.UpdateResult{},
-		// 	statePositionSizes: map[types.ClobPairId]map[satypes.SubaccountId]*big.Int{
-		// 		0: {
-		// 			constants.Alice_Num0: big.NewInt(0),
-		// 			constants.Alice_Num1: big.NewInt(10),
-		// 			constants.Bob_Num0: big.NewInt(0),
-		// 		},
-		// 	},
-
-		// 	order: constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-
-		// 	expectedOrderStatus: types.Success,
-		// 	expectedFilledSize:  15,
-		// 	expectedRemainingBids: []orderWithRemainingSize{
-		// 		{
-		// 			order:         constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			remainingSize: 5,
-		// 		},
-		// 	},
-		// 	expectedRemainingAsks: []orderWithRemainingSize{},
-		// 	expectedPendingMatches: []expectedMatch{
-		// 		{
-		// 			makerOrder:      &constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
-		// 			takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			matchedQuantums: 10,
-		// 		},
-		// 		{
-		// 			makerOrder:      &constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
-		// 			takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			matchedQuantums: 5,
-		// 		},
-		// 	},
-		// 	expectedExistingMatches: []expectedMatch{},
-		// 	expectedNewMatches: []expectedMatch{
-		// 		{
-		// 			makerOrder:      &constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
-		// 			takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			matchedQuantums: 10,
-		// 		},
-		// 		{
-		// 			makerOrder:      &constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
-		// 			takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			matchedQuantums: 5,
-		// 		},
-		// 	},
-		// 	expectedSubaccountOpenReduceOnlyOrders: map[types.ClobPairId]map[satypes.SubaccountId]map[types.OrderId]bool{
-		// 		0: {},
-		// 	},
-		// 	expectedCancelledReduceOnlyOrders: []types.OrderId{
-		// 		constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO.OrderId,
-		// 		constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO.OrderId,
-		// 	},
-		// },
-		// `Can place a taker order that matches with a maker order that changes the maker's position
-		// 		        side, and all following matched reduce-only orders from the same maker are canceled`: {
-		// 	placedMatchableOrders: []types.MatchableOrder{
-		// 		&constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
-		// 		&constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO,
-		// 		&constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO,
-		// 		&constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
-		// 	},
-		// 	collateralizationCheckFailures: map[int]map[satypes.SubaccountId]satypes.UpdateResult{},
-		// 	statePositionSizes: map[types.ClobPairId]map[satypes.SubaccountId]*big.Int{
-		// 		0: {
-		// 			constants.Alice_Num0: big.NewInt(0),
-		// 			constants.Alice_Num1: big.NewInt(5),
-		// 			constants.Bob_Num0: big.NewInt(0),
-		// 		},
-		// 	},
-
-		// 	order: constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-
-		// 	expectedOrderStatus: types.Success,
-		// 	expectedFilledSize:  15,
-		// 	expectedRemainingBids: []orderWithRemainingSize{
-		// 		{
-		// 			order:         constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			remainingSize: 5,
-		// 		},
-		// 	},
-		// 	expectedRemainingAsks: []orderWithRemainingSize{},
-		// 	expectedPendingMatches: []expectedMatch{
-		// 		{
-		// 			makerOrder:      &constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
-		// 			takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			matchedQuantums: 10,
-		// 		},
-		// 		{
-		// 			makerOrder:      &constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
-		// 			takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			matchedQuantums: 5,
-		// 		},
-		// 	},
-		// 	expectedExistingMatches: []expectedMatch{},
-		// 	expectedNewMatches: []expectedMatch{
-		// 		{
-		// 			makerOrder:      &constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
-		// 			takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			matchedQuantums: 10,
-		// 		},
-		// 		{
-		// 			makerOrder:      &constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
-		// 			takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
-		// 			matchedQuantums: 5,
-		// 		},
-		// 	},
-		// 	expectedSubaccountOpenReduceOnlyOrders: map[types.ClobPairId]map[satypes.SubaccountId]map[types.OrderId]bool{
-		// 		0: {},
-		// 	},
-		// 	expectedCancelledReduceOnlyOrders: []types.OrderId{
-		// 		constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO.OrderId,
-		// 		constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO.OrderId,
-		// 	},
-		// },
+		`Can place a taker order that matches with a maker order that sets the maker's position size
+			        to zero, and all following matched reduce-only orders from the same maker are canceled`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
+				&constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO,
+				&constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO,
+				&constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+			},
+			collateralizationCheckFailures: map[int]map[satypes.SubaccountId]satypes.UpdateResult{},
+			statePositionSizes: map[types.ClobPairId]map[satypes.SubaccountId]*big.Int{
+				0: {
+					constants.Alice_Num0: big.NewInt(0),
+					constants.Alice_Num1: big.NewInt(10),
+					constants.Bob_Num0:   big.NewInt(0),
+				},
+			},
+
+			order: constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+
+			expectedOrderStatus: types.Success,
+			expectedFilledSize:  15,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					RemainingSize: 5,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+			expectedPendingMatches: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
+					takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					matchedQuantums: 10,
+				},
+				{
+					makerOrder:      &constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+					takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					matchedQuantums: 5,
+				},
+			},
+			expectedExistingMatches: []expectedMatch{},
+			expectedNewMatches: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
+					takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					matchedQuantums: 10,
+				},
+				{
+					makerOrder:      &constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+					takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					matchedQuantums: 5,
+				},
+			},
+			expectedSubaccountOpenReduceOnlyOrders: map[types.ClobPairId]map[satypes.SubaccountId]map[types.OrderId]bool{
+				0: {},
+			},
+			expectedCancelledReduceOnlyOrders: []types.OrderId{
+				constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO.OrderId,
+				constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO.OrderId,
+			},
+		},
+		`Can place a taker order that matches with a maker order that changes the maker's position
+				        side, and all following matched reduce-only orders from the same maker are canceled`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
+				&constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO,
+				&constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO,
+				&constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+			},
+			collateralizationCheckFailures: map[int]map[satypes.SubaccountId]satypes.UpdateResult{},
+			statePositionSizes: map[types.ClobPairId]map[satypes.SubaccountId]*big.Int{
+				0: {
+					constants.Alice_Num0: big.NewInt(0),
+					constants.Alice_Num1: big.NewInt(5),
+					constants.Bob_Num0:   big.NewInt(0),
+				},
+			},
+
+			order: constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+
+			expectedOrderStatus: types.Success,
+			expectedFilledSize:  15,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					RemainingSize: 5,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+			expectedPendingMatches: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
+					takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					matchedQuantums: 10,
+				},
+				{
+					makerOrder:      &constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+					takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					matchedQuantums: 5,
+				},
+			},
+			expectedExistingMatches: []expectedMatch{},
+			expectedNewMatches: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Alice_Num1_Id9_Clob0_Sell10_Price10_GTB31,
+					takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					matchedQuantums: 10,
+				},
+				{
+					makerOrder:      &constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+					takerOrder:      &constants.Order_Bob_Num0_Id6_Clob0_Buy20_Price1000_GTB22,
+					matchedQuantums: 5,
+				},
+			},
+			expectedSubaccountOpenReduceOnlyOrders: map[types.ClobPairId]map[satypes.SubaccountId]map[types.OrderId]bool{
+				0: {},
+			},
+			expectedCancelledReduceOnlyOrders: []types.OrderId{
+				constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO.OrderId,
+				constants.Order_Alice_Num1_Id4_Clob0_Sell15_Price20_GTB20_RO.OrderId,
+			},
+		},
 		`Can place a reduce-only taker order that matches with a reduce-only maker order, and both
 						reduce-only orders change their subaccount's position side and need to be resized. Since the
 						maker order is resized by a larger delta than the taker, the taker order's full size is
@@ -1391,8 +1390,8 @@ func TestPlaceOrder_ReduceOnly(t *testing.T) {
 
 // These tests aim to test two different scenarios regarding stateful reduce-only order removals:
 //  1. A stateful reduce-only maker order is encountered during matching which would result in increasing position size.
-//     This should result in the maker order being removed and an OrderRemoval operation being added to the ops queue.
-//     This happens DURING the matching loop, in `mustPerformTakerOrderMatching`.
+//     The maker order is skipped DURING the matching loop, in `mustPerformTakerOrderMatching`, and is removed
+//     with an OrderRemoval operation added to the ops queue once the new matches have been added.
 //  2. A taker order matches with a a maker order that changes the maker subaccount's position side.
 //     The maker subaccount's resting stateful reduce-only orders should be removed and an OrderRemoval operation
 //     should be added to the ops queue for each resting order.
@@ -1423,8 +1422,9 @@ func TestPlaceOrder_LongTermReduceOnlyRemovals(t *testing.T) {
 		expectedInternalOperations             []types.InternalOperation
 	}{
 		`Can place a regular taker order that partially matches with a regular order which changes the
-			maker order's position side. The maker's resting stateful reduce-only order is canceled and an
-			OrderRemoval operation is added while attempting to match remaining taker size against it`: {
+			maker order's position side. The maker's resting stateful reduce-only order is skipped while
+			attempting to match remaining taker size against it, and is canceled with an OrderRemoval
+			operation added after the match`: {
 			placedMatchableOrders: []types.MatchableOrder{
 				&constants.Order_Bob_Num0_Id8_Clob0_Sell20_Price10_GTB22,
 				&constants.LongTermOrder_Bob_Num0_Id2_Clob0_Sell10_Price35_GTB20_RO,
@@ -1470,10 +1470,6 @@ func TestPlaceOrder_LongTermReduceOnlyRemovals(t *testing.T) {
 				constants.LongTermOrder_Bob_Num0_Id2_Clob0_Sell10_Price35_GTB20_RO.OrderId,
 			},
 			expectedInternalOperations: []types.InternalOperation{
-				types.NewOrderRemovalInternalOperation(
-					constants.LongTermOrder_Bob_Num0_Id2_Clob0_Sell10_Price35_GTB20_RO.OrderId,
-					types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
-				),
 				types.NewShortTermOrderPlacementInternalOperation(
 					constants.Order_Bob_Num0_Id8_Clob0_Sell20_Price10_GTB22,
 				),
@@ -1489,6 +1485,10 @@ func TestPlaceOrder_LongTermReduceOnlyRemovals(t *testing.T) {
 						},
 					},
 				),
+				types.NewOrderRemovalInternalOperation(
+					constants.LongTermOrder_Bob_Num0_Id2_Clob0_Sell10_Price35_GTB20_RO.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY,
+				),
 			},
 		},
 		`Can place a regular taker order that fully-matches with a regular order which changes the
@@ -847,6 +847,282 @@ func TestPlaceOrder_MatchOrders(t *testing.T) {
 				),
 			},
 		},
+		`Stops matching if two orders from the same subaccount overlap and the taker order uses the
+			cancel-taker self-trade prevention mode, leaving the maker order on the book`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+			},
+
+			order: constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelTaker,
+
+			expectedFilledSize:    10,
+			expectedOrderStatus:   types.SelfTradeCanceled,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+					RemainingSize: 10,
+				},
+			},
+			expectedCollatCheck: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelTaker,
+					matchedQuantums: 10,
+				},
+			},
+			expectedMatches: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelTaker,
+					matchedQuantums: 10,
+				},
+			},
+			expectedOperations: []types.Operation{
+				clobtest.NewOrderPlacementOperation(
+					constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				),
+				clobtest.NewOrderPlacementOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelTaker,
+				),
+				clobtest.NewMatchOperation(
+					&constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelTaker,
+					[]types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+							FillAmount:   10,
+						},
+					},
+				),
+			},
+			expectedInternalOperations: []types.InternalOperation{
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				),
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelTaker,
+				),
+				types.NewMatchOrdersInternalOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelTaker,
+					[]types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+							FillAmount:   10,
+						},
+					},
+				),
+			},
+		},
+		`Stops matching if two orders from the same subaccount overlap and the taker order uses the
+			cancel-both self-trade prevention mode, and cancels the maker order`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+			},
+
+			order: constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelBoth,
+
+			expectedFilledSize:    10,
+			expectedOrderStatus:   types.SelfTradeCanceled,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+			expectedCollatCheck: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelBoth,
+					matchedQuantums: 10,
+				},
+			},
+			expectedMatches: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelBoth,
+					matchedQuantums: 10,
+				},
+			},
+			expectedOperations: []types.Operation{
+				clobtest.NewOrderPlacementOperation(
+					constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				),
+				clobtest.NewOrderPlacementOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelBoth,
+				),
+				clobtest.NewMatchOperation(
+					&constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelBoth,
+					[]types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+							FillAmount:   10,
+						},
+					},
+				),
+			},
+			expectedInternalOperations: []types.InternalOperation{
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				),
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelBoth,
+				),
+				types.NewMatchOrdersInternalOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_CancelBoth,
+					[]types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+							FillAmount:   10,
+						},
+					},
+				),
+			},
+		},
+		`Decrements the taker order and cancels the smaller maker order if two orders from the same
+			subaccount overlap and the taker order uses the decrement-and-cancel self-trade prevention mode,
+			and cancels the remaining size of the taker order after matching`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+				&constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+			},
+
+			order: constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_DecrementAndCancel,
+
+			expectedFilledSize:    10,
+			expectedOrderStatus:   types.SelfTradeCanceled,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+			expectedCollatCheck: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_DecrementAndCancel,
+					matchedQuantums: 10,
+				},
+			},
+			expectedMatches: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_DecrementAndCancel,
+					matchedQuantums: 10,
+				},
+			},
+			expectedOperations: []types.Operation{
+				clobtest.NewOrderPlacementOperation(
+					constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				),
+				clobtest.NewOrderPlacementOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_DecrementAndCancel,
+				),
+				clobtest.NewMatchOperation(
+					&constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_DecrementAndCancel,
+					[]types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+							FillAmount:   10,
+						},
+					},
+				),
+			},
+			expectedInternalOperations: []types.InternalOperation{
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				),
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_DecrementAndCancel,
+				),
+				types.NewMatchOrdersInternalOperation(
+					constants.Order_Alice_Num1_Id14_Clob1_Buy35_PriceMax_GTB30_STP_DecrementAndCancel,
+					[]types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+							FillAmount:   10,
+						},
+					},
+				),
+			},
+		},
+		`Cancels both orders if two orders from the same subaccount overlap and the taker order is smaller
+			and uses the decrement-and-cancel self-trade prevention mode`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+			},
+
+			order: constants.Order_Alice_Num1_Id14_Clob1_Buy5_PriceMax_GTB30_STP_DecrementAndCancel,
+
+			expectedFilledSize:         0,
+			expectedOrderStatus:        types.SelfTradeCanceled,
+			expectedRemainingBids:      []OrderWithRemainingSize{},
+			expectedRemainingAsks:      []OrderWithRemainingSize{},
+			expectedCollatCheck:        []expectedMatch{},
+			expectedMatches:            []expectedMatch{},
+			expectedOperations:         []types.Operation{},
+			expectedInternalOperations: []types.InternalOperation{},
+		},
+		`Continues matching and cancels maker orders from other subaccounts of the same owner if the
+			taker order has owner-level self-trade prevention enabled`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+				&constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+			},
+
+			order: constants.Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel,
+
+			expectedFilledSize:  10,
+			expectedOrderStatus: types.Success,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel,
+					RemainingSize: 25,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+			expectedCollatCheck: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel,
+					matchedQuantums: 10,
+				},
+			},
+			expectedMatches: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel,
+					matchedQuantums: 10,
+				},
+			},
+			expectedOperations: []types.Operation{
+				clobtest.NewOrderPlacementOperation(
+					constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				),
+				clobtest.NewOrderPlacementOperation(
+					constants.Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel,
+				),
+				clobtest.NewMatchOperation(
+					&constants.Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel,
+					[]types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+							FillAmount:   10,
+						},
+					},
+				),
+			},
+			expectedInternalOperations: []types.InternalOperation{
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20,
+				),
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel,
+				),
+				types.NewMatchOrdersInternalOperation(
+					constants.Order_Alice_Num0_Id11_Clob1_Buy35_PriceMax_GTB30_STP_OwnerLevel,
+					[]types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId,
+							FillAmount:   10,
+						},
+					},
+				),
+			},
+		},
 		"Buy order fully matches multiple sell orders and remaining size is added to the orderbook after it uncrosses": {
 			placedMatchableOrders: []types.MatchableOrder{
 				&constants.Order_Alice_Num1_Id5_Clob1_Sell50_Price40_GTB20,
@@ -0,0 +1,151 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceOrder(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	tests := map[string]struct {
+		// State.
+		placedMatchableOrders []types.MatchableOrder
+
+		// Parameters.
+		order types.Order
+
+		// Expectations.
+		expectedErr           error
+		expectedRemainingBids []OrderWithRemainingSize
+		expectedFrontOfLevel  types.OrderId
+	}{
+		"Replacement with the same price and a lower size retains queue priority": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+				&constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+			},
+
+			order: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20,
+
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20,
+					RemainingSize: 5,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+					RemainingSize: 20,
+				},
+			},
+			expectedFrontOfLevel: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.OrderId,
+		},
+		"Replacement with the same price and a greater size loses queue priority": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+				&constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+			},
+
+			order: constants.Order_Alice_Num0_Id0_Clob0_Buy35_Price10_GTB20,
+
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+					RemainingSize: 20,
+				},
+				{
+					Order:         constants.Order_Alice_Num0_Id0_Clob0_Buy35_Price10_GTB20,
+					RemainingSize: 35,
+				},
+			},
+			expectedFrontOfLevel: constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22.OrderId,
+		},
+		"Replacement with a different price moves the order to the new price level": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+				&constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+			},
+
+			order: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price5_GTB20,
+
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+					RemainingSize: 20,
+				},
+				{
+					Order:         constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price5_GTB20,
+					RemainingSize: 5,
+				},
+			},
+			expectedFrontOfLevel: constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22.OrderId,
+		},
+		"Replacement identical to the existing order is rejected": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+				&constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+			},
+
+			order: constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+
+			expectedErr: types.ErrInvalidReplacement,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+					RemainingSize: 10,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+					RemainingSize: 20,
+				},
+			},
+			expectedFrontOfLevel: constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16.OrderId,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup memclob state and test expectations.
+			memclob, _ := setUpMemclobAndOrderbook(
+				t,
+				ctx,
+				tc.placedMatchableOrders,
+				constants.GetStatePosition_ZeroPositionSize,
+				[]types.MatchableOrder{&tc.order},
+			)
+
+			// Run the test case and verify expectations.
+			_, orderStatus, offchainUpdates, err := memclob.ReplaceOrder(ctx, tc.order)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, types.Success, orderStatus)
+
+				// A single replace message should be sent for the order instead of a removal and placement.
+				require.Equal(t, types.ReplaceMessageType, offchainUpdates.Messages[0].Type)
+				require.Equal(t, tc.order.OrderId, offchainUpdates.Messages[0].OrderId)
+				for _, message := range offchainUpdates.Messages[1:] {
+					require.NotEqual(t, types.RemoveMessageType, message.Type)
+					require.NotEqual(t, types.PlaceMessageType, message.Type)
+				}
+			}
+
+			AssertMemclobHasOrders(t, ctx, memclob, tc.expectedRemainingBids, []OrderWithRemainingSize{})
+
+			// Verify the order at the front of the price level of the first bid.
+			orderbook := memclob.openOrders.mustGetOrderbook(ctx, tc.order.GetClobPairId())
+			firstOrder, found := memclob.openOrders.getFirstOrderAtSideAndSubticks(
+				orderbook,
+				true, // isBuy
+				tc.expectedRemainingBids[0].Order.GetOrderSubticks(),
+			)
+			require.True(t, found)
+			require.Equal(t, tc.expectedFrontOfLevel, firstOrder.Value.Order.OrderId)
+		})
+	}
+}
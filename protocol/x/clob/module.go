@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	daemonservertypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
 	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/liquidations"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/client/cli"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
@@ -109,6 +110,7 @@ type AppModule struct {
 	bankKeeper                types.BankKeeper
 	subaccountsKeeper         types.SubaccountsKeeper
 	liquidatableSubaccountIds *liquidationtypes.LiquidatableSubaccountIds
+	daemonHealthChecker       daemonservertypes.DaemonHealthChecker
 }
 
 func NewAppModule(
@@ -118,6 +120,7 @@ func NewAppModule(
 	bankKeeper types.BankKeeper,
 	subaccountsKeeper types.SubaccountsKeeper,
 	liquidatableSubaccountIds *liquidationtypes.LiquidatableSubaccountIds,
+	daemonHealthChecker daemonservertypes.DaemonHealthChecker,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic:            NewAppModuleBasic(cdc),
@@ -126,6 +129,7 @@ func NewAppModule(
 		bankKeeper:                bankKeeper,
 		subaccountsKeeper:         subaccountsKeeper,
 		liquidatableSubaccountIds: liquidatableSubaccountIds,
+		daemonHealthChecker:       daemonHealthChecker,
 	}
 }
 
@@ -195,5 +199,6 @@ func (am AppModule) Commit(ctx sdk.Context) {
 		ctx,
 		am.keeper,
 		am.liquidatableSubaccountIds,
+		am.daemonHealthChecker,
 	)
 }
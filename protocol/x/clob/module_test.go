@@ -105,6 +105,7 @@ func createAppModuleWithKeeper(t *testing.T) (
 		nil,
 		nil,
 		liquidations_types.NewLiquidatableSubaccountIds(),
+		&mocks.DaemonHealthChecker{},
 	), ks.ClobKeeper, ks.PricesKeeper, ks.PerpetualsKeeper, ks.Ctx, mockIndexerEventManager
 }
 
@@ -22,6 +22,10 @@ type ClobKeeper interface {
 		success bool,
 		successPerUpdate map[satypes.SubaccountId]satypes.UpdateResult,
 	)
+	BatchPlaceAndCancelShortTermOrders(
+		ctx sdk.Context,
+		msg *MsgBatchPlaceAndCancel,
+	) (*MsgBatchPlaceAndCancelResponse, error)
 	CancelShortTermOrder(ctx sdk.Context, msg *MsgCancelOrder) error
 	CancelStatefulOrder(ctx sdk.Context, msg *MsgCancelOrder) error
 	CreatePerpetualClobPair(
@@ -45,6 +49,12 @@ type ClobKeeper interface {
 		err error,
 	)
 	PlaceStatefulOrder(ctx sdk.Context, msg *MsgPlaceOrder) error
+	ReplaceShortTermOrder(ctx sdk.Context, msg *MsgReplaceOrder) (
+		orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+		orderStatus OrderStatus,
+		err error,
+	)
+	ReplaceStatefulOrder(ctx sdk.Context, msg *MsgReplaceOrder) error
 	PruneStateFillAmountsForShortTermOrders(
 		ctx sdk.Context,
 	)
@@ -119,6 +129,7 @@ type ClobKeeper interface {
 	GetIndexerEventManager() indexer_manager.IndexerEventManager
 	RateLimitCancelOrder(ctx sdk.Context, order *MsgCancelOrder) error
 	RateLimitPlaceOrder(ctx sdk.Context, order *MsgPlaceOrder) error
+	RateLimitBatchPlaceAndCancel(ctx sdk.Context, msg *MsgBatchPlaceAndCancel) error
 	InitializeBlockRateLimit(ctx sdk.Context, config BlockRateLimitConfiguration) error
 	InitializeEquityTierLimit(ctx sdk.Context, config EquityTierLimitConfiguration) error
 	Logger(ctx sdk.Context) log.Logger
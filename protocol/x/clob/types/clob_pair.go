@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -10,7 +13,26 @@ import (
 // may be transitioned to from this state. Note the keys of this map may be
 // a subset of the types defined in the proto for ClobPair_Status.
 var SupportedClobPairStatusTransitions = map[ClobPair_Status]map[ClobPair_Status]struct{}{
-	ClobPair_STATUS_ACTIVE: {},
+	ClobPair_STATUS_ACTIVE: {
+		ClobPair_STATUS_PAUSED:      struct{}{},
+		ClobPair_STATUS_CANCEL_ONLY: struct{}{},
+		ClobPair_STATUS_POST_ONLY:   struct{}{},
+	},
+	ClobPair_STATUS_PAUSED: {
+		ClobPair_STATUS_ACTIVE:      struct{}{},
+		ClobPair_STATUS_CANCEL_ONLY: struct{}{},
+		ClobPair_STATUS_POST_ONLY:   struct{}{},
+	},
+	ClobPair_STATUS_CANCEL_ONLY: {
+		ClobPair_STATUS_ACTIVE:    struct{}{},
+		ClobPair_STATUS_PAUSED:    struct{}{},
+		ClobPair_STATUS_POST_ONLY: struct{}{},
+	},
+	ClobPair_STATUS_POST_ONLY: {
+		ClobPair_STATUS_ACTIVE:      struct{}{},
+		ClobPair_STATUS_PAUSED:      struct{}{},
+		ClobPair_STATUS_CANCEL_ONLY: struct{}{},
+	},
 	ClobPair_STATUS_INITIALIZING: {
 		ClobPair_STATUS_ACTIVE: struct{}{},
 	},
@@ -39,10 +61,11 @@ func (c *ClobPair) GetClobPairMinOrderBaseQuantums() satypes.BaseQuantums {
 }
 
 // GetPerpetualId returns the `PerpetualId` for the provided `clobPair`.
+// Returns an error if the `clobPair` is not a perpetual CLOB pair.
 func (c *ClobPair) GetPerpetualId() (uint32, error) {
 	perpetualClobMetadata := c.GetPerpetualClobMetadata()
 	if perpetualClobMetadata == nil {
-		return 0, ErrAssetOrdersNotImplemented
+		return 0, ErrNotPerpetualClobPair
 	}
 
 	return perpetualClobMetadata.PerpetualId, nil
@@ -58,6 +81,21 @@ func (c *ClobPair) MustGetPerpetualId() uint32 {
 	return id
 }
 
+// IsSpotClobPair returns true if the provided `clobPair` is a spot CLOB pair.
+func (c *ClobPair) IsSpotClobPair() bool {
+	return c.GetSpotClobMetadata() != nil
+}
+
+// MustGetSpotClobMetadata returns the `SpotClobMetadata` for the provided `clobPair`.
+// Will panic if the `clobPair` is not a spot CLOB pair.
+func (c *ClobPair) MustGetSpotClobMetadata() SpotClobMetadata {
+	spotClobMetadata := c.GetSpotClobMetadata()
+	if spotClobMetadata == nil {
+		panic(fmt.Sprintf("MustGetSpotClobMetadata: CLOB pair %d is not a spot CLOB pair", c.Id))
+	}
+	return *spotClobMetadata
+}
+
 // GetId returns the `ClobPairId` for the provided `clobPair`.
 func (c *ClobPair) GetClobPairId() ClobPairId {
 	return ClobPairId(c.Id)
@@ -65,14 +103,34 @@ func (c *ClobPair) GetClobPairId() ClobPairId {
 
 // Stateless validation on ClobPair.
 func (c *ClobPair) Validate() error {
-	switch c.Metadata.(type) {
-	// TODO(DEC-1535): update this when additional clob pair types are supported.
+	switch metadata := c.Metadata.(type) {
 	case *ClobPair_SpotClobMetadata:
-		return errorsmod.Wrapf(
-			ErrInvalidClobPairParameter,
-			"CLOB pair (%+v) is not a perpetual CLOB.",
-			c,
-		)
+		spotClobMetadata := metadata.SpotClobMetadata
+		if spotClobMetadata == nil {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) is missing spot metadata.",
+				c,
+			)
+		}
+
+		// Fees and collateral are denominated in USDC, so spot CLOB pairs must be quoted in USDC.
+		if spotClobMetadata.QuoteAssetId != assettypes.AssetUsdc.Id {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"invalid ClobPair parameter: spot QuoteAssetId must be %d (USDC). Got %v",
+				assettypes.AssetUsdc.Id,
+				spotClobMetadata.QuoteAssetId,
+			)
+		}
+
+		if spotClobMetadata.BaseAssetId == spotClobMetadata.QuoteAssetId {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"invalid ClobPair parameter: spot BaseAssetId must not equal QuoteAssetId. Got %v",
+				spotClobMetadata.BaseAssetId,
+			)
+		}
 	}
 
 	if !IsSupportedClobPairStatus(c.Status) {
@@ -30,14 +30,15 @@ const (
 	ClobPair_STATUS_UNSPECIFIED ClobPair_Status = 0
 	// STATUS_ACTIVE represents an active clob pair.
 	ClobPair_STATUS_ACTIVE ClobPair_Status = 1
-	// STATUS_PAUSED behavior is unfinalized.
-	// TODO(DEC-600): update this documentation.
+	// STATUS_PAUSED represents a paused clob pair. Clob pairs in this
+	// state do not accept order placements or cancellations, and are
+	// skipped by liquidations, deleveraging and conditional order triggering.
 	ClobPair_STATUS_PAUSED ClobPair_Status = 2
-	// STATUS_CANCEL_ONLY behavior is unfinalized.
-	// TODO(DEC-600): update this documentation.
+	// STATUS_CANCEL_ONLY represents a clob pair which only accepts
+	// order cancellations. No orders may be placed or matched.
 	ClobPair_STATUS_CANCEL_ONLY ClobPair_Status = 3
-	// STATUS_POST_ONLY behavior is unfinalized.
-	// TODO(DEC-600): update this documentation.
+	// STATUS_POST_ONLY represents a clob pair which only accepts
+	// post-only orders and cancellations. No orders may be matched.
 	ClobPair_STATUS_POST_ONLY ClobPair_Status = 4
 	// STATUS_INITIALIZING represents a newly-added clob pair.
 	// Clob pairs in this state only accept orders which are
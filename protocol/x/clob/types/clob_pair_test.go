@@ -35,12 +35,14 @@ func TestGetPerpetualId(t *testing.T) {
 
 	perpetualId, err = constants.ClobPair_Asset.GetPerpetualId()
 	require.Equal(t, uint32(0), perpetualId)
-	require.ErrorIs(t, types.ErrAssetOrdersNotImplemented, err)
+	require.ErrorIs(t, types.ErrNotPerpetualClobPair, err)
 }
 
 func TestIsSupportedClobPairStatus_Supported(t *testing.T) {
-	// these are the only two supported statuses
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_ACTIVE))
+	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_PAUSED))
+	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_CANCEL_ONLY))
+	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_POST_ONLY))
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_INITIALIZING))
 }
 
@@ -48,18 +50,30 @@ func TestIsSupportedClobPairStatus_Unsupported(t *testing.T) {
 	// out of bounds of the clob pair status enum
 	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_Status(100)))
 
-	// these are part of the ClobPair_Status enum but are not supported
+	// this is part of the ClobPair_Status enum but is not supported
 	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_UNSPECIFIED))
-	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_PAUSED))
-	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_CANCEL_ONLY))
-	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_POST_ONLY))
 }
 
 func TestIsSupportedClobPairStatusTransition_Supported(t *testing.T) {
-	// only supported transition
 	require.True(t, types.IsSupportedClobPairStatusTransition(
 		types.ClobPair_STATUS_INITIALIZING, types.ClobPair_STATUS_ACTIVE,
 	))
+
+	// any two of the trading statuses may transition between each other
+	tradingStatuses := []types.ClobPair_Status{
+		types.ClobPair_STATUS_ACTIVE,
+		types.ClobPair_STATUS_PAUSED,
+		types.ClobPair_STATUS_CANCEL_ONLY,
+		types.ClobPair_STATUS_POST_ONLY,
+	}
+	for _, from := range tradingStatuses {
+		for _, to := range tradingStatuses {
+			if from == to {
+				continue
+			}
+			require.True(t, types.IsSupportedClobPairStatusTransition(from, to))
+		}
+	}
 }
 
 func TestIsSupportedClobPairStatusTransition_Unsupported(t *testing.T) {
@@ -77,17 +91,16 @@ func TestIsSupportedClobPairStatusTransition_Unsupported(t *testing.T) {
 	// iterate over all permutations of clob pair statuses
 	for _, fromClobPairStatus := range types.ClobPair_Status_value {
 		for _, toClobPairStatus := range types.ClobPair_Status_value {
-			if fromClobPairStatus == int32(types.ClobPair_STATUS_INITIALIZING) &&
-				toClobPairStatus == int32(types.ClobPair_STATUS_ACTIVE) {
-				continue
-			} else {
-				require.False(
-					t,
-					types.IsSupportedClobPairStatusTransition(
-						types.ClobPair_Status(fromClobPairStatus),
-						types.ClobPair_Status(toClobPairStatus),
-					),
-				)
+			from := types.ClobPair_Status(fromClobPairStatus)
+			to := types.ClobPair_Status(toClobPairStatus)
+			// Nothing may transition to or from the unspecified status, a ClobPair may not
+			// transition to its current status, and no ClobPair may transition back to initializing.
+			if from == types.ClobPair_STATUS_UNSPECIFIED ||
+				to == types.ClobPair_STATUS_UNSPECIFIED ||
+				from == to ||
+				to == types.ClobPair_STATUS_INITIALIZING ||
+				(from == types.ClobPair_STATUS_INITIALIZING && to != types.ClobPair_STATUS_ACTIVE) {
+				require.False(t, types.IsSupportedClobPairStatusTransition(from, to))
 			}
 		}
 	}
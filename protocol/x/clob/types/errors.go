@@ -201,6 +201,36 @@ var (
 		43,
 		"Order has remaining size",
 	)
+	ErrNotPerpetualClobPair = errorsmod.Register(
+		ModuleName,
+		44,
+		"ClobPair is not a perpetual CLOB pair",
+	)
+	ErrInvalidSelfTradePrevention = errorsmod.Register(
+		ModuleName,
+		45,
+		"Invalid self-trade prevention",
+	)
+	ErrInvalidBatchPlaceAndCancel = errorsmod.Register(
+		ModuleName,
+		46,
+		"Invalid batch place and cancel",
+	)
+	ErrInvalidReplacementOrder = errorsmod.Register(
+		ModuleName,
+		47,
+		"Invalid replacement order",
+	)
+	ErrOrderToReplaceDoesNotExist = errorsmod.Register(
+		ModuleName,
+		48,
+		"Order to replace does not exist",
+	)
+	ErrInvalidGrpcStreamingRequest = errorsmod.Register(
+		ModuleName,
+		49,
+		"Invalid gRPC streaming request",
+	)
 
 	// Liquidations errors.
 	ErrInvalidLiquidationsConfig = errorsmod.Register(
@@ -392,6 +422,11 @@ var (
 		3010,
 		"Stateful order cancellation failed because the order was already removed from state",
 	)
+	ErrInvalidOneCancelsOtherOrder = errorsmod.Register(
+		ModuleName,
+		3011,
+		"One-cancels-other order is invalid",
+	)
 
 	// Operations Queue validation errors
 	ErrInvalidMsgProposedOperations = errorsmod.Register(
@@ -434,6 +469,11 @@ var (
 		4007,
 		"Order Removal reason is invalid",
 	)
+	ErrDeleveragingFillNotInAdlOrder = errorsmod.Register(
+		ModuleName,
+		4008,
+		"Deleveraging fills are not in auto-deleveraging order",
+	)
 
 	// Block rate limit errors.
 	ErrInvalidBlockRateLimitConfig = errorsmod.Register(
@@ -463,27 +503,22 @@ var (
 		6002,
 		"Conditional order is untriggered",
 	)
-
-	// Errors for unimplemented and disabled functionality.
-	ErrAssetOrdersNotImplemented = errorsmod.Register(
-		ModuleName,
-		9000,
-		"Asset orders are not implemented",
-	)
-	ErrAssetUpdateNotImplemented = errorsmod.Register(
+	ErrInvalidConditionalOrderTrailingPercent = errorsmod.Register(
 		ModuleName,
-		9001,
-		"Updates for assets other than USDC are not implemented",
+		6003,
+		"Conditional order trailing percent is invalid",
 	)
+
+	// Errors for unimplemented and disabled functionality.
 	ErrNotImplemented = errorsmod.Register(
 		ModuleName,
 		9002,
 		"This function is not implemented",
 	)
-	ErrReduceOnlyDisabled = errorsmod.Register(
+	ErrGrpcStreamingManagerNotEnabled = errorsmod.Register(
 		ModuleName,
 		9003,
-		"Reduce-only is currently disabled",
+		"GrpcStreamingManager is not enabled",
 	)
 
 	// Equity tier limit errors.
@@ -26,6 +26,21 @@ const (
 	AttributeKeyIsLiquidation                           = "is_liquidation"
 	AttributeKeyIsDeleverage                            = "is_deleverage"
 	AttributeKeyPerpetualId                             = "perpetual_id"
+	AttributeKeyMakerAssetQuantumsDeltaBaseQuantums     = "maker_asset_quantums_delta_base_quantums"
+	AttributeKeyTakerAssetQuantumsDeltaBaseQuantums     = "taker_asset_quantums_delta_base_quantums"
+	AttributeKeyBaseAssetId                             = "base_asset_id"
+
+	EventTypeBatchCancelOrderResult = "batch_cancel_order_result"
+	EventTypeBatchPlaceOrderResult  = "batch_place_order_result"
+
+	AttributeKeySubaccount                   = "subaccount"
+	AttributeKeySubaccountNumber             = "subaccount_number"
+	AttributeKeyClientId                     = "client_id"
+	AttributeKeyClobPairId                   = "clob_pair_id"
+	AttributeKeyBatchIndex                   = "batch_index"
+	AttributeKeyOrderStatus                  = "order_status"
+	AttributeKeyOptimisticallyFilledQuantums = "optimistically_filled_quantums"
+	AttributeKeyError                        = "error"
 )
 
 // NewCreateMatchEvent constructs a new match sdk.Event.
@@ -61,3 +76,67 @@ func NewCreateMatchEvent(
 		sdk.NewAttribute(AttributeKeyPerpetualId, fmt.Sprint(perpetualId)),
 	)
 }
+
+// NewCreateSpotMatchEvent constructs a new match sdk.Event for a match on a spot CLOB pair.
+func NewCreateSpotMatchEvent(
+	taker satypes.SubaccountId,
+	maker satypes.SubaccountId,
+	takerOrderFee *big.Int,
+	makerOrderFee *big.Int,
+	takerQuoteBalanceDelta *big.Int,
+	makerQuoteBalanceDelta *big.Int,
+	takerAssetQuantumsDelta *big.Int,
+	makerAssetQuantumsDelta *big.Int,
+	baseAssetId uint32,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeMatch,
+		sdk.NewAttribute(AttributeKeyTakerSubaccount, taker.Owner),
+		sdk.NewAttribute(AttributeKeyTakerSubaccountNumber, fmt.Sprint(taker.Number)),
+		sdk.NewAttribute(AttributeKeyMakerSubaccount, maker.Owner),
+		sdk.NewAttribute(AttributeKeyMakerSubaccountNumber, fmt.Sprint(maker.Number)),
+		sdk.NewAttribute(AttributeKeyTakerOrderFeeQuoteQuantums, fmt.Sprint(takerOrderFee)),
+		sdk.NewAttribute(AttributeKeyMakerOrderFeeQuoteQuantums, fmt.Sprint(makerOrderFee)),
+		sdk.NewAttribute(AttributeKeyTakerQuoteBalanceDeltaQuoteQuantums, takerQuoteBalanceDelta.String()),
+		sdk.NewAttribute(AttributeKeyMakerQuoteBalanceDeltaQuoteQuantums, makerQuoteBalanceDelta.String()),
+		sdk.NewAttribute(AttributeKeyTakerAssetQuantumsDeltaBaseQuantums, takerAssetQuantumsDelta.String()),
+		sdk.NewAttribute(AttributeKeyMakerAssetQuantumsDeltaBaseQuantums, makerAssetQuantumsDelta.String()),
+		sdk.NewAttribute(AttributeKeyBaseAssetId, fmt.Sprint(baseAssetId)),
+	)
+}
+
+// NewBatchCancelOrderResultEvent constructs a new sdk.Event for the result of the cancellation at index
+// `batchIndex` of a `MsgBatchPlaceAndCancel`.
+func NewBatchCancelOrderResultEvent(
+	batchIndex int,
+	result BatchCancelOrderResult,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeBatchCancelOrderResult,
+		sdk.NewAttribute(AttributeKeyBatchIndex, fmt.Sprint(batchIndex)),
+		sdk.NewAttribute(AttributeKeySubaccount, result.OrderId.SubaccountId.Owner),
+		sdk.NewAttribute(AttributeKeySubaccountNumber, fmt.Sprint(result.OrderId.SubaccountId.Number)),
+		sdk.NewAttribute(AttributeKeyClientId, fmt.Sprint(result.OrderId.ClientId)),
+		sdk.NewAttribute(AttributeKeyClobPairId, fmt.Sprint(result.OrderId.ClobPairId)),
+		sdk.NewAttribute(AttributeKeyError, result.Error),
+	)
+}
+
+// NewBatchPlaceOrderResultEvent constructs a new sdk.Event for the result of the placement at index
+// `batchIndex` of a `MsgBatchPlaceAndCancel`.
+func NewBatchPlaceOrderResultEvent(
+	batchIndex int,
+	result BatchPlaceOrderResult,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeBatchPlaceOrderResult,
+		sdk.NewAttribute(AttributeKeyBatchIndex, fmt.Sprint(batchIndex)),
+		sdk.NewAttribute(AttributeKeySubaccount, result.OrderId.SubaccountId.Owner),
+		sdk.NewAttribute(AttributeKeySubaccountNumber, fmt.Sprint(result.OrderId.SubaccountId.Number)),
+		sdk.NewAttribute(AttributeKeyClientId, fmt.Sprint(result.OrderId.ClientId)),
+		sdk.NewAttribute(AttributeKeyClobPairId, fmt.Sprint(result.OrderId.ClobPairId)),
+		sdk.NewAttribute(AttributeKeyOrderStatus, OrderStatus(result.Status).String()),
+		sdk.NewAttribute(AttributeKeyOptimisticallyFilledQuantums, fmt.Sprint(result.OptimisticallyFilledQuantums)),
+		sdk.NewAttribute(AttributeKeyError, result.Error),
+	)
+}
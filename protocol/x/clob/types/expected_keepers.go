@@ -42,10 +42,23 @@ type SubaccountsKeeper interface {
 	) (
 		list []satypes.Subaccount,
 	)
-	ForEachSubaccountRandomStart(
+	GetAdlRanking(
 		ctx sdk.Context,
-		callback func(satypes.Subaccount) (finished bool),
-		rand *rand.Rand,
+		perpetualId uint32,
+		isLong bool,
+		limit uint32,
+	) (
+		positions []satypes.AdlPosition,
+		err error,
+	)
+	GetAdlPosition(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		perpetualId uint32,
+	) (
+		adlPosition satypes.AdlPosition,
+		exists bool,
+		err error,
 	)
 	GetRandomSubaccount(
 		ctx sdk.Context,
@@ -70,11 +83,20 @@ type SubaccountsKeeper interface {
 	TransferInsuranceFundPayments(
 		ctx sdk.Context,
 		amount *big.Int,
+		perpetualId uint32,
+	) error
+	ApplySimulatedSubaccountUpdate(
+		ctx sdk.Context,
+		update satypes.Update,
 	) error
 }
 
 type AssetsKeeper interface {
 	GetAsset(ctx sdk.Context, id uint32) (val assettypes.Asset, exists bool)
+	GetAssetAndMarketPrice(
+		ctx sdk.Context,
+		id uint32,
+	) (assettypes.Asset, pricestypes.MarketPrice, error)
 }
 
 type BlockTimeKeeper interface {
@@ -123,6 +145,7 @@ type PerpetualsKeeper interface {
 		ctx sdk.Context,
 		id uint32,
 	) (val perpetualsmoduletypes.Perpetual, err error)
+	GetAllPerpetuals(ctx sdk.Context) []perpetualsmoduletypes.Perpetual
 	GetPerpetualAndMarketPrice(
 		ctx sdk.Context,
 		perpetualId uint32,
@@ -140,6 +163,13 @@ type PerpetualsKeeper interface {
 	MaybeProcessNewFundingTickEpoch(ctx sdk.Context)
 }
 
+type PricesKeeper interface {
+	SetSimulatedMarketPrices(
+		ctx sdk.Context,
+		updates []*pricestypes.MsgUpdateMarketPrices_MarketPrice,
+	) error
+}
+
 type StatsKeeper interface {
 	RecordFill(ctx sdk.Context, takerAddress string, makerAddress string, notional *big.Int)
 }
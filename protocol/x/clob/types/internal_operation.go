@@ -162,3 +162,25 @@ func NewOrderRemovalInternalOperation(
 		},
 	}
 }
+
+// NewSelfTradeOrderRemovalInternalOperation returns a new operation for removing an order which
+// self-traded with the order with ID `selfTradeOrderId`, or with a liquidation order if
+// `selfTradeOrderId` is nil.
+// This function panics if it's called with an OrderId for a non stateful order.
+func NewSelfTradeOrderRemovalInternalOperation(
+	orderId OrderId,
+	selfTradeOrderId *OrderId,
+) InternalOperation {
+	orderId.MustBeStatefulOrder()
+
+	orderRemoval := OrderRemoval{
+		OrderId:          orderId,
+		RemovalReason:    OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+		SelfTradeOrderId: selfTradeOrderId,
+	}
+	return InternalOperation{
+		Operation: &InternalOperation_OrderRemoval{
+			OrderRemoval: &orderRemoval,
+		},
+	}
+}
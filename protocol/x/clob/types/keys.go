@@ -1,5 +1,9 @@
 package types
 
+import (
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
 // Module name and store keys
 const (
 	// ModuleName defines the module name
@@ -52,6 +56,10 @@ const (
 	// StatefulOrdersTimeSlicePrefix is the key to retrieve a unique list of the stateful orders that
 	// expire at a given timestamp, sorted by order ID.
 	StatefulOrdersTimeSlicePrefix = "ExpTm:"
+
+	// OneCancelsOtherKeyPrefix is the prefix to retrieve the order ID of the stateful order paired
+	// with a stateful order as a one-cancels-other order.
+	OneCancelsOtherKeyPrefix = "OCO:"
 )
 
 // Store / Memstore
@@ -110,5 +118,5 @@ const (
 // Module Accounts
 const (
 	// InsuranceFundName defines the root string for the insurance fund account address
-	InsuranceFundName = "insurance_fund"
+	InsuranceFundName = perptypes.InsuranceFundName
 )
@@ -19,7 +19,8 @@ type MatchWithOrders struct {
 // not perform any state reads, or memclob reads.
 //
 // This validation ensures:
-//   - Order match does not constitute a self-trade.
+//   - Order match does not constitute a self-trade, including between subaccounts of the same owner
+//     if the taker order has owner-level self-trade prevention enabled.
 //   - Order match contains a `fillAmount` greater than 0.
 //   - Orders in match are for the same `ClobPairId`.
 //   - Orders in match are for opposing sides.
@@ -31,8 +32,14 @@ func (match MatchWithOrders) Validate() error {
 	makerOrder := match.MakerOrder
 	takerOrder := match.TakerOrder
 	fillAmount := match.FillAmount
-	// Make sure the maker and taker order are not for the same Subaccount.
-	if makerOrder.GetSubaccountId() == takerOrder.GetSubaccountId() {
+	// Make sure the maker and taker order are not for the same Subaccount, or for the same owner if
+	// the taker order has owner-level self-trade prevention enabled.
+	isSelfTrade := makerOrder.GetSubaccountId() == takerOrder.GetSubaccountId()
+	if !takerOrder.IsLiquidation() {
+		unwrappedTakerOrder := takerOrder.MustGetOrder()
+		isSelfTrade = unwrappedTakerOrder.IsSelfTrade(makerOrder.GetSubaccountId())
+	}
+	if isSelfTrade {
 		return errors.New("Match constitutes a self-trade")
 	}
 
@@ -36,6 +36,25 @@ func TestPerformStatelessMatchOrdersValidation(t *testing.T) {
 			fillAmount:    50_000_000, // .5 BTC
 			expectedError: errors.New("Match constitutes a self-trade"),
 		},
+		"Stateless match validation: match constitutes an owner-level self-trade": {
+			makerOrder: &types.Order{
+				OrderId:      types.OrderId{SubaccountId: constants.Alice_Num1, ClientId: 0, ClobPairId: 0},
+				Side:         types.Order_SIDE_BUY,
+				Quantums:     100_000_000,
+				Subticks:     50_000_000_000,
+				GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+			},
+			takerOrder: &types.Order{
+				OrderId:                       types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 0, ClobPairId: 0},
+				Side:                          types.Order_SIDE_SELL,
+				Quantums:                      100_000_000,
+				Subticks:                      50_000_000_000,
+				GoodTilOneof:                  &types.Order_GoodTilBlock{GoodTilBlock: 20},
+				SelfTradePreventionOwnerLevel: true,
+			},
+			fillAmount:    50_000_000, // .5 BTC
+			expectedError: errors.New("Match constitutes a self-trade"),
+		},
 		"Stateless match validation: fillAmount must be greater than 0": {
 			makerOrder:    &constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10,
 			takerOrder:    &constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10,
@@ -40,6 +40,7 @@ type MemClobKeeper interface {
 	CanDeleverageSubaccount(
 		ctx sdk.Context,
 		subaccountId satypes.SubaccountId,
+		perpetualId uint32,
 	) (bool, error)
 	GetStatePosition(
 		ctx sdk.Context,
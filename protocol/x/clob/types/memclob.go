@@ -59,6 +59,14 @@ type MemClob interface {
 		ctx sdk.Context,
 		orderId OrderId,
 	) satypes.BaseQuantums
+	GetOffchainUpdatesForOrderbookSnapshot(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+	) *OffchainUpdates
+	GetOpenOrders(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+	) []Order
 	GetOrderRemainingAmount(
 		ctx sdk.Context,
 		order Order,
@@ -76,6 +84,10 @@ type MemClob interface {
 		ctx sdk.Context,
 		order Order,
 	) (satypes.BaseQuantums, OrderStatus, *OffchainUpdates, error)
+	ReplaceOrder(
+		ctx sdk.Context,
+		order Order,
+	) (satypes.BaseQuantums, OrderStatus, *OffchainUpdates, error)
 	PlacePerpetualLiquidation(
 		ctx sdk.Context,
 		liquidationOrder LiquidationOrder,
@@ -134,4 +146,12 @@ type MemClob interface {
 		subticks Subticks,
 		exists bool,
 	)
+	GetOrderbookDepth(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+		maxLevels uint32,
+	) (
+		bids []OrderbookLevel,
+		asks []OrderbookLevel,
+	)
 }
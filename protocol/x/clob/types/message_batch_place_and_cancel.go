@@ -0,0 +1,150 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+const TypeMsgBatchPlaceAndCancel = "batch_place_and_cancel"
+
+// MaxBatchPlaceAndCancelSize is the maximum number of cancellations and placements combined
+// that may be included in a single `MsgBatchPlaceAndCancel`.
+const MaxBatchPlaceAndCancelSize = 32
+
+// ShortTermOrderBatchIndex is the context key used to store the index of the Short-Term order
+// placement currently being processed within a `MsgBatchPlaceAndCancel`.
+const ShortTermOrderBatchIndex = sdk.ContextKey("short_term_order_batch_index")
+
+var _ sdk.Msg = &MsgBatchPlaceAndCancel{}
+
+// NewMsgBatchPlaceAndCancel constructs a `MsgBatchPlaceAndCancel` from a subaccount ID and the
+// cancellations and placements to process.
+func NewMsgBatchPlaceAndCancel(
+	subaccountId satypes.SubaccountId,
+	cancels []MsgCancelOrder,
+	places []MsgPlaceOrder,
+) *MsgBatchPlaceAndCancel {
+	return &MsgBatchPlaceAndCancel{
+		SubaccountId: subaccountId,
+		Cancels:      cancels,
+		Places:       places,
+	}
+}
+
+func (msg *MsgBatchPlaceAndCancel) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ValidateBasic performs stateless validation on the batch. It verifies that the batch is non-empty
+// and does not exceed `MaxBatchPlaceAndCancelSize`, that every cancellation and placement is valid,
+// is for a Short-Term order and belongs to the batch's subaccount, and that no order ID appears more
+// than once in the batch.
+func (msg *MsgBatchPlaceAndCancel) ValidateBasic() (err error) {
+	defer func() {
+		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{ModuleName, metrics.BatchPlaceAndCancel, metrics.ValidateBasic, metrics.Error, metrics.Count},
+				1,
+				nil,
+			)
+		}
+	}()
+
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	numOrders := len(msg.Cancels) + len(msg.Places)
+	if numOrders == 0 {
+		return errorsmod.Wrap(ErrInvalidBatchPlaceAndCancel, "batch must contain at least one cancel or place")
+	}
+	if numOrders > MaxBatchPlaceAndCancelSize {
+		return errorsmod.Wrapf(
+			ErrInvalidBatchPlaceAndCancel,
+			"batch contains %d cancels and places which exceeds the maximum of %d",
+			numOrders,
+			MaxBatchPlaceAndCancelSize,
+		)
+	}
+
+	seenOrderIds := make(map[OrderId]struct{}, numOrders)
+	validateOrderId := func(orderId OrderId) error {
+		if !orderId.IsShortTermOrder() {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceAndCancel,
+				"order %+v is not a Short-Term order",
+				orderId,
+			)
+		}
+		if orderId.SubaccountId != msg.SubaccountId {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceAndCancel,
+				"order %+v does not belong to subaccount %+v",
+				orderId,
+				msg.SubaccountId,
+			)
+		}
+		if _, exists := seenOrderIds[orderId]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceAndCancel,
+				"order %+v appears more than once in the batch",
+				orderId,
+			)
+		}
+		seenOrderIds[orderId] = struct{}{}
+		return nil
+	}
+
+	for i, cancel := range msg.Cancels {
+		if err := cancel.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid cancel at index %d", i)
+		}
+		if err := validateOrderId(cancel.OrderId); err != nil {
+			return errorsmod.Wrapf(err, "invalid cancel at index %d", i)
+		}
+	}
+
+	for i, place := range msg.Places {
+		if err := place.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid place at index %d", i)
+		}
+		if err := validateOrderId(place.Order.OrderId); err != nil {
+			return errorsmod.Wrapf(err, "invalid place at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// GetPlaceOrderIndex returns the index of the placement of `order` within the batch's `Places`.
+// Returns false if the order is not placed by this batch.
+func (msg *MsgBatchPlaceAndCancel) GetPlaceOrderIndex(order Order) (index uint32, found bool) {
+	orderHash := order.GetOrderHash()
+	for i, place := range msg.Places {
+		if place.Order.GetOrderHash() == orderHash {
+			return uint32(i), true
+		}
+	}
+	return 0, false
+}
+
+// WithShortTermOrderBatchIndex returns a context that records `index` as the index of the
+// Short-Term order placement currently being processed within a `MsgBatchPlaceAndCancel`.
+func WithShortTermOrderBatchIndex(ctx sdk.Context, index uint32) sdk.Context {
+	return ctx.WithValue(ShortTermOrderBatchIndex, index)
+}
+
+// GetShortTermOrderBatchIndex returns the index of the Short-Term order placement currently being
+// processed within a `MsgBatchPlaceAndCancel`. Returns false if the order placement being processed
+// is not part of a batch.
+func GetShortTermOrderBatchIndex(ctx sdk.Context) (index uint32, found bool) {
+	index, found = ctx.Value(ShortTermOrderBatchIndex).(uint32)
+	return index, found
+}
@@ -0,0 +1,243 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchPlaceAndCancel_ValidateBasic(t *testing.T) {
+	subaccountId := satypes.SubaccountId{
+		Owner:  sample.AccAddress(),
+		Number: uint32(0),
+	}
+	otherSubaccountId := satypes.SubaccountId{
+		Owner:  subaccountId.Owner,
+		Number: uint32(1),
+	}
+	shortTermOrderId := func(clientId uint32) OrderId {
+		return OrderId{
+			SubaccountId: subaccountId,
+			ClientId:     clientId,
+			OrderFlags:   OrderIdFlags_ShortTerm,
+		}
+	}
+	shortTermOrder := func(orderId OrderId) MsgPlaceOrder {
+		return *NewMsgPlaceOrder(Order{
+			OrderId:      orderId,
+			Side:         Order_SIDE_BUY,
+			Quantums:     uint64(100),
+			Subticks:     uint64(10),
+			GoodTilOneof: &Order_GoodTilBlock{GoodTilBlock: uint32(10)},
+		})
+	}
+	shortTermCancel := func(orderId OrderId) MsgCancelOrder {
+		return *NewMsgCancelOrderShortTerm(orderId, uint32(10))
+	}
+
+	maxPlaces := make([]MsgPlaceOrder, 0, MaxBatchPlaceAndCancelSize)
+	for i := uint32(0); i < MaxBatchPlaceAndCancelSize; i++ {
+		maxPlaces = append(maxPlaces, shortTermOrder(shortTermOrderId(i)))
+	}
+
+	tests := map[string]struct {
+		msg MsgBatchPlaceAndCancel
+		err error
+	}{
+		"valid cancels and places": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				[]MsgCancelOrder{shortTermCancel(shortTermOrderId(0))},
+				[]MsgPlaceOrder{shortTermOrder(shortTermOrderId(1)), shortTermOrder(shortTermOrderId(2))},
+			),
+		},
+		"valid cancels only": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				[]MsgCancelOrder{shortTermCancel(shortTermOrderId(0))},
+				nil,
+			),
+		},
+		"valid places only": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				nil,
+				maxPlaces,
+			),
+		},
+		"invalid subaccountId owner": {
+			msg: MsgBatchPlaceAndCancel{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  "invalid_owner",
+					Number: uint32(0),
+				},
+			},
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"empty batch": {
+			msg: *NewMsgBatchPlaceAndCancel(subaccountId, nil, nil),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+		"batch exceeds maximum size": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				[]MsgCancelOrder{shortTermCancel(shortTermOrderId(MaxBatchPlaceAndCancelSize))},
+				maxPlaces,
+			),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+		"invalid cancel": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				[]MsgCancelOrder{*NewMsgCancelOrderShortTerm(shortTermOrderId(0), 0)},
+				nil,
+			),
+			err: ErrInvalidOrderGoodTilBlock,
+		},
+		"invalid place": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				nil,
+				[]MsgPlaceOrder{
+					*NewMsgPlaceOrder(Order{
+						OrderId:      shortTermOrderId(0),
+						Side:         Order_SIDE_BUY,
+						Quantums:     uint64(0),
+						Subticks:     uint64(10),
+						GoodTilOneof: &Order_GoodTilBlock{GoodTilBlock: uint32(10)},
+					}),
+				},
+			),
+			err: ErrInvalidOrderQuantums,
+		},
+		"stateful cancel": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				[]MsgCancelOrder{
+					*NewMsgCancelOrderStateful(
+						OrderId{
+							SubaccountId: subaccountId,
+							OrderFlags:   OrderIdFlags_LongTerm,
+						},
+						uint32(10),
+					),
+				},
+				nil,
+			),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+		"stateful place": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				nil,
+				[]MsgPlaceOrder{
+					*NewMsgPlaceOrder(Order{
+						OrderId: OrderId{
+							SubaccountId: subaccountId,
+							OrderFlags:   OrderIdFlags_LongTerm,
+						},
+						Side:         Order_SIDE_BUY,
+						Quantums:     uint64(100),
+						Subticks:     uint64(10),
+						GoodTilOneof: &Order_GoodTilBlockTime{GoodTilBlockTime: uint32(10)},
+					}),
+				},
+			),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+		"cancel for a different subaccount": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				[]MsgCancelOrder{
+					shortTermCancel(OrderId{SubaccountId: otherSubaccountId, OrderFlags: OrderIdFlags_ShortTerm}),
+				},
+				nil,
+			),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+		"place for a different subaccount": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				nil,
+				[]MsgPlaceOrder{
+					shortTermOrder(OrderId{SubaccountId: otherSubaccountId, OrderFlags: OrderIdFlags_ShortTerm}),
+				},
+			),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+		"duplicate cancel": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				[]MsgCancelOrder{shortTermCancel(shortTermOrderId(0)), shortTermCancel(shortTermOrderId(0))},
+				nil,
+			),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+		"duplicate place": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				nil,
+				[]MsgPlaceOrder{shortTermOrder(shortTermOrderId(0)), shortTermOrder(shortTermOrderId(0))},
+			),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+		"order is both canceled and placed": {
+			msg: *NewMsgBatchPlaceAndCancel(
+				subaccountId,
+				[]MsgCancelOrder{shortTermCancel(shortTermOrderId(0))},
+				[]MsgPlaceOrder{shortTermOrder(shortTermOrderId(0))},
+			),
+			err: ErrInvalidBatchPlaceAndCancel,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBatchPlaceAndCancel_GetPlaceOrderIndex(t *testing.T) {
+	subaccountId := satypes.SubaccountId{
+		Owner:  sample.AccAddress(),
+		Number: uint32(0),
+	}
+	orders := make([]Order, 0, 3)
+	for i := uint32(0); i < 3; i++ {
+		orders = append(orders, Order{
+			OrderId: OrderId{
+				SubaccountId: subaccountId,
+				ClientId:     i,
+				OrderFlags:   OrderIdFlags_ShortTerm,
+			},
+			Side:         Order_SIDE_SELL,
+			Quantums:     uint64(100),
+			Subticks:     uint64(10),
+			GoodTilOneof: &Order_GoodTilBlock{GoodTilBlock: uint32(10)},
+		})
+	}
+
+	msg := NewMsgBatchPlaceAndCancel(
+		subaccountId,
+		nil,
+		[]MsgPlaceOrder{*NewMsgPlaceOrder(orders[0]), *NewMsgPlaceOrder(orders[1])},
+	)
+
+	index, found := msg.GetPlaceOrderIndex(orders[0])
+	require.True(t, found)
+	require.Equal(t, uint32(0), index)
+
+	index, found = msg.GetPlaceOrderIndex(orders[1])
+	require.True(t, found)
+	require.Equal(t, uint32(1), index)
+
+	_, found = msg.GetPlaceOrderIndex(orders[2])
+	require.False(t, found)
+}
@@ -25,7 +25,7 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 		expectedErr string
 	}{
 		{
-			desc: "Invalid Metadata (SpotClobMetadata)",
+			desc: "Missing SpotClobMetadata",
 			msg: types.MsgCreateClobPair{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				ClobPair: types.ClobPair{
@@ -35,7 +35,7 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 					Status:           types.ClobPair_STATUS_ACTIVE,
 				},
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "is missing spot metadata",
 		},
 		{
 			desc: "Empty authority",
@@ -58,7 +58,7 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 					Metadata:         &types.ClobPair_PerpetualClobMetadata{},
 					StepBaseQuantums: 1,
 					SubticksPerTick:  1,
-					Status:           types.ClobPair_STATUS_PAUSED,
+					Status:           types.ClobPair_STATUS_UNSPECIFIED,
 				},
 			},
 			expectedErr: "has unsupported status",
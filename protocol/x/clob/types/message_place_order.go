@@ -4,6 +4,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
 
@@ -53,6 +54,14 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		return errorsmod.Wrapf(ErrInvalidOrderQuantums, "order size quantums cannot be 0")
 	}
 
+	if _, exists := Order_SelfTradePrevention_name[int32(msg.Order.SelfTradePrevention)]; !exists {
+		return errorsmod.Wrapf(
+			ErrInvalidSelfTradePrevention,
+			"invalid self-trade prevention (%s)",
+			msg.Order.SelfTradePrevention,
+		)
+	}
+
 	orderId := msg.Order.GetOrderId()
 	if orderId.IsShortTermOrder() {
 		// This also implicitly verifies that GoodTilBlockTime is not set / is zero for short-term orders.
@@ -74,10 +83,6 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		return ErrLongTermOrdersCannotRequireImmediateExecution
 	}
 
-	if msg.Order.ReduceOnly {
-		return errorsmod.Wrapf(ErrReduceOnlyDisabled, "reduce-only is temporarily disabled")
-	}
-
 	if msg.Order.Subticks == uint64(0) {
 		return errorsmod.Wrapf(ErrInvalidOrderSubticks, "order subticks cannot be 0")
 	}
@@ -90,6 +95,23 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		if msg.Order.ConditionalOrderTriggerSubticks == uint64(0) {
 			return errorsmod.Wrapf(ErrInvalidConditionalOrderTriggerSubticks, "conditional order trigger subticks cannot be 0")
 		}
+
+		if msg.Order.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP {
+			if msg.Order.ConditionalOrderTrailingPercentPpm == 0 ||
+				msg.Order.ConditionalOrderTrailingPercentPpm >= lib.OneMillion {
+				return errorsmod.Wrapf(
+					ErrInvalidConditionalOrderTrailingPercent,
+					"trailing stop order trailing percent ppm (%d) must be greater than 0 and less than %d",
+					msg.Order.ConditionalOrderTrailingPercentPpm,
+					lib.OneMillion,
+				)
+			}
+		} else if msg.Order.ConditionalOrderTrailingPercentPpm != 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidConditionalOrderTrailingPercent,
+				"conditional order trailing percent ppm greater than 0 for non-trailing stop order",
+			)
+		}
 	} else {
 		if msg.Order.ConditionType != Order_CONDITION_TYPE_UNSPECIFIED {
 			return errorsmod.Wrapf(ErrInvalidConditionType, "condition type specified for non-conditional order")
@@ -101,6 +123,38 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 				"conditional order trigger subticks greater than 0 for non-conditional order",
 			)
 		}
+
+		if msg.Order.ConditionalOrderTrailingPercentPpm != 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidConditionalOrderTrailingPercent,
+				"conditional order trailing percent ppm greater than 0 for non-conditional order",
+			)
+		}
+	}
+
+	if ocoOrderId := msg.Order.OneCancelsOtherOrderId; ocoOrderId != nil {
+		if orderId.IsShortTermOrder() || ocoOrderId.IsShortTermOrder() {
+			return errorsmod.Wrapf(
+				ErrInvalidOneCancelsOtherOrder,
+				"one-cancels-other orders must be stateful orders",
+			)
+		}
+
+		if *ocoOrderId == orderId {
+			return errorsmod.Wrapf(
+				ErrInvalidOneCancelsOtherOrder,
+				"order cannot be paired with itself",
+			)
+		}
+
+		if ocoOrderId.SubaccountId != orderId.SubaccountId || ocoOrderId.ClobPairId != orderId.ClobPairId {
+			return errorsmod.Wrapf(
+				ErrInvalidOneCancelsOtherOrder,
+				"paired order (%+v) must have the same subaccount and clob pair as order (%+v)",
+				*ocoOrderId,
+				orderId,
+			)
+		}
 	}
 
 	return nil
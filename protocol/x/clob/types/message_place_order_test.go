@@ -9,6 +9,7 @@ import (
 )
 
 func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
+	ocoOwner := sample.AccAddress()
 	tests := map[string]struct {
 		msg MsgPlaceOrder
 		err error
@@ -80,6 +81,22 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidOrderQuantums,
 		},
+		"invalid self-trade prevention": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+					Side:                Order_SIDE_BUY,
+					Quantums:            uint64(42),
+					SelfTradePrevention: Order_SelfTradePrevention(uint32(999)),
+				},
+			},
+			err: ErrInvalidSelfTradePrevention,
+		},
 		"zero GoodTilBlock": {
 			msg: MsgPlaceOrder{
 				Order: Order{
@@ -233,7 +250,7 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 				},
 			},
 		},
-		"reduce-only disabled": {
+		"valid reduce-only order": {
 			msg: MsgPlaceOrder{
 				Order: Order{
 					OrderId: OrderId{
@@ -250,7 +267,6 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 					ReduceOnly:   true,
 				},
 			},
-			err: ErrReduceOnlyDisabled,
 		},
 		"conditional: valid": {
 			msg: MsgPlaceOrder{
@@ -356,6 +372,267 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidConditionalOrderTriggerSubticks,
 		},
+		"conditional: valid trailing stop": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                      Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks:    uint64(10),
+					ConditionalOrderTrailingPercentPpm: uint32(100_000),
+				},
+			},
+		},
+		"conditional: zero trailing percent for trailing stop": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+				},
+			},
+			err: ErrInvalidConditionalOrderTrailingPercent,
+		},
+		"conditional: trailing percent of 100% for trailing stop": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                      Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks:    uint64(10),
+					ConditionalOrderTrailingPercentPpm: uint32(1_000_000),
+				},
+			},
+			err: ErrInvalidConditionalOrderTrailingPercent,
+		},
+		"conditional: trailing percent for non-trailing stop order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                      Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks:    uint64(10),
+					ConditionalOrderTrailingPercentPpm: uint32(100_000),
+				},
+			},
+			err: ErrInvalidConditionalOrderTrailingPercent,
+		},
+		"non-conditional: greater than zero ConditionalOrderTrailingPercentPpm": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionalOrderTrailingPercentPpm: uint32(100_000),
+				},
+			},
+			err: ErrInvalidConditionalOrderTrailingPercent,
+		},
+		"one-cancels-other: valid": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					OneCancelsOtherOrderId: &OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						ClientId:   uint32(1),
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+				},
+			},
+		},
+		"one-cancels-other: short-term paired order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					OneCancelsOtherOrderId: &OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						ClientId:   uint32(1),
+						OrderFlags: OrderIdFlags_ShortTerm,
+					},
+				},
+			},
+			err: ErrInvalidOneCancelsOtherOrder,
+		},
+		"one-cancels-other: paired with itself": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					OneCancelsOtherOrderId: &OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						ClientId:   uint32(0),
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+				},
+			},
+			err: ErrInvalidOneCancelsOtherOrder,
+		},
+		"one-cancels-other: different subaccount": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					OneCancelsOtherOrderId: &OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						ClientId:   uint32(1),
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+				},
+			},
+			err: ErrInvalidOneCancelsOtherOrder,
+		},
+		"one-cancels-other: different clob pair": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_BUY,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					OneCancelsOtherOrderId: &OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  ocoOwner,
+							Number: uint32(0),
+						},
+						ClientId:   uint32(1),
+						OrderFlags: OrderIdFlags_LongTerm,
+						ClobPairId: uint32(1),
+					},
+				},
+			},
+			err: ErrInvalidOneCancelsOtherOrder,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -25,7 +25,8 @@ func (msg *MsgProposedOperations) ValidateBasic() error {
 		switch operation := rawOperation.Operation.(type) {
 		case
 			*OperationRaw_Match,
-			*OperationRaw_ShortTermOrderPlacement:
+			*OperationRaw_ShortTermOrderPlacement,
+			*OperationRaw_ShortTermOrderBatchPlacement:
 			// no-op, stateless validation is done in ValidateAndTransformRawOperations
 		case *OperationRaw_OrderRemoval:
 			orderId := operation.OrderRemoval.GetOrderId()
@@ -44,13 +45,6 @@ func (msg *MsgProposedOperations) ValidateBasic() error {
 					"order removal reason must be specified: %v",
 					orderId,
 				)
-			case OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY:
-				// TODO: remove this case when reduce-only orders are enabled.
-				return errorsmod.Wrapf(
-					ErrInvalidMsgProposedOperations,
-					"order removals for invalid reduce-only orders are not allowed. Reduce-only orders"+
-						" are not currently supported.",
-				)
 			}
 
 		default:
@@ -80,6 +74,8 @@ func ValidateAndTransformRawOperations(
 	validator := operationsQueueValidator{
 		ordersPlacedInBlock: make(map[OrderId]Order, 0),
 	}
+	// Batches are decoded once, no matter how many of their placements are included in the block.
+	decodedBatches := make(map[string]*MsgBatchPlaceAndCancel)
 
 	// Go through the operations one by one to validate them, updating state as necessary.
 	for _, rawOperation := range rawOperations {
@@ -109,6 +105,22 @@ func ValidateAndTransformRawOperations(
 			); err != nil {
 				return nil, err
 			}
+		case *OperationRaw_ShortTermOrderBatchPlacement:
+			operation, err = decodeOperationRawShortTermOrderBatchPlacement(
+				ctx,
+				rawOperation.GetShortTermOrderBatchPlacement(),
+				decoder,
+				anteHandler,
+				decodedBatches,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if err = validator.validateShortTermOrderPlacementOperation(
+				operation.GetShortTermOrderPlacement(),
+			); err != nil {
+				return nil, err
+			}
 		case *OperationRaw_OrderRemoval:
 			orderRemoval := rawOperation.GetOrderRemoval()
 			if err := orderRemoval.OrderId.Validate(); err != nil {
@@ -121,6 +133,18 @@ func ValidateAndTransformRawOperations(
 					orderRemoval.RemovalReason,
 				)
 			}
+			if selfTradeOrderId := orderRemoval.SelfTradeOrderId; selfTradeOrderId != nil {
+				if orderRemoval.RemovalReason != OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE {
+					return nil, errorsmod.Wrapf(
+						ErrInvalidOrderRemoval,
+						"Self-trade order ID set for order removal reason: %+v",
+						orderRemoval.RemovalReason,
+					)
+				}
+				if err := selfTradeOrderId.Validate(); err != nil {
+					return nil, err
+				}
+			}
 			operation.Operation = &InternalOperation_OrderRemoval{
 				OrderRemoval: rawOperation.GetOrderRemoval(),
 			}
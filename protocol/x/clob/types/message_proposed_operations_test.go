@@ -81,7 +81,7 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectedError: errors.New("order removal reason must be specified"),
 		},
-		"reduce-only removal reason returns error": {
+		"valid reduce-only removal reason": {
 			msg: types.MsgProposedOperations{
 				OperationsQueue: []types.OperationRaw{
 					{
@@ -94,7 +94,6 @@ func TestValidateBasic(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("order removals for invalid reduce-only orders are not allowed"),
 		},
 	}
 
@@ -142,6 +141,39 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 			expectedError: nil,
 		},
 
+		"passes validation with order placed within a batch": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderBatchPlacementOperationRaw(
+					types.NewMsgBatchPlaceAndCancel(
+						constants.Carl_Num0,
+						[]types.MsgCancelOrder{
+							*types.NewMsgCancelOrderShortTerm(
+								types.OrderId{SubaccountId: constants.Carl_Num0, ClientId: 1, ClobPairId: 0},
+								10,
+							),
+						},
+						[]types.MsgPlaceOrder{
+							*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10),
+						},
+					),
+					0,
+				),
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(
+					constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10,
+				),
+				clobtestutils.NewMatchOperationRaw(
+					&constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10,
+					[]types.MakerFill{
+						{
+							FillAmount:   100_000_000, // 1 BTC
+							MakerOrderId: constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: nil,
+		},
+
 		// tests for invalid subaccount id
 		"Stateless order validation: Place Order has invalid SubaccountId": {
 			operations: []types.OperationRaw{
@@ -511,7 +543,61 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("expected MsgPlaceOrder, got *types.MsgCancelOrder"),
+			expectedError: errors.New("expected MsgPlaceOrder or MsgReplaceOrder, got *types.MsgCancelOrder"),
+		},
+		"Short term order batch placement index is out of range": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderBatchPlacementOperationRaw(
+					types.NewMsgBatchPlaceAndCancel(
+						constants.Carl_Num0,
+						nil,
+						[]types.MsgPlaceOrder{
+							*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10),
+						},
+					),
+					1,
+				),
+			},
+			expectedError: errors.New(
+				"batch placement index 1 out of range for MsgBatchPlaceAndCancel with 1 places",
+			),
+		},
+		"Order removal with a self-trade order ID for a removal reason other than self-trade": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_OrderRemoval{
+						OrderRemoval: &types.OrderRemoval{
+							OrderId:          constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15.OrderId,
+							RemovalReason:    types.OrderRemoval_REMOVAL_REASON_UNDERCOLLATERALIZED,
+							SelfTradeOrderId: &constants.Order_Alice_Num0_Id0_Clob0_Sell5_Price10_GTB20.OrderId,
+						},
+					},
+				},
+			},
+			expectedError: errors.New("Self-trade order ID set for order removal reason"),
+		},
+		"Self-trade order removal with invalid self-trade order ID": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15.OrderId,
+					&constants.InvalidSubaccountIdOwner_OrderId,
+				),
+			},
+			expectedError: errors.New("invalid SubaccountId Owner address"),
+		},
+		"Short term order batch placement tx bytes contains a placement instead of a batch": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: testtx.MustGetTxBytes(
+								constants.Msg_PlaceOrder,
+							),
+						},
+					},
+				},
+			},
+			expectedError: errors.New("expected MsgBatchPlaceAndCancel, got *types.MsgPlaceOrder"),
 		},
 	}
 	for name, tc := range tests {
@@ -532,6 +618,46 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 	}
 }
 
+func TestValidateAndTransformRawOperations_DecodesEachBatchOnce(t *testing.T) {
+	batch := types.NewMsgBatchPlaceAndCancel(
+		constants.Carl_Num0,
+		nil,
+		[]types.MsgPlaceOrder{
+			*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10),
+			*types.NewMsgPlaceOrder(constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000),
+		},
+	)
+	anteHandlerCalls := 0
+	anteHandler := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		anteHandlerCalls++
+		return ctx, nil
+	}
+
+	var ctx sdk.Context
+	operations, err := types.ValidateAndTransformRawOperations(
+		ctx,
+		[]types.OperationRaw{
+			clobtestutils.NewShortTermOrderBatchPlacementOperationRaw(batch, 0),
+			clobtestutils.NewShortTermOrderBatchPlacementOperationRaw(batch, 1),
+		},
+		constants.TestEncodingCfg.TxConfig.TxDecoder(),
+		anteHandler,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, anteHandlerCalls)
+	require.Len(t, operations, 2)
+	require.Equal(
+		t,
+		constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10.OrderId,
+		operations[0].GetShortTermOrderPlacement().Order.OrderId,
+	)
+	require.Equal(
+		t,
+		constants.Order_Carl_Num0_Id2_Clob0_Buy05BTC_Price50000.OrderId,
+		operations[1].GetShortTermOrderPlacement().Order.OrderId,
+	)
+}
+
 func TestGetSigners(t *testing.T) {
 	msg := types.MsgProposedOperations{}
 	require.Empty(t, msg.GetSigners())
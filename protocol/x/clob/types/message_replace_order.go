@@ -0,0 +1,68 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+)
+
+const TypeMsgReplaceOrder = "replace_order"
+
+var _ sdk.Msg = &MsgReplaceOrder{}
+
+// NewMsgReplaceOrder constructs a `MsgReplaceOrder` from the new version of an order.
+func NewMsgReplaceOrder(order Order) *MsgReplaceOrder {
+	return &MsgReplaceOrder{
+		Order: order,
+	}
+}
+
+func (msg *MsgReplaceOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Order.OrderId.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ValidateBasic performs stateless validation on the replacement order. The replacement order must
+// be a valid order placement, and must not be a conditional order.
+func (msg *MsgReplaceOrder) ValidateBasic() (err error) {
+	defer func() {
+		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{ModuleName, metrics.ReplaceOrder, metrics.ValidateBasic, metrics.Error, metrics.Count},
+				1,
+				msg.Order.GetOrderLabels(),
+			)
+		}
+	}()
+
+	if err := NewMsgPlaceOrder(msg.Order).ValidateBasic(); err != nil {
+		return err
+	}
+
+	if msg.Order.IsConditionalOrder() {
+		return errorsmod.Wrapf(
+			ErrInvalidReplacementOrder,
+			"conditional orders cannot be replaced",
+		)
+	}
+
+	return nil
+}
+
+// GetMsgPlaceOrder returns the `MsgPlaceOrder` that places the order in `msg`, which must be either
+// a `MsgPlaceOrder` or a `MsgReplaceOrder`. An order replacement places the new version of the order.
+// Returns false if `msg` is neither.
+func GetMsgPlaceOrder(msg sdk.Msg) (placeOrder *MsgPlaceOrder, ok bool) {
+	switch msg := msg.(type) {
+	case *MsgPlaceOrder:
+		return msg, true
+	case *MsgReplaceOrder:
+		return NewMsgPlaceOrder(msg.Order), true
+	default:
+		return nil, false
+	}
+}
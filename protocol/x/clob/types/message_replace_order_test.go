@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReplaceOrder_ValidateBasic(t *testing.T) {
+	subaccountId := satypes.SubaccountId{
+		Owner:  sample.AccAddress(),
+		Number: uint32(0),
+	}
+	tests := map[string]struct {
+		msg MsgReplaceOrder
+		err error
+	}{
+		"valid short-term order": {
+			msg: *NewMsgReplaceOrder(Order{
+				OrderId: OrderId{
+					SubaccountId: subaccountId,
+					OrderFlags:   OrderIdFlags_ShortTerm,
+				},
+				Side:         Order_SIDE_BUY,
+				Quantums:     uint64(100),
+				Subticks:     uint64(10),
+				GoodTilOneof: &Order_GoodTilBlock{GoodTilBlock: uint32(10)},
+			}),
+		},
+		"valid long-term order": {
+			msg: *NewMsgReplaceOrder(Order{
+				OrderId: OrderId{
+					SubaccountId: subaccountId,
+					OrderFlags:   OrderIdFlags_LongTerm,
+				},
+				Side:         Order_SIDE_SELL,
+				Quantums:     uint64(100),
+				Subticks:     uint64(10),
+				GoodTilOneof: &Order_GoodTilBlockTime{GoodTilBlockTime: uint32(100)},
+			}),
+		},
+		"invalid order placement": {
+			msg: *NewMsgReplaceOrder(Order{
+				OrderId: OrderId{
+					SubaccountId: subaccountId,
+					OrderFlags:   OrderIdFlags_ShortTerm,
+				},
+				Side:         Order_SIDE_BUY,
+				Quantums:     uint64(0),
+				Subticks:     uint64(10),
+				GoodTilOneof: &Order_GoodTilBlock{GoodTilBlock: uint32(10)},
+			}),
+			err: ErrInvalidOrderQuantums,
+		},
+		"conditional order": {
+			msg: *NewMsgReplaceOrder(Order{
+				OrderId: OrderId{
+					SubaccountId: subaccountId,
+					OrderFlags:   OrderIdFlags_Conditional,
+				},
+				Side:                            Order_SIDE_BUY,
+				Quantums:                        uint64(100),
+				Subticks:                        uint64(10),
+				GoodTilOneof:                    &Order_GoodTilBlockTime{GoodTilBlockTime: uint32(100)},
+				ConditionType:                   Order_CONDITION_TYPE_TAKE_PROFIT,
+				ConditionalOrderTriggerSubticks: uint64(10),
+			}),
+			err: ErrInvalidReplacementOrder,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetMsgPlaceOrder(t *testing.T) {
+	order := Order{
+		OrderId: OrderId{
+			SubaccountId: satypes.SubaccountId{Owner: sample.AccAddress()},
+			ClientId:     1,
+		},
+		Side:         Order_SIDE_BUY,
+		Quantums:     uint64(100),
+		Subticks:     uint64(10),
+		GoodTilOneof: &Order_GoodTilBlock{GoodTilBlock: uint32(10)},
+	}
+
+	msg, ok := GetMsgPlaceOrder(NewMsgPlaceOrder(order))
+	require.True(t, ok)
+	require.Equal(t, NewMsgPlaceOrder(order), msg)
+
+	msg, ok = GetMsgPlaceOrder(NewMsgReplaceOrder(order))
+	require.True(t, ok)
+	require.Equal(t, NewMsgPlaceOrder(order), msg)
+
+	msg, ok = GetMsgPlaceOrder(NewMsgCancelOrderShortTerm(order.OrderId, 10))
+	require.False(t, ok)
+	require.Nil(t, msg)
+}
@@ -29,7 +29,7 @@ func TestMsgUpdateClobPair_ValidateBasic(t *testing.T) {
 		expectedErr string
 	}{
 		{
-			desc:      "Invalid Metadata (SpotClobMetadata)",
+			desc:      "Missing SpotClobMetadata",
 			authority: validAuthority,
 			clobPair: types.ClobPair{
 				Metadata:         &types.ClobPair_SpotClobMetadata{},
@@ -37,7 +37,7 @@ func TestMsgUpdateClobPair_ValidateBasic(t *testing.T) {
 				SubticksPerTick:  1,
 				Status:           types.ClobPair_STATUS_ACTIVE,
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "is missing spot metadata",
 		},
 		{
 			desc:      "UNSPECIFIED Status",
@@ -46,7 +46,7 @@ func TestMsgUpdateClobPair_ValidateBasic(t *testing.T) {
 				Metadata:         &types.ClobPair_PerpetualClobMetadata{},
 				StepBaseQuantums: 1,
 				SubticksPerTick:  1,
-				Status:           types.ClobPair_STATUS_PAUSED,
+				Status:           types.ClobPair_STATUS_UNSPECIFIED,
 			},
 			expectedErr: "has unsupported status",
 		},
@@ -13,6 +13,7 @@ const (
 	PlaceMessageType OffchainUpdateMessageType = iota
 	RemoveMessageType
 	UpdateMessageType
+	ReplaceMessageType
 )
 
 // Represents a single message added to the OffchainUpdates.
@@ -41,6 +42,11 @@ func (om *OffchainUpdates) AddPlaceMessage(orderId OrderId, message msgsender.Me
 	om.Messages = append(om.Messages, OffchainUpdateMessage{PlaceMessageType, orderId, message})
 }
 
+// AddReplaceMessage adds an off-chain message for the replacement of an order to the OffchainUpdates.
+func (om *OffchainUpdates) AddReplaceMessage(orderId OrderId, message msgsender.Message) {
+	om.Messages = append(om.Messages, OffchainUpdateMessage{ReplaceMessageType, orderId, message})
+}
+
 // AddUpdateMessage adds an off-chain message for the update of an order to the OffchainUpdates.
 func (om *OffchainUpdates) AddUpdateMessage(orderId OrderId, message msgsender.Message) {
 	om.Messages = append(om.Messages, OffchainUpdateMessage{UpdateMessageType, orderId, message})
@@ -53,7 +59,7 @@ func (om *OffchainUpdates) AddRemoveMessage(orderId OrderId, message msgsender.M
 
 // CondenseMessageForReplay removes all but the last off-chain message for each OrderId from the
 // slice of all off-chain messages tracked by the OffchainUpdates struct with the exception of
-// OrderPlace messages.
+// OrderPlace and OrderReplace messages.
 // Intended for use after off-chain messages are generated when replaying multiple operations.
 func (om *OffchainUpdates) CondenseMessagesForReplay() {
 	seenOrderIds := mapset.NewSet[OrderId]()
@@ -75,7 +81,9 @@ func (om *OffchainUpdates) CondenseMessagesForReplay() {
 		//    because it'll just be removed anyway.
 		// 3. Since Update messages only have an "amount filled" parameter, we only need the latest
 		//    message.
-		if msg.Type == PlaceMessageType {
+		// Replace messages are treated the same as Place messages, as they place the new version of the
+		// order.
+		if msg.Type == PlaceMessageType || msg.Type == ReplaceMessageType {
 			continue
 		}
 
@@ -31,9 +31,11 @@ func decodeOperationRawShortTermOrderPlacementBytes(
 		return nil, fmt.Errorf("expected 1 msg, got %d", len(msgs))
 	}
 
-	msg, ok := msgs[0].(*MsgPlaceOrder)
+	// Short-Term order replacements are proposed as Short-Term order placements of the new version
+	// of the order.
+	msg, ok := GetMsgPlaceOrder(msgs[0])
 	if !ok {
-		return nil, fmt.Errorf("expected MsgPlaceOrder, got %T", msgs[0])
+		return nil, fmt.Errorf("expected MsgPlaceOrder or MsgReplaceOrder, got %T", msgs[0])
 	}
 
 	return &InternalOperation{
@@ -43,6 +45,76 @@ func decodeOperationRawShortTermOrderPlacementBytes(
 	}, nil
 }
 
+// decodeOperationRawShortTermOrderBatchPlacement decodes the transaction bytes of a Short-Term order
+// placement that was included in a `MsgBatchPlaceAndCancel`, runs the ante handler on the transaction,
+// and returns an internal operation for the order placement at the provided index of the batch.
+// Decoded batches are cached in `decodedBatches` by their transaction bytes so that the transaction
+// of a batch is only decoded and run through the ante handler once, regardless of how many of its
+// placements are proposed.
+func decodeOperationRawShortTermOrderBatchPlacement(
+	ctx sdk.Context,
+	batchPlacement *ShortTermOrderBatchPlacement,
+	decoder sdk.TxDecoder,
+	anteHandler sdk.AnteHandler,
+	decodedBatches map[string]*MsgBatchPlaceAndCancel,
+) (*InternalOperation, error) {
+	txBytes := string(batchPlacement.GetTxBytes())
+	msg, exists := decodedBatches[txBytes]
+	if !exists {
+		var err error
+		msg, err = decodeMsgBatchPlaceAndCancelBytes(ctx, batchPlacement.GetTxBytes(), decoder, anteHandler)
+		if err != nil {
+			return nil, err
+		}
+		decodedBatches[txBytes] = msg
+	}
+
+	index := batchPlacement.GetIndex()
+	if index >= uint32(len(msg.Places)) {
+		return nil, fmt.Errorf(
+			"batch placement index %d out of range for MsgBatchPlaceAndCancel with %d places",
+			index,
+			len(msg.Places),
+		)
+	}
+
+	return &InternalOperation{
+		Operation: &InternalOperation_ShortTermOrderPlacement{
+			ShortTermOrderPlacement: &msg.Places[index],
+		},
+	}, nil
+}
+
+// decodeMsgBatchPlaceAndCancelBytes decodes the transaction bytes of a `MsgBatchPlaceAndCancel`
+// and runs the ante handler on the transaction.
+func decodeMsgBatchPlaceAndCancelBytes(
+	ctx sdk.Context,
+	bytes []byte,
+	decoder sdk.TxDecoder,
+	anteHandler sdk.AnteHandler,
+) (*MsgBatchPlaceAndCancel, error) {
+	tx, err := decoder(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := anteHandler(ctx, tx, false); err != nil {
+		return nil, err
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("expected 1 msg, got %d", len(msgs))
+	}
+
+	msg, ok := msgs[0].(*MsgBatchPlaceAndCancel)
+	if !ok {
+		return nil, fmt.Errorf("expected MsgBatchPlaceAndCancel, got %T", msgs[0])
+	}
+
+	return msg, nil
+}
+
 // GetInternalOperationTextString returns the text string representation of this operation.
 // TODO(DEC-1772): Add method for encoding operation protos as JSON to make debugging easier.
 func (o *InternalOperation) GetInternalOperationTextString() string {
@@ -19,6 +19,10 @@ type OperationsToPropose struct {
 	// This is used in `GetOperationsQueueRaw` for returning a slice of `OperationRaw` for
 	// the purposes of constructing `MsgProposedOperations`.
 	ShortTermOrderHashToTxBytes map[OrderHash][]byte
+	// A map of Short-Term order hashes to the index of the order placement within the
+	// `MsgBatchPlaceAndCancel` contained in the raw transaction bytes. Only Short-Term orders
+	// placed as part of a batch have an entry in this map.
+	ShortTermOrderHashToBatchIndex map[OrderHash]uint32
 	// A map from order ID to the orders themselves for each order that
 	// was matched. Note: there may be multiple distinct orders with the same
 	// ID that are matched. In that case, only the "greatest" of any such orders
@@ -34,6 +38,7 @@ func NewOperationsToPropose() *OperationsToPropose {
 		OperationsQueue:                make([]InternalOperation, 0),
 		OrderHashesInOperationsQueue:   make(map[OrderHash]bool),
 		ShortTermOrderHashToTxBytes:    make(map[OrderHash][]byte),
+		ShortTermOrderHashToBatchIndex: make(map[OrderHash]uint32),
 		MatchedOrderIdToOrder:          make(map[OrderId]Order),
 		OrderRemovalsInOperationsQueue: make(map[OrderId]bool),
 	}
@@ -81,6 +86,20 @@ func (o *OperationsToPropose) MustAddShortTermOrderTxBytes(
 	o.ShortTermOrderHashToTxBytes[orderHash] = txBytes
 }
 
+// MustAddShortTermOrderBatchTxBytes adds the provided Short-Term order hash and TX bytes into
+// `ShortTermOrderHashToTxBytes`, and records the index of the order placement within the
+// `MsgBatchPlaceAndCancel` contained in the TX bytes in `ShortTermOrderHashToBatchIndex`.
+// This function will panic if the provided order is not a Short-Term order or this order already
+// exists in `ShortTermOrderHashToTxBytes`.
+func (o *OperationsToPropose) MustAddShortTermOrderBatchTxBytes(
+	order Order,
+	txBytes []byte,
+	batchIndex uint32,
+) {
+	o.MustAddShortTermOrderTxBytes(order, txBytes)
+	o.ShortTermOrderHashToBatchIndex[order.GetOrderHash()] = batchIndex
+}
+
 // MustAddShortTermOrderPlacementToOperationsQueue adds a Short-Term order placement operation to the
 // operations queue.
 // This function will panic if the order is not a Short-Term order, the order already exists in
@@ -147,6 +166,7 @@ func (o *OperationsToPropose) RemoveShortTermOrderTxBytes(
 	}
 
 	delete(o.ShortTermOrderHashToTxBytes, orderHash)
+	delete(o.ShortTermOrderHashToBatchIndex, orderHash)
 }
 
 // MustAddStatefulOrderPlacementToOperationsQueue adds a stateful order placement operation to the
@@ -332,6 +352,41 @@ func (o *OperationsToPropose) MustAddOrderRemovalToOperationsQueue(
 	o.OrderRemovalsInOperationsQueue[orderId] = true
 }
 
+// MustAddSelfTradeOrderRemovalToOperationsQueue adds an order removal operation with the
+// `REMOVAL_REASON_INVALID_SELF_TRADE` removal reason to the operations queue, referencing the order
+// on the other side of the self-trade. `selfTradeOrder` is nil if the other side of the self-trade
+// is a liquidation order.
+// This function will panic if the order removal already exists in the operations queue, or if the
+// other order is a Short-Term order whose placement is not in the operations queue.
+func (o *OperationsToPropose) MustAddSelfTradeOrderRemovalToOperationsQueue(
+	orderId OrderId,
+	selfTradeOrder *Order,
+) {
+	if _, exists := o.OrderRemovalsInOperationsQueue[orderId]; exists {
+		panic("MustAddSelfTradeOrderRemovalToOperationsQueue: order removal already exists in operations queue")
+	}
+
+	var selfTradeOrderId *OrderId
+	if selfTradeOrder != nil {
+		if selfTradeOrder.IsShortTermOrder() && !o.IsOrderPlacementInOperationsQueue(*selfTradeOrder) {
+			panic(
+				fmt.Sprintf(
+					"MustAddSelfTradeOrderRemovalToOperationsQueue: Order (%s) is not in the operations queue.",
+					selfTradeOrder.GetOrderTextString(),
+				),
+			)
+		}
+		otherOrderId := selfTradeOrder.OrderId
+		selfTradeOrderId = &otherOrderId
+	}
+
+	o.OperationsQueue = append(
+		o.OperationsQueue,
+		NewSelfTradeOrderRemovalInternalOperation(orderId, selfTradeOrderId),
+	)
+	o.OrderRemovalsInOperationsQueue[orderId] = true
+}
+
 // IsOrderRemovalInOperationsQueue returns true if the provided order ID is included in
 // `OrderRemovalsInOperationsQueue`, false if not.
 func (o *OperationsToPropose) IsOrderRemovalInOperationsQueue(
@@ -436,11 +491,22 @@ func (o *OperationsToPropose) GetOperationsToPropose() []OperationRaw {
 					),
 				)
 			}
-			operationRaws = append(operationRaws, OperationRaw{
-				Operation: &OperationRaw_ShortTermOrderPlacement{
-					ShortTermOrderPlacement: operationBytes,
-				},
-			})
+			if batchIndex, isBatch := o.ShortTermOrderHashToBatchIndex[order.GetOrderHash()]; isBatch {
+				operationRaws = append(operationRaws, OperationRaw{
+					Operation: &OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &ShortTermOrderBatchPlacement{
+							TxBytes: operationBytes,
+							Index:   batchIndex,
+						},
+					},
+				})
+			} else {
+				operationRaws = append(operationRaws, OperationRaw{
+					Operation: &OperationRaw_ShortTermOrderPlacement{
+						ShortTermOrderPlacement: operationBytes,
+					},
+				})
+			}
 		case *InternalOperation_PreexistingStatefulOrder:
 		case *InternalOperation_OrderRemoval:
 			operationRaws = append(operationRaws, OperationRaw{
@@ -288,6 +288,47 @@ func TestRemoveShortTermOrderTxBytes(t *testing.T) {
 	require.Empty(t, otp.ShortTermOrderHashToTxBytes)
 }
 
+func TestMustAddShortTermOrderBatchTxBytes(t *testing.T) {
+	shortTermOrder1 := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	shortTermOrder2 := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+	shortTermOrder3 := constants.Order_Carl_Num0_Id0_Clob0_Buy05BTC_Price50000_GTB10_FOK
+
+	otp := types.NewOperationsToPropose()
+
+	// Add `shortTermOrder1` and `shortTermOrder2` as orders placed within a batch, and `shortTermOrder3`
+	// as an order placed on its own.
+	batchTxBytes := []byte{1, 2, 3}
+	otp.MustAddShortTermOrderBatchTxBytes(shortTermOrder1, batchTxBytes, 0)
+	otp.MustAddShortTermOrderBatchTxBytes(shortTermOrder2, batchTxBytes, 1)
+	otp.MustAddShortTermOrderTxBytes(shortTermOrder3, []byte{4, 5, 6})
+
+	// Verify all orders are present in `ShortTermOrderHashToTxBytes`, and only the batched orders
+	// are present in `ShortTermOrderHashToBatchIndex`.
+	require.Equal(t, batchTxBytes, otp.ShortTermOrderHashToTxBytes[shortTermOrder1.GetOrderHash()])
+	require.Equal(t, batchTxBytes, otp.ShortTermOrderHashToTxBytes[shortTermOrder2.GetOrderHash()])
+	require.Equal(t, []byte{4, 5, 6}, otp.ShortTermOrderHashToTxBytes[shortTermOrder3.GetOrderHash()])
+	require.Equal(
+		t,
+		map[types.OrderHash]uint32{
+			shortTermOrder1.GetOrderHash(): 0,
+			shortTermOrder2.GetOrderHash(): 1,
+		},
+		otp.ShortTermOrderHashToBatchIndex,
+	)
+
+	// Adding a batched order that already exists panics.
+	require.Panics(t, func() {
+		otp.MustAddShortTermOrderBatchTxBytes(shortTermOrder1, batchTxBytes, 2)
+	})
+
+	// Removing the orders removes them from `ShortTermOrderHashToBatchIndex`.
+	for _, order := range []types.Order{shortTermOrder1, shortTermOrder2, shortTermOrder3} {
+		otp.RemoveShortTermOrderTxBytes(order)
+	}
+	require.Empty(t, otp.ShortTermOrderHashToTxBytes)
+	require.Empty(t, otp.ShortTermOrderHashToBatchIndex)
+}
+
 func TestRemoveShortTermOrderTxBytes_PanicsOnStatefulOrder(t *testing.T) {
 	order := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20
 	otp := types.NewOperationsToPropose()
@@ -998,6 +1039,24 @@ func TestGetOperationsToPropose_Success(t *testing.T) {
 				},
 			},
 		},
+		"Short term orders placed within a batch are included in operations to propose": {
+			setup: func(otp *types.OperationsToPropose) {
+				shortTermOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16
+				// Dummy bytes for testing.
+				otp.MustAddShortTermOrderBatchTxBytes(shortTermOrder, []byte{4, 0, 8}, 3)
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(shortTermOrder)
+			},
+			expectedOperations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: []byte{4, 0, 8},
+							Index:   3,
+						},
+					},
+				},
+			},
+		},
 		"Stateful orders do not get included in operations to propose": {
 			setup: func(otp *types.OperationsToPropose) {
 				statefulOrder := constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25
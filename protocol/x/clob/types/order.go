@@ -7,8 +7,10 @@ import (
 	"math/big"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	gometrics "github.com/armon/go-metrics"
 	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -114,6 +116,61 @@ func (x *Order) MustCmpReplacementOrder(y *Order) int {
 	return bytes.Compare(xHash[:], yHash[:])
 }
 
+// ValidateIsReplacementOf returns an error if this order is not a valid replacement of `existing`.
+// A replacement order must have the same order ID as the order it replaces, and may only change the
+// `Subticks` and `Quantums` of the order. Short-Term replacement orders must also have a greater
+// `GoodTilBlock` than the order they replace, while stateful replacement orders must have the same
+// `GoodTilBlockTime` and must differ from the order they replace.
+func (o *Order) ValidateIsReplacementOf(existing *Order) error {
+	if o.OrderId != existing.OrderId {
+		return errorsmod.Wrapf(
+			ErrInvalidReplacementOrder,
+			"order ID (%+v) does not equal order ID (%+v) of the order to replace",
+			o.OrderId,
+			existing.OrderId,
+		)
+	}
+
+	if o.IsShortTermOrder() && o.GetGoodTilBlock() <= existing.GetGoodTilBlock() {
+		return errorsmod.Wrapf(
+			ErrInvalidReplacementOrder,
+			"GoodTilBlock (%d) must be greater than GoodTilBlock (%d) of the order to replace",
+			o.GetGoodTilBlock(),
+			existing.GetGoodTilBlock(),
+		)
+	}
+
+	// Verify that only the fields that may be replaced differ from the existing order.
+	normalized := *o
+	normalized.Subticks = existing.Subticks
+	normalized.Quantums = existing.Quantums
+	if o.IsShortTermOrder() {
+		normalized.GoodTilOneof = existing.GoodTilOneof
+	}
+	if normalized.GetOrderHash() != existing.GetOrderHash() {
+		return errorsmod.Wrapf(
+			ErrInvalidReplacementOrder,
+			"replacement order may only change the subticks and quantums of the order to replace",
+		)
+	}
+
+	if o.Subticks == existing.Subticks && o.Quantums == existing.Quantums && o.IsStatefulOrder() {
+		return errorsmod.Wrapf(
+			ErrInvalidReplacementOrder,
+			"replacement order is identical to the order to replace",
+		)
+	}
+
+	return nil
+}
+
+// CanRetainQueuePriority returns true if this replacement order keeps the queue priority of the order
+// it replaces, which is the case if the replacement has the same price and does not increase the size
+// of the order.
+func (o *Order) CanRetainQueuePriority(existing *Order) bool {
+	return o.Subticks == existing.Subticks && o.Quantums <= existing.Quantums
+}
+
 // GetSubaccountId returns the subaccount ID that placed this order.
 // This function is necessary for the `Order` type to implement the `MatchableOrder` interface.
 func (o *Order) GetSubaccountId() satypes.SubaccountId {
@@ -143,6 +200,18 @@ func (o *Order) IsReduceOnly() bool {
 	return o.ReduceOnly
 }
 
+// IsSelfTrade returns whether matching this order as a taker against a maker order from
+// `makerSubaccountId` is a self-trade. Maker orders from the same subaccount are always self-trades,
+// and maker orders from other subaccounts of the same owner are self-trades if owner-level self-trade
+// prevention is enabled on this order.
+func (o *Order) IsSelfTrade(makerSubaccountId satypes.SubaccountId) bool {
+	takerSubaccountId := o.GetSubaccountId()
+	if makerSubaccountId == takerSubaccountId {
+		return true
+	}
+	return o.SelfTradePreventionOwnerLevel && makerSubaccountId.Owner == takerSubaccountId.Owner
+}
+
 // IsTakeProfitOrder returns whether this is order is a conditional take profit order.
 func (o *Order) IsTakeProfitOrder() bool {
 	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_TAKE_PROFIT
@@ -153,6 +222,17 @@ func (o *Order) IsStopLossOrder() bool {
 	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS
 }
 
+// IsTrailingStopOrder returns whether this is order is a conditional trailing stop order.
+func (o *Order) IsTrailingStopOrder() bool {
+	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP
+}
+
+// IsOneCancelsOtherOrder returns whether this order is paired with another stateful order
+// as a one-cancels-other order.
+func (o *Order) IsOneCancelsOtherOrder() bool {
+	return o.OneCancelsOtherOrderId != nil
+}
+
 // RequiresImmediateExecution returns whether this order has to be executed immediately.
 func (o *Order) RequiresImmediateExecution() bool {
 	return o.GetTimeInForce() == Order_TIME_IN_FORCE_IOC || o.GetTimeInForce() == Order_TIME_IN_FORCE_FILL_OR_KILL
@@ -181,9 +261,10 @@ func (o *Order) CanTrigger(subticks Subticks) bool {
 	orderTriggerSubticks := Subticks(o.ConditionalOrderTriggerSubticks)
 
 	// Take profit buys and stop loss sells trigger when the oracle price goes lower
-	// than or equal to the trigger price.
+	// than or equal to the trigger price. Trailing stops trigger like stop losses.
 	if o.ConditionType == Order_CONDITION_TYPE_TAKE_PROFIT && o.IsBuy() ||
-		o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS && !o.IsBuy() {
+		o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS && !o.IsBuy() ||
+		o.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP && !o.IsBuy() {
 		return orderTriggerSubticks >= subticks
 	}
 	// Take profit sells and stop loss buys trigger when the oracle price goes higher
@@ -191,6 +272,47 @@ func (o *Order) CanTrigger(subticks Subticks) bool {
 	return orderTriggerSubticks <= subticks
 }
 
+// GetTrailingStopTriggerSubticks returns the trigger subticks of a trailing stop order after
+// following the oracle price, and whether they differ from the current trigger subticks.
+// The trigger price of a sell is raised to `oraclePrice * (1 - trailingPercent)` and the trigger
+// price of a buy is lowered to `oraclePrice * (1 + trailingPercent)`, both rounded away from the
+// oracle price to the nearest tick so the trigger price is never closer to the oracle price than
+// the trailing distance. The trigger price of a sell is never lowered and the trigger price of a
+// buy is never raised. Function will panic if order is not a trailing stop order.
+func (o *Order) GetTrailingStopTriggerSubticks(
+	oraclePriceSubticksRat *big.Rat,
+	subticksPerTick SubticksPerTick,
+) (triggerSubticks Subticks, updated bool) {
+	if !o.IsTrailingStopOrder() {
+		panic(fmt.Sprintf("GetTrailingStopTriggerSubticks: order (%+v) is not a trailing stop order", o))
+	}
+
+	currentTriggerSubticks := Subticks(o.ConditionalOrderTriggerSubticks)
+	var trailingPpm uint32
+	if o.IsBuy() {
+		trailingPpm = lib.OneMillion + o.ConditionalOrderTrailingPercentPpm
+	} else {
+		trailingPpm = lib.OneMillion - o.ConditionalOrderTrailingPercentPpm
+	}
+
+	// Round away from the oracle price, down for sells and up for buys.
+	candidateSubticksBig := lib.BigIntRoundToMultiple(
+		lib.BigRatRound(lib.BigRatMulPpm(oraclePriceSubticksRat, trailingPpm), o.IsBuy()),
+		big.NewInt(int64(subticksPerTick)),
+		o.IsBuy(),
+	)
+	if !candidateSubticksBig.IsUint64() {
+		return currentTriggerSubticks, false
+	}
+	candidateSubticks := Subticks(candidateSubticksBig.Uint64())
+
+	if o.IsBuy() && candidateSubticks < currentTriggerSubticks ||
+		!o.IsBuy() && candidateSubticks > currentTriggerSubticks {
+		return candidateSubticks, true
+	}
+	return currentTriggerSubticks, false
+}
+
 // MustGetUnixGoodTilBlockTime returns an instance of `Time` that represents the order's
 // `GoodTilBlockTime`. This function panics when the order is a short-term order or
 // when its `GoodTilBlockTime` is zero.
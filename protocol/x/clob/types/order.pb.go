@@ -120,18 +120,27 @@ const (
 	// order will trigger when the oracle price moves at or below the trigger
 	// price for buys and at or above the trigger price for sells.
 	Order_CONDITION_TYPE_TAKE_PROFIT Order_ConditionType = 2
+	// CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+	// trailing stop order triggers like a stop order, but its trigger price
+	// follows the oracle price at a distance of
+	// `conditional_order_trailing_percent_ppm` whenever the oracle price moves
+	// up for sells or down for buys. The trigger price never moves away from
+	// the oracle price.
+	Order_CONDITION_TYPE_TRAILING_STOP Order_ConditionType = 3
 )
 
 var Order_ConditionType_name = map[int32]string{
 	0: "CONDITION_TYPE_UNSPECIFIED",
 	1: "CONDITION_TYPE_STOP_LOSS",
 	2: "CONDITION_TYPE_TAKE_PROFIT",
+	3: "CONDITION_TYPE_TRAILING_STOP",
 }
 
 var Order_ConditionType_value = map[string]int32{
-	"CONDITION_TYPE_UNSPECIFIED": 0,
-	"CONDITION_TYPE_STOP_LOSS":   1,
-	"CONDITION_TYPE_TAKE_PROFIT": 2,
+	"CONDITION_TYPE_UNSPECIFIED":   0,
+	"CONDITION_TYPE_STOP_LOSS":     1,
+	"CONDITION_TYPE_TAKE_PROFIT":   2,
+	"CONDITION_TYPE_TRAILING_STOP": 3,
 }
 
 func (x Order_ConditionType) String() string {
@@ -142,6 +151,58 @@ func (Order_ConditionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_673c6f4faa93736b, []int{7, 2}
 }
 
+// SelfTradePrevention indicates how a match between this order as a taker
+// and a maker order from the same subaccount is resolved. Self-trades are
+// never matched.
+type Order_SelfTradePrevention int32
+
+const (
+	// SELF_TRADE_PREVENTION_UNSPECIFIED represents the default behavior where
+	// the resting maker order is canceled and matching continues.
+	Order_SELF_TRADE_PREVENTION_UNSPECIFIED Order_SelfTradePrevention = 0
+	// SELF_TRADE_PREVENTION_CANCEL_MAKER cancels the resting maker order and
+	// continues matching the taker order.
+	Order_SELF_TRADE_PREVENTION_CANCEL_MAKER Order_SelfTradePrevention = 1
+	// SELF_TRADE_PREVENTION_CANCEL_TAKER cancels the remaining size of the
+	// taker order and leaves the maker order on the book.
+	Order_SELF_TRADE_PREVENTION_CANCEL_TAKER Order_SelfTradePrevention = 2
+	// SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both the resting maker order
+	// and the remaining size of the taker order.
+	Order_SELF_TRADE_PREVENTION_CANCEL_BOTH Order_SelfTradePrevention = 3
+	// SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL cancels the resting
+	// maker order. If the maker order is smaller than the remaining size of the
+	// taker order, the taker order is decremented by the remaining size of the
+	// maker order and matching continues, but any size left after matching is
+	// canceled instead of being placed on the book. Otherwise the taker order is
+	// canceled as well. Decremented orders never rest on the book, since the
+	// decremented size is not recorded in state.
+	Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL Order_SelfTradePrevention = 4
+)
+
+var Order_SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_CANCEL_MAKER",
+	2: "SELF_TRADE_PREVENTION_CANCEL_TAKER",
+	3: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+	4: "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL",
+}
+
+var Order_SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_UNSPECIFIED":          0,
+	"SELF_TRADE_PREVENTION_CANCEL_MAKER":         1,
+	"SELF_TRADE_PREVENTION_CANCEL_TAKER":         2,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":          3,
+	"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL": 4,
+}
+
+func (x Order_SelfTradePrevention) String() string {
+	return proto.EnumName(Order_SelfTradePrevention_name, int32(x))
+}
+
+func (Order_SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{7, 3}
+}
+
 // OrderId refers to a single order belonging to a Subaccount.
 type OrderId struct {
 	// The subaccount ID that opened this order.
@@ -607,6 +668,23 @@ type Order struct {
 	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
 	// orderId.ClobPairId`).
 	ConditionalOrderTriggerSubticks uint64 `protobuf:"varint,11,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+	// The self-trade prevention mode applied when this order matches as a taker
+	// against a maker order from the same subaccount.
+	SelfTradePrevention Order_SelfTradePrevention `protobuf:"varint,12,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=dydxprotocol.clob.Order_SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// If true, self-trade prevention is applied to maker orders from any
+	// subaccount owned by the same address as this order's subaccount, instead
+	// of only maker orders from the same subaccount.
+	SelfTradePreventionOwnerLevel bool `protobuf:"varint,13,opt,name=self_trade_prevention_owner_level,json=selfTradePreventionOwnerLevel,proto3" json:"self_trade_prevention_owner_level,omitempty"`
+	// conditional_order_trailing_percent_ppm is the distance, in parts per
+	// million of the oracle price, that the trigger price of a trailing stop
+	// order is kept from the oracle price. Must be nonzero and less than one
+	// million if the condition_type is CONDITION_TYPE_TRAILING_STOP, and must be
+	// 0 otherwise.
+	ConditionalOrderTrailingPercentPpm uint32 `protobuf:"varint,14,opt,name=conditional_order_trailing_percent_ppm,json=conditionalOrderTrailingPercentPpm,proto3" json:"conditional_order_trailing_percent_ppm,omitempty"`
+	// one_cancels_other_order_id is the id of an existing stateful order of the
+	// same subaccount and clob pair that this order is paired with. When either
+	// order of the pair is triggered or filled, the other order is canceled.
+	OneCancelsOtherOrderId *OrderId `protobuf:"bytes,15,opt,name=one_cancels_other_order_id,json=oneCancelsOtherOrderId,proto3" json:"one_cancels_other_order_id,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -742,6 +820,34 @@ func (m *Order) GetConditionalOrderTriggerSubticks() uint64 {
 	return 0
 }
 
+func (m *Order) GetSelfTradePrevention() Order_SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return Order_SELF_TRADE_PREVENTION_UNSPECIFIED
+}
+
+func (m *Order) GetSelfTradePreventionOwnerLevel() bool {
+	if m != nil {
+		return m.SelfTradePreventionOwnerLevel
+	}
+	return false
+}
+
+func (m *Order) GetConditionalOrderTrailingPercentPpm() uint32 {
+	if m != nil {
+		return m.ConditionalOrderTrailingPercentPpm
+	}
+	return 0
+}
+
+func (m *Order) GetOneCancelsOtherOrderId() *OrderId {
+	if m != nil {
+		return m.OneCancelsOtherOrderId
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Order) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -812,6 +918,7 @@ func init() {
 	proto.RegisterEnum("dydxprotocol.clob.Order_Side", Order_Side_name, Order_Side_value)
 	proto.RegisterEnum("dydxprotocol.clob.Order_TimeInForce", Order_TimeInForce_name, Order_TimeInForce_value)
 	proto.RegisterEnum("dydxprotocol.clob.Order_ConditionType", Order_ConditionType_name, Order_ConditionType_value)
+	proto.RegisterEnum("dydxprotocol.clob.Order_SelfTradePrevention", Order_SelfTradePrevention_name, Order_SelfTradePrevention_value)
 	proto.RegisterType((*OrderId)(nil), "dydxprotocol.clob.OrderId")
 	proto.RegisterType((*OrdersFilledDuringLatestBlock)(nil), "dydxprotocol.clob.OrdersFilledDuringLatestBlock")
 	proto.RegisterType((*PotentiallyPrunableOrders)(nil), "dydxprotocol.clob.PotentiallyPrunableOrders")
@@ -826,69 +933,84 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/clob/order.proto", fileDescriptor_673c6f4faa93736b) }
 
 var fileDescriptor_673c6f4faa93736b = []byte{
-	// 989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0xdb, 0x6c, 0x9b, 0x9e, 0xfc, 0xac, 0x3b, 0xdd, 0x05, 0xb7, 0xa5, 0x69, 0x88, 0x50,
-	0x29, 0x42, 0x24, 0xa2, 0xac, 0x90, 0x10, 0xe2, 0x62, 0xdb, 0x26, 0xaa, 0xd5, 0xb4, 0x0e, 0xb6,
-	0x8b, 0xd4, 0x15, 0x62, 0xe4, 0xd8, 0x53, 0x77, 0xb4, 0x13, 0x4f, 0xb0, 0xc7, 0xa8, 0xb9, 0xe3,
-	0x11, 0x78, 0x09, 0xde, 0x82, 0x07, 0xd8, 0xcb, 0xbd, 0xe4, 0x0a, 0xa1, 0xf6, 0x19, 0x10, 0xb7,
-	0x68, 0xc6, 0x6e, 0xea, 0x74, 0xb7, 0x42, 0x68, 0x6f, 0xb8, 0xf3, 0x7c, 0xe7, 0x3b, 0xdf, 0x9c,
-	0xdf, 0x91, 0x61, 0x2b, 0x98, 0x06, 0x57, 0x93, 0x98, 0x0b, 0xee, 0x73, 0xd6, 0xf5, 0x19, 0x1f,
-	0x75, 0x79, 0x1c, 0x90, 0xb8, 0xa3, 0x30, 0xb4, 0x5a, 0x34, 0x77, 0xa4, 0x79, 0xe3, 0x49, 0xc8,
-	0x43, 0xae, 0xa0, 0xae, 0xfc, 0xca, 0x88, 0x1b, 0x9f, 0xcc, 0xe9, 0x24, 0xe9, 0xc8, 0xf3, 0x7d,
-	0x9e, 0x46, 0x22, 0x29, 0x7c, 0x67, 0xd4, 0xf6, 0x6f, 0x1a, 0x2c, 0x5b, 0xf2, 0x0e, 0x33, 0x40,
-	0xdf, 0x42, 0xfd, 0xce, 0x8e, 0x69, 0x60, 0x68, 0x2d, 0x6d, 0xb7, 0xba, 0xb7, 0xd3, 0x99, 0xbb,
-	0xb7, 0x20, 0xd7, 0x71, 0x66, 0xdf, 0x66, 0xb0, 0x5f, 0x7e, 0xf5, 0xc7, 0x76, 0xc9, 0xae, 0x25,
-	0x05, 0x0c, 0x6d, 0xc2, 0x8a, 0xcf, 0x28, 0xc9, 0xe4, 0x16, 0x5a, 0xda, 0xee, 0xb2, 0x5d, 0xc9,
-	0x00, 0x33, 0x40, 0xdb, 0x50, 0x55, 0xe9, 0xe1, 0x0b, 0xe6, 0x85, 0x89, 0xb1, 0xd8, 0xd2, 0x76,
-	0xeb, 0x36, 0x28, 0xa8, 0x2f, 0x11, 0xd4, 0x82, 0x9a, 0xcc, 0x12, 0x4f, 0x3c, 0x1a, 0x4b, 0x81,
-	0x72, 0xc6, 0x90, 0xd8, 0xd0, 0xa3, 0xb1, 0x19, 0xb4, 0x7f, 0x80, 0x2d, 0x15, 0x7d, 0xd2, 0xa7,
-	0x8c, 0x91, 0xe0, 0x30, 0x8d, 0x69, 0x14, 0x0e, 0x3c, 0x41, 0x12, 0xb1, 0xcf, 0xb8, 0xff, 0x12,
-	0x7d, 0x03, 0x2b, 0xd9, 0x1d, 0x34, 0x48, 0x0c, 0xad, 0xb5, 0xb8, 0x5b, 0xdd, 0xdb, 0xe8, 0xbc,
-	0x51, 0xc7, 0x4e, 0x5e, 0x82, 0x3c, 0x87, 0x0a, 0xcf, 0x8e, 0x49, 0xfb, 0x05, 0xac, 0x0f, 0xb9,
-	0x20, 0x91, 0xa0, 0x1e, 0x63, 0xd3, 0x61, 0x9c, 0x46, 0xde, 0x88, 0x91, 0xec, 0xca, 0x77, 0xd5,
-	0x26, 0xd0, 0x50, 0x26, 0x19, 0xba, 0x23, 0x3c, 0x41, 0x64, 0x41, 0x2e, 0x28, 0x63, 0xd8, 0x1b,
-	0xcb, 0xf2, 0xa9, 0xf2, 0x97, 0x6d, 0x90, 0xd0, 0x73, 0x85, 0xa0, 0x3d, 0x78, 0x3a, 0xc9, 0x63,
-	0xc0, 0x23, 0x99, 0x1f, 0xbe, 0x24, 0x34, 0xbc, 0x14, 0xaa, 0xb4, 0x75, 0x7b, 0xed, 0xd6, 0xa8,
-	0x72, 0x3f, 0x52, 0xa6, 0xf6, 0xf7, 0xb0, 0xa9, 0xd4, 0x2f, 0x52, 0xa6, 0xae, 0x73, 0xe9, 0x98,
-	0x38, 0x8c, 0xfa, 0xe4, 0x3b, 0x8f, 0xa5, 0xe4, 0x5d, 0x93, 0xf8, 0x55, 0x83, 0xf7, 0x06, 0x3c,
-	0x0a, 0x5d, 0x12, 0x8f, 0x15, 0x67, 0xc8, 0x3c, 0x9f, 0x8c, 0x49, 0x24, 0xd0, 0x33, 0x78, 0xa4,
-	0x68, 0xf9, 0x18, 0x19, 0x0f, 0xa9, 0xe6, 0x9a, 0x19, 0x19, 0x9d, 0xc1, 0xe3, 0xc9, 0xad, 0x04,
-	0xa6, 0x51, 0x40, 0xae, 0x54, 0x72, 0x6f, 0x8c, 0xa1, 0xf2, 0x77, 0x63, 0x2f, 0x4a, 0x3c, 0x5f,
-	0x50, 0x1e, 0x29, 0x29, 0x1a, 0x85, 0xb9, 0x5a, 0x63, 0x26, 0x62, 0x4a, 0x8d, 0xf6, 0x5f, 0x1a,
-	0xac, 0x1f, 0xf0, 0x28, 0xa0, 0x92, 0xeb, 0xb1, 0xff, 0x71, 0xa8, 0xe8, 0x18, 0xea, 0x22, 0xa6,
-	0x61, 0x28, 0x7b, 0xa2, 0x44, 0x17, 0xff, 0x8b, 0xa8, 0x5d, 0xcb, 0x9d, 0xb3, 0xbc, 0xff, 0x5e,
-	0x82, 0x47, 0xca, 0x84, 0xbe, 0x86, 0xca, 0x6d, 0xa3, 0xf3, 0x34, 0xff, 0xbd, 0xcf, 0xcb, 0x79,
-	0x9f, 0xd1, 0xe7, 0x50, 0x4e, 0x68, 0x40, 0x54, 0x7e, 0x8d, 0xbd, 0xad, 0x87, 0x1c, 0x3b, 0x0e,
-	0x0d, 0x88, 0xad, 0xa8, 0x68, 0x03, 0x2a, 0x3f, 0xa6, 0x5e, 0x24, 0xd2, 0x71, 0xb6, 0xda, 0x65,
-	0x7b, 0x76, 0x96, 0xb6, 0x24, 0x1d, 0x09, 0xea, 0xbf, 0x4c, 0xd4, 0x52, 0x97, 0xed, 0xd9, 0x19,
-	0xed, 0x40, 0x23, 0xe4, 0x3c, 0xc0, 0x82, 0xb2, 0x6c, 0xc6, 0x8d, 0x47, 0x72, 0xb8, 0x8f, 0x4a,
-	0x76, 0x4d, 0xe2, 0x2e, 0x65, 0xd9, 0x66, 0x77, 0x61, 0x6d, 0x9e, 0x87, 0x05, 0x1d, 0x13, 0x63,
-	0x49, 0x3e, 0x32, 0x47, 0x25, 0x5b, 0x2f, 0x92, 0xe5, 0xcc, 0xa3, 0x23, 0xa8, 0x4b, 0x06, 0xa6,
-	0x11, 0xbe, 0xe0, 0xb1, 0x4f, 0x8c, 0x65, 0x95, 0xcc, 0x47, 0x0f, 0x26, 0x23, 0xbd, 0xcc, 0xa8,
-	0x2f, 0xb9, 0x76, 0x55, 0xdc, 0x1d, 0xe4, 0x9e, 0xc6, 0x24, 0x48, 0x7d, 0x82, 0x79, 0xc4, 0xa6,
-	0x46, 0xa5, 0xa5, 0xed, 0x56, 0x6c, 0xc8, 0x20, 0x2b, 0x62, 0x53, 0xf4, 0x31, 0x3c, 0xce, 0x9f,
-	0xbd, 0x31, 0x11, 0x5e, 0xe0, 0x09, 0xcf, 0x58, 0x51, 0x1b, 0xda, 0xc8, 0xe0, 0x93, 0x1c, 0x45,
-	0x27, 0xd0, 0xf0, 0x6f, 0xa7, 0x12, 0x8b, 0xe9, 0x84, 0x18, 0xa0, 0x82, 0xda, 0x79, 0x30, 0xa8,
-	0xd9, 0x10, 0xbb, 0xd3, 0x09, 0xb1, 0xeb, 0x7e, 0xf1, 0x88, 0x8e, 0xa1, 0xed, 0xdf, 0x0d, 0x39,
-	0xce, 0xfa, 0x7d, 0x3b, 0x4c, 0xb3, 0x8a, 0x57, 0x55, 0xc5, 0xb7, 0xfd, 0x7b, 0xeb, 0xe0, 0x66,
-	0x3c, 0x27, 0xa7, 0xb5, 0xbf, 0x82, 0xb2, 0x6c, 0x27, 0x7a, 0x02, 0xba, 0x63, 0x1e, 0xf6, 0xf0,
-	0xd9, 0xa9, 0x33, 0xec, 0x1d, 0x98, 0x7d, 0xb3, 0x77, 0xa8, 0x97, 0x50, 0x0d, 0x2a, 0x0a, 0xdd,
-	0x3f, 0x3b, 0xd7, 0x35, 0x54, 0x87, 0x15, 0x75, 0x72, 0x7a, 0x83, 0x81, 0xbe, 0xd0, 0xfe, 0x59,
-	0x83, 0x6a, 0xa1, 0x7a, 0x68, 0x0b, 0xd6, 0x5d, 0xf3, 0xa4, 0x87, 0xcd, 0x53, 0xdc, 0xb7, 0xec,
-	0x83, 0xfb, 0x5a, 0x4f, 0x61, 0x75, 0xde, 0x6c, 0x5a, 0x07, 0xba, 0x86, 0x36, 0xe1, 0xfd, 0x79,
-	0x78, 0x68, 0x39, 0x2e, 0xb6, 0x4e, 0x07, 0xe7, 0xfa, 0x02, 0x6a, 0xc2, 0xc6, 0xbc, 0xb1, 0x6f,
-	0x0e, 0x06, 0xd8, 0xb2, 0xf1, 0xb1, 0x39, 0x18, 0xe8, 0x8b, 0xed, 0x31, 0xd4, 0xe7, 0x4a, 0x25,
-	0x1d, 0x0e, 0xac, 0xd3, 0x43, 0xd3, 0x35, 0xad, 0x53, 0xec, 0x9e, 0x0f, 0xef, 0x07, 0xf1, 0x01,
-	0x18, 0xf7, 0xec, 0x8e, 0x6b, 0x0d, 0xf1, 0xc0, 0x72, 0x1c, 0x5d, 0x7b, 0x8b, 0xb7, 0xfb, 0xfc,
-	0xb8, 0x87, 0x87, 0xb6, 0xd5, 0x37, 0x5d, 0x7d, 0x61, 0x5f, 0x2f, 0x4c, 0x2d, 0x8f, 0x08, 0xbf,
-	0x68, 0x13, 0x58, 0x7b, 0xcb, 0x7a, 0xa2, 0x0f, 0xa1, 0x36, 0xf7, 0x72, 0x6b, 0x6a, 0x2e, 0xaa,
-	0xa3, 0xbb, 0x17, 0x1b, 0x7d, 0x0a, 0xab, 0xe2, 0xce, 0xb3, 0xf0, 0xb2, 0xd4, 0x6d, 0xbd, 0x60,
-	0x50, 0x0b, 0xbe, 0x3f, 0x7c, 0x75, 0xdd, 0xd4, 0x5e, 0x5f, 0x37, 0xb5, 0x3f, 0xaf, 0x9b, 0xda,
-	0x2f, 0x37, 0xcd, 0xd2, 0xeb, 0x9b, 0x66, 0xe9, 0xf7, 0x9b, 0x66, 0xe9, 0xc5, 0x97, 0x21, 0x15,
-	0x97, 0xe9, 0xa8, 0xe3, 0xf3, 0x71, 0x77, 0xee, 0x87, 0xe0, 0xa7, 0x67, 0x9f, 0xf9, 0x97, 0x1e,
-	0x8d, 0xba, 0x33, 0xe4, 0x2a, 0xfb, 0xd9, 0x90, 0x03, 0x98, 0x8c, 0x96, 0x14, 0xfc, 0xc5, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x0b, 0xbd, 0x8a, 0x8e, 0x08, 0x00, 0x00,
+	// 1217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0x16, 0x6d, 0x25, 0x96, 0x8f, 0x7e, 0xc2, 0x8c, 0x93, 0x5c, 0xc6, 0x89, 0x15, 0x85, 0xb8,
+	0xd7, 0xd7, 0xf7, 0xb6, 0x95, 0x51, 0x37, 0x28, 0x50, 0x14, 0x5d, 0xd8, 0x12, 0x55, 0x13, 0xa6,
+	0x45, 0x96, 0x64, 0x02, 0x24, 0x28, 0x3a, 0xa5, 0xc8, 0x91, 0x3c, 0xc8, 0x88, 0xa3, 0x92, 0x54,
+	0x1a, 0xef, 0xfa, 0x06, 0xed, 0x4b, 0xf4, 0x2d, 0xfa, 0x00, 0x59, 0x66, 0x99, 0x55, 0x51, 0xc4,
+	0xcf, 0xd0, 0x7d, 0x31, 0x43, 0x5a, 0x96, 0x1c, 0x19, 0x41, 0x91, 0x4d, 0x77, 0x9c, 0xef, 0x7c,
+	0xe7, 0x9b, 0x73, 0xce, 0x9c, 0x33, 0x1c, 0xd8, 0x8a, 0x4e, 0xa3, 0x97, 0x93, 0x84, 0x67, 0x3c,
+	0xe4, 0x6c, 0x37, 0x64, 0x7c, 0xb0, 0xcb, 0x93, 0x88, 0x24, 0x6d, 0x89, 0xa1, 0x9b, 0xf3, 0xe6,
+	0xb6, 0x30, 0x6f, 0xde, 0x1a, 0xf1, 0x11, 0x97, 0xd0, 0xae, 0xf8, 0xca, 0x89, 0x9b, 0xff, 0x5b,
+	0xd0, 0x49, 0xa7, 0x83, 0x20, 0x0c, 0xf9, 0x34, 0xce, 0xd2, 0xb9, 0xef, 0x9c, 0xaa, 0xff, 0xa6,
+	0xc0, 0x9a, 0x2d, 0xf6, 0x30, 0x23, 0xf4, 0x0d, 0xd4, 0x2f, 0xec, 0x98, 0x46, 0x9a, 0xd2, 0x52,
+	0x76, 0xaa, 0x7b, 0xdb, 0xed, 0x85, 0x7d, 0xe7, 0xe4, 0xda, 0xde, 0xec, 0xdb, 0x8c, 0x0e, 0xca,
+	0xaf, 0x7e, 0x7f, 0x50, 0x72, 0x6b, 0xe9, 0x1c, 0x86, 0xee, 0xc1, 0x7a, 0xc8, 0x28, 0xc9, 0xe5,
+	0x56, 0x5a, 0xca, 0xce, 0x9a, 0x5b, 0xc9, 0x01, 0x33, 0x42, 0x0f, 0xa0, 0x2a, 0xd3, 0xc3, 0x43,
+	0x16, 0x8c, 0x52, 0x6d, 0xb5, 0xa5, 0xec, 0xd4, 0x5d, 0x90, 0x50, 0x4f, 0x20, 0xa8, 0x05, 0x35,
+	0x91, 0x25, 0x9e, 0x04, 0x34, 0x11, 0x02, 0xe5, 0x9c, 0x21, 0x30, 0x27, 0xa0, 0x89, 0x19, 0xe9,
+	0xdf, 0xc1, 0x96, 0x8c, 0x3e, 0xed, 0x51, 0xc6, 0x48, 0xd4, 0x9d, 0x26, 0x34, 0x1e, 0x59, 0x41,
+	0x46, 0xd2, 0xec, 0x80, 0xf1, 0xf0, 0x39, 0xfa, 0x0a, 0xd6, 0xf3, 0x3d, 0x68, 0x94, 0x6a, 0x4a,
+	0x6b, 0x75, 0xa7, 0xba, 0xb7, 0xd9, 0x7e, 0xa7, 0x8e, 0xed, 0xa2, 0x04, 0x45, 0x0e, 0x15, 0x9e,
+	0x2f, 0x53, 0xfd, 0x19, 0xdc, 0x75, 0x78, 0x46, 0xe2, 0x8c, 0x06, 0x8c, 0x9d, 0x3a, 0xc9, 0x34,
+	0x0e, 0x06, 0x8c, 0xe4, 0x5b, 0x7e, 0xa8, 0x36, 0x81, 0x86, 0x34, 0x89, 0xd0, 0xbd, 0x2c, 0xc8,
+	0x88, 0x28, 0xc8, 0x90, 0x32, 0x86, 0x83, 0xb1, 0x28, 0x9f, 0x2c, 0x7f, 0xd9, 0x05, 0x01, 0xed,
+	0x4b, 0x04, 0xed, 0xc1, 0xed, 0x49, 0x11, 0x03, 0x1e, 0x88, 0xfc, 0xf0, 0x09, 0xa1, 0xa3, 0x93,
+	0x4c, 0x96, 0xb6, 0xee, 0x6e, 0x9c, 0x1b, 0x65, 0xee, 0x87, 0xd2, 0xa4, 0x7f, 0x0b, 0xf7, 0xa4,
+	0xfa, 0x70, 0xca, 0xe4, 0x76, 0x3e, 0x1d, 0x13, 0x8f, 0xd1, 0x90, 0x3c, 0x09, 0xd8, 0x94, 0x7c,
+	0x68, 0x12, 0xbf, 0x2a, 0x70, 0xc7, 0xe2, 0xf1, 0xc8, 0x27, 0xc9, 0x58, 0x72, 0x1c, 0x16, 0x84,
+	0x64, 0x4c, 0xe2, 0x0c, 0x3d, 0x82, 0x6b, 0x92, 0x56, 0xb4, 0x91, 0x76, 0x95, 0x6a, 0xa1, 0x99,
+	0x93, 0xd1, 0x63, 0xb8, 0x31, 0x39, 0x97, 0xc0, 0x34, 0x8e, 0xc8, 0x4b, 0x6d, 0x65, 0x59, 0x1b,
+	0x4a, 0x7f, 0x3f, 0x09, 0xe2, 0x34, 0x08, 0x33, 0xca, 0x63, 0x29, 0x45, 0xe3, 0x51, 0xa1, 0xd6,
+	0x98, 0x89, 0x98, 0x42, 0x43, 0xff, 0x53, 0x81, 0xbb, 0x1d, 0x1e, 0x47, 0x54, 0x70, 0x03, 0xf6,
+	0x0f, 0x0e, 0x15, 0x1d, 0x41, 0x3d, 0x4b, 0xe8, 0x68, 0x24, 0xce, 0x44, 0x8a, 0xae, 0xfe, 0x1d,
+	0x51, 0xb7, 0x56, 0x38, 0xe7, 0x79, 0xbf, 0xa9, 0xc2, 0x35, 0x69, 0x42, 0x5f, 0x42, 0xe5, 0xfc,
+	0xa0, 0x8b, 0x34, 0xdf, 0x7f, 0xce, 0x6b, 0xc5, 0x39, 0xa3, 0x4f, 0xa1, 0x9c, 0xd2, 0x88, 0xc8,
+	0xfc, 0x1a, 0x7b, 0x5b, 0x57, 0x39, 0xb6, 0x3d, 0x1a, 0x11, 0x57, 0x52, 0xd1, 0x26, 0x54, 0x7e,
+	0x98, 0x06, 0x71, 0x36, 0x1d, 0xe7, 0xa3, 0x5d, 0x76, 0x67, 0x6b, 0x61, 0x4b, 0xa7, 0x83, 0x8c,
+	0x86, 0xcf, 0x53, 0x39, 0xd4, 0x65, 0x77, 0xb6, 0x46, 0xdb, 0xd0, 0x18, 0x71, 0x1e, 0xe1, 0x8c,
+	0xb2, 0xbc, 0xc7, 0xb5, 0x6b, 0xa2, 0xb9, 0x0f, 0x4b, 0x6e, 0x4d, 0xe0, 0x3e, 0x65, 0xf9, 0x64,
+	0xef, 0xc2, 0xc6, 0x22, 0x0f, 0x67, 0x74, 0x4c, 0xb4, 0xeb, 0xe2, 0x92, 0x39, 0x2c, 0xb9, 0xea,
+	0x3c, 0x59, 0xf4, 0x3c, 0x3a, 0x84, 0xba, 0x60, 0x60, 0x1a, 0xe3, 0x21, 0x4f, 0x42, 0xa2, 0xad,
+	0xc9, 0x64, 0xfe, 0x7d, 0x65, 0x32, 0xc2, 0xcb, 0x8c, 0x7b, 0x82, 0xeb, 0x56, 0xb3, 0x8b, 0x85,
+	0x98, 0xd3, 0x84, 0x44, 0xd3, 0x90, 0x60, 0x1e, 0xb3, 0x53, 0xad, 0xd2, 0x52, 0x76, 0x2a, 0x2e,
+	0xe4, 0x90, 0x1d, 0xb3, 0x53, 0xf4, 0x5f, 0xb8, 0x51, 0x5c, 0x7b, 0x63, 0x92, 0x05, 0x51, 0x90,
+	0x05, 0xda, 0xba, 0x9c, 0xd0, 0x46, 0x0e, 0x1f, 0x17, 0x28, 0x3a, 0x86, 0x46, 0x78, 0xde, 0x95,
+	0x38, 0x3b, 0x9d, 0x10, 0x0d, 0x64, 0x50, 0xdb, 0x57, 0x06, 0x35, 0x6b, 0x62, 0xff, 0x74, 0x42,
+	0xdc, 0x7a, 0x38, 0xbf, 0x44, 0x47, 0xa0, 0x87, 0x17, 0x4d, 0x8e, 0xf3, 0xf3, 0x3e, 0x6f, 0xa6,
+	0x59, 0xc5, 0xab, 0xb2, 0xe2, 0x0f, 0xc2, 0x4b, 0xe3, 0xe0, 0xe7, 0x3c, 0xef, 0xfc, 0x20, 0xbe,
+	0x87, 0xdb, 0x29, 0x61, 0x43, 0x9c, 0x25, 0x41, 0x44, 0xf0, 0x24, 0x21, 0x2f, 0xc4, 0x3d, 0xc8,
+	0x63, 0xad, 0x26, 0x43, 0xfc, 0xf8, 0xea, 0x26, 0x20, 0x6c, 0xe8, 0x0b, 0x27, 0x67, 0xe6, 0xe3,
+	0x6e, 0xa4, 0xef, 0x82, 0xe8, 0x10, 0x1e, 0x2e, 0xdd, 0x01, 0xf3, 0x1f, 0x63, 0x92, 0x60, 0x46,
+	0x5e, 0x10, 0xa6, 0xd5, 0x65, 0x75, 0xb7, 0x96, 0xf8, 0xdb, 0x82, 0x65, 0x09, 0x12, 0x72, 0x61,
+	0x7b, 0x59, 0xe2, 0x01, 0x65, 0x34, 0x1e, 0xe1, 0x09, 0x49, 0x42, 0x71, 0x18, 0x93, 0xc9, 0x58,
+	0x6b, 0xc8, 0x73, 0xd0, 0xdf, 0x4d, 0x3e, 0xe7, 0x3a, 0x39, 0xd5, 0x99, 0x8c, 0xd1, 0x13, 0xd8,
+	0xe4, 0x31, 0xc1, 0x61, 0x10, 0x87, 0x84, 0xa5, 0x98, 0x67, 0x27, 0x24, 0xc1, 0xb3, 0x11, 0xba,
+	0xf1, 0xbe, 0x11, 0x72, 0xef, 0xf0, 0x98, 0x74, 0x72, 0x67, 0x5b, 0xf8, 0x16, 0xb8, 0xfe, 0x05,
+	0x94, 0xc5, 0x98, 0xa0, 0x5b, 0xa0, 0x7a, 0x66, 0xd7, 0xc0, 0x8f, 0xfb, 0x9e, 0x63, 0x74, 0xcc,
+	0x9e, 0x69, 0x74, 0xd5, 0x12, 0xaa, 0x41, 0x45, 0xa2, 0x07, 0x8f, 0x9f, 0xaa, 0x0a, 0xaa, 0xc3,
+	0xba, 0x5c, 0x79, 0x86, 0x65, 0xa9, 0x2b, 0xfa, 0x4f, 0x0a, 0x54, 0xe7, 0xba, 0x12, 0x6d, 0xc1,
+	0x5d, 0xdf, 0x3c, 0x36, 0xb0, 0xd9, 0xc7, 0x3d, 0xdb, 0xed, 0x5c, 0xd6, 0xba, 0x0d, 0x37, 0x17,
+	0xcd, 0xa6, 0xdd, 0x51, 0x15, 0x74, 0x0f, 0xfe, 0xb5, 0x08, 0x3b, 0xb6, 0xe7, 0x63, 0xbb, 0x6f,
+	0x3d, 0x55, 0x57, 0x50, 0x13, 0x36, 0x17, 0x8d, 0x3d, 0xd3, 0xb2, 0xb0, 0xed, 0xe2, 0x23, 0xd3,
+	0xb2, 0xd4, 0x55, 0xfd, 0x67, 0x05, 0xea, 0x0b, 0x3d, 0x28, 0x3c, 0x3a, 0x76, 0xbf, 0x6b, 0xfa,
+	0xa6, 0xdd, 0xc7, 0xfe, 0x53, 0xe7, 0x72, 0x14, 0xf7, 0x41, 0xbb, 0x64, 0xf7, 0x7c, 0xdb, 0xc1,
+	0x96, 0xed, 0x79, 0xaa, 0xb2, 0xc4, 0xdb, 0xdf, 0x3f, 0x32, 0xb0, 0xe3, 0xda, 0x3d, 0xd3, 0x57,
+	0x57, 0x50, 0x0b, 0xee, 0x5f, 0xb6, 0xbb, 0xfb, 0xa6, 0x65, 0xf6, 0xbf, 0x96, 0x32, 0xea, 0xaa,
+	0x7e, 0xa6, 0xc0, 0xc6, 0x92, 0x96, 0x43, 0xff, 0x81, 0x87, 0x9e, 0x61, 0xf5, 0x04, 0xbf, 0x2b,
+	0x04, 0x8d, 0x27, 0x46, 0x5f, 0xaa, 0x2c, 0x86, 0xb7, 0x0d, 0xfa, 0x72, 0x5a, 0x67, 0xbf, 0xdf,
+	0x31, 0x2c, 0x7c, 0xbc, 0x7f, 0x64, 0xb8, 0xaa, 0xf2, 0x5e, 0x9e, 0x2f, 0x79, 0x2b, 0x57, 0x6f,
+	0x5b, 0xf0, 0x0e, 0x6c, 0xff, 0x50, 0x5d, 0x45, 0x6d, 0xf8, 0xff, 0x72, 0x5a, 0xd7, 0xe8, 0xb8,
+	0xc6, 0xb1, 0xd1, 0xf7, 0xf1, 0x7e, 0xbf, 0x5b, 0x38, 0xa9, 0xe5, 0x03, 0x75, 0xee, 0x5a, 0xe4,
+	0x31, 0xe1, 0x43, 0x9d, 0xc0, 0xc6, 0x92, 0xfb, 0x1f, 0x3d, 0x84, 0xda, 0xc2, 0xd3, 0x40, 0x91,
+	0x0d, 0x5f, 0x1d, 0x5c, 0x3c, 0x09, 0xd0, 0x47, 0x70, 0x33, 0xbb, 0xf0, 0x9c, 0xfb, 0x75, 0xd5,
+	0x5d, 0x75, 0xce, 0x20, 0xff, 0x20, 0x07, 0xce, 0xab, 0xb7, 0x4d, 0xe5, 0xf5, 0xdb, 0xa6, 0xf2,
+	0xc7, 0xdb, 0xa6, 0xf2, 0xcb, 0x59, 0xb3, 0xf4, 0xfa, 0xac, 0x59, 0x7a, 0x73, 0xd6, 0x2c, 0x3d,
+	0xfb, 0x7c, 0x44, 0xb3, 0x93, 0xe9, 0xa0, 0x1d, 0xf2, 0xf1, 0xee, 0xc2, 0x8b, 0xf3, 0xc5, 0xa3,
+	0x4f, 0xc2, 0x93, 0x80, 0xc6, 0xbb, 0x33, 0xe4, 0x65, 0xfe, 0x9a, 0x15, 0x37, 0x5c, 0x3a, 0xb8,
+	0x2e, 0xe1, 0xcf, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x40, 0x74, 0x2c, 0xef, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *OrderId) Marshal() (dAtA []byte, err error) {
@@ -1202,6 +1324,38 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OneCancelsOtherOrderId != nil {
+		{
+			size, err := m.OneCancelsOtherOrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ConditionalOrderTrailingPercentPpm != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ConditionalOrderTrailingPercentPpm))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SelfTradePreventionOwnerLevel {
+		i--
+		if m.SelfTradePreventionOwnerLevel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		i = encodeVarintOrder(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
@@ -1483,6 +1637,19 @@ func (m *Order) Size() (n int) {
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		n += 1 + sovOrder(uint64(m.ConditionalOrderTriggerSubticks))
 	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovOrder(uint64(m.SelfTradePrevention))
+	}
+	if m.SelfTradePreventionOwnerLevel {
+		n += 2
+	}
+	if m.ConditionalOrderTrailingPercentPpm != 0 {
+		n += 1 + sovOrder(uint64(m.ConditionalOrderTrailingPercentPpm))
+	}
+	if m.OneCancelsOtherOrderId != nil {
+		l = m.OneCancelsOtherOrderId.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
 	return n
 }
 
@@ -2510,6 +2677,100 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= Order_SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePreventionOwnerLevel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SelfTradePreventionOwnerLevel = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTrailingPercentPpm", wireType)
+			}
+			m.ConditionalOrderTrailingPercentPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderTrailingPercentPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneCancelsOtherOrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OneCancelsOtherOrderId == nil {
+				m.OneCancelsOtherOrderId = &OrderId{}
+			}
+			if err := m.OneCancelsOtherOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
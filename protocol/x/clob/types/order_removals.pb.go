@@ -96,6 +96,11 @@ func (OrderRemoval_RemovalReason) EnumDescriptor() ([]byte, []int) {
 type OrderRemoval struct {
 	OrderId       OrderId                    `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
 	RemovalReason OrderRemoval_RemovalReason `protobuf:"varint,2,opt,name=removal_reason,json=removalReason,proto3,enum=dydxprotocol.clob.OrderRemoval_RemovalReason" json:"removal_reason,omitempty"`
+	// The ID of the order on the other side of the self-trade if the removal
+	// reason is REMOVAL_REASON_INVALID_SELF_TRADE. Not set for any other removal
+	// reason, or if the other side of the self-trade is a liquidation order.
+	// Short-Term orders must be placed earlier in the same operations queue.
+	SelfTradeOrderId *OrderId `protobuf:"bytes,3,opt,name=self_trade_order_id,json=selfTradeOrderId,proto3" json:"self_trade_order_id,omitempty"`
 }
 
 func (m *OrderRemoval) Reset()         { *m = OrderRemoval{} }
@@ -145,6 +150,13 @@ func (m *OrderRemoval) GetRemovalReason() OrderRemoval_RemovalReason {
 	return OrderRemoval_REMOVAL_REASON_UNSPECIFIED
 }
 
+func (m *OrderRemoval) GetSelfTradeOrderId() *OrderId {
+	if m != nil {
+		return m.SelfTradeOrderId
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("dydxprotocol.clob.OrderRemoval_RemovalReason", OrderRemoval_RemovalReason_name, OrderRemoval_RemovalReason_value)
 	proto.RegisterType((*OrderRemoval)(nil), "dydxprotocol.clob.OrderRemoval")
@@ -155,35 +167,37 @@ func init() {
 }
 
 var fileDescriptor_60fa12f781955c9f = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xed, 0x10, 0x5a, 0xb4, 0xd0, 0xca, 0xac, 0x38, 0x54, 0x41, 0xb8, 0x25, 0x12, 0x55,
-	0x2f, 0xb5, 0xa1, 0x14, 0x84, 0x04, 0x17, 0xc7, 0xbb, 0x96, 0x56, 0xd9, 0x7a, 0xa2, 0xb5, 0x53,
-	0xd4, 0x5e, 0x46, 0x49, 0x1c, 0xa5, 0x91, 0xd2, 0x6e, 0xe5, 0x84, 0xaa, 0x7d, 0x0b, 0x4e, 0x3c,
-	0x53, 0x8f, 0x3d, 0x72, 0x42, 0x28, 0x79, 0x03, 0x9e, 0x00, 0x65, 0x1d, 0xa1, 0x26, 0x21, 0x3d,
-	0xd9, 0x33, 0xff, 0xf7, 0xff, 0xff, 0x1c, 0x96, 0xec, 0x66, 0x37, 0xd9, 0xf5, 0x65, 0xae, 0x47,
-	0xba, 0xa3, 0x07, 0x7e, 0x67, 0xa0, 0xdb, 0xbe, 0xce, 0xb3, 0x6e, 0x8e, 0x79, 0xf7, 0x5c, 0x5f,
-	0xb5, 0x06, 0x43, 0xcf, 0x88, 0xf4, 0xf9, 0x7d, 0xce, 0x9b, 0x72, 0x95, 0x17, 0x3d, 0xdd, 0xd3,
-	0x66, 0xe5, 0x4f, 0xff, 0x0a, 0xb0, 0xf2, 0x6a, 0x45, 0x60, 0x21, 0x57, 0x7f, 0x94, 0xc9, 0x33,
-	0x98, 0xce, 0xaa, 0xc8, 0xa7, 0x9f, 0xc9, 0x93, 0xa2, 0xb0, 0x9f, 0x6d, 0xd9, 0x3b, 0xf6, 0xde,
-	0xd3, 0x83, 0x8a, 0xb7, 0xd4, 0xe5, 0x19, 0x8b, 0xc8, 0x6a, 0xe5, 0xdb, 0x5f, 0xdb, 0x96, 0x5a,
-	0xd7, 0xc5, 0x48, 0x53, 0xb2, 0x39, 0xbb, 0x13, 0xf3, 0x6e, 0x6b, 0xa8, 0x2f, 0xb6, 0x4a, 0x3b,
-	0xf6, 0xde, 0xe6, 0xc1, 0xfe, 0xaa, 0x88, 0x59, 0xab, 0x37, 0xfb, 0x2a, 0x63, 0x52, 0x1b, 0xf9,
-	0xfd, 0xb1, 0xfa, 0xa7, 0x44, 0x36, 0xe6, 0x00, 0xea, 0x92, 0x8a, 0xe2, 0x47, 0x70, 0x1c, 0x48,
-	0x54, 0x3c, 0x48, 0x20, 0xc6, 0x66, 0x9c, 0x34, 0x78, 0x28, 0x22, 0xc1, 0x99, 0x63, 0xd1, 0x5d,
-	0x52, 0x5d, 0xd2, 0x19, 0x57, 0x21, 0x48, 0x19, 0xa4, 0x5c, 0x05, 0x52, 0x9c, 0x72, 0xe6, 0xd8,
-	0xff, 0xe1, 0x44, 0x7c, 0x1c, 0x48, 0xc1, 0x50, 0x71, 0xd6, 0x0c, 0x39, 0x42, 0x2c, 0x4f, 0x9c,
-	0x12, 0x3d, 0x24, 0x6f, 0x17, 0xb8, 0x06, 0x24, 0xa9, 0x51, 0xf1, 0x2b, 0x34, 0x25, 0xc3, 0x50,
-	0x41, 0x92, 0xe0, 0x51, 0x50, 0xe7, 0x0a, 0x41, 0x31, 0xae, 0x9c, 0x47, 0xf4, 0x0d, 0x79, 0xbd,
-	0x22, 0x3d, 0xe1, 0x32, 0xc2, 0x54, 0x05, 0x8c, 0x3b, 0x65, 0xfa, 0x85, 0x7c, 0x5a, 0xc0, 0x42,
-	0x88, 0x99, 0x48, 0x05, 0xc4, 0x81, 0xc4, 0x08, 0xea, 0x18, 0x9a, 0x8a, 0x18, 0x52, 0xac, 0x71,
-	0x8c, 0x9a, 0x52, 0x9e, 0x60, 0x24, 0xa4, 0xe4, 0xcc, 0x79, 0x4c, 0x3f, 0x90, 0x77, 0x0f, 0xb8,
-	0x05, 0x84, 0xb3, 0x03, 0x15, 0x37, 0x07, 0x63, 0x0d, 0xa0, 0xee, 0xac, 0xd1, 0x6d, 0xf2, 0x72,
-	0xc1, 0x36, 0x97, 0xbb, 0x5e, 0x6b, 0xdc, 0x8e, 0x5d, 0xfb, 0x6e, 0xec, 0xda, 0xbf, 0xc7, 0xae,
-	0xfd, 0x7d, 0xe2, 0x5a, 0x77, 0x13, 0xd7, 0xfa, 0x39, 0x71, 0xad, 0xd3, 0x8f, 0xbd, 0xfe, 0xe8,
-	0xec, 0x5b, 0xdb, 0xeb, 0xe8, 0x73, 0x7f, 0xee, 0x71, 0x5d, 0x1d, 0xee, 0x77, 0xce, 0x5a, 0xfd,
-	0x0b, 0xff, 0xdf, 0xe6, 0xba, 0x78, 0x70, 0xa3, 0x9b, 0xcb, 0xee, 0xb0, 0xbd, 0x66, 0xd6, 0xef,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x63, 0x2c, 0xa7, 0xa2, 0xe3, 0x02, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x4f, 0xba, 0xb2, 0x21, 0xc3, 0xa6, 0x60, 0x38, 0x4c, 0x45, 0x64, 0xa3, 0x12, 0xd3, 0x2e,
+	0x4b, 0x60, 0x0c, 0x84, 0x04, 0x97, 0x34, 0x76, 0x24, 0xab, 0x5e, 0x5c, 0x39, 0xe9, 0xd0, 0x76,
+	0xf9, 0xd4, 0x36, 0xa1, 0xab, 0xd4, 0xcd, 0x53, 0x5a, 0xa6, 0xed, 0x2d, 0x78, 0xac, 0x1d, 0x77,
+	0xe4, 0x84, 0xa0, 0x7d, 0x03, 0x9e, 0x00, 0xc5, 0x89, 0xa6, 0xb5, 0xa5, 0x70, 0x4a, 0xbe, 0xdf,
+	0xff, 0x83, 0xd1, 0x4e, 0x72, 0x9d, 0x5c, 0x5d, 0x64, 0x6a, 0xac, 0x7a, 0x6a, 0xe8, 0xf6, 0x86,
+	0xaa, 0xeb, 0xaa, 0x2c, 0x49, 0x33, 0xc8, 0xd2, 0x33, 0x75, 0xd9, 0x19, 0x8e, 0x1c, 0x4d, 0xe2,
+	0x27, 0xf7, 0x75, 0x4e, 0xae, 0xab, 0x3d, 0xeb, 0xab, 0xbe, 0xd2, 0x90, 0x9b, 0xff, 0x15, 0xc2,
+	0xda, 0x8b, 0x25, 0x81, 0x05, 0x5d, 0xff, 0x55, 0x45, 0x8f, 0x45, 0x7e, 0xcb, 0x22, 0x1f, 0x7f,
+	0x44, 0x0f, 0x8b, 0xc2, 0x41, 0xb2, 0x69, 0x6e, 0x9b, 0xbb, 0x8f, 0xf6, 0x6b, 0xce, 0x42, 0x97,
+	0xa3, 0x2d, 0x2c, 0x69, 0x54, 0x6f, 0x7e, 0x6c, 0x19, 0x72, 0x4d, 0x15, 0x27, 0x8e, 0xd1, 0x46,
+	0xb9, 0x13, 0xb2, 0xb4, 0x33, 0x52, 0xe7, 0x9b, 0x95, 0x6d, 0x73, 0x77, 0x63, 0x7f, 0x6f, 0x59,
+	0x44, 0xd9, 0xea, 0x94, 0x5f, 0xa9, 0x4d, 0x72, 0x3d, 0xbb, 0x7f, 0x62, 0x86, 0x9e, 0x8e, 0xd2,
+	0xe1, 0x17, 0x18, 0x67, 0x9d, 0x24, 0x85, 0xbb, 0x75, 0x2b, 0xff, 0x5b, 0x27, 0xad, 0xdc, 0x16,
+	0xe7, 0xae, 0x12, 0xa9, 0xff, 0xae, 0xa0, 0xf5, 0x99, 0x2e, 0x6c, 0xa3, 0x9a, 0xa4, 0x87, 0xe2,
+	0xc8, 0xe3, 0x20, 0xa9, 0x17, 0x89, 0x10, 0xda, 0x61, 0xd4, 0xa2, 0x3e, 0x0b, 0x18, 0x25, 0x96,
+	0x81, 0x77, 0x50, 0x7d, 0x81, 0x27, 0x54, 0xfa, 0x82, 0x73, 0x2f, 0xa6, 0xd2, 0xe3, 0xec, 0x84,
+	0x12, 0xcb, 0xfc, 0x8b, 0x8e, 0x85, 0x47, 0x1e, 0x67, 0x04, 0x24, 0x25, 0x6d, 0x9f, 0x82, 0x08,
+	0xf9, 0xb1, 0x55, 0xc1, 0x07, 0xe8, 0xf5, 0x9c, 0xae, 0x25, 0xa2, 0x58, 0xb3, 0xf0, 0x59, 0xb4,
+	0x39, 0x01, 0x5f, 0x8a, 0x28, 0x82, 0x43, 0xaf, 0x49, 0x25, 0x08, 0x49, 0xa8, 0xb4, 0x56, 0xf0,
+	0x2b, 0xf4, 0x72, 0x49, 0x7a, 0x44, 0x79, 0x00, 0xb1, 0xf4, 0x08, 0xb5, 0xaa, 0xf8, 0x13, 0xfa,
+	0x30, 0x27, 0xf3, 0x45, 0x48, 0x58, 0xcc, 0x44, 0xe8, 0x71, 0x08, 0x44, 0x13, 0x7c, 0x5d, 0x11,
+	0x8a, 0x18, 0x1a, 0x14, 0x82, 0x36, 0xe7, 0xc7, 0x10, 0x30, 0xce, 0x29, 0xb1, 0x1e, 0xe0, 0x77,
+	0xe8, 0xcd, 0x3f, 0xdc, 0x4c, 0xf8, 0xe5, 0x40, 0x49, 0xf5, 0x60, 0x68, 0x08, 0xd1, 0xb4, 0x56,
+	0xf1, 0x16, 0x7a, 0x3e, 0x67, 0x9b, 0xc9, 0x5d, 0x6b, 0xb4, 0x6e, 0x26, 0xb6, 0x79, 0x3b, 0xb1,
+	0xcd, 0x9f, 0x13, 0xdb, 0xfc, 0x36, 0xb5, 0x8d, 0xdb, 0xa9, 0x6d, 0x7c, 0x9f, 0xda, 0xc6, 0xc9,
+	0xfb, 0xfe, 0x60, 0x7c, 0xfa, 0xb5, 0xeb, 0xf4, 0xd4, 0x99, 0x3b, 0xf3, 0x4e, 0x2f, 0x0f, 0xf6,
+	0x7a, 0xa7, 0x9d, 0xc1, 0xb9, 0x7b, 0x87, 0x5c, 0x15, 0x6f, 0x77, 0x7c, 0x7d, 0x91, 0x8e, 0xba,
+	0xab, 0x1a, 0x7e, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0x23, 0x85, 0x69, 0xb3, 0x2e, 0x03, 0x00,
+	0x00,
 }
 
 func (m *OrderRemoval) Marshal() (dAtA []byte, err error) {
@@ -206,6 +220,18 @@ func (m *OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradeOrderId != nil {
+		{
+			size, err := m.SelfTradeOrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrderRemovals(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.RemovalReason != 0 {
 		i = encodeVarintOrderRemovals(dAtA, i, uint64(m.RemovalReason))
 		i--
@@ -246,6 +272,10 @@ func (m *OrderRemoval) Size() (n int) {
 	if m.RemovalReason != 0 {
 		n += 1 + sovOrderRemovals(uint64(m.RemovalReason))
 	}
+	if m.SelfTradeOrderId != nil {
+		l = m.SelfTradeOrderId.Size()
+		n += 1 + l + sovOrderRemovals(uint64(l))
+	}
 	return n
 }
 
@@ -336,6 +366,42 @@ func (m *OrderRemoval) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradeOrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderRemovals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderRemovals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderRemovals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SelfTradeOrderId == nil {
+				m.SelfTradeOrderId = &OrderId{}
+			}
+			if err := m.SelfTradeOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrderRemovals(dAtA[iNdEx:])
@@ -347,6 +347,117 @@ func TestOrder_MustCmpReplacementOrder_PanicsWithNonReplacementOrder(t *testing.
 	)
 }
 
+func TestOrder_ValidateIsReplacementOf(t *testing.T) {
+	shortTerm := types.Order{
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     10,
+		Subticks:     10,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 5},
+	}
+	longTerm := types.Order{
+		OrderId:      types.OrderId{OrderFlags: types.OrderIdFlags_LongTerm},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     10,
+		Subticks:     10,
+		GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 5},
+	}
+	withChanges := func(order types.Order, change func(order *types.Order)) types.Order {
+		change(&order)
+		return order
+	}
+
+	tests := map[string]struct {
+		order       types.Order
+		existing    types.Order
+		expectedErr bool
+	}{
+		"Short-Term: changes subticks and quantums with a greater GoodTilBlock": {
+			order: withChanges(shortTerm, func(order *types.Order) {
+				order.Quantums = 5
+				order.Subticks = 20
+				order.GoodTilOneof = &types.Order_GoodTilBlock{GoodTilBlock: 6}
+			}),
+			existing: shortTerm,
+		},
+		"Short-Term: only increases GoodTilBlock": {
+			order: withChanges(shortTerm, func(order *types.Order) {
+				order.GoodTilOneof = &types.Order_GoodTilBlock{GoodTilBlock: 6}
+			}),
+			existing: shortTerm,
+		},
+		"Short-Term: GoodTilBlock is not greater": {
+			order: withChanges(shortTerm, func(order *types.Order) {
+				order.Quantums = 5
+			}),
+			existing:    shortTerm,
+			expectedErr: true,
+		},
+		"Short-Term: changes side": {
+			order: withChanges(shortTerm, func(order *types.Order) {
+				order.Side = types.Order_SIDE_SELL
+				order.GoodTilOneof = &types.Order_GoodTilBlock{GoodTilBlock: 6}
+			}),
+			existing:    shortTerm,
+			expectedErr: true,
+		},
+		"Short-Term: different order ID": {
+			order: withChanges(shortTerm, func(order *types.Order) {
+				order.OrderId.ClientId = 1
+				order.GoodTilOneof = &types.Order_GoodTilBlock{GoodTilBlock: 6}
+			}),
+			existing:    shortTerm,
+			expectedErr: true,
+		},
+		"Long-Term: changes subticks and quantums": {
+			order: withChanges(longTerm, func(order *types.Order) {
+				order.Quantums = 20
+				order.Subticks = 5
+			}),
+			existing: longTerm,
+		},
+		"Long-Term: changes GoodTilBlockTime": {
+			order: withChanges(longTerm, func(order *types.Order) {
+				order.Quantums = 20
+				order.GoodTilOneof = &types.Order_GoodTilBlockTime{GoodTilBlockTime: 6}
+			}),
+			existing:    longTerm,
+			expectedErr: true,
+		},
+		"Long-Term: changes time in force": {
+			order: withChanges(longTerm, func(order *types.Order) {
+				order.Quantums = 20
+				order.TimeInForce = types.Order_TIME_IN_FORCE_POST_ONLY
+			}),
+			existing:    longTerm,
+			expectedErr: true,
+		},
+		"Long-Term: identical order": {
+			order:       longTerm,
+			existing:    longTerm,
+			expectedErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.order.ValidateIsReplacementOf(&tc.existing)
+			if tc.expectedErr {
+				require.ErrorIs(t, err, types.ErrInvalidReplacementOrder)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOrder_CanRetainQueuePriority(t *testing.T) {
+	existing := types.Order{Quantums: 10, Subticks: 10}
+
+	require.True(t, (&types.Order{Quantums: 10, Subticks: 10}).CanRetainQueuePriority(&existing))
+	require.True(t, (&types.Order{Quantums: 5, Subticks: 10}).CanRetainQueuePriority(&existing))
+	require.False(t, (&types.Order{Quantums: 15, Subticks: 10}).CanRetainQueuePriority(&existing))
+	require.False(t, (&types.Order{Quantums: 5, Subticks: 15}).CanRetainQueuePriority(&existing))
+}
+
 func TestOrder_GetSubaccountId(t *testing.T) {
 	expectedSubaccountId := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId.SubaccountId
 	order := types.Order{
@@ -390,6 +501,117 @@ func TestOrder_IsReduceOnly(t *testing.T) {
 	require.True(t, constants.Order_Alice_Num1_Id1_Clob0_Sell10_Price15_GTB20_RO.IsReduceOnly())
 }
 
+func TestOrder_IsTrailingStopOrder(t *testing.T) {
+	require.False(
+		t,
+		constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20.IsTrailingStopOrder(),
+	)
+	require.False(t, constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15.IsTrailingStopOrder())
+	require.True(
+		t,
+		constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_10Pct.IsTrailingStopOrder(),
+	)
+}
+
+func TestOrder_IsOneCancelsOtherOrder(t *testing.T) {
+	require.False(
+		t,
+		constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20.IsOneCancelsOtherOrder(),
+	)
+	require.True(
+		t,
+		constants.ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO_Id0.IsOneCancelsOtherOrder(),
+	)
+}
+
+func TestOrder_GetTrailingStopTriggerSubticks(t *testing.T) {
+	tests := map[string]struct {
+		order               types.Order
+		oraclePriceSubticks *big.Rat
+		subticksPerTick     types.SubticksPerTick
+
+		expectedTriggerSubticks types.Subticks
+		expectedUpdated         bool
+	}{
+		"Sell: trigger price follows oracle price up": {
+			order:                   constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_10Pct,
+			oraclePriceSubticks:     big.NewRat(30, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 27,
+			expectedUpdated:         true,
+		},
+		"Sell: trigger price is rounded down to the nearest tick": {
+			order:                   constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_10Pct,
+			oraclePriceSubticks:     big.NewRat(30, 1),
+			subticksPerTick:         5,
+			expectedTriggerSubticks: 25,
+			expectedUpdated:         true,
+		},
+		"Sell: trigger price does not follow oracle price down": {
+			order:                   constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_10Pct,
+			oraclePriceSubticks:     big.NewRat(21, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 20,
+			expectedUpdated:         false,
+		},
+		"Buy: trigger price follows oracle price down": {
+			order:                   constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price20_GTBT15_TrailingStop20_10Pct,
+			oraclePriceSubticks:     big.NewRat(10, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 11,
+			expectedUpdated:         true,
+		},
+		"Buy: trigger price is rounded up to the nearest tick": {
+			order:                   constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price20_GTBT15_TrailingStop20_10Pct,
+			oraclePriceSubticks:     big.NewRat(10, 1),
+			subticksPerTick:         5,
+			expectedTriggerSubticks: 15,
+			expectedUpdated:         true,
+		},
+		"Buy: trigger price does not follow oracle price up": {
+			order:                   constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price20_GTBT15_TrailingStop20_10Pct,
+			oraclePriceSubticks:     big.NewRat(19, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 20,
+			expectedUpdated:         false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			triggerSubticks, updated := tc.order.GetTrailingStopTriggerSubticks(
+				tc.oraclePriceSubticks,
+				tc.subticksPerTick,
+			)
+			require.Equal(t, tc.expectedTriggerSubticks, triggerSubticks)
+			require.Equal(t, tc.expectedUpdated, updated)
+		})
+	}
+}
+
+func TestOrder_GetTrailingStopTriggerSubticks_PanicsOnNonTrailingStopOrder(t *testing.T) {
+	order := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20
+	require.PanicsWithValue(
+		t,
+		fmt.Sprintf("GetTrailingStopTriggerSubticks: order (%+v) is not a trailing stop order", &order),
+		func() {
+			order.GetTrailingStopTriggerSubticks(big.NewRat(20, 1), 1)
+		},
+	)
+}
+
+func TestOrder_IsSelfTrade(t *testing.T) {
+	order := constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15
+	require.True(t, order.IsSelfTrade(constants.Alice_Num0))
+	require.False(t, order.IsSelfTrade(constants.Alice_Num1))
+	require.False(t, order.IsSelfTrade(constants.Bob_Num0))
+
+	order.SelfTradePreventionOwnerLevel = true
+	require.True(t, order.IsSelfTrade(constants.Alice_Num0))
+	require.True(t, order.IsSelfTrade(constants.Alice_Num1))
+	require.False(t, order.IsSelfTrade(constants.Bob_Num0))
+}
+
 func TestOrder_RequiresImmediateExecution(t *testing.T) {
 	require.False(t, constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15.RequiresImmediateExecution())
 	require.True(t, constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20_FOK.RequiresImmediateExecution())
@@ -148,6 +148,9 @@ type TakerOrderStatus struct {
 	// order. Note that if any quantums of this order were optimistically filled or filled in state before the current
 	// matching cycle, this value will not include them.
 	OrderOptimisticallyFilledQuantums satypes.BaseQuantums
+	// The maker order the taker order self-traded with if the taker order was canceled by its self-trade
+	// prevention mode. Only set if the order status is `SelfTradeCanceled`.
+	SelfTradeMakerOrder *Order
 }
 
 // OrderStatus represents the status of an order after attempting to place it on the orderbook.
@@ -179,6 +182,9 @@ const (
 	// LiquidationExceededSubaccountMaxInsuranceLost indicates that the liquidation order could not
 	// be matched because it exceeded the maximum funds lost for the insurance fund in this block.
 	LiquidationExceededSubaccountMaxInsuranceLost
+	// SelfTradeCanceled indicates the remaining size of the order was canceled by the order's
+	// self-trade prevention mode after it crossed a maker order from the same subaccount or owner.
+	SelfTradeCanceled
 )
 
 // String returns a string representation of this `OrderStatus` enum.
@@ -200,6 +206,8 @@ func (os OrderStatus) String() string {
 		return "LiquidationExceededSubaccountMaxNotionalLiquidated"
 	case LiquidationExceededSubaccountMaxInsuranceLost:
 		return "LiquidationExceededSubaccountMaxInsuranceLost"
+	case SelfTradeCanceled:
+		return "SelfTradeCanceled"
 	default:
 		return "Unknown"
 	}
@@ -78,6 +78,11 @@ func TestString(t *testing.T) {
 
 			expectedString: "LiquidationExceededSubaccountMaxInsuranceLost",
 		},
+		"Order status is SelfTradeCanceled": {
+			orderStatus: types.SelfTradeCanceled,
+
+			expectedString: "SelfTradeCanceled",
+		},
 		"Order status is unknown enum value": {
 			orderStatus: 999,
 
@@ -66,11 +66,10 @@ func (p *PendingUpdates) ConvertToUpdates() []satypes.Update {
 			p.subaccountFee[subaccountId],
 		)
 
-		// Panic if there is more than one asset updates since we only support
-		// USDC asset at the moment.
-		if len(assetUpdates) > 1 {
-			panic(ErrAssetUpdateNotImplemented)
-		}
+		// Sort the assetIds in ascending order for determinism.
+		sort.Slice(assetUpdates, func(i, j int) bool {
+			return assetUpdates[i].AssetId < assetUpdates[j].AssetId
+		})
 
 		// Create an empty slice to store the perpetual updates for this subaccount.
 		perpetualUpdates := make(
@@ -177,3 +176,68 @@ func (p *PendingUpdates) AddPerpetualFill(
 	)
 	p.subaccountFee[subaccountId] = totalFee
 }
+
+// AddSpotFill adds a new fill on a spot CLOB pair to the PendingUpdate object, by
+// updating quoteBalanceDelta, the base asset update and fees paid or received by a subaccount.
+func (p *PendingUpdates) AddSpotFill(
+	subaccountId satypes.SubaccountId,
+	baseAssetId uint32,
+	isBuy bool,
+	feePpm int32,
+	bigFillBaseQuantums *big.Int,
+	bigFillQuoteQuantums *big.Int,
+) {
+	var quoteBalanceUpdate *big.Int
+	var baseAssetUpdate *big.Int
+
+	subaccountAssetUpdates, exists := p.subaccountAssetUpdates[subaccountId]
+	if !exists {
+		subaccountAssetUpdates = make(map[uint32]*big.Int)
+		p.subaccountAssetUpdates[subaccountId] = subaccountAssetUpdates
+	}
+	quoteBalanceUpdate, exists = subaccountAssetUpdates[assettypes.AssetUsdc.Id]
+	if !exists {
+		quoteBalanceUpdate = big.NewInt(0)
+		subaccountAssetUpdates[assettypes.AssetUsdc.Id] = quoteBalanceUpdate
+	}
+	baseAssetUpdate, exists = subaccountAssetUpdates[baseAssetId]
+	if !exists {
+		baseAssetUpdate = big.NewInt(0)
+		subaccountAssetUpdates[baseAssetId] = baseAssetUpdate
+	}
+
+	if isBuy {
+		quoteBalanceUpdate.Sub(
+			quoteBalanceUpdate,
+			bigFillQuoteQuantums,
+		)
+
+		baseAssetUpdate.Add(
+			baseAssetUpdate,
+			bigFillBaseQuantums,
+		)
+	} else {
+		quoteBalanceUpdate.Add(
+			quoteBalanceUpdate,
+			bigFillQuoteQuantums,
+		)
+
+		baseAssetUpdate.Sub(
+			baseAssetUpdate,
+			bigFillBaseQuantums,
+		)
+	}
+
+	totalFee, exists := p.subaccountFee[subaccountId]
+	if !exists {
+		totalFee = big.NewInt(0)
+	}
+
+	bigFeeQuoteQuantums := lib.BigIntMulSignedPpm(bigFillQuoteQuantums, feePpm, true)
+
+	totalFee.Add(
+		totalFee,
+		bigFeeQuoteQuantums,
+	)
+	p.subaccountFee[subaccountId] = totalFee
+}
@@ -312,3 +312,145 @@ func TestPendingUpdates(t *testing.T) {
 		})
 	}
 }
+
+type spotFill struct {
+	subaccountId         satypes.SubaccountId
+	baseAssetId          uint32
+	isBuy                bool
+	bigFillBaseQuantums  *big.Int
+	bigFillQuoteQuantums *big.Int
+	feePpm               int32
+}
+
+func TestPendingUpdates_SpotFills(t *testing.T) {
+	tests := []struct {
+		name            string
+		spotFills       []spotFill
+		expectedUpdates []satypes.Update
+	}{
+		{
+			name: "buy and sell (with fees)",
+			spotFills: []spotFill{
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                true,
+					feePpm:               500,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(10_000), // fee = 5_000_000 / 1_000_000
+				},
+				{
+					subaccountId:         constants.Bob_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                false,
+					feePpm:               -200,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(10_000), // fee = -2_000_000 / 1_000_000
+				},
+			},
+			expectedUpdates: []satypes.Update{
+				{
+					SubaccountId: constants.Bob_Num0,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId: uint32(0),
+							// 10_000 + (fee) 2
+							BigQuantumsDelta: big.NewInt(10_002),
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(-100),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{},
+				},
+				{
+					SubaccountId: constants.Alice_Num0,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId: uint32(0),
+							// - 10_000 - (fee) 5
+							BigQuantumsDelta: big.NewInt(-10_005),
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(100),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{},
+				},
+			},
+		},
+		{
+			name: "multiple fill amounts for same account across base assets (no fees)",
+			spotFills: []spotFill{
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(2),
+					isBuy:                true,
+					bigFillBaseQuantums:  big.NewInt(300),
+					bigFillQuoteQuantums: big.NewInt(300),
+				},
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                true,
+					bigFillBaseQuantums:  big.NewInt(200),
+					bigFillQuoteQuantums: big.NewInt(200),
+				},
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(2),
+					isBuy:                false,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(150),
+				},
+			},
+			expectedUpdates: []satypes.Update{
+				{
+					SubaccountId: constants.Alice_Num0,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId: uint32(0),
+							// - 300 - 200 + 150
+							BigQuantumsDelta: big.NewInt(-350),
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(200),
+						},
+						{
+							AssetId:          uint32(2),
+							BigQuantumsDelta: big.NewInt(200),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Run many times for determinism.
+			for i := 0; i < 100; i++ {
+				pendingUpdates := types.NewPendingUpdates()
+
+				for _, spotFill := range tt.spotFills {
+					pendingUpdates.AddSpotFill(
+						spotFill.subaccountId,
+						spotFill.baseAssetId,
+						spotFill.isBuy,
+						spotFill.feePpm,
+						spotFill.bigFillBaseQuantums,
+						spotFill.bigFillQuoteQuantums,
+					)
+				}
+
+				updates := pendingUpdates.ConvertToUpdates()
+
+				require.Equal(t, tt.expectedUpdates, updates)
+			}
+		})
+	}
+}
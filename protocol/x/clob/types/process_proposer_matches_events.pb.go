@@ -33,6 +33,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // - Stateful order IDs forcefully removed in the last block.
 // - Conditional order IDs triggered in the last block.
 // - Conditional order IDs placed, but not triggered in the last block.
+// - Long term order IDs that were replaced in the last block.
 // - The height of the block in which the events occurred.
 type ProcessProposerMatchesEvents struct {
 	PlacedLongTermOrderIds                  []OrderId `protobuf:"bytes,1,rep,name=placed_long_term_order_ids,json=placedLongTermOrderIds,proto3" json:"placed_long_term_order_ids"`
@@ -43,6 +44,7 @@ type ProcessProposerMatchesEvents struct {
 	ConditionalOrderIdsTriggeredInLastBlock []OrderId `protobuf:"bytes,6,rep,name=conditional_order_ids_triggered_in_last_block,json=conditionalOrderIdsTriggeredInLastBlock,proto3" json:"conditional_order_ids_triggered_in_last_block"`
 	PlacedConditionalOrderIds               []OrderId `protobuf:"bytes,7,rep,name=placed_conditional_order_ids,json=placedConditionalOrderIds,proto3" json:"placed_conditional_order_ids"`
 	BlockHeight                             uint32    `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ReplacedLongTermOrderIds                []OrderId `protobuf:"bytes,9,rep,name=replaced_long_term_order_ids,json=replacedLongTermOrderIds,proto3" json:"replaced_long_term_order_ids"`
 }
 
 func (m *ProcessProposerMatchesEvents) Reset()         { *m = ProcessProposerMatchesEvents{} }
@@ -134,6 +136,13 @@ func (m *ProcessProposerMatchesEvents) GetBlockHeight() uint32 {
 	return 0
 }
 
+func (m *ProcessProposerMatchesEvents) GetReplacedLongTermOrderIds() []OrderId {
+	if m != nil {
+		return m.ReplacedLongTermOrderIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProcessProposerMatchesEvents)(nil), "dydxprotocol.clob.ProcessProposerMatchesEvents")
 }
@@ -143,36 +152,37 @@ func init() {
 }
 
 var fileDescriptor_4626e94e6961a770 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x36, 0x0a, 0xf2, 0xe0, 0x40, 0x84, 0xa0, 0x44, 0x23, 0x8c, 0x1d, 0x60, 0x97,
-	0x25, 0x12, 0x20, 0xb8, 0x77, 0x02, 0x31, 0x69, 0x88, 0x6a, 0xec, 0x84, 0x40, 0x96, 0x6b, 0xbf,
-	0x25, 0x16, 0x8e, 0x5f, 0x64, 0x7b, 0x55, 0x77, 0xe3, 0x23, 0xf0, 0x09, 0xf8, 0x3c, 0x3b, 0xee,
-	0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0x38, 0x69, 0x95, 0x29, 0x3d, 0xe4, 0x16, 0x3d, 0x3f, 0xff,
-	0x7e, 0xef, 0xfd, 0x23, 0x93, 0x77, 0xe2, 0x52, 0xcc, 0x4b, 0x83, 0x0e, 0x39, 0xaa, 0x94, 0x2b,
-	0x9c, 0xa6, 0xa5, 0x41, 0x0e, 0xd6, 0xd2, 0xd2, 0x60, 0x89, 0x16, 0x0c, 0x2d, 0x98, 0xe3, 0x39,
-	0x58, 0x0a, 0x33, 0xd0, 0xce, 0x26, 0xbe, 0x3b, 0x7c, 0xd0, 0xbe, 0x98, 0x54, 0x17, 0xa3, 0x87,
-	0x19, 0x66, 0xe8, 0x4b, 0x69, 0xf5, 0x55, 0x37, 0x46, 0x4f, 0xbb, 0x06, 0x34, 0x02, 0x4c, 0x7d,
-	0xbc, 0xff, 0x7b, 0x48, 0x76, 0x27, 0xb5, 0x71, 0xd2, 0x08, 0x3f, 0xd5, 0xbe, 0xf7, 0x5e, 0x17,
-	0x7e, 0x23, 0x51, 0xa9, 0x18, 0x07, 0x41, 0x15, 0xea, 0x8c, 0x3a, 0x30, 0x05, 0xf5, 0x00, 0x2a,
-	0x85, 0x1d, 0x05, 0x7b, 0x5b, 0x07, 0x3b, 0xaf, 0xa2, 0xa4, 0x33, 0x4d, 0xf2, 0xb9, 0xea, 0x39,
-	0x16, 0xe3, 0xed, 0xab, 0xbf, 0xcf, 0x06, 0xa7, 0x8f, 0x6a, 0xc6, 0x09, 0xea, 0xec, 0x0c, 0x4c,
-	0xd1, 0x1c, 0xda, 0xf0, 0x3b, 0x89, 0x60, 0x5e, 0x4a, 0x03, 0x82, 0x5a, 0xc7, 0x1c, 0x9c, 0x5f,
-	0xa8, 0x16, 0xfd, 0x56, 0x4f, 0xfa, 0xe3, 0x86, 0xf1, 0xa5, 0x41, 0xac, 0xf1, 0x9c, 0xc4, 0x6b,
-	0x1a, 0x3d, 0x97, 0x4a, 0x81, 0xa0, 0x52, 0x53, 0xc5, 0xac, 0xa3, 0x53, 0x85, 0xfc, 0xc7, 0x68,
-	0xab, 0xa7, 0xe2, 0x09, 0x36, 0xcc, 0x0f, 0x9e, 0x72, 0xac, 0x4f, 0x98, 0x75, 0xe3, 0x0a, 0x11,
-	0x3a, 0xf2, 0xa2, 0x49, 0x68, 0xbd, 0x02, 0x67, 0x9a, 0x83, 0x52, 0xcc, 0x49, 0xd4, 0xad, 0x7d,
-	0xb6, 0x7b, 0xca, 0xf6, 0x6b, 0xde, 0x6a, 0x9d, 0xa3, 0x16, 0xad, 0x9d, 0x9c, 0x81, 0x02, 0x67,
-	0x9b, 0x93, 0xbb, 0xdd, 0x37, 0xb9, 0x86, 0xd1, 0x49, 0xee, 0x67, 0x40, 0x0e, 0x39, 0x6a, 0x21,
-	0x2b, 0x29, 0x6b, 0xa1, 0xa9, 0x33, 0x32, 0xcb, 0xc0, 0x74, 0x92, 0x1c, 0xf6, 0x54, 0xbe, 0x6c,
-	0x61, 0x57, 0xba, 0xb3, 0x15, 0xb3, 0x9d, 0x2b, 0x23, 0xbb, 0x4d, 0xae, 0x1b, 0x07, 0x19, 0xdd,
-	0xe9, 0xfb, 0xeb, 0x6a, 0xca, 0x51, 0x57, 0x1b, 0x3e, 0x27, 0xf7, 0xfc, 0xf0, 0x34, 0x07, 0x99,
-	0xe5, 0x6e, 0x74, 0x77, 0x2f, 0x38, 0xb8, 0x7f, 0xba, 0xe3, 0x6b, 0x1f, 0x7d, 0x69, 0x3c, 0xb9,
-	0x5a, 0xc4, 0xc1, 0xf5, 0x22, 0x0e, 0xfe, 0x2d, 0xe2, 0xe0, 0xd7, 0x32, 0x1e, 0x5c, 0x2f, 0xe3,
-	0xc1, 0x9f, 0x65, 0x3c, 0xf8, 0xfa, 0x36, 0x93, 0x2e, 0xbf, 0x98, 0x26, 0x1c, 0x8b, 0xf4, 0xc6,
-	0x23, 0x9b, 0xbd, 0x39, 0xe4, 0x39, 0x93, 0x3a, 0x5d, 0x57, 0xe6, 0xf5, 0xc3, 0x73, 0x97, 0x25,
-	0xd8, 0xe9, 0xd0, 0x97, 0x5f, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x2c, 0xfa, 0x18, 0x53, 0xfc,
-	0x03, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xb6, 0xb6, 0x3a, 0xd5, 0x83, 0x41, 0x34, 0x86, 0x35, 0xd6, 0x1e, 0xb4, 0x97,
+	0x26, 0xa0, 0xa2, 0xf7, 0x2d, 0x8a, 0x85, 0x8a, 0x4b, 0xed, 0x49, 0x94, 0x71, 0x76, 0xf2, 0x9a,
+	0x0c, 0x4e, 0xe6, 0x85, 0x99, 0xe9, 0xb2, 0xbd, 0xf5, 0x23, 0xf8, 0xb1, 0x7a, 0xec, 0xd1, 0x93,
+	0xc8, 0xee, 0x17, 0x91, 0x4c, 0xb2, 0x4b, 0x24, 0x4b, 0xc9, 0x2d, 0xbc, 0x99, 0xf9, 0xfd, 0xde,
+	0xfb, 0x87, 0x47, 0xde, 0xa5, 0x17, 0xe9, 0xac, 0xd4, 0x68, 0x91, 0xa3, 0x4c, 0xb8, 0xc4, 0x49,
+	0x52, 0x6a, 0xe4, 0x60, 0x0c, 0x2d, 0x35, 0x96, 0x68, 0x40, 0xd3, 0x82, 0x59, 0x9e, 0x83, 0xa1,
+	0x30, 0x05, 0x65, 0x4d, 0xec, 0x6e, 0xfb, 0x0f, 0xda, 0x0f, 0xe3, 0xea, 0x61, 0xf8, 0x30, 0xc3,
+	0x0c, 0x5d, 0x29, 0xa9, 0xbe, 0xea, 0x8b, 0xe1, 0xd3, 0xae, 0x01, 0x75, 0x0a, 0xba, 0x3e, 0xde,
+	0xbb, 0xdc, 0x26, 0xc3, 0x71, 0x6d, 0x1c, 0x37, 0xc2, 0x4f, 0xb5, 0xef, 0xbd, 0xd3, 0xf9, 0xdf,
+	0x48, 0x58, 0x4a, 0xc6, 0x21, 0xa5, 0x12, 0x55, 0x46, 0x2d, 0xe8, 0x82, 0x3a, 0x00, 0x15, 0xa9,
+	0x09, 0xbc, 0xdd, 0x8d, 0xfd, 0x9d, 0x57, 0x61, 0xdc, 0xe9, 0x26, 0xfe, 0x5c, 0xdd, 0x39, 0x4a,
+	0x47, 0x9b, 0x57, 0x7f, 0x9e, 0x0d, 0x4e, 0x1e, 0xd5, 0x8c, 0x63, 0x54, 0xd9, 0x29, 0xe8, 0xa2,
+	0x39, 0x34, 0xfe, 0x77, 0x12, 0xc2, 0xac, 0x14, 0x1a, 0x52, 0x6a, 0x2c, 0xb3, 0x70, 0x76, 0x2e,
+	0x5b, 0xf4, 0x5b, 0x3d, 0xe9, 0x8f, 0x1b, 0xc6, 0x97, 0x06, 0xb1, 0xc2, 0x73, 0x12, 0xad, 0x68,
+	0xf4, 0x4c, 0x48, 0x09, 0x29, 0x15, 0x8a, 0x4a, 0x66, 0x2c, 0x9d, 0x48, 0xe4, 0x3f, 0x83, 0x8d,
+	0x9e, 0x8a, 0x27, 0xd8, 0x30, 0x3f, 0x38, 0xca, 0x91, 0x3a, 0x66, 0xc6, 0x8e, 0x2a, 0x84, 0x6f,
+	0xc9, 0x8b, 0x26, 0xa1, 0xd5, 0x08, 0x9c, 0x29, 0x0e, 0x52, 0x32, 0x2b, 0x50, 0xb5, 0xe6, 0xd9,
+	0xec, 0x29, 0xdb, 0xab, 0x79, 0xcb, 0x71, 0x0e, 0x5b, 0xb4, 0x76, 0x72, 0x1a, 0x0a, 0x9c, 0xae,
+	0x4f, 0xee, 0x76, 0xdf, 0xe4, 0x1a, 0x46, 0x27, 0xb9, 0x4b, 0x8f, 0x1c, 0x70, 0x54, 0xa9, 0xa8,
+	0xa4, 0xac, 0x85, 0xa6, 0x56, 0x8b, 0x2c, 0x03, 0xdd, 0x49, 0x72, 0xab, 0xa7, 0xf2, 0x65, 0x0b,
+	0xbb, 0xd4, 0x9d, 0x2e, 0x99, 0xed, 0x5c, 0x19, 0x19, 0x36, 0xb9, 0xae, 0x6d, 0x24, 0xd8, 0xee,
+	0xfb, 0xeb, 0x6a, 0xca, 0x61, 0x57, 0xeb, 0x3f, 0x27, 0xf7, 0x5c, 0xf3, 0x34, 0x07, 0x91, 0xe5,
+	0x36, 0xb8, 0xb3, 0xeb, 0xed, 0xdf, 0x3f, 0xd9, 0x71, 0xb5, 0x8f, 0xae, 0xe4, 0xff, 0x20, 0x43,
+	0x0d, 0x37, 0x6c, 0xc0, 0xdd, 0x9e, 0x5d, 0x04, 0x1a, 0xd6, 0xef, 0xc0, 0x68, 0x7c, 0x35, 0x8f,
+	0xbc, 0xeb, 0x79, 0xe4, 0xfd, 0x9d, 0x47, 0xde, 0xaf, 0x45, 0x34, 0xb8, 0x5e, 0x44, 0x83, 0xdf,
+	0x8b, 0x68, 0xf0, 0xf5, 0x6d, 0x26, 0x6c, 0x7e, 0x3e, 0x89, 0x39, 0x16, 0xc9, 0x7f, 0x6b, 0x3c,
+	0x7d, 0x73, 0xc0, 0x73, 0x26, 0x54, 0xb2, 0xaa, 0xcc, 0xea, 0xd5, 0xb6, 0x17, 0x25, 0x98, 0xc9,
+	0x96, 0x2b, 0xbf, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x7b, 0x8e, 0x4e, 0x5e, 0x04, 0x00,
+	0x00,
 }
 
 func (m *ProcessProposerMatchesEvents) Marshal() (dAtA []byte, err error) {
@@ -195,6 +205,20 @@ func (m *ProcessProposerMatchesEvents) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReplacedLongTermOrderIds) > 0 {
+		for iNdEx := len(m.ReplacedLongTermOrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplacedLongTermOrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -363,6 +387,12 @@ func (m *ProcessProposerMatchesEvents) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovProcessProposerMatchesEvents(uint64(m.BlockHeight))
 	}
+	if len(m.ReplacedLongTermOrderIds) > 0 {
+		for _, e := range m.ReplacedLongTermOrderIds {
+			l = e.Size()
+			n += 1 + l + sovProcessProposerMatchesEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -658,6 +688,40 @@ func (m *ProcessProposerMatchesEvents) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedLongTermOrderIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessProposerMatchesEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacedLongTermOrderIds = append(m.ReplacedLongTermOrderIds, OrderId{})
+			if err := m.ReplacedLongTermOrderIds[len(m.ReplacedLongTermOrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProcessProposerMatchesEvents(dAtA[iNdEx:])
@@ -11,6 +11,8 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	types1 "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
 	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -630,947 +632,5532 @@ func (m *QueryEquityTierLimitConfigurationResponse) GetEquityTierLimitConfig() E
 	return EquityTierLimitConfiguration{}
 }
 
-func init() {
-	proto.RegisterType((*QueryGetClobPairRequest)(nil), "dydxprotocol.clob.QueryGetClobPairRequest")
-	proto.RegisterType((*QueryClobPairResponse)(nil), "dydxprotocol.clob.QueryClobPairResponse")
-	proto.RegisterType((*QueryAllClobPairRequest)(nil), "dydxprotocol.clob.QueryAllClobPairRequest")
-	proto.RegisterType((*QueryClobPairAllResponse)(nil), "dydxprotocol.clob.QueryClobPairAllResponse")
-	proto.RegisterType((*AreSubaccountsLiquidatableRequest)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableRequest")
-	proto.RegisterType((*AreSubaccountsLiquidatableResponse)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableResponse")
-	proto.RegisterType((*AreSubaccountsLiquidatableResponse_Result)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableResponse.Result")
-	proto.RegisterType((*MevNodeToNodeCalculationRequest)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationRequest")
-	proto.RegisterType((*MevNodeToNodeCalculationResponse)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse")
-	proto.RegisterType((*MevNodeToNodeCalculationResponse_MevAndVolumePerClob)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse.MevAndVolumePerClob")
-	proto.RegisterType((*QueryEquityTierLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationRequest")
-	proto.RegisterType((*QueryEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationResponse")
+// QueryOrderbookDepthRequest is request type for the OrderbookDepth method.
+type QueryOrderbookDepthRequest struct {
+	// The id of the ClobPair to query.
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The maximum number of price levels to return for each side of the
+	// orderbook. All price levels are returned if zero.
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/clob/query.proto", fileDescriptor_3365c195b25c5bc0) }
-
-var fileDescriptor_3365c195b25c5bc0 = []byte{
-	// 926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x25, 0x84, 0xd7, 0x26, 0xc0, 0xb4, 0x29, 0xab, 0x6d, 0xd8, 0x6e, 0x0c, 0x4a,
-	0x93, 0x20, 0x6c, 0x9a, 0x06, 0x0e, 0xa5, 0x42, 0x4a, 0x23, 0x88, 0x2a, 0x35, 0x68, 0xeb, 0x56,
-	0x45, 0x82, 0x4a, 0xd6, 0xd8, 0x1e, 0x9c, 0x11, 0x63, 0xcf, 0xc6, 0x33, 0xb6, 0x12, 0x21, 0x2e,
-	0x88, 0x4b, 0x05, 0x07, 0x24, 0x8e, 0x1c, 0xf9, 0x0f, 0x5c, 0x11, 0xb7, 0x1e, 0x2b, 0x71, 0xe1,
-	0x80, 0x10, 0x4a, 0x38, 0xf3, 0x1b, 0x90, 0xc7, 0xb3, 0xbb, 0x76, 0x76, 0xbd, 0xe9, 0xe6, 0xe2,
-	0xb5, 0x67, 0xbe, 0xf9, 0xde, 0xf7, 0xde, 0xf3, 0xfb, 0xd6, 0xf0, 0x66, 0x70, 0x14, 0x1c, 0xf6,
-	0x12, 0x2e, 0xb9, 0xcf, 0x99, 0xed, 0x33, 0xee, 0xd9, 0x07, 0x29, 0x49, 0x8e, 0x2c, 0xb5, 0x86,
-	0x5e, 0x2f, 0x6f, 0x5b, 0xf9, 0x76, 0xeb, 0x4a, 0xc8, 0x43, 0xae, 0x96, 0xec, 0xfc, 0xae, 0x00,
-	0xb6, 0x96, 0x43, 0xce, 0x43, 0x46, 0x6c, 0xdc, 0xa3, 0x36, 0x8e, 0x63, 0x2e, 0xb1, 0xa4, 0x3c,
-	0x16, 0x7a, 0x77, 0xc3, 0xe7, 0x22, 0xe2, 0xc2, 0xf6, 0xb0, 0x20, 0x05, 0xbf, 0x9d, 0xdd, 0xf4,
-	0x88, 0xc4, 0x37, 0xed, 0x1e, 0x0e, 0x69, 0xac, 0xc0, 0x1a, 0xbb, 0x32, 0xaa, 0x28, 0xbf, 0xb8,
-	0x3d, 0x4c, 0x13, 0x0d, 0x79, 0x6f, 0x14, 0x42, 0x0e, 0x52, 0x2a, 0x8f, 0x5c, 0x49, 0x49, 0xe2,
-	0x32, 0x1a, 0x51, 0xe9, 0xfa, 0x3c, 0xfe, 0x92, 0x86, 0xfa, 0xc4, 0xb5, 0xd1, 0x13, 0x11, 0xc9,
-	0xf4, 0xe6, 0x7a, 0x65, 0x53, 0xa4, 0x1e, 0xf6, 0x7d, 0x9e, 0xc6, 0x52, 0x94, 0xee, 0x0b, 0xa8,
-	0xb9, 0x0e, 0x6f, 0x3c, 0xc8, 0xe5, 0xef, 0x12, 0xb9, 0xc3, 0xb8, 0xd7, 0xc5, 0x34, 0x71, 0xc8,
-	0x41, 0x4a, 0x84, 0x44, 0x8b, 0xd0, 0xa0, 0x41, 0xd3, 0xe8, 0x18, 0x6b, 0x0b, 0x4e, 0x83, 0x06,
-	0xe6, 0x67, 0xb0, 0xa4, 0xa0, 0x43, 0x9c, 0xe8, 0xf1, 0x58, 0x10, 0xf4, 0x11, 0xbc, 0x32, 0x48,
-	0x48, 0xe1, 0x2f, 0x6e, 0x5e, 0xb3, 0x46, 0xea, 0x6c, 0xf5, 0xcf, 0xdd, 0xbd, 0xf0, 0xec, 0xef,
-	0xeb, 0x33, 0xce, 0xbc, 0xaf, 0x9f, 0x4d, 0xac, 0x35, 0x6c, 0x33, 0x76, 0x5a, 0xc3, 0x27, 0x00,
-	0xc3, 0x7a, 0x6a, 0xee, 0x55, 0xab, 0x28, 0xbe, 0x95, 0x17, 0xdf, 0x2a, 0x9a, 0xab, 0x8b, 0x6f,
-	0x75, 0x71, 0x48, 0xf4, 0x59, 0xa7, 0x74, 0xd2, 0xfc, 0xc5, 0x80, 0x66, 0x45, 0xfc, 0x36, 0x63,
-	0x75, 0xfa, 0x67, 0xa7, 0xd4, 0x8f, 0x76, 0x2b, 0x22, 0x1b, 0x4a, 0xe4, 0x8d, 0x33, 0x45, 0x16,
-	0xc1, 0x2b, 0x2a, 0x0f, 0x61, 0x65, 0x3b, 0x21, 0x0f, 0x87, 0xfd, 0xba, 0x4f, 0x0f, 0x52, 0x1a,
-	0x60, 0x89, 0x3d, 0xd6, 0x4f, 0x0b, 0x3d, 0x84, 0xc5, 0x61, 0x17, 0x5d, 0x1a, 0x08, 0x2d, 0x79,
-	0xb5, 0x2a, 0xb9, 0xd4, 0x75, 0x6b, 0xc8, 0x78, 0x2f, 0xd0, 0xea, 0x17, 0x44, 0x69, 0x4d, 0x98,
-	0x4f, 0x1b, 0x60, 0x4e, 0x0a, 0xad, 0x2b, 0xf5, 0x04, 0x5e, 0x4e, 0x88, 0x48, 0x99, 0xec, 0x07,
-	0xbd, 0x33, 0xa6, 0x4e, 0x67, 0xf3, 0x58, 0x8e, 0x22, 0xd1, 0x52, 0xfa, 0x94, 0xad, 0xef, 0x0c,
-	0x98, 0x2b, 0x76, 0xd0, 0x03, 0x58, 0xa8, 0x24, 0x39, 0x68, 0xfd, 0x34, 0x39, 0x5e, 0x2a, 0xe7,
-	0x88, 0x6e, 0xc0, 0xab, 0x54, 0xb8, 0xac, 0x24, 0x47, 0xb5, 0x6a, 0xde, 0x59, 0xa4, 0x15, 0x91,
-	0xe6, 0x5f, 0x06, 0x5c, 0xdf, 0x23, 0xd9, 0xa7, 0x3c, 0x20, 0x8f, 0x78, 0x7e, 0xdd, 0xc1, 0xcc,
-	0x4f, 0x99, 0x6a, 0x51, 0xbf, 0x09, 0x4f, 0xe0, 0xaa, 0xc7, 0xb8, 0xff, 0x95, 0xdb, 0x4b, 0x78,
-	0x8f, 0x0b, 0x92, 0xb8, 0x11, 0x96, 0xfe, 0x3e, 0x11, 0xe3, 0x85, 0xaa, 0xba, 0x3c, 0xc6, 0x2c,
-	0x8f, 0xc1, 0x93, 0x3d, 0x92, 0xed, 0x15, 0x68, 0xe7, 0x8a, 0x62, 0xe9, 0x6a, 0x12, 0xbd, 0x8a,
-	0xbe, 0x80, 0xa5, 0xac, 0x0f, 0x76, 0x23, 0x92, 0xb9, 0x11, 0x91, 0x09, 0xf5, 0xc5, 0xe0, 0xdd,
-	0x1a, 0x25, 0xaf, 0x08, 0xde, 0x2b, 0xe0, 0xce, 0xe5, 0xac, 0x1c, 0xb2, 0x58, 0x34, 0xff, 0x33,
-	0xa0, 0x53, 0x9f, 0x9e, 0x6e, 0x74, 0x78, 0xba, 0xd1, 0xbb, 0x67, 0xc5, 0x1c, 0xc3, 0x92, 0x03,
-	0xb6, 0xe3, 0xe0, 0x31, 0x67, 0x69, 0x44, 0xba, 0x24, 0xc9, 0x07, 0xe8, 0x74, 0xcf, 0x31, 0x5c,
-	0x1e, 0x83, 0x42, 0x1d, 0xb8, 0x34, 0x18, 0x49, 0x77, 0xe0, 0x42, 0xd0, 0x1f, 0xb9, 0x7b, 0x01,
-	0x7a, 0x0d, 0x66, 0x23, 0x92, 0xa9, 0x8a, 0x34, 0x9c, 0xfc, 0x16, 0x5d, 0x85, 0xb9, 0x4c, 0x91,
-	0x34, 0x67, 0x3b, 0xc6, 0xda, 0x05, 0x47, 0x3f, 0x99, 0x1b, 0xb0, 0xa6, 0x46, 0xff, 0x63, 0xe5,
-	0xa8, 0x8f, 0x28, 0x49, 0xee, 0xe7, 0x7e, 0xba, 0xa3, 0xec, 0x34, 0x4d, 0xca, 0x7d, 0x35, 0x7f,
-	0x36, 0x60, 0xfd, 0x05, 0xc0, 0xba, 0x4a, 0x31, 0x34, 0xeb, 0x6c, 0x5a, 0xbf, 0x07, 0xf6, 0x98,
-	0xb2, 0x4d, 0xa2, 0xd6, 0xe5, 0x59, 0x22, 0xe3, 0x30, 0x9b, 0xbf, 0xce, 0xc1, 0x4b, 0x4a, 0x1d,
-	0xfa, 0xde, 0x80, 0xf9, 0xbe, 0x1f, 0xa1, 0x8d, 0x31, 0x41, 0x6a, 0x4c, 0xbd, 0xb5, 0x56, 0x87,
-	0x3d, 0xed, 0xea, 0xe6, 0xfa, 0xb7, 0x7f, 0xfc, 0xfb, 0x53, 0xe3, 0x2d, 0xb4, 0x62, 0x4f, 0xf8,
-	0xff, 0xb2, 0xbf, 0xa6, 0xc1, 0x37, 0xe8, 0x07, 0x03, 0x2e, 0x96, 0x8c, 0xb5, 0x5e, 0xd0, 0xa8,
-	0xc3, 0xb7, 0xde, 0x39, 0x4b, 0x50, 0xc9, 0xa9, 0xcd, 0xb7, 0x95, 0xa6, 0x36, 0x5a, 0x9e, 0xa4,
-	0x09, 0x3d, 0x35, 0xa0, 0x55, 0x6f, 0x42, 0x68, 0x6b, 0x4a, 0xcf, 0x2a, 0x74, 0xbe, 0x7f, 0x2e,
-	0xa7, 0x43, 0xbf, 0x19, 0xd0, 0xac, 0x9b, 0x13, 0xb4, 0x39, 0xd5, 0x50, 0x15, 0x3a, 0x6e, 0x9d,
-	0x63, 0x10, 0xcd, 0xdb, 0xaa, 0x6e, 0x5b, 0xb7, 0x8d, 0x0d, 0xd3, 0xb6, 0xc7, 0x7e, 0x39, 0xb8,
-	0x31, 0x0f, 0x88, 0x2b, 0x79, 0xf1, 0xeb, 0x97, 0x44, 0xfe, 0x6e, 0xc0, 0xf2, 0xa4, 0x57, 0x16,
-	0x7d, 0x58, 0xd7, 0xc1, 0x17, 0x18, 0xb8, 0xd6, 0x9d, 0xf3, 0x1d, 0xd6, 0x79, 0xad, 0xaa, 0xbc,
-	0x3a, 0xa8, 0x6d, 0x4f, 0xfc, 0x80, 0xba, 0xdb, 0x7d, 0x76, 0xdc, 0x36, 0x9e, 0x1f, 0xb7, 0x8d,
-	0x7f, 0x8e, 0xdb, 0xc6, 0x8f, 0x27, 0xed, 0x99, 0xe7, 0x27, 0xed, 0x99, 0x3f, 0x4f, 0xda, 0x33,
-	0x9f, 0x7f, 0x10, 0x52, 0xb9, 0x9f, 0x7a, 0x96, 0xcf, 0xa3, 0x2a, 0x47, 0xb6, 0xf5, 0xae, 0xbf,
-	0x8f, 0x69, 0x6c, 0x0f, 0x56, 0x0e, 0x0b, 0x5e, 0x79, 0xd4, 0x23, 0xc2, 0x9b, 0x53, 0xcb, 0xb7,
-	0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x65, 0x3e, 0x63, 0x6f, 0x0a, 0x00, 0x00,
+func (m *QueryOrderbookDepthRequest) Reset()         { *m = QueryOrderbookDepthRequest{} }
+func (m *QueryOrderbookDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderbookDepthRequest) ProtoMessage()    {}
+func (*QueryOrderbookDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{10}
+}
+func (m *QueryOrderbookDepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderbookDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderbookDepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrderbookDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderbookDepthRequest.Merge(m, src)
+}
+func (m *QueryOrderbookDepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderbookDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderbookDepthRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryOrderbookDepthRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a ClobPair by id.
-	ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error)
-	// Queries a list of ClobPair items.
-	ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error)
-	// Returns whether a subaccount is liquidatable.
-	AreSubaccountsLiquidatable(ctx context.Context, in *AreSubaccountsLiquidatableRequest, opts ...grpc.CallOption) (*AreSubaccountsLiquidatableResponse, error)
-	// Runs the MEV node <> node calculation with the provided parameters.
-	MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error)
-	// Queries EquityTierLimitConfiguration.
-	EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error)
+func (m *QueryOrderbookDepthRequest) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryOrderbookDepthRequest) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// OrderbookLevel is the aggregated size of all orders resting on a price level
+// of the orderbook.
+type OrderbookLevel struct {
+	// The price of the level, in subticks.
+	Subticks uint64 `protobuf:"varint,1,opt,name=subticks,proto3" json:"subticks,omitempty"`
+	// The total remaining size of all orders on the level, in base quantums.
+	Quantums uint64 `protobuf:"varint,2,opt,name=quantums,proto3" json:"quantums,omitempty"`
+	// The number of orders resting on the level.
+	NumOrders uint32 `protobuf:"varint,3,opt,name=num_orders,json=numOrders,proto3" json:"num_orders,omitempty"`
 }
 
-func (c *queryClient) ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error) {
-	out := new(QueryClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OrderbookLevel) Reset()         { *m = OrderbookLevel{} }
+func (m *OrderbookLevel) String() string { return proto.CompactTextString(m) }
+func (*OrderbookLevel) ProtoMessage()    {}
+func (*OrderbookLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{11}
 }
-
-func (c *queryClient) ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error) {
-	out := new(QueryClobPairAllResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderbookLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderbookLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderbookLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OrderbookLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderbookLevel.Merge(m, src)
+}
+func (m *OrderbookLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderbookLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderbookLevel.DiscardUnknown(m)
 }
 
-func (c *queryClient) AreSubaccountsLiquidatable(ctx context.Context, in *AreSubaccountsLiquidatableRequest, opts ...grpc.CallOption) (*AreSubaccountsLiquidatableResponse, error) {
-	out := new(AreSubaccountsLiquidatableResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/AreSubaccountsLiquidatable", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrderbookLevel proto.InternalMessageInfo
+
+func (m *OrderbookLevel) GetSubticks() uint64 {
+	if m != nil {
+		return m.Subticks
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error) {
-	out := new(MevNodeToNodeCalculationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MevNodeToNodeCalculation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderbookLevel) GetQuantums() uint64 {
+	if m != nil {
+		return m.Quantums
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error) {
-	out := new(QueryEquityTierLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/EquityTierLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderbookLevel) GetNumOrders() uint32 {
+	if m != nil {
+		return m.NumOrders
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a ClobPair by id.
-	ClobPair(context.Context, *QueryGetClobPairRequest) (*QueryClobPairResponse, error)
-	// Queries a list of ClobPair items.
-	ClobPairAll(context.Context, *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error)
-	// Returns whether a subaccount is liquidatable.
-	AreSubaccountsLiquidatable(context.Context, *AreSubaccountsLiquidatableRequest) (*AreSubaccountsLiquidatableResponse, error)
-	// Runs the MEV node <> node calculation with the provided parameters.
-	MevNodeToNodeCalculation(context.Context, *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error)
-	// Queries EquityTierLimitConfiguration.
-	EquityTierLimitConfiguration(context.Context, *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error)
+// QueryOrderbookDepthResponse is response type for the OrderbookDepth method.
+type QueryOrderbookDepthResponse struct {
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Bid price levels, sorted from the highest to the lowest price.
+	Bids []OrderbookLevel `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	// Ask price levels, sorted from the lowest to the highest price.
+	Asks []OrderbookLevel `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryOrderbookDepthResponse) Reset()         { *m = QueryOrderbookDepthResponse{} }
+func (m *QueryOrderbookDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderbookDepthResponse) ProtoMessage()    {}
+func (*QueryOrderbookDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{12}
 }
-
-func (*UnimplementedQueryServer) ClobPair(ctx context.Context, req *QueryGetClobPairRequest) (*QueryClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClobPair not implemented")
+func (m *QueryOrderbookDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ClobPairAll(ctx context.Context, req *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClobPairAll not implemented")
+func (m *QueryOrderbookDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderbookDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AreSubaccountsLiquidatable(ctx context.Context, req *AreSubaccountsLiquidatableRequest) (*AreSubaccountsLiquidatableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AreSubaccountsLiquidatable not implemented")
+func (m *QueryOrderbookDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderbookDepthResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) MevNodeToNodeCalculation(ctx context.Context, req *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MevNodeToNodeCalculation not implemented")
+func (m *QueryOrderbookDepthResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EquityTierLimitConfiguration(ctx context.Context, req *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EquityTierLimitConfiguration not implemented")
+func (m *QueryOrderbookDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderbookDepthResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryOrderbookDepthResponse proto.InternalMessageInfo
 
-func _Query_ClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetClobPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/ClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClobPair(ctx, req.(*QueryGetClobPairRequest))
+func (m *QueryOrderbookDepthResponse) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ClobPairAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllClobPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryOrderbookDepthResponse) GetBids() []OrderbookLevel {
+	if m != nil {
+		return m.Bids
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClobPairAll(ctx, in)
+	return nil
+}
+
+func (m *QueryOrderbookDepthResponse) GetAsks() []OrderbookLevel {
+	if m != nil {
+		return m.Asks
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/ClobPairAll",
+	return nil
+}
+
+// QuerySubaccountOpenOrdersRequest is request type for the
+// SubaccountOpenOrders method.
+type QuerySubaccountOpenOrdersRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QuerySubaccountOpenOrdersRequest) Reset()         { *m = QuerySubaccountOpenOrdersRequest{} }
+func (m *QuerySubaccountOpenOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountOpenOrdersRequest) ProtoMessage()    {}
+func (*QuerySubaccountOpenOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{13}
+}
+func (m *QuerySubaccountOpenOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountOpenOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountOpenOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClobPairAll(ctx, req.(*QueryAllClobPairRequest))
+}
+func (m *QuerySubaccountOpenOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountOpenOrdersRequest.Merge(m, src)
+}
+func (m *QuerySubaccountOpenOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountOpenOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountOpenOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountOpenOrdersRequest proto.InternalMessageInfo
+
+func (m *QuerySubaccountOpenOrdersRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AreSubaccountsLiquidatable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AreSubaccountsLiquidatableRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QuerySubaccountOpenOrdersRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AreSubaccountsLiquidatable(ctx, in)
+	return 0
+}
+
+// OpenOrder is an order resting on the orderbook along with its fill state.
+type OpenOrder struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// The fill state of the order in state.
+	FillState OrderFillState `protobuf:"bytes,2,opt,name=fill_state,json=fillState,proto3" json:"fill_state"`
+	// The block height and transaction index at which the order was placed.
+	// Only set for stateful orders.
+	PlacementIndex *TransactionOrdering `protobuf:"bytes,3,opt,name=placement_index,json=placementIndex,proto3" json:"placement_index,omitempty"`
+}
+
+func (m *OpenOrder) Reset()         { *m = OpenOrder{} }
+func (m *OpenOrder) String() string { return proto.CompactTextString(m) }
+func (*OpenOrder) ProtoMessage()    {}
+func (*OpenOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{14}
+}
+func (m *OpenOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/AreSubaccountsLiquidatable",
+}
+func (m *OpenOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenOrder.Merge(m, src)
+}
+func (m *OpenOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenOrder proto.InternalMessageInfo
+
+func (m *OpenOrder) GetOrder() Order {
+	if m != nil {
+		return m.Order
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AreSubaccountsLiquidatable(ctx, req.(*AreSubaccountsLiquidatableRequest))
+	return Order{}
+}
+
+func (m *OpenOrder) GetFillState() OrderFillState {
+	if m != nil {
+		return m.FillState
 	}
-	return interceptor(ctx, in, info, handler)
+	return OrderFillState{}
 }
 
-func _Query_MevNodeToNodeCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MevNodeToNodeCalculationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/MevNodeToNodeCalculation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, req.(*MevNodeToNodeCalculationRequest))
+func (m *OpenOrder) GetPlacementIndex() *TransactionOrdering {
+	if m != nil {
+		return m.PlacementIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEquityTierLimitConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/EquityTierLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, req.(*QueryEquityTierLimitConfigurationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySubaccountOpenOrdersResponse is response type for the
+// SubaccountOpenOrders method.
+type QuerySubaccountOpenOrdersResponse struct {
+	// Open orders of the subaccount, sorted by ClobPair id.
+	OpenOrders []OpenOrder `protobuf:"bytes,1,rep,name=open_orders,json=openOrders,proto3" json:"open_orders"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.clob.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ClobPair",
-			Handler:    _Query_ClobPair_Handler,
-		},
-		{
-			MethodName: "ClobPairAll",
-			Handler:    _Query_ClobPairAll_Handler,
-		},
-		{
-			MethodName: "AreSubaccountsLiquidatable",
-			Handler:    _Query_AreSubaccountsLiquidatable_Handler,
-		},
-		{
-			MethodName: "MevNodeToNodeCalculation",
-			Handler:    _Query_MevNodeToNodeCalculation_Handler,
-		},
-		{
-			MethodName: "EquityTierLimitConfiguration",
-			Handler:    _Query_EquityTierLimitConfiguration_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/clob/query.proto",
+func (m *QuerySubaccountOpenOrdersResponse) Reset()         { *m = QuerySubaccountOpenOrdersResponse{} }
+func (m *QuerySubaccountOpenOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountOpenOrdersResponse) ProtoMessage()    {}
+func (*QuerySubaccountOpenOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{15}
 }
-
-func (m *QueryGetClobPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySubaccountOpenOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountOpenOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountOpenOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySubaccountOpenOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountOpenOrdersResponse.Merge(m, src)
 }
-
-func (m *QueryGetClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySubaccountOpenOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountOpenOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountOpenOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubaccountOpenOrdersResponse proto.InternalMessageInfo
+
+func (m *QuerySubaccountOpenOrdersResponse) GetOpenOrders() []OpenOrder {
+	if m != nil {
+		return m.OpenOrders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderFillStateRequest is request type for the OrderFillState method.
+type QueryOrderFillStateRequest struct {
+	OrderId OrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
 }
 
-func (m *QueryClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryOrderFillStateRequest) Reset()         { *m = QueryOrderFillStateRequest{} }
+func (m *QueryOrderFillStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderFillStateRequest) ProtoMessage()    {}
+func (*QueryOrderFillStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{16}
+}
+func (m *QueryOrderFillStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderFillStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderFillStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryOrderFillStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderFillStateRequest.Merge(m, src)
+}
+func (m *QueryOrderFillStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderFillStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderFillStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllClobPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrderFillStateRequest proto.InternalMessageInfo
+
+func (m *QueryOrderFillStateRequest) GetOrderId() OrderId {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
+	return OrderId{}
 }
 
-func (m *QueryAllClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderFillStateResponse is response type for the OrderFillState method.
+type QueryOrderFillStateResponse struct {
+	// The fill state of the order in state. Empty if the order has not been
+	// filled or its fill state has been pruned.
+	FillState OrderFillState `protobuf:"bytes,1,opt,name=fill_state,json=fillState,proto3" json:"fill_state"`
+	// Whether the order is resting on the in-memory orderbook.
+	Resting bool `protobuf:"varint,2,opt,name=resting,proto3" json:"resting,omitempty"`
+	// The placement of the order in state. Only set for stateful orders which
+	// exist in state.
+	LongTermOrderPlacement *LongTermOrderPlacement `protobuf:"bytes,3,opt,name=long_term_order_placement,json=longTermOrderPlacement,proto3" json:"long_term_order_placement,omitempty"`
 }
 
-func (m *QueryAllClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOrderFillStateResponse) Reset()         { *m = QueryOrderFillStateResponse{} }
+func (m *QueryOrderFillStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderFillStateResponse) ProtoMessage()    {}
+func (*QueryOrderFillStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{17}
+}
+func (m *QueryOrderFillStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderFillStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderFillStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOrderFillStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderFillStateResponse.Merge(m, src)
+}
+func (m *QueryOrderFillStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderFillStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderFillStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClobPairAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrderFillStateResponse proto.InternalMessageInfo
+
+func (m *QueryOrderFillStateResponse) GetFillState() OrderFillState {
+	if m != nil {
+		return m.FillState
 	}
-	return dAtA[:n], nil
+	return OrderFillState{}
 }
 
-func (m *QueryClobPairAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOrderFillStateResponse) GetResting() bool {
+	if m != nil {
+		return m.Resting
+	}
+	return false
 }
 
-func (m *QueryClobPairAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClobPair) > 0 {
-		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryOrderFillStateResponse) GetLongTermOrderPlacement() *LongTermOrderPlacement {
+	if m != nil {
+		return m.LongTermOrderPlacement
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AreSubaccountsLiquidatableRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryInsuranceFundBalancesRequest is request type for the
+// InsuranceFundBalances method.
+type QueryInsuranceFundBalancesRequest struct {
+}
+
+func (m *QueryInsuranceFundBalancesRequest) Reset()         { *m = QueryInsuranceFundBalancesRequest{} }
+func (m *QueryInsuranceFundBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundBalancesRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{18}
+}
+func (m *QueryInsuranceFundBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryInsuranceFundBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundBalancesRequest.Merge(m, src)
+}
+func (m *QueryInsuranceFundBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundBalancesRequest.DiscardUnknown(m)
 }
 
-func (m *AreSubaccountsLiquidatableRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryInsuranceFundBalancesRequest proto.InternalMessageInfo
+
+// InsuranceFundBalance is the balance of an insurance fund along with the
+// perpetuals that it backs.
+type InsuranceFundBalance struct {
+	// The name of the module account of the insurance fund.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address of the module account of the insurance fund. An insurance
+	// fund is funded by sending USDC to this address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Ids of the perpetuals backed by the insurance fund, in ascending order.
+	PerpetualIds []uint32 `protobuf:"varint,3,rep,packed,name=perpetual_ids,json=perpetualIds,proto3" json:"perpetual_ids,omitempty"`
+	// The balance of the insurance fund in quote quantums.
+	Balance github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"balance"`
 }
 
-func (m *AreSubaccountsLiquidatableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SubaccountIds) > 0 {
-		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *InsuranceFundBalance) Reset()         { *m = InsuranceFundBalance{} }
+func (m *InsuranceFundBalance) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundBalance) ProtoMessage()    {}
+func (*InsuranceFundBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{19}
+}
+func (m *InsuranceFundBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AreSubaccountsLiquidatableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *InsuranceFundBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundBalance.Merge(m, src)
+}
+func (m *InsuranceFundBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundBalance.DiscardUnknown(m)
 }
 
-func (m *AreSubaccountsLiquidatableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_InsuranceFundBalance proto.InternalMessageInfo
+
+func (m *InsuranceFundBalance) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *AreSubaccountsLiquidatableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *InsuranceFundBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AreSubaccountsLiquidatableResponse_Result) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InsuranceFundBalance) GetPerpetualIds() []uint32 {
+	if m != nil {
+		return m.PerpetualIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AreSubaccountsLiquidatableResponse_Result) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInsuranceFundBalancesResponse is response type for the
+// InsuranceFundBalances method.
+type QueryInsuranceFundBalancesResponse struct {
+	// The global insurance fund followed by the insurance funds scoped to a
+	// perpetual or liquidity tier.
+	InsuranceFunds []InsuranceFundBalance `protobuf:"bytes,1,rep,name=insurance_funds,json=insuranceFunds,proto3" json:"insurance_funds"`
 }
 
-func (m *AreSubaccountsLiquidatableResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsLiquidatable {
-		i--
-		if m.IsLiquidatable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryInsuranceFundBalancesResponse) Reset()         { *m = QueryInsuranceFundBalancesResponse{} }
+func (m *QueryInsuranceFundBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundBalancesResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{20}
+}
+func (m *QueryInsuranceFundBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryInsuranceFundBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundBalancesResponse.Merge(m, src)
+}
+func (m *QueryInsuranceFundBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundBalancesResponse.DiscardUnknown(m)
 }
 
-func (m *MevNodeToNodeCalculationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInsuranceFundBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundBalancesResponse) GetInsuranceFunds() []InsuranceFundBalance {
+	if m != nil {
+		return m.InsuranceFunds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MevNodeToNodeCalculationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySimulateLiquidationRequest is request type for the SimulateLiquidation
+// method.
+type QuerySimulateLiquidationRequest struct {
+	// The subaccount to simulate.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// Hypothetical changes to the perpetual positions of the subaccount. Each
+	// perpetual may be changed at most once.
+	PerpetualPositionChanges []SimulatedPerpetualPositionChange `protobuf:"bytes,2,rep,name=perpetual_position_changes,json=perpetualPositionChanges,proto3" json:"perpetual_position_changes"`
+	// Hypothetical change to the quote balance of the subaccount in quote
+	// quantums.
+	QuoteQuantumsDelta int64 `protobuf:"zigzag64,3,opt,name=quote_quantums_delta,json=quoteQuantumsDelta,proto3" json:"quote_quantums_delta,omitempty"`
+	// Hypothetical oracle prices of markets. Each market may be priced at most
+	// once.
+	MarketPrices []SimulatedMarketPrice `protobuf:"bytes,4,rep,name=market_prices,json=marketPrices,proto3" json:"market_prices"`
+}
+
+func (m *QuerySimulateLiquidationRequest) Reset()         { *m = QuerySimulateLiquidationRequest{} }
+func (m *QuerySimulateLiquidationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateLiquidationRequest) ProtoMessage()    {}
+func (*QuerySimulateLiquidationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{21}
+}
+func (m *QuerySimulateLiquidationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MevNodeToNodeCalculationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatorMevMetrics != nil {
-		{
-			size, err := m.ValidatorMevMetrics.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockProposerMatches != nil {
-		{
-			size, err := m.BlockProposerMatches.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySimulateLiquidationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateLiquidationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateLiquidationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateLiquidationRequest.Merge(m, src)
+}
+func (m *QuerySimulateLiquidationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateLiquidationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateLiquidationRequest.DiscardUnknown(m)
 }
 
-func (m *MevNodeToNodeCalculationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateLiquidationRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateLiquidationRequest) GetSubaccountId() types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return dAtA[:n], nil
+	return types.SubaccountId{}
 }
 
-func (m *MevNodeToNodeCalculationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateLiquidationRequest) GetPerpetualPositionChanges() []SimulatedPerpetualPositionChange {
+	if m != nil {
+		return m.PerpetualPositionChanges
+	}
+	return nil
 }
 
-func (m *MevNodeToNodeCalculationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QuerySimulateLiquidationRequest) GetQuoteQuantumsDelta() int64 {
+	if m != nil {
+		return m.QuoteQuantumsDelta
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateLiquidationRequest) GetMarketPrices() []SimulatedMarketPrice {
+	if m != nil {
+		return m.MarketPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SimulatedPerpetualPositionChange is a hypothetical change to a perpetual
+// position.
+type SimulatedPerpetualPositionChange struct {
+	// The id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The change to the size of the position in base quantums. Positive for
+	// buys and negative for sells.
+	QuantumsDelta int64 `protobuf:"zigzag64,2,opt,name=quantums_delta,json=quantumsDelta,proto3" json:"quantums_delta,omitempty"`
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Volume != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x18
+func (m *SimulatedPerpetualPositionChange) Reset()         { *m = SimulatedPerpetualPositionChange{} }
+func (m *SimulatedPerpetualPositionChange) String() string { return proto.CompactTextString(m) }
+func (*SimulatedPerpetualPositionChange) ProtoMessage()    {}
+func (*SimulatedPerpetualPositionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{22}
+}
+func (m *SimulatedPerpetualPositionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedPerpetualPositionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedPerpetualPositionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Mev != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mev))))
-		i--
-		dAtA[i] = 0x15
+}
+func (m *SimulatedPerpetualPositionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedPerpetualPositionChange.Merge(m, src)
+}
+func (m *SimulatedPerpetualPositionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedPerpetualPositionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedPerpetualPositionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedPerpetualPositionChange proto.InternalMessageInfo
+
+func (m *SimulatedPerpetualPositionChange) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	if m.ClobPairId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *SimulatedPerpetualPositionChange) GetQuantumsDelta() int64 {
+	if m != nil {
+		return m.QuantumsDelta
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// SimulatedMarketPrice is a hypothetical oracle price of a market.
+type SimulatedMarketPrice struct {
+	// The id of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// The price of the market, in the exponent of the market.
+	Price uint64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulatedMarketPrice) Reset()         { *m = SimulatedMarketPrice{} }
+func (m *SimulatedMarketPrice) String() string { return proto.CompactTextString(m) }
+func (*SimulatedMarketPrice) ProtoMessage()    {}
+func (*SimulatedMarketPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{23}
 }
-
-func (m *QueryEquityTierLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SimulatedMarketPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedMarketPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedMarketPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulatedMarketPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedMarketPrice.Merge(m, src)
+}
+func (m *SimulatedMarketPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedMarketPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedMarketPrice.DiscardUnknown(m)
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SimulatedMarketPrice proto.InternalMessageInfo
+
+func (m *SimulatedMarketPrice) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulatedMarketPrice) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+// QuerySimulateLiquidationResponse is response type for the
+// SimulateLiquidation method.
+type QuerySimulateLiquidationResponse struct {
+	// The net collateral of the subaccount in quote quantums.
+	NetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	// The initial margin requirement of the subaccount in quote quantums.
+	InitialMarginRequirement github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin_requirement"`
+	// The maintenance margin requirement of the subaccount in quote quantums.
+	MaintenanceMarginRequirement github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin_requirement"`
+	// The net collateral in excess of the initial margin requirement in quote
+	// quantums. Negative if the subaccount is undercollateralized.
+	FreeCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=free_collateral,json=freeCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"free_collateral"`
+	// Whether the subaccount is liquidatable.
+	IsLiquidatable bool `protobuf:"varint,5,opt,name=is_liquidatable,json=isLiquidatable,proto3" json:"is_liquidatable,omitempty"`
+	// The simulated perpetual positions of the subaccount, in ascending order of
+	// perpetual id.
+	PerpetualPositions []SimulatedPerpetualPosition `protobuf:"bytes,6,rep,name=perpetual_positions,json=perpetualPositions,proto3" json:"perpetual_positions"`
+}
+
+func (m *QuerySimulateLiquidationResponse) Reset()         { *m = QuerySimulateLiquidationResponse{} }
+func (m *QuerySimulateLiquidationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateLiquidationResponse) ProtoMessage()    {}
+func (*QuerySimulateLiquidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{24}
+}
+func (m *QuerySimulateLiquidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateLiquidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateLiquidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateLiquidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateLiquidationResponse.Merge(m, src)
+}
+func (m *QuerySimulateLiquidationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateLiquidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateLiquidationResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QuerySimulateLiquidationResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateLiquidationResponse) GetIsLiquidatable() bool {
+	if m != nil {
+		return m.IsLiquidatable
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *QueryGetClobPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+
+func (m *QuerySimulateLiquidationResponse) GetPerpetualPositions() []SimulatedPerpetualPosition {
+	if m != nil {
+		return m.PerpetualPositions
 	}
-	return n
+	return nil
 }
 
-func (m *QueryClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// SimulatedPerpetualPosition is a simulated perpetual position along with the
+// prices at which it would be liquidated and closed. Prices are zero if they
+// do not exist for the position.
+type SimulatedPerpetualPosition struct {
+	// The id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The size of the position in base quantums.
+	Quantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums"`
+	// The oracle price in subticks at which the subaccount becomes
+	// liquidatable, assuming the prices of all other markets are unchanged.
+	LiquidationPriceSubticks uint64 `protobuf:"varint,3,opt,name=liquidation_price_subticks,json=liquidationPriceSubticks,proto3" json:"liquidation_price_subticks,omitempty"`
+	// The price in subticks at which closing the position would leave the
+	// subaccount with zero net collateral.
+	BankruptcyPriceSubticks uint64 `protobuf:"varint,4,opt,name=bankruptcy_price_subticks,json=bankruptcyPriceSubticks,proto3" json:"bankruptcy_price_subticks,omitempty"`
+	// The worst price in subticks at which a liquidation order closing the
+	// position may be filled at the simulated oracle prices.
+	FillablePriceSubticks uint64 `protobuf:"varint,5,opt,name=fillable_price_subticks,json=fillablePriceSubticks,proto3" json:"fillable_price_subticks,omitempty"`
+	// The change in the insurance fund balance in quote quantums if the
+	// position was liquidated at the fillable price. Positive values are the
+	// liquidation fee paid by the subaccount and negative values are paid out
+	// by the insurance fund.
+	InsuranceFundDelta github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,6,opt,name=insurance_fund_delta,json=insuranceFundDelta,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"insurance_fund_delta"`
+}
+
+func (m *SimulatedPerpetualPosition) Reset()         { *m = SimulatedPerpetualPosition{} }
+func (m *SimulatedPerpetualPosition) String() string { return proto.CompactTextString(m) }
+func (*SimulatedPerpetualPosition) ProtoMessage()    {}
+func (*SimulatedPerpetualPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{25}
+}
+func (m *SimulatedPerpetualPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedPerpetualPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedPerpetualPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.ClobPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *SimulatedPerpetualPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedPerpetualPosition.Merge(m, src)
+}
+func (m *SimulatedPerpetualPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedPerpetualPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedPerpetualPosition.DiscardUnknown(m)
 }
 
-func (m *QueryAllClobPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_SimulatedPerpetualPosition proto.InternalMessageInfo
+
+func (m *SimulatedPerpetualPosition) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryClobPairAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClobPair) > 0 {
-		for _, e := range m.ClobPair {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *SimulatedPerpetualPosition) GetLiquidationPriceSubticks() uint64 {
+	if m != nil {
+		return m.LiquidationPriceSubticks
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *SimulatedPerpetualPosition) GetBankruptcyPriceSubticks() uint64 {
+	if m != nil {
+		return m.BankruptcyPriceSubticks
 	}
-	return n
+	return 0
 }
 
-func (m *AreSubaccountsLiquidatableRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SimulatedPerpetualPosition) GetFillablePriceSubticks() uint64 {
+	if m != nil {
+		return m.FillablePriceSubticks
 	}
-	var l int
-	_ = l
-	if len(m.SubaccountIds) > 0 {
-		for _, e := range m.SubaccountIds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// StreamOrderbookUpdatesRequest is a request message for the
+// StreamOrderbookUpdates method.
+type StreamOrderbookUpdatesRequest struct {
+	// Clob pair ids to stream orderbook updates for.
+	ClobPairId []uint32 `protobuf:"varint,1,rep,packed,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+}
+
+func (m *StreamOrderbookUpdatesRequest) Reset()         { *m = StreamOrderbookUpdatesRequest{} }
+func (m *StreamOrderbookUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookUpdatesRequest) ProtoMessage()    {}
+func (*StreamOrderbookUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{26}
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookUpdatesRequest.Merge(m, src)
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookUpdatesRequest.DiscardUnknown(m)
 }
 
-func (m *AreSubaccountsLiquidatableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_StreamOrderbookUpdatesRequest proto.InternalMessageInfo
+
+func (m *StreamOrderbookUpdatesRequest) GetClobPairId() []uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// StreamOrderbookUpdatesResponse is a response message for the
+// StreamOrderbookUpdates method.
+type StreamOrderbookUpdatesResponse struct {
+	// Orderbook updates for the clob pairs. Each update is an order placement,
+	// fill amount update, replacement or removal of an order on the orderbook.
+	Updates []types1.OffChainUpdateV1 `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+	// Snapshot indicates if the response is a snapshot of the orderbooks. A
+	// snapshot contains an order placement and fill amount update for every
+	// order resting on the orderbooks, from which both the L3 (per-order) and
+	// L2 (per-price level) orderbooks can be constructed. Clients should reset
+	// their local orderbooks when a snapshot is received.
+	Snapshot bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// Aggregated price levels of each subscribed orderbook. Only set on the
+	// initial snapshot of a subscription, for clients which only maintain an L2
+	// orderbook.
+	L2Snapshots []OrderbookL2Snapshot `protobuf:"bytes,3,rep,name=l2_snapshots,json=l2Snapshots,proto3" json:"l2_snapshots"`
+}
+
+func (m *StreamOrderbookUpdatesResponse) Reset()         { *m = StreamOrderbookUpdatesResponse{} }
+func (m *StreamOrderbookUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookUpdatesResponse) ProtoMessage()    {}
+func (*StreamOrderbookUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{27}
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookUpdatesResponse.Merge(m, src)
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookUpdatesResponse.DiscardUnknown(m)
 }
 
-func (m *AreSubaccountsLiquidatableResponse_Result) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SubaccountId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.IsLiquidatable {
-		n += 2
+var xxx_messageInfo_StreamOrderbookUpdatesResponse proto.InternalMessageInfo
+
+func (m *StreamOrderbookUpdatesResponse) GetUpdates() []types1.OffChainUpdateV1 {
+	if m != nil {
+		return m.Updates
 	}
-	return n
+	return nil
 }
 
-func (m *MevNodeToNodeCalculationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockProposerMatches != nil {
-		l = m.BlockProposerMatches.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ValidatorMevMetrics != nil {
-		l = m.ValidatorMevMetrics.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *StreamOrderbookUpdatesResponse) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
 	}
-	return n
+	return false
 }
 
-func (m *MevNodeToNodeCalculationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *StreamOrderbookUpdatesResponse) GetL2Snapshots() []OrderbookL2Snapshot {
+	if m != nil {
+		return m.L2Snapshots
 	}
-	return n
+	return nil
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Size() (n int) {
-	if m == nil {
-		return 0
+// OrderbookL2Snapshot is a snapshot of the price levels of an orderbook,
+// aggregated by price.
+type OrderbookL2Snapshot struct {
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Bid price levels, sorted from the highest to the lowest price.
+	Bids []OrderbookLevel `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	// Ask price levels, sorted from the lowest to the highest price.
+	Asks []OrderbookLevel `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks"`
+}
+
+func (m *OrderbookL2Snapshot) Reset()         { *m = OrderbookL2Snapshot{} }
+func (m *OrderbookL2Snapshot) String() string { return proto.CompactTextString(m) }
+func (*OrderbookL2Snapshot) ProtoMessage()    {}
+func (*OrderbookL2Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{28}
+}
+func (m *OrderbookL2Snapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderbookL2Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderbookL2Snapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.ClobPairId != 0 {
-		n += 1 + sovQuery(uint64(m.ClobPairId))
+}
+func (m *OrderbookL2Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderbookL2Snapshot.Merge(m, src)
+}
+func (m *OrderbookL2Snapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderbookL2Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderbookL2Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderbookL2Snapshot proto.InternalMessageInfo
+
+func (m *OrderbookL2Snapshot) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	if m.Mev != 0 {
-		n += 5
+	return 0
+}
+
+func (m *OrderbookL2Snapshot) GetBids() []OrderbookLevel {
+	if m != nil {
+		return m.Bids
 	}
-	if m.Volume != 0 {
-		n += 1 + sovQuery(uint64(m.Volume))
+	return nil
+}
+
+func (m *OrderbookL2Snapshot) GetAsks() []OrderbookLevel {
+	if m != nil {
+		return m.Asks
 	}
-	return n
+	return nil
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryGetClobPairRequest)(nil), "dydxprotocol.clob.QueryGetClobPairRequest")
+	proto.RegisterType((*QueryClobPairResponse)(nil), "dydxprotocol.clob.QueryClobPairResponse")
+	proto.RegisterType((*QueryAllClobPairRequest)(nil), "dydxprotocol.clob.QueryAllClobPairRequest")
+	proto.RegisterType((*QueryClobPairAllResponse)(nil), "dydxprotocol.clob.QueryClobPairAllResponse")
+	proto.RegisterType((*AreSubaccountsLiquidatableRequest)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableRequest")
+	proto.RegisterType((*AreSubaccountsLiquidatableResponse)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableResponse")
+	proto.RegisterType((*AreSubaccountsLiquidatableResponse_Result)(nil), "dydxprotocol.clob.AreSubaccountsLiquidatableResponse.Result")
+	proto.RegisterType((*MevNodeToNodeCalculationRequest)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationRequest")
+	proto.RegisterType((*MevNodeToNodeCalculationResponse)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse")
+	proto.RegisterType((*MevNodeToNodeCalculationResponse_MevAndVolumePerClob)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse.MevAndVolumePerClob")
+	proto.RegisterType((*QueryEquityTierLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationRequest")
+	proto.RegisterType((*QueryEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationResponse")
+	proto.RegisterType((*QueryOrderbookDepthRequest)(nil), "dydxprotocol.clob.QueryOrderbookDepthRequest")
+	proto.RegisterType((*OrderbookLevel)(nil), "dydxprotocol.clob.OrderbookLevel")
+	proto.RegisterType((*QueryOrderbookDepthResponse)(nil), "dydxprotocol.clob.QueryOrderbookDepthResponse")
+	proto.RegisterType((*QuerySubaccountOpenOrdersRequest)(nil), "dydxprotocol.clob.QuerySubaccountOpenOrdersRequest")
+	proto.RegisterType((*OpenOrder)(nil), "dydxprotocol.clob.OpenOrder")
+	proto.RegisterType((*QuerySubaccountOpenOrdersResponse)(nil), "dydxprotocol.clob.QuerySubaccountOpenOrdersResponse")
+	proto.RegisterType((*QueryOrderFillStateRequest)(nil), "dydxprotocol.clob.QueryOrderFillStateRequest")
+	proto.RegisterType((*QueryOrderFillStateResponse)(nil), "dydxprotocol.clob.QueryOrderFillStateResponse")
+	proto.RegisterType((*QueryInsuranceFundBalancesRequest)(nil), "dydxprotocol.clob.QueryInsuranceFundBalancesRequest")
+	proto.RegisterType((*InsuranceFundBalance)(nil), "dydxprotocol.clob.InsuranceFundBalance")
+	proto.RegisterType((*QueryInsuranceFundBalancesResponse)(nil), "dydxprotocol.clob.QueryInsuranceFundBalancesResponse")
+	proto.RegisterType((*QuerySimulateLiquidationRequest)(nil), "dydxprotocol.clob.QuerySimulateLiquidationRequest")
+	proto.RegisterType((*SimulatedPerpetualPositionChange)(nil), "dydxprotocol.clob.SimulatedPerpetualPositionChange")
+	proto.RegisterType((*SimulatedMarketPrice)(nil), "dydxprotocol.clob.SimulatedMarketPrice")
+	proto.RegisterType((*QuerySimulateLiquidationResponse)(nil), "dydxprotocol.clob.QuerySimulateLiquidationResponse")
+	proto.RegisterType((*SimulatedPerpetualPosition)(nil), "dydxprotocol.clob.SimulatedPerpetualPosition")
+	proto.RegisterType((*StreamOrderbookUpdatesRequest)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesRequest")
+	proto.RegisterType((*StreamOrderbookUpdatesResponse)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesResponse")
+	proto.RegisterType((*OrderbookL2Snapshot)(nil), "dydxprotocol.clob.OrderbookL2Snapshot")
+}
+
+func init() { proto.RegisterFile("dydxprotocol/clob/query.proto", fileDescriptor_3365c195b25c5bc0) }
+
+var fileDescriptor_3365c195b25c5bc0 = []byte{
+	// 2207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdb, 0x6f, 0x1c, 0x49,
+	0xd5, 0x4f, 0xfb, 0x92, 0xd8, 0x27, 0x9e, 0xc9, 0xf7, 0x55, 0x9c, 0x64, 0xb6, 0x93, 0x4c, 0xec,
+	0x0e, 0xe4, 0x06, 0x99, 0x8e, 0xed, 0xec, 0x02, 0x49, 0xb4, 0x22, 0xf1, 0x92, 0x60, 0x29, 0x26,
+	0x93, 0x76, 0x08, 0xb7, 0x95, 0x5a, 0x35, 0xdd, 0xe5, 0x71, 0xc9, 0xdd, 0x5d, 0xed, 0xbe, 0x4c,
+	0xe2, 0x0d, 0xd6, 0x4a, 0x68, 0x85, 0xb4, 0x02, 0x21, 0x24, 0x1e, 0x79, 0xe4, 0x8d, 0x17, 0x04,
+	0x4f, 0xc0, 0x0b, 0xe2, 0x6d, 0x5f, 0x90, 0x56, 0xda, 0x17, 0xb4, 0x42, 0x2b, 0x94, 0x80, 0x78,
+	0x43, 0x48, 0xfc, 0x03, 0xa8, 0xaa, 0xab, 0x7b, 0xba, 0x67, 0xba, 0xc7, 0x9e, 0xc8, 0x48, 0xbc,
+	0xd8, 0x5d, 0x55, 0xe7, 0x9c, 0xfa, 0x9d, 0x4b, 0x9d, 0x3a, 0x75, 0x06, 0xce, 0xdb, 0xbb, 0xf6,
+	0x73, 0x3f, 0x60, 0x11, 0xb3, 0x98, 0xa3, 0x5b, 0x0e, 0xeb, 0xe8, 0x3b, 0x31, 0x09, 0x76, 0x5b,
+	0x62, 0x0e, 0xfd, 0x7f, 0x7e, 0xb9, 0xc5, 0x97, 0xd5, 0xf9, 0x2e, 0xeb, 0x32, 0x31, 0xa5, 0xf3,
+	0xaf, 0x84, 0x50, 0x3d, 0xd7, 0x65, 0xac, 0xeb, 0x10, 0x1d, 0xfb, 0x54, 0xc7, 0x9e, 0xc7, 0x22,
+	0x1c, 0x51, 0xe6, 0x85, 0x72, 0xf5, 0x9a, 0xc5, 0x42, 0x97, 0x85, 0x7a, 0x07, 0x87, 0x24, 0x91,
+	0xaf, 0xf7, 0x96, 0x3a, 0x24, 0xc2, 0x4b, 0xba, 0x8f, 0xbb, 0xd4, 0x13, 0xc4, 0x92, 0x76, 0x71,
+	0x18, 0x11, 0xff, 0x63, 0xfa, 0x98, 0x06, 0x92, 0xe4, 0xc6, 0x30, 0x09, 0xd9, 0x89, 0x69, 0xb4,
+	0x6b, 0x46, 0x94, 0x04, 0xa6, 0x43, 0x5d, 0x1a, 0x99, 0x16, 0xf3, 0x36, 0x69, 0x57, 0x72, 0x9c,
+	0x1d, 0xe6, 0x70, 0x49, 0x4f, 0x2e, 0x96, 0xd8, 0x80, 0x05, 0x36, 0x49, 0x77, 0x7b, 0xbb, 0xb0,
+	0x4c, 0x3d, 0x9b, 0x3c, 0x27, 0x81, 0xce, 0x36, 0x37, 0x4d, 0x6b, 0x0b, 0x53, 0xcf, 0x8c, 0x7d,
+	0x1b, 0x47, 0x24, 0x1c, 0x9e, 0x91, 0xfc, 0x57, 0x0b, 0xfc, 0x61, 0xdc, 0xc1, 0x96, 0xc5, 0x62,
+	0x2f, 0x0a, 0x73, 0xdf, 0x09, 0xa9, 0x76, 0x15, 0xce, 0x3c, 0xe6, 0xd6, 0x79, 0x40, 0xa2, 0x55,
+	0x87, 0x75, 0xda, 0x98, 0x06, 0x06, 0xd9, 0x89, 0x49, 0x18, 0xa1, 0x3a, 0x4c, 0x50, 0xbb, 0xa1,
+	0x2c, 0x28, 0x57, 0x6a, 0xc6, 0x04, 0xb5, 0xb5, 0x6f, 0xc1, 0x29, 0x41, 0xda, 0xa7, 0x0b, 0x7d,
+	0xe6, 0x85, 0x04, 0xbd, 0x0d, 0xb3, 0x99, 0xbd, 0x04, 0xfd, 0xf1, 0xe5, 0xb3, 0xad, 0x21, 0x37,
+	0xb6, 0x52, 0xbe, 0x7b, 0x53, 0x1f, 0x7d, 0x76, 0xe1, 0x88, 0x31, 0x63, 0xc9, 0xb1, 0x86, 0x25,
+	0x86, 0xbb, 0x8e, 0x33, 0x88, 0xe1, 0x3e, 0x40, 0xdf, 0x5d, 0x52, 0xf6, 0xa5, 0x56, 0xe2, 0xdb,
+	0x16, 0xf7, 0x6d, 0x2b, 0x89, 0x1d, 0xe9, 0xdb, 0x56, 0x1b, 0x77, 0x89, 0xe4, 0x35, 0x72, 0x9c,
+	0xda, 0x2f, 0x14, 0x68, 0x14, 0xc0, 0xdf, 0x75, 0x9c, 0x2a, 0xfc, 0x93, 0x63, 0xe2, 0x47, 0x0f,
+	0x0a, 0x20, 0x27, 0x04, 0xc8, 0xcb, 0xfb, 0x82, 0x4c, 0x36, 0x2f, 0xa0, 0x7c, 0x0e, 0x8b, 0x77,
+	0x03, 0xb2, 0xd1, 0xf7, 0xd7, 0x43, 0xba, 0x13, 0x53, 0x1b, 0x47, 0xb8, 0xe3, 0xa4, 0x6a, 0xa1,
+	0x0d, 0xa8, 0xf7, 0xbd, 0x68, 0x52, 0x3b, 0x94, 0x90, 0x2f, 0x15, 0x21, 0xe7, 0xbc, 0xde, 0xea,
+	0x4b, 0x5c, 0xb3, 0x25, 0xfa, 0x5a, 0x98, 0x9b, 0x0b, 0xb5, 0x0f, 0x27, 0x40, 0x1b, 0xb5, 0xb5,
+	0xb4, 0xd4, 0xbb, 0x70, 0x2c, 0x20, 0x61, 0xec, 0x44, 0xe9, 0xa6, 0x77, 0x4a, 0xec, 0xb4, 0xbf,
+	0x9c, 0x96, 0x21, 0x84, 0x48, 0x28, 0xa9, 0x48, 0xf5, 0x03, 0x05, 0x8e, 0x26, 0x2b, 0xe8, 0x31,
+	0xd4, 0x0a, 0x4a, 0x66, 0xae, 0x1f, 0x47, 0xc7, 0xb9, 0xbc, 0x8e, 0xe8, 0x32, 0x9c, 0xa0, 0xa1,
+	0xe9, 0xe4, 0xe0, 0x08, 0x57, 0xcd, 0x18, 0x75, 0x5a, 0x00, 0xa9, 0xfd, 0x45, 0x81, 0x0b, 0xeb,
+	0xa4, 0xf7, 0x0d, 0x66, 0x93, 0x27, 0x8c, 0xff, 0x5d, 0xc5, 0x8e, 0x15, 0x3b, 0xc2, 0x45, 0xa9,
+	0x13, 0xde, 0x85, 0xd3, 0x1d, 0x87, 0x59, 0xdb, 0xa6, 0x1f, 0x30, 0x9f, 0x85, 0x24, 0x30, 0x5d,
+	0x1c, 0x59, 0x5b, 0x24, 0x2c, 0x07, 0x2a, 0xec, 0xf2, 0x14, 0x3b, 0x7c, 0x0f, 0x16, 0xac, 0x93,
+	0xde, 0x7a, 0x42, 0x6d, 0xcc, 0x0b, 0x29, 0x6d, 0x29, 0x44, 0xce, 0xa2, 0xef, 0xc1, 0xa9, 0x5e,
+	0x4a, 0x6c, 0xba, 0xa4, 0x67, 0xba, 0x24, 0x0a, 0xa8, 0x15, 0x66, 0xb1, 0x35, 0x2c, 0xbc, 0x00,
+	0x78, 0x3d, 0x21, 0x37, 0x4e, 0xf6, 0xf2, 0x5b, 0x26, 0x93, 0xda, 0x3f, 0x15, 0x58, 0xa8, 0x56,
+	0x4f, 0x3a, 0xba, 0x3b, 0xe8, 0xe8, 0x07, 0xfb, 0xed, 0x59, 0x22, 0x85, 0x13, 0xdc, 0xf5, 0xec,
+	0xa7, 0xcc, 0x89, 0x5d, 0xd2, 0x26, 0x01, 0x3f, 0x40, 0x83, 0x3e, 0xc7, 0x70, 0xb2, 0x84, 0x0a,
+	0x2d, 0xc0, 0x5c, 0x76, 0x24, 0xcd, 0x2c, 0x0b, 0x41, 0x7a, 0xe4, 0xd6, 0x6c, 0xf4, 0x7f, 0x30,
+	0xe9, 0x92, 0x9e, 0xb0, 0xc8, 0x84, 0xc1, 0x3f, 0xd1, 0x69, 0x38, 0xda, 0x13, 0x42, 0x1a, 0x93,
+	0x0b, 0xca, 0x95, 0x29, 0x43, 0x8e, 0xb4, 0x6b, 0x70, 0x45, 0x1c, 0xfd, 0xaf, 0x89, 0x84, 0xfd,
+	0x84, 0x92, 0xe0, 0x21, 0x4f, 0xd7, 0xab, 0x22, 0x5b, 0xc7, 0x41, 0xde, 0xaf, 0xda, 0xcf, 0x15,
+	0xb8, 0x7a, 0x00, 0x62, 0x69, 0x25, 0x0f, 0x1a, 0x55, 0xb7, 0x80, 0x8c, 0x03, 0xbd, 0xc4, 0x6c,
+	0xa3, 0x44, 0x4b, 0xf3, 0x9c, 0x22, 0x65, 0x34, 0xda, 0x13, 0x50, 0x05, 0xb8, 0x47, 0x81, 0x4d,
+	0x82, 0x0e, 0x63, 0xdb, 0xef, 0x10, 0x3f, 0xda, 0x4a, 0x63, 0x72, 0x7f, 0x9b, 0xcd, 0xc3, 0xb4,
+	0xcd, 0x39, 0x84, 0xd5, 0x6a, 0x46, 0x32, 0xd0, 0xba, 0x50, 0xcf, 0x04, 0x3e, 0x24, 0x3d, 0xe2,
+	0x20, 0x15, 0x66, 0xc2, 0xb8, 0x13, 0x51, 0x6b, 0x3b, 0x89, 0xe7, 0x29, 0x23, 0x1b, 0xf3, 0xb5,
+	0x9d, 0x18, 0x7b, 0x51, 0xec, 0x26, 0xe1, 0x38, 0x65, 0x64, 0x63, 0x74, 0x1e, 0xc0, 0x8b, 0x5d,
+	0x53, 0x5c, 0x65, 0xa1, 0xf0, 0x42, 0xcd, 0x98, 0xf5, 0x62, 0x57, 0x88, 0x0f, 0xb5, 0xdf, 0x29,
+	0x70, 0xb6, 0x14, 0xbf, 0x34, 0xe7, 0xfe, 0x0a, 0xdc, 0x86, 0xa9, 0x0e, 0xcf, 0x78, 0x13, 0x22,
+	0x26, 0x17, 0x4b, 0x8c, 0x5b, 0xd4, 0x44, 0x9a, 0x53, 0x30, 0x71, 0x66, 0x1c, 0x6e, 0x73, 0x5c,
+	0xe3, 0x31, 0x73, 0x26, 0xad, 0x0d, 0x0b, 0x02, 0x7a, 0x3f, 0xcd, 0x3c, 0xf2, 0x89, 0x97, 0x28,
+	0x96, 0x3a, 0x60, 0x1e, 0xa6, 0xd9, 0x33, 0x8f, 0x24, 0x77, 0xe0, 0xac, 0x91, 0x0c, 0x78, 0x58,
+	0x7a, 0xb1, 0xdb, 0x21, 0x81, 0xb4, 0xba, 0x1c, 0x69, 0x9f, 0x2a, 0x30, 0x9b, 0xc9, 0x40, 0x37,
+	0x61, 0x5a, 0x98, 0x4d, 0xc6, 0x4d, 0xa3, 0x0a, 0x9d, 0x04, 0x95, 0x10, 0xf3, 0xeb, 0x71, 0x93,
+	0x3a, 0x8e, 0x19, 0x46, 0x38, 0x22, 0x32, 0x3b, 0x54, 0x2a, 0x76, 0x9f, 0x3a, 0xce, 0x06, 0x27,
+	0x94, 0x32, 0x66, 0x37, 0xd3, 0x09, 0xf4, 0x08, 0x4e, 0xf8, 0x0e, 0xb6, 0x88, 0x4b, 0x78, 0xb6,
+	0xe5, 0xf5, 0x46, 0x63, 0xb2, 0x32, 0x8f, 0x3d, 0x09, 0xb0, 0x17, 0x62, 0x8b, 0x87, 0xab, 0x90,
+	0x4b, 0xbd, 0xae, 0x51, 0xcf, 0xd8, 0xd7, 0x38, 0xb7, 0xb6, 0x05, 0x8b, 0x23, 0xcc, 0x25, 0xfd,
+	0xbd, 0x0a, 0xc7, 0x99, 0x4f, 0xbc, 0x34, 0x5e, 0x92, 0x44, 0x73, 0xae, 0x0c, 0x7e, 0xca, 0x2b,
+	0x91, 0x03, 0xcb, 0x84, 0x69, 0xdf, 0xc9, 0x9f, 0x89, 0x4c, 0xc5, 0xd4, 0x25, 0xb7, 0x61, 0x46,
+	0x48, 0xef, 0x5f, 0x21, 0x6a, 0x95, 0x79, 0xb2, 0x6b, 0xe3, 0x18, 0x4b, 0x86, 0xda, 0xdf, 0x0b,
+	0xf1, 0x9a, 0x93, 0x2d, 0xf1, 0x17, 0xad, 0xaf, 0xbc, 0xb6, 0xf5, 0x1b, 0x22, 0xd9, 0x46, 0xd4,
+	0xeb, 0xca, 0x1b, 0x29, 0x1d, 0x22, 0x1b, 0xde, 0x70, 0x98, 0xd7, 0x35, 0x23, 0x12, 0xc8, 0x63,
+	0x65, 0x66, 0x86, 0x96, 0x1e, 0xba, 0x5a, 0xb2, 0xe1, 0x43, 0xe6, 0x75, 0x9f, 0x90, 0x20, 0x39,
+	0x77, 0xed, 0x94, 0xc1, 0x38, 0xed, 0x94, 0xce, 0x6b, 0x17, 0xa5, 0xb3, 0xd6, 0xbc, 0x30, 0x0e,
+	0xb0, 0x67, 0x91, 0xfb, 0xb1, 0x67, 0xdf, 0xc3, 0x0e, 0xff, 0x4c, 0x83, 0x5b, 0xfb, 0x93, 0x02,
+	0xf3, 0x65, 0x04, 0x08, 0xc1, 0x94, 0x87, 0x5d, 0x22, 0x83, 0x5e, 0x7c, 0x73, 0x8d, 0xb0, 0x6d,
+	0x07, 0x24, 0x4c, 0x72, 0xc4, 0xac, 0x91, 0x0e, 0xd1, 0x45, 0xa8, 0xf9, 0x24, 0xf0, 0x49, 0x14,
+	0x63, 0x47, 0x14, 0x2f, 0xfc, 0x34, 0xd6, 0x8c, 0xb9, 0x6c, 0x72, 0xcd, 0x0e, 0x51, 0x07, 0x8e,
+	0x75, 0x12, 0xe9, 0x8d, 0xa9, 0x05, 0xe5, 0xca, 0xdc, 0xbd, 0xaf, 0x73, 0x93, 0x7d, 0xfa, 0xd9,
+	0x85, 0xaf, 0x76, 0x69, 0xb4, 0x15, 0x77, 0x5a, 0x16, 0x73, 0xf5, 0x42, 0x8d, 0xdb, 0xbb, 0x79,
+	0x5d, 0x14, 0xc2, 0x7a, 0x36, 0x63, 0x47, 0xbb, 0x3e, 0x09, 0x5b, 0x1b, 0x24, 0xa0, 0xd8, 0xa1,
+	0xef, 0xf1, 0x9b, 0x7d, 0xcd, 0x8b, 0x8c, 0x54, 0xb0, 0xf6, 0x7d, 0xd0, 0x46, 0x29, 0x2d, 0x5d,
+	0xfc, 0x14, 0x4e, 0xd0, 0x94, 0xc0, 0xdc, 0x8c, 0xbd, 0xac, 0xda, 0x2a, 0xbb, 0x83, 0xcb, 0x44,
+	0x49, 0x6f, 0xd7, 0x69, 0x7e, 0x2d, 0xd4, 0xfe, 0x3d, 0x01, 0x17, 0x92, 0x03, 0x42, 0x5d, 0x7e,
+	0x6b, 0x92, 0xb4, 0x02, 0xc9, 0xd5, 0x18, 0xff, 0x85, 0x1a, 0xe8, 0x19, 0xa8, 0x7d, 0xeb, 0xfb,
+	0x2c, 0xa4, 0x7c, 0x3f, 0xfe, 0x84, 0xf0, 0xba, 0x24, 0xcd, 0xaa, 0x2b, 0x25, 0x9a, 0xa5, 0x28,
+	0xed, 0x76, 0xca, 0xdd, 0x96, 0xcc, 0xab, 0x82, 0x57, 0x6e, 0xd6, 0xf0, 0xcb, 0x97, 0x43, 0x74,
+	0x03, 0xe6, 0x77, 0x62, 0x16, 0x11, 0x33, 0xbd, 0x2b, 0x4c, 0x9b, 0x38, 0x11, 0x16, 0x31, 0x8c,
+	0x0c, 0x24, 0xd6, 0x1e, 0xcb, 0xa5, 0x77, 0xf8, 0x0a, 0x32, 0xa0, 0xe6, 0xe2, 0x60, 0x9b, 0x44,
+	0xa6, 0x1f, 0x50, 0x8b, 0x84, 0x8d, 0xa9, 0x4a, 0xbb, 0x67, 0xe8, 0xd6, 0x05, 0x43, 0x9b, 0xd3,
+	0xa7, 0xea, 0xbb, 0xfd, 0xa9, 0x50, 0x73, 0x60, 0x61, 0x3f, 0x4d, 0xd0, 0x22, 0xcc, 0xe5, 0x03,
+	0x54, 0x5e, 0x42, 0xc7, 0x73, 0xf1, 0x89, 0x3e, 0x0f, 0xf5, 0x01, 0x35, 0x26, 0x84, 0x1a, 0xb5,
+	0x9d, 0xbc, 0x06, 0xda, 0x1a, 0xcc, 0x97, 0x21, 0x43, 0x67, 0x61, 0x56, 0x6a, 0x96, 0x89, 0x9f,
+	0x49, 0x26, 0x92, 0x2b, 0x5a, 0xe8, 0x2b, 0xef, 0xd6, 0x64, 0xa0, 0xfd, 0x6a, 0x3a, 0xbd, 0x7e,
+	0xca, 0xc2, 0x45, 0xc6, 0x2a, 0x83, 0xba, 0x47, 0x78, 0xfd, 0xe1, 0x70, 0x8a, 0x00, 0x3b, 0x0d,
+	0xe5, 0x90, 0x0f, 0x4f, 0xcd, 0x23, 0xd1, 0x6a, 0x26, 0x1e, 0xfd, 0x50, 0x01, 0x95, 0x7a, 0x34,
+	0xa2, 0xd8, 0x31, 0x5d, 0x1c, 0x74, 0xa9, 0x67, 0x06, 0xbc, 0x70, 0x09, 0x92, 0xfc, 0x34, 0x71,
+	0xc8, 0xbb, 0x37, 0xe4, 0x5e, 0xeb, 0x62, 0x2b, 0xa3, 0xbf, 0x13, 0xfa, 0x89, 0x02, 0x4d, 0x17,
+	0x53, 0x2f, 0x22, 0x9e, 0x38, 0xa8, 0x25, 0x60, 0x26, 0x0f, 0x19, 0xcc, 0xb9, 0xdc, 0x7e, 0xc3,
+	0x80, 0x76, 0xe0, 0xc4, 0x66, 0x40, 0x48, 0xde, 0x17, 0x87, 0x9d, 0xc8, 0xea, 0x7c, 0x83, 0x9c,
+	0x33, 0x4a, 0x9e, 0x37, 0xd3, 0x65, 0xcf, 0x1b, 0x64, 0xc3, 0xc9, 0xe1, 0x1c, 0x10, 0x36, 0x8e,
+	0x8a, 0xe3, 0x75, 0x7d, 0xac, 0xc3, 0x2f, 0x0f, 0x19, 0x1a, 0x3a, 0xf6, 0xa1, 0xf6, 0xc9, 0x24,
+	0xa8, 0xd5, 0x8c, 0x07, 0x39, 0x65, 0xf6, 0x40, 0xa1, 0x79, 0x98, 0xc6, 0xeb, 0x97, 0xac, 0x77,
+	0x40, 0x75, 0xfa, 0x67, 0x29, 0xc9, 0x35, 0x66, 0x56, 0xfc, 0x26, 0x0f, 0x89, 0x46, 0x8e, 0x42,
+	0x1c, 0xe1, 0x0d, 0xb9, 0x8e, 0x6e, 0xc1, 0x1b, 0x1d, 0xec, 0x6d, 0x07, 0xb1, 0x1f, 0x59, 0xbb,
+	0x83, 0xcc, 0x53, 0x82, 0xf9, 0x4c, 0x9f, 0xa0, 0xc8, 0xfb, 0x16, 0x9c, 0xe1, 0x25, 0x00, 0x87,
+	0x34, 0xc8, 0x39, 0x2d, 0x38, 0x4f, 0xa5, 0xcb, 0x45, 0xbe, 0xf7, 0x60, 0xbe, 0x78, 0x25, 0xc9,
+	0x1c, 0x74, 0xf4, 0x90, 0x6d, 0x84, 0x0a, 0x57, 0x56, 0x92, 0xd2, 0xee, 0xc2, 0xf9, 0x8d, 0x28,
+	0x20, 0xd8, 0xcd, 0x2a, 0xe5, 0x6f, 0x26, 0x8d, 0xa7, 0xea, 0x37, 0xc8, 0x64, 0xb1, 0x84, 0xd7,
+	0xfe, 0xa1, 0x40, 0xb3, 0x4a, 0x86, 0x4c, 0x64, 0xdf, 0x86, 0x63, 0xb2, 0x9f, 0x25, 0x2f, 0xdb,
+	0x2f, 0x17, 0xa3, 0x52, 0x36, 0xc4, 0x5a, 0xc3, 0xed, 0xaf, 0x47, 0x9b, 0x9b, 0xab, 0x7c, 0x22,
+	0x91, 0xf8, 0x74, 0x29, 0xad, 0xe8, 0xe4, 0xba, 0x78, 0xd8, 0x78, 0xd8, 0x0f, 0xb7, 0x58, 0x24,
+	0x4b, 0xad, 0x6c, 0x8c, 0x1e, 0xc1, 0x9c, 0xb3, 0x6c, 0xa6, 0xc3, 0xf4, 0x99, 0x70, 0x69, 0xe4,
+	0x33, 0x61, 0x79, 0x43, 0x92, 0xcb, 0x8d, 0x8e, 0x3b, 0xd9, 0x4c, 0xa8, 0xfd, 0x5a, 0x81, 0x93,
+	0x25, 0xa4, 0xff, 0xcb, 0xcf, 0x9c, 0xe5, 0x0f, 0x4e, 0xc0, 0xb4, 0xb8, 0x68, 0xd0, 0x8f, 0x14,
+	0x98, 0x49, 0x3b, 0x5e, 0xe8, 0x5a, 0x89, 0x94, 0x8a, 0xb6, 0xa1, 0x7a, 0xa5, 0x8a, 0x76, 0xb0,
+	0x6f, 0xa8, 0x5d, 0xfd, 0xc1, 0x27, 0x7f, 0xfb, 0xd9, 0xc4, 0x45, 0xb4, 0xa8, 0x8f, 0x68, 0xc0,
+	0xea, 0x2f, 0xa8, 0xbd, 0x87, 0x7e, 0xac, 0xc0, 0xf1, 0x5c, 0xeb, 0xae, 0x1a, 0xd0, 0x70, 0x0f,
+	0x51, 0xfd, 0xc2, 0x7e, 0x80, 0x72, 0xbd, 0x40, 0xed, 0x73, 0x02, 0x53, 0x13, 0x9d, 0x1b, 0x85,
+	0x09, 0x7d, 0xa8, 0x80, 0x5a, 0xdd, 0xe6, 0x42, 0x37, 0xc7, 0xec, 0x8a, 0x25, 0x38, 0xdf, 0x7c,
+	0xad, 0x5e, 0x1a, 0xfa, 0x83, 0x02, 0x8d, 0xaa, 0x4e, 0x0c, 0x5a, 0x1e, 0xab, 0x6d, 0x93, 0xe0,
+	0x58, 0x79, 0x8d, 0x56, 0x8f, 0x76, 0x4b, 0xd8, 0xed, 0xa6, 0xa6, 0xeb, 0xa5, 0x7d, 0x6f, 0xd3,
+	0x63, 0x36, 0x31, 0x23, 0x96, 0xfc, 0xb7, 0xfa, 0x02, 0x6e, 0x29, 0xd7, 0xd0, 0x1f, 0x15, 0x38,
+	0x37, 0xaa, 0x29, 0x82, 0x6e, 0x57, 0x79, 0xf0, 0x00, 0x2d, 0x1d, 0xf5, 0xce, 0xeb, 0x31, 0x4b,
+	0xbd, 0x2e, 0x09, 0xbd, 0x16, 0x50, 0x53, 0x1f, 0xf9, 0x0b, 0x00, 0xfa, 0xa5, 0x02, 0xf5, 0x62,
+	0x5b, 0x03, 0x5d, 0xaf, 0xda, 0xb8, 0xb4, 0x7d, 0xa3, 0xb6, 0x0e, 0x4a, 0x2e, 0x91, 0x7d, 0x45,
+	0x20, 0x5b, 0x41, 0x4b, 0x7a, 0xc5, 0x8f, 0x09, 0x9c, 0xc5, 0x14, 0x2d, 0x1e, 0xfd, 0x45, 0x3e,
+	0xe1, 0xec, 0xa1, 0xdf, 0x2b, 0x30, 0x5f, 0xf6, 0x32, 0x47, 0x2b, 0x55, 0x18, 0x46, 0xb4, 0x3d,
+	0xd4, 0x9b, 0xe3, 0x31, 0x49, 0xf8, 0x5f, 0x12, 0xf0, 0x97, 0x50, 0x59, 0xc0, 0xe4, 0xba, 0x02,
+	0xfa, 0x0b, 0xd1, 0x45, 0xd9, 0xd3, 0x5f, 0x24, 0x6d, 0x93, 0x3d, 0xf4, 0xaf, 0xd4, 0xd2, 0xd9,
+	0x8b, 0x7a, 0x1f, 0x4b, 0x0f, 0x36, 0x05, 0xd4, 0xd6, 0x41, 0xc9, 0x25, 0xd4, 0xf7, 0x05, 0xd4,
+	0x5d, 0xf4, 0xac, 0xca, 0xd2, 0x66, 0xbf, 0x0d, 0xa0, 0xbf, 0x48, 0xfb, 0x0d, 0xad, 0xc2, 0xe3,
+	0xad, 0x95, 0xaa, 0x51, 0xb1, 0x2c, 0xb5, 0xcb, 0xad, 0x5b, 0x0e, 0x15, 0xdd, 0x18, 0x7b, 0x0f,
+	0xfd, 0x56, 0x81, 0x53, 0xa5, 0xef, 0x54, 0x54, 0x69, 0xfb, 0x51, 0x6f, 0x79, 0xf5, 0xcd, 0x31,
+	0xb9, 0xa4, 0x1d, 0x96, 0x85, 0x1d, 0xbe, 0x88, 0xae, 0x95, 0xd8, 0x61, 0xa0, 0x24, 0xe9, 0xa4,
+	0x00, 0x7f, 0xa3, 0xc0, 0xc9, 0x92, 0x47, 0x0b, 0x5a, 0xae, 0x82, 0x50, 0xfd, 0x20, 0x56, 0x57,
+	0xc6, 0xe2, 0x29, 0x82, 0xd6, 0x2e, 0x97, 0x80, 0x0e, 0x25, 0x9f, 0x99, 0x2b, 0xf0, 0x78, 0x42,
+	0x7a, 0x1f, 0x4e, 0x97, 0x97, 0x28, 0xe8, 0x46, 0x59, 0x7d, 0x3c, 0xaa, 0x22, 0x52, 0x97, 0xc6,
+	0xe0, 0x48, 0x20, 0xdf, 0x50, 0xee, 0xb5, 0x3f, 0x7a, 0xd9, 0x54, 0x3e, 0x7e, 0xd9, 0x54, 0xfe,
+	0xfa, 0xb2, 0xa9, 0xfc, 0xf4, 0x55, 0xf3, 0xc8, 0xc7, 0xaf, 0x9a, 0x47, 0xfe, 0xfc, 0xaa, 0x79,
+	0xe4, 0xbb, 0x6f, 0x1d, 0xbc, 0xae, 0x7b, 0x9e, 0x28, 0x29, 0xaa, 0xbb, 0xce, 0x51, 0x31, 0xbd,
+	0xf2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x76, 0x30, 0x28, 0x7e, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a ClobPair by id.
+	ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error)
+	// Queries a list of ClobPair items.
+	ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error)
+	// Returns whether a subaccount is liquidatable.
+	AreSubaccountsLiquidatable(ctx context.Context, in *AreSubaccountsLiquidatableRequest, opts ...grpc.CallOption) (*AreSubaccountsLiquidatableResponse, error)
+	// Runs the MEV node <> node calculation with the provided parameters.
+	MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error)
+	// Queries EquityTierLimitConfiguration.
+	EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error)
+	// Queries the aggregated depth of the in-memory orderbook for a ClobPair.
+	OrderbookDepth(ctx context.Context, in *QueryOrderbookDepthRequest, opts ...grpc.CallOption) (*QueryOrderbookDepthResponse, error)
+	// Queries the orders of a subaccount resting on the in-memory orderbooks.
+	SubaccountOpenOrders(ctx context.Context, in *QuerySubaccountOpenOrdersRequest, opts ...grpc.CallOption) (*QuerySubaccountOpenOrdersResponse, error)
+	// Queries the fill state of an order.
+	OrderFillState(ctx context.Context, in *QueryOrderFillStateRequest, opts ...grpc.CallOption) (*QueryOrderFillStateResponse, error)
+	// Queries the balance of each insurance fund along with the perpetuals that
+	// it backs.
+	InsuranceFundBalances(ctx context.Context, in *QueryInsuranceFundBalancesRequest, opts ...grpc.CallOption) (*QueryInsuranceFundBalancesResponse, error)
+	// Simulates the margin requirements of a subaccount along with the
+	// liquidation, bankruptcy and fillable prices of its perpetual positions
+	// after hypothetical changes to its positions and to oracle prices. The
+	// simulation never writes to state.
+	SimulateLiquidation(ctx context.Context, in *QuerySimulateLiquidationRequest, opts ...grpc.CallOption) (*QuerySimulateLiquidationResponse, error)
+	// Streams orderbook updates for a set of clob pairs. The first response on
+	// the stream is a snapshot of the orderbooks, followed by incremental
+	// updates.
+	StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error) {
+	out := new(QueryClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error) {
+	out := new(QueryClobPairAllResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AreSubaccountsLiquidatable(ctx context.Context, in *AreSubaccountsLiquidatableRequest, opts ...grpc.CallOption) (*AreSubaccountsLiquidatableResponse, error) {
+	out := new(AreSubaccountsLiquidatableResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/AreSubaccountsLiquidatable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error) {
+	out := new(MevNodeToNodeCalculationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MevNodeToNodeCalculation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error) {
+	out := new(QueryEquityTierLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/EquityTierLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrderbookDepth(ctx context.Context, in *QueryOrderbookDepthRequest, opts ...grpc.CallOption) (*QueryOrderbookDepthResponse, error) {
+	out := new(QueryOrderbookDepthResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/OrderbookDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubaccountOpenOrders(ctx context.Context, in *QuerySubaccountOpenOrdersRequest, opts ...grpc.CallOption) (*QuerySubaccountOpenOrdersResponse, error) {
+	out := new(QuerySubaccountOpenOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/SubaccountOpenOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrderFillState(ctx context.Context, in *QueryOrderFillStateRequest, opts ...grpc.CallOption) (*QueryOrderFillStateResponse, error) {
+	out := new(QueryOrderFillStateResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/OrderFillState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InsuranceFundBalances(ctx context.Context, in *QueryInsuranceFundBalancesRequest, opts ...grpc.CallOption) (*QueryInsuranceFundBalancesResponse, error) {
+	out := new(QueryInsuranceFundBalancesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/InsuranceFundBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateLiquidation(ctx context.Context, in *QuerySimulateLiquidationRequest, opts ...grpc.CallOption) (*QuerySimulateLiquidationResponse, error) {
+	out := new(QuerySimulateLiquidationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/SimulateLiquidation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/dydxprotocol.clob.Query/StreamOrderbookUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamOrderbookUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamOrderbookUpdatesClient interface {
+	Recv() (*StreamOrderbookUpdatesResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamOrderbookUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamOrderbookUpdatesClient) Recv() (*StreamOrderbookUpdatesResponse, error) {
+	m := new(StreamOrderbookUpdatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a ClobPair by id.
+	ClobPair(context.Context, *QueryGetClobPairRequest) (*QueryClobPairResponse, error)
+	// Queries a list of ClobPair items.
+	ClobPairAll(context.Context, *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error)
+	// Returns whether a subaccount is liquidatable.
+	AreSubaccountsLiquidatable(context.Context, *AreSubaccountsLiquidatableRequest) (*AreSubaccountsLiquidatableResponse, error)
+	// Runs the MEV node <> node calculation with the provided parameters.
+	MevNodeToNodeCalculation(context.Context, *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error)
+	// Queries EquityTierLimitConfiguration.
+	EquityTierLimitConfiguration(context.Context, *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error)
+	// Queries the aggregated depth of the in-memory orderbook for a ClobPair.
+	OrderbookDepth(context.Context, *QueryOrderbookDepthRequest) (*QueryOrderbookDepthResponse, error)
+	// Queries the orders of a subaccount resting on the in-memory orderbooks.
+	SubaccountOpenOrders(context.Context, *QuerySubaccountOpenOrdersRequest) (*QuerySubaccountOpenOrdersResponse, error)
+	// Queries the fill state of an order.
+	OrderFillState(context.Context, *QueryOrderFillStateRequest) (*QueryOrderFillStateResponse, error)
+	// Queries the balance of each insurance fund along with the perpetuals that
+	// it backs.
+	InsuranceFundBalances(context.Context, *QueryInsuranceFundBalancesRequest) (*QueryInsuranceFundBalancesResponse, error)
+	// Simulates the margin requirements of a subaccount along with the
+	// liquidation, bankruptcy and fillable prices of its perpetual positions
+	// after hypothetical changes to its positions and to oracle prices. The
+	// simulation never writes to state.
+	SimulateLiquidation(context.Context, *QuerySimulateLiquidationRequest) (*QuerySimulateLiquidationResponse, error)
+	// Streams orderbook updates for a set of clob pairs. The first response on
+	// the stream is a snapshot of the orderbooks, followed by incremental
+	// updates.
+	StreamOrderbookUpdates(*StreamOrderbookUpdatesRequest, Query_StreamOrderbookUpdatesServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ClobPair(ctx context.Context, req *QueryGetClobPairRequest) (*QueryClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPair not implemented")
+}
+func (*UnimplementedQueryServer) ClobPairAll(ctx context.Context, req *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPairAll not implemented")
+}
+func (*UnimplementedQueryServer) AreSubaccountsLiquidatable(ctx context.Context, req *AreSubaccountsLiquidatableRequest) (*AreSubaccountsLiquidatableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AreSubaccountsLiquidatable not implemented")
+}
+func (*UnimplementedQueryServer) MevNodeToNodeCalculation(ctx context.Context, req *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MevNodeToNodeCalculation not implemented")
+}
+func (*UnimplementedQueryServer) EquityTierLimitConfiguration(ctx context.Context, req *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquityTierLimitConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) OrderbookDepth(ctx context.Context, req *QueryOrderbookDepthRequest) (*QueryOrderbookDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderbookDepth not implemented")
+}
+func (*UnimplementedQueryServer) SubaccountOpenOrders(ctx context.Context, req *QuerySubaccountOpenOrdersRequest) (*QuerySubaccountOpenOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountOpenOrders not implemented")
+}
+func (*UnimplementedQueryServer) OrderFillState(ctx context.Context, req *QueryOrderFillStateRequest) (*QueryOrderFillStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderFillState not implemented")
+}
+func (*UnimplementedQueryServer) InsuranceFundBalances(ctx context.Context, req *QueryInsuranceFundBalancesRequest) (*QueryInsuranceFundBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFundBalances not implemented")
+}
+func (*UnimplementedQueryServer) SimulateLiquidation(ctx context.Context, req *QuerySimulateLiquidationRequest) (*QuerySimulateLiquidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateLiquidation not implemented")
+}
+func (*UnimplementedQueryServer) StreamOrderbookUpdates(req *StreamOrderbookUpdatesRequest, srv Query_StreamOrderbookUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderbookUpdates not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetClobPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPair(ctx, req.(*QueryGetClobPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClobPairAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClobPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPairAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPairAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPairAll(ctx, req.(*QueryAllClobPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AreSubaccountsLiquidatable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AreSubaccountsLiquidatableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AreSubaccountsLiquidatable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/AreSubaccountsLiquidatable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AreSubaccountsLiquidatable(ctx, req.(*AreSubaccountsLiquidatableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MevNodeToNodeCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MevNodeToNodeCalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/MevNodeToNodeCalculation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, req.(*MevNodeToNodeCalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquityTierLimitConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/EquityTierLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, req.(*QueryEquityTierLimitConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderbookDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderbookDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderbookDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/OrderbookDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderbookDepth(ctx, req.(*QueryOrderbookDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubaccountOpenOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountOpenOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountOpenOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/SubaccountOpenOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountOpenOrders(ctx, req.(*QuerySubaccountOpenOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderFillState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderFillStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderFillState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/OrderFillState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderFillState(ctx, req.(*QueryOrderFillStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InsuranceFundBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceFundBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/InsuranceFundBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceFundBalances(ctx, req.(*QueryInsuranceFundBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateLiquidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateLiquidationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateLiquidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/SimulateLiquidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateLiquidation(ctx, req.(*QuerySimulateLiquidationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamOrderbookUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrderbookUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamOrderbookUpdates(m, &queryStreamOrderbookUpdatesServer{stream})
+}
+
+type Query_StreamOrderbookUpdatesServer interface {
+	Send(*StreamOrderbookUpdatesResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamOrderbookUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamOrderbookUpdatesServer) Send(m *StreamOrderbookUpdatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.clob.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClobPair",
+			Handler:    _Query_ClobPair_Handler,
+		},
+		{
+			MethodName: "ClobPairAll",
+			Handler:    _Query_ClobPairAll_Handler,
+		},
+		{
+			MethodName: "AreSubaccountsLiquidatable",
+			Handler:    _Query_AreSubaccountsLiquidatable_Handler,
+		},
+		{
+			MethodName: "MevNodeToNodeCalculation",
+			Handler:    _Query_MevNodeToNodeCalculation_Handler,
+		},
+		{
+			MethodName: "EquityTierLimitConfiguration",
+			Handler:    _Query_EquityTierLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "OrderbookDepth",
+			Handler:    _Query_OrderbookDepth_Handler,
+		},
+		{
+			MethodName: "SubaccountOpenOrders",
+			Handler:    _Query_SubaccountOpenOrders_Handler,
+		},
+		{
+			MethodName: "OrderFillState",
+			Handler:    _Query_OrderFillState_Handler,
+		},
+		{
+			MethodName: "InsuranceFundBalances",
+			Handler:    _Query_InsuranceFundBalances_Handler,
+		},
+		{
+			MethodName: "SimulateLiquidation",
+			Handler:    _Query_SimulateLiquidation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrderbookUpdates",
+			Handler:       _Query_StreamOrderbookUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dydxprotocol/clob/query.proto",
+}
+
+func (m *QueryGetClobPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClobPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClobPairAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClobPair) > 0 {
+		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AreSubaccountsLiquidatableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AreSubaccountsLiquidatableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AreSubaccountsLiquidatableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AreSubaccountsLiquidatableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AreSubaccountsLiquidatableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AreSubaccountsLiquidatableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AreSubaccountsLiquidatableResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AreSubaccountsLiquidatableResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AreSubaccountsLiquidatableResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsLiquidatable {
+		i--
+		if m.IsLiquidatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorMevMetrics != nil {
+		{
+			size, err := m.ValidatorMevMetrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockProposerMatches != nil {
+		{
+			size, err := m.BlockProposerMatches.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Volume != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mev != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mev))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderbookDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderbookDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderbookDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderbookLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderbookLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderbookLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOrders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOrders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Quantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantums))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Subticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Subticks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderbookDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderbookDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderbookDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountOpenOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountOpenOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountOpenOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlacementIndex != nil {
+		{
+			size, err := m.PlacementIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.FillState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountOpenOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountOpenOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountOpenOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OpenOrders) > 0 {
+		for iNdEx := len(m.OpenOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpenOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderFillStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderFillStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderFillStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderFillStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderFillStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderFillStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LongTermOrderPlacement != nil {
+		{
+			size, err := m.LongTermOrderPlacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Resting {
+		i--
+		if m.Resting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FillState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PerpetualIds) > 0 {
+		dAtA15 := make([]byte, len(m.PerpetualIds)*10)
+		var j14 int
+		for _, num := range m.PerpetualIds {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InsuranceFunds) > 0 {
+		for iNdEx := len(m.InsuranceFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateLiquidationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateLiquidationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateLiquidationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketPrices) > 0 {
+		for iNdEx := len(m.MarketPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.QuoteQuantumsDelta != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64((uint64(m.QuoteQuantumsDelta)<<1)^uint64((m.QuoteQuantumsDelta>>63))))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PerpetualPositionChanges) > 0 {
+		for iNdEx := len(m.PerpetualPositionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerpetualPositionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedPerpetualPositionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedPerpetualPositionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedPerpetualPositionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuantumsDelta != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64((uint64(m.QuantumsDelta)<<1)^uint64((m.QuantumsDelta>>63))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedMarketPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedMarketPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedMarketPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Price != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateLiquidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateLiquidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateLiquidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerpetualPositions) > 0 {
+		for iNdEx := len(m.PerpetualPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerpetualPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.IsLiquidatable {
+		i--
+		if m.IsLiquidatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.FreeCollateral.Size()
+		i -= size
+		if _, err := m.FreeCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaintenanceMarginRequirement.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialMarginRequirement.Size()
+		i -= size
+		if _, err := m.InitialMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedPerpetualPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedPerpetualPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedPerpetualPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InsuranceFundDelta.Size()
+		i -= size
+		if _, err := m.InsuranceFundDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.FillablePriceSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FillablePriceSubticks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BankruptcyPriceSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BankruptcyPriceSubticks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LiquidationPriceSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LiquidationPriceSubticks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Quantums.Size()
+		i -= size
+		if _, err := m.Quantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderbookUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClobPairId) > 0 {
+		dAtA18 := make([]byte, len(m.ClobPairId)*10)
+		var j17 int
+		for _, num := range m.ClobPairId {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintQuery(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderbookUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.L2Snapshots) > 0 {
+		for iNdEx := len(m.L2Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.L2Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderbookL2Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderbookL2Snapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderbookL2Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetClobPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClobPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllClobPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClobPairAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClobPair) > 0 {
+		for _, e := range m.ClobPair {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AreSubaccountsLiquidatableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for _, e := range m.SubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AreSubaccountsLiquidatableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AreSubaccountsLiquidatableResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsLiquidatable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockProposerMatches != nil {
+		l = m.BlockProposerMatches.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValidatorMevMetrics != nil {
+		l = m.ValidatorMevMetrics.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.Mev != 0 {
+		n += 5
+	}
+	if m.Volume != 0 {
+		n += 1 + sovQuery(uint64(m.Volume))
+	}
+	return n
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EquityTierLimitConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderbookDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *OrderbookLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subticks != 0 {
+		n += 1 + sovQuery(uint64(m.Subticks))
+	}
+	if m.Quantums != 0 {
+		n += 1 + sovQuery(uint64(m.Quantums))
+	}
+	if m.NumOrders != 0 {
+		n += 1 + sovQuery(uint64(m.NumOrders))
+	}
+	return n
+}
+
+func (m *QueryOrderbookDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySubaccountOpenOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *OpenOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FillState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PlacementIndex != nil {
+		l = m.PlacementIndex.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubaccountOpenOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OpenOrders) > 0 {
+		for _, e := range m.OpenOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOrderFillStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderFillStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FillState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Resting {
+		n += 2
+	}
+	if m.LongTermOrderPlacement != nil {
+		l = m.LongTermOrderPlacement.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsuranceFundBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PerpetualIds) > 0 {
+		l = 0
+		for _, e := range m.PerpetualIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInsuranceFundBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InsuranceFunds) > 0 {
+		for _, e := range m.InsuranceFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateLiquidationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PerpetualPositionChanges) > 0 {
+		for _, e := range m.PerpetualPositionChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.QuoteQuantumsDelta != 0 {
+		n += 1 + sozQuery(uint64(m.QuoteQuantumsDelta))
+	}
+	if len(m.MarketPrices) > 0 {
+		for _, e := range m.MarketPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedPerpetualPositionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.QuantumsDelta != 0 {
+		n += 1 + sozQuery(uint64(m.QuantumsDelta))
+	}
+	return n
+}
+
+func (m *SimulatedMarketPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Price != 0 {
+		n += 1 + sovQuery(uint64(m.Price))
+	}
+	return n
+}
+
+func (m *QuerySimulateLiquidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FreeCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsLiquidatable {
+		n += 2
+	}
+	if len(m.PerpetualPositions) > 0 {
+		for _, e := range m.PerpetualPositions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedPerpetualPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = m.Quantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LiquidationPriceSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.LiquidationPriceSubticks))
+	}
+	if m.BankruptcyPriceSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.BankruptcyPriceSubticks))
+	}
+	if m.FillablePriceSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.FillablePriceSubticks))
+	}
+	l = m.InsuranceFundDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StreamOrderbookUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClobPairId) > 0 {
+		l = 0
+		for _, e := range m.ClobPairId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *StreamOrderbookUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	if len(m.L2Snapshots) > 0 {
+		for _, e := range m.L2Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderbookL2Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetClobPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClobPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClobPair = append(m.ClobPair, ClobPair{})
+			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AreSubaccountsLiquidatableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AreSubaccountsLiquidatableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountIds = append(m.SubaccountIds, types.SubaccountId{})
+			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AreSubaccountsLiquidatableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AreSubaccountsLiquidatableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, AreSubaccountsLiquidatableResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidatable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiquidatable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerMatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockProposerMatches == nil {
+				m.BlockProposerMatches = &ValidatorMevMatches{}
+			}
+			if err := m.BlockProposerMatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMevMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorMevMetrics == nil {
+				m.ValidatorMevMetrics = &MevNodeToNodeMetrics{}
+			}
+			if err := m.ValidatorMevMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MevNodeToNodeCalculationResponse_MevAndVolumePerClob{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevAndVolumePerClob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevAndVolumePerClob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mev", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Mev = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderbookDepthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderbookDepthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderbookDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderbookLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderbookLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderbookLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subticks", wireType)
+			}
+			m.Subticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Subticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			m.Quantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOrders", wireType)
+			}
+			m.NumOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderbookDepthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderbookDepthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderbookDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, OrderbookLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, OrderbookLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountOpenOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountOpenOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountOpenOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FillState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PlacementIndex == nil {
+				m.PlacementIndex = &TransactionOrdering{}
+			}
+			if err := m.PlacementIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountOpenOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountOpenOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountOpenOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenOrders = append(m.OpenOrders, OpenOrder{})
+			if err := m.OpenOrders[len(m.OpenOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderFillStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderFillStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderFillStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryEquityTierLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.EquityTierLimitConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderFillStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1593,17 +6180,50 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetClobPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderFillStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderFillStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FillState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resting", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1613,11 +6233,48 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resting = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongTermOrderPlacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LongTermOrderPlacement == nil {
+				m.LongTermOrderPlacement = &LongTermOrderPlacement{}
+			}
+			if err := m.LongTermOrderPlacement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1639,7 +6296,7 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInsuranceFundBalancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1662,17 +6319,67 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClobPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInsuranceFundBalancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInsuranceFundBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1682,22 +6389,162 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PerpetualIds = append(m.PerpetualIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PerpetualIds) == 0 {
+					m.PerpetualIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PerpetualIds = append(m.PerpetualIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1722,7 +6569,7 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInsuranceFundBalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1745,15 +6592,15 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllClobPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInsuranceFundBalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInsuranceFundBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFunds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1780,10 +6627,8 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InsuranceFunds = append(m.InsuranceFunds, InsuranceFundBalance{})
+			if err := m.InsuranceFunds[len(m.InsuranceFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1808,7 +6653,7 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateLiquidationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1831,15 +6676,48 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClobPairAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateLiquidationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClobPairAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateLiquidationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualPositionChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1866,14 +6744,35 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClobPair = append(m.ClobPair, ClobPair{})
-			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PerpetualPositionChanges = append(m.PerpetualPositionChanges, SimulatedPerpetualPositionChange{})
+			if err := m.PerpetualPositionChanges[len(m.PerpetualPositionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantumsDelta", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.QuoteQuantumsDelta = int64(v)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1900,10 +6799,8 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketPrices = append(m.MarketPrices, SimulatedMarketPrice{})
+			if err := m.MarketPrices[len(m.MarketPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1928,7 +6825,7 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulatedPerpetualPositionChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1951,17 +6848,17 @@ func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AreSubaccountsLiquidatableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedPerpetualPositionChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AreSubaccountsLiquidatableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedPerpetualPositionChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1971,26 +6868,32 @@ func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumsDelta", wireType)
 			}
-			m.SubaccountIds = append(m.SubaccountIds, types.SubaccountId{})
-			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.QuantumsDelta = int64(v)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2012,7 +6915,7 @@ func (m *AreSubaccountsLiquidatableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulatedMarketPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2035,17 +6938,17 @@ func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AreSubaccountsLiquidatableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedMarketPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AreSubaccountsLiquidatableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedMarketPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2055,26 +6958,30 @@ func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Results = append(m.Results, AreSubaccountsLiquidatableResponse_Result{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2096,7 +7003,7 @@ func (m *AreSubaccountsLiquidatableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateLiquidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2119,17 +7026,17 @@ func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateLiquidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateLiquidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2139,26 +7046,125 @@ func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreeCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidatable", wireType)
 			}
@@ -2178,6 +7184,40 @@ func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error
 				}
 			}
 			m.IsLiquidatable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerpetualPositions = append(m.PerpetualPositions, SimulatedPerpetualPosition{})
+			if err := m.PerpetualPositions[len(m.PerpetualPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2199,7 +7239,7 @@ func (m *AreSubaccountsLiquidatableResponse_Result) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulatedPerpetualPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,17 +7262,69 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedPerpetualPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedPerpetualPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerMatches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var msglen int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPriceSubticks", wireType)
+			}
+			m.LiquidationPriceSubticks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2242,33 +7334,54 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LiquidationPriceSubticks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankruptcyPriceSubticks", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BankruptcyPriceSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BankruptcyPriceSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.BlockProposerMatches == nil {
-				m.BlockProposerMatches = &ValidatorMevMatches{}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillablePriceSubticks", wireType)
 			}
-			if err := m.BlockProposerMatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FillablePriceSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillablePriceSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMevMetrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundDelta", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2278,25 +7391,22 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidatorMevMetrics == nil {
-				m.ValidatorMevMetrics = &MevNodeToNodeMetrics{}
-			}
-			if err := m.ValidatorMevMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InsuranceFundDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2321,7 +7431,7 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamOrderbookUpdatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2344,46 +7454,88 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamOrderbookUpdatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamOrderbookUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ClobPairId = append(m.ClobPairId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClobPairId) == 0 {
+					m.ClobPairId = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClobPairId = append(m.ClobPairId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Results = append(m.Results, MevNodeToNodeCalculationResponse_MevAndVolumePerClob{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2405,7 +7557,7 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []byte) error {
+func (m *StreamOrderbookUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2428,17 +7580,17 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevAndVolumePerClob: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamOrderbookUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevAndVolumePerClob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamOrderbookUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
-			m.ClobPairId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2448,27 +7600,31 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClobPairId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mev", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Mev = float32(math.Float32frombits(v))
-		case 3:
+			m.Updates = append(m.Updates, types1.OffChainUpdateV1{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			m.Volume = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2478,61 +7634,46 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Snapshot = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2Snapshots", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.L2Snapshots = append(m.L2Snapshots, OrderbookL2Snapshot{})
+			if err := m.L2Snapshots[len(m.L2Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2554,7 +7695,7 @@ func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *OrderbookL2Snapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2577,15 +7718,34 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderbookL2Snapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderbookL2Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2612,7 +7772,42 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, OrderbookLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, OrderbookLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -423,6 +424,347 @@ func (m *MsgCancelOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrderResponse proto.InternalMessageInfo
 
+// MsgBatchPlaceAndCancel is a request type used for atomically canceling and
+// placing multiple Short-Term orders for a single subaccount. All
+// cancellations are processed before all placements, and the batch is
+// rejected if any cancellation or placement fails stateful validation.
+type MsgBatchPlaceAndCancel struct {
+	// The subaccount that owns every order in the batch.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The Short-Term order cancellations to process, in order.
+	Cancels []MsgCancelOrder `protobuf:"bytes,2,rep,name=cancels,proto3" json:"cancels"`
+	// The Short-Term order placements to process, in order.
+	Places []MsgPlaceOrder `protobuf:"bytes,3,rep,name=places,proto3" json:"places"`
+}
+
+func (m *MsgBatchPlaceAndCancel) Reset()         { *m = MsgBatchPlaceAndCancel{} }
+func (m *MsgBatchPlaceAndCancel) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchPlaceAndCancel) ProtoMessage()    {}
+func (*MsgBatchPlaceAndCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{8}
+}
+func (m *MsgBatchPlaceAndCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchPlaceAndCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchPlaceAndCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchPlaceAndCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchPlaceAndCancel.Merge(m, src)
+}
+func (m *MsgBatchPlaceAndCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchPlaceAndCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchPlaceAndCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchPlaceAndCancel proto.InternalMessageInfo
+
+func (m *MsgBatchPlaceAndCancel) GetSubaccountId() types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return types.SubaccountId{}
+}
+
+func (m *MsgBatchPlaceAndCancel) GetCancels() []MsgCancelOrder {
+	if m != nil {
+		return m.Cancels
+	}
+	return nil
+}
+
+func (m *MsgBatchPlaceAndCancel) GetPlaces() []MsgPlaceOrder {
+	if m != nil {
+		return m.Places
+	}
+	return nil
+}
+
+// MsgBatchPlaceAndCancelResponse is a response type used for atomically
+// canceling and placing multiple Short-Term orders. It contains a result for
+// each cancellation and placement in the same order as the request.
+type MsgBatchPlaceAndCancelResponse struct {
+	CancelResults []BatchCancelOrderResult `protobuf:"bytes,1,rep,name=cancel_results,json=cancelResults,proto3" json:"cancel_results"`
+	PlaceResults  []BatchPlaceOrderResult  `protobuf:"bytes,2,rep,name=place_results,json=placeResults,proto3" json:"place_results"`
+}
+
+func (m *MsgBatchPlaceAndCancelResponse) Reset()         { *m = MsgBatchPlaceAndCancelResponse{} }
+func (m *MsgBatchPlaceAndCancelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchPlaceAndCancelResponse) ProtoMessage()    {}
+func (*MsgBatchPlaceAndCancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{9}
+}
+func (m *MsgBatchPlaceAndCancelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchPlaceAndCancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchPlaceAndCancelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchPlaceAndCancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchPlaceAndCancelResponse.Merge(m, src)
+}
+func (m *MsgBatchPlaceAndCancelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchPlaceAndCancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchPlaceAndCancelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchPlaceAndCancelResponse proto.InternalMessageInfo
+
+func (m *MsgBatchPlaceAndCancelResponse) GetCancelResults() []BatchCancelOrderResult {
+	if m != nil {
+		return m.CancelResults
+	}
+	return nil
+}
+
+func (m *MsgBatchPlaceAndCancelResponse) GetPlaceResults() []BatchPlaceOrderResult {
+	if m != nil {
+		return m.PlaceResults
+	}
+	return nil
+}
+
+// BatchCancelOrderResult is the result of a single cancellation within a
+// MsgBatchPlaceAndCancel.
+type BatchCancelOrderResult struct {
+	// The ID of the canceled order.
+	OrderId OrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	// The error returned by the memclob when canceling the order, if any.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *BatchCancelOrderResult) Reset()         { *m = BatchCancelOrderResult{} }
+func (m *BatchCancelOrderResult) String() string { return proto.CompactTextString(m) }
+func (*BatchCancelOrderResult) ProtoMessage()    {}
+func (*BatchCancelOrderResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{10}
+}
+func (m *BatchCancelOrderResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCancelOrderResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCancelOrderResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchCancelOrderResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCancelOrderResult.Merge(m, src)
+}
+func (m *BatchCancelOrderResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCancelOrderResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCancelOrderResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCancelOrderResult proto.InternalMessageInfo
+
+func (m *BatchCancelOrderResult) GetOrderId() OrderId {
+	if m != nil {
+		return m.OrderId
+	}
+	return OrderId{}
+}
+
+func (m *BatchCancelOrderResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// BatchPlaceOrderResult is the result of a single placement within a
+// MsgBatchPlaceAndCancel.
+type BatchPlaceOrderResult struct {
+	// The ID of the placed order.
+	OrderId OrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	// The quantums of the order that were optimistically filled when placing
+	// the order.
+	OptimisticallyFilledQuantums uint64 `protobuf:"varint,2,opt,name=optimistically_filled_quantums,json=optimisticallyFilledQuantums,proto3" json:"optimistically_filled_quantums,omitempty"`
+	// The status of the order after placement, see `OrderStatus`.
+	Status uint32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// The error returned by the memclob when placing the order, if any.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *BatchPlaceOrderResult) Reset()         { *m = BatchPlaceOrderResult{} }
+func (m *BatchPlaceOrderResult) String() string { return proto.CompactTextString(m) }
+func (*BatchPlaceOrderResult) ProtoMessage()    {}
+func (*BatchPlaceOrderResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{11}
+}
+func (m *BatchPlaceOrderResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPlaceOrderResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPlaceOrderResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchPlaceOrderResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPlaceOrderResult.Merge(m, src)
+}
+func (m *BatchPlaceOrderResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPlaceOrderResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPlaceOrderResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchPlaceOrderResult proto.InternalMessageInfo
+
+func (m *BatchPlaceOrderResult) GetOrderId() OrderId {
+	if m != nil {
+		return m.OrderId
+	}
+	return OrderId{}
+}
+
+func (m *BatchPlaceOrderResult) GetOptimisticallyFilledQuantums() uint64 {
+	if m != nil {
+		return m.OptimisticallyFilledQuantums
+	}
+	return 0
+}
+
+func (m *BatchPlaceOrderResult) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *BatchPlaceOrderResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// MsgReplaceOrder is a request type used for atomically replacing an existing
+// order with a new version of the order. The new order must have the same
+// order id as the order it replaces, and may only change the price and size of
+// the order. Short-Term replacement orders must also have a greater
+// `good_til_block` than the order they replace. If the price is unchanged and
+// the size is not increased, the order keeps its priority within its price
+// level.
+type MsgReplaceOrder struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+}
+
+func (m *MsgReplaceOrder) Reset()         { *m = MsgReplaceOrder{} }
+func (m *MsgReplaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrder) ProtoMessage()    {}
+func (*MsgReplaceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{12}
+}
+func (m *MsgReplaceOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrder.Merge(m, src)
+}
+func (m *MsgReplaceOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceOrder proto.InternalMessageInfo
+
+func (m *MsgReplaceOrder) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order{}
+}
+
+// MsgReplaceOrderResponse is a response type used for replacing orders.
+type MsgReplaceOrderResponse struct {
+}
+
+func (m *MsgReplaceOrderResponse) Reset()         { *m = MsgReplaceOrderResponse{} }
+func (m *MsgReplaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrderResponse) ProtoMessage()    {}
+func (*MsgReplaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{13}
+}
+func (m *MsgReplaceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrderResponse.Merge(m, src)
+}
+func (m *MsgReplaceOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceOrderResponse proto.InternalMessageInfo
+
 // MsgUpdateClobPair is a request type used for updating a ClobPair in state.
 type MsgUpdateClobPair struct {
 	// Authority is the address that may send this message.
@@ -435,7 +777,7 @@ func (m *MsgUpdateClobPair) Reset()         { *m = MsgUpdateClobPair{} }
 func (m *MsgUpdateClobPair) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateClobPair) ProtoMessage()    {}
 func (*MsgUpdateClobPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{8}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{14}
 }
 func (m *MsgUpdateClobPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +829,7 @@ func (m *MsgUpdateClobPairResponse) Reset()         { *m = MsgUpdateClobPairResp
 func (m *MsgUpdateClobPairResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateClobPairResponse) ProtoMessage()    {}
 func (*MsgUpdateClobPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{9}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{15}
 }
 func (m *MsgUpdateClobPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,13 +862,15 @@ var xxx_messageInfo_MsgUpdateClobPairResponse proto.InternalMessageInfo
 // Note that the `order_placement` operation is a signed message.
 type OperationRaw struct {
 	// operationRaw represents an operation that occurred, which can be a match,
-	// a signed order placement, or an order removal.
+	// a signed order placement, an order removal, or a signed order placement
+	// within a batch.
 	//
 	// Types that are valid to be assigned to Operation:
 	//
 	//	*OperationRaw_Match
 	//	*OperationRaw_ShortTermOrderPlacement
 	//	*OperationRaw_OrderRemoval
+	//	*OperationRaw_ShortTermOrderBatchPlacement
 	Operation isOperationRaw_Operation `protobuf_oneof:"operation"`
 }
 
@@ -534,7 +878,7 @@ func (m *OperationRaw) Reset()         { *m = OperationRaw{} }
 func (m *OperationRaw) String() string { return proto.CompactTextString(m) }
 func (*OperationRaw) ProtoMessage()    {}
 func (*OperationRaw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{10}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{16}
 }
 func (m *OperationRaw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,10 +922,14 @@ type OperationRaw_ShortTermOrderPlacement struct {
 type OperationRaw_OrderRemoval struct {
 	OrderRemoval *OrderRemoval `protobuf:"bytes,3,opt,name=order_removal,json=orderRemoval,proto3,oneof" json:"order_removal,omitempty"`
 }
+type OperationRaw_ShortTermOrderBatchPlacement struct {
+	ShortTermOrderBatchPlacement *ShortTermOrderBatchPlacement `protobuf:"bytes,4,opt,name=short_term_order_batch_placement,json=shortTermOrderBatchPlacement,proto3,oneof" json:"short_term_order_batch_placement,omitempty"`
+}
 
-func (*OperationRaw_Match) isOperationRaw_Operation()                   {}
-func (*OperationRaw_ShortTermOrderPlacement) isOperationRaw_Operation() {}
-func (*OperationRaw_OrderRemoval) isOperationRaw_Operation()            {}
+func (*OperationRaw_Match) isOperationRaw_Operation()                        {}
+func (*OperationRaw_ShortTermOrderPlacement) isOperationRaw_Operation()      {}
+func (*OperationRaw_OrderRemoval) isOperationRaw_Operation()                 {}
+func (*OperationRaw_ShortTermOrderBatchPlacement) isOperationRaw_Operation() {}
 
 func (m *OperationRaw) GetOperation() isOperationRaw_Operation {
 	if m != nil {
@@ -611,13 +959,78 @@ func (m *OperationRaw) GetOrderRemoval() *OrderRemoval {
 	return nil
 }
 
+func (m *OperationRaw) GetShortTermOrderBatchPlacement() *ShortTermOrderBatchPlacement {
+	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderBatchPlacement); ok {
+		return x.ShortTermOrderBatchPlacement
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*OperationRaw) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*OperationRaw_Match)(nil),
 		(*OperationRaw_ShortTermOrderPlacement)(nil),
 		(*OperationRaw_OrderRemoval)(nil),
+		(*OperationRaw_ShortTermOrderBatchPlacement)(nil),
+	}
+}
+
+// ShortTermOrderBatchPlacement represents a Short-Term order placement that
+// was included in a signed MsgBatchPlaceAndCancel transaction.
+type ShortTermOrderBatchPlacement struct {
+	// The bytes of the signed transaction containing the MsgBatchPlaceAndCancel.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// The index of the order placement within the `places` of the
+	// MsgBatchPlaceAndCancel.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *ShortTermOrderBatchPlacement) Reset()         { *m = ShortTermOrderBatchPlacement{} }
+func (m *ShortTermOrderBatchPlacement) String() string { return proto.CompactTextString(m) }
+func (*ShortTermOrderBatchPlacement) ProtoMessage()    {}
+func (*ShortTermOrderBatchPlacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{17}
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShortTermOrderBatchPlacement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShortTermOrderBatchPlacement.Merge(m, src)
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShortTermOrderBatchPlacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShortTermOrderBatchPlacement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShortTermOrderBatchPlacement proto.InternalMessageInfo
+
+func (m *ShortTermOrderBatchPlacement) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *ShortTermOrderBatchPlacement) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
+	return 0
 }
 
 // MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
@@ -633,7 +1046,7 @@ func (m *MsgUpdateEquityTierLimitConfiguration) Reset()         { *m = MsgUpdate
 func (m *MsgUpdateEquityTierLimitConfiguration) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateEquityTierLimitConfiguration) ProtoMessage()    {}
 func (*MsgUpdateEquityTierLimitConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{11}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{18}
 }
 func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +1102,7 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) String() string {
 }
 func (*MsgUpdateEquityTierLimitConfigurationResponse) ProtoMessage() {}
 func (*MsgUpdateEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{12}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{19}
 }
 func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,7 +1144,7 @@ func (m *MsgUpdateBlockRateLimitConfiguration) Reset()         { *m = MsgUpdateB
 func (m *MsgUpdateBlockRateLimitConfiguration) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateBlockRateLimitConfiguration) ProtoMessage()    {}
 func (*MsgUpdateBlockRateLimitConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{13}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{20}
 }
 func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,7 +1200,7 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) String() string {
 }
 func (*MsgUpdateBlockRateLimitConfigurationResponse) ProtoMessage() {}
 func (*MsgUpdateBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{14}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{21}
 }
 func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +1243,7 @@ func (m *MsgUpdateLiquidationsConfig) Reset()         { *m = MsgUpdateLiquidatio
 func (m *MsgUpdateLiquidationsConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateLiquidationsConfig) ProtoMessage()    {}
 func (*MsgUpdateLiquidationsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{15}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{22}
 }
 func (m *MsgUpdateLiquidationsConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +1294,7 @@ func (m *MsgUpdateLiquidationsConfigResponse) Reset()         { *m = MsgUpdateLi
 func (m *MsgUpdateLiquidationsConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateLiquidationsConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateLiquidationsConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{16}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{23}
 }
 func (m *MsgUpdateLiquidationsConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,9 +1332,16 @@ func init() {
 	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "dydxprotocol.clob.MsgPlaceOrderResponse")
 	proto.RegisterType((*MsgCancelOrder)(nil), "dydxprotocol.clob.MsgCancelOrder")
 	proto.RegisterType((*MsgCancelOrderResponse)(nil), "dydxprotocol.clob.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgBatchPlaceAndCancel)(nil), "dydxprotocol.clob.MsgBatchPlaceAndCancel")
+	proto.RegisterType((*MsgBatchPlaceAndCancelResponse)(nil), "dydxprotocol.clob.MsgBatchPlaceAndCancelResponse")
+	proto.RegisterType((*BatchCancelOrderResult)(nil), "dydxprotocol.clob.BatchCancelOrderResult")
+	proto.RegisterType((*BatchPlaceOrderResult)(nil), "dydxprotocol.clob.BatchPlaceOrderResult")
+	proto.RegisterType((*MsgReplaceOrder)(nil), "dydxprotocol.clob.MsgReplaceOrder")
+	proto.RegisterType((*MsgReplaceOrderResponse)(nil), "dydxprotocol.clob.MsgReplaceOrderResponse")
 	proto.RegisterType((*MsgUpdateClobPair)(nil), "dydxprotocol.clob.MsgUpdateClobPair")
 	proto.RegisterType((*MsgUpdateClobPairResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairResponse")
 	proto.RegisterType((*OperationRaw)(nil), "dydxprotocol.clob.OperationRaw")
+	proto.RegisterType((*ShortTermOrderBatchPlacement)(nil), "dydxprotocol.clob.ShortTermOrderBatchPlacement")
 	proto.RegisterType((*MsgUpdateEquityTierLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration")
 	proto.RegisterType((*MsgUpdateEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse")
 	proto.RegisterType((*MsgUpdateBlockRateLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration")
@@ -933,68 +1353,90 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
 
 var fileDescriptor_19b9e2c0de4ab64a = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xdf, 0x21, 0x40, 0x9a, 0xb7, 0x49, 0x48, 0xdd, 0x94, 0xb8, 0x0e, 0xdd, 0x6c, 0x96, 0xa6,
-	0x5a, 0xa0, 0x59, 0x97, 0x50, 0x05, 0x04, 0xe2, 0xdf, 0x46, 0x45, 0x41, 0x6a, 0xd4, 0xc5, 0x04,
-	0x09, 0x01, 0x92, 0xe5, 0xb5, 0xa7, 0xde, 0x51, 0x6d, 0x8f, 0x33, 0x9e, 0x0d, 0xc9, 0xb5, 0x9f,
-	0x80, 0x3b, 0x42, 0xe2, 0x23, 0x70, 0xe0, 0xc0, 0x19, 0x2e, 0x3d, 0x56, 0x9c, 0x2a, 0x21, 0x01,
-	0x4a, 0x0e, 0x7c, 0x0d, 0x64, 0x7b, 0x3c, 0xf1, 0xc6, 0xf6, 0x66, 0x59, 0x71, 0xe8, 0x65, 0x77,
-	0x67, 0xe6, 0xf7, 0xde, 0xef, 0xbd, 0xf7, 0x1b, 0xbf, 0xb7, 0x06, 0xcd, 0x39, 0x76, 0x8e, 0x42,
-	0x46, 0x39, 0xb5, 0xa9, 0xa7, 0xdb, 0x1e, 0xed, 0xeb, 0xfc, 0xa8, 0x93, 0x6c, 0x28, 0x97, 0xf3,
-	0x67, 0x9d, 0xf8, 0x4c, 0xbb, 0x66, 0xd3, 0xc8, 0xa7, 0x91, 0x99, 0xec, 0xea, 0xe9, 0x22, 0x45,
-	0x6b, 0x2b, 0xe9, 0x4a, 0xf7, 0x23, 0x57, 0x3f, 0x7c, 0x33, 0xfe, 0x12, 0x07, 0xcb, 0x2e, 0x75,
-	0x69, 0x6a, 0x10, 0xff, 0x12, 0xbb, 0x7a, 0x91, 0xb8, 0xef, 0x51, 0xfb, 0xa1, 0xc9, 0x2c, 0x8e,
-	0x4d, 0x8f, 0xf8, 0x84, 0x9b, 0x36, 0x0d, 0x1e, 0x90, 0xcc, 0xcd, 0x7a, 0xd1, 0x20, 0xfe, 0x30,
-	0x43, 0x8b, 0x30, 0x01, 0xb9, 0x5d, 0x84, 0xe0, 0x83, 0x21, 0xe1, 0xc7, 0x26, 0x27, 0x98, 0x95,
-	0x39, 0x5d, 0x2b, 0x5a, 0xf8, 0x16, 0xb7, 0x07, 0x38, 0xcb, 0xea, 0x7a, 0x11, 0x40, 0x99, 0x83,
-	0x33, 0xc6, 0x9b, 0x15, 0xc7, 0x26, 0xc3, 0x3e, 0x3d, 0xb4, 0xbc, 0xcc, 0xcd, 0x1b, 0x45, 0x9c,
-	0x47, 0x0e, 0x86, 0xc4, 0xb1, 0x38, 0xa1, 0x41, 0x34, 0x12, 0x54, 0xeb, 0x7b, 0x04, 0x97, 0xf7,
-	0x22, 0x77, 0x87, 0x61, 0x8b, 0xe3, 0x1d, 0x8f, 0xf6, 0x7b, 0x16, 0x61, 0xca, 0x36, 0xcc, 0x59,
-	0x43, 0x3e, 0xa0, 0x8c, 0xf0, 0x63, 0x15, 0x35, 0x51, 0x7b, 0xae, 0xab, 0xfe, 0xfe, 0xf3, 0xe6,
-	0xb2, 0x10, 0xe1, 0x63, 0xc7, 0x61, 0x38, 0x8a, 0x3e, 0xe7, 0x8c, 0x04, 0xae, 0x71, 0x06, 0x55,
-	0x3e, 0x80, 0x39, 0x59, 0x27, 0xf5, 0xb9, 0x26, 0x6a, 0xd7, 0xb7, 0x56, 0x3b, 0x05, 0x65, 0x3b,
-	0x19, 0x4f, 0xf7, 0xf9, 0xc7, 0x7f, 0xae, 0xd5, 0x8c, 0x4b, 0xb6, 0x58, 0xbf, 0xbb, 0xf8, 0xe8,
-	0x9f, 0x9f, 0x5e, 0x3f, 0xf3, 0xd7, 0x5a, 0x85, 0x6b, 0x85, 0xe0, 0x0c, 0x1c, 0x85, 0x34, 0x88,
-	0x70, 0x8b, 0xc0, 0xd5, 0xbd, 0xc8, 0xed, 0x31, 0x1a, 0xd2, 0x08, 0x3b, 0xf7, 0x43, 0xcc, 0xd2,
-	0x04, 0x95, 0x1e, 0x2c, 0x51, 0xb9, 0x32, 0x0f, 0x86, 0x78, 0x88, 0x55, 0xd4, 0x9c, 0x69, 0xd7,
-	0xb7, 0xd6, 0x4a, 0x82, 0x91, 0x86, 0x86, 0xf5, 0xad, 0x08, 0xe8, 0xa5, 0x33, 0xf3, 0xcf, 0x62,
-	0xeb, 0xd6, 0x1a, 0x5c, 0x2f, 0xa5, 0x92, 0xb1, 0xdc, 0x85, 0x85, 0x18, 0xe0, 0x59, 0x36, 0xbe,
-	0x1f, 0x6b, 0xa2, 0xdc, 0x81, 0x17, 0x12, 0x71, 0x92, 0xea, 0xd5, 0xb7, 0xd4, 0x32, 0xe2, 0xf8,
-	0x5c, 0x30, 0xa6, 0xe0, 0xd6, 0x4a, 0x9a, 0x92, 0x74, 0x23, 0xfd, 0xff, 0x82, 0x60, 0x31, 0xae,
-	0x84, 0x15, 0xd8, 0xd8, 0x4b, 0x19, 0xde, 0x83, 0x4b, 0xa9, 0xfc, 0xc4, 0x11, 0x24, 0x5a, 0x15,
-	0xc9, 0xa7, 0x8e, 0xa0, 0x99, 0xa5, 0xe9, 0x52, 0xb9, 0x09, 0x8b, 0x2e, 0xa5, 0x8e, 0xc9, 0x89,
-	0x67, 0x26, 0x8f, 0x42, 0xa2, 0xd6, 0xc2, 0x6e, 0xcd, 0x98, 0x8f, 0xf7, 0xf7, 0x89, 0xd7, 0x8d,
-	0x77, 0x15, 0x1d, 0xae, 0x8c, 0xe2, 0x4c, 0x4e, 0x7c, 0xac, 0xce, 0x34, 0x51, 0x7b, 0x76, 0xb7,
-	0x66, 0x2c, 0xe5, 0xc1, 0xfb, 0xc4, 0xc7, 0xdd, 0xa5, 0x9c, 0x63, 0x1a, 0x60, 0xfa, 0xa0, 0xa5,
-	0xc2, 0xcb, 0xa3, 0x91, 0xcb, 0xa4, 0xc4, 0xdd, 0xfb, 0x22, 0x74, 0x9e, 0xdd, 0xbb, 0x37, 0x1a,
-	0x9c, 0x0c, 0xfd, 0x29, 0x82, 0xf9, 0xfc, 0xc5, 0x89, 0xf5, 0x4e, 0x1e, 0x66, 0x21, 0xc5, 0x2b,
-	0x15, 0xcc, 0x7b, 0x31, 0x66, 0xb7, 0x66, 0xa4, 0x60, 0xe5, 0x7d, 0xd0, 0xa2, 0x01, 0x65, 0xdc,
-	0xe4, 0x98, 0xf9, 0x66, 0x2a, 0x67, 0x18, 0xab, 0xef, 0xe3, 0x80, 0x27, 0x49, 0xcc, 0xef, 0xd6,
-	0x8c, 0x95, 0x04, 0xb3, 0x8f, 0x99, 0x9f, 0xd4, 0xaf, 0x97, 0x01, 0x94, 0x4f, 0x60, 0x61, 0xa4,
-	0x03, 0x24, 0xba, 0x54, 0xdc, 0xf2, 0xb4, 0xf2, 0x09, 0x2c, 0x56, 0x99, 0xe6, 0xd6, 0xdd, 0x3a,
-	0xcc, 0xc9, 0x1b, 0xdf, 0xfa, 0x0b, 0xc1, 0x86, 0x4c, 0xfc, 0x6e, 0xd2, 0xd2, 0xf6, 0x09, 0x66,
-	0xf7, 0xe2, 0x86, 0xb6, 0x93, 0xb4, 0x8e, 0x61, 0x8a, 0x9c, 0x5a, 0xa9, 0x00, 0xd4, 0xaa, 0x56,
-	0x29, 0x84, 0xd3, 0x4b, 0x32, 0x18, 0x17, 0x8a, 0x10, 0xf3, 0x2a, 0x2e, 0xc3, 0x14, 0x94, 0xd5,
-	0x61, 0x73, 0xa2, 0x04, 0xa5, 0xda, 0x7f, 0x20, 0xb8, 0x21, 0x2d, 0x92, 0xbb, 0x6e, 0x58, 0x1c,
-	0xff, 0x8f, 0x15, 0x79, 0x08, 0x2b, 0x15, 0x03, 0x49, 0x48, 0xda, 0x29, 0x29, 0xc8, 0x98, 0x40,
-	0x44, 0x3d, 0x96, 0xfb, 0x25, 0x90, 0x42, 0x39, 0x3a, 0x70, 0x6b, 0x92, 0xe4, 0x64, 0x35, 0x7e,
-	0x43, 0xb0, 0x2a, 0x0d, 0xee, 0xe5, 0x26, 0x4b, 0x0a, 0x9f, 0xba, 0x08, 0xdf, 0xc0, 0x95, 0x92,
-	0x39, 0x25, 0x6e, 0xc4, 0x46, 0x49, 0x01, 0x8a, 0xdc, 0x22, 0x6f, 0xc5, 0x2b, 0x9c, 0x14, 0xb2,
-	0xde, 0x80, 0x57, 0xc7, 0x24, 0x91, 0x25, 0xbb, 0xf5, 0xeb, 0x2c, 0xcc, 0xec, 0x45, 0xae, 0x12,
-	0x82, 0x52, 0x32, 0x69, 0xda, 0x25, 0x51, 0x95, 0x0e, 0x0a, 0xed, 0xf6, 0xa4, 0xc8, 0x8c, 0x59,
-	0xf9, 0x12, 0x20, 0x37, 0x4f, 0x9a, 0x15, 0xf6, 0x12, 0xa1, 0xb5, 0x2f, 0x42, 0x48, 0xcf, 0x5f,
-	0x43, 0x3d, 0x3f, 0x48, 0xd6, 0xcb, 0x0d, 0x73, 0x10, 0xed, 0xb5, 0x0b, 0x21, 0xd2, 0xb9, 0x03,
-	0x8b, 0xe7, 0xfe, 0x4c, 0xdc, 0xa8, 0x30, 0x1e, 0x41, 0x69, 0xb7, 0x26, 0x41, 0xe5, 0x59, 0xce,
-	0x8d, 0x8d, 0x0a, 0x96, 0x51, 0x54, 0x15, 0x4b, 0x79, 0x97, 0x57, 0x7e, 0x44, 0xd0, 0x9a, 0xa0,
-	0x0f, 0xbe, 0x33, 0xce, 0xe9, 0x38, 0x4b, 0xed, 0xa3, 0x69, 0x2d, 0x65, 0x88, 0x3f, 0x20, 0x58,
-	0xbf, 0xb8, 0x2f, 0xbd, 0x3d, 0x8e, 0x67, 0x8c, 0xa1, 0xf6, 0xe1, 0x94, 0x86, 0x32, 0xbe, 0x47,
-	0x08, 0xd4, 0xca, 0x4e, 0xd1, 0x19, 0xe7, 0xbd, 0x88, 0xd7, 0xb6, 0xff, 0x1b, 0x3e, 0x0b, 0xa2,
-	0xdb, 0x7b, 0x7c, 0xd2, 0x40, 0x4f, 0x4e, 0x1a, 0xe8, 0xef, 0x93, 0x06, 0xfa, 0xee, 0xb4, 0x51,
-	0x7b, 0x72, 0xda, 0xa8, 0x3d, 0x3d, 0x6d, 0xd4, 0xbe, 0xda, 0x76, 0x09, 0x1f, 0x0c, 0xfb, 0x1d,
-	0x9b, 0xfa, 0xa3, 0x2f, 0x09, 0x87, 0x77, 0x36, 0xed, 0x81, 0x45, 0x02, 0x5d, 0xee, 0x1c, 0x89,
-	0x37, 0x96, 0xe3, 0x10, 0x47, 0xfd, 0x17, 0x93, 0xed, 0xb7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbb, 0xf1, 0x76, 0x35, 0xd3, 0x0c, 0x00, 0x00,
+	// 1319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x27, 0x69, 0xd3, 0xbc, 0xec, 0xa6, 0xad, 0x9b, 0x34, 0x8e, 0x9b, 0x6e, 0x52, 0xd3,
+	0x56, 0xdb, 0xd2, 0xee, 0xb6, 0xa1, 0x2a, 0x08, 0x44, 0xa1, 0x5b, 0x5a, 0x82, 0xd4, 0xa8, 0xa9,
+	0x13, 0x10, 0x02, 0x84, 0xe5, 0xb5, 0xa7, 0x9b, 0x51, 0x6d, 0xcf, 0xc6, 0x33, 0x2e, 0xd9, 0x6b,
+	0x7f, 0x01, 0x37, 0x0e, 0x08, 0x89, 0x9f, 0xc0, 0x81, 0x03, 0x77, 0x2e, 0xbd, 0x20, 0x55, 0x70,
+	0x41, 0x42, 0x02, 0xd4, 0x1e, 0xb8, 0xf1, 0x1b, 0x90, 0xc7, 0xb3, 0xb3, 0x76, 0x6d, 0x6f, 0x96,
+	0x94, 0x03, 0x97, 0xc4, 0x33, 0xf3, 0xbd, 0xef, 0xbd, 0xf7, 0xcd, 0xf3, 0xcc, 0x5b, 0x83, 0xee,
+	0xf6, 0xdd, 0xbd, 0x5e, 0x48, 0x18, 0x71, 0x88, 0xd7, 0x72, 0x3c, 0xd2, 0x69, 0xb1, 0xbd, 0x26,
+	0x9f, 0x50, 0x8f, 0xa7, 0xd7, 0x9a, 0xf1, 0x9a, 0xbe, 0xe4, 0x10, 0xea, 0x13, 0x6a, 0xf1, 0xd9,
+	0x56, 0x32, 0x48, 0xd0, 0xfa, 0x62, 0x32, 0x6a, 0xf9, 0xb4, 0xdb, 0x7a, 0x74, 0x35, 0xfe, 0x27,
+	0x16, 0xe6, 0xbb, 0xa4, 0x4b, 0x12, 0x83, 0xf8, 0x49, 0xcc, 0xb6, 0xf2, 0x8e, 0x3b, 0x1e, 0x71,
+	0x1e, 0x5a, 0xa1, 0xcd, 0x90, 0xe5, 0x61, 0x1f, 0x33, 0xcb, 0x21, 0xc1, 0x03, 0x3c, 0xa0, 0x39,
+	0x93, 0x37, 0x88, 0xff, 0x58, 0x3d, 0x1b, 0x87, 0x02, 0x72, 0x25, 0x0f, 0x41, 0xbb, 0x11, 0x66,
+	0x7d, 0x8b, 0x61, 0x14, 0x16, 0x91, 0xae, 0xe4, 0x2d, 0x7c, 0x9b, 0x39, 0x3b, 0x68, 0x90, 0xd5,
+	0xe9, 0x3c, 0x80, 0x84, 0x2e, 0x1a, 0x78, 0x3c, 0x5f, 0xb2, 0x6c, 0x85, 0xc8, 0x27, 0x8f, 0x6c,
+	0x6f, 0x40, 0xf3, 0x6a, 0x1e, 0xe7, 0xe1, 0xdd, 0x08, 0xbb, 0x36, 0xc3, 0x24, 0xa0, 0xd9, 0xa0,
+	0x2e, 0x64, 0xc0, 0x34, 0xea, 0xd8, 0x8e, 0x43, 0xa2, 0x80, 0xd1, 0xd4, 0x73, 0x02, 0x35, 0xbe,
+	0x56, 0xe0, 0xf8, 0x06, 0xed, 0xde, 0x0a, 0x91, 0xcd, 0xd0, 0x2d, 0x8f, 0x74, 0x36, 0x6d, 0x1c,
+	0xaa, 0xd7, 0x61, 0xc6, 0x8e, 0xd8, 0x0e, 0x09, 0x31, 0xeb, 0x6b, 0xca, 0xaa, 0xd2, 0x98, 0x69,
+	0x6b, 0x3f, 0x7f, 0x7f, 0x79, 0x5e, 0xec, 0xd7, 0x4d, 0xd7, 0x0d, 0x11, 0xa5, 0x5b, 0x2c, 0xc4,
+	0x41, 0xd7, 0x1c, 0x42, 0xd5, 0x1b, 0x30, 0x23, 0x25, 0xd5, 0x26, 0x56, 0x95, 0xc6, 0xec, 0xda,
+	0xa9, 0x66, 0xae, 0x08, 0x9a, 0x03, 0x3f, 0xed, 0xa9, 0x27, 0xbf, 0xaf, 0x54, 0xcc, 0x23, 0x8e,
+	0x18, 0xbf, 0x39, 0xf7, 0xf8, 0xaf, 0xef, 0x2e, 0x0e, 0xf9, 0x8c, 0x53, 0xb0, 0x94, 0x0b, 0xce,
+	0x44, 0xb4, 0x47, 0x02, 0x8a, 0x0c, 0x0c, 0x0b, 0x1b, 0xb4, 0xbb, 0x19, 0x92, 0x1e, 0xa1, 0xc8,
+	0xbd, 0xd7, 0x43, 0x61, 0xa2, 0x85, 0xba, 0x09, 0xc7, 0x88, 0x1c, 0x59, 0xbb, 0x11, 0x8a, 0x90,
+	0xa6, 0xac, 0x4e, 0x36, 0x66, 0xd7, 0x56, 0x0a, 0x82, 0x91, 0x86, 0xa6, 0xfd, 0x85, 0x08, 0xe8,
+	0xe8, 0xd0, 0xfc, 0x7e, 0x6c, 0x6d, 0xac, 0xc0, 0xe9, 0x42, 0x57, 0x32, 0x96, 0xdb, 0x50, 0x8b,
+	0x01, 0x9e, 0xed, 0xa0, 0x7b, 0xf1, 0xf6, 0xa9, 0xd7, 0xe0, 0x10, 0xdf, 0x47, 0xae, 0xde, 0xec,
+	0x9a, 0x56, 0xe4, 0x38, 0x5e, 0x17, 0x1e, 0x13, 0xb0, 0xb1, 0x08, 0x0b, 0x19, 0x1a, 0xc9, 0xff,
+	0x83, 0x02, 0x73, 0xb1, 0x12, 0x76, 0xe0, 0x20, 0x2f, 0xf1, 0xf0, 0x16, 0x1c, 0x49, 0x2a, 0x05,
+	0xbb, 0xc2, 0x89, 0x5e, 0xe6, 0xe4, 0x03, 0x57, 0xb8, 0x99, 0x26, 0xc9, 0x50, 0x3d, 0x0f, 0x73,
+	0x5d, 0x42, 0x5c, 0x8b, 0x61, 0xcf, 0xe2, 0x6f, 0x0d, 0xdf, 0xad, 0xda, 0x7a, 0xc5, 0xac, 0xc6,
+	0xf3, 0xdb, 0xd8, 0x6b, 0xc7, 0xb3, 0x6a, 0x0b, 0x4e, 0x64, 0x71, 0x16, 0xc3, 0x3e, 0xd2, 0x26,
+	0x57, 0x95, 0xc6, 0xf4, 0x7a, 0xc5, 0x3c, 0x96, 0x06, 0x6f, 0x63, 0x1f, 0xb5, 0x8f, 0xa5, 0x88,
+	0x49, 0x80, 0xc8, 0x03, 0x43, 0x83, 0x93, 0xd9, 0xc8, 0x65, 0x52, 0x7f, 0x2b, 0x7c, 0xa9, 0x1d,
+	0xbf, 0x2f, 0x3c, 0xe7, 0x9b, 0x81, 0x9b, 0xe0, 0xd4, 0xfb, 0x50, 0x1b, 0x96, 0xea, 0x30, 0xc3,
+	0xf3, 0xd9, 0x0c, 0x87, 0x10, 0xda, 0xdc, 0x92, 0xcf, 0x32, 0xdb, 0x2a, 0x4d, 0xcd, 0xa9, 0x37,
+	0x61, 0xda, 0xe1, 0xe4, 0x54, 0x9b, 0xe0, 0xc5, 0x70, 0xa6, 0x40, 0xae, 0x6c, 0xa4, 0x03, 0xd5,
+	0x84, 0x9d, 0x7a, 0x03, 0x0e, 0xf7, 0xe2, 0x38, 0xa9, 0x36, 0xc9, 0x19, 0x56, 0x8b, 0x19, 0x86,
+	0xfb, 0x27, 0x08, 0x84, 0x95, 0xf1, 0x93, 0x02, 0xf5, 0xe2, 0x84, 0x07, 0x9a, 0xa8, 0x1f, 0xc1,
+	0x5c, 0xe2, 0xcd, 0x0a, 0x11, 0x8d, 0x3c, 0x46, 0x45, 0xe5, 0x5e, 0x28, 0x70, 0xc5, 0x79, 0xb2,
+	0xc2, 0x46, 0x1e, 0x13, 0x3e, 0x6b, 0xce, 0x80, 0x38, 0x66, 0x51, 0xb7, 0xa0, 0xc6, 0x83, 0x90,
+	0xb4, 0x89, 0x06, 0x8d, 0x32, 0xda, 0x4c, 0x0d, 0x0e, 0x59, 0xab, 0x9c, 0x44, 0x90, 0x1a, 0x0f,
+	0xe1, 0x64, 0x71, 0x0c, 0x2f, 0x57, 0x9c, 0xf3, 0x70, 0x08, 0x85, 0x21, 0x49, 0x4e, 0x90, 0x19,
+	0x33, 0x19, 0x18, 0x4f, 0x14, 0x58, 0x28, 0x0c, 0xed, 0xe5, 0x9c, 0xbd, 0x07, 0x75, 0xd2, 0x63,
+	0xd8, 0xc7, 0x94, 0x61, 0xc7, 0xf6, 0xbc, 0xbe, 0xf5, 0x00, 0x7b, 0x1e, 0x72, 0xad, 0xdd, 0xc8,
+	0x0e, 0x58, 0xe4, 0x53, 0x1e, 0xc5, 0x94, 0xb9, 0x9c, 0x45, 0xdd, 0xe1, 0xa0, 0xfb, 0x02, 0xa3,
+	0x9e, 0x84, 0xc3, 0x94, 0xd9, 0x2c, 0xa2, 0xfc, 0xd5, 0xa8, 0x99, 0x62, 0x34, 0x4c, 0x65, 0x2a,
+	0x9d, 0xca, 0xfb, 0x70, 0x74, 0x83, 0x76, 0x4d, 0xd4, 0x7b, 0xd9, 0xf3, 0x62, 0x09, 0x16, 0x5f,
+	0x20, 0x92, 0x2f, 0x97, 0x38, 0xd8, 0x3f, 0xec, 0xb9, 0xff, 0xdf, 0x83, 0x3d, 0x1b, 0x9c, 0x0c,
+	0xfd, 0x97, 0x09, 0xa8, 0xa6, 0x4f, 0xe5, 0x58, 0x1c, 0x7e, 0xa9, 0x0a, 0x71, 0x96, 0x4b, 0x3c,
+	0x6f, 0xc4, 0x98, 0xf5, 0x8a, 0x99, 0x80, 0xd5, 0xb7, 0x41, 0xa7, 0x3b, 0x24, 0x64, 0x16, 0x43,
+	0xa1, 0x6f, 0x25, 0x15, 0xc2, 0x85, 0xf2, 0x51, 0xc0, 0x78, 0x12, 0xd5, 0xf5, 0x8a, 0xb9, 0xc8,
+	0x31, 0xdb, 0x28, 0xf4, 0xb9, 0x7e, 0x9b, 0x03, 0x80, 0x7a, 0x07, 0x6a, 0x99, 0x9b, 0x98, 0xef,
+	0x6c, 0xc9, 0x15, 0x92, 0x28, 0xcf, 0x61, 0xf1, 0x11, 0x4a, 0x52, 0x63, 0xb5, 0x0f, 0xab, 0xb9,
+	0x30, 0x3a, 0x71, 0x80, 0xa9, 0x60, 0xa6, 0x38, 0x75, 0xab, 0x80, 0x7a, 0x2b, 0x13, 0xdd, 0xb0,
+	0xfe, 0x63, 0xb3, 0xf5, 0x8a, 0xb9, 0x4c, 0x47, 0xac, 0xb7, 0x67, 0x61, 0x46, 0xde, 0x64, 0xc6,
+	0x3d, 0x58, 0x1e, 0x45, 0xa6, 0x2e, 0xc1, 0x11, 0xb6, 0x67, 0x75, 0xfa, 0x0c, 0x51, 0xae, 0x73,
+	0xd5, 0x9c, 0x66, 0x7b, 0xed, 0x78, 0x18, 0x57, 0x31, 0x0e, 0x5c, 0xb4, 0x97, 0x5c, 0x12, 0x66,
+	0x32, 0x30, 0xfe, 0x50, 0xe0, 0x9c, 0xdc, 0xc4, 0xdb, 0xbc, 0x4d, 0xda, 0xc6, 0x28, 0xbc, 0x1b,
+	0x37, 0x49, 0xb7, 0x78, 0x3b, 0x12, 0x25, 0xae, 0x0f, 0x5c, 0x75, 0x01, 0x68, 0x65, 0xed, 0x97,
+	0x36, 0x51, 0x2a, 0xd9, 0xa8, 0x50, 0x44, 0x61, 0x2e, 0xa0, 0x22, 0x4c, 0xae, 0x4a, 0x5b, 0x70,
+	0x79, 0xac, 0x04, 0x65, 0xe5, 0xfe, 0xa6, 0xc0, 0x59, 0x69, 0xc1, 0x2f, 0x45, 0xd3, 0x66, 0xe8,
+	0x3f, 0x54, 0xe4, 0x21, 0x2c, 0x96, 0x34, 0xb9, 0xa2, 0x3c, 0x9b, 0x45, 0x07, 0x7a, 0x79, 0x20,
+	0x42, 0x8f, 0xf9, 0x4e, 0x01, 0x24, 0x27, 0x47, 0x13, 0x2e, 0x8d, 0x93, 0x9c, 0x54, 0xe3, 0x47,
+	0x05, 0x4e, 0x49, 0x83, 0xbb, 0xa9, 0x6e, 0x35, 0x81, 0x1f, 0x58, 0x84, 0xcf, 0xe0, 0x44, 0x41,
+	0xef, 0x2b, 0x2a, 0xe2, 0x5c, 0x81, 0x00, 0x79, 0xdf, 0x22, 0x6f, 0xd5, 0xcb, 0xad, 0xe4, 0xb2,
+	0x3e, 0x07, 0xaf, 0x8c, 0x48, 0x62, 0x90, 0xec, 0xda, 0x57, 0x33, 0x30, 0xb9, 0x41, 0xbb, 0x6a,
+	0x0f, 0xd4, 0x82, 0x96, 0xb4, 0x51, 0xd2, 0x29, 0xe4, 0x90, 0xfa, 0x95, 0x71, 0x91, 0xb2, 0x65,
+	0xf8, 0x18, 0x20, 0xd5, 0x78, 0xee, 0xdb, 0x93, 0xe8, 0x8d, 0xfd, 0x10, 0x92, 0xf9, 0x53, 0x98,
+	0x4d, 0x77, 0x9c, 0xfb, 0x37, 0x4c, 0xfa, 0x85, 0x7d, 0x21, 0x92, 0x9c, 0xc2, 0x89, 0xa2, 0xce,
+	0xaf, 0x84, 0xa1, 0x00, 0xaa, 0x5f, 0x1d, 0x1b, 0x2a, 0x9d, 0x7e, 0x0e, 0xd5, 0xcc, 0xb5, 0x6b,
+	0x14, 0x53, 0xa4, 0x31, 0xfa, 0xc5, 0xfd, 0x31, 0x92, 0xdf, 0x85, 0xb9, 0x17, 0x7e, 0x4a, 0x9d,
+	0x2d, 0x51, 0x24, 0x83, 0xd2, 0x2f, 0x8d, 0x83, 0x4a, 0x7b, 0x79, 0xe1, 0x5e, 0x2f, 0xf1, 0x92,
+	0x45, 0xe9, 0x97, 0xc6, 0x41, 0x49, 0x2f, 0xdf, 0x2a, 0x60, 0x8c, 0x71, 0xb8, 0xbf, 0x31, 0x8a,
+	0x74, 0x94, 0xa5, 0xfe, 0xee, 0x41, 0x2d, 0x65, 0x88, 0xdf, 0x28, 0x70, 0x66, 0xff, 0xc3, 0xf6,
+	0xf5, 0x51, 0x7e, 0x46, 0x18, 0xea, 0xef, 0x1c, 0xd0, 0x50, 0xc6, 0xf7, 0x58, 0x01, 0xad, 0xf4,
+	0xf8, 0x6b, 0x8e, 0x62, 0xcf, 0xe3, 0xf5, 0xeb, 0xff, 0x0e, 0x3f, 0x08, 0xa2, 0xbd, 0xf9, 0xe4,
+	0x59, 0x5d, 0x79, 0xfa, 0xac, 0xae, 0xfc, 0xf9, 0xac, 0xae, 0x7c, 0xf9, 0xbc, 0x5e, 0x79, 0xfa,
+	0xbc, 0x5e, 0xf9, 0xf5, 0x79, 0xbd, 0xf2, 0xc9, 0xf5, 0x2e, 0x66, 0x3b, 0x51, 0xa7, 0xe9, 0x10,
+	0x3f, 0xfb, 0x35, 0xe5, 0xd1, 0xb5, 0xcb, 0xce, 0x8e, 0x8d, 0x83, 0x96, 0x9c, 0xd9, 0x13, 0x9f,
+	0x76, 0xfa, 0x3d, 0x44, 0x3b, 0x87, 0xf9, 0xf4, 0x6b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3b,
+	0x14, 0x18, 0x4b, 0xfc, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1016,6 +1458,12 @@ type MsgClient interface {
 	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
 	// CancelOrder allows accounts to cancel existing orders on the orderbook.
 	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// BatchPlaceAndCancel allows accounts to atomically cancel and place
+	// multiple Short-Term orders for a single subaccount.
+	BatchPlaceAndCancel(ctx context.Context, in *MsgBatchPlaceAndCancel, opts ...grpc.CallOption) (*MsgBatchPlaceAndCancelResponse, error)
+	// ReplaceOrder allows accounts to atomically replace an existing order on
+	// the orderbook with a new version of the order.
+	ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error)
 	// CreateClobPair creates a new clob pair.
 	CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error)
 	// UpdateClobPair sets the status of a clob pair. Should return an error
@@ -1068,6 +1516,24 @@ func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ..
 	return out, nil
 }
 
+func (c *msgClient) BatchPlaceAndCancel(ctx context.Context, in *MsgBatchPlaceAndCancel, opts ...grpc.CallOption) (*MsgBatchPlaceAndCancelResponse, error) {
+	out := new(MsgBatchPlaceAndCancelResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/BatchPlaceAndCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error) {
+	out := new(MsgReplaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error) {
 	out := new(MsgCreateClobPairResponse)
 	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CreateClobPair", in, out, opts...)
@@ -1122,6 +1588,12 @@ type MsgServer interface {
 	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
 	// CancelOrder allows accounts to cancel existing orders on the orderbook.
 	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	// BatchPlaceAndCancel allows accounts to atomically cancel and place
+	// multiple Short-Term orders for a single subaccount.
+	BatchPlaceAndCancel(context.Context, *MsgBatchPlaceAndCancel) (*MsgBatchPlaceAndCancelResponse, error)
+	// ReplaceOrder allows accounts to atomically replace an existing order on
+	// the orderbook with a new version of the order.
+	ReplaceOrder(context.Context, *MsgReplaceOrder) (*MsgReplaceOrderResponse, error)
 	// CreateClobPair creates a new clob pair.
 	CreateClobPair(context.Context, *MsgCreateClobPair) (*MsgCreateClobPairResponse, error)
 	// UpdateClobPair sets the status of a clob pair. Should return an error
@@ -1152,6 +1624,12 @@ func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrde
 func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (*UnimplementedMsgServer) BatchPlaceAndCancel(ctx context.Context, req *MsgBatchPlaceAndCancel) (*MsgBatchPlaceAndCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPlaceAndCancel not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceOrder(ctx context.Context, req *MsgReplaceOrder) (*MsgReplaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
 func (*UnimplementedMsgServer) CreateClobPair(ctx context.Context, req *MsgCreateClobPair) (*MsgCreateClobPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateClobPair not implemented")
 }
@@ -1226,7 +1704,43 @@ func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Msg_BatchPlaceAndCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchPlaceAndCancel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchPlaceAndCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/BatchPlaceAndCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchPlaceAndCancel(ctx, req.(*MsgBatchPlaceAndCancel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceOrder(ctx, req.(*MsgReplaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateClobPair)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -1332,6 +1846,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Msg_CancelOrder_Handler,
 		},
+		{
+			MethodName: "BatchPlaceAndCancel",
+			Handler:    _Msg_BatchPlaceAndCancel_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Msg_ReplaceOrder_Handler,
+		},
 		{
 			MethodName: "CreateClobPair",
 			Handler:    _Msg_CreateClobPair_Handler,
@@ -1626,7 +2148,7 @@ func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchPlaceAndCancel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1636,18 +2158,46 @@ func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchPlaceAndCancel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchPlaceAndCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Places) > 0 {
+		for iNdEx := len(m.Places) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Places[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Cancels) > 0 {
+		for iNdEx := len(m.Cancels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cancels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1655,18 +2205,11 @@ func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchPlaceAndCancelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1676,20 +2219,48 @@ func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchPlaceAndCancelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchPlaceAndCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PlaceResults) > 0 {
+		for iNdEx := len(m.PlaceResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlaceResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CancelResults) > 0 {
+		for iNdEx := len(m.CancelResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CancelResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
+func (m *BatchCancelOrderResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1699,87 +2270,87 @@ func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperationRaw) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchCancelOrderResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperationRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchCancelOrderResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Operation != nil {
-		{
-			size := m.Operation.Size()
-			i -= size
-			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *OperationRaw_Match) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchPlaceOrderResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OperationRaw_Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Match != nil {
-		{
-			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *OperationRaw_ShortTermOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *BatchPlaceOrderResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperationRaw_ShortTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchPlaceOrderResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ShortTermOrderPlacement != nil {
-		i -= len(m.ShortTermOrderPlacement)
-		copy(dAtA[i:], m.ShortTermOrderPlacement)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortTermOrderPlacement)))
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *OperationRaw_OrderRemoval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OperationRaw_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OrderRemoval != nil {
-		{
-			size, err := m.OrderRemoval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
+	}
+	if m.OptimisticallyFilledQuantums != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OptimisticallyFilledQuantums))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err error) {
+
+func (m *MsgReplaceOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1789,18 +2360,18 @@ func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReplaceOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReplaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1808,18 +2379,11 @@ func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgReplaceOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1829,12 +2393,12 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReplaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReplaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1842,7 +2406,7 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1852,18 +2416,18 @@ func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1871,7 +2435,7 @@ func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte)
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1882,7 +2446,7 @@ func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1892,12 +2456,12 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1905,7 +2469,7 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
+func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1915,267 +2479,402 @@ func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateLiquidationsConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperationRaw) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OperationRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Operation != nil {
+		{
+			size := m.Operation.Size()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateLiquidationsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperationRaw_Match) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OperationRaw_Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.Match != nil {
+		{
+			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func (m *MsgCreateClobPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ClobPair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *OperationRaw_ShortTermOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperationRaw_ShortTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShortTermOrderPlacement != nil {
+		i -= len(m.ShortTermOrderPlacement)
+		copy(dAtA[i:], m.ShortTermOrderPlacement)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortTermOrderPlacement)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_OrderRemoval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProposedOperations) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OperationsQueue) > 0 {
-		for _, e := range m.OperationsQueue {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *OperationRaw_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderRemoval != nil {
+		{
+			size, err := m.OrderRemoval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_ShortTermOrderBatchPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProposedOperationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperationRaw_ShortTermOrderBatchPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShortTermOrderBatchPlacement != nil {
+		{
+			size, err := m.ShortTermOrderBatchPlacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *MsgPlaceOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShortTermOrderBatchPlacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPlaceOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *ShortTermOrderBatchPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShortTermOrderBatchPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.OrderId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.GoodTilOneof != nil {
-		n += m.GoodTilOneof.Size()
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
-func (m *MsgCancelOrder_GoodTilBlock) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	n += 1 + sovTx(uint64(m.GoodTilBlock))
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MsgCancelOrder_GoodTilBlockTime) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 5
-	return n
+	return dAtA[:n], nil
 }
-func (m *MsgCancelOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *MsgUpdateEquityTierLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateClobPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ClobPair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OperationRaw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Operation != nil {
-		n += m.Operation.Size()
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperationRaw_Match) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Match != nil {
-		l = m.Match.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *OperationRaw_ShortTermOrderPlacement) Size() (n int) {
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationsConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClobPair) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShortTermOrderPlacement != nil {
-		l = len(m.ShortTermOrderPlacement)
+	l = len(m.Authority)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.ClobPair.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
-func (m *OperationRaw_OrderRemoval) Size() (n int) {
+
+func (m *MsgCreateClobPairResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.OrderRemoval != nil {
-		l = m.OrderRemoval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgProposedOperations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperationsQueue) > 0 {
+		for _, e := range m.OperationsQueue {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	return n
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) Size() (n int) {
+
+func (m *MsgProposedOperationsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = m.EquityTierLimitConfig.Size()
+	var l int
+	_ = l
+	l = m.Order.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
+func (m *MsgPlaceOrderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2184,61 +2883,922 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Size() (n int) {
+func (m *MsgCancelOrder) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	l = m.OrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GoodTilOneof != nil {
+		n += m.GoodTilOneof.Size()
 	}
-	l = m.BlockRateLimitConfig.Size()
+	return n
+}
+
+func (m *MsgCancelOrder_GoodTilBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.GoodTilBlock))
+	return n
+}
+func (m *MsgCancelOrder_GoodTilBlockTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 5
+	return n
+}
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBatchPlaceAndCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.Cancels) > 0 {
+		for _, e := range m.Cancels {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Places) > 0 {
+		for _, e := range m.Places {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Size() (n int) {
+func (m *MsgBatchPlaceAndCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelResults) > 0 {
+		for _, e := range m.CancelResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PlaceResults) > 0 {
+		for _, e := range m.PlaceResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchCancelOrderResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgUpdateLiquidationsConfig) Size() (n int) {
+func (m *BatchPlaceOrderResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = m.OrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OptimisticallyFilledQuantums != 0 {
+		n += 1 + sovTx(uint64(m.OptimisticallyFilledQuantums))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.Error)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.LiquidationsConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *MsgReplaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReplaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateClobPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ClobPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OperationRaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		n += m.Operation.Size()
+	}
+	return n
+}
+
+func (m *OperationRaw_Match) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Match != nil {
+		l = m.Match.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_ShortTermOrderPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortTermOrderPlacement != nil {
+		l = len(m.ShortTermOrderPlacement)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_OrderRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderRemoval != nil {
+		l = m.OrderRemoval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_ShortTermOrderBatchPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortTermOrderBatchPlacement != nil {
+		l = m.ShortTermOrderBatchPlacement.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *ShortTermOrderBatchPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.EquityTierLimitConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BlockRateLimitConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLiquidationsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LiquidationsConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClobPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClobPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposedOperations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationsQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationsQueue = append(m.OperationsQueue, OperationRaw{})
+			if err := m.OperationsQueue[len(m.OperationsQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposedOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{v}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchPlaceAndCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2261,17 +3821,17 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClobPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchPlaceAndCancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchPlaceAndCancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2281,27 +3841,28 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2328,7 +3889,42 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cancels = append(m.Cancels, MsgCancelOrder{})
+			if err := m.Cancels[len(m.Cancels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Places", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Places = append(m.Places, MsgPlaceOrder{})
+			if err := m.Places[len(m.Places)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2353,7 +3949,7 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchPlaceAndCancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2376,12 +3972,80 @@ func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClobPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchPlaceAndCancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchPlaceAndCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelResults = append(m.CancelResults, BatchCancelOrderResult{})
+			if err := m.CancelResults[len(m.CancelResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlaceResults = append(m.PlaceResults, BatchPlaceOrderResult{})
+			if err := m.PlaceResults[len(m.PlaceResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2403,7 +4067,7 @@ func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
+func (m *BatchCancelOrderResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2426,15 +4090,15 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposedOperations: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchCancelOrderResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchCancelOrderResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationsQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2461,11 +4125,42 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperationsQueue = append(m.OperationsQueue, OperationRaw{})
-			if err := m.OperationsQueue[len(m.OperationsQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2487,7 +4182,7 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchPlaceOrderResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2510,67 +4205,88 @@ func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposedOperationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchPlaceOrderResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchPlaceOrderResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticallyFilledQuantums", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.OptimisticallyFilledQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptimisticallyFilledQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2580,24 +4296,23 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2620,57 +4335,7 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2693,15 +4358,15 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2728,41 +4393,10 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
-			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{v}
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2784,7 +4418,7 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2807,10 +4441,10 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3131,6 +4765,144 @@ func (m *OperationRaw) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &OperationRaw_OrderRemoval{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermOrderBatchPlacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ShortTermOrderBatchPlacement{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &OperationRaw_ShortTermOrderBatchPlacement{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShortTermOrderBatchPlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShortTermOrderBatchPlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShortTermOrderBatchPlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13,6 +13,12 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 )
 
+const (
+	// FlagLimit is the flag used to specify the maximum number of the most recent funding index snapshots
+	// to return. Defaults to returning all stored snapshots.
+	FlagLimit = "limit"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group perpetuals queries under a subcommand
@@ -26,6 +32,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListPerpetual())
 	cmd.AddCommand(CmdShowPerpetual())
+	cmd.AddCommand(CmdQueryPremiumSamples())
+	cmd.AddCommand(CmdQueryPremiumVotes())
+	cmd.AddCommand(CmdQueryPredictedFundingRates())
+	cmd.AddCommand(CmdQueryFundingIndexHistory())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
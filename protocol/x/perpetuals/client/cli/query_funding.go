@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryPremiumSamples() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-premium-samples",
+		Short: "get the premium samples collected during the current funding-tick epoch",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PremiumSamples(
+				context.Background(),
+				&types.QueryPremiumSamplesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPremiumVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-premium-votes",
+		Short: "get the premium votes collected during the current funding-sample epoch",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PremiumVotes(
+				context.Background(),
+				&types.QueryPremiumVotesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPredictedFundingRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-predicted-funding-rates",
+		Short: "get the predicted funding rates of all perpetuals for the current funding-tick epoch",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PredictedFundingRates(
+				context.Background(),
+				&types.QueryPredictedFundingRatesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFundingIndexHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-funding-index-history [id]",
+		Short: "get the most recent funding index snapshots of a perpetual",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argLimit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FundingIndexHistory(
+				context.Background(),
+				&types.QueryFundingIndexHistoryRequest{
+					Id:    argId,
+					Limit: argLimit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(
+		FlagLimit,
+		0,
+		"Maximum number of the most recent funding index snapshots to return. Defaults to all stored snapshots.",
+	)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -44,6 +44,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			elem.Params.AtomicResolution,
 			elem.Params.DefaultFundingPpm,
 			elem.Params.LiquidityTier,
+			elem.Params.FundingRateBounds,
+			elem.Params.InterestRatePpm,
+			elem.Params.InsuranceFundScope,
 		)
 
 		if err != nil {
@@ -158,6 +158,7 @@ func TestGenesis_Failure(t *testing.T) {
 				Params: types.Params{
 					FundingRateClampFactorPpm: tc.fundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: tc.premiumVoteClampFactorPpm,
+					FundingRatePeriodSeconds:  28_800,
 				},
 				Perpetuals: []types.Perpetual{
 					{
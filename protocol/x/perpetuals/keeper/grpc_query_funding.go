@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PremiumSamples(
+	c context.Context,
+	req *types.QueryPremiumSamplesRequest,
+) (
+	*types.QueryPremiumSamplesResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPremiumSamplesResponse{
+		PremiumSamples: k.GetPremiumSamples(ctx),
+	}, nil
+}
+
+func (k Keeper) PremiumVotes(
+	c context.Context,
+	req *types.QueryPremiumVotesRequest,
+) (
+	*types.QueryPremiumVotesResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPremiumVotesResponse{
+		PremiumVotes: k.GetPremiumVotes(ctx),
+	}, nil
+}
+
+func (k Keeper) PredictedFundingRates(
+	c context.Context,
+	req *types.QueryPredictedFundingRatesRequest,
+) (
+	*types.QueryPredictedFundingRatesResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	predictedFundingRates, err := k.GetPredictedFundingRates(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPredictedFundingRatesResponse{
+		PredictedFundingRates: predictedFundingRates,
+	}, nil
+}
+
+func (k Keeper) FundingIndexHistory(
+	c context.Context,
+	req *types.QueryFundingIndexHistoryRequest,
+) (
+	*types.QueryFundingIndexHistoryResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasPerpetual(ctx, req.Id) {
+		return nil,
+			status.Error(
+				codes.NotFound,
+				fmt.Sprintf(
+					"Perpetual id %+v not found.",
+					req.Id,
+				),
+			)
+	}
+
+	// Only return the most recent `limit` snapshots, if a limit is provided.
+	snapshots := k.GetFundingIndexHistory(ctx, req.Id).Snapshots
+	if req.Limit != 0 && uint32(len(snapshots)) > req.Limit {
+		snapshots = snapshots[uint32(len(snapshots))-req.Limit:]
+	}
+
+	return &types.QueryFundingIndexHistoryResponse{
+		Snapshots: snapshots,
+	}, nil
+}
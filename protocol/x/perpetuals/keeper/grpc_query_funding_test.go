@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+func TestPremiumSamplesQuery(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	wctx := sdk.WrapSDKContext(pc.Ctx)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 2)
+	keepertest.PopulateTestPremiumStore(
+		t,
+		pc.Ctx,
+		pc.PerpetualsKeeper,
+		perps,
+		[]int32{1_000, -2_000},
+		false, // isVote
+	)
+
+	_, err := pc.PerpetualsKeeper.PremiumSamples(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	response, err := pc.PerpetualsKeeper.PremiumSamples(wctx, &types.QueryPremiumSamplesRequest{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&types.QueryPremiumSamplesResponse{
+			PremiumSamples: types.PremiumStore{
+				AllMarketPremiums: []types.MarketPremiums{
+					{PerpetualId: perps[0].Params.Id, Premiums: []int32{1_000, -2_000}},
+					{PerpetualId: perps[1].Params.Id, Premiums: []int32{1_000, -2_000}},
+				},
+				NumPremiums: 2,
+			},
+		},
+		response,
+	)
+}
+
+func TestPremiumVotesQuery(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	wctx := sdk.WrapSDKContext(pc.Ctx)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+	keepertest.PopulateTestPremiumStore(
+		t,
+		pc.Ctx,
+		pc.PerpetualsKeeper,
+		perps,
+		[]int32{500},
+		true, // isVote
+	)
+
+	_, err := pc.PerpetualsKeeper.PremiumVotes(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	response, err := pc.PerpetualsKeeper.PremiumVotes(wctx, &types.QueryPremiumVotesRequest{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&types.QueryPremiumVotesResponse{
+			PremiumVotes: types.PremiumStore{
+				AllMarketPremiums: []types.MarketPremiums{
+					{PerpetualId: perps[0].Params.Id, Premiums: []int32{500}},
+				},
+				NumPremiums: 1,
+			},
+		},
+		response,
+	)
+}
+
+func TestPredictedFundingRatesQuery(t *testing.T) {
+	tests := map[string]struct {
+		testPerpetual                 types.Perpetual
+		testRemovedTailSampleRatioPpm uint32
+		testFundingSamples            []int32
+		expectedPredictedFundingRate  types.PredictedFundingRate
+	}{
+		"Success: no premium samples": {
+			testPerpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			expectedPredictedFundingRate: types.PredictedFundingRate{
+				PerpetualId: constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+			},
+		},
+		"Success: 60 equivalent samples of 0.001 percent, 60 samples expected": {
+			testPerpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			// Premium sample = 0.001%, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(1000, 60),
+			expectedPredictedFundingRate: types.PredictedFundingRate{
+				PerpetualId:    constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+				PremiumPpm:     1_000,
+				FundingRatePpm: 1_000,
+			},
+		},
+		"Success: samples which have not been collected yet are padded with zeros": {
+			testPerpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			// Premium sample = 0.001%, length = 30.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(1000, 30),
+			expectedPredictedFundingRate: types.PredictedFundingRate{
+				PerpetualId:    constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+				PremiumPpm:     500,
+				FundingRatePpm: 500,
+			},
+		},
+		"Success: outlier samples are removed by tail sample removal": {
+			testPerpetual:                 constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			testRemovedTailSampleRatioPpm: 50_000, // 5%
+			// 59 samples of 0.001% and 1 outlier sample of 0.1%.
+			testFundingSamples: append(
+				constants.GenerateConstantFundingPremiums(1000, 59),
+				100_000,
+			),
+			expectedPredictedFundingRate: types.PredictedFundingRate{
+				PerpetualId:    constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+				PremiumPpm:     1_000,
+				FundingRatePpm: 1_000,
+			},
+		},
+		"Success: interest rate is added to the premium": {
+			testPerpetual: constants.BtcUsd_0_001Percent_Interest_10AtomicResolution,
+			// Premium sample = 0.001%, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(1000, 60),
+			expectedPredictedFundingRate: types.PredictedFundingRate{
+				PerpetualId:    constants.BtcUsd_0_001Percent_Interest_10AtomicResolution.GetId(),
+				PremiumPpm:     1_000,
+				InterestPpm:    1_000,
+				FundingRatePpm: 2_000,
+			},
+		},
+		"Success: funding rate is clamped to the funding rate bounds of the perpetual": {
+			testPerpetual: constants.BtcUsd_0_001Percent_FundingRateBounds_10AtomicResolution,
+			// Premium sample = 0.2%, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(2000, 60),
+			expectedPredictedFundingRate: types.PredictedFundingRate{
+				PerpetualId:    constants.BtcUsd_0_001Percent_FundingRateBounds_10AtomicResolution.GetId(),
+				PremiumPpm:     2_000,
+				FundingRatePpm: 1_000,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := keepertest.PerpetualsKeepers(t)
+			wctx := sdk.WrapSDKContext(pc.Ctx)
+			keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+			// 60 funding samples are expected per funding-tick epoch.
+			require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochstypes.EpochInfo{
+				Name:     string(epochstypes.FundingTickEpochInfoName),
+				Duration: 3600,
+			}))
+			require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochstypes.EpochInfo{
+				Name:     string(epochstypes.FundingSampleEpochInfoName),
+				Duration: 60,
+			}))
+
+			params := pc.PerpetualsKeeper.GetParams(pc.Ctx)
+			params.RemovedTailSampleRatioPpm = tc.testRemovedTailSampleRatioPpm
+			require.NoError(t, pc.PerpetualsKeeper.SetParams(pc.Ctx, params))
+
+			perp, err := pc.PerpetualsKeeper.CreatePerpetual(
+				pc.Ctx,
+				tc.testPerpetual.Params.Id,
+				tc.testPerpetual.Params.Ticker,
+				tc.testPerpetual.Params.MarketId,
+				tc.testPerpetual.Params.AtomicResolution,
+				tc.testPerpetual.Params.DefaultFundingPpm,
+				tc.testPerpetual.Params.LiquidityTier,
+				tc.testPerpetual.Params.FundingRateBounds,
+				tc.testPerpetual.Params.InterestRatePpm,
+				tc.testPerpetual.Params.InsuranceFundScope,
+			)
+			require.NoError(t, err)
+
+			keepertest.PopulateTestPremiumStore(
+				t,
+				pc.Ctx,
+				pc.PerpetualsKeeper,
+				[]types.Perpetual{perp},
+				tc.testFundingSamples,
+				false, // isVote
+			)
+			premiumSamples := pc.PerpetualsKeeper.GetPremiumSamples(pc.Ctx)
+
+			response, err := pc.PerpetualsKeeper.PredictedFundingRates(
+				wctx,
+				&types.QueryPredictedFundingRatesRequest{},
+			)
+			require.NoError(t, err)
+			require.Equal(
+				t,
+				&types.QueryPredictedFundingRatesResponse{
+					PredictedFundingRates: []types.PredictedFundingRate{tc.expectedPredictedFundingRate},
+				},
+				response,
+			)
+
+			// Verify that the query does not modify state.
+			require.Equal(t, premiumSamples, pc.PerpetualsKeeper.GetPremiumSamples(pc.Ctx))
+			newPerp, err := pc.PerpetualsKeeper.GetPerpetual(pc.Ctx, perp.Params.Id)
+			require.NoError(t, err)
+			require.Equal(t, perp, newPerp)
+		})
+	}
+}
+
+func TestPredictedFundingRatesQuery_InvalidRequest(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	wctx := sdk.WrapSDKContext(pc.Ctx)
+
+	_, err := pc.PerpetualsKeeper.PredictedFundingRates(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestFundingIndexHistoryQuery(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	wctx := sdk.WrapSDKContext(pc.Ctx)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	perp := constants.BtcUsd_0DefaultFunding_10AtomicResolution
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		perp.Params.Id,
+		perp.Params.Ticker,
+		perp.Params.MarketId,
+		perp.Params.AtomicResolution,
+		perp.Params.DefaultFundingPpm,
+		perp.Params.LiquidityTier,
+		perp.Params.FundingRateBounds,
+		perp.Params.InterestRatePpm,
+		perp.Params.InsuranceFundScope,
+	)
+	require.NoError(t, err)
+
+	// Each funding tick increases the funding index by 625.
+	processFundingTicks(t, pc, []types.Perpetual{perp}, 3)
+	allSnapshots := []types.FundingIndexSnapshot{
+		{BlockHeight: 23, FundingRatePpm: 1_000, FundingIndex: dtypes.NewInt(625)},
+		{BlockHeight: 23, FundingRatePpm: 1_000, FundingIndex: dtypes.NewInt(1_250)},
+		{BlockHeight: 23, FundingRatePpm: 1_000, FundingIndex: dtypes.NewInt(1_875)},
+	}
+
+	for name, tc := range map[string]struct {
+		request  *types.QueryFundingIndexHistoryRequest
+		response *types.QueryFundingIndexHistoryResponse
+		err      error
+	}{
+		"All snapshots": {
+			request:  &types.QueryFundingIndexHistoryRequest{Id: perp.Params.Id},
+			response: &types.QueryFundingIndexHistoryResponse{Snapshots: allSnapshots},
+		},
+		"Most recent snapshots": {
+			request:  &types.QueryFundingIndexHistoryRequest{Id: perp.Params.Id, Limit: 2},
+			response: &types.QueryFundingIndexHistoryResponse{Snapshots: allSnapshots[1:]},
+		},
+		"Limit greater than number of snapshots": {
+			request:  &types.QueryFundingIndexHistoryRequest{Id: perp.Params.Id, Limit: 10},
+			response: &types.QueryFundingIndexHistoryResponse{Snapshots: allSnapshots},
+		},
+		"Perpetual not found": {
+			request: &types.QueryFundingIndexHistoryRequest{Id: 1},
+			err:     status.Error(codes.NotFound, "Perpetual id 1 not found."),
+		},
+		"Nil request": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			response, err := pc.PerpetualsKeeper.FundingIndexHistory(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
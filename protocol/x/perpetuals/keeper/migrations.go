@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the perpetuals module from consensus version 1 to 2.
+// Version 2 adds `FundingRatePeriodSeconds` and `RemovedTailSampleRatioPpm` to params, which
+// are initialized to the values that were previously hard-coded.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.FundingRatePeriodSeconds = types.DefaultFundingRatePeriodSeconds
+	params.RemovedTailSampleRatioPpm = types.DefaultRemovedTailSampleRatioPpm
+
+	if err := m.keeper.ValidateParamsWithEpochs(ctx, params); err != nil {
+		return err
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	tests := map[string]struct {
+		fundingTickDuration uint32
+		expectedErr         error
+	}{
+		"Success": {
+			fundingTickDuration: 3_600,
+		},
+		"Failure: funding-tick epoch duration is inconsistent with default funding rate period": {
+			fundingTickDuration: 7_000,
+			expectedErr:         types.ErrFundingRatePeriodNotMultipleOfFundingTick,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := keepertest.PerpetualsKeepers(t)
+			require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochstypes.EpochInfo{
+				Name:     string(epochstypes.FundingTickEpochInfoName),
+				Duration: tc.fundingTickDuration,
+			}))
+			require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochstypes.EpochInfo{
+				Name:     string(epochstypes.FundingSampleEpochInfoName),
+				Duration: 60,
+			}))
+
+			// Write version 1 params, which do not include the funding rate period and removed tail
+			// sample ratio, directly to the store since they would fail validation.
+			v1Params := types.Params{
+				FundingRateClampFactorPpm: constants.TestFundingRateClampFactorPpm,
+				PremiumVoteClampFactorPpm: constants.TestPremiumVoteClampFactorPpm,
+				MinNumVotesPerSample:      constants.TestMinNumVotesPerSample,
+			}
+			pc.Ctx.KVStore(pc.StoreKey).Set([]byte(types.ParamsKey), pc.Cdc.MustMarshal(&v1Params))
+
+			err := keeper.NewMigrator(*pc.PerpetualsKeeper).Migrate1to2(pc.Ctx)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, v1Params, pc.PerpetualsKeeper.GetParams(pc.Ctx))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(
+				t,
+				types.Params{
+					FundingRateClampFactorPpm: constants.TestFundingRateClampFactorPpm,
+					PremiumVoteClampFactorPpm: constants.TestPremiumVoteClampFactorPpm,
+					MinNumVotesPerSample:      constants.TestMinNumVotesPerSample,
+					FundingRatePeriodSeconds:  types.DefaultFundingRatePeriodSeconds,
+					RemovedTailSampleRatioPpm: types.DefaultRemovedTailSampleRatioPpm,
+				},
+				pc.PerpetualsKeeper.GetParams(pc.Ctx),
+			)
+		})
+	}
+}
@@ -31,6 +31,9 @@ func (k msgServer) CreatePerpetual(
 		msg.Params.AtomicResolution,
 		msg.Params.DefaultFundingPpm,
 		msg.Params.LiquidityTier,
+		msg.Params.FundingRateBounds,
+		msg.Params.InterestRatePpm,
+		msg.Params.InsuranceFundScope,
 	)
 	if err != nil {
 		return &types.MsgCreatePerpetualResponse{}, err
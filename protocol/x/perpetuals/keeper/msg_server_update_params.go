@@ -23,6 +23,10 @@ func (k msgServer) UpdateParams(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.Keeper.ValidateParamsWithEpochs(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
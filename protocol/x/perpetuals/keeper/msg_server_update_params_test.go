@@ -8,6 +8,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	perpkeeper "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,7 @@ func TestUpdateParams(t *testing.T) {
 		FundingRateClampFactorPpm: 6_000_000,
 		PremiumVoteClampFactorPpm: 60_000_000,
 		MinNumVotesPerSample:      15,
+		FundingRatePeriodSeconds:  28_800,
 	}
 
 	tests := map[string]struct {
@@ -31,6 +33,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: 1_234,
 					PremiumVoteClampFactorPpm: initialParams.PremiumVoteClampFactorPpm,
 					MinNumVotesPerSample:      initialParams.MinNumVotesPerSample,
+					FundingRatePeriodSeconds:  initialParams.FundingRatePeriodSeconds,
 				},
 			},
 		},
@@ -41,9 +44,48 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: 1_234,
 					MinNumVotesPerSample:      7,
+					FundingRatePeriodSeconds:  initialParams.FundingRatePeriodSeconds,
 				},
 			},
 		},
+		"Success: modify funding rate period and removed tail sample ratio": {
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: types.Params{
+					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
+					PremiumVoteClampFactorPpm: initialParams.PremiumVoteClampFactorPpm,
+					MinNumVotesPerSample:      initialParams.MinNumVotesPerSample,
+					FundingRatePeriodSeconds:  3_600,
+					RemovedTailSampleRatioPpm: 50_000,
+				},
+			},
+		},
+		"Failure: funding rate period is not a multiple of funding-tick epoch duration": {
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: types.Params{
+					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
+					PremiumVoteClampFactorPpm: initialParams.PremiumVoteClampFactorPpm,
+					MinNumVotesPerSample:      initialParams.MinNumVotesPerSample,
+					FundingRatePeriodSeconds:  5_400,
+				},
+			},
+			expectedErr: "Funding rate period is not a multiple of the funding-tick epoch duration",
+		},
+		"Failure: removed tail sample ratio removes no samples": {
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: types.Params{
+					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
+					PremiumVoteClampFactorPpm: initialParams.PremiumVoteClampFactorPpm,
+					MinNumVotesPerSample:      initialParams.MinNumVotesPerSample,
+					FundingRatePeriodSeconds:  initialParams.FundingRatePeriodSeconds,
+					// 60 samples per funding-tick epoch * 0.5% * 2 tails < 1 sample.
+					RemovedTailSampleRatioPpm: 5_000,
+				},
+			},
+			expectedErr: "Removed tail sample ratio is non-zero but removes no funding samples",
+		},
 		"Failure: parameters are not valid": {
 			msg: &types.MsgUpdateParams{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -51,6 +93,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: 0, // invalid
 					MinNumVotesPerSample:      initialParams.MinNumVotesPerSample,
+					FundingRatePeriodSeconds:  initialParams.FundingRatePeriodSeconds,
 				},
 			},
 			expectedErr: "Premium vote clamp factor ppm is zero",
@@ -62,6 +105,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: 1_234,
 					MinNumVotesPerSample:      7,
+					FundingRatePeriodSeconds:  initialParams.FundingRatePeriodSeconds,
 				},
 			},
 			expectedErr: "invalid authority",
@@ -73,6 +117,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: 1_234,
 					MinNumVotesPerSample:      7,
+					FundingRatePeriodSeconds:  initialParams.FundingRatePeriodSeconds,
 				},
 			},
 			expectedErr: "invalid authority",
@@ -84,6 +129,16 @@ func TestUpdateParams(t *testing.T) {
 			pc := keepertest.PerpetualsKeepers(t)
 			err := pc.PerpetualsKeeper.SetParams(pc.Ctx, initialParams)
 			require.NoError(t, err)
+			err = pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochstypes.EpochInfo{
+				Name:     string(epochstypes.FundingTickEpochInfoName),
+				Duration: 3_600,
+			})
+			require.NoError(t, err)
+			err = pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochstypes.EpochInfo{
+				Name:     string(epochstypes.FundingSampleEpochInfoName),
+				Duration: 60,
+			})
+			require.NoError(t, err)
 
 			msgServer := perpkeeper.NewMsgServerImpl(pc.PerpetualsKeeper)
 			wrappedCtx := sdk.WrapSDKContext(pc.Ctx)
@@ -30,6 +30,9 @@ func (k msgServer) UpdatePerpetualParams(
 		msg.PerpetualParams.MarketId,
 		msg.PerpetualParams.DefaultFundingPpm,
 		msg.PerpetualParams.LiquidityTier,
+		msg.PerpetualParams.FundingRateBounds,
+		msg.PerpetualParams.InterestRatePpm,
+		msg.PerpetualParams.InsuranceFundScope,
 	)
 	if err != nil {
 		return nil, err
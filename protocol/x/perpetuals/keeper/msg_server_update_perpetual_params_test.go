@@ -79,6 +79,62 @@ func TestUpdatePerpetualParams(t *testing.T) {
 				},
 			},
 		},
+		"Success: modify funding rate bounds and interest rate": {
+			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
+				keepertest.CreateTestPricesAndPerpetualMarkets(
+					t,
+					ctx,
+					perpKeeper,
+					pricesKeeper,
+					[]types.Perpetual{testPerp},
+					[]pricestypes.MarketParamPrice{testMarket1},
+				)
+			},
+			msg: &types.MsgUpdatePerpetualParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				PerpetualParams: types.PerpetualParams{
+					Id:                testPerp.Params.Id,
+					Ticker:            testPerp.Params.Ticker,
+					MarketId:          testPerp.Params.MarketId,
+					AtomicResolution:  testPerp.Params.AtomicResolution,
+					DefaultFundingPpm: testPerp.Params.DefaultFundingPpm,
+					LiquidityTier:     testPerp.Params.LiquidityTier,
+					FundingRateBounds: &types.FundingRateBounds{
+						MinFundingRatePpm: -5_000,
+						MaxFundingRatePpm: 5_000,
+					},
+					InterestRatePpm: 100,
+				},
+			},
+		},
+		"Failure: min funding rate greater than max funding rate": {
+			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
+				keepertest.CreateTestPricesAndPerpetualMarkets(
+					t,
+					ctx,
+					perpKeeper,
+					pricesKeeper,
+					[]types.Perpetual{testPerp},
+					[]pricestypes.MarketParamPrice{testMarket1},
+				)
+			},
+			msg: &types.MsgUpdatePerpetualParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				PerpetualParams: types.PerpetualParams{
+					Id:                testPerp.Params.Id,
+					Ticker:            testPerp.Params.Ticker,
+					MarketId:          testPerp.Params.MarketId,
+					AtomicResolution:  testPerp.Params.AtomicResolution,
+					DefaultFundingPpm: testPerp.Params.DefaultFundingPpm,
+					LiquidityTier:     testPerp.Params.LiquidityTier,
+					FundingRateBounds: &types.FundingRateBounds{
+						MinFundingRatePpm: 5_000,
+						MaxFundingRatePpm: -5_000,
+					},
+				},
+			},
+			expectedErr: types.ErrMinFundingRateGreaterThanMax.Error(),
+		},
 		"Failure: updates a non-existing perpetual ID": {
 			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
 				keepertest.CreateTestPricesAndPerpetualMarkets(
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"math/rand"
 	"sort"
@@ -35,6 +36,9 @@ func (k Keeper) CreatePerpetual(
 	atomicResolution int32,
 	defaultFundingPpm int32,
 	liquidityTier uint32,
+	fundingRateBounds *types.FundingRateBounds,
+	interestRatePpm int32,
+	insuranceFundScope types.InsuranceFundScope,
 ) (types.Perpetual, error) {
 	// Check if perpetual exists.
 	if k.HasPerpetual(ctx, id) {
@@ -47,12 +51,15 @@ func (k Keeper) CreatePerpetual(
 	// Create the perpetual.
 	perpetual := types.Perpetual{
 		Params: types.PerpetualParams{
-			Id:                id,
-			Ticker:            ticker,
-			MarketId:          marketId,
-			AtomicResolution:  atomicResolution,
-			DefaultFundingPpm: defaultFundingPpm,
-			LiquidityTier:     liquidityTier,
+			Id:                 id,
+			Ticker:             ticker,
+			MarketId:           marketId,
+			AtomicResolution:   atomicResolution,
+			DefaultFundingPpm:  defaultFundingPpm,
+			LiquidityTier:      liquidityTier,
+			FundingRateBounds:  fundingRateBounds,
+			InterestRatePpm:    interestRatePpm,
+			InsuranceFundScope: insuranceFundScope,
 		},
 		FundingIndex: dtypes.ZeroInt(),
 	}
@@ -94,18 +101,25 @@ func (k Keeper) ModifyPerpetual(
 	marketId uint32,
 	defaultFundingPpm int32,
 	liquidityTier uint32,
+	fundingRateBounds *types.FundingRateBounds,
+	interestRatePpm int32,
+	insuranceFundScope types.InsuranceFundScope,
 ) (types.Perpetual, error) {
 	// Get perpetual.
 	perpetual, err := k.GetPerpetual(ctx, id)
 	if err != nil {
 		return perpetual, err
 	}
+	oldInsuranceFundName := perpetual.Params.GetInsuranceFundName()
 
 	// Modify perpetual.
 	perpetual.Params.Ticker = ticker
 	perpetual.Params.MarketId = marketId
 	perpetual.Params.DefaultFundingPpm = defaultFundingPpm
 	perpetual.Params.LiquidityTier = liquidityTier
+	perpetual.Params.FundingRateBounds = fundingRateBounds
+	perpetual.Params.InterestRatePpm = interestRatePpm
+	perpetual.Params.InsuranceFundScope = insuranceFundScope
 
 	// Validate updates to perpetual.
 	if err = k.validatePerpetual(
@@ -114,6 +128,13 @@ func (k Keeper) ModifyPerpetual(
 	); err != nil {
 		return perpetual, err
 	}
+	if err = k.validateInsuranceFundChange(
+		ctx,
+		oldInsuranceFundName,
+		perpetual,
+	); err != nil {
+		return perpetual, err
+	}
 
 	// Store the modified perpetual.
 	k.setPerpetual(ctx, perpetual)
@@ -195,8 +216,6 @@ func (k Keeper) processStoredPremiums(
 ) (
 	perpIdToPremium map[uint32]int32,
 ) {
-	perpIdToPremium = make(map[uint32]int32)
-
 	premiumStore := k.getPremiumStore(ctx, premiumKey)
 
 	telemetry.SetGaugeWithLabels(
@@ -222,6 +241,22 @@ func (k Keeper) processStoredPremiums(
 		},
 	)
 
+	return summarizePremiums(premiumStore, minNumPremiumsRequired, combineFunc, filterFunc)
+}
+
+// summarizePremiums combines the premiums of each market in `premiumStore` into a single premium,
+// and returns a mapping from perpetual ID to the summarized premium. See `processStoredPremiums`
+// for a description of the parameters. Does not make any changes to state.
+func summarizePremiums(
+	premiumStore types.PremiumStore,
+	minNumPremiumsRequired uint32,
+	combineFunc func([]int32) int32,
+	filterFunc func([]int32) []int32,
+) (
+	perpIdToPremium map[uint32]int32,
+) {
+	perpIdToPremium = make(map[uint32]int32)
+
 	for _, marketPremiums := range premiumStore.AllMarketPremiums {
 		// Invariant: `len(marketPremiums.Premiums) <= NumPremiums`
 		if uint32(len(marketPremiums.Premiums)) > premiumStore.NumPremiums {
@@ -354,13 +389,14 @@ func (k Keeper) MaybeProcessNewFundingSampleEpoch(
 }
 
 // getFundingIndexDelta returns fundingIndexDelta which represents the change of FundingIndex since
-// the last time `funding-tick` was processed.
-// TODO(DEC-1536): Make the 8-hour funding rate period configurable.
+// the last time `funding-tick` was processed. `bigFundingRatePpm` is the funding rate paid over
+// `fundingRatePeriodSeconds`, which is pro-rated by `timeSinceLastFunding`.
 func (k Keeper) getFundingIndexDelta(
 	ctx sdk.Context,
 	perp types.Perpetual,
-	big8hrFundingRatePpm *big.Int,
+	bigFundingRatePpm *big.Int,
 	timeSinceLastFunding uint32,
+	fundingRatePeriodSeconds uint32,
 ) (
 	fundingIndexDelta *big.Int,
 	err error,
@@ -371,7 +407,7 @@ func (k Keeper) getFundingIndexDelta(
 	}
 
 	// Get pro-rated funding rate adjusted by time delta.
-	proratedFundingRate := new(big.Rat).SetInt(big8hrFundingRatePpm)
+	proratedFundingRate := new(big.Rat).SetInt(bigFundingRatePpm)
 	proratedFundingRate.Mul(
 		proratedFundingRate,
 		new(big.Rat).SetUint64(uint64(timeSinceLastFunding)),
@@ -379,7 +415,7 @@ func (k Keeper) getFundingIndexDelta(
 
 	proratedFundingRate.Quo(
 		proratedFundingRate,
-		new(big.Rat).SetUint64(3600*8),
+		new(big.Rat).SetUint64(uint64(fundingRatePeriodSeconds)),
 	)
 
 	bigFundingIndexDelta := lib.FundingRateToIndex(
@@ -556,6 +592,69 @@ func (k Keeper) GetRemoveSampleTailsFunc(
 	}
 }
 
+// getFundingRatePpm returns the premium component and the funding rate of a perpetual for a
+// `funding-tick` epoch, given the summarized premium of its premium samples. The premium component
+// is the sum of the premium and the default funding of the perpetual. The funding rate is the sum
+// of the premium component and the interest rate of the perpetual, clamped to the bounds
+// determined by its liquidity tier and to its explicit funding rate bounds, if any.
+func (k Keeper) getFundingRatePpm(
+	ctx sdk.Context,
+	perp types.Perpetual,
+	premiumPpm int32,
+	fundingRateClampFactorPpm uint32,
+) (
+	bigPremiumPpm *big.Int,
+	bigFundingRatePpm *big.Int,
+	err error,
+) {
+	// premium component = premium + default funding
+	bigPremiumPpm = new(big.Int).SetInt64(int64(premiumPpm))
+	bigPremiumPpm.Add(
+		bigPremiumPpm,
+		new(big.Int).SetInt64(int64(perp.Params.DefaultFundingPpm)),
+	)
+
+	// funding rate = premium component + interest rate
+	bigFundingRatePpm = new(big.Int).Add(
+		bigPremiumPpm,
+		new(big.Int).SetInt64(int64(perp.Params.InterestRatePpm)),
+	)
+
+	liquidityTier, err := k.GetLiquidityTier(ctx, perp.Params.LiquidityTier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Return an error if maintenance fraction ppm is larger than its maximum value.
+	if liquidityTier.MaintenanceFractionPpm > types.MaxMaintenanceFractionPpm {
+		return nil, nil, errorsmod.Wrapf(
+			types.ErrMaintenanceFractionPpmExceedsMax,
+			"perpetual Id = (%d), liquidity tier Id = (%d), maintenance fraction ppm = (%v)",
+			perp.Params.Id, perp.Params.LiquidityTier, liquidityTier.MaintenanceFractionPpm,
+		)
+	}
+
+	// Clamp funding rate according to equation:
+	// |R| <= clamp_factor * (initial margin - maintenance margin)
+	fundingRateUpperBoundPpm := liquidityTier.GetMaxAbsFundingClampPpm(fundingRateClampFactorPpm)
+	bigFundingRatePpm = lib.BigIntClamp(
+		bigFundingRatePpm,
+		new(big.Int).Neg(fundingRateUpperBoundPpm),
+		fundingRateUpperBoundPpm,
+	)
+
+	// Clamp funding rate to the explicit funding rate bounds of the perpetual, if any.
+	if bounds := perp.Params.FundingRateBounds; bounds != nil {
+		bigFundingRatePpm = lib.BigIntClamp(
+			bigFundingRatePpm,
+			new(big.Int).SetInt64(int64(bounds.MinFundingRatePpm)),
+			new(big.Int).SetInt64(int64(bounds.MaxFundingRatePpm)),
+		)
+	}
+
+	return bigPremiumPpm, bigFundingRatePpm, nil
+}
+
 // MaybeProcessNewFundingTickEpoch processes funding ticks if the current block
 // is the start of a new funding-tick epoch. Otherwise, do nothing.
 func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
@@ -576,24 +675,14 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 
 	fundingTickEpochInfo := k.epochsKeeper.MustGetFundingTickEpochInfo(ctx)
-	fundingSampleEpochInfo := k.epochsKeeper.MustGetFundingSampleEpochInfo(ctx)
-
-	// Use the ratio between funding-tick and funding-sample durations
-	// as minimum number of samples required to get a premium rate.
-	minSampleRequiredForPremiumRate := lib.MustDivideUint32RoundUp(
-		fundingTickEpochInfo.Duration,
-		fundingSampleEpochInfo.Duration,
-	)
 
-	// TODO(DEC-1449): Read `RemovedTailSampleRatioPpm` from state. Determine initial value.
-	// This value should be 0% or some low value like 5%, since we already has a layer of
-	// filtering we compute samples as median of premium votes.
-	tailRemovalRatePpm := types.RemovedTailSampleRatioPpm
+	// Use the number of funding samples per funding-tick epoch as minimum number
+	// of samples required to get a premium rate.
+	minSampleRequiredForPremiumRate := k.getNumSamplesPerFundingTick(ctx)
 
 	// Get `sampleTailsRemovalFunc` which removes a percentage of top and bottom samples
 	// from the input after sorting.
-
-	sampleTailsRemovalFunc := k.GetRemoveSampleTailsFunc(ctx, tailRemovalRatePpm)
+	sampleTailsRemovalFunc := k.GetRemoveSampleTailsFunc(ctx, params.RemovedTailSampleRatioPpm)
 
 	// Process stored samples from last `funding-tick` epoch, and retrieve
 	// a mapping from `perpetualId` to summarized premium rate for this epoch.
@@ -624,37 +713,16 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 			premiumPpm = 0
 		}
 
-		bigFundingRatePpm := new(big.Int).SetInt64(int64(premiumPpm))
-
-		// funding rate = premium + default funding
-		bigFundingRatePpm.Add(
-			bigFundingRatePpm,
-			new(big.Int).SetInt64(int64(perp.Params.DefaultFundingPpm)),
+		bigPremiumPpm, bigFundingRatePpm, err := k.getFundingRatePpm(
+			ctx,
+			perp,
+			premiumPpm,
+			params.FundingRateClampFactorPpm,
 		)
-
-		liquidityTier, err := k.GetLiquidityTier(ctx, perp.Params.LiquidityTier)
 		if err != nil {
 			panic(err)
 		}
 
-		// Panic if maintenance fraction ppm is larger than its maximum value.
-		if liquidityTier.MaintenanceFractionPpm > types.MaxMaintenanceFractionPpm {
-			panic(errorsmod.Wrapf(
-				types.ErrMaintenanceFractionPpmExceedsMax,
-				"perpetual Id = (%d), liquidity tier Id = (%d), maintenance fraction ppm = (%v)",
-				perp.Params.Id, perp.Params.LiquidityTier, liquidityTier.MaintenanceFractionPpm,
-			))
-		}
-
-		// Clamp funding rate according to equation:
-		// |R| <= clamp_factor * (initial margin - maintenance margin)
-		fundingRateUpperBoundPpm := liquidityTier.GetMaxAbsFundingClampPpm(params.FundingRateClampFactorPpm)
-		bigFundingRatePpm = lib.BigIntClamp(
-			bigFundingRatePpm,
-			new(big.Int).Neg(fundingRateUpperBoundPpm),
-			fundingRateUpperBoundPpm,
-		)
-
 		// Emit clamped funding rate.
 		telemetry.SetGaugeWithLabels(
 			[]string{
@@ -687,6 +755,7 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 				// TODO(DEC-1483): Handle the case when duration value is updated
 				// during the epoch.
 				fundingTickEpochInfo.Duration,
+				params.FundingRatePeriodSeconds,
 			)
 			if err != nil {
 				panic(err)
@@ -702,10 +771,23 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 		if err != nil {
 			panic(err)
 		}
+
+		// Record the updated funding index in the funding index history of the perpetual.
+		k.addFundingIndexSnapshot(
+			ctx,
+			perp.Params.Id,
+			types.FundingIndexSnapshot{
+				BlockHeight:    lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				FundingRatePpm: int32(bigFundingRatePpm.Int64()),
+				FundingIndex:   perp.FundingIndex,
+			},
+		)
 		newFundingRatesAndIndicesForEvent = append(newFundingRatesAndIndicesForEvent, indexerevents.FundingUpdateV1{
 			PerpetualId:     perp.Params.Id,
 			FundingValuePpm: int32(bigFundingRatePpm.Int64()),
 			FundingIndex:    perp.FundingIndex,
+			PremiumPpm:      lib.BigInt32Clamp(bigPremiumPpm, math.MinInt32, math.MaxInt32),
+			InterestPpm:     perp.Params.InterestRatePpm,
 		})
 	}
 
@@ -723,6 +805,90 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 	k.SetEmptyPremiumSamples(ctx)
 }
 
+// GetPredictedFundingRates returns the predicted funding rate of each perpetual for the current
+// `funding-tick` epoch, sorted by perpetual ID. Premium samples are summarized and converted into
+// funding rates the same way as in `MaybeProcessNewFundingTickEpoch`, including padding samples
+// which have not been collected yet during the epoch with zeros. The predicted funding rates
+// are therefore the funding rates that would be paid if the remaining samples of the epoch
+// were all zero. Does not make any changes to state.
+func (k Keeper) GetPredictedFundingRates(ctx sdk.Context) (
+	predictedFundingRates []types.PredictedFundingRate,
+	err error,
+) {
+	params := k.GetParams(ctx)
+
+	// Use the same minimum number of samples as `MaybeProcessNewFundingTickEpoch`, so that
+	// samples which have not been collected yet are padded with zeros.
+	minSampleRequiredForPremiumRate := k.getNumSamplesPerFundingTick(ctx)
+	sampleTailsRemovalFunc := k.GetRemoveSampleTailsFunc(ctx, params.RemovedTailSampleRatioPpm)
+	perpIdToPremiumPpm := summarizePremiums(
+		k.GetPremiumSamples(ctx),
+		minSampleRequiredForPremiumRate,
+		lib.AvgInt32,           // combineFunc
+		sampleTailsRemovalFunc, // filterFunc
+	)
+
+	allPerps := k.GetAllPerpetuals(ctx)
+	predictedFundingRates = make([]types.PredictedFundingRate, 0, len(allPerps))
+	for _, perp := range allPerps {
+		bigPremiumPpm, bigFundingRatePpm, err := k.getFundingRatePpm(
+			ctx,
+			perp,
+			perpIdToPremiumPpm[perp.Params.Id],
+			params.FundingRateClampFactorPpm,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		predictedFundingRates = append(predictedFundingRates, types.PredictedFundingRate{
+			PerpetualId:    perp.Params.Id,
+			PremiumPpm:     lib.BigInt32Clamp(bigPremiumPpm, math.MinInt32, math.MaxInt32),
+			InterestPpm:    perp.Params.InterestRatePpm,
+			FundingRatePpm: lib.BigInt32Clamp(bigFundingRatePpm, math.MinInt32, math.MaxInt32),
+		})
+	}
+
+	return predictedFundingRates, nil
+}
+
+// GetFundingIndexHistory returns the funding index snapshots stored for a perpetual, sorted from
+// the oldest to the newest snapshot. At most `MaxFundingIndexHistoryLength` snapshots are stored.
+func (k Keeper) GetFundingIndexHistory(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	fundingIndexHistory types.FundingIndexHistory,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingIndexHistoryKeyPrefix))
+
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return types.FundingIndexHistory{}
+	}
+
+	k.cdc.MustUnmarshal(b, &fundingIndexHistory)
+	return fundingIndexHistory
+}
+
+// addFundingIndexSnapshot appends a funding index snapshot to the history of a perpetual, removing
+// the oldest snapshots if the history exceeds `MaxFundingIndexHistoryLength` snapshots.
+func (k Keeper) addFundingIndexSnapshot(
+	ctx sdk.Context,
+	perpetualId uint32,
+	snapshot types.FundingIndexSnapshot,
+) {
+	fundingIndexHistory := k.GetFundingIndexHistory(ctx, perpetualId)
+	fundingIndexHistory.Snapshots = append(fundingIndexHistory.Snapshots, snapshot)
+	if numSnapshots := len(fundingIndexHistory.Snapshots); numSnapshots > types.MaxFundingIndexHistoryLength {
+		fundingIndexHistory.Snapshots = fundingIndexHistory.Snapshots[numSnapshots-types.MaxFundingIndexHistoryLength:]
+	}
+
+	b := k.cdc.MustMarshal(&fundingIndexHistory)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingIndexHistoryKeyPrefix))
+	store.Set(lib.Uint32ToKey(perpetualId), b)
+}
+
 // GetNetNotional returns the net notional in quote quantums, which can be represented by the following equation:
 // `quantums / 10^baseAtomicResolution * marketPrice * 10^marketExponent * 10^quoteAtomicResolution`.
 // Note that longs are positive, and shorts are negative.
@@ -1171,6 +1337,41 @@ func (k Keeper) validatePerpetual(
 	return nil
 }
 
+// validateInsuranceFundChange returns an error if modifying a perpetual would strand the balance of the
+// insurance fund that previously backed it. Funds are not moved between insurance funds, so the change is
+// rejected if the old insurance fund is scoped to a perpetual or a liquidity tier, no longer backs any
+// perpetual after the change, and has a non-zero balance. The global insurance fund is never stranded.
+// Note that this must be called before the modified perpetual is stored.
+func (k Keeper) validateInsuranceFundChange(
+	ctx sdk.Context,
+	oldInsuranceFundName string,
+	perpetual types.Perpetual,
+) error {
+	if oldInsuranceFundName == perpetual.Params.GetInsuranceFundName() ||
+		oldInsuranceFundName == types.InsuranceFundName {
+		return nil
+	}
+
+	for _, otherPerpetual := range k.GetAllPerpetuals(ctx) {
+		if otherPerpetual.Params.Id != perpetual.Params.Id &&
+			otherPerpetual.Params.GetInsuranceFundName() == oldInsuranceFundName {
+			return nil
+		}
+	}
+
+	// The stored perpetual is still backed by the old insurance fund.
+	if balance := k.clobKeeper.GetInsuranceFundBalance(ctx, perpetual.Params.Id); balance.Sign() != 0 {
+		return errorsmod.Wrapf(
+			types.ErrInsuranceFundBalanceStranded,
+			"insurance fund %s of perpetual %d has a balance of %v",
+			oldInsuranceFundName,
+			perpetual.Params.Id,
+			balance,
+		)
+	}
+	return nil
+}
+
 func (k Keeper) setPremiumStore(
 	ctx sdk.Context,
 	premiumStore types.PremiumStore,
@@ -1402,6 +1603,48 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return nil
 }
 
+// `ValidateParamsWithEpochs` validates that perpetuals module parameters are consistent with the
+// durations of the `funding-tick` and `funding-sample` epochs:
+// - The funding rate period must be a multiple of the `funding-tick` epoch duration, so that
+// funding rates are paid in whole funding-tick increments.
+// - A non-zero removed tail sample ratio must remove at least one of the funding samples expected
+// during a `funding-tick` epoch, otherwise it would silently have no effect.
+func (k Keeper) ValidateParamsWithEpochs(ctx sdk.Context, params types.Params) error {
+	fundingTickEpochInfo := k.epochsKeeper.MustGetFundingTickEpochInfo(ctx)
+
+	if params.FundingRatePeriodSeconds%fundingTickEpochInfo.Duration != 0 {
+		return errorsmod.Wrapf(
+			types.ErrFundingRatePeriodNotMultipleOfFundingTick,
+			"funding rate period (%d) is not a multiple of the funding-tick epoch duration (%d)",
+			params.FundingRatePeriodSeconds,
+			fundingTickEpochInfo.Duration,
+		)
+	}
+
+	numSamplesPerFundingTick := k.getNumSamplesPerFundingTick(ctx)
+	if params.RemovedTailSampleRatioPpm != 0 &&
+		lib.Int64MulPpm(int64(numSamplesPerFundingTick), params.RemovedTailSampleRatioPpm*2) == 0 {
+		return errorsmod.Wrapf(
+			types.ErrRemovedTailSampleRatioRemovesNoSamples,
+			"removed tail sample ratio ppm (%d), number of samples per funding-tick epoch (%d)",
+			params.RemovedTailSampleRatioPpm,
+			numSamplesPerFundingTick,
+		)
+	}
+
+	return nil
+}
+
+// getNumSamplesPerFundingTick returns the number of funding samples expected during a
+// `funding-tick` epoch, which is the ratio between the `funding-tick` and `funding-sample`
+// epoch durations, rounded up.
+func (k Keeper) getNumSamplesPerFundingTick(ctx sdk.Context) uint32 {
+	return lib.MustDivideUint32RoundUp(
+		k.epochsKeeper.MustGetFundingTickEpochInfo(ctx).Duration,
+		k.epochsKeeper.MustGetFundingSampleEpochInfo(ctx).Duration,
+	)
+}
+
 // `getLiquidityTiertoMaxAbsPremiumVotePpm` returns `maxAbsPremiumVotePpm` for each liquidity tier
 // (used for clamping premium votes) as a map whose key is liquidity tier ID.
 func (k Keeper) getLiquidityTiertoMaxAbsPremiumVotePpm(
@@ -52,6 +52,12 @@ func TestModifyPerpetual_Success(t *testing.T) {
 		marketId := uint32(i*2) % numMarkets
 		defaultFundingPpm := int32(i * 2)
 		liquidityTier := uint32((i + 1) % numLiquidityTiers)
+		fundingRateBounds := &types.FundingRateBounds{
+			MinFundingRatePpm: -int32(i * 3),
+			MaxFundingRatePpm: int32(i * 3),
+		}
+		interestRatePpm := int32(i)
+		insuranceFundScope := types.InsuranceFundScope(i % len(types.InsuranceFundScope_name))
 		retItem, err := pc.PerpetualsKeeper.ModifyPerpetual(
 			pc.Ctx,
 			item.Params.Id,
@@ -59,6 +65,9 @@ func TestModifyPerpetual_Success(t *testing.T) {
 			marketId,
 			defaultFundingPpm,
 			liquidityTier,
+			fundingRateBounds,
+			interestRatePpm,
+			insuranceFundScope,
 		)
 		require.NoError(t, err)
 
@@ -104,6 +113,21 @@ func TestModifyPerpetual_Success(t *testing.T) {
 			liquidityTier,
 			newItem.Params.LiquidityTier,
 		)
+		require.Equal(
+			t,
+			fundingRateBounds,
+			newItem.Params.FundingRateBounds,
+		)
+		require.Equal(
+			t,
+			interestRatePpm,
+			newItem.Params.InterestRatePpm,
+		)
+		require.Equal(
+			t,
+			insuranceFundScope,
+			newItem.Params.InsuranceFundScope,
+		)
 	}
 
 	// Verify that expected indexer events were emitted.
@@ -137,13 +161,16 @@ func getUpdatePerpetualEventsFromIndexerBlock(
 
 func TestCreatePerpetual_Failure(t *testing.T) {
 	tests := map[string]struct {
-		id                uint32
-		ticker            string
-		marketId          uint32
-		atomicResolution  int32
-		defaultFundingPpm int32
-		liquidityTier     uint32
-		expectedError     error
+		id                 uint32
+		ticker             string
+		marketId           uint32
+		atomicResolution   int32
+		defaultFundingPpm  int32
+		liquidityTier      uint32
+		fundingRateBounds  *types.FundingRateBounds
+		interestRatePpm    int32
+		insuranceFundScope types.InsuranceFundScope
+		expectedError      error
 	}{
 		"Price doesn't exist": {
 			id:                0,
@@ -196,6 +223,47 @@ func TestCreatePerpetual_Failure(t *testing.T) {
 			liquidityTier:     0,
 			expectedError:     types.ErrTickerEmptyString,
 		},
+		"Min funding rate is greater than max funding rate": {
+			id:                0,
+			ticker:            "ticker",
+			marketId:          0,
+			atomicResolution:  -10,
+			defaultFundingPpm: 0,
+			liquidityTier:     0,
+			fundingRateBounds: &types.FundingRateBounds{
+				MinFundingRatePpm: 1_000,
+				MaxFundingRatePpm: -1_000,
+			},
+			expectedError: errorsmod.Wrapf(
+				types.ErrMinFundingRateGreaterThanMax,
+				"min funding rate ppm (%d), max funding rate ppm (%d)",
+				1_000,
+				-1_000,
+			),
+		},
+		"Interest rate magnitude exceeds maximum": {
+			id:                0,
+			ticker:            "ticker",
+			marketId:          0,
+			atomicResolution:  -10,
+			defaultFundingPpm: 0,
+			liquidityTier:     0,
+			interestRatePpm:   int32(lib.OneMillion + 1),
+			expectedError: errorsmod.Wrap(
+				types.ErrInterestRatePpmMagnitudeExceedsMax,
+				fmt.Sprint(int32(lib.OneMillion+1)),
+			),
+		},
+		"Insurance fund scope is invalid": {
+			id:                 0,
+			ticker:             "ticker",
+			marketId:           0,
+			atomicResolution:   -10,
+			defaultFundingPpm:  0,
+			liquidityTier:      0,
+			insuranceFundScope: types.InsuranceFundScope(3),
+			expectedError:      errorsmod.Wrap(types.ErrInvalidInsuranceFundScope, fmt.Sprint(3)),
+		},
 	}
 
 	// Test setup.
@@ -214,6 +282,9 @@ func TestCreatePerpetual_Failure(t *testing.T) {
 				tc.atomicResolution,
 				tc.defaultFundingPpm,
 				tc.liquidityTier,
+				tc.fundingRateBounds,
+				tc.interestRatePpm,
+				tc.insuranceFundScope,
 			)
 
 			require.Error(t, err)
@@ -272,6 +343,9 @@ func TestModifyPerpetual_Failure(t *testing.T) {
 				tc.marketId,
 				tc.defaultFundingPpm,
 				tc.liquidityTier,
+				nil, // FundingRateBounds
+				0,   // InterestRatePpm
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
 			)
 
 			require.Error(t, err)
@@ -280,6 +354,140 @@ func TestModifyPerpetual_Failure(t *testing.T) {
 	}
 }
 
+func TestModifyPerpetual_InsuranceFundChange(t *testing.T) {
+	tests := map[string]struct {
+		// Scopes of perpetuals 0 and 1 before perpetual 0 is modified.
+		initialScopes []types.InsuranceFundScope
+		// Liquidity tiers of perpetuals 0 and 1 before perpetual 0 is modified.
+		initialLiquidityTiers []uint32
+		newScope              types.InsuranceFundScope
+		newLiquidityTier      uint32
+		// Balance of the old insurance fund of perpetual 0, nil if it is not queried.
+		oldInsuranceFundBalance *big.Int
+		expectedError           error
+	}{
+		"Succeeds: insurance fund is unchanged": {
+			initialScopes: []types.InsuranceFundScope{
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			},
+			initialLiquidityTiers: []uint32{0, 1},
+			newScope:              types.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+			newLiquidityTier:      1,
+		},
+		"Succeeds: old insurance fund is the global insurance fund": {
+			initialScopes: []types.InsuranceFundScope{
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			},
+			initialLiquidityTiers: []uint32{0, 1},
+			newScope:              types.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+			newLiquidityTier:      0,
+		},
+		"Succeeds: old insurance fund still backs another perpetual": {
+			initialScopes: []types.InsuranceFundScope{
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+			},
+			initialLiquidityTiers: []uint32{0, 0},
+			newScope:              types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			newLiquidityTier:      0,
+		},
+		"Succeeds: old insurance fund is empty": {
+			initialScopes: []types.InsuranceFundScope{
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			},
+			initialLiquidityTiers:   []uint32{0, 1},
+			newScope:                types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			newLiquidityTier:        0,
+			oldInsuranceFundBalance: big.NewInt(0),
+		},
+		"Fails: scope change strands the balance of the old insurance fund": {
+			initialScopes: []types.InsuranceFundScope{
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			},
+			initialLiquidityTiers:   []uint32{0, 1},
+			newScope:                types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			newLiquidityTier:        0,
+			oldInsuranceFundBalance: big.NewInt(100),
+			expectedError: errorsmod.Wrapf(
+				types.ErrInsuranceFundBalanceStranded,
+				"insurance fund %s of perpetual %d has a balance of %v",
+				"insurance_fund:perpetual:0",
+				0,
+				100,
+			),
+		},
+		"Fails: liquidity tier change strands the balance of the old insurance fund": {
+			initialScopes: []types.InsuranceFundScope{
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+			},
+			initialLiquidityTiers:   []uint32{0, 1},
+			newScope:                types.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+			newLiquidityTier:        1,
+			oldInsuranceFundBalance: big.NewInt(100),
+			expectedError: errorsmod.Wrapf(
+				types.ErrInsuranceFundBalanceStranded,
+				"insurance fund %s of perpetual %d has a balance of %v",
+				"insurance_fund:liquidity_tier:0",
+				0,
+				100,
+			),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClobKeeper := &mocks.PerpetualsClobKeeper{}
+			if tc.oldInsuranceFundBalance != nil {
+				mockClobKeeper.On("GetInsuranceFundBalance", mock.Anything, uint32(0)).
+					Return(tc.oldInsuranceFundBalance)
+			}
+			pc := keepertest.PerpetualsKeepersWithClobHelpers(t, mockClobKeeper)
+			perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 2)
+
+			modifyPerpetual := func(
+				perp types.Perpetual,
+				liquidityTier uint32,
+				scope types.InsuranceFundScope,
+			) (types.Perpetual, error) {
+				return pc.PerpetualsKeeper.ModifyPerpetual(
+					pc.Ctx,
+					perp.Params.Id,
+					perp.Params.Ticker,
+					perp.Params.MarketId,
+					perp.Params.DefaultFundingPpm,
+					liquidityTier,
+					perp.Params.FundingRateBounds,
+					perp.Params.InterestRatePpm,
+					scope,
+				)
+			}
+			for i, perp := range perps {
+				_, err := modifyPerpetual(perp, tc.initialLiquidityTiers[i], tc.initialScopes[i])
+				require.NoError(t, err)
+			}
+
+			_, err := modifyPerpetual(perps[0], tc.newLiquidityTier, tc.newScope)
+			perp, getErr := pc.PerpetualsKeeper.GetPerpetual(pc.Ctx, 0)
+			require.NoError(t, getErr)
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Equal(t, tc.initialScopes[0], perp.Params.InsuranceFundScope)
+				require.Equal(t, tc.initialLiquidityTiers[0], perp.Params.LiquidityTier)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.newScope, perp.Params.InsuranceFundScope)
+				require.Equal(t, tc.newLiquidityTier, perp.Params.LiquidityTier)
+			}
+			mockClobKeeper.AssertExpectations(t)
+		})
+	}
+}
+
 func TestGetPerpetual_Success(t *testing.T) {
 	pc := keepertest.PerpetualsKeepers(t)
 	// Create liquidity tiers and perpetuals,
@@ -337,6 +545,9 @@ func TestHasPerpetual(t *testing.T) {
 			perps[perp].Params.AtomicResolution,
 			perps[perp].Params.DefaultFundingPpm,
 			perps[perp].Params.LiquidityTier,
+			perps[perp].Params.FundingRateBounds,
+			perps[perp].Params.InterestRatePpm,
+			perps[perp].Params.InsuranceFundScope,
 		)
 		require.NoError(t, err)
 	}
@@ -414,6 +625,9 @@ func TestGetAllPerpetuals_Sorted(t *testing.T) {
 			perps[perp].Params.AtomicResolution,
 			perps[perp].Params.DefaultFundingPpm,
 			perps[perp].Params.LiquidityTier,
+			perps[perp].Params.FundingRateBounds,
+			perps[perp].Params.InterestRatePpm,
+			perps[perp].Params.InsuranceFundScope,
 		)
 		require.NoError(t, err)
 	}
@@ -709,6 +923,9 @@ func TestGetMarginRequirements_Success(t *testing.T) {
 				tc.baseCurrencyAtomicResolution, // AtomicResolution
 				int32(0),                        // DefaultFundingPpm
 				0,                               // LiquidityTier
+				nil,                             // FundingRateBounds
+				int32(0),                        // InterestRatePpm
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL, // InsuranceFundScope
 			)
 			require.NoError(t, err)
 
@@ -911,6 +1128,9 @@ func TestGetNetNotional_Success(t *testing.T) {
 				tc.baseCurrencyAtomicResolution, // AtomicResolution
 				int32(0),                        // DefaultFundingPpm
 				0,                               // LiquidityTier
+				nil,                             // FundingRateBounds
+				int32(0),                        // InterestRatePpm
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL, // InsuranceFundScope
 			)
 			require.NoError(t, err)
 
@@ -1073,6 +1293,9 @@ func TestGetNotionalInBaseQuantums_Success(t *testing.T) {
 				tc.baseCurrencyAtomicResolution, // AtomicResolution
 				int32(0),                        // DefaultFundingPpm
 				0,                               // LiquidityTier
+				nil,                             // FundingRateBounds
+				int32(0),                        // InterestRatePpm
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL, // InsuranceFundScope
 			)
 			require.NoError(t, err)
 
@@ -1236,6 +1459,9 @@ func TestGetNetCollateral_Success(t *testing.T) {
 				tc.baseCurrencyAtomicResolution, // AtomicResolution
 				int32(0),                        // DefaultFundingPpm
 				0,                               // LiquidityTier
+				nil,                             // FundingRateBounds
+				int32(0),                        // InterestRatePpm
+				types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL, // InsuranceFundScope
 			)
 			require.NoError(t, err)
 
@@ -1560,6 +1786,8 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 	tests := map[string]struct {
 		testFundingSampleDuration        uint32
 		testFundingTickDuration          uint32
+		testFundingRatePeriodSeconds     uint32
+		testRemovedTailSampleRatioPpm    uint32
 		testPerpetuals                   []types.Perpetual
 		testFundingSamples               []int32
 		expectedFundingIndexDeltas       []*big.Int
@@ -1580,6 +1808,49 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: 1_000,
 					FundingIndex:    dtypes.NewInt(625),
+					PremiumPpm:      1_000,
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.001 percent, 1-hour funding rate period": {
+			testFundingSampleDuration:    60,
+			testFundingTickDuration:      3600,
+			testFundingRatePeriodSeconds: 3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			},
+			// Premium sample = 0.001%, length = 60.
+			testFundingSamples:               constants.GenerateConstantFundingPremiums(1000, 60),
+			expectedFundingIndexDeltaStrings: []string{"5000"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 1_000,
+					FundingIndex:    dtypes.NewInt(5000),
+					PremiumPpm:      1_000,
+				},
+			},
+		},
+		"Success: outlier samples are removed by tail sample removal": {
+			testFundingSampleDuration:     60,
+			testFundingTickDuration:       3600,
+			testRemovedTailSampleRatioPpm: 50_000, // 5%
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			},
+			// 57 samples of 0.001% and 3 outlier samples of 0.1%. The bottom and top 3 samples are removed,
+			// leaving 54 samples of 0.001%.
+			testFundingSamples: append(
+				constants.GenerateConstantFundingPremiums(1000, 57),
+				constants.GenerateConstantFundingPremiums(100_000, 3)...,
+			),
+			expectedFundingIndexDeltaStrings: []string{"625"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 1_000,
+					FundingIndex:    dtypes.NewInt(625),
+					PremiumPpm:      1_000,
 				},
 			},
 		},
@@ -1597,6 +1868,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: -1_000,
 					FundingIndex:    dtypes.NewInt(-625),
+					PremiumPpm:      -1_000,
 				},
 			},
 		},
@@ -1616,6 +1888,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: 1_500_000,
 					FundingIndex:    dtypes.NewInt(937500),
+					PremiumPpm:      math.MaxInt32,
 				},
 			},
 		},
@@ -1634,6 +1907,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: -1_500_000,
 					FundingIndex:    dtypes.NewInt(-937500),
+					PremiumPpm:      math.MinInt32,
 				},
 			},
 		},
@@ -1652,6 +1926,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: 2_000,
 					FundingIndex:    dtypes.NewInt(1250),
+					PremiumPpm:      2_000,
 				},
 			},
 		},
@@ -1670,6 +1945,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution_20IM_18MM.GetId(),
 					FundingValuePpm: 120_000,
 					FundingIndex:    dtypes.NewInt(75000),
+					PremiumPpm:      150_000,
 				},
 			},
 		},
@@ -1688,6 +1964,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution_20IM_18MM.GetId(),
 					FundingValuePpm: -120_000,
 					FundingIndex:    dtypes.NewInt(-75000),
+					PremiumPpm:      -150_000,
 				},
 			},
 		},
@@ -1707,6 +1984,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution_20IM_18MM.GetId(),
 					FundingValuePpm: 120_000,
 					FundingIndex:    dtypes.NewInt(75000),
+					PremiumPpm:      120_000,
 				},
 			},
 		},
@@ -1725,6 +2003,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution_20IM_18MM.GetId(),
 					FundingValuePpm: -120_000,
 					FundingIndex:    dtypes.NewInt(-75000),
+					PremiumPpm:      -120_000,
 				},
 			},
 		},
@@ -1743,6 +2022,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_100PercentMarginRequirement.GetId(),
 					FundingValuePpm: 0,
 					FundingIndex:    dtypes.NewInt(0),
+					PremiumPpm:      120_000,
 				},
 			},
 		},
@@ -1767,11 +2047,13 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: 1_000,
 					FundingIndex:    dtypes.NewInt(625),
+					PremiumPpm:      1_000,
 				},
 				{
 					PerpetualId:     constants.EthUsd_0DefaultFunding_9AtomicResolution.GetId(),
 					FundingValuePpm: 1_000,
 					FundingIndex:    dtypes.NewInt(375),
+					PremiumPpm:      1_000,
 				},
 			},
 		},
@@ -1790,6 +2072,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: 500,
 					FundingIndex:    dtypes.NewInt(312),
+					PremiumPpm:      500,
 				},
 			},
 		},
@@ -1807,6 +2090,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: 500,
 					FundingIndex:    dtypes.NewInt(312),
+					PremiumPpm:      500,
 				},
 			},
 		},
@@ -1824,6 +2108,80 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0_001Percent_DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: 2_000, // 0.001% (premium) + 0.001% (default funding)
 					FundingIndex:    dtypes.NewInt(1250),
+					PremiumPpm:      2_000,
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.001 percent, interest rate = 0.001 percent, 60 samples expected": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0_001Percent_Interest_10AtomicResolution,
+			},
+			// Premium sample = 0.001%, length = 60.
+			testFundingSamples:               constants.GenerateConstantFundingPremiums(1000, 60),
+			expectedFundingIndexDeltaStrings: []string{"1250"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0_001Percent_Interest_10AtomicResolution.GetId(),
+					FundingValuePpm: 2_000, // 0.001% (premium) + 0.001% (interest)
+					FundingIndex:    dtypes.NewInt(1250),
+					PremiumPpm:      1_000,
+					InterestPpm:     1_000,
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.2 percent, clamped to max funding rate of 0.001 percent": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0_001Percent_FundingRateBounds_10AtomicResolution,
+			},
+			// Premium sample = 0.2%, length = 60.
+			testFundingSamples:               constants.GenerateConstantFundingPremiums(2000, 60),
+			expectedFundingIndexDeltaStrings: []string{"625"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0_001Percent_FundingRateBounds_10AtomicResolution.GetId(),
+					FundingValuePpm: 1_000,
+					FundingIndex:    dtypes.NewInt(625),
+					PremiumPpm:      2_000,
+				},
+			},
+		},
+		"Success: 60 equivalent samples of -0.2 percent, clamped to min funding rate of -0.001 percent": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0_001Percent_FundingRateBounds_10AtomicResolution,
+			},
+			// Premium sample = -0.2%, length = 60.
+			testFundingSamples:               constants.GenerateConstantFundingPremiums(-2000, 60),
+			expectedFundingIndexDeltaStrings: []string{"-625"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0_001Percent_FundingRateBounds_10AtomicResolution.GetId(),
+					FundingValuePpm: -1_000,
+					FundingIndex:    dtypes.NewInt(-625),
+					PremiumPpm:      -2_000,
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.2 percent, clamped to zero funding rate bounds": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_ZeroFundingRateBounds_10AtomicResolution,
+			},
+			// Premium sample = 0.2%, length = 60.
+			testFundingSamples:               constants.GenerateConstantFundingPremiums(2000, 60),
+			expectedFundingIndexDeltaStrings: []string{"0"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_ZeroFundingRateBounds_10AtomicResolution.GetId(),
+					FundingValuePpm: 0,
+					FundingIndex:    dtypes.NewInt(0),
+					PremiumPpm:      2_000,
 				},
 			},
 		},
@@ -1844,6 +2202,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: 2_000,
 					FundingIndex:    dtypes.NewInt(1250),
+					PremiumPpm:      2_000,
 				},
 			},
 		},
@@ -1861,6 +2220,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_0AtomicResolution.GetId(),
 					FundingValuePpm: 6_000_000,
 					FundingIndex:    dtypes.NewInt(37500000000000000),
+					PremiumPpm:      math.MaxInt32,
 				},
 			},
 		},
@@ -1877,6 +2237,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_0DefaultFunding_0AtomicResolution.GetId(),
 					FundingValuePpm: 0,
 					FundingIndex:    dtypes.NewInt(0),
+					PremiumPpm:      0,
 				},
 			},
 		},
@@ -1893,6 +2254,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					PerpetualId:     constants.BtcUsd_NegativeDefaultFunding_10AtomicResolution.GetId(),
 					FundingValuePpm: -1000,
 					FundingIndex:    dtypes.NewInt(-625),
+					PremiumPpm:      -1000,
 				},
 			},
 		},
@@ -1911,6 +2273,14 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 			// Create liquidity tiers.
 			keepertest.CreateTestLiquidityTiers(t, ctx, pc.PerpetualsKeeper)
 
+			// Set funding rate period and removed tail sample ratio.
+			params := pc.PerpetualsKeeper.GetParams(pc.Ctx)
+			if tc.testFundingRatePeriodSeconds != 0 {
+				params.FundingRatePeriodSeconds = tc.testFundingRatePeriodSeconds
+			}
+			params.RemovedTailSampleRatioPpm = tc.testRemovedTailSampleRatioPpm
+			require.NoError(t, pc.PerpetualsKeeper.SetParams(pc.Ctx, params))
+
 			// Create test perpetuals.
 			// 1BTC = $50,000.
 			oldPerps := make([]types.Perpetual, len(tc.testPerpetuals))
@@ -1923,6 +2293,9 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 				oldPerps[i] = perp
@@ -1987,6 +2360,19 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					actualFundingIndexDelta,
 				)
 
+				// Check that the new funding index was recorded in the funding index history.
+				require.Equal(
+					t,
+					[]types.FundingIndexSnapshot{
+						{
+							BlockHeight:    testCurrentFundingTickEpochStartBlock,
+							FundingRatePpm: tc.fundingRatesAndIndices[i].FundingValuePpm,
+							FundingIndex:   newPerp.FundingIndex,
+						},
+					},
+					pc.PerpetualsKeeper.GetFundingIndexHistory(pc.Ctx, p.Params.Id).Snapshots,
+				)
+
 				// Check that all recorded funding samples from the previous epoch were deleted.
 				allSamples := pc.PerpetualsKeeper.GetPremiumSamples(pc.Ctx)
 				require.NoError(t, err)
@@ -2101,6 +2487,93 @@ func TestMaybeProcessNewFundingTickEpoch_Failure(t *testing.T) {
 	}
 }
 
+// processFundingTicks creates the `funding-tick` and `funding-sample` epochs and processes
+// `numFundingTicks` funding ticks, each with 60 premium samples of 0.001 percent for all perpetuals.
+func processFundingTicks(
+	t *testing.T,
+	pc keepertest.PerpKeepersTestContext,
+	perps []types.Perpetual,
+	numFundingTicks int,
+) {
+	testCurrentFundingTickEpochStartBlock := uint32(23)
+
+	err := pc.EpochsKeeper.CreateEpochInfo(
+		pc.Ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			CurrentEpochStartBlock: testCurrentFundingTickEpochStartBlock,
+			CurrentEpoch:           1,
+		},
+	)
+	require.NoError(t, err)
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		pc.Ctx,
+		epochstypes.EpochInfo{
+			Name:     string(epochstypes.FundingSampleEpochInfoName),
+			Duration: 60,
+		},
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < numFundingTicks; i++ {
+		keepertest.PopulateTestPremiumStore(
+			t,
+			pc.Ctx,
+			pc.PerpetualsKeeper,
+			perps,
+			constants.GenerateConstantFundingPremiums(1000, 60),
+			false, // isVote
+		)
+		pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(
+			// Current block is the start of a new epoch for funding-tick.
+			pc.Ctx.WithBlockHeight(int64(testCurrentFundingTickEpochStartBlock)),
+		)
+	}
+}
+
+func TestMaybeProcessNewFundingTickEpoch_FundingIndexHistoryIsBounded(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	perp := constants.BtcUsd_0DefaultFunding_10AtomicResolution
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		perp.Params.Id,
+		perp.Params.Ticker,
+		perp.Params.MarketId,
+		perp.Params.AtomicResolution,
+		perp.Params.DefaultFundingPpm,
+		perp.Params.LiquidityTier,
+		perp.Params.FundingRateBounds,
+		perp.Params.InterestRatePpm,
+		perp.Params.InsuranceFundScope,
+	)
+	require.NoError(t, err)
+
+	// Each funding tick increases the funding index by 625.
+	processFundingTicks(t, pc, []types.Perpetual{perp}, types.MaxFundingIndexHistoryLength+1)
+
+	// The snapshot of the first funding tick was removed from the history.
+	snapshots := pc.PerpetualsKeeper.GetFundingIndexHistory(pc.Ctx, perp.Params.Id).Snapshots
+	require.Len(t, snapshots, types.MaxFundingIndexHistoryLength)
+	for i, snapshot := range snapshots {
+		require.Equal(
+			t,
+			types.FundingIndexSnapshot{
+				BlockHeight:    23,
+				FundingRatePpm: 1_000,
+				FundingIndex:   dtypes.NewInt(int64(625 * (i + 2))),
+			},
+			snapshot,
+		)
+	}
+
+	newPerp, err := pc.PerpetualsKeeper.GetPerpetual(pc.Ctx, perp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, newPerp.FundingIndex, snapshots[len(snapshots)-1].FundingIndex)
+}
+
 func TestMaybeProcessNewFundingTickEpoch_NoNewEpoch(t *testing.T) {
 	testCurrentFundingTickEpochStartBlock := uint32(23)
 	testCurrentEpoch := uint32(1)
@@ -2771,6 +3244,7 @@ func TestMaybeProcessNewFundingSampleEpoch(t *testing.T) {
 					FundingRateClampFactorPpm: params.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: params.PremiumVoteClampFactorPpm,
 					MinNumVotesPerSample:      tc.minNumVotesPerSample,
+					FundingRatePeriodSeconds:  params.FundingRatePeriodSeconds,
 				},
 			)
 			require.NoError(t, err)
@@ -3137,6 +3611,7 @@ func TestSetParams(t *testing.T) {
 				FundingRateClampFactorPpm: 6_000_000,
 				PremiumVoteClampFactorPpm: 60_000_000,
 				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  28_800,
 			},
 		},
 		"Failure: Funding Rate Clamp is 0": {
@@ -3144,6 +3619,7 @@ func TestSetParams(t *testing.T) {
 				FundingRateClampFactorPpm: 0,
 				PremiumVoteClampFactorPpm: 60_000_000,
 				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  28_800,
 			},
 			expectedErr: types.ErrFundingRateClampFactorPpmIsZero.Error(),
 		},
@@ -3152,9 +3628,38 @@ func TestSetParams(t *testing.T) {
 				FundingRateClampFactorPpm: 6_000_000,
 				PremiumVoteClampFactorPpm: 0,
 				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  28_800,
 			},
 			expectedErr: types.ErrPremiumVoteClampFactorPpmIsZero.Error(),
 		},
+		"Success: 1-hour funding rate period with tail sample removal": {
+			params: types.Params{
+				FundingRateClampFactorPpm: 6_000_000,
+				PremiumVoteClampFactorPpm: 60_000_000,
+				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  3_600,
+				RemovedTailSampleRatioPpm: 50_000,
+			},
+		},
+		"Failure: Funding Rate Period is 0": {
+			params: types.Params{
+				FundingRateClampFactorPpm: 6_000_000,
+				PremiumVoteClampFactorPpm: 60_000_000,
+				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  0,
+			},
+			expectedErr: types.ErrFundingRatePeriodIsZero.Error(),
+		},
+		"Failure: Removed Tail Sample Ratio is too large": {
+			params: types.Params{
+				FundingRateClampFactorPpm: 6_000_000,
+				PremiumVoteClampFactorPpm: 60_000_000,
+				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  28_800,
+				RemovedTailSampleRatioPpm: 500_000,
+			},
+			expectedErr: types.ErrRemovedTailSampleRatioPpmTooLarge.Error(),
+		},
 	}
 
 	// Test setup.
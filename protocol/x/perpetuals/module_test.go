@@ -120,7 +120,8 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	require.Equal(
 		t,
 		`{"perpetuals":[],"liquidity_tiers":[],"params":{"funding_rate_clamp_factor_ppm":6000000,`+
-			`"premium_vote_clamp_factor_ppm":60000000,"min_num_votes_per_sample":15}}`,
+			`"premium_vote_clamp_factor_ppm":60000000,"min_num_votes_per_sample":15,`+
+			`"funding_rate_period_seconds":28800,"removed_tail_sample_ratio_ppm":0}}`,
 		string(json),
 	)
 }
@@ -154,7 +155,9 @@ func TestAppModuleBasic_ValidateGenesisErrBadState(t *testing.T) {
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "funding_rate_period_seconds":28800,
+		   "removed_tail_sample_ratio_ppm":0
 		}
 	 }`)
 
@@ -180,7 +183,9 @@ func TestAppModuleBasic_ValidateGenesis(t *testing.T) {
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "funding_rate_period_seconds":28800,
+		   "removed_tail_sample_ratio_ppm":0
 		}
 	 }`)
 
@@ -244,9 +249,13 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "perpetuals", cmd.Use)
-	require.Equal(t, 2, len(cmd.Commands()))
-	require.Equal(t, "list-perpetual", cmd.Commands()[0].Name())
-	require.Equal(t, "show-perpetual", cmd.Commands()[1].Name())
+	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, "get-funding-index-history", cmd.Commands()[0].Name())
+	require.Equal(t, "get-predicted-funding-rates", cmd.Commands()[1].Name())
+	require.Equal(t, "get-premium-samples", cmd.Commands()[2].Name())
+	require.Equal(t, "get-premium-votes", cmd.Commands()[3].Name())
+	require.Equal(t, "list-perpetual", cmd.Commands()[4].Name())
+	require.Equal(t, "show-perpetual", cmd.Commands()[5].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -263,6 +272,7 @@ func TestAppModule_RegisterServices(t *testing.T) {
 	mockConfigurator.On("MsgServer").Return(mockMsgServer)
 	mockQueryServer.On("RegisterService", mock.Anything, mock.Anything).Return()
 	mockMsgServer.On("RegisterService", mock.Anything, mock.Anything).Return()
+	mockConfigurator.On("RegisterMigration", "perpetuals", uint64(1), mock.Anything).Return(nil)
 
 	am := createAppModule(t)
 	am.RegisterServices(mockConfigurator)
@@ -324,7 +334,9 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "funding_rate_period_seconds":28800,
+		   "removed_tail_sample_ratio_ppm":0
 		}
 	}`
 	gs := json.RawMessage(msg)
@@ -348,7 +360,10 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 				 "market_id":0,
 				 "atomic_resolution":0,
 				 "default_funding_ppm":0,
-				 "liquidity_tier":0
+				 "liquidity_tier":0,
+				 "interest_rate_ppm":0,
+				 "insurance_fund_scope":"INSURANCE_FUND_SCOPE_GLOBAL",
+				 "funding_rate_bounds":null
 			  },
 			  "funding_index":"0"
 		   }
@@ -366,7 +381,9 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "funding_rate_period_seconds":28800,
+		   "removed_tail_sample_ratio_ppm":0
 		}
 	 }`
 	require.Equal(t,
@@ -386,7 +403,7 @@ func TestAppModule_InitGenesisPanic(t *testing.T) {
 
 func TestAppModule_ConsensusVersion(t *testing.T) {
 	am := createAppModule(t)
-	require.Equal(t, uint64(1), am.ConsensusVersion())
+	require.Equal(t, uint64(2), am.ConsensusVersion())
 }
 
 func TestAppModule_BeginBlock(t *testing.T) {
@@ -17,6 +17,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/sim_helpers"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
@@ -114,11 +115,13 @@ func calculateImpactNotional(initialMarginPpm uint32) uint64 {
 	return impactNotional.Uint64()
 }
 
-func genParams(r *rand.Rand, isReasonableGenesis bool) types.Params {
+func genParams(r *rand.Rand, isReasonableGenesis bool, fundingTickDuration uint32) types.Params {
 	return types.Params{
 		FundingRateClampFactorPpm: genFundingRateClampFactorPpm(r, isReasonableGenesis),
 		PremiumVoteClampFactorPpm: genPremiumVoteClampFactorPpm(r, isReasonableGenesis),
 		MinNumVotesPerSample:      genMinNumVotesPerSample(r, isReasonableGenesis),
+		FundingRatePeriodSeconds:  genFundingRatePeriodSeconds(r, isReasonableGenesis, fundingTickDuration),
+		RemovedTailSampleRatioPpm: genRemovedTailSampleRatioPpm(r, isReasonableGenesis),
 	}
 }
 
@@ -154,13 +157,57 @@ func genMinNumVotesPerSample(r *rand.Rand, isReasonableGenesis bool) uint32 {
 	)
 }
 
+// genFundingRatePeriodSeconds returns a randomized uint32 for funding rate period in seconds. The funding
+// rate period must be a multiple of the funding-tick epoch duration, so the randomized period is rounded
+// down to a multiple of the duration, and is at least one funding-tick epoch.
+func genFundingRatePeriodSeconds(r *rand.Rand, isReasonableGenesis bool, fundingTickDuration uint32) uint32 {
+	periodSeconds := uint32(
+		simtypes.RandIntBetween(
+			r,
+			sim_helpers.PickGenesisParameter(sim_helpers.MinFundingRatePeriodSeconds, isReasonableGenesis),
+			sim_helpers.PickGenesisParameter(sim_helpers.MaxFundingRatePeriodSeconds, isReasonableGenesis)+1,
+		),
+	)
+	if periodSeconds < fundingTickDuration {
+		return fundingTickDuration
+	}
+	return periodSeconds - periodSeconds%fundingTickDuration
+}
+
+// getFundingTickDuration returns the duration of the funding-tick epoch from the `Epochs` genesis state.
+func getFundingTickDuration(simState *module.SimulationState, cdc codec.Codec) uint32 {
+	epochsGenesisBytes := simState.GenState[epochstypes.ModuleName]
+	var epochsGenesis epochstypes.GenesisState
+	if err := cdc.UnmarshalJSON(epochsGenesisBytes, &epochsGenesis); err != nil {
+		panic(fmt.Sprintf("Could not unmarshal Epochs GenesisState %s", err))
+	}
+	for _, epochInfo := range epochsGenesis.GetEpochInfoList() {
+		if epochInfo.Name == string(epochstypes.FundingTickEpochInfoName) {
+			return epochInfo.Duration
+		}
+	}
+	panic("Funding-tick epoch not found in Epochs GenesisState")
+}
+
+// genRemovedTailSampleRatioPpm returns a randomized uint32 for removed tail sample ratio ppm.
+func genRemovedTailSampleRatioPpm(r *rand.Rand, isReasonableGenesis bool) uint32 {
+	return uint32(
+		simtypes.RandIntBetween(
+			r,
+			sim_helpers.PickGenesisParameter(sim_helpers.MinRemovedTailSampleRatioPpm, isReasonableGenesis),
+			sim_helpers.PickGenesisParameter(sim_helpers.MaxRemovedTailSampleRatioPpm, isReasonableGenesis)+1,
+		),
+	)
+}
+
 // RandomizedGenState generates a random GenesisState for `Perpetuals`.
 func RandomizedGenState(simState *module.SimulationState) {
 	r := simState.Rand
 	isReasonableGenesis := sim_helpers.ShouldGenerateReasonableGenesis(r, simState.GenTimestamp)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 
 	// Generate `Params`.
-	params := genParams(r, isReasonableGenesis)
+	params := genParams(r, isReasonableGenesis, getFundingTickDuration(simState, cdc))
 
 	// Generate `LiquidityTier`s.
 	numLiquidityTiers := genNumLiquidityTiers(r, isReasonableGenesis)
@@ -180,7 +227,6 @@ func RandomizedGenState(simState *module.SimulationState) {
 	}
 
 	// Get number of `Prices.Markets`.
-	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	pricesGenesisBytes := simState.GenState[pricestypes.ModuleName]
 	var pricesGenesis pricestypes.GenesisState
 	if err := cdc.UnmarshalJSON(pricesGenesisBytes, &pricesGenesis); err != nil {
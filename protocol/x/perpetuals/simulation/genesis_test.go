@@ -11,6 +11,8 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	testutil_rand "github.com/dydxprotocol/v4-chain/protocol/testutil/rand"
+	epochssimulation "github.com/dydxprotocol/v4-chain/protocol/x/epochs/simulation"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/simulation"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricessimulation "github.com/dydxprotocol/v4-chain/protocol/x/prices/simulation"
@@ -34,8 +36,13 @@ func TestRandomizedGenState(t *testing.T) {
 	}
 
 	for i := 0; i < 100; i++ {
-		// `Perpetuals` module has a dependency on `Prices` module.
+		// `Perpetuals` module has a dependency on `Prices` and `Epochs` modules.
 		pricessimulation.RandomizedGenState(&simState)
+		epochssimulation.RandomizedGenState(&simState)
+		var epochsGenesis epochstypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[epochstypes.ModuleName], &epochsGenesis)
+		fundingTickEpochInfo := epochsGenesis.EpochInfoList[0]
+		require.Equal(t, string(epochstypes.FundingTickEpochInfoName), fundingTickEpochInfo.Name)
 
 		simulation.RandomizedGenState(&simState)
 		var perpetualsGenesis types.GenesisState
@@ -48,6 +55,9 @@ func TestRandomizedGenState(t *testing.T) {
 
 		require.True(t, perpetualsGenesis.Params.FundingRateClampFactorPpm > 0)
 		require.True(t, perpetualsGenesis.Params.PremiumVoteClampFactorPpm > 0)
+		require.True(t, perpetualsGenesis.Params.FundingRatePeriodSeconds > 0)
+		require.Zero(t, perpetualsGenesis.Params.FundingRatePeriodSeconds%fundingTickEpochInfo.Duration)
+		require.True(t, perpetualsGenesis.Params.RemovedTailSampleRatioPpm < types.MaxRemovedTailSampleRatioPpm)
 
 		for _, lt := range perpetualsGenesis.LiquidityTiers {
 			require.True(t, len(lt.Name) >= 1)
@@ -107,6 +107,46 @@ var (
 		21,
 		"Maintenance margin fraction is larger than initial margin fraction",
 	)
+	ErrFundingRatePeriodIsZero = errorsmod.Register(
+		ModuleName,
+		22,
+		"Funding rate period is zero",
+	)
+	ErrRemovedTailSampleRatioPpmTooLarge = errorsmod.Register(
+		ModuleName,
+		23,
+		"Removed tail sample ratio ppm is too large",
+	)
+	ErrFundingRatePeriodNotMultipleOfFundingTick = errorsmod.Register(
+		ModuleName,
+		24,
+		"Funding rate period is not a multiple of the funding-tick epoch duration",
+	)
+	ErrRemovedTailSampleRatioRemovesNoSamples = errorsmod.Register(
+		ModuleName,
+		25,
+		"Removed tail sample ratio is non-zero but removes no funding samples of a funding-tick epoch",
+	)
+	ErrMinFundingRateGreaterThanMax = errorsmod.Register(
+		ModuleName,
+		26,
+		"Min funding rate is greater than max funding rate",
+	)
+	ErrInterestRatePpmMagnitudeExceedsMax = errorsmod.Register(
+		ModuleName,
+		27,
+		"InterestRatePpm magnitude exceeds maximum value of 1e6",
+	)
+	ErrInvalidInsuranceFundScope = errorsmod.Register(
+		ModuleName,
+		28,
+		"Invalid insurance fund scope",
+	)
+	ErrInsuranceFundBalanceStranded = errorsmod.Register(
+		ModuleName,
+		29,
+		"Insurance fund would no longer back any perpetual but has a non-zero balance",
+	)
 
 	// Errors for Not Implemented
 	ErrNotImplementedFunding = errorsmod.Register(ModuleName, 1001, "Not Implemented: Perpetuals Funding")
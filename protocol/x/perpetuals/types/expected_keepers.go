@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
@@ -34,6 +36,12 @@ type PerpetualsClobKeeper interface {
 		isActive bool,
 		err error,
 	)
+	GetInsuranceFundBalance(
+		ctx sdk.Context,
+		perpetualId uint32,
+	) (
+		balance *big.Int,
+	)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations.
@@ -7,15 +7,22 @@ import (
 )
 
 const (
-	// Clamp factor for 8-hour funding rate is by default 600%.
+	// Clamp factor for funding rate is by default 600%.
 	DefaultFundingRateClampFactorPpm = 6 * lib.OneMillion
 	// Clamp factor for premium vote is by default 6_000%.
 	DefaultPremiumVoteClampFactorPpm = 60 * lib.OneMillion
 	// Minimum number of votes per sample is by default 15.
 	DefaultMinNumVotesPerSample = 15
+	// Funding rates are by default paid over an 8-hour period.
+	DefaultFundingRatePeriodSeconds = 8 * 3600
+	// No funding samples are removed by default, since samples are already computed as the
+	// median of premium votes.
+	DefaultRemovedTailSampleRatioPpm = 0
 
 	// Maximum default funding rate magnitude is 100%.
 	MaxDefaultFundingPpmAbs = lib.OneMillion
+	// Maximum interest rate magnitude is 100%.
+	MaxInterestRatePpmAbs = lib.OneMillion
 
 	// Liquidity-tier related constants
 	MaxInitialMarginPpm       = lib.OneMillion
@@ -31,6 +38,8 @@ func DefaultGenesis() *GenesisState {
 			FundingRateClampFactorPpm: DefaultFundingRateClampFactorPpm,
 			PremiumVoteClampFactorPpm: DefaultPremiumVoteClampFactorPpm,
 			MinNumVotesPerSample:      DefaultMinNumVotesPerSample,
+			FundingRatePeriodSeconds:  DefaultFundingRatePeriodSeconds,
+			RemovedTailSampleRatioPpm: DefaultRemovedTailSampleRatioPpm,
 		},
 	}
 }
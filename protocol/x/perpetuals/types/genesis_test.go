@@ -44,6 +44,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 3_000_000,
 					PremiumVoteClampFactorPpm: 30_000_000,
 					MinNumVotesPerSample:      0,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: nil,
@@ -82,6 +83,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("duplicated perpetual id"),
@@ -120,6 +122,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("found a gap in perpetual id"),
@@ -150,6 +153,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("Ticker must be non-empty string"),
@@ -180,6 +184,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("InitialMarginPpm exceeds maximum value of 1e6"),
@@ -210,6 +215,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("MaintenanceFractionPpm exceeds maximum value of 1e6"),
@@ -240,6 +246,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 0,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("Funding rate clamp factor ppm is zero"),
@@ -270,6 +277,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 0,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("Premium vote clamp factor ppm is zero"),
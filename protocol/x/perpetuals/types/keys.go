@@ -9,6 +9,13 @@ const (
 	StoreKey = ModuleName
 )
 
+// Module Accounts
+const (
+	// InsuranceFundName defines the root string for the insurance fund account addresses. The global
+	// insurance fund uses the root string as its name.
+	InsuranceFundName = "insurance_fund"
+)
+
 // State
 const (
 	// PerpetualKeyPrefix is the prefix to retrieve all Perpetual
@@ -24,6 +31,10 @@ const (
 	// `funding-tick` epoch.
 	PremiumSamplesKey = "PremSamples"
 
+	// FundingIndexHistoryKeyPrefix is the prefix to retrieve the `FundingIndexHistory`
+	// of a perpetual.
+	FundingIndexHistoryKeyPrefix = "FundIdxHist:"
+
 	// LiquidityTierKeyPrefix is the prefix to retrieve all `LiquidityTier`s.
 	LiquidityTierKeyPrefix = "LiqTier:"
 
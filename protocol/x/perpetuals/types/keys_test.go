@@ -12,10 +12,15 @@ func TestModuleKeys(t *testing.T) {
 	require.Equal(t, "perpetuals", types.StoreKey)
 }
 
+func TestModuleAccountKeys(t *testing.T) {
+	require.Equal(t, "insurance_fund", types.InsuranceFundName)
+}
+
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "Perp:", types.PerpetualKeyPrefix)
 	require.Equal(t, "PremVotes", types.PremiumVotesKey)
 	require.Equal(t, "PremSamples", types.PremiumSamplesKey)
+	require.Equal(t, "FundIdxHist:", types.FundingIndexHistoryKeyPrefix)
 	require.Equal(t, "LiqTier:", types.LiquidityTierKeyPrefix)
 	require.Equal(t, "Params", types.ParamsKey)
 }
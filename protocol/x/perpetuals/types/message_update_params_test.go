@@ -28,6 +28,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 					FundingRateClampFactorPpm: 400_000,
 					PremiumVoteClampFactorPpm: 400_000,
 					MinNumVotesPerSample:      5,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 		},
@@ -44,6 +45,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 					FundingRateClampFactorPpm: 0,
 					PremiumVoteClampFactorPpm: 400_000,
 					MinNumVotesPerSample:      5,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedErr: "Funding rate clamp factor ppm is zero",
@@ -55,10 +57,23 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 					FundingRateClampFactorPpm: 400_000,
 					PremiumVoteClampFactorPpm: 0,
 					MinNumVotesPerSample:      5,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedErr: "Premium vote clamp factor ppm is zero",
 		},
+		"Failure: 0 FundingRatePeriodSeconds": {
+			msg: types.MsgUpdateParams{
+				Authority: validAuthority,
+				Params: types.Params{
+					FundingRateClampFactorPpm: 400_000,
+					PremiumVoteClampFactorPpm: 400_000,
+					MinNumVotesPerSample:      5,
+					FundingRatePeriodSeconds:  0,
+				},
+			},
+			expectedErr: "Funding rate period is zero",
+		},
 	}
 
 	for name, tc := range tests {
@@ -1,5 +1,9 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // Validate validates perpetual module's parameters.
 func (params Params) Validate() error {
 	if params.FundingRateClampFactorPpm == 0 {
@@ -8,6 +12,17 @@ func (params Params) Validate() error {
 	if params.PremiumVoteClampFactorPpm == 0 {
 		return ErrPremiumVoteClampFactorPpmIsZero
 	}
+	if params.FundingRatePeriodSeconds == 0 {
+		return ErrFundingRatePeriodIsZero
+	}
+	if params.RemovedTailSampleRatioPpm >= MaxRemovedTailSampleRatioPpm {
+		return errorsmod.Wrapf(
+			ErrRemovedTailSampleRatioPpmTooLarge,
+			"removed tail sample ratio ppm (%d) must be less than %d",
+			params.RemovedTailSampleRatioPpm,
+			MaxRemovedTailSampleRatioPpm,
+		)
+	}
 
 	return nil
 }
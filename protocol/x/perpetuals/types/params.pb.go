@@ -24,8 +24,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for x/perpetuals module.
 type Params struct {
-	// Funding rate clamp factor in parts-per-million, used for clamping 8-hour
-	// funding rates according to equation: |R| <= funding_rate_clamp_factor *
+	// Funding rate clamp factor in parts-per-million, used for clamping funding
+	// rates according to equation: |R| <= funding_rate_clamp_factor *
 	// (initial margin - maintenance margin).
 	FundingRateClampFactorPpm uint32 `protobuf:"varint,1,opt,name=funding_rate_clamp_factor_ppm,json=fundingRateClampFactorPpm,proto3" json:"funding_rate_clamp_factor_ppm,omitempty"`
 	// Premium vote clamp factor in parts-per-million, used for clamping premium
@@ -35,6 +35,14 @@ type Params struct {
 	// Minimum number of premium votes per premium sample. If number of premium
 	// votes is smaller than this number, pad with zeros up to this number.
 	MinNumVotesPerSample uint32 `protobuf:"varint,3,opt,name=min_num_votes_per_sample,json=minNumVotesPerSample,proto3" json:"min_num_votes_per_sample,omitempty"`
+	// Period (in seconds) over which funding rates are paid. Funding rates are
+	// pro-rated by the funding-tick epoch duration divided by this period, so
+	// this must be a multiple of the funding-tick epoch duration.
+	FundingRatePeriodSeconds uint32 `protobuf:"varint,4,opt,name=funding_rate_period_seconds,json=fundingRatePeriodSeconds,proto3" json:"funding_rate_period_seconds,omitempty"`
+	// Ratio (in parts-per-million) of funding samples to be removed on each end
+	// of the sorted funding samples collected during a funding-tick epoch
+	// before taking their average.
+	RemovedTailSampleRatioPpm uint32 `protobuf:"varint,5,opt,name=removed_tail_sample_ratio_ppm,json=removedTailSampleRatioPpm,proto3" json:"removed_tail_sample_ratio_ppm,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -91,6 +99,20 @@ func (m *Params) GetMinNumVotesPerSample() uint32 {
 	return 0
 }
 
+func (m *Params) GetFundingRatePeriodSeconds() uint32 {
+	if m != nil {
+		return m.FundingRatePeriodSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetRemovedTailSampleRatioPpm() uint32 {
+	if m != nil {
+		return m.RemovedTailSampleRatioPpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dydxprotocol.perpetuals.Params")
 }
@@ -100,24 +122,27 @@ func init() {
 }
 
 var fileDescriptor_8b16af88c7880f7e = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0xc7, 0x73, 0x0a, 0x1d, 0x02, 0x2e, 0x45, 0x30, 0x0e, 0x1e, 0x22, 0x0e, 0x2e, 0x26, 0x83,
-	0xe2, 0xe4, 0x20, 0x0a, 0x8e, 0x12, 0x2a, 0x74, 0x70, 0x39, 0xae, 0x97, 0xd7, 0xf6, 0x20, 0xef,
-	0xee, 0x71, 0x77, 0x29, 0xed, 0xb7, 0xf0, 0x43, 0x39, 0x38, 0x76, 0x74, 0x94, 0xe4, 0x8b, 0x48,
-	0xaf, 0x41, 0x2b, 0xba, 0xbe, 0xdf, 0xef, 0xfd, 0x86, 0x7f, 0x7a, 0x5e, 0xad, 0xaa, 0x25, 0x39,
-	0x1b, 0xac, 0xb2, 0x75, 0x41, 0xe0, 0x08, 0x42, 0x23, 0x6b, 0x5f, 0x90, 0x74, 0x12, 0x7d, 0x1e,
-	0xd1, 0xf0, 0x68, 0xd7, 0xca, 0x7f, 0xac, 0xb3, 0x37, 0x96, 0x0e, 0xca, 0x68, 0x0e, 0xef, 0xd2,
-	0x93, 0x69, 0x63, 0x2a, 0x6d, 0x66, 0xc2, 0xc9, 0x00, 0x42, 0xd5, 0x12, 0x49, 0x4c, 0xa5, 0x0a,
-	0xd6, 0x09, 0x22, 0xcc, 0xd8, 0x29, 0xbb, 0x38, 0x18, 0x1d, 0xf7, 0xd2, 0x48, 0x06, 0x78, 0xd8,
-	0x28, 0x8f, 0xd1, 0x28, 0x09, 0x37, 0x05, 0x72, 0x80, 0xba, 0x41, 0xb1, 0xb0, 0xff, 0x15, 0xf6,
-	0xb6, 0x85, 0x5e, 0x1a, 0xdb, 0x3f, 0x85, 0x9b, 0x34, 0x43, 0x6d, 0x84, 0xe9, 0x0b, 0x5e, 0x10,
-	0x38, 0xe1, 0x25, 0x52, 0x0d, 0xd9, 0x7e, 0x7c, 0x3e, 0x44, 0x6d, 0x9e, 0xb6, 0xbf, 0xbe, 0x04,
-	0xf7, 0x1c, 0xd9, 0xfd, 0xf8, 0xbd, 0xe5, 0x6c, 0xdd, 0x72, 0xf6, 0xd9, 0x72, 0xf6, 0xda, 0xf1,
-	0x64, 0xdd, 0xf1, 0xe4, 0xa3, 0xe3, 0xc9, 0xcb, 0xed, 0x4c, 0x87, 0x79, 0x33, 0xc9, 0x95, 0xc5,
-	0xe2, 0xd7, 0x54, 0x8b, 0xeb, 0x4b, 0x35, 0x97, 0xda, 0x14, 0xdf, 0x97, 0xe5, 0xee, 0x7c, 0x61,
-	0x45, 0xe0, 0x27, 0x83, 0x08, 0xaf, 0xbe, 0x02, 0x00, 0x00, 0xff, 0xff, 0x45, 0x37, 0xf4, 0x5b,
-	0x66, 0x01, 0x00, 0x00,
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4b, 0x03, 0x31,
+	0x18, 0x86, 0x7b, 0x55, 0x3b, 0x1c, 0xb8, 0x14, 0xc1, 0x13, 0xf1, 0x10, 0x71, 0x70, 0xb1, 0x37,
+	0x28, 0x4e, 0x0a, 0xa2, 0xe0, 0x28, 0x47, 0x2b, 0x1d, 0x5c, 0x42, 0x7a, 0xf9, 0xda, 0x06, 0xf2,
+	0x25, 0x1f, 0x49, 0xae, 0xb4, 0xff, 0xc2, 0x3f, 0x25, 0x38, 0x76, 0x74, 0x94, 0xf6, 0x8f, 0xc8,
+	0xe5, 0x0e, 0x6d, 0xd1, 0x35, 0xef, 0xf3, 0x3e, 0x79, 0xe1, 0x8b, 0xcf, 0xc5, 0x42, 0xcc, 0xc9,
+	0x1a, 0x6f, 0x0a, 0xa3, 0x32, 0x02, 0x4b, 0xe0, 0x4b, 0xae, 0x5c, 0x46, 0xdc, 0x72, 0x74, 0xbd,
+	0x10, 0x75, 0x0f, 0x37, 0xa9, 0xde, 0x2f, 0x75, 0xf6, 0xde, 0x8e, 0x3b, 0x79, 0x20, 0xbb, 0xf7,
+	0xf1, 0xc9, 0xb8, 0xd4, 0x42, 0xea, 0x09, 0xb3, 0xdc, 0x03, 0x2b, 0x14, 0x47, 0x62, 0x63, 0x5e,
+	0x78, 0x63, 0x19, 0x11, 0x26, 0xd1, 0x69, 0x74, 0xb1, 0xdf, 0x3f, 0x6a, 0xa0, 0x3e, 0xf7, 0xf0,
+	0x58, 0x21, 0x4f, 0x81, 0xc8, 0x09, 0x2b, 0x03, 0x59, 0x40, 0x59, 0x22, 0x9b, 0x99, 0xff, 0x0c,
+	0xed, 0xda, 0xd0, 0x40, 0x43, 0xf3, 0xc7, 0x70, 0x13, 0x27, 0x28, 0x35, 0xd3, 0x8d, 0xc1, 0x31,
+	0x02, 0xcb, 0x1c, 0x47, 0x52, 0x90, 0xec, 0x84, 0xf2, 0x01, 0x4a, 0xfd, 0x5c, 0x77, 0x5d, 0x0e,
+	0x76, 0x10, 0xb2, 0xee, 0x5d, 0x7c, 0xbc, 0xb5, 0x9d, 0xc0, 0x4a, 0x23, 0x98, 0x83, 0xc2, 0x68,
+	0xe1, 0x92, 0xdd, 0x50, 0x4d, 0x36, 0x96, 0xe7, 0x01, 0x18, 0xd4, 0x79, 0x35, 0xdc, 0x02, 0x9a,
+	0x19, 0x08, 0xe6, 0xb9, 0x54, 0xcd, 0x8f, 0x95, 0x4a, 0x9a, 0x30, 0x7c, 0xaf, 0x1e, 0xde, 0x40,
+	0x2f, 0x5c, 0xaa, 0xfa, 0xe3, 0x7e, 0x45, 0xe4, 0x84, 0x0f, 0xc3, 0x8f, 0x55, 0x1a, 0x2d, 0x57,
+	0x69, 0xf4, 0xb5, 0x4a, 0xa3, 0xb7, 0x75, 0xda, 0x5a, 0xae, 0xd3, 0xd6, 0xe7, 0x3a, 0x6d, 0xbd,
+	0xde, 0x4e, 0xa4, 0x9f, 0x96, 0xa3, 0x5e, 0x61, 0x30, 0xdb, 0xba, 0xd5, 0xec, 0xfa, 0xb2, 0x98,
+	0x72, 0xa9, 0xb3, 0x9f, 0x97, 0xf9, 0xe6, 0xfd, 0xfc, 0x82, 0xc0, 0x8d, 0x3a, 0x21, 0xbc, 0xfa,
+	0x0e, 0x00, 0x00, 0xff, 0xff, 0x41, 0x40, 0xbe, 0x08, 0xe7, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +165,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RemovedTailSampleRatioPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RemovedTailSampleRatioPpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FundingRatePeriodSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FundingRatePeriodSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.MinNumVotesPerSample != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinNumVotesPerSample))
 		i--
@@ -184,6 +219,12 @@ func (m *Params) Size() (n int) {
 	if m.MinNumVotesPerSample != 0 {
 		n += 1 + sovParams(uint64(m.MinNumVotesPerSample))
 	}
+	if m.FundingRatePeriodSeconds != 0 {
+		n += 1 + sovParams(uint64(m.FundingRatePeriodSeconds))
+	}
+	if m.RemovedTailSampleRatioPpm != 0 {
+		n += 1 + sovParams(uint64(m.RemovedTailSampleRatioPpm))
+	}
 	return n
 }
 
@@ -279,6 +320,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRatePeriodSeconds", wireType)
+			}
+			m.FundingRatePeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRatePeriodSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedTailSampleRatioPpm", wireType)
+			}
+			m.RemovedTailSampleRatioPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovedTailSampleRatioPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -13,32 +13,54 @@ func TestParamsValidate(t *testing.T) {
 		fundingRateClampFactorPpm uint32
 		premiumVoteClampFactorPpm uint32
 		minNumVotesPerSample      uint32
+		fundingRatePeriodSeconds  uint32
+		removedTailSampleRatioPpm uint32
 		expectedError             error
 	}{
 		"Validates successfully": {
 			fundingRateClampFactorPpm: 6_000_000,
 			premiumVoteClampFactorPpm: 60_000_000,
 			minNumVotesPerSample:      15,
+			fundingRatePeriodSeconds:  28_800,
 			expectedError:             nil,
 		},
 		"Validates successfully: max values": {
 			fundingRateClampFactorPpm: math.MaxUint32,
 			premiumVoteClampFactorPpm: math.MaxUint32,
 			minNumVotesPerSample:      math.MaxUint32,
+			fundingRatePeriodSeconds:  math.MaxUint32,
+			removedTailSampleRatioPpm: 499_999,
 			expectedError:             nil,
 		},
 		"Failure: funding rate clamp factor ppm is zero": {
 			fundingRateClampFactorPpm: 0,
 			premiumVoteClampFactorPpm: 60_000_000,
 			minNumVotesPerSample:      15,
+			fundingRatePeriodSeconds:  28_800,
 			expectedError:             types.ErrFundingRateClampFactorPpmIsZero,
 		},
 		"Failure: premium vote clamp factor ppm is zero": {
 			fundingRateClampFactorPpm: 6_000_000,
 			premiumVoteClampFactorPpm: 0,
 			minNumVotesPerSample:      15,
+			fundingRatePeriodSeconds:  28_800,
 			expectedError:             types.ErrPremiumVoteClampFactorPpmIsZero,
 		},
+		"Failure: funding rate period is zero": {
+			fundingRateClampFactorPpm: 6_000_000,
+			premiumVoteClampFactorPpm: 60_000_000,
+			minNumVotesPerSample:      15,
+			fundingRatePeriodSeconds:  0,
+			expectedError:             types.ErrFundingRatePeriodIsZero,
+		},
+		"Failure: removed tail sample ratio ppm is too large": {
+			fundingRateClampFactorPpm: 6_000_000,
+			premiumVoteClampFactorPpm: 60_000_000,
+			minNumVotesPerSample:      15,
+			fundingRatePeriodSeconds:  28_800,
+			removedTailSampleRatioPpm: 500_000,
+			expectedError:             types.ErrRemovedTailSampleRatioPpmTooLarge,
+		},
 	}
 
 	// Run tests.
@@ -48,6 +70,8 @@ func TestParamsValidate(t *testing.T) {
 				FundingRateClampFactorPpm: tc.fundingRateClampFactorPpm,
 				PremiumVoteClampFactorPpm: tc.premiumVoteClampFactorPpm,
 				MinNumVotesPerSample:      tc.minNumVotesPerSample,
+				FundingRatePeriodSeconds:  tc.fundingRatePeriodSeconds,
+				RemovedTailSampleRatioPpm: tc.removedTailSampleRatioPpm,
 			}
 
 			err := params.Validate()
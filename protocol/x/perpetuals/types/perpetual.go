@@ -1,7 +1,11 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/pkg/errors"
 )
@@ -25,5 +29,59 @@ func (p *PerpetualParams) Validate() error {
 			lib.IntToString(p.DefaultFundingPpm))
 	}
 
+	// Validate `interestRatePpm`.
+	interestRatePpm := lib.AbsInt32(p.InterestRatePpm)
+	if interestRatePpm > MaxInterestRatePpmAbs {
+		return errorsmod.Wrap(
+			ErrInterestRatePpmMagnitudeExceedsMax,
+			lib.IntToString(p.InterestRatePpm))
+	}
+
+	// Validate `fundingRateBounds`, if set.
+	if p.FundingRateBounds != nil {
+		if err := p.FundingRateBounds.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate `insuranceFundScope`.
+	if _, exists := InsuranceFundScope_name[int32(p.InsuranceFundScope)]; !exists {
+		return errorsmod.Wrap(
+			ErrInvalidInsuranceFundScope,
+			lib.IntToString(int32(p.InsuranceFundScope)))
+	}
+
 	return nil
 }
+
+// Validate validates funding rate bounds.
+func (b *FundingRateBounds) Validate() error {
+	if b.MinFundingRatePpm > b.MaxFundingRatePpm {
+		return errorsmod.Wrapf(
+			ErrMinFundingRateGreaterThanMax,
+			"min funding rate ppm (%d), max funding rate ppm (%d)",
+			b.MinFundingRatePpm,
+			b.MaxFundingRatePpm,
+		)
+	}
+	return nil
+}
+
+// GetInsuranceFundName returns the name of the insurance fund that backs the perpetual. Perpetuals
+// are backed by the global insurance fund unless their insurance fund is scoped to the perpetual or
+// to its liquidity tier.
+func (p *PerpetualParams) GetInsuranceFundName() string {
+	switch p.InsuranceFundScope {
+	case InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL:
+		return fmt.Sprintf("%s:perpetual:%d", InsuranceFundName, p.Id)
+	case InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER:
+		return fmt.Sprintf("%s:liquidity_tier:%d", InsuranceFundName, p.LiquidityTier)
+	default:
+		return InsuranceFundName
+	}
+}
+
+// GetInsuranceFundModuleAddress returns the address of the insurance fund that backs the perpetual.
+func (p *PerpetualParams) GetInsuranceFundModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(p.GetInsuranceFundName())
+}
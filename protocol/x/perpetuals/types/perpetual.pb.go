@@ -24,6 +24,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InsuranceFundScope determines which insurance fund backs a perpetual.
+type InsuranceFundScope int32
+
+const (
+	// Default value. The perpetual is backed by the global insurance fund
+	// shared by all perpetuals with this scope.
+	InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL InsuranceFundScope = 0
+	// The perpetual is backed by an insurance fund of its own.
+	InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL InsuranceFundScope = 1
+	// The perpetual is backed by an insurance fund shared by all perpetuals in
+	// the same liquidity tier with this scope.
+	InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER InsuranceFundScope = 2
+)
+
+var InsuranceFundScope_name = map[int32]string{
+	0: "INSURANCE_FUND_SCOPE_GLOBAL",
+	1: "INSURANCE_FUND_SCOPE_PERPETUAL",
+	2: "INSURANCE_FUND_SCOPE_LIQUIDITY_TIER",
+}
+
+var InsuranceFundScope_value = map[string]int32{
+	"INSURANCE_FUND_SCOPE_GLOBAL":         0,
+	"INSURANCE_FUND_SCOPE_PERPETUAL":      1,
+	"INSURANCE_FUND_SCOPE_LIQUIDITY_TIER": 2,
+}
+
+func (x InsuranceFundScope) String() string {
+	return proto.EnumName(InsuranceFundScope_name, int32(x))
+}
+
+func (InsuranceFundScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{0}
+}
+
 // Perpetual represents a perpetual on the dYdX exchange.
 type Perpetual struct {
 	// PerpetualParams is the parameters of the perpetual.
@@ -94,6 +128,21 @@ type PerpetualParams struct {
 	DefaultFundingPpm int32 `protobuf:"zigzag32,5,opt,name=default_funding_ppm,json=defaultFundingPpm,proto3" json:"default_funding_ppm,omitempty"`
 	// The liquidity_tier that this perpetual is associated with.
 	LiquidityTier uint32 `protobuf:"varint,6,opt,name=liquidity_tier,json=liquidityTier,proto3" json:"liquidity_tier,omitempty"`
+	// The interest rate component of the funding rate per funding rate period,
+	// which is added to the premium before the funding rate is clamped. In
+	// parts-per-million.
+	InterestRatePpm int32 `protobuf:"zigzag32,9,opt,name=interest_rate_ppm,json=interestRatePpm,proto3" json:"interest_rate_ppm,omitempty"`
+	// The insurance fund that covers losses from liquidations of this perpetual
+	// and receives its liquidation fees. Changing the scope or the liquidity
+	// tier of a perpetual does not move funds between insurance funds, and is
+	// rejected if it would leave a non-empty scoped insurance fund backing no
+	// perpetual. A scoped insurance fund is funded by sending USDC to its
+	// address, as returned by the InsuranceFundBalances query.
+	InsuranceFundScope InsuranceFundScope `protobuf:"varint,10,opt,name=insurance_fund_scope,json=insuranceFundScope,proto3,enum=dydxprotocol.perpetuals.InsuranceFundScope" json:"insurance_fund_scope,omitempty"`
+	// The explicit funding rate bounds of this perpetual, which are applied in
+	// addition to the clamp determined by the liquidity tier. Funding rates are
+	// not explicitly bounded if unset.
+	FundingRateBounds *FundingRateBounds `protobuf:"bytes,11,opt,name=funding_rate_bounds,json=fundingRateBounds,proto3" json:"funding_rate_bounds,omitempty"`
 }
 
 func (m *PerpetualParams) Reset()         { *m = PerpetualParams{} }
@@ -171,6 +220,84 @@ func (m *PerpetualParams) GetLiquidityTier() uint32 {
 	return 0
 }
 
+func (m *PerpetualParams) GetInterestRatePpm() int32 {
+	if m != nil {
+		return m.InterestRatePpm
+	}
+	return 0
+}
+
+func (m *PerpetualParams) GetInsuranceFundScope() InsuranceFundScope {
+	if m != nil {
+		return m.InsuranceFundScope
+	}
+	return InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL
+}
+
+func (m *PerpetualParams) GetFundingRateBounds() *FundingRateBounds {
+	if m != nil {
+		return m.FundingRateBounds
+	}
+	return nil
+}
+
+// FundingRateBounds are the explicit bounds of the funding rate of a perpetual.
+type FundingRateBounds struct {
+	// The minimum funding rate of the perpetual per funding rate period. In
+	// parts-per-million.
+	MinFundingRatePpm int32 `protobuf:"zigzag32,1,opt,name=min_funding_rate_ppm,json=minFundingRatePpm,proto3" json:"min_funding_rate_ppm,omitempty"`
+	// The maximum funding rate of the perpetual per funding rate period. In
+	// parts-per-million.
+	MaxFundingRatePpm int32 `protobuf:"zigzag32,2,opt,name=max_funding_rate_ppm,json=maxFundingRatePpm,proto3" json:"max_funding_rate_ppm,omitempty"`
+}
+
+func (m *FundingRateBounds) Reset()         { *m = FundingRateBounds{} }
+func (m *FundingRateBounds) String() string { return proto.CompactTextString(m) }
+func (*FundingRateBounds) ProtoMessage()    {}
+func (*FundingRateBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{2}
+}
+func (m *FundingRateBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingRateBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingRateBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingRateBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingRateBounds.Merge(m, src)
+}
+func (m *FundingRateBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingRateBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingRateBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingRateBounds proto.InternalMessageInfo
+
+func (m *FundingRateBounds) GetMinFundingRatePpm() int32 {
+	if m != nil {
+		return m.MinFundingRatePpm
+	}
+	return 0
+}
+
+func (m *FundingRateBounds) GetMaxFundingRatePpm() int32 {
+	if m != nil {
+		return m.MaxFundingRatePpm
+	}
+	return 0
+}
+
 // MarketPremiums stores a list of premiums for a single perpetual market.
 type MarketPremiums struct {
 	// perpetual_id is the Id of the perpetual market.
@@ -185,7 +312,7 @@ func (m *MarketPremiums) Reset()         { *m = MarketPremiums{} }
 func (m *MarketPremiums) String() string { return proto.CompactTextString(m) }
 func (*MarketPremiums) ProtoMessage()    {}
 func (*MarketPremiums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{2}
+	return fileDescriptor_ce7204eee10038be, []int{3}
 }
 func (m *MarketPremiums) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +378,7 @@ func (m *PremiumStore) Reset()         { *m = PremiumStore{} }
 func (m *PremiumStore) String() string { return proto.CompactTextString(m) }
 func (*PremiumStore) ProtoMessage()    {}
 func (*PremiumStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{3}
+	return fileDescriptor_ce7204eee10038be, []int{4}
 }
 func (m *PremiumStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,6 +421,112 @@ func (m *PremiumStore) GetNumPremiums() uint32 {
 	return 0
 }
 
+// FundingIndexSnapshot is the funding index of a perpetual after a
+// `funding-tick` epoch was processed.
+type FundingIndexSnapshot struct {
+	// The block height at which the `funding-tick` epoch was processed.
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The funding rate applied during the `funding-tick` epoch, in
+	// parts-per-million.
+	FundingRatePpm int32 `protobuf:"varint,2,opt,name=funding_rate_ppm,json=fundingRatePpm,proto3" json:"funding_rate_ppm,omitempty"`
+	// The funding index of the perpetual after the `funding-tick` epoch was
+	// processed.
+	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
+}
+
+func (m *FundingIndexSnapshot) Reset()         { *m = FundingIndexSnapshot{} }
+func (m *FundingIndexSnapshot) String() string { return proto.CompactTextString(m) }
+func (*FundingIndexSnapshot) ProtoMessage()    {}
+func (*FundingIndexSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{5}
+}
+func (m *FundingIndexSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingIndexSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingIndexSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingIndexSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingIndexSnapshot.Merge(m, src)
+}
+func (m *FundingIndexSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingIndexSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingIndexSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingIndexSnapshot proto.InternalMessageInfo
+
+func (m *FundingIndexSnapshot) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FundingIndexSnapshot) GetFundingRatePpm() int32 {
+	if m != nil {
+		return m.FundingRatePpm
+	}
+	return 0
+}
+
+// FundingIndexHistory stores the most recent funding index snapshots of a
+// perpetual, sorted from the oldest to the newest snapshot.
+type FundingIndexHistory struct {
+	Snapshots []FundingIndexSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+}
+
+func (m *FundingIndexHistory) Reset()         { *m = FundingIndexHistory{} }
+func (m *FundingIndexHistory) String() string { return proto.CompactTextString(m) }
+func (*FundingIndexHistory) ProtoMessage()    {}
+func (*FundingIndexHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{6}
+}
+func (m *FundingIndexHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingIndexHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingIndexHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingIndexHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingIndexHistory.Merge(m, src)
+}
+func (m *FundingIndexHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingIndexHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingIndexHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingIndexHistory proto.InternalMessageInfo
+
+func (m *FundingIndexHistory) GetSnapshots() []FundingIndexSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
 // LiquidityTier stores margin information.
 type LiquidityTier struct {
 	// Unique id.
@@ -324,7 +557,7 @@ func (m *LiquidityTier) Reset()         { *m = LiquidityTier{} }
 func (m *LiquidityTier) String() string { return proto.CompactTextString(m) }
 func (*LiquidityTier) ProtoMessage()    {}
 func (*LiquidityTier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{4}
+	return fileDescriptor_ce7204eee10038be, []int{7}
 }
 func (m *LiquidityTier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,10 +629,14 @@ func (m *LiquidityTier) GetImpactNotional() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("dydxprotocol.perpetuals.InsuranceFundScope", InsuranceFundScope_name, InsuranceFundScope_value)
 	proto.RegisterType((*Perpetual)(nil), "dydxprotocol.perpetuals.Perpetual")
 	proto.RegisterType((*PerpetualParams)(nil), "dydxprotocol.perpetuals.PerpetualParams")
+	proto.RegisterType((*FundingRateBounds)(nil), "dydxprotocol.perpetuals.FundingRateBounds")
 	proto.RegisterType((*MarketPremiums)(nil), "dydxprotocol.perpetuals.MarketPremiums")
 	proto.RegisterType((*PremiumStore)(nil), "dydxprotocol.perpetuals.PremiumStore")
+	proto.RegisterType((*FundingIndexSnapshot)(nil), "dydxprotocol.perpetuals.FundingIndexSnapshot")
+	proto.RegisterType((*FundingIndexHistory)(nil), "dydxprotocol.perpetuals.FundingIndexHistory")
 	proto.RegisterType((*LiquidityTier)(nil), "dydxprotocol.perpetuals.LiquidityTier")
 }
 
@@ -408,44 +645,63 @@ func init() {
 }
 
 var fileDescriptor_ce7204eee10038be = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4b, 0x1b, 0x41,
-	0x14, 0xc7, 0xb3, 0x31, 0x0d, 0x3a, 0x26, 0xd1, 0x8c, 0x62, 0x17, 0x0b, 0x31, 0x0d, 0x14, 0x03,
-	0x6d, 0x37, 0x60, 0x3d, 0xf4, 0xd0, 0x43, 0xf1, 0x20, 0x0d, 0xd4, 0x36, 0xac, 0xa5, 0x87, 0x42,
-	0x59, 0x26, 0x3b, 0x63, 0x7c, 0x38, 0x3f, 0xb6, 0xb3, 0xb3, 0x45, 0xfb, 0x57, 0xf8, 0x37, 0xf5,
-	0xe4, 0xd1, 0x63, 0xf1, 0x20, 0x45, 0xff, 0x8b, 0x9e, 0xca, 0xce, 0x4e, 0x36, 0xb1, 0x22, 0xf4,
-	0x94, 0x99, 0xf7, 0xfd, 0xbc, 0x97, 0xef, 0x9b, 0x37, 0xb3, 0x68, 0x9b, 0x9e, 0xd1, 0xd3, 0x44,
-	0x2b, 0xa3, 0x62, 0xc5, 0x07, 0x09, 0xd3, 0x09, 0x33, 0x19, 0xe1, 0xe9, 0x6c, 0x19, 0x58, 0x15,
-	0x3f, 0x9e, 0x07, 0x83, 0x19, 0xb8, 0xb9, 0x3e, 0x51, 0x13, 0x65, 0x85, 0x41, 0xbe, 0x2a, 0xf0,
-	0xde, 0x4f, 0x0f, 0x2d, 0x8d, 0xa6, 0x10, 0xde, 0x47, 0xf5, 0x84, 0x68, 0x22, 0x52, 0xdf, 0xeb,
-	0x7a, 0xfd, 0xe5, 0x9d, 0x7e, 0xf0, 0x40, 0xb5, 0xa0, 0xcc, 0x19, 0x59, 0x7e, 0xaf, 0x76, 0x71,
-	0xbd, 0x55, 0x09, 0x5d, 0x36, 0x16, 0xa8, 0x79, 0x94, 0x49, 0x0a, 0x72, 0x12, 0x81, 0xa4, 0xec,
-	0xd4, 0xaf, 0x76, 0xbd, 0x7e, 0x63, 0xef, 0x5d, 0x0e, 0x5d, 0x5d, 0x6f, 0xbd, 0x9d, 0x80, 0x39,
-	0xce, 0xc6, 0x41, 0xac, 0xc4, 0xe0, 0x4e, 0x5f, 0xdf, 0x77, 0x5f, 0xc6, 0xc7, 0x04, 0xe4, 0xa0,
-	0x8c, 0x50, 0x73, 0x96, 0xb0, 0x34, 0x38, 0x64, 0x1a, 0x08, 0x87, 0x1f, 0x64, 0xcc, 0xd9, 0x50,
-	0x9a, 0xb0, 0xe1, 0xca, 0x0f, 0xf3, 0xea, 0xbd, 0x2b, 0x0f, 0xad, 0xfc, 0x63, 0x08, 0xb7, 0x50,
-	0x15, 0xa8, 0x6d, 0xa3, 0x19, 0x56, 0x81, 0xe2, 0x0d, 0x54, 0x37, 0x10, 0x9f, 0x30, 0x6d, 0xbd,
-	0x2c, 0x85, 0x6e, 0x87, 0x9f, 0xa0, 0x25, 0x41, 0xf4, 0x09, 0x33, 0x11, 0x50, 0x7f, 0xc1, 0xe2,
-	0x8b, 0x45, 0x60, 0x48, 0xf1, 0x73, 0xd4, 0x26, 0x46, 0x09, 0x88, 0x23, 0xcd, 0x52, 0xc5, 0x33,
-	0x03, 0x4a, 0xfa, 0xb5, 0xae, 0xd7, 0x6f, 0x87, 0xab, 0x85, 0x10, 0x96, 0x71, 0x1c, 0xa0, 0x35,
-	0xca, 0x8e, 0x48, 0xc6, 0x4d, 0x34, 0x6d, 0x3e, 0x49, 0x84, 0xff, 0xc8, 0xe2, 0x6d, 0x27, 0xed,
-	0x17, 0xca, 0x28, 0x11, 0xf8, 0x19, 0x6a, 0x71, 0xf8, 0x96, 0x01, 0x05, 0x73, 0x16, 0x19, 0x60,
-	0xda, 0xaf, 0xdb, 0xbf, 0x6f, 0x96, 0xd1, 0x4f, 0xc0, 0x74, 0xef, 0x23, 0x6a, 0x1d, 0x58, 0x3f,
-	0x23, 0xcd, 0x04, 0x64, 0x22, 0xc5, 0x4f, 0x51, 0xa3, 0x9c, 0x44, 0x54, 0x36, 0xb9, 0x5c, 0xc6,
-	0x86, 0x14, 0x6f, 0xa2, 0xc5, 0xc4, 0xe1, 0x7e, 0xb5, 0xbb, 0xd0, 0x6f, 0x87, 0xe5, 0xbe, 0x77,
-	0xee, 0xa1, 0x86, 0xab, 0x75, 0x68, 0x94, 0x66, 0xf8, 0x2b, 0x5a, 0x23, 0x9c, 0x47, 0xee, 0x18,
-	0xca, 0x3c, 0xaf, 0xbb, 0xd0, 0x5f, 0xde, 0xd9, 0x7e, 0xf0, 0x0a, 0xdc, 0x75, 0xe5, 0x6e, 0x40,
-	0x9b, 0x70, 0x7e, 0xdf, 0xae, 0xcc, 0x44, 0x34, 0xe7, 0xc7, 0xda, 0x95, 0x99, 0x98, 0x22, 0xbd,
-	0x3f, 0x1e, 0x6a, 0xbe, 0x9f, 0xef, 0xfa, 0xde, 0xf8, 0x30, 0xaa, 0x49, 0x22, 0x98, 0x1b, 0x9e,
-	0x5d, 0xe3, 0x17, 0x08, 0x83, 0x04, 0x03, 0xc4, 0x7a, 0x9f, 0x80, 0xb4, 0xe7, 0x5d, 0xcc, 0x70,
-	0xd5, 0x29, 0x07, 0x56, 0xc8, 0x8f, 0xfb, 0x35, 0xf2, 0x05, 0x01, 0x69, 0x98, 0x24, 0x32, 0x66,
-	0xd1, 0x91, 0x26, 0x71, 0x3e, 0x36, 0x9b, 0x53, 0xb3, 0x39, 0x1b, 0x73, 0xfa, 0xbe, 0x93, 0xf3,
-	0xcc, 0x5d, 0xb4, 0x31, 0x26, 0x29, 0x8b, 0x12, 0x95, 0x82, 0x4d, 0x91, 0x2a, 0xff, 0x21, 0xdc,
-	0xce, 0xb6, 0x16, 0xae, 0xe7, 0xea, 0xc8, 0x89, 0x1f, 0x9c, 0x86, 0xb7, 0xd1, 0x0a, 0x88, 0x84,
-	0xc4, 0x66, 0x86, 0xd7, 0x2d, 0xde, 0x2a, 0xc2, 0x53, 0x70, 0xef, 0xf3, 0xc5, 0x4d, 0xc7, 0xbb,
-	0xbc, 0xe9, 0x78, 0xbf, 0x6f, 0x3a, 0xde, 0xf9, 0x6d, 0xa7, 0x72, 0x79, 0xdb, 0xa9, 0xfc, 0xba,
-	0xed, 0x54, 0xbe, 0xbc, 0xf9, 0xff, 0x77, 0x72, 0x3a, 0xff, 0x4d, 0xb0, 0x6f, 0x66, 0x5c, 0xb7,
-	0xe2, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x2e, 0x21, 0x26, 0x3b, 0x04, 0x00, 0x00,
+	// 891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x15, 0x25, 0x45, 0x95, 0xc6, 0xb6, 0x22, 0xad, 0x05, 0x97, 0x48, 0x00, 0x59, 0x55, 0x51,
+	0x58, 0x70, 0x1a, 0x09, 0x70, 0x73, 0xe8, 0xa1, 0x87, 0x5a, 0x89, 0x54, 0x33, 0x50, 0x6c, 0x85,
+	0xb2, 0x0b, 0x34, 0x40, 0x40, 0xac, 0xc8, 0xb5, 0xb4, 0x30, 0xb9, 0xcb, 0x92, 0xcb, 0x42, 0xee,
+	0xb1, 0x5f, 0x90, 0x6f, 0xea, 0x29, 0x97, 0x02, 0x41, 0x4f, 0x45, 0x0f, 0x41, 0x61, 0xff, 0x45,
+	0x4f, 0x05, 0x97, 0x4b, 0x5a, 0xb2, 0x6c, 0xb4, 0x97, 0x9e, 0xbc, 0x9c, 0xf7, 0xde, 0x78, 0xe6,
+	0xed, 0xcc, 0x0a, 0xf6, 0x9c, 0x4b, 0x67, 0xe1, 0x07, 0x5c, 0x70, 0x9b, 0xbb, 0x3d, 0x9f, 0x04,
+	0x3e, 0x11, 0x11, 0x76, 0xc3, 0x9b, 0x63, 0x57, 0xa2, 0xe8, 0xd3, 0x65, 0x62, 0xf7, 0x86, 0xf8,
+	0xa8, 0x31, 0xe3, 0x33, 0x2e, 0x81, 0x5e, 0x7c, 0x4a, 0xe8, 0xed, 0x5f, 0x35, 0xa8, 0x8c, 0x53,
+	0x12, 0x1a, 0x42, 0xc9, 0xc7, 0x01, 0xf6, 0x42, 0x5d, 0x6b, 0x69, 0x9d, 0x8d, 0x83, 0x4e, 0xf7,
+	0x9e, 0x6c, 0xdd, 0x4c, 0x33, 0x96, 0xfc, 0x7e, 0xf1, 0xfd, 0xc7, 0xdd, 0x9c, 0xa9, 0xd4, 0xc8,
+	0x83, 0xad, 0xf3, 0x88, 0x39, 0x94, 0xcd, 0x2c, 0xca, 0x1c, 0xb2, 0xd0, 0xf3, 0x2d, 0xad, 0xb3,
+	0xd9, 0x3f, 0x8a, 0x49, 0x7f, 0x7e, 0xdc, 0xfd, 0x76, 0x46, 0xc5, 0x3c, 0x9a, 0x76, 0x6d, 0xee,
+	0xf5, 0x56, 0xfa, 0xfa, 0xe9, 0xd9, 0x53, 0x7b, 0x8e, 0x29, 0xeb, 0x65, 0x11, 0x47, 0x5c, 0xfa,
+	0x24, 0xec, 0x4e, 0x48, 0x40, 0xb1, 0x4b, 0x7f, 0xc6, 0x53, 0x97, 0x18, 0x4c, 0x98, 0x9b, 0x2a,
+	0xbd, 0x11, 0x67, 0x6f, 0xff, 0x56, 0x80, 0x87, 0xb7, 0x0a, 0x42, 0x55, 0xc8, 0x53, 0x47, 0xb6,
+	0xb1, 0x65, 0xe6, 0xa9, 0x83, 0x76, 0xa0, 0x24, 0xa8, 0x7d, 0x41, 0x02, 0x59, 0x4b, 0xc5, 0x54,
+	0x5f, 0xe8, 0x31, 0x54, 0x3c, 0x1c, 0x5c, 0x10, 0x61, 0x51, 0x47, 0x2f, 0x48, 0x7a, 0x39, 0x09,
+	0x18, 0x0e, 0x7a, 0x02, 0x75, 0x2c, 0xb8, 0x47, 0x6d, 0x2b, 0x20, 0x21, 0x77, 0x23, 0x41, 0x39,
+	0xd3, 0x8b, 0x2d, 0xad, 0x53, 0x37, 0x6b, 0x09, 0x60, 0x66, 0x71, 0xd4, 0x85, 0x6d, 0x87, 0x9c,
+	0xe3, 0xc8, 0x15, 0x56, 0xda, 0xbc, 0xef, 0x7b, 0xfa, 0x03, 0x49, 0xaf, 0x2b, 0x68, 0x98, 0x20,
+	0x63, 0xdf, 0x43, 0x5f, 0x40, 0xd5, 0xa5, 0x3f, 0x46, 0xd4, 0xa1, 0xe2, 0xd2, 0x12, 0x94, 0x04,
+	0x7a, 0x49, 0xfe, 0xfb, 0xad, 0x2c, 0x7a, 0x4a, 0x49, 0x80, 0xf6, 0xa1, 0x4e, 0x99, 0x20, 0x01,
+	0x09, 0x85, 0x15, 0x60, 0x41, 0x64, 0xd2, 0x8a, 0x4c, 0xfa, 0x30, 0x05, 0x4c, 0x2c, 0x48, 0x9c,
+	0xf2, 0x2d, 0x34, 0x28, 0x0b, 0xa3, 0x00, 0x33, 0x9b, 0xc8, 0x22, 0xac, 0xd0, 0xe6, 0x3e, 0xd1,
+	0xa1, 0xa5, 0x75, 0xaa, 0x07, 0x4f, 0xee, 0xbd, 0x4d, 0x23, 0x15, 0xc5, 0xe5, 0x4d, 0x62, 0x89,
+	0x89, 0xe8, 0x5a, 0x0c, 0xbd, 0x81, 0xed, 0xb4, 0x33, 0x59, 0xc9, 0x94, 0x47, 0xcc, 0x09, 0xf5,
+	0x0d, 0x39, 0x2b, 0xfb, 0xf7, 0x66, 0x57, 0x3d, 0xc7, 0x45, 0xf6, 0xa5, 0xc2, 0xac, 0x9f, 0xdf,
+	0x0e, 0xbd, 0x2c, 0x96, 0x3f, 0xa9, 0x95, 0x5f, 0x16, 0xcb, 0xe5, 0x5a, 0xa5, 0x1d, 0x41, 0x7d,
+	0x4d, 0x83, 0x7a, 0xd0, 0xf0, 0x28, 0xb3, 0x56, 0x0a, 0x88, 0xad, 0xd0, 0x12, 0x7f, 0x3d, 0xca,
+	0x96, 0x34, 0xb1, 0x19, 0xb1, 0x00, 0x2f, 0xd6, 0x05, 0x79, 0x25, 0xc0, 0x8b, 0x55, 0x41, 0xfb,
+	0x04, 0xaa, 0xaf, 0xe4, 0xcd, 0x8f, 0x03, 0xe2, 0xd1, 0xc8, 0x0b, 0xd1, 0x67, 0xb0, 0x99, 0xf5,
+	0x61, 0x65, 0xe3, 0xb4, 0x91, 0xc5, 0x0c, 0x07, 0x3d, 0x82, 0xb2, 0xaf, 0xe8, 0x7a, 0xbe, 0x55,
+	0xe8, 0xd4, 0xcd, 0xec, 0xbb, 0xfd, 0x4e, 0x83, 0x4d, 0x95, 0x6b, 0x22, 0x78, 0x40, 0xd0, 0x5b,
+	0xd8, 0xc6, 0xae, 0x6b, 0xa9, 0x81, 0xcb, 0x74, 0x5a, 0xab, 0xd0, 0xd9, 0x38, 0xd8, 0xbb, 0xd7,
+	0xc0, 0xd5, 0xaa, 0xd4, 0xae, 0xd5, 0xb1, 0xeb, 0xae, 0x97, 0xcb, 0x22, 0xcf, 0x5a, 0xaa, 0x47,
+	0x96, 0xcb, 0x22, 0x2f, 0xa5, 0xb4, 0x7f, 0xd7, 0xa0, 0x31, 0x5c, 0xda, 0x9d, 0x09, 0xc3, 0x7e,
+	0x38, 0xe7, 0x22, 0xd6, 0x4e, 0x5d, 0x6e, 0x5f, 0x58, 0x73, 0x42, 0x67, 0x73, 0x91, 0xb6, 0x2a,
+	0x63, 0x47, 0x32, 0x84, 0x3a, 0x50, 0xbb, 0xd3, 0xcc, 0x07, 0x66, 0xf5, 0x7c, 0xd5, 0xfa, 0xb5,
+	0xfd, 0x2f, 0xfc, 0xaf, 0xfb, 0x3f, 0x87, 0xed, 0xe5, 0x9e, 0x8e, 0x68, 0x28, 0x78, 0x70, 0x89,
+	0x5e, 0x43, 0x25, 0x54, 0xed, 0xa5, 0x1e, 0x3f, 0xfd, 0xb7, 0x21, 0x5d, 0x31, 0x45, 0x39, 0x7d,
+	0x93, 0xa5, 0xfd, 0xb7, 0x06, 0x5b, 0xa3, 0x95, 0xf5, 0xbc, 0xfd, 0xce, 0x20, 0x28, 0x32, 0xec,
+	0x11, 0xf5, 0xca, 0xc8, 0x33, 0xfa, 0x12, 0x10, 0x65, 0x54, 0x50, 0x2c, 0xaf, 0x7e, 0x46, 0x99,
+	0xb4, 0x2e, 0x79, 0x6c, 0x6a, 0x0a, 0x79, 0x25, 0x81, 0xd8, 0xbc, 0xaf, 0x41, 0xf7, 0x70, 0xbc,
+	0xd9, 0x2c, 0x59, 0xe3, 0x00, 0xdb, 0x82, 0xf2, 0x44, 0x53, 0x94, 0x9a, 0x9d, 0x25, 0x7c, 0xa8,
+	0xe0, 0x58, 0xf9, 0x0c, 0x76, 0xa6, 0x38, 0x24, 0x96, 0xcf, 0x43, 0x2a, 0x25, 0x8c, 0xc7, 0x7f,
+	0xb0, 0x2b, 0x1f, 0xa1, 0xa2, 0xd9, 0x88, 0xd1, 0xb1, 0x02, 0x8f, 0x15, 0x86, 0xf6, 0xe0, 0x21,
+	0xf5, 0x7c, 0x6c, 0x8b, 0x1b, 0x7a, 0x49, 0xd2, 0xab, 0x49, 0x38, 0x25, 0xee, 0xff, 0xa2, 0x01,
+	0x5a, 0x7f, 0x29, 0xd0, 0x2e, 0x3c, 0x36, 0x8e, 0x27, 0x67, 0xe6, 0xe1, 0xf1, 0xf3, 0x81, 0x35,
+	0x3c, 0x3b, 0x7e, 0x61, 0x4d, 0x9e, 0x9f, 0x8c, 0x07, 0xd6, 0x77, 0xa3, 0x93, 0xfe, 0xe1, 0xa8,
+	0x96, 0x43, 0x6d, 0x68, 0xde, 0x49, 0x18, 0x0f, 0xcc, 0xf1, 0xe0, 0xf4, 0xec, 0x70, 0x54, 0xd3,
+	0xd0, 0x1e, 0x7c, 0x7e, 0x27, 0x67, 0x64, 0xbc, 0x3e, 0x33, 0x5e, 0x18, 0xa7, 0x3f, 0x58, 0xa7,
+	0xc6, 0xc0, 0xac, 0xe5, 0xfb, 0xdf, 0xbf, 0xbf, 0x6a, 0x6a, 0x1f, 0xae, 0x9a, 0xda, 0x5f, 0x57,
+	0x4d, 0xed, 0xdd, 0x75, 0x33, 0xf7, 0xe1, 0xba, 0x99, 0xfb, 0xe3, 0xba, 0x99, 0x7b, 0xf3, 0xcd,
+	0x7f, 0x9f, 0xaa, 0xc5, 0xf2, 0x2f, 0xa8, 0x9c, 0xb0, 0x69, 0x49, 0x82, 0x5f, 0xfd, 0x13, 0x00,
+	0x00, 0xff, 0xff, 0x82, 0x84, 0x71, 0x11, 0x69, 0x07, 0x00, 0x00,
 }
 
 func (m *Perpetual) Marshal() (dAtA []byte, err error) {
@@ -511,6 +767,28 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FundingRateBounds != nil {
+		{
+			size, err := m.FundingRateBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPerpetual(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.InsuranceFundScope != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.InsuranceFundScope))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.InterestRatePpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.InterestRatePpm)<<1)^uint32((m.InterestRatePpm>>31))))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.LiquidityTier != 0 {
 		i = encodeVarintPerpetual(dAtA, i, uint64(m.LiquidityTier))
 		i--
@@ -546,6 +824,39 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FundingRateBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRateBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRateBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFundingRatePpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.MaxFundingRatePpm)<<1)^uint32((m.MaxFundingRatePpm>>31))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinFundingRatePpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.MinFundingRatePpm)<<1)^uint32((m.MinFundingRatePpm>>31))))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketPremiums) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -567,21 +878,21 @@ func (m *MarketPremiums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Premiums) > 0 {
-		dAtA2 := make([]byte, len(m.Premiums)*5)
-		var j3 int
+		dAtA3 := make([]byte, len(m.Premiums)*5)
+		var j4 int
 		for _, num := range m.Premiums {
-			x4 := (uint32(num) << 1) ^ uint32((num >> 31))
-			for x4 >= 1<<7 {
-				dAtA2[j3] = uint8(uint64(x4)&0x7f | 0x80)
-				j3++
-				x4 >>= 7
-			}
-			dAtA2[j3] = uint8(x4)
-			j3++
+			x5 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x5 >= 1<<7 {
+				dAtA3[j4] = uint8(uint64(x5)&0x7f | 0x80)
+				j4++
+				x5 >>= 7
+			}
+			dAtA3[j4] = uint8(x5)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA2[:j3])
-		i = encodeVarintPerpetual(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA3[:j4])
+		i = encodeVarintPerpetual(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -635,6 +946,86 @@ func (m *PremiumStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FundingIndexSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingIndexSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingIndexSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FundingIndex.Size()
+		i -= size
+		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPerpetual(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FundingRatePpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.FundingRatePpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingIndexHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingIndexHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingIndexHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPerpetual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LiquidityTier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -739,6 +1130,31 @@ func (m *PerpetualParams) Size() (n int) {
 	if m.LiquidityTier != 0 {
 		n += 1 + sovPerpetual(uint64(m.LiquidityTier))
 	}
+	if m.InterestRatePpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.InterestRatePpm))
+	}
+	if m.InsuranceFundScope != 0 {
+		n += 1 + sovPerpetual(uint64(m.InsuranceFundScope))
+	}
+	if m.FundingRateBounds != nil {
+		l = m.FundingRateBounds.Size()
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	return n
+}
+
+func (m *FundingRateBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinFundingRatePpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.MinFundingRatePpm))
+	}
+	if m.MaxFundingRatePpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.MaxFundingRatePpm))
+	}
 	return n
 }
 
@@ -779,24 +1195,56 @@ func (m *PremiumStore) Size() (n int) {
 	return n
 }
 
-func (m *LiquidityTier) Size() (n int) {
+func (m *FundingIndexSnapshot) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovPerpetual(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPerpetual(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovPerpetual(uint64(m.BlockHeight))
 	}
-	if m.InitialMarginPpm != 0 {
-		n += 1 + sovPerpetual(uint64(m.InitialMarginPpm))
+	if m.FundingRatePpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.FundingRatePpm))
 	}
-	if m.MaintenanceFractionPpm != 0 {
-		n += 1 + sovPerpetual(uint64(m.MaintenanceFractionPpm))
+	l = m.FundingIndex.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	return n
+}
+
+func (m *FundingIndexHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovPerpetual(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPerpetual(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	if m.InitialMarginPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.InitialMarginPpm))
+	}
+	if m.MaintenanceFractionPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.MaintenanceFractionPpm))
 	}
 	if m.BasePositionNotional != 0 {
 		n += 1 + sovPerpetual(uint64(m.BasePositionNotional))
@@ -1089,6 +1537,174 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRatePpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.InterestRatePpm = v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundScope", wireType)
+			}
+			m.InsuranceFundScope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsuranceFundScope |= InsuranceFundScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FundingRateBounds == nil {
+				m.FundingRateBounds = &FundingRateBounds{}
+			}
+			if err := m.FundingRateBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRateBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRateBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRateBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFundingRatePpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.MinFundingRatePpm = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingRatePpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.MaxFundingRatePpm = v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
@@ -1360,6 +1976,211 @@ func (m *PremiumStore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FundingIndexSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingIndexSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingIndexSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRatePpm", wireType)
+			}
+			m.FundingRatePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRatePpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingIndex", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingIndexHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingIndexHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingIndexHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, FundingIndexSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/stretchr/testify/require"
 )
@@ -37,6 +38,62 @@ func TestPerpetualParams_Validate(t *testing.T) {
 			},
 			expectedErr: "DefaultFundingPpm magnitude exceeds maximum value",
 		},
+		{
+			desc: "Valid funding rate bounds and interest rate",
+			params: types.PerpetualParams{
+				Ticker:            "test",
+				DefaultFundingPpm: 1_000_000,
+				FundingRateBounds: &types.FundingRateBounds{
+					MinFundingRatePpm: -10_000,
+					MaxFundingRatePpm: 10_000,
+				},
+				InterestRatePpm: -1_000_000,
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Valid zero funding rate bounds",
+			params: types.PerpetualParams{
+				Ticker:            "test",
+				FundingRateBounds: &types.FundingRateBounds{},
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Invalid InterestRatePpm",
+			params: types.PerpetualParams{
+				Ticker:          "test",
+				InterestRatePpm: 1_000_001,
+			},
+			expectedErr: "InterestRatePpm magnitude exceeds maximum value",
+		},
+		{
+			desc: "MinFundingRatePpm greater than MaxFundingRatePpm",
+			params: types.PerpetualParams{
+				Ticker: "test",
+				FundingRateBounds: &types.FundingRateBounds{
+					MinFundingRatePpm: 1,
+					MaxFundingRatePpm: 0,
+				},
+			},
+			expectedErr: "Min funding rate is greater than max funding rate",
+		},
+		{
+			desc: "Valid InsuranceFundScope",
+			params: types.PerpetualParams{
+				Ticker:             "test",
+				InsuranceFundScope: types.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Invalid InsuranceFundScope",
+			params: types.PerpetualParams{
+				Ticker:             "test",
+				InsuranceFundScope: types.InsuranceFundScope(3),
+			},
+			expectedErr: "Invalid insurance fund scope",
+		},
 	}
 
 	for _, tc := range tests {
@@ -50,3 +107,36 @@ func TestPerpetualParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPerpetualParams_GetInsuranceFundName(t *testing.T) {
+	tests := map[string]struct {
+		insuranceFundScope types.InsuranceFundScope
+
+		expectedName string
+	}{
+		"Global": {
+			insuranceFundScope: types.InsuranceFundScope_INSURANCE_FUND_SCOPE_GLOBAL,
+			expectedName:       "insurance_fund",
+		},
+		"Perpetual": {
+			insuranceFundScope: types.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+			expectedName:       "insurance_fund:perpetual:5",
+		},
+		"Liquidity tier": {
+			insuranceFundScope: types.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+			expectedName:       "insurance_fund:liquidity_tier:2",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.PerpetualParams{
+				Id:                 5,
+				LiquidityTier:      2,
+				InsuranceFundScope: tc.insuranceFundScope,
+			}
+			require.Equal(t, tc.expectedName, params.GetInsuranceFundName())
+			require.Equal(t, authtypes.NewModuleAddress(tc.expectedName), params.GetInsuranceFundModuleAddress())
+		})
+	}
+}
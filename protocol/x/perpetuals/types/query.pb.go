@@ -218,11 +218,451 @@ func (m *QueryAllPerpetualsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryPremiumSamplesRequest is request type for the PremiumSamples RPC
+// method.
+type QueryPremiumSamplesRequest struct {
+}
+
+func (m *QueryPremiumSamplesRequest) Reset()         { *m = QueryPremiumSamplesRequest{} }
+func (m *QueryPremiumSamplesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPremiumSamplesRequest) ProtoMessage()    {}
+func (*QueryPremiumSamplesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{4}
+}
+func (m *QueryPremiumSamplesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPremiumSamplesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPremiumSamplesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPremiumSamplesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPremiumSamplesRequest.Merge(m, src)
+}
+func (m *QueryPremiumSamplesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPremiumSamplesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPremiumSamplesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPremiumSamplesRequest proto.InternalMessageInfo
+
+// QueryPremiumSamplesResponse is response type for the PremiumSamples RPC
+// method.
+type QueryPremiumSamplesResponse struct {
+	PremiumSamples PremiumStore `protobuf:"bytes,1,opt,name=premium_samples,json=premiumSamples,proto3" json:"premium_samples"`
+}
+
+func (m *QueryPremiumSamplesResponse) Reset()         { *m = QueryPremiumSamplesResponse{} }
+func (m *QueryPremiumSamplesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPremiumSamplesResponse) ProtoMessage()    {}
+func (*QueryPremiumSamplesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{5}
+}
+func (m *QueryPremiumSamplesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPremiumSamplesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPremiumSamplesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPremiumSamplesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPremiumSamplesResponse.Merge(m, src)
+}
+func (m *QueryPremiumSamplesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPremiumSamplesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPremiumSamplesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPremiumSamplesResponse proto.InternalMessageInfo
+
+func (m *QueryPremiumSamplesResponse) GetPremiumSamples() PremiumStore {
+	if m != nil {
+		return m.PremiumSamples
+	}
+	return PremiumStore{}
+}
+
+// QueryPremiumVotesRequest is request type for the PremiumVotes RPC method.
+type QueryPremiumVotesRequest struct {
+}
+
+func (m *QueryPremiumVotesRequest) Reset()         { *m = QueryPremiumVotesRequest{} }
+func (m *QueryPremiumVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPremiumVotesRequest) ProtoMessage()    {}
+func (*QueryPremiumVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{6}
+}
+func (m *QueryPremiumVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPremiumVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPremiumVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPremiumVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPremiumVotesRequest.Merge(m, src)
+}
+func (m *QueryPremiumVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPremiumVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPremiumVotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPremiumVotesRequest proto.InternalMessageInfo
+
+// QueryPremiumVotesResponse is response type for the PremiumVotes RPC method.
+type QueryPremiumVotesResponse struct {
+	PremiumVotes PremiumStore `protobuf:"bytes,1,opt,name=premium_votes,json=premiumVotes,proto3" json:"premium_votes"`
+}
+
+func (m *QueryPremiumVotesResponse) Reset()         { *m = QueryPremiumVotesResponse{} }
+func (m *QueryPremiumVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPremiumVotesResponse) ProtoMessage()    {}
+func (*QueryPremiumVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{7}
+}
+func (m *QueryPremiumVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPremiumVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPremiumVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPremiumVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPremiumVotesResponse.Merge(m, src)
+}
+func (m *QueryPremiumVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPremiumVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPremiumVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPremiumVotesResponse proto.InternalMessageInfo
+
+func (m *QueryPremiumVotesResponse) GetPremiumVotes() PremiumStore {
+	if m != nil {
+		return m.PremiumVotes
+	}
+	return PremiumStore{}
+}
+
+// QueryPredictedFundingRatesRequest is request type for the
+// PredictedFundingRates RPC method.
+type QueryPredictedFundingRatesRequest struct {
+}
+
+func (m *QueryPredictedFundingRatesRequest) Reset()         { *m = QueryPredictedFundingRatesRequest{} }
+func (m *QueryPredictedFundingRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPredictedFundingRatesRequest) ProtoMessage()    {}
+func (*QueryPredictedFundingRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{8}
+}
+func (m *QueryPredictedFundingRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPredictedFundingRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPredictedFundingRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPredictedFundingRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPredictedFundingRatesRequest.Merge(m, src)
+}
+func (m *QueryPredictedFundingRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPredictedFundingRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPredictedFundingRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPredictedFundingRatesRequest proto.InternalMessageInfo
+
+// PredictedFundingRate is the funding rate of a perpetual predicted from the
+// premium samples collected so far during the current `funding-tick` epoch.
+// Samples which have not been collected yet are counted as zero.
+type PredictedFundingRate struct {
+	// The id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The premium component (in parts-per-million) of the funding rate, which
+	// includes the default funding of the perpetual.
+	PremiumPpm int32 `protobuf:"varint,2,opt,name=premium_ppm,json=premiumPpm,proto3" json:"premium_ppm,omitempty"`
+	// The interest rate component (in parts-per-million) of the funding rate.
+	InterestPpm int32 `protobuf:"varint,3,opt,name=interest_ppm,json=interestPpm,proto3" json:"interest_ppm,omitempty"`
+	// The predicted funding rate (in parts-per-million), which is the sum of
+	// `premium_ppm` and `interest_ppm` clamped to the bounds of the perpetual.
+	FundingRatePpm int32 `protobuf:"varint,4,opt,name=funding_rate_ppm,json=fundingRatePpm,proto3" json:"funding_rate_ppm,omitempty"`
+}
+
+func (m *PredictedFundingRate) Reset()         { *m = PredictedFundingRate{} }
+func (m *PredictedFundingRate) String() string { return proto.CompactTextString(m) }
+func (*PredictedFundingRate) ProtoMessage()    {}
+func (*PredictedFundingRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{9}
+}
+func (m *PredictedFundingRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PredictedFundingRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PredictedFundingRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PredictedFundingRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictedFundingRate.Merge(m, src)
+}
+func (m *PredictedFundingRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PredictedFundingRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictedFundingRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictedFundingRate proto.InternalMessageInfo
+
+func (m *PredictedFundingRate) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *PredictedFundingRate) GetPremiumPpm() int32 {
+	if m != nil {
+		return m.PremiumPpm
+	}
+	return 0
+}
+
+func (m *PredictedFundingRate) GetInterestPpm() int32 {
+	if m != nil {
+		return m.InterestPpm
+	}
+	return 0
+}
+
+func (m *PredictedFundingRate) GetFundingRatePpm() int32 {
+	if m != nil {
+		return m.FundingRatePpm
+	}
+	return 0
+}
+
+// QueryPredictedFundingRatesResponse is response type for the
+// PredictedFundingRates RPC method.
+type QueryPredictedFundingRatesResponse struct {
+	PredictedFundingRates []PredictedFundingRate `protobuf:"bytes,1,rep,name=predicted_funding_rates,json=predictedFundingRates,proto3" json:"predicted_funding_rates"`
+}
+
+func (m *QueryPredictedFundingRatesResponse) Reset()         { *m = QueryPredictedFundingRatesResponse{} }
+func (m *QueryPredictedFundingRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPredictedFundingRatesResponse) ProtoMessage()    {}
+func (*QueryPredictedFundingRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{10}
+}
+func (m *QueryPredictedFundingRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPredictedFundingRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPredictedFundingRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPredictedFundingRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPredictedFundingRatesResponse.Merge(m, src)
+}
+func (m *QueryPredictedFundingRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPredictedFundingRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPredictedFundingRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPredictedFundingRatesResponse proto.InternalMessageInfo
+
+func (m *QueryPredictedFundingRatesResponse) GetPredictedFundingRates() []PredictedFundingRate {
+	if m != nil {
+		return m.PredictedFundingRates
+	}
+	return nil
+}
+
+// QueryFundingIndexHistoryRequest is request type for the FundingIndexHistory
+// RPC method.
+type QueryFundingIndexHistoryRequest struct {
+	// The id of the perpetual.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The maximum number of the most recent snapshots to return. All stored
+	// snapshots are returned if zero.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryFundingIndexHistoryRequest) Reset()         { *m = QueryFundingIndexHistoryRequest{} }
+func (m *QueryFundingIndexHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingIndexHistoryRequest) ProtoMessage()    {}
+func (*QueryFundingIndexHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{11}
+}
+func (m *QueryFundingIndexHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingIndexHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingIndexHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingIndexHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingIndexHistoryRequest.Merge(m, src)
+}
+func (m *QueryFundingIndexHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingIndexHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingIndexHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingIndexHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFundingIndexHistoryRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryFundingIndexHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryFundingIndexHistoryResponse is response type for the
+// FundingIndexHistory RPC method.
+type QueryFundingIndexHistoryResponse struct {
+	// Funding index snapshots, sorted from the oldest to the newest snapshot.
+	Snapshots []FundingIndexSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+}
+
+func (m *QueryFundingIndexHistoryResponse) Reset()         { *m = QueryFundingIndexHistoryResponse{} }
+func (m *QueryFundingIndexHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingIndexHistoryResponse) ProtoMessage()    {}
+func (*QueryFundingIndexHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{12}
+}
+func (m *QueryFundingIndexHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingIndexHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingIndexHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingIndexHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingIndexHistoryResponse.Merge(m, src)
+}
+func (m *QueryFundingIndexHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingIndexHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingIndexHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingIndexHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFundingIndexHistoryResponse) GetSnapshots() []FundingIndexSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPerpetualRequest)(nil), "dydxprotocol.perpetuals.QueryPerpetualRequest")
 	proto.RegisterType((*QueryPerpetualResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualResponse")
 	proto.RegisterType((*QueryAllPerpetualsRequest)(nil), "dydxprotocol.perpetuals.QueryAllPerpetualsRequest")
 	proto.RegisterType((*QueryAllPerpetualsResponse)(nil), "dydxprotocol.perpetuals.QueryAllPerpetualsResponse")
+	proto.RegisterType((*QueryPremiumSamplesRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesRequest")
+	proto.RegisterType((*QueryPremiumSamplesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesResponse")
+	proto.RegisterType((*QueryPremiumVotesRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumVotesRequest")
+	proto.RegisterType((*QueryPremiumVotesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumVotesResponse")
+	proto.RegisterType((*QueryPredictedFundingRatesRequest)(nil), "dydxprotocol.perpetuals.QueryPredictedFundingRatesRequest")
+	proto.RegisterType((*PredictedFundingRate)(nil), "dydxprotocol.perpetuals.PredictedFundingRate")
+	proto.RegisterType((*QueryPredictedFundingRatesResponse)(nil), "dydxprotocol.perpetuals.QueryPredictedFundingRatesResponse")
+	proto.RegisterType((*QueryFundingIndexHistoryRequest)(nil), "dydxprotocol.perpetuals.QueryFundingIndexHistoryRequest")
+	proto.RegisterType((*QueryFundingIndexHistoryResponse)(nil), "dydxprotocol.perpetuals.QueryFundingIndexHistoryResponse")
 }
 
 func init() {
@@ -230,34 +670,59 @@ func init() {
 }
 
 var fileDescriptor_13b6d29860ccef6b = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xf0, 0x47, 0xaa, 0x51, 0x19, 0x2c, 0xfe, 0x46, 0x28, 0xa0, 0x80, 0x08, 0x54,
-	0xc2, 0x56, 0x5b, 0x46, 0x16, 0x3a, 0x94, 0xb5, 0x64, 0x60, 0x60, 0xc2, 0x49, 0xac, 0xd4, 0x52,
-	0x1a, 0xa7, 0xb5, 0x53, 0xb5, 0x42, 0x2c, 0x7c, 0x02, 0x24, 0x66, 0x36, 0x56, 0xbe, 0x47, 0x27,
-	0x54, 0x89, 0x85, 0x09, 0xa1, 0xf6, 0x3e, 0xc8, 0xa9, 0x4e, 0x9a, 0xa6, 0xa7, 0xf4, 0x7a, 0x77,
-	0x9b, 0xe5, 0xf7, 0x79, 0xde, 0xe7, 0xf7, 0xbe, 0x36, 0x7c, 0x1a, 0xce, 0xc3, 0x59, 0x3a, 0x11,
-	0x4a, 0x04, 0x22, 0x26, 0x29, 0x9b, 0xa4, 0x4c, 0x65, 0x34, 0x96, 0x64, 0x9c, 0xb1, 0xc9, 0x1c,
-	0xeb, 0x0a, 0xba, 0x5f, 0x15, 0xe1, 0x9d, 0xc8, 0xba, 0x13, 0x89, 0x48, 0xe8, 0x02, 0xd9, 0x9c,
-	0x72, 0xb9, 0xf5, 0x28, 0x12, 0x22, 0x8a, 0x19, 0xa1, 0x29, 0x27, 0x34, 0x49, 0x84, 0xa2, 0x8a,
-	0x8b, 0x44, 0x16, 0xd5, 0x56, 0x20, 0xe4, 0x48, 0x48, 0xe2, 0x53, 0xc9, 0xf2, 0x14, 0x32, 0x6d,
-	0xfb, 0x4c, 0xd1, 0x36, 0x49, 0x69, 0xc4, 0x13, 0x2d, 0x2e, 0xb4, 0xee, 0x21, 0xba, 0xf2, 0x98,
-	0x0b, 0x1d, 0x17, 0xde, 0x7d, 0xbf, 0x69, 0x35, 0xd8, 0xde, 0x7b, 0x6c, 0x9c, 0x31, 0xa9, 0xd0,
-	0x6d, 0x68, 0xf2, 0xf0, 0x01, 0x78, 0x02, 0x5e, 0x34, 0x3d, 0x93, 0x87, 0xce, 0x27, 0x78, 0xef,
-	0xac, 0x50, 0xa6, 0x22, 0x91, 0x0c, 0xf5, 0x61, 0xa3, 0xec, 0xaa, 0x0d, 0xb7, 0x3a, 0x0e, 0x3e,
-	0x30, 0x38, 0x2e, 0xed, 0xbd, 0xeb, 0x8b, 0x7f, 0x8f, 0x0d, 0x6f, 0x67, 0x75, 0x02, 0xf8, 0x50,
-	0x27, 0xbc, 0x8d, 0xe3, 0x52, 0x25, 0xb7, 0x38, 0x7d, 0x08, 0x77, 0x43, 0x16, 0x29, 0xcf, 0x71,
-	0xbe, 0x11, 0xbc, 0xd9, 0x08, 0xce, 0xf7, 0x5e, 0x6c, 0x04, 0x0f, 0x68, 0xc4, 0x0a, 0xaf, 0x57,
-	0x71, 0x3a, 0xbf, 0x00, 0xb4, 0xea, 0x52, 0xea, 0x67, 0xb9, 0x76, 0xc5, 0x59, 0xd0, 0xbb, 0x3d,
-	0x5c, 0x53, 0xe3, 0xba, 0x47, 0x71, 0x73, 0x88, 0x2a, 0x6f, 0xe7, 0xb7, 0x09, 0x6f, 0x68, 0x5e,
-	0xf4, 0x03, 0xc0, 0x46, 0x99, 0x88, 0xf0, 0x41, 0xaa, 0xda, 0xe7, 0xb4, 0xc8, 0x85, 0xf5, 0x39,
-	0x84, 0x43, 0xbe, 0xfe, 0x39, 0xf9, 0x6e, 0xbe, 0x44, 0x2e, 0x39, 0xfa, 0x95, 0xc8, 0x67, 0x1e,
-	0x7e, 0x41, 0x3f, 0x01, 0x6c, 0xee, 0x2d, 0x15, 0x75, 0xce, 0xcf, 0xac, 0x7b, 0x67, 0xab, 0x7b,
-	0x29, 0x4f, 0xc1, 0xda, 0xd2, 0xac, 0xcf, 0x90, 0x73, 0x9c, 0xb5, 0xf7, 0x61, 0xb1, 0xb2, 0xc1,
-	0x72, 0x65, 0x83, 0xff, 0x2b, 0x1b, 0x7c, 0x5b, 0xdb, 0xc6, 0x72, 0x6d, 0x1b, 0x7f, 0xd7, 0xb6,
-	0xf1, 0xf1, 0x4d, 0xc4, 0xd5, 0x30, 0xf3, 0x71, 0x20, 0x46, 0xfb, 0x7d, 0xa6, 0xaf, 0x5f, 0x05,
-	0x43, 0xca, 0x13, 0x52, 0xde, 0xcc, 0xaa, 0xbd, 0xd5, 0x3c, 0x65, 0xd2, 0xbf, 0xa9, 0x8b, 0xdd,
-	0xd3, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x2a, 0x78, 0x13, 0x18, 0x04, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x4f, 0x3b, 0x45,
+	0x14, 0xee, 0xf6, 0x07, 0x46, 0x5e, 0x69, 0x35, 0x23, 0x08, 0xac, 0xa4, 0xc0, 0xa2, 0xb4, 0x92,
+	0xb0, 0x0b, 0x05, 0x13, 0x14, 0x2f, 0x72, 0x00, 0xb9, 0x95, 0x62, 0x38, 0x78, 0xa9, 0xdb, 0xee,
+	0xb0, 0x9d, 0xd8, 0xdd, 0x19, 0x76, 0xa6, 0x84, 0xc6, 0x78, 0xf1, 0x2f, 0xd0, 0x78, 0xf6, 0xa6,
+	0xf1, 0xc4, 0x9f, 0xe0, 0x59, 0xe2, 0x89, 0xc4, 0x8b, 0x27, 0x63, 0xc0, 0x3f, 0xc4, 0x74, 0x76,
+	0x76, 0xbb, 0xf5, 0xb7, 0xdb, 0x42, 0x6f, 0xe5, 0xbd, 0xef, 0xbd, 0xef, 0xfb, 0x1e, 0x3b, 0x1f,
+	0x6c, 0x3a, 0x7d, 0xe7, 0x96, 0x05, 0x54, 0xd0, 0x36, 0xed, 0x5a, 0x0c, 0x07, 0x0c, 0x8b, 0x9e,
+	0xdd, 0xe5, 0xd6, 0x75, 0x0f, 0x07, 0x7d, 0x53, 0x76, 0xd0, 0x52, 0x12, 0x64, 0x0e, 0x41, 0xfa,
+	0x82, 0x4b, 0x5d, 0x2a, 0x1b, 0xd6, 0xe0, 0x57, 0x08, 0xd7, 0x57, 0x5d, 0x4a, 0xdd, 0x2e, 0xb6,
+	0x6c, 0x46, 0x2c, 0xdb, 0xf7, 0xa9, 0xb0, 0x05, 0xa1, 0x3e, 0x57, 0xdd, 0xed, 0x36, 0xe5, 0x1e,
+	0xe5, 0x56, 0xcb, 0xe6, 0x38, 0x64, 0xb1, 0x6e, 0xf6, 0x5a, 0x58, 0xd8, 0x7b, 0x16, 0xb3, 0x5d,
+	0xe2, 0x4b, 0xb0, 0xc2, 0x56, 0xb2, 0xd4, 0xc5, 0x3f, 0x43, 0xa0, 0x51, 0x81, 0xc5, 0xf3, 0xc1,
+	0xaa, 0x7a, 0x54, 0x6f, 0xe0, 0xeb, 0x1e, 0xe6, 0x02, 0x95, 0x20, 0x4f, 0x9c, 0x65, 0x6d, 0x5d,
+	0xab, 0x16, 0x1b, 0x79, 0xe2, 0x18, 0x5f, 0xc1, 0xbb, 0xff, 0x07, 0x72, 0x46, 0x7d, 0x8e, 0xd1,
+	0x09, 0xcc, 0xc5, 0x5b, 0xe5, 0x40, 0xa1, 0x66, 0x98, 0x19, 0xc6, 0xcd, 0x78, 0xfc, 0x78, 0xe6,
+	0xfe, 0xef, 0xb5, 0x5c, 0x63, 0x38, 0x6a, 0xb4, 0x61, 0x45, 0x32, 0x7c, 0xd6, 0xed, 0xc6, 0x28,
+	0x1e, 0xc9, 0x39, 0x01, 0x18, 0x9a, 0x54, 0x2c, 0x5b, 0x66, 0x78, 0x11, 0x73, 0x70, 0x11, 0x33,
+	0xbc, 0xbb, 0xba, 0x88, 0x59, 0xb7, 0x5d, 0xac, 0x66, 0x1b, 0x89, 0x49, 0xe3, 0x4e, 0x03, 0x3d,
+	0x8d, 0x25, 0xdd, 0xcb, 0xab, 0x29, 0xbd, 0xa0, 0xd3, 0x11, 0xb9, 0x79, 0x29, 0xb7, 0x32, 0x51,
+	0x6e, 0x28, 0x62, 0x44, 0xef, 0xaa, 0x92, 0x5b, 0x0f, 0xb0, 0x47, 0x7a, 0xde, 0x85, 0xed, 0xb1,
+	0x2e, 0x8e, 0xae, 0x62, 0x70, 0x78, 0x2f, 0xb5, 0xab, 0xdc, 0x7c, 0x01, 0x6f, 0xb1, 0xb0, 0xd3,
+	0xe4, 0x61, 0x4b, 0x5d, 0xee, 0x83, 0x6c, 0x4f, 0x6a, 0x93, 0xa0, 0x01, 0x56, 0xb6, 0x4a, 0x6c,
+	0x64, 0xbb, 0xa1, 0xc3, 0x72, 0x92, 0xf4, 0x92, 0x8a, 0xa1, 0x20, 0x0f, 0x56, 0x52, 0x7a, 0x4a,
+	0x4e, 0x1d, 0x8a, 0x91, 0x9c, 0x1b, 0x2a, 0xa6, 0x13, 0x33, 0xcf, 0x12, 0x9b, 0x8d, 0x4d, 0xd8,
+	0x88, 0xe8, 0x1c, 0xd2, 0x16, 0xd8, 0x39, 0xe9, 0xf9, 0x0e, 0xf1, 0xdd, 0x86, 0x9d, 0xd0, 0xf4,
+	0xab, 0x06, 0x0b, 0x69, 0x00, 0xb4, 0x01, 0xf3, 0x31, 0x59, 0x33, 0xfe, 0xd8, 0x0b, 0x71, 0xed,
+	0xcc, 0x41, 0x6b, 0x50, 0x88, 0x24, 0x33, 0xe6, 0xc9, 0x7f, 0xe4, 0x6c, 0x03, 0x54, 0xa9, 0xce,
+	0xbc, 0xc1, 0x0e, 0xe2, 0x0b, 0x1c, 0x60, 0x2e, 0x24, 0xe2, 0x95, 0x44, 0x14, 0xa2, 0xda, 0x00,
+	0x52, 0x85, 0xb7, 0xaf, 0x42, 0xd6, 0x66, 0x60, 0x0b, 0x2c, 0x61, 0x33, 0x12, 0x56, 0xba, 0x1a,
+	0xaa, 0xa9, 0x33, 0xcf, 0xf8, 0x41, 0x03, 0x63, 0x9c, 0x1f, 0x75, 0xc7, 0xaf, 0x61, 0x89, 0x45,
+	0x80, 0x66, 0x72, 0x35, 0x57, 0x9f, 0xec, 0xce, 0xb8, 0x8b, 0xbe, 0xb6, 0x58, 0x5d, 0x76, 0x91,
+	0xa5, 0x91, 0x1a, 0xa7, 0xb0, 0x26, 0x25, 0xa9, 0xe2, 0x99, 0xef, 0xe0, 0xdb, 0xcf, 0x09, 0x17,
+	0x34, 0xe8, 0x67, 0x44, 0x05, 0x5a, 0x80, 0xd9, 0x2e, 0xf1, 0x88, 0x90, 0xe7, 0x2a, 0x36, 0xc2,
+	0x3f, 0x8c, 0x1e, 0xac, 0x67, 0x2f, 0x52, 0xce, 0xce, 0x61, 0x8e, 0xfb, 0x36, 0xe3, 0x1d, 0x2a,
+	0x26, 0x7b, 0x49, 0x2e, 0xba, 0x50, 0x53, 0xd1, 0x4b, 0x8c, 0xb7, 0xd4, 0x7e, 0x7b, 0x13, 0x66,
+	0x25, 0x2f, 0xfa, 0x49, 0x83, 0xb9, 0xf8, 0xc9, 0x22, 0x33, 0x73, 0x6f, 0x6a, 0x1e, 0xea, 0xd6,
+	0xb3, 0xf1, 0xa1, 0x17, 0xc3, 0xfa, 0xee, 0xcf, 0x7f, 0x7f, 0xcc, 0x7f, 0x88, 0x2a, 0xd6, 0xc4,
+	0x2c, 0xb6, 0xbe, 0x21, 0xce, 0xb7, 0xe8, 0x67, 0x0d, 0x8a, 0x23, 0xa9, 0x84, 0x6a, 0xe3, 0x39,
+	0xd3, 0x82, 0x52, 0xdf, 0x7f, 0xd1, 0x8c, 0xd2, 0xba, 0x2d, 0xb5, 0xbe, 0x8f, 0x8c, 0xc9, 0x5a,
+	0xd1, 0x9d, 0x06, 0xa5, 0xd1, 0xbc, 0x41, 0x13, 0x38, 0x53, 0xb3, 0x4b, 0x3f, 0x78, 0xd9, 0x90,
+	0x52, 0xba, 0x2b, 0x95, 0x6e, 0xa3, 0x6a, 0xb6, 0xd2, 0xd1, 0xc4, 0x43, 0xbf, 0x68, 0x30, 0x9f,
+	0x8c, 0x23, 0xb4, 0xf7, 0x2c, 0xe2, 0x64, 0xac, 0xe9, 0xb5, 0x97, 0x8c, 0x28, 0xa5, 0xa6, 0x54,
+	0x5a, 0x45, 0x5b, 0x13, 0x95, 0xca, 0x30, 0x44, 0x7f, 0x68, 0xb0, 0x98, 0xfa, 0xee, 0xd1, 0x27,
+	0x13, 0xd9, 0x33, 0xc3, 0x4f, 0x3f, 0x9a, 0x6a, 0x56, 0x59, 0x38, 0x94, 0x16, 0x6a, 0x68, 0x77,
+	0x9c, 0x85, 0xb4, 0x1c, 0x42, 0xbf, 0x6b, 0xf0, 0x4e, 0xca, 0x43, 0x47, 0x87, 0xe3, 0xe5, 0x64,
+	0x87, 0x8c, 0xfe, 0xf1, 0x14, 0x93, 0xca, 0xc6, 0x91, 0xb4, 0xf1, 0x11, 0xda, 0xcf, 0xb4, 0x11,
+	0x89, 0x27, 0x83, 0xf1, 0x66, 0x27, 0x9c, 0x97, 0xaf, 0xf2, 0xf8, 0xf2, 0xfe, 0xb1, 0xac, 0x3d,
+	0x3c, 0x96, 0xb5, 0x7f, 0x1e, 0xcb, 0xda, 0xf7, 0x4f, 0xe5, 0xdc, 0xc3, 0x53, 0x39, 0xf7, 0xd7,
+	0x53, 0x39, 0xf7, 0xe5, 0xa7, 0x2e, 0x11, 0x9d, 0x5e, 0xcb, 0x6c, 0x53, 0x6f, 0x74, 0xf1, 0xcd,
+	0xc1, 0x4e, 0xbb, 0x63, 0x13, 0xdf, 0x8a, 0x2b, 0xb7, 0x49, 0x32, 0xd1, 0x67, 0x98, 0xb7, 0xde,
+	0x90, 0xcd, 0xfd, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x69, 0xc5, 0xd8, 0x58, 0x48, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -276,6 +741,17 @@ type QueryClient interface {
 	Perpetual(ctx context.Context, in *QueryPerpetualRequest, opts ...grpc.CallOption) (*QueryPerpetualResponse, error)
 	// Queries a list of Perpetual items.
 	AllPerpetuals(ctx context.Context, in *QueryAllPerpetualsRequest, opts ...grpc.CallOption) (*QueryAllPerpetualsResponse, error)
+	// Queries the premium samples collected during the current `funding-tick`
+	// epoch.
+	PremiumSamples(ctx context.Context, in *QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*QueryPremiumSamplesResponse, error)
+	// Queries the premium votes collected during the current `funding-sample`
+	// epoch.
+	PremiumVotes(ctx context.Context, in *QueryPremiumVotesRequest, opts ...grpc.CallOption) (*QueryPremiumVotesResponse, error)
+	// Queries the predicted funding rates of all perpetuals for the current
+	// `funding-tick` epoch.
+	PredictedFundingRates(ctx context.Context, in *QueryPredictedFundingRatesRequest, opts ...grpc.CallOption) (*QueryPredictedFundingRatesResponse, error)
+	// Queries the most recent funding index snapshots of a perpetual.
+	FundingIndexHistory(ctx context.Context, in *QueryFundingIndexHistoryRequest, opts ...grpc.CallOption) (*QueryFundingIndexHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -304,24 +780,83 @@ func (c *queryClient) AllPerpetuals(ctx context.Context, in *QueryAllPerpetualsR
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a Perpetual by id.
-	Perpetual(context.Context, *QueryPerpetualRequest) (*QueryPerpetualResponse, error)
-	// Queries a list of Perpetual items.
-	AllPerpetuals(context.Context, *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error)
+func (c *queryClient) PremiumSamples(ctx context.Context, in *QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*QueryPremiumSamplesResponse, error) {
+	out := new(QueryPremiumSamplesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PremiumSamples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (c *queryClient) PremiumVotes(ctx context.Context, in *QueryPremiumVotesRequest, opts ...grpc.CallOption) (*QueryPremiumVotesResponse, error) {
+	out := new(QueryPremiumVotesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PremiumVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedQueryServer) Perpetual(ctx context.Context, req *QueryPerpetualRequest) (*QueryPerpetualResponse, error) {
+func (c *queryClient) PredictedFundingRates(ctx context.Context, in *QueryPredictedFundingRatesRequest, opts ...grpc.CallOption) (*QueryPredictedFundingRatesResponse, error) {
+	out := new(QueryPredictedFundingRatesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PredictedFundingRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingIndexHistory(ctx context.Context, in *QueryFundingIndexHistoryRequest, opts ...grpc.CallOption) (*QueryFundingIndexHistoryResponse, error) {
+	out := new(QueryFundingIndexHistoryResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/FundingIndexHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a Perpetual by id.
+	Perpetual(context.Context, *QueryPerpetualRequest) (*QueryPerpetualResponse, error)
+	// Queries a list of Perpetual items.
+	AllPerpetuals(context.Context, *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error)
+	// Queries the premium samples collected during the current `funding-tick`
+	// epoch.
+	PremiumSamples(context.Context, *QueryPremiumSamplesRequest) (*QueryPremiumSamplesResponse, error)
+	// Queries the premium votes collected during the current `funding-sample`
+	// epoch.
+	PremiumVotes(context.Context, *QueryPremiumVotesRequest) (*QueryPremiumVotesResponse, error)
+	// Queries the predicted funding rates of all perpetuals for the current
+	// `funding-tick` epoch.
+	PredictedFundingRates(context.Context, *QueryPredictedFundingRatesRequest) (*QueryPredictedFundingRatesResponse, error)
+	// Queries the most recent funding index snapshots of a perpetual.
+	FundingIndexHistory(context.Context, *QueryFundingIndexHistoryRequest) (*QueryFundingIndexHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Perpetual(ctx context.Context, req *QueryPerpetualRequest) (*QueryPerpetualResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Perpetual not implemented")
 }
 func (*UnimplementedQueryServer) AllPerpetuals(ctx context.Context, req *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllPerpetuals not implemented")
 }
+func (*UnimplementedQueryServer) PremiumSamples(ctx context.Context, req *QueryPremiumSamplesRequest) (*QueryPremiumSamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PremiumSamples not implemented")
+}
+func (*UnimplementedQueryServer) PremiumVotes(ctx context.Context, req *QueryPremiumVotesRequest) (*QueryPremiumVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PremiumVotes not implemented")
+}
+func (*UnimplementedQueryServer) PredictedFundingRates(ctx context.Context, req *QueryPredictedFundingRatesRequest) (*QueryPredictedFundingRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PredictedFundingRates not implemented")
+}
+func (*UnimplementedQueryServer) FundingIndexHistory(ctx context.Context, req *QueryFundingIndexHistoryRequest) (*QueryFundingIndexHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingIndexHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -363,6 +898,78 @@ func _Query_AllPerpetuals_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PremiumSamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPremiumSamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PremiumSamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PremiumSamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PremiumSamples(ctx, req.(*QueryPremiumSamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PremiumVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPremiumVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PremiumVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PremiumVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PremiumVotes(ctx, req.(*QueryPremiumVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PredictedFundingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPredictedFundingRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PredictedFundingRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PredictedFundingRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PredictedFundingRates(ctx, req.(*QueryPredictedFundingRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingIndexHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingIndexHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingIndexHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/FundingIndexHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingIndexHistory(ctx, req.(*QueryFundingIndexHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.perpetuals.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -375,6 +982,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllPerpetuals",
 			Handler:    _Query_AllPerpetuals_Handler,
 		},
+		{
+			MethodName: "PremiumSamples",
+			Handler:    _Query_PremiumSamples_Handler,
+		},
+		{
+			MethodName: "PremiumVotes",
+			Handler:    _Query_PremiumVotes_Handler,
+		},
+		{
+			MethodName: "PredictedFundingRates",
+			Handler:    _Query_PredictedFundingRates_Handler,
+		},
+		{
+			MethodName: "FundingIndexHistory",
+			Handler:    _Query_FundingIndexHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/perpetuals/query.proto",
@@ -525,79 +1148,1020 @@ func (m *QueryAllPerpetualsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPremiumSamplesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPerpetualRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryPremiumSamplesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumSamplesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPremiumSamplesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumSamplesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumSamplesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	{
+		size, err := m.PremiumSamples.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPerpetualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPremiumVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Perpetual.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPerpetualsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPremiumVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PremiumVotes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPerpetualsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPredictedFundingRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPredictedFundingRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPredictedFundingRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Perpetual) > 0 {
-		for _, e := range m.Perpetual {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PredictedFundingRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PredictedFundingRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PredictedFundingRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FundingRatePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FundingRatePpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InterestPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InterestPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PremiumPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PremiumPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPredictedFundingRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPredictedFundingRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPredictedFundingRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PredictedFundingRates) > 0 {
+		for iNdEx := len(m.PredictedFundingRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PredictedFundingRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingIndexHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingIndexHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingIndexHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingIndexHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingIndexHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingIndexHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPerpetualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPerpetualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Perpetual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPerpetualsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPerpetualsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Perpetual) > 0 {
+		for _, e := range m.Perpetual {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPremiumSamplesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPremiumSamplesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PremiumSamples.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPremiumVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPremiumVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PremiumVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPredictedFundingRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PredictedFundingRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.PremiumPpm != 0 {
+		n += 1 + sovQuery(uint64(m.PremiumPpm))
+	}
+	if m.InterestPpm != 0 {
+		n += 1 + sovQuery(uint64(m.InterestPpm))
+	}
+	if m.FundingRatePpm != 0 {
+		n += 1 + sovQuery(uint64(m.FundingRatePpm))
+	}
+	return n
+}
+
+func (m *QueryPredictedFundingRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PredictedFundingRates) > 0 {
+		for _, e := range m.PredictedFundingRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFundingIndexHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryFundingIndexHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Perpetual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPerpetualsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPerpetualsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPerpetualsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPerpetualsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Perpetual = append(m.Perpetual, Perpetual{})
+			if err := m.Perpetual[len(m.Perpetual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumSamplesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumSamplesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumSamplesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumSamplesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumSamplesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumSamples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumSamples.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -620,17 +2184,17 @@ func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPerpetualRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPremiumVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPerpetualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPremiumVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumVotes", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -640,11 +2204,25 @@ func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -666,7 +2244,7 @@ func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPredictedFundingRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -689,17 +2267,67 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPerpetualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPredictedFundingRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPredictedFundingRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PredictedFundingRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PredictedFundingRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PredictedFundingRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -709,25 +2337,68 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumPpm", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.PremiumPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PremiumPpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestPpm", wireType)
 			}
-			if err := m.Perpetual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InterestPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestPpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRatePpm", wireType)
+			}
+			m.FundingRatePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRatePpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -749,7 +2420,7 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPredictedFundingRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -772,15 +2443,15 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPerpetualsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPredictedFundingRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPerpetualsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPredictedFundingRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedFundingRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -807,10 +2478,8 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PredictedFundingRates = append(m.PredictedFundingRates, PredictedFundingRate{})
+			if err := m.PredictedFundingRates[len(m.PredictedFundingRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -835,7 +2504,7 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFundingIndexHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -858,17 +2527,17 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPerpetualsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundingIndexHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPerpetualsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundingIndexHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -878,29 +2547,83 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Perpetual = append(m.Perpetual, Perpetual{})
-			if err := m.Perpetual[len(m.Perpetual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingIndexHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingIndexHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingIndexHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -927,10 +2650,8 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, FundingIndexSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -76,6 +76,9 @@ type PerpetualsKeeper interface {
 		atomicResolution int32,
 		defaultFundingPpm int32,
 		liquidityTier uint32,
+		fundingRateBounds *FundingRateBounds,
+		interestRatePpm int32,
+		insuranceFundScope InsuranceFundScope,
 	) (Perpetual, error)
 	ModifyPerpetual(
 		ctx sdk.Context,
@@ -84,6 +87,9 @@ type PerpetualsKeeper interface {
 		marketId uint32,
 		defaultFundingPpm int32,
 		liquidityTier uint32,
+		fundingRateBounds *FundingRateBounds,
+		interestRatePpm int32,
+		insuranceFundScope InsuranceFundScope,
 	) (Perpetual, error)
 	SetLiquidityTier(
 		ctx sdk.Context,
@@ -101,4 +107,8 @@ type PerpetualsKeeper interface {
 		ctx sdk.Context,
 		params Params,
 	) error
+	ValidateParamsWithEpochs(
+		ctx sdk.Context,
+		params Params,
+	) error
 }
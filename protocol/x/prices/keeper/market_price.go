@@ -110,6 +110,27 @@ func (k Keeper) UpdateMarketPrices(
 	return nil
 }
 
+// SetSimulatedMarketPrices overwrites the prices of markets without validating the updates, reporting
+// metrics or emitting indexer events. It is intended for simulating hypothetical prices and must only
+// be called on a branched context whose writes are discarded.
+func (k Keeper) SetSimulatedMarketPrices(
+	ctx sdk.Context,
+	updates []*types.MsgUpdateMarketPrices_MarketPrice,
+) error {
+	marketPriceStore := k.getMarketPriceStore(ctx)
+	for _, update := range updates {
+		marketPrice, err := k.GetMarketPrice(ctx, update.MarketId)
+		if err != nil {
+			return err
+		}
+
+		marketPrice.Price = update.Price
+		b := k.cdc.MustMarshal(&marketPrice)
+		marketPriceStore.Set(lib.Uint32ToKey(marketPrice.Id), b)
+	}
+	return nil
+}
+
 // GetMarketPrice returns a market price from its id.
 func (k Keeper) GetMarketPrice(
 	ctx sdk.Context,
@@ -53,6 +53,21 @@ func TestUpdateMarketParam(t *testing.T) {
 				},
 			},
 		},
+		"Succeeds: update smoothing params only": {
+			msg: &pricestypes.MsgUpdateMarketParam{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketParam: pricestypes.MarketParam{
+					Id:                         testMarketParam.Id,
+					Pair:                       testMarketParam.Pair,
+					Exponent:                   testMarketParam.Exponent,
+					MinExchanges:               testMarketParam.MinExchanges,
+					MinPriceChangePpm:          testMarketParam.MinPriceChangePpm,
+					ExchangeConfigJson:         testMarketParam.ExchangeConfigJson,
+					SmoothedPriceHistoryLength: 10,
+					PriceSmoothingPpm:          500_000,
+				},
+			},
+		},
 		"Failure: update to an empty pair": {
 			msg: &pricestypes.MsgUpdateMarketParam{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -109,6 +124,21 @@ func TestUpdateMarketParam(t *testing.T) {
 			},
 			expectedErr: "Invalid input",
 		},
+		"Failure: update to smoothed price history length above max": {
+			msg: &pricestypes.MsgUpdateMarketParam{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketParam: pricestypes.MarketParam{
+					Id:                         testMarketParam.Id,
+					Pair:                       testMarketParam.Pair,
+					Exponent:                   testMarketParam.Exponent,
+					MinExchanges:               testMarketParam.MinExchanges,
+					MinPriceChangePpm:          testMarketParam.MinPriceChangePpm,
+					ExchangeConfigJson:         testMarketParam.ExchangeConfigJson,
+					SmoothedPriceHistoryLength: pricestypes.MaxSmoothedPriceHistoryLength + 1, // invalid
+				},
+			},
+			expectedErr: "Smoothed price history length must be less than or equal to",
+		},
 		"Failure: update market exponent": {
 			msg: &pricestypes.MsgUpdateMarketParam{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -5,12 +5,12 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
 // UpdateSmoothedPrices updates the internal map of smoothed prices for all markets.
 // The smoothing is calculated with Basic Exponential Smoothing, see
 // https://en.wikipedia.org/wiki/Exponential_smoothing
+// The smoothing factor of each market is configured by its `MarketParam`, see `PriceSmoothingPpmOrDefault`.
 // If there is no valid index price for a market at this time, the smoothed price does not change.
 func (k Keeper) UpdateSmoothedPrices(
 	ctx sdk.Context,
@@ -37,7 +37,7 @@ func (k Keeper) UpdateSmoothedPrices(
 		update, err := linearInterpolateFunc(
 			smoothedPrice,
 			indexPrice,
-			types.PriceSmoothingPpm,
+			marketParam.PriceSmoothingPpmOrDefault(),
 		)
 		if err != nil {
 			updateErrors = append(
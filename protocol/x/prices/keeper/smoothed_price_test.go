@@ -33,6 +33,7 @@ func TestUpdateSmoothedPrices(t *testing.T) {
 	tests := map[string]struct {
 		smoothedPrices        map[uint32]uint64
 		indexPrices           []*api.MarketPriceUpdate
+		priceSmoothingPpms    map[uint32]uint32
 		expectedResult        map[uint32]uint64
 		linearInterpolateFunc func(v0 uint64, v1 uint64, ppm uint32) (uint64, error)
 		expectedErr           string
@@ -72,6 +73,20 @@ func TestUpdateSmoothedPrices(t *testing.T) {
 			expectedResult:        constants.AtTimeTSingleExchangeSmoothedPricesPlus7,
 			linearInterpolateFunc: lib.Uint64LinearInterpolate,
 		},
+		"All updated - markets use their configured price smoothing": {
+			indexPrices:    constants.AtTimeTSingleExchangePriceUpdate,
+			smoothedPrices: constants.AtTimeTSingleExchangeSmoothedPricesPlus10,
+			priceSmoothingPpms: map[uint32]uint32{
+				constants.MarketId1: 1_000_000, // 100%
+				constants.MarketId2: 500_000,   // 50%
+			},
+			expectedResult: map[uint32]uint64{
+				constants.MarketId0: constants.AtTimeTSingleExchangeSmoothedPricesPlus7[constants.MarketId0], // default
+				constants.MarketId1: constants.AtTimeTSingleExchangeSmoothedPrices[constants.MarketId1],
+				constants.MarketId2: constants.AtTimeTSingleExchangeSmoothedPrices[constants.MarketId2] + 5,
+			},
+			linearInterpolateFunc: lib.Uint64LinearInterpolate,
+		},
 		"Interpolation errors - returns error": {
 			indexPrices:           constants.AtTimeTSingleExchangePriceUpdate,
 			smoothedPrices:        constants.AtTimeTSingleExchangeSmoothedPricesPlus10,
@@ -100,6 +115,13 @@ func TestUpdateSmoothedPrices(t *testing.T) {
 			mockTimeProvider.On("Now").Return(constants.TimeT)
 
 			keepertest.CreateTestMarkets(t, ctx, k)
+			for market, priceSmoothingPpm := range tc.priceSmoothingPpms {
+				marketParam, exists := k.GetMarketParam(ctx, market)
+				require.True(t, exists)
+				marketParam.PriceSmoothingPpm = priceSmoothingPpm
+				_, err := k.ModifyMarketParam(ctx, marketParam)
+				require.NoError(t, err)
+			}
 			indexPriceCache.UpdatePrices(tc.indexPrices)
 			for market, smoothedPrice := range tc.smoothedPrices {
 				marketToSmoothedPrices.PushSmoothedPrice(market, smoothedPrice)
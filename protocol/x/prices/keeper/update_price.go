@@ -24,6 +24,8 @@ import (
 // 3) The proposed price is either the index price or the smoothed price, depending on which is closer to the
 // oracle price.
 // 4) The proposed price meets the minimum price change ppm requirement.
+// 5) The proposed price does not cross any of the market's historical smoothed prices, where the number of
+// historical smoothed prices considered is configured per market by its `MarketParam`.
 // Note: the list of market price updates can be empty if there are no "valid" index prices, smoothed prices, and/or
 // proposed prices for any market.
 func (k Keeper) GetValidMarketPriceUpdates(
@@ -81,7 +83,10 @@ func (k Keeper) GetValidMarketPriceUpdates(
 			continue
 		}
 
-		historicalSmoothedPrices := k.marketToSmoothedPrices.GetHistoricalSmoothedPrices(marketId)
+		historicalSmoothedPrices := k.marketToSmoothedPrices.GetHistoricalSmoothedPrices(
+			marketId,
+			marketParamPrice.Param.SmoothedPriceHistoryLengthOrDefault(),
+		)
 		// We generally expect to have a smoothed price history for each market, except during the first few blocks
 		// after network genesis or a network restart. In this scenario, we use the index price as the smoothed price.
 		if len(historicalSmoothedPrices) == 0 {
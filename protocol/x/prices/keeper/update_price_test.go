@@ -118,6 +118,8 @@ func TestGetValidMarketPriceUpdates(t *testing.T) {
 		// historicalSmoothedIndexPrice prices for each market are expected to be ordered from most recent to least
 		// recent.
 		historicalSmoothedIndexPrices map[uint32][]uint64
+		// smoothedPriceHistoryLengths overrides the `SmoothedPriceHistoryLength` of each market.
+		smoothedPriceHistoryLengths   map[uint32]uint32
 		skipCreateMarketsAndExchanges bool
 
 		// Expected.
@@ -168,6 +170,22 @@ func TestGetValidMarketPriceUpdates(t *testing.T) {
 			historicalSmoothedIndexPrices: invalidMarket0HistoricalSmoothedPricesCrossesOraclePrice,
 			expectedMsg:                   emptyResult,
 		},
+		"Single result: historical smoothed price crosses oracle price outside of market's smoothed price history": {
+			indexPrices:                   []*api.MarketPriceUpdate{validMarket0Update},
+			historicalSmoothedIndexPrices: invalidMarket0HistoricalSmoothedPricesCrossesOraclePrice,
+			smoothedPriceHistoryLengths: map[uint32]uint32{
+				constants.MarketId0: 1,
+			},
+			expectedMsg: validMarket0UpdateResult,
+		},
+		"Empty result: historical smoothed price crosses oracle price within market's smoothed price history": {
+			indexPrices:                   []*api.MarketPriceUpdate{validMarket0Update},
+			historicalSmoothedIndexPrices: invalidMarket0HistoricalSmoothedPricesCrossesOraclePrice,
+			smoothedPriceHistoryLengths: map[uint32]uint32{
+				constants.MarketId0: 2,
+			},
+			expectedMsg: emptyResult,
+		},
 		"Empty result: proposed price is smoothed price, meets min change but trends away from index price": {
 			indexPrices:                   []*api.MarketPriceUpdate{validMarket0Update},
 			historicalSmoothedIndexPrices: invalidMarket0SmoothedPriceTrendsAwayFromIndexPrice,
@@ -260,6 +278,13 @@ func TestGetValidMarketPriceUpdates(t *testing.T) {
 			if !tc.skipCreateMarketsAndExchanges {
 				keepertest.CreateTestMarkets(t, ctx, k)
 			}
+			for market, historyLength := range tc.smoothedPriceHistoryLengths {
+				marketParam, exists := k.GetMarketParam(ctx, market)
+				require.True(t, exists)
+				marketParam.SmoothedPriceHistoryLength = historyLength
+				_, err := k.ModifyMarketParam(ctx, marketParam)
+				require.NoError(t, err)
+			}
 			indexPriceCache.UpdatePrices(tc.indexPrices)
 
 			// Smoothed prices are listed in reverse chronological order for test case constant legibility.
@@ -277,7 +277,8 @@ func (k Keeper) validatePriceAccuracy(
 }
 
 // GetMarketsMissingFromPriceUpdates returns a list of market ids that should have been included but
-// not present in the `MsgUpdateMarketPrices`.
+// not present in the `MsgUpdateMarketPrices`. Whether a market should have been included is determined
+// by `GetValidMarketPriceUpdates`, using the smoothing settings configured by each market's `MarketParam`.
 //
 // Note: this is NOT determistic, because it relies on "index price" that is subject to each validator.
 func (k Keeper) GetMarketsMissingFromPriceUpdates(
@@ -35,7 +35,7 @@ const (
 	// This genesis state is formatted to export back to itself. It explicitly defines all fields using valid defaults.
 	validGenesisState = `{` +
 		`"market_params":[{"id":0,"pair":"DENT-USD","exponent":0,"min_exchanges":1,"min_price_change_ppm":1,` +
-		`"exchange_config_json":"{}"}],` +
+		`"exchange_config_json":"{}","smoothed_price_history_length":0,"price_smoothing_ppm":0}],` +
 		`"market_prices":[{"id":0,"exponent":0,"price":"1"}]` +
 		`}`
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/json"
 )
@@ -25,6 +26,23 @@ func (mp *MarketParam) Validate() error {
 			lib.MaxPriceChangePpm)
 	}
 
+	// Validate smoothing settings. Zero values indicate that the protocol defaults should be used.
+	if mp.SmoothedPriceHistoryLength > MaxSmoothedPriceHistoryLength {
+		return errorsmod.Wrapf(
+			ErrInvalidInput,
+			"Smoothed price history length must be less than or equal to %d",
+			MaxSmoothedPriceHistoryLength,
+		)
+	}
+
+	if mp.PriceSmoothingPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidInput,
+			"Price smoothing in parts-per-million must be less than or equal to %d",
+			lib.OneMillion,
+		)
+	}
+
 	if err := json.IsValidJSON(mp.ExchangeConfigJson); err != nil {
 		return errorsmod.Wrapf(
 			ErrInvalidInput,
@@ -34,5 +52,32 @@ func (mp *MarketParam) Validate() error {
 		)
 	}
 
+	if _, err := pricefeedtypes.AggregationConfigFromExchangeConfigJson(mp.ExchangeConfigJson); err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidInput,
+			"ExchangeConfigJson aggregation config is not valid: err=%v, input=%v",
+			err,
+			mp.ExchangeConfigJson,
+		)
+	}
+
 	return nil
 }
+
+// SmoothedPriceHistoryLengthOrDefault returns the number of historical smoothed prices that must agree with a
+// proposed price update for this market, falling back to `DefaultSmoothedPriceHistoryLength` if unset.
+func (mp *MarketParam) SmoothedPriceHistoryLengthOrDefault() uint32 {
+	if mp.SmoothedPriceHistoryLength == 0 {
+		return DefaultSmoothedPriceHistoryLength
+	}
+	return mp.SmoothedPriceHistoryLength
+}
+
+// PriceSmoothingPpmOrDefault returns the weight of the index price when updating the smoothed price of this market,
+// falling back to `DefaultPriceSmoothingPpm` if unset.
+func (mp *MarketParam) PriceSmoothingPpmOrDefault() uint32 {
+	if mp.PriceSmoothingPpm == 0 {
+		return DefaultPriceSmoothingPpm
+	}
+	return mp.PriceSmoothingPpm
+}
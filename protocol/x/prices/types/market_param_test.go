@@ -44,6 +44,40 @@ func TestMarketParam_Validate(t *testing.T) {
 			},
 			expErrMsg: "Min price change in parts-per-million must be greater than 0",
 		},
+		{
+			name: "Valid smoothing params",
+			input: types.MarketParam{
+				Pair:                       "BTC-USD",
+				MinExchanges:               1,
+				MinPriceChangePpm:          1_000,
+				ExchangeConfigJson:         validExchangeConfigJson,
+				SmoothedPriceHistoryLength: types.MaxSmoothedPriceHistoryLength,
+				PriceSmoothingPpm:          1_000_000,
+			},
+			expErrMsg: "",
+		},
+		{
+			name: "Invalid SmoothedPriceHistoryLength",
+			input: types.MarketParam{
+				Pair:                       "BTC-USD",
+				MinExchanges:               1,
+				MinPriceChangePpm:          1_000,
+				ExchangeConfigJson:         validExchangeConfigJson,
+				SmoothedPriceHistoryLength: types.MaxSmoothedPriceHistoryLength + 1,
+			},
+			expErrMsg: "Smoothed price history length must be less than or equal to 100",
+		},
+		{
+			name: "Invalid PriceSmoothingPpm",
+			input: types.MarketParam{
+				Pair:               "BTC-USD",
+				MinExchanges:       1,
+				MinPriceChangePpm:  1_000,
+				ExchangeConfigJson: validExchangeConfigJson,
+				PriceSmoothingPpm:  1_000_001,
+			},
+			expErrMsg: "Price smoothing in parts-per-million must be less than or equal to 1000000",
+		},
 		{
 			name: "Empty ExchangeConfigJson",
 			input: types.MarketParam{
@@ -64,6 +98,28 @@ func TestMarketParam_Validate(t *testing.T) {
 			},
 			expErrMsg: "ExchangeConfigJson string is not valid",
 		},
+		{
+			name: "Valid aggregation config in ExchangeConfigJson",
+			input: types.MarketParam{
+				Pair:              "BTC-USD",
+				MinExchanges:      1,
+				MinPriceChangePpm: 1_000,
+				ExchangeConfigJson: `{"exchanges":[{"exchangeName":"Binance","ticker":"BTCUSDT"}],` +
+					`"aggregation":{"method":"trimmedMean","trimPpm":100000,"maxDeviationPpm":50000}}`,
+			},
+			expErrMsg: "",
+		},
+		{
+			name: "Invalid aggregation config in ExchangeConfigJson",
+			input: types.MarketParam{
+				Pair:              "BTC-USD",
+				MinExchanges:      1,
+				MinPriceChangePpm: 1_000,
+				ExchangeConfigJson: `{"exchanges":[{"exchangeName":"Binance","ticker":"BTCUSDT"}],` +
+					`"aggregation":{"method":"mode"}}`,
+			},
+			expErrMsg: "ExchangeConfigJson aggregation config is not valid",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -77,3 +133,16 @@ func TestMarketParam_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestMarketParam_SmoothingParamsOrDefault(t *testing.T) {
+	marketParam := types.MarketParam{}
+	require.Equal(t, types.DefaultSmoothedPriceHistoryLength, marketParam.SmoothedPriceHistoryLengthOrDefault())
+	require.Equal(t, types.DefaultPriceSmoothingPpm, marketParam.PriceSmoothingPpmOrDefault())
+
+	marketParam = types.MarketParam{
+		SmoothedPriceHistoryLength: 20,
+		PriceSmoothingPpm:          100_000,
+	}
+	require.Equal(t, uint32(20), marketParam.SmoothedPriceHistoryLengthOrDefault())
+	require.Equal(t, uint32(100_000), marketParam.PriceSmoothingPpmOrDefault())
+}
@@ -5,17 +5,21 @@ import (
 )
 
 const (
-	// SmoothedPriceTrackingBlockHistoryLength is the number of blocks we track smoothed prices for to determine if the
-	// next smoothed price should be used in the price update proposal.
-	// TODO(CORE-396): Put this on-chain and configure it in the application via chain state.
-	SmoothedPriceTrackingBlockHistoryLength = uint32(5)
+	// DefaultSmoothedPriceHistoryLength is the number of blocks of smoothed prices used to determine if the
+	// next smoothed price should be used in the price update proposal, for markets that do not configure
+	// `SmoothedPriceHistoryLength` in their `MarketParam`.
+	DefaultSmoothedPriceHistoryLength = uint32(5)
+
+	// MaxSmoothedPriceHistoryLength is the maximum `SmoothedPriceHistoryLength` a market may be configured with.
+	// This is also the number of blocks we track smoothed prices for in memory.
+	MaxSmoothedPriceHistoryLength = uint32(100)
 )
 
 // MarketToSmoothedPrices tracks current and historical exponentially smoothed prices for each market.
 type MarketToSmoothedPrices interface {
 	GetSmoothedPrice(marketId uint32) (price uint64, ok bool)
 	GetSmoothedPricesForTest() map[uint32]uint64
-	GetHistoricalSmoothedPrices(marketId uint32) []uint64
+	GetHistoricalSmoothedPrices(marketId uint32, historyLength uint32) []uint64
 	PushSmoothedPrice(marketId uint32, price uint64)
 }
 
@@ -55,17 +59,22 @@ func (m *MarketToSmoothedPricesImpl) GetSmoothedPricesForTest() map[uint32]uint6
 	return smoothedPrices
 }
 
-// GetHistoricalSmoothedPrices returns up to the last `SmoothedPriceTrackingBlockHistoryLength` smoothed prices for the
-// given market. The returned slice is ordered from newest to oldest, and the first entry in the slice will be the
-// most recent valid smoothed price.
-func (m *MarketToSmoothedPricesImpl) GetHistoricalSmoothedPrices(marketId uint32) []uint64 {
+// GetHistoricalSmoothedPrices returns up to the last `historyLength` smoothed prices for the given market. The
+// number of prices returned is also bounded by the history length this struct was created with. The returned slice
+// is ordered from newest to oldest, and the first entry in the slice will be the most recent valid smoothed price.
+func (m *MarketToSmoothedPricesImpl) GetHistoricalSmoothedPrices(marketId uint32, historyLength uint32) []uint64 {
 	smoothedPrices, ok := m.marketToSmoothedPrices[marketId]
 	if !ok {
 		return []uint64{}
 	}
 
-	prices := make([]uint64, 0, smoothedPrices.Len())
-	for i := 0; i < smoothedPrices.Len(); i++ {
+	numPrices := smoothedPrices.Len()
+	if int(historyLength) < numPrices {
+		numPrices = int(historyLength)
+	}
+
+	prices := make([]uint64, 0, numPrices)
+	for i := 0; i < numPrices; i++ {
 		price := smoothedPrices.Value
 		if price != 0 {
 			prices = append(prices, price)
@@ -88,7 +97,8 @@ func (m *MarketToSmoothedPricesImpl) PushSmoothedPrice(id uint32, price uint64)
 }
 
 // NewMarketToSmoothedPrices returns a new `MarketToSmoothedPrices` that tracks the previous `historyLength` prices per
-// market. The default value to use for the protocol is `SmoothedPriceTrackingBlockHistoryLength`.
+// market. The value to use for the protocol is `MaxSmoothedPriceHistoryLength`, so that any market's configured
+// `SmoothedPriceHistoryLength` can be served.
 func NewMarketToSmoothedPrices(historyLength uint32) MarketToSmoothedPrices {
 	return &MarketToSmoothedPricesImpl{
 		historyLength:          historyLength,
@@ -13,12 +13,12 @@ const (
 )
 
 func TestNewMarketToSmoothedPrices_IsEmpty(t *testing.T) {
-	mtsp := types.NewMarketToSmoothedPrices(types.SmoothedPriceTrackingBlockHistoryLength)
+	mtsp := types.NewMarketToSmoothedPrices(testSmoothedPriceHistoryLength)
 	require.Empty(t, mtsp.GetSmoothedPricesForTest())
 }
 
 func TestSetSmoothedPrice(t *testing.T) {
-	mtsp := types.NewMarketToSmoothedPrices(types.SmoothedPriceTrackingBlockHistoryLength)
+	mtsp := types.NewMarketToSmoothedPrices(testSmoothedPriceHistoryLength)
 
 	mtsp.PushSmoothedPrice(marketId1, price1)
 	actualPrice, ok := mtsp.GetSmoothedPrice(marketId1)
@@ -28,7 +28,7 @@ func TestSetSmoothedPrice(t *testing.T) {
 
 	// Set a new price for the same market enough times to cause the ring buffer to loop. This is to sanity
 	// check ring buffer logic.
-	for i := 0; i < int(types.SmoothedPriceTrackingBlockHistoryLength); i++ {
+	for i := 0; i < testSmoothedPriceHistoryLength; i++ {
 		updatePrice := price1 + uint64(i+1)*uint64(1_000_000_000)
 
 		mtsp.PushSmoothedPrice(marketId1, updatePrice)
@@ -42,24 +42,44 @@ func TestSetSmoothedPrice(t *testing.T) {
 func TestGetHistoricalSmoothedPrices(t *testing.T) {
 	tests := map[string]struct {
 		prices         []uint64
+		historyLength  uint32
 		expectedPrices []uint64
 	}{
 		"no prices": {
 			prices:         []uint64{},
+			historyLength:  testSmoothedPriceHistoryLength,
 			expectedPrices: []uint64{},
 		},
 		"one price": {
 			prices:         []uint64{price1},
+			historyLength:  testSmoothedPriceHistoryLength,
 			expectedPrices: []uint64{price1},
 		},
 		"two prices": {
 			prices:         []uint64{price1, price1 + 1},
+			historyLength:  testSmoothedPriceHistoryLength,
 			expectedPrices: []uint64{price1 + 1, price1},
 		},
-		"num prices >> SmoothedPriceTrackingBlockHistoryLength": {
+		"num prices >> tracked history length": {
 			prices:         []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			historyLength:  testSmoothedPriceHistoryLength,
 			expectedPrices: []uint64{12, 11, 10, 9, 8},
 		},
+		"history length < tracked history length": {
+			prices:         []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			historyLength:  2,
+			expectedPrices: []uint64{12, 11},
+		},
+		"history length > tracked history length": {
+			prices:         []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			historyLength:  testSmoothedPriceHistoryLength + 10,
+			expectedPrices: []uint64{12, 11, 10, 9, 8},
+		},
+		"zero history length": {
+			prices:         []uint64{1, 2, 3},
+			historyLength:  0,
+			expectedPrices: []uint64{},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -67,7 +87,7 @@ func TestGetHistoricalSmoothedPrices(t *testing.T) {
 			for _, price := range tc.prices {
 				mtsp.PushSmoothedPrice(marketId1, price)
 			}
-			require.Equal(t, tc.expectedPrices, mtsp.GetHistoricalSmoothedPrices(marketId1))
+			require.Equal(t, tc.expectedPrices, mtsp.GetHistoricalSmoothedPrices(marketId1, tc.historyLength))
 		})
 	}
 }
@@ -1,5 +1,7 @@
 package types
 
 const (
-	PriceSmoothingPpm = uint32(300_000)
+	// DefaultPriceSmoothingPpm is the weight of the index price used when updating the smoothed price of markets
+	// that do not configure `PriceSmoothingPpm` in their `MarketParam`.
+	DefaultPriceSmoothingPpm = uint32(300_000)
 )
@@ -5,6 +5,6 @@ import (
 	"testing"
 )
 
-func TestPriceSmoothingPpm(t *testing.T) {
-	require.Equal(t, PriceSmoothingPpm, uint32(300_000))
+func TestDefaultPriceSmoothingPpm(t *testing.T) {
+	require.Equal(t, DefaultPriceSmoothingPpm, uint32(300_000))
 }
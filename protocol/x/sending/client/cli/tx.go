@@ -11,6 +11,11 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
 )
 
+const (
+	// FlagAssetId is the flag used to specify the asset to deposit or withdraw. Defaults to USDC.
+	FlagAssetId = "asset-id"
+)
+
 var (
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 )
@@ -132,6 +132,9 @@ func runProcessTransferTest(t *testing.T, tc TransferTestCase) {
 			p.Params.AtomicResolution,
 			p.Params.DefaultFundingPpm,
 			p.Params.LiquidityTier,
+			p.Params.FundingRateBounds,
+			p.Params.InterestRatePpm,
+			p.Params.InsuranceFundScope,
 		)
 		require.NoError(t, err)
 	}
@@ -258,6 +261,9 @@ func TestProcessTransfer_CreateRecipientAccount(t *testing.T) {
 			p.Params.AtomicResolution,
 			p.Params.DefaultFundingPpm,
 			p.Params.LiquidityTier,
+			p.Params.FundingRateBounds,
+			p.Params.InterestRatePpm,
+			p.Params.InsuranceFundScope,
 		)
 		require.NoError(t, err)
 	}
@@ -37,14 +37,13 @@ func TestMsgWithdrawFromSubaccount_ValidateBasic(t *testing.T) {
 			},
 			err: types.ErrInvalidAccountAddress,
 		},
-		"Non-USDC asset transfer not supported": {
+		"Valid - non-USDC asset": {
 			msg: types.MsgWithdrawFromSubaccount{
 				Sender:    constants.Alice_Num0,
 				Recipient: constants.AliceAccAddress.String(),
 				AssetId:   uint32(1),
 				Quantums:  uint64(100),
 			},
-			err: types.ErrNonUsdcAssetTransferNotImplemented,
 		},
 		"Invalid quantums": {
 			msg: types.MsgWithdrawFromSubaccount{
@@ -23,6 +23,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListSubaccount())
 	cmd.AddCommand(CmdShowSubaccount())
+	cmd.AddCommand(CmdListLiquidationCandidates())
+	cmd.AddCommand(CmdShowAdlRanks())
 
 	return cmd
 }
@@ -0,0 +1,43 @@
+//go:build all || integration_test
+
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	tmcli "github.com/cometbft/cometbft/libs/cli"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/client/cli"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestShowAdlRanks(t *testing.T) {
+	// Subaccounts without open perpetual positions do not have ADL ranks.
+	net, objs := networkWithSubaccountObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	out, err := clitestutil.ExecTestCLICmd(
+		ctx,
+		cli.CmdShowAdlRanks(),
+		[]string{
+			objs[0].Id.Owner,
+			strconv.Itoa(int(objs[0].Id.Number)),
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		},
+	)
+	require.NoError(t, err)
+	var resp types.QueryAdlRanksResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Empty(t, resp.AdlRanks)
+
+	_, err = clitestutil.ExecTestCLICmd(
+		ctx,
+		cli.CmdShowAdlRanks(),
+		[]string{objs[0].Id.Owner, "invalid", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,40 @@
+//go:build all || integration_test
+
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	tmcli "github.com/cometbft/cometbft/libs/cli"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/client/cli"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestListLiquidationCandidates(t *testing.T) {
+	// Subaccounts without open perpetual positions are not liquidation candidates.
+	net, _ := networkWithSubaccountObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	for _, args := range [][]string{
+		{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+		{fmt.Sprintf("--%s=1", flags.FlagLimit), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+	} {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListLiquidationCandidates(), args)
+		require.NoError(t, err)
+		var resp types.QueryLiquidationCandidatesResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Empty(t, resp.Candidates)
+	}
+
+	_, err := clitestutil.ExecTestCLICmd(
+		ctx,
+		cli.CmdListLiquidationCandidates(),
+		[]string{fmt.Sprintf("--%s=1", flags.FlagOffset), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,478 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// getPositionEntryNotionalStore returns the store of the entry notional of perpetual positions.
+func (k Keeper) getPositionEntryNotionalStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PositionEntryNotionalKeyPrefix))
+}
+
+// getPositionEntryNotionalKey returns the key of the entry notional of the position of the
+// subaccount in the perpetual.
+func getPositionEntryNotionalKey(subaccountId types.SubaccountId, perpetualId uint32) []byte {
+	return append(subaccountId.ToStateKey(), lib.Uint32ToKey(perpetualId)...)
+}
+
+// GetPositionEntryNotional returns the notional of the position of the subaccount in the
+// perpetual at the oracle prices at which the position was entered, in quote quantums. The
+// entry notional has the same sign as the position. Returns false if no entry notional was
+// recorded for the position, which is the case for positions opened before entry notionals
+// were tracked.
+func (k Keeper) GetPositionEntryNotional(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+) (
+	entryNotional *big.Int,
+	exists bool,
+) {
+	b := k.getPositionEntryNotionalStore(ctx).Get(getPositionEntryNotionalKey(subaccountId, perpetualId))
+	if b == nil {
+		return nil, false
+	}
+
+	var serializableEntryNotional dtypes.SerializableInt
+	if err := serializableEntryNotional.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return serializableEntryNotional.BigInt(), true
+}
+
+// setPositionEntryNotional sets the entry notional of the position of the subaccount in the
+// perpetual, removing it if `entryNotional` is zero.
+func (k Keeper) setPositionEntryNotional(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+	entryNotional *big.Int,
+) {
+	store := k.getPositionEntryNotionalStore(ctx)
+	key := getPositionEntryNotionalKey(subaccountId, perpetualId)
+
+	if entryNotional.Sign() == 0 {
+		store.Delete(key)
+		return
+	}
+
+	b, err := dtypes.NewIntFromBigInt(entryNotional).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, b)
+}
+
+// getPositionEntryNotionalOrCurrent returns the entry notional of the position of the subaccount
+// in the perpetual, or `currentNotional` if no entry notional was recorded for the position.
+func (k Keeper) getPositionEntryNotionalOrCurrent(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+	currentNotional *big.Int,
+) *big.Int {
+	if entryNotional, exists := k.GetPositionEntryNotional(ctx, subaccountId, perpetualId); exists {
+		return entryNotional
+	}
+	return new(big.Int).Set(currentNotional)
+}
+
+// updatePositionEntryNotionals updates the entry notional of every perpetual position changed by
+// `settledUpdates`, valuing the changes at current oracle prices. Increasing a position adds the
+// notional of the increase to its entry notional, reducing a position reduces its entry notional
+// proportionally, and opening or flipping a position resets its entry notional to the notional of
+// the new position. This function must be called before the updates are applied to the settled
+// subaccounts.
+func (k Keeper) updatePositionEntryNotionals(
+	ctx sdk.Context,
+	settledUpdates []settledUpdate,
+) error {
+	for _, u := range settledUpdates {
+		subaccountId := *u.SettledSubaccount.Id
+		for _, pu := range u.PerpetualUpdates {
+			bigQuantums := new(big.Int)
+			if position, exists := u.SettledSubaccount.GetPerpetualPositionForId(pu.PerpetualId); exists {
+				bigQuantums = position.GetBigQuantums()
+			}
+			bigDeltaQuantums := pu.GetBigQuantums()
+			bigNewQuantums := new(big.Int).Add(bigQuantums, bigDeltaQuantums)
+
+			var entryNotional *big.Int
+			switch {
+			case bigNewQuantums.Sign() == 0:
+				// The position is closed.
+				entryNotional = new(big.Int)
+			case bigQuantums.Sign() != bigNewQuantums.Sign():
+				// The position is opened or flipped.
+				newNotional, err := k.perpetualsKeeper.GetNetCollateral(ctx, pu.PerpetualId, bigNewQuantums)
+				if err != nil {
+					return err
+				}
+				entryNotional = newNotional
+			case bigNewQuantums.CmpAbs(bigQuantums) > 0:
+				// The position is increased.
+				notional, err := k.perpetualsKeeper.GetNetCollateral(ctx, pu.PerpetualId, bigQuantums)
+				if err != nil {
+					return err
+				}
+				deltaNotional, err := k.perpetualsKeeper.GetNetCollateral(ctx, pu.PerpetualId, bigDeltaQuantums)
+				if err != nil {
+					return err
+				}
+				entryNotional = new(big.Int).Add(
+					k.getPositionEntryNotionalOrCurrent(ctx, subaccountId, pu.PerpetualId, notional),
+					deltaNotional,
+				)
+			default:
+				// The position is reduced.
+				notional, err := k.perpetualsKeeper.GetNetCollateral(ctx, pu.PerpetualId, bigQuantums)
+				if err != nil {
+					return err
+				}
+				entryNotional = new(big.Int).Quo(
+					new(big.Int).Mul(
+						k.getPositionEntryNotionalOrCurrent(ctx, subaccountId, pu.PerpetualId, notional),
+						bigNewQuantums,
+					),
+					bigQuantums,
+				)
+			}
+			k.setPositionEntryNotional(ctx, subaccountId, pu.PerpetualId, entryNotional)
+		}
+	}
+	return nil
+}
+
+// InitializePositionEntryNotionals sets the entry notional of every open perpetual position without a
+// recorded entry notional to its notional at current oracle prices, such that the unrealized PnL of
+// positions opened before entry notionals were tracked is measured from this point on.
+func (k Keeper) InitializePositionEntryNotionals(ctx sdk.Context) error {
+	for _, subaccount := range k.GetAllSubaccount(ctx) {
+		for _, position := range subaccount.PerpetualPositions {
+			if _, exists := k.GetPositionEntryNotional(ctx, *subaccount.Id, position.PerpetualId); exists {
+				continue
+			}
+
+			bigNotional, err := k.perpetualsKeeper.GetNetCollateral(
+				ctx,
+				position.PerpetualId,
+				position.GetBigQuantums(),
+			)
+			if err != nil {
+				return err
+			}
+			k.setPositionEntryNotional(ctx, *subaccount.Id, position.PerpetualId, bigNotional)
+		}
+	}
+	return nil
+}
+
+// getPerpetualPositionMemStore returns the memstore index of open perpetual positions by perpetual and
+// side. The index is local to the node and derived from state, so accesses are not metered by the gas
+// meter of the context.
+func (k Keeper) getPerpetualPositionMemStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.MultiStore().GetKVStore(k.memKey),
+		[]byte(types.PerpetualPositionKeyPrefix),
+	)
+}
+
+// getSubaccountPerpetualPositionMemStore returns the memstore mapping from the perpetual ids of the open
+// positions of the subaccount to their keys in the index of open perpetual positions. The state key of
+// the subaccount is prefixed with its length, since the state key of a subaccount can be a prefix of the
+// state key of another.
+func (k Keeper) getSubaccountPerpetualPositionMemStore(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) prefix.Store {
+	subaccountKey := subaccountId.ToStateKey()
+	storePrefix := append(
+		[]byte(types.SubaccountPerpetualPositionKeyPrefix),
+		lib.Uint32ToKey(uint32(len(subaccountKey)))...,
+	)
+	return prefix.NewStore(
+		ctx.MultiStore().GetKVStore(k.memKey),
+		append(storePrefix, subaccountKey...),
+	)
+}
+
+// getPerpetualPositionSideKey returns the prefix of the keys of the index of open perpetual positions for
+// the given side of the perpetual.
+func getPerpetualPositionSideKey(perpetualId uint32, isLong bool) []byte {
+	side := byte(0)
+	if isLong {
+		side = 1
+	}
+	return append(lib.Uint32ToKey(perpetualId), side)
+}
+
+// setPerpetualPositionIndex replaces the entries of the subaccount in the index of open perpetual
+// positions with its open perpetual positions. Entries only depend on the perpetual and side of the
+// positions, so entries of positions that are neither opened, closed nor flipped are left unchanged.
+func (k Keeper) setPerpetualPositionIndex(ctx sdk.Context, subaccount types.Subaccount) {
+	positionStore := k.getPerpetualPositionMemStore(ctx)
+	subaccountStore := k.getSubaccountPerpetualPositionMemStore(ctx, *subaccount.Id)
+
+	subaccountKey := subaccount.Id.ToStateKey()
+	positionKeys := make(map[string][]byte, len(subaccount.PerpetualPositions))
+	for _, position := range subaccount.PerpetualPositions {
+		positionKeys[string(lib.Uint32ToKey(position.PerpetualId))] = append(
+			getPerpetualPositionSideKey(position.PerpetualId, position.GetIsLong()),
+			subaccountKey...,
+		)
+	}
+
+	// Remove the entries of positions that were closed or flipped.
+	unchangedPerpetualIdKeys := make(map[string]bool)
+	perpetualIdKeys := make([][]byte, 0)
+	staleKeys := make([][]byte, 0)
+	iterator := subaccountStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(positionKeys[string(iterator.Key())], iterator.Value()) {
+			unchangedPerpetualIdKeys[string(iterator.Key())] = true
+			continue
+		}
+		perpetualIdKeys = append(perpetualIdKeys, iterator.Key())
+		staleKeys = append(staleKeys, iterator.Value())
+	}
+	iterator.Close()
+	for i := range perpetualIdKeys {
+		subaccountStore.Delete(perpetualIdKeys[i])
+		positionStore.Delete(staleKeys[i])
+	}
+
+	// Add the entries of positions that were opened or flipped.
+	for _, position := range subaccount.PerpetualPositions {
+		perpetualIdKey := lib.Uint32ToKey(position.PerpetualId)
+		if unchangedPerpetualIdKeys[string(perpetualIdKey)] {
+			continue
+		}
+		positionKey := positionKeys[string(perpetualIdKey)]
+		positionStore.Set(positionKey, subaccountKey)
+		subaccountStore.Set(perpetualIdKey, positionKey)
+	}
+}
+
+// getAdlPosition returns the ADL position of the subaccount with net collateral `bigNetCollateral` for
+// its open perpetual position.
+func (k Keeper) getAdlPosition(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	position *types.PerpetualPosition,
+	bigNetCollateral *big.Int,
+) (
+	adlPosition types.AdlPosition,
+	err error,
+) {
+	bigQuantums := position.GetBigQuantums()
+	bigNotional, err := k.perpetualsKeeper.GetNetCollateral(ctx, position.PerpetualId, bigQuantums)
+	if err != nil {
+		return adlPosition, err
+	}
+
+	entryNotional := k.getPositionEntryNotionalOrCurrent(ctx, subaccountId, position.PerpetualId, bigNotional)
+	return types.AdlPosition{
+		SubaccountId:  subaccountId,
+		Quantums:      bigQuantums,
+		UnrealizedPnl: new(big.Int).Sub(bigNotional, entryNotional),
+		Notional:      bigNotional,
+		NetCollateral: bigNetCollateral,
+	}, nil
+}
+
+// GetAdlPosition returns the ADL position of the open position of the subaccount in the perpetual at
+// current oracle prices. Returns false if the subaccount does not have an open position in the perpetual.
+func (k Keeper) GetAdlPosition(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+) (
+	adlPosition types.AdlPosition,
+	exists bool,
+	err error,
+) {
+	subaccount := k.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists {
+		return adlPosition, false, nil
+	}
+
+	bigNetCollateral, _, _, err := k.GetNetCollateralAndMarginRequirements(
+		ctx,
+		types.Update{SubaccountId: subaccountId},
+	)
+	if err != nil {
+		return adlPosition, false, err
+	}
+
+	adlPosition, err = k.getAdlPosition(ctx, subaccountId, position, bigNetCollateral)
+	if err != nil {
+		return adlPosition, false, err
+	}
+	return adlPosition, true, nil
+}
+
+// forEachPerpetualPosition calls `callback` with the state key and id of each subaccount with an open
+// position on the given side of the perpetual, in the order of the index of open perpetual positions,
+// which is the order of subaccount state keys.
+func (k Keeper) forEachPerpetualPosition(
+	ctx sdk.Context,
+	perpetualId uint32,
+	isLong bool,
+	callback func(subaccountKey []byte, subaccountId types.SubaccountId) error,
+) error {
+	iterator := prefix.NewStore(
+		k.getPerpetualPositionMemStore(ctx),
+		getPerpetualPositionSideKey(perpetualId, isLong),
+	).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var subaccountId types.SubaccountId
+		k.cdc.MustUnmarshal(iterator.Value(), &subaccountId)
+		if err := callback(iterator.Key(), subaccountId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getIndexedAdlPosition returns the ADL position of a subaccount in the index of open perpetual positions
+// of the perpetual, and returns an error if the subaccount has no open position in the perpetual.
+func (k Keeper) getIndexedAdlPosition(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+) (
+	adlPosition types.AdlPosition,
+	err error,
+) {
+	adlPosition, exists, err := k.GetAdlPosition(ctx, subaccountId, perpetualId)
+	if err != nil {
+		return adlPosition, err
+	}
+	if !exists {
+		return adlPosition, fmt.Errorf(
+			"subaccount %+v in the index of open perpetual positions has no open position in perpetual %d",
+			subaccountId,
+			perpetualId,
+		)
+	}
+	return adlPosition, nil
+}
+
+// GetAdlRanking returns at most `limit` open positions on the given side of the perpetual in
+// auto-deleveraging order. Positions are ranked by unrealized PnL multiplied by leverage at current
+// oracle prices, such that the most profitable and most leveraged positions come first. All open
+// positions on the side of the perpetual are scored, and ties are broken by the order of subaccount
+// state keys, which makes the ranking deterministic.
+func (k Keeper) GetAdlRanking(
+	ctx sdk.Context,
+	perpetualId uint32,
+	isLong bool,
+	limit uint32,
+) (
+	positions []types.AdlPosition,
+	err error,
+) {
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.GetAdlRanking,
+		metrics.Latency,
+	)
+
+	positions = make([]types.AdlPosition, 0)
+	if err := k.forEachPerpetualPosition(
+		ctx,
+		perpetualId,
+		isLong,
+		func(_ []byte, subaccountId types.SubaccountId) error {
+			adlPosition, err := k.getIndexedAdlPosition(ctx, subaccountId, perpetualId)
+			if err != nil {
+				return err
+			}
+			positions = append(positions, adlPosition)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	types.SortAdlPositions(positions)
+	if uint32(len(positions)) > limit {
+		positions = positions[:limit]
+	}
+	return positions, nil
+}
+
+// GetAdlRanks returns the auto-deleveraging rank of each open perpetual position of the
+// subaccount among all open positions on the same side of the perpetual, in ascending order
+// of perpetual id. Each rank is computed in a single pass over the positions on the side of
+// the perpetual, and ranks are capped at `MaxAdlRank`.
+func (k Keeper) GetAdlRanks(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	adlRanks []types.AdlRank,
+	err error,
+) {
+	subaccount := k.GetSubaccount(ctx, subaccountId)
+	subaccountKey := subaccountId.ToStateKey()
+
+	adlRanks = make([]types.AdlRank, 0, len(subaccount.PerpetualPositions))
+	for _, position := range subaccount.PerpetualPositions {
+		adlPosition, _, err := k.GetAdlPosition(ctx, subaccountId, position.PerpetualId)
+		if err != nil {
+			return nil, err
+		}
+		score := adlPosition.GetAdlScore()
+
+		// Count the positions that rank before the position of the subaccount. Positions with equal
+		// scores rank in the order of subaccount state keys.
+		numPositions, numPositionsBefore := uint32(0), uint32(0)
+		if err := k.forEachPerpetualPosition(
+			ctx,
+			position.PerpetualId,
+			position.GetIsLong(),
+			func(otherSubaccountKey []byte, otherSubaccountId types.SubaccountId) error {
+				numPositions++
+				if otherSubaccountId == subaccountId || numPositionsBefore+1 >= types.MaxAdlRank {
+					return nil
+				}
+
+				otherAdlPosition, err := k.getIndexedAdlPosition(ctx, otherSubaccountId, position.PerpetualId)
+				if err != nil {
+					return err
+				}
+				cmp := types.CompareAdlScores(otherAdlPosition.GetAdlScore(), score)
+				if cmp > 0 || (cmp == 0 && bytes.Compare(otherSubaccountKey, subaccountKey) < 0) {
+					numPositionsBefore++
+				}
+				return nil
+			},
+		); err != nil {
+			return nil, err
+		}
+
+		rank := numPositionsBefore + 1
+		adlRanks = append(adlRanks, types.AdlRank{
+			PerpetualId:   position.PerpetualId,
+			Rank:          rank,
+			NumPositions:  numPositions,
+			PercentilePpm: types.GetAdlRankPercentilePpm(rank, numPositions),
+			UnrealizedPnl: dtypes.NewIntFromBigInt(adlPosition.UnrealizedPnl),
+		})
+	}
+	return adlRanks, nil
+}
@@ -0,0 +1,359 @@
+package keeper_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAdlTest(t *testing.T) (sdk.Context, *keeper.Keeper, *priceskeeper.Keeper) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _ := testutil.SubaccountsKeepers(t, true)
+	testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+	testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.FundingRateBounds,
+		p.Params.InterestRatePpm,
+		p.Params.InsuranceFundScope,
+	)
+	require.NoError(t, err)
+	return ctx, keeper, pricesKeeper
+}
+
+// setBtcPrice sets the oracle price of BTC in dollars.
+func setBtcPrice(t *testing.T, ctx sdk.Context, pricesKeeper *priceskeeper.Keeper, price uint64) {
+	require.NoError(
+		t,
+		pricesKeeper.UpdateMarketPrices(
+			ctx,
+			[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+				pricestypes.NewMarketPriceUpdate(0, price*100_000),
+			},
+		),
+	)
+}
+
+// tradeBtc updates the BTC position of the subaccount by `deltaQuantums` at `price` dollars per BTC.
+func tradeBtc(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	subaccountId types.SubaccountId,
+	deltaQuantums int64,
+	price int64,
+) {
+	success, _, err := k.UpdateSubaccounts(
+		ctx,
+		[]types.Update{
+			{
+				SubaccountId: subaccountId,
+				AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-deltaQuantums * price / 100)),
+				PerpetualUpdates: []types.PerpetualUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(deltaQuantums),
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, success)
+}
+
+func TestUpdateSubaccounts_PositionEntryNotional(t *testing.T) {
+	ctx, keeper, pricesKeeper := setupAdlTest(t)
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)),
+	})
+
+	for _, step := range []struct {
+		description   string
+		price         uint64
+		deltaQuantums int64
+
+		expectedEntryNotional *big.Int
+	}{
+		{
+			description:           "Opening a position sets the entry notional",
+			price:                 50_000,
+			deltaQuantums:         100_000_000, // 1 BTC
+			expectedEntryNotional: big.NewInt(50_000_000_000),
+		},
+		{
+			description:           "Increasing a position adds the notional of the increase",
+			price:                 60_000,
+			deltaQuantums:         100_000_000, // 1 BTC
+			expectedEntryNotional: big.NewInt(110_000_000_000),
+		},
+		{
+			description:           "Reducing a position reduces the entry notional proportionally",
+			price:                 60_000,
+			deltaQuantums:         -100_000_000, // -1 BTC
+			expectedEntryNotional: big.NewInt(55_000_000_000),
+		},
+		{
+			description:           "Flipping a position resets the entry notional",
+			price:                 60_000,
+			deltaQuantums:         -200_000_000, // -2 BTC
+			expectedEntryNotional: big.NewInt(-60_000_000_000),
+		},
+		{
+			description:   "Closing a position removes the entry notional",
+			price:         50_000,
+			deltaQuantums: 100_000_000, // 1 BTC
+		},
+	} {
+		setBtcPrice(t, ctx, pricesKeeper, step.price)
+		tradeBtc(t, ctx, keeper, constants.Alice_Num0, step.deltaQuantums, int64(step.price))
+
+		entryNotional, exists := keeper.GetPositionEntryNotional(ctx, constants.Alice_Num0, 0)
+		if step.expectedEntryNotional == nil {
+			require.False(t, exists, step.description)
+		} else {
+			require.True(t, exists, step.description)
+			require.Zero(t, step.expectedEntryNotional.Cmp(entryNotional), step.description)
+		}
+	}
+}
+
+// setupAdlRankingTest creates the following positions with BTC at $55,000:
+// - Dave_Num0 is long 1 BTC entered at $50,000 with $25,000 of net collateral.
+// - Bob_Num0 is long 1 BTC entered at $50,000 with $105,000 of net collateral.
+// - Alice_Num0 is long 1 BTC without a recorded entry with $55,000 of net collateral.
+// - Carl_Num1 is long 1 BTC without a recorded entry with -$5,000 of net collateral.
+// - Carl_Num0 is short 1 BTC entered at $50,000 with $95,000 of net collateral.
+func setupAdlRankingTest(t *testing.T) (sdk.Context, *keeper.Keeper, *priceskeeper.Keeper) {
+	ctx, keeper, pricesKeeper := setupAdlTest(t)
+
+	for subaccountId, quoteBalance := range map[types.SubaccountId]int64{
+		constants.Dave_Num0: 20_000_000_000,
+		constants.Bob_Num0:  100_000_000_000,
+		constants.Carl_Num0: 100_000_000_000,
+	} {
+		id := subaccountId
+		keeper.SetSubaccount(ctx, types.Subaccount{
+			Id:             &id,
+			AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(quoteBalance)),
+		})
+	}
+	tradeBtc(t, ctx, keeper, constants.Dave_Num0, 100_000_000, 50_000)
+	tradeBtc(t, ctx, keeper, constants.Bob_Num0, 100_000_000, 50_000)
+	tradeBtc(t, ctx, keeper, constants.Carl_Num0, -100_000_000, 50_000)
+
+	setBtcPrice(t, ctx, pricesKeeper, 55_000)
+	for subaccountId, quoteBalance := range map[types.SubaccountId]int64{
+		constants.Alice_Num0: 0,
+		constants.Carl_Num1:  -60_000_000_000,
+	} {
+		id := subaccountId
+		keeper.SetSubaccount(ctx, types.Subaccount{
+			Id:             &id,
+			AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(quoteBalance)),
+			PerpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  0,
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+		})
+	}
+	return ctx, keeper, pricesKeeper
+}
+
+func TestGetAdlRanking(t *testing.T) {
+	ctx, keeper, _ := setupAdlRankingTest(t)
+
+	tests := map[string]struct {
+		isLong bool
+
+		expectedSubaccountIds []types.SubaccountId
+		expectedUnrealizedPnl []*big.Int
+	}{
+		"Longs are ranked by unrealized PnL multiplied by leverage": {
+			isLong: true,
+			expectedSubaccountIds: []types.SubaccountId{
+				constants.Dave_Num0,
+				constants.Bob_Num0,
+				constants.Alice_Num0,
+				constants.Carl_Num1,
+			},
+			expectedUnrealizedPnl: []*big.Int{
+				big.NewInt(5_000_000_000),
+				big.NewInt(5_000_000_000),
+				big.NewInt(0),
+				big.NewInt(0),
+			},
+		},
+		"Shorts": {
+			isLong:                false,
+			expectedSubaccountIds: []types.SubaccountId{constants.Carl_Num0},
+			expectedUnrealizedPnl: []*big.Int{big.NewInt(-5_000_000_000)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			positions, err := keeper.GetAdlRanking(ctx, 0, tc.isLong, math.MaxUint32)
+			require.NoError(t, err)
+			require.Len(t, positions, len(tc.expectedSubaccountIds))
+			for i, position := range positions {
+				require.Equal(t, tc.expectedSubaccountIds[i], position.SubaccountId)
+				require.Zero(t, tc.expectedUnrealizedPnl[i].Cmp(position.UnrealizedPnl))
+			}
+		})
+	}
+
+	// Perpetuals without open positions have an empty ranking.
+	positions, err := keeper.GetAdlRanking(ctx, 1, true, math.MaxUint32)
+	require.NoError(t, err)
+	require.Empty(t, positions)
+}
+
+func TestGetAdlRanking_Limit(t *testing.T) {
+	ctx, keeper, _ := setupAdlRankingTest(t)
+
+	// Only the positions with the highest ADL scores are ranked.
+	positions, err := keeper.GetAdlRanking(ctx, 0, true, 2)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	require.Equal(t, constants.Dave_Num0, positions[0].SubaccountId)
+	require.Equal(t, constants.Bob_Num0, positions[1].SubaccountId)
+
+	positions, err = keeper.GetAdlRanking(ctx, 0, true, 0)
+	require.NoError(t, err)
+	require.Empty(t, positions)
+
+	// Closing a position removes it from the ranking.
+	tradeBtc(t, ctx, keeper, constants.Dave_Num0, -100_000_000, 55_000)
+	positions, err = keeper.GetAdlRanking(ctx, 0, true, 2)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	require.Equal(t, constants.Bob_Num0, positions[0].SubaccountId)
+	require.Equal(t, constants.Alice_Num0, positions[1].SubaccountId)
+}
+
+func TestGetAdlRanking_PriceUpdate(t *testing.T) {
+	ctx, keeper, pricesKeeper := setupAdlRankingTest(t)
+
+	// Positions are ranked at the current oracle price without any update of their subaccounts.
+	// With BTC at $45,000, Alice_Num0 has no unrealized PnL, Bob_Num0 loses $5,000 at 0.47x leverage
+	// and Dave_Num0 loses $5,000 at 3x leverage.
+	setBtcPrice(t, ctx, pricesKeeper, 45_000)
+	positions, err := keeper.GetAdlRanking(ctx, 0, true, math.MaxUint32)
+	require.NoError(t, err)
+	subaccountIds := make([]types.SubaccountId, 0, len(positions))
+	for _, position := range positions {
+		subaccountIds = append(subaccountIds, position.SubaccountId)
+	}
+	require.Equal(
+		t,
+		[]types.SubaccountId{
+			constants.Alice_Num0,
+			constants.Bob_Num0,
+			constants.Dave_Num0,
+			constants.Carl_Num1,
+		},
+		subaccountIds,
+	)
+}
+
+func TestInitializePositionEntryNotionals(t *testing.T) {
+	ctx, keeper, _ := setupAdlRankingTest(t)
+
+	require.NoError(t, keeper.InitializePositionEntryNotionals(ctx))
+
+	for subaccountId, expectedEntryNotional := range map[types.SubaccountId]int64{
+		// Recorded entry notionals are not changed.
+		constants.Dave_Num0: 50_000_000_000,
+		constants.Carl_Num0: -50_000_000_000,
+		// Positions without a recorded entry notional are entered at the current price.
+		constants.Alice_Num0: 55_000_000_000,
+		constants.Carl_Num1:  55_000_000_000,
+	} {
+		entryNotional, exists := keeper.GetPositionEntryNotional(ctx, subaccountId, 0)
+		require.True(t, exists)
+		require.Zero(t, big.NewInt(expectedEntryNotional).Cmp(entryNotional))
+	}
+}
+
+func TestGetAdlRanks(t *testing.T) {
+	ctx, keeper, _ := setupAdlRankingTest(t)
+
+	tests := map[string]struct {
+		subaccountId types.SubaccountId
+
+		expectedAdlRanks []types.AdlRank
+	}{
+		"First long": {
+			subaccountId: constants.Dave_Num0,
+			expectedAdlRanks: []types.AdlRank{
+				{
+					PerpetualId:   0,
+					Rank:          1,
+					NumPositions:  4,
+					PercentilePpm: lib.OneMillion,
+					UnrealizedPnl: dtypes.NewInt(5_000_000_000),
+				},
+			},
+		},
+		"Second long": {
+			subaccountId: constants.Bob_Num0,
+			expectedAdlRanks: []types.AdlRank{
+				{
+					PerpetualId:   0,
+					Rank:          2,
+					NumPositions:  4,
+					PercentilePpm: 750_000,
+					UnrealizedPnl: dtypes.NewInt(5_000_000_000),
+				},
+			},
+		},
+		"Only short": {
+			subaccountId: constants.Carl_Num0,
+			expectedAdlRanks: []types.AdlRank{
+				{
+					PerpetualId:   0,
+					Rank:          1,
+					NumPositions:  1,
+					PercentilePpm: lib.OneMillion,
+					UnrealizedPnl: dtypes.NewInt(-5_000_000_000),
+				},
+			},
+		},
+		"Subaccount without open positions": {
+			subaccountId:     constants.Dave_Num1,
+			expectedAdlRanks: []types.AdlRank{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			adlRanks, err := keeper.GetAdlRanks(ctx, tc.subaccountId)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedAdlRanks, adlRanks)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AdlRanks(
+	c context.Context,
+	req *types.QueryAdlRanksRequest,
+) (*types.QueryAdlRanksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	adlRanks, err := k.GetAdlRanks(
+		ctx,
+		types.SubaccountId{
+			Owner:  req.Owner,
+			Number: req.Number,
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAdlRanksResponse{AdlRanks: adlRanks}, nil
+}
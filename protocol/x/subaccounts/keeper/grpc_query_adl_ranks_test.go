@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdlRanksQuery(t *testing.T) {
+	ctx, keeper := setupAdlRankingTest(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	for name, tc := range map[string]struct {
+		request  *types.QueryAdlRanksRequest
+		response *types.QueryAdlRanksResponse
+		err      error
+	}{
+		"Success": {
+			request: &types.QueryAdlRanksRequest{
+				Owner:  constants.Dave_Num0.Owner,
+				Number: constants.Dave_Num0.Number,
+			},
+			response: &types.QueryAdlRanksResponse{
+				AdlRanks: []types.AdlRank{
+					{
+						PerpetualId:   0,
+						Rank:          1,
+						NumPositions:  4,
+						PercentilePpm: lib.OneMillion,
+						UnrealizedPnl: dtypes.NewInt(5_000_000_000),
+					},
+				},
+			},
+		},
+		"Subaccount without open positions": {
+			request: &types.QueryAdlRanksRequest{
+				Owner:  constants.Dave_Num1.Owner,
+				Number: constants.Dave_Num1.Number,
+			},
+			response: &types.QueryAdlRanksResponse{
+				AdlRanks: []types.AdlRank{},
+			},
+		},
+		"Nil request": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			response, err := keeper.AdlRanks(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
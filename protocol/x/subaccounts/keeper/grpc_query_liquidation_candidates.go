@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) LiquidationCandidates(
+	c context.Context,
+	req *types.QueryLiquidationCandidatesRequest,
+) (*types.QueryLiquidationCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	// Offsets and total counts require iterating over all preceding or remaining entries of the
+	// index, so only key-based pagination is supported.
+	pagination := &query.PageRequest{Limit: query.DefaultLimit}
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.CountTotal {
+			return nil, status.Error(codes.InvalidArgument, "only key-based pagination is supported")
+		}
+		if req.Pagination.Limit > types.MaxLiquidationCandidatesPageLimit {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"page limit %d exceeds the maximum of %d",
+				req.Pagination.Limit,
+				types.MaxLiquidationCandidatesPageLimit,
+			)
+		}
+		pagination.Key = req.Pagination.Key
+		pagination.Reverse = req.Pagination.Reverse
+		if req.Pagination.Limit > 0 {
+			pagination.Limit = req.Pagination.Limit
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	candidates, pageRes, err := k.GetLiquidationCandidates(ctx, pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidationCandidatesResponse{Candidates: candidates, Pagination: pageRes}, nil
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLiquidationCandidatesQuery(t *testing.T) {
+	ctx, keeper, _ := setupLiquidationCandidatesTest(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetSubaccount(ctx, dave_Num0_1BTC_Long_100000USD_Collateral)
+	keeper.SetSubaccount(ctx, alice_Num0_1BTC_Long_Below_Maintenance_Margin)
+
+	for name, tc := range map[string]struct {
+		request  *types.QueryLiquidationCandidatesRequest
+		response *types.QueryLiquidationCandidatesResponse
+		err      error
+	}{
+		"Success": {
+			request: &types.QueryLiquidationCandidatesRequest{},
+			response: &types.QueryLiquidationCandidatesResponse{
+				Candidates: []types.LiquidationCandidate{
+					{
+						SubaccountId:                 constants.Alice_Num0,
+						NetCollateral:                dtypes.NewInt(4_500_000_000),
+						MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+					},
+					{
+						SubaccountId:                 constants.Dave_Num0,
+						NetCollateral:                dtypes.NewInt(100_000_000_000),
+						MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+					},
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"Success with page limit": {
+			request: &types.QueryLiquidationCandidatesRequest{
+				Pagination: &query.PageRequest{Limit: types.MaxLiquidationCandidatesPageLimit},
+			},
+			response: &types.QueryLiquidationCandidatesResponse{
+				Candidates: []types.LiquidationCandidate{
+					{
+						SubaccountId:                 constants.Alice_Num0,
+						NetCollateral:                dtypes.NewInt(4_500_000_000),
+						MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+					},
+					{
+						SubaccountId:                 constants.Dave_Num0,
+						NetCollateral:                dtypes.NewInt(100_000_000_000),
+						MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+					},
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"Page limit too large": {
+			request: &types.QueryLiquidationCandidatesRequest{
+				Pagination: &query.PageRequest{Limit: types.MaxLiquidationCandidatesPageLimit + 1},
+			},
+			err: status.Error(codes.InvalidArgument, "page limit 1001 exceeds the maximum of 1000"),
+		},
+		"Offset not supported": {
+			request: &types.QueryLiquidationCandidatesRequest{
+				Pagination: &query.PageRequest{Offset: 1},
+			},
+			err: status.Error(codes.InvalidArgument, "only key-based pagination is supported"),
+		},
+		"Count total not supported": {
+			request: &types.QueryLiquidationCandidatesRequest{
+				Pagination: &query.PageRequest{CountTotal: true},
+			},
+			err: status.Error(codes.InvalidArgument, "only key-based pagination is supported"),
+		},
+		"Nil request": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			response, err := keeper.LiquidationCandidates(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
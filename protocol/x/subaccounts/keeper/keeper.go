@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
+	"sync/atomic"
 
 	sdklog "cosmossdk.io/log"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
@@ -18,16 +21,20 @@ type (
 	Keeper struct {
 		cdc                 codec.BinaryCodec
 		storeKey            storetypes.StoreKey
+		memKey              storetypes.StoreKey
 		assetsKeeper        types.AssetsKeeper
 		bankKeeper          types.BankKeeper
 		perpetualsKeeper    types.PerpetualsKeeper
 		indexerEventManager indexer_manager.IndexerEventManager
+
+		memStoreInitialized *atomic.Bool
 	}
 )
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	memKey storetypes.StoreKey,
 	assetsKeeper types.AssetsKeeper,
 	bankKeeper types.BankKeeper,
 	perpetualsKeeper types.PerpetualsKeeper,
@@ -36,10 +43,12 @@ func NewKeeper(
 	return &Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
+		memKey:              memKey,
 		assetsKeeper:        assetsKeeper,
 		bankKeeper:          bankKeeper,
 		perpetualsKeeper:    perpetualsKeeper,
 		indexerEventManager: indexerEventManager,
+		memStoreInitialized: &atomic.Bool{},
 	}
 }
 
@@ -53,3 +62,35 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 func (k Keeper) InitializeForGenesis(ctx sdk.Context) {
 }
+
+// InitMemStore initializes the memstore of the `subaccounts` module with the index of subaccounts
+// that have at least one open perpetual position and the index of open perpetual positions by
+// perpetual and side. The memstore is not persisted across restarts, so this must be called once on
+// application start-up before any subaccounts are updated.
+func (k Keeper) InitMemStore(ctx sdk.Context) {
+	alreadyInitialized := k.memStoreInitialized.Swap(true)
+	if alreadyInitialized {
+		panic(errors.New("Memory store already initialized and is not intended to be invoked more then once."))
+	}
+
+	memStoreType := ctx.KVStore(k.memKey).GetStoreType()
+	if memStoreType != storetypes.StoreTypeMemory {
+		panic(
+			fmt.Sprintf(
+				"invalid memory store type; got %s, expected: %s",
+				memStoreType,
+				storetypes.StoreTypeMemory,
+			),
+		)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var subaccount types.Subaccount
+		k.cdc.MustUnmarshal(iterator.Value(), &subaccount)
+		k.setOpenPerpetualPositionIndex(ctx, subaccount)
+		k.setPerpetualPositionIndex(ctx, subaccount)
+	}
+}
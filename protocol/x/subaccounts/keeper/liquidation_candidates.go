@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// getOpenPerpetualPositionMemStore returns the memstore index of subaccounts with at least one open
+// perpetual position. The index is local to the node and derived from state, so accesses are not
+// metered by the gas meter of the context.
+func (k Keeper) getOpenPerpetualPositionMemStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.MultiStore().GetKVStore(k.memKey),
+		[]byte(types.OpenPerpetualPositionKeyPrefix),
+	)
+}
+
+// setOpenPerpetualPositionIndex adds the subaccount to the index of subaccounts with open perpetual
+// positions if it has at least one open perpetual position, and removes it from the index otherwise.
+func (k Keeper) setOpenPerpetualPositionIndex(ctx sdk.Context, subaccount types.Subaccount) {
+	memStore := k.getOpenPerpetualPositionMemStore(ctx)
+	key := subaccount.Id.ToStateKey()
+
+	if len(subaccount.PerpetualPositions) > 0 {
+		memStore.Set(key, []byte{})
+	} else if memStore.Has(key) {
+		memStore.Delete(key)
+	}
+}
+
+// GetLiquidationCandidates returns a page of subaccounts with at least one open perpetual position
+// along with their net collateral and maintenance margin requirement at current oracle prices.
+// Pages are iterated in key order of the index, so the work of a call is bounded by the page limit
+// as long as the request sets a non-zero limit and does not count the total.
+func (k Keeper) GetLiquidationCandidates(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) (
+	candidates []types.LiquidationCandidate,
+	pageRes *query.PageResponse,
+	err error,
+) {
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.GetLiquidationCandidates,
+		metrics.Latency,
+	)
+
+	candidates = make([]types.LiquidationCandidate, 0)
+	pageRes, err = query.Paginate(
+		k.getOpenPerpetualPositionMemStore(ctx),
+		pagination,
+		func(key []byte, value []byte) error {
+			var subaccountId types.SubaccountId
+			if err := k.cdc.Unmarshal(key, &subaccountId); err != nil {
+				return err
+			}
+
+			bigNetCollateral, _, bigMaintenanceMargin, err := k.GetNetCollateralAndMarginRequirements(
+				ctx,
+				types.Update{SubaccountId: subaccountId},
+			)
+			if err != nil {
+				return err
+			}
+
+			candidates = append(candidates, types.LiquidationCandidate{
+				SubaccountId:                 subaccountId,
+				NetCollateral:                dtypes.NewIntFromBigInt(bigNetCollateral),
+				MaintenanceMarginRequirement: dtypes.NewIntFromBigInt(bigMaintenanceMargin),
+			})
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return candidates, pageRes, nil
+}
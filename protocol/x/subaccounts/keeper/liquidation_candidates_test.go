@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// $4,500 of net collateral and $5,000 of maintenance margin.
+	alice_Num0_1BTC_Long_Below_Maintenance_Margin = types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(-45_500_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			{
+				PerpetualId:  0,
+				Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+				FundingIndex: dtypes.NewInt(0),
+			},
+		},
+	}
+	// $10,000 of net collateral and $5,000 of maintenance margin.
+	bob_Num0_1BTC_Short_10000USD_Collateral = types.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(60_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			{
+				PerpetualId:  0,
+				Quantums:     dtypes.NewInt(-100_000_000), // -1 BTC
+				FundingIndex: dtypes.NewInt(0),
+			},
+		},
+	}
+	// $1,000 of net collateral and no open perpetual positions.
+	carl_Num0_1000USD = types.Subaccount{
+		Id:             &constants.Carl_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000_000_000)),
+	}
+	// $100,000 of net collateral and $5,000 of maintenance margin.
+	dave_Num0_1BTC_Long_100000USD_Collateral = types.Subaccount{
+		Id:             &constants.Dave_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(50_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{
+			{
+				PerpetualId:  0,
+				Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+				FundingIndex: dtypes.NewInt(0),
+			},
+		},
+	}
+
+	maxLiquidationCandidatesPage = &query.PageRequest{Limit: types.MaxLiquidationCandidatesPageLimit}
+)
+
+func setupLiquidationCandidatesTest(t *testing.T) (sdk.Context, *keeper.Keeper, storetypes.StoreKey) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, storeKey := testutil.SubaccountsKeepers(t, true)
+	testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+	testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.FundingRateBounds,
+		p.Params.InterestRatePpm,
+		p.Params.InsuranceFundScope,
+	)
+	require.NoError(t, err)
+	return ctx, keeper, storeKey
+}
+
+func TestGetLiquidationCandidates(t *testing.T) {
+	tests := map[string]struct {
+		// state
+		subaccounts []types.Subaccount
+
+		// expectations
+		expectedCandidates []types.LiquidationCandidate
+	}{
+		"no subaccounts": {
+			expectedCandidates: []types.LiquidationCandidate{},
+		},
+		"subaccounts without open perpetual positions are not candidates": {
+			subaccounts:        []types.Subaccount{carl_Num0_1000USD},
+			expectedCandidates: []types.LiquidationCandidate{},
+		},
+		"subaccounts with open perpetual positions are candidates": {
+			subaccounts: []types.Subaccount{
+				dave_Num0_1BTC_Long_100000USD_Collateral,
+				bob_Num0_1BTC_Short_10000USD_Collateral,
+				alice_Num0_1BTC_Long_Below_Maintenance_Margin,
+				carl_Num0_1000USD,
+			},
+			expectedCandidates: []types.LiquidationCandidate{
+				{
+					SubaccountId:                 constants.Alice_Num0,
+					NetCollateral:                dtypes.NewInt(4_500_000_000),
+					MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+				},
+				{
+					SubaccountId:                 constants.Bob_Num0,
+					NetCollateral:                dtypes.NewInt(10_000_000_000),
+					MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+				},
+				{
+					SubaccountId:                 constants.Dave_Num0,
+					NetCollateral:                dtypes.NewInt(100_000_000_000),
+					MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, _ := setupLiquidationCandidatesTest(t)
+			for _, subaccount := range tc.subaccounts {
+				keeper.SetSubaccount(ctx, subaccount)
+			}
+
+			candidates, pageRes, err := keeper.GetLiquidationCandidates(ctx, maxLiquidationCandidatesPage)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedCandidates, candidates)
+			require.Empty(t, pageRes.NextKey)
+		})
+	}
+}
+
+func TestGetLiquidationCandidates_Paginated(t *testing.T) {
+	ctx, keeper, _ := setupLiquidationCandidatesTest(t)
+	for _, subaccount := range []types.Subaccount{
+		dave_Num0_1BTC_Long_100000USD_Collateral,
+		bob_Num0_1BTC_Short_10000USD_Collateral,
+		alice_Num0_1BTC_Long_Below_Maintenance_Margin,
+		carl_Num0_1000USD,
+	} {
+		keeper.SetSubaccount(ctx, subaccount)
+	}
+
+	// Each page only contains as many candidates as the page limit.
+	page := &query.PageRequest{Limit: 1}
+	subaccountIds := make([]types.SubaccountId, 0)
+	for i := 0; i < 3; i++ {
+		candidates, pageRes, err := keeper.GetLiquidationCandidates(ctx, page)
+		require.NoError(t, err)
+		require.Len(t, candidates, 1)
+		subaccountIds = append(subaccountIds, candidates[0].SubaccountId)
+		page = &query.PageRequest{Key: pageRes.NextKey, Limit: 1}
+	}
+	require.Empty(t, page.Key)
+
+	// Pages cover all candidates.
+	require.ElementsMatch(
+		t,
+		[]types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0, constants.Dave_Num0},
+		subaccountIds,
+	)
+}
+
+func TestGetLiquidationCandidates_ClosedPositionIsRemoved(t *testing.T) {
+	ctx, keeper, _ := setupLiquidationCandidatesTest(t)
+	keeper.SetSubaccount(ctx, bob_Num0_1BTC_Short_10000USD_Collateral)
+
+	candidates, _, err := keeper.GetLiquidationCandidates(ctx, maxLiquidationCandidatesPage)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+
+	// Closing the perpetual position removes the subaccount from the candidates.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(10_000_000_000)),
+	})
+	candidates, _, err = keeper.GetLiquidationCandidates(ctx, maxLiquidationCandidatesPage)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+}
+
+func TestInitMemStore_LiquidationCandidates(t *testing.T) {
+	ctx, keeper, storeKey := setupLiquidationCandidatesTest(t)
+
+	// Write subaccounts to state directly such that the index is not updated.
+	store := prefix.NewStore(ctx.KVStore(storeKey), []byte(types.SubaccountKeyPrefix))
+	for _, subaccount := range []types.Subaccount{
+		bob_Num0_1BTC_Short_10000USD_Collateral,
+		carl_Num0_1000USD,
+	} {
+		b, err := subaccount.Marshal()
+		require.NoError(t, err)
+		store.Set(subaccount.Id.ToStateKey(), b)
+	}
+	candidates, _, err := keeper.GetLiquidationCandidates(ctx, maxLiquidationCandidatesPage)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+
+	// Initializing the memstore hydrates the index from state.
+	keeper.InitMemStore(ctx)
+	candidates, _, err = keeper.GetLiquidationCandidates(ctx, maxLiquidationCandidatesPage)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]types.LiquidationCandidate{
+			{
+				SubaccountId:                 constants.Bob_Num0,
+				NetCollateral:                dtypes.NewInt(10_000_000_000),
+				MaintenanceMarginRequirement: dtypes.NewInt(5_000_000_000),
+			},
+		},
+		candidates,
+	)
+
+	// Initializing a second time causes a panic.
+	require.Panics(t, func() {
+		keeper.InitMemStore(ctx)
+	})
+}
@@ -17,11 +17,13 @@ import (
 	indexer_manager "github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // SetSubaccount set a specific subaccount in the store from its index.
 // Note that empty subaccounts are removed from state.
+// The index of subaccounts with open perpetual positions is updated accordingly.
 func (k Keeper) SetSubaccount(ctx sdk.Context, subaccount types.Subaccount) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
 	key := subaccount.Id.ToStateKey()
@@ -34,6 +36,8 @@ func (k Keeper) SetSubaccount(ctx sdk.Context, subaccount types.Subaccount) {
 		b := k.cdc.MustMarshal(&subaccount)
 		store.Set(key, b)
 	}
+	k.setOpenPerpetualPositionIndex(ctx, subaccount)
+	k.setPerpetualPositionIndex(ctx, subaccount)
 }
 
 // GetSubaccount returns a subaccount from its index.
@@ -282,6 +286,11 @@ func (k Keeper) UpdateSubaccounts(
 		perpIdToFundingIndex[perp.Params.Id] = perp.FundingIndex
 	}
 
+	// Update the entry notional of the perpetual positions before the updates are applied.
+	if err := k.updatePositionEntryNotionals(ctx, settledUpdates); err != nil {
+		return false, nil, err
+	}
+
 	// Apply the updates to perpetual positions.
 	success, err = UpdatePerpetualPositions(
 		settledUpdates,
@@ -338,6 +347,35 @@ func (k Keeper) UpdateSubaccounts(
 	return success, successPerUpdate, err
 }
 
+// ApplySimulatedSubaccountUpdate settles and applies `update` to its subaccount without checking the
+// collateralization of the subaccount, tracking entry notionals or emitting events. It is intended for
+// simulating hypothetical positions and must only be called on a branched context whose writes are
+// discarded. All perpetuals referenced by `update` must exist.
+func (k Keeper) ApplySimulatedSubaccountUpdate(
+	ctx sdk.Context,
+	update types.Update,
+) error {
+	settledUpdates, _, err := k.getSettledUpdates(ctx, []types.Update{update}, true)
+	if err != nil {
+		return err
+	}
+
+	perpIdToFundingIndex := make(map[uint32]dtypes.SerializableInt)
+	for _, perp := range k.perpetualsKeeper.GetAllPerpetuals(ctx) {
+		perpIdToFundingIndex[perp.Params.Id] = perp.FundingIndex
+	}
+
+	if _, err := UpdatePerpetualPositions(settledUpdates, perpIdToFundingIndex); err != nil {
+		return err
+	}
+	if _, err := UpdateAssetPositions(settledUpdates); err != nil {
+		return err
+	}
+
+	k.SetSubaccount(ctx, settledUpdates[0].SettledSubaccount)
+	return nil
+}
+
 // CanUpdateSubaccounts will validate all `updates` to the relevant subaccounts.
 // The `updates` do not have to contain unique `SubaccountIds`.
 // Each update is considered in isolation. Thus if two updates are provided
@@ -466,6 +504,29 @@ func checkPositionUpdatable(
 	return nil
 }
 
+// hasNegativeNonUsdcAssetBalance returns true if applying the asset updates of the provided
+// settled update results in a negative balance for any asset other than USDC.
+// TODO(DEC-582): remove this check once margin-trading of assets is supported.
+func hasNegativeNonUsdcAssetBalance(u settledUpdate) bool {
+	for _, assetUpdate := range u.AssetUpdates {
+		if assetUpdate.AssetId == assettypes.AssetUsdc.Id {
+			continue
+		}
+
+		bigNewQuantums := new(big.Int).Set(assetUpdate.GetBigQuantums())
+		for _, assetPosition := range u.SettledSubaccount.AssetPositions {
+			if assetPosition.AssetId == assetUpdate.AssetId {
+				bigNewQuantums.Add(bigNewQuantums, assetPosition.GetBigQuantums())
+			}
+		}
+
+		if bigNewQuantums.Sign() < 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // internalCanUpdateSubaccounts will validate all `updates` to the relevant subaccounts.
 // The `updates` do not have to contain `Subaccounts` with unique `SubaccountIds`.
 // Each update is considered in isolation. Thus if two updates are provided
@@ -506,6 +567,14 @@ func (k Keeper) internalCanUpdateSubaccounts(
 			}
 		}
 
+		// Non-USDC assets cannot be margin-traded, so the update must not cause the balance of
+		// any non-USDC asset to become negative.
+		if hasNegativeNonUsdcAssetBalance(u) {
+			success = false
+			successPerUpdate[i] = types.InsufficientAssetBalance
+			continue
+		}
+
 		// Get the new collateralization and margin requirements with the update applied.
 		bigNewNetCollateral,
 			bigNewInitialMargin,
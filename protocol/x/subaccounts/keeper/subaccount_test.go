@@ -2177,6 +2177,7 @@ func TestUpdateSubaccounts(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -2190,6 +2191,9 @@ func TestUpdateSubaccounts(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 
@@ -2723,6 +2727,146 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 			expectedSuccess:          true,
 			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
 		},
+		"spot buy of non-USDC asset with sufficient USDC": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          asstypes.AssetUsdc.Id,
+							BigQuantumsDelta: big.NewInt(-50_000_000_000), // -$50,000
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+		},
+		"spot buy of non-USDC asset with insufficient USDC": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(10_000_000_000)), // $10,000
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          asstypes.AssetUsdc.Id,
+							BigQuantumsDelta: big.NewInt(-50_000_000_000), // -$50,000
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized},
+		},
+		"spot sell of entire non-USDC asset balance": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          asstypes.AssetUsdc.Id,
+							BigQuantumsDelta: big.NewInt(50_000_000_000), // $50,000
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(-100_000_000), // -1 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+		},
+		"spot sell of non-USDC asset exceeding balance": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          asstypes.AssetUsdc.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000_000), // $100,000
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(-200_000_000), // -2 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.InsufficientAssetBalance},
+		},
+		"perpetual buy collateralized by weighted non-USDC asset": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd_90PercentCollateralWeight,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC, // $50,000 notional, $45,000 collateral.
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-25_000_000_000)), // -$25,000
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(0),
+							BigQuantumsDelta: big.NewInt(50_000_000), // .5 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+		},
+		"perpetual buy exceeding collateral weight of non-USDC asset": {
+			assets: []*asstypes.Asset{
+				constants.BtcUsd_90PercentCollateralWeight,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC, // $50,000 notional, $45,000 collateral.
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-50_000_000_000)), // -$50,000
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(0),
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized},
+		},
 		"2 updates, 1 update involves not-updatable perp": {
 			assetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000_000_000_000)),
 			expectedErr:    types.ErrProductPositionNotUpdatable,
@@ -2789,6 +2933,7 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -2811,6 +2956,9 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -3163,7 +3311,9 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 			},
 		},
 		"asset with no balance and update": {
-			expectedErr: asstypes.ErrNotImplementedMulticollateral,
+			expectedNetCollateral:     big.NewInt(0),
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -3178,7 +3328,9 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 			},
 		},
 		"single positive asset": {
-			expectedErr: asstypes.ErrNotImplementedMulticollateral,
+			expectedNetCollateral:     big.NewInt(0),
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -3186,6 +3338,17 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 				&constants.Long_Asset_1BTC,
 			},
 		},
+		"single positive asset with collateral weight": {
+			expectedNetCollateral:     big.NewInt(45_000_000_000), // 90% of $50,000
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
+			assets: []*asstypes.Asset{
+				constants.BtcUsd_90PercentCollateralWeight,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+		},
 		"single negative asset": {
 			expectedErr: asstypes.ErrNotImplementedMargin,
 			assets: []*asstypes.Asset{
@@ -3214,6 +3377,7 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -3227,6 +3391,9 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.InsuranceFundScope,
 				)
 				require.NoError(t, err)
 			}
@@ -10,7 +10,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
-	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -32,21 +32,16 @@ func (k Keeper) getValidSubaccountUpdatesForTransfer(
 		bigBalanceDelta.Neg(bigBalanceDelta)
 	}
 
-	if assetId == 0 {
-		updates = []types.Update{
-			{
-				SubaccountId: subaccountId,
-				AssetUpdates: []types.AssetUpdate{
-					{
-						AssetId:          assettypes.AssetUsdc.Id,
-						BigQuantumsDelta: bigBalanceDelta,
-					},
+	updates = []types.Update{
+		{
+			SubaccountId: subaccountId,
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assetId,
+					BigQuantumsDelta: bigBalanceDelta,
 				},
 			},
-		}
-	} else {
-		// TODO(DEC-715): Support non-USDC assets.
-		return nil, types.ErrAssetTransferThroughBankNotImplemented
+		},
 	}
 
 	success, successPerUpdate, err := k.CanUpdateSubaccounts(ctx, updates)
@@ -86,6 +81,8 @@ func (k Keeper) applyValidSubaccountUpdateForTransfer(
 // fails. Otherwise, deducts the asset quantums from the subaccount, translates the
 // `assetId` and `quantums` into a `sdk.Coin`, and calls
 // `bankKeeper.SendCoinsFromModuleToModule()`.
+// Any asset held by the subaccount can be transferred, as long as the subaccount remains
+// collateralized after the transfer.
 func (k Keeper) TransferFundsFromSubaccountToModule(
 	ctx sdk.Context,
 	fromSubaccountId types.SubaccountId,
@@ -93,11 +90,6 @@ func (k Keeper) TransferFundsFromSubaccountToModule(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
-	}
-
 	if quantums.Sign() <= 0 {
 		return errorsmod.Wrap(types.ErrAssetTransferQuantumsNotPositive, lib.UintToString(assetId))
 	}
@@ -147,6 +139,7 @@ func (k Keeper) TransferFundsFromSubaccountToModule(
 // TransferFundsFromModuleToSubaccount returns an error if the call to `k.CanUpdateSubaccounts()`
 // fails. Otherwise, increases the asset quantums in the subaccount, translates the
 // `assetId` and `quantums` into a `sdk.Coin`, and calls `bankKeeper.SendCoinsFromModuleToModule()`.
+// Any asset that exists in the assets module can be transferred.
 func (k Keeper) TransferFundsFromModuleToSubaccount(
 	ctx sdk.Context,
 	fromModule string,
@@ -154,11 +147,6 @@ func (k Keeper) TransferFundsFromModuleToSubaccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
-	}
-
 	if quantums.Sign() <= 0 {
 		return errorsmod.Wrap(types.ErrAssetTransferQuantumsNotPositive, lib.UintToString(assetId))
 	}
@@ -205,6 +193,7 @@ func (k Keeper) TransferFundsFromModuleToSubaccount(
 // fails. Otherwise, increases the asset quantums in the subaccount, translates the
 // `assetId` and `quantums` into a `sdk.Coin`, and calls
 // `bankKeeper.SendCoinsFromAccountToModule()`.
+// Only USDC and assets with a non-zero collateral weight can be deposited.
 // TODO(CORE-168): Change function interface to accept `denom` and `amount` instead of `assetId` and `quantums`.
 func (k Keeper) DepositFundsFromAccountToSubaccount(
 	ctx sdk.Context,
@@ -213,9 +202,13 @@ func (k Keeper) DepositFundsFromAccountToSubaccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	asset, exists := k.assetsKeeper.GetAsset(ctx, assetId)
+	if !exists {
+		return errorsmod.Wrap(assettypes.ErrAssetDoesNotExist, lib.UintToString(assetId))
+	}
+
+	if !asset.IsCollateral() {
+		return errorsmod.Wrap(assettypes.ErrAssetNotCollateral, lib.UintToString(assetId))
 	}
 
 	if quantums.Sign() <= 0 {
@@ -263,6 +256,8 @@ func (k Keeper) DepositFundsFromAccountToSubaccount(
 // WithdrawFundsFromSubaccountToAccount returns an error if the call to `k.CanUpdateSubaccounts()`
 // fails. Otherwise, deducts the asset quantums from the subaccount, translates the
 // `assetId` and `quantums` into a `sdk.Coin`, and calls `bankKeeper.SendCoinsFromModuleToAccount()`.
+// Any asset held by the subaccount can be withdrawn, as long as the subaccount remains
+// collateralized after the withdrawal.
 func (k Keeper) WithdrawFundsFromSubaccountToAccount(
 	ctx sdk.Context,
 	fromSubaccountId types.SubaccountId,
@@ -270,11 +265,6 @@ func (k Keeper) WithdrawFundsFromSubaccountToAccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
-	}
-
 	if quantums.Sign() <= 0 {
 		return errorsmod.Wrap(types.ErrAssetTransferQuantumsNotPositive, lib.UintToString(assetId))
 	}
@@ -320,16 +310,12 @@ func (k Keeper) WithdrawFundsFromSubaccountToAccount(
 // TransferFeesToFeeCollectorModule translates the assetId and quantums into a sdk.Coin,
 // and moves the funds from subaccounts module to the `fee_collector` module account by calling
 // bankKeeper.SendCoinsFromModuleToModule(). Does not change any individual subaccount state.
+// Fees can be paid in any asset that exists in the assets module.
 func (k Keeper) TransferFeesToFeeCollectorModule(
 	ctx sdk.Context,
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
-	}
-
 	if quantums.Sign() == 0 {
 		return nil
 	}
@@ -364,18 +350,22 @@ func (k Keeper) TransferFeesToFeeCollectorModule(
 	return nil
 }
 
-// TransferInsuranceFundPayments transfers funds in and out of the insurance fund to the subaccounts
-// module by calling `bankKeeper.SendCoinsFromModuleToModule`.
+// TransferInsuranceFundPayments transfers funds in and out of the insurance fund that backs the
+// perpetual to the subaccounts module by calling `bankKeeper.SendCoinsFromModuleToModule` for the
+// global insurance fund, and `bankKeeper.SendCoinsFromModuleToAccount` or
+// `bankKeeper.SendCoinsFromAccountToModule` for insurance funds scoped to a perpetual or a liquidity tier.
 // This function transfers funds
 //   - from the insurance fund to the subaccounts module when `insuranceFundDelta` is negative.
 //   - from the subaccounts module to the insurance fund when `insuranceFundDelta` is positive.
 //   - does nothing if `insuranceFundDelta` is zero.
 //
 // If the sender account does not have enough balance for the transfer, an error is returned.
+// An error is also returned if the perpetual does not exist.
 // Note this function does not change any individual subaccount state.
 func (k Keeper) TransferInsuranceFundPayments(
 	ctx sdk.Context,
 	insuranceFundDelta *big.Int,
+	perpetualId uint32,
 ) error {
 	if insuranceFundDelta.Sign() == 0 {
 		return nil
@@ -391,10 +381,37 @@ func (k Keeper) TransferInsuranceFundPayments(
 		panic(err)
 	}
 
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+
+	// Insurance funds scoped to a perpetual or a liquidity tier are not registered module accounts, so
+	// coins are sent to and from their addresses directly.
+	insuranceFundName := perpetual.Params.GetInsuranceFundName()
+	if insuranceFundName != perptypes.InsuranceFundName {
+		insuranceFundAddress := perpetual.Params.GetInsuranceFundModuleAddress()
+		if insuranceFundDelta.Sign() < 0 {
+			// Insurance fund needs to cover losses from liquidations.
+			return k.bankKeeper.SendCoinsFromAccountToModule(
+				ctx,
+				insuranceFundAddress,
+				types.ModuleName,
+				[]sdk.Coin{coinToTransfer},
+			)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ModuleName,
+			insuranceFundAddress,
+			[]sdk.Coin{coinToTransfer},
+		)
+	}
+
 	// Determine the sender and receiver.
 	// Send coins from `subaccounts` to the `insurance_fund` module account by default.
 	fromModule := types.ModuleName
-	toModule := clobtypes.InsuranceFundName
+	toModule := insuranceFundName
 
 	if insuranceFundDelta.Sign() < 0 {
 		// Insurance fund needs to cover losses from liquidations.
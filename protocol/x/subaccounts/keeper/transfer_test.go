@@ -12,6 +12,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	auth_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/auth"
 	bank_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/bank"
@@ -20,6 +21,7 @@ import (
 	sample_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
 	asstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/stretchr/testify/require"
 )
@@ -126,9 +128,49 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			),
 			expectedAccAddressBalance: big.NewInt(0),
 		},
+		"DepositFundsFromAccountToSubaccount: send collateral asset from account to subaccount": {
+			testTransferFundToAccount:  false,
+			asset:                      *constants.BtcUsd_90PercentCollateralWeight,
+			subaccountModuleAccBalance: big.NewInt(200),
+			accAddressBalance:          big.NewInt(2000),
+			quantums:                   big.NewInt(500),
+			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(150)),
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(150),
+				},
+				{
+					AssetId:  constants.BtcUsd_90PercentCollateralWeight.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+			},
+			expectedQuoteBalance:                big.NewInt(150),
+			expectedSubaccountsModuleAccBalance: big.NewInt(700),  // 200 + 500
+			expectedAccAddressBalance:           big.NewInt(1500), // 2000 - 500
+		},
+		"WithdrawFundsFromSubaccountToAccount: send non-USDC asset from subaccount to an account address": {
+			testTransferFundToAccount:  true,
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(600),
+			accAddressBalance:          big.NewInt(2500),
+			quantums:                   big.NewInt(500),
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(150),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+			},
+			expectedAssetPositions:              keepertest.CreateUsdcAssetPosition(big.NewInt(150)),
+			expectedQuoteBalance:                big.NewInt(150),
+			expectedSubaccountsModuleAccBalance: big.NewInt(100),  // 600 - 500
+			expectedAccAddressBalance:           big.NewInt(3000), // 2500 + 500
+		},
 
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
 		// TODO(CORE-169): Add tests for when the input quantums is rounded down to
 		// a integer denom amount.
 	}
@@ -183,6 +225,7 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 				tc.asset.HasMarket,
 				tc.asset.MarketId,
 				tc.asset.AtomicResolution,
+				tc.asset.CollateralWeightPpm,
 			)
 			require.NoError(t, err)
 
@@ -303,14 +346,14 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedErr:                types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"WithdrawFundsFromSubaccountToAccount: do not support assets other than USDC": {
+		"WithdrawFundsFromSubaccountToAccount: subaccount does not have enough balance of a non-USDC asset": {
 			testTransferFundToAccount:  true,
 			accAddressBalance:          big.NewInt(500),
 			asset:                      *constants.BtcUsd,
 			subaccountModuleAccBalance: big.NewInt(500),
 			quantums:                   big.NewInt(500),
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                types.ErrFailedToUpdateSubaccounts,
 		},
 		"WithdrawFundsFromSubaccountToAccount: asset ID doesn't exist": {
 			testTransferFundToAccount:  true,
@@ -340,14 +383,14 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedErr:                types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"DepositFundsFromAccountToSubaccount: do not support assets other than USDC": {
+		"DepositFundsFromAccountToSubaccount: asset cannot be used as collateral": {
 			testTransferFundToAccount:  false,
 			accAddressBalance:          big.NewInt(500),
 			asset:                      *constants.BtcUsd,
 			subaccountModuleAccBalance: big.NewInt(500),
 			quantums:                   big.NewInt(500),
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                asstypes.ErrAssetNotCollateral,
 		},
 		"DepositFundsFromAccountToSubaccount: failure, asset ID doesn't exist": {
 			testTransferFundToAccount:  false,
@@ -359,8 +402,6 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedErr:                asstypes.ErrAssetDoesNotExist,
 		},
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
 	}
 
 	for name, tc := range tests {
@@ -420,6 +461,7 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -559,18 +601,27 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 			expectedOtherModuleAccBalance:       big.NewInt(2500),
 			expectedErr:                         types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"TransferFundsFromSubaccountToModule: failure, do not support assets other than USDC": {
-			testTransferFundToModule:            true,
-			otherModuleName:                     authtypes.FeeCollectorName,
-			otherModuleAccBalance:               big.NewInt(500),
-			asset:                               *constants.BtcUsd,
-			subaccountModuleAccBalance:          big.NewInt(500),
-			quantums:                            big.NewInt(500),
-			assetPositions:                      keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                         types.ErrAssetTransferThroughBankNotImplemented,
+		"TransferFundsFromSubaccountToModule: successfully send non-USDC asset to fee-collector module account": {
+			testTransferFundToModule:   true,
+			otherModuleName:            authtypes.FeeCollectorName,
+			otherModuleAccBalance:      big.NewInt(500),
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(600),
+			quantums:                   big.NewInt(500),
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+			},
+			expectedAssetPositions:              keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedQuoteBalance:                big.NewInt(500),
-			expectedSubaccountsModuleAccBalance: big.NewInt(500),
-			expectedOtherModuleAccBalance:       big.NewInt(500),
+			expectedSubaccountsModuleAccBalance: big.NewInt(100),  // 600 - 500
+			expectedOtherModuleAccBalance:       big.NewInt(1000), // 500 + 500
 		},
 		"TransferFundsFromSubaccountToModule: failure, asset ID doesn't exist": {
 			testTransferFundToModule:            true,
@@ -624,18 +675,27 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 			expectedOtherModuleAccBalance:       big.NewInt(2500),
 			expectedErr:                         types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"TransferFundsFromModuleToSubaccount: failure, do not support assets other than USDC": {
-			testTransferFundToModule:            false,
-			otherModuleName:                     authtypes.FeeCollectorName,
-			otherModuleAccBalance:               big.NewInt(500),
-			asset:                               *constants.BtcUsd,
-			subaccountModuleAccBalance:          big.NewInt(500),
-			quantums:                            big.NewInt(500),
-			assetPositions:                      keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                         types.ErrAssetTransferThroughBankNotImplemented,
+		"TransferFundsFromModuleToSubaccount: successfully send non-USDC asset from fee-collector module account": {
+			testTransferFundToModule:   false,
+			otherModuleName:            authtypes.FeeCollectorName,
+			otherModuleAccBalance:      big.NewInt(2000),
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(200),
+			quantums:                   big.NewInt(500),
+			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+			},
 			expectedQuoteBalance:                big.NewInt(500),
-			expectedSubaccountsModuleAccBalance: big.NewInt(500),
-			expectedOtherModuleAccBalance:       big.NewInt(500),
+			expectedSubaccountsModuleAccBalance: big.NewInt(700),  // 200 + 500
+			expectedOtherModuleAccBalance:       big.NewInt(1500), // 2000 - 500
 		},
 		"TransferFundsFromModuleToSubaccount: failure, asset ID doesn't exist": {
 			testTransferFundToModule:            false,
@@ -651,8 +711,6 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 			expectedSubaccountsModuleAccBalance: big.NewInt(500),
 			expectedOtherModuleAccBalance:       big.NewInt(500),
 		},
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
 	}
 
 	for name, tc := range tests {
@@ -722,6 +780,7 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -848,14 +907,13 @@ func TestTransferFeesToFeeCollectorModule(t *testing.T) {
 			expectedSubaccountsModuleAccBalance: big.NewInt(500),
 			expectedFeeModuleAccBalance:         big.NewInt(1500),
 		},
-		"failure - asset other than USDC not supported": {
+		"success - transfer asset other than USDC": {
 			feeModuleAccBalance:                 big.NewInt(1500),
 			asset:                               *constants.BtcUsd,
 			subaccountModuleAccBalance:          big.NewInt(500),
 			quantums:                            big.NewInt(500),
-			expectedErr:                         types.ErrAssetTransferThroughBankNotImplemented,
-			expectedSubaccountsModuleAccBalance: big.NewInt(500),
-			expectedFeeModuleAccBalance:         big.NewInt(1500),
+			expectedSubaccountsModuleAccBalance: big.NewInt(0),
+			expectedFeeModuleAccBalance:         big.NewInt(2000),
 		},
 		"success - transfer quantums is negative": {
 			feeModuleAccBalance:                 big.NewInt(1500),
@@ -865,8 +923,6 @@ func TestTransferFeesToFeeCollectorModule(t *testing.T) {
 			expectedSubaccountsModuleAccBalance: big.NewInt(1000),
 			expectedFeeModuleAccBalance:         big.NewInt(1000),
 		},
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
 	}
 
 	for name, tc := range tests {
@@ -934,6 +990,7 @@ func TestTransferFeesToFeeCollectorModule(t *testing.T) {
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -978,7 +1035,8 @@ func TestTransferFeesToFeeCollectorModule(t *testing.T) {
 
 func TestTransferInsuranceFundPayments(t *testing.T) {
 	tests := map[string]struct {
-		skipSetUpUsdc bool
+		skipSetUpUsdc      bool
+		insuranceFundScope perptypes.InsuranceFundScope
 
 		// Module account state.
 		subaccountModuleAccBalance int64
@@ -1030,6 +1088,31 @@ func TestTransferInsuranceFundPayments(t *testing.T) {
 			expectedInsuranceFundBalance:        300,
 			expectedErr:                         sdkerrors.ErrInsufficientFunds,
 		},
+		"success - send to insurance fund scoped to the perpetual": {
+			insuranceFundScope:                  perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+			insuranceFundBalance:                2500,
+			subaccountModuleAccBalance:          600,
+			quantums:                            big.NewInt(500),
+			expectedSubaccountsModuleAccBalance: 100,  // 600 - 500
+			expectedInsuranceFundBalance:        3000, // 2500 + 500
+		},
+		"success - send from insurance fund scoped to the liquidity tier": {
+			insuranceFundScope:                  perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_LIQUIDITY_TIER,
+			insuranceFundBalance:                2500,
+			subaccountModuleAccBalance:          600,
+			quantums:                            big.NewInt(-500),
+			expectedSubaccountsModuleAccBalance: 1100, // 600 + 500
+			expectedInsuranceFundBalance:        2000, // 2500 - 500
+		},
+		"failure - insurance fund scoped to the perpetual does not have sufficient funds": {
+			insuranceFundScope:                  perptypes.InsuranceFundScope_INSURANCE_FUND_SCOPE_PERPETUAL,
+			insuranceFundBalance:                300,
+			subaccountModuleAccBalance:          2500,
+			quantums:                            big.NewInt(-500),
+			expectedSubaccountsModuleAccBalance: 2500,
+			expectedInsuranceFundBalance:        300,
+			expectedErr:                         sdkerrors.ErrInsufficientFunds,
+		},
 		"panics - asset doesn't exist": {
 			insuranceFundBalance:                1500,
 			skipSetUpUsdc:                       true,
@@ -1044,8 +1127,25 @@ func TestTransferInsuranceFundPayments(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx, keeper, pricesKeeper, _, accountKeeper, bankKeeper, assetsKeeper, _ := keepertest.SubaccountsKeepers(t, true)
+			ctx, keeper, pricesKeeper, perpetualsKeeper, accountKeeper, bankKeeper, assetsKeeper, _ :=
+				keepertest.SubaccountsKeepers(t, true)
 			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			perpetual, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.FundingRateBounds,
+				p.Params.InterestRatePpm,
+				tc.insuranceFundScope,
+			)
+			require.NoError(t, err)
 
 			// Set up Subaccounts module account.
 			auth_testutil.CreateTestModuleAccount(ctx, accountKeeper, types.ModuleName, []string{})
@@ -1065,9 +1165,9 @@ func TestTransferInsuranceFundPayments(t *testing.T) {
 
 			// Mint asset in the receipt/sender module account for transfer.
 			if tc.insuranceFundBalance > 0 {
-				err := bank_testutil.FundModuleAccount(
+				err := bank_testutil.FundAccount(
 					ctx,
-					clobtypes.InsuranceFundName,
+					perpetual.Params.GetInsuranceFundModuleAddress(),
 					sdk.Coins{
 						sdk.NewInt64Coin(constants.Usdc.Denom, tc.insuranceFundBalance),
 					},
@@ -1100,18 +1200,18 @@ func TestTransferInsuranceFundPayments(t *testing.T) {
 						tc.expectedErr.Error(),
 						func() {
 							//nolint:errcheck
-							keeper.TransferInsuranceFundPayments(ctx, tc.quantums)
+							keeper.TransferInsuranceFundPayments(ctx, tc.quantums, perpetual.Params.Id)
 						},
 					)
 				} else {
 					require.ErrorIs(
 						t,
-						keeper.TransferInsuranceFundPayments(ctx, tc.quantums),
+						keeper.TransferInsuranceFundPayments(ctx, tc.quantums, perpetual.Params.Id),
 						tc.expectedErr,
 					)
 				}
 			} else {
-				require.NoError(t, keeper.TransferInsuranceFundPayments(ctx, tc.quantums))
+				require.NoError(t, keeper.TransferInsuranceFundPayments(ctx, tc.quantums, perpetual.Params.Id))
 			}
 
 			// Check the subaccount module balance.
@@ -1128,7 +1228,7 @@ func TestTransferInsuranceFundPayments(t *testing.T) {
 
 			// Check the fee module account balance has been updated as expected.
 			toModuleBalance := bankKeeper.GetBalance(
-				ctx, authtypes.NewModuleAddress(clobtypes.InsuranceFundName),
+				ctx, perpetual.Params.GetInsuranceFundModuleAddress(),
 				constants.Usdc.Denom,
 			)
 			require.Equal(t,
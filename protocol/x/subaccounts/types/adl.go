@@ -0,0 +1,82 @@
+package types
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// MaxAdlRank is the maximum rank reported for an open perpetual position. Positions that rank at or
+// after `MaxAdlRank` are reported with rank `MaxAdlRank`, which bounds the number of positions scored
+// to rank a position.
+const MaxAdlRank uint32 = 10_000
+
+// AdlPosition is an open perpetual position that can be used to offset deleveraged positions
+// on the opposite side of the same perpetual.
+type AdlPosition struct {
+	// The id of the subaccount holding the position.
+	SubaccountId SubaccountId
+	// The size of the position in base quantums.
+	Quantums *big.Int
+	// The unrealized PnL of the position in quote quantums.
+	UnrealizedPnl *big.Int
+	// The notional of the position in quote quantums at the current oracle price.
+	Notional *big.Int
+	// The net collateral of the subaccount in quote quantums.
+	NetCollateral *big.Int
+}
+
+// GetAdlScore returns the unrealized PnL of the position multiplied by the leverage of the
+// subaccount, where leverage is the absolute notional of the position divided by the net
+// collateral of the subaccount. Returns nil if the subaccount does not have positive net
+// collateral, since its leverage is unbounded.
+func (p AdlPosition) GetAdlScore() *big.Rat {
+	if p.NetCollateral.Sign() <= 0 {
+		return nil
+	}
+	return new(big.Rat).SetFrac(
+		new(big.Int).Mul(p.UnrealizedPnl, new(big.Int).Abs(p.Notional)),
+		p.NetCollateral,
+	)
+}
+
+// CompareAdlScores returns a positive number if a position with ADL score `a` ranks before a position
+// with ADL score `b`, a negative number if it ranks after, and zero if the scores are equal. Higher
+// scores rank first, and missing scores rank after all other scores.
+func CompareAdlScores(a *big.Rat, b *big.Rat) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+	return a.Cmp(b)
+}
+
+// SortAdlPositions sorts positions of a single perpetual and side in descending order of ADL
+// score such that the most profitable and most leveraged positions come first. Positions without
+// a score are sorted last. The sort is stable so that ties are broken by the original order of
+// `positions`.
+func SortAdlPositions(positions []AdlPosition) {
+	scores := make(map[SubaccountId]*big.Rat, len(positions))
+	for _, position := range positions {
+		scores[position.SubaccountId] = position.GetAdlScore()
+	}
+	sort.SliceStable(positions, func(i, j int) bool {
+		return CompareAdlScores(scores[positions[i].SubaccountId], scores[positions[j].SubaccountId]) > 0
+	})
+}
+
+// GetAdlRankPercentilePpm returns the percentage of `numPositions` positions that rank at or
+// after the position with the 1-based `rank`, in parts per million.
+func GetAdlRankPercentilePpm(rank uint32, numPositions uint32) uint32 {
+	if rank == 0 || rank > numPositions {
+		return 0
+	}
+	return uint32(uint64(numPositions-rank+1) * uint64(lib.OneMillion) / uint64(numPositions))
+}
@@ -0,0 +1,178 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAdlScore(t *testing.T) {
+	tests := map[string]struct {
+		unrealizedPnl int64
+		notional      int64
+		netCollateral int64
+		expectedScore *big.Rat
+	}{
+		"Profitable long": {
+			unrealizedPnl: 1_000,
+			notional:      20_000,
+			netCollateral: 10_000,
+			expectedScore: big.NewRat(2_000, 1),
+		},
+		"Profitable short": {
+			unrealizedPnl: 1_000,
+			notional:      -20_000,
+			netCollateral: 10_000,
+			expectedScore: big.NewRat(2_000, 1),
+		},
+		"Unprofitable position": {
+			unrealizedPnl: -1_000,
+			notional:      5_000,
+			netCollateral: 10_000,
+			expectedScore: big.NewRat(-500, 1),
+		},
+		"Zero net collateral": {
+			unrealizedPnl: 1_000,
+			notional:      5_000,
+			netCollateral: 0,
+		},
+		"Negative net collateral": {
+			unrealizedPnl: 1_000,
+			notional:      5_000,
+			netCollateral: -1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			position := types.AdlPosition{
+				UnrealizedPnl: big.NewInt(tc.unrealizedPnl),
+				Notional:      big.NewInt(tc.notional),
+				NetCollateral: big.NewInt(tc.netCollateral),
+			}
+			score := position.GetAdlScore()
+			if tc.expectedScore == nil {
+				require.Nil(t, score)
+			} else {
+				require.Zero(t, tc.expectedScore.Cmp(score))
+			}
+		})
+	}
+}
+
+func TestSortAdlPositions(t *testing.T) {
+	newPosition := func(id types.SubaccountId, unrealizedPnl int64, netCollateral int64) types.AdlPosition {
+		return types.AdlPosition{
+			SubaccountId:  id,
+			UnrealizedPnl: big.NewInt(unrealizedPnl),
+			Notional:      big.NewInt(10_000),
+			NetCollateral: big.NewInt(netCollateral),
+		}
+	}
+
+	positions := []types.AdlPosition{
+		newPosition(constants.Alice_Num0, 1_000, -1),   // No score.
+		newPosition(constants.Alice_Num1, -1_000, 100), // Score of -100,000.
+		newPosition(constants.Bob_Num0, 1_000, 10_000), // Score of 1,000.
+		newPosition(constants.Carl_Num0, 1_000, 1_000), // Score of 10,000.
+		newPosition(constants.Dave_Num0, 0, 1_000),     // Score of 0.
+		newPosition(constants.Dave_Num1, 1_000, 0),     // No score.
+		newPosition(constants.Bob_Num1, 0, 10),         // Score of 0.
+	}
+	types.SortAdlPositions(positions)
+
+	sortedIds := make([]types.SubaccountId, 0, len(positions))
+	for _, position := range positions {
+		sortedIds = append(sortedIds, position.SubaccountId)
+	}
+	require.Equal(
+		t,
+		[]types.SubaccountId{
+			constants.Carl_Num0,
+			constants.Bob_Num0,
+			constants.Dave_Num0,
+			constants.Bob_Num1,
+			constants.Alice_Num1,
+			constants.Alice_Num0,
+			constants.Dave_Num1,
+		},
+		sortedIds,
+	)
+}
+
+func TestCompareAdlScores(t *testing.T) {
+	// Scores in ranking order.
+	scores := []*big.Rat{
+		new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 100)),
+		big.NewRat(3, 2),
+		big.NewRat(1, 1),
+		big.NewRat(0, 1),
+		big.NewRat(-1, 1),
+		new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(-1), 100)),
+		nil,
+	}
+	for i := range scores {
+		for j := range scores {
+			expected := 0
+			if i < j {
+				expected = 1
+			} else if i > j {
+				expected = -1
+			}
+			require.Equal(
+				t,
+				expected,
+				types.CompareAdlScores(scores[i], scores[j]),
+				"score %v, score %v",
+				scores[i],
+				scores[j],
+			)
+		}
+	}
+}
+
+func TestGetAdlRankPercentilePpm(t *testing.T) {
+	tests := map[string]struct {
+		rank               uint32
+		numPositions       uint32
+		expectedPercentile uint32
+	}{
+		"First of one": {
+			rank:               1,
+			numPositions:       1,
+			expectedPercentile: 1_000_000,
+		},
+		"First of three": {
+			rank:               1,
+			numPositions:       3,
+			expectedPercentile: 1_000_000,
+		},
+		"Second of three": {
+			rank:               2,
+			numPositions:       3,
+			expectedPercentile: 666_666,
+		},
+		"Last of three": {
+			rank:               3,
+			numPositions:       3,
+			expectedPercentile: 333_333,
+		},
+		"Zero rank": {
+			rank:               0,
+			numPositions:       3,
+			expectedPercentile: 0,
+		},
+		"Rank exceeds number of positions": {
+			rank:               4,
+			numPositions:       3,
+			expectedPercentile: 0,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedPercentile, types.GetAdlRankPercentilePpm(tc.rank, tc.numPositions))
+		})
+	}
+}
@@ -36,6 +36,4 @@ var (
 	// 500 - 599: transfer related.
 	ErrAssetTransferQuantumsNotPositive = errorsmod.Register(
 		ModuleName, 500, "asset transfer quantums is not positive")
-	ErrAssetTransferThroughBankNotImplemented = errorsmod.Register(
-		ModuleName, 501, "asset transfer (other than USDC) through the bank module is not implemented")
 )
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 )
 
@@ -39,6 +40,13 @@ type ProductKeeper interface {
 
 type AssetsKeeper interface {
 	ProductKeeper
+	GetAsset(
+		ctx sdk.Context,
+		id uint32,
+	) (
+		asset assettypes.Asset,
+		exists bool,
+	)
 	ConvertAssetToCoin(
 		ctx sdk.Context,
 		assetId uint32,
@@ -62,6 +70,7 @@ type PerpetualsKeeper interface {
 		newFundingIndex *big.Int,
 		err error,
 	)
+	GetPerpetual(ctx sdk.Context, id uint32) (perptypes.Perpetual, error)
 	GetAllPerpetuals(ctx sdk.Context) []perptypes.Perpetual
 }
 
@@ -7,10 +7,32 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_" + ModuleName
 )
 
 // State
 const (
 	// SubaccountKeyPrefix is the prefix to retrieve all Subaccount
 	SubaccountKeyPrefix = "SA:"
+
+	// PositionEntryNotionalKeyPrefix is the prefix to retrieve the entry notional of
+	// all perpetual positions.
+	PositionEntryNotionalKeyPrefix = "PosEntry:"
+)
+
+// Memstore
+const (
+	// OpenPerpetualPositionKeyPrefix is the prefix of the in-memory index of subaccounts
+	// with at least one open perpetual position.
+	OpenPerpetualPositionKeyPrefix = "OpenPerp:"
+
+	// PerpetualPositionKeyPrefix is the prefix of the in-memory index of open perpetual
+	// positions by perpetual and side.
+	PerpetualPositionKeyPrefix = "PerpPos:"
+
+	// SubaccountPerpetualPositionKeyPrefix is the prefix of the in-memory mapping from open
+	// perpetual positions of subaccounts to their keys in the index of open perpetual positions.
+	SubaccountPerpetualPositionKeyPrefix = "SaPerpPos:"
 )
@@ -10,8 +10,16 @@ import (
 func TestModuleKeys(t *testing.T) {
 	require.Equal(t, "subaccounts", types.ModuleName)
 	require.Equal(t, "subaccounts", types.StoreKey)
+	require.Equal(t, "mem_subaccounts", types.MemStoreKey)
 }
 
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "SA:", types.SubaccountKeyPrefix)
+	require.Equal(t, "PosEntry:", types.PositionEntryNotionalKeyPrefix)
+}
+
+func TestMemStoreKeys(t *testing.T) {
+	require.Equal(t, "OpenPerp:", types.OpenPerpetualPositionKeyPrefix)
+	require.Equal(t, "PerpPos:", types.PerpetualPositionKeyPrefix)
+	require.Equal(t, "SaPerpPos:", types.SubaccountPerpetualPositionKeyPrefix)
 }
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"math/big"
+)
+
+// MaxLiquidationCandidatesPageLimit is the maximum number of liquidation candidates that can be
+// requested in a single page.
+const MaxLiquidationCandidatesPageLimit = 1_000
+
+// GetBigDistanceToMaintenanceMargin returns the net collateral of the candidate in excess of its
+// maintenance margin requirement. The distance is negative if the net collateral is below the
+// maintenance margin requirement.
+func (c *LiquidationCandidate) GetBigDistanceToMaintenanceMargin() *big.Int {
+	return new(big.Int).Sub(c.NetCollateral.BigInt(), c.MaintenanceMarginRequirement.BigInt())
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBigDistanceToMaintenanceMargin(t *testing.T) {
+	tests := map[string]struct {
+		netCollateral                int64
+		maintenanceMarginRequirement int64
+		expectedDistance             *big.Int
+	}{
+		"Above maintenance margin": {
+			netCollateral:                10_000,
+			maintenanceMarginRequirement: 5_000,
+			expectedDistance:             big.NewInt(5_000),
+		},
+		"At maintenance margin": {
+			netCollateral:                5_000,
+			maintenanceMarginRequirement: 5_000,
+			expectedDistance:             big.NewInt(0),
+		},
+		"Below maintenance margin": {
+			netCollateral:                4_500,
+			maintenanceMarginRequirement: 5_000,
+			expectedDistance:             big.NewInt(-500),
+		},
+		"Negative net collateral": {
+			netCollateral:                -1_000,
+			maintenanceMarginRequirement: 5_000,
+			expectedDistance:             big.NewInt(-6_000),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			candidate := types.LiquidationCandidate{
+				NetCollateral:                dtypes.NewInt(tc.netCollateral),
+				MaintenanceMarginRequirement: dtypes.NewInt(tc.maintenanceMarginRequirement),
+			}
+			require.Zero(t, tc.expectedDistance.Cmp(candidate.GetBigDistanceToMaintenanceMargin()))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -227,11 +228,354 @@ func (m *QuerySubaccountAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryLiquidationCandidatesRequest is request type for the
+// LiquidationCandidates RPC method.
+type QueryLiquidationCandidatesRequest struct {
+	// Key-based pagination over subaccounts with open perpetual positions. The
+	// page limit defaults to 100 and cannot exceed 1000. Offsets and total
+	// counts are not supported.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiquidationCandidatesRequest) Reset()         { *m = QueryLiquidationCandidatesRequest{} }
+func (m *QueryLiquidationCandidatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidationCandidatesRequest) ProtoMessage()    {}
+func (*QueryLiquidationCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{4}
+}
+func (m *QueryLiquidationCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidationCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidationCandidatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidationCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidationCandidatesRequest.Merge(m, src)
+}
+func (m *QueryLiquidationCandidatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidationCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidationCandidatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidationCandidatesRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidationCandidatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLiquidationCandidatesResponse is response type for the
+// LiquidationCandidates RPC method.
+type QueryLiquidationCandidatesResponse struct {
+	// Candidates of the page in ascending order of net collateral in excess of
+	// the maintenance margin requirement.
+	Candidates []LiquidationCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiquidationCandidatesResponse) Reset()         { *m = QueryLiquidationCandidatesResponse{} }
+func (m *QueryLiquidationCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidationCandidatesResponse) ProtoMessage()    {}
+func (*QueryLiquidationCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{5}
+}
+func (m *QueryLiquidationCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidationCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidationCandidatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidationCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidationCandidatesResponse.Merge(m, src)
+}
+func (m *QueryLiquidationCandidatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidationCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidationCandidatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidationCandidatesResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidationCandidatesResponse) GetCandidates() []LiquidationCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *QueryLiquidationCandidatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// LiquidationCandidate is a subaccount with at least one open perpetual
+// position along with its collateralization at current oracle prices.
+type LiquidationCandidate struct {
+	// The id of the subaccount.
+	SubaccountId SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The net collateral of the subaccount in quote quantums.
+	NetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	// The maintenance margin requirement of the subaccount in quote quantums.
+	MaintenanceMarginRequirement github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin_requirement"`
+}
+
+func (m *LiquidationCandidate) Reset()         { *m = LiquidationCandidate{} }
+func (m *LiquidationCandidate) String() string { return proto.CompactTextString(m) }
+func (*LiquidationCandidate) ProtoMessage()    {}
+func (*LiquidationCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{6}
+}
+func (m *LiquidationCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidationCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationCandidate.Merge(m, src)
+}
+func (m *LiquidationCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationCandidate proto.InternalMessageInfo
+
+func (m *LiquidationCandidate) GetSubaccountId() SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return SubaccountId{}
+}
+
+// QueryAdlRanksRequest is request type for the AdlRanks RPC method.
+type QueryAdlRanksRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QueryAdlRanksRequest) Reset()         { *m = QueryAdlRanksRequest{} }
+func (m *QueryAdlRanksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdlRanksRequest) ProtoMessage()    {}
+func (*QueryAdlRanksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{7}
+}
+func (m *QueryAdlRanksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdlRanksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdlRanksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdlRanksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdlRanksRequest.Merge(m, src)
+}
+func (m *QueryAdlRanksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdlRanksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdlRanksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdlRanksRequest proto.InternalMessageInfo
+
+func (m *QueryAdlRanksRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryAdlRanksRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QueryAdlRanksResponse is response type for the AdlRanks RPC method.
+type QueryAdlRanksResponse struct {
+	// The ranks of the open perpetual positions of the subaccount, in ascending
+	// order of perpetual id.
+	AdlRanks []AdlRank `protobuf:"bytes,1,rep,name=adl_ranks,json=adlRanks,proto3" json:"adl_ranks"`
+}
+
+func (m *QueryAdlRanksResponse) Reset()         { *m = QueryAdlRanksResponse{} }
+func (m *QueryAdlRanksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdlRanksResponse) ProtoMessage()    {}
+func (*QueryAdlRanksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{8}
+}
+func (m *QueryAdlRanksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdlRanksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdlRanksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdlRanksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdlRanksResponse.Merge(m, src)
+}
+func (m *QueryAdlRanksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdlRanksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdlRanksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdlRanksResponse proto.InternalMessageInfo
+
+func (m *QueryAdlRanksResponse) GetAdlRanks() []AdlRank {
+	if m != nil {
+		return m.AdlRanks
+	}
+	return nil
+}
+
+// AdlRank is the position of an open perpetual position in the
+// auto-deleveraging queue of its perpetual and side. Positions are ranked by
+// unrealized PnL multiplied by leverage, and positions with a lower rank are
+// used first to offset deleveraged positions.
+type AdlRank struct {
+	// The id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The 1-based rank of the position among all open positions on the same
+	// side of the perpetual. Ranks are capped at 10,000.
+	Rank uint32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The number of open positions on the same side of the perpetual.
+	NumPositions uint32 `protobuf:"varint,3,opt,name=num_positions,json=numPositions,proto3" json:"num_positions,omitempty"`
+	// The percentage of positions on the same side of the perpetual that rank
+	// at or after this position, in parts per million. A position at the front
+	// of the queue has a percentile of 1,000,000.
+	PercentilePpm uint32 `protobuf:"varint,4,opt,name=percentile_ppm,json=percentilePpm,proto3" json:"percentile_ppm,omitempty"`
+	// The unrealized PnL of the position in quote quantums.
+	UnrealizedPnl github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,5,opt,name=unrealized_pnl,json=unrealizedPnl,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"unrealized_pnl"`
+}
+
+func (m *AdlRank) Reset()         { *m = AdlRank{} }
+func (m *AdlRank) String() string { return proto.CompactTextString(m) }
+func (*AdlRank) ProtoMessage()    {}
+func (*AdlRank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{9}
+}
+func (m *AdlRank) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdlRank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdlRank.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdlRank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdlRank.Merge(m, src)
+}
+func (m *AdlRank) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdlRank) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdlRank.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdlRank proto.InternalMessageInfo
+
+func (m *AdlRank) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *AdlRank) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *AdlRank) GetNumPositions() uint32 {
+	if m != nil {
+		return m.NumPositions
+	}
+	return 0
+}
+
+func (m *AdlRank) GetPercentilePpm() uint32 {
+	if m != nil {
+		return m.PercentilePpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryGetSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryGetSubaccountRequest")
 	proto.RegisterType((*QuerySubaccountResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountResponse")
 	proto.RegisterType((*QueryAllSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryAllSubaccountRequest")
 	proto.RegisterType((*QuerySubaccountAllResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountAllResponse")
+	proto.RegisterType((*QueryLiquidationCandidatesRequest)(nil), "dydxprotocol.subaccounts.QueryLiquidationCandidatesRequest")
+	proto.RegisterType((*QueryLiquidationCandidatesResponse)(nil), "dydxprotocol.subaccounts.QueryLiquidationCandidatesResponse")
+	proto.RegisterType((*LiquidationCandidate)(nil), "dydxprotocol.subaccounts.LiquidationCandidate")
+	proto.RegisterType((*QueryAdlRanksRequest)(nil), "dydxprotocol.subaccounts.QueryAdlRanksRequest")
+	proto.RegisterType((*QueryAdlRanksResponse)(nil), "dydxprotocol.subaccounts.QueryAdlRanksResponse")
+	proto.RegisterType((*AdlRank)(nil), "dydxprotocol.subaccounts.AdlRank")
 }
 
 func init() {
@@ -239,38 +583,62 @@ func init() {
 }
 
 var fileDescriptor_adc19ff1d5b72954 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0x14, 0x31,
-	0x14, 0xc7, 0x37, 0xab, 0x2d, 0x18, 0xe9, 0x25, 0x14, 0xdd, 0x0e, 0x32, 0x96, 0x61, 0xa9, 0x55,
-	0x6c, 0xc2, 0xb6, 0x15, 0x4f, 0x3d, 0xec, 0x1e, 0x2c, 0x78, 0xd2, 0xe9, 0x41, 0xf0, 0x22, 0x99,
-	0xd9, 0x90, 0x0e, 0xcc, 0x26, 0xd3, 0x49, 0xa6, 0x76, 0x29, 0xbd, 0xf8, 0x09, 0x04, 0xbf, 0x84,
-	0x57, 0xd1, 0x0f, 0xd1, 0x63, 0xd1, 0x8b, 0x27, 0x91, 0x5d, 0x0f, 0x7e, 0x0c, 0xd9, 0x24, 0xee,
-	0xcc, 0xba, 0x0e, 0xbb, 0xf4, 0x96, 0xe4, 0xbd, 0xff, 0x7b, 0xbf, 0xff, 0xcb, 0x83, 0xed, 0xfe,
-	0xb0, 0x7f, 0x96, 0xe5, 0x52, 0xcb, 0x58, 0xa6, 0x44, 0x15, 0x11, 0x8d, 0x63, 0x59, 0x08, 0xad,
-	0xc8, 0x49, 0xc1, 0xf2, 0x21, 0x36, 0x21, 0xd4, 0xaa, 0x66, 0xe1, 0x4a, 0x96, 0xb7, 0x11, 0x4b,
-	0x35, 0x90, 0xea, 0x8d, 0x09, 0x12, 0x7b, 0xb1, 0x22, 0x6f, 0x9d, 0x4b, 0x2e, 0xed, 0xfb, 0xe4,
-	0xe4, 0x5e, 0xef, 0x71, 0x29, 0x79, 0xca, 0x08, 0xcd, 0x12, 0x42, 0x85, 0x90, 0x9a, 0xea, 0x44,
-	0x8a, 0xbf, 0x9a, 0x47, 0xb6, 0x02, 0x89, 0xa8, 0x62, 0x96, 0x80, 0x9c, 0x76, 0x22, 0xa6, 0x69,
-	0x87, 0x64, 0x94, 0x27, 0xc2, 0x24, 0xbb, 0xdc, 0x87, 0xb5, 0xe8, 0xe5, 0xd9, 0xa6, 0x06, 0x31,
-	0xdc, 0x78, 0x39, 0x29, 0x76, 0xc8, 0xf4, 0xd1, 0x34, 0x16, 0xb2, 0x93, 0x82, 0x29, 0x8d, 0x30,
-	0x5c, 0x91, 0x6f, 0x05, 0xcb, 0x5b, 0x60, 0x13, 0x6c, 0xdf, 0xea, 0xb5, 0xbe, 0x7e, 0xd9, 0x59,
-	0x77, 0x46, 0xba, 0xfd, 0x7e, 0xce, 0x94, 0x3a, 0xd2, 0x79, 0x22, 0x78, 0x68, 0xd3, 0xd0, 0x1d,
-	0xb8, 0x2a, 0x8a, 0x41, 0xc4, 0xf2, 0x56, 0x73, 0x13, 0x6c, 0xaf, 0x85, 0xee, 0x16, 0x30, 0x78,
-	0xd7, 0x34, 0xa9, 0x76, 0x50, 0x99, 0x14, 0x8a, 0xa1, 0xe7, 0x10, 0x96, 0x4c, 0xa6, 0xcf, 0xed,
-	0xdd, 0x36, 0xae, 0x1b, 0x2a, 0x2e, 0x2b, 0xf4, 0x6e, 0x5e, 0xfe, 0xb8, 0xdf, 0x08, 0x2b, 0xea,
-	0xa9, 0x97, 0x6e, 0x9a, 0xce, 0x7b, 0x79, 0x06, 0x61, 0x39, 0x27, 0xd7, 0x68, 0x0b, 0x3b, 0x37,
-	0x93, 0xa1, 0x62, 0xfb, 0xad, 0x6e, 0xa8, 0xf8, 0x05, 0xe5, 0xcc, 0x69, 0xc3, 0x8a, 0x32, 0xf8,
-	0x04, 0xa0, 0xf7, 0x8f, 0x99, 0x6e, 0x9a, 0xd6, 0xfa, 0xb9, 0x71, 0x7d, 0x3f, 0xe8, 0x70, 0x06,
-	0xb9, 0x69, 0x90, 0x1f, 0x2c, 0x44, 0xb6, 0x20, 0x55, 0xe6, 0xdd, 0xdf, 0x4d, 0xb8, 0x62, 0x98,
-	0xd1, 0x67, 0x00, 0x61, 0xd9, 0x13, 0xed, 0xd5, 0x93, 0xd5, 0x6e, 0x85, 0xd7, 0x59, 0x20, 0x9a,
-	0xff, 0xe5, 0xe0, 0xe0, 0xdd, 0xb7, 0x5f, 0x1f, 0x9a, 0x4f, 0xd1, 0x13, 0xb2, 0xc4, 0x66, 0x92,
-	0x73, 0xb3, 0x4d, 0x17, 0xe4, 0xdc, 0xae, 0xcf, 0x05, 0xfa, 0x08, 0xe0, 0xda, 0xcc, 0xb8, 0x17,
-	0x82, 0xff, 0x6f, 0x05, 0xbc, 0xfd, 0xa5, 0xc1, 0x2b, 0x3f, 0x1a, 0x3c, 0x36, 0xec, 0x5b, 0xa8,
-	0xbd, 0x0c, 0x7b, 0xef, 0xd5, 0xe5, 0xc8, 0x07, 0x57, 0x23, 0x1f, 0xfc, 0x1c, 0xf9, 0xe0, 0xfd,
-	0xd8, 0x6f, 0x5c, 0x8d, 0xfd, 0xc6, 0xf7, 0xb1, 0xdf, 0x78, 0x7d, 0xc0, 0x13, 0x7d, 0x5c, 0x44,
-	0x38, 0x96, 0x83, 0xd9, 0x4a, 0xa7, 0xfb, 0x3b, 0xf1, 0x31, 0x4d, 0x04, 0x99, 0xbe, 0x9c, 0xcd,
-	0x54, 0xd7, 0xc3, 0x8c, 0xa9, 0x68, 0xd5, 0x44, 0xf7, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3a,
-	0x73, 0x87, 0x23, 0x97, 0x04, 0x00, 0x00,
+	// 874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xd3, 0xa4, 0xb4, 0xaf, 0xd9, 0x1e, 0x46, 0x29, 0x6c, 0x57, 0xd5, 0xb6, 0x31, 0xa1,
+	0x14, 0x44, 0x6d, 0x92, 0x86, 0x3f, 0x12, 0xad, 0x44, 0x52, 0x44, 0x09, 0x02, 0x29, 0x75, 0x90,
+	0x90, 0x90, 0xc0, 0x9a, 0xb5, 0x9f, 0xdc, 0x51, 0xc7, 0x33, 0x8e, 0x3d, 0x2e, 0x0d, 0x55, 0x2f,
+	0x7c, 0x00, 0x84, 0xc4, 0x97, 0x80, 0x23, 0x82, 0x6f, 0xc0, 0xa5, 0x07, 0x0e, 0x15, 0x5c, 0x10,
+	0x87, 0x0a, 0x25, 0xdc, 0xf8, 0x0c, 0x48, 0x95, 0xc7, 0xb3, 0xb6, 0xb7, 0x59, 0x77, 0x57, 0xd1,
+	0xde, 0x66, 0xde, 0xbc, 0xf7, 0x7e, 0xbf, 0xf7, 0x9b, 0x37, 0x6f, 0x60, 0x35, 0xdc, 0x0f, 0xef,
+	0x27, 0xa9, 0x54, 0x32, 0x90, 0xdc, 0xcd, 0xf2, 0x01, 0x0d, 0x02, 0x99, 0x0b, 0x95, 0xb9, 0x7b,
+	0x39, 0xa6, 0xfb, 0x8e, 0x3e, 0x22, 0xdd, 0xa6, 0x97, 0xd3, 0xf0, 0xea, 0x9d, 0x0f, 0x64, 0x16,
+	0xcb, 0xcc, 0xd7, 0x87, 0x6e, 0xb9, 0x29, 0x83, 0x7a, 0xcb, 0x91, 0x8c, 0x64, 0x69, 0x2f, 0x56,
+	0xc6, 0x7a, 0x21, 0x92, 0x32, 0xe2, 0xe8, 0xd2, 0x84, 0xb9, 0x54, 0x08, 0xa9, 0xa8, 0x62, 0x52,
+	0x0c, 0x63, 0x5e, 0x2f, 0x33, 0xb8, 0x03, 0x9a, 0x61, 0xc9, 0xc0, 0xbd, 0xb7, 0x36, 0x40, 0x45,
+	0xd7, 0xdc, 0x84, 0x46, 0x4c, 0x68, 0x67, 0xe3, 0xfb, 0x5a, 0x2b, 0xf5, 0x7a, 0x5d, 0xba, 0xda,
+	0x01, 0x9c, 0xbf, 0x5d, 0x24, 0xbb, 0x85, 0x6a, 0xb7, 0x3a, 0xf3, 0x70, 0x2f, 0xc7, 0x4c, 0x11,
+	0x07, 0x16, 0xe5, 0xd7, 0x02, 0xd3, 0xae, 0x75, 0xc9, 0xba, 0x72, 0x7a, 0xab, 0xfb, 0xc7, 0xaf,
+	0x57, 0x97, 0x4d, 0x21, 0x9b, 0x61, 0x98, 0x62, 0x96, 0xed, 0xaa, 0x94, 0x89, 0xc8, 0x2b, 0xdd,
+	0xc8, 0x8b, 0x70, 0x52, 0xe4, 0xf1, 0x00, 0xd3, 0xee, 0xfc, 0x25, 0xeb, 0x4a, 0xc7, 0x33, 0x3b,
+	0x1b, 0xe1, 0x25, 0x0d, 0xd2, 0x44, 0xc8, 0x12, 0x29, 0x32, 0x24, 0x1f, 0x03, 0xd4, 0x9c, 0x34,
+	0xce, 0x99, 0xf5, 0x55, 0xa7, 0x4d, 0x54, 0xa7, 0xce, 0xb0, 0xb5, 0xf0, 0xe8, 0xc9, 0xc5, 0x39,
+	0xaf, 0x11, 0x5d, 0xd5, 0xb2, 0xc9, 0xf9, 0xd1, 0x5a, 0x3e, 0x04, 0xa8, 0x75, 0x32, 0x40, 0x97,
+	0x1d, 0x53, 0x4d, 0x21, 0xaa, 0x53, 0x5e, 0xab, 0x11, 0xd5, 0xd9, 0xa1, 0x11, 0x9a, 0x58, 0xaf,
+	0x11, 0x69, 0xff, 0x6c, 0x41, 0xef, 0x99, 0x62, 0x36, 0x39, 0x6f, 0xad, 0xe7, 0xc4, 0xf1, 0xeb,
+	0x21, 0xb7, 0x46, 0x28, 0xcf, 0x6b, 0xca, 0xaf, 0x4e, 0xa4, 0x5c, 0x12, 0x19, 0xe1, 0x7c, 0x17,
+	0x56, 0x34, 0xe5, 0x4f, 0xd8, 0x5e, 0xce, 0x42, 0x6d, 0xbb, 0x49, 0x45, 0x58, 0x2c, 0x31, 0x9b,
+	0xb5, 0x40, 0xbf, 0x59, 0x60, 0x3f, 0x0f, 0xcd, 0x08, 0xf5, 0x19, 0x40, 0x50, 0x59, 0x8d, 0x50,
+	0x4e, 0xbb, 0x50, 0xe3, 0x92, 0x0d, 0x25, 0xab, 0xf3, 0xcc, 0x4e, 0xb2, 0xff, 0xe6, 0x61, 0x79,
+	0x1c, 0x26, 0xb9, 0x0d, 0x9d, 0x9a, 0x97, 0xcf, 0xc2, 0x4a, 0xa9, 0x29, 0xee, 0x78, 0x3b, 0x34,
+	0x94, 0x97, 0xb2, 0x86, 0x8d, 0x48, 0x38, 0x2b, 0x50, 0xf9, 0x81, 0xe4, 0x9c, 0x2a, 0x4c, 0x29,
+	0xd7, 0xc4, 0x97, 0xb6, 0x3e, 0x2a, 0x7c, 0xff, 0x7e, 0x72, 0xf1, 0xfd, 0x88, 0xa9, 0x3b, 0xf9,
+	0xc0, 0x09, 0x64, 0xec, 0x8e, 0xbc, 0xec, 0x7b, 0x1b, 0x57, 0x83, 0x3b, 0x94, 0x09, 0xb7, 0xb2,
+	0x84, 0x6a, 0x3f, 0xc1, 0xcc, 0xd9, 0xc5, 0x94, 0x51, 0xce, 0xbe, 0xa1, 0x03, 0x8e, 0xdb, 0x42,
+	0x79, 0x1d, 0x81, 0xea, 0x66, 0x95, 0x9e, 0x7c, 0x67, 0x41, 0x3f, 0xa6, 0x4c, 0x28, 0x14, 0x54,
+	0x04, 0xe8, 0xc7, 0x34, 0x8d, 0x98, 0xf0, 0x53, 0xdc, 0xcb, 0x59, 0x8a, 0x31, 0x0a, 0xd5, 0x3d,
+	0x31, 0x63, 0x06, 0x17, 0x1a, 0x78, 0x9f, 0x6a, 0x38, 0xaf, 0x46, 0xb3, 0xbf, 0x82, 0xe5, 0xf2,
+	0xe5, 0x86, 0xdc, 0xa3, 0xe2, 0x6e, 0x36, 0xeb, 0x01, 0xf4, 0x25, 0x9c, 0x7b, 0x26, 0xbf, 0xe9,
+	0xc2, 0x0f, 0xe0, 0x34, 0x0d, 0xb9, 0x9f, 0x16, 0x46, 0xd3, 0x84, 0x2b, 0xed, 0x37, 0x69, 0xc2,
+	0xcd, 0x25, 0x9e, 0xa2, 0x26, 0x9b, 0xfd, 0xbf, 0x05, 0x2f, 0x98, 0x33, 0xb2, 0x02, 0x4b, 0x09,
+	0xa6, 0x09, 0xaa, 0x9c, 0xf2, 0x61, 0x7b, 0x74, 0xbc, 0x33, 0x95, 0x6d, 0x3b, 0x24, 0x04, 0x16,
+	0x0a, 0x40, 0xc3, 0x51, 0xaf, 0xc9, 0xcb, 0xd0, 0x11, 0x79, 0xec, 0x27, 0x32, 0x63, 0x7a, 0xea,
+	0xeb, 0x0b, 0xe8, 0x78, 0x4b, 0x22, 0x8f, 0x77, 0x86, 0x36, 0xf2, 0x0a, 0x9c, 0x4d, 0x30, 0x0d,
+	0x50, 0x28, 0xc6, 0xd1, 0x4f, 0x92, 0xb8, 0xbb, 0xa0, 0xbd, 0x3a, 0xb5, 0x75, 0x27, 0x89, 0x8b,
+	0x7e, 0xca, 0x45, 0x8a, 0x85, 0xfc, 0x18, 0xfa, 0x89, 0xe0, 0xdd, 0xc5, 0x59, 0xf7, 0x53, 0x9d,
+	0x7f, 0x47, 0xf0, 0xf5, 0xc3, 0x45, 0x58, 0xd4, 0xfa, 0x92, 0x5f, 0x2c, 0x80, 0xba, 0xdf, 0xc9,
+	0xb5, 0x76, 0x2d, 0x5b, 0x7f, 0x9d, 0xde, 0xda, 0x84, 0xa0, 0xa3, 0xbf, 0x88, 0x7d, 0xe3, 0xdb,
+	0x3f, 0xff, 0xfd, 0x61, 0xfe, 0x1d, 0xf2, 0x96, 0x3b, 0xc5, 0xcf, 0xe7, 0x3e, 0xd0, 0xcd, 0xf2,
+	0xd0, 0x7d, 0x50, 0x76, 0xc7, 0x43, 0xf2, 0xa3, 0x05, 0x9d, 0x91, 0x71, 0x3e, 0x91, 0xf8, 0xb8,
+	0x2f, 0xa6, 0xb7, 0x31, 0x35, 0xf1, 0xc6, 0x8f, 0x61, 0xbf, 0xa1, 0xb9, 0x5f, 0x26, 0xab, 0xd3,
+	0x70, 0x27, 0xbf, 0x5b, 0x70, 0x6e, 0xec, 0x60, 0x25, 0xef, 0x4d, 0x40, 0x7f, 0xde, 0xf0, 0xef,
+	0x5d, 0x3f, 0x5e, 0xb0, 0x29, 0xe1, 0x5d, 0x5d, 0xc2, 0x3a, 0x79, 0xb3, 0xbd, 0x04, 0x5e, 0x27,
+	0xf0, 0x1b, 0xf3, 0xfa, 0x27, 0x0b, 0x4e, 0x0d, 0x1f, 0x25, 0x71, 0x26, 0x89, 0x3e, 0x3a, 0x1d,
+	0x7a, 0xee, 0xd4, 0xfe, 0x86, 0xe7, 0x75, 0xcd, 0xf3, 0x6d, 0xb2, 0xd1, 0xce, 0xb3, 0x9a, 0x06,
+	0x47, 0xba, 0x64, 0xeb, 0xf3, 0x47, 0x07, 0x7d, 0xeb, 0xf1, 0x41, 0xdf, 0xfa, 0xe7, 0xa0, 0x6f,
+	0x7d, 0x7f, 0xd8, 0x9f, 0x7b, 0x7c, 0xd8, 0x9f, 0xfb, 0xeb, 0xb0, 0x3f, 0xf7, 0xc5, 0x8d, 0xe9,
+	0x1f, 0xd4, 0xfd, 0x11, 0x34, 0xfd, 0xba, 0x06, 0x27, 0xf5, 0xe9, 0xb5, 0xa7, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x28, 0x58, 0x76, 0x2a, 0x72, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -289,6 +657,13 @@ type QueryClient interface {
 	Subaccount(ctx context.Context, in *QueryGetSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountResponse, error)
 	// Queries a list of Subaccount items.
 	SubaccountAll(ctx context.Context, in *QueryAllSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountAllResponse, error)
+	// Queries a page of subaccounts with open perpetual positions along with
+	// their net collateral and maintenance margin requirement at current oracle
+	// prices.
+	LiquidationCandidates(ctx context.Context, in *QueryLiquidationCandidatesRequest, opts ...grpc.CallOption) (*QueryLiquidationCandidatesResponse, error)
+	// Queries the auto-deleveraging rank of each open perpetual position of a
+	// subaccount.
+	AdlRanks(ctx context.Context, in *QueryAdlRanksRequest, opts ...grpc.CallOption) (*QueryAdlRanksResponse, error)
 }
 
 type queryClient struct {
@@ -317,12 +692,37 @@ func (c *queryClient) SubaccountAll(ctx context.Context, in *QueryAllSubaccountR
 	return out, nil
 }
 
+func (c *queryClient) LiquidationCandidates(ctx context.Context, in *QueryLiquidationCandidatesRequest, opts ...grpc.CallOption) (*QueryLiquidationCandidatesResponse, error) {
+	out := new(QueryLiquidationCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/LiquidationCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdlRanks(ctx context.Context, in *QueryAdlRanksRequest, opts ...grpc.CallOption) (*QueryAdlRanksResponse, error) {
+	out := new(QueryAdlRanksResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/AdlRanks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a Subaccount by id
 	Subaccount(context.Context, *QueryGetSubaccountRequest) (*QuerySubaccountResponse, error)
 	// Queries a list of Subaccount items.
 	SubaccountAll(context.Context, *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error)
+	// Queries a page of subaccounts with open perpetual positions along with
+	// their net collateral and maintenance margin requirement at current oracle
+	// prices.
+	LiquidationCandidates(context.Context, *QueryLiquidationCandidatesRequest) (*QueryLiquidationCandidatesResponse, error)
+	// Queries the auto-deleveraging rank of each open perpetual position of a
+	// subaccount.
+	AdlRanks(context.Context, *QueryAdlRanksRequest) (*QueryAdlRanksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -335,6 +735,12 @@ func (*UnimplementedQueryServer) Subaccount(ctx context.Context, req *QueryGetSu
 func (*UnimplementedQueryServer) SubaccountAll(ctx context.Context, req *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubaccountAll not implemented")
 }
+func (*UnimplementedQueryServer) LiquidationCandidates(ctx context.Context, req *QueryLiquidationCandidatesRequest) (*QueryLiquidationCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidationCandidates not implemented")
+}
+func (*UnimplementedQueryServer) AdlRanks(ctx context.Context, req *QueryAdlRanksRequest) (*QueryAdlRanksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdlRanks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -376,6 +782,42 @@ func _Query_SubaccountAll_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LiquidationCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidationCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidationCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/LiquidationCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidationCandidates(ctx, req.(*QueryLiquidationCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdlRanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdlRanksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdlRanks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/AdlRanks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdlRanks(ctx, req.(*QueryAdlRanksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.subaccounts.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -388,6 +830,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubaccountAll",
 			Handler:    _Query_SubaccountAll_Handler,
 		},
+		{
+			MethodName: "LiquidationCandidates",
+			Handler:    _Query_LiquidationCandidates_Handler,
+		},
+		{
+			MethodName: "AdlRanks",
+			Handler:    _Query_AdlRanks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/subaccounts/query.proto",
@@ -545,27 +995,289 @@ func (m *QuerySubaccountAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLiquidationCandidatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetSubaccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+
+func (m *QueryLiquidationCandidatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationCandidatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationCandidatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationCandidatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationCandidatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaintenanceMarginRequirement.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdlRanksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdlRanksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdlRanksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdlRanksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdlRanksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdlRanksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdlRanks) > 0 {
+		for iNdEx := len(m.AdlRanks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdlRanks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdlRank) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdlRank) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdlRank) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnrealizedPnl.Size()
+		i -= size
+		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PercentilePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PercentilePpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumPositions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPositions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rank != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetSubaccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Number != 0 {
 		n += 1 + sovQuery(uint64(m.Number))
 	}
@@ -608,20 +1320,717 @@ func (m *QuerySubaccountAllResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidationCandidatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidationCandidatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidationCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAdlRanksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryAdlRanksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AdlRanks) > 0 {
+		for _, e := range m.AdlRanks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdlRank) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovQuery(uint64(m.Rank))
+	}
+	if m.NumPositions != 0 {
+		n += 1 + sovQuery(uint64(m.NumPositions))
+	}
+	if m.PercentilePpm != 0 {
+		n += 1 + sovQuery(uint64(m.PercentilePpm))
+	}
+	l = m.UnrealizedPnl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSubaccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSubaccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subaccount = append(m.Subaccount, Subaccount{})
+			if err := m.Subaccount[len(m.Subaccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidationCandidatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidationCandidatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidationCandidatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidationCandidatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidationCandidatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidationCandidatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, LiquidationCandidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidationCandidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -644,17 +2053,17 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSubaccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationCandidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -664,29 +2073,30 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
 			}
-			m.Number = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -696,11 +2106,58 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRequirement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -722,7 +2179,7 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAdlRanksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -745,17 +2202,17 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubaccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdlRanksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubaccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdlRanksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -765,25 +2222,43 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -805,7 +2280,7 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAdlRanksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -828,15 +2303,15 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSubaccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdlRanksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdlRanksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdlRanks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -863,10 +2338,8 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdlRanks = append(m.AdlRanks, AdlRank{})
+			if err := m.AdlRanks[len(m.AdlRanks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -891,7 +2364,7 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
+func (m *AdlRank) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -914,17 +2387,17 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubaccountAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdlRank: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubaccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdlRank: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -934,31 +2407,73 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPositions", wireType)
 			}
-			m.Subaccount = append(m.Subaccount, Subaccount{})
-			if err := m.Subaccount[len(m.Subaccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NumPositions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPositions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentilePpm", wireType)
+			}
+			m.PercentilePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PercentilePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -968,25 +2483,22 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -63,6 +63,25 @@ func (m *Subaccount) GetPerpetualPositionForId(
 	return nil, false
 }
 
+// GetAssetPositionForId returns the asset position with the given
+// asset id. Returns nil if subaccount does not have a position
+// for the asset.
+func (m *Subaccount) GetAssetPositionForId(
+	assetId uint32,
+) (
+	assetPosition *AssetPosition,
+	exists bool,
+) {
+	if m != nil {
+		for _, position := range m.AssetPositions {
+			if position.AssetId == assetId {
+				return position, true
+			}
+		}
+	}
+	return nil, false
+}
+
 // GetUsdcPosition returns the balance of the USDC asset position.
 func (m *Subaccount) GetUsdcPosition() *big.Int {
 	usdcAssetPosition := m.getUsdcAssetPosition()
@@ -143,6 +143,34 @@ func TestSubaccountGetPerpetualPositionForId(t *testing.T) {
 	require.Nil(t, position)
 }
 
+func TestSubaccountGetAssetPositionForId(t *testing.T) {
+	expectedAssetPositions := []*types.AssetPosition{
+		{
+			AssetId:  0,
+			Quantums: dtypes.NewInt(100),
+		},
+		{
+			AssetId:  1,
+			Quantums: dtypes.NewInt(100),
+		},
+	}
+	subaccount := types.Subaccount{
+		AssetPositions: expectedAssetPositions,
+	}
+
+	position, exists := subaccount.GetAssetPositionForId(0)
+	require.True(t, exists)
+	require.Equal(t, expectedAssetPositions[0], position)
+
+	position, exists = subaccount.GetAssetPositionForId(1)
+	require.True(t, exists)
+	require.Equal(t, expectedAssetPositions[1], position)
+
+	position, exists = subaccount.GetAssetPositionForId(2)
+	require.False(t, exists)
+	require.Nil(t, position)
+}
+
 func TestGetSubaccountQuoteBalance(t *testing.T) {
 	tests := map[string]struct {
 		subaccount           *types.Subaccount
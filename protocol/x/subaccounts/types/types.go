@@ -50,6 +50,7 @@ type SubaccountsKeeper interface {
 		amount *big.Int,
 	) (err error)
 	SetSubaccount(ctx sdk.Context, subaccount Subaccount)
+	InitializePositionEntryNotionals(ctx sdk.Context) error
 	GetSubaccount(
 		ctx sdk.Context,
 		id SubaccountId,
@@ -54,6 +54,7 @@ var updateResultStringMap = map[UpdateResult]string{
 	1: "NewlyUndercollateralized",
 	2: "StillUndercollateralized",
 	3: "UpdateCausedError",
+	4: "InsufficientAssetBalance",
 }
 
 const (
@@ -61,6 +62,7 @@ const (
 	NewlyUndercollateralized
 	StillUndercollateralized
 	UpdateCausedError
+	InsufficientAssetBalance
 )
 
 // Update is used by the subaccounts keeper to allow other modules
@@ -88,6 +88,10 @@ func TestUpdateResultString(t *testing.T) {
 			value:          types.UpdateCausedError,
 			expectedResult: "UpdateCausedError",
 		},
+		"InsufficientAssetBalance": {
+			value:          types.InsufficientAssetBalance,
+			expectedResult: "InsufficientAssetBalance",
+		},
 		"UnexpectedError": {
 			value:          types.UpdateResult(5),
 			expectedResult: "UnexpectedError",
@@ -124,6 +128,10 @@ func TestUpdateResultIsSuccess(t *testing.T) {
 			value:          types.UpdateCausedError,
 			expectedResult: false,
 		},
+		"InsufficientAssetBalance": {
+			value:          types.InsufficientAssetBalance,
+			expectedResult: false,
+		},
 		"UnexpectedError": {
 			value:          types.UpdateResult(5),
 			expectedResult: false,